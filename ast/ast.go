@@ -129,6 +129,7 @@ const (
 	Add = OperatorNumber(iota + 1)
 	Sub
 	Mult
+	MatMult
 	Div
 	Modulo
 	Pow
@@ -148,6 +149,8 @@ func (o OperatorNumber) String() string {
 		return "Sub()"
 	case Mult:
 		return "Mult()"
+	case MatMult:
+		return "MatMult()"
 	case Div:
 		return "Div()"
 	case Modulo:
@@ -283,13 +286,20 @@ type FunctionDef struct {
 	Returns       Expr
 }
 
+type AsyncFunctionDef struct {
+	StmtBase
+	Name          Identifier
+	Args          *Arguments
+	Body          []Stmt
+	DecoratorList []Expr
+	Returns       Expr
+}
+
 type ClassDef struct {
 	StmtBase
 	Name          Identifier
 	Bases         []Expr
 	Keywords      []*Keyword
-	Starargs      Expr
-	Kwargs        Expr
 	Body          []Stmt
 	DecoratorList []Expr
 }
@@ -317,6 +327,16 @@ type AugAssign struct {
 	Value  Expr
 }
 
+// AnnAssign is an assignment with a type annotation. Simple is 1 if
+// the target is a Name which wasn't in parentheses.
+type AnnAssign struct {
+	StmtBase
+	Target     Expr
+	Annotation Expr
+	Value      Expr
+	Simple     int
+}
+
 type For struct {
 	StmtBase
 	Target Expr
@@ -325,6 +345,14 @@ type For struct {
 	Orelse []Stmt
 }
 
+type AsyncFor struct {
+	StmtBase
+	Target Expr
+	Iter   Expr
+	Body   []Stmt
+	Orelse []Stmt
+}
+
 type While struct {
 	StmtBase
 	Test   Expr
@@ -345,6 +373,12 @@ type With struct {
 	Body  []Stmt
 }
 
+type AsyncWith struct {
+	StmtBase
+	Items []*WithItem
+	Body  []Stmt
+}
+
 type Raise struct {
 	StmtBase
 	Exc   Expr
@@ -418,6 +452,12 @@ type BoolOp struct {
 	Values []Expr
 }
 
+type NamedExpr struct {
+	ExprBase
+	Target Expr
+	Value  Expr
+}
+
 type BinOp struct {
 	ExprBase
 	Left  Expr
@@ -480,6 +520,11 @@ type GeneratorExp struct {
 	Generators []Comprehension
 }
 
+type Await struct {
+	ExprBase
+	Value Expr
+}
+
 type Yield struct {
 	ExprBase
 	Value Expr
@@ -503,8 +548,6 @@ type Call struct {
 	Func     Expr
 	Args     []Expr
 	Keywords []*Keyword
-	Starargs Expr
-	Kwargs   Expr
 }
 
 type Num struct {
@@ -522,6 +565,22 @@ type Bytes struct {
 	S py.Bytes
 }
 
+// JoinedStr is an f-string made of Str and FormattedValue parts
+type JoinedStr struct {
+	ExprBase
+	Values []Expr
+}
+
+// FormattedValue is a single {} replacement field in an f-string
+//
+// Conversion is -1 for none, otherwise one of 's', 'r' or 'a'
+type FormattedValue struct {
+	ExprBase
+	Value      Expr
+	Conversion int
+	FormatSpec Expr
+}
+
 type NameConstant struct {
 	ExprBase
 	Value Singleton
@@ -659,12 +718,13 @@ type ExceptHandler struct {
 
 type Arguments struct {
 	Pos
-	Args       []*Arg
-	Vararg     *Arg
-	Kwonlyargs []*Arg
-	KwDefaults []Expr
-	Kwarg      *Arg
-	Defaults   []Expr
+	Posonlyargs []*Arg
+	Args        []*Arg
+	Vararg      *Arg
+	Kwonlyargs  []*Arg
+	KwDefaults  []Expr
+	Kwarg       *Arg
+	Defaults    []Expr
 }
 
 type Arg struct {
@@ -705,15 +765,19 @@ var _ Mod = (*Suite)(nil)
 // Stmt
 var _ Stmt = (*StmtBase)(nil)
 var _ Stmt = (*FunctionDef)(nil)
+var _ Stmt = (*AsyncFunctionDef)(nil)
 var _ Stmt = (*ClassDef)(nil)
 var _ Stmt = (*Return)(nil)
 var _ Stmt = (*Delete)(nil)
 var _ Stmt = (*Assign)(nil)
 var _ Stmt = (*AugAssign)(nil)
+var _ Stmt = (*AnnAssign)(nil)
 var _ Stmt = (*For)(nil)
+var _ Stmt = (*AsyncFor)(nil)
 var _ Stmt = (*While)(nil)
 var _ Stmt = (*If)(nil)
 var _ Stmt = (*With)(nil)
+var _ Stmt = (*AsyncWith)(nil)
 var _ Stmt = (*Raise)(nil)
 var _ Stmt = (*Try)(nil)
 var _ Stmt = (*Assert)(nil)
@@ -729,6 +793,7 @@ var _ Stmt = (*Continue)(nil)
 // Expr
 var _ Expr = (*ExprBase)(nil)
 var _ Expr = (*BoolOp)(nil)
+var _ Expr = (*NamedExpr)(nil)
 var _ Expr = (*BinOp)(nil)
 var _ Expr = (*UnaryOp)(nil)
 var _ Expr = (*Lambda)(nil)
@@ -739,6 +804,7 @@ var _ Expr = (*ListComp)(nil)
 var _ Expr = (*SetComp)(nil)
 var _ Expr = (*DictComp)(nil)
 var _ Expr = (*GeneratorExp)(nil)
+var _ Expr = (*Await)(nil)
 var _ Expr = (*Yield)(nil)
 var _ Expr = (*YieldFrom)(nil)
 var _ Expr = (*Compare)(nil)
@@ -746,6 +812,8 @@ var _ Expr = (*Call)(nil)
 var _ Expr = (*Num)(nil)
 var _ Expr = (*Str)(nil)
 var _ Expr = (*Bytes)(nil)
+var _ Expr = (*JoinedStr)(nil)
+var _ Expr = (*FormattedValue)(nil)
 var _ Expr = (*NameConstant)(nil)
 var _ Expr = (*Ellipsis)(nil)
 var _ Expr = (*Attribute)(nil)
@@ -782,15 +850,19 @@ var SuiteType = ModBaseType.NewType("Suite", "Suite Node", nil, nil)
 // Stmt
 var StmtBaseType = ASTType.NewType("Stmt", "Stmt Node", nil, nil)
 var FunctionDefType = StmtBaseType.NewType("FunctionDef", "FunctionDef Node", nil, nil)
+var AsyncFunctionDefType = StmtBaseType.NewType("AsyncFunctionDef", "AsyncFunctionDef Node", nil, nil)
 var ClassDefType = StmtBaseType.NewType("ClassDef", "ClassDef Node", nil, nil)
 var ReturnType = StmtBaseType.NewType("Return", "Return Node", nil, nil)
 var DeleteType = StmtBaseType.NewType("Delete", "Delete Node", nil, nil)
 var AssignType = StmtBaseType.NewType("Assign", "Assign Node", nil, nil)
 var AugAssignType = StmtBaseType.NewType("AugAssign", "AugAssign Node", nil, nil)
+var AnnAssignType = StmtBaseType.NewType("AnnAssign", "AnnAssign Node", nil, nil)
 var ForType = StmtBaseType.NewType("For", "For Node", nil, nil)
+var AsyncForType = StmtBaseType.NewType("AsyncFor", "AsyncFor Node", nil, nil)
 var WhileType = StmtBaseType.NewType("While", "While Node", nil, nil)
 var IfType = StmtBaseType.NewType("If", "If Node", nil, nil)
 var WithType = StmtBaseType.NewType("With", "With Node", nil, nil)
+var AsyncWithType = StmtBaseType.NewType("AsyncWith", "AsyncWith Node", nil, nil)
 var RaiseType = StmtBaseType.NewType("Raise", "Raise Node", nil, nil)
 var TryType = StmtBaseType.NewType("Try", "Try Node", nil, nil)
 var AssertType = StmtBaseType.NewType("Assert", "Assert Node", nil, nil)
@@ -806,6 +878,7 @@ var ContinueType = StmtBaseType.NewType("Continue", "Continue Node", nil, nil)
 // Expr
 var ExprBaseType = ASTType.NewType("Expr", "Expr Node", nil, nil)
 var BoolOpType = ExprBaseType.NewType("BoolOp", "BoolOp Node", nil, nil)
+var NamedExprType = ExprBaseType.NewType("NamedExpr", "NamedExpr Node", nil, nil)
 var BinOpType = ExprBaseType.NewType("BinOp", "BinOp Node", nil, nil)
 var UnaryOpType = ExprBaseType.NewType("UnaryOp", "UnaryOp Node", nil, nil)
 var LambdaType = ExprBaseType.NewType("Lambda", "Lambda Node", nil, nil)
@@ -816,6 +889,7 @@ var ListCompType = ExprBaseType.NewType("ListComp", "ListComp Node", nil, nil)
 var SetCompType = ExprBaseType.NewType("SetComp", "SetComp Node", nil, nil)
 var DictCompType = ExprBaseType.NewType("DictComp", "DictComp Node", nil, nil)
 var GeneratorExpType = ExprBaseType.NewType("GeneratorExp", "GeneratorExp Node", nil, nil)
+var AwaitType = ExprBaseType.NewType("Await", "Await Node", nil, nil)
 var YieldType = ExprBaseType.NewType("Yield", "Yield Node", nil, nil)
 var YieldFromType = ExprBaseType.NewType("YieldFrom", "YieldFrom Node", nil, nil)
 var CompareType = ExprBaseType.NewType("Compare", "Compare Node", nil, nil)
@@ -823,6 +897,8 @@ var CallType = ExprBaseType.NewType("Call", "Call Node", nil, nil)
 var NumType = ExprBaseType.NewType("Num", "Num Node", nil, nil)
 var StrType = ExprBaseType.NewType("Str", "Str Node", nil, nil)
 var BytesType = ExprBaseType.NewType("Bytes", "Bytes Node", nil, nil)
+var JoinedStrType = ExprBaseType.NewType("JoinedStr", "JoinedStr Node", nil, nil)
+var FormattedValueType = ExprBaseType.NewType("FormattedValue", "FormattedValue Node", nil, nil)
 var NameConstantType = ExprBaseType.NewType("NameConstant", "NameConstant Node", nil, nil)
 var EllipsisType = ExprBaseType.NewType("Ellipsis", "Ellipsis Node", nil, nil)
 var AttributeType = ExprBaseType.NewType("Attribute", "Attribute Node", nil, nil)
@@ -847,68 +923,76 @@ var AliasType = ASTType.NewType("Alias", "Alias Node", nil, nil)
 var WithItemType = ASTType.NewType("WithItem", "WithItem Node", nil, nil)
 
 // Python type definitions
-func (o *AST) Type() *py.Type           { return ASTType }
-func (o *ModBase) Type() *py.Type       { return ModBaseType }
-func (o *Module) Type() *py.Type        { return ModuleType }
-func (o *Interactive) Type() *py.Type   { return InteractiveType }
-func (o *Expression) Type() *py.Type    { return ExpressionType }
-func (o *Suite) Type() *py.Type         { return SuiteType }
-func (o *StmtBase) Type() *py.Type      { return StmtBaseType }
-func (o *FunctionDef) Type() *py.Type   { return FunctionDefType }
-func (o *ClassDef) Type() *py.Type      { return ClassDefType }
-func (o *Return) Type() *py.Type        { return ReturnType }
-func (o *Delete) Type() *py.Type        { return DeleteType }
-func (o *Assign) Type() *py.Type        { return AssignType }
-func (o *AugAssign) Type() *py.Type     { return AugAssignType }
-func (o *For) Type() *py.Type           { return ForType }
-func (o *While) Type() *py.Type         { return WhileType }
-func (o *If) Type() *py.Type            { return IfType }
-func (o *With) Type() *py.Type          { return WithType }
-func (o *Raise) Type() *py.Type         { return RaiseType }
-func (o *Try) Type() *py.Type           { return TryType }
-func (o *Assert) Type() *py.Type        { return AssertType }
-func (o *Import) Type() *py.Type        { return ImportType }
-func (o *ImportFrom) Type() *py.Type    { return ImportFromType }
-func (o *Global) Type() *py.Type        { return GlobalType }
-func (o *Nonlocal) Type() *py.Type      { return NonlocalType }
-func (o *ExprStmt) Type() *py.Type      { return ExprStmtType }
-func (o *Pass) Type() *py.Type          { return PassType }
-func (o *Break) Type() *py.Type         { return BreakType }
-func (o *Continue) Type() *py.Type      { return ContinueType }
-func (o *ExprBase) Type() *py.Type      { return ExprBaseType }
-func (o *BoolOp) Type() *py.Type        { return BoolOpType }
-func (o *BinOp) Type() *py.Type         { return BinOpType }
-func (o *UnaryOp) Type() *py.Type       { return UnaryOpType }
-func (o *Lambda) Type() *py.Type        { return LambdaType }
-func (o *IfExp) Type() *py.Type         { return IfExpType }
-func (o *Dict) Type() *py.Type          { return DictType }
-func (o *Set) Type() *py.Type           { return SetType }
-func (o *ListComp) Type() *py.Type      { return ListCompType }
-func (o *SetComp) Type() *py.Type       { return SetCompType }
-func (o *DictComp) Type() *py.Type      { return DictCompType }
-func (o *GeneratorExp) Type() *py.Type  { return GeneratorExpType }
-func (o *Yield) Type() *py.Type         { return YieldType }
-func (o *YieldFrom) Type() *py.Type     { return YieldFromType }
-func (o *Compare) Type() *py.Type       { return CompareType }
-func (o *Call) Type() *py.Type          { return CallType }
-func (o *Num) Type() *py.Type           { return NumType }
-func (o *Str) Type() *py.Type           { return StrType }
-func (o *Bytes) Type() *py.Type         { return BytesType }
-func (o *NameConstant) Type() *py.Type  { return NameConstantType }
-func (o *Ellipsis) Type() *py.Type      { return EllipsisType }
-func (o *Attribute) Type() *py.Type     { return AttributeType }
-func (o *Subscript) Type() *py.Type     { return SubscriptType }
-func (o *Starred) Type() *py.Type       { return StarredType }
-func (o *Name) Type() *py.Type          { return NameType }
-func (o *List) Type() *py.Type          { return ListType }
-func (o *Tuple) Type() *py.Type         { return TupleType }
-func (o *SliceBase) Type() *py.Type     { return SliceBaseType }
-func (o *Slice) Type() *py.Type         { return SliceType }
-func (o *ExtSlice) Type() *py.Type      { return ExtSliceType }
-func (o *Index) Type() *py.Type         { return IndexType }
-func (o *ExceptHandler) Type() *py.Type { return ExceptHandlerType }
-func (o *Arguments) Type() *py.Type     { return ArgumentsType }
-func (o *Arg) Type() *py.Type           { return ArgType }
-func (o *Keyword) Type() *py.Type       { return KeywordType }
-func (o *Alias) Type() *py.Type         { return AliasType }
-func (o *WithItem) Type() *py.Type      { return WithItemType }
+func (o *AST) Type() *py.Type              { return ASTType }
+func (o *ModBase) Type() *py.Type          { return ModBaseType }
+func (o *Module) Type() *py.Type           { return ModuleType }
+func (o *Interactive) Type() *py.Type      { return InteractiveType }
+func (o *Expression) Type() *py.Type       { return ExpressionType }
+func (o *Suite) Type() *py.Type            { return SuiteType }
+func (o *StmtBase) Type() *py.Type         { return StmtBaseType }
+func (o *FunctionDef) Type() *py.Type      { return FunctionDefType }
+func (o *AsyncFunctionDef) Type() *py.Type { return AsyncFunctionDefType }
+func (o *ClassDef) Type() *py.Type         { return ClassDefType }
+func (o *Return) Type() *py.Type           { return ReturnType }
+func (o *Delete) Type() *py.Type           { return DeleteType }
+func (o *Assign) Type() *py.Type           { return AssignType }
+func (o *AugAssign) Type() *py.Type        { return AugAssignType }
+func (o *AnnAssign) Type() *py.Type        { return AnnAssignType }
+func (o *For) Type() *py.Type              { return ForType }
+func (o *AsyncFor) Type() *py.Type         { return AsyncForType }
+func (o *While) Type() *py.Type            { return WhileType }
+func (o *If) Type() *py.Type               { return IfType }
+func (o *With) Type() *py.Type             { return WithType }
+func (o *AsyncWith) Type() *py.Type        { return AsyncWithType }
+func (o *Raise) Type() *py.Type            { return RaiseType }
+func (o *Try) Type() *py.Type              { return TryType }
+func (o *Assert) Type() *py.Type           { return AssertType }
+func (o *Import) Type() *py.Type           { return ImportType }
+func (o *ImportFrom) Type() *py.Type       { return ImportFromType }
+func (o *Global) Type() *py.Type           { return GlobalType }
+func (o *Nonlocal) Type() *py.Type         { return NonlocalType }
+func (o *ExprStmt) Type() *py.Type         { return ExprStmtType }
+func (o *Pass) Type() *py.Type             { return PassType }
+func (o *Break) Type() *py.Type            { return BreakType }
+func (o *Continue) Type() *py.Type         { return ContinueType }
+func (o *ExprBase) Type() *py.Type         { return ExprBaseType }
+func (o *BoolOp) Type() *py.Type           { return BoolOpType }
+func (o *NamedExpr) Type() *py.Type        { return NamedExprType }
+func (o *BinOp) Type() *py.Type            { return BinOpType }
+func (o *UnaryOp) Type() *py.Type          { return UnaryOpType }
+func (o *Lambda) Type() *py.Type           { return LambdaType }
+func (o *IfExp) Type() *py.Type            { return IfExpType }
+func (o *Dict) Type() *py.Type             { return DictType }
+func (o *Set) Type() *py.Type              { return SetType }
+func (o *ListComp) Type() *py.Type         { return ListCompType }
+func (o *SetComp) Type() *py.Type          { return SetCompType }
+func (o *DictComp) Type() *py.Type         { return DictCompType }
+func (o *GeneratorExp) Type() *py.Type     { return GeneratorExpType }
+func (o *Await) Type() *py.Type            { return AwaitType }
+func (o *Yield) Type() *py.Type            { return YieldType }
+func (o *YieldFrom) Type() *py.Type        { return YieldFromType }
+func (o *Compare) Type() *py.Type          { return CompareType }
+func (o *Call) Type() *py.Type             { return CallType }
+func (o *Num) Type() *py.Type              { return NumType }
+func (o *Str) Type() *py.Type              { return StrType }
+func (o *Bytes) Type() *py.Type            { return BytesType }
+func (o *JoinedStr) Type() *py.Type        { return JoinedStrType }
+func (o *FormattedValue) Type() *py.Type   { return FormattedValueType }
+func (o *NameConstant) Type() *py.Type     { return NameConstantType }
+func (o *Ellipsis) Type() *py.Type         { return EllipsisType }
+func (o *Attribute) Type() *py.Type        { return AttributeType }
+func (o *Subscript) Type() *py.Type        { return SubscriptType }
+func (o *Starred) Type() *py.Type          { return StarredType }
+func (o *Name) Type() *py.Type             { return NameType }
+func (o *List) Type() *py.Type             { return ListType }
+func (o *Tuple) Type() *py.Type            { return TupleType }
+func (o *SliceBase) Type() *py.Type        { return SliceBaseType }
+func (o *Slice) Type() *py.Type            { return SliceType }
+func (o *ExtSlice) Type() *py.Type         { return ExtSliceType }
+func (o *Index) Type() *py.Type            { return IndexType }
+func (o *ExceptHandler) Type() *py.Type    { return ExceptHandlerType }
+func (o *Arguments) Type() *py.Type        { return ArgumentsType }
+func (o *Arg) Type() *py.Type              { return ArgType }
+func (o *Keyword) Type() *py.Type          { return KeywordType }
+func (o *Alias) Type() *py.Type            { return AliasType }
+func (o *WithItem) Type() *py.Type         { return WithItemType }
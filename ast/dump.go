@@ -83,6 +83,8 @@ func dump(ast interface{}, name string) string {
 			fname = "kw_defaults"
 		case "decoratorlist":
 			fname = "decorator_list"
+		case "formatspec":
+			fname = "format_spec"
 		}
 		if fieldValue.Kind() == reflect.Slice && fieldValue.Type().Elem().Kind() != reflect.Uint8 {
 			strs := make([]string, fieldValue.Len())
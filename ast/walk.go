@@ -84,20 +84,29 @@ func Walk(ast Ast, Visit func(Ast) bool) {
 		walkExprs(node.DecoratorList)
 		walk(node.Returns)
 
+	case *AsyncFunctionDef:
+		// Name          Identifier
+		// Args          *Arguments
+		// Body          []Stmt
+		// DecoratorList []Expr
+		// Returns       Expr
+		if node.Args != nil {
+			walk(node.Args)
+		}
+		walkStmts(node.Body)
+		walkExprs(node.DecoratorList)
+		walk(node.Returns)
+
 	case *ClassDef:
 		// Name          Identifier
 		// Bases         []Expr
 		// Keywords      []*Keyword
-		// Starargs      Expr
-		// Kwargs        Expr
 		// Body          []Stmt
 		// DecoratorList []Expr
 		walkExprs(node.Bases)
 		for _, k := range node.Keywords {
 			walk(k)
 		}
-		walk(node.Starargs)
-		walk(node.Kwargs)
 		walkStmts(node.Body)
 		walkExprs(node.DecoratorList)
 
@@ -122,6 +131,15 @@ func Walk(ast Ast, Visit func(Ast) bool) {
 		walk(node.Target)
 		walk(node.Value)
 
+	case *AnnAssign:
+		// Target     Expr
+		// Annotation Expr
+		// Value      Expr
+		// Simple     int
+		walk(node.Target)
+		walk(node.Annotation)
+		walk(node.Value)
+
 	case *For:
 		// Target Expr
 		// Iter   Expr
@@ -132,6 +150,16 @@ func Walk(ast Ast, Visit func(Ast) bool) {
 		walkStmts(node.Body)
 		walkStmts(node.Orelse)
 
+	case *AsyncFor:
+		// Target Expr
+		// Iter   Expr
+		// Body   []Stmt
+		// Orelse []Stmt
+		walk(node.Target)
+		walk(node.Iter)
+		walkStmts(node.Body)
+		walkStmts(node.Orelse)
+
 	case *While:
 		// Test   Expr
 		// Body   []Stmt
@@ -156,6 +184,14 @@ func Walk(ast Ast, Visit func(Ast) bool) {
 		}
 		walkStmts(node.Body)
 
+	case *AsyncWith:
+		// Items []*WithItem
+		// Body  []Stmt
+		for _, wi := range node.Items {
+			walk(wi)
+		}
+		walkStmts(node.Body)
+
 	case *Raise:
 		// Exc   Expr
 		// Cause Expr
@@ -217,6 +253,12 @@ func Walk(ast Ast, Visit func(Ast) bool) {
 		// Values []Expr
 		walkExprs(node.Values)
 
+	case *NamedExpr:
+		// Target Expr
+		// Value  Expr
+		walk(node.Target)
+		walk(node.Value)
+
 	case *BinOp:
 		// Left  Expr
 		// Op    OperatorNumber
@@ -281,6 +323,10 @@ func Walk(ast Ast, Visit func(Ast) bool) {
 		walk(node.Elt)
 		walkComprehensions(node.Generators)
 
+	case *Await:
+		// Value Expr
+		walk(node.Value)
+
 	case *Yield:
 		// Value Expr
 		walk(node.Value)
@@ -300,15 +346,11 @@ func Walk(ast Ast, Visit func(Ast) bool) {
 		// Func     Expr
 		// Args     []Expr
 		// Keywords []*Keyword
-		// Starargs Expr
-		// Kwargs   Expr
 		walk(node.Func)
 		walkExprs(node.Args)
 		for _, k := range node.Keywords {
 			walk(k)
 		}
-		walk(node.Starargs)
-		walk(node.Kwargs)
 
 	case *Num:
 		// N Object
@@ -319,6 +361,17 @@ func Walk(ast Ast, Visit func(Ast) bool) {
 	case *Bytes:
 		// S py.Bytes
 
+	case *JoinedStr:
+		// Values []Expr
+		walkExprs(node.Values)
+
+	case *FormattedValue:
+		// Value      Expr
+		// Conversion int
+		// FormatSpec Expr
+		walk(node.Value)
+		walk(node.FormatSpec)
+
 	case *NameConstant:
 		// Value Singleton
 
@@ -386,12 +439,16 @@ func Walk(ast Ast, Visit func(Ast) bool) {
 		walkStmts(node.Body)
 
 	case *Arguments:
-		// Args       []*Arg
-		// Vararg     *Arg
-		// Kwonlyargs []*Arg
-		// KwDefaults []Expr
-		// Kwarg      *Arg
-		// Defaults   []Expr
+		// Posonlyargs []*Arg
+		// Args        []*Arg
+		// Vararg      *Arg
+		// Kwonlyargs  []*Arg
+		// KwDefaults  []Expr
+		// Kwarg       *Arg
+		// Defaults    []Expr
+		for _, arg := range node.Posonlyargs {
+			walk(arg)
+		}
 		for _, arg := range node.Args {
 			walk(arg)
 		}
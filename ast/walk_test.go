@@ -28,15 +28,19 @@ func TestWalk(t *testing.T) {
 		{&Expression{}, []string{"*ast.Expression"}},
 		{&Suite{}, []string{"*ast.Suite"}},
 		{&FunctionDef{}, []string{"*ast.FunctionDef"}},
+		{&AsyncFunctionDef{}, []string{"*ast.AsyncFunctionDef"}},
 		{&ClassDef{}, []string{"*ast.ClassDef"}},
 		{&Return{}, []string{"*ast.Return"}},
 		{&Delete{}, []string{"*ast.Delete"}},
 		{&Assign{}, []string{"*ast.Assign"}},
 		{&AugAssign{}, []string{"*ast.AugAssign"}},
+		{&AnnAssign{}, []string{"*ast.AnnAssign"}},
 		{&For{}, []string{"*ast.For"}},
+		{&AsyncFor{}, []string{"*ast.AsyncFor"}},
 		{&While{}, []string{"*ast.While"}},
 		{&If{}, []string{"*ast.If"}},
 		{&With{}, []string{"*ast.With"}},
+		{&AsyncWith{}, []string{"*ast.AsyncWith"}},
 		{&Raise{}, []string{"*ast.Raise"}},
 		{&Try{}, []string{"*ast.Try"}},
 		{&Assert{}, []string{"*ast.Assert"}},
@@ -49,6 +53,7 @@ func TestWalk(t *testing.T) {
 		{&Break{}, []string{"*ast.Break"}},
 		{&Continue{}, []string{"*ast.Continue"}},
 		{&BoolOp{}, []string{"*ast.BoolOp"}},
+		{&NamedExpr{}, []string{"*ast.NamedExpr"}},
 		{&BinOp{}, []string{"*ast.BinOp"}},
 		{&UnaryOp{}, []string{"*ast.UnaryOp"}},
 		{&Lambda{}, []string{"*ast.Lambda"}},
@@ -59,6 +64,7 @@ func TestWalk(t *testing.T) {
 		{&SetComp{}, []string{"*ast.SetComp"}},
 		{&DictComp{}, []string{"*ast.DictComp"}},
 		{&GeneratorExp{}, []string{"*ast.GeneratorExp"}},
+		{&Await{}, []string{"*ast.Await"}},
 		{&Yield{}, []string{"*ast.Yield"}},
 		{&YieldFrom{}, []string{"*ast.YieldFrom"}},
 		{&Compare{}, []string{"*ast.Compare"}},
@@ -66,6 +72,8 @@ func TestWalk(t *testing.T) {
 		{&Num{}, []string{"*ast.Num"}},
 		{&Str{}, []string{"*ast.Str"}},
 		{&Bytes{}, []string{"*ast.Bytes"}},
+		{&JoinedStr{}, []string{"*ast.JoinedStr"}},
+		{&FormattedValue{}, []string{"*ast.FormattedValue"}},
 		{&NameConstant{}, []string{"*ast.NameConstant"}},
 		{&Ellipsis{}, []string{"*ast.Ellipsis"}},
 		{&Attribute{}, []string{"*ast.Attribute"}},
@@ -0,0 +1,274 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Asyncio module
+//
+// A subset of the python asyncio module with an event loop
+// implemented in Go. Timers are Go timers and Go code can complete
+// futures from other goroutines with Loop.CallSoonThreadsafe or
+// Loop.Go.
+
+package asyncio
+
+import (
+	"github.com/go-python/gpython/py"
+)
+
+const module_doc = `The asyncio package, tracking PEP 3156.
+
+This is a subset of the python asyncio package with an event loop
+implemented in Go.`
+
+const run_doc = `run(main) -> Execute the coroutine and return the result.
+
+This function runs the passed coroutine, taking care of managing the
+event loop and cancelling any remaining tasks when it finishes.
+
+This function cannot be called when another asyncio event loop is
+running.`
+
+func asyncio_run(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var main py.Object
+	err := py.ParseTupleAndKeywords(args, kwargs, "O:run", []string{"main"}, &main)
+	if err != nil {
+		return nil, err
+	}
+	return Run(py.GetContext(self), main)
+}
+
+const sleep_doc = `sleep(delay, result=None) -> Coroutine that completes after a given time (in seconds).`
+
+func asyncio_sleep(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var delayObj py.Object
+	var result py.Object = py.None
+	err := py.ParseTupleAndKeywords(args, kwargs, "d|O:sleep", []string{"delay", "result"}, &delayObj, &result)
+	if err != nil {
+		return nil, err
+	}
+	l, err := GetRunningLoop(py.GetContext(self))
+	if err != nil {
+		return nil, err
+	}
+	return Sleep(l, float64(delayObj.(py.Float)), result), nil
+}
+
+// Sleep returns a future which completes with result after delay
+// seconds
+func Sleep(l *Loop, delay float64, result py.Object) *Future {
+	f := NewFuture(l)
+	f.onCancel = l.CallLater(delay, func() error {
+		if f.Done() {
+			return nil
+		}
+		return f.SetResult(result)
+	})
+	return f
+}
+
+const create_task_doc = `create_task(coro) -> Schedule the execution of a coroutine object in a spawn task.
+
+Return a Task object.`
+
+func asyncio_create_task(self py.Object, coro py.Object) (py.Object, error) {
+	l, err := GetRunningLoop(py.GetContext(self))
+	if err != nil {
+		return nil, err
+	}
+	return NewTask(l, coro)
+}
+
+const ensure_future_doc = `ensure_future(coro_or_future) -> Wrap a coroutine or an awaitable in a future.
+
+If the argument is a Future, it is returned directly.`
+
+func asyncio_ensure_future(self py.Object, coro py.Object) (py.Object, error) {
+	l, err := GetRunningLoop(py.GetContext(self))
+	if err != nil {
+		return nil, err
+	}
+	f, err := l.ensureFuture(coro)
+	if err != nil {
+		return nil, err
+	}
+	return f.self, nil
+}
+
+const gather_doc = `gather(*coros_or_futures, return_exceptions=False) -> Return a future aggregating results from the given coroutines/futures.
+
+Coroutines will be wrapped in a future and scheduled in the event
+loop. The results are returned in the order of the original sequence.
+
+If return_exceptions is True, exceptions in the tasks are treated the
+same as successful results, and gathered in the result list; otherwise,
+the first raised exception will be immediately propagated to the
+returned future.
+
+Cancelling the returned future cancels all the children.`
+
+func asyncio_gather(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var returnExceptions py.Object = py.False
+	err := py.ParseTupleAndKeywords(nil, kwargs, "|p:gather", []string{"return_exceptions"}, &returnExceptions)
+	if err != nil {
+		return nil, err
+	}
+	l, err := GetRunningLoop(py.GetContext(self))
+	if err != nil {
+		return nil, err
+	}
+	return Gather(l, args, returnExceptions == py.True)
+}
+
+// Gather returns a future which completes with a list of the results
+// of the awaitables
+func Gather(l *Loop, aws py.Tuple, returnExceptions bool) (*Future, error) {
+	children := make([]*Future, len(aws))
+	for i, aw := range aws {
+		f, err := l.ensureFuture(aw)
+		if err != nil {
+			return nil, err
+		}
+		children[i] = f
+	}
+	outer := NewFuture(l)
+	if len(children) == 0 {
+		_ = outer.SetResult(py.NewList())
+		return outer, nil
+	}
+	outer.onCancel = func() {
+		for _, child := range children {
+			child.Cancel()
+		}
+	}
+	finished := 0
+	for _, child := range children {
+		child := child
+		child.AddDoneCallback(func() error {
+			finished++
+			if outer.Done() {
+				return nil
+			}
+			if !returnExceptions {
+				if child.Cancelled() {
+					return outer.SetException(newCancelledError())
+				}
+				if child.exception != nil {
+					return outer.SetException(child.exception)
+				}
+			}
+			if finished < len(children) {
+				return nil
+			}
+			results := make([]py.Object, len(children))
+			for i, f := range children {
+				switch {
+				case f.Cancelled():
+					results[i] = exceptionValue(newCancelledError())
+				case f.exception != nil:
+					results[i] = exceptionValue(f.exception)
+				default:
+					results[i] = f.result
+				}
+			}
+			return outer.SetResult(py.NewListFromItems(results))
+		})
+	}
+	return outer, nil
+}
+
+const wait_for_doc = `wait_for(aw, timeout) -> Wait for the single Future or coroutine to complete, with timeout.
+
+Coroutine will be wrapped in Task.
+
+Returns result of the Future or coroutine.  When a timeout occurs,
+it cancels the task and raises TimeoutError.  To avoid the task
+cancellation, wrap it in shield().
+
+If the wait is cancelled, the task is also cancelled.`
+
+func asyncio_wait_for(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var aw, timeoutObj py.Object
+	err := py.ParseTupleAndKeywords(args, kwargs, "OO:wait_for", []string{"fut", "timeout"}, &aw, &timeoutObj)
+	if err != nil {
+		return nil, err
+	}
+	l, err := GetRunningLoop(py.GetContext(self))
+	if err != nil {
+		return nil, err
+	}
+	if timeoutObj == py.None {
+		f, err := l.ensureFuture(aw)
+		if err != nil {
+			return nil, err
+		}
+		return f.self, nil
+	}
+	timeout, err := py.FloatAsFloat64(timeoutObj)
+	if err != nil {
+		return nil, err
+	}
+	return WaitFor(l, aw, timeout)
+}
+
+// WaitFor returns a future which completes with the result of aw or
+// with TimeoutError if that takes longer than timeout seconds in which
+// case aw is cancelled
+func WaitFor(l *Loop, aw py.Object, timeout float64) (*Future, error) {
+	inner, err := l.ensureFuture(aw)
+	if err != nil {
+		return nil, err
+	}
+	outer := NewFuture(l)
+	stopTimer := l.CallLater(timeout, func() error {
+		if outer.Done() {
+			return nil
+		}
+		inner.Cancel()
+		return outer.SetException(py.MakeException(TimeoutError))
+	})
+	inner.AddDoneCallback(func() error {
+		stopTimer()
+		if outer.Done() {
+			return nil
+		}
+		return outer.copyState(inner)
+	})
+	outer.onCancel = func() {
+		stopTimer()
+		inner.Cancel()
+	}
+	return outer, nil
+}
+
+const get_running_loop_doc = `get_running_loop() -> Return the running event loop.  Raise a RuntimeError if there is none.`
+
+func asyncio_get_running_loop(self py.Object) (py.Object, error) {
+	return GetRunningLoop(py.GetContext(self))
+}
+
+// Initialise the module
+func init() {
+	methods := []*py.Method{
+		py.MustNewMethod("run", asyncio_run, 0, run_doc),
+		py.MustNewMethod("sleep", asyncio_sleep, 0, sleep_doc),
+		py.MustNewMethod("create_task", asyncio_create_task, 0, create_task_doc),
+		py.MustNewMethod("ensure_future", asyncio_ensure_future, 0, ensure_future_doc),
+		py.MustNewMethod("gather", asyncio_gather, 0, gather_doc),
+		py.MustNewMethod("wait_for", asyncio_wait_for, 0, wait_for_doc),
+		py.MustNewMethod("get_running_loop", asyncio_get_running_loop, 0, get_running_loop_doc),
+	}
+	globals := py.StringDict{
+		"CancelledError":    CancelledError,
+		"TimeoutError":      TimeoutError,
+		"InvalidStateError": InvalidStateError,
+		"QueueEmpty":        QueueEmpty,
+		"QueueFull":         QueueFull,
+	}
+	module := py.NewModule("asyncio", module_doc, methods, globals)
+	module.ContextInit = func(m *py.Module) {
+		m.Globals["Future"] = futureType(m.Context)
+		m.Globals["Task"] = taskType(m.Context)
+		m.Globals["Event"] = eventType(m.Context)
+		m.Globals["Queue"] = queueType(m.Context)
+	}
+}
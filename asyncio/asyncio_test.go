@@ -0,0 +1,139 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package asyncio_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-python/gpython/asyncio"
+	"github.com/go-python/gpython/compile"
+	"github.com/go-python/gpython/py"
+	"github.com/go-python/gpython/pytest"
+	"github.com/go-python/gpython/vm"
+)
+
+// A module standing in for a Go service which python code awaits
+func init() {
+	methods := []*py.Method{
+		py.MustNewMethod("fetch", func(self py.Object, args py.Tuple) (py.Object, error) {
+			var value py.Object
+			err := py.UnpackTuple(args, nil, "fetch", 1, 1, &value)
+			if err != nil {
+				return nil, err
+			}
+			l, err := asyncio.GetRunningLoop(py.GetContext(self))
+			if err != nil {
+				return nil, err
+			}
+			return l.Go(func() (py.Object, error) {
+				time.Sleep(time.Millisecond)
+				if value == py.None {
+					return nil, py.ExceptionNewf(py.ValueError, "nothing to fetch")
+				}
+				return value, nil
+			}), nil
+		}, 0, "fetch(value) -> future completed with value by a goroutine"),
+		py.MustNewMethod("complete", func(self py.Object, args py.Tuple) (py.Object, error) {
+			var fut, value py.Object
+			err := py.UnpackTuple(args, nil, "complete", 2, 2, &fut, &value)
+			if err != nil {
+				return nil, err
+			}
+			f, ok := fut.(*asyncio.Future)
+			if !ok {
+				return nil, py.ExceptionNewf(py.TypeError, "expecting Future")
+			}
+			l, err := asyncio.GetRunningLoop(py.GetContext(self))
+			if err != nil {
+				return nil, err
+			}
+			go func() {
+				time.Sleep(time.Millisecond)
+				l.CallSoonThreadsafe(func() error {
+					return f.SetResult(value)
+				})
+			}()
+			return py.None, nil
+		}, 0, "complete(future, value) -> set the future's result from a goroutine"),
+	}
+	py.NewModule("goservice", "Test service", methods, nil)
+}
+
+func TestAsyncio(t *testing.T) {
+	pytest.RunTests(t, "tests")
+}
+
+const contextScript = `
+import asyncio
+import goservice
+
+async def worker(q, n):
+    total = 0
+    for i in range(n):
+        total += await q.get()
+        await asyncio.sleep(0)
+    return total
+
+async def main():
+    q = asyncio.Queue()
+    t = asyncio.create_task(worker(q, 10))
+    for i in range(10):
+        await q.put(await goservice.fetch(i * n))
+    return await t
+
+got = asyncio.run(main())
+`
+
+// Runs contextScript in a new context returning the sum it got
+func runAsync(n int) (py.Object, *py.Context, error) {
+	obj, err := compile.Compile(contextScript, "<string>", "exec", 0, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx := py.NewContext(py.DefaultContextOpts())
+	module := ctx.NewModule("__main__", "", nil, nil)
+	module.Globals["n"] = py.Int(n)
+	_, err = vm.Run(module.Globals, module.Globals, obj.(*py.Code), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return module.Globals["got"], ctx, nil
+}
+
+func TestContexts(t *testing.T) {
+	const n = 4
+	var gots [n]py.Object
+	var ctxs [n]*py.Context
+	var errs [n]error
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			gots[i], ctxs[i], errs[i] = runAsync(i)
+		}(i)
+	}
+	wg.Wait()
+	for i := 0; i < n; i++ {
+		if errs[i] != nil {
+			t.Fatalf("context %d: %v", i, errs[i])
+		}
+		if want := py.Int(45 * i); gots[i] != want {
+			t.Errorf("context %d: want %v got %v", i, want, gots[i])
+		}
+	}
+
+	// Each context has its own types which are subtypes of each other
+	m := ctxs[0].MustGetModule("asyncio")
+	future, task := m.Globals["Future"].(*py.Type), m.Globals["Task"].(*py.Type)
+	if !task.IsSubtype(future) || !task.IsSubtype(asyncio.FutureType) || future.IsSubtype(task) {
+		t.Errorf("Task isn't a subtype of Future")
+	}
+	if other := ctxs[1].MustGetModule("asyncio").Globals["Future"]; other == future {
+		t.Errorf("contexts share the Future type")
+	}
+}
@@ -0,0 +1,359 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Future objects
+
+package asyncio
+
+import (
+	"fmt"
+
+	"github.com/go-python/gpython/py"
+)
+
+var (
+	CancelledError    = py.BaseException.NewType("CancelledError", "The Future or Task was cancelled.", nil, nil)
+	TimeoutError      = py.ExceptionType.NewType("TimeoutError", "The operation exceeded the given deadline.", nil, nil)
+	InvalidStateError = py.ExceptionType.NewType("InvalidStateError", "The operation is not allowed in this state.", nil, nil)
+)
+
+// The states a future can be in
+type futureState int
+
+const (
+	statePending futureState = iota
+	stateCancelled
+	stateFinished
+)
+
+// Future is a python asyncio Future
+//
+// Apart from CallSoonThreadsafe the methods must only be called from
+// the goroutine running the loop.
+type Future struct {
+	self      py.Object // the python object - this or the Task
+	loop      *Loop
+	state     futureState
+	result    py.Object
+	exception error
+	callbacks []func() error
+	blocking  bool   // set when yielded to a task by the await
+	onCancel  func() // if set called when the future is cancelled
+}
+
+var FutureType = py.NewTypeX("Future", "This class is *almost* compatible with concurrent.futures.Future.", noContext, nil)
+
+// The iterator returned by Future.__await__
+type FutureIter struct {
+	future *Future
+}
+
+var FutureIterType = py.NewType("FutureIter", "Iterator used to await a Future.")
+
+// Type of this object
+func (f *Future) Type() *py.Type {
+	return futureType(f.loop.ctx)
+}
+
+// Type of this object
+func (it *FutureIter) Type() *py.Type {
+	return FutureIterType
+}
+
+// NewFuture makes a new pending future attached to loop
+func NewFuture(l *Loop) *Future {
+	f := &Future{loop: l}
+	f.self = f
+	return f
+}
+
+// Returns the Future type of ctx which python code makes futures
+// attached to the loop running in the context with
+func futureType(ctx *py.Context) *py.Type {
+	return ctx.SubType(FutureType, futureNew)
+}
+
+// The constructor of the types shared by all the contexts which only
+// their subtypes for each context can make instances of
+func noContext(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	return nil, py.ExceptionNewf(py.TypeError, "cannot create '%s' instances", metatype.Name)
+}
+
+// Makes a Future from python in ctx
+func futureNew(ctx *py.Context, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	err := py.UnpackTuple(args, kwargs, "Future", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	l, err := GetRunningLoop(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewFuture(l), nil
+}
+
+// asFuture returns the Future part of a Future or a Task
+func asFuture(o py.Object) (*Future, bool) {
+	switch x := o.(type) {
+	case *Future:
+		return x, true
+	case *Task:
+		return &x.Future, true
+	}
+	return nil, false
+}
+
+// Returns the value of an exception stored as an error
+func exceptionValue(err error) py.Object {
+	switch x := err.(type) {
+	case py.ExceptionInfo:
+		return x.Value
+	case *py.Exception:
+		return x
+	}
+	return py.MakeException(err)
+}
+
+// Makes an instance of CancelledError
+func newCancelledError() error {
+	return py.MakeException(CancelledError)
+}
+
+// Done returns true if the future is cancelled or has a result or an
+// exception
+func (f *Future) Done() bool {
+	return f.state != statePending
+}
+
+// Cancelled returns true if the future was cancelled
+func (f *Future) Cancelled() bool {
+	return f.state == stateCancelled
+}
+
+// Result returns the result of the future or the exception it was
+// completed with
+func (f *Future) Result() (py.Object, error) {
+	switch f.state {
+	case stateCancelled:
+		return nil, newCancelledError()
+	case statePending:
+		return nil, py.ExceptionNewf(InvalidStateError, "Result is not ready.")
+	}
+	if f.exception != nil {
+		return nil, f.exception
+	}
+	return f.result, nil
+}
+
+// Exception returns the exception the future was completed with or
+// None
+func (f *Future) Exception() (py.Object, error) {
+	switch f.state {
+	case stateCancelled:
+		return nil, newCancelledError()
+	case statePending:
+		return nil, py.ExceptionNewf(InvalidStateError, "Exception is not set.")
+	}
+	if f.exception != nil {
+		return exceptionValue(f.exception), nil
+	}
+	return py.None, nil
+}
+
+// schedule the callbacks now the future is done
+func (f *Future) scheduleCallbacks() {
+	for _, fn := range f.callbacks {
+		f.loop.CallSoon(fn)
+	}
+	f.callbacks = nil
+}
+
+// SetResult marks the future done with result
+func (f *Future) SetResult(result py.Object) error {
+	if f.state != statePending {
+		return py.ExceptionNewf(InvalidStateError, "invalid state")
+	}
+	f.result = result
+	f.state = stateFinished
+	f.scheduleCallbacks()
+	return nil
+}
+
+// SetException marks the future done with the exception err
+func (f *Future) SetException(err error) error {
+	if f.state != statePending {
+		return py.ExceptionNewf(InvalidStateError, "invalid state")
+	}
+	if py.IsException(py.StopIteration, err) {
+		return py.ExceptionNewf(py.TypeError, "StopIteration interacts badly with generators and cannot be raised into a Future")
+	}
+	f.exception = err
+	f.state = stateFinished
+	f.scheduleCallbacks()
+	return nil
+}
+
+// cancel cancels the future itself
+func (f *Future) cancel() bool {
+	if f.state != statePending {
+		return false
+	}
+	f.state = stateCancelled
+	if f.onCancel != nil {
+		f.onCancel()
+		f.onCancel = nil
+	}
+	f.scheduleCallbacks()
+	return true
+}
+
+// Cancel cancels the future (or the task if it is one) returning
+// false if it was already done
+func (f *Future) Cancel() bool {
+	if t, ok := f.self.(*Task); ok {
+		return t.Cancel()
+	}
+	return f.cancel()
+}
+
+// AddDoneCallback arranges for fn to be called on the loop when the
+// future is done
+func (f *Future) AddDoneCallback(fn func() error) {
+	if f.Done() {
+		f.loop.CallSoon(fn)
+		return
+	}
+	f.callbacks = append(f.callbacks, fn)
+}
+
+// copyState makes f finish the same way as other
+func (f *Future) copyState(other *Future) error {
+	if other.Cancelled() {
+		f.Cancel()
+		return nil
+	}
+	if other.exception != nil {
+		return f.SetException(other.exception)
+	}
+	return f.SetResult(other.result)
+}
+
+// M__await__ returns an iterator which waits for the future
+func (f *Future) M__await__() (py.Object, error) {
+	return &FutureIter{future: f}, nil
+}
+
+// M__iter__ makes "yield from future" work in generator based code
+func (f *Future) M__iter__() (py.Object, error) {
+	return f.M__await__()
+}
+
+func (f *Future) M__repr__() (py.Object, error) {
+	name := f.self.Type().Name
+	switch f.state {
+	case statePending:
+		return py.String(fmt.Sprintf("<%s pending>", name)), nil
+	case stateCancelled:
+		return py.String(fmt.Sprintf("<%s cancelled>", name)), nil
+	}
+	if f.exception != nil {
+		r, err := py.ReprAsString(exceptionValue(f.exception))
+		if err != nil {
+			return nil, err
+		}
+		return py.String(fmt.Sprintf("<%s finished exception=%s>", name, r)), nil
+	}
+	r, err := py.ReprAsString(f.result)
+	if err != nil {
+		return nil, err
+	}
+	return py.String(fmt.Sprintf("<%s finished result=%s>", name, r)), nil
+}
+
+func (it *FutureIter) M__iter__() (py.Object, error) {
+	return it, nil
+}
+
+// M__next__ yields the future to the task if it isn't done, otherwise
+// it finishes with the result of the future
+func (it *FutureIter) M__next__() (py.Object, error) {
+	f := it.future
+	if !f.Done() {
+		f.blocking = true
+		return f.self, nil
+	}
+	res, err := f.Result()
+	if err != nil {
+		return nil, err
+	}
+	stop, err := py.ExceptionNew(py.StopIteration, py.Tuple{res}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return nil, stop.(*py.Exception)
+}
+
+func (it *FutureIter) Send(value py.Object) (py.Object, error) {
+	return it.M__next__()
+}
+
+func init() {
+	self := func(o py.Object) *Future {
+		f, _ := asFuture(o)
+		return f
+	}
+	FutureType.Dict["result"] = py.MustNewMethod("result", func(o py.Object) (py.Object, error) {
+		return self(o).Result()
+	}, 0, "Return the result this future represents.")
+	FutureType.Dict["exception"] = py.MustNewMethod("exception", func(o py.Object) (py.Object, error) {
+		return self(o).Exception()
+	}, 0, "Return the exception that was set on this future.")
+	FutureType.Dict["done"] = py.MustNewMethod("done", func(o py.Object) (py.Object, error) {
+		return py.NewBool(self(o).Done()), nil
+	}, 0, "Return True if the future is done.")
+	FutureType.Dict["cancelled"] = py.MustNewMethod("cancelled", func(o py.Object) (py.Object, error) {
+		return py.NewBool(self(o).Cancelled()), nil
+	}, 0, "Return True if the future was cancelled.")
+	FutureType.Dict["cancel"] = py.MustNewMethod("cancel", func(o py.Object) (py.Object, error) {
+		return py.NewBool(self(o).Cancel()), nil
+	}, 0, "Cancel the future and schedule callbacks.")
+	FutureType.Dict["set_result"] = py.MustNewMethod("set_result", func(o py.Object, result py.Object) (py.Object, error) {
+		return py.None, self(o).SetResult(result)
+	}, 0, "Mark the future done and set its result.")
+	FutureType.Dict["set_exception"] = py.MustNewMethod("set_exception", func(o py.Object, exc py.Object) (py.Object, error) {
+		switch exc.(type) {
+		case *py.Exception:
+		case *py.Type:
+			if !py.ExceptionClassCheck(exc) {
+				return nil, py.ExceptionNewf(py.TypeError, "exceptions must derive from BaseException")
+			}
+		default:
+			return nil, py.ExceptionNewf(py.TypeError, "exceptions must derive from BaseException")
+		}
+		return py.None, self(o).SetException(py.MakeException(exc))
+	}, 0, "Mark the future done and set an exception.")
+	FutureType.Dict["add_done_callback"] = py.MustNewMethod("add_done_callback", func(o py.Object, fn py.Object) (py.Object, error) {
+		f := self(o)
+		f.AddDoneCallback(func() error {
+			_, err := py.Call(fn, py.Tuple{f.self}, nil)
+			return err
+		})
+		return py.None, nil
+	}, 0, "Add a callback to be run when the future becomes done.")
+	FutureType.Dict["get_loop"] = py.MustNewMethod("get_loop", func(o py.Object) (py.Object, error) {
+		return self(o).loop, nil
+	}, 0, "Return the event loop the Future is bound to.")
+	FutureType.Dict["__await__"] = py.MustNewMethod("__await__", func(o py.Object) (py.Object, error) {
+		return self(o).M__await__()
+	}, 0, "Return an iterator to be used in await expression.")
+
+	FutureIterType.Dict["send"] = py.MustNewMethod("send", func(self py.Object, value py.Object) (py.Object, error) {
+		return self.(*FutureIter).Send(value)
+	}, 0, "send(arg) -> send 'arg' into the iterator.")
+}
+
+// Check interfaces are satisfied
+var _ py.I__await__ = (*Future)(nil)
+var _ py.I_iterator = (*FutureIter)(nil)
+var _ py.I_send = (*FutureIter)(nil)
@@ -0,0 +1,322 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Event loop
+
+package asyncio
+
+import (
+	"sync"
+	"time"
+
+	"github.com/go-python/gpython/py"
+	"github.com/go-python/gpython/sys"
+)
+
+// Loop is an event loop which runs python tasks
+//
+// The python code all runs on the goroutine which called Run. Other
+// goroutines (timers and Go services) hand work back to the loop with
+// CallSoonThreadsafe.
+type Loop struct {
+	ctx     *py.Context // the context the python code runs in
+	handler py.Object   // set by set_exception_handler or nil
+	start   time.Time
+	ready   []func() error     // callbacks to run on the next iteration
+	tasks   map[*Task]struct{} // tasks which haven't finished
+	mu      sync.Mutex         // protects posted
+	posted  []func() error     // callbacks posted from other goroutines
+	wakeup  chan struct{}      // signalled when posted is added to
+}
+
+var LoopType = py.NewType("EventLoop", "Event loop which runs python tasks.")
+
+// The key the loop running in a context is stored under
+//
+// A context isn't safe to use from more than one goroutine so there
+// can only be one loop running in each.
+type runningKey struct{}
+
+// Type of this object
+func (l *Loop) Type() *py.Type {
+	return LoopType
+}
+
+// NewLoop makes a new event loop for running python code in ctx
+func NewLoop(ctx *py.Context) *Loop {
+	return &Loop{
+		ctx:    ctx,
+		start:  time.Now(),
+		tasks:  make(map[*Task]struct{}),
+		wakeup: make(chan struct{}, 1),
+	}
+}
+
+// GetRunningLoop returns the loop running in ctx or raises a
+// RuntimeError if there isn't one
+func GetRunningLoop(ctx *py.Context) (*Loop, error) {
+	l, _ := ctx.Value(runningKey{}).(*Loop)
+	if l == nil {
+		return nil, py.ExceptionNewf(py.RuntimeError, "no running event loop")
+	}
+	return l, nil
+}
+
+// setRunning marks the loop as the one running in its context
+// returning a function to unmark it
+func (l *Loop) setRunning() (unset func()) {
+	l.ctx.SetValue(runningKey{}, l)
+	return func() {
+		l.ctx.SetValue(runningKey{}, nil)
+	}
+}
+
+// Time returns the number of seconds since the loop was created
+// according to the loop's monotonic clock
+func (l *Loop) Time() float64 {
+	return time.Since(l.start).Seconds()
+}
+
+// CallSoon arranges for fn to be called on the next iteration of the
+// loop
+//
+// This must only be called from the goroutine running the loop.
+func (l *Loop) CallSoon(fn func() error) {
+	l.ready = append(l.ready, fn)
+}
+
+// CallSoonThreadsafe arranges for fn to be called on the next
+// iteration of the loop
+//
+// It may be called from any goroutine.
+func (l *Loop) CallSoonThreadsafe(fn func() error) {
+	l.mu.Lock()
+	l.posted = append(l.posted, fn)
+	l.mu.Unlock()
+	select {
+	case l.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// CallLater arranges for fn to be called on the loop after delay
+// seconds
+//
+// It returns a function which cancels the call if it hasn't happened
+// yet.
+func (l *Loop) CallLater(delay float64, fn func() error) (cancel func()) {
+	if delay <= 0 {
+		cancelled := false
+		l.CallSoon(func() error {
+			if cancelled {
+				return nil
+			}
+			return fn()
+		})
+		return func() { cancelled = true }
+	}
+	stopped := false
+	timer := time.AfterFunc(time.Duration(delay*float64(time.Second)), func() {
+		l.CallSoonThreadsafe(func() error {
+			if stopped {
+				return nil
+			}
+			return fn()
+		})
+	})
+	return func() {
+		stopped = true
+		timer.Stop()
+	}
+}
+
+// Go runs fn on a new goroutine returning a Future which is completed
+// with its result
+//
+// This is the way for Go services to provide results to python code
+// without blocking the interpreter. fn must not use the interpreter.
+func (l *Loop) Go(fn func() (py.Object, error)) *Future {
+	f := NewFuture(l)
+	go func() {
+		res, err := fn()
+		l.CallSoonThreadsafe(func() error {
+			if f.Done() {
+				return nil
+			}
+			if err != nil {
+				return f.SetException(err)
+			}
+			if res == nil {
+				res = py.None
+			}
+			return f.SetResult(res)
+		})
+	}()
+	return f
+}
+
+// runOnce runs one iteration of the loop, waiting for something to
+// be posted if there is nothing ready to run
+func (l *Loop) runOnce() error {
+	if len(l.ready) == 0 {
+		<-l.wakeup
+	}
+	l.mu.Lock()
+	l.ready = append(l.ready, l.posted...)
+	l.posted = nil
+	l.mu.Unlock()
+	ready := l.ready
+	l.ready = nil
+	for i, fn := range ready {
+		err := fn()
+		if err == nil {
+			continue
+		}
+		// As in CPython only these stop the loop, anything else
+		// is reported and the other callbacks carry on
+		if py.IsException(py.SystemExit, err) || py.IsException(py.KeyboardInterrupt, err) {
+			// Keep the unrun callbacks for next time
+			l.ready = append(ready[i+1:], l.ready...)
+			return err
+		}
+		l.callExceptionHandler(err)
+	}
+	return nil
+}
+
+// callExceptionHandler reports err returned by a callback to the
+// handler set with set_exception_handler or prints it to sys.stderr
+// if there isn't one
+func (l *Loop) callExceptionHandler(err error) {
+	exc := exceptionValue(err)
+	if l.handler != nil {
+		context := py.StringDict{
+			"message":   py.String("Exception in callback"),
+			"exception": exc,
+		}
+		_, hookErr := py.Call(l.handler, py.Tuple{l, context}, nil)
+		if hookErr == nil {
+			return
+		}
+		l.defaultExceptionHandler("Unhandled error in exception handler", hookErr)
+	}
+	l.defaultExceptionHandler("Exception in callback", err)
+}
+
+// defaultExceptionHandler prints message and the traceback of err to
+// the context's sys.stderr
+func (l *Loop) defaultExceptionHandler(message string, err error) {
+	stderr, ok := l.ctx.MustGetModule("sys").Globals["stderr"]
+	if ok && stderr != py.None {
+		if write, werr := py.GetAttrString(stderr, "write"); werr == nil {
+			_, _ = py.Call(write, py.Tuple{py.String(message + "\n")}, nil)
+		}
+	}
+	sys.ExceptHook(l.ctx, err)
+}
+
+// runUntil runs the loop until done returns true
+func (l *Loop) runUntil(done func() bool) error {
+	for !done() {
+		err := l.runOnce()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunUntilComplete runs the loop until the future is done returning
+// its result
+func (l *Loop) RunUntilComplete(f *Future) (py.Object, error) {
+	if _, err := GetRunningLoop(l.ctx); err == nil {
+		return nil, py.ExceptionNewf(py.RuntimeError, "This event loop is already running")
+	}
+	defer l.setRunning()()
+	err := l.runUntil(f.Done)
+	if err != nil {
+		return nil, err
+	}
+	return f.Result()
+}
+
+// Run runs the coroutine on a new event loop in ctx returning its
+// result
+//
+// Any tasks still running when it finishes are cancelled.
+func Run(ctx *py.Context, coro py.Object) (py.Object, error) {
+	if _, err := GetRunningLoop(ctx); err == nil {
+		return nil, py.ExceptionNewf(py.RuntimeError, "asyncio.run() cannot be called from a running event loop")
+	}
+	if _, ok := coro.(*py.Coroutine); !ok {
+		r, err := py.ReprAsString(coro)
+		if err != nil {
+			return nil, err
+		}
+		return nil, py.ExceptionNewf(py.ValueError, "a coroutine was expected, got %s", r)
+	}
+	l := NewLoop(ctx)
+	main, err := NewTask(l, coro)
+	if err != nil {
+		return nil, err
+	}
+	res, err := l.RunUntilComplete(&main.Future)
+	cancelErr := l.cancelAllTasks()
+	if err == nil {
+		err = cancelErr
+	}
+	return res, err
+}
+
+// cancelAllTasks cancels any outstanding tasks and waits for them to
+// finish
+func (l *Loop) cancelAllTasks() error {
+	if len(l.tasks) == 0 {
+		return nil
+	}
+	for t := range l.tasks {
+		t.Cancel()
+	}
+	defer l.setRunning()()
+	return l.runUntil(func() bool {
+		return len(l.tasks) == 0
+	})
+}
+
+func init() {
+	LoopType.Dict["time"] = py.MustNewMethod("time", func(self py.Object) (py.Object, error) {
+		return py.Float(self.(*Loop).Time()), nil
+	}, 0, "time() -> the time according to the event loop's clock.")
+	LoopType.Dict["call_soon"] = py.MustNewMethod("call_soon", func(self py.Object, args py.Tuple) (py.Object, error) {
+		if len(args) < 1 {
+			return nil, py.ExceptionNewf(py.TypeError, "call_soon() missing 1 required positional argument: 'callback'")
+		}
+		callback, callArgs := args[0], args[1:]
+		self.(*Loop).CallSoon(func() error {
+			_, err := py.Call(callback, callArgs, nil)
+			return err
+		})
+		return py.None, nil
+	}, 0, "call_soon(callback, *args) -> arrange for callback to be called soon.")
+	LoopType.Dict["set_exception_handler"] = py.MustNewMethod("set_exception_handler", func(self py.Object, handler py.Object) (py.Object, error) {
+		if handler == py.None {
+			handler = nil
+		}
+		self.(*Loop).handler = handler
+		return py.None, nil
+	}, 0, "set_exception_handler(handler) -> set handler(loop, context) as the loop exception handler.")
+	LoopType.Dict["get_exception_handler"] = py.MustNewMethod("get_exception_handler", func(self py.Object) (py.Object, error) {
+		handler := self.(*Loop).handler
+		if handler == nil {
+			return py.None, nil
+		}
+		return handler, nil
+	}, 0, "get_exception_handler() -> return the exception handler or None if the default one is in use.")
+	LoopType.Dict["create_future"] = py.MustNewMethod("create_future", func(self py.Object) (py.Object, error) {
+		return NewFuture(self.(*Loop)), nil
+	}, 0, "create_future() -> create a Future object attached to the loop.")
+	LoopType.Dict["create_task"] = py.MustNewMethod("create_task", func(self py.Object, coro py.Object) (py.Object, error) {
+		return NewTask(self.(*Loop), coro)
+	}, 0, "create_task(coro) -> schedule a coroutine object.")
+}
@@ -0,0 +1,298 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Event and Queue objects
+
+package asyncio
+
+import (
+	"github.com/go-python/gpython/py"
+)
+
+var (
+	QueueEmpty = py.ExceptionType.NewType("QueueEmpty", "Raised when Queue.get_nowait() is called on an empty Queue.", nil, nil)
+	QueueFull  = py.ExceptionType.NewType("QueueFull", "Raised when the Queue.put_nowait() method is called on a full Queue.", nil, nil)
+)
+
+// Event is an asyncio Event
+type Event struct {
+	ctx     *py.Context // the context the event was made in
+	flag    bool
+	waiters []*Future
+}
+
+var EventType = py.NewTypeX("Event", `Asynchronous equivalent to threading.Event.
+
+Class implementing event objects. An event manages a flag that can be set
+to true with the set() method and reset to false with the clear() method.
+The wait() method blocks until the flag is true. The flag is initially
+false.`, noContext, nil)
+
+// Type of this object
+func (e *Event) Type() *py.Type {
+	return eventType(e.ctx)
+}
+
+// Returns the Event type of ctx
+func eventType(ctx *py.Context) *py.Type {
+	return ctx.SubType(EventType, eventNew)
+}
+
+// Makes an Event from python in ctx
+func eventNew(ctx *py.Context, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	err := py.UnpackTuple(args, kwargs, "Event", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &Event{ctx: ctx}, nil
+}
+
+// doneFuture returns a future attached to the loop running in ctx
+// which is already finished with result
+func doneFuture(ctx *py.Context, result py.Object) (*Future, error) {
+	l, err := GetRunningLoop(ctx)
+	if err != nil {
+		return nil, err
+	}
+	f := NewFuture(l)
+	_ = f.SetResult(result)
+	return f, nil
+}
+
+// Set sets the flag waking up all the waiters
+func (e *Event) Set() {
+	if e.flag {
+		return
+	}
+	e.flag = true
+	for _, f := range e.waiters {
+		if !f.Done() {
+			_ = f.SetResult(py.True)
+		}
+	}
+	e.waiters = nil
+}
+
+// Wait returns a future which is done when the flag is set
+func (e *Event) Wait() (*Future, error) {
+	if e.flag {
+		return doneFuture(e.ctx, py.True)
+	}
+	l, err := GetRunningLoop(e.ctx)
+	if err != nil {
+		return nil, err
+	}
+	f := NewFuture(l)
+	e.waiters = append(e.waiters, f)
+	return f, nil
+}
+
+// Queue is an asyncio FIFO Queue
+type Queue struct {
+	ctx        *py.Context // the context the queue was made in
+	maxsize    int
+	items      []py.Object
+	getters    []*Future
+	putters    []putter
+	unfinished int
+	joiners    []*Future
+}
+
+// A put waiting for space in the queue
+type putter struct {
+	future *Future
+	item   py.Object
+}
+
+var QueueType = py.NewTypeX("Queue", `A queue, useful for coordinating producer and consumer coroutines.
+
+If maxsize is less than or equal to zero, the queue size is infinite. If it
+is an integer greater than 0, then "await put()" will block when the
+queue reaches maxsize, until an item is removed by get().`, noContext, nil)
+
+// Type of this object
+func (q *Queue) Type() *py.Type {
+	return queueType(q.ctx)
+}
+
+// Returns the Queue type of ctx
+func queueType(ctx *py.Context) *py.Type {
+	return ctx.SubType(QueueType, queueNew)
+}
+
+// Makes a Queue from python in ctx
+func queueNew(ctx *py.Context, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var maxsize py.Object = py.Int(0)
+	err := py.ParseTupleAndKeywords(args, kwargs, "|i:Queue", []string{"maxsize"}, &maxsize)
+	if err != nil {
+		return nil, err
+	}
+	return &Queue{ctx: ctx, maxsize: int(maxsize.(py.Int))}, nil
+}
+
+// Full returns true if there are maxsize items in the queue
+func (q *Queue) Full() bool {
+	return q.maxsize > 0 && len(q.items) >= q.maxsize
+}
+
+// add puts an item on the queue and hands items to any getters
+func (q *Queue) add(item py.Object) {
+	q.items = append(q.items, item)
+	q.unfinished++
+	for len(q.getters) > 0 && len(q.items) > 0 {
+		f := q.getters[0]
+		q.getters = q.getters[1:]
+		if !f.Done() {
+			_ = f.SetResult(q.remove())
+		}
+	}
+}
+
+// remove takes the first item off the queue and lets any putters add
+// theirs
+func (q *Queue) remove() py.Object {
+	item := q.items[0]
+	q.items = q.items[1:]
+	for len(q.putters) > 0 && !q.Full() {
+		p := q.putters[0]
+		q.putters = q.putters[1:]
+		if !p.future.Done() {
+			_ = p.future.SetResult(py.None)
+			q.add(p.item)
+		}
+	}
+	return item
+}
+
+// PutNowait puts an item on the queue raising QueueFull if there isn't
+// room
+func (q *Queue) PutNowait(item py.Object) error {
+	if q.Full() {
+		return py.MakeException(QueueFull)
+	}
+	q.add(item)
+	return nil
+}
+
+// Put returns a future which is done when item has been put on the
+// queue
+func (q *Queue) Put(item py.Object) (*Future, error) {
+	if !q.Full() {
+		q.add(item)
+		return doneFuture(q.ctx, py.None)
+	}
+	l, err := GetRunningLoop(q.ctx)
+	if err != nil {
+		return nil, err
+	}
+	f := NewFuture(l)
+	q.putters = append(q.putters, putter{future: f, item: item})
+	return f, nil
+}
+
+// GetNowait removes an item from the queue raising QueueEmpty if there
+// isn't one
+func (q *Queue) GetNowait() (py.Object, error) {
+	if len(q.items) == 0 {
+		return nil, py.MakeException(QueueEmpty)
+	}
+	return q.remove(), nil
+}
+
+// Get returns a future which is done with the next item from the
+// queue
+func (q *Queue) Get() (*Future, error) {
+	if len(q.items) > 0 {
+		return doneFuture(q.ctx, q.remove())
+	}
+	l, err := GetRunningLoop(q.ctx)
+	if err != nil {
+		return nil, err
+	}
+	f := NewFuture(l)
+	q.getters = append(q.getters, f)
+	return f, nil
+}
+
+// TaskDone indicates that a formerly enqueued task is complete
+func (q *Queue) TaskDone() error {
+	if q.unfinished <= 0 {
+		return py.ExceptionNewf(py.ValueError, "task_done() called too many times")
+	}
+	q.unfinished--
+	if q.unfinished == 0 {
+		for _, f := range q.joiners {
+			if !f.Done() {
+				_ = f.SetResult(py.None)
+			}
+		}
+		q.joiners = nil
+	}
+	return nil
+}
+
+// Join returns a future which is done when all items in the queue
+// have been processed
+func (q *Queue) Join() (*Future, error) {
+	if q.unfinished == 0 {
+		return doneFuture(q.ctx, py.None)
+	}
+	l, err := GetRunningLoop(q.ctx)
+	if err != nil {
+		return nil, err
+	}
+	f := NewFuture(l)
+	q.joiners = append(q.joiners, f)
+	return f, nil
+}
+
+func init() {
+	EventType.Dict["set"] = py.MustNewMethod("set", func(self py.Object) (py.Object, error) {
+		self.(*Event).Set()
+		return py.None, nil
+	}, 0, "Set the internal flag to true. All coroutines waiting for it to\nbecome true are awakened.")
+	EventType.Dict["clear"] = py.MustNewMethod("clear", func(self py.Object) (py.Object, error) {
+		self.(*Event).flag = false
+		return py.None, nil
+	}, 0, "Reset the internal flag to false.")
+	EventType.Dict["is_set"] = py.MustNewMethod("is_set", func(self py.Object) (py.Object, error) {
+		return py.NewBool(self.(*Event).flag), nil
+	}, 0, "Return True if and only if the internal flag is true.")
+	EventType.Dict["wait"] = py.MustNewMethod("wait", func(self py.Object) (py.Object, error) {
+		return self.(*Event).Wait()
+	}, 0, "Block until the internal flag is true.")
+
+	QueueType.Dict["maxsize"] = &py.Property{
+		Fget: func(self py.Object) (py.Object, error) {
+			return py.Int(self.(*Queue).maxsize), nil
+		},
+	}
+	QueueType.Dict["qsize"] = py.MustNewMethod("qsize", func(self py.Object) (py.Object, error) {
+		return py.Int(len(self.(*Queue).items)), nil
+	}, 0, "Number of items in the queue.")
+	QueueType.Dict["empty"] = py.MustNewMethod("empty", func(self py.Object) (py.Object, error) {
+		return py.NewBool(len(self.(*Queue).items) == 0), nil
+	}, 0, "Return True if the queue is empty, False otherwise.")
+	QueueType.Dict["full"] = py.MustNewMethod("full", func(self py.Object) (py.Object, error) {
+		return py.NewBool(self.(*Queue).Full()), nil
+	}, 0, "Return True if there are maxsize items in the queue.")
+	QueueType.Dict["put"] = py.MustNewMethod("put", func(self py.Object, item py.Object) (py.Object, error) {
+		return self.(*Queue).Put(item)
+	}, 0, "Put an item into the queue, waiting until a free slot is available.")
+	QueueType.Dict["put_nowait"] = py.MustNewMethod("put_nowait", func(self py.Object, item py.Object) (py.Object, error) {
+		return py.None, self.(*Queue).PutNowait(item)
+	}, 0, "Put an item into the queue without blocking.")
+	QueueType.Dict["get"] = py.MustNewMethod("get", func(self py.Object) (py.Object, error) {
+		return self.(*Queue).Get()
+	}, 0, "Remove and return an item from the queue, waiting until one is available.")
+	QueueType.Dict["get_nowait"] = py.MustNewMethod("get_nowait", func(self py.Object) (py.Object, error) {
+		return self.(*Queue).GetNowait()
+	}, 0, "Remove and return an item from the queue without blocking.")
+	QueueType.Dict["task_done"] = py.MustNewMethod("task_done", func(self py.Object) (py.Object, error) {
+		return py.None, self.(*Queue).TaskDone()
+	}, 0, "Indicate that a formerly enqueued task is complete.")
+	QueueType.Dict["join"] = py.MustNewMethod("join", func(self py.Object) (py.Object, error) {
+		return self.(*Queue).Join()
+	}, 0, "Block until all items in the queue have been gotten and processed.")
+}
@@ -0,0 +1,206 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Task objects
+
+package asyncio
+
+import (
+	"log"
+
+	"github.com/go-python/gpython/py"
+)
+
+// Task is a Future which drives a coroutine
+type Task struct {
+	Future
+	coro       py.Object // the coroutine or awaitable
+	iter       py.Object // the iterator being driven
+	waiter     *Future   // the future the task is waiting for
+	mustCancel bool      // set to throw CancelledError at the next step
+}
+
+var TaskType = FutureType.NewType("Task", "A coroutine wrapped in a Future.", noContext, nil)
+
+// Type of this object
+func (t *Task) Type() *py.Type {
+	return taskType(t.loop.ctx)
+}
+
+// Returns the Task type of ctx
+func taskType(ctx *py.Context) *py.Type {
+	// Make the Future type first so it is in the Task type's MRO
+	futureType(ctx)
+	return ctx.SubType(TaskType, taskNew)
+}
+
+// NewTask wraps the awaitable coro in a Task and schedules it to run
+// on the loop
+func NewTask(l *Loop, coro py.Object) (*Task, error) {
+	iter, err := py.GetAwaitableIter(coro)
+	if err != nil {
+		if py.IsException(py.TypeError, err) {
+			r, rerr := py.ReprAsString(coro)
+			if rerr != nil {
+				return nil, rerr
+			}
+			return nil, py.ExceptionNewf(py.TypeError, "a coroutine was expected, got %s", r)
+		}
+		return nil, err
+	}
+	t := &Task{
+		coro: coro,
+		iter: iter,
+	}
+	t.loop = l
+	t.self = t
+	l.tasks[t] = struct{}{}
+	l.CallSoon(func() error {
+		t.step(nil)
+		return nil
+	})
+	return t, nil
+}
+
+// Makes a Task from python in ctx
+func taskNew(ctx *py.Context, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var coro py.Object
+	err := py.UnpackTuple(args, kwargs, "Task", 1, 1, &coro)
+	if err != nil {
+		return nil, err
+	}
+	l, err := GetRunningLoop(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewTask(l, coro)
+}
+
+// ensureFuture returns the Future for a Future or Task or wraps any
+// other awaitable in a new Task
+func (l *Loop) ensureFuture(o py.Object) (*Future, error) {
+	if f, ok := asFuture(o); ok {
+		return f, nil
+	}
+	t, err := NewTask(l, o)
+	if err != nil {
+		return nil, err
+	}
+	return &t.Future, nil
+}
+
+// Cancel requests that the task is cancelled
+//
+// This throws a CancelledError into the coroutine at the next
+// opportunity which it may catch to clean up. It returns false if
+// the task is already done.
+func (t *Task) Cancel() bool {
+	if t.Done() {
+		return false
+	}
+	if t.waiter != nil && t.waiter.Cancel() {
+		// The task will be woken up with CancelledError
+		return true
+	}
+	t.mustCancel = true
+	return true
+}
+
+// send resumes the iterator the task is driving
+func (t *Task) send() (py.Object, error) {
+	switch t.iter.(type) {
+	case *py.Generator, *py.Coroutine:
+		return py.Send(t.iter, py.None)
+	}
+	return py.Next(t.iter)
+}
+
+// throw raises exc in the iterator the task is driving
+func (t *Task) throw(exc error) (py.Object, error) {
+	value := exceptionValue(exc)
+	if I, ok := t.iter.(py.I_throw); ok {
+		return I.Throw(py.Tuple{value}, nil)
+	} else if res, ok, err := py.TypeCall1(t.iter, "throw", value); ok {
+		return res, err
+	}
+	return nil, exc
+}
+
+// step runs the coroutine until it next waits
+func (t *Task) step(exc error) {
+	if t.Done() {
+		return
+	}
+	if t.mustCancel {
+		t.mustCancel = false
+		exc = newCancelledError()
+	}
+	t.waiter = nil
+	var res py.Object
+	var err error
+	if exc != nil {
+		res, err = t.throw(exc)
+	} else {
+		res, err = t.send()
+	}
+	if err != nil {
+		delete(t.loop.tasks, t)
+		switch {
+		case py.IsException(py.StopIteration, err):
+			_ = t.SetResult(py.StopIterationValue(err))
+		case py.IsException(CancelledError, err):
+			t.cancel()
+		default:
+			_ = t.SetException(err)
+		}
+		return
+	}
+	wakeup := func() error {
+		t.step(nil)
+		return nil
+	}
+	if res == py.None {
+		// Bare yield - just let other tasks run
+		t.loop.CallSoon(wakeup)
+		return
+	}
+	f, ok := asFuture(res)
+	if !ok || !f.blocking {
+		r, rerr := py.ReprAsString(res)
+		if rerr != nil {
+			r = res.Type().Name
+		}
+		badYield := py.ExceptionNewf(py.RuntimeError, "Task got bad yield: %s", r)
+		t.loop.CallSoon(func() error {
+			t.step(badYield)
+			return nil
+		})
+		return
+	}
+	f.blocking = false
+	if f == &t.Future {
+		selfAwait := py.ExceptionNewf(py.RuntimeError, "Task cannot await on itself")
+		t.loop.CallSoon(func() error {
+			t.step(selfAwait)
+			return nil
+		})
+		return
+	}
+	t.waiter = f
+	f.AddDoneCallback(wakeup)
+	if t.mustCancel && f.Cancel() {
+		t.mustCancel = false
+	}
+}
+
+func init() {
+	// Calculate the MRO so Task inherits the Future methods
+	err := TaskType.Ready()
+	if err != nil {
+		log.Fatal(err)
+	}
+	TaskType.Dict["get_coro"] = py.MustNewMethod("get_coro", func(self py.Object) (py.Object, error) {
+		return self.(*Task).coro, nil
+	}, 0, "Return the coroutine object wrapped by the Task.")
+}
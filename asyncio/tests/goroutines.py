@@ -0,0 +1,46 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+# Tests futures completed by Go code - needs the goservice module
+# from asyncio_test.go
+
+import asyncio
+import goservice
+
+doc="await result from goroutine"
+async def main():
+    return await goservice.fetch("potato")
+assert asyncio.run(main()) == "potato"
+
+doc="exception from goroutine"
+async def main():
+    try:
+        await goservice.fetch(None)
+    except ValueError as e:
+        return e.args[0]
+assert asyncio.run(main()) == "nothing to fetch"
+
+doc="many goroutines"
+async def main():
+    return await asyncio.gather(*[goservice.fetch(i) for i in range(20)])
+assert asyncio.run(main()) == list(range(20))
+
+doc="complete future from goroutine"
+async def main():
+    f = asyncio.get_running_loop().create_future()
+    goservice.complete(f, 42)
+    return await f
+assert asyncio.run(main()) == 42
+
+doc="timeout waiting for goroutine"
+async def main():
+    f = asyncio.Future()
+    try:
+        await asyncio.wait_for(f, 0.001)
+    except asyncio.TimeoutError:
+        pass
+    return f.cancelled()
+assert asyncio.run(main())
+
+doc="finished"
@@ -0,0 +1,336 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+import asyncio
+
+doc="run"
+async def add(a, b):
+    return a + b
+assert asyncio.run(add(1, 2)) == 3
+
+doc="run not coroutine"
+ok = False
+try:
+    asyncio.run(1)
+except ValueError:
+    ok = True
+assert ok, "ValueError not raised"
+
+doc="run exception"
+async def fail():
+    raise KeyError("potato")
+ok = False
+try:
+    asyncio.run(fail())
+except KeyError as e:
+    ok = e.args[0] == "potato"
+assert ok, "KeyError not raised"
+
+doc="no running loop"
+ok = False
+try:
+    asyncio.get_running_loop()
+except RuntimeError:
+    ok = True
+assert ok, "RuntimeError not raised"
+
+doc="sleep"
+async def sleeper():
+    await asyncio.sleep(0)
+    return await asyncio.sleep(0.001, "slept")
+assert asyncio.run(sleeper()) == "slept"
+
+doc="create_task"
+events = []
+async def worker(name, delay):
+    events.append("start " + name)
+    await asyncio.sleep(delay)
+    events.append("end " + name)
+    return name
+async def main():
+    a = asyncio.create_task(worker("a", 0.02))
+    b = asyncio.create_task(worker("b", 0.001))
+    assert not a.done()
+    ra = await a
+    rb = await b
+    assert a.done() and b.done()
+    assert a.result() == "a"
+    return ra + rb
+assert asyncio.run(main()) == "ab"
+assert events == ["start a", "start b", "end b", "end a"]
+
+doc="gather"
+async def main():
+    return await asyncio.gather(worker("x", 0.01), worker("y", 0), add(1, 2))
+events = []
+assert asyncio.run(main()) == ["x", "y", 3]
+assert events == ["start x", "start y", "end y", "end x"]
+
+async def main():
+    return await asyncio.gather()
+assert asyncio.run(main()) == []
+
+doc="gather exception"
+async def main():
+    try:
+        await asyncio.gather(worker("x", 0), fail())
+    except KeyError:
+        return "caught"
+assert asyncio.run(main()) == "caught"
+
+async def main():
+    res = await asyncio.gather(add(1, 1), fail(), return_exceptions=True)
+    assert res[0] == 2
+    assert repr(type(res[1])) == "<class 'KeyError'>"
+    return "ok"
+assert asyncio.run(main()) == "ok"
+
+doc="wait_for"
+async def main():
+    return await asyncio.wait_for(worker("w", 0), 1)
+assert asyncio.run(main()) == "w"
+
+async def main():
+    return await asyncio.wait_for(worker("w", 0), None)
+assert asyncio.run(main()) == "w"
+
+doc="wait_for timeout"
+cancelled = False
+async def slow():
+    global cancelled
+    try:
+        await asyncio.sleep(10)
+    except asyncio.CancelledError:
+        cancelled = True
+        raise
+async def main():
+    try:
+        await asyncio.wait_for(slow(), 0.01)
+    except asyncio.TimeoutError:
+        return "timeout"
+assert asyncio.run(main()) == "timeout"
+assert cancelled
+
+doc="cancel"
+async def main():
+    t = asyncio.create_task(slow())
+    await asyncio.sleep(0)
+    assert t.cancel()
+    try:
+        await t
+    except asyncio.CancelledError:
+        pass
+    assert t.cancelled()
+    assert not t.cancel()
+    return "ok"
+cancelled = False
+assert asyncio.run(main()) == "ok"
+assert cancelled
+
+doc="cancel before start"
+async def main():
+    t = asyncio.create_task(slow())
+    t.cancel()
+    try:
+        await t
+    except asyncio.CancelledError:
+        return "cancelled"
+cancelled = False
+assert asyncio.run(main()) == "cancelled"
+assert not cancelled
+
+doc="pending tasks cancelled at end of run"
+async def main():
+    asyncio.create_task(slow())
+    await asyncio.sleep(0)
+cancelled = False
+asyncio.run(main())
+assert cancelled
+
+doc="Future"
+async def main():
+    loop = asyncio.get_running_loop()
+    f = loop.create_future()
+    assert not f.done()
+    assert repr(f) == "<Future pending>"
+    ok = False
+    try:
+        f.result()
+    except asyncio.InvalidStateError:
+        ok = True
+    assert ok
+    called = []
+    f.add_done_callback(lambda fut: called.append(fut.result()))
+    loop.call_soon(f.set_result, 42)
+    assert await f == 42
+    assert f.done()
+    assert repr(f) == "<Future finished result=42>"
+    ok = False
+    try:
+        f.set_result(43)
+    except asyncio.InvalidStateError:
+        ok = True
+    assert ok
+    await asyncio.sleep(0)
+    assert called == [42]
+
+    f = asyncio.Future()
+    f.set_exception(ValueError("bad"))
+    assert f.exception().args[0] == "bad"
+    ok = False
+    try:
+        await f
+    except ValueError:
+        ok = True
+    assert ok
+
+    f = asyncio.Future()
+    assert f.cancel()
+    assert f.cancelled()
+    ok = False
+    try:
+        f.result()
+    except asyncio.CancelledError:
+        ok = True
+    assert ok
+    return "ok"
+assert asyncio.run(main()) == "ok"
+
+doc="Event"
+async def waiter(ev, name):
+    await ev.wait()
+    events.append(name)
+async def main():
+    ev = asyncio.Event()
+    assert not ev.is_set()
+    t1 = asyncio.create_task(waiter(ev, "one"))
+    t2 = asyncio.create_task(waiter(ev, "two"))
+    await asyncio.sleep(0)
+    assert events == []
+    ev.set()
+    assert ev.is_set()
+    await asyncio.gather(t1, t2)
+    assert await ev.wait()
+    ev.clear()
+    assert not ev.is_set()
+events = []
+asyncio.run(main())
+assert events == ["one", "two"]
+
+doc="Queue"
+async def producer(q, n):
+    for i in range(n):
+        await q.put(i)
+    await q.put(None)
+async def consumer(q):
+    got = []
+    while True:
+        item = await q.get()
+        q.task_done()
+        if item is None:
+            break
+        got.append(item)
+    return got
+async def main():
+    q = asyncio.Queue(maxsize=2)
+    assert q.maxsize == 2
+    assert q.empty()
+    got, _ = await asyncio.gather(consumer(q), producer(q, 5))
+    await q.join()
+    return got
+assert asyncio.run(main()) == [0, 1, 2, 3, 4]
+
+doc="Queue nowait"
+async def main():
+    q = asyncio.Queue(1)
+    q.put_nowait("a")
+    assert q.full()
+    assert q.qsize() == 1
+    ok = False
+    try:
+        q.put_nowait("b")
+    except asyncio.QueueFull:
+        ok = True
+    assert ok
+    assert q.get_nowait() == "a"
+    ok = False
+    try:
+        q.get_nowait()
+    except asyncio.QueueEmpty:
+        ok = True
+    assert ok
+    q.task_done()
+    ok = False
+    try:
+        q.task_done()
+    except ValueError:
+        ok = True
+    assert ok
+    return "ok"
+assert asyncio.run(main()) == "ok"
+
+doc="run inside run"
+async def main():
+    c = add(1, 2)
+    try:
+        asyncio.run(c)
+    except RuntimeError:
+        return "ok"
+    finally:
+        c.close()
+assert asyncio.run(main()) == "ok"
+
+doc="types"
+async def main():
+    t = asyncio.create_task(add(1, 2))
+    assert type(t) is asyncio.Task
+    assert type(asyncio.Future()) is asyncio.Future
+    assert type(asyncio.Event()) is asyncio.Event
+    assert type(asyncio.Queue()) is asyncio.Queue
+    return await t
+assert asyncio.run(main()) == 3
+
+doc="callback exception doesn't stop the loop"
+import io
+import sys
+async def ticker(ticks):
+    for i in range(3):
+        ticks.append(i)
+        await asyncio.sleep(0)
+async def main():
+    loop = asyncio.get_running_loop()
+    ticks = []
+    t = asyncio.create_task(ticker(ticks))
+    loop.call_soon(lambda: 1/0)
+    await t
+    return ticks
+stderr = sys.stderr
+sys.stderr = io.StringIO()
+try:
+    assert asyncio.run(main()) == [0, 1, 2]
+    out = sys.stderr.getvalue()
+finally:
+    sys.stderr = stderr
+assert "Exception in callback" in out, out
+assert "ZeroDivisionError" in out, out
+
+doc="exception handler"
+async def main():
+    loop = asyncio.get_running_loop()
+    assert loop.get_exception_handler() is None
+    errors = []
+    def handler(l, context):
+        assert l is loop
+        errors.append((context["message"], type(context["exception"])))
+    loop.set_exception_handler(handler)
+    assert loop.get_exception_handler() is handler
+    loop.call_soon(lambda: 1/0)
+    loop.call_soon(lambda: errors.append("next"))
+    await asyncio.sleep(0)
+    await asyncio.sleep(0)
+    loop.set_exception_handler(None)
+    return errors
+assert asyncio.run(main()) == [("Exception in callback", ZeroDivisionError), "next"]
+
+doc="finished"
@@ -0,0 +1,233 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package bisect implements the python bisect module
+package bisect
+
+import (
+	"github.com/go-python/gpython/py"
+)
+
+const module_doc = `Bisection algorithms.
+
+This module provides support for maintaining a list in sorted order without
+having to sort the list after each insertion. For long lists of items with
+expensive comparison operations, this can be an improvement over the more
+common approach.`
+
+// Returns a < b
+func less(a, b py.Object) (bool, error) {
+	res, err := py.Lt(a, b)
+	if err != nil {
+		return false, err
+	}
+	res, err = py.MakeBool(res)
+	if err != nil {
+		return false, err
+	}
+	return res == py.True, nil
+}
+
+// The parsed arguments of the bisect and insort functions
+type bisectArgs struct {
+	a   py.Object
+	x   py.Object
+	lo  int
+	hi  int
+	key py.Object
+}
+
+// Parses the arguments (a, x, lo=0, hi=None, *, key=None)
+func parseArgs(name string, args py.Tuple, kwargs py.StringDict) (*bisectArgs, error) {
+	var loObj py.Object = py.Int(0)
+	var hiObj py.Object = py.None
+	b := &bisectArgs{key: py.None}
+	var key py.Object
+	if kwargs != nil {
+		key = kwargs["key"]
+		if key != nil {
+			kwargs = kwargs.Copy()
+			delete(kwargs, "key")
+			b.key = key
+		}
+	}
+	err := py.ParseTupleAndKeywords(args, kwargs, "OO|OO:"+name, []string{"a", "x", "lo", "hi"}, &b.a, &b.x, &loObj, &hiObj)
+	if err != nil {
+		return nil, err
+	}
+	b.lo, err = py.IndexInt(loObj)
+	if err != nil {
+		return nil, err
+	}
+	if b.lo < 0 {
+		return nil, py.ExceptionNewf(py.ValueError, "lo must be non-negative")
+	}
+	if hiObj == py.None {
+		n, err := py.Len(b.a)
+		if err != nil {
+			return nil, err
+		}
+		b.hi = int(n.(py.Int))
+	} else {
+		b.hi, err = py.IndexInt(hiObj)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// Returns the index to insert x at in the sorted sequence a
+//
+// If right is set this is after any items equal to x, otherwise it
+// is before them.  x should already have had the key function applied.
+func (b *bisectArgs) bisect(x py.Object, right bool) (int, error) {
+	lo, hi := b.lo, b.hi
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		item, err := py.GetItem(b.a, py.Int(mid))
+		if err != nil {
+			return 0, err
+		}
+		if b.key != py.None {
+			item, err = py.Call(b.key, py.Tuple{item}, nil)
+			if err != nil {
+				return 0, err
+			}
+		}
+		var lt bool
+		if right {
+			lt, err = less(x, item)
+		} else {
+			lt, err = less(item, x)
+		}
+		if err != nil {
+			return 0, err
+		}
+		if lt == right {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return lo, nil
+}
+
+// Bisects with the arguments passed in
+func bisect(name string, args py.Tuple, kwargs py.StringDict, right bool) (py.Object, error) {
+	b, err := parseArgs(name, args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	i, err := b.bisect(b.x, right)
+	if err != nil {
+		return nil, err
+	}
+	return py.Int(i), nil
+}
+
+// Inserts x into a keeping it sorted
+func insort(name string, args py.Tuple, kwargs py.StringDict, right bool) (py.Object, error) {
+	b, err := parseArgs(name, args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	x := b.x
+	if b.key != py.None {
+		x, err = py.Call(b.key, py.Tuple{x}, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	i, err := b.bisect(x, right)
+	if err != nil {
+		return nil, err
+	}
+	if l, ok := b.a.(*py.List); ok {
+		if i > len(l.Items) {
+			i = len(l.Items)
+		}
+		l.Items = append(l.Items, nil)
+		copy(l.Items[i+1:], l.Items[i:])
+		l.Items[i] = b.x
+		return py.None, nil
+	}
+	insert, err := py.GetAttrString(b.a, "insert")
+	if err != nil {
+		return nil, err
+	}
+	_, err = py.Call(insert, py.Tuple{py.Int(i), b.x}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return py.None, nil
+}
+
+const bisect_right_doc = `Return the index where to insert item x in list a, assuming a is sorted.
+
+The return value i is such that all e in a[:i] have e <= x, and all e in
+a[i:] have e > x.  So if x already appears in the list, a.insert(i, x) will
+insert just after the rightmost x already there.
+
+Optional args lo (default 0) and hi (default len(a)) bound the
+slice of a to be searched.
+
+A custom key function can be supplied to customize the sort order.`
+
+func bisect_bisect_right(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	return bisect("bisect_right", args, kwargs, true)
+}
+
+const bisect_left_doc = `Return the index where to insert item x in list a, assuming a is sorted.
+
+The return value i is such that all e in a[:i] have e < x, and all e in
+a[i:] have e >= x.  So if x already appears in the list, a.insert(i, x) will
+insert just before the leftmost x already there.
+
+Optional args lo (default 0) and hi (default len(a)) bound the
+slice of a to be searched.
+
+A custom key function can be supplied to customize the sort order.`
+
+func bisect_bisect_left(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	return bisect("bisect_left", args, kwargs, false)
+}
+
+const insort_right_doc = `Insert item x in list a, and keep it sorted assuming a is sorted.
+
+If x is already in a, insert it to the right of the rightmost x.
+
+Optional args lo (default 0) and hi (default len(a)) bound the
+slice of a to be searched.
+
+A custom key function can be supplied to customize the sort order.`
+
+func bisect_insort_right(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	return insort("insort_right", args, kwargs, true)
+}
+
+const insort_left_doc = `Insert item x in list a, and keep it sorted assuming a is sorted.
+
+If x is already in a, insert it to the left of the leftmost x.
+
+Optional args lo (default 0) and hi (default len(a)) bound the
+slice of a to be searched.
+
+A custom key function can be supplied to customize the sort order.`
+
+func bisect_insort_left(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	return insort("insort_left", args, kwargs, false)
+}
+
+func init() {
+	methods := []*py.Method{
+		py.MustNewMethod("bisect_right", bisect_bisect_right, 0, bisect_right_doc),
+		py.MustNewMethod("bisect_left", bisect_bisect_left, 0, bisect_left_doc),
+		py.MustNewMethod("insort_right", bisect_insort_right, 0, insort_right_doc),
+		py.MustNewMethod("insort_left", bisect_insort_left, 0, insort_left_doc),
+		py.MustNewMethod("bisect", bisect_bisect_right, 0, bisect_right_doc),
+		py.MustNewMethod("insort", bisect_insort_right, 0, insort_right_doc),
+	}
+	py.NewModule("bisect", module_doc, methods, nil)
+}
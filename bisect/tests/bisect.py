@@ -0,0 +1,56 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+import bisect
+from bisect import bisect_left, bisect_right, insort_left, insort_right, insort
+from libtest import assertRaises, assertRaisesText
+
+doc = "bisect"
+a = [1, 2, 2, 2, 3, 5]
+assert bisect_left(a, 2) == 1
+assert bisect_right(a, 2) == 4
+assert bisect.bisect(a, 2) == 4
+assert bisect_left(a, 0) == 0 and bisect_right(a, 9) == 6
+assert bisect_left(a, 4) == 5 and bisect_right(a, 4) == 5
+assert bisect_left(a, 2, 2) == 2
+assert bisect_right(a, 2, 0, 3) == 3
+assert bisect_right(a, 2, lo=1, hi=2) == 2
+assert bisect_left([], 1) == 0
+assert bisect_left((1, 3, 5), 4) == 2
+assertRaisesText(ValueError, "lo must be non-negative", bisect_left, a, 1, -1)
+
+doc = "key"
+records = [("a", 1), ("b", 3), ("c", 3), ("d", 7)]
+assert bisect_left(records, 3, key=lambda r: r[1]) == 1
+assert bisect_right(records, 3, key=lambda r: r[1]) == 3
+
+doc = "insort"
+a = [1, 3, 5]
+assert insort(a, 4) is None and a == [1, 3, 4, 5]
+insort_left(a, 0)
+insort_right(a, 9)
+assert a == [0, 1, 3, 4, 5, 9]
+a = [1.0, 2]
+insort_left(a, 1)
+insort_right(a, 2.0)
+assert a == [1, 1.0, 2, 2.0]
+assert str(a[0]) == "1" and type(a[3]) is float
+records = [("a", 1), ("d", 7)]
+insort(records, ("b", 3), key=lambda r: r[1])
+assert records == [("a", 1), ("b", 3), ("d", 7)]
+
+class Inserter:
+    def __init__(self):
+        self.items = [1, 5]
+    def __len__(self):
+        return len(self.items)
+    def __getitem__(self, i):
+        return self.items[i]
+    def insert(self, i, x):
+        self.items = self.items[:i] + [x] + self.items[i:]
+s = Inserter()
+insort(s, 3)
+assert s.items == [1, 3, 5]
+
+doc = "finished"
@@ -11,7 +11,9 @@ import (
 	"unicode/utf8"
 
 	"github.com/go-python/gpython/compile"
+	"github.com/go-python/gpython/io"
 	"github.com/go-python/gpython/py"
+	"github.com/go-python/gpython/pyast"
 )
 
 const builtin_doc = `Built-in functions, exceptions, and other objects.
@@ -28,6 +30,7 @@ func init() {
 		py.MustNewMethod("any", builtin_any, 0, any_doc),
 		py.MustNewMethod("ascii", builtin_ascii, 0, ascii_doc),
 		py.MustNewMethod("bin", builtin_bin, 0, bin_doc),
+		py.MustNewMethod("breakpoint", builtin_breakpoint, 0, breakpoint_doc),
 		// py.MustNewMethod("callable", builtin_callable, 0, callable_doc),
 		py.MustNewMethod("chr", builtin_chr, 0, chr_doc),
 		py.MustNewMethod("compile", builtin_compile, 0, compile_doc),
@@ -36,7 +39,7 @@ func init() {
 		py.MustNewMethod("divmod", builtin_divmod, 0, divmod_doc),
 		py.MustNewMethod("eval", py.InternalMethodEval, 0, eval_doc),
 		py.MustNewMethod("exec", py.InternalMethodExec, 0, exec_doc),
-		// py.MustNewMethod("format", builtin_format, 0, format_doc),
+		py.MustNewMethod("format", builtin_format, 0, format_doc),
 		py.MustNewMethod("getattr", builtin_getattr, 0, getattr_doc),
 		py.MustNewMethod("globals", py.InternalMethodGlobals, 0, globals_doc),
 		py.MustNewMethod("hasattr", builtin_hasattr, 0, hasattr_doc),
@@ -52,7 +55,7 @@ func init() {
 		py.MustNewMethod("max", builtin_max, 0, max_doc),
 		py.MustNewMethod("min", builtin_min, 0, min_doc),
 		py.MustNewMethod("next", builtin_next, 0, next_doc),
-		py.MustNewMethod("open", builtin_open, 0, open_doc),
+		py.MustNewMethod("open", io.Open, 0, io.OpenDoc),
 		// py.MustNewMethod("oct", builtin_oct, 0, oct_doc),
 		py.MustNewMethod("ord", builtin_ord, 0, ord_doc),
 		py.MustNewMethod("pow", builtin_pow, 0, pow_doc),
@@ -144,6 +147,7 @@ func init() {
 		"RuntimeError":              py.RuntimeError,
 		"RuntimeWarning":            py.RuntimeWarning,
 		"StopIteration":             py.StopIteration,
+		"StopAsyncIteration":        py.StopAsyncIteration,
 		"SyntaxError":               py.SyntaxError,
 		"SyntaxWarning":             py.SyntaxWarning,
 		"SystemError":               py.SystemError,
@@ -161,6 +165,7 @@ func init() {
 		"ValueError":                py.ValueError,
 		"Warning":                   py.Warning,
 		"ZeroDivisionError":         py.ZeroDivisionError,
+		"__debug__":                 py.True,
 	}
 	py.NewModule("builtins", builtin_doc, methods, globals)
 }
@@ -178,16 +183,29 @@ func builtin_print(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Obje
 	var (
 		sepObj py.Object = py.String(" ")
 		endObj py.Object = py.String("\n")
-		file   py.Object = py.MustGetModule("sys").Globals["stdout"]
+		file   py.Object = py.None
 		flush  py.Object
 	)
 	kwlist := []string{"sep", "end", "file", "flush"}
-	err := py.ParseTupleAndKeywords(nil, kwargs, "|ssOO:print", kwlist, &sepObj, &endObj, &file, &flush)
+	err := py.ParseTupleAndKeywords(nil, kwargs, "|zzOO:print", kwlist, &sepObj, &endObj, &file, &flush)
 	if err != nil {
 		return nil, err
 	}
+	if sepObj == py.None {
+		sepObj = py.String(" ")
+	}
+	if endObj == py.None {
+		endObj = py.String("\n")
+	}
 	sep := sepObj.(py.String)
 	end := endObj.(py.String)
+	if file == py.None {
+		file = py.GetContext(self).MustGetModule("sys").Globals["stdout"]
+		// sys.stdout may be None when there is no console
+		if file == nil || file == py.None {
+			return py.None, nil
+		}
+	}
 
 	write, err := py.GetAttrString(file, "write")
 	if err != nil {
@@ -352,6 +370,21 @@ func builtin_bin(self, o py.Object) (py.Object, error) {
 	return py.String(out), nil
 }
 
+const breakpoint_doc = `breakpoint(*args, **kws)
+
+Call sys.breakpointhook(*args, **kws).  sys.breakpointhook() must accept
+whatever arguments are passed.
+
+By default, this drops you into the pdb debugger.`
+
+func builtin_breakpoint(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	hook, ok := py.GetContext(self).MustGetModule("sys").Globals["breakpointhook"]
+	if !ok {
+		return nil, py.ExceptionNewf(py.RuntimeError, "lost sys.breakpointhook")
+	}
+	return py.Call(hook, args, kwargs)
+}
+
 const round_doc = `round(number[, ndigits]) -> number
 
 Round a number to a given precision in decimal digits (default 0 digits).
@@ -509,68 +542,6 @@ fromlist is not empty.  Level is used to determine whether to perform
 absolute or relative imports. 0 is absolute while a positive number
 is the number of parent directories to search relative to the current module.`
 
-const open_doc = `open(name[, mode[, buffering]]) -> file object
-
-Open a file using the file() type, returns a file object.  This is the
-preferred way to open a file.  See file.__doc__ for further information.`
-
-func builtin_open(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
-	kwlist := []string{
-		"file",
-		"mode",
-		"buffering",
-		"encoding",
-		"errors",
-		"newline",
-		"closefd",
-		"opener",
-	}
-
-	var (
-		filename  py.Object
-		mode      py.Object = py.String("r")
-		buffering py.Object = py.Int(-1)
-		encoding  py.Object = py.None
-		errors    py.Object = py.None
-		newline   py.Object = py.None
-		closefd   py.Object = py.Bool(true)
-		opener    py.Object = py.None
-	)
-
-	err := py.ParseTupleAndKeywords(args, kwargs, "s|sizzzpO:open", kwlist,
-		&filename,
-		&mode,
-		&buffering,
-		&encoding,
-		&errors,
-		&newline,
-		&closefd,
-		&opener)
-	if err != nil {
-		return nil, err
-	}
-
-	if encoding != py.None && encoding.(py.String) != py.String("utf-8") {
-		return nil, py.ExceptionNewf(py.NotImplementedError, "encoding not implemented yet")
-	}
-
-	if errors != py.None {
-		return nil, py.ExceptionNewf(py.NotImplementedError, "errors not implemented yet")
-	}
-
-	if newline != py.None {
-		return nil, py.ExceptionNewf(py.NotImplementedError, "newline not implemented yet")
-	}
-
-	if opener != py.None {
-		return nil, py.ExceptionNewf(py.NotImplementedError, "opener not implemented yet")
-	}
-
-	return py.OpenFile(string(filename.(py.String)),
-		string(mode.(py.String)),
-		int(buffering.(py.Int)))
-}
-
 const ord_doc = `ord(c) -> integer
 
 Return the integer ordinal of a one-character string.`
@@ -764,29 +735,30 @@ func builtin_compile(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Ob
 	// 	return nil, py.ExceptionNewf(py.ValueError, "compile() arg 3 must be 'exec', 'eval' or 'single'")
 	// }
 
-	// is_ast = PyAST_Check(cmd)
-	// if is_ast {
-	// 	if supplied_flags & PyCF_ONLY_AST {
-	// 		result = cmd
-	// 	} else {
-
-	// 		arena := PyArena_New()
-	// 		mod := PyAST_obj2mod(cmd, arena, mode)
-	// 		PyAST_Validate(mod)
-	// 		result = PyAST_CompileObject(mod, filename, &cf, optimize, arena)
-	// 		PyArena_Free(arena)
-	// 	}
-	// } else {
+	flags := int(supplied_flags.(py.Int))
+	mode := string(startstr.(py.String))
+	if pyast.IsAst(cmd) {
+		if flags&compile.PyCF_ONLY_AST != 0 {
+			return cmd, nil
+		}
+		mod, err := pyast.PyToAst(cmd, mode)
+		if err != nil {
+			return nil, err
+		}
+		return compile.CompileAst(mod, string(filename.(py.String)), flags, dont_inherit.(py.Int) != 0)
+	}
 	str, err := source_as_string(cmd, "compile", "string, bytes or AST" /*, &cf*/)
 	if err != nil {
 		return nil, err
 	}
+	if flags&compile.PyCF_ONLY_AST != 0 {
+		return pyast.Parse(str, string(filename.(py.String)), mode)
+	}
 	// result = py.CompileStringExFlags(str, filename, start[mode], &cf, optimize)
-	result, err = compile.Compile(str, string(filename.(py.String)), string(startstr.(py.String)), int(supplied_flags.(py.Int)), dont_inherit.(py.Int) != 0)
+	result, err = compile.Compile(str, string(filename.(py.String)), mode, flags, dont_inherit.(py.Int) != 0)
 	if err != nil {
 		return nil, err
 	}
-	// }
 
 	return result, nil
 }
@@ -826,6 +798,21 @@ object.
 The globals and locals are dictionaries, defaulting to the current
 globals and locals.  If only globals is given, locals defaults to it.`
 
+const format_doc = `format(value[, format_spec]) -> string
+
+Returns value.__format__(format_spec)
+format_spec defaults to ""`
+
+func builtin_format(self py.Object, args py.Tuple) (py.Object, error) {
+	var value py.Object
+	var formatSpec py.Object = py.String("")
+	err := py.UnpackTuple(args, nil, "format", 1, 2, &value, &formatSpec)
+	if err != nil {
+		return nil, err
+	}
+	return py.Format(value, formatSpec)
+}
+
 const iter_doc = `iter(iterable) -> iterator
 iter(callable, sentinel) -> iterator
 
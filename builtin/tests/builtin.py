@@ -25,6 +25,11 @@ assert ascii('안녕 세상') == "'\\uc548\\ub155 \\uc138\\uc0c1'"
 assert ascii(chr(0x10001)) == "'\\U00010001'"
 assert ascii('안녕 gpython') == "'\\uc548\\ub155 gpython'"
 
+doc="__debug__"
+assert __debug__ is True
+import builtins
+assert builtins.__debug__ is True
+
 doc="bin"
 assert bin(False) == '0b0'
 assert bin(True) == '0b1'
@@ -48,6 +53,46 @@ code = compile("pass", "<string>", "exec")
 assert code is not None
 # FIXME
 
+doc="compile syntax errors"
+def ck_syntax_error(source, exc, filename, lineno, offset, text, msg):
+    try:
+        compile(source, filename, "exec")
+    except SyntaxError as e:
+        assert type(e) == exc, type(e)
+        assert e.filename == filename, e.filename
+        assert e.lineno == lineno, e.lineno
+        assert e.offset == offset, e.offset
+        assert e.text == text, e.text
+        assert e.msg == msg, e.msg
+        assert e.args[0] == msg, e.args
+        assert e.args[1][:4] == (filename, lineno, offset, text), e.args
+    else:
+        assert False, "SyntaxError not raised"
+ck_syntax_error("x = 1\nif x\n    pass\n", SyntaxError, "f.py", 2, 5, "if x\n", "expected ':'")
+ck_syntax_error("é = 1 +* 2\n", SyntaxError, "g.py", 1, 8, "é = 1 +* 2\n", "invalid syntax")
+ck_syntax_error("def f():\n    return\nreturn 1\n", SyntaxError, "h.py", 3, 1, "return 1\n", "'return' outside function")
+ck_syntax_error("$", SyntaxError, "i.py", 1, 1, "$\n", "invalid syntax")
+ck_syntax_error("    x = 1\n", IndentationError, "j.py", 1, 1, "    x = 1\n", "unexpected indent")
+ck_syntax_error("if x:\npass\n", IndentationError, "k.py", 2, 1, "pass\n", "expected an indented block")
+
+doc="SyntaxError args"
+e = SyntaxError("m", ("f", 1, 2, "t"))
+assert e.args == ("m", ("f", 1, 2, "t"))
+assert (e.msg, e.filename, e.lineno, e.offset, e.text) == ("m", "f", 1, 2, "t")
+assert (e.end_lineno, e.end_offset) == (None, None)
+e = IndentationError("m", ("f", 1, 2, "t", 1, 4))
+assert (e.lineno, e.offset, e.end_lineno, e.end_offset) == (1, 2, 1, 4)
+e = SyntaxError("m")
+assert (e.msg, e.filename, e.lineno, e.offset, e.text) == ("m", None, None, None, None)
+e = SyntaxError()
+assert e.msg is None
+try:
+    SyntaxError("m", (1, 2))
+except TypeError:
+    pass
+else:
+    assert False, "TypeError not raised"
+
 doc="divmod"
 assert divmod(34,7) == (4, 6)
 
@@ -66,6 +111,62 @@ glob = {"a":100}
 assert exec("b = a+100", glob) == None
 assert glob["b"] == 200
 
+doc="format"
+assert format(12) == "12"
+assert format("abc") == "abc"
+assert format("abc", "<5") == "abc  "
+assert format("abc", ">5") == "  abc"
+assert format("abc", "*^7") == "**abc**"
+assert format("abcdef", ".3") == "abc"
+assert format(42, "d") == "42"
+assert format(42, "+d") == "+42"
+assert format(-42, "06d") == "-00042"
+assert format(255, "x") == "ff"
+assert format(255, "#X") == "0XFF"
+assert format(5, "#b") == "0b101"
+assert format(8, "o") == "10"
+assert format(65, "c") == "A"
+assert format(1234567, ",") == "1,234,567"
+assert format(12345678901234567890, ",d") == "12,345,678,901,234,567,890"
+assert format(True) == "True"
+assert format(True, "d") == "1"
+assert format(3.14159, ".2f") == "3.14"
+assert format(3.14159, "8.3f") == "   3.142"
+assert format(1234.5, "e") == "1.234500e+03"
+assert format(1234.5, "E") == "1.234500E+03"
+assert format(0.000012345, "g") == "1.2345e-05"
+assert format(123456789.0, "g") == "1.23457e+08"
+assert format(1.0, ".3") == "1.0"
+assert format(1e20, ".3") == "1e+20"
+assert format(0.25, "%") == "25.000000%"
+assert format(0.25, ".0%") == "25%"
+assert format(1234567.125, ",.2f") == "1,234,567.12"
+assert format(float("inf"), "f") == "inf"
+assert format(float("-inf"), "F") == "-INF"
+assert format(2, "f") == "2.000000"
+ok = False
+try:
+    format("abc", "d")
+except ValueError:
+    ok = True
+assert ok, "ValueError not raised"
+ok = False
+try:
+    format(1, ".2d")
+except ValueError:
+    ok = True
+assert ok, "ValueError not raised"
+ok = False
+try:
+    format(object(), "x")
+except TypeError:
+    ok = True
+assert ok, "TypeError not raised"
+class Fmt:
+    def __format__(self, spec):
+        return "Fmt(" + spec + ")"
+assert format(Fmt(), "xyz") == "Fmt(xyz)"
+
 doc="getattr"
 class C:
     def __init__(self):
@@ -309,6 +410,26 @@ with open("testfile", "w") as f:
 with open("testfile", "r") as f:
     assert f.read() == "1,2,3,\n"
 
+class Writer:
+    def __init__(self):
+        self.out = ""
+    def write(self, s):
+        self.out += s
+w = Writer()
+print("a", 1, file=w)
+print("b", "c", sep=None, end=None, file=w)
+assert w.out == "a 1\nb c\n"
+
+import sys
+stdout = sys.stdout
+sys.stdout = w
+try:
+    print("redirected")
+    print("to None", file=None)
+finally:
+    sys.stdout = stdout
+assert w.out == "a 1\nb c\nredirected\nto None\n"
+
 doc="round"
 assert round(1.1) == 1.0
 
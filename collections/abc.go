@@ -0,0 +1,731 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// collections.abc module
+
+package collections
+
+import (
+	"github.com/go-python/gpython/py"
+)
+
+const abc_doc = `Abstract Base Classes (ABCs) for collections, according to PEP 3119.
+
+Subclass these to get the mixin methods, eg a class which subclasses
+Mapping and defines __getitem__, __iter__ and __len__ gets get, keys,
+items, values, __contains__, __eq__ and __ne__.
+
+Unused by the builtin types of gpython as it has no isinstance
+or ABCMeta.register.`
+
+var FunctionType = py.NewType("method_descriptor", "Go function used as a method by a python class")
+
+// Function is a Go function which can be a method of a python class
+//
+// Unlike a py.Method it is always passed the instance as its first
+// argument however it is called, which is how the runtime calls the
+// special methods it finds in classes.
+type Function struct {
+	Name string
+	Doc  string
+	Fn   func(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error)
+}
+
+// Type of this object
+func (f *Function) Type() *py.Type {
+	return FunctionType
+}
+
+func (f *Function) M__call__(args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	if len(args) == 0 {
+		return nil, py.ExceptionNewf(py.TypeError, "%s() needs an argument", f.Name)
+	}
+	return f.Fn(args[0], args[1:], kwargs)
+}
+
+// Read a Function from a class which makes a bound method
+func (f *Function) M__get__(instance, owner py.Object) (py.Object, error) {
+	if instance == nil || instance == py.None {
+		return f, nil
+	}
+	return py.NewBoundMethod(instance, f), nil
+}
+
+// Check interface is satisfied
+var _ py.I__call__ = (*Function)(nil)
+var _ py.I__get__ = (*Function)(nil)
+
+// Adds a mixin method taking min to max arguments to t
+func addMixin(t *py.Type, name string, min, max int, fn func(self py.Object, args py.Tuple) (py.Object, error)) {
+	t.Dict[name] = &Function{
+		Name: name,
+		Fn: func(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+			if len(kwargs) != 0 {
+				return nil, py.ExceptionNewf(py.TypeError, "%s() takes no keyword arguments", name)
+			}
+			if len(args) < min || len(args) > max {
+				return nil, py.ExceptionNewf(py.TypeError, "%s() takes from %d to %d positional arguments but %d were given", name, min+1, max+1, len(args)+1)
+			}
+			return fn(self, args)
+		},
+	}
+}
+
+// Makes an abstract base class with the given bases
+func newABC(name, doc string, bases ...*py.Type) *py.Type {
+	t := &py.Type{
+		ObjectType: py.TypeType,
+		Name:       name,
+		Doc:        doc,
+		Flags:      py.TPFLAGS_BASETYPE,
+		Dict:       py.StringDict{},
+	}
+	if len(bases) > 0 {
+		t.Base = bases[0]
+		t.Bases = make(py.Tuple, len(bases))
+		for i, base := range bases {
+			t.Bases[i] = base
+		}
+	}
+	err := t.Ready()
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// Returns the items of o as a list
+func items(o py.Object) ([]py.Object, error) {
+	list, err := py.SequenceList(o)
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// Returns true if all the items of a are in b
+func isSubset(a, b py.Object) (bool, error) {
+	xs, err := items(a)
+	if err != nil {
+		return false, err
+	}
+	for _, x := range xs {
+		found, err := py.SequenceContains(b, x)
+		if err != nil || !found {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// Compares the lengths of a and b with op
+func compareLen(a, b py.Object, op func(a, b py.Object) (py.Object, error)) (bool, error) {
+	x, err := py.Len(a)
+	if err != nil {
+		return false, err
+	}
+	y, err := py.Len(b)
+	if err != nil {
+		return false, err
+	}
+	res, err := op(x, y)
+	return res == py.True, err
+}
+
+// Makes the mixins of Set
+func addSetMixins(t *py.Type) {
+	// Makes a new instance of the type of self from the items
+	fromIterable := func(self py.Object, items []py.Object) (py.Object, error) {
+		return py.Call(self.Type(), py.Tuple{py.NewListFromItems(items)}, nil)
+	}
+	// Makes the comparisons, which are subset tests when the lengths
+	// compare with lenOp
+	compare := func(name string, lenOp func(a, b py.Object) (py.Object, error), swap bool) {
+		addMixin(t, name, 1, 1, func(self py.Object, args py.Tuple) (py.Object, error) {
+			a, b := self, args[0]
+			if swap {
+				a, b = b, a
+			}
+			ok, err := compareLen(a, b, lenOp)
+			if err != nil || !ok {
+				return py.False, err
+			}
+			ok, err = isSubset(a, b)
+			return py.NewBool(ok), err
+		})
+	}
+	compare("__le__", py.Le, false)
+	compare("__lt__", py.Lt, false)
+	compare("__ge__", py.Le, true)
+	compare("__gt__", py.Lt, true)
+	compare("__eq__", py.Eq, false)
+	addMixin(t, "__ne__", 1, 1, func(self py.Object, args py.Tuple) (py.Object, error) {
+		eq, err := py.Eq(self, args[0])
+		if err != nil {
+			return nil, err
+		}
+		return py.Not(eq)
+	})
+	addMixin(t, "isdisjoint", 1, 1, func(self py.Object, args py.Tuple) (py.Object, error) {
+		xs, err := items(args[0])
+		if err != nil {
+			return nil, err
+		}
+		for _, x := range xs {
+			found, err := py.SequenceContains(self, x)
+			if err != nil {
+				return nil, err
+			}
+			if found {
+				return py.False, nil
+			}
+		}
+		return py.True, nil
+	})
+	// Makes the operators which keep the items of a (or b) where
+	// keep returns true for whether they are in the other one
+	operator := func(name string, keep func(inOther bool) bool, withB bool) {
+		addMixin(t, name, 1, 1, func(self py.Object, args py.Tuple) (py.Object, error) {
+			var res []py.Object
+			add := func(a, b py.Object) error {
+				xs, err := items(a)
+				if err != nil {
+					return err
+				}
+				for _, x := range xs {
+					found, err := py.SequenceContains(b, x)
+					if err != nil {
+						return err
+					}
+					if keep(found) {
+						res = append(res, x)
+					}
+				}
+				return nil
+			}
+			err := add(self, args[0])
+			if err == nil && withB {
+				err = add(args[0], self)
+			}
+			if err != nil {
+				return nil, err
+			}
+			return fromIterable(self, res)
+		})
+	}
+	in := func(inOther bool) bool { return inOther }
+	notIn := func(inOther bool) bool { return !inOther }
+	operator("__and__", in, false)
+	operator("__sub__", notIn, false)
+	operator("__xor__", notIn, true)
+	addMixin(t, "__or__", 1, 1, func(self py.Object, args py.Tuple) (py.Object, error) {
+		xs, err := items(self)
+		if err != nil {
+			return nil, err
+		}
+		ys, err := items(args[0])
+		if err != nil {
+			return nil, err
+		}
+		return fromIterable(self, append(xs, ys...))
+	})
+}
+
+// Makes the mixins of MutableSet
+func addMutableSetMixins(t *py.Type) {
+	call := func(self py.Object, name string, args ...py.Object) (py.Object, error) {
+		method, err := py.GetAttrString(self, name)
+		if err != nil {
+			return nil, err
+		}
+		return py.Call(method, args, nil)
+	}
+	addMixin(t, "remove", 1, 1, func(self py.Object, args py.Tuple) (py.Object, error) {
+		found, err := py.SequenceContains(self, args[0])
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, keyError(args[0])
+		}
+		return call(self, "discard", args[0])
+	})
+	addMixin(t, "pop", 0, 0, func(self py.Object, args py.Tuple) (py.Object, error) {
+		xs, err := items(self)
+		if err != nil {
+			return nil, err
+		}
+		if len(xs) == 0 {
+			return nil, py.ExceptionNewf(py.KeyError, "pop from an empty set")
+		}
+		_, err = call(self, "discard", xs[0])
+		return xs[0], err
+	})
+	addMixin(t, "clear", 0, 0, func(self py.Object, args py.Tuple) (py.Object, error) {
+		xs, err := items(self)
+		if err != nil {
+			return nil, err
+		}
+		for _, x := range xs {
+			_, err = call(self, "discard", x)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return py.None, nil
+	})
+	// Makes the in place operators which update self with fn
+	inplace := func(name string, fn func(self, other py.Object) error) {
+		addMixin(t, name, 1, 1, func(self py.Object, args py.Tuple) (py.Object, error) {
+			err := fn(self, args[0])
+			if err != nil {
+				return nil, err
+			}
+			return self, nil
+		})
+	}
+	inplace("__ior__", func(self, other py.Object) error {
+		ys, err := items(other)
+		for i := 0; err == nil && i < len(ys); i++ {
+			_, err = call(self, "add", ys[i])
+		}
+		return err
+	})
+	inplace("__isub__", func(self, other py.Object) error {
+		ys, err := items(other)
+		for i := 0; err == nil && i < len(ys); i++ {
+			_, err = call(self, "discard", ys[i])
+		}
+		return err
+	})
+	inplace("__iand__", func(self, other py.Object) error {
+		xs, err := items(self)
+		for i := 0; err == nil && i < len(xs); i++ {
+			var found bool
+			found, err = py.SequenceContains(other, xs[i])
+			if err == nil && !found {
+				_, err = call(self, "discard", xs[i])
+			}
+		}
+		return err
+	})
+}
+
+// Makes the mixins of Sequence
+func addSequenceMixins(t *py.Type) {
+	addMixin(t, "__iter__", 0, 0, func(self py.Object, args py.Tuple) (py.Object, error) {
+		xs, err := sequenceItems(self)
+		if err != nil {
+			return nil, err
+		}
+		return py.NewIterator(xs), nil
+	})
+	addMixin(t, "__reversed__", 0, 0, func(self py.Object, args py.Tuple) (py.Object, error) {
+		xs, err := sequenceItems(self)
+		if err != nil {
+			return nil, err
+		}
+		for i, j := 0, len(xs)-1; i < j; i, j = i+1, j-1 {
+			xs[i], xs[j] = xs[j], xs[i]
+		}
+		return py.NewIterator(xs), nil
+	})
+	addMixin(t, "__contains__", 1, 1, func(self py.Object, args py.Tuple) (py.Object, error) {
+		i, err := sequenceIndex(self, args[0], 0, -1)
+		return py.NewBool(i >= 0), err
+	})
+	addMixin(t, "index", 1, 3, func(self py.Object, args py.Tuple) (py.Object, error) {
+		bounds := []int{0, -1}
+		for i := range args[1:] {
+			var err error
+			bounds[i], err = py.IndexInt(args[1+i])
+			if err != nil {
+				return nil, err
+			}
+		}
+		if bounds[0] < 0 || (len(args) > 2 && bounds[1] < 0) {
+			n, err := py.Len(self)
+			if err != nil {
+				return nil, err
+			}
+			for i := range bounds {
+				if bounds[i] < 0 && (i == 0 || len(args) > 2) {
+					bounds[i] += int(n.(py.Int))
+					if bounds[i] < 0 {
+						bounds[i] = 0
+					}
+				}
+			}
+		}
+		i, err := sequenceIndex(self, args[0], bounds[0], bounds[1])
+		if err != nil {
+			return nil, err
+		}
+		if i < 0 {
+			return nil, py.ExceptionNewf(py.ValueError, "value not in sequence")
+		}
+		return py.Int(i), nil
+	})
+	addMixin(t, "count", 1, 1, func(self py.Object, args py.Tuple) (py.Object, error) {
+		xs, err := sequenceItems(self)
+		if err != nil {
+			return nil, err
+		}
+		count := 0
+		for _, x := range xs {
+			eq, err := py.Eq(x, args[0])
+			if err != nil {
+				return nil, err
+			}
+			if eq == py.True {
+				count++
+			}
+		}
+		return py.Int(count), nil
+	})
+}
+
+// Returns the items of a Sequence by indexing from 0 until
+// IndexError
+func sequenceItems(self py.Object) ([]py.Object, error) {
+	var xs []py.Object
+	for i := 0; ; i++ {
+		x, err := py.GetItem(self, py.Int(i))
+		if err != nil {
+			if py.IsException(py.IndexError, err) {
+				return xs, nil
+			}
+			return nil, err
+		}
+		xs = append(xs, x)
+	}
+}
+
+// Returns the index of value in the Sequence between start and stop
+// (or the end if stop < 0), or -1 if not found
+func sequenceIndex(self, value py.Object, start, stop int) (int, error) {
+	for i := start; stop < 0 || i < stop; i++ {
+		x, err := py.GetItem(self, py.Int(i))
+		if err != nil {
+			if py.IsException(py.IndexError, err) {
+				break
+			}
+			return 0, err
+		}
+		eq, err := py.Eq(x, value)
+		if err != nil {
+			return 0, err
+		}
+		if eq == py.True {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+// Makes the mixins of MutableSequence
+func addMutableSequenceMixins(t *py.Type) {
+	insert := func(self, index, value py.Object) error {
+		method, err := py.GetAttrString(self, "insert")
+		if err == nil {
+			_, err = py.Call(method, py.Tuple{index, value}, nil)
+		}
+		return err
+	}
+	extend := func(self, values py.Object) error {
+		if values == self {
+			xs, err := items(values)
+			if err != nil {
+				return err
+			}
+			values = py.NewListFromItems(xs)
+		}
+		xs, err := items(values)
+		for i := 0; err == nil && i < len(xs); i++ {
+			var n py.Object
+			n, err = py.Len(self)
+			if err == nil {
+				err = insert(self, n, xs[i])
+			}
+		}
+		return err
+	}
+	pop := func(self py.Object, index py.Object) (py.Object, error) {
+		x, err := py.GetItem(self, index)
+		if err != nil {
+			return nil, err
+		}
+		_, err = py.DelItem(self, index)
+		return x, err
+	}
+	addMixin(t, "append", 1, 1, func(self py.Object, args py.Tuple) (py.Object, error) {
+		n, err := py.Len(self)
+		if err != nil {
+			return nil, err
+		}
+		return py.None, insert(self, n, args[0])
+	})
+	addMixin(t, "extend", 1, 1, func(self py.Object, args py.Tuple) (py.Object, error) {
+		return py.None, extend(self, args[0])
+	})
+	addMixin(t, "pop", 0, 1, func(self py.Object, args py.Tuple) (py.Object, error) {
+		var index py.Object = py.Int(-1)
+		if len(args) > 0 {
+			index = args[0]
+		}
+		return pop(self, index)
+	})
+	addMixin(t, "remove", 1, 1, func(self py.Object, args py.Tuple) (py.Object, error) {
+		i, err := sequenceIndex(self, args[0], 0, -1)
+		if err != nil {
+			return nil, err
+		}
+		if i < 0 {
+			return nil, py.ExceptionNewf(py.ValueError, "value not in sequence")
+		}
+		_, err = py.DelItem(self, py.Int(i))
+		return py.None, err
+	})
+	addMixin(t, "clear", 0, 0, func(self py.Object, args py.Tuple) (py.Object, error) {
+		for {
+			_, err := pop(self, py.Int(-1))
+			if err != nil {
+				if py.IsException(py.IndexError, err) {
+					return py.None, nil
+				}
+				return nil, err
+			}
+		}
+	})
+	addMixin(t, "reverse", 0, 0, func(self py.Object, args py.Tuple) (py.Object, error) {
+		xs, err := sequenceItems(self)
+		if err != nil {
+			return nil, err
+		}
+		n := len(xs)
+		for i := 0; i < n/2; i++ {
+			_, err = py.SetItem(self, py.Int(i), xs[n-1-i])
+			if err == nil {
+				_, err = py.SetItem(self, py.Int(n-1-i), xs[i])
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		return py.None, nil
+	})
+	addMixin(t, "__iadd__", 1, 1, func(self py.Object, args py.Tuple) (py.Object, error) {
+		err := extend(self, args[0])
+		if err != nil {
+			return nil, err
+		}
+		return self, nil
+	})
+}
+
+// Makes the mixins of Mapping
+func addMappingMixins(t *py.Type) {
+	get := func(self, key, def py.Object) (py.Object, error) {
+		value, err := py.GetItem(self, key)
+		if err != nil && py.IsException(py.KeyError, err) {
+			return def, nil
+		}
+		return value, err
+	}
+	addMixin(t, "get", 1, 2, func(self py.Object, args py.Tuple) (py.Object, error) {
+		var def py.Object = py.None
+		if len(args) > 1 {
+			def = args[1]
+		}
+		return get(self, args[0], def)
+	})
+	addMixin(t, "__contains__", 1, 1, func(self py.Object, args py.Tuple) (py.Object, error) {
+		_, err := py.GetItem(self, args[0])
+		if err != nil {
+			if py.IsException(py.KeyError, err) {
+				return py.False, nil
+			}
+			return nil, err
+		}
+		return py.True, nil
+	})
+	// The views are snapshots of the mapping as it is now
+	snapshot := func(self py.Object) (*mapping, error) {
+		m := &mapping{t: newTable()}
+		keys, err := items(self)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			value, err := py.GetItem(self, key)
+			if err != nil {
+				return nil, err
+			}
+			err = m.t.set(key, value)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return m, nil
+	}
+	views := map[string]iterKind{"keys": iterKeys, "values": iterValues, "items": iterItems}
+	for name, kind := range views {
+		kind := kind
+		addMixin(t, name, 0, 0, func(self py.Object, args py.Tuple) (py.Object, error) {
+			m, err := snapshot(self)
+			if err != nil {
+				return nil, err
+			}
+			return &mappingView{m: m, kind: kind}, nil
+		})
+	}
+	addMixin(t, "__eq__", 1, 1, func(self py.Object, args py.Tuple) (py.Object, error) {
+		m, err := snapshot(self)
+		if err != nil {
+			return nil, err
+		}
+		other := args[0]
+		if _, ok := other.(*py.Type); ok {
+			other, err = snapshot(other)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return m.equal(other, false)
+	})
+	addMixin(t, "__ne__", 1, 1, func(self py.Object, args py.Tuple) (py.Object, error) {
+		eq, err := py.Eq(self, args[0])
+		if err != nil {
+			return nil, err
+		}
+		return py.Not(eq)
+	})
+}
+
+// Makes the mixins of MutableMapping
+func addMutableMappingMixins(t *py.Type) {
+	addMixin(t, "pop", 1, 2, func(self py.Object, args py.Tuple) (py.Object, error) {
+		value, err := py.GetItem(self, args[0])
+		if err != nil {
+			if py.IsException(py.KeyError, err) && len(args) > 1 {
+				return args[1], nil
+			}
+			return nil, err
+		}
+		_, err = py.DelItem(self, args[0])
+		return value, err
+	})
+	addMixin(t, "popitem", 0, 0, func(self py.Object, args py.Tuple) (py.Object, error) {
+		keys, err := items(self)
+		if err != nil {
+			return nil, err
+		}
+		if len(keys) == 0 {
+			return nil, py.ExceptionNewf(py.KeyError, "popitem(): dictionary is empty")
+		}
+		value, err := py.GetItem(self, keys[0])
+		if err != nil {
+			return nil, err
+		}
+		_, err = py.DelItem(self, keys[0])
+		return py.Tuple{keys[0], value}, err
+	})
+	addMixin(t, "clear", 0, 0, func(self py.Object, args py.Tuple) (py.Object, error) {
+		keys, err := items(self)
+		for i := 0; err == nil && i < len(keys); i++ {
+			_, err = py.DelItem(self, keys[i])
+		}
+		return py.None, err
+	})
+	t.Dict["update"] = &Function{
+		Name: "update",
+		Fn: func(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+			return py.None, updateArgs("update", args, kwargs, func(key, value py.Object) error {
+				_, err := py.SetItem(self, key, value)
+				return err
+			})
+		},
+	}
+	addMixin(t, "setdefault", 1, 2, func(self py.Object, args py.Tuple) (py.Object, error) {
+		value, err := py.GetItem(self, args[0])
+		if err == nil || !py.IsException(py.KeyError, err) {
+			return value, err
+		}
+		var def py.Object = py.None
+		if len(args) > 1 {
+			def = args[1]
+		}
+		_, err = py.SetItem(self, args[0], def)
+		return def, err
+	})
+}
+
+// Makes the collections.abc module
+func newAbcModule() *py.Module {
+	hashable := newABC("Hashable", "Classes which define __hash__.")
+	awaitable := newABC("Awaitable", "Classes which define __await__.")
+	coroutine := newABC("Coroutine", "Awaitables which define send, throw and close.", awaitable)
+	asyncIterable := newABC("AsyncIterable", "Classes which define __aiter__.")
+	asyncIterator := newABC("AsyncIterator", "Async iterables which define __anext__.", asyncIterable)
+	iterable := newABC("Iterable", "Classes which define __iter__.")
+	iterator := newABC("Iterator", "Iterables which define __next__.", iterable)
+	reversible := newABC("Reversible", "Iterables which define __reversed__.", iterable)
+	generator := newABC("Generator", "Iterators which define send, throw and close.", iterator)
+	sized := newABC("Sized", "Classes which define __len__.")
+	container := newABC("Container", "Classes which define __contains__.")
+	callable := newABC("Callable", "Classes which define __call__.")
+	collection := newABC("Collection", "Sized iterable containers.", sized, iterable, container)
+	set := newABC("Set", `A set is a finite, iterable container.
+
+Subclasses define __contains__, __iter__ and __len__ and the
+comparisons and set operators are provided.`, collection)
+	mutableSet := newABC("MutableSet", `A mutable set is a finite, iterable container.
+
+Subclasses define add and discard as well as the methods Set needs.`, set)
+	mapping := newABC("Mapping", `A Mapping is a generic container for associating key/value
+pairs.
+
+Subclasses define __getitem__, __iter__ and __len__.`, collection)
+	mutableMapping := newABC("MutableMapping", `A MutableMapping is a generic container for associating
+key/value pairs.
+
+Subclasses define __setitem__ and __delitem__ as well as the
+methods Mapping needs.`, mapping)
+	sequence := newABC("Sequence", `All the operations on a read-only sequence.
+
+Subclasses define __getitem__ and __len__.`, reversible, collection)
+	byteString := newABC("ByteString", "This unifies bytes and bytearray.", sequence)
+	mutableSequence := newABC("MutableSequence", `All the operations on a read-write sequence.
+
+Subclasses define __setitem__, __delitem__ and insert as well as the
+methods Sequence needs.`, sequence)
+
+	addMixin(iterator, "__iter__", 0, 0, func(self py.Object, args py.Tuple) (py.Object, error) {
+		return self, nil
+	})
+	addMixin(generator, "__next__", 0, 0, func(self py.Object, args py.Tuple) (py.Object, error) {
+		send, err := py.GetAttrString(self, "send")
+		if err != nil {
+			return nil, err
+		}
+		return py.Call(send, py.Tuple{py.None}, nil)
+	})
+	addSetMixins(set)
+	addMutableSetMixins(mutableSet)
+	addMappingMixins(mapping)
+	addMutableMappingMixins(mutableMapping)
+	addSequenceMixins(sequence)
+	addMutableSequenceMixins(mutableSequence)
+
+	globals := py.StringDict{}
+	for _, t := range []*py.Type{
+		hashable, awaitable, coroutine, asyncIterable, asyncIterator,
+		iterable, iterator, reversible, generator, sized, container,
+		callable, collection, set, mutableSet, mapping, mutableMapping,
+		sequence, byteString, mutableSequence,
+	} {
+		globals[t.Name] = t
+	}
+	return py.NewModule("collections.abc", abc_doc, nil, globals)
+}
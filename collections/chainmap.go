@@ -0,0 +1,489 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// ChainMap objects
+
+package collections
+
+import (
+	"github.com/go-python/gpython/py"
+)
+
+var ChainMapType = newBaseType("ChainMap", `A ChainMap groups multiple dicts (or other mappings) together
+to create a single, updateable view.
+
+The underlying mappings are stored in a list.  That list is public and can
+be accessed or updated using the *maps* attribute.  There is no other
+state.
+
+Lookups search the underlying mappings successively until a key is found.
+In contrast, writes, updates, and deletions only operate on the first
+mapping.`, ChainMapNew)
+
+// dictType is the type of the empty mappings ChainMap makes
+//
+// It is a dict which can have any hashable key as the keys of
+// gpython's dict can only be strings.
+var dictType = py.NewType("dict", "dict with keys of any hashable type")
+
+// dict is an instance of dictType
+type dict struct {
+	mapping
+}
+
+// Makes a new empty dict
+func newDict() *dict {
+	return &dict{mapping: newMapping(dictType)}
+}
+
+// dictNew makes a dict the way the dict constructor does
+func dictNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	d := newDict()
+	err := updateArgs("dict", args, kwargs, d.t.set)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (d *dict) M__getitem__(key py.Object) (py.Object, error) {
+	return getitem(d, key)
+}
+
+func (d *dict) M__repr__() (py.Object, error) {
+	repr, err := d.reprItems()
+	if err != nil {
+		return nil, err
+	}
+	return py.String(repr), nil
+}
+
+func (d *dict) M__eq__(other py.Object) (py.Object, error) {
+	return d.equal(other, false)
+}
+
+func (d *dict) M__ne__(other py.Object) (py.Object, error) {
+	eq, err := d.equal(other, false)
+	if err != nil || eq == py.NotImplemented {
+		return eq, err
+	}
+	return py.Not(eq)
+}
+
+// ChainMap is a view of a list of mappings as one
+type ChainMap struct {
+	typ  *py.Type
+	maps *py.List
+	dict py.StringDict
+}
+
+// Type of this object
+func (cm *ChainMap) Type() *py.Type {
+	return cm.typ
+}
+
+// Returns the instance dictionary for the attributes of subclasses
+func (cm *ChainMap) GetDict() py.StringDict {
+	return cm.dict
+}
+
+// ChainMapNew makes a ChainMap of the mappings passed in
+func ChainMapNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	cm := newChainMap(metatype, nil)
+	if isSubclass(metatype) {
+		return cm, nil
+	}
+	err := cm.init(args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	return cm, nil
+}
+
+// Sets the maps from the constructor arguments
+func (cm *ChainMap) init(args py.Tuple, kwargs py.StringDict) error {
+	if len(kwargs) > 0 {
+		return py.ExceptionNewf(py.TypeError, "ChainMap() takes no keyword arguments")
+	}
+	cm.maps = newChainMap(cm.typ, args).maps
+	return nil
+}
+
+// Makes a ChainMap of type t of maps, or of a new empty dict if
+// there are none
+func newChainMap(t *py.Type, maps []py.Object) *ChainMap {
+	if len(maps) == 0 {
+		maps = []py.Object{newDict()}
+	}
+	items := make([]py.Object, len(maps))
+	copy(items, maps)
+	return &ChainMap{typ: t, maps: py.NewListFromItems(items), dict: py.NewStringDict()}
+}
+
+// Returns the first mapping
+func (cm *ChainMap) first() (py.Object, error) {
+	if len(cm.maps.Items) == 0 {
+		return nil, py.ExceptionNewf(py.IndexError, "list index out of range")
+	}
+	return cm.maps.Items[0], nil
+}
+
+// Returns the key, value pairs seen through the ChainMap
+//
+// The keys are in the order they are first found going from the last
+// mapping to the first.
+func (cm *ChainMap) snapshot() (*mapping, error) {
+	m := &mapping{t: newTable()}
+	for i := len(cm.maps.Items) - 1; i >= 0; i-- {
+		var innerErr error
+		err := py.Iterate(cm.maps.Items[i], func(key py.Object) bool {
+			innerErr = m.t.set(key, nil)
+			return innerErr != nil
+		})
+		if err == nil {
+			err = innerErr
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, e := range m.t.entries() {
+		value, err := cm.M__getitem__(e.key)
+		if err != nil {
+			return nil, err
+		}
+		e.value = value
+	}
+	return m, nil
+}
+
+// Calls the method name on the first mapping and converts a KeyError
+// from it into one with message
+func (cm *ChainMap) callFirst(name string, args py.Tuple, message string) (py.Object, error) {
+	first, err := cm.first()
+	if err != nil {
+		return nil, err
+	}
+	var res py.Object
+	if d, ok := first.(py.StringDict); ok {
+		res, err = stringDictMethod(d, name, args)
+	} else {
+		var method py.Object
+		method, err = py.GetAttrString(first, name)
+		if err == nil {
+			res, err = py.Call(method, args, nil)
+		}
+	}
+	if err != nil && message != "" && py.IsException(py.KeyError, err) {
+		return nil, py.ExceptionNewf(py.KeyError, "%s", message)
+	}
+	return res, err
+}
+
+// Implements the methods ChainMap needs from its first mapping for
+// StringDicts which don't have them
+func stringDictMethod(d py.StringDict, name string, args py.Tuple) (py.Object, error) {
+	switch name {
+	case "copy":
+		return d.Copy(), nil
+	case "clear":
+		for key := range d {
+			delete(d, key)
+		}
+		return py.None, nil
+	case "popitem":
+		keys := sortedKeys(d)
+		if len(keys) == 0 {
+			return nil, py.ExceptionNewf(py.KeyError, "popitem(): dictionary is empty")
+		}
+		key := keys[len(keys)-1]
+		value := d[key]
+		delete(d, key)
+		return py.Tuple{py.String(key), value}, nil
+	case "pop":
+		var key, def py.Object
+		err := py.UnpackTuple(args, nil, "pop", 1, 2, &key, &def)
+		if err != nil {
+			return nil, err
+		}
+		if s, ok := key.(py.String); ok {
+			if value, ok := d[string(s)]; ok {
+				delete(d, string(s))
+				return value, nil
+			}
+		}
+		if def != nil {
+			return def, nil
+		}
+		return nil, keyError(key)
+	}
+	return nil, py.ExceptionNewf(py.AttributeError, "'dict' object has no attribute '%s'", name)
+}
+
+// Returns the message for a key not in the first mapping
+func notInFirst(key py.Object) string {
+	repr, err := py.ReprAsString(key)
+	if err != nil {
+		repr = "?"
+	}
+	return "Key not found in the first mapping: " + repr
+}
+
+func (cm *ChainMap) M__getitem__(key py.Object) (py.Object, error) {
+	for _, m := range cm.maps.Items {
+		value, err := py.GetItem(m, key)
+		if err == nil {
+			return value, nil
+		}
+		if !py.IsException(py.KeyError, err) {
+			return nil, err
+		}
+	}
+	if cm.typ.NativeGetAttrOrNil("__missing__") != nil {
+		missing, err := py.GetAttrString(cm, "__missing__")
+		if err != nil {
+			return nil, err
+		}
+		return py.Call(missing, py.Tuple{key}, nil)
+	}
+	return nil, keyError(key)
+}
+
+func (cm *ChainMap) M__setitem__(key, value py.Object) (py.Object, error) {
+	first, err := cm.first()
+	if err != nil {
+		return nil, err
+	}
+	return py.SetItem(first, key, value)
+}
+
+func (cm *ChainMap) M__delitem__(key py.Object) (py.Object, error) {
+	first, err := cm.first()
+	if err != nil {
+		return nil, err
+	}
+	_, err = py.DelItem(first, key)
+	if err != nil {
+		if py.IsException(py.KeyError, err) {
+			return nil, py.ExceptionNewf(py.KeyError, "%s", notInFirst(key))
+		}
+		return nil, err
+	}
+	return py.None, nil
+}
+
+func (cm *ChainMap) M__contains__(key py.Object) (py.Object, error) {
+	for _, m := range cm.maps.Items {
+		found, err := py.SequenceContains(m, key)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			return py.True, nil
+		}
+	}
+	return py.False, nil
+}
+
+func (cm *ChainMap) M__len__() (py.Object, error) {
+	m, err := cm.snapshot()
+	if err != nil {
+		return nil, err
+	}
+	return py.Int(m.t.len()), nil
+}
+
+func (cm *ChainMap) M__bool__() (py.Object, error) {
+	for _, m := range cm.maps.Items {
+		ok, err := py.MakeBool(m)
+		if err != nil {
+			return nil, err
+		}
+		if ok == py.True {
+			return py.True, nil
+		}
+	}
+	return py.False, nil
+}
+
+func (cm *ChainMap) M__iter__() (py.Object, error) {
+	m, err := cm.snapshot()
+	if err != nil {
+		return nil, err
+	}
+	return py.NewIterator(m.t.keys()), nil
+}
+
+func (cm *ChainMap) M__repr__() (py.Object, error) {
+	maps, err := joinReprs(cm.maps.Items)
+	if err != nil {
+		return nil, err
+	}
+	return py.String(cm.typ.Name + "(" + maps + ")"), nil
+}
+
+func (cm *ChainMap) M__eq__(other py.Object) (py.Object, error) {
+	m, err := cm.snapshot()
+	if err != nil {
+		return nil, err
+	}
+	if b, ok := other.(*ChainMap); ok {
+		other, err = b.snapshot()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return m.equal(other, false)
+}
+
+func (cm *ChainMap) M__ne__(other py.Object) (py.Object, error) {
+	eq, err := cm.M__eq__(other)
+	if err != nil || eq == py.NotImplemented {
+		return eq, err
+	}
+	return py.Not(eq)
+}
+
+// Check interface is satisfied
+var _ py.I__getitem__ = (*dict)(nil)
+var _ py.I__repr__ = (*dict)(nil)
+var _ py.I__eq__ = (*dict)(nil)
+var _ py.I__ne__ = (*dict)(nil)
+var _ py.I__getitem__ = (*ChainMap)(nil)
+var _ py.I__setitem__ = (*ChainMap)(nil)
+var _ py.I__delitem__ = (*ChainMap)(nil)
+var _ py.I__contains__ = (*ChainMap)(nil)
+var _ py.I__len__ = (*ChainMap)(nil)
+var _ py.I__bool__ = (*ChainMap)(nil)
+var _ py.I__iter__ = (*ChainMap)(nil)
+var _ py.I__repr__ = (*ChainMap)(nil)
+var _ py.I__eq__ = (*ChainMap)(nil)
+var _ py.I__ne__ = (*ChainMap)(nil)
+
+func init() {
+	dictType.New = dictNew
+	addMappingMethods(dictType, func(o py.Object) (py.Object, error) {
+		d := newDict()
+		d.t = o.(*dict).t.copy()
+		return d, nil
+	})
+
+	self := func(o py.Object) *ChainMap {
+		return o.(*ChainMap)
+	}
+	addInit(ChainMapType, func(o py.Object, args py.Tuple, kwargs py.StringDict) error {
+		return self(o).init(args, kwargs)
+	})
+	ChainMapType.Dict["maps"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return self(o).maps, nil
+		},
+		Fset: func(o, value py.Object) error {
+			maps, ok := value.(*py.List)
+			if !ok {
+				return py.ExceptionNewf(py.TypeError, "maps must be a list")
+			}
+			self(o).maps = maps
+			return nil
+		},
+		Doc: "The list of mappings searched in order.",
+	}
+	ChainMapType.Dict["parents"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			cm := self(o)
+			var maps []py.Object
+			if len(cm.maps.Items) > 0 {
+				maps = cm.maps.Items[1:]
+			}
+			return newChainMap(cm.typ, maps), nil
+		},
+		Doc: "New ChainMap from maps[1:].",
+	}
+	ChainMapType.Dict["new_child"] = py.MustNewMethod("new_child", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		var m py.Object = py.None
+		err := py.ParseTupleAndKeywords(args, kwargs, "|O:new_child", []string{"m"}, &m)
+		if err != nil {
+			return nil, err
+		}
+		if m == py.None {
+			m = newDict()
+		}
+		cm := self(o)
+		return newChainMap(cm.typ, append([]py.Object{m}, cm.maps.Items...)), nil
+	}, 0, "New ChainMap with a new map followed by all previous maps.\nIf no map is provided, an empty dict is used.")
+	ChainMapType.Dict["get"] = py.MustNewMethod("get", func(o py.Object, args py.Tuple) (py.Object, error) {
+		var key py.Object
+		var def py.Object = py.None
+		err := py.UnpackTuple(args, nil, "get", 1, 2, &key, &def)
+		if err != nil {
+			return nil, err
+		}
+		cm := self(o)
+		found, err := cm.M__contains__(key)
+		if err != nil {
+			return nil, err
+		}
+		if found != py.True {
+			return def, nil
+		}
+		return cm.M__getitem__(key)
+	}, 0, "D.get(k[,d]) -> D[k] if k in D, else d.  d defaults to None.")
+	views := map[string]iterKind{"keys": iterKeys, "values": iterValues, "items": iterItems}
+	for name, kind := range views {
+		kind := kind
+		ChainMapType.Dict[name] = py.MustNewMethod(name, func(o py.Object) (py.Object, error) {
+			m, err := self(o).snapshot()
+			if err != nil {
+				return nil, err
+			}
+			return &mappingView{m: m, kind: kind}, nil
+		}, 0, "D."+name+"() -> a view of the "+name+" of D as they are now")
+	}
+	copyChainMap := func(o py.Object) (py.Object, error) {
+		cm := self(o)
+		first, err := cm.callFirst("copy", nil, "")
+		if err != nil {
+			return nil, err
+		}
+		return newChainMap(cm.typ, append([]py.Object{first}, cm.maps.Items[1:]...)), nil
+	}
+	ChainMapType.Dict["copy"] = py.MustNewMethod("copy", copyChainMap, 0, "New ChainMap or subclass with a new copy of maps[0] and refs to maps[1:]")
+	ChainMapType.Dict["__copy__"] = py.MustNewMethod("__copy__", copyChainMap, 0, "New ChainMap or subclass with a new copy of maps[0] and refs to maps[1:]")
+	ChainMapType.Dict["__reduce__"] = py.MustNewMethod("__reduce__", func(o py.Object) (py.Object, error) {
+		cm := self(o)
+		return py.Tuple{o.Type(), py.Tuple(cm.maps.Items).Copy(), reduceState(cm.dict)}, nil
+	}, 0, "Return state information for pickling")
+	ChainMapType.Dict["pop"] = py.MustNewMethod("pop", func(o py.Object, args py.Tuple) (py.Object, error) {
+		var key, def py.Object
+		err := py.UnpackTuple(args, nil, "pop", 1, 2, &key, &def)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).callFirst("pop", args, notInFirst(key))
+	}, 0, "Remove *key* from maps[0] and return its value. Raise KeyError if *key* not in maps[0].")
+	ChainMapType.Dict["popitem"] = py.MustNewMethod("popitem", func(o py.Object) (py.Object, error) {
+		return self(o).callFirst("popitem", nil, "No keys found in the first mapping.")
+	}, 0, "Remove and return an item pair from maps[0]. Raise KeyError is maps[0] is empty.")
+	ChainMapType.Dict["clear"] = py.MustNewMethod("clear", func(o py.Object) (py.Object, error) {
+		return self(o).callFirst("clear", nil, "")
+	}, 0, "Clear maps[0], leaving maps[1:] intact.")
+	ChainMapType.Dict["update"] = py.MustNewMethod("update", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		cm := self(o)
+		return py.None, updateArgs("update", args, kwargs, func(key, value py.Object) error {
+			_, err := cm.M__setitem__(key, value)
+			return err
+		})
+	}, 0, "D.update([E, ]**F) -> None.  Update maps[0] from dict/iterable E and F.")
+	ChainMapType.Dict["fromkeys"] = newClassMethod("fromkeys", "Create a ChainMap with a single dict created from the iterable.", func(cls *py.Type, args py.Tuple) (py.Object, error) {
+		fromkeys, err := py.GetAttrString(dictType, "fromkeys")
+		if err != nil {
+			return nil, err
+		}
+		d, err := py.Call(fromkeys, args, nil)
+		if err != nil {
+			return nil, err
+		}
+		return newChainMap(cls, []py.Object{d}), nil
+	})
+}
@@ -0,0 +1,142 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package collections implements the python collections module
+//
+// All the types are written in Go.  dicts in gpython can only have
+// str keys and can't be subclassed, so the dict like types here keep
+// their items in their own hash table which accepts any hashable key
+// and remembers insertion order.
+//
+// Classes which subclass the types made here get the Go constructor
+// of their base (see TPFLAGS_INHERIT_NEW) so their instances are
+// proper deques, Counters etc.
+package collections
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/go-python/gpython/py"
+)
+
+const module_doc = `This module implements specialized container datatypes providing
+alternatives to Python's general purpose built-in containers, dict,
+list, set, and tuple.
+
+* namedtuple   factory function for creating tuple subclasses with named fields
+* deque        list-like container with fast appends and pops on either end
+* ChainMap     dict-like class for creating a single view of multiple mappings
+* Counter      dict subclass for counting hashable objects
+* OrderedDict  dict subclass that remembers the order entries were added
+* defaultdict  dict subclass that calls a factory function to supply missing values
+`
+
+// Makes a type for a Go object which python classes can subclass
+func newBaseType(name, doc string, new py.NewFunc) *py.Type {
+	t := py.NewTypeX(name, doc, new, nil)
+	t.Flags |= py.TPFLAGS_BASETYPE | py.TPFLAGS_INHERIT_NEW
+	// Ready it now as py has already readied the types made before it
+	// was initialised
+	err := t.Ready()
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// Returns true if t is a python subclass
+//
+// The Go constructors make empty objects for these and leave filling
+// them in to __init__ so that subclasses can override it.
+func isSubclass(t *py.Type) bool {
+	return t.Flags&py.TPFLAGS_HEAPTYPE != 0
+}
+
+// Adds an __init__ method to t for its python subclasses
+func addInit(t *py.Type, init func(self py.Object, args py.Tuple, kwargs py.StringDict) error) {
+	t.Dict["__init__"] = &Function{
+		Name: "__init__",
+		Doc:  "Initialize self.  See help(type(self)) for accurate signature.",
+		Fn: func(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+			if !self.Type().IsSubtype(t) {
+				return nil, py.ExceptionNewf(py.TypeError, "descriptor '__init__' requires a '%s' object but received a '%s'", t.Name, self.Type().Name)
+			}
+			return py.None, init(self, args, kwargs)
+		},
+	}
+}
+
+// Makes a classmethod which calls fn with the class it was read from
+func newClassMethod(name, doc string, fn func(cls *py.Type, args py.Tuple) (py.Object, error)) *py.ClassMethod {
+	return &py.ClassMethod{
+		Callable: &Function{
+			Name: name,
+			Doc:  doc,
+			Fn: func(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+				if len(kwargs) != 0 {
+					return nil, py.ExceptionNewf(py.TypeError, "%s() takes no keyword arguments", name)
+				}
+				cls, ok := self.(*py.Type)
+				if !ok {
+					return nil, py.ExceptionNewf(py.TypeError, "%s() must be called with a class", name)
+				}
+				return fn(cls, args)
+			},
+		},
+		Dict: py.NewStringDict(),
+	}
+}
+
+// Returns a KeyError for key
+func keyError(key py.Object) error {
+	return &py.Exception{
+		Base: py.KeyError,
+		Args: py.Tuple{key},
+		Dict: py.NewStringDict(),
+	}
+}
+
+// Returns the keys of d sorted as there is no order to a StringDict
+func sortedKeys(d py.StringDict) []string {
+	keys := make([]string, 0, len(d))
+	for key := range d {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Returns the reprs of items joined with ", "
+func joinReprs(items []py.Object) (string, error) {
+	reprs := make([]string, len(items))
+	for i, item := range items {
+		repr, err := py.ReprAsString(item)
+		if err != nil {
+			return "", err
+		}
+		reprs[i] = repr
+	}
+	return strings.Join(reprs, ", "), nil
+}
+
+// Returns true if o is None
+func isNone(o py.Object) bool {
+	return o == nil || o == py.None
+}
+
+func init() {
+	methods := []*py.Method{
+		py.MustNewMethod("namedtuple", collections_namedtuple, 0, namedtuple_doc),
+	}
+	globals := py.StringDict{
+		"deque":       DequeType,
+		"OrderedDict": OrderedDictType,
+		"defaultdict": DefaultDictType,
+		"Counter":     CounterType,
+		"ChainMap":    ChainMapType,
+		"abc":         newAbcModule(),
+	}
+	py.NewModule("collections", module_doc, methods, globals)
+}
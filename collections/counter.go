@@ -0,0 +1,458 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Counter objects
+
+package collections
+
+import (
+	"sort"
+
+	"github.com/go-python/gpython/py"
+)
+
+var CounterType = newBaseType("Counter", `Dict subclass for counting hashable items.  Sometimes called a bag
+or multiset.  Elements are stored as dictionary keys and their counts
+are stored as dictionary values.
+
+>>> c = Counter('abcdeabcdabcaba')  # count elements from a string
+
+>>> c.most_common(3)                # three most common elements
+[('a', 5), ('b', 4), ('c', 3)]
+>>> c['a']                          # count of letter 'a'
+5
+>>> c['z']                          # count of letters not in the Counter
+0`, CounterNew)
+
+// Counter is a dict mapping items to their counts
+type Counter struct {
+	mapping
+}
+
+// Makes a new empty Counter of type t
+func newCounter(t *py.Type) *Counter {
+	return &Counter{mapping: newMapping(t)}
+}
+
+// CounterNew makes a Counter from an iterable or mapping and keyword
+// arguments
+func CounterNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	c := newCounter(metatype)
+	if isSubclass(metatype) {
+		return c, nil
+	}
+	err := c.update("Counter", args, kwargs, py.Add)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Returns the count for key or 0
+func (c *Counter) count(key py.Object) (py.Object, error) {
+	value, ok, err := c.t.get(key)
+	if err != nil || ok {
+		return value, err
+	}
+	return py.Int(0), nil
+}
+
+// Combines the count for key with n using op
+func (c *Counter) combine(key, n py.Object, op func(a, b py.Object) (py.Object, error)) error {
+	value, err := c.count(key)
+	if err != nil {
+		return err
+	}
+	value, err = op(value, n)
+	if err != nil {
+		return err
+	}
+	return c.t.set(key, value)
+}
+
+// Adds (or subtracts with op) counts from a mapping or the elements
+// of an iterable, and keyword arguments
+func (c *Counter) update(name string, args py.Tuple, kwargs py.StringDict, op func(a, b py.Object) (py.Object, error)) error {
+	var arg py.Object = py.None
+	err := py.UnpackTuple(args, nil, name, 0, 1, &arg)
+	if err != nil {
+		return err
+	}
+	add := func(key, n py.Object) error {
+		return c.combine(key, n, op)
+	}
+	if isMapping(arg) {
+		err = eachItem(arg, add)
+	} else if arg != py.None {
+		var innerErr error
+		err = py.Iterate(arg, func(item py.Object) bool {
+			innerErr = add(item, py.Int(1))
+			return innerErr != nil
+		})
+		if err == nil {
+			err = innerErr
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if len(kwargs) > 0 {
+		return eachItem(kwargs, add)
+	}
+	return nil
+}
+
+// Returns true if o should be read as a mapping rather than an
+// iterable of elements
+func isMapping(o py.Object) bool {
+	switch o.(type) {
+	case mapper, py.StringDict:
+		return true
+	}
+	_, err := py.GetAttrString(o, "keys")
+	return err == nil
+}
+
+// Returns the entries from the most common down
+//
+// Entries with equal counts stay in insertion order.
+func (c *Counter) mostCommon() ([]*entry, error) {
+	entries := c.t.entries()
+	var err error
+	sort.SliceStable(entries, func(i, j int) bool {
+		if err != nil {
+			return false
+		}
+		var gt py.Object
+		gt, err = py.Gt(entries[i].value, entries[j].value)
+		return gt == py.True
+	})
+	return entries, err
+}
+
+// Returns true if the count n is > 0
+func isPositive(n py.Object) (bool, error) {
+	gt, err := py.Gt(n, py.Int(0))
+	return gt == py.True, err
+}
+
+// Makes a new Counter with op applied to the counts of a and b,
+// keeping only the positive results
+//
+// Keys only in b are only included if keepB is set.
+func counterBinary(a *Counter, other py.Object, keepB bool, op func(a, b py.Object) (py.Object, error)) (py.Object, error) {
+	b, ok := other.(*Counter)
+	if !ok {
+		return py.NotImplemented, nil
+	}
+	res := newCounter(CounterType)
+	add := func(key py.Object) error {
+		x, err := a.count(key)
+		if err != nil {
+			return err
+		}
+		y, err := b.count(key)
+		if err != nil {
+			return err
+		}
+		n, err := op(x, y)
+		if err != nil {
+			return err
+		}
+		positive, err := isPositive(n)
+		if err != nil || !positive {
+			return err
+		}
+		return res.t.set(key, n)
+	}
+	for _, key := range a.t.keys() {
+		err := add(key)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if keepB {
+		for _, key := range b.t.keys() {
+			if e, _ := a.t.lookup(key); e == nil {
+				err := add(key)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return res, nil
+}
+
+// Returns the larger of a and b
+func maxCount(a, b py.Object) (py.Object, error) {
+	lt, err := py.Lt(a, b)
+	if err != nil {
+		return nil, err
+	}
+	if lt == py.True {
+		return b, nil
+	}
+	return a, nil
+}
+
+// Returns the smaller of a and b
+func minCount(a, b py.Object) (py.Object, error) {
+	lt, err := py.Lt(b, a)
+	if err != nil {
+		return nil, err
+	}
+	if lt == py.True {
+		return b, nil
+	}
+	return a, nil
+}
+
+// Replaces the counts of c with those of the result of a binary
+// operation for the in place operators
+func (c *Counter) inplace(res py.Object, err error) (py.Object, error) {
+	if err != nil || res == py.NotImplemented {
+		return res, err
+	}
+	c.t = res.(*Counter).t
+	return c, nil
+}
+
+// Makes a new Counter from the counts of c passed through op, keeping
+// only the positive results
+func (c *Counter) unary(op func(n py.Object) (py.Object, error)) (py.Object, error) {
+	res := newCounter(CounterType)
+	for _, e := range c.t.entries() {
+		n, err := op(e.value)
+		if err != nil {
+			return nil, err
+		}
+		positive, err := isPositive(n)
+		if err != nil {
+			return nil, err
+		}
+		if positive {
+			err = res.t.set(e.key, n)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return res, nil
+}
+
+func (c *Counter) M__getitem__(key py.Object) (py.Object, error) {
+	return getitem(c, key)
+}
+
+// Deleting a missing count isn't an error
+func (c *Counter) M__delitem__(key py.Object) (py.Object, error) {
+	_, err := c.t.delete(key)
+	if err != nil {
+		return nil, err
+	}
+	return py.None, nil
+}
+
+func (c *Counter) M__repr__() (py.Object, error) {
+	if c.t.len() == 0 {
+		return py.String(c.typ.Name + "()"), nil
+	}
+	entries, err := c.mostCommon()
+	if err != nil {
+		entries = c.t.entries()
+	}
+	sorted := mapping{t: newTable()}
+	for _, e := range entries {
+		_ = sorted.t.set(e.key, e.value)
+	}
+	items, err := sorted.reprItems()
+	if err != nil {
+		return nil, err
+	}
+	return py.String(c.typ.Name + "(" + items + ")"), nil
+}
+
+// Compares with other treating missing counts as zero if it is a
+// Counter
+func (c *Counter) counterEqual(other py.Object) (py.Object, error) {
+	b, ok := other.(*Counter)
+	if !ok {
+		return c.equal(other, false)
+	}
+	for _, pair := range [][2]*Counter{{c, b}, {b, c}} {
+		for _, e := range pair[0].t.entries() {
+			n, err := pair[1].count(e.key)
+			if err != nil {
+				return nil, err
+			}
+			eq, err := py.Eq(e.value, n)
+			if err != nil || eq != py.True {
+				return py.False, err
+			}
+		}
+	}
+	return py.True, nil
+}
+
+func (c *Counter) M__eq__(other py.Object) (py.Object, error) {
+	return c.counterEqual(other)
+}
+
+func (c *Counter) M__ne__(other py.Object) (py.Object, error) {
+	eq, err := c.counterEqual(other)
+	if err != nil || eq == py.NotImplemented {
+		return eq, err
+	}
+	return py.Not(eq)
+}
+
+func (c *Counter) M__add__(other py.Object) (py.Object, error) {
+	return counterBinary(c, other, true, py.Add)
+}
+
+func (c *Counter) M__sub__(other py.Object) (py.Object, error) {
+	return counterBinary(c, other, false, py.Sub)
+}
+
+func (c *Counter) M__or__(other py.Object) (py.Object, error) {
+	return counterBinary(c, other, true, maxCount)
+}
+
+func (c *Counter) M__and__(other py.Object) (py.Object, error) {
+	return counterBinary(c, other, false, minCount)
+}
+
+func (c *Counter) M__iadd__(other py.Object) (py.Object, error) {
+	return c.inplace(c.M__add__(other))
+}
+
+func (c *Counter) M__isub__(other py.Object) (py.Object, error) {
+	return c.inplace(c.M__sub__(other))
+}
+
+func (c *Counter) M__ior__(other py.Object) (py.Object, error) {
+	return c.inplace(c.M__or__(other))
+}
+
+func (c *Counter) M__iand__(other py.Object) (py.Object, error) {
+	return c.inplace(c.M__and__(other))
+}
+
+func (c *Counter) M__pos__() (py.Object, error) {
+	return c.unary(func(n py.Object) (py.Object, error) {
+		return n, nil
+	})
+}
+
+func (c *Counter) M__neg__() (py.Object, error) {
+	return c.unary(func(n py.Object) (py.Object, error) {
+		return py.Sub(py.Int(0), n)
+	})
+}
+
+// Check interface is satisfied
+var _ py.I__getitem__ = (*Counter)(nil)
+var _ py.I__delitem__ = (*Counter)(nil)
+var _ py.I__repr__ = (*Counter)(nil)
+var _ py.I__eq__ = (*Counter)(nil)
+var _ py.I__ne__ = (*Counter)(nil)
+var _ py.I__add__ = (*Counter)(nil)
+var _ py.I__sub__ = (*Counter)(nil)
+var _ py.I__or__ = (*Counter)(nil)
+var _ py.I__and__ = (*Counter)(nil)
+var _ py.I__iadd__ = (*Counter)(nil)
+var _ py.I__isub__ = (*Counter)(nil)
+var _ py.I__ior__ = (*Counter)(nil)
+var _ py.I__iand__ = (*Counter)(nil)
+var _ py.I__pos__ = (*Counter)(nil)
+var _ py.I__neg__ = (*Counter)(nil)
+
+func init() {
+	self := func(o py.Object) *Counter {
+		return o.(*Counter)
+	}
+	addInit(CounterType, func(o py.Object, args py.Tuple, kwargs py.StringDict) error {
+		return self(o).update("Counter", args, kwargs, py.Add)
+	})
+	addMappingMethods(CounterType, func(o py.Object) (py.Object, error) {
+		c := newCounter(o.Type())
+		c.t = self(o).t.copy()
+		return c, nil
+	})
+	CounterType.Dict["fromkeys"] = newClassMethod("fromkeys", "", func(cls *py.Type, args py.Tuple) (py.Object, error) {
+		return nil, py.ExceptionNewf(py.NotImplementedError, "Counter.fromkeys() is undefined.  Use Counter(iterable) instead.")
+	})
+	CounterType.Dict["__missing__"] = py.MustNewMethod("__missing__", func(o, key py.Object) (py.Object, error) {
+		return py.Int(0), nil
+	}, 0, "The count of elements not in the Counter is zero.")
+	CounterType.Dict["update"] = py.MustNewMethod("update", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		return py.None, self(o).update("update", args, kwargs, py.Add)
+	}, 0, `Like dict.update() but add counts instead of replacing them.
+
+Source can be an iterable, a dictionary, or another Counter instance.`)
+	CounterType.Dict["subtract"] = py.MustNewMethod("subtract", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		return py.None, self(o).update("subtract", args, kwargs, py.Sub)
+	}, 0, `Like dict.update() but subtracts counts instead of replacing them.
+Counts can be reduced below zero.  Both the inputs and outputs are
+allowed to contain zero and negative counts.
+
+Source can be an iterable, a dictionary, or another Counter instance.`)
+	CounterType.Dict["most_common"] = py.MustNewMethod("most_common", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		var nObj py.Object = py.None
+		err := py.ParseTupleAndKeywords(args, kwargs, "|O:most_common", []string{"n"}, &nObj)
+		if err != nil {
+			return nil, err
+		}
+		entries, err := self(o).mostCommon()
+		if err != nil {
+			return nil, err
+		}
+		if nObj != py.None {
+			n, err := py.IndexInt(nObj)
+			if err != nil {
+				return nil, err
+			}
+			if n < 0 {
+				n = 0
+			}
+			if n < len(entries) {
+				entries = entries[:n]
+			}
+		}
+		res := make([]py.Object, len(entries))
+		for i, e := range entries {
+			res[i] = py.Tuple{e.key, e.value}
+		}
+		return py.NewListFromItems(res), nil
+	}, 0, `List the n most common elements and their counts from the most
+common to the least.  If n is None, then list all element counts.`)
+	CounterType.Dict["elements"] = py.MustNewMethod("elements", func(o py.Object) (py.Object, error) {
+		var res []py.Object
+		for _, e := range self(o).t.entries() {
+			n, err := py.IndexInt(e.value)
+			if err != nil {
+				return nil, err
+			}
+			for i := 0; i < n; i++ {
+				res = append(res, e.key)
+			}
+		}
+		return py.NewIterator(res), nil
+	}, 0, `Iterator over elements repeating each as many times as its count.
+
+If an element's count has been set to zero or is a negative number,
+elements() will ignore it.`)
+	CounterType.Dict["total"] = py.MustNewMethod("total", func(o py.Object) (py.Object, error) {
+		var total py.Object = py.Int(0)
+		for _, e := range self(o).t.entries() {
+			var err error
+			total, err = py.Add(total, e.value)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return total, nil
+	}, 0, "Sum of the counts")
+}
@@ -0,0 +1,150 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// defaultdict objects
+
+package collections
+
+import (
+	"github.com/go-python/gpython/py"
+)
+
+var DefaultDictType = newBaseType("defaultdict", `defaultdict(default_factory[, ...]) --> dict with default factory
+
+The default factory is called without arguments to produce
+a new value when a key is not present, in __getitem__ only.
+A defaultdict compares equal to a dict with the same items.
+All remaining arguments are treated the same as if they were
+passed to the dict constructor, including keyword arguments.`, DefaultDictNew)
+
+// DefaultDict is a dict which makes values for missing keys
+type DefaultDict struct {
+	mapping
+	factory py.Object // called to make missing values or None
+}
+
+// Makes a new empty defaultdict of type t
+func newDefaultDict(t *py.Type, factory py.Object) *DefaultDict {
+	return &DefaultDict{mapping: newMapping(t), factory: factory}
+}
+
+// Checks factory is callable or None
+func checkFactory(factory py.Object) error {
+	if _, ok := factory.(py.I__call__); !ok && factory != py.None {
+		return py.ExceptionNewf(py.TypeError, "first argument must be callable or None")
+	}
+	return nil
+}
+
+// DefaultDictNew makes a defaultdict from a factory and the dict
+// constructor arguments
+func DefaultDictNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	d := newDefaultDict(metatype, py.None)
+	if isSubclass(metatype) {
+		return d, nil
+	}
+	err := d.init(args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Sets the factory and adds the items from the constructor arguments
+func (d *DefaultDict) init(args py.Tuple, kwargs py.StringDict) error {
+	factory := py.Object(py.None)
+	if len(args) > 0 {
+		factory, args = args[0], args[1:]
+	}
+	err := checkFactory(factory)
+	if err != nil {
+		return err
+	}
+	d.factory = factory
+	return updateArgs("defaultdict", args, kwargs, d.t.set)
+}
+
+func (d *DefaultDict) M__getitem__(key py.Object) (py.Object, error) {
+	return getitem(d, key)
+}
+
+// Makes, stores and returns the value for a missing key
+func (d *DefaultDict) missing(key py.Object) (py.Object, error) {
+	if d.factory == py.None {
+		return nil, keyError(key)
+	}
+	value, err := py.Call(d.factory, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return value, d.t.set(key, value)
+}
+
+func (d *DefaultDict) M__repr__() (py.Object, error) {
+	factory, err := py.ReprAsString(d.factory)
+	if err != nil {
+		return nil, err
+	}
+	items, err := d.reprItems()
+	if err != nil {
+		return nil, err
+	}
+	return py.String(d.typ.Name + "(" + factory + ", " + items + ")"), nil
+}
+
+func (d *DefaultDict) M__eq__(other py.Object) (py.Object, error) {
+	return d.equal(other, false)
+}
+
+func (d *DefaultDict) M__ne__(other py.Object) (py.Object, error) {
+	eq, err := d.equal(other, false)
+	if err != nil || eq == py.NotImplemented {
+		return eq, err
+	}
+	return py.Not(eq)
+}
+
+// Check interface is satisfied
+var _ py.I__getitem__ = (*DefaultDict)(nil)
+var _ py.I__repr__ = (*DefaultDict)(nil)
+var _ py.I__eq__ = (*DefaultDict)(nil)
+var _ py.I__ne__ = (*DefaultDict)(nil)
+
+func init() {
+	self := func(o py.Object) *DefaultDict {
+		return o.(*DefaultDict)
+	}
+	addInit(DefaultDictType, func(o py.Object, args py.Tuple, kwargs py.StringDict) error {
+		return self(o).init(args, kwargs)
+	})
+	addMappingMethods(DefaultDictType, func(o py.Object) (py.Object, error) {
+		d := newDefaultDict(o.Type(), self(o).factory)
+		d.t = self(o).t.copy()
+		return d, nil
+	})
+	DefaultDictType.Dict["__reduce__"] = py.MustNewMethod("__reduce__", func(o py.Object) (py.Object, error) {
+		d := self(o)
+		return py.Tuple{o.Type(), py.Tuple{d.factory}, reduceState(d.dict), py.None, d.iter(iterItems)}, nil
+	}, 0, "Return state information for pickling")
+	DefaultDictType.Dict["default_factory"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return self(o).factory, nil
+		},
+		Fset: func(o, value py.Object) error {
+			err := checkFactory(value)
+			if err != nil {
+				return err
+			}
+			self(o).factory = value
+			return nil
+		},
+		Doc: "Factory for default value called by __missing__().",
+	}
+	DefaultDictType.Dict["__missing__"] = py.MustNewMethod("__missing__", func(o, key py.Object) (py.Object, error) {
+		return self(o).missing(key)
+	}, 0, `__missing__(key) # Called by __getitem__ for missing key; pseudo-code:
+  if self.default_factory is None: raise KeyError((key,))
+  self[key] = value = self.default_factory()
+  return value`)
+}
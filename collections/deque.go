@@ -0,0 +1,636 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// deque objects
+
+package collections
+
+import (
+	"fmt"
+
+	"github.com/go-python/gpython/py"
+)
+
+var DequeType = newBaseType("deque", `deque([iterable[, maxlen]]) --> deque object
+
+A list-like sequence optimized for data accesses near its endpoints.`, DequeNew)
+
+var DequeIteratorType = py.NewType("_collections._deque_iterator", "")
+
+// Deque is a double ended queue kept in a ring buffer
+type Deque struct {
+	typ    *py.Type
+	buf    []py.Object // ring buffer holding the items
+	head   int         // index in buf of the first item
+	n      int         // number of items
+	maxlen int         // maximum number of items or -1 for unbounded
+	state  int         // incremented on every change so iterators can notice
+	dict   py.StringDict
+}
+
+// Type of this object
+func (d *Deque) Type() *py.Type {
+	return d.typ
+}
+
+// Returns the instance dictionary for the attributes of subclasses
+func (d *Deque) GetDict() py.StringDict {
+	return d.dict
+}
+
+// Makes a new empty deque
+func newDeque(t *py.Type, maxlen int) *Deque {
+	return &Deque{typ: t, maxlen: maxlen, dict: py.NewStringDict()}
+}
+
+// DequeNew makes a deque from an iterable
+func DequeNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	d := newDeque(metatype, -1)
+	if isSubclass(metatype) {
+		return d, nil
+	}
+	err := d.init(args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Sets the maxlen and items of the deque from the constructor
+// arguments
+func (d *Deque) init(args py.Tuple, kwargs py.StringDict) error {
+	var iterable py.Object = py.None
+	var maxlenObj py.Object = py.None
+	err := py.ParseTupleAndKeywords(args, kwargs, "|OO:deque", []string{"iterable", "maxlen"}, &iterable, &maxlenObj)
+	if err != nil {
+		return err
+	}
+	maxlen := -1
+	if !isNone(maxlenObj) {
+		maxlen, err = py.IndexInt(maxlenObj)
+		if err != nil {
+			return err
+		}
+		if maxlen < 0 {
+			return py.ExceptionNewf(py.ValueError, "maxlen must be non-negative")
+		}
+	}
+	d.setItems(nil)
+	d.maxlen = maxlen
+	if !isNone(iterable) {
+		return d.extend(iterable)
+	}
+	return nil
+}
+
+// Returns the index in buf of item i
+func (d *Deque) index(i int) int {
+	i += d.head
+	if i >= len(d.buf) {
+		i -= len(d.buf)
+	}
+	return i
+}
+
+// Returns item i which must be in range
+func (d *Deque) at(i int) py.Object {
+	return d.buf[d.index(i)]
+}
+
+// Returns the items in order
+func (d *Deque) items() []py.Object {
+	items := make([]py.Object, d.n)
+	for i := range items {
+		items[i] = d.at(i)
+	}
+	return items
+}
+
+// Replaces the items with items, dropping from the left if there
+// are more than maxlen
+func (d *Deque) setItems(items []py.Object) {
+	if d.maxlen >= 0 && len(items) > d.maxlen {
+		items = items[len(items)-d.maxlen:]
+	}
+	d.buf = make([]py.Object, len(items), len(items)+1)
+	copy(d.buf, items)
+	d.head = 0
+	d.n = len(items)
+	d.state++
+}
+
+// Makes sure there is room for another item
+func (d *Deque) grow() {
+	if d.n < len(d.buf) {
+		return
+	}
+	size := 2 * len(d.buf)
+	if size < 8 {
+		size = 8
+	}
+	buf := make([]py.Object, size)
+	for i := 0; i < d.n; i++ {
+		buf[i] = d.at(i)
+	}
+	d.buf = buf
+	d.head = 0
+}
+
+// Adds x to the right, dropping an item from the left if full
+func (d *Deque) append(x py.Object) {
+	if d.maxlen == 0 {
+		return
+	}
+	if d.n == d.maxlen {
+		d.popleft()
+	}
+	d.grow()
+	d.buf[d.index(d.n)] = x
+	d.n++
+	d.state++
+}
+
+// Adds x to the left, dropping an item from the right if full
+func (d *Deque) appendleft(x py.Object) {
+	if d.maxlen == 0 {
+		return
+	}
+	if d.n == d.maxlen {
+		d.pop()
+	}
+	d.grow()
+	d.head--
+	if d.head < 0 {
+		d.head += len(d.buf)
+	}
+	d.buf[d.head] = x
+	d.n++
+	d.state++
+}
+
+// Removes and returns the rightmost item which must exist
+func (d *Deque) pop() py.Object {
+	d.n--
+	i := d.index(d.n)
+	x := d.buf[i]
+	d.buf[i] = nil
+	d.state++
+	return x
+}
+
+// Removes and returns the leftmost item which must exist
+func (d *Deque) popleft() py.Object {
+	x := d.buf[d.head]
+	d.buf[d.head] = nil
+	d.head = d.index(1)
+	d.n--
+	d.state++
+	return x
+}
+
+// Appends the items of iterable to the right
+func (d *Deque) extend(iterable py.Object) error {
+	if iterable == d {
+		iterable = py.NewListFromItems(d.items())
+	}
+	return py.Iterate(iterable, func(item py.Object) bool {
+		d.append(item)
+		return false
+	})
+}
+
+// Appends the items of iterable to the left
+func (d *Deque) extendleft(iterable py.Object) error {
+	if iterable == d {
+		iterable = py.NewListFromItems(d.items())
+	}
+	return py.Iterate(iterable, func(item py.Object) bool {
+		d.appendleft(item)
+		return false
+	})
+}
+
+// Rotates the deque n steps to the right, or left if n is negative
+func (d *Deque) rotate(n int) {
+	if d.n <= 1 {
+		return
+	}
+	n %= d.n
+	if n < 0 {
+		n += d.n
+	}
+	if n > d.n/2 {
+		for i := n; i < d.n; i++ {
+			d.append(d.popleft())
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			d.appendleft(d.pop())
+		}
+	}
+}
+
+// Returns the index of x in the items from start to stop or -1
+func (d *Deque) find(x py.Object, start, stop int) (int, error) {
+	state := d.state
+	for i := start; i < stop && i < d.n; i++ {
+		eq, err := py.Eq(d.at(i), x)
+		if err != nil {
+			return 0, err
+		}
+		if state != d.state {
+			return 0, py.ExceptionNewf(py.RuntimeError, "deque mutated during iteration")
+		}
+		if eq == py.True {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+// Converts a python index into an index of the items
+func (d *Deque) itemIndex(key py.Object) (int, error) {
+	if _, ok := key.(*py.Slice); ok {
+		return 0, py.ExceptionNewf(py.TypeError, "sequence index must be integer, not 'slice'")
+	}
+	i, err := py.IndexInt(key)
+	if err != nil {
+		return 0, err
+	}
+	if i < 0 {
+		i += d.n
+	}
+	if i < 0 || i >= d.n {
+		return 0, py.ExceptionNewf(py.IndexError, "deque index out of range")
+	}
+	return i, nil
+}
+
+func (d *Deque) M__len__() (py.Object, error) {
+	return py.Int(d.n), nil
+}
+
+func (d *Deque) M__bool__() (py.Object, error) {
+	return py.NewBool(d.n > 0), nil
+}
+
+func (d *Deque) M__getitem__(key py.Object) (py.Object, error) {
+	i, err := d.itemIndex(key)
+	if err != nil {
+		return nil, err
+	}
+	return d.at(i), nil
+}
+
+func (d *Deque) M__setitem__(key, value py.Object) (py.Object, error) {
+	i, err := d.itemIndex(key)
+	if err != nil {
+		return nil, err
+	}
+	d.buf[d.index(i)] = value
+	return py.None, nil
+}
+
+func (d *Deque) M__delitem__(key py.Object) (py.Object, error) {
+	i, err := d.itemIndex(key)
+	if err != nil {
+		return nil, err
+	}
+	items := d.items()
+	d.setItems(append(items[:i], items[i+1:]...))
+	return py.None, nil
+}
+
+func (d *Deque) M__contains__(x py.Object) (py.Object, error) {
+	i, err := d.find(x, 0, d.n)
+	if err != nil {
+		return nil, err
+	}
+	return py.NewBool(i >= 0), nil
+}
+
+func (d *Deque) M__iter__() (py.Object, error) {
+	return &dequeIterator{d: d, state: d.state}, nil
+}
+
+func (d *Deque) M__reversed__() (py.Object, error) {
+	return &dequeIterator{d: d, state: d.state, reverse: true}, nil
+}
+
+func (d *Deque) M__repr__() (py.Object, error) {
+	items, err := joinReprs(d.items())
+	if err != nil {
+		return nil, err
+	}
+	if d.maxlen >= 0 {
+		return py.String(fmt.Sprintf("%s([%s], maxlen=%d)", d.typ.Name, items, d.maxlen)), nil
+	}
+	return py.String(fmt.Sprintf("%s([%s])", d.typ.Name, items)), nil
+}
+
+// Returns a new deque of the same type and maxlen with the items
+func (d *Deque) copy() *Deque {
+	res := newDeque(d.typ, d.maxlen)
+	res.setItems(d.items())
+	return res
+}
+
+func (d *Deque) M__add__(other py.Object) (py.Object, error) {
+	b, ok := other.(*Deque)
+	if !ok {
+		return nil, py.ExceptionNewf(py.TypeError, "can only concatenate deque (not \"%s\") to deque", other.Type().Name)
+	}
+	res := d.copy()
+	err := res.extend(b)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (d *Deque) M__iadd__(other py.Object) (py.Object, error) {
+	err := d.extend(other)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Compares the items of deques a and b with op returning
+// NotImplemented if b isn't a deque
+//
+// The items are compared in order until the first which differ
+// then those are compared with op, otherwise the lengths are.
+func dequeCompare(a *Deque, other py.Object, op func(a, b py.Object) (py.Object, error)) (py.Object, error) {
+	b, ok := other.(*Deque)
+	if !ok {
+		return py.NotImplemented, nil
+	}
+	x, y := a.items(), b.items()
+	for i := 0; i < len(x) && i < len(y); i++ {
+		eq, err := py.Eq(x[i], y[i])
+		if err != nil {
+			return nil, err
+		}
+		if eq != py.True {
+			return op(x[i], y[i])
+		}
+	}
+	return op(py.Int(len(x)), py.Int(len(y)))
+}
+
+func (d *Deque) M__eq__(other py.Object) (py.Object, error) {
+	return dequeCompare(d, other, py.Eq)
+}
+
+func (d *Deque) M__ne__(other py.Object) (py.Object, error) {
+	return dequeCompare(d, other, py.Ne)
+}
+
+func (d *Deque) M__lt__(other py.Object) (py.Object, error) {
+	return dequeCompare(d, other, py.Lt)
+}
+
+func (d *Deque) M__le__(other py.Object) (py.Object, error) {
+	return dequeCompare(d, other, py.Le)
+}
+
+func (d *Deque) M__gt__(other py.Object) (py.Object, error) {
+	return dequeCompare(d, other, py.Gt)
+}
+
+func (d *Deque) M__ge__(other py.Object) (py.Object, error) {
+	return dequeCompare(d, other, py.Ge)
+}
+
+// dequeIterator iterates over a deque forwards or backwards
+type dequeIterator struct {
+	d       *Deque
+	i       int
+	state   int
+	reverse bool
+}
+
+// Type of this object
+func (it *dequeIterator) Type() *py.Type {
+	return DequeIteratorType
+}
+
+func (it *dequeIterator) M__iter__() (py.Object, error) {
+	return it, nil
+}
+
+func (it *dequeIterator) M__next__() (py.Object, error) {
+	if it.state != it.d.state {
+		it.i = it.d.n
+		return nil, py.ExceptionNewf(py.RuntimeError, "deque mutated during iteration")
+	}
+	if it.i >= it.d.n {
+		return nil, py.StopIteration
+	}
+	i := it.i
+	if it.reverse {
+		i = it.d.n - 1 - i
+	}
+	it.i++
+	return it.d.at(i), nil
+}
+
+// Check interface is satisfied
+var _ py.I__len__ = (*Deque)(nil)
+var _ py.I__bool__ = (*Deque)(nil)
+var _ py.I__getitem__ = (*Deque)(nil)
+var _ py.I__setitem__ = (*Deque)(nil)
+var _ py.I__delitem__ = (*Deque)(nil)
+var _ py.I__contains__ = (*Deque)(nil)
+var _ py.I__iter__ = (*Deque)(nil)
+var _ py.I__reversed__ = (*Deque)(nil)
+var _ py.I__repr__ = (*Deque)(nil)
+var _ py.I__add__ = (*Deque)(nil)
+var _ py.I__iadd__ = (*Deque)(nil)
+var _ py.I__eq__ = (*Deque)(nil)
+var _ py.I__ne__ = (*Deque)(nil)
+var _ py.I__lt__ = (*Deque)(nil)
+var _ py.I__le__ = (*Deque)(nil)
+var _ py.I__gt__ = (*Deque)(nil)
+var _ py.I__ge__ = (*Deque)(nil)
+var _ py.I_iterator = (*dequeIterator)(nil)
+
+func init() {
+	self := func(o py.Object) *Deque {
+		return o.(*Deque)
+	}
+	addInit(DequeType, func(o py.Object, args py.Tuple, kwargs py.StringDict) error {
+		return self(o).init(args, kwargs)
+	})
+	DequeType.Dict["maxlen"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			if self(o).maxlen < 0 {
+				return py.None, nil
+			}
+			return py.Int(self(o).maxlen), nil
+		},
+		Doc: "maximum size of a deque or None if unbounded",
+	}
+	DequeType.Dict["append"] = py.MustNewMethod("append", func(o, x py.Object) (py.Object, error) {
+		self(o).append(x)
+		return py.None, nil
+	}, 0, "Add an element to the right side of the deque.")
+	DequeType.Dict["appendleft"] = py.MustNewMethod("appendleft", func(o, x py.Object) (py.Object, error) {
+		self(o).appendleft(x)
+		return py.None, nil
+	}, 0, "Add an element to the left side of the deque.")
+	DequeType.Dict["pop"] = py.MustNewMethod("pop", func(o py.Object) (py.Object, error) {
+		if self(o).n == 0 {
+			return nil, py.ExceptionNewf(py.IndexError, "pop from an empty deque")
+		}
+		return self(o).pop(), nil
+	}, 0, "Remove and return the rightmost element.")
+	DequeType.Dict["popleft"] = py.MustNewMethod("popleft", func(o py.Object) (py.Object, error) {
+		if self(o).n == 0 {
+			return nil, py.ExceptionNewf(py.IndexError, "pop from an empty deque")
+		}
+		return self(o).popleft(), nil
+	}, 0, "Remove and return the leftmost element.")
+	DequeType.Dict["extend"] = py.MustNewMethod("extend", func(o, iterable py.Object) (py.Object, error) {
+		return py.None, self(o).extend(iterable)
+	}, 0, "Extend the right side of the deque with elements from the iterable")
+	DequeType.Dict["extendleft"] = py.MustNewMethod("extendleft", func(o, iterable py.Object) (py.Object, error) {
+		return py.None, self(o).extendleft(iterable)
+	}, 0, "Extend the left side of the deque with elements from the iterable")
+	DequeType.Dict["rotate"] = py.MustNewMethod("rotate", func(o py.Object, args py.Tuple) (py.Object, error) {
+		var nObj py.Object = py.Int(1)
+		err := py.UnpackTuple(args, nil, "rotate", 0, 1, &nObj)
+		if err != nil {
+			return nil, err
+		}
+		n, err := py.IndexInt(nObj)
+		if err != nil {
+			return nil, err
+		}
+		self(o).rotate(n)
+		return py.None, nil
+	}, 0, "Rotate the deque n steps to the right (default n=1).  If n is negative, rotates left.")
+	DequeType.Dict["clear"] = py.MustNewMethod("clear", func(o py.Object) (py.Object, error) {
+		self(o).setItems(nil)
+		return py.None, nil
+	}, 0, "Remove all elements from the deque.")
+	DequeType.Dict["copy"] = py.MustNewMethod("copy", func(o py.Object) (py.Object, error) {
+		return self(o).copy(), nil
+	}, 0, "Return a shallow copy of a deque.")
+	DequeType.Dict["__copy__"] = py.MustNewMethod("__copy__", func(o py.Object) (py.Object, error) {
+		return self(o).copy(), nil
+	}, 0, "Return a shallow copy of a deque.")
+	DequeType.Dict["__reduce__"] = py.MustNewMethod("__reduce__", func(o py.Object) (py.Object, error) {
+		d := self(o)
+		var maxlen py.Object = py.None
+		if d.maxlen >= 0 {
+			maxlen = py.Int(d.maxlen)
+		}
+		return py.Tuple{o.Type(), py.Tuple{py.Tuple{}, maxlen}, reduceState(d.dict), &dequeIterator{d: d, state: d.state}}, nil
+	}, 0, "Return state information for pickling.")
+	DequeType.Dict["count"] = py.MustNewMethod("count", func(o, x py.Object) (py.Object, error) {
+		d := self(o)
+		state := d.state
+		count := 0
+		for i := 0; i < d.n; i++ {
+			eq, err := py.Eq(d.at(i), x)
+			if err != nil {
+				return nil, err
+			}
+			if state != d.state {
+				return nil, py.ExceptionNewf(py.RuntimeError, "deque mutated during iteration")
+			}
+			if eq == py.True {
+				count++
+			}
+		}
+		return py.Int(count), nil
+	}, 0, "D.count(value) -> integer -- return number of occurrences of value")
+	DequeType.Dict["index"] = py.MustNewMethod("index", func(o py.Object, args py.Tuple) (py.Object, error) {
+		d := self(o)
+		var x py.Object
+		var startObj py.Object = py.Int(0)
+		var stopObj py.Object = py.Int(d.n)
+		err := py.UnpackTuple(args, nil, "index", 1, 3, &x, &startObj, &stopObj)
+		if err != nil {
+			return nil, err
+		}
+		var bounds [2]int
+		for j, obj := range []py.Object{startObj, stopObj} {
+			bounds[j], err = py.IndexInt(obj)
+			if err != nil {
+				return nil, err
+			}
+			if bounds[j] < 0 {
+				bounds[j] += d.n
+				if bounds[j] < 0 {
+					bounds[j] = 0
+				}
+			}
+		}
+		i, err := d.find(x, bounds[0], bounds[1])
+		if err != nil {
+			return nil, err
+		}
+		if i < 0 {
+			repr, err := py.ReprAsString(x)
+			if err != nil {
+				return nil, err
+			}
+			return nil, py.ExceptionNewf(py.ValueError, "%s is not in deque", repr)
+		}
+		return py.Int(i), nil
+	}, 0, "D.index(value, [start, [stop]]) -> integer -- return first index of value.\nRaises ValueError if the value is not present.")
+	DequeType.Dict["insert"] = py.MustNewMethod("insert", func(o py.Object, args py.Tuple) (py.Object, error) {
+		d := self(o)
+		var iObj, x py.Object
+		err := py.UnpackTuple(args, nil, "insert", 2, 2, &iObj, &x)
+		if err != nil {
+			return nil, err
+		}
+		i, err := py.IndexInt(iObj)
+		if err != nil {
+			return nil, err
+		}
+		if d.n == d.maxlen {
+			return nil, py.ExceptionNewf(py.IndexError, "deque already at its maximum size")
+		}
+		if i < 0 {
+			i += d.n
+			if i < 0 {
+				i = 0
+			}
+		}
+		if i > d.n {
+			i = d.n
+		}
+		items := d.items()
+		items = append(items[:i], append([]py.Object{x}, items[i:]...)...)
+		d.setItems(items)
+		return py.None, nil
+	}, 0, "D.insert(index, object) -- insert object before index")
+	DequeType.Dict["remove"] = py.MustNewMethod("remove", func(o, x py.Object) (py.Object, error) {
+		d := self(o)
+		i, err := d.find(x, 0, d.n)
+		if err != nil {
+			return nil, err
+		}
+		if i < 0 {
+			return nil, py.ExceptionNewf(py.ValueError, "deque.remove(x): x not in deque")
+		}
+		items := d.items()
+		d.setItems(append(items[:i], items[i+1:]...))
+		return py.None, nil
+	}, 0, "D.remove(value) -- remove first occurrence of value.")
+	DequeType.Dict["reverse"] = py.MustNewMethod("reverse", func(o py.Object) (py.Object, error) {
+		d := self(o)
+		items := d.items()
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+		d.setItems(items)
+		return py.None, nil
+	}, 0, "D.reverse() -- reverse *IN PLACE*")
+}
@@ -0,0 +1,479 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// The parts common to the dict like types
+
+package collections
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-python/gpython/py"
+)
+
+// mapping holds the items of OrderedDict, defaultdict and Counter
+type mapping struct {
+	typ     *py.Type
+	t       *table
+	ordered bool // set for OrderedDict whose views and errors are named differently
+	dict    py.StringDict
+}
+
+// mapper is implemented by the types which embed a mapping
+type mapper interface {
+	py.Object
+	base() *mapping
+}
+
+// Makes a new empty mapping of type t
+func newMapping(t *py.Type) mapping {
+	return mapping{typ: t, t: newTable(), dict: py.NewStringDict()}
+}
+
+// Returns the instance dictionary for the attributes of subclasses
+func (m *mapping) GetDict() py.StringDict {
+	return m.dict
+}
+
+// Type of this object
+func (m *mapping) Type() *py.Type {
+	return m.typ
+}
+
+func (m *mapping) base() *mapping {
+	return m
+}
+
+// Returns the message for an iterator noticing the mapping changed
+func (m *mapping) mutated() string {
+	if m.ordered {
+		return "OrderedDict mutated during iteration"
+	}
+	return "dictionary changed size during iteration"
+}
+
+// Returns an iterator over the keys, values or items
+func (m *mapping) iter(kind iterKind) *tableIterator {
+	return m.t.iter(m.mutated(), kind)
+}
+
+// Returns the item for key, calling __missing__ if the type has one
+// and the key isn't found
+func getitem(self mapper, key py.Object) (py.Object, error) {
+	value, ok, err := self.base().t.get(key)
+	if err != nil || ok {
+		return value, err
+	}
+	if self.Type().NativeGetAttrOrNil("__missing__") != nil {
+		missing, err := py.GetAttrString(self, "__missing__")
+		if err != nil {
+			return nil, err
+		}
+		return py.Call(missing, py.Tuple{key}, nil)
+	}
+	return nil, keyError(key)
+}
+
+func (m *mapping) M__len__() (py.Object, error) {
+	return py.Int(m.t.len()), nil
+}
+
+func (m *mapping) M__bool__() (py.Object, error) {
+	return py.NewBool(m.t.len() > 0), nil
+}
+
+func (m *mapping) M__contains__(key py.Object) (py.Object, error) {
+	e, err := m.t.lookup(key)
+	if err != nil {
+		return nil, err
+	}
+	return py.NewBool(e != nil), nil
+}
+
+func (m *mapping) M__iter__() (py.Object, error) {
+	return m.iter(iterKeys), nil
+}
+
+func (m *mapping) M__setitem__(key, value py.Object) (py.Object, error) {
+	return py.None, m.t.set(key, value)
+}
+
+func (m *mapping) M__delitem__(key py.Object) (py.Object, error) {
+	e, err := m.t.delete(key)
+	if err != nil {
+		return nil, err
+	}
+	if e == nil {
+		return nil, keyError(key)
+	}
+	return py.None, nil
+}
+
+// Returns the "{k: v, ...}" repr of the items
+func (m *mapping) reprItems() (string, error) {
+	var out strings.Builder
+	out.WriteString("{")
+	for i, e := range m.t.entries() {
+		if i > 0 {
+			out.WriteString(", ")
+		}
+		for j, o := range []py.Object{e.key, e.value} {
+			repr, err := py.ReprAsString(o)
+			if err != nil {
+				return "", err
+			}
+			if j > 0 {
+				out.WriteString(": ")
+			}
+			out.WriteString(repr)
+		}
+	}
+	out.WriteString("}")
+	return out.String(), nil
+}
+
+// Compares the items of m with those of other
+//
+// If ordered is set and other is also ordered then the order of the
+// items must match too.  Returns NotImplemented if other isn't a
+// mapping.
+func (m *mapping) equal(other py.Object, ordered bool) (py.Object, error) {
+	var entries []*entry
+	switch b := other.(type) {
+	case mapper:
+		if b.base().t.len() != m.t.len() {
+			return py.False, nil
+		}
+		entries = b.base().t.entries()
+		if ordered && b.base().ordered {
+			for i, e := range m.t.entries() {
+				eq, err := py.Eq(e.key, entries[i].key)
+				if err != nil || eq != py.True {
+					return eq, err
+				}
+			}
+		}
+	case py.StringDict:
+		if len(b) != m.t.len() {
+			return py.False, nil
+		}
+		for key, value := range b {
+			entries = append(entries, &entry{key: py.String(key), value: value})
+		}
+	default:
+		return py.NotImplemented, nil
+	}
+	for _, e := range entries {
+		value, ok, err := m.t.get(e.key)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return py.False, nil
+		}
+		eq, err := py.Eq(value, e.value)
+		if err != nil || eq != py.True {
+			return eq, err
+		}
+	}
+	return py.True, nil
+}
+
+// Calls fn for each key, value pair in a mapping or iterable of pairs
+//
+// This is how update and the constructors read their argument.
+// StringDicts are read in key order as they have no order of their
+// own.
+func eachItem(arg py.Object, fn func(key, value py.Object) error) error {
+	switch x := arg.(type) {
+	case mapper:
+		for _, e := range x.base().t.entries() {
+			err := fn(e.key, e.value)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case py.StringDict:
+		for _, key := range sortedKeys(x) {
+			err := fn(py.String(key), x[key])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if keys, err := py.GetAttrString(arg, "keys"); err == nil {
+		keys, err = py.Call(keys, nil, nil)
+		if err != nil {
+			return err
+		}
+		var innerErr error
+		err = py.Iterate(keys, func(key py.Object) bool {
+			var value py.Object
+			value, innerErr = py.GetItem(arg, key)
+			if innerErr == nil {
+				innerErr = fn(key, value)
+			}
+			return innerErr != nil
+		})
+		if err == nil {
+			err = innerErr
+		}
+		return err
+	}
+	i := 0
+	var innerErr error
+	err := py.Iterate(arg, func(item py.Object) bool {
+		var pair py.Tuple
+		pair, innerErr = py.SequenceTuple(item)
+		if innerErr != nil {
+			innerErr = py.ExceptionNewf(py.TypeError, "cannot convert dictionary update sequence element #%d to a sequence", i)
+		} else if len(pair) != 2 {
+			innerErr = py.ExceptionNewf(py.ValueError, "dictionary update sequence element #%d has length %d; 2 is required", i, len(pair))
+		} else {
+			innerErr = fn(pair[0], pair[1])
+		}
+		i++
+		return innerErr != nil
+	})
+	if err == nil {
+		err = innerErr
+	}
+	return err
+}
+
+// Updates the items from the positional argument and keyword
+// arguments the way dict.update does, setting each with set
+func updateArgs(name string, args py.Tuple, kwargs py.StringDict, set func(key, value py.Object) error) error {
+	var arg py.Object
+	err := py.UnpackTuple(args, nil, name, 0, 1, &arg)
+	if err != nil {
+		return err
+	}
+	if arg != nil {
+		err = eachItem(arg, set)
+		if err != nil {
+			return err
+		}
+	}
+	if len(kwargs) > 0 {
+		return eachItem(kwargs, set)
+	}
+	return nil
+}
+
+// Makes a new empty instance of the mapping type t by calling it
+func newEmpty(t *py.Type) (mapper, error) {
+	res, err := py.Call(t, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := res.(mapper)
+	if !ok {
+		return nil, py.ExceptionNewf(py.TypeError, "%s() didn't return a mapping", t.Name)
+	}
+	return m, nil
+}
+
+// mappingView is what keys, values and items return
+type mappingView struct {
+	m    *mapping
+	kind iterKind
+}
+
+var (
+	KeysViewType   = py.NewType("dict_keys", "")
+	ValuesViewType = py.NewType("dict_values", "")
+	ItemsViewType  = py.NewType("dict_items", "")
+)
+
+// Type of this object
+func (v *mappingView) Type() *py.Type {
+	switch v.kind {
+	case iterValues:
+		return ValuesViewType
+	case iterItems:
+		return ItemsViewType
+	}
+	return KeysViewType
+}
+
+func (v *mappingView) M__len__() (py.Object, error) {
+	return py.Int(v.m.t.len()), nil
+}
+
+func (v *mappingView) M__iter__() (py.Object, error) {
+	return v.m.iter(v.kind), nil
+}
+
+func (v *mappingView) M__contains__(x py.Object) (py.Object, error) {
+	switch v.kind {
+	case iterKeys:
+		return v.m.M__contains__(x)
+	case iterItems:
+		item, ok := x.(py.Tuple)
+		if !ok || len(item) != 2 {
+			return py.False, nil
+		}
+		value, ok, err := v.m.t.get(item[0])
+		if err != nil || !ok {
+			return py.False, err
+		}
+		return py.Eq(value, item[1])
+	}
+	for _, e := range v.m.t.entries() {
+		eq, err := py.Eq(e.value, x)
+		if err != nil || eq == py.True {
+			return eq, err
+		}
+	}
+	return py.False, nil
+}
+
+func (v *mappingView) M__repr__() (py.Object, error) {
+	items, err := py.SequenceList(v.m.iter(v.kind))
+	if err != nil {
+		return nil, err
+	}
+	repr, err := joinReprs(items.Items)
+	if err != nil {
+		return nil, err
+	}
+	name := v.Type().Name
+	if v.m.ordered {
+		name = "o" + name
+	}
+	return py.String(fmt.Sprintf("%s([%s])", name, repr)), nil
+}
+
+// Check interface is satisfied
+var _ py.I__len__ = (*mapping)(nil)
+var _ py.I__bool__ = (*mapping)(nil)
+var _ py.I__contains__ = (*mapping)(nil)
+var _ py.I__iter__ = (*mapping)(nil)
+var _ py.I__setitem__ = (*mapping)(nil)
+var _ py.I__delitem__ = (*mapping)(nil)
+var _ py.I__len__ = (*mappingView)(nil)
+var _ py.I__iter__ = (*mappingView)(nil)
+var _ py.I__contains__ = (*mappingView)(nil)
+var _ py.I__repr__ = (*mappingView)(nil)
+
+// Returns the state __reduce__ reports for an instance dictionary
+//
+// This is None unless a subclass instance has attributes set.
+func reduceState(dict py.StringDict) py.Object {
+	if len(dict) == 0 {
+		return py.None
+	}
+	return dict
+}
+
+// Adds the dict methods to t whose instances must be mappers
+//
+// copy makes a copy of the instance passed in.
+func addMappingMethods(t *py.Type, copy func(o py.Object) (py.Object, error)) {
+	self := func(o py.Object) *mapping {
+		return o.(mapper).base()
+	}
+	t.Dict["keys"] = py.MustNewMethod("keys", func(o py.Object) (py.Object, error) {
+		return &mappingView{m: self(o), kind: iterKeys}, nil
+	}, 0, "D.keys() -> a set-like object providing a view on D's keys")
+	t.Dict["values"] = py.MustNewMethod("values", func(o py.Object) (py.Object, error) {
+		return &mappingView{m: self(o), kind: iterValues}, nil
+	}, 0, "D.values() -> an object providing a view on D's values")
+	t.Dict["items"] = py.MustNewMethod("items", func(o py.Object) (py.Object, error) {
+		return &mappingView{m: self(o), kind: iterItems}, nil
+	}, 0, "D.items() -> a set-like object providing a view on D's items")
+	t.Dict["get"] = py.MustNewMethod("get", func(o py.Object, args py.Tuple) (py.Object, error) {
+		var key py.Object
+		var def py.Object = py.None
+		err := py.UnpackTuple(args, nil, "get", 1, 2, &key, &def)
+		if err != nil {
+			return nil, err
+		}
+		value, ok, err := self(o).t.get(key)
+		if err != nil || ok {
+			return value, err
+		}
+		return def, nil
+	}, 0, "D.get(k[,d]) -> D[k] if k in D, else d.  d defaults to None.")
+	t.Dict["setdefault"] = py.MustNewMethod("setdefault", func(o py.Object, args py.Tuple) (py.Object, error) {
+		var key py.Object
+		var def py.Object = py.None
+		err := py.UnpackTuple(args, nil, "setdefault", 1, 2, &key, &def)
+		if err != nil {
+			return nil, err
+		}
+		m := self(o)
+		value, ok, err := m.t.get(key)
+		if err != nil || ok {
+			return value, err
+		}
+		return def, m.t.set(key, def)
+	}, 0, "D.setdefault(k[,d]) -> D.get(k,d), also set D[k]=d if k not in D")
+	t.Dict["pop"] = py.MustNewMethod("pop", func(o py.Object, args py.Tuple) (py.Object, error) {
+		var key, def py.Object
+		err := py.UnpackTuple(args, nil, "pop", 1, 2, &key, &def)
+		if err != nil {
+			return nil, err
+		}
+		e, err := self(o).t.delete(key)
+		if err != nil {
+			return nil, err
+		}
+		if e == nil {
+			if def == nil {
+				return nil, keyError(key)
+			}
+			return def, nil
+		}
+		return e.value, nil
+	}, 0, "D.pop(k[,d]) -> v, remove specified key and return the corresponding value.\nIf key is not found, d is returned if given, otherwise KeyError is raised")
+	t.Dict["popitem"] = py.MustNewMethod("popitem", func(o py.Object) (py.Object, error) {
+		m := self(o)
+		e := m.t.end(true)
+		if e == nil {
+			return nil, py.ExceptionNewf(py.KeyError, "popitem(): dictionary is empty")
+		}
+		_, _ = m.t.delete(e.key)
+		return py.Tuple{e.key, e.value}, nil
+	}, 0, "D.popitem() -> (k, v), remove and return some (key, value) pair as a\n2-tuple; but raise KeyError if D is empty.")
+	t.Dict["update"] = py.MustNewMethod("update", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		return py.None, updateArgs("update", args, kwargs, self(o).t.set)
+	}, 0, "D.update([E, ]**F) -> None.  Update D from dict/iterable E and F.")
+	t.Dict["clear"] = py.MustNewMethod("clear", func(o py.Object) (py.Object, error) {
+		self(o).t.clear()
+		return py.None, nil
+	}, 0, "D.clear() -> None.  Remove all items from D.")
+	t.Dict["copy"] = py.MustNewMethod("copy", func(o py.Object) (py.Object, error) {
+		return copy(o)
+	}, 0, "D.copy() -> a shallow copy of D")
+	t.Dict["__reduce__"] = py.MustNewMethod("__reduce__", func(o py.Object) (py.Object, error) {
+		m := self(o)
+		return py.Tuple{o.Type(), py.Tuple{}, reduceState(m.dict), py.None, m.iter(iterItems)}, nil
+	}, 0, "Return state information for pickling")
+	t.Dict["fromkeys"] = newClassMethod("fromkeys", "Create a new dictionary with keys from iterable and values set to value.", func(cls *py.Type, args py.Tuple) (py.Object, error) {
+		var iterable py.Object
+		var value py.Object = py.None
+		err := py.UnpackTuple(args, nil, "fromkeys", 1, 2, &iterable, &value)
+		if err != nil {
+			return nil, err
+		}
+		res, err := newEmpty(cls)
+		if err != nil {
+			return nil, err
+		}
+		var innerErr error
+		err = py.Iterate(iterable, func(key py.Object) bool {
+			innerErr = res.base().t.set(key, value)
+			return innerErr != nil
+		})
+		if err == nil {
+			err = innerErr
+		}
+		return res, err
+	})
+}
@@ -0,0 +1,423 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// namedtuple
+
+package collections
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/go-python/gpython/py"
+)
+
+const namedtuple_doc = `namedtuple(typename, field_names, *, rename=False, defaults=None, module=None)
+
+Returns a new subclass of tuple with named fields.
+
+>>> Point = namedtuple('Point', ['x', 'y'])
+>>> Point.__doc__                   # docstring for the new class
+'Point(x, y)'
+>>> p = Point(11, y=22)             # instantiate with positional args or keywords
+>>> p[0] + p[1]                     # indexable like a plain tuple
+33
+>>> x, y = p                        # unpack like a regular tuple
+>>> x, y
+(11, 22)
+>>> p.x + p.y                       # fields also accessible by name
+33
+>>> d = p._asdict()                 # convert to a dictionary
+>>> d['x']
+11
+>>> Point(**d)                      # convert from a dictionary
+Point(x=11, y=22)
+>>> p._replace(x=100)               # _replace() is like str.replace() but targets named fields
+Point(x=100, y=22)`
+
+// The python keywords which can't be field names
+var keywords = map[string]bool{
+	"False": true, "None": true, "True": true, "and": true, "as": true,
+	"assert": true, "async": true, "await": true, "break": true,
+	"class": true, "continue": true, "def": true, "del": true,
+	"elif": true, "else": true, "except": true, "finally": true,
+	"for": true, "from": true, "global": true, "if": true,
+	"import": true, "in": true, "is": true, "lambda": true,
+	"nonlocal": true, "not": true, "or": true, "pass": true,
+	"raise": true, "return": true, "try": true, "while": true,
+	"with": true, "yield": true,
+}
+
+// Returns true if s is a python identifier
+func isIdentifier(s string) bool {
+	for i, r := range s {
+		if !(r == '_' || unicode.IsLetter(r) || (i > 0 && (unicode.IsDigit(r) || unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Mc, r)))) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// NamedTuple is an instance of a class made by namedtuple
+type NamedTuple struct {
+	py.Tuple
+	typ *py.Type
+}
+
+// Type of this object
+func (nt *NamedTuple) Type() *py.Type {
+	return nt.typ
+}
+
+// The fields of a namedtuple class
+type namedTupleFields struct {
+	names    []string
+	defaults py.Tuple // defaults for the rightmost fields
+}
+
+// Makes a new instance of t from the fields in args and kwargs
+func (f *namedTupleFields) new(t *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	n := len(f.names)
+	if len(args) > n {
+		return nil, py.ExceptionNewf(py.TypeError, "__new__() takes %d positional arguments but %d were given", n+1, len(args)+1)
+	}
+	items := make(py.Tuple, n)
+	copy(items, args)
+	for name, value := range kwargs {
+		i := f.index(name)
+		if i < 0 {
+			return nil, py.ExceptionNewf(py.TypeError, "__new__() got an unexpected keyword argument '%s'", name)
+		}
+		if items[i] != nil {
+			return nil, py.ExceptionNewf(py.TypeError, "__new__() got multiple values for argument '%s'", name)
+		}
+		items[i] = value
+	}
+	var missing []string
+	firstDefault := n - len(f.defaults)
+	for i := range items {
+		if items[i] != nil {
+			continue
+		}
+		if i >= firstDefault {
+			items[i] = f.defaults[i-firstDefault]
+		} else {
+			missing = append(missing, "'"+f.names[i]+"'")
+		}
+	}
+	if len(missing) > 0 {
+		var list string
+		switch len(missing) {
+		case 1:
+			list = missing[0]
+		case 2:
+			list = missing[0] + " and " + missing[1]
+		default:
+			list = strings.Join(missing[:len(missing)-1], ", ") + ", and " + missing[len(missing)-1]
+		}
+		plural := "s"
+		if len(missing) == 1 {
+			plural = ""
+		}
+		return nil, py.ExceptionNewf(py.TypeError, "__new__() missing %d required positional argument%s: %s", len(missing), plural, list)
+	}
+	return &NamedTuple{Tuple: items, typ: t}, nil
+}
+
+// Returns the index of the field name or -1
+func (f *namedTupleFields) index(name string) int {
+	for i, field := range f.names {
+		if field == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// Returns the names of the fields from field_names which may be a
+// string of names separated by commas or spaces or an iterable of
+// strings
+func fieldNames(fieldNamesObj py.Object) ([]string, error) {
+	var names []string
+	if s, ok := fieldNamesObj.(py.String); ok {
+		names = strings.Fields(strings.Replace(string(s), ",", " ", -1))
+		return names, nil
+	}
+	var innerErr error
+	err := py.Iterate(fieldNamesObj, func(item py.Object) bool {
+		s, ok := item.(py.String)
+		if !ok {
+			innerErr = py.ExceptionNewf(py.TypeError, "Type names and field names must be strings")
+			return true
+		}
+		names = append(names, string(s))
+		return false
+	})
+	if err == nil {
+		err = innerErr
+	}
+	return names, err
+}
+
+// Checks the type name and field names are valid, renaming the bad
+// field names if rename is set
+func checkNames(typename string, names []string, rename bool) error {
+	if rename {
+		seen := map[string]bool{}
+		for i, name := range names {
+			if !isIdentifier(name) || keywords[name] || strings.HasPrefix(name, "_") || seen[name] {
+				names[i] = fmt.Sprintf("_%d", i)
+			}
+			seen[name] = true
+		}
+	}
+	for i, name := range append([]string{typename}, names...) {
+		if !isIdentifier(name) {
+			return py.ExceptionNewf(py.ValueError, "Type names and field names must be valid identifiers: '%s'", name)
+		}
+		if keywords[name] {
+			return py.ExceptionNewf(py.ValueError, "Type names and field names cannot be a keyword: '%s'", name)
+		}
+		if i == 0 {
+			continue
+		}
+		if strings.HasPrefix(name, "_") && !rename {
+			return py.ExceptionNewf(py.ValueError, "Field names cannot start with an underscore: '%s'", name)
+		}
+		for _, other := range names[:i-1] {
+			if other == name {
+				return py.ExceptionNewf(py.ValueError, "Encountered duplicate field name: '%s'", name)
+			}
+		}
+	}
+	return nil
+}
+
+func collections_namedtuple(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var typenameObj, fieldNamesObj py.Object
+	var rename py.Object = py.False
+	var defaultsObj py.Object = py.None
+	var module py.Object = py.None
+	// The arguments after field_names are keyword only
+	keywordOnly := map[string]*py.Object{
+		"rename":   &rename,
+		"defaults": &defaultsObj,
+		"module":   &module,
+	}
+	rest := py.StringDict{}
+	for name, value := range kwargs {
+		if result, ok := keywordOnly[name]; ok {
+			*result = value
+		} else {
+			rest[name] = value
+		}
+	}
+	err := py.ParseTupleAndKeywords(args, rest, "UO:namedtuple", []string{"typename", "field_names"}, &typenameObj, &fieldNamesObj)
+	if err != nil {
+		return nil, err
+	}
+	typename := string(typenameObj.(py.String))
+	names, err := fieldNames(fieldNamesObj)
+	if err != nil {
+		return nil, err
+	}
+	renameOn, err := py.MakeBool(rename)
+	if err != nil {
+		return nil, err
+	}
+	err = checkNames(typename, names, renameOn == py.True)
+	if err != nil {
+		return nil, err
+	}
+	var defaults py.Tuple
+	if defaultsObj != py.None {
+		defaults, err = py.SequenceTuple(defaultsObj)
+		if err != nil {
+			return nil, err
+		}
+		if len(defaults) > len(names) {
+			return nil, py.ExceptionNewf(py.TypeError, "Got more default values than field names")
+		}
+	}
+	return newNamedTupleType(typename, names, defaults, module)
+}
+
+// NewNamedTuple makes a namedtuple class with the field names given
+// as collections.namedtuple(typename, names) does
+func NewNamedTuple(typename string, names ...string) (*py.Type, error) {
+	err := checkNames(typename, names, false)
+	if err != nil {
+		return nil, err
+	}
+	return newNamedTupleType(typename, names, nil, py.None)
+}
+
+// Makes the class for a namedtuple
+func newNamedTupleType(typename string, names []string, defaults py.Tuple, module py.Object) (*py.Type, error) {
+	fields := &namedTupleFields{names: names, defaults: defaults}
+	t := &py.Type{
+		ObjectType: py.TypeType,
+		Name:       typename,
+		Doc:        typename + "(" + strings.Join(names, ", ") + ")",
+		New: func(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+			return fields.new(metatype, args, kwargs)
+		},
+		Flags: py.TPFLAGS_BASETYPE | py.TPFLAGS_INHERIT_NEW,
+		Dict:  py.StringDict{},
+		Base:  py.TupleType,
+		Bases: py.Tuple{py.TupleType},
+	}
+	err := t.Ready()
+	if err != nil {
+		return nil, err
+	}
+
+	self := func(o py.Object) *NamedTuple {
+		return o.(*NamedTuple)
+	}
+	fieldsTuple := make(py.Tuple, len(names))
+	for i, name := range names {
+		i := i
+		fieldsTuple[i] = py.String(name)
+		t.Dict[name] = &py.Property{
+			Fget: func(o py.Object) (py.Object, error) {
+				return self(o).Tuple[i], nil
+			},
+			Doc: fmt.Sprintf("Alias for field number %d", i),
+		}
+	}
+	fieldDefaults := py.NewStringDict()
+	for i, value := range defaults {
+		fieldDefaults[names[len(names)-len(defaults)+i]] = value
+	}
+	t.Dict["_fields"] = fieldsTuple
+	t.Dict["_field_defaults"] = fieldDefaults
+	if module != py.None {
+		t.Dict["__module__"] = module
+	}
+	t.Dict["_make"] = newClassMethod("_make", "Make a new "+typename+" object from a sequence or iterable", func(cls *py.Type, args py.Tuple) (py.Object, error) {
+		var iterable py.Object
+		err := py.UnpackTuple(args, nil, "_make", 1, 1, &iterable)
+		if err != nil {
+			return nil, err
+		}
+		items, err := py.SequenceTuple(iterable)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) != len(names) {
+			return nil, py.ExceptionNewf(py.TypeError, "Expected %d arguments, got %d", len(names), len(items))
+		}
+		return &NamedTuple{Tuple: items, typ: cls}, nil
+	})
+	t.Dict["_replace"] = py.MustNewMethod("_replace", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		if len(args) > 0 {
+			return nil, py.ExceptionNewf(py.TypeError, "_replace() takes 1 positional argument but %d were given", len(args)+1)
+		}
+		nt := self(o)
+		items := nt.Tuple.Copy()
+		var unexpected []py.Object
+		for _, name := range sortedKeys(kwargs) {
+			i := fields.index(name)
+			if i < 0 {
+				unexpected = append(unexpected, py.String(name))
+				continue
+			}
+			items[i] = kwargs[name]
+		}
+		if len(unexpected) > 0 {
+			repr, err := joinReprs(unexpected)
+			if err != nil {
+				return nil, err
+			}
+			return nil, py.ExceptionNewf(py.ValueError, "Got unexpected field names: [%s]", repr)
+		}
+		return &NamedTuple{Tuple: items, typ: nt.typ}, nil
+	}, 0, "Return a new "+typename+" object replacing specified fields with new values")
+	t.Dict["_asdict"] = py.MustNewMethod("_asdict", func(o py.Object) (py.Object, error) {
+		d := newOrderedDict(OrderedDictType)
+		for i, name := range names {
+			_ = d.t.set(py.String(name), self(o).Tuple[i])
+		}
+		return d, nil
+	}, 0, "Return a new OrderedDict which maps field names to their values.")
+	t.Dict["__getnewargs__"] = py.MustNewMethod("__getnewargs__", func(o py.Object) (py.Object, error) {
+		return self(o).Tuple.Copy(), nil
+	}, 0, "Return self as a plain tuple.  Used by copy and pickle.")
+	return t, nil
+}
+
+func (nt *NamedTuple) M__repr__() (py.Object, error) {
+	names := nt.typ.NativeGetAttrOrNil("_fields")
+	fields, _ := names.(py.Tuple)
+	var out strings.Builder
+	out.WriteString(nt.typ.Name)
+	out.WriteString("(")
+	for i, value := range nt.Tuple {
+		if i > 0 {
+			out.WriteString(", ")
+		}
+		if i < len(fields) {
+			out.WriteString(string(fields[i].(py.String)))
+			out.WriteString("=")
+		}
+		repr, err := py.ReprAsString(value)
+		if err != nil {
+			return nil, err
+		}
+		out.WriteString(repr)
+	}
+	out.WriteString(")")
+	return py.String(out.String()), nil
+}
+
+func (nt *NamedTuple) M__str__() (py.Object, error) {
+	return nt.M__repr__()
+}
+
+// Returns the tuple in other if it is a tuple or namedtuple
+func asTuple(other py.Object) (py.Tuple, bool) {
+	switch x := other.(type) {
+	case py.Tuple:
+		return x, true
+	case *NamedTuple:
+		return x.Tuple, true
+	}
+	return nil, false
+}
+
+func (nt *NamedTuple) M__eq__(other py.Object) (py.Object, error) {
+	b, ok := asTuple(other)
+	if !ok {
+		return py.NotImplemented, nil
+	}
+	return nt.Tuple.M__eq__(b)
+}
+
+func (nt *NamedTuple) M__ne__(other py.Object) (py.Object, error) {
+	b, ok := asTuple(other)
+	if !ok {
+		return py.NotImplemented, nil
+	}
+	return nt.Tuple.M__ne__(b)
+}
+
+func (nt *NamedTuple) M__add__(other py.Object) (py.Object, error) {
+	b, ok := asTuple(other)
+	if !ok {
+		return py.NotImplemented, nil
+	}
+	return nt.Tuple.M__add__(b)
+}
+
+// Check interface is satisfied
+var _ py.I__repr__ = (*NamedTuple)(nil)
+var _ py.I__str__ = (*NamedTuple)(nil)
+var _ py.I__eq__ = (*NamedTuple)(nil)
+var _ py.I__ne__ = (*NamedTuple)(nil)
+var _ py.I__add__ = (*NamedTuple)(nil)
+var _ py.I__getitem__ = (*NamedTuple)(nil)
+var _ py.I__len__ = (*NamedTuple)(nil)
+var _ py.I__iter__ = (*NamedTuple)(nil)
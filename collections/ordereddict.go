@@ -0,0 +1,137 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// OrderedDict objects
+
+package collections
+
+import (
+	"github.com/go-python/gpython/py"
+)
+
+var OrderedDictType = newBaseType("OrderedDict", "Dictionary that remembers insertion order", OrderedDictNew)
+
+// OrderedDict is a dict which remembers the order keys were added in
+type OrderedDict struct {
+	mapping
+}
+
+// Makes a new empty OrderedDict of type t
+func newOrderedDict(t *py.Type) *OrderedDict {
+	d := &OrderedDict{mapping: newMapping(t)}
+	d.ordered = true
+	return d
+}
+
+// OrderedDictNew makes an OrderedDict from a mapping or iterable of
+// pairs and keyword arguments
+func OrderedDictNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	d := newOrderedDict(metatype)
+	if isSubclass(metatype) {
+		return d, nil
+	}
+	err := d.init(args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Adds the items from the constructor arguments
+func (d *OrderedDict) init(args py.Tuple, kwargs py.StringDict) error {
+	return updateArgs("OrderedDict", args, kwargs, d.t.set)
+}
+
+func (d *OrderedDict) M__getitem__(key py.Object) (py.Object, error) {
+	return getitem(d, key)
+}
+
+func (d *OrderedDict) M__reversed__() (py.Object, error) {
+	keys := d.t.keys()
+	for i, j := 0, len(keys)-1; i < j; i, j = i+1, j-1 {
+		keys[i], keys[j] = keys[j], keys[i]
+	}
+	return py.NewIterator(keys), nil
+}
+
+func (d *OrderedDict) M__repr__() (py.Object, error) {
+	if d.t.len() == 0 {
+		return py.String(d.typ.Name + "()"), nil
+	}
+	items := make([]py.Object, 0, d.t.len())
+	for _, e := range d.t.entries() {
+		items = append(items, py.Tuple{e.key, e.value})
+	}
+	repr, err := joinReprs(items)
+	if err != nil {
+		return nil, err
+	}
+	return py.String(d.typ.Name + "([" + repr + "])"), nil
+}
+
+// Comparison to another OrderedDict is order sensitive
+func (d *OrderedDict) M__eq__(other py.Object) (py.Object, error) {
+	return d.equal(other, true)
+}
+
+func (d *OrderedDict) M__ne__(other py.Object) (py.Object, error) {
+	eq, err := d.equal(other, true)
+	if err != nil || eq == py.NotImplemented {
+		return eq, err
+	}
+	return py.Not(eq)
+}
+
+// Check interface is satisfied
+var _ py.I__getitem__ = (*OrderedDict)(nil)
+var _ py.I__reversed__ = (*OrderedDict)(nil)
+var _ py.I__repr__ = (*OrderedDict)(nil)
+var _ py.I__eq__ = (*OrderedDict)(nil)
+var _ py.I__ne__ = (*OrderedDict)(nil)
+
+func init() {
+	self := func(o py.Object) *OrderedDict {
+		return o.(*OrderedDict)
+	}
+	addInit(OrderedDictType, func(o py.Object, args py.Tuple, kwargs py.StringDict) error {
+		return self(o).init(args, kwargs)
+	})
+	addMappingMethods(OrderedDictType, func(o py.Object) (py.Object, error) {
+		d := newOrderedDict(o.Type())
+		d.t = self(o).t.copy()
+		return d, nil
+	})
+	OrderedDictType.Dict["popitem"] = py.MustNewMethod("popitem", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		var last py.Object = py.True
+		err := py.ParseTupleAndKeywords(args, kwargs, "|O:popitem", []string{"last"}, &last)
+		if err != nil {
+			return nil, err
+		}
+		d := self(o)
+		e := d.t.end(last == py.True)
+		if e == nil {
+			return nil, py.ExceptionNewf(py.KeyError, "dictionary is empty")
+		}
+		_, _ = d.t.delete(e.key)
+		return py.Tuple{e.key, e.value}, nil
+	}, 0, "Remove and return a (key, value) pair from the dictionary.\n\nPairs are returned in LIFO order if last is true or FIFO order if false.")
+	OrderedDictType.Dict["move_to_end"] = py.MustNewMethod("move_to_end", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		var key py.Object
+		var last py.Object = py.True
+		err := py.ParseTupleAndKeywords(args, kwargs, "O|O:move_to_end", []string{"key", "last"}, &key, &last)
+		if err != nil {
+			return nil, err
+		}
+		d := self(o)
+		e, err := d.t.lookup(key)
+		if err != nil {
+			return nil, err
+		}
+		if e == nil {
+			return nil, keyError(key)
+		}
+		d.t.move(e, last == py.True)
+		return py.None, nil
+	}, 0, "Move an existing element to the end (or beginning if last is false).\n\nRaise KeyError if the element does not exist.")
+}
@@ -0,0 +1,308 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Insertion ordered hash table with python keys
+
+package collections
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/go-python/gpython/py"
+)
+
+// Keys of the hash table for objects which aren't used as they are
+type (
+	bigKey   string // an int too big for py.Int
+	bytesKey string
+	tupleKey string
+)
+
+// Returns a Go map key for the python object o
+//
+// Objects which compare equal in python give the same key, so 1, 1.0
+// and True are all the same key.  Objects without a value based key
+// are compared by identity.
+func hashKey(o py.Object) (interface{}, error) {
+	switch x := o.(type) {
+	case py.String, py.Int, py.NoneType:
+		return x, nil
+	case py.Bool:
+		if x {
+			return py.Int(1), nil
+		}
+		return py.Int(0), nil
+	case *py.BigInt:
+		if i, err := x.Int(); err == nil {
+			return i, nil
+		}
+		return bigKey((*big.Int)(x).String()), nil
+	case py.Float:
+		f := float64(x)
+		if f != math.Trunc(f) || math.IsInf(f, 0) {
+			return x, nil
+		}
+		if math.Abs(f) < 1<<63 {
+			return py.Int(f), nil
+		}
+		i, _ := big.NewFloat(f).Int(nil)
+		return bigKey(i.String()), nil
+	case py.Complex:
+		if imag(x) == 0 {
+			return hashKey(py.Float(real(x)))
+		}
+		return x, nil
+	case py.Bytes:
+		return bytesKey(x), nil
+	case py.Tuple:
+		keys := make([]string, len(x))
+		for i, item := range x {
+			key, err := hashKey(item)
+			if err != nil {
+				return nil, err
+			}
+			keys[i] = itemKey(key)
+		}
+		return tupleKey(strings.Join(keys, ",")), nil
+	case *NamedTuple:
+		return hashKey(x.Tuple)
+	case *py.List, *py.Set, py.StringDict, *Deque, *OrderedDict, *DefaultDict, *Counter, *ChainMap, *dict:
+		return nil, unhashable(o)
+	}
+	if !reflect.TypeOf(o).Comparable() {
+		return nil, unhashable(o)
+	}
+	return o, nil
+}
+
+// Returns a string identifying key as an item of a tuple key
+//
+// Keys which are pointers are identified by address so they don't
+// depend on the contents of the object they point to.
+func itemKey(key interface{}) string {
+	if reflect.TypeOf(key).Kind() == reflect.Ptr {
+		return fmt.Sprintf("%T%p", key, key)
+	}
+	return fmt.Sprintf("%T%#v", key, key)
+}
+
+// Returns the error for an object which can't be a key
+func unhashable(o py.Object) error {
+	return py.ExceptionNewf(py.TypeError, "unhashable type: '%s'", o.Type().Name)
+}
+
+// An entry in the table
+type entry struct {
+	key, value py.Object
+	prev, next *entry
+}
+
+// table is a hash table which remembers the order its keys were
+// added in
+type table struct {
+	index   map[interface{}]*entry
+	root    entry // sentinel of the circular list of entries
+	changes int   // incremented whenever the keys or their order change
+}
+
+// Makes a new empty table
+func newTable() *table {
+	t := &table{}
+	t.clear()
+	return t
+}
+
+// Removes all the entries
+func (t *table) clear() {
+	t.index = make(map[interface{}]*entry)
+	t.root.next = &t.root
+	t.root.prev = &t.root
+	t.changes++
+}
+
+// Returns the number of entries
+func (t *table) len() int {
+	return len(t.index)
+}
+
+// Returns the entry for key or nil if not found
+func (t *table) lookup(key py.Object) (*entry, error) {
+	k, err := hashKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return t.index[k], nil
+}
+
+// Returns the value for key and whether it was found
+func (t *table) get(key py.Object) (py.Object, bool, error) {
+	e, err := t.lookup(key)
+	if err != nil || e == nil {
+		return nil, false, err
+	}
+	return e.value, true, nil
+}
+
+// Sets key to value, adding it at the end if it is new
+func (t *table) set(key, value py.Object) error {
+	k, err := hashKey(key)
+	if err != nil {
+		return err
+	}
+	if e, ok := t.index[k]; ok {
+		e.value = value
+		return nil
+	}
+	e := &entry{key: key, value: value}
+	t.index[k] = e
+	t.link(e, t.root.prev)
+	t.changes++
+	return nil
+}
+
+// Removes key returning its entry or nil if not found
+func (t *table) delete(key py.Object) (*entry, error) {
+	k, err := hashKey(key)
+	if err != nil {
+		return nil, err
+	}
+	e, ok := t.index[k]
+	if !ok {
+		return nil, nil
+	}
+	delete(t.index, k)
+	t.unlink(e)
+	t.changes++
+	return e, nil
+}
+
+// Inserts e into the list after at
+func (t *table) link(e, at *entry) {
+	e.prev = at
+	e.next = at.next
+	at.next.prev = e
+	at.next = e
+}
+
+// Removes e from the list
+func (t *table) unlink(e *entry) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+}
+
+// Moves e to the end of the list if last is set otherwise to the start
+func (t *table) move(e *entry, last bool) {
+	t.unlink(e)
+	if last {
+		t.link(e, t.root.prev)
+	} else {
+		t.link(e, &t.root)
+	}
+	t.changes++
+}
+
+// Returns the first entry, or the last if last is set, or nil if empty
+func (t *table) end(last bool) *entry {
+	e := t.root.next
+	if last {
+		e = t.root.prev
+	}
+	if e == &t.root {
+		return nil
+	}
+	return e
+}
+
+// Returns the entries in order
+func (t *table) entries() []*entry {
+	res := make([]*entry, 0, t.len())
+	for e := t.root.next; e != &t.root; e = e.next {
+		res = append(res, e)
+	}
+	return res
+}
+
+// Returns the keys in order
+func (t *table) keys() []py.Object {
+	res := make([]py.Object, 0, t.len())
+	for e := t.root.next; e != &t.root; e = e.next {
+		res = append(res, e.key)
+	}
+	return res
+}
+
+// Returns a copy of the table
+func (t *table) copy() *table {
+	res := newTable()
+	for e := t.root.next; e != &t.root; e = e.next {
+		_ = res.set(e.key, e.value)
+	}
+	return res
+}
+
+// What a tableIterator returns
+type iterKind int
+
+const (
+	iterKeys iterKind = iota
+	iterValues
+	iterItems
+)
+
+var TableIteratorType = py.NewType("odict_iterator", "")
+
+// tableIterator iterates over the keys, values or items of a table
+type tableIterator struct {
+	t       *table
+	mutated string // error message if t changes during iteration
+	kind    iterKind
+	next    *entry
+	changes int
+}
+
+// Returns an iterator over t
+func (t *table) iter(mutated string, kind iterKind) *tableIterator {
+	return &tableIterator{
+		t:       t,
+		mutated: mutated,
+		kind:    kind,
+		next:    t.root.next,
+		changes: t.changes,
+	}
+}
+
+// Type of this object
+func (it *tableIterator) Type() *py.Type {
+	return TableIteratorType
+}
+
+func (it *tableIterator) M__iter__() (py.Object, error) {
+	return it, nil
+}
+
+func (it *tableIterator) M__next__() (py.Object, error) {
+	if it.changes != it.t.changes {
+		it.next = &it.t.root
+		return nil, py.ExceptionNewf(py.RuntimeError, "%s", it.mutated)
+	}
+	e := it.next
+	if e == &it.t.root {
+		return nil, py.StopIteration
+	}
+	it.next = e.next
+	switch it.kind {
+	case iterValues:
+		return e.value, nil
+	case iterItems:
+		return py.Tuple{e.key, e.value}, nil
+	}
+	return e.key, nil
+}
+
+// Check interface is satisfied
+var _ py.I_iterator = (*tableIterator)(nil)
@@ -0,0 +1,171 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+import collections.abc
+from collections import OrderedDict
+from collections.abc import Mapping, MutableMapping, Sequence, MutableSequence, Set, MutableSet, Iterator
+from libtest import assertRaises
+
+doc = "module"
+assert collections.abc.Mapping is Mapping
+assert collections.abc.Sized is not None
+assert collections.abc.Hashable is not None
+
+doc = "Mapping"
+class ReadOnly(Mapping):
+    def __init__(self, d):
+        self.d = d
+    def __getitem__(self, key):
+        return self.d[key]
+    def __len__(self):
+        return len(self.d)
+    def __iter__(self):
+        return iter(self.d)
+m = ReadOnly({"a": 1})
+assert m.get("a") == 1 and m.get("z") is None and m.get("z", 9) == 9
+assert "a" in m and "z" not in m
+assert list(m.keys()) == ["a"]
+assert list(m.values()) == [1]
+assert list(m.items()) == [("a", 1)]
+assert m == {"a": 1}
+assert m != {"a": 2}
+
+doc = "MutableMapping"
+class Store(MutableMapping):
+    def __init__(self):
+        self.d = OrderedDict()
+    def __getitem__(self, key):
+        return self.d[key]
+    def __setitem__(self, key, value):
+        self.d[key] = value
+    def __delitem__(self, key):
+        del self.d[key]
+    def __len__(self):
+        return len(self.d)
+    def __iter__(self):
+        return iter(self.d)
+s = Store()
+s.update({"a": 1}, b=2)
+assert len(s) == 2 and s["b"] == 2
+assert s.setdefault("c", 3) == 3 and s["c"] == 3
+assert s.pop("c") == 3 and s.pop("c", 4) == 4
+assertRaises(KeyError, s.pop, "c")
+key, value = s.popitem()
+assert len(s) == 1
+s.clear()
+assert len(s) == 0
+assertRaises(KeyError, s.popitem)
+
+doc = "Sequence"
+class Evens(Sequence):
+    def __getitem__(self, i):
+        if i >= 3:
+            raise IndexError("out of range")
+        return i * 2
+    def __len__(self):
+        return 3
+e = Evens()
+assert list(e) == [0, 2, 4]
+assert list(e.__reversed__()) == [4, 2, 0]
+assert 2 in e and 3 not in e
+assert e.index(4) == 2 and e.count(2) == 1
+assertRaises(ValueError, e.index, 5)
+
+doc = "MutableSequence"
+class Items(MutableSequence):
+    def __init__(self):
+        self.items = []
+    def __getitem__(self, i):
+        return self.items[i]
+    def __setitem__(self, i, value):
+        self.items[i] = value
+    def __delitem__(self, i):
+        del self.items[i]
+    def __len__(self):
+        return len(self.items)
+    def insert(self, i, value):
+        self.items = self.items[:i] + [value] + self.items[i:]
+l = Items()
+l.append(1)
+l.extend([2, 3])
+assert l.items == [1, 2, 3]
+l.reverse()
+assert l.items == [3, 2, 1]
+assert l.pop() == 1 and l.pop(0) == 3
+l.remove(2)
+assert len(l) == 0
+l += [4, 5]
+assert l.items == [4, 5]
+l.clear()
+assert l.items == []
+
+doc = "Set"
+class Letters(Set):
+    def __init__(self, letters):
+        self.letters = list(letters)
+    def __contains__(self, x):
+        return x in self.letters
+    def __len__(self):
+        return len(self.letters)
+    def __iter__(self):
+        return iter(self.letters)
+a = Letters("abc")
+b = Letters("bc")
+assert b <= a and b < a and a >= b and a > b
+assert not a <= b
+assert a == Letters("cab")
+assert a != b
+assert a.isdisjoint(Letters("xy")) and not a.isdisjoint(b)
+assert len(a & b) == 2 and "b" in (a & b) and "c" in (a & b)
+assert len(a | Letters("xy")) == 5
+assert list(a - b) == ["a"]
+assert len(a ^ Letters("cd")) == 3
+
+doc = "MutableSet"
+class Bag(MutableSet):
+    def __init__(self, items=()):
+        self.items = list(items)
+    def __contains__(self, x):
+        return x in self.items
+    def __len__(self):
+        return len(self.items)
+    def __iter__(self):
+        return iter(self.items)
+    def add(self, x):
+        if x not in self.items:
+            self.items.append(x)
+    def discard(self, x):
+        items = []
+        for y in self.items:
+            if y != x:
+                items.append(y)
+        self.items = items
+s = Bag([1, 2])
+s.add(3)
+s.remove(1)
+assertRaises(KeyError, s.remove, 1)
+assert s.items == [2, 3]
+s |= [4]
+assert s.items == [2, 3, 4]
+s -= [2]
+assert s.items == [3, 4]
+assert s.pop() == 3
+s.clear()
+assert len(s) == 0
+assertRaises(KeyError, s.pop)
+
+doc = "Iterator"
+class Count(Iterator):
+    def __init__(self):
+        self.n = 0
+    def __next__(self):
+        self.n += 1
+        if self.n > 3:
+            raise StopIteration
+        return self.n
+c = Count()
+assert c.__iter__() is c
+assert list(c) == [1, 2, 3]
+
+doc = "finished"
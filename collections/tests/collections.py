@@ -0,0 +1,249 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+from collections import deque, OrderedDict, defaultdict, Counter, namedtuple, ChainMap
+from libtest import assertRaises, assertRaisesText
+
+doc = "deque"
+d = deque([1, 2, 3])
+d.append(4)
+d.appendleft(0)
+assert list(d) == [0, 1, 2, 3, 4]
+assert len(d) == 5 and d[0] == 0 and d[-1] == 4
+assert d.pop() == 4 and d.popleft() == 0
+assert repr(d) == "deque([1, 2, 3])"
+d.extend([4, 5])
+d.extendleft([0, -1])
+assert list(d) == [-1, 0, 1, 2, 3, 4, 5]
+d.rotate(2)
+assert list(d) == [4, 5, -1, 0, 1, 2, 3]
+d.rotate(-3)
+assert list(d) == [0, 1, 2, 3, 4, 5, -1]
+d[0] = 10
+del d[-1]
+assert list(d) == [10, 1, 2, 3, 4, 5]
+assert 3 in d and 7 not in d
+assert d.index(3) == 3 and d.count(1) == 1
+d.insert(1, 9)
+d.remove(9)
+d.reverse()
+assert list(d) == [5, 4, 3, 2, 1, 10]
+assert list(d.__reversed__()) == [10, 1, 2, 3, 4, 5]
+d.clear()
+assert len(d) == 0 and not d
+assertRaisesText(IndexError, "pop from an empty deque", d.pop)
+assertRaisesText(ValueError, "not in deque", deque([1]).remove, 2)
+
+doc = "deque maxlen"
+d = deque(range(5), maxlen=3)
+assert list(d) == [2, 3, 4] and d.maxlen == 3
+d.append(5)
+assert list(d) == [3, 4, 5]
+d.appendleft(2)
+assert list(d) == [2, 3, 4]
+assert repr(d) == "deque([2, 3, 4], maxlen=3)"
+assert deque().maxlen is None
+assertRaisesText(ValueError, "maxlen must be non-negative", deque, [], -1)
+
+doc = "deque operators"
+assert deque([1, 2]) + deque([3]) == deque([1, 2, 3])
+assert deque([1, 2]) < deque([1, 3])
+assert deque([1, 2]) != deque([2, 1])
+d = deque([1])
+d += [2, 3]
+assert d == deque([1, 2, 3])
+assert d.copy() == d
+
+doc = "deque mutated during iteration"
+d = deque([1, 2, 3])
+def mutate():
+    for x in d:
+        d.append(x)
+assertRaisesText(RuntimeError, "deque mutated during iteration", mutate)
+
+doc = "OrderedDict"
+o = OrderedDict()
+o["b"] = 1
+o[2] = 2
+o[(1, 2)] = 3
+assert list(o) == ["b", 2, (1, 2)]
+assert list(o.values()) == [1, 2, 3]
+assert list(o.items()) == [("b", 1), (2, 2), ((1, 2), 3)]
+assert repr(o) == "OrderedDict([('b', 1), (2, 2), ((1, 2), 3)])"
+assert repr(OrderedDict()) == "OrderedDict()"
+assert o[(1, 2)] == 3 and o.get("x") is None and o.get("x", 5) == 5
+o.move_to_end("b")
+assert list(o) == [2, (1, 2), "b"]
+o.move_to_end("b", last=False)
+assert list(o) == ["b", 2, (1, 2)]
+assert o.popitem() == ((1, 2), 3)
+assert o.popitem(last=False) == ("b", 1)
+assert o.pop(2) == 2 and len(o) == 0
+assertRaisesText(KeyError, "dictionary is empty", o.popitem)
+assertRaises(KeyError, o.move_to_end, "x")
+assertRaisesText(TypeError, "unhashable type: 'list'", o.get, [1])
+assert o.setdefault("a", 1) == 1 and o.setdefault("a", 2) == 1
+o.update([("b", 2)], c=3)
+assert list(o.keys()) == ["a", "b", "c"]
+assert list(OrderedDict.fromkeys("ab", 0).items()) == [("a", 0), ("b", 0)]
+assert list(o.__reversed__()) == ["c", "b", "a"]
+
+doc = "OrderedDict equality"
+a = OrderedDict([("a", 1), ("b", 2)])
+b = OrderedDict([("b", 2), ("a", 1)])
+assert a != b
+assert a == OrderedDict(a)
+assert a == {"a": 1, "b": 2}
+
+doc = "OrderedDict mutated during iteration"
+def mutate():
+    for k in a:
+        a[k + k] = 1
+assertRaisesText(RuntimeError, "OrderedDict mutated during iteration", mutate)
+
+doc = "defaultdict"
+dd = defaultdict(list)
+dd["x"].append(1)
+dd[3].append(2)
+assert dd["x"] == [1] and dd[3] == [2]
+assert dd.default_factory is list
+assert repr(defaultdict(int)) == "defaultdict(<class 'int'>, {})"
+dd = defaultdict(None, a=1)
+assert dd["a"] == 1
+assertRaises(KeyError, lambda: dd["b"])
+dd.default_factory = int
+assert dd["b"] == 0 and len(dd) == 2
+assertRaisesText(TypeError, "first argument must be callable or None", defaultdict, 1)
+
+doc = "Counter"
+c = Counter("abracadabra")
+assert c["a"] == 5 and c["b"] == 2 and c["z"] == 0
+assert "z" not in c
+assert c.most_common(2) == [("a", 5), ("b", 2)]
+assert c.most_common()[-1] == ("d", 1)
+assert repr(Counter("aab")) == "Counter({'a': 2, 'b': 1})"
+assert list(Counter("abba").elements()) == ["a", "a", "b", "b"]
+assert c.total() == 11
+c.update("zz")
+c.update({"a": 1})
+assert c["z"] == 2 and c["a"] == 6
+c.subtract(a=10)
+assert c["a"] == -4
+del c["a"]
+del c["missing"]
+assert "a" not in c
+c = Counter(a=1)
+c["b"] += 2
+assert c == Counter(a=1, b=2)
+assert Counter(a=1) == Counter(a=1, b=0)
+assertRaises(NotImplementedError, Counter.fromkeys, "ab")
+
+doc = "Counter arithmetic"
+a = Counter(a=3, b=1)
+b = Counter(a=1, b=2)
+assert a + b == Counter(a=4, b=3)
+assert a - b == Counter(a=2)
+assert a | b == Counter(a=3, b=2)
+assert a & b == Counter(a=1, b=1)
+assert +Counter(a=-1, b=2) == Counter(b=2)
+assert -Counter(a=-1, b=2) == Counter(a=1)
+a += b
+assert a == Counter(a=4, b=3)
+a -= Counter(a=4)
+assert a == Counter(b=3)
+
+doc = "namedtuple"
+Point = namedtuple("Point", "x y")
+p = Point(1, y=2)
+assert p.x == 1 and p.y == 2 and p[0] == 1 and len(p) == 2
+assert p == (1, 2)
+assert tuple(p) == (1, 2)
+x, y = p
+assert x == 1 and y == 2
+assert repr(p) == "Point(x=1, y=2)" and str(p) == "Point(x=1, y=2)"
+assert Point._fields == ("x", "y")
+assert p._replace(x=3) == Point(3, 2)
+assert p._asdict() == OrderedDict([("x", 1), ("y", 2)])
+assert Point._make([5, 6]) == Point(5, 6)
+assert p + (3,) == (1, 2, 3)
+assertRaisesText(AttributeError, "can't set attribute", setattr, p, "x", 3)
+assertRaisesText(TypeError, "missing 1 required positional argument: 'y'", Point, 1)
+assertRaisesText(TypeError, "takes 3 positional arguments but 4 were given", Point, 1, 2, 3)
+assertRaisesText(TypeError, "unexpected keyword argument 'z'", Point, 1, z=2)
+assertRaisesText(ValueError, "Got unexpected field names", p._replace, z=1)
+
+doc = "namedtuple options"
+P = namedtuple("P", ["a", "b", "c"], defaults=[2, 3])
+assert P(1) == (1, 2, 3)
+assert P._field_defaults == {"b": 2, "c": 3}
+assert namedtuple("P", "x, def, x, _y", rename=True)._fields == ("x", "_1", "_2", "_3")
+assert namedtuple("P", "x", module="here").__module__ == "here"
+assertRaisesText(ValueError, "duplicate field name: 'x'", namedtuple, "P", "x x")
+assertRaisesText(ValueError, "cannot be a keyword: 'def'", namedtuple, "P", "def")
+assertRaisesText(ValueError, "cannot start with an underscore: '_x'", namedtuple, "P", "_x")
+assertRaisesText(ValueError, "must be valid identifiers: '1P'", namedtuple, "1P", "x")
+assertRaisesText(TypeError, "Got more default values than field names", namedtuple, "P", "x", defaults=[1, 2])
+
+doc = "ChainMap"
+a = OrderedDict(a=1)
+b = {"a": 2, "b": 3}
+cm = ChainMap(a, b)
+assert cm["a"] == 1 and cm["b"] == 3 and len(cm) == 2
+assert "b" in cm and "c" not in cm
+assert cm.get("c", 4) == 4
+assertRaises(KeyError, lambda: cm["c"])
+cm["c"] = 5
+assert a["c"] == 5
+del cm["a"]
+assert cm["a"] == 2
+assertRaisesText(KeyError, "Key not found in the first mapping: 'b'", cm.pop, "b")
+assert cm.maps[0] is a and len(cm.maps) == 2
+child = cm.new_child()
+child["b"] = 6
+assert child["b"] == 6 and cm["b"] == 3
+assert child.parents.maps == cm.maps
+assert repr(ChainMap({"a": 1})) == "ChainMap({'a': 1})"
+assert len(ChainMap().maps) == 1
+cm = ChainMap(OrderedDict([(1, 2)]))
+assert cm[1] == 2
+
+doc = "subclasses"
+class Stack(deque):
+    def peek(self):
+        return self[-1]
+s = Stack([1, 2])
+assert s.peek() == 2 and repr(s) == "Stack([1, 2])"
+s.name = "stack"
+assert s.name == "stack"
+
+class Tally(Counter):
+    def __init__(self, iterable, name):
+        Counter.__init__(self, iterable)
+        self.name = name
+t = Tally("aab", "t")
+assert t["a"] == 2 and t.name == "t"
+
+class Twice(defaultdict):
+    def __missing__(self, key):
+        return key * 2
+tw = Twice(None)
+assert tw["ab"] == "abab" and len(tw) == 0
+
+class Point3(namedtuple("Point3", "x y z")):
+    def total(self):
+        return self.x + self.y + self.z
+p = Point3(1, 2, 3)
+assert p.total() == 6 and repr(p) == "Point3(x=1, y=2, z=3)"
+assert Point3._make([1, 1, 1]).total() == 3
+
+doc = "unhashable keys"
+assertRaisesText(TypeError, "unhashable type: 'list'", Counter, [[1]])
+assertRaisesText(TypeError, "unhashable type: 'dict'", OrderedDict, [({}, 1)])
+d = OrderedDict()
+d[1] = "int"
+d[1.0] = "float"
+d[True] = "bool"
+assert len(d) == 1 and d[1] == "bool"
+
+doc = "finished"
@@ -9,6 +9,7 @@ package compile
 // FIXME kill ast.Identifier and turn into string?
 
 import (
+	"bytes"
 	"fmt"
 	"log"
 	"strings"
@@ -84,6 +85,16 @@ type compiler struct {
 	interactive bool
 }
 
+// Optimize is the optimization level used when compiling, the
+// equivalent of python's -O flag.  If it is greater than 0 then the
+// peephole optimizer is run on the compiled code, assert statements
+// are removed and __debug__ is False.
+var Optimize = 0
+
+// PyCF_ONLY_AST is the flag passed to compile() to return the Ast
+// rather than a code object
+const PyCF_ONLY_AST = 0x0400
+
 // Set in py to avoid circular import
 func init() {
 	py.Compile = Compile
@@ -104,10 +115,37 @@ func init() {
 // in addition to any features explicitly specified.
 func Compile(str, filename, mode string, futureFlags int, dont_inherit bool) (py.Object, error) {
 	// Parse Ast
-	Ast, err := parser.ParseString(str, mode)
+	Ast, err := parser.Parse(bytes.NewBufferString(str), filename, mode)
 	if err != nil {
 		return nil, err
 	}
+	code, err := CompileAst(Ast, filename, futureFlags, dont_inherit)
+	if err != nil {
+		addSyntaxErrorText(err, str)
+	}
+	return code, err
+}
+
+// Adds the line of source to a SyntaxError found after parsing
+func addSyntaxErrorText(err error, str string) {
+	exc, ok := err.(*py.Exception)
+	if !ok || !exc.Type().IsSubtype(py.SyntaxError) || exc.Dict["text"] != py.None {
+		return
+	}
+	lineno, ok := exc.Dict["lineno"].(py.Int)
+	if !ok {
+		return
+	}
+	lines := strings.SplitAfter(str, "\n")
+	if lineno >= 1 && int(lineno) <= len(lines) {
+		py.SyntaxErrorSetText(exc, lines[lineno-1])
+	}
+}
+
+// CompileAst compiles an already parsed Ast into a code object
+//
+// The parameters are as for Compile.
+func CompileAst(Ast ast.Ast, filename string, futureFlags int, dont_inherit bool) (py.Object, error) {
 	// Make symbol table
 	SymTable, err := symtable.NewSymTable(Ast, filename)
 	if err != nil {
@@ -125,7 +163,7 @@ func Compile(str, filename, mode string, futureFlags int, dont_inherit bool) (py
 // Make a new compiler object with empty code object
 func newCompiler(parent *compiler, scopeType compilerScopeType) *compiler {
 	code := &py.Code{
-		Firstlineno: 1,          // FIXME
+		Firstlineno: 1,
 		Name:        "<module>", // FIXME
 	}
 	c := &compiler{
@@ -197,11 +235,14 @@ func (c *compiler) compileAst(Ast ast.Ast, filename string, futureFlags int, don
 	code.Flags = c.codeFlags(SymTable) | int32(futureFlags&py.CO_COMPILER_FLAGS_MASK)
 	valueOnStack := false
 	c.SetLineno(Ast)
+	code.Firstlineno = firstLineno(Ast)
 	switch node := Ast.(type) {
 	case *ast.Module:
+		c.setupAnnotations(node.Body)
 		c.Stmts(c.docString(node.Body, false))
 	case *ast.Interactive:
 		c.interactive = true
+		c.setupAnnotations(node.Body)
 		c.Stmts(node.Body)
 	case *ast.Expression:
 		c.Expr(node.Body)
@@ -219,8 +260,15 @@ func (c *compiler) compileAst(Ast ast.Ast, filename string, futureFlags int, don
 		code.Name = string(node.Name)
 		c.setQualname()
 		c.Stmts(c.docString(node.Body, true))
+	case *ast.AsyncFunctionDef:
+		code.Name = string(node.Name)
+		c.setQualname()
+		c.Stmts(c.docString(node.Body, true))
 	case *ast.ClassDef:
 		code.Name = string(node.Name)
+		// As in CPython the set up is on the first line, which
+		// is the first decorator's if there are any
+		c.Lineno = int(code.Firstlineno)
 		/* load (global) __name__ ... */
 		c.NameOp("__name__", ast.Load)
 		/* ... and store it as __module__ */
@@ -234,6 +282,7 @@ func (c *compiler) compileAst(Ast ast.Ast, filename string, futureFlags int, don
 		c.NameOp("__qualname__", ast.Store)
 
 		/* compile the body proper */
+		c.setupAnnotations(node.Body)
 		c.Stmts(c.docString(node.Body, false))
 
 		if SymTable.NeedsClassClosure {
@@ -283,6 +332,12 @@ func (c *compiler) compileAst(Ast ast.Ast, filename string, futureFlags int, don
 	default:
 		panic(fmt.Sprintf("Unknown ModuleBase: %v", Ast))
 	}
+	switch Ast.(type) {
+	case *ast.Module, *ast.Interactive, *ast.Expression:
+		// As in CPython modules start on the line of their first
+		// instruction, not counting the return added below
+		code.Firstlineno = int32(c.OpCodes.FirstLineno())
+	}
 	if !c.OpCodes.EndsWithReturn() {
 		// add a return
 		if !valueOnStack {
@@ -291,13 +346,38 @@ func (c *compiler) compileAst(Ast ast.Ast, filename string, futureFlags int, don
 		}
 		c.Op(vm.RETURN_VALUE)
 	}
+	if Optimize > 0 {
+		c.optimize()
+	}
 	code.Code = c.OpCodes.Assemble()
 	code.Stacksize = int32(c.OpCodes.StackDepth())
 	code.Nlocals = int32(len(code.Varnames))
-	code.Lnotab = string(c.OpCodes.Lnotab())
+	code.Lnotab = string(c.OpCodes.Lnotab(int(code.Firstlineno)))
 	return nil
 }
 
+// Returns the line a code object made from Ast starts on
+//
+// This is the line of the first decorator of decorated definitions
+// and line 1 for modules.
+func firstLineno(Ast ast.Ast) int32 {
+	var decorators []ast.Expr
+	switch node := Ast.(type) {
+	case *ast.Module, *ast.Interactive, *ast.Expression:
+		return 1
+	case *ast.FunctionDef:
+		decorators = node.DecoratorList
+	case *ast.AsyncFunctionDef:
+		decorators = node.DecoratorList
+	case *ast.ClassDef:
+		decorators = node.DecoratorList
+	}
+	if len(decorators) > 0 {
+		return int32(decorators[0].GetLineno())
+	}
+	return int32(Ast.GetLineno())
+}
+
 // Check for docstring as first Expr in body and remove it and set the
 // first constant if found if fn is set, or set __doc__ if it isn't
 func (c *compiler) docString(body []ast.Stmt, fn bool) []ast.Stmt {
@@ -318,6 +398,7 @@ func (c *compiler) docString(body []ast.Stmt, fn bool) []ast.Stmt {
 		}
 	} else {
 		if docstring != nil {
+			c.SetLineno(docstring)
 			c.LoadConst(docstring.S)
 			c.NameOp("__doc__", ast.Store)
 		}
@@ -325,6 +406,59 @@ func (c *compiler) docString(body []ast.Stmt, fn bool) []ast.Stmt {
 	return body
 }
 
+// Returns true if any of the statements contains a variable annotation
+// not nested in a function or class definition
+func findAnn(stmts []ast.Stmt) bool {
+	for _, stmt := range stmts {
+		switch node := stmt.(type) {
+		case *ast.AnnAssign:
+			return true
+		case *ast.For:
+			if findAnn(node.Body) || findAnn(node.Orelse) {
+				return true
+			}
+		case *ast.AsyncFor:
+			if findAnn(node.Body) || findAnn(node.Orelse) {
+				return true
+			}
+		case *ast.While:
+			if findAnn(node.Body) || findAnn(node.Orelse) {
+				return true
+			}
+		case *ast.If:
+			if findAnn(node.Body) || findAnn(node.Orelse) {
+				return true
+			}
+		case *ast.With:
+			if findAnn(node.Body) {
+				return true
+			}
+		case *ast.AsyncWith:
+			if findAnn(node.Body) {
+				return true
+			}
+		case *ast.Try:
+			if findAnn(node.Body) || findAnn(node.Orelse) || findAnn(node.Finalbody) {
+				return true
+			}
+			for _, handler := range node.Handlers {
+				if findAnn(handler.Body) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// Makes sure __annotations__ exists if the body of a module or class
+// contains variable annotations
+func (c *compiler) setupAnnotations(stmts []ast.Stmt) {
+	if findAnn(stmts) {
+		c.Op(vm.SETUP_ANNOTATIONS)
+	}
+}
+
 // Compiles a python constant
 //
 // Returns the index into the Consts tuple
@@ -422,7 +556,7 @@ func (c *compiler) Jump(Op vm.OpCode, Dest *Label) {
 	switch Op {
 	case vm.JUMP_IF_FALSE_OR_POP, vm.JUMP_IF_TRUE_OR_POP, vm.JUMP_ABSOLUTE, vm.POP_JUMP_IF_FALSE, vm.POP_JUMP_IF_TRUE, vm.CONTINUE_LOOP: // Absolute
 		instr = &JumpAbs{OpArg: OpArg{Op: Op}, Dest: Dest}
-	case vm.JUMP_FORWARD, vm.SETUP_WITH, vm.FOR_ITER, vm.SETUP_LOOP, vm.SETUP_EXCEPT, vm.SETUP_FINALLY:
+	case vm.JUMP_FORWARD, vm.SETUP_WITH, vm.SETUP_ASYNC_WITH, vm.FOR_ITER, vm.SETUP_LOOP, vm.SETUP_EXCEPT, vm.SETUP_FINALLY:
 		instr = &JumpRel{OpArg: OpArg{Op: Op}, Dest: Dest}
 	default:
 		panic("Jump called with non jump instruction")
@@ -474,6 +608,10 @@ func (c *compiler) makeClosure(code *py.Code, args uint32, child *compiler, qual
 			arg = c.FindId(name, c.Code.Cellvars)
 		} else { /* (reftype == FREE) */
 			arg = c.FindId(name, c.Code.Freevars)
+			if arg >= 0 {
+				// Free variables come after the cell variables
+				arg += len(c.Code.Cellvars)
+			}
 		}
 		if arg < 0 {
 			panic(fmt.Sprintf("compile: makeClosure: lookup %q in %q %v %v\nfreevars of %q: %v\n", name, c.SymTable.Name, reftype, arg, code.Name, code.Freevars))
@@ -499,6 +637,9 @@ func (c *compiler) codeFlags(st *symtable.SymTable) (flags int32) {
 		if st.Generator {
 			flags |= py.CO_GENERATOR
 		}
+		if st.Coroutine {
+			flags |= py.CO_COROUTINE
+		}
 		if st.Varargs {
 			flags |= py.CO_VARARGS
 		}
@@ -555,8 +696,14 @@ func (c *compiler) setQualname() {
 // Compile a function
 func (c *compiler) compileFunc(compilerScope compilerScopeType, Ast ast.Ast, Args *ast.Arguments, DecoratorList []ast.Expr, Returns ast.Expr) {
 	newC := c.newCompilerScope(compilerScope, Ast, "")
-	newC.Code.Argcount = int32(len(Args.Args))
+	newC.Code.Argcount = int32(len(Args.Posonlyargs) + len(Args.Args))
+	newC.Code.Posonlyargcount = int32(len(Args.Posonlyargs))
 	newC.Code.Kwonlyargcount = int32(len(Args.Kwonlyargs))
+	newC.Code.SetCell2arg()
+
+	// Load decorators onto stack before the defaults which are used
+	// by MAKE_FUNCTION
+	c.Exprs(DecoratorList)
 
 	// Defaults
 	c.Exprs(Args.Defaults)
@@ -580,6 +727,7 @@ func (c *compiler) compileFunc(compilerScope compilerScopeType, Ast ast.Ast, Arg
 			}
 		}
 	}
+	addAnnotation(Args.Posonlyargs...)
 	addAnnotation(Args.Args...)
 	addAnnotation(Args.Vararg)
 	addAnnotation(Args.Kwonlyargs...)
@@ -594,9 +742,6 @@ func (c *compiler) compileFunc(compilerScope compilerScopeType, Ast ast.Ast, Arg
 		c.LoadConst(annotations)
 	}
 
-	// Load decorators onto stack
-	c.Exprs(DecoratorList)
-
 	// Make function or closure, leaving it on the stack
 	posdefaults := uint32(len(Args.Defaults))
 	kwdefaults := uint32(len(Args.KwDefaults))
@@ -650,7 +795,7 @@ func (c *compiler) class(Ast ast.Ast, class *ast.ClassDef) {
 	c.LoadConst(py.String(class.Name))
 
 	/* 5. generate the rest of the code for the call */
-	c.callHelper(2, class.Bases, class.Keywords, class.Starargs, class.Kwargs)
+	c.callHelper(2, class.Bases, class.Keywords)
 
 	/* 6. apply decorators */
 	for range class.DecoratorList {
@@ -718,12 +863,155 @@ func (c *compiler) with(node *ast.With, pos int) {
 	   the exception or return information. Just issue our magic
 	   opcode. */
 	c.Label(finally)
-	c.Op(vm.WITH_CLEANUP)
+	c.Op(vm.WITH_CLEANUP_START)
+	c.Op(vm.WITH_CLEANUP_FINISH)
+
+	/* Finally block ends. */
+	c.Op(vm.END_FINALLY)
+}
+
+/*
+   Implements the async with statement from PEP 492.
+
+   The semantics outlined in that PEP are as follows:
+
+   async with EXPR as VAR:
+       BLOCK
+
+   It is implemented roughly as:
+
+   context = EXPR
+   exit = context.__aexit__  # not calling it
+   value = await context.__aenter__()
+   try:
+       VAR = value  # if VAR present in the syntax
+       BLOCK
+   finally:
+       if an exception was raised:
+           exc = copy of (exception, instance, traceback)
+       else:
+           exc = (None, None, None)
+       if not (await exit(*exc)):
+           raise
+*/
+func (c *compiler) asyncWith(node *ast.AsyncWith, pos int) {
+	item := node.Items[pos]
+	finally := new(Label)
+
+	/* Evaluate EXPR */
+	c.Expr(item.ContextExpr)
+	c.Op(vm.BEFORE_ASYNC_WITH)
+	c.await()
+	c.Jump(vm.SETUP_ASYNC_WITH, finally)
+
+	/* SETUP_ASYNC_WITH pushes a finally block. */
+	c.loops.Push(loop{Type: finallyTryLoop})
+	if item.OptionalVars != nil {
+		c.Expr(item.OptionalVars)
+	} else {
+		/* Discard result from context.__aenter__() */
+		c.Op(vm.POP_TOP)
+	}
+
+	pos++
+	if pos == len(node.Items) {
+		/* BLOCK code */
+		c.Stmts(node.Body)
+	} else {
+		c.asyncWith(node, pos)
+	}
+
+	/* End of try block; start the finally block */
+	c.Op(vm.POP_BLOCK)
+	c.loops.Pop()
+	c.LoadConst(py.None)
+
+	/* Finally block starts; context.__aexit__ is on the stack
+	   under the exception or return information. Await the result
+	   of calling it between the two halves of the cleanup. */
+	c.Label(finally)
+	c.Op(vm.WITH_CLEANUP_START)
+	c.await()
+	c.Op(vm.WITH_CLEANUP_FINISH)
 
 	/* Finally block ends. */
 	c.Op(vm.END_FINALLY)
 }
 
+/*
+   Implements the async for statement from PEP 492.
+
+   async for TARGET in ITER:
+       BLOCK
+   else:
+       ELSE
+
+   It is implemented roughly as:
+
+   iter = ITER.__aiter__()
+   while True:
+       try:
+           TARGET = await iter.__anext__()
+       except StopAsyncIteration:
+           break
+       BLOCK
+   else:
+       ELSE
+*/
+func (c *compiler) asyncFor(node *ast.AsyncFor) {
+	except := new(Label)
+	afterTry := new(Label)
+	tryCleanup := new(Label)
+	endpopblock := new(Label)
+	c.Jump(vm.SETUP_LOOP, endpopblock)
+	c.Expr(node.Iter)
+	c.Op(vm.GET_AITER)
+	try := c.NewLabel()
+	c.loops.Push(loop{Start: try, End: endpopblock, Type: loopLoop})
+
+	/* TARGET = await iter.__anext__() */
+	c.Jump(vm.SETUP_EXCEPT, except)
+	c.loops.Push(loop{Type: exceptLoop})
+	c.Op(vm.GET_ANEXT)
+	c.LoadConst(py.None)
+	c.Op(vm.YIELD_FROM)
+	c.Expr(node.Target)
+	c.Op(vm.POP_BLOCK)
+	c.loops.Pop()
+	c.Jump(vm.JUMP_FORWARD, afterTry)
+
+	/* except StopAsyncIteration: break */
+	c.Label(except)
+	c.Op(vm.DUP_TOP)
+	c.OpArg(vm.LOAD_GLOBAL, c.Name("StopAsyncIteration"))
+	c.OpArg(vm.COMPARE_OP, vm.PyCmp_EXC_MATCH)
+	c.Jump(vm.POP_JUMP_IF_TRUE, tryCleanup)
+	c.Op(vm.END_FINALLY)
+
+	c.Label(afterTry)
+	c.Stmts(node.Body)
+	c.Jump(vm.JUMP_ABSOLUTE, try)
+
+	/* Drop the exception, the except handler and the iterator */
+	c.Label(tryCleanup)
+	c.Op(vm.POP_TOP)
+	c.Op(vm.POP_TOP)
+	c.Op(vm.POP_TOP)
+	c.Op(vm.POP_EXCEPT)
+	c.Op(vm.POP_TOP)
+	c.Op(vm.POP_BLOCK)
+	c.loops.Pop()
+	c.Stmts(node.Orelse)
+	c.Label(endpopblock)
+}
+
+// Emits the code to await TOS
+func (c *compiler) await() {
+	c.Op(vm.GET_AWAITABLE)
+	c.LoadConst(py.None)
+	c.Op(vm.YIELD_FROM)
+}
+
 /* Code generated for "try: <body> finally: <finalbody>" is as follows:
 
         SETUP_FINALLY           L
@@ -821,9 +1109,7 @@ func (c *compiler) tryExcept(node *ast.Try) {
 		if handler.ExprType == nil && i < n-1 {
 			c.panicSyntaxErrorf(handler, "default 'except:' must be last")
 		}
-		// FIXME c.u.u_lineno_set = 0
-		// c.u.u_lineno = handler.lineno
-		// c.u.u_col_offset = handler.col_offset
+		c.SetLineno(handler)
 		except := new(Label)
 		if handler.ExprType != nil {
 			c.Op(vm.DUP_TOP)
@@ -973,6 +1259,68 @@ func (c *compiler) importFrom(node *ast.ImportFrom) {
 	c.Op(vm.POP_TOP)
 }
 
+// Compile a variable annotation
+func (c *compiler) annAssign(node *ast.AnnAssign) {
+	// Do the actual assignment first
+	if node.Value != nil {
+		c.Expr(node.Value)
+		c.Expr(node.Target)
+	}
+	// Annotations are only evaluated in a module or class
+	annotate := c.scopeType == compilerScopeModule || c.scopeType == compilerScopeClass
+	switch target := node.Target.(type) {
+	case *ast.Name:
+		// Store the annotation of a simple name
+		if node.Simple != 0 && annotate {
+			c.Expr(node.Annotation)
+			c.NameOp("__annotations__", ast.Load)
+			c.LoadConst(py.String(target.Id))
+			c.Op(vm.STORE_SUBSCR)
+			return
+		}
+	case *ast.Attribute:
+		if node.Value == nil {
+			c.annExpr(target.Value)
+		}
+	case *ast.Subscript:
+		if node.Value == nil {
+			c.annExpr(target.Value)
+			c.annSubscr(target.Slice)
+		}
+	default:
+		panic(fmt.Sprintf("compile: invalid node type %T for annotated assignment", target))
+	}
+	if annotate {
+		c.annExpr(node.Annotation)
+	}
+}
+
+// Evaluates an expression for its side effects only
+func (c *compiler) annExpr(expr ast.Expr) {
+	if expr != nil {
+		c.Expr(expr)
+		c.Op(vm.POP_TOP)
+	}
+}
+
+// Evaluates the parts of a subscript for their side effects only
+func (c *compiler) annSubscr(s ast.Slicer) {
+	switch node := s.(type) {
+	case *ast.Index:
+		c.annExpr(node.Value)
+	case *ast.Slice:
+		c.annExpr(node.Lower)
+		c.annExpr(node.Upper)
+		c.annExpr(node.Step)
+	case *ast.ExtSlice:
+		for _, sub := range node.Dims {
+			c.annSubscr(sub)
+		}
+	default:
+		panic(fmt.Sprintf("invalid subscript kind %T", s))
+	}
+}
+
 // Compile statements
 func (c *compiler) Stmts(stmts []ast.Stmt) {
 	for _, stmt := range stmts {
@@ -993,12 +1341,19 @@ func (c *compiler) Stmt(stmt ast.Stmt) {
 		c.compileFunc(compilerScopeFunction, stmt, node.Args, node.DecoratorList, node.Returns)
 		c.NameOp(string(node.Name), ast.Store)
 
+	case *ast.AsyncFunctionDef:
+		// Name          Identifier
+		// Args          *Arguments
+		// Body          []Stmt
+		// DecoratorList []Expr
+		// Returns       Expr
+		c.compileFunc(compilerScopeFunction, stmt, node.Args, node.DecoratorList, node.Returns)
+		c.NameOp(string(node.Name), ast.Store)
+
 	case *ast.ClassDef:
 		// Name          Identifier
 		// Bases         []Expr
 		// Keywords      []*Keyword
-		// Starargs      Expr
-		// Kwargs        Expr
 		// Body          []Stmt
 		// DecoratorList []Expr
 		c.class(stmt, node)
@@ -1046,6 +1401,8 @@ func (c *compiler) Stmt(stmt ast.Stmt) {
 			op = vm.INPLACE_SUBTRACT
 		case ast.Mult:
 			op = vm.INPLACE_MULTIPLY
+		case ast.MatMult:
+			op = vm.INPLACE_MATRIX_MULTIPLY
 		case ast.Div:
 			op = vm.INPLACE_TRUE_DIVIDE
 		case ast.Modulo:
@@ -1070,6 +1427,12 @@ func (c *compiler) Stmt(stmt ast.Stmt) {
 		c.Op(op)
 		setctx.SetCtx(ast.AugStore)
 		c.Expr(node.Target)
+	case *ast.AnnAssign:
+		// Target     Expr
+		// Annotation Expr
+		// Value      Expr
+		// Simple     int
+		c.annAssign(node)
 	case *ast.For:
 		// Target Expr
 		// Iter   Expr
@@ -1125,10 +1488,26 @@ func (c *compiler) Stmt(stmt ast.Stmt) {
 		c.Label(orelse)
 		c.Stmts(node.Orelse)
 		c.Label(endif)
+	case *ast.AsyncFor:
+		// Target Expr
+		// Iter   Expr
+		// Body   []Stmt
+		// Orelse []Stmt
+		if !c.SymTable.Coroutine {
+			c.panicSyntaxErrorf(node, "'async for' outside async function")
+		}
+		c.asyncFor(node)
 	case *ast.With:
 		// Items []*WithItem
 		// Body  []Stmt
 		c.with(node, 0)
+	case *ast.AsyncWith:
+		// Items []*WithItem
+		// Body  []Stmt
+		if !c.SymTable.Coroutine {
+			c.panicSyntaxErrorf(node, "'async with' outside async function")
+		}
+		c.asyncWith(node, 0)
 	case *ast.Raise:
 		// Exc   Expr
 		// Cause Expr
@@ -1151,6 +1530,10 @@ func (c *compiler) Stmt(stmt ast.Stmt) {
 	case *ast.Assert:
 		// Test Expr
 		// Msg  Expr
+		if Optimize > 0 {
+			// asserts are removed when optimizing
+			break
+		}
 		label := new(Label)
 		c.Expr(node.Test)
 		c.Jump(vm.POP_JUMP_IF_TRUE, label)
@@ -1347,20 +1730,74 @@ func (c *compiler) NameOp(name string, ctx ast.ExprContext) {
 	if op == 0 {
 		panic("NameOp: Op not set")
 	}
-	c.OpArg(op, c.Index(mangled, dict))
+	i := c.Index(mangled, dict)
+	if dict == &c.Code.Freevars {
+		// Free variables come after the cell variables
+		i += uint32(len(c.Code.Cellvars))
+	}
+	c.OpArg(op, i)
 }
 
 // Call a function which is already on the stack with n arguments already on the stack
-func (c *compiler) callHelper(n int, Args []ast.Expr, Keywords []*ast.Keyword, Starargs ast.Expr, Kwargs ast.Expr) {
-	args := len(Args) + n
-	for i := range Args {
-		c.Expr(Args[i])
+func (c *compiler) callHelper(n int, Args []ast.Expr, Keywords []*ast.Keyword) {
+	code := 0
+	nseen, nsubargs := 0, 0
+	// the number of tuples and dictionaries on the stack
+	nsubkwargs, nkw := 0, 0
+	// Positional arguments before star
+	for _, elt := range Args {
+		if starred, ok := elt.(*ast.Starred); ok {
+			// A star-arg. If we've seen positional arguments,
+			// pack the positional arguments into a tuple.
+			if nseen != 0 {
+				c.OpArg(vm.BUILD_TUPLE, uint32(nseen))
+				nseen = 0
+				nsubargs++
+			}
+			c.Expr(starred.Value)
+			nsubargs++
+		} else if nsubargs != 0 {
+			// We've seen star-args already, so we count towards
+			// items-to-pack-into-tuple.
+			c.Expr(elt)
+			nseen++
+		} else {
+			// Positional arguments before star-arguments are
+			// left on the stack.
+			c.Expr(elt)
+			n++
+		}
 	}
-	kwargs := len(Keywords)
+	if nsubargs != 0 {
+		code |= 1
+		if nseen != 0 {
+			// Pack up any trailing positional arguments.
+			c.OpArg(vm.BUILD_TUPLE, uint32(nseen))
+			nsubargs++
+		}
+		if nsubargs > 1 {
+			// If we ended up with more than one stararg, we need
+			// to concatenate them into a single sequence.
+			c.OpArg(vm.BUILD_TUPLE_UNPACK, uint32(nsubargs))
+		}
+	}
+
+	// Same dance again for keyword arguments
 	duplicateDetector := make(map[ast.Identifier]struct{}, len(Keywords))
 	var duplicate *ast.Keyword
-	for i := range Keywords {
-		kw := Keywords[i]
+	nseen = 0 // the number of keyword arguments on the stack following
+	for i, kw := range Keywords {
+		if kw.Arg == "" {
+			// A keyword argument unpacking.
+			if nseen != 0 {
+				c.subkwargs(Keywords[i-nseen : i])
+				nsubkwargs++
+				nseen = 0
+			}
+			c.Expr(kw.Value)
+			nsubkwargs++
+			continue
+		}
 		if _, found := duplicateDetector[kw.Arg]; found {
 			if duplicate == nil {
 				duplicate = kw
@@ -1368,26 +1805,56 @@ func (c *compiler) callHelper(n int, Args []ast.Expr, Keywords []*ast.Keyword, S
 		} else {
 			duplicateDetector[kw.Arg] = struct{}{}
 		}
-		c.LoadConst(py.String(kw.Arg))
-		c.Expr(kw.Value)
+		if nsubkwargs != 0 {
+			// A keyword argument and we already have a dict.
+			nseen++
+		} else {
+			// Keyword arguments before any '**' are left on the
+			// stack as name, value pairs.
+			c.LoadConst(py.String(kw.Arg))
+			c.Expr(kw.Value)
+			nkw++
+		}
 	}
 	if duplicate != nil {
 		c.panicSyntaxErrorf(duplicate, "keyword argument repeated")
 	}
-	op := vm.CALL_FUNCTION
-	if Starargs != nil {
-		c.Expr(Starargs)
-		if Kwargs != nil {
-			c.Expr(Kwargs)
-			op = vm.CALL_FUNCTION_VAR_KW
-		} else {
-			op = vm.CALL_FUNCTION_VAR
-		}
-	} else if Kwargs != nil {
-		c.Expr(Kwargs)
+	if nsubkwargs != 0 {
+		code |= 2
+		if nseen != 0 {
+			// Pack up any trailing keyword arguments.
+			c.subkwargs(Keywords[len(Keywords)-nseen:])
+			nsubkwargs++
+		}
+		if nsubkwargs > 1 {
+			// Pack it all up
+			functionPos := n + (code & 1) + 2*nkw + 1
+			c.OpArg(vm.BUILD_MAP_UNPACK_WITH_CALL, uint32(nsubkwargs|(functionPos<<8)))
+		}
+	}
+	var op vm.OpCode
+	switch code {
+	case 0:
+		op = vm.CALL_FUNCTION
+	case 1:
+		op = vm.CALL_FUNCTION_VAR
+	case 2:
 		op = vm.CALL_FUNCTION_KW
+	case 3:
+		op = vm.CALL_FUNCTION_VAR_KW
+	}
+	c.OpArg(op, uint32(n|(nkw<<8)))
+}
+
+// Build a dict from named keyword arguments which follow a '**'
+// unpacking
+func (c *compiler) subkwargs(Keywords []*ast.Keyword) {
+	c.OpArg(vm.BUILD_MAP, uint32(len(Keywords)))
+	for _, kw := range Keywords {
+		c.Expr(kw.Value)
+		c.LoadConst(py.String(kw.Arg))
+		c.Op(vm.STORE_MAP)
 	}
-	c.OpArg(op, uint32(args+kwargs<<8))
 }
 
 /* List and set comprehensions and generator expressions work by creating a
@@ -1497,9 +1964,98 @@ func (c *compiler) tupleOrList(op vm.OpCode, ctx ast.ExprContext, elts []ast.Exp
 			c.OpArg(vm.UNPACK_SEQUENCE, uint32(n))
 		}
 	}
-	c.Exprs(elts)
 	if ctx == ast.Load {
-		c.OpArg(op, uint32(n))
+		unpackOp := vm.BUILD_TUPLE_UNPACK
+		if op == vm.BUILD_LIST {
+			unpackOp = vm.BUILD_LIST_UNPACK
+		}
+		c.starunpackHelper(elts, op, vm.BUILD_TUPLE, unpackOp)
+		return
+	}
+	c.Exprs(elts)
+}
+
+// Build a list, tuple or set from elts which may contain starred
+// expressions to unpack
+//
+// singleOp builds the result if there are no starred expressions,
+// otherwise runs of ordinary items are packed with innerOp and the
+// pieces are joined with outerOp.
+func (c *compiler) starunpackHelper(elts []ast.Expr, singleOp, innerOp, outerOp vm.OpCode) {
+	nsubitems, nseen := 0, 0
+	for _, elt := range elts {
+		if starred, ok := elt.(*ast.Starred); ok {
+			if nseen != 0 {
+				c.OpArg(innerOp, uint32(nseen))
+				nseen = 0
+				nsubitems++
+			}
+			c.Expr(starred.Value)
+			nsubitems++
+		} else {
+			c.Expr(elt)
+			nseen++
+		}
+	}
+	if nsubitems != 0 {
+		if nseen != 0 {
+			c.OpArg(innerOp, uint32(nseen))
+			nsubitems++
+		}
+		c.OpArg(outerOp, uint32(nsubitems))
+	} else {
+		c.OpArg(singleOp, uint32(nseen))
+	}
+}
+
+// Build a dict from the keys and values in node[begin:end] which
+// don't contain any '**' unpackings
+func (c *compiler) subdict(node *ast.Dict, begin, end int) {
+	c.OpArg(vm.BUILD_MAP, uint32(end-begin))
+	for i := begin; i < end; i++ {
+		c.Expr(node.Values[i])
+		c.Expr(node.Keys[i])
+		c.Op(vm.STORE_MAP)
+	}
+}
+
+// Build a dict which may contain '**' unpackings marked by a nil key
+func (c *compiler) dict(node *ast.Dict) {
+	n := len(node.Keys)
+	if n != len(node.Values) {
+		panic("compile: Dict keys and values differing sizes")
+	}
+	containers, elements := 0, 0
+	isUnpacking := false
+	for i := range node.Keys {
+		isUnpacking = node.Keys[i] == nil
+		if elements != 0 && isUnpacking {
+			c.subdict(node, i-elements, i)
+			containers++
+			elements = 0
+		}
+		if isUnpacking {
+			c.Expr(node.Values[i])
+			containers++
+		} else {
+			elements++
+		}
+	}
+	if elements != 0 || containers == 0 {
+		c.subdict(node, n-elements, n)
+		containers++
+	}
+	// If there is more than one dict, they need to be merged into
+	// a new dict. If there is one dict and it's an unpacking, then
+	// it needs to be copied into a new dict.
+	for containers > 1 || isUnpacking {
+		oparg := containers
+		if oparg > 255 {
+			oparg = 255
+		}
+		c.OpArg(vm.BUILD_MAP_UNPACK, uint32(oparg))
+		containers -= oparg - 1
+		isUnpacking = false
 	}
 }
 
@@ -1634,6 +2190,8 @@ func (c *compiler) Expr(expr ast.Expr) {
 			op = vm.BINARY_SUBTRACT
 		case ast.Mult:
 			op = vm.BINARY_MULTIPLY
+		case ast.MatMult:
+			op = vm.BINARY_MATRIX_MULTIPLY
 		case ast.Div:
 			op = vm.BINARY_TRUE_DIVIDE
 		case ast.Modulo:
@@ -1695,20 +2253,10 @@ func (c *compiler) Expr(expr ast.Expr) {
 	case *ast.Dict:
 		// Keys   []Expr
 		// Values []Expr
-		n := len(node.Keys)
-		if n != len(node.Values) {
-			panic("compile: Dict keys and values differing sizes")
-		}
-		c.OpArg(vm.BUILD_MAP, uint32(n))
-		for i := range node.Keys {
-			c.Expr(node.Values[i])
-			c.Expr(node.Keys[i])
-			c.Op(vm.STORE_MAP)
-		}
+		c.dict(node)
 	case *ast.Set:
 		// Elts []Expr
-		c.Exprs(node.Elts)
-		c.OpArg(vm.BUILD_SET, uint32(len(node.Elts)))
+		c.starunpackHelper(node.Elts, vm.BUILD_SET, vm.BUILD_SET, vm.BUILD_SET_UNPACK)
 	case *ast.ListComp:
 		// Elt        Expr
 		// Generators []Comprehension
@@ -1746,6 +2294,25 @@ func (c *compiler) Expr(expr ast.Expr) {
 		c.Op(vm.GET_ITER)
 		c.LoadConst(py.None)
 		c.Op(vm.YIELD_FROM)
+	case *ast.NamedExpr:
+		// Target Expr
+		// Value  Expr
+		c.Expr(node.Value)
+		c.Op(vm.DUP_TOP)
+		c.Expr(node.Target)
+	case *ast.Await:
+		// Value Expr
+		if c.SymTable.Type != symtable.FunctionBlock {
+			c.panicSyntaxErrorf(node, "'await' outside function")
+		}
+		if c.scopeType == compilerScopeComprehension {
+			c.panicSyntaxErrorf(node, "'await' expressions in comprehensions are not supported")
+		}
+		if !c.SymTable.Coroutine {
+			c.panicSyntaxErrorf(node, "'await' outside async function")
+		}
+		c.Expr(node.Value)
+		c.await()
 	case *ast.Compare:
 		// Left        Expr
 		// Ops         []CmpOp
@@ -1808,10 +2375,8 @@ func (c *compiler) Expr(expr ast.Expr) {
 		// Func     Expr
 		// Args     []Expr
 		// Keywords []*Keyword
-		// Starargs Expr
-		// Kwargs   Expr
 		c.Expr(node.Func)
-		c.callHelper(0, node.Args, node.Keywords, node.Starargs, node.Kwargs)
+		c.callHelper(0, node.Args, node.Keywords)
 	case *ast.Num:
 		// N Object
 		c.LoadConst(node.N)
@@ -1821,6 +2386,33 @@ func (c *compiler) Expr(expr ast.Expr) {
 	case *ast.Bytes:
 		// S py.Bytes
 		c.LoadConst(node.S)
+	case *ast.JoinedStr:
+		// Values []Expr
+		for _, value := range node.Values {
+			c.Expr(value)
+		}
+		if len(node.Values) != 1 {
+			c.OpArg(vm.BUILD_STRING, uint32(len(node.Values)))
+		}
+	case *ast.FormattedValue:
+		// Value      Expr
+		// Conversion int
+		// FormatSpec Expr
+		c.Expr(node.Value)
+		flags := uint32(vm.FVC_NONE)
+		switch node.Conversion {
+		case 's':
+			flags = vm.FVC_STR
+		case 'r':
+			flags = vm.FVC_REPR
+		case 'a':
+			flags = vm.FVC_ASCII
+		}
+		if node.FormatSpec != nil {
+			c.Expr(node.FormatSpec)
+			flags |= vm.FVS_HAVE_SPEC
+		}
+		c.OpArg(vm.FORMAT_VALUE, flags)
 	case *ast.NameConstant:
 		// Value Singleton
 		c.LoadConst(node.Value)
@@ -1889,6 +2481,11 @@ func (c *compiler) Expr(expr ast.Expr) {
 	case *ast.Name:
 		// Id  Identifier
 		// Ctx ExprContext
+		if node.Id == "__debug__" && node.Ctx == ast.Load {
+			// __debug__ is a constant which is False when optimizing
+			c.LoadConst(py.NewBool(Optimize == 0))
+			break
+		}
 		c.NameOp(string(node.Id), node.Ctx)
 	case *ast.List:
 		// Elts []Expr
@@ -136,6 +136,23 @@ var compileTestData = []struct {
 		Firstlineno:    1,
 		Lnotab:         "",
 	}, nil, ""},
+	{"\"a\"@\"b\"", "eval", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      2,
+		Flags:          64,
+		Code:           "\x64\x00\x00\x64\x01\x00\x10\x53",
+		Consts:         []py.Object{py.String("a"), py.String("b")},
+		Names:          []string{},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}, nil, ""},
 	{"\"a\"/1", "eval", &py.Code{
 		Argcount:       0,
 		Kwonlyargcount: 0,
@@ -1241,6 +1258,57 @@ var compileTestData = []struct {
 		Firstlineno:    1,
 		Lnotab:         "",
 	}, nil, ""},
+	{"{**a}", "eval", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      1,
+		Flags:          64,
+		Code:           "\x65\x00\x00\x96\x01\x00\x53",
+		Consts:         []py.Object{},
+		Names:          []string{"a"},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}, nil, ""},
+	{"{**a, 'b':c}", "eval", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      4,
+		Flags:          64,
+		Code:           "\x65\x00\x00\x69\x01\x00\x65\x01\x00\x64\x00\x00\x36\x96\x02\x00\x53",
+		Consts:         []py.Object{py.String("b")},
+		Names:          []string{"a", "c"},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}, nil, ""},
+	{"{'a':b, **c, **d}", "eval", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      3,
+		Flags:          64,
+		Code:           "\x69\x01\x00\x65\x00\x00\x64\x00\x00\x36\x65\x01\x00\x65\x02\x00\x96\x03\x00\x53",
+		Consts:         []py.Object{py.String("a")},
+		Names:          []string{"b", "c", "d"},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}, nil, ""},
 	{"{1}", "eval", &py.Code{
 		Argcount:       0,
 		Kwonlyargcount: 0,
@@ -1275,6 +1343,57 @@ var compileTestData = []struct {
 		Firstlineno:    1,
 		Lnotab:         "",
 	}, nil, ""},
+	{"{*a, b}", "eval", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      2,
+		Flags:          64,
+		Code:           "\x65\x00\x00\x65\x01\x00\x68\x01\x00\x99\x02\x00\x53",
+		Consts:         []py.Object{},
+		Names:          []string{"a", "b"},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}, nil, ""},
+	{"[*a, b]", "eval", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      2,
+		Flags:          64,
+		Code:           "\x65\x00\x00\x65\x01\x00\x66\x01\x00\x95\x02\x00\x53",
+		Consts:         []py.Object{},
+		Names:          []string{"a", "b"},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}, nil, ""},
+	{"(*a, *b)", "eval", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      2,
+		Flags:          64,
+		Code:           "\x65\x00\x00\x65\x01\x00\x98\x02\x00\x53",
+		Consts:         []py.Object{},
+		Names:          []string{"a", "b"},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}, nil, ""},
 	{"lambda: 0", "eval", &py.Code{
 		Argcount:       0,
 		Kwonlyargcount: 0,
@@ -1596,6 +1715,23 @@ var compileTestData = []struct {
 		Firstlineno:    1,
 		Lnotab:         "",
 	}, nil, ""},
+	{"a@=b", "exec", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      2,
+		Flags:          64,
+		Code:           "\x65\x00\x00\x65\x01\x00\x11\x5a\x00\x00\x64\x00\x00\x53",
+		Consts:         []py.Object{py.None},
+		Names:          []string{"a", "b"},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}, nil, ""},
 	{"a/=1", "exec", &py.Code{
 		Argcount:       0,
 		Kwonlyargcount: 0,
@@ -2233,9 +2369,9 @@ var compileTestData = []struct {
 		Nlocals:        0,
 		Stacksize:      7,
 		Flags:          64,
-		Code:           "\x65\x00\x00\x65\x01\x00\x65\x02\x00\x64\x00\x00\x65\x03\x00\x65\x04\x00\x65\x05\x00\x8e\x02\x01\x53",
+		Code:           "\x65\x00\x00\x65\x01\x00\x65\x02\x00\x65\x03\x00\x64\x00\x00\x65\x04\x00\x65\x05\x00\x8e\x02\x01\x53",
 		Consts:         []py.Object{py.String("d")},
-		Names:          []string{"f", "a", "b", "e", "args", "kwargs"},
+		Names:          []string{"f", "a", "b", "args", "e", "kwargs"},
 		Varnames:       []string{},
 		Freevars:       []string{},
 		Cellvars:       []string{},
@@ -2261,6 +2397,91 @@ var compileTestData = []struct {
 		Firstlineno:    1,
 		Lnotab:         "",
 	}, nil, ""},
+	{"f(*a, *b)", "eval", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      3,
+		Flags:          64,
+		Code:           "\x65\x00\x00\x65\x01\x00\x65\x02\x00\x98\x02\x00\x8c\x00\x00\x53",
+		Consts:         []py.Object{},
+		Names:          []string{"f", "a", "b"},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}, nil, ""},
+	{"f(a, *b, c)", "eval", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      4,
+		Flags:          64,
+		Code:           "\x65\x00\x00\x65\x01\x00\x65\x02\x00\x65\x03\x00\x66\x01\x00\x98\x02\x00\x8c\x01\x00\x53",
+		Consts:         []py.Object{},
+		Names:          []string{"f", "a", "b", "c"},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}, nil, ""},
+	{"f(**a, **b)", "eval", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      3,
+		Flags:          64,
+		Code:           "\x65\x00\x00\x65\x01\x00\x65\x02\x00\x97\x02\x01\x8d\x00\x00\x53",
+		Consts:         []py.Object{},
+		Names:          []string{"f", "a", "b"},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}, nil, ""},
+	{"f(a, *b, c, d=e, **f, g=h)", "eval", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      9,
+		Flags:          64,
+		Code:           "\x65\x00\x00\x65\x01\x00\x65\x02\x00\x65\x03\x00\x66\x01\x00\x98\x02\x00\x64\x00\x00\x65\x04\x00\x65\x00\x00\x69\x01\x00\x65\x05\x00\x64\x01\x00\x36\x97\x02\x05\x8e\x01\x01\x53",
+		Consts:         []py.Object{py.String("d"), py.String("g")},
+		Names:          []string{"f", "a", "b", "c", "e", "h"},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}, nil, ""},
+	{"f(a=b, **c, d=e, **f)", "eval", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      7,
+		Flags:          64,
+		Code:           "\x65\x00\x00\x64\x00\x00\x65\x01\x00\x65\x02\x00\x69\x01\x00\x65\x03\x00\x64\x01\x00\x36\x65\x00\x00\x97\x03\x03\x8d\x00\x01\x53",
+		Consts:         []py.Object{py.String("a"), py.String("d")},
+		Names:          []string{"f", "b", "c", "e"},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}, nil, ""},
 	{"f(a=1,a=2)", "eval", nil, py.SyntaxError, "keyword argument repeated"},
 	{"return", "exec", nil, py.SyntaxError, "'return' outside function"},
 	{"def fn(): pass", "exec", &py.Code{
@@ -3288,7 +3509,7 @@ var compileTestData = []struct {
 		Nlocals:        0,
 		Stacksize:      13,
 		Flags:          64,
-		Code:           "\x65\x00\x00\x65\x01\x00\x83\x00\x00\x47\x64\x00\x00\x64\x01\x00\x84\x00\x00\x64\x01\x00\x65\x02\x00\x65\x03\x00\x64\x02\x00\x64\x03\x00\x64\x04\x00\x64\x05\x00\x65\x04\x00\x65\x05\x00\x8e\x04\x02\x83\x01\x00\x83\x01\x00\x5a\x06\x00\x64\x06\x00\x53",
+		Code:           "\x65\x00\x00\x65\x01\x00\x83\x00\x00\x47\x64\x00\x00\x64\x01\x00\x84\x00\x00\x64\x01\x00\x65\x02\x00\x65\x03\x00\x65\x04\x00\x64\x02\x00\x64\x03\x00\x64\x04\x00\x64\x05\x00\x65\x05\x00\x8e\x04\x02\x83\x01\x00\x83\x01\x00\x5a\x06\x00\x64\x06\x00\x53",
 		Consts: []py.Object{&py.Code{
 			Argcount:       0,
 			Kwonlyargcount: 0,
@@ -3829,9 +4050,9 @@ var compileTestData = []struct {
 		Argcount:       0,
 		Kwonlyargcount: 0,
 		Nlocals:        0,
-		Stacksize:      8,
+		Stacksize:      9,
 		Flags:          64,
-		Code:           "\x65\x00\x00\x8f\x0c\x00\x01\x65\x01\x00\x83\x00\x00\x01\x57\x64\x00\x00\x51\x58\x64\x00\x00\x53",
+		Code:           "\x65\x00\x00\x8f\x0c\x00\x01\x65\x01\x00\x83\x00\x00\x01\x57\x64\x00\x00\x51\x52\x58\x64\x00\x00\x53",
 		Consts:         []py.Object{py.None},
 		Names:          []string{"a", "f"},
 		Varnames:       []string{},
@@ -3848,7 +4069,7 @@ var compileTestData = []struct {
 		Nlocals:        0,
 		Stacksize:      9,
 		Flags:          64,
-		Code:           "\x65\x00\x00\x83\x00\x00\x8f\x11\x00\x5a\x01\x00\x65\x02\x00\x65\x01\x00\x83\x01\x00\x01\x57\x64\x00\x00\x51\x58\x64\x00\x00\x53",
+		Code:           "\x65\x00\x00\x83\x00\x00\x8f\x11\x00\x5a\x01\x00\x65\x02\x00\x65\x01\x00\x83\x01\x00\x01\x57\x64\x00\x00\x51\x52\x58\x64\x00\x00\x53",
 		Consts:         []py.Object{py.None},
 		Names:          []string{"a", "b", "f"},
 		Varnames:       []string{},
@@ -3865,7 +4086,7 @@ var compileTestData = []struct {
 		Nlocals:        0,
 		Stacksize:      17,
 		Flags:          64,
-		Code:           "\x65\x00\x00\x83\x00\x00\x8f\x26\x00\x5a\x01\x00\x65\x02\x00\x83\x00\x00\x8f\x14\x00\x5a\x03\x00\x65\x04\x00\x65\x01\x00\x65\x03\x00\x83\x02\x00\x01\x57\x64\x00\x00\x51\x58\x57\x64\x00\x00\x51\x58\x64\x00\x00\x53",
+		Code:           "\x65\x00\x00\x83\x00\x00\x8f\x27\x00\x5a\x01\x00\x65\x02\x00\x83\x00\x00\x8f\x14\x00\x5a\x03\x00\x65\x04\x00\x65\x01\x00\x65\x03\x00\x83\x02\x00\x01\x57\x64\x00\x00\x51\x52\x58\x57\x64\x00\x00\x51\x52\x58\x64\x00\x00\x53",
 		Consts:         []py.Object{py.None},
 		Names:          []string{"A", "a", "B", "b", "f"},
 		Varnames:       []string{},
@@ -3882,7 +4103,7 @@ var compileTestData = []struct {
 		Nlocals:        0,
 		Stacksize:      17,
 		Flags:          64,
-		Code:           "\x65\x00\x00\x83\x00\x00\x8f\x26\x00\x5a\x01\x00\x65\x02\x00\x83\x00\x00\x8f\x14\x00\x5a\x03\x00\x65\x04\x00\x65\x01\x00\x65\x03\x00\x83\x02\x00\x01\x57\x64\x00\x00\x51\x58\x57\x64\x00\x00\x51\x58\x64\x00\x00\x53",
+		Code:           "\x65\x00\x00\x83\x00\x00\x8f\x27\x00\x5a\x01\x00\x65\x02\x00\x83\x00\x00\x8f\x14\x00\x5a\x03\x00\x65\x04\x00\x65\x01\x00\x65\x03\x00\x83\x02\x00\x01\x57\x64\x00\x00\x51\x52\x58\x57\x64\x00\x00\x51\x52\x58\x64\x00\x00\x53",
 		Consts:         []py.Object{py.None},
 		Names:          []string{"A", "a", "B", "b", "f"},
 		Varnames:       []string{},
@@ -3893,6 +4114,109 @@ var compileTestData = []struct {
 		Firstlineno:    1,
 		Lnotab:         "\x0c\x01\x0c\x01",
 	}, nil, ""},
+	{"async def f():\n    await a\n", "exec", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      2,
+		Flags:          64,
+		Code:           "\x64\x00\x00\x64\x01\x00\x84\x00\x00\x5a\x00\x00\x64\x02\x00\x53",
+		Consts: []py.Object{&py.Code{
+			Argcount:       0,
+			Kwonlyargcount: 0,
+			Nlocals:        0,
+			Stacksize:      2,
+			Flags:          195,
+			Code:           "\x74\x00\x00\x49\x64\x00\x00\x48\x01\x64\x00\x00\x53",
+			Consts:         []py.Object{py.None},
+			Names:          []string{"a"},
+			Varnames:       []string{},
+			Freevars:       []string{},
+			Cellvars:       []string{},
+			Filename:       "<string>",
+			Name:           "f",
+			Firstlineno:    1,
+			Lnotab:         "\x00\x01",
+		}, py.String("f"), py.None},
+		Names:       []string{"f"},
+		Varnames:    []string{},
+		Freevars:    []string{},
+		Cellvars:    []string{},
+		Filename:    "<string>",
+		Name:        "<module>",
+		Firstlineno: 1,
+		Lnotab:      "",
+	}, nil, ""},
+	{"async def f():\n    async with a as b:\n        pass\n", "exec", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      2,
+		Flags:          64,
+		Code:           "\x64\x00\x00\x64\x01\x00\x84\x00\x00\x5a\x00\x00\x64\x02\x00\x53",
+		Consts: []py.Object{&py.Code{
+			Argcount:       0,
+			Kwonlyargcount: 0,
+			Nlocals:        1,
+			Stacksize:      10,
+			Flags:          195,
+			Code:           "\x74\x00\x00\x34\x49\x64\x00\x00\x48\x9a\x07\x00\x7d\x00\x00\x57\x64\x00\x00\x51\x49\x64\x00\x00\x48\x52\x58\x64\x00\x00\x53",
+			Consts:         []py.Object{py.None},
+			Names:          []string{"a"},
+			Varnames:       []string{"b"},
+			Freevars:       []string{},
+			Cellvars:       []string{},
+			Filename:       "<string>",
+			Name:           "f",
+			Firstlineno:    1,
+			Lnotab:         "\x00\x01\x0f\x01",
+		}, py.String("f"), py.None},
+		Names:       []string{"f"},
+		Varnames:    []string{},
+		Freevars:    []string{},
+		Cellvars:    []string{},
+		Filename:    "<string>",
+		Name:        "<module>",
+		Firstlineno: 1,
+		Lnotab:      "",
+	}, nil, ""},
+	{"async def f():\n    async for a in b:\n        c\n", "exec", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      2,
+		Flags:          64,
+		Code:           "\x64\x00\x00\x64\x01\x00\x84\x00\x00\x5a\x00\x00\x64\x02\x00\x53",
+		Consts: []py.Object{&py.Code{
+			Argcount:       0,
+			Kwonlyargcount: 0,
+			Nlocals:        1,
+			Stacksize:      18,
+			Flags:          195,
+			Code:           "\x78\x2b\x00\x74\x00\x00\x32\x79\x0c\x00\x33\x64\x00\x00\x48\x7d\x00\x00\x57\x6e\x0b\x00\x04\x74\x01\x00\x6b\x0a\x00\x73\x28\x00\x58\x74\x02\x00\x01\x71\x07\x00\x01\x01\x01\x59\x01\x57\x64\x00\x00\x53",
+			Consts:         []py.Object{py.None},
+			Names:          []string{"b", "StopAsyncIteration", "c"},
+			Varnames:       []string{"a"},
+			Freevars:       []string{},
+			Cellvars:       []string{},
+			Filename:       "<string>",
+			Name:           "f",
+			Firstlineno:    1,
+			Lnotab:         "\x00\x01\x21\x01",
+		}, py.String("f"), py.None},
+		Names:       []string{"f"},
+		Varnames:    []string{},
+		Freevars:    []string{},
+		Cellvars:    []string{},
+		Filename:    "<string>",
+		Name:        "<module>",
+		Firstlineno: 1,
+		Lnotab:      "",
+	}, nil, ""},
+	{"await a", "exec", nil, py.SyntaxError, "'await' outside function"},
+	{"def f():\n    await a\n", "exec", nil, py.SyntaxError, "'await' outside async function"},
+	{"async def f():\n    yield a\n", "exec", nil, py.SyntaxError, "'yield' inside async function"},
+	{"async def f():\n    [await x for x in a]\n", "exec", nil, py.SyntaxError, "'await' expressions in comprehensions are not supported"},
 	{"ok = False\ntry:\n    raise SyntaxError\nexcept SyntaxError:\n    ok = True\nassert ok\n", "exec", &py.Code{
 		Argcount:       0,
 		Kwonlyargcount: 0,
@@ -4356,7 +4680,23 @@ var compileTestData = []struct {
 	}, nil, ""},
 	{"a, *b, *c = t", "exec", nil, py.SyntaxError, "two starred expressions in assignment"},
 	{"a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,*a = t", "exec", nil, py.SyntaxError, "too many expressions in star-unpacking assignment"},
-	{"a, b, *c", "exec", nil, py.SyntaxError, "can use starred expression only as assignment target"},
+	{"a, b, *c", "exec", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      2,
+		Flags:          64,
+		Code:           "\x65\x00\x00\x65\x01\x00\x66\x02\x00\x65\x02\x00\x98\x02\x00\x01\x64\x00\x00\x53",
+		Consts:         []py.Object{py.None},
+		Names:          []string{"a", "b", "c"},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}, nil, ""},
 	{"a, (b, c), d = t", "exec", &py.Code{
 		Argcount:       0,
 		Kwonlyargcount: 0,
@@ -5678,9 +6018,9 @@ var compileTestData = []struct {
 		Argcount:       0,
 		Kwonlyargcount: 0,
 		Nlocals:        0,
-		Stacksize:      8,
+		Stacksize:      16,
 		Flags:          64,
-		Code:           "\x78\x1a\x00\x65\x00\x00\x72\x1c\x00\x65\x01\x00\x8f\x08\x00\x01\x77\x03\x00\x57\x64\x00\x00\x51\x58\x71\x03\x00\x57\x64\x00\x00\x53",
+		Code:           "\x78\x1b\x00\x65\x00\x00\x72\x1d\x00\x65\x01\x00\x8f\x08\x00\x01\x77\x03\x00\x57\x64\x00\x00\x51\x52\x58\x71\x03\x00\x57\x64\x00\x00\x53",
 		Consts:         []py.Object{py.None},
 		Names:          []string{"x", "c"},
 		Varnames:       []string{},
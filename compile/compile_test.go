@@ -132,19 +132,18 @@ func EqCodeCode(t *testing.T, name string, a, b string) {
 func EqCode(t *testing.T, name string, a, b *py.Code) {
 	// int32
 	EqInt32(t, name+": Argcount", a.Argcount, b.Argcount)
+	EqInt32(t, name+": Posonlyargcount", a.Posonlyargcount, b.Posonlyargcount)
 	EqInt32(t, name+": Kwonlyargcount", a.Kwonlyargcount, b.Kwonlyargcount)
 	EqInt32(t, name+": Nlocals", a.Nlocals, b.Nlocals)
 	// FIXME EqInt32(t, name+": Stacksize", a.Stacksize, b.Stacksize)
 	EqInt32(t, name+": Flags", a.Flags, b.Flags)
-	// FIXME EqInt32(t, name+": Firstlineno", a.Firstlineno, b.Firstlineno)
+	EqInt32(t, name+": Firstlineno", a.Firstlineno, b.Firstlineno)
 
 	// string
 	EqCodeCode(t, name+": Code", a.Code, b.Code)
 	EqString(t, name+": Filename", a.Filename, b.Filename)
 	EqString(t, name+": Name", a.Name, b.Name)
-	// The Lnotabs are mostly the same but not entirely
-	// So it is probably not profitable to test them exactly
-	// EqLnotab(t, name+": Lnotab", a.Lnotab, b.Lnotab)
+	EqLnotab(t, name+": Lnotab", a.Lnotab, b.Lnotab)
 
 	// []string
 	EqStrings(t, name+": Names", a.Names, b.Names)
@@ -160,6 +159,14 @@ func EqCode(t *testing.T, name string, a, b *py.Code) {
 
 }
 
+// Sources whose line numbers python3.4, which made compileTestData,
+// works out differently to gpython and later pythons so the
+// Firstlineno and Lnotab of their modules aren't compared
+var linenoQuirks = map[string]bool{
+	"(a+\nb+\nc)\n": true, // a BinOp is on the line of its operator
+	"# Module\n\"\"\"\nA module docstring\n\"\"\"\n": true, // a string is on the line it ends on
+}
+
 func TestCompile(t *testing.T) {
 	for _, test := range compileTestData {
 		// log.Printf(">>> %s", test.in)
@@ -205,7 +212,13 @@ func TestCompile(t *testing.T) {
 					t.Errorf("%s: Expecting *py.Code but got %T", test.in, codeObj)
 				} else {
 					//t.Logf("Testing %q", test.in)
-					EqCode(t, test.in, test.out, code)
+					want := test.out
+					if linenoQuirks[test.in] {
+						quirk := *want
+						quirk.Firstlineno, quirk.Lnotab = code.Firstlineno, code.Lnotab
+						want = &quirk
+					}
+					EqCode(t, test.in, want, code)
 				}
 			}
 		}
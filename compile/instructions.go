@@ -91,6 +91,8 @@ func opcodeStackEffect(opcode vm.OpCode, oparg uint32) int {
 		return -1
 	case vm.MAP_ADD:
 		return -2
+	case vm.BINARY_MATRIX_MULTIPLY, vm.INPLACE_MATRIX_MULTIPLY:
+		return -1
 	case vm.BINARY_POWER, vm.BINARY_MULTIPLY, vm.BINARY_MODULO, vm.BINARY_ADD, vm.BINARY_SUBTRACT, vm.BINARY_SUBSCR, vm.BINARY_FLOOR_DIVIDE, vm.BINARY_TRUE_DIVIDE:
 		return -1
 	case vm.INPLACE_FLOOR_DIVIDE, vm.INPLACE_TRUE_DIVIDE:
@@ -119,12 +121,16 @@ func opcodeStackEffect(opcode vm.OpCode, oparg uint32) int {
 		return 0
 	case vm.SETUP_WITH:
 		return 7
-	case vm.WITH_CLEANUP:
+	case vm.WITH_CLEANUP_START:
+		return 1 /* XXX */
+	case vm.WITH_CLEANUP_FINISH:
 		return -1 /* XXX Sometimes more */
 	case vm.RETURN_VALUE:
 		return -1
 	case vm.IMPORT_STAR:
 		return -1
+	case vm.SETUP_ANNOTATIONS:
+		return 0
 	case vm.YIELD_VALUE:
 		return 0
 	case vm.YIELD_FROM:
@@ -161,6 +167,10 @@ func opcodeStackEffect(opcode vm.OpCode, oparg uint32) int {
 		return 1 - int(oparg)
 	case vm.BUILD_MAP:
 		return 1
+	case vm.BUILD_TUPLE_UNPACK, vm.BUILD_LIST_UNPACK, vm.BUILD_SET_UNPACK, vm.BUILD_MAP_UNPACK:
+		return 1 - int(oparg)
+	case vm.BUILD_MAP_UNPACK_WITH_CALL:
+		return 1 - int(oparg&0xFF)
 	case vm.LOAD_ATTR:
 		return 0
 	case vm.COMPARE_OP:
@@ -220,6 +230,24 @@ func opcodeStackEffect(opcode vm.OpCode, oparg uint32) int {
 		return -1
 	case vm.DELETE_DEREF:
 		return 0
+	case vm.FORMAT_VALUE:
+		// If there's a format spec, it's popped
+		if oparg&vm.FVS_MASK == vm.FVS_HAVE_SPEC {
+			return -1
+		}
+		return 0
+	case vm.BUILD_STRING:
+		return 1 - int(oparg)
+	case vm.GET_AWAITABLE:
+		return 0
+	case vm.SETUP_ASYNC_WITH:
+		return 6
+	case vm.BEFORE_ASYNC_WITH:
+		return 1
+	case vm.GET_AITER:
+		return 0
+	case vm.GET_ANEXT:
+		return 1
 	default:
 		panic("Unknown opcode in StackEffect")
 	}
@@ -455,13 +483,14 @@ func (o *JumpRel) Resolve() {
 	}
 }
 
-// Creates the lnotab from the instruction stream
+// Creates the lnotab from the instruction stream for code starting on
+// line firstLineno
 //
 // See Objects/lnotab_notes.txt for the description of the line number table.
-func (is Instructions) Lnotab() []byte {
+func (is Instructions) Lnotab(firstLineno int) []byte {
 	var lnotab []byte
 	old_offset := uint32(0)
-	old_lineno := 1
+	old_lineno := firstLineno
 	for _, instr := range is {
 		if instr.Size() == 0 {
 			continue
@@ -492,3 +521,14 @@ func (is Instructions) Lnotab() []byte {
 	}
 	return lnotab
 }
+
+// FirstLineno returns the line of the first instruction or 1 if there
+// isn't one with a line
+func (is Instructions) FirstLineno() int {
+	for _, instr := range is {
+		if instr.Size() != 0 && instr.Lineno() > 0 {
+			return instr.Lineno()
+		}
+	}
+	return 1
+}
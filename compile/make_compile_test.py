@@ -22,6 +22,7 @@ inp = [
     ('''"a"+1''', "eval"),
     ('''"a"-1''', "eval"),
     ('''"a"*"b"''', "eval"),
+    ('''"a"@"b"''', "eval"),
     ('''"a"/1''', "eval"),
     ('''"a"%1''', "eval"),
     ('''"a"**1''', "eval"),
@@ -100,10 +101,16 @@ inp = [
     # dict
     ('''{}''', "eval"),
     ('''{1:2,a:b}''', "eval"),
+    ('''{**a}''', "eval"),
+    ('''{**a, 'b':c}''', "eval"),
+    ('''{'a':b, **c, **d}''', "eval"),
     # set
     # ('''set()''', "eval"),
     ('''{1}''', "eval"),
     ('''{1,2,a,b}''', "eval"),
+    ('''{*a, b}''', "eval"),
+    ('''[*a, b]''', "eval"),
+    ('''(*a, *b)''', "eval"),
     # lambda
     ('''lambda: 0''', "eval"),
     ('''lambda x: 2*x''', "eval"),
@@ -127,6 +134,7 @@ inp = [
     ('''a+=1''', "exec"),
     ('''a-=1''', "exec"),
     ('''a*=b''', "exec"),
+    ('''a@=b''', "exec"),
     ('''a/=1''', "exec"),
     ('''a%=1''', "exec"),
     ('''a**=1''', "exec"),
@@ -184,6 +192,11 @@ def fn(b):
     ('''f(a, b, *args)''', "eval"),
     ('''f(a, b, *args, d=e, **kwargs)''', "eval"),
     ('''f(a, d=e, **kwargs)''', "eval"),
+    ('''f(*a, *b)''', "eval"),
+    ('''f(a, *b, c)''', "eval"),
+    ('''f(**a, **b)''', "eval"),
+    ('''f(a, *b, c, d=e, **f, g=h)''', "eval"),
+    ('''f(a=b, **c, d=e, **f)''', "eval"),
     ('''f(a=1,a=2)''', "eval", SyntaxError),
     # return
     ('''return''', "exec", SyntaxError),
@@ -320,6 +333,7 @@ def outer(x):
     ('''{ (x,y,z) for x in xs for y in ys if a if b for z in zs if c if d }''', "eval"),
     ('''{ x:(y,z) for x in xs for y in ys for z in zs }''', "eval"),
     ('''( (x,y,z) for x in xs for y in ys if a if b for z in zs if c if d )''', "eval"),
+    # f-strings need python3.6 so they are tested in vm/tests/fstring.py
     # with
     ('''\
 with a:
@@ -338,6 +352,34 @@ with A() as a:
     with B() as b:
         f(a,b)
 ''', "exec"),
+    # async
+    ('''\
+async def f():
+    await a
+''', "exec"),
+    ('''\
+async def f():
+    async with a as b:
+        pass
+''', "exec"),
+    ('''\
+async def f():
+    async for a in b:
+        c
+''', "exec"),
+    ('''await a''', "exec", SyntaxError),
+    ('''\
+def f():
+    await a
+''', "exec", SyntaxError),
+    ('''\
+async def f():
+    yield a
+''', "exec", SyntaxError),
+    ('''\
+async def f():
+    [await x for x in a]
+''', "exec", SyntaxError),
     # try/except/finally/else
     ('''\
 ok = False
@@ -433,7 +475,7 @@ def f():
     ('''a, *b, c = t''', "exec"),
     ('''a, *b, *c = t''', "exec", SyntaxError),
     ('''a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,a,*a = t''', "exec", SyntaxError),
-    ('''a, b, *c''', "exec", SyntaxError),
+    ('''a, b, *c''', "exec"),
     ('''a, (b, c), d = t''', "exec"),
     # subscript - load
     ("x[a]", "exec"),
@@ -611,6 +653,9 @@ while x:
     ('''print("hello world!")\n''', "single"),
     # FIXME ('''if True:\n "hello world!"\n''', "single"),
     # FIXME ('''def fn(x):\n "hello world!"\n''', "single"),
+    # variable annotations, assignment expressions and positional only
+    # parameters need python3.8 so they are tested in vm/tests/annotations.py,
+    # vm/tests/assignexpr.py and vm/tests/functions.py
 
  ]
 
@@ -651,6 +696,9 @@ def const(x):
         return "\n".join([
             "&py.Code{",
             "Argcount: %s," % x.co_argcount,
+        ] + ([
+            "Posonlyargcount: %s," % x.co_posonlyargcount,
+        ] if getattr(x, "co_posonlyargcount", 0) else []) + [
             "Kwonlyargcount: %s," % x.co_kwonlyargcount,
             "Nlocals: %s," % x.co_nlocals,
             "Stacksize: %s," % x.co_stacksize,
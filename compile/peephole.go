@@ -0,0 +1,423 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Peephole optimizer
+//
+// This works on the instruction stream before it is assembled so
+// jumps are still expressed as Labels which makes it easy to remove
+// and rewrite instructions.
+
+package compile
+
+import (
+	"math/big"
+	"math/bits"
+
+	"github.com/go-python/gpython/py"
+	"github.com/go-python/gpython/vm"
+)
+
+// Limits on the size of the constants made by constant folding so
+// that folding can't make the code objects huge
+const (
+	maxFoldIntBits  = 128  // max number of bits in a folded int
+	maxFoldStrLen   = 4096 // max length of a folded str or bytes
+	maxFoldTupleLen = 256  // max number of items in a folded tuple
+)
+
+// Binary operations which can be constant folded
+var foldBinaryOps = map[vm.OpCode]func(a, b py.Object) (py.Object, error){
+	vm.BINARY_POWER: func(a, b py.Object) (py.Object, error) {
+		return py.Pow(a, b, py.None)
+	},
+	vm.BINARY_MULTIPLY:     py.Mul,
+	vm.BINARY_MODULO:       py.Mod,
+	vm.BINARY_ADD:          py.Add,
+	vm.BINARY_SUBTRACT:     py.Sub,
+	vm.BINARY_SUBSCR:       py.GetItem,
+	vm.BINARY_FLOOR_DIVIDE: py.FloorDiv,
+	vm.BINARY_TRUE_DIVIDE:  py.TrueDiv,
+	vm.BINARY_LSHIFT:       py.Lshift,
+	vm.BINARY_RSHIFT:       py.Rshift,
+	vm.BINARY_AND:          py.And,
+	vm.BINARY_XOR:          py.Xor,
+	vm.BINARY_OR:           py.Or,
+}
+
+// Unary operations which can be constant folded
+var foldUnaryOps = map[vm.OpCode]func(a py.Object) (py.Object, error){
+	vm.UNARY_POSITIVE: py.Pos,
+	vm.UNARY_NEGATIVE: py.Neg,
+	vm.UNARY_INVERT:   py.Invert,
+}
+
+// Returns the opcode of the instruction or 0 for a Label
+func opCode(instr Instruction) vm.OpCode {
+	switch i := instr.(type) {
+	case *Op:
+		return i.Op
+	case *OpArg:
+		return i.Op
+	case *JumpAbs:
+		return i.Op
+	case *JumpRel:
+		return i.Op
+	}
+	return 0
+}
+
+// Returns the destination of the instruction if it is a jump
+func jumpDest(instr Instruction) *Label {
+	switch i := instr.(type) {
+	case *JumpAbs:
+		return i.Dest
+	case *JumpRel:
+		return i.Dest
+	}
+	return nil
+}
+
+// Returns true for the instructions which transfer control
+// unconditionally so the code after them is unreachable
+func isUnconditional(op vm.OpCode) bool {
+	switch op {
+	case vm.RETURN_VALUE, vm.RAISE_VARARGS, vm.JUMP_ABSOLUTE, vm.JUMP_FORWARD, vm.BREAK_LOOP, vm.CONTINUE_LOOP:
+		return true
+	}
+	return false
+}
+
+// Optimize the instructions of the code being compiled
+//
+// This folds constant expressions, threads jumps to jumps, removes
+// unreachable code and merges UNARY_NOT into conditional jumps.
+func (c *compiler) optimize() {
+	is := c.OpCodes
+	is = is.mergeNotJumps()
+	is = c.foldConstants(is)
+	is = is.threadJumps()
+	is = is.removeDeadCode()
+	is = is.removeNullJumps()
+	c.OpCodes = is
+}
+
+// Replace UNARY_NOT, POP_JUMP_IF_FALSE with POP_JUMP_IF_TRUE and
+// vice versa
+func (is Instructions) mergeNotJumps() Instructions {
+	out := make(Instructions, 0, len(is))
+	for i, instr := range is {
+		if opCode(instr) == vm.UNARY_NOT && i+1 < len(is) {
+			if jump, ok := is[i+1].(*JumpAbs); ok {
+				switch jump.Op {
+				case vm.POP_JUMP_IF_FALSE:
+					jump.Op = vm.POP_JUMP_IF_TRUE
+					continue
+				case vm.POP_JUMP_IF_TRUE:
+					jump.Op = vm.POP_JUMP_IF_FALSE
+					continue
+				}
+			}
+		}
+		out = append(out, instr)
+	}
+	return out
+}
+
+// Returns the constant loaded if instr is a LOAD_CONST
+func (c *compiler) loadedConst(instr Instruction) (py.Object, bool) {
+	if op, ok := instr.(*OpArg); ok && op.Op == vm.LOAD_CONST {
+		return c.Code.Consts[op.Arg], true
+	}
+	return nil, false
+}
+
+// Adds a constant made by folding to the Consts
+//
+// Only immutable atoms are shared with existing constants as Eq
+// can't distinguish 0.0 from -0.0 or (1,) from (1.0,)
+func (c *compiler) foldedConst(obj py.Object) uint32 {
+	switch obj.(type) {
+	case py.Int, py.String, py.Bytes:
+		return c.Const(obj)
+	}
+	c.Code.Consts = append(c.Code.Consts, obj)
+	return uint32(len(c.Code.Consts) - 1)
+}
+
+// Returns the number of bits needed to represent an int or false if
+// obj isn't an int
+func intBits(obj py.Object) (int, bool) {
+	switch x := obj.(type) {
+	case py.Int:
+		if x < 0 {
+			return bits.Len64(uint64(-x)), true
+		}
+		return bits.Len64(uint64(x)), true
+	case *py.BigInt:
+		return (*big.Int)(x).BitLen(), true
+	}
+	return 0, false
+}
+
+// Returns the length of a str, bytes or tuple or false if obj isn't
+// one of those
+func seqLen(obj py.Object) (int, bool) {
+	switch x := obj.(type) {
+	case py.String:
+		return len(x), true
+	case py.Bytes:
+		return len(x), true
+	case py.Tuple:
+		return len(x), true
+	}
+	return 0, false
+}
+
+// Returns whether obj is an int of any size
+func isInt(obj py.Object) bool {
+	switch obj.(type) {
+	case py.Int, *py.BigInt, py.Bool:
+		return true
+	}
+	return false
+}
+
+// Checks whether the operation is cheap enough to do at compile time
+// and won't make a result which is too big
+func foldBinaryOK(op vm.OpCode, a, b py.Object) bool {
+	aInt, aIsInt := a.(py.Int)
+	bInt, bIsInt := b.(py.Int)
+	switch op {
+	case vm.BINARY_POWER, vm.BINARY_LSHIFT:
+		if !aIsInt || !bIsInt {
+			// Any other int could make a huge result so only fold
+			// if neither operand is one
+			return !isInt(a) && !isInt(b)
+		}
+		aBits, _ := intBits(aInt)
+		if op == vm.BINARY_LSHIFT {
+			return bInt <= maxFoldIntBits && aBits+int(bInt) <= maxFoldIntBits
+		}
+		return bInt <= 0 || aBits <= 1 || int64(bInt) <= maxFoldIntBits/int64(aBits)
+	case vm.BINARY_MULTIPLY:
+		seq, count := a, b
+		if _, ok := seqLen(b); ok {
+			seq, count = b, a
+		}
+		if n, ok := seqLen(seq); ok && isInt(count) {
+			c, ok := count.(py.Int)
+			return ok && c <= maxFoldStrLen && int64(n)*int64(c) <= maxFoldStrLen
+		}
+	}
+	return true
+}
+
+// Checks the result of folding is small enough to be a constant
+func foldResultOK(obj py.Object) bool {
+	switch x := obj.(type) {
+	case py.Int, py.Bool, py.Float, py.Complex:
+		return true
+	case *py.BigInt:
+		n, _ := intBits(x)
+		return n <= maxFoldIntBits
+	case py.String, py.Bytes:
+		n, _ := seqLen(x)
+		return n <= maxFoldStrLen
+	case py.Tuple:
+		return len(x) <= maxFoldTupleLen
+	}
+	return false
+}
+
+// Replaces the last n instructions of is with a LOAD_CONST of obj
+func (c *compiler) replaceWithConst(is Instructions, n int, obj py.Object) Instructions {
+	first := is[len(is)-n]
+	instr := &OpArg{Op: vm.LOAD_CONST, Arg: c.foldedConst(obj)}
+	instr.SetLineno(first.Lineno())
+	is = is[:len(is)-n]
+	return append(is, instr)
+}
+
+// Fold the instructions on the end of is if possible
+func (c *compiler) foldLast(is Instructions) Instructions {
+	n := len(is)
+	last := is[n-1]
+	op := opCode(last)
+	if fn, ok := foldBinaryOps[op]; ok && n >= 3 {
+		a, aOk := c.loadedConst(is[n-3])
+		b, bOk := c.loadedConst(is[n-2])
+		if !aOk || !bOk || !foldBinaryOK(op, a, b) {
+			return is
+		}
+		res, err := fn(a, b)
+		if err != nil || !foldResultOK(res) {
+			return is
+		}
+		return c.replaceWithConst(is, 3, res)
+	}
+	if fn, ok := foldUnaryOps[op]; ok && n >= 2 {
+		a, aOk := c.loadedConst(is[n-2])
+		if !aOk {
+			return is
+		}
+		res, err := fn(a)
+		if err != nil || !foldResultOK(res) {
+			return is
+		}
+		return c.replaceWithConst(is, 2, res)
+	}
+	if op == vm.BUILD_TUPLE {
+		count := int(last.(*OpArg).Arg)
+		if count == 0 || count > maxFoldTupleLen || n < count+1 {
+			return is
+		}
+		tuple := make(py.Tuple, count)
+		for i := range tuple {
+			item, ok := c.loadedConst(is[n-1-count+i])
+			if !ok {
+				return is
+			}
+			tuple[i] = item
+		}
+		return c.replaceWithConst(is, count+1, tuple)
+	}
+	return is
+}
+
+// Fold operations on constants into a single constant
+//
+// As the instructions are copied to the output the tail of the
+// output is folded so nested expressions fold completely.  Labels
+// are copied too so nothing is ever folded across a jump target.
+func (c *compiler) foldConstants(is Instructions) Instructions {
+	out := make(Instructions, 0, len(is))
+	for _, instr := range is {
+		out = append(out, instr)
+		out = c.foldLast(out)
+	}
+	return out
+}
+
+// Returns a map of Label to its index in the instructions
+func (is Instructions) labelIndex() map[*Label]int {
+	index := make(map[*Label]int)
+	for i, instr := range is {
+		if label, ok := instr.(*Label); ok {
+			index[label] = i
+		}
+	}
+	return index
+}
+
+// Returns the index of the first instruction which isn't a label at
+// or after i
+func (is Instructions) skipLabels(i int) int {
+	for i < len(is) {
+		if _, ok := is[i].(*Label); !ok {
+			break
+		}
+		i++
+	}
+	return i
+}
+
+// Follows a chain of unconditional jumps starting at dest returning
+// the final destination
+func (is Instructions) finalDest(index map[*Label]int, dest *Label) *Label {
+	// Limit the number of hops in case of an infinite loop
+	for hops := 0; hops < len(is); hops++ {
+		i := is.skipLabels(index[dest])
+		if i >= len(is) {
+			break
+		}
+		switch op := opCode(is[i]); op {
+		case vm.JUMP_ABSOLUTE, vm.JUMP_FORWARD:
+			dest = jumpDest(is[i])
+		default:
+			return dest
+		}
+	}
+	return dest
+}
+
+// Thread jumps to unconditional jumps so they go straight to the
+// final destination, and replace unconditional jumps to a return
+// with the return itself.
+func (is Instructions) threadJumps() Instructions {
+	index := is.labelIndex()
+	for i, instr := range is {
+		switch jump := instr.(type) {
+		case *JumpAbs:
+			switch jump.Op {
+			case vm.JUMP_ABSOLUTE, vm.POP_JUMP_IF_FALSE, vm.POP_JUMP_IF_TRUE, vm.JUMP_IF_FALSE_OR_POP, vm.JUMP_IF_TRUE_OR_POP:
+				jump.Dest = is.finalDest(index, jump.Dest)
+			}
+		case *JumpRel:
+			if jump.Op != vm.JUMP_FORWARD {
+				continue
+			}
+			dest := is.finalDest(index, jump.Dest)
+			if index[dest] < i {
+				// JUMP_FORWARD can't go backwards so swap it for a
+				// JUMP_ABSOLUTE
+				newJump := &JumpAbs{OpArg: OpArg{Op: vm.JUMP_ABSOLUTE}, Dest: dest}
+				newJump.SetLineno(jump.Lineno())
+				is[i] = newJump
+			} else {
+				jump.Dest = dest
+			}
+		}
+		if op := opCode(is[i]); op == vm.JUMP_ABSOLUTE || op == vm.JUMP_FORWARD {
+			target := is.skipLabels(index[jumpDest(is[i])])
+			if target < len(is) && opCode(is[target]) == vm.RETURN_VALUE {
+				ret := &Op{Op: vm.RETURN_VALUE}
+				ret.SetLineno(is[i].Lineno())
+				is[i] = ret
+			}
+		}
+	}
+	return is
+}
+
+// Remove the code after an unconditional transfer of control up to
+// the next label which is the destination of a jump
+func (is Instructions) removeDeadCode() Instructions {
+	referenced := make(map[*Label]bool)
+	for _, instr := range is {
+		if dest := jumpDest(instr); dest != nil {
+			referenced[dest] = true
+		}
+	}
+	out := make(Instructions, 0, len(is))
+	dead := false
+	for _, instr := range is {
+		if label, ok := instr.(*Label); ok && referenced[label] {
+			dead = false
+		}
+		if dead {
+			continue
+		}
+		out = append(out, instr)
+		if isUnconditional(opCode(instr)) {
+			dead = true
+		}
+	}
+	return out
+}
+
+// Remove unconditional jumps to the next instruction
+func (is Instructions) removeNullJumps() Instructions {
+	index := is.labelIndex()
+	out := make(Instructions, 0, len(is))
+	for i, instr := range is {
+		switch opCode(instr) {
+		case vm.JUMP_ABSOLUTE, vm.JUMP_FORWARD:
+			if is.skipLabels(index[jumpDest(instr)]) == is.skipLabels(i+1) {
+				continue
+			}
+		}
+		out = append(out, instr)
+	}
+	return out
+}
@@ -0,0 +1,365 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package compile
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/go-python/gpython/py"
+)
+
+// 10 ** 20 which is too big for a py.Int
+var tenToTheTwenty = (*py.BigInt)(new(big.Int).Exp(big.NewInt(10), big.NewInt(20), nil))
+
+var peepholeTestData = []struct {
+	in   string
+	mode string // exec, eval or single
+	out  *py.Code
+}{
+	{"x = 2*3600", "exec", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      1,
+		Flags:          64,
+		Code:           "\x64\x03\x00\x5a\x00\x00\x64\x02\x00\x53",
+		Consts:         []py.Object{py.Int(2), py.Int(3600), py.None, py.Int(7200)},
+		Names:          []string{"x"},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}},
+	{"(1+2)*3-4", "eval", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      1,
+		Flags:          64,
+		Code:           "\x64\x05\x00\x53",
+		Consts:         []py.Object{py.Int(1), py.Int(2), py.Int(3), py.Int(4), py.Int(9), py.Int(5)},
+		Names:          []string{},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}},
+	{"-5", "eval", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      1,
+		Flags:          64,
+		Code:           "\x64\x01\x00\x53",
+		Consts:         []py.Object{py.Int(5), py.Int(-5)},
+		Names:          []string{},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}},
+	{"(1, 2, (3, 4))", "eval", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      1,
+		Flags:          64,
+		Code:           "\x64\x05\x00\x53",
+		Consts:         []py.Object{py.Int(1), py.Int(2), py.Int(3), py.Int(4), py.Tuple{py.Int(3), py.Int(4)}, py.Tuple{py.Int(1), py.Int(2), py.Tuple{py.Int(3), py.Int(4)}}},
+		Names:          []string{},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}},
+	{"'ab'*3", "eval", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      1,
+		Flags:          64,
+		Code:           "\x64\x02\x00\x53",
+		Consts:         []py.Object{py.String("ab"), py.Int(3), py.String("ababab")},
+		Names:          []string{},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}},
+	{"'x'*5000", "eval", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      2,
+		Flags:          64,
+		Code:           "\x64\x00\x00\x64\x01\x00\x14\x53",
+		Consts:         []py.Object{py.String("x"), py.Int(5000)},
+		Names:          []string{},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}},
+	{"2**1000", "eval", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      2,
+		Flags:          64,
+		Code:           "\x64\x00\x00\x64\x01\x00\x13\x53",
+		Consts:         []py.Object{py.Int(2), py.Int(1000)},
+		Names:          []string{},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}},
+	{"1/0", "eval", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      2,
+		Flags:          64,
+		Code:           "\x64\x00\x00\x64\x01\x00\x1b\x53",
+		Consts:         []py.Object{py.Int(1), py.Int(0)},
+		Names:          []string{},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}},
+	{"if not a:\n    b\nelse:\n    c\n", "exec", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      1,
+		Flags:          64,
+		Code:           "\x65\x00\x00\x73\x0d\x00\x65\x01\x00\x01\x6e\x04\x00\x65\x02\x00\x01\x64\x00\x00\x53",
+		Consts:         []py.Object{py.None},
+		Names:          []string{"a", "b", "c"},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "\x06\x01\a\x02",
+	}},
+	{"def f(x):\n    return x\n    y = 1\n", "exec", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      2,
+		Flags:          64,
+		Code:           "\x64\x00\x00\x64\x01\x00\x84\x00\x00\x5a\x00\x00\x64\x02\x00\x53",
+		Consts: []py.Object{&py.Code{
+			Argcount:       1,
+			Kwonlyargcount: 0,
+			Nlocals:        2,
+			Stacksize:      1,
+			Flags:          67,
+			Code:           "\x7c\x00\x00\x53",
+			Consts:         []py.Object{py.None, py.Int(1)},
+			Names:          []string{},
+			Varnames:       []string{"x", "y"},
+			Freevars:       []string{},
+			Cellvars:       []string{},
+			Filename:       "<string>",
+			Name:           "f",
+			Firstlineno:    1,
+			Lnotab:         "\x00\x01",
+		}, py.String("f"), py.None},
+		Names:       []string{"f"},
+		Varnames:    []string{},
+		Freevars:    []string{},
+		Cellvars:    []string{},
+		Filename:    "<string>",
+		Name:        "<module>",
+		Firstlineno: 1,
+		Lnotab:      "",
+	}},
+	// Only the exponent is folded as the result would be huge
+	{"2 ** 10 ** 20", "eval", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      2,
+		Flags:          64,
+		Code:           "\x64\x00\x00\x64\x03\x00\x13\x53",
+		Consts:         []py.Object{py.Int(2), py.Int(10), py.Int(20), tenToTheTwenty},
+		Names:          []string{},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}},
+	{"1 << 10 ** 20", "eval", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      2,
+		Flags:          64,
+		Code:           "\x64\x00\x00\x64\x03\x00\x3e\x53",
+		Consts:         []py.Object{py.Int(1), py.Int(10), py.Int(20), tenToTheTwenty},
+		Names:          []string{},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}},
+	{"'ab' * 10 ** 20", "eval", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      2,
+		Flags:          64,
+		Code:           "\x64\x00\x00\x64\x03\x00\x14\x53",
+		Consts:         []py.Object{py.String("ab"), py.Int(10), py.Int(20), tenToTheTwenty},
+		Names:          []string{},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}},
+	{"while a:\n    if b:\n        c\n", "exec", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      1,
+		Flags:          64,
+		Code:           "\x78\x14\x00\x65\x00\x00\x72\x16\x00\x65\x01\x00\x72\x03\x00\x65\x02\x00\x01\x71\x03\x00\x57\x64\x00\x00\x53",
+		Consts:         []py.Object{py.None},
+		Names:          []string{"a", "b", "c"},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "\t\x01\x06\x01",
+	}},
+	{"def f(a):\n    if a:\n        x = 1\n    else:\n        x = 2\n    return x\n", "exec", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      2,
+		Flags:          64,
+		Code:           "\x64\x00\x00\x64\x01\x00\x84\x00\x00\x5a\x00\x00\x64\x02\x00\x53",
+		Consts: []py.Object{&py.Code{
+			Argcount:       1,
+			Kwonlyargcount: 0,
+			Nlocals:        2,
+			Stacksize:      1,
+			Flags:          67,
+			Code:           "\x7c\x00\x00\x72\x0f\x00\x64\x01\x00\x7d\x01\x00\x6e\x06\x00\x64\x02\x00\x7d\x01\x00\x7c\x01\x00\x53",
+			Consts:         []py.Object{py.None, py.Int(1), py.Int(2)},
+			Names:          []string{},
+			Varnames:       []string{"a", "x"},
+			Freevars:       []string{},
+			Cellvars:       []string{},
+			Filename:       "<string>",
+			Name:           "f",
+			Firstlineno:    1,
+			Lnotab:         "\x00\x01\x06\x01\t\x02\x06\x01",
+		}, py.String("f"), py.None},
+		Names:       []string{"f"},
+		Varnames:    []string{},
+		Freevars:    []string{},
+		Cellvars:    []string{},
+		Filename:    "<string>",
+		Name:        "<module>",
+		Firstlineno: 1,
+		Lnotab:      "",
+	}},
+	{"assert x, 'message'", "exec", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      1,
+		Flags:          64,
+		Code:           "\x64\x00\x00\x53",
+		Consts:         []py.Object{py.None},
+		Names:          []string{},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}},
+	{"__debug__", "eval", &py.Code{
+		Argcount:       0,
+		Kwonlyargcount: 0,
+		Nlocals:        0,
+		Stacksize:      1,
+		Flags:          64,
+		Code:           "\x64\x00\x00\x53",
+		Consts:         []py.Object{py.False},
+		Names:          []string{},
+		Varnames:       []string{},
+		Freevars:       []string{},
+		Cellvars:       []string{},
+		Filename:       "<string>",
+		Name:           "<module>",
+		Firstlineno:    1,
+		Lnotab:         "",
+	}},
+}
+
+func TestPeephole(t *testing.T) {
+	oldOptimize := Optimize
+	Optimize = 1
+	defer func() {
+		Optimize = oldOptimize
+	}()
+	for _, test := range peepholeTestData {
+		codeObj, err := Compile(test.in, "<string>", test.mode, 0, true)
+		if err != nil {
+			t.Errorf("%s: Got exception %v when not expecting one", test.in, err)
+			continue
+		}
+		code, ok := codeObj.(*py.Code)
+		if !ok {
+			t.Errorf("%s: Expecting *py.Code but got %T", test.in, codeObj)
+			continue
+		}
+		EqCode(t, test.in, test.out, code)
+	}
+}
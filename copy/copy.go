@@ -0,0 +1,521 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package copy implements the python copy module
+//
+// Objects are copied with the same protocol as CPython: the builtin
+// containers are copied directly, then the __copy__ and __deepcopy__
+// hooks are tried, then __reduce_ex__ and __reduce__, and finally
+// instances of python classes are rebuilt from their __dict__.
+package copy
+
+import (
+	"fmt"
+
+	"github.com/go-python/gpython/collections"
+	"github.com/go-python/gpython/py"
+)
+
+const module_doc = `Generic (shallow and deep) copying operations.
+
+Interface summary:
+
+        import copy
+
+        x = copy.copy(y)        # make a shallow copy of y
+        x = copy.deepcopy(y)    # make a deep copy of y
+
+For module specific errors, copy.Error is raised.
+
+The difference between shallow and deep copying is only relevant for
+compound objects (objects that contain other objects, like lists or
+class instances).
+
+- A shallow copy constructs a new compound object and then (to the
+  extent possible) inserts *the same objects* into it that the
+  original contains.
+
+- A deep copy constructs a new compound object and then, recursively,
+  inserts *copies* into it of the objects found in the original.
+
+Classes can use the same interfaces to control copying that they use
+to control pickling: they can define methods called __getinitargs__(),
+__getstate__() and __setstate__().  See the documentation for module
+"pickle" for information on these methods.`
+
+var Error = py.ExceptionType.NewType("copy.Error", "Raised for module specific errors.", nil, nil)
+
+// Returns true if x is never copied as it is immutable or has no
+// state worth copying
+func isAtomic(x py.Object) bool {
+	switch x.(type) {
+	case py.NoneType, py.Bool, py.Int, *py.BigInt, py.Float, py.Complex,
+		py.String, py.Bytes, py.EllipsisType,
+		*py.Range, *py.Slice, *py.Code, *py.Function, *py.Method,
+		*py.BoundMethod, *py.Property:
+		return true
+	}
+	// Classes, but not the instances of python classes which are
+	// also *py.Type but have no name
+	if t, ok := x.(*py.Type); ok && t.Name != "" {
+		return true
+	}
+	return x == py.NotImplemented
+}
+
+// Returns the attribute name of x or nil if it doesn't have one
+//
+// A hook set to None is returned as nil too and disables it.
+func getHook(x py.Object, name string) (py.Object, error) {
+	hook, err := py.GetAttrString(x, name)
+	if err != nil {
+		if py.IsException(py.AttributeError, err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if hook == py.None {
+		return nil, nil
+	}
+	return hook, nil
+}
+
+// Returns true if the class of x disables the hook name by setting it
+// to None
+func isDisabled(x py.Object, name string) bool {
+	return x.Type().Lookup(name) == py.None
+}
+
+// Calls copyreg.__newobj__(cls, *args) which makes an instance of cls
+// without calling __init__
+func newobj(self py.Object, args py.Tuple) (py.Object, error) {
+	if len(args) == 0 {
+		return nil, py.ExceptionNewf(py.TypeError, "__newobj__ expected at least 1 argument, got 0")
+	}
+	cls, ok := args[0].(*py.Type)
+	if !ok {
+		return nil, py.ExceptionNewf(py.TypeError, "__newobj__ arg 1 must be a type, not %s", args[0].Type().Name)
+	}
+	return cls.New(cls, args[1:], nil)
+}
+
+var newobjMethod = py.MustNewMethod("__newobj__", newobj, 0, "Makes an instance of cls without calling __init__")
+
+// Returns the reduce tuple for x
+//
+// This uses __reduce_ex__ or __reduce__ if x has them, otherwise it
+// makes the default one for an instance of a python class.  It
+// returns nil if x can't be reduced.
+func reduce(x py.Object) (py.Object, error) {
+	for _, name := range []string{"__reduce_ex__", "__reduce__"} {
+		hook, err := getHook(x, name)
+		if err != nil {
+			return nil, err
+		}
+		if hook == nil {
+			continue
+		}
+		var args py.Tuple
+		if name == "__reduce_ex__" {
+			args = py.Tuple{py.Int(4)}
+		}
+		return py.Call(hook, args, nil)
+	}
+	// The default reduction from object.__reduce_ex__
+	inst, ok := x.(*py.Type)
+	cls := x.Type()
+	if !ok || cls.Flags&py.TPFLAGS_HEAPTYPE == 0 || isDisabled(x, "__reduce_ex__") {
+		return nil, nil
+	}
+	args := py.Tuple{cls}
+	getnewargs, err := getHook(x, "__getnewargs__")
+	if err != nil {
+		return nil, err
+	}
+	if getnewargs != nil {
+		newargs, err := py.Call(getnewargs, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		tuple, ok := newargs.(py.Tuple)
+		if !ok {
+			return nil, py.ExceptionNewf(py.TypeError, "__getnewargs__ should return a tuple, not '%s'", newargs.Type().Name)
+		}
+		args = append(args, tuple...)
+	}
+	var state py.Object = py.None
+	getstate, err := getHook(x, "__getstate__")
+	if err != nil {
+		return nil, err
+	}
+	if getstate != nil {
+		state, err = py.Call(getstate, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+	} else if len(inst.Dict) != 0 {
+		state = inst.Dict
+	}
+	return py.Tuple{newobjMethod, args, state}, nil
+}
+
+// Sets the attributes in state on y
+func setAttrs(y, state py.Object) error {
+	if state == py.None {
+		return nil
+	}
+	dict, ok := state.(py.StringDict)
+	if !ok {
+		return py.ExceptionNewf(py.TypeError, "state must be a dict, not '%s'", state.Type().Name)
+	}
+	for name, value := range dict {
+		_, err := py.SetAttrString(y, name, value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rebuilds x from the result of reducing it
+//
+// If memo is not nil the parts are deep copied.
+func reconstruct(x, rv py.Object, memo py.StringDict) (py.Object, error) {
+	if _, ok := rv.(py.String); ok {
+		return x, nil
+	}
+	parts, ok := rv.(py.Tuple)
+	if !ok {
+		return nil, py.ExceptionNewf(py.TypeError, "__reduce__ must return a string or tuple")
+	}
+	if len(parts) < 2 || len(parts) > 5 {
+		return nil, py.ExceptionNewf(py.TypeError, "tuple returned by __reduce__ must contain 2 through 5 elements")
+	}
+	deep := memo != nil
+	var err error
+	// Copy the parts so the deep copies don't change rv
+	parts = append(parts.Copy(), make(py.Tuple, 5-len(parts))...)
+	for i := 2; i < 5; i++ {
+		if parts[i] == nil {
+			parts[i] = py.None
+		}
+	}
+	callable, args, state, listiter, dictiter := parts[0], parts[1], parts[2], parts[3], parts[4]
+	if deep {
+		args, err = deepcopy(args, memo)
+		if err != nil {
+			return nil, err
+		}
+	}
+	argsTuple, ok := args.(py.Tuple)
+	if !ok {
+		return nil, py.ExceptionNewf(py.TypeError, "second item of the tuple returned by __reduce__ must be a tuple")
+	}
+	y, err := py.Call(callable, argsTuple, nil)
+	if err != nil {
+		return nil, err
+	}
+	if deep {
+		memo[identity(x)] = y
+	}
+	if state != py.None {
+		if deep {
+			state, err = deepcopy(state, memo)
+			if err != nil {
+				return nil, err
+			}
+		}
+		setstate, err := getHook(y, "__setstate__")
+		if err != nil {
+			return nil, err
+		}
+		if setstate != nil {
+			_, err = py.Call(setstate, py.Tuple{state}, nil)
+		} else if pair, ok := state.(py.Tuple); ok && len(pair) == 2 {
+			err = setAttrs(y, pair[0])
+			if err == nil {
+				err = setAttrs(y, pair[1])
+			}
+		} else {
+			err = setAttrs(y, state)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if listiter != py.None {
+		var iterErr error
+		err = py.Iterate(listiter, func(item py.Object) bool {
+			if deep {
+				item, iterErr = deepcopy(item, memo)
+				if iterErr != nil {
+					return true
+				}
+			}
+			var appendMethod py.Object
+			appendMethod, iterErr = py.GetAttrString(y, "append")
+			if iterErr == nil {
+				_, iterErr = py.Call(appendMethod, py.Tuple{item}, nil)
+			}
+			return iterErr != nil
+		})
+		if err == nil {
+			err = iterErr
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if dictiter != py.None {
+		var iterErr error
+		err = py.Iterate(dictiter, func(item py.Object) bool {
+			pair, ok := item.(py.Tuple)
+			if !ok || len(pair) != 2 {
+				iterErr = py.ExceptionNewf(py.ValueError, "dict items iterator must return 2-tuples")
+				return true
+			}
+			key, value := pair[0], pair[1]
+			if deep {
+				key, iterErr = deepcopy(key, memo)
+				if iterErr == nil {
+					value, iterErr = deepcopy(value, memo)
+				}
+				if iterErr != nil {
+					return true
+				}
+			}
+			_, iterErr = py.SetItem(y, key, value)
+			return iterErr != nil
+		})
+		if err == nil {
+			err = iterErr
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return y, nil
+}
+
+// Returns a shallow copy of x
+func shallowcopy(x py.Object) (py.Object, error) {
+	if isAtomic(x) {
+		return x, nil
+	}
+	switch x := x.(type) {
+	case py.Tuple, *py.FrozenSet:
+		return x, nil
+	case *collections.NamedTuple:
+		return py.Call(x.Type(), x.Tuple, nil)
+	case *py.List:
+		return x.Copy(), nil
+	case py.StringDict:
+		return x.Copy(), nil
+	case *py.Set:
+		items, err := py.SequenceList(x)
+		if err != nil {
+			return nil, err
+		}
+		return py.NewSetFromItems(items.Items), nil
+	}
+	hook, err := getHook(x, "__copy__")
+	if err != nil {
+		return nil, err
+	}
+	if hook != nil {
+		return py.Call(hook, nil, nil)
+	}
+	rv, err := reduce(x)
+	if err != nil {
+		return nil, err
+	}
+	if rv == nil {
+		return nil, py.ExceptionNewf(Error, "un(shallow)copyable object of type %s", x.Type().Name)
+	}
+	return reconstruct(x, rv, nil)
+}
+
+const copy_doc = `Shallow copy operation on arbitrary Python objects.
+
+See the module's __doc__ string for more info.`
+
+func copy_copy(self, x py.Object) (py.Object, error) {
+	return shallowcopy(x)
+}
+
+// Returns the key of x in the memo
+//
+// This is unique to the object for as long as it is alive.  Tuples
+// include their length as slices of the same array share a pointer.
+func identity(x py.Object) string {
+	if t, ok := x.(py.Tuple); ok {
+		return fmt.Sprintf("%T%p:%d", t, t, len(t))
+	}
+	return fmt.Sprintf("%T%p", x, x)
+}
+
+// Deep copies each item of items returning the copies and whether any
+// of them are different objects from the originals
+func deepcopyItems(items []py.Object, memo py.StringDict) (py.Tuple, bool, error) {
+	res := make(py.Tuple, len(items))
+	changed := false
+	for i, item := range items {
+		y, err := deepcopy(item, memo)
+		if err != nil {
+			return nil, false, err
+		}
+		res[i] = y
+		if !py.Is(y, item) {
+			changed = true
+		}
+	}
+	return res, changed, nil
+}
+
+// Returns a deep copy of x using memo to find the objects already copied
+func deepcopy(x py.Object, memo py.StringDict) (py.Object, error) {
+	if isAtomic(x) {
+		return x, nil
+	}
+	key := identity(x)
+	if y, ok := memo[key]; ok {
+		return y, nil
+	}
+	var y py.Object
+	switch x := x.(type) {
+	case *py.List:
+		l := py.NewListWithCapacity(len(x.Items))
+		memo[key] = l
+		for _, item := range x.Items {
+			item, err := deepcopy(item, memo)
+			if err != nil {
+				return nil, err
+			}
+			l.Append(item)
+		}
+		return l, nil
+	case py.StringDict:
+		d := py.NewStringDict()
+		memo[key] = d
+		for k, v := range x {
+			v, err := deepcopy(v, memo)
+			if err != nil {
+				return nil, err
+			}
+			d[k] = v
+		}
+		return d, nil
+	case py.Tuple:
+		items, changed, err := deepcopyItems(x, memo)
+		if err != nil {
+			return nil, err
+		}
+		// The tuple may have been copied while copying its items
+		if y, ok := memo[key]; ok {
+			return y, nil
+		}
+		y = x
+		if changed {
+			y = items
+		}
+	case *collections.NamedTuple:
+		items, changed, err := deepcopyItems(x.Tuple, memo)
+		if err != nil {
+			return nil, err
+		}
+		if y, ok := memo[key]; ok {
+			return y, nil
+		}
+		y = x
+		if changed {
+			y, err = py.Call(x.Type(), items, nil)
+			if err != nil {
+				return nil, err
+			}
+		}
+	case *py.Set:
+		items, err := py.SequenceTuple(x)
+		if err != nil {
+			return nil, err
+		}
+		items, _, err = deepcopyItems(items, memo)
+		if err != nil {
+			return nil, err
+		}
+		y = py.NewSetFromItems(items)
+	case *py.FrozenSet:
+		items, err := py.SequenceTuple(x)
+		if err != nil {
+			return nil, err
+		}
+		items, changed, err := deepcopyItems(items, memo)
+		if err != nil {
+			return nil, err
+		}
+		y = x
+		if changed {
+			y = py.NewFrozenSetFromItems(items)
+		}
+	default:
+		hook, err := getHook(x, "__deepcopy__")
+		if err != nil {
+			return nil, err
+		}
+		if hook != nil {
+			y, err = py.Call(hook, py.Tuple{memo}, nil)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+		rv, err := reduce(x)
+		if err != nil {
+			return nil, err
+		}
+		if rv == nil {
+			return nil, py.ExceptionNewf(Error, "un(deep)copyable object of type %s", x.Type().Name)
+		}
+		y, err = reconstruct(x, rv, memo)
+		if err != nil {
+			return nil, err
+		}
+	}
+	memo[key] = y
+	return y, nil
+}
+
+const deepcopy_doc = `Deep copy operation on arbitrary Python objects.
+
+See the module's __doc__ string for more info.`
+
+func copy_deepcopy(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var x py.Object
+	var memoObj py.Object = py.None
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|O:deepcopy", []string{"x", "memo"}, &x, &memoObj)
+	if err != nil {
+		return nil, err
+	}
+	var memo py.StringDict
+	switch m := memoObj.(type) {
+	case py.NoneType:
+		memo = py.NewStringDict()
+	case py.StringDict:
+		memo = m
+	default:
+		return nil, py.ExceptionNewf(py.TypeError, "memo must be a dict, not '%s'", memoObj.Type().Name)
+	}
+	return deepcopy(x, memo)
+}
+
+func init() {
+	methods := []*py.Method{
+		py.MustNewMethod("copy", copy_copy, 0, copy_doc),
+		py.MustNewMethod("deepcopy", copy_deepcopy, 0, deepcopy_doc),
+	}
+	globals := py.StringDict{
+		"Error": Error,
+		"error": Error,
+	}
+	py.NewModule("copy", module_doc, methods, globals)
+}
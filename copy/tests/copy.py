@@ -0,0 +1,140 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+import copy
+from collections import OrderedDict, defaultdict, deque, Counter, ChainMap, namedtuple
+from libtest import assertRaises, assertRaisesText
+
+doc = "atomic"
+for x in (None, True, 1, 1.5, "abc", (1, 2), len, range(3), int):
+    assert copy.copy(x) is x
+    assert copy.deepcopy(x) is x
+assert copy.copy(b"abc") == b"abc"
+
+doc = "builtin containers"
+l = [1, [2, 3]]
+c = copy.copy(l)
+assert c == l and c is not l and c[1] is l[1]
+d = copy.deepcopy(l)
+assert d == l and d is not l and d[1] is not l[1]
+dct = {"a": [1]}
+c = copy.copy(dct)
+c["b"] = 2
+assert c == {"a": [1], "b": 2} and dct == {"a": [1]} and c["a"] is dct["a"]
+d = copy.deepcopy(dct)
+assert d == dct and d["a"] is not dct["a"]
+s = {1, 2}
+c = copy.copy(s)
+assert c == s and c is not s
+t = (1, [2])
+d = copy.deepcopy(t)
+assert d == t and d is not t and d[1] is not t[1]
+t = (1, (2, 3))
+assert copy.deepcopy(t) is t
+
+doc = "shared and recursive"
+inner = [1]
+outer = [inner, inner]
+d = copy.deepcopy(outer)
+assert d[0] is d[1] and d[0] is not inner
+r = [1]
+r.append(r)
+d = copy.deepcopy(r)
+assert d[1] is d and d is not r
+
+doc = "instances"
+class Point:
+    def __init__(self, x, y):
+        self.x = x
+        self.y = y
+p = Point(1, [2])
+c = copy.copy(p)
+assert type(c) is Point and c is not p and c.x == 1 and c.y is p.y
+d = copy.deepcopy(p)
+assert type(d) is Point and d.y == [2] and d.y is not p.y
+
+class Counted:
+    inits = 0
+    def __init__(self):
+        Counted.inits += 1
+        self.v = 1
+c = copy.copy(Counted())
+assert Counted.inits == 1 and c.v == 1
+
+doc = "hooks"
+class Hooked:
+    def __init__(self, v):
+        self.v = v
+    def __copy__(self):
+        return Hooked("copy")
+    def __deepcopy__(self, memo):
+        assert type(memo) is dict
+        return Hooked("deepcopy")
+assert copy.copy(Hooked(1)).v == "copy"
+assert copy.deepcopy(Hooked(1)).v == "deepcopy"
+assert copy.deepcopy([Hooked(1)])[0].v == "deepcopy"
+
+class Reduced:
+    def __init__(self, a, b):
+        self.a = a
+        self.b = b
+    def __reduce_ex__(self, proto):
+        assert proto == 4
+        return (Reduced, (self.a, self.b))
+r = copy.deepcopy(Reduced([1], 2))
+assert r.a == [1] and r.b == 2
+
+class State:
+    def __init__(self):
+        self.v = 1
+    def __getstate__(self):
+        return {"v": self.v + 1}
+    def __setstate__(self, state):
+        self.v = state["v"] * 10
+assert copy.copy(State()).v == 20
+
+doc = "memo"
+memo = {}
+x = [1]
+y = copy.deepcopy([x, x], memo)
+assert memo and y[0] is y[1]
+
+doc = "collections"
+od = OrderedDict([(1, [1]), (2, [2])])
+c = copy.copy(od)
+assert type(c) is OrderedDict and c == od and c is not od and c[1] is od[1]
+d = copy.deepcopy(od)
+assert d == od and d[1] is not od[1] and list(d.keys()) == [1, 2]
+dd = defaultdict(list, {"a": [1]})
+d = copy.deepcopy(dd)
+assert d.default_factory is list and d["a"] == [1] and d["a"] is not dd["a"]
+assert d["b"] == []
+q = deque([[1], [2]], 5)
+c = copy.copy(q)
+assert c == q and c is not q and c.maxlen == 5 and c[0] is q[0]
+d = copy.deepcopy(q)
+assert d == q and d.maxlen == 5 and d[0] is not q[0]
+cnt = Counter("abca")
+assert copy.deepcopy(cnt) == cnt
+cm = ChainMap({"a": 1}, {"b": [2]})
+d = copy.deepcopy(cm)
+assert d["b"] == [2] and d["b"] is not cm["b"]
+c = copy.copy(cm)
+assert c.maps[1] is cm.maps[1]
+P = namedtuple("P", "x y")
+p = P(1, [2])
+c = copy.copy(p)
+assert type(c) is P and c == p and c is not p and c.y is p.y
+d = copy.deepcopy(p)
+assert type(d) is P and d == p and d.y is not p.y
+
+doc = "errors"
+class NoCopy:
+    __reduce_ex__ = None
+    __reduce__ = None
+assertRaisesText(copy.Error, "un(shallow)copyable object of type", copy.copy, NoCopy())
+assertRaisesText(copy.Error, "un(deep)copyable object of type", copy.deepcopy, NoCopy())
+assert copy.error is copy.Error
+
+doc = "finished"
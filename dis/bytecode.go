@@ -0,0 +1,140 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Bytecode object
+
+package dis
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/go-python/gpython/py"
+)
+
+// Bytecode wraps a code object for easy access to details of its
+// compiled bytecode
+type Bytecode struct {
+	Codeobj       *py.Code
+	FirstLine     int
+	CurrentOffset int // -1 for none
+	original      py.Object
+	lineOffset    int
+}
+
+var BytecodeType = py.NewTypeX("Bytecode", `The bytecode operations of a piece of code
+
+Instantiate this with a function, method, string of code, or a code object
+(as returned by compile()).
+
+Iterating over this yields the bytecode operations as Instruction instances.`, BytecodeNew, nil)
+
+// Type of this object
+func (b *Bytecode) Type() *py.Type {
+	return BytecodeType
+}
+
+// BytecodeNew creates a new Bytecode object
+func BytecodeNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var x py.Object
+	var firstLine, currentOffset py.Object = py.None, py.None
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|OO:Bytecode", []string{"x", "first_line", "current_offset"}, &x, &firstLine, &currentOffset)
+	if err != nil {
+		return nil, err
+	}
+	code, err := getCode(x)
+	if err != nil {
+		return nil, err
+	}
+	b := &Bytecode{
+		Codeobj:       code,
+		FirstLine:     int(code.Firstlineno),
+		CurrentOffset: -1,
+		original:      x,
+	}
+	b.lineOffset, err = lineOffset(code, firstLine)
+	if err != nil {
+		return nil, err
+	}
+	b.FirstLine += b.lineOffset
+	if currentOffset != py.None {
+		b.CurrentOffset, err = py.MakeGoInt(currentOffset)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// Instructions returns the decoded instructions of the bytecode
+func (b *Bytecode) Instructions() ([]*Instruction, error) {
+	return GetInstructions(b.Codeobj, b.lineOffset)
+}
+
+// Dis returns a formatted view of the bytecode operations
+func (b *Bytecode) Dis() (string, error) {
+	instructions, err := b.Instructions()
+	if err != nil {
+		return "", err
+	}
+	var out bytes.Buffer
+	writeInstructions(&out, instructions, b.CurrentOffset)
+	return out.String(), nil
+}
+
+func (b *Bytecode) M__iter__() (py.Object, error) {
+	instructions, err := b.Instructions()
+	if err != nil {
+		return nil, err
+	}
+	return instructionIterator(instructions), nil
+}
+
+func (b *Bytecode) M__repr__() (py.Object, error) {
+	repr, err := py.ReprAsString(b.original)
+	if err != nil {
+		return nil, err
+	}
+	return py.String(fmt.Sprintf("Bytecode(%s)", repr)), nil
+}
+
+// Check interface is satisfied
+var _ py.I__iter__ = (*Bytecode)(nil)
+var _ py.I__repr__ = (*Bytecode)(nil)
+
+func init() {
+	BytecodeType.Dict["codeobj"] = &py.Property{
+		Fget: func(self py.Object) (py.Object, error) {
+			return self.(*Bytecode).Codeobj, nil
+		},
+	}
+	BytecodeType.Dict["first_line"] = &py.Property{
+		Fget: func(self py.Object) (py.Object, error) {
+			return py.Int(self.(*Bytecode).FirstLine), nil
+		},
+	}
+	BytecodeType.Dict["current_offset"] = &py.Property{
+		Fget: func(self py.Object) (py.Object, error) {
+			b := self.(*Bytecode)
+			if b.CurrentOffset < 0 {
+				return py.None, nil
+			}
+			return py.Int(b.CurrentOffset), nil
+		},
+	}
+	BytecodeType.Dict["dis"] = py.MustNewMethod("dis", func(self py.Object) (py.Object, error) {
+		out, err := self.(*Bytecode).Dis()
+		if err != nil {
+			return nil, err
+		}
+		return py.String(out), nil
+	}, 0, "dis() -> Return a formatted view of the bytecode operations.")
+	BytecodeType.Dict["info"] = py.MustNewMethod("info", func(self py.Object) (py.Object, error) {
+		info, err := CodeInfo(self.(*Bytecode).Codeobj)
+		if err != nil {
+			return nil, err
+		}
+		return py.String(info), nil
+	}, 0, "info() -> Return formatted information about the code object.")
+}
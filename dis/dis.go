@@ -0,0 +1,919 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Dis module
+//
+// Disassembler of python bytecode into mnemonics.
+
+package dis
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/go-python/gpython/py"
+	"github.com/go-python/gpython/vm"
+)
+
+const module_doc = `Disassembler of Python byte code into mnemonics.`
+
+// Names of the comparison operators used by COMPARE_OP
+var cmpOp = []string{"<", "<=", "==", "!=", ">", ">=", "in", "not in", "is", "is not", "exception match", "BAD"}
+
+// Opcodes which take the different kinds of argument
+var (
+	hasConst   = []vm.OpCode{vm.LOAD_CONST}
+	hasName    = []vm.OpCode{vm.STORE_NAME, vm.DELETE_NAME, vm.STORE_ATTR, vm.DELETE_ATTR, vm.STORE_GLOBAL, vm.DELETE_GLOBAL, vm.LOAD_NAME, vm.LOAD_ATTR, vm.IMPORT_NAME, vm.IMPORT_FROM, vm.LOAD_GLOBAL}
+	hasJrel    = []vm.OpCode{vm.FOR_ITER, vm.JUMP_FORWARD, vm.SETUP_LOOP, vm.SETUP_EXCEPT, vm.SETUP_FINALLY, vm.SETUP_WITH, vm.SETUP_ASYNC_WITH}
+	hasJabs    = []vm.OpCode{vm.JUMP_IF_FALSE_OR_POP, vm.JUMP_IF_TRUE_OR_POP, vm.JUMP_ABSOLUTE, vm.POP_JUMP_IF_FALSE, vm.POP_JUMP_IF_TRUE, vm.CONTINUE_LOOP}
+	hasLocal   = []vm.OpCode{vm.LOAD_FAST, vm.STORE_FAST, vm.DELETE_FAST}
+	hasCompare = []vm.OpCode{vm.COMPARE_OP}
+	hasFree    = []vm.OpCode{vm.LOAD_CLOSURE, vm.LOAD_DEREF, vm.STORE_DEREF, vm.DELETE_DEREF, vm.LOAD_CLASSDEREF}
+	hasNargs   = []vm.OpCode{vm.CALL_FUNCTION, vm.CALL_FUNCTION_VAR, vm.CALL_FUNCTION_KW, vm.CALL_FUNCTION_VAR_KW}
+)
+
+// Kind of argument an opcode takes
+type argKind byte
+
+const (
+	argNone argKind = iota
+	argConst
+	argName
+	argJrel
+	argJabs
+	argLocal
+	argCompare
+	argFree
+	argNargs
+)
+
+var (
+	// Name of each opcode indexed by opcode
+	opNames [256]string
+	// Kind of argument of each opcode indexed by opcode
+	argKinds [256]argKind
+)
+
+func init() {
+	for i := range opNames {
+		op := vm.OpCode(i)
+		name := op.String()
+		if strings.HasPrefix(name, "OpCode(") {
+			name = fmt.Sprintf("<%d>", i)
+		}
+		opNames[i] = name
+	}
+	// HAVE_ARGUMENT is an alias for STORE_NAME
+	opNames[vm.STORE_NAME] = "STORE_NAME"
+	for kind, ops := range map[argKind][]vm.OpCode{
+		argConst:   hasConst,
+		argName:    hasName,
+		argJrel:    hasJrel,
+		argJabs:    hasJabs,
+		argLocal:   hasLocal,
+		argCompare: hasCompare,
+		argFree:    hasFree,
+		argNargs:   hasNargs,
+	} {
+		for _, op := range ops {
+			argKinds[op] = kind
+		}
+	}
+}
+
+// OpName returns the name of the opcode as used by the disassembler
+func OpName(op vm.OpCode) string {
+	return opNames[op]
+}
+
+// Instruction is a single decoded bytecode operation
+type Instruction struct {
+	Opname       string    // human readable name for operation
+	Opcode       vm.OpCode // numeric code for operation
+	Arg          int       // numeric argument to operation or -1 if none
+	Argval       py.Object // resolved arg value or None
+	Argrepr      string    // human readable description of operation argument
+	Offset       int       // start index of operation within bytecode sequence
+	StartsLine   int       // line started by this opcode or 0 if none
+	IsJumpTarget bool      // true if other code jumps to here
+}
+
+var InstructionType = py.NewType("Instruction", "Details for a bytecode operation")
+
+// Type of this object
+func (i *Instruction) Type() *py.Type {
+	return InstructionType
+}
+
+func (i *Instruction) M__repr__() (py.Object, error) {
+	fields := make([]string, 0, 8)
+	add := func(name string, value py.Object) error {
+		repr, err := py.ReprAsString(value)
+		if err != nil {
+			return err
+		}
+		fields = append(fields, name+"="+repr)
+		return nil
+	}
+	for _, name := range instructionFields {
+		value, err := py.GetAttrString(i, name)
+		if err != nil {
+			return nil, err
+		}
+		err = add(name, value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return py.String("Instruction(" + strings.Join(fields, ", ") + ")"), nil
+}
+
+func (i *Instruction) M__eq__(other py.Object) (py.Object, error) {
+	b, ok := other.(*Instruction)
+	if !ok {
+		return py.NotImplemented, nil
+	}
+	a := *i
+	if a.Opcode != b.Opcode || a.Arg != b.Arg || a.Argrepr != b.Argrepr || a.Offset != b.Offset || a.StartsLine != b.StartsLine || a.IsJumpTarget != b.IsJumpTarget {
+		return py.False, nil
+	}
+	return py.Eq(a.Argval, b.Argval)
+}
+
+func (i *Instruction) M__ne__(other py.Object) (py.Object, error) {
+	eq, err := i.M__eq__(other)
+	if err != nil || eq == py.NotImplemented {
+		return eq, err
+	}
+	return py.Not(eq)
+}
+
+// Check interface is satisfied
+var _ py.I__repr__ = (*Instruction)(nil)
+var _ py.I__eq__ = (*Instruction)(nil)
+var _ py.I__ne__ = (*Instruction)(nil)
+
+// Names of the attributes of an Instruction in order
+var instructionFields = []string{"opname", "opcode", "arg", "argval", "argrepr", "offset", "starts_line", "is_jump_target"}
+
+func init() {
+	intOrNone := func(i int, none int) py.Object {
+		if i == none {
+			return py.None
+		}
+		return py.Int(i)
+	}
+	for name, fget := range map[string]func(i *Instruction) py.Object{
+		"opname":         func(i *Instruction) py.Object { return py.String(i.Opname) },
+		"opcode":         func(i *Instruction) py.Object { return py.Int(i.Opcode) },
+		"arg":            func(i *Instruction) py.Object { return intOrNone(i.Arg, -1) },
+		"argval":         func(i *Instruction) py.Object { return i.Argval },
+		"argrepr":        func(i *Instruction) py.Object { return py.String(i.Argrepr) },
+		"offset":         func(i *Instruction) py.Object { return py.Int(i.Offset) },
+		"starts_line":    func(i *Instruction) py.Object { return intOrNone(i.StartsLine, 0) },
+		"is_jump_target": func(i *Instruction) py.Object { return py.NewBool(i.IsJumpTarget) },
+	} {
+		fget := fget
+		InstructionType.Dict[name] = &py.Property{
+			Fget: func(self py.Object) (py.Object, error) {
+				return fget(self.(*Instruction)), nil
+			},
+		}
+	}
+	InstructionType.Dict["_fields"] = stringTuple(instructionFields)
+}
+
+// Make a Tuple of Strings
+func stringTuple(xs []string) py.Tuple {
+	t := make(py.Tuple, len(xs))
+	for i, x := range xs {
+		t[i] = py.String(x)
+	}
+	return t
+}
+
+// LineStart is the line number which starts at the bytecode Offset
+type LineStart struct {
+	Offset int
+	Line   int
+}
+
+// FindLineStarts finds the offsets in the bytecode which are the
+// start of lines in the source code using the line number table.
+func FindLineStarts(code *py.Code) []LineStart {
+	var starts []LineStart
+	lastLine := -1
+	line := int(code.Firstlineno)
+	addr := 0
+	lnotab := code.Lnotab
+	for i := 0; i+1 < len(lnotab); i += 2 {
+		byteIncr, lineIncr := int(lnotab[i]), int(lnotab[i+1])
+		if byteIncr != 0 {
+			if line != lastLine {
+				starts = append(starts, LineStart{Offset: addr, Line: line})
+				lastLine = line
+			}
+			addr += byteIncr
+		}
+		line += lineIncr
+	}
+	if line != lastLine {
+		starts = append(starts, LineStart{Offset: addr, Line: line})
+	}
+	return starts
+}
+
+// Decodes the next instruction at offset i of code returning the
+// opcode, the argument (or -1) and the offset of the next
+// instruction.  EXTENDED_ARG is folded into the argument of the
+// instruction which follows it.
+func decode(code string, i int) (op vm.OpCode, arg int, next int) {
+	extendedArg := 0
+	for {
+		op = vm.OpCode(code[i])
+		i++
+		if !op.HAS_ARG() {
+			return op, -1, i
+		}
+		if i+1 >= len(code) {
+			return op, extendedArg, len(code)
+		}
+		arg = int(code[i]) | int(code[i+1])<<8 | extendedArg
+		i += 2
+		if op != vm.EXTENDED_ARG {
+			return op, arg, i
+		}
+		extendedArg = arg << 16
+	}
+}
+
+// FindLabels finds the offsets in the bytecode which are jump targets
+func FindLabels(code string) []int {
+	var labels []int
+	seen := make(map[int]bool)
+	for i := 0; i < len(code); {
+		op, arg, next := decode(code, i)
+		label := -1
+		switch argKinds[op] {
+		case argJrel:
+			label = next + arg
+		case argJabs:
+			label = arg
+		}
+		if label >= 0 && !seen[label] {
+			seen[label] = true
+			labels = append(labels, label)
+		}
+		i = next
+	}
+	return labels
+}
+
+// Look up index i in names returning the name or a description of
+// the index if it is out of range
+func nameInfo(i int, names []string) (py.Object, string) {
+	if i >= 0 && i < len(names) {
+		return py.String(names[i]), names[i]
+	}
+	return py.Int(i), fmt.Sprintf("%d", i)
+}
+
+// GetInstructions decodes the bytecode in code into Instructions.
+//
+// lineOffset is added to the line numbers found in the line number
+// table.
+func GetInstructions(code *py.Code, lineOffset int) ([]*Instruction, error) {
+	lineStarts := make(map[int]int)
+	for _, start := range FindLineStarts(code) {
+		lineStarts[start.Offset] = start.Line
+	}
+	labels := make(map[int]bool)
+	for _, label := range FindLabels(code.Code) {
+		labels[label] = true
+	}
+	cells := append(append([]string(nil), code.Cellvars...), code.Freevars...)
+	var instructions []*Instruction
+	co := code.Code
+	for i := 0; i < len(co); {
+		op, arg, next := decode(co, i)
+		instr := &Instruction{
+			Opname:       OpName(op),
+			Opcode:       op,
+			Arg:          arg,
+			Argval:       py.None,
+			Offset:       i,
+			IsJumpTarget: labels[i],
+		}
+		if line, ok := lineStarts[i]; ok {
+			instr.StartsLine = line + lineOffset
+		}
+		if arg >= 0 {
+			instr.Argval = py.Int(arg)
+			switch argKinds[op] {
+			case argConst:
+				if arg < len(code.Consts) {
+					instr.Argval = code.Consts[arg]
+					repr, err := py.ReprAsString(instr.Argval)
+					if err != nil {
+						return nil, err
+					}
+					instr.Argrepr = repr
+				} else {
+					instr.Argrepr = fmt.Sprintf("%d", arg)
+				}
+			case argName:
+				instr.Argval, instr.Argrepr = nameInfo(arg, code.Names)
+			case argJrel:
+				instr.Argval = py.Int(next + arg)
+				instr.Argrepr = fmt.Sprintf("to %d", next+arg)
+			case argLocal:
+				instr.Argval, instr.Argrepr = nameInfo(arg, code.Varnames)
+			case argCompare:
+				if arg < len(cmpOp) {
+					instr.Argval = py.String(cmpOp[arg])
+					instr.Argrepr = cmpOp[arg]
+				}
+			case argFree:
+				instr.Argval, instr.Argrepr = nameInfo(arg, cells)
+			case argNargs:
+				instr.Argrepr = fmt.Sprintf("%d positional, %d keyword pair", arg&0xFF, (arg>>8)&0xFF)
+			}
+		}
+		instructions = append(instructions, instr)
+		i = next
+	}
+	return instructions, nil
+}
+
+// Formats the instruction as a line of a disassembly listing
+func (i *Instruction) format(linenoWidth int, current bool) string {
+	fields := make([]string, 0, 7)
+	if linenoWidth > 0 {
+		if i.StartsLine != 0 {
+			fields = append(fields, fmt.Sprintf("%*d", linenoWidth, i.StartsLine))
+		} else {
+			fields = append(fields, strings.Repeat(" ", linenoWidth))
+		}
+	}
+	if current {
+		fields = append(fields, "-->")
+	} else {
+		fields = append(fields, "   ")
+	}
+	if i.IsJumpTarget {
+		fields = append(fields, ">>")
+	} else {
+		fields = append(fields, "  ")
+	}
+	fields = append(fields, fmt.Sprintf("%4d", i.Offset))
+	fields = append(fields, fmt.Sprintf("%-20s", i.Opname))
+	if i.Arg >= 0 {
+		fields = append(fields, fmt.Sprintf("%5d", i.Arg))
+		if i.Argrepr != "" {
+			fields = append(fields, "("+i.Argrepr+")")
+		}
+	}
+	return strings.TrimRight(strings.Join(fields, " "), " ")
+}
+
+// Writes a disassembly listing of the instructions to w marking the
+// instruction at lasti as current
+func writeInstructions(w io.Writer, instructions []*Instruction, lasti int) {
+	linenoWidth := 3
+	for _, instr := range instructions {
+		if instr.StartsLine >= 1000 {
+			linenoWidth = len(fmt.Sprintf("%d", instr.StartsLine))
+		}
+	}
+	for _, instr := range instructions {
+		if instr.StartsLine != 0 && instr.Offset > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintln(w, instr.format(linenoWidth, instr.Offset == lasti))
+	}
+}
+
+// Disassemble writes a disassembly listing of code to w
+//
+// The instruction at offset lasti is marked as the current
+// instruction - pass -1 to not mark any instructions.
+func Disassemble(w io.Writer, code *py.Code, lasti int) error {
+	instructions, err := GetInstructions(code, 0)
+	if err != nil {
+		return err
+	}
+	writeInstructions(w, instructions, lasti)
+	return nil
+}
+
+// DisassembleAll writes a disassembly listing of code to w followed
+// by listings for all the code objects in its constants recursively.
+func DisassembleAll(w io.Writer, code *py.Code) error {
+	err := Disassemble(w, code, -1)
+	if err != nil {
+		return err
+	}
+	for _, c := range code.Consts {
+		if inner, ok := c.(*py.Code); ok {
+			repr, err := py.ReprAsString(inner)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "\nDisassembly of %s:\n", repr)
+			err = DisassembleAll(w, inner)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Names of the bits in Code.Flags
+var codeFlagNames = map[int32]string{
+	py.CO_OPTIMIZED:          "OPTIMIZED",
+	py.CO_NEWLOCALS:          "NEWLOCALS",
+	py.CO_VARARGS:            "VARARGS",
+	py.CO_VARKEYWORDS:        "VARKEYWORDS",
+	py.CO_NESTED:             "NESTED",
+	py.CO_GENERATOR:          "GENERATOR",
+	py.CO_NOFREE:             "NOFREE",
+	py.CO_COROUTINE:          "COROUTINE",
+	py.CO_ITERABLE_COROUTINE: "ITERABLE_COROUTINE",
+}
+
+// Formats code flags in a human readable form
+func prettyFlags(flags int32) string {
+	var names []string
+	for i := uint(0); i < 32; i++ {
+		flag := int32(1) << i
+		if flags&flag != 0 {
+			name, ok := codeFlagNames[flag]
+			if !ok {
+				name = fmt.Sprintf("0x%x", flag)
+			}
+			names = append(names, name)
+			flags ^= flag
+			if flags == 0 {
+				return strings.Join(names, ", ")
+			}
+		}
+	}
+	names = append(names, fmt.Sprintf("0x%x", flags))
+	return strings.Join(names, ", ")
+}
+
+// CodeInfo returns a formatted multi-line string with detailed
+// information about the code object
+func CodeInfo(code *py.Code) (string, error) {
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "Name:              %s\n", code.Name)
+	fmt.Fprintf(&out, "Filename:          %s\n", code.Filename)
+	fmt.Fprintf(&out, "Argument count:    %d\n", code.Argcount)
+	fmt.Fprintf(&out, "Positional-only arguments: %d\n", code.Posonlyargcount)
+	fmt.Fprintf(&out, "Kw-only arguments: %d\n", code.Kwonlyargcount)
+	fmt.Fprintf(&out, "Number of locals:  %d\n", code.Nlocals)
+	fmt.Fprintf(&out, "Stack size:        %d\n", code.Stacksize)
+	fmt.Fprintf(&out, "Flags:             %s\n", prettyFlags(code.Flags))
+	if len(code.Consts) > 0 {
+		fmt.Fprintf(&out, "Constants:\n")
+		for i, c := range code.Consts {
+			repr, err := py.ReprAsString(c)
+			if err != nil {
+				return "", err
+			}
+			fmt.Fprintf(&out, "%4d: %s\n", i, repr)
+		}
+	}
+	for _, names := range []struct {
+		title string
+		names []string
+	}{
+		{"Names", code.Names},
+		{"Variable names", code.Varnames},
+		{"Free variables", code.Freevars},
+		{"Cell variables", code.Cellvars},
+	} {
+		if len(names.names) > 0 {
+			fmt.Fprintf(&out, "%s:\n", names.title)
+			for i, name := range names.names {
+				fmt.Fprintf(&out, "%4d: %s\n", i, name)
+			}
+		}
+	}
+	return strings.TrimSuffix(out.String(), "\n"), nil
+}
+
+// Compiles source code for disassembly, trying it as an expression
+// first and then as statements
+func compileSource(source string, name string) (*py.Code, error) {
+	obj, err := py.Compile(source, name, "eval", 0, true)
+	if err != nil {
+		obj, err = py.Compile(source, name, "exec", 0, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return obj.(*py.Code), nil
+}
+
+// Finds the code object for x which may be a function, method,
+// generator, code object or source code
+func getCode(x py.Object) (*py.Code, error) {
+	switch o := x.(type) {
+	case *py.BoundMethod:
+		return getCode(o.Method)
+	case *py.Function:
+		return o.Code, nil
+	case *py.Generator:
+		return o.Code, nil
+	case *py.Code:
+		return o, nil
+	case py.String:
+		return compileSource(string(o), "<disassembly>")
+	}
+	return nil, py.ExceptionNewf(py.TypeError, "don't know how to disassemble %s objects", x.Type().Name)
+}
+
+// Returns the file to write to, defaulting to sys.stdout of ctx
+func getFile(ctx *py.Context, file py.Object) (py.Object, error) {
+	if file != nil && file != py.None {
+		return file, nil
+	}
+	sys, err := ctx.GetModule("sys")
+	if err != nil {
+		return nil, err
+	}
+	stdout, ok := sys.Globals["stdout"]
+	if !ok {
+		return nil, py.ExceptionNewf(py.RuntimeError, "lost sys.stdout")
+	}
+	return stdout, nil
+}
+
+// Writes out to the python file object returned by getFile
+func writeFile(file py.Object, out string) error {
+	write, err := py.GetAttrString(file, "write")
+	if err != nil {
+		return err
+	}
+	_, err = py.Call(write, py.Tuple{py.String(out)}, nil)
+	return err
+}
+
+// Disassembles x writing the output to file
+func disassembleObject(x py.Object, file py.Object) error {
+	var dict py.StringDict
+	switch o := x.(type) {
+	case *py.Type:
+		dict = o.Dict
+	case *py.Module:
+		dict = o.Globals
+	}
+	if dict != nil {
+		names := make([]string, 0, len(dict))
+		for name := range dict {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			switch dict[name].(type) {
+			case *py.BoundMethod, *py.Function, *py.Generator, *py.Code, *py.Type:
+			default:
+				continue
+			}
+			err := writeFile(file, fmt.Sprintf("Disassembly of %s:\n", name))
+			if err != nil {
+				return err
+			}
+			err = disassembleObject(dict[name], file)
+			if err != nil {
+				if !py.IsException(py.TypeError, err) {
+					return err
+				}
+				err = writeFile(file, fmt.Sprintf("Sorry: %v\n", err))
+				if err != nil {
+					return err
+				}
+			}
+			err = writeFile(file, "\n")
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	code, err := getCode(x)
+	if err != nil {
+		return err
+	}
+	var out bytes.Buffer
+	err = Disassemble(&out, code, -1)
+	if err != nil {
+		return err
+	}
+	return writeFile(file, out.String())
+}
+
+// Disassembles the traceback tb or sys.last_traceback if it is None
+func disassembleTraceback(ctx *py.Context, tb py.Object, file py.Object) error {
+	if tb == nil || tb == py.None {
+		sys, err := ctx.GetModule("sys")
+		if err != nil {
+			return err
+		}
+		var ok bool
+		tb, ok = sys.Globals["last_traceback"]
+		if !ok {
+			return py.ExceptionNewf(py.RuntimeError, "no last traceback to disassemble")
+		}
+	}
+	traceback, ok := tb.(*py.Traceback)
+	if !ok {
+		return py.ExceptionNewf(py.TypeError, "expecting traceback, got %s", tb.Type().Name)
+	}
+	for traceback.Next != nil {
+		traceback = traceback.Next
+	}
+	var out bytes.Buffer
+	err := Disassemble(&out, traceback.Frame.Code, int(traceback.Lasti))
+	if err != nil {
+		return err
+	}
+	return writeFile(file, out.String())
+}
+
+const dis_doc = `dis(x=None, *, file=None)
+
+Disassemble classes, methods, functions, generators, or code.
+
+With no argument, disassemble the last traceback.`
+
+func dis_dis(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var x, file py.Object = py.None, py.None
+	err := py.ParseTupleAndKeywords(args, kwargs, "|OO:dis", []string{"x", "file"}, &x, &file)
+	if err != nil {
+		return nil, err
+	}
+	ctx := py.GetContext(self)
+	file, err = getFile(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+	if x == py.None {
+		err = disassembleTraceback(ctx, py.None, file)
+	} else {
+		err = disassembleObject(x, file)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return py.None, nil
+}
+
+const distb_doc = `distb(tb=None, *, file=None)
+
+Disassemble a traceback (default: last traceback).`
+
+func dis_distb(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var tb, file py.Object = py.None, py.None
+	err := py.ParseTupleAndKeywords(args, kwargs, "|OO:distb", []string{"tb", "file"}, &tb, &file)
+	if err != nil {
+		return nil, err
+	}
+	ctx := py.GetContext(self)
+	file, err = getFile(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+	err = disassembleTraceback(ctx, tb, file)
+	if err != nil {
+		return nil, err
+	}
+	return py.None, nil
+}
+
+const disassemble_doc = `disassemble(co, lasti=-1, *, file=None)
+
+Disassemble a code object.`
+
+func dis_disassemble(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var co, file py.Object
+	var lasti py.Object = py.Int(-1)
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|iO:disassemble", []string{"co", "lasti", "file"}, &co, &lasti, &file)
+	if err != nil {
+		return nil, err
+	}
+	code, ok := co.(*py.Code)
+	if !ok {
+		return nil, py.ExceptionNewf(py.TypeError, "disassemble() argument 1 must be code, not %s", co.Type().Name)
+	}
+	file, err = getFile(py.GetContext(self), file)
+	if err != nil {
+		return nil, err
+	}
+	var out bytes.Buffer
+	err = Disassemble(&out, code, int(lasti.(py.Int)))
+	if err != nil {
+		return nil, err
+	}
+	err = writeFile(file, out.String())
+	if err != nil {
+		return nil, err
+	}
+	return py.None, nil
+}
+
+const code_info_doc = `code_info(x)
+
+Formatted details of methods, functions, or code.`
+
+func dis_code_info(self py.Object, x py.Object) (py.Object, error) {
+	code, err := getCode(x)
+	if err != nil {
+		return nil, err
+	}
+	info, err := CodeInfo(code)
+	if err != nil {
+		return nil, err
+	}
+	return py.String(info), nil
+}
+
+const show_code_doc = `show_code(co, *, file=None)
+
+Print details of methods, functions, or code to file.`
+
+func dis_show_code(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var co, file py.Object
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|O:show_code", []string{"co", "file"}, &co, &file)
+	if err != nil {
+		return nil, err
+	}
+	file, err = getFile(py.GetContext(self), file)
+	if err != nil {
+		return nil, err
+	}
+	info, err := dis_code_info(nil, co)
+	if err != nil {
+		return nil, err
+	}
+	err = writeFile(file, string(info.(py.String))+"\n")
+	if err != nil {
+		return nil, err
+	}
+	return py.None, nil
+}
+
+// Reads an optional first_line argument returning the offset to add
+// to the line numbers of code
+func lineOffset(code *py.Code, firstLine py.Object) (int, error) {
+	if firstLine == nil || firstLine == py.None {
+		return 0, nil
+	}
+	line, err := py.MakeGoInt(firstLine)
+	if err != nil {
+		return 0, err
+	}
+	return line - int(code.Firstlineno), nil
+}
+
+// Makes a python iterator over the instructions
+func instructionIterator(instructions []*Instruction) *py.Iterator {
+	objs := make([]py.Object, len(instructions))
+	for i, instr := range instructions {
+		objs[i] = instr
+	}
+	return py.NewIterator(objs)
+}
+
+const get_instructions_doc = `get_instructions(x, *, first_line=None)
+
+Iterator for the opcodes in methods, functions or code
+
+Generates a series of Instruction named tuples giving the details of
+each operations in the supplied code.
+
+If *first_line* is not None, it indicates the line number that should
+be reported for the first source line in the disassembled code.
+Otherwise, the source line information (if any) is taken directly from
+the disassembled code object.`
+
+func dis_get_instructions(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var x py.Object
+	var firstLine py.Object = py.None
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|O:get_instructions", []string{"x", "first_line"}, &x, &firstLine)
+	if err != nil {
+		return nil, err
+	}
+	code, err := getCode(x)
+	if err != nil {
+		return nil, err
+	}
+	offset, err := lineOffset(code, firstLine)
+	if err != nil {
+		return nil, err
+	}
+	instructions, err := GetInstructions(code, offset)
+	if err != nil {
+		return nil, err
+	}
+	return instructionIterator(instructions), nil
+}
+
+const findlinestarts_doc = `findlinestarts(code)
+
+Find the offsets in a byte code which are start of lines in the source.
+
+Generate pairs (offset, lineno) as described in Python/compile.c.`
+
+func dis_findlinestarts(self py.Object, x py.Object) (py.Object, error) {
+	code, ok := x.(*py.Code)
+	if !ok {
+		return nil, py.ExceptionNewf(py.TypeError, "findlinestarts() argument must be code, not %s", x.Type().Name)
+	}
+	starts := FindLineStarts(code)
+	objs := make([]py.Object, len(starts))
+	for i, start := range starts {
+		objs[i] = py.Tuple{py.Int(start.Offset), py.Int(start.Line)}
+	}
+	return py.NewIterator(objs), nil
+}
+
+const findlabels_doc = `findlabels(code)
+
+Detect all offsets in a byte code which are jump targets.
+
+Return the list of offsets.`
+
+func dis_findlabels(self py.Object, x py.Object) (py.Object, error) {
+	var code string
+	switch o := x.(type) {
+	case py.Bytes:
+		code = string(o)
+	case *py.Code:
+		code = o.Code
+	default:
+		return nil, py.ExceptionNewf(py.TypeError, "findlabels() argument must be bytes, not %s", x.Type().Name)
+	}
+	labels := FindLabels(code)
+	list := py.NewListSized(len(labels))
+	for i, label := range labels {
+		list.Items[i] = py.Int(label)
+	}
+	return list, nil
+}
+
+// Make a list of opcodes as python ints
+func opcodeList(ops []vm.OpCode) *py.List {
+	list := py.NewListSized(len(ops))
+	for i, op := range ops {
+		list.Items[i] = py.Int(op)
+	}
+	return list
+}
+
+// Initialise the module
+func init() {
+	methods := []*py.Method{
+		py.MustNewMethod("dis", dis_dis, 0, dis_doc),
+		py.MustNewMethod("distb", dis_distb, 0, distb_doc),
+		py.MustNewMethod("disassemble", dis_disassemble, 0, disassemble_doc),
+		py.MustNewMethod("disco", dis_disassemble, 0, disassemble_doc),
+		py.MustNewMethod("code_info", dis_code_info, 0, code_info_doc),
+		py.MustNewMethod("show_code", dis_show_code, 0, show_code_doc),
+		py.MustNewMethod("get_instructions", dis_get_instructions, 0, get_instructions_doc),
+		py.MustNewMethod("findlinestarts", dis_findlinestarts, 0, findlinestarts_doc),
+		py.MustNewMethod("findlabels", dis_findlabels, 0, findlabels_doc),
+	}
+	opname := py.NewListSized(len(opNames))
+	opmap := py.NewStringDict()
+	for i, name := range opNames {
+		opname.Items[i] = py.String(name)
+		if !strings.HasPrefix(name, "<") {
+			opmap[name] = py.Int(i)
+		}
+	}
+	globals := py.StringDict{
+		"Bytecode":      BytecodeType,
+		"Instruction":   InstructionType,
+		"opname":        opname,
+		"opmap":         opmap,
+		"cmp_op":        stringTuple(cmpOp),
+		"hasconst":      opcodeList(hasConst),
+		"hasname":       opcodeList(hasName),
+		"hasjrel":       opcodeList(hasJrel),
+		"hasjabs":       opcodeList(hasJabs),
+		"haslocal":      opcodeList(hasLocal),
+		"hascompare":    opcodeList(hasCompare),
+		"hasfree":       opcodeList(hasFree),
+		"hasnargs":      opcodeList(hasNargs),
+		"HAVE_ARGUMENT": py.Int(vm.HAVE_ARGUMENT),
+		"EXTENDED_ARG":  py.Int(vm.EXTENDED_ARG),
+	}
+	py.NewModule("dis", module_doc, methods, globals)
+}
@@ -0,0 +1,16 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dis_test
+
+import (
+	"testing"
+
+	_ "github.com/go-python/gpython/dis"
+	"github.com/go-python/gpython/pytest"
+)
+
+func TestDis(t *testing.T) {
+	pytest.RunTests(t, "tests")
+}
@@ -0,0 +1,133 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+import dis
+import sys
+
+class Writer:
+    def __init__(self):
+        self.out = ""
+    def write(self, s):
+        self.out += s
+
+def f(a):
+    return a + 1
+
+doc="opname and opmap"
+assert dis.opname[1] == "POP_TOP"
+assert dis.opname[90] == "STORE_NAME"
+assert dis.opname[0] == "<0>"
+assert dis.opmap["LOAD_CONST"] == 100
+assert dis.opmap["RETURN_VALUE"] == 83
+assert dis.HAVE_ARGUMENT == 90
+assert dis.cmp_op[2] == "=="
+assert dis.opmap["LOAD_CONST"] in dis.hasconst
+assert dis.opmap["JUMP_FORWARD"] in dis.hasjrel
+assert dis.opmap["JUMP_ABSOLUTE"] in dis.hasjabs
+
+doc="get_instructions"
+instrs = list(dis.get_instructions(f))
+assert [i.opname for i in instrs] == ["LOAD_FAST", "LOAD_CONST", "BINARY_ADD", "RETURN_VALUE"]
+i = instrs[0]
+assert i.opcode == dis.opmap["LOAD_FAST"]
+assert i.arg == 0
+assert i.argval == "a"
+assert i.argrepr == "a"
+assert i.offset == 0
+assert i.starts_line == 15
+assert i.is_jump_target == False
+assert instrs[1].argval == 1
+assert instrs[1].argrepr == "1"
+assert instrs[1].starts_line is None
+assert instrs[2].arg is None
+assert repr(i) == "Instruction(opname='LOAD_FAST', opcode=124, arg=0, argval='a', argrepr='a', offset=0, starts_line=15, is_jump_target=False)"
+assert list(dis.get_instructions(f, first_line=f.__code__.co_firstlineno+10))[0].starts_line == 25
+assert i == list(dis.get_instructions(f))[0]
+assert i != instrs[1]
+
+doc="dis function"
+w = Writer()
+dis.dis(f, file=w)
+assert w.out == """ 15           0 LOAD_FAST                0 (a)
+              3 LOAD_CONST               1 (1)
+              6 BINARY_ADD
+              7 RETURN_VALUE
+""", w.out
+
+doc="dis source"
+w = Writer()
+dis.dis("x = y", file=w)
+assert w.out == """  1           0 LOAD_NAME                0 (y)
+              3 STORE_NAME               1 (x)
+              6 LOAD_CONST               0 (None)
+              9 RETURN_VALUE
+""", w.out
+
+doc="dis jumps and compare"
+def g(x):
+    while x > 0:
+        x -= 1
+    return x
+w = Writer()
+dis.dis(g, file=w)
+assert ">>" in w.out
+assert "(>)" in w.out
+assert "(to " in w.out
+assert dis.findlabels(g.__code__.co_code) == [w for w in dis.findlabels(g.__code__)]
+lines = [line for offset, line in dis.findlinestarts(g.__code__)]
+assert lines == [69, 70, 71], lines
+
+doc="dis class"
+class C:
+    def m(self):
+        pass
+w = Writer()
+dis.dis(C, file=w)
+assert w.out.startswith("Disassembly of m:\n"), w.out
+
+doc="dis bound method"
+w = Writer()
+dis.dis(C().m, file=w)
+assert "LOAD_CONST" in w.out
+
+doc="dis bad type"
+try:
+    dis.dis(1)
+except TypeError as e:
+    pass
+else:
+    assert False, "TypeError not raised"
+
+doc="code_info"
+info = dis.code_info(f)
+assert info.startswith("Name:              f\n"), info
+assert "Argument count:    1\n" in info
+assert "Flags:             OPTIMIZED, NEWLOCALS, NOFREE" in info
+assert "Variable names:\n   0: a" in info
+w = Writer()
+dis.show_code(f, file=w)
+assert w.out == info + "\n"
+
+doc="Bytecode"
+b = dis.Bytecode(f)
+assert b.codeobj is f.__code__
+assert b.first_line == f.__code__.co_firstlineno
+assert b.current_offset is None
+assert [i.opname for i in b] == ["LOAD_FAST", "LOAD_CONST", "BINARY_ADD", "RETURN_VALUE"]
+assert b.info() == dis.code_info(f)
+assert "-->" not in b.dis()
+b = dis.Bytecode(f, current_offset=3)
+assert "    -->       3 LOAD_CONST" in b.dis(), b.dis()
+assert repr(dis.Bytecode("1")).startswith("Bytecode(")
+
+doc="distb"
+try:
+    1/0
+except ZeroDivisionError as e:
+    w = Writer()
+    dis.distb(sys.exc_info()[2], file=w)
+    assert "--> " in w.out
+    assert "BINARY_TRUE_DIVIDE" in w.out
+
+doc="finished"
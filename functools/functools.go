@@ -0,0 +1,247 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package functools implements the python functools module
+package functools
+
+import (
+	"github.com/go-python/gpython/py"
+)
+
+const module_doc = `Tools for working with functions and callable objects`
+
+var (
+	// Attributes update_wrapper copies from the wrapped function
+	WRAPPER_ASSIGNMENTS = py.Tuple{py.String("__module__"), py.String("__name__"), py.String("__qualname__"), py.String("__doc__"), py.String("__annotations__")}
+	// Attributes of the wrapper update_wrapper updates from the wrapped function
+	WRAPPER_UPDATES = py.Tuple{py.String("__dict__")}
+)
+
+// Returns whether o is true
+func isTrue(o py.Object) (bool, error) {
+	res, err := py.MakeBool(o)
+	if err != nil {
+		return false, err
+	}
+	return res == py.True, nil
+}
+
+// Returns the name of a callable for error messages
+func nameOf(fn py.Object) string {
+	name, err := py.GetAttrString(fn, "__name__")
+	if err == nil {
+		if s, ok := name.(py.String); ok {
+			return string(s)
+		}
+	}
+	return fn.Type().Name
+}
+
+// Binds callable o to instance when it is read from a class
+//
+// This is shared by the Go objects which are used as methods
+func bind(o, instance py.Object) (py.Object, error) {
+	if instance == nil || instance == py.None {
+		return o, nil
+	}
+	return py.NewBoundMethod(instance, o), nil
+}
+
+const reduce_doc = `reduce(function, sequence[, initial]) -> value
+
+Apply a function of two arguments cumulatively to the items of a sequence,
+from left to right, so as to reduce the sequence to a single value.
+For example, reduce(lambda x, y: x+y, [1, 2, 3, 4, 5]) calculates
+((((1+2)+3)+4)+5).  If initial is present, it is placed before the items
+of the sequence in the calculation, and serves as a default when the
+sequence is empty.`
+
+func functools_reduce(self py.Object, args py.Tuple) (py.Object, error) {
+	var fn, sequence, result py.Object
+	err := py.UnpackTuple(args, nil, "reduce", 2, 3, &fn, &sequence, &result)
+	if err != nil {
+		return nil, err
+	}
+	it, err := py.Iter(sequence)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		item, err := py.Next(it)
+		if err != nil {
+			if py.IsException(py.StopIteration, err) {
+				break
+			}
+			return nil, err
+		}
+		if result == nil {
+			result = item
+			continue
+		}
+		result, err = py.Call(fn, py.Tuple{result, item}, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if result == nil {
+		return nil, py.ExceptionNewf(py.TypeError, "reduce() of empty sequence with no initial value")
+	}
+	return result, nil
+}
+
+const update_wrapper_doc = `Update a wrapper function to look like the wrapped function
+
+wrapper is the function to be updated
+wrapped is the original function
+assigned is a tuple naming the attributes assigned directly
+from the wrapped function to the wrapper function (defaults to
+functools.WRAPPER_ASSIGNMENTS)
+updated is a tuple naming the attributes of the wrapper that
+are updated with the corresponding attribute from the wrapped
+function (defaults to functools.WRAPPER_UPDATES)`
+
+// Reads a tuple of attribute names
+func attrNames(o py.Object) ([]string, error) {
+	items, err := py.SequenceTuple(o)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(items))
+	for i, item := range items {
+		name, ok := item.(py.String)
+		if !ok {
+			return nil, py.ExceptionNewf(py.TypeError, "attribute name must be string, not '%s'", item.Type().Name)
+		}
+		names[i] = string(name)
+	}
+	return names, nil
+}
+
+// Updates the dictionary attribute dict with the items of other
+func updateDict(dict, other py.Object) error {
+	d, ok := dict.(py.StringDict)
+	o, isDict := other.(py.StringDict)
+	if ok && isDict {
+		for k, v := range o {
+			d[k] = v
+		}
+		return nil
+	}
+	update, err := py.GetAttrString(dict, "update")
+	if err != nil {
+		return err
+	}
+	_, err = py.Call(update, py.Tuple{other}, nil)
+	return err
+}
+
+// Copies the assigned attributes of wrapped to wrapper and updates the
+// updated ones
+func updateWrapper(wrapper, wrapped py.Object, assigned, updated []string) error {
+	for _, name := range assigned {
+		value, err := py.GetAttrString(wrapped, name)
+		if err != nil {
+			if py.IsException(py.AttributeError, err) {
+				continue
+			}
+			return err
+		}
+		_, err = py.SetAttrString(wrapper, name, value)
+		if err != nil {
+			return err
+		}
+	}
+	for _, name := range updated {
+		value, err := py.GetAttrString(wrapped, name)
+		if err != nil {
+			if py.IsException(py.AttributeError, err) {
+				continue
+			}
+			return err
+		}
+		dict, err := py.GetAttrString(wrapper, name)
+		if err != nil {
+			return err
+		}
+		err = updateDict(dict, value)
+		if err != nil {
+			return err
+		}
+	}
+	_, err := py.SetAttrString(wrapper, "__wrapped__", wrapped)
+	return err
+}
+
+func functools_update_wrapper(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var wrapper, wrapped py.Object
+	var assignedObj py.Object = WRAPPER_ASSIGNMENTS
+	var updatedObj py.Object = WRAPPER_UPDATES
+	err := py.ParseTupleAndKeywords(args, kwargs, "OO|OO:update_wrapper", []string{"wrapper", "wrapped", "assigned", "updated"}, &wrapper, &wrapped, &assignedObj, &updatedObj)
+	if err != nil {
+		return nil, err
+	}
+	if wrapper == nil || wrapped == nil {
+		return nil, py.ExceptionNewf(py.TypeError, "update_wrapper() missing required argument 'wrapped'")
+	}
+	assigned, err := attrNames(assignedObj)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := attrNames(updatedObj)
+	if err != nil {
+		return nil, err
+	}
+	err = updateWrapper(wrapper, wrapped, assigned, updated)
+	if err != nil {
+		return nil, err
+	}
+	return wrapper, nil
+}
+
+var updateWrapperMethod = py.MustNewMethod("update_wrapper", functools_update_wrapper, 0, update_wrapper_doc)
+
+const wraps_doc = `Decorator factory to apply update_wrapper() to a wrapper function
+
+Returns a decorator that invokes update_wrapper() with the decorated
+function as the wrapper argument and the arguments to wraps() as the
+remaining arguments. Default arguments are as for update_wrapper().
+This is a convenience function to simplify applying partial() to
+update_wrapper().`
+
+func functools_wraps(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var wrapped py.Object
+	var assigned py.Object = WRAPPER_ASSIGNMENTS
+	var updated py.Object = WRAPPER_UPDATES
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|OO:wraps", []string{"wrapped", "assigned", "updated"}, &wrapped, &assigned, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return newPartial(updateWrapperMethod, nil, py.StringDict{
+		"wrapped":  wrapped,
+		"assigned": assigned,
+		"updated":  updated,
+	}), nil
+}
+
+func init() {
+	methods := []*py.Method{
+		py.MustNewMethod("reduce", functools_reduce, 0, reduce_doc),
+		updateWrapperMethod,
+		py.MustNewMethod("wraps", functools_wraps, 0, wraps_doc),
+		py.MustNewMethod("lru_cache", functools_lru_cache, 0, lru_cache_doc),
+		py.MustNewMethod("cache", functools_cache, 0, cache_doc),
+		py.MustNewMethod("total_ordering", functools_total_ordering, 0, total_ordering_doc),
+		py.MustNewMethod("cmp_to_key", functools_cmp_to_key, 0, cmp_to_key_doc),
+		py.MustNewMethod("singledispatch", functools_singledispatch, 0, singledispatch_doc),
+	}
+	globals := py.StringDict{
+		"partial":             PartialType,
+		"WRAPPER_ASSIGNMENTS": WRAPPER_ASSIGNMENTS,
+		"WRAPPER_UPDATES":     WRAPPER_UPDATES,
+		"_CacheInfo":          CacheInfoType,
+		"_lru_cache_wrapper":  LRUCacheWrapperType,
+		"KeyWrapper":          KeyWrapperType,
+	}
+	py.NewModule("functools", module_doc, methods, globals)
+}
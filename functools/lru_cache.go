@@ -0,0 +1,266 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// lru_cache and cache decorators
+
+package functools
+
+import (
+	"sort"
+
+	"github.com/go-python/gpython/collections"
+	"github.com/go-python/gpython/py"
+)
+
+// CacheInfoType is the namedtuple returned by cache_info()
+var CacheInfoType *py.Type
+
+func init() {
+	var err error
+	CacheInfoType, err = collections.NewNamedTuple("CacheInfo", "hits", "misses", "maxsize", "currsize")
+	if err != nil {
+		panic(err)
+	}
+}
+
+// A python _lru_cache_wrapper object
+//
+// The cache is an OrderedDict so it can use any hashable python
+// objects as keys.  The least recently used entry is at the front.
+type LRUCacheWrapper struct {
+	fn      py.Object
+	maxsize int // -1 for unbounded
+	typed   bool
+	cache   py.Object
+	hits    int
+	misses  int
+	dict    py.StringDict
+}
+
+var LRUCacheWrapperType = py.NewType("functools._lru_cache_wrapper", "Function wrapped with a least recently used cache")
+
+// Type of this object
+func (w *LRUCacheWrapper) Type() *py.Type {
+	return LRUCacheWrapperType
+}
+
+// Get the Dict
+func (w *LRUCacheWrapper) GetDict() py.StringDict {
+	return w.dict
+}
+
+// Makes a new empty cache
+func newCache() (py.Object, error) {
+	return collections.OrderedDictNew(collections.OrderedDictType, nil, nil)
+}
+
+// Wraps fn in a cache of maxsize entries
+func newLRUCacheWrapper(fn py.Object, maxsize int, typed bool) (*LRUCacheWrapper, error) {
+	cache, err := newCache()
+	if err != nil {
+		return nil, err
+	}
+	w := &LRUCacheWrapper{
+		fn:      fn,
+		maxsize: maxsize,
+		typed:   typed,
+		cache:   cache,
+		dict:    py.NewStringDict(),
+	}
+	_, err = functools_update_wrapper(nil, py.Tuple{w, fn}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Makes the cache key for a call
+//
+// This is the tuple of the arguments and a tuple of the sorted keyword
+// arguments.  If the cache is typed the names of the types of the
+// arguments are added too so 3 and 3.0 are cached separately.
+func (w *LRUCacheWrapper) key(args py.Tuple, kwargs py.StringDict) py.Object {
+	names := make([]string, 0, len(kwargs))
+	for name := range kwargs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	kw := make(py.Tuple, 0, 2*len(names))
+	for _, name := range names {
+		kw = append(kw, py.String(name), kwargs[name])
+	}
+	key := py.Tuple{args.Copy(), kw}
+	if w.typed {
+		types := make(py.Tuple, 0, len(args)+len(names))
+		for _, arg := range args {
+			types = append(types, py.String(arg.Type().Name))
+		}
+		for _, name := range names {
+			types = append(types, py.String(kwargs[name].Type().Name))
+		}
+		key = append(key, types)
+	}
+	return key
+}
+
+// Calls the method name of the cache
+func (w *LRUCacheWrapper) callCache(name string, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	method, err := py.GetAttrString(w.cache, name)
+	if err != nil {
+		return nil, err
+	}
+	return py.Call(method, args, kwargs)
+}
+
+func (w *LRUCacheWrapper) M__call__(args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	if w.maxsize == 0 {
+		w.misses++
+		return py.Call(w.fn, args, kwargs)
+	}
+	key := w.key(args, kwargs)
+	result, err := py.GetItem(w.cache, key)
+	if err == nil {
+		w.hits++
+		if w.maxsize > 0 {
+			_, err = w.callCache("move_to_end", py.Tuple{key}, nil)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
+	}
+	if !py.IsException(py.KeyError, err) {
+		return nil, err
+	}
+	w.misses++
+	result, err = py.Call(w.fn, args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	_, err = py.SetItem(w.cache, key, result)
+	if err != nil {
+		return nil, err
+	}
+	if w.maxsize > 0 {
+		n, err := py.Len(w.cache)
+		if err != nil {
+			return nil, err
+		}
+		if int(n.(py.Int)) > w.maxsize {
+			_, err = w.callCache("popitem", nil, py.StringDict{"last": py.False})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// Read an lru_cache wrapper from a class which makes a bound method
+func (w *LRUCacheWrapper) M__get__(instance, owner py.Object) (py.Object, error) {
+	return bind(w, instance)
+}
+
+// Returns maxsize as a python object
+func (w *LRUCacheWrapper) maxsizeObject() py.Object {
+	if w.maxsize < 0 {
+		return py.None
+	}
+	return py.Int(w.maxsize)
+}
+
+func init() {
+	self := func(o py.Object) *LRUCacheWrapper {
+		return o.(*LRUCacheWrapper)
+	}
+	LRUCacheWrapperType.Dict["cache_info"] = py.MustNewMethod("cache_info", func(o py.Object) (py.Object, error) {
+		w := self(o)
+		currsize, err := py.Len(w.cache)
+		if err != nil {
+			return nil, err
+		}
+		return py.Call(CacheInfoType, py.Tuple{py.Int(w.hits), py.Int(w.misses), w.maxsizeObject(), currsize}, nil)
+	}, 0, "Report cache statistics")
+	LRUCacheWrapperType.Dict["cache_clear"] = py.MustNewMethod("cache_clear", func(o py.Object) (py.Object, error) {
+		w := self(o)
+		cache, err := newCache()
+		if err != nil {
+			return nil, err
+		}
+		w.cache = cache
+		w.hits = 0
+		w.misses = 0
+		return py.None, nil
+	}, 0, "Clear the cache and cache statistics")
+	LRUCacheWrapperType.Dict["cache_parameters"] = py.MustNewMethod("cache_parameters", func(o py.Object) (py.Object, error) {
+		w := self(o)
+		return py.StringDict{
+			"maxsize": w.maxsizeObject(),
+			"typed":   py.NewBool(w.typed),
+		}, nil
+	}, 0, "Report the cache parameters")
+	LRUCacheWrapperType.Dict["__dict__"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return self(o).dict, nil
+		},
+	}
+}
+
+const lru_cache_doc = `Least-recently-used cache decorator.
+
+If *maxsize* is set to None, the LRU features are disabled and the cache
+can grow without bound.
+
+If *typed* is True, arguments of different types will be cached separately.
+For example, f(3.0) and f(3) will be treated as distinct calls with
+distinct results.
+
+Arguments to the cached function must be hashable.
+
+View the cache statistics named tuple (hits, misses, maxsize, currsize)
+with f.cache_info().  Clear the cache and statistics with f.cache_clear().
+Access the underlying function with f.__wrapped__.`
+
+func functools_lru_cache(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var maxsizeObj py.Object = py.Int(128)
+	var typedObj py.Object = py.False
+	err := py.ParseTupleAndKeywords(args, kwargs, "|OO:lru_cache", []string{"maxsize", "typed"}, &maxsizeObj, &typedObj)
+	if err != nil {
+		return nil, err
+	}
+	typed, err := isTrue(typedObj)
+	if err != nil {
+		return nil, err
+	}
+	// Used as @lru_cache without arguments
+	if _, isInt := maxsizeObj.(py.Int); !isInt {
+		if _, ok := maxsizeObj.(py.I__call__); ok {
+			return newLRUCacheWrapper(maxsizeObj, 128, typed)
+		}
+	}
+	maxsize := -1
+	if maxsizeObj != py.None {
+		maxsize, err = py.IndexInt(maxsizeObj)
+		if err != nil {
+			return nil, py.ExceptionNewf(py.TypeError, "Expected first argument to be an integer, a callable, or None")
+		}
+		if maxsize < 0 {
+			maxsize = 0
+		}
+	}
+	return py.MustNewMethod("decorating_function", func(self, fn py.Object) (py.Object, error) {
+		return newLRUCacheWrapper(fn, maxsize, typed)
+	}, 0, ""), nil
+}
+
+const cache_doc = `Simple lightweight unbounded cache.  Sometimes called "memoize".`
+
+func functools_cache(self, fn py.Object) (py.Object, error) {
+	return newLRUCacheWrapper(fn, -1, false)
+}
+
+// Check interface is satisfied
+var _ py.I__call__ = (*LRUCacheWrapper)(nil)
+var _ py.I__get__ = (*LRUCacheWrapper)(nil)
+var _ py.IGetDict = (*LRUCacheWrapper)(nil)
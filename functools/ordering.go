@@ -0,0 +1,197 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// total_ordering and cmp_to_key
+
+package functools
+
+import (
+	"github.com/go-python/gpython/collections"
+	"github.com/go-python/gpython/py"
+)
+
+// How a comparison is worked out from the root comparison
+//
+// The result of the root is negated if not is set, then if join is
+// "and" the result is anded with self != other or if join is "or"
+// the result is ored with self == other.
+type derivation struct {
+	name string
+	not  bool
+	join string
+}
+
+// The comparisons total_ordering derives from each root in the order
+// it prefers the roots, as done by CPython
+var derivations = []struct {
+	root    string
+	derived []derivation
+}{
+	{"__lt__", []derivation{{"__gt__", true, "and"}, {"__le__", false, "or"}, {"__ge__", true, ""}}},
+	{"__le__", []derivation{{"__ge__", true, "or"}, {"__lt__", false, "and"}, {"__gt__", true, ""}}},
+	{"__gt__", []derivation{{"__lt__", true, "and"}, {"__ge__", false, "or"}, {"__le__", true, ""}}},
+	{"__ge__", []derivation{{"__le__", true, "or"}, {"__gt__", false, "and"}, {"__lt__", true, ""}}},
+}
+
+// Makes the comparison method d from the root comparison
+func (d derivation) method(root py.Object) *collections.Function {
+	return &collections.Function{
+		Name: d.name,
+		Doc:  d.name + " computed by @total_ordering",
+		Fn: func(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+			var other py.Object
+			err := py.UnpackTuple(args, kwargs, d.name, 1, 1, &other)
+			if err != nil {
+				return nil, err
+			}
+			res, err := py.Call(root, py.Tuple{self, other}, nil)
+			if err != nil || res == py.NotImplemented {
+				return res, err
+			}
+			ok, err := isTrue(res)
+			if err != nil {
+				return nil, err
+			}
+			if d.not {
+				ok = !ok
+			}
+			switch {
+			case d.join == "and" && ok:
+				res, err = py.Ne(self, other)
+			case d.join == "or" && !ok:
+				res, err = py.Eq(self, other)
+			default:
+				return py.NewBool(ok), nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			return py.MakeBool(res)
+		},
+	}
+}
+
+const total_ordering_doc = `Class decorator that fills in missing ordering methods`
+
+func functools_total_ordering(self, arg py.Object) (py.Object, error) {
+	cls, ok := arg.(*py.Type)
+	if !ok {
+		return nil, py.ExceptionNewf(py.TypeError, "total_ordering() argument must be a class, not '%s'", arg.Type().Name)
+	}
+	for _, ordering := range derivations {
+		root, ok := cls.Dict[ordering.root]
+		if !ok {
+			continue
+		}
+		for _, d := range ordering.derived {
+			if _, ok := cls.Dict[d.name]; !ok {
+				cls.Dict[d.name] = d.method(root)
+			}
+		}
+		return cls, nil
+	}
+	return nil, py.ExceptionNewf(py.ValueError, "must define at least one ordering operation: < > <= >=")
+}
+
+// A python KeyWrapper object made by cmp_to_key
+//
+// Calling one with an object makes a KeyWrapper which compares objects
+// with the cmp function.
+type KeyWrapper struct {
+	cmp py.Object
+	obj py.Object
+}
+
+var KeyWrapperType = py.NewType("functools.KeyWrapper", "Object which compares with a cmp function")
+
+// Type of this object
+func (k *KeyWrapper) Type() *py.Type {
+	return KeyWrapperType
+}
+
+func (k *KeyWrapper) M__call__(args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var obj py.Object
+	err := py.ParseTupleAndKeywords(args, kwargs, "O:K", []string{"obj"}, &obj)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyWrapper{cmp: k.cmp, obj: obj}, nil
+}
+
+// Compares k to other with cmp and then the result to zero with op
+func (k *KeyWrapper) compare(other py.Object, op func(a, b py.Object) (py.Object, error)) (py.Object, error) {
+	o, ok := other.(*KeyWrapper)
+	if !ok {
+		return nil, py.ExceptionNewf(py.TypeError, "other argument must be K instance")
+	}
+	if k.obj == nil || o.obj == nil {
+		return nil, py.ExceptionNewf(py.AttributeError, "object")
+	}
+	res, err := py.Call(k.cmp, py.Tuple{k.obj, o.obj}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return op(res, py.Int(0))
+}
+
+func (k *KeyWrapper) M__lt__(other py.Object) (py.Object, error) {
+	return k.compare(other, py.Lt)
+}
+
+func (k *KeyWrapper) M__le__(other py.Object) (py.Object, error) {
+	return k.compare(other, py.Le)
+}
+
+func (k *KeyWrapper) M__eq__(other py.Object) (py.Object, error) {
+	return k.compare(other, py.Eq)
+}
+
+func (k *KeyWrapper) M__ne__(other py.Object) (py.Object, error) {
+	return k.compare(other, py.Ne)
+}
+
+func (k *KeyWrapper) M__gt__(other py.Object) (py.Object, error) {
+	return k.compare(other, py.Gt)
+}
+
+func (k *KeyWrapper) M__ge__(other py.Object) (py.Object, error) {
+	return k.compare(other, py.Ge)
+}
+
+func init() {
+	KeyWrapperType.Dict["obj"] = &py.Property{
+		Fget: func(self py.Object) (py.Object, error) {
+			obj := self.(*KeyWrapper).obj
+			if obj == nil {
+				return nil, py.ExceptionNewf(py.AttributeError, "obj")
+			}
+			return obj, nil
+		},
+		Fset: func(self, value py.Object) error {
+			self.(*KeyWrapper).obj = value
+			return nil
+		},
+		Doc: "Value wrapped by a key function.",
+	}
+}
+
+const cmp_to_key_doc = `Convert a cmp= function into a key= function.`
+
+func functools_cmp_to_key(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var cmp py.Object
+	err := py.ParseTupleAndKeywords(args, kwargs, "O:cmp_to_key", []string{"mycmp"}, &cmp)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyWrapper{cmp: cmp}, nil
+}
+
+// Check interface is satisfied
+var _ py.I__call__ = (*KeyWrapper)(nil)
+var _ py.I__lt__ = (*KeyWrapper)(nil)
+var _ py.I__le__ = (*KeyWrapper)(nil)
+var _ py.I__eq__ = (*KeyWrapper)(nil)
+var _ py.I__ne__ = (*KeyWrapper)(nil)
+var _ py.I__gt__ = (*KeyWrapper)(nil)
+var _ py.I__ge__ = (*KeyWrapper)(nil)
@@ -0,0 +1,153 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// partial objects
+
+package functools
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/go-python/gpython/py"
+)
+
+// A python partial object
+type Partial struct {
+	fn       py.Object
+	args     py.Tuple
+	keywords py.StringDict
+	dict     py.StringDict
+}
+
+var PartialType = py.NewTypeX("functools.partial", `partial(func, *args, **keywords) - new function with partial application
+of the given arguments and keywords.`, PartialNew, nil)
+
+// Type of this object
+func (p *Partial) Type() *py.Type {
+	return PartialType
+}
+
+// Get the Dict
+func (p *Partial) GetDict() py.StringDict {
+	return p.dict
+}
+
+// Makes a new partial object
+func newPartial(fn py.Object, args py.Tuple, keywords py.StringDict) *Partial {
+	if keywords == nil {
+		keywords = py.NewStringDict()
+	}
+	return &Partial{
+		fn:       fn,
+		args:     args,
+		keywords: keywords,
+		dict:     py.NewStringDict(),
+	}
+}
+
+// PartialNew makes a partial object
+func PartialNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	if len(args) < 1 {
+		return nil, py.ExceptionNewf(py.TypeError, "type 'partial' takes at least one argument")
+	}
+	fn := args[0]
+	if _, ok := fn.(py.I__call__); !ok {
+		return nil, py.ExceptionNewf(py.TypeError, "the first argument must be callable")
+	}
+	args = args[1:].Copy()
+	keywords := kwargs.Copy()
+	// Flatten partial(partial(f, ...), ...) into one partial
+	if inner, ok := fn.(*Partial); ok {
+		fn = inner.fn
+		args = append(inner.args.Copy(), args...)
+		keywords = inner.keywords.Copy()
+		for k, v := range kwargs {
+			keywords[k] = v
+		}
+	}
+	return newPartial(fn, args, keywords), nil
+}
+
+func (p *Partial) M__call__(args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	callArgs := append(p.args.Copy(), args...)
+	callKwargs := p.keywords
+	if len(kwargs) != 0 {
+		callKwargs = p.keywords.Copy()
+		for k, v := range kwargs {
+			callKwargs[k] = v
+		}
+	}
+	return py.Call(p.fn, callArgs, callKwargs)
+}
+
+func (p *Partial) M__repr__() (py.Object, error) {
+	var out strings.Builder
+	out.WriteString(PartialType.Name)
+	out.WriteString("(")
+	repr, err := py.ReprAsString(p.fn)
+	if err != nil {
+		return nil, err
+	}
+	out.WriteString(repr)
+	for _, arg := range p.args {
+		repr, err := py.ReprAsString(arg)
+		if err != nil {
+			return nil, err
+		}
+		out.WriteString(", ")
+		out.WriteString(repr)
+	}
+	names := make([]string, 0, len(p.keywords))
+	for name := range p.keywords {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		repr, err := py.ReprAsString(p.keywords[name])
+		if err != nil {
+			return nil, err
+		}
+		out.WriteString(", ")
+		out.WriteString(name)
+		out.WriteString("=")
+		out.WriteString(repr)
+	}
+	out.WriteString(")")
+	return py.String(out.String()), nil
+}
+
+func init() {
+	self := func(o py.Object) *Partial {
+		return o.(*Partial)
+	}
+	PartialType.Dict["func"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return self(o).fn, nil
+		},
+		Doc: "function object to use in future partial calls",
+	}
+	PartialType.Dict["args"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return self(o).args, nil
+		},
+		Doc: "tuple of arguments to future partial calls",
+	}
+	PartialType.Dict["keywords"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return self(o).keywords, nil
+		},
+		Doc: "dictionary of keyword arguments to future partial calls",
+	}
+	PartialType.Dict["__dict__"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return self(o).dict, nil
+		},
+	}
+}
+
+// Check interface is satisfied
+var _ py.I__call__ = (*Partial)(nil)
+var _ py.I__repr__ = (*Partial)(nil)
+var _ py.IGetDict = (*Partial)(nil)
@@ -0,0 +1,187 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// singledispatch generic functions
+
+package functools
+
+import (
+	"github.com/go-python/gpython/collections"
+	"github.com/go-python/gpython/py"
+)
+
+// A python singledispatch function
+//
+// Calls are dispatched on the class of the first argument to the
+// implementation registered for the nearest class in its MRO.
+type SingleDispatch struct {
+	name     string
+	registry map[*py.Type]py.Object
+	order    []*py.Type // registered classes in the order registered
+	dict     py.StringDict
+}
+
+var SingleDispatchType = py.NewType("functools.singledispatch", "Single-dispatch generic function")
+
+// Type of this object
+func (s *SingleDispatch) Type() *py.Type {
+	return SingleDispatchType
+}
+
+// Get the Dict
+func (s *SingleDispatch) GetDict() py.StringDict {
+	return s.dict
+}
+
+// Registers fn as the implementation for cls
+func (s *SingleDispatch) register(cls *py.Type, fn py.Object) {
+	if _, ok := s.registry[cls]; !ok {
+		s.order = append(s.order, cls)
+	}
+	s.registry[cls] = fn
+}
+
+// Returns the implementation for cls
+func (s *SingleDispatch) dispatch(cls *py.Type) (py.Object, error) {
+	for _, o := range cls.Mro {
+		if fn, ok := s.registry[o.(*py.Type)]; ok {
+			return fn, nil
+		}
+	}
+	if fn, ok := s.registry[cls]; ok {
+		return fn, nil
+	}
+	return s.registry[py.ObjectType], nil
+}
+
+func (s *SingleDispatch) M__call__(args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	if len(args) == 0 {
+		return nil, py.ExceptionNewf(py.TypeError, "%s requires at least 1 positional argument", s.name)
+	}
+	fn, err := s.dispatch(args[0].Type())
+	if err != nil {
+		return nil, err
+	}
+	return py.Call(fn, args, kwargs)
+}
+
+// Read a singledispatch function from a class which makes a bound method
+func (s *SingleDispatch) M__get__(instance, owner py.Object) (py.Object, error) {
+	return bind(s, instance)
+}
+
+// Returns the class annotated on the first argument of fn
+func annotatedClass(fn py.Object) (*py.Type, error) {
+	invalid := func() error {
+		repr, err := py.ReprAsString(fn)
+		if err != nil {
+			return err
+		}
+		return py.ExceptionNewf(py.TypeError, "Invalid first argument to `register()`: %s. Use either `@register(some_class)` or plain `@register` on an annotated function.", repr)
+	}
+	f, ok := fn.(*py.Function)
+	if !ok || f.Code.Argcount == 0 {
+		return nil, invalid()
+	}
+	cls, ok := f.Annotations[f.Code.Varnames[0]].(*py.Type)
+	if !ok {
+		return nil, invalid()
+	}
+	return cls, nil
+}
+
+func init() {
+	self := func(o py.Object) *SingleDispatch {
+		return o.(*SingleDispatch)
+	}
+	SingleDispatchType.Dict["register"] = py.MustNewMethod("register", func(o py.Object, args py.Tuple) (py.Object, error) {
+		s := self(o)
+		var clsObj py.Object
+		var fn py.Object = py.None
+		err := py.UnpackTuple(args, nil, "register", 1, 2, &clsObj, &fn)
+		if err != nil {
+			return nil, err
+		}
+		cls, ok := clsObj.(*py.Type)
+		if !ok {
+			if fn != py.None {
+				return nil, py.ExceptionNewf(py.TypeError, "Invalid first argument to `register()`. %s is not a class.", clsObj.Type().Name)
+			}
+			fn = clsObj
+			cls, err = annotatedClass(fn)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if fn == py.None {
+			return py.MustNewMethod("register", func(_, fn py.Object) (py.Object, error) {
+				s.register(cls, fn)
+				return fn, nil
+			}, 0, ""), nil
+		}
+		s.register(cls, fn)
+		return fn, nil
+	}, 0, `register(cls, func) -> func
+
+Registers a new implementation for the given *cls* on a *generic_func*.`)
+	SingleDispatchType.Dict["dispatch"] = py.MustNewMethod("dispatch", func(o, clsObj py.Object) (py.Object, error) {
+		cls, ok := clsObj.(*py.Type)
+		if !ok {
+			return nil, py.ExceptionNewf(py.TypeError, "dispatch() argument must be a class, not '%s'", clsObj.Type().Name)
+		}
+		return self(o).dispatch(cls)
+	}, 0, `dispatch(cls) -> <function implementation>
+
+Runs the dispatch algorithm to return the best available implementation
+for the given *cls* registered on *generic_func*.`)
+	SingleDispatchType.Dict["registry"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			s := self(o)
+			registry, err := collections.OrderedDictNew(collections.OrderedDictType, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			for _, cls := range s.order {
+				_, err = py.SetItem(registry, cls, s.registry[cls])
+				if err != nil {
+					return nil, err
+				}
+			}
+			return registry, nil
+		},
+		Doc: "Copy of the mapping of classes to their implementations",
+	}
+	SingleDispatchType.Dict["__dict__"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return self(o).dict, nil
+		},
+	}
+}
+
+const singledispatch_doc = `Single-dispatch generic function decorator.
+
+Transforms a function into a generic function, which can have different
+behaviours depending upon the type of its first argument. The decorated
+function acts as the default implementation, and additional
+implementations can be registered using the register() attribute of the
+generic function.`
+
+func functools_singledispatch(self, fn py.Object) (py.Object, error) {
+	s := &SingleDispatch{
+		name:     nameOf(fn),
+		registry: map[*py.Type]py.Object{},
+		dict:     py.NewStringDict(),
+	}
+	s.register(py.ObjectType, fn)
+	_, err := functools_update_wrapper(nil, py.Tuple{s, fn}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Check interface is satisfied
+var _ py.I__call__ = (*SingleDispatch)(nil)
+var _ py.I__get__ = (*SingleDispatch)(nil)
+var _ py.IGetDict = (*SingleDispatch)(nil)
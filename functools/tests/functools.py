@@ -0,0 +1,171 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+from functools import partial, reduce, wraps, update_wrapper, lru_cache, cache, total_ordering, cmp_to_key, singledispatch
+from libtest import assertRaises, assertRaisesText
+
+doc = "partial"
+p = partial(pow, 2)
+assert p(10) == 1024
+assert p.func is pow and p.args == (2,) and p.keywords == {}
+def f(a, b, c=0):
+    return (a, b, c)
+p = partial(f, 1, c=3)
+assert p(2) == (1, 2, 3) and p(2, c=4) == (1, 2, 4)
+q = partial(p, 5)
+assert q.func is f and q.args == (1, 5) and q.keywords == {"c": 3}
+assert q() == (1, 5, 3)
+assert repr(partial(int, "10", base=2)) == "functools.partial(<class 'int'>, '10', base=2)"
+p.attr = 1
+assert p.attr == 1
+assertRaisesText(TypeError, "the first argument must be callable", partial, 1)
+
+doc = "reduce"
+assert reduce(lambda a, b: a + b, [1, 2, 3, 4]) == 10
+assert reduce(lambda a, b: a + b, [], 5) == 5
+assert reduce(lambda a, b: a * b, range(1, 5), 10) == 240
+assertRaisesText(TypeError, "reduce() of empty", reduce, pow, [])
+
+doc = "wraps"
+def deco(fn):
+    @wraps(fn)
+    def wrapper(*args):
+        return fn(*args)
+    return wrapper
+@deco
+def hello(x):
+    "Say hello"
+    return x
+assert hello.__name__ == "hello" and hello.__doc__ == "Say hello"
+assert hello.__qualname__ == "hello"
+assert hello(3) == 3 and hello.__wrapped__(4) == 4
+def target():
+    pass
+target.extra = 1
+def other():
+    pass
+assert update_wrapper(other, target, assigned=()) is other
+assert other.__name__ == "other" and other.extra == 1 and other.__wrapped__ is target
+
+doc = "lru_cache"
+calls = []
+@lru_cache(maxsize=2)
+def square(x):
+    calls.append(x)
+    return x * x
+assert square(2) == 4 and square(2) == 4 and square(3) == 9
+assert square(4) == 16 and square(2) == 4
+assert calls == [2, 3, 4, 2]
+assert square.cache_info() == (1, 4, 2, 2)
+info = square.cache_info()
+assert info.hits == 1 and info.misses == 4 and info.maxsize == 2 and info.currsize == 2
+assert repr(info) == "CacheInfo(hits=1, misses=4, maxsize=2, currsize=2)"
+assert square.__name__ == "square"
+assert square.cache_parameters() == {"maxsize": 2, "typed": False}
+square.cache_clear()
+assert square.cache_info() == (0, 0, 2, 0)
+assertRaisesText(TypeError, "unhashable type", square, [1])
+
+@lru_cache
+def fib(n):
+    return n if n < 2 else fib(n - 1) + fib(n - 2)
+assert fib(80) == 23416728348467685
+assert fib.cache_info().maxsize == 128
+
+@cache
+def add(a, b=1):
+    calls.append((a, b))
+    return a + b
+calls = []
+assert add(1) == 2 and add(1, b=2) == 3 and add(1, b=2) == 3 and add(1, 2) == 3
+assert calls == [(1, 1), (1, 2), (1, 2)]
+assert add.cache_info() == (1, 3, None, 3)
+
+@lru_cache(maxsize=None, typed=True)
+def kind(x):
+    return type(x)
+assert kind(1) is int and kind(1.0) is float
+
+@lru_cache(maxsize=0)
+def nocache(x):
+    return x
+assert nocache(1) == 1 and nocache(1) == 1 and nocache.cache_info() == (0, 2, 0, 0)
+
+class C:
+    @lru_cache()
+    def method(self, x):
+        return x + 1
+c = C()
+assert c.method(1) == 2 and c.method(1) == 2
+assert C.method.cache_info().hits == 1
+assertRaisesText(TypeError, "Expected first argument to be an integer, a callable, or None", lru_cache, "x")
+
+doc = "total_ordering"
+@total_ordering
+class V:
+    def __init__(self, v):
+        self.v = v
+    def __eq__(self, other):
+        return self.v == other.v
+    def __lt__(self, other):
+        return self.v < other.v
+assert V(1) < V(2) and V(1) <= V(1) and V(1) <= V(2)
+assert V(2) > V(1) and not V(1) > V(1)
+assert V(2) >= V(2) and not V(1) >= V(2)
+
+@total_ordering
+class G:
+    def __init__(self, v):
+        self.v = v
+    def __eq__(self, other):
+        return self.v == other.v
+    def __ge__(self, other):
+        return self.v >= other.v
+assert G(1) < G(2) and G(2) > G(1) and G(1) <= G(1) and not G(2) <= G(1)
+
+def noorder():
+    class N:
+        pass
+    total_ordering(N)
+assertRaisesText(ValueError, "must define at least one ordering operation", noorder)
+
+doc = "cmp_to_key"
+K = cmp_to_key(lambda a, b: b - a)
+assert K(1) > K(2) and K(2) < K(1) and K(1) == K(1) and K(1) != K(2)
+assert K(1) >= K(1) and K(1) <= K(1)
+assert K(3).obj == 3
+assertRaisesText(TypeError, "other argument must be K instance", lambda: K(1) < 1)
+
+doc = "singledispatch"
+@singledispatch
+def show(x):
+    "Show x"
+    return "object"
+@show.register(int)
+def _(x):
+    return "int"
+@show.register
+def _(x: str):
+    return "str"
+def show_list(x):
+    return "list"
+assert show.register(list, show_list) is show_list
+assert show(1) == "int" and show("a") == "str" and show([]) == "list"
+assert show(1.5) == "object" and show(None) == "object"
+assert show.__name__ == "show" and show.__doc__ == "Show x"
+assert show.dispatch(int)(0) == "int" and show.dispatch(float) is show.__wrapped__
+assert show.registry[str](0) == "str" and len(show.registry) == 4
+
+class Base:
+    pass
+class Derived(Base):
+    pass
+@show.register(Base)
+def _(x):
+    return "base"
+assert show(Derived()) == "base"
+assertRaisesText(TypeError, "requires at least 1 positional argument", show)
+assertRaisesText(TypeError, "Invalid first argument to `register()`", show.register, lambda x: x)
+
+doc = "finished"
@@ -1,6 +1,10 @@
 module github.com/go-python/gpython
 
+go 1.24
+
 require (
+	github.com/gopherjs/gopherjs v0.0.0-20180825215210-0210a2f0f73c // indirect
 	github.com/gopherjs/gopherwasm v1.0.0 // indirect
+	github.com/mattn/go-runewidth v0.0.3 // indirect
 	github.com/peterh/liner v1.1.0
 )
@@ -0,0 +1,363 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package heapq implements the python heapq module
+//
+// The heap functions work on the items of a python list in place
+// using the same algorithms as CPython so the lists end up in the
+// same order.  Items are only ever compared with <.
+package heapq
+
+import (
+	"sort"
+
+	"github.com/go-python/gpython/py"
+)
+
+const module_doc = `Heap queue algorithm (a.k.a. priority queue).
+
+Heaps are arrays for which a[k] <= a[2*k+1] and a[k] <= a[2*k+2] for
+all k, counting elements from 0.  For the sake of comparison,
+non-existing elements are considered to be infinite.  The interesting
+property of a heap is that a[0] is always its smallest element.
+
+Usage:
+
+heap = []            # creates an empty heap
+heappush(heap, item) # pushes a new item on the heap
+item = heappop(heap) # pops the smallest item from the heap
+item = heap[0]       # smallest item on the heap without popping it
+heapify(x)           # transforms list into a heap, in-place, in linear time
+item = heapreplace(heap, item) # pops and returns smallest item, and adds
+                               # new item; the heap size is unchanged
+
+Our API differs from textbook heap algorithms as follows:
+
+- We use 0-based indexing.  This makes the relationship between the
+  index for a node and the indexes for its children slightly less
+  obvious, but is more suitable since Python uses 0-based indexing.
+
+- Our heappop() method returns the smallest item, not the largest.
+
+These two make it possible to view the heap as a regular Python list
+without surprises: heap[0] is the smallest item, and heap.sort()
+maintains the heap invariant!`
+
+// Returns a < b
+func less(a, b py.Object) (bool, error) {
+	res, err := py.Lt(a, b)
+	if err != nil {
+		return false, err
+	}
+	res, err = py.MakeBool(res)
+	if err != nil {
+		return false, err
+	}
+	return res == py.True, nil
+}
+
+// Returns the list heap passed to the function name
+func heapArg(name string, heap py.Object) (*py.List, error) {
+	l, ok := heap.(*py.List)
+	if !ok {
+		return nil, py.ExceptionNewf(py.TypeError, "%s() argument 1 must be list, not %s", name, heap.Type().Name)
+	}
+	return l, nil
+}
+
+// Checks the comparisons haven't changed the size of the heap
+func checkSize(heap *py.List, n int) error {
+	if len(heap.Items) != n {
+		return py.ExceptionNewf(py.RuntimeError, "list changed size during iteration")
+	}
+	return nil
+}
+
+// Moves the item at pos up towards startpos until its parent isn't
+// bigger than it
+func siftdown(heap *py.List, startpos, pos int) error {
+	n := len(heap.Items)
+	newitem := heap.Items[pos]
+	for pos > startpos {
+		parentpos := (pos - 1) >> 1
+		parent := heap.Items[parentpos]
+		lt, err := less(newitem, parent)
+		if err != nil {
+			return err
+		}
+		err = checkSize(heap, n)
+		if err != nil {
+			return err
+		}
+		if !lt {
+			break
+		}
+		heap.Items[pos] = parent
+		pos = parentpos
+	}
+	heap.Items[pos] = newitem
+	return nil
+}
+
+// Moves the smaller child up until hitting a leaf then sifts the item
+// which was at pos down to its place
+func siftup(heap *py.List, pos int) error {
+	endpos := len(heap.Items)
+	startpos := pos
+	newitem := heap.Items[pos]
+	childpos := 2*pos + 1
+	for childpos < endpos {
+		rightpos := childpos + 1
+		if rightpos < endpos {
+			lt, err := less(heap.Items[childpos], heap.Items[rightpos])
+			if err != nil {
+				return err
+			}
+			err = checkSize(heap, endpos)
+			if err != nil {
+				return err
+			}
+			if !lt {
+				childpos = rightpos
+			}
+		}
+		heap.Items[pos] = heap.Items[childpos]
+		pos = childpos
+		childpos = 2*pos + 1
+	}
+	heap.Items[pos] = newitem
+	return siftdown(heap, startpos, pos)
+}
+
+const heappush_doc = `Push item onto heap, maintaining the heap invariant.`
+
+func heapq_heappush(self py.Object, args py.Tuple) (py.Object, error) {
+	var heapObj, item py.Object
+	err := py.UnpackTuple(args, nil, "heappush", 2, 2, &heapObj, &item)
+	if err != nil {
+		return nil, err
+	}
+	heap, err := heapArg("heappush", heapObj)
+	if err != nil {
+		return nil, err
+	}
+	heap.Append(item)
+	err = siftdown(heap, 0, len(heap.Items)-1)
+	if err != nil {
+		return nil, err
+	}
+	return py.None, nil
+}
+
+const heappop_doc = `Pop the smallest item off the heap, maintaining the heap invariant.`
+
+func heapq_heappop(self, heapObj py.Object) (py.Object, error) {
+	heap, err := heapArg("heappop", heapObj)
+	if err != nil {
+		return nil, err
+	}
+	n := len(heap.Items)
+	if n == 0 {
+		return nil, py.ExceptionNewf(py.IndexError, "index out of range")
+	}
+	lastelt := heap.Items[n-1]
+	heap.Items[n-1] = nil
+	heap.Items = heap.Items[:n-1]
+	if n == 1 {
+		return lastelt, nil
+	}
+	returnitem := heap.Items[0]
+	heap.Items[0] = lastelt
+	err = siftup(heap, 0)
+	if err != nil {
+		return nil, err
+	}
+	return returnitem, nil
+}
+
+const heapreplace_doc = `Pop and return the current smallest value, and add the new item.
+
+This is more efficient than heappop() followed by heappush(), and can be
+more appropriate when using a fixed-size heap.  Note that the value
+returned may be larger than item!  That constrains reasonable uses of
+this routine unless written as part of a conditional replacement:
+
+    if item > heap[0]:
+        item = heapreplace(heap, item)`
+
+func heapq_heapreplace(self py.Object, args py.Tuple) (py.Object, error) {
+	var heapObj, item py.Object
+	err := py.UnpackTuple(args, nil, "heapreplace", 2, 2, &heapObj, &item)
+	if err != nil {
+		return nil, err
+	}
+	heap, err := heapArg("heapreplace", heapObj)
+	if err != nil {
+		return nil, err
+	}
+	if len(heap.Items) == 0 {
+		return nil, py.ExceptionNewf(py.IndexError, "index out of range")
+	}
+	returnitem := heap.Items[0]
+	heap.Items[0] = item
+	err = siftup(heap, 0)
+	if err != nil {
+		return nil, err
+	}
+	return returnitem, nil
+}
+
+const heappushpop_doc = `Push item on the heap, then pop and return the smallest item from the heap.
+
+The combined action runs more efficiently than heappush() followed by
+a separate call to heappop().`
+
+func heapq_heappushpop(self py.Object, args py.Tuple) (py.Object, error) {
+	var heapObj, item py.Object
+	err := py.UnpackTuple(args, nil, "heappushpop", 2, 2, &heapObj, &item)
+	if err != nil {
+		return nil, err
+	}
+	heap, err := heapArg("heappushpop", heapObj)
+	if err != nil {
+		return nil, err
+	}
+	if len(heap.Items) == 0 {
+		return item, nil
+	}
+	lt, err := less(heap.Items[0], item)
+	if err != nil {
+		return nil, err
+	}
+	if !lt {
+		return item, nil
+	}
+	// The comparison may have emptied the heap
+	if len(heap.Items) == 0 {
+		return nil, py.ExceptionNewf(py.IndexError, "index out of range")
+	}
+	returnitem := heap.Items[0]
+	heap.Items[0] = item
+	err = siftup(heap, 0)
+	if err != nil {
+		return nil, err
+	}
+	return returnitem, nil
+}
+
+const heapify_doc = `Transform list into a heap, in-place, in O(len(heap)) time.`
+
+func heapq_heapify(self, heapObj py.Object) (py.Object, error) {
+	heap, err := heapArg("heapify", heapObj)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(heap.Items)/2 - 1; i >= 0; i-- {
+		err = siftup(heap, i)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return py.None, nil
+}
+
+// Returns the items of iterable and their keys
+func keyed(iterable, key py.Object) (items, keys []py.Object, err error) {
+	l, err := py.SequenceList(iterable)
+	if err != nil {
+		return nil, nil, err
+	}
+	items = l.Items
+	if key == py.None {
+		return items, items, nil
+	}
+	keys = make([]py.Object, len(items))
+	for i, item := range items {
+		keys[i], err = py.Call(key, py.Tuple{item}, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return items, keys, nil
+}
+
+// Returns the n smallest or largest items of iterable
+//
+// Equal items are returned in the order they were found.
+func nselect(name string, args py.Tuple, kwargs py.StringDict, largest bool) (py.Object, error) {
+	var nObj, iterable py.Object
+	var key py.Object = py.None
+	err := py.ParseTupleAndKeywords(args, kwargs, "OO|O:"+name, []string{"n", "iterable", "key"}, &nObj, &iterable, &key)
+	if err != nil {
+		return nil, err
+	}
+	n, err := py.IndexInt(nObj)
+	if err != nil {
+		return nil, err
+	}
+	items, keys, err := keyed(iterable, key)
+	if err != nil {
+		return nil, err
+	}
+	order := make([]int, len(items))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		if err != nil {
+			return false
+		}
+		a, b := keys[order[i]], keys[order[j]]
+		if largest {
+			a, b = b, a
+		}
+		var lt bool
+		lt, err = less(a, b)
+		return lt
+	})
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 {
+		n = 0
+	}
+	if n > len(order) {
+		n = len(order)
+	}
+	res := py.NewListWithCapacity(n)
+	for _, i := range order[:n] {
+		res.Append(items[i])
+	}
+	return res, nil
+}
+
+const nlargest_doc = `Find the n largest elements in a dataset.
+
+Equivalent to:  sorted(iterable, key=key, reverse=True)[:n]`
+
+func heapq_nlargest(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	return nselect("nlargest", args, kwargs, true)
+}
+
+const nsmallest_doc = `Find the n smallest elements in a dataset.
+
+Equivalent to:  sorted(iterable, key=key)[:n]`
+
+func heapq_nsmallest(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	return nselect("nsmallest", args, kwargs, false)
+}
+
+func init() {
+	methods := []*py.Method{
+		py.MustNewMethod("heappush", heapq_heappush, 0, heappush_doc),
+		py.MustNewMethod("heappop", heapq_heappop, 0, heappop_doc),
+		py.MustNewMethod("heapreplace", heapq_heapreplace, 0, heapreplace_doc),
+		py.MustNewMethod("heappushpop", heapq_heappushpop, 0, heappushpop_doc),
+		py.MustNewMethod("heapify", heapq_heapify, 0, heapify_doc),
+		py.MustNewMethod("merge", heapq_merge, 0, merge_doc),
+		py.MustNewMethod("nlargest", heapq_nlargest, 0, nlargest_doc),
+		py.MustNewMethod("nsmallest", heapq_nsmallest, 0, nsmallest_doc),
+	}
+	py.NewModule("heapq", module_doc, methods, nil)
+}
@@ -0,0 +1,154 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// merge of sorted iterables
+
+package heapq
+
+import (
+	"github.com/go-python/gpython/py"
+)
+
+// The next item of one of the iterables being merged
+type mergeHead struct {
+	item py.Object
+	key  py.Object
+	it   py.Object // iterator the item came from
+}
+
+// A python merge object
+//
+// This holds the next item of each iterable which isn't used up, in
+// the order the iterables were passed in, so picking the first of the
+// smallest items keeps the merge stable.
+type Merge struct {
+	heads   []*mergeHead
+	key     py.Object
+	reverse bool
+	started bool
+}
+
+var MergeType = py.NewType("merge", "Iterator over the merged items of sorted iterables")
+
+// Type of this object
+func (m *Merge) Type() *py.Type {
+	return MergeType
+}
+
+// Reads the next item of it into head returning false if it is used up
+func (m *Merge) advance(head *mergeHead) (bool, error) {
+	item, err := py.Next(head.it)
+	if err != nil {
+		if py.IsException(py.StopIteration, err) {
+			return false, nil
+		}
+		return false, err
+	}
+	head.item = item
+	head.key = item
+	if m.key != py.None {
+		head.key, err = py.Call(m.key, py.Tuple{item}, nil)
+		if err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// Drops the head at index i
+func (m *Merge) drop(i int) {
+	m.heads = append(m.heads[:i], m.heads[i+1:]...)
+}
+
+func (m *Merge) M__iter__() (py.Object, error) {
+	return m, nil
+}
+
+func (m *Merge) M__next__() (py.Object, error) {
+	if !m.started {
+		m.started = true
+		for i := 0; i < len(m.heads); {
+			ok, err := m.advance(m.heads[i])
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				i++
+			} else {
+				m.drop(i)
+			}
+		}
+	}
+	if len(m.heads) == 0 {
+		return nil, py.StopIteration
+	}
+	best := 0
+	for i := 1; i < len(m.heads); i++ {
+		a, b := m.heads[i].key, m.heads[best].key
+		if m.reverse {
+			a, b = b, a
+		}
+		lt, err := less(a, b)
+		if err != nil {
+			return nil, err
+		}
+		if lt {
+			best = i
+		}
+	}
+	head := m.heads[best]
+	item := head.item
+	ok, err := m.advance(head)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		m.drop(best)
+	}
+	return item, nil
+}
+
+const merge_doc = `Merge multiple sorted inputs into a single sorted output.
+
+Similar to sorted(itertools.chain(*iterables)) but returns a generator,
+does not pull the data into memory all at once, and assumes that each of
+the input streams is already sorted (smallest to largest).
+
+>>> list(merge([1,3,5,7], [0,2,4,8], [5,10,15,20], [], [25]))
+[0, 1, 2, 3, 4, 5, 5, 7, 8, 10, 15, 20, 25]
+
+If *key* is not None, applies a key function to each element to determine
+its sort order.
+
+>>> list(merge(['dog', 'horse'], ['cat', 'fish', 'kangaroo'], key=len))
+['dog', 'cat', 'fish', 'horse', 'kangaroo']`
+
+func heapq_merge(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var key py.Object = py.None
+	var reverseObj py.Object = py.False
+	err := py.ParseTupleAndKeywords(nil, kwargs, "|OO:merge", []string{"key", "reverse"}, &key, &reverseObj)
+	if err != nil {
+		return nil, err
+	}
+	reverse, err := py.MakeBool(reverseObj)
+	if err != nil {
+		return nil, err
+	}
+	m := &Merge{
+		heads:   make([]*mergeHead, len(args)),
+		key:     key,
+		reverse: reverse == py.True,
+	}
+	for i, iterable := range args {
+		it, err := py.Iter(iterable)
+		if err != nil {
+			return nil, err
+		}
+		m.heads[i] = &mergeHead{it: it}
+	}
+	return m, nil
+}
+
+// Check interface is satisfied
+var _ py.I_iterator = (*Merge)(nil)
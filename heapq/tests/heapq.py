@@ -0,0 +1,67 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+from heapq import heappush, heappop, heapify, heapreplace, heappushpop, nlargest, nsmallest, merge
+from libtest import assertRaises, assertRaisesText
+
+def is_heap(h):
+    for i in range(len(h)):
+        for c in (2*i+1, 2*i+2):
+            if c < len(h) and h[c] < h[i]:
+                return False
+    return True
+
+doc = "heappush and heappop"
+h = []
+for x in [5, 3, 8, 1, 9, 2, 7]:
+    heappush(h, x)
+    assert is_heap(h)
+assert h[0] == 1
+out = []
+while h:
+    out.append(heappop(h))
+    assert is_heap(h)
+assert out == [1, 2, 3, 5, 7, 8, 9]
+assertRaisesText(IndexError, "index out of range", heappop, [])
+assertRaisesText(TypeError, "heappush() argument 1 must be list, not tuple", heappush, (), 1)
+
+doc = "heapify"
+h = [9, 8, 7, 6, 5, 4, 3, 2, 1, 0]
+assert heapify(h) is None
+assert h == [0, 1, 3, 2, 5, 4, 7, 9, 6, 8]
+assert is_heap(h)
+h = [(2, "b"), (1, "a"), (3, "c")]
+heapify(h)
+assert heappop(h) == (1, "a")
+
+doc = "heapreplace and heappushpop"
+h = [1, 3, 5]
+assert heapreplace(h, 4) == 1 and h == [3, 4, 5]
+assertRaisesText(IndexError, "index out of range", heapreplace, [], 1)
+h = [1, 3, 5]
+assert heappushpop(h, 0) == 0 and h == [1, 3, 5]
+assert heappushpop(h, 4) == 1 and h == [3, 4, 5]
+assert heappushpop([], 7) == 7
+
+doc = "nlargest and nsmallest"
+data = [5, 1, 8, 3, 9, 2]
+assert nlargest(3, data) == [9, 8, 5]
+assert nsmallest(3, data) == [1, 2, 3]
+assert nsmallest(0, data) == [] and nlargest(-1, data) == []
+assert nlargest(10, data) == [9, 8, 5, 3, 2, 1]
+words = ["bb", "a", "ccc", "dd", "e"]
+assert nlargest(2, words, key=len) == ["ccc", "bb"]
+assert nsmallest(2, words, key=len) == ["a", "e"]
+assert nsmallest(2, iter([3, 1, 2])) == [1, 2]
+
+doc = "merge"
+assert list(merge([1, 3, 5, 7], [0, 2, 4, 8], [5, 10, 15, 20], [], [25])) == [0, 1, 2, 3, 4, 5, 5, 7, 8, 10, 15, 20, 25]
+assert list(merge(["dog", "horse"], ["cat", "fish", "kangaroo"], key=len)) == ["dog", "cat", "fish", "horse", "kangaroo"]
+assert list(merge([7, 5, 1], [6, 2], reverse=True)) == [7, 6, 5, 2, 1]
+assert list(merge()) == []
+assert list(merge([(1, "a")], [(1, "b")], key=lambda x: x[0])) == [(1, "a"), (1, "b")]
+m = merge([1, 2], [0])
+assert iter(m) is m and next(m) == 0
+
+doc = "finished"
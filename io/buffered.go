@@ -0,0 +1,584 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Buffered binary streams
+
+package io
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/go-python/gpython/py"
+)
+
+var (
+	BufferedReaderType = BufferedIOBaseType.NewType("BufferedReader", "Create a new buffered reader using the given readable raw IO object.", BufferedReaderNew, nil)
+	BufferedWriterType = BufferedIOBaseType.NewType("BufferedWriter", `A buffer for a writeable sequential RawIO object.
+
+The constructor creates a BufferedWriter for the given writeable raw
+stream. If the buffer_size is not given, it defaults to
+DEFAULT_BUFFER_SIZE.`, BufferedWriterNew, nil)
+	BufferedRandomType = BufferedIOBaseType.NewType("BufferedRandom", `A buffered interface to random access streams.
+
+The constructor creates a reader and writer for a seekable stream,
+raw, given in the first argument. If the buffer_size is omitted it
+defaults to DEFAULT_BUFFER_SIZE.`, BufferedRandomNew, nil)
+)
+
+// Buffered is a BufferedReader, BufferedWriter or BufferedRandom
+// depending on whether it can read, write or both
+//
+// It buffers the raw stream it wraps which is used through its python
+// methods.
+type Buffered struct {
+	raw        py.Object
+	bufferSize int
+	readable   bool
+	writable   bool
+	rbuf       []byte      // read from raw but not returned yet
+	wbuf       []byte      // written but not passed to raw yet
+	writers    openWriters // writers to flush at exit it is registered in
+}
+
+// Type of this object
+func (b *Buffered) Type() *py.Type {
+	switch {
+	case b.readable && b.writable:
+		return BufferedRandomType
+	case b.readable:
+		return BufferedReaderType
+	}
+	return BufferedWriterType
+}
+
+// NewBufferedReader makes a BufferedReader reading from raw
+func NewBufferedReader(raw py.Object, bufferSize int) *Buffered {
+	return &Buffered{raw: raw, bufferSize: bufferSize, readable: true}
+}
+
+// NewBufferedWriter makes a BufferedWriter writing to raw
+func NewBufferedWriter(raw py.Object, bufferSize int) *Buffered {
+	return &Buffered{raw: raw, bufferSize: bufferSize, writable: true}
+}
+
+// NewBufferedRandom makes a BufferedRandom reading and writing raw
+func NewBufferedRandom(raw py.Object, bufferSize int) *Buffered {
+	return &Buffered{raw: raw, bufferSize: bufferSize, readable: true, writable: true}
+}
+
+// The key the open writers of a context are stored under
+type openWritersKey struct{}
+
+// The buffered writers opened in a context which haven't been closed
+type openWriters map[*Buffered]struct{}
+
+// Registers b to be flushed when ctx is finalized if it hasn't been
+// closed by then
+func (b *Buffered) register(ctx *py.Context) {
+	writers, ok := ctx.Value(openWritersKey{}).(openWriters)
+	if !ok {
+		writers = make(openWriters)
+		ctx.SetValue(openWritersKey{}, writers)
+		ctx.AtExit(writers.flush)
+	}
+	writers[b] = struct{}{}
+	b.writers = writers
+}
+
+// Flushes the writers, ignoring errors as there is nowhere to report
+// them when the interpreter is exiting
+func (writers openWriters) flush() {
+	for b := range writers {
+		_ = b.Flush()
+	}
+}
+
+// Makes a buffered stream from python checking raw can do what the
+// type needs
+func bufferedNew(name string, args py.Tuple, kwargs py.StringDict, newBuffered func(py.Object, int) *Buffered) (py.Object, error) {
+	var raw, bufferSize py.Object = nil, py.Int(DEFAULT_BUFFER_SIZE)
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|i:"+name, []string{"raw", "buffer_size"}, &raw, &bufferSize)
+	if err != nil {
+		return nil, err
+	}
+	size := int(bufferSize.(py.Int))
+	if size <= 0 {
+		return nil, py.ExceptionNewf(py.ValueError, "buffer size must be strictly positive")
+	}
+	b := newBuffered(raw, size)
+	if b.readable {
+		ok, err := callBool(raw, "readable")
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, py.ExceptionNewf(py.OSError, "\"raw\" argument must be readable.")
+		}
+	}
+	if b.writable {
+		ok, err := callBool(raw, "writable")
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, py.ExceptionNewf(py.OSError, "\"raw\" argument must be writable.")
+		}
+	}
+	return b, nil
+}
+
+// BufferedReaderNew makes a BufferedReader from python
+func BufferedReaderNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	return bufferedNew("BufferedReader", args, kwargs, NewBufferedReader)
+}
+
+// BufferedWriterNew makes a BufferedWriter from python
+func BufferedWriterNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	return bufferedNew("BufferedWriter", args, kwargs, NewBufferedWriter)
+}
+
+// BufferedRandomNew makes a BufferedRandom from python
+func BufferedRandomNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	return bufferedNew("BufferedRandom", args, kwargs, NewBufferedRandom)
+}
+
+// Returns an error if the stream is closed
+func (b *Buffered) checkClosed() error {
+	return checkClosed(b.raw)
+}
+
+// Returns an error if the stream is closed or can't be read
+func (b *Buffered) checkReadable() error {
+	if err := b.checkClosed(); err != nil {
+		return err
+	}
+	if !b.readable {
+		return errUnsupported("read")
+	}
+	return nil
+}
+
+// Returns an error if the stream is closed or can't be written
+func (b *Buffered) checkWritable() error {
+	if closed, err := isClosed(b.raw); err != nil {
+		return err
+	} else if closed {
+		return py.ExceptionNewf(py.ValueError, "write to closed file")
+	}
+	if !b.writable {
+		return errUnsupported("write")
+	}
+	return nil
+}
+
+// Reads up to n bytes from the raw stream into the read buffer
+// returning the number of bytes read which is 0 at the end of the
+// stream
+func (b *Buffered) fill(n int) (int, error) {
+	res, err := callMethod(b.raw, "read", py.Int(n))
+	if err != nil {
+		return 0, err
+	}
+	if res == py.None {
+		return 0, nil
+	}
+	chunk, err := bytesArg("read", res)
+	if err != nil {
+		return 0, err
+	}
+	b.rbuf = append(b.rbuf, chunk...)
+	return len(chunk), nil
+}
+
+// Removes and returns the first n bytes of the read buffer
+func (b *Buffered) take(n int) py.Bytes {
+	if n > len(b.rbuf) {
+		n = len(b.rbuf)
+	}
+	res := make(py.Bytes, n)
+	copy(res, b.rbuf)
+	b.rbuf = b.rbuf[n:]
+	return res
+}
+
+// Writes out the write buffer to the raw stream
+func (b *Buffered) flushWrites() error {
+	for len(b.wbuf) > 0 {
+		res, err := callMethod(b.raw, "write", py.Bytes(b.wbuf))
+		if err != nil {
+			return err
+		}
+		n, ok := res.(py.Int)
+		if !ok {
+			return py.ExceptionNewf(py.BlockingIOError, "write could not complete without blocking")
+		}
+		if int(n) < 0 || int(n) > len(b.wbuf) {
+			return py.ExceptionNewf(py.OSError, "raw write() returned invalid length %d (should have been between 0 and %d)", n, len(b.wbuf))
+		}
+		b.wbuf = b.wbuf[n:]
+	}
+	b.wbuf = nil
+	return nil
+}
+
+// Discards the read buffer moving the raw stream back so its position
+// is where the reader had got to
+func (b *Buffered) resetRead() error {
+	if len(b.rbuf) > 0 {
+		_, err := callMethod(b.raw, "seek", py.Int(-len(b.rbuf)), py.Int(SEEK_CUR))
+		if err != nil {
+			return err
+		}
+	}
+	b.rbuf = nil
+	return nil
+}
+
+// Read reads size bytes or until the end of the stream if size < 0
+func (b *Buffered) Read(size int) (py.Object, error) {
+	if err := b.checkReadable(); err != nil {
+		return nil, err
+	}
+	if err := b.flushWrites(); err != nil {
+		return nil, err
+	}
+	if size < 0 {
+		for {
+			n, err := b.fill(b.bufferSize)
+			if err != nil {
+				return nil, err
+			}
+			if n == 0 {
+				break
+			}
+		}
+		return b.take(len(b.rbuf)), nil
+	}
+	for len(b.rbuf) < size {
+		want := size - len(b.rbuf)
+		if want < b.bufferSize {
+			want = b.bufferSize
+		}
+		n, err := b.fill(want)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			break
+		}
+	}
+	return b.take(size), nil
+}
+
+// Read1 reads up to size bytes with at most one read of the raw stream
+func (b *Buffered) Read1(size int) (py.Object, error) {
+	if err := b.checkReadable(); err != nil {
+		return nil, err
+	}
+	if err := b.flushWrites(); err != nil {
+		return nil, err
+	}
+	if size < 0 {
+		size = b.bufferSize
+	}
+	if size == 0 {
+		return py.Bytes{}, nil
+	}
+	if len(b.rbuf) == 0 {
+		want := size
+		if want < b.bufferSize {
+			want = b.bufferSize
+		}
+		if _, err := b.fill(want); err != nil {
+			return nil, err
+		}
+	}
+	return b.take(size), nil
+}
+
+// Peek returns the buffered bytes without consuming them reading from
+// the raw stream if there aren't any
+func (b *Buffered) Peek() (py.Object, error) {
+	if err := b.checkReadable(); err != nil {
+		return nil, err
+	}
+	if err := b.flushWrites(); err != nil {
+		return nil, err
+	}
+	if len(b.rbuf) == 0 {
+		if _, err := b.fill(b.bufferSize); err != nil {
+			return nil, err
+		}
+	}
+	return py.Bytes(append([]byte(nil), b.rbuf...)), nil
+}
+
+// ReadLine reads up to and including the next "\n" or size bytes if
+// size >= 0
+func (b *Buffered) ReadLine(size int) (py.Object, error) {
+	if err := b.checkReadable(); err != nil {
+		return nil, err
+	}
+	if err := b.flushWrites(); err != nil {
+		return nil, err
+	}
+	end := 0
+	for {
+		if i := bytes.IndexByte(b.rbuf, '\n'); i >= 0 {
+			end = i + 1
+			break
+		}
+		if size >= 0 && len(b.rbuf) >= size {
+			end = size
+			break
+		}
+		n, err := b.fill(b.bufferSize)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			end = len(b.rbuf)
+			break
+		}
+	}
+	if size >= 0 && end > size {
+		end = size
+	}
+	return b.take(end), nil
+}
+
+// Write buffers data writing it to the raw stream when the buffer is
+// full
+func (b *Buffered) Write(data []byte) (py.Object, error) {
+	if err := b.checkWritable(); err != nil {
+		return nil, err
+	}
+	if err := b.resetRead(); err != nil {
+		return nil, err
+	}
+	b.wbuf = append(b.wbuf, data...)
+	if len(b.wbuf) >= b.bufferSize {
+		if err := b.flushWrites(); err != nil {
+			return nil, err
+		}
+	}
+	return py.Int(len(data)), nil
+}
+
+// Flush writes out anything buffered to the raw stream
+func (b *Buffered) Flush() error {
+	if b.writable {
+		if err := b.checkWritable(); err != nil {
+			return err
+		}
+		return b.flushWrites()
+	}
+	return b.checkClosed()
+}
+
+// Moves to pos relative to whence returning the new position
+func (b *Buffered) seek(pos int64, whence int) (py.Object, error) {
+	if err := b.checkClosed(); err != nil {
+		return nil, err
+	}
+	if err := b.flushWrites(); err != nil {
+		return nil, err
+	}
+	if whence == SEEK_CUR {
+		pos -= int64(len(b.rbuf))
+	}
+	b.rbuf = nil
+	return callMethod(b.raw, "seek", py.Int(pos), py.Int(whence))
+}
+
+// Tell returns the position of the stream
+func (b *Buffered) Tell() (py.Object, error) {
+	res, err := callMethod(b.raw, "tell")
+	if err != nil {
+		return nil, err
+	}
+	pos, ok := res.(py.Int)
+	if !ok {
+		return nil, py.ExceptionNewf(py.TypeError, "raw tell() returned %s not int", res.Type().Name)
+	}
+	pos = pos - py.Int(len(b.rbuf)) + py.Int(len(b.wbuf))
+	if pos < 0 {
+		pos = 0
+	}
+	return pos, nil
+}
+
+// Truncate resizes the stream to pos bytes or the current position if
+// pos is None
+func (b *Buffered) Truncate(pos py.Object) (py.Object, error) {
+	if err := b.checkWritable(); err != nil {
+		return nil, err
+	}
+	if err := b.flushWrites(); err != nil {
+		return nil, err
+	}
+	if pos == py.None {
+		var err error
+		pos, err = b.Tell()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := b.resetRead(); err != nil {
+		return nil, err
+	}
+	return callMethod(b.raw, "truncate", pos)
+}
+
+// Close flushes the stream and closes the raw stream
+func (b *Buffered) Close() error {
+	closed, err := isClosed(b.raw)
+	if err != nil || closed {
+		return err
+	}
+	delete(b.writers, b)
+	flushErr := b.Flush()
+	_, err = callMethod(b.raw, "close")
+	if flushErr != nil {
+		return flushErr
+	}
+	return err
+}
+
+func (b *Buffered) M__repr__() (py.Object, error) {
+	name, err := py.GetAttrString(b.raw, "name")
+	if err != nil {
+		return py.String(fmt.Sprintf("<_io.%s>", b.Type().Name)), nil
+	}
+	repr, err := py.ReprAsString(name)
+	if err != nil {
+		return nil, err
+	}
+	return py.String(fmt.Sprintf("<_io.%s name=%s>", b.Type().Name, repr)), nil
+}
+
+func (b *Buffered) M__iter__() (py.Object, error) {
+	if err := b.checkClosed(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (b *Buffered) M__next__() (py.Object, error) {
+	return iterNext(b)
+}
+
+// Check interfaces are satisfied
+var _ py.I__repr__ = (*Buffered)(nil)
+var _ py.I_iterator = (*Buffered)(nil)
+
+func init() {
+	self := func(o py.Object) *Buffered {
+		return o.(*Buffered)
+	}
+	dict := py.StringDict{}
+	dict["raw"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return self(o).raw, nil
+		},
+	}
+	for _, name := range []string{"name", "mode"} {
+		name := name
+		dict[name] = &py.Property{
+			Fget: func(o py.Object) (py.Object, error) {
+				return py.GetAttrString(self(o).raw, name)
+			},
+		}
+	}
+	dict["closed"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return py.GetAttrString(self(o).raw, "closed")
+		},
+	}
+	dict["read"] = py.MustNewMethod("read", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		size, err := readSizeArgs("read", args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).Read(size)
+	}, 0, "Read and return up to size bytes.\n\nIf the argument is omitted, None, or negative, reads and\nreturns all data until EOF.")
+	dict["read1"] = py.MustNewMethod("read1", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		size, err := readSizeArgs("read1", args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).Read1(size)
+	}, 0, "Read and return up to size bytes, with at most one read() call\nto the underlying raw stream. A short result does not imply\nthat EOF is imminent.")
+	dict["peek"] = py.MustNewMethod("peek", func(o py.Object, args py.Tuple) (py.Object, error) {
+		var size py.Object = py.Int(0)
+		err := py.UnpackTuple(args, nil, "peek", 0, 1, &size)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).Peek()
+	}, 0, "Return buffered bytes without advancing the position.")
+	dict["readline"] = py.MustNewMethod("readline", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		size, err := readSizeArgs("readline", args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).ReadLine(size)
+	}, 0, "Read and return a line from the stream.\n\nIf size is specified, at most size bytes will be read.")
+	dict["write"] = py.MustNewMethod("write", func(o py.Object, arg py.Object) (py.Object, error) {
+		data, err := bytesArg("write", arg)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).Write(data)
+	}, 0, "Write the given buffer to the IO stream.\n\nReturns the number of bytes written.")
+	dict["flush"] = py.MustNewMethod("flush", func(o py.Object) (py.Object, error) {
+		return py.None, self(o).Flush()
+	}, 0, "Flush the write buffer to the raw stream.")
+	dict["seek"] = py.MustNewMethod("seek", func(o py.Object, args py.Tuple) (py.Object, error) {
+		pos, whence, err := seekArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).seek(pos, whence)
+	}, 0, "Change stream position.")
+	dict["tell"] = py.MustNewMethod("tell", func(o py.Object) (py.Object, error) {
+		return self(o).Tell()
+	}, 0, "Return current stream position.")
+	dict["truncate"] = py.MustNewMethod("truncate", func(o py.Object, args py.Tuple) (py.Object, error) {
+		var pos py.Object = py.None
+		err := py.UnpackTuple(args, nil, "truncate", 0, 1, &pos)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).Truncate(pos)
+	}, 0, "Truncate file to size bytes.")
+	dict["close"] = py.MustNewMethod("close", func(o py.Object) (py.Object, error) {
+		return py.None, self(o).Close()
+	}, 0, "Flush and close the stream.")
+	for _, name := range []string{"fileno", "isatty", "seekable"} {
+		name := name
+		dict[name] = py.MustNewMethod(name, func(o py.Object) (py.Object, error) {
+			return callMethod(self(o).raw, name)
+		}, 0, "")
+	}
+	dict["readable"] = py.MustNewMethod("readable", func(o py.Object) (py.Object, error) {
+		if !self(o).readable {
+			return py.False, nil
+		}
+		return callMethod(self(o).raw, "readable")
+	}, 0, "Return whether object was opened for reading.")
+	dict["writable"] = py.MustNewMethod("writable", func(o py.Object) (py.Object, error) {
+		if !self(o).writable {
+			return py.False, nil
+		}
+		return callMethod(self(o).raw, "writable")
+	}, 0, "Return whether object was opened for writing.")
+
+	for _, t := range []*py.Type{BufferedReaderType, BufferedWriterType, BufferedRandomType} {
+		for name, value := range dict {
+			t.Dict[name] = value
+		}
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// BytesIO in memory binary stream
+
+package io
+
+import (
+	"bytes"
+
+	"github.com/go-python/gpython/py"
+)
+
+var BytesIOType = BufferedIOBaseType.NewType("BytesIO", "BytesIO([buffer]) -> object\n\nCreate a buffered I/O implementation using an in-memory bytes\nbuffer, ready for reading and writing.", BytesIONew, nil)
+
+// BytesIO is a binary stream kept in memory
+type BytesIO struct {
+	buf    []byte
+	pos    int
+	closed bool
+}
+
+// Type of this object
+func (b *BytesIO) Type() *py.Type {
+	return BytesIOType
+}
+
+// NewBytesIO makes a BytesIO containing a copy of data positioned at
+// the start
+func NewBytesIO(data []byte) *BytesIO {
+	return &BytesIO{buf: append([]byte(nil), data...)}
+}
+
+// BytesIONew makes a BytesIO from python
+func BytesIONew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var initial py.Object = py.None
+	err := py.ParseTupleAndKeywords(args, kwargs, "|O:BytesIO", []string{"initial_bytes"}, &initial)
+	if err != nil {
+		return nil, err
+	}
+	if initial == py.None {
+		return NewBytesIO(nil), nil
+	}
+	data, err := bytesArg("BytesIO", initial)
+	if err != nil {
+		return nil, err
+	}
+	return NewBytesIO(data), nil
+}
+
+// Returns an error if the stream is closed
+func (b *BytesIO) checkClosed() error {
+	if b.closed {
+		return errClosed()
+	}
+	return nil
+}
+
+// Removes and returns the next n bytes or all of them if n < 0
+func (b *BytesIO) take(n int) py.Object {
+	start := b.pos
+	if start > len(b.buf) {
+		start = len(b.buf)
+	}
+	end := start + n
+	if n < 0 || end > len(b.buf) {
+		end = len(b.buf)
+	}
+	b.pos = end
+	return py.Bytes(append([]byte(nil), b.buf[start:end]...))
+}
+
+// Read reads size bytes or the rest of the stream if size < 0
+func (b *BytesIO) Read(size int) (py.Object, error) {
+	if err := b.checkClosed(); err != nil {
+		return nil, err
+	}
+	return b.take(size), nil
+}
+
+// ReadLine reads up to and including the next "\n" or at most size
+// bytes if size >= 0
+func (b *BytesIO) ReadLine(size int) (py.Object, error) {
+	if err := b.checkClosed(); err != nil {
+		return nil, err
+	}
+	if b.pos >= len(b.buf) {
+		return py.Bytes{}, nil
+	}
+	end := len(b.buf) - b.pos
+	if i := bytes.IndexByte(b.buf[b.pos:], '\n'); i >= 0 {
+		end = i + 1
+	}
+	if size >= 0 && end > size {
+		end = size
+	}
+	return b.take(end), nil
+}
+
+// Write writes data at the current position returning the number of
+// bytes written
+func (b *BytesIO) Write(data []byte) (py.Object, error) {
+	if err := b.checkClosed(); err != nil {
+		return nil, err
+	}
+	for len(b.buf) < b.pos {
+		b.buf = append(b.buf, 0)
+	}
+	end := b.pos + len(data)
+	if end > len(b.buf) {
+		b.buf = append(b.buf, make([]byte, end-len(b.buf))...)
+	}
+	copy(b.buf[b.pos:], data)
+	b.pos = end
+	return py.Int(len(data)), nil
+}
+
+// Moves to pos relative to whence returning the new position
+func (b *BytesIO) seek(pos int64, whence int) (py.Object, error) {
+	if err := b.checkClosed(); err != nil {
+		return nil, err
+	}
+	switch whence {
+	case SEEK_SET:
+		if pos < 0 {
+			return nil, py.ExceptionNewf(py.ValueError, "negative seek value %d", pos)
+		}
+	case SEEK_CUR:
+		pos += int64(b.pos)
+	case SEEK_END:
+		pos += int64(len(b.buf))
+	}
+	if pos < 0 {
+		pos = 0
+	}
+	b.pos = int(pos)
+	return py.Int(b.pos), nil
+}
+
+// Truncate resizes the stream to size bytes or the current position
+// if size is None leaving the position unchanged
+func (b *BytesIO) Truncate(sizeObj py.Object) (py.Object, error) {
+	if err := b.checkClosed(); err != nil {
+		return nil, err
+	}
+	size := b.pos
+	if sizeObj != py.None {
+		var err error
+		size, err = sizeArg("truncate", sizeObj)
+		if err != nil {
+			return nil, err
+		}
+		if size < 0 {
+			return nil, py.ExceptionNewf(py.ValueError, "negative size value %d", size)
+		}
+	}
+	if size < len(b.buf) {
+		b.buf = b.buf[:size]
+	}
+	return py.Int(size), nil
+}
+
+// GetValue returns the contents of the stream
+func (b *BytesIO) GetValue() (py.Object, error) {
+	if err := b.checkClosed(); err != nil {
+		return nil, err
+	}
+	return py.Bytes(append([]byte(nil), b.buf...)), nil
+}
+
+func (b *BytesIO) M__iter__() (py.Object, error) {
+	if err := b.checkClosed(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (b *BytesIO) M__next__() (py.Object, error) {
+	return iterNext(b)
+}
+
+// Check interfaces are satisfied
+var _ py.I_iterator = (*BytesIO)(nil)
+
+func init() {
+	self := func(o py.Object) *BytesIO {
+		return o.(*BytesIO)
+	}
+	BytesIOType.Dict["closed"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return py.NewBool(self(o).closed), nil
+		},
+	}
+	BytesIOType.Dict["getvalue"] = py.MustNewMethod("getvalue", func(o py.Object) (py.Object, error) {
+		return self(o).GetValue()
+	}, 0, "getvalue() -> bytes.\n\nRetrieve the entire contents of the BytesIO object.")
+	for _, name := range []string{"read", "read1"} {
+		name := name
+		BytesIOType.Dict[name] = py.MustNewMethod(name, func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+			size, err := readSizeArgs(name, args, kwargs)
+			if err != nil {
+				return nil, err
+			}
+			return self(o).Read(size)
+		}, 0, name+"([size]) -> read at most size bytes, returned as a bytes object.\n\nIf the size argument is negative, read until EOF is reached.\nReturn an empty bytes object at EOF.")
+	}
+	BytesIOType.Dict["readline"] = py.MustNewMethod("readline", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		size, err := readSizeArgs("readline", args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).ReadLine(size)
+	}, 0, "readline([size]) -> next line from the file, as a bytes object.\n\nRetain newline.  A non-negative size argument limits the maximum\nnumber of bytes to return (an incomplete line may be returned then).\nReturn an empty bytes object at EOF.")
+	BytesIOType.Dict["write"] = py.MustNewMethod("write", func(o py.Object, arg py.Object) (py.Object, error) {
+		data, err := bytesArg("write", arg)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).Write(data)
+	}, 0, "write(bytes) -> int.  Write bytes to file.\n\nReturn the number of bytes written.")
+	BytesIOType.Dict["seek"] = py.MustNewMethod("seek", func(o py.Object, args py.Tuple) (py.Object, error) {
+		pos, whence, err := seekArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).seek(pos, whence)
+	}, 0, "seek(pos, whence=0) -> int.  Change stream position.\n\nSeek to byte offset pos relative to position indicated by whence:\n     0  Start of stream (the default).  pos should be >= 0;\n     1  Current position - pos may be negative;\n     2  End of stream - pos usually negative.\nReturns the new absolute position.")
+	BytesIOType.Dict["tell"] = py.MustNewMethod("tell", func(o py.Object) (py.Object, error) {
+		if err := self(o).checkClosed(); err != nil {
+			return nil, err
+		}
+		return py.Int(self(o).pos), nil
+	}, 0, "tell() -> current file position, an integer")
+	BytesIOType.Dict["truncate"] = py.MustNewMethod("truncate", func(o py.Object, args py.Tuple) (py.Object, error) {
+		var size py.Object = py.None
+		err := py.UnpackTuple(args, nil, "truncate", 0, 1, &size)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).Truncate(size)
+	}, 0, "truncate([size]) -> int.  Truncate the file to at most size bytes.\n\nSize defaults to the current file position, as returned by tell().\nThe current file position is unchanged.  Returns the new size.")
+	BytesIOType.Dict["close"] = py.MustNewMethod("close", func(o py.Object) (py.Object, error) {
+		self(o).closed = true
+		self(o).buf = nil
+		return py.None, nil
+	}, 0, "close() -> None.  Disable all I/O operations.")
+	for _, name := range []string{"readable", "writable", "seekable"} {
+		BytesIOType.Dict[name] = py.MustNewMethod(name, func(o py.Object) (py.Object, error) {
+			if err := self(o).checkClosed(); err != nil {
+				return nil, err
+			}
+			return py.True, nil
+		}, 0, "")
+	}
+}
@@ -0,0 +1,366 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Text encodings used by the text streams
+
+package io
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/go-python/gpython/py"
+)
+
+// The encoding used when none is given
+const defaultEncoding = "UTF-8"
+
+// A codec encodes and decodes text
+//
+// The utf-16 and utf-32 codecs have a width. Those without a byte
+// order start the stream with a byte order mark and are resolved to
+// the codec with the byte order in use by the text stream.
+type codec struct {
+	name    string    // python's name for the codec, used in errors
+	maxRune rune      // largest rune which can be encoded
+	width   int       // size of the code units of utf-16 and utf-32
+	order   byteOrder // byte order of utf-16 and utf-32 or nil if given by a BOM
+	native  *codec    // codec used when there is no byte order mark
+}
+
+// The byte orders used by the utf-16 and utf-32 codecs
+type byteOrder interface {
+	binary.ByteOrder
+	binary.AppendByteOrder
+}
+
+var (
+	utf8Codec    = &codec{name: "utf-8", maxRune: unicode.MaxRune}
+	asciiCodec   = &codec{name: "ascii", maxRune: 0x7F}
+	latin1Codec  = &codec{name: "latin-1", maxRune: 0xFF}
+	utf16LECodec = &codec{name: "utf-16-le", maxRune: unicode.MaxRune, width: 2, order: binary.LittleEndian}
+	utf16BECodec = &codec{name: "utf-16-be", maxRune: unicode.MaxRune, width: 2, order: binary.BigEndian}
+	utf16Codec   = &codec{name: "utf-16", maxRune: unicode.MaxRune, width: 2, native: utf16LECodec}
+	utf32LECodec = &codec{name: "utf-32-le", maxRune: unicode.MaxRune, width: 4, order: binary.LittleEndian}
+	utf32BECodec = &codec{name: "utf-32-be", maxRune: unicode.MaxRune, width: 4, order: binary.BigEndian}
+	utf32Codec   = &codec{name: "utf-32", maxRune: unicode.MaxRune, width: 4, native: utf32LECodec}
+)
+
+// The codecs indexed by normalised name
+var codecs = map[string]*codec{
+	"utf-8":      utf8Codec,
+	"utf8":       utf8Codec,
+	"u8":         utf8Codec,
+	"ascii":      asciiCodec,
+	"us-ascii":   asciiCodec,
+	"646":        asciiCodec,
+	"latin-1":    latin1Codec,
+	"latin1":     latin1Codec,
+	"latin":      latin1Codec,
+	"l1":         latin1Codec,
+	"iso-8859-1": latin1Codec,
+	"iso8859-1":  latin1Codec,
+	"8859":       latin1Codec,
+	"cp819":      latin1Codec,
+	"utf-16":     utf16Codec,
+	"utf16":      utf16Codec,
+	"u16":        utf16Codec,
+	"utf-16-le":  utf16LECodec,
+	"utf-16le":   utf16LECodec,
+	"utf-16-be":  utf16BECodec,
+	"utf-16be":   utf16BECodec,
+	"utf-32":     utf32Codec,
+	"utf32":      utf32Codec,
+	"u32":        utf32Codec,
+	"utf-32-le":  utf32LECodec,
+	"utf-32le":   utf32LECodec,
+	"utf-32-be":  utf32BECodec,
+	"utf-32be":   utf32BECodec,
+}
+
+// The error handlers which are understood
+var errorHandlers = map[string]bool{
+	"strict":            true,
+	"ignore":            true,
+	"replace":           true,
+	"backslashreplace":  true,
+	"xmlcharrefreplace": true,
+}
+
+// Finds the codec for encoding
+func lookupCodec(encoding string) (*codec, error) {
+	c, ok := codecs[strings.Replace(strings.ToLower(encoding), "_", "-", -1)]
+	if !ok {
+		return nil, py.ExceptionNewf(py.LookupError, "unknown encoding: %s", encoding)
+	}
+	return c, nil
+}
+
+// Checks errors is the name of an error handler
+func checkErrorHandler(errors string) error {
+	if !errorHandlers[errors] {
+		return py.ExceptionNewf(py.LookupError, "unknown error handler name '%s'", errors)
+	}
+	return nil
+}
+
+// Returns whether the codec starts the stream with a byte order mark
+func (c *codec) hasBOM() bool {
+	return c.native != nil
+}
+
+// Returns the byte order mark written at the start of the stream
+func (c *codec) bom() []byte {
+	return c.native.bomOf()
+}
+
+// Reads the byte order mark at the start of data returning the codec
+// for the byte order found and the data after it. It returns a nil
+// codec if more data is needed to tell.
+func (c *codec) readBOM(data []byte, final bool) (*codec, []byte, error) {
+	if len(data) < c.width {
+		if !final {
+			return nil, data, nil
+		}
+		return c.native, data, nil
+	}
+	for _, order := range []*codec{c.native, c.swapped()} {
+		if mark := order.bomOf(); string(data[:len(mark)]) == string(mark) {
+			return order, data[len(mark):], nil
+		}
+	}
+	return nil, nil, py.ExceptionNewf(py.UnicodeError, "%s stream does not start with BOM", strings.ToUpper(c.name))
+}
+
+// Returns the byte order mark in c's byte order
+func (c *codec) bomOf() []byte {
+	data, _ := c.encode("\ufeff", "strict")
+	return data
+}
+
+// Returns the codec with the opposite byte order to the native one
+func (c *codec) swapped() *codec {
+	if c.width == 2 {
+		return utf16BECodec
+	}
+	return utf32BECodec
+}
+
+// Returns the error for data[start:end] failing to decode or the
+// text to replace it with
+func (c *codec) decodeError(data []byte, start, end int, reason, errors string) ([]rune, error) {
+	switch errors {
+	case "ignore":
+		return nil, nil
+	case "replace":
+		return []rune{unicode.ReplacementChar}, nil
+	case "backslashreplace":
+		var text []rune
+		for _, b := range data[start:end] {
+			text = append(text, []rune(fmt.Sprintf("\\x%02x", b))...)
+		}
+		return text, nil
+	}
+	if end-start == 1 {
+		return nil, py.ExceptionNewf(py.UnicodeDecodeError, "'%s' codec can't decode byte 0x%02x in position %d: %s", c.name, data[start], start, reason)
+	}
+	return nil, py.ExceptionNewf(py.UnicodeDecodeError, "'%s' codec can't decode bytes in position %d-%d: %s", c.name, start, end-1, reason)
+}
+
+// Decodes utf-16 or utf-32 data in the manner of decode
+func (c *codec) decodeWide(data []byte, final bool, errors string) (text []rune, rest []byte, err error) {
+	text = make([]rune, 0, len(data)/c.width)
+	for i := 0; i < len(data); {
+		var r rune
+		end := i + c.width
+		reason := ""
+		switch {
+		case end > len(data):
+			if !final {
+				return text, data[i:], nil
+			}
+			end, reason = len(data), "truncated data"
+		case c.width == 2:
+			r = rune(c.order.Uint16(data[i:]))
+			switch {
+			case !utf16.IsSurrogate(r):
+			case r >= 0xDC00:
+				reason = "illegal encoding"
+			case end+2 > len(data):
+				if !final {
+					return text, data[i:], nil
+				}
+				end, reason = len(data), "unexpected end of data"
+			default:
+				r2 := rune(c.order.Uint16(data[end:]))
+				if r2 < 0xDC00 || r2 > 0xDFFF {
+					reason = "illegal UTF-16 surrogate"
+				} else {
+					r = utf16.DecodeRune(r, r2)
+					end += 2
+				}
+			}
+		default:
+			u := c.order.Uint32(data[i:])
+			switch {
+			case u > unicode.MaxRune:
+				reason = "code point not in range(0x110000)"
+			case utf16.IsSurrogate(rune(u)):
+				reason = "code point in surrogate code point range(0xd800, 0xe000)"
+			default:
+				r = rune(u)
+			}
+		}
+		if reason != "" {
+			replacement, err := c.decodeError(data, i, end, reason, errors)
+			if err != nil {
+				return nil, nil, err
+			}
+			text = append(text, replacement...)
+		} else {
+			text = append(text, r)
+		}
+		i = end
+	}
+	return text, nil, nil
+}
+
+// Decodes data returning the text and any bytes at the end which are
+// the start of a character which is continued in the next data.
+// Incomplete characters are errors if final is set.
+func (c *codec) decode(data []byte, final bool, errors string) (text []rune, rest []byte, err error) {
+	if c.width != 0 {
+		return c.decodeWide(data, final, errors)
+	}
+	text = make([]rune, 0, len(data))
+	for i := 0; i < len(data); {
+		r, size := rune(data[i]), 1
+		reason := ""
+		switch c {
+		case utf8Codec:
+			if r >= utf8.RuneSelf {
+				r, size = utf8.DecodeRune(data[i:])
+				if r == utf8.RuneError && size <= 1 {
+					switch {
+					case !utf8.FullRune(data[i:]) && !final:
+						return text, data[i:], nil
+					case !utf8.FullRune(data[i:]):
+						reason = "unexpected end of data"
+					case data[i] < 0xC2 || data[i] > 0xF4:
+						reason = "invalid start byte"
+					default:
+						reason = "invalid continuation byte"
+					}
+				}
+			}
+		case asciiCodec:
+			if r > c.maxRune {
+				reason = "ordinal not in range(128)"
+			}
+		}
+		if reason != "" {
+			switch errors {
+			case "ignore":
+			case "replace":
+				text = append(text, unicode.ReplacementChar)
+			case "backslashreplace":
+				text = append(text, []rune(fmt.Sprintf("\\x%02x", data[i]))...)
+			default:
+				return nil, nil, py.ExceptionNewf(py.UnicodeDecodeError, "'%s' codec can't decode byte 0x%02x in position %d: %s", c.name, data[i], i, reason)
+			}
+			i++
+			continue
+		}
+		text = append(text, r)
+		i += size
+	}
+	return text, nil, nil
+}
+
+// Returns r in the form python uses to show characters in errors
+func escapeRune(r rune) string {
+	switch {
+	case r < 0x100:
+		return fmt.Sprintf("\\x%02x", r)
+	case r < 0x10000:
+		return fmt.Sprintf("\\u%04x", r)
+	}
+	return fmt.Sprintf("\\U%08x", r)
+}
+
+// Encodes text
+func (c *codec) encode(text string, errors string) ([]byte, error) {
+	switch {
+	case c == utf8Codec:
+		return []byte(text), nil
+	case c.native != nil:
+		return c.native.encode(text, errors)
+	case c.width == 2:
+		out := make([]byte, 0, 2*len(text))
+		for _, r := range text {
+			if r1, r2 := utf16.EncodeRune(r); r1 != unicode.ReplacementChar {
+				out = c.order.AppendUint16(out, uint16(r1))
+				out = c.order.AppendUint16(out, uint16(r2))
+			} else {
+				out = c.order.AppendUint16(out, uint16(r))
+			}
+		}
+		return out, nil
+	case c.width == 4:
+		out := make([]byte, 0, 4*len(text))
+		for _, r := range text {
+			out = c.order.AppendUint32(out, uint32(r))
+		}
+		return out, nil
+	}
+	out := make([]byte, 0, len(text))
+	i := 0
+	for _, r := range text {
+		if r <= c.maxRune {
+			out = append(out, byte(r))
+		} else {
+			switch errors {
+			case "ignore":
+			case "replace":
+				out = append(out, '?')
+			case "backslashreplace":
+				out = append(out, escapeRune(r)...)
+			case "xmlcharrefreplace":
+				out = append(out, fmt.Sprintf("&#%d;", r)...)
+			default:
+				return nil, py.ExceptionNewf(py.UnicodeEncodeError, "'%s' codec can't encode character '%s' in position %d: ordinal not in range(%d)", c.name, escapeRune(r), i, c.maxRune+1)
+			}
+		}
+		i++
+	}
+	return out, nil
+}
+
+// Returns the number of bytes text encodes to
+//
+// This is used to work out stream positions which are only exact if
+// the text was decoded without errors.
+func (c *codec) encodedLen(text []rune) int {
+	switch {
+	case c == utf8Codec:
+		n := 0
+		for _, r := range text {
+			n += utf8.RuneLen(r)
+		}
+		return n
+	case c.width == 2:
+		n := 0
+		for _, r := range text {
+			n += 2 * utf16.RuneLen(r)
+		}
+		return n
+	case c.width == 4:
+		return 4 * len(text)
+	}
+	return len(text)
+}
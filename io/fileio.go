@@ -0,0 +1,566 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// FileIO raw stream
+
+package io
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"syscall"
+
+	"github.com/go-python/gpython/py"
+	"github.com/go-python/gpython/vfs"
+)
+
+var FileIOType = RawIOBaseType.NewType("FileIO", `file(name: str[, mode: str][, opener: None]) -> file IO object
+
+Open a file.  The mode can be 'r' (default), 'w', 'x' or 'a' for reading,
+writing, exclusive creation or appending.  The file will be created if it
+doesn't exist when opened for writing or appending; it will be truncated
+when opened for writing.  A FileExistsError will be raised if it already
+exists when opened for creating. Opening a file for creating implies
+writing so this mode behaves in a similar way to 'w'.Add a '+' to the mode
+to allow simultaneous reading and writing. A custom opener can be used by
+passing a callable as *opener*. The underlying file descriptor for the file
+object is then obtained by calling opener with (*name*, *flags*).
+*opener* must return an open file descriptor (passing os.open as *opener*
+results in functionality similar to passing None).`, fileIONoContext, nil)
+
+// FileIO is a raw stream reading and writing an OS file
+type FileIO struct {
+	typ       *py.Type // FileIO type of the context or nil
+	file      vfs.File // nil when closed
+	name      py.Object
+	mode      string
+	readable  bool
+	writable  bool
+	appending bool
+	closefd   bool
+}
+
+// Type of this object
+func (f *FileIO) Type() *py.Type {
+	if f.typ != nil {
+		return f.typ
+	}
+	return FileIOType
+}
+
+// Returns the FileIO type of ctx which python code uses to open files
+// on the context's filesystem
+func fileIOType(ctx *py.Context) *py.Type {
+	return ctx.SubType(FileIOType, fileIONew)
+}
+
+// NewFileIO opens file, which is a file name or a file descriptor,
+// with mode which is made of the characters "rwxa+b" on the
+// filesystem of ctx
+//
+// If opener isn't None it is called with the name and the os flags
+// and must return a file descriptor.
+func NewFileIO(ctx *py.Context, file py.Object, mode string, closefd bool, opener py.Object) (*FileIO, error) {
+	f := &FileIO{
+		typ:     fileIOType(ctx),
+		name:    file,
+		closefd: closefd,
+	}
+	flags, err := f.parseMode(mode)
+	if err != nil {
+		return nil, err
+	}
+
+	switch x := file.(type) {
+	case py.Int:
+		fd, err := x.GoInt()
+		if err != nil {
+			return nil, err
+		}
+		if fd < 0 {
+			return nil, py.ExceptionNewf(py.ValueError, "negative file descriptor")
+		}
+		f.file, err = openFd(ctx, fd, fmt.Sprintf("fd %d", fd))
+		if err != nil {
+			return nil, err
+		}
+	case py.String:
+		if !closefd {
+			return nil, py.ExceptionNewf(py.ValueError, "Cannot use closefd=False with file name")
+		}
+		name := string(x)
+		if opener == py.None {
+			f.file, err = ctx.FS().OpenFile(name, flags, 0666)
+			if err != nil {
+				return nil, py.ExceptionFromOSError(err, file)
+			}
+		} else {
+			res, err := py.Call(opener, py.Tuple{file, py.Int(flags)}, nil)
+			if err != nil {
+				return nil, err
+			}
+			fd, ok := res.(py.Int)
+			if !ok {
+				return nil, py.ExceptionNewf(py.TypeError, "expected integer from opener")
+			}
+			if fd < 0 {
+				return nil, py.ExceptionNewf(py.ValueError, "opener returned %d", fd)
+			}
+			f.file, err = openFd(ctx, int(fd), name)
+			if err != nil {
+				return nil, err
+			}
+		}
+	default:
+		repr, _ := py.ReprAsString(file)
+		return nil, py.ExceptionNewf(py.TypeError, "invalid file: %s", repr)
+	}
+
+	if fi, err := f.file.Stat(); err == nil && fi.IsDir() {
+		if f.closefd {
+			_ = f.file.Close()
+		}
+		return nil, py.ExceptionFromOSError(syscall.EISDIR, file)
+	}
+	if f.appending {
+		_, _ = f.file.Seek(0, io.SeekEnd)
+	}
+	return f, nil
+}
+
+// Returns the file for the descriptor fd
+//
+// Descriptors are for the real disk so they can't be used when ctx
+// has another filesystem.
+func openFd(ctx *py.Context, fd int, name string) (vfs.File, error) {
+	if ctx.FS() != vfs.OS {
+		return nil, py.ExceptionFromOSError(syscall.EBADF, nil)
+	}
+	return os.NewFile(uintptr(fd), name), nil
+}
+
+// NewFileIOFromFile makes a FileIO called name from an open os.File
+// which is closed with the FileIO if closefd is set
+func NewFileIOFromFile(file *os.File, name py.Object, mode string, closefd bool) (*FileIO, error) {
+	f := &FileIO{
+		file:    file,
+		name:    name,
+		closefd: closefd,
+	}
+	if _, err := f.parseMode(mode); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Parses the mode setting the flags of f and returning the flags to
+// open the file with
+func (f *FileIO) parseMode(mode string) (int, error) {
+	var flags int
+	rwa, plus := false, false
+	badMode := func() (int, error) {
+		return 0, py.ExceptionNewf(py.ValueError, "Must have exactly one of create/read/write/append mode and at most one plus")
+	}
+	for _, c := range mode {
+		switch c {
+		case 'x', 'w', 'a', 'r':
+			if rwa {
+				return badMode()
+			}
+			rwa = true
+			switch c {
+			case 'x':
+				f.writable = true
+				flags |= os.O_EXCL | os.O_CREATE
+			case 'w':
+				f.writable = true
+				flags |= os.O_CREATE | os.O_TRUNC
+			case 'a':
+				f.writable = true
+				f.appending = true
+				flags |= os.O_APPEND | os.O_CREATE
+			case 'r':
+				f.readable = true
+			}
+		case '+':
+			if plus {
+				return badMode()
+			}
+			f.readable = true
+			f.writable = true
+			plus = true
+		case 'b':
+		default:
+			return 0, py.ExceptionNewf(py.ValueError, "invalid mode: %s", mode)
+		}
+	}
+	if !rwa {
+		return badMode()
+	}
+	switch {
+	case f.readable && f.writable:
+		flags |= os.O_RDWR
+	case f.readable:
+		flags |= os.O_RDONLY
+	default:
+		flags |= os.O_WRONLY
+	}
+
+	switch {
+	case flags&os.O_EXCL != 0:
+		f.mode = "xb"
+	case f.appending:
+		f.mode = "ab"
+	case flags&os.O_TRUNC != 0:
+		f.mode = "wb"
+	default:
+		f.mode = "rb"
+	}
+	if plus {
+		f.mode += "+"
+	}
+	return flags, nil
+}
+
+// Refuses to make a FileIO from python without a context to open the
+// file in - the FileIO type of each context is used instead
+func fileIONoContext(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	return nil, py.ExceptionNewf(py.TypeError, "cannot create '%s' instances", metatype.Name)
+}
+
+// Makes a FileIO from python in ctx
+func fileIONew(ctx *py.Context, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var (
+		file    py.Object
+		mode    py.Object = py.String("r")
+		closefd py.Object = py.True
+		opener  py.Object = py.None
+	)
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|spO:FileIO", []string{"file", "mode", "closefd", "opener"}, &file, &mode, &closefd, &opener)
+	if err != nil {
+		return nil, err
+	}
+	return NewFileIO(ctx, file, string(mode.(py.String)), closefd == py.True, opener)
+}
+
+// Returns an error if the file is closed
+func (f *FileIO) checkClosed() error {
+	if f.file == nil {
+		return errClosed()
+	}
+	return nil
+}
+
+// Returns an error if the file is closed or can't be read
+func (f *FileIO) checkReadable() error {
+	if err := f.checkClosed(); err != nil {
+		return err
+	}
+	if !f.readable {
+		return errUnsupported("File not open for reading")
+	}
+	return nil
+}
+
+// Returns an error if the file is closed or can't be written
+func (f *FileIO) checkWritable() error {
+	if err := f.checkClosed(); err != nil {
+		return err
+	}
+	if !f.writable {
+		return errUnsupported("File not open for writing")
+	}
+	return nil
+}
+
+// Converts an error from the os package
+func (f *FileIO) error(err error) error {
+	return py.ExceptionFromOSError(err, nil)
+}
+
+// Read reads up to size bytes or all the remaining bytes if size < 0
+func (f *FileIO) Read(size int) (py.Object, error) {
+	if err := f.checkReadable(); err != nil {
+		return nil, err
+	}
+	if size < 0 {
+		return f.ReadAll()
+	}
+	buf := make([]byte, size)
+	n, err := f.file.Read(buf)
+	if err != nil && err != io.EOF {
+		return nil, f.error(err)
+	}
+	return py.Bytes(buf[:n]), nil
+}
+
+// ReadAll reads until the end of the file
+func (f *FileIO) ReadAll() (py.Object, error) {
+	if err := f.checkReadable(); err != nil {
+		return nil, err
+	}
+	buf, err := ioutil.ReadAll(f.file)
+	if err != nil {
+		return nil, f.error(err)
+	}
+	return py.Bytes(buf), nil
+}
+
+// Write writes b returning the number of bytes written
+func (f *FileIO) Write(b []byte) (py.Object, error) {
+	if err := f.checkWritable(); err != nil {
+		return nil, err
+	}
+	n, err := f.file.Write(b)
+	if err != nil {
+		return nil, f.error(err)
+	}
+	return py.Int(n), nil
+}
+
+// Moves to pos relative to whence returning the new position
+func (f *FileIO) seek(pos int64, whence int) (py.Object, error) {
+	if err := f.checkClosed(); err != nil {
+		return nil, err
+	}
+	n, err := f.file.Seek(pos, whence)
+	if err != nil {
+		return nil, f.error(err)
+	}
+	return py.Int(n), nil
+}
+
+// Truncate resizes the file to size bytes or the current position if
+// size is None
+func (f *FileIO) Truncate(size py.Object) (py.Object, error) {
+	if err := f.checkWritable(); err != nil {
+		return nil, err
+	}
+	if size == py.None {
+		var err error
+		size, err = f.seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, err
+		}
+	}
+	n, ok := size.(py.Int)
+	if !ok {
+		return nil, py.ExceptionNewf(py.TypeError, "an integer is required (got type %s)", size.Type().Name)
+	}
+	if err := f.file.Truncate(int64(n)); err != nil {
+		return nil, f.error(err)
+	}
+	return n, nil
+}
+
+// Closes the file
+func (f *FileIO) close() error {
+	if f.file == nil {
+		return nil
+	}
+	file := f.file
+	f.file = nil
+	if f.closefd {
+		if err := file.Close(); err != nil {
+			return f.error(err)
+		}
+	}
+	return nil
+}
+
+// Returns true if the file is a terminal
+func (f *FileIO) isatty() bool {
+	if f.file == nil {
+		return false
+	}
+	fi, err := f.file.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+func (f *FileIO) M__repr__() (py.Object, error) {
+	if f.file == nil {
+		return py.String("<_io.FileIO [closed]>"), nil
+	}
+	name, err := py.ReprAsString(f.name)
+	if err != nil {
+		return nil, err
+	}
+	closefd := "False"
+	if f.closefd {
+		closefd = "True"
+	}
+	return py.String(fmt.Sprintf("<_io.FileIO name=%s mode='%s' closefd=%s>", name, f.mode, closefd)), nil
+}
+
+func (f *FileIO) M__iter__() (py.Object, error) {
+	if err := f.checkClosed(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *FileIO) M__next__() (py.Object, error) {
+	return iterNext(f)
+}
+
+// Check interfaces are satisfied
+var _ py.I__repr__ = (*FileIO)(nil)
+var _ py.I_iterator = (*FileIO)(nil)
+
+func init() {
+	self := func(o py.Object) *FileIO {
+		return o.(*FileIO)
+	}
+	FileIOType.Dict["name"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return self(o).name, nil
+		},
+	}
+	FileIOType.Dict["mode"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return py.String(self(o).mode), nil
+		},
+	}
+	FileIOType.Dict["closefd"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return py.NewBool(self(o).closefd), nil
+		},
+	}
+	FileIOType.Dict["closed"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return py.NewBool(self(o).file == nil), nil
+		},
+	}
+	FileIOType.Dict["read"] = py.MustNewMethod("read", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		size, err := readSizeArgs("read", args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).Read(size)
+	}, 0, `read(size: int) -> bytes.  read at most size bytes, returned as bytes.
+
+Only makes one system call, so less data may be returned than requested
+In non-blocking mode, returns None if no data is available.
+Return an empty bytes object at EOF.`)
+	FileIOType.Dict["readall"] = py.MustNewMethod("readall", func(o py.Object) (py.Object, error) {
+		return self(o).ReadAll()
+	}, 0, `readall() -> bytes.  read all data from the file, returned as bytes.
+
+In non-blocking mode, returns as much as is immediately available,
+or None if no data is available.  Return an empty bytes object at EOF.`)
+	FileIOType.Dict["readline"] = py.MustNewMethod("readline", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		size, err := readSizeArgs("readline", args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+		f := self(o)
+		if err := f.checkReadable(); err != nil {
+			return nil, err
+		}
+		var line []byte
+		b := make([]byte, 1)
+		for size < 0 || len(line) < size {
+			n, err := f.file.Read(b)
+			if n == 0 || err != nil {
+				if err != nil && err != io.EOF {
+					return nil, f.error(err)
+				}
+				break
+			}
+			line = append(line, b[0])
+			if b[0] == '\n' {
+				break
+			}
+		}
+		return py.Bytes(line), nil
+	}, 0, "readline(size=-1) -> bytes.  Read and return a line from the stream.")
+	FileIOType.Dict["write"] = py.MustNewMethod("write", func(o py.Object, arg py.Object) (py.Object, error) {
+		b, err := bytesArg("write", arg)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).Write(b)
+	}, 0, `write(b: bytes) -> int.  Write bytes b to file, return number written.
+
+Only makes one system call, so not all of the data may be written.
+The number of bytes actually written is returned.  In non-blocking mode,
+returns None if the write would block.`)
+	FileIOType.Dict["seek"] = py.MustNewMethod("seek", func(o py.Object, args py.Tuple) (py.Object, error) {
+		pos, whence, err := seekArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).seek(pos, whence)
+	}, 0, `seek(offset: int[, whence: int]) -> int.  Move to new file position and
+return the file position.
+
+Argument offset is a byte count.  Optional argument whence defaults to
+SEEK_SET or 0 (offset from start of file, offset should be >= 0); other values
+are SEEK_CUR or 1 (move relative to current position, positive or negative),
+and SEEK_END or 2 (move relative to end of file, usually negative, although
+many platforms allow seeking beyond the end of a file).`)
+	FileIOType.Dict["tell"] = py.MustNewMethod("tell", func(o py.Object) (py.Object, error) {
+		return self(o).seek(0, io.SeekCurrent)
+	}, 0, "tell() -> int.  Current file position.\n\nCan raise OSError for non seekable files.")
+	FileIOType.Dict["truncate"] = py.MustNewMethod("truncate", func(o py.Object, args py.Tuple) (py.Object, error) {
+		var size py.Object = py.None
+		err := py.UnpackTuple(args, nil, "truncate", 0, 1, &size)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).Truncate(size)
+	}, 0, `truncate([size: int]) -> int.  Truncate the file to at most size bytes
+and return the truncated size.
+
+Size defaults to the current file position, as returned by tell().
+The current file position is changed to the value of size.`)
+	FileIOType.Dict["close"] = py.MustNewMethod("close", func(o py.Object) (py.Object, error) {
+		return py.None, self(o).close()
+	}, 0, `close() -> None.  Close the file.
+
+A closed file cannot be used for further I/O operations.  close() may be
+called more than once without error.`)
+	FileIOType.Dict["fileno"] = py.MustNewMethod("fileno", func(o py.Object) (py.Object, error) {
+		f := self(o)
+		if err := f.checkClosed(); err != nil {
+			return nil, err
+		}
+		file, ok := f.file.(interface{ Fd() uintptr })
+		if !ok {
+			return nil, errUnsupported("fileno")
+		}
+		return py.Int(file.Fd()), nil
+	}, 0, "fileno() -> int.  Return the underlying file descriptor (an integer).")
+	FileIOType.Dict["isatty"] = py.MustNewMethod("isatty", func(o py.Object) (py.Object, error) {
+		f := self(o)
+		if err := f.checkClosed(); err != nil {
+			return nil, err
+		}
+		return py.NewBool(f.isatty()), nil
+	}, 0, "isatty() -> bool.  True if the file is connected to a TTY device.")
+	FileIOType.Dict["readable"] = py.MustNewMethod("readable", func(o py.Object) (py.Object, error) {
+		f := self(o)
+		if err := f.checkClosed(); err != nil {
+			return nil, err
+		}
+		return py.NewBool(f.readable), nil
+	}, 0, "readable() -> bool.  True if file was opened in a read mode.")
+	FileIOType.Dict["writable"] = py.MustNewMethod("writable", func(o py.Object) (py.Object, error) {
+		f := self(o)
+		if err := f.checkClosed(); err != nil {
+			return nil, err
+		}
+		return py.NewBool(f.writable), nil
+	}, 0, "writable() -> bool.  True if file was opened in a write mode.")
+	FileIOType.Dict["seekable"] = py.MustNewMethod("seekable", func(o py.Object) (py.Object, error) {
+		f := self(o)
+		if err := f.checkClosed(); err != nil {
+			return nil, err
+		}
+		_, err := f.file.Seek(0, io.SeekCurrent)
+		return py.NewBool(err == nil), nil
+	}, 0, "seekable() -> bool.  True if file supports random-access.")
+}
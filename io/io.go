@@ -0,0 +1,709 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Io module
+//
+// This is modelled on python's layering of streams:
+//
+//   - FileIO is a raw binary stream reading and writing an OS file
+//   - BufferedReader, BufferedWriter and BufferedRandom buffer a raw stream
+//   - TextIOWrapper decodes and encodes text on top of a buffered stream
+//   - StringIO and BytesIO are in memory text and binary streams
+//
+// The wrappers talk to the stream they wrap by calling its python
+// methods so any object implementing the right methods can be wrapped.
+
+package io
+
+import (
+	"io"
+	"os"
+
+	"github.com/go-python/gpython/py"
+)
+
+const module_doc = `The io module provides the Python interfaces to stream handling. The
+builtin open function is defined in this module.
+
+At the top of the I/O hierarchy is the abstract base class IOBase. It
+defines the basic interface to a stream. Note, however, that there is no
+separation between reading and writing to streams; implementations are
+allowed to raise an OSError if they do not support a given operation.
+
+Extending IOBase is RawIOBase which deals simply with the reading and
+writing of raw bytes to a stream. FileIO subclasses RawIOBase to provide
+an interface to OS files.
+
+BufferedIOBase deals with buffering on a raw byte stream (RawIOBase). Its
+subclasses, BufferedWriter, BufferedReader, and BufferedRandom buffer
+streams that are readable, writable, and both respectively.
+
+Another IOBase subclass, TextIOBase, deals with the encoding and decoding
+of streams into text. TextIOWrapper, which extends it, is a buffered text
+interface to a buffered raw stream (` + "`BufferedIOBase`" + `). Finally, StringIO
+is a in-memory stream for text.
+
+Argument names are not part of the specification, and only the arguments
+of open() are intended to be used as keyword arguments.
+
+data:
+
+DEFAULT_BUFFER_SIZE
+
+   An int containing the default buffer size used by the module's buffered
+   I/O classes. open() uses the file's blksize (as obtained by os.stat) if
+   possible.`
+
+// DEFAULT_BUFFER_SIZE is the size of the buffers used by default
+const DEFAULT_BUFFER_SIZE = 8192
+
+// Values for the whence argument of seek
+const (
+	SEEK_SET = 0
+	SEEK_CUR = 1
+	SEEK_END = 2
+)
+
+var UnsupportedOperation = py.OSError.NewType("UnsupportedOperation", "Operation not supported by the stream.", nil, nil)
+
+// The abstract base classes of the streams
+var (
+	IOBaseType = py.NewType("_IOBase", `The abstract base class for all I/O classes, acting on streams of
+bytes. There is no public constructor.
+
+This class provides dummy implementations for many methods that
+derived classes can override selectively; the default implementations
+represent a file that cannot be read, written or seeked.`)
+	RawIOBaseType      = IOBaseType.NewType("_RawIOBase", "Base class for raw binary I/O.", nil, nil)
+	BufferedIOBaseType = IOBaseType.NewType("_BufferedIOBase", `Base class for buffered IO objects.
+
+The main difference with RawIOBase is that the read() method
+supports omitting the size argument, and does not have a default
+implementation that defers to readinto().`, nil, nil)
+	TextIOBaseType = IOBaseType.NewType("_TextIOBase", `Base class for text I/O.
+
+This class provides a character and line based interface to stream
+I/O. There is no readinto method because Python's character strings
+are immutable. There is no public constructor.`, nil, nil)
+)
+
+// OpenDoc is the documentation for open which is also the builtin
+const OpenDoc = `open(file, mode='r', buffering=-1, encoding=None,
+     errors=None, newline=None, closefd=True, opener=None) -> file object
+
+Open file and return a stream.  Raise OSError upon failure.
+
+file is either a text or byte string giving the name (and the path
+if the file isn't in the current working directory) of the file to
+be opened or an integer file descriptor of the file to be
+wrapped. (If a file descriptor is given, it is closed when the
+returned I/O object is closed, unless closefd is set to False.)
+
+mode is an optional string that specifies the mode in which the file
+is opened. It defaults to 'r' which means open for reading in text
+mode.  Other common values are 'w' for writing (truncating the file if
+it already exists), 'x' for creating and writing to a new file, and
+'a' for appending (which on some Unix systems, means that all writes
+append to the end of the file regardless of the current seek position).
+In text mode, if encoding is not specified the encoding used is UTF-8.
+The available modes are:
+
+========= ===============================================================
+Character Meaning
+--------- ---------------------------------------------------------------
+'r'       open for reading (default)
+'w'       open for writing, truncating the file first
+'x'       create a new file and open it for writing
+'a'       open for writing, appending to the end of the file if it exists
+'b'       binary mode
+'t'       text mode (default)
+'+'       open a disk file for updating (reading and writing)
+'U'       universal newline mode (deprecated)
+========= ===============================================================
+
+The default mode is 'rt' (open for reading text). For binary random
+access, the mode 'w+b' opens and truncates the file to 0 bytes, while
+'r+b' opens the file without truncation. The 'x' mode implies 'w' and
+raises an ` + "`FileExistsError`" + ` if the file already exists.
+
+Python distinguishes between files opened in binary and text modes,
+even when the underlying operating system doesn't. Files opened in
+binary mode (appending 'b' to the mode argument) return contents as
+bytes objects without any decoding. In text mode (the default, or when
+'t' is appended to the mode argument), the contents of the file are
+returned as strings, the bytes having been first decoded using the
+encoding given.
+
+buffering is an optional integer used to set the buffering policy.
+Pass 0 to switch buffering off (only allowed in binary mode), 1 to select
+line buffering (only usable in text mode), and an integer > 1 to indicate
+the size of a fixed-size chunk buffer.  When no buffering argument is
+given, binary files are buffered in fixed-size chunks of
+DEFAULT_BUFFER_SIZE bytes and interactive text files use line buffering.
+
+encoding is the name of the encoding used to decode or encode the
+file. This should only be used in text mode. The default encoding is
+UTF-8. The encodings supported are utf-8, ascii, latin-1 and the utf-16 and
+utf-32 families.
+
+errors is an optional string that specifies how encoding errors are to
+be handled---this argument should not be used in binary mode. Pass
+'strict' to raise a ValueError exception if there is an encoding error
+(the default of None has the same effect), or pass 'ignore' to ignore
+errors, or 'replace' to replace malformed data with a marker.
+
+newline controls how universal newlines works (it only applies to text
+mode). It can be None, '', '\n', '\r', and '\r\n'.  It works as
+follows:
+
+* On input, if newline is None, universal newlines mode is
+  enabled. Lines in the input can end in '\n', '\r', or '\r\n', and
+  these are translated into '\n' before being returned to the
+  caller. If it is '', universal newline mode is enabled, but line
+  endings are returned to the caller untranslated. If it has any of
+  the other legal values, input lines are only terminated by the given
+  string, and the line ending is returned to the caller untranslated.
+
+* On output, if newline is None, any '\n' characters written are
+  translated to the system default line separator, os.linesep. If
+  newline is '' or '\n', no translation takes place. If newline is any
+  of the other legal values, any '\n' characters written are translated
+  to the given string.
+
+If closefd is False, the underlying file descriptor will be kept open
+when the file is closed. This does not work when a file name is given
+and must be True in that case.
+
+A custom opener can be used by passing a callable as *opener*. The
+underlying file descriptor for the file object is then obtained by
+calling *opener* with (*file*, *flags*). *opener* must return an open
+file descriptor (passing os.open as *opener* results in functionality
+similar to passing None).
+
+open() returns a file object whose type depends on the mode, and
+through which the standard file operations such as reading and writing
+are performed. When open() is used to open a file in a text mode ('w',
+'r', 'wt', 'rt', etc.), it returns a TextIOWrapper. When used to open
+a file in a binary mode, the returned class varies: in read binary
+mode, it returns a BufferedReader; in write binary and append binary
+modes, it returns a BufferedWriter, and in read/write mode, it returns
+a BufferedRandom.
+
+It is also possible to use a string or bytearray as a file for both
+reading and writing. For strings StringIO can be used like a file
+opened in a text mode, and for bytes a BytesIO can be used like a file
+opened in a binary mode.`
+
+// Open implements the builtin open() returning the stream for file
+// which is built in layers according to mode and buffering
+func Open(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	kwlist := []string{"file", "mode", "buffering", "encoding", "errors", "newline", "closefd", "opener"}
+	var (
+		file      py.Object
+		modeObj   py.Object = py.String("r")
+		buffering py.Object = py.Int(-1)
+		encoding  py.Object = py.None
+		errors    py.Object = py.None
+		newline   py.Object = py.None
+		closefd   py.Object = py.True
+		opener    py.Object = py.None
+	)
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|sizzzpO:open", kwlist,
+		&file, &modeObj, &buffering, &encoding, &errors, &newline, &closefd, &opener)
+	if err != nil {
+		return nil, err
+	}
+	mode := string(modeObj.(py.String))
+	m, err := parseOpenMode(mode)
+	if err != nil {
+		return nil, err
+	}
+	if m.binary {
+		switch {
+		case encoding != py.None:
+			return nil, py.ExceptionNewf(py.ValueError, "binary mode doesn't take an encoding argument")
+		case errors != py.None:
+			return nil, py.ExceptionNewf(py.ValueError, "binary mode doesn't take an errors argument")
+		case newline != py.None:
+			return nil, py.ExceptionNewf(py.ValueError, "binary mode doesn't take a newline argument")
+		}
+	}
+	if !m.binary {
+		// check the text arguments before opening the file
+		if encoding != py.None {
+			if _, err = lookupCodec(string(encoding.(py.String))); err != nil {
+				return nil, err
+			}
+		}
+		if _, err = parseNewline(newline); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := py.GetContext(self)
+	raw, err := NewFileIO(ctx, file, m.rawMode(), closefd == py.True, opener)
+	if err != nil {
+		return nil, err
+	}
+	size := int(buffering.(py.Int))
+	lineBuffering := false
+	if size == 1 || (size < 0 && raw.isatty()) {
+		size = -1
+		lineBuffering = true
+	}
+	if size < 0 {
+		size = DEFAULT_BUFFER_SIZE
+	}
+	if size == 0 {
+		if m.binary {
+			return raw, nil
+		}
+		_ = raw.close()
+		return nil, py.ExceptionNewf(py.ValueError, "can't have unbuffered text I/O")
+	}
+
+	var buffer *Buffered
+	switch {
+	case m.updating:
+		buffer = NewBufferedRandom(raw, size)
+	case m.reading:
+		buffer = NewBufferedReader(raw, size)
+	default:
+		buffer = NewBufferedWriter(raw, size)
+	}
+	if buffer.writable {
+		// Flush it at exit if it isn't closed as CPython does
+		buffer.register(ctx)
+	}
+	if m.binary {
+		return buffer, nil
+	}
+
+	text, err := NewTextIOWrapper(buffer, optionalString(encoding), optionalString(errors), newline, lineBuffering, false)
+	if err != nil {
+		_ = buffer.Close()
+		return nil, err
+	}
+	text.mode = mode
+	return text, nil
+}
+
+// NewStdStream makes the text stream for the standard stream f called
+// name, eg "<stdout>", of ctx which is opened with mode "r" or "w"
+//
+// Output is written straight through to f, as python does when run
+// with -u, as Go code writes to the standard streams directly too.
+func NewStdStream(ctx *py.Context, f *os.File, name, mode, errors string) (*TextIOWrapper, error) {
+	raw, err := NewFileIOFromFile(f, py.String(name), mode, false)
+	if err != nil {
+		return nil, err
+	}
+	raw.typ = fileIOType(ctx)
+	return newStdText(raw, raw.readable, raw.writable, mode, errors)
+}
+
+// NewGoStdStream makes a text stream called name, eg "<stdout>", for
+// use as a standard stream which reads from r or writes to w
+//
+// One of r and w should be nil.  Output is written straight through to
+// w as with NewStdStream.
+func NewGoStdStream(r io.Reader, w io.Writer, name, errors string) (*TextIOWrapper, error) {
+	mode := "w"
+	if r != nil {
+		mode = "r"
+	}
+	return newStdText(NewStreamIO(r, w, py.String(name)), r != nil, w != nil, mode, errors)
+}
+
+// Wraps the raw stream of a standard stream in a text stream
+func newStdText(raw py.Object, readable, writable bool, mode, errors string) (*TextIOWrapper, error) {
+	buffer := raw
+	if readable {
+		buffer = NewBufferedReader(raw, DEFAULT_BUFFER_SIZE)
+	}
+	text, err := NewTextIOWrapper(buffer, "", errors, py.String("\n"), false, writable)
+	if err != nil {
+		return nil, err
+	}
+	text.mode = mode
+	return text, nil
+}
+
+// The mode of a file passed to open
+type openMode struct {
+	creating, reading, writing, appending, updating bool
+	text, binary                                    bool
+}
+
+// Parses the mode passed to open
+func parseOpenMode(mode string) (m openMode, err error) {
+	invalid := func() (openMode, error) {
+		return m, py.ExceptionNewf(py.ValueError, "invalid mode: '%s'", mode)
+	}
+	seen := map[rune]bool{}
+	universal := false
+	for _, c := range mode {
+		if seen[c] {
+			return invalid()
+		}
+		seen[c] = true
+		switch c {
+		case 'x':
+			m.creating = true
+		case 'r':
+			m.reading = true
+		case 'w':
+			m.writing = true
+		case 'a':
+			m.appending = true
+		case '+':
+			m.updating = true
+		case 't':
+			m.text = true
+		case 'b':
+			m.binary = true
+		case 'U':
+			universal = true
+		default:
+			return invalid()
+		}
+	}
+	if universal {
+		if m.creating || m.writing || m.appending || m.updating {
+			return m, py.ExceptionNewf(py.ValueError, "mode U cannot be combined with 'x', 'w', 'a', or '+'")
+		}
+		m.reading = true
+	}
+	if m.text && m.binary {
+		return m, py.ExceptionNewf(py.ValueError, "can't have text and binary mode at once")
+	}
+	n := 0
+	for _, set := range []bool{m.creating, m.reading, m.writing, m.appending} {
+		if set {
+			n++
+		}
+	}
+	if n != 1 {
+		return m, py.ExceptionNewf(py.ValueError, "must have exactly one of create/read/write/append mode")
+	}
+	return m, nil
+}
+
+// Returns the mode to open the FileIO with
+func (m openMode) rawMode() string {
+	var mode string
+	switch {
+	case m.creating:
+		mode = "x"
+	case m.reading:
+		mode = "r"
+	case m.writing:
+		mode = "w"
+	case m.appending:
+		mode = "a"
+	}
+	if m.updating {
+		mode += "+"
+	}
+	return mode
+}
+
+// Calls the python method name of obj with args
+func callMethod(obj py.Object, name string, args ...py.Object) (py.Object, error) {
+	method, err := py.GetAttrString(obj, name)
+	if err != nil {
+		return nil, err
+	}
+	return py.Call(method, py.Tuple(args), nil)
+}
+
+// Returns true if the python method name of obj returns true
+func callBool(obj py.Object, name string) (bool, error) {
+	res, err := callMethod(obj, name)
+	if err != nil {
+		return false, err
+	}
+	b, err := py.MakeBool(res)
+	if err != nil {
+		return false, err
+	}
+	return b == py.True, nil
+}
+
+// Returns true if the closed attribute of obj is true
+func isClosed(obj py.Object) (bool, error) {
+	closed, err := py.GetAttrString(obj, "closed")
+	if err != nil {
+		return false, err
+	}
+	b, err := py.MakeBool(closed)
+	if err != nil {
+		return false, err
+	}
+	return b == py.True, nil
+}
+
+// Error for an operation on a closed stream
+func errClosed() error {
+	return py.ExceptionNewf(py.ValueError, "I/O operation on closed file.")
+}
+
+// Returns an error if obj is closed
+func checkClosed(obj py.Object) error {
+	closed, err := isClosed(obj)
+	if err != nil {
+		return err
+	}
+	if closed {
+		return errClosed()
+	}
+	return nil
+}
+
+// Error for an operation the stream doesn't support
+func errUnsupported(format string, a ...interface{}) error {
+	return py.ExceptionNewf(UnsupportedOperation, format, a...)
+}
+
+// Reads a size argument which may be None meaning -1
+func sizeArg(name string, obj py.Object) (int, error) {
+	if obj == py.None {
+		return -1, nil
+	}
+	i, ok := obj.(py.Int)
+	if !ok {
+		return 0, py.ExceptionNewf(py.TypeError, "%s() argument must be int or None, not '%s'", name, obj.Type().Name)
+	}
+	n, err := i.GoInt()
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// Reads the optional size argument of a read or readline method
+func readSizeArgs(name string, args py.Tuple, kwargs py.StringDict) (int, error) {
+	var size py.Object = py.None
+	err := py.UnpackTuple(args, kwargs, name, 0, 1, &size)
+	if err != nil {
+		return 0, err
+	}
+	return sizeArg(name, size)
+}
+
+// Reads the arguments of a seek method
+func seekArgs(args py.Tuple) (pos int64, whence int, err error) {
+	var posObj, whenceObj py.Object = nil, py.Int(SEEK_SET)
+	err = py.UnpackTuple(args, nil, "seek", 1, 2, &posObj, &whenceObj)
+	if err != nil {
+		return 0, 0, err
+	}
+	p, ok := posObj.(py.Int)
+	if !ok {
+		return 0, 0, py.ExceptionNewf(py.TypeError, "an integer is required (got type %s)", posObj.Type().Name)
+	}
+	w, ok := whenceObj.(py.Int)
+	if !ok {
+		return 0, 0, py.ExceptionNewf(py.TypeError, "an integer is required (got type %s)", whenceObj.Type().Name)
+	}
+	pos, err = p.GoInt64()
+	if err != nil {
+		return 0, 0, err
+	}
+	whence, err = w.GoInt()
+	if err != nil {
+		return 0, 0, err
+	}
+	if whence < SEEK_SET || whence > SEEK_END {
+		return 0, 0, py.ExceptionNewf(py.ValueError, "invalid whence (%d, should be 0, 1 or 2)", whence)
+	}
+	return pos, whence, nil
+}
+
+// Reads a bytes like argument
+func bytesArg(name string, obj py.Object) ([]byte, error) {
+	b, ok := obj.(py.Bytes)
+	if !ok {
+		return nil, py.ExceptionNewf(py.TypeError, "%s() argument must be a bytes-like object, not '%s'", name, obj.Type().Name)
+	}
+	return b, nil
+}
+
+// Returns the length of a line read from a stream
+func lineLen(line py.Object) (int, error) {
+	switch x := line.(type) {
+	case py.String:
+		return len(x), nil
+	case py.Bytes:
+		return len(x), nil
+	}
+	n, err := py.Len(line)
+	if err != nil {
+		return 0, err
+	}
+	return int(n.(py.Int)), nil
+}
+
+// Returns the next line of a stream for iteration
+func iterNext(self py.Object) (py.Object, error) {
+	line, err := callMethod(self, "readline")
+	if err != nil {
+		return nil, err
+	}
+	n, err := lineLen(line)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, py.StopIteration
+	}
+	return line, nil
+}
+
+// Adds the methods of the IOBase types to t which doesn't inherit
+// them as types made outside the py package don't have an mro
+func inherit(t *py.Type) {
+	for base := t.Base; base != nil; base = base.Base {
+		for name, value := range base.Dict {
+			if _, found := t.Dict[name]; !found {
+				t.Dict[name] = value
+			}
+		}
+		if base == IOBaseType {
+			break
+		}
+	}
+}
+
+func init() {
+	IOBaseType.Dict["closed"] = &py.Property{
+		Fget: func(self py.Object) (py.Object, error) {
+			return py.False, nil
+		},
+	}
+	IOBaseType.Dict["readable"] = py.MustNewMethod("readable", func(self py.Object) (py.Object, error) {
+		return py.False, nil
+	}, 0, "Return whether object was opened for reading.\n\nIf False, read() will raise OSError.")
+	IOBaseType.Dict["writable"] = py.MustNewMethod("writable", func(self py.Object) (py.Object, error) {
+		return py.False, nil
+	}, 0, "Return whether object was opened for writing.\n\nIf False, write() will raise OSError.")
+	IOBaseType.Dict["seekable"] = py.MustNewMethod("seekable", func(self py.Object) (py.Object, error) {
+		return py.False, nil
+	}, 0, "Return whether object supports random access.\n\nIf False, seek(), tell() and truncate() will raise OSError.\nThis method may need to do a test seek().")
+	IOBaseType.Dict["isatty"] = py.MustNewMethod("isatty", func(self py.Object) (py.Object, error) {
+		if err := checkClosed(self); err != nil {
+			return nil, err
+		}
+		return py.False, nil
+	}, 0, "Return whether this is an 'interactive' stream.\n\nReturn False if it can't be determined.")
+	IOBaseType.Dict["fileno"] = py.MustNewMethod("fileno", func(self py.Object) (py.Object, error) {
+		return nil, errUnsupported("fileno")
+	}, 0, "Returns underlying file descriptor if one exists.\n\nOSError is raised if the IO object does not use a file descriptor.")
+	IOBaseType.Dict["seek"] = py.MustNewMethod("seek", func(self py.Object, args py.Tuple) (py.Object, error) {
+		return nil, errUnsupported("seek")
+	}, 0, "Change stream position.")
+	IOBaseType.Dict["tell"] = py.MustNewMethod("tell", func(self py.Object) (py.Object, error) {
+		return callMethod(self, "seek", py.Int(0), py.Int(SEEK_CUR))
+	}, 0, "Return current stream position.")
+	IOBaseType.Dict["truncate"] = py.MustNewMethod("truncate", func(self py.Object, args py.Tuple) (py.Object, error) {
+		return nil, errUnsupported("truncate")
+	}, 0, "Truncate file to size bytes.\n\nFile pointer is left unchanged.  Size defaults to the current IO\nposition as reported by tell().  Returns the new size.")
+	IOBaseType.Dict["flush"] = py.MustNewMethod("flush", func(self py.Object) (py.Object, error) {
+		return py.None, checkClosed(self)
+	}, 0, "Flush write buffers, if applicable.\n\nThis is not implemented for read-only and non-blocking streams.")
+	IOBaseType.Dict["readlines"] = py.MustNewMethod("readlines", func(self py.Object, args py.Tuple) (py.Object, error) {
+		var hintObj py.Object = py.None
+		err := py.UnpackTuple(args, nil, "readlines", 0, 1, &hintObj)
+		if err != nil {
+			return nil, err
+		}
+		hint, err := sizeArg("readlines", hintObj)
+		if err != nil {
+			return nil, err
+		}
+		lines := py.NewList()
+		total := 0
+		for {
+			line, err := callMethod(self, "readline")
+			if err != nil {
+				return nil, err
+			}
+			n, err := lineLen(line)
+			if err != nil {
+				return nil, err
+			}
+			if n == 0 {
+				break
+			}
+			lines.Append(line)
+			total += n
+			if hint > 0 && total >= hint {
+				break
+			}
+		}
+		return lines, nil
+	}, 0, "Return a list of lines from the stream.\n\nhint can be specified to control the number of lines read: no more\nlines will be read if the total size (in bytes/characters) of all\nlines so far exceeds hint.")
+	IOBaseType.Dict["writelines"] = py.MustNewMethod("writelines", func(self py.Object, lines py.Object) (py.Object, error) {
+		if err := checkClosed(self); err != nil {
+			return nil, err
+		}
+		write, err := py.GetAttrString(self, "write")
+		if err != nil {
+			return nil, err
+		}
+		var writeErr error
+		err = py.Iterate(lines, func(line py.Object) bool {
+			_, writeErr = py.Call(write, py.Tuple{line}, nil)
+			return writeErr != nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return py.None, writeErr
+	}, 0, "Write a list of lines to the stream.\n\nLine separators are not added, so it is usual for each of the lines\nprovided to have a line separator at the end.")
+	IOBaseType.Dict["__enter__"] = py.MustNewMethod("__enter__", func(self py.Object) (py.Object, error) {
+		if err := checkClosed(self); err != nil {
+			return nil, err
+		}
+		return self, nil
+	}, 0, "")
+	IOBaseType.Dict["__exit__"] = py.MustNewMethod("__exit__", func(self py.Object, args py.Tuple) (py.Object, error) {
+		_, err := callMethod(self, "close")
+		return py.None, err
+	}, 0, "")
+
+	for _, t := range []*py.Type{RawIOBaseType, BufferedIOBaseType, TextIOBaseType} {
+		inherit(t)
+	}
+	for _, t := range []*py.Type{FileIOType, StreamIOType, BufferedReaderType, BufferedWriterType, BufferedRandomType, TextIOWrapperType, StringIOType, BytesIOType} {
+		inherit(t)
+	}
+
+	methods := []*py.Method{
+		py.MustNewMethod("open", Open, 0, OpenDoc),
+	}
+	globals := py.StringDict{
+		"DEFAULT_BUFFER_SIZE":  py.Int(DEFAULT_BUFFER_SIZE),
+		"SEEK_SET":             py.Int(SEEK_SET),
+		"SEEK_CUR":             py.Int(SEEK_CUR),
+		"SEEK_END":             py.Int(SEEK_END),
+		"UnsupportedOperation": UnsupportedOperation,
+		"BlockingIOError":      py.BlockingIOError,
+		"IOBase":               IOBaseType,
+		"RawIOBase":            RawIOBaseType,
+		"BufferedIOBase":       BufferedIOBaseType,
+		"TextIOBase":           TextIOBaseType,
+		"FileIO":               FileIOType,
+		"BufferedReader":       BufferedReaderType,
+		"BufferedWriter":       BufferedWriterType,
+		"BufferedRandom":       BufferedRandomType,
+		"TextIOWrapper":        TextIOWrapperType,
+		"StringIO":             StringIOType,
+		"BytesIO":              BytesIOType,
+	}
+	module := py.NewModule("io", module_doc, methods, globals)
+	module.ContextInit = func(m *py.Module) {
+		m.Globals["FileIO"] = fileIOType(m.Context)
+	}
+}
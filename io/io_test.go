@@ -0,0 +1,239 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package io_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-python/gpython/compile"
+	"github.com/go-python/gpython/py"
+	"github.com/go-python/gpython/pytest"
+	"github.com/go-python/gpython/vm"
+)
+
+func TestVm(t *testing.T) {
+	pytest.RunTests(t, "tests")
+}
+
+const fileScript = `
+import io
+
+def assertRaises(expecting, fn, *args, **kwargs):
+    assertRaisesText(expecting, "", fn, *args, **kwargs)
+
+def assertRaisesText(expecting, text, fn, *args, **kwargs):
+    try:
+        fn(*args, **kwargs)
+    except expecting as e:
+        assert text in e.args[0], "'%s' not found in '%s'" % (text, e.args[0])
+    else:
+        assert False, "%s not raised" % (expecting,)
+
+name = tmp + "/test.txt"
+with open(name, "w") as f:
+    assert type(f) is io.TextIOWrapper
+    assert type(f.buffer) is io.BufferedWriter
+    assert type(f.buffer.raw) is io.FileIO
+    assert f.name == name
+    assert f.mode == "w"
+    assert f.buffer.raw.mode == "wb"
+    assert f.writable() and not f.readable()
+    assert f.write("héllo\nwörld\n") == 12
+assert f.closed
+assert f.buffer.raw.closed
+
+with open(name) as f:
+    assert f.read() == "héllo\nwörld\n"
+with open(name, "rb") as f:
+    assert type(f) is io.BufferedReader
+    assert f.read(2) == b"h\xc3"
+    assert f.peek() == b"\xa9llo\nw\xc3\xb6rld\n"
+    assert f.readline() == b"\xa9llo\n"
+    assert f.tell() == 7
+    assertRaises(io.UnsupportedOperation, f.write, b"x")
+with open(name, "rb", buffering=0) as f:
+    assert type(f) is io.FileIO
+    assert f.read(1) == b"h"
+    assert f.readall() == b"\xc3\xa9llo\nw\xc3\xb6rld\n"
+
+with open(name, "r+") as f:
+    assert f.readline() == "héllo\n"
+    f.write("WÖRLD")
+    f.seek(0)
+    assert f.read() == "héllo\nWÖRLD\n"
+    assert f.truncate(3) == 3
+with open(name, "a") as f:
+    f.write("!")
+with open(name, "rb") as f:
+    assert f.read() == b"h\xc3\xa9!"
+with open(name, "w+b") as f:
+    assert type(f) is io.BufferedRandom
+    f.write(b"abc")
+    f.seek(1)
+    assert f.read() == b"bc"
+    f.seek(0, io.SEEK_END)
+    assert f.tell() == 3
+with open(name, encoding="latin-1", newline="") as f:
+    assert f.read() == "abc"
+
+assertRaises(FileExistsError, open, name, "x")
+with open(tmp + "/new.txt", "x") as f:
+    f.write("new")
+e = None
+try:
+    open(tmp + "/missing.txt")
+except FileNotFoundError as exc:
+    e = exc
+assert e.errno == 2
+assert e.filename == tmp + "/missing.txt"
+assert e.args[0] == "[Errno 2] No such file or directory: '%s'" % (tmp + "/missing.txt")
+assertRaises(IsADirectoryError, open, tmp)
+
+assertRaisesText(ValueError, "invalid mode: 'rr'", open, name, "rr")
+assertRaisesText(ValueError, "must have exactly one of create/read/write/append mode", open, name, "rw")
+assertRaisesText(ValueError, "can't have text and binary mode at once", open, name, "rbt")
+assertRaisesText(ValueError, "binary mode doesn't take an encoding argument", open, name, "rb", encoding="utf-8")
+assertRaisesText(ValueError, "binary mode doesn't take a newline argument", open, name, "rb", newline="")
+assertRaisesText(ValueError, "can't have unbuffered text I/O", open, name, buffering=0)
+assertRaisesText(ValueError, "illegal newline value", open, name, newline="x")
+assertRaisesText(LookupError, "unknown encoding", open, name, encoding="x")
+
+f = open(name, "rb")
+fd = f.fileno()
+g = open(fd, "rb", closefd=False)
+assert g.read() == b"abc"
+g.close()
+f.seek(0)
+assert f.read() == b"abc"
+f.close()
+
+flags = []
+def opener(path, flags_):
+    flags.append(flags_)
+    return io.FileIO(path).fileno()
+with open(name, "rb", opener=opener) as f:
+    assert f.read() == b"abc"
+assert len(flags) == 1
+
+import sys
+assert type(sys.stdout) is io.TextIOWrapper
+assert type(sys.stdin.buffer) is io.BufferedReader
+assert sys.stdout.name == "<stdout>"
+assert sys.stderr.errors == "backslashreplace"
+assert sys.stdout.write("") == 0
+`
+
+func TestFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gpython-io")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	obj, err := compile.Compile(fileScript, filepath.Join(dir, "io_test.py"), "exec", 0, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	module := py.DefaultContext.NewModule("__main__", "", nil, nil)
+	module.Globals["tmp"] = py.String(dir)
+	_, err = vm.Run(module.Globals, module.Globals, obj.(*py.Code), nil)
+	if err != nil {
+		py.TracebackDump(err)
+		t.Fatal(err)
+	}
+}
+
+func TestOpenFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gpython-io")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "test.txt")
+	if err = ioutil.WriteFile(name, []byte("hello"), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		mode string
+		want py.Object
+	}{
+		{"r", py.String("hello")},
+		{"rb", py.Bytes("hello")},
+	} {
+		f, err := py.OpenFile(name, test.mode, -1)
+		if err != nil {
+			t.Fatalf("%s: %v", test.mode, err)
+		}
+		read, err := py.GetAttrString(f, "read")
+		if err != nil {
+			t.Fatalf("%s: %v", test.mode, err)
+		}
+		got, err := py.Call(read, nil, nil)
+		if err != nil {
+			t.Fatalf("%s: %v", test.mode, err)
+		}
+		eq, err := py.Eq(got, test.want)
+		if err != nil || eq != py.True {
+			t.Errorf("%s: want %v got %v", test.mode, test.want, got)
+		}
+		closeMethod, err := py.GetAttrString(f, "close")
+		if err != nil {
+			t.Fatalf("%s: %v", test.mode, err)
+		}
+		if _, err = py.Call(closeMethod, nil, nil); err != nil {
+			t.Errorf("%s: close: %v", test.mode, err)
+		}
+	}
+
+	_, err = py.OpenFile(filepath.Join(dir, "missing.txt"), "r", -1)
+	if !py.IsException(py.FileNotFoundError, err) {
+		t.Errorf("want FileNotFoundError got %v", err)
+	}
+}
+
+func TestFlushAtExit(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gpython-io")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ctx := py.NewContext(py.DefaultContextOpts())
+	obj, err := compile.Compile(`
+open(tmp + "/text.txt", "w").write("hi")
+open(tmp + "/bytes.txt", "ab").write(b"there")
+f = open(tmp + "/closed.txt", "w")
+f.write("closed")
+f.close()
+`, "<string>", "exec", 0, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	module := ctx.NewModule("__main__", "", nil, nil)
+	module.Globals["tmp"] = py.String(dir)
+	_, err = vm.Run(module.Globals, module.Globals, obj.(*py.Code), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx.Finalize()
+
+	for name, want := range map[string]string{
+		"text.txt":   "hi",
+		"bytes.txt":  "there",
+		"closed.txt": "closed",
+	} {
+		got, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != want {
+			t.Errorf("%s: want %q got %q", name, want, got)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// StreamIO raw stream over Go readers and writers
+
+package io
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/go-python/gpython/py"
+)
+
+var StreamIOType = RawIOBaseType.NewType("StreamIO", `Raw stream reading from a Go io.Reader and writing to a Go io.Writer.
+
+These are made from Go, for instance to redirect the standard streams of
+the interpreter, and can't be made from python.`, nil, nil)
+
+// StreamIO is a raw stream which reads from a Go io.Reader and writes
+// to a Go io.Writer
+//
+// Closing it doesn't close the reader or writer which belong to the
+// Go code which made it.
+type StreamIO struct {
+	r      io.Reader // nil if not readable
+	w      io.Writer // nil if not writable
+	name   py.Object
+	closed bool
+}
+
+// Type of this object
+func (s *StreamIO) Type() *py.Type {
+	return StreamIOType
+}
+
+// NewStreamIO makes a raw stream called name which reads from r and
+// writes to w, either of which may be nil
+func NewStreamIO(r io.Reader, w io.Writer, name py.Object) *StreamIO {
+	return &StreamIO{
+		r:    r,
+		w:    w,
+		name: name,
+	}
+}
+
+// Returns the mode the stream behaves as if it was opened with
+func (s *StreamIO) mode() string {
+	switch {
+	case s.r != nil && s.w != nil:
+		return "rb+"
+	case s.r != nil:
+		return "rb"
+	}
+	return "wb"
+}
+
+// Returns an error if the stream is closed
+func (s *StreamIO) checkClosed() error {
+	if s.closed {
+		return errClosed()
+	}
+	return nil
+}
+
+// Returns an error if the stream is closed or can't be read
+func (s *StreamIO) checkReadable() error {
+	if err := s.checkClosed(); err != nil {
+		return err
+	}
+	if s.r == nil {
+		return errUnsupported("File not open for reading")
+	}
+	return nil
+}
+
+// Returns an error if the stream is closed or can't be written
+func (s *StreamIO) checkWritable() error {
+	if err := s.checkClosed(); err != nil {
+		return err
+	}
+	if s.w == nil {
+		return errUnsupported("File not open for writing")
+	}
+	return nil
+}
+
+// Read reads up to size bytes or all the remaining bytes if size < 0
+func (s *StreamIO) Read(size int) (py.Object, error) {
+	if err := s.checkReadable(); err != nil {
+		return nil, err
+	}
+	if size < 0 {
+		return s.ReadAll()
+	}
+	buf := make([]byte, size)
+	n, err := s.r.Read(buf)
+	if err != nil && err != io.EOF {
+		return nil, py.ExceptionFromOSError(err, nil)
+	}
+	return py.Bytes(buf[:n]), nil
+}
+
+// ReadAll reads until the end of the stream
+func (s *StreamIO) ReadAll() (py.Object, error) {
+	if err := s.checkReadable(); err != nil {
+		return nil, err
+	}
+	buf, err := ioutil.ReadAll(s.r)
+	if err != nil {
+		return nil, py.ExceptionFromOSError(err, nil)
+	}
+	return py.Bytes(buf), nil
+}
+
+// Write writes all of b returning the number of bytes written
+func (s *StreamIO) Write(b []byte) (py.Object, error) {
+	if err := s.checkWritable(); err != nil {
+		return nil, err
+	}
+	n, err := s.w.Write(b)
+	if err != nil {
+		return nil, py.ExceptionFromOSError(err, nil)
+	}
+	return py.Int(n), nil
+}
+
+// Flush flushes the writer if it has a Flush method, like a
+// bufio.Writer
+func (s *StreamIO) Flush() error {
+	if err := s.checkClosed(); err != nil {
+		return err
+	}
+	if flusher, ok := s.w.(interface{ Flush() error }); ok {
+		if err := flusher.Flush(); err != nil {
+			return py.ExceptionFromOSError(err, nil)
+		}
+	}
+	return nil
+}
+
+func (s *StreamIO) M__repr__() (py.Object, error) {
+	if s.closed {
+		return py.String("<_io.StreamIO [closed]>"), nil
+	}
+	name, err := py.ReprAsString(s.name)
+	if err != nil {
+		return nil, err
+	}
+	return py.String(fmt.Sprintf("<_io.StreamIO name=%s mode='%s'>", name, s.mode())), nil
+}
+
+func (s *StreamIO) M__iter__() (py.Object, error) {
+	if err := s.checkClosed(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *StreamIO) M__next__() (py.Object, error) {
+	return iterNext(s)
+}
+
+// Check interfaces are satisfied
+var _ py.I__repr__ = (*StreamIO)(nil)
+var _ py.I_iterator = (*StreamIO)(nil)
+
+func init() {
+	self := func(o py.Object) *StreamIO {
+		return o.(*StreamIO)
+	}
+	StreamIOType.Dict["name"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return self(o).name, nil
+		},
+	}
+	StreamIOType.Dict["mode"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return py.String(self(o).mode()), nil
+		},
+	}
+	StreamIOType.Dict["closed"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return py.NewBool(self(o).closed), nil
+		},
+	}
+	StreamIOType.Dict["read"] = py.MustNewMethod("read", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		size, err := readSizeArgs("read", args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).Read(size)
+	}, 0, "read(size=-1) -> bytes.  Read at most size bytes, returned as bytes.")
+	StreamIOType.Dict["readall"] = py.MustNewMethod("readall", func(o py.Object) (py.Object, error) {
+		return self(o).ReadAll()
+	}, 0, "readall() -> bytes.  Read until EOF.")
+	StreamIOType.Dict["readline"] = py.MustNewMethod("readline", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		size, err := readSizeArgs("readline", args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+		s := self(o)
+		if err := s.checkReadable(); err != nil {
+			return nil, err
+		}
+		var line []byte
+		b := make([]byte, 1)
+		for size < 0 || len(line) < size {
+			n, err := s.r.Read(b)
+			if n == 0 || err != nil {
+				if err != nil && err != io.EOF {
+					return nil, py.ExceptionFromOSError(err, nil)
+				}
+				if n == 0 {
+					break
+				}
+			}
+			line = append(line, b[0])
+			if b[0] == '\n' {
+				break
+			}
+		}
+		return py.Bytes(line), nil
+	}, 0, "readline(size=-1) -> bytes.  Read and return a line from the stream.")
+	StreamIOType.Dict["write"] = py.MustNewMethod("write", func(o py.Object, arg py.Object) (py.Object, error) {
+		b, err := bytesArg("write", arg)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).Write(b)
+	}, 0, "write(b: bytes) -> int.  Write bytes b to the stream, return number written.")
+	StreamIOType.Dict["flush"] = py.MustNewMethod("flush", func(o py.Object) (py.Object, error) {
+		return py.None, self(o).Flush()
+	}, 0, "flush() -> None.  Flush the Go writer if it can be flushed.")
+	StreamIOType.Dict["close"] = py.MustNewMethod("close", func(o py.Object) (py.Object, error) {
+		s := self(o)
+		if s.closed {
+			return py.None, nil
+		}
+		err := s.Flush()
+		s.closed = true
+		return py.None, err
+	}, 0, "close() -> None.  Close the stream, leaving the Go reader and writer open.")
+	StreamIOType.Dict["isatty"] = py.MustNewMethod("isatty", func(o py.Object) (py.Object, error) {
+		if err := self(o).checkClosed(); err != nil {
+			return nil, err
+		}
+		return py.False, nil
+	}, 0, "isatty() -> bool.  Always False.")
+	StreamIOType.Dict["readable"] = py.MustNewMethod("readable", func(o py.Object) (py.Object, error) {
+		s := self(o)
+		if err := s.checkClosed(); err != nil {
+			return nil, err
+		}
+		return py.NewBool(s.r != nil), nil
+	}, 0, "readable() -> bool.  True if the stream has a reader.")
+	StreamIOType.Dict["writable"] = py.MustNewMethod("writable", func(o py.Object) (py.Object, error) {
+		s := self(o)
+		if err := s.checkClosed(); err != nil {
+			return nil, err
+		}
+		return py.NewBool(s.w != nil), nil
+	}, 0, "writable() -> bool.  True if the stream has a writer.")
+}
@@ -0,0 +1,301 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// StringIO in memory text stream
+
+package io
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-python/gpython/py"
+)
+
+var StringIOType = TextIOBaseType.NewType("StringIO", `Text I/O implementation using an in-memory buffer.
+
+The initial_value argument sets the value of object.  The newline
+argument is like the one of TextIOWrapper's constructor.`, StringIONew, nil)
+
+// StringIO is a text stream kept in memory
+type StringIO struct {
+	buf     []rune
+	pos     int
+	closed  bool
+	newline newlineMode
+}
+
+// Type of this object
+func (s *StringIO) Type() *py.Type {
+	return StringIOType
+}
+
+// NewStringIO makes a StringIO containing value positioned at the
+// start. newline is the python newline argument.
+func NewStringIO(value string, newline py.Object) (*StringIO, error) {
+	s := &StringIO{}
+	if newline == py.None {
+		// newlines are translated on write but are always written
+		// as "\n"
+		s.newline = newlineMode{translate: true, universal: true}
+	} else {
+		var err error
+		s.newline, err = parseNewline(newline)
+		if err != nil {
+			return nil, err
+		}
+	}
+	s.write(value)
+	s.pos = 0
+	return s, nil
+}
+
+// StringIONew makes a StringIO from python
+func StringIONew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var value, newline py.Object = py.String(""), py.String("\n")
+	err := py.ParseTupleAndKeywords(args, kwargs, "|OO:StringIO", []string{"initial_value", "newline"}, &value, &newline)
+	if err != nil {
+		return nil, err
+	}
+	if newline != py.None {
+		if _, ok := newline.(py.String); !ok {
+			return nil, py.ExceptionNewf(py.TypeError, "newline must be str or None, not %s", newline.Type().Name)
+		}
+	}
+	var initial string
+	switch x := value.(type) {
+	case py.String:
+		initial = string(x)
+	case py.NoneType:
+	default:
+		return nil, py.ExceptionNewf(py.TypeError, "initial_value must be str or None, not %s", value.Type().Name)
+	}
+	return NewStringIO(initial, newline)
+}
+
+// Returns an error if the stream is closed
+func (s *StringIO) checkClosed() error {
+	if s.closed {
+		return errClosed()
+	}
+	return nil
+}
+
+// Writes text at the current position translating newlines
+func (s *StringIO) write(text string) {
+	if s.newline.translate {
+		text = strings.Replace(text, "\r\n", "\n", -1)
+		text = strings.Replace(text, "\r", "\n", -1)
+	}
+	if s.newline.writeNL != "" {
+		text = strings.Replace(text, "\n", s.newline.writeNL, -1)
+	}
+	runes := []rune(text)
+	for len(s.buf) < s.pos {
+		s.buf = append(s.buf, 0)
+	}
+	end := s.pos + len(runes)
+	if end > len(s.buf) {
+		s.buf = append(s.buf, make([]rune, end-len(s.buf))...)
+	}
+	copy(s.buf[s.pos:], runes)
+	s.pos = end
+}
+
+// Removes and returns the next n characters
+func (s *StringIO) take(n int) py.Object {
+	start := s.pos
+	if start > len(s.buf) {
+		start = len(s.buf)
+	}
+	end := start + n
+	if n < 0 || end > len(s.buf) {
+		end = len(s.buf)
+	}
+	s.pos = end
+	return py.String(string(s.buf[start:end]))
+}
+
+// Read reads size characters or the rest of the stream if size < 0
+func (s *StringIO) Read(size int) (py.Object, error) {
+	if err := s.checkClosed(); err != nil {
+		return nil, err
+	}
+	return s.take(size), nil
+}
+
+// ReadLine reads the next line or at most size characters if size >= 0
+func (s *StringIO) ReadLine(size int) (py.Object, error) {
+	if err := s.checkClosed(); err != nil {
+		return nil, err
+	}
+	if s.pos >= len(s.buf) {
+		return py.String(""), nil
+	}
+	end, ok := s.newline.lineEnd(s.buf[s.pos:], true)
+	if !ok {
+		end = len(s.buf) - s.pos
+	}
+	if size >= 0 && end > size {
+		end = size
+	}
+	return s.take(end), nil
+}
+
+// Write writes text at the current position returning the number of
+// characters written
+func (s *StringIO) Write(text string) (py.Object, error) {
+	if err := s.checkClosed(); err != nil {
+		return nil, err
+	}
+	s.write(text)
+	return py.Int(utf8.RuneCountInString(text)), nil
+}
+
+// Moves to pos, or to the end if whence is SEEK_END, returning
+// the new position
+func (s *StringIO) seek(pos int64, whence int) (py.Object, error) {
+	if err := s.checkClosed(); err != nil {
+		return nil, err
+	}
+	switch whence {
+	case SEEK_SET:
+		if pos < 0 {
+			return nil, py.ExceptionNewf(py.ValueError, "Negative seek position %d", pos)
+		}
+		s.pos = int(pos)
+	case SEEK_CUR:
+		if pos != 0 {
+			return nil, py.ExceptionNewf(py.OSError, "Can't do nonzero cur-relative seeks")
+		}
+	case SEEK_END:
+		if pos != 0 {
+			return nil, py.ExceptionNewf(py.OSError, "Can't do nonzero end-relative seeks")
+		}
+		s.pos = len(s.buf)
+	}
+	return py.Int(s.pos), nil
+}
+
+// Truncate resizes the stream to size characters or the current
+// position if size is None leaving the position unchanged
+func (s *StringIO) Truncate(sizeObj py.Object) (py.Object, error) {
+	if err := s.checkClosed(); err != nil {
+		return nil, err
+	}
+	size := s.pos
+	if sizeObj != py.None {
+		var err error
+		size, err = sizeArg("truncate", sizeObj)
+		if err != nil {
+			return nil, err
+		}
+		if size < 0 {
+			return nil, py.ExceptionNewf(py.ValueError, "Negative size value %d", size)
+		}
+	}
+	if size < len(s.buf) {
+		s.buf = s.buf[:size]
+	}
+	return py.Int(size), nil
+}
+
+// GetValue returns the contents of the stream
+func (s *StringIO) GetValue() (py.Object, error) {
+	if err := s.checkClosed(); err != nil {
+		return nil, err
+	}
+	return py.String(string(s.buf)), nil
+}
+
+func (s *StringIO) M__iter__() (py.Object, error) {
+	if err := s.checkClosed(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *StringIO) M__next__() (py.Object, error) {
+	return iterNext(s)
+}
+
+// Check interfaces are satisfied
+var _ py.I_iterator = (*StringIO)(nil)
+
+func init() {
+	self := func(o py.Object) *StringIO {
+		return o.(*StringIO)
+	}
+	StringIOType.Dict["closed"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return py.NewBool(self(o).closed), nil
+		},
+	}
+	StringIOType.Dict["line_buffering"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			if err := self(o).checkClosed(); err != nil {
+				return nil, err
+			}
+			return py.False, nil
+		},
+	}
+	StringIOType.Dict["getvalue"] = py.MustNewMethod("getvalue", func(o py.Object) (py.Object, error) {
+		return self(o).GetValue()
+	}, 0, "Retrieve the entire contents of the object.")
+	StringIOType.Dict["read"] = py.MustNewMethod("read", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		size, err := readSizeArgs("read", args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).Read(size)
+	}, 0, "Read at most size characters, returned as a string.\n\nIf the argument is negative or omitted, read until EOF\nis reached. Return an empty string at EOF.")
+	StringIOType.Dict["readline"] = py.MustNewMethod("readline", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		size, err := readSizeArgs("readline", args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).ReadLine(size)
+	}, 0, "Read until newline or EOF.\n\nReturns an empty string if EOF is hit immediately.")
+	StringIOType.Dict["write"] = py.MustNewMethod("write", func(o py.Object, arg py.Object) (py.Object, error) {
+		s, ok := arg.(py.String)
+		if !ok {
+			return nil, py.ExceptionNewf(py.TypeError, "string argument expected, got '%s'", arg.Type().Name)
+		}
+		return self(o).Write(string(s))
+	}, 0, "Write string to file.\n\nReturns the number of characters written, which is always equal to\nthe length of the string.")
+	StringIOType.Dict["seek"] = py.MustNewMethod("seek", func(o py.Object, args py.Tuple) (py.Object, error) {
+		pos, whence, err := seekArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).seek(pos, whence)
+	}, 0, "Change stream position.\n\nSeek to character offset pos relative to position indicated by whence:\n    0  Start of stream (the default).  pos should be >= 0;\n    1  Current position - pos must be 0;\n    2  End of stream - pos must be 0.\nReturns the new absolute position.")
+	StringIOType.Dict["tell"] = py.MustNewMethod("tell", func(o py.Object) (py.Object, error) {
+		if err := self(o).checkClosed(); err != nil {
+			return nil, err
+		}
+		return py.Int(self(o).pos), nil
+	}, 0, "Tell the current file position.")
+	StringIOType.Dict["truncate"] = py.MustNewMethod("truncate", func(o py.Object, args py.Tuple) (py.Object, error) {
+		var size py.Object = py.None
+		err := py.UnpackTuple(args, nil, "truncate", 0, 1, &size)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).Truncate(size)
+	}, 0, "Truncate size to pos.\n\nThe pos argument defaults to the current file position, as\nreturned by tell().  The current file position is unchanged.\nReturns the new absolute position.")
+	StringIOType.Dict["close"] = py.MustNewMethod("close", func(o py.Object) (py.Object, error) {
+		self(o).closed = true
+		self(o).buf = nil
+		return py.None, nil
+	}, 0, "Close the IO object.\n\nAttempting any further operation after the object is closed\nwill raise a ValueError.\n\nThis method has no effect if the file is already closed.")
+	for _, name := range []string{"readable", "writable", "seekable"} {
+		StringIOType.Dict[name] = py.MustNewMethod(name, func(o py.Object) (py.Object, error) {
+			if err := self(o).checkClosed(); err != nil {
+				return nil, err
+			}
+			return py.True, nil
+		}, 0, "")
+	}
+}
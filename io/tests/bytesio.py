@@ -0,0 +1,64 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+import io
+from libtest import assertRaises, assertRaisesText
+
+doc = "BytesIO read"
+f = io.BytesIO(b"hello\nworld\n")
+assert type(f) is io.BytesIO
+assert f.read(3) == b"hel"
+assert f.read1(2) == b"lo"
+assert f.tell() == 5
+assert f.readline() == b"\n"
+assert f.readline(2) == b"wo"
+assert f.read() == b"rld\n"
+assert f.read() == b""
+assert f.readline() == b""
+
+doc = "BytesIO write"
+f = io.BytesIO()
+assert f.write(b"hello") == 5
+assert f.getvalue() == b"hello"
+f.seek(-4, io.SEEK_END)
+assert f.write(b"E") == 1
+assert f.getvalue() == b"hEllo"
+f.seek(2, io.SEEK_CUR)
+assert f.tell() == 4
+f.seek(7)
+f.write(b"!")
+assert f.getvalue() == b"hEllo\x00\x00!"
+assert f.seek(-100, io.SEEK_CUR) == 0
+assertRaisesText(ValueError, "negative seek value", f.seek, -1)
+assertRaises(TypeError, f.write, "str")
+assertRaises(ValueError, f.seek, 0, 3)
+
+doc = "BytesIO truncate"
+f = io.BytesIO(b"abcdef")
+f.seek(3)
+assert f.truncate() == 3
+assert f.getvalue() == b"abc"
+assert f.truncate(1) == 1
+assert f.getvalue() == b"a"
+assert f.tell() == 3
+assertRaises(ValueError, f.truncate, -1)
+
+doc = "BytesIO lines"
+f = io.BytesIO(b"a\nb\nc")
+assert f.readlines() == [b"a\n", b"b\n", b"c"]
+f.seek(0)
+assert f.readlines(2) == [b"a\n"]
+f.seek(0)
+assert list(f) == [b"a\n", b"b\n", b"c"]
+
+doc = "BytesIO close"
+f = io.BytesIO(b"abc")
+f.close()
+assert f.closed
+assertRaises(ValueError, f.read)
+assertRaises(ValueError, f.getvalue)
+assertRaises(ValueError, f.tell)
+assertRaises(ValueError, iter, f)
+
+doc = "finished"
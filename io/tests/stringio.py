@@ -0,0 +1,88 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+import io
+from libtest import assertRaises, assertRaisesText
+
+doc = "StringIO read"
+f = io.StringIO("hello\nworld\n")
+assert type(f) is io.StringIO
+assert f.read(3) == "hel"
+assert f.tell() == 3
+assert f.readline() == "lo\n"
+assert f.read() == "world\n"
+assert f.read() == ""
+assert f.readline() == ""
+
+doc = "StringIO write"
+f = io.StringIO()
+assert f.write("héllo") == 5
+assert f.tell() == 5
+assert f.getvalue() == "héllo"
+f.seek(1)
+assert f.write("E") == 1
+assert f.getvalue() == "hEllo"
+assert f.seek(0, io.SEEK_END) == 5
+f.seek(7)
+f.write("!")
+assert f.getvalue() == "hEllo\0\0!"
+assertRaises(TypeError, f.write, b"bytes")
+
+doc = "StringIO seek"
+f = io.StringIO("abc")
+assertRaisesText(ValueError, "Negative seek position", f.seek, -1)
+assertRaises(OSError, f.seek, 1, io.SEEK_CUR)
+assertRaises(OSError, f.seek, 1, io.SEEK_END)
+assert f.seek(0, io.SEEK_CUR) == 0
+
+doc = "StringIO truncate"
+f = io.StringIO("abcdef")
+f.seek(2)
+assert f.truncate() == 2
+assert f.getvalue() == "ab"
+assert f.tell() == 2
+assert f.truncate(10) == 10
+assert f.getvalue() == "ab"
+assertRaises(ValueError, f.truncate, -1)
+
+doc = "StringIO lines"
+f = io.StringIO("a\nb\nc")
+assert f.readlines() == ["a\n", "b\n", "c"]
+f.seek(0)
+assert [line for line in f] == ["a\n", "b\n", "c"]
+f.seek(0)
+assert f.readline(1) == "a"
+assert f.readline(5) == "\n"
+f = io.StringIO()
+f.writelines(["a", "b\n", "c"])
+assert f.getvalue() == "ab\nc"
+
+doc = "StringIO newline"
+assert io.StringIO("a\r\nb\rc\n").read() == "a\r\nb\rc\n"
+assert io.StringIO("a\r\nb\rc\n", newline=None).read() == "a\nb\nc\n"
+assert io.StringIO("a\r\nb\rc\n", newline=None).readlines() == ["a\n", "b\n", "c\n"]
+assert io.StringIO("a\r\nb\rc\n", newline="").readlines() == ["a\r\n", "b\r", "c\n"]
+assert io.StringIO("a\nb\rc", newline="\r").readlines() == ["a\r", "b\r", "c"]
+assert io.StringIO("a\nb\r\nc", newline="\r\n").getvalue() == "a\r\nb\r\r\nc"
+assertRaises(ValueError, io.StringIO, "", "x")
+assertRaises(TypeError, io.StringIO, "", 1)
+
+doc = "StringIO close"
+f = io.StringIO("abc")
+assert not f.closed
+assert f.readable() and f.writable() and f.seekable()
+f.close()
+assert f.closed
+f.close()
+assertRaises(ValueError, f.read)
+assertRaises(ValueError, f.write, "x")
+assertRaises(ValueError, f.getvalue)
+assertRaises(ValueError, f.readable)
+
+doc = "StringIO with"
+with io.StringIO("abc") as f:
+    assert f.read() == "abc"
+assert f.closed
+
+doc = "finished"
@@ -0,0 +1,165 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+import io
+from libtest import assertRaises, assertRaisesText
+
+doc = "TextIOWrapper read"
+b = io.BytesIO(b"h\xc3\xa9llo\nw\xc3\xb6rld\n")
+t = io.TextIOWrapper(b)
+assert t.encoding == "UTF-8"
+assert t.errors == "strict"
+assert t.buffer is b
+assert t.read(2) == "hé"
+assert t.tell() == 3
+assert t.readline() == "llo\n"
+assert t.tell() == 7
+assert t.read() == "wörld\n"
+assert t.read() == ""
+assert t.seek(3) == 3
+assert t.read(3) == "llo"
+t.seek(0)
+assert t.readlines() == ["héllo\n", "wörld\n"]
+t.seek(0)
+assert [line for line in t] == ["héllo\n", "wörld\n"]
+
+doc = "TextIOWrapper newline"
+data = b"a\r\nb\rc\nd"
+assert io.TextIOWrapper(io.BytesIO(data)).readlines() == ["a\n", "b\n", "c\n", "d"]
+assert io.TextIOWrapper(io.BytesIO(data)).read() == "a\nb\nc\nd"
+assert io.TextIOWrapper(io.BytesIO(data)).read(3) == "a\nb"
+assert io.TextIOWrapper(io.BytesIO(data), newline="").readlines() == ["a\r\n", "b\r", "c\n", "d"]
+assert io.TextIOWrapper(io.BytesIO(data), newline="\n").readlines() == ["a\r\n", "b\rc\n", "d"]
+assert io.TextIOWrapper(io.BytesIO(data), newline="\r").readlines() == ["a\r", "\nb\r", "c\nd"]
+assert io.TextIOWrapper(io.BytesIO(data), newline="\r\n").readlines() == ["a\r\n", "b\rc\nd"]
+assert io.TextIOWrapper(io.BytesIO(b"a\r")).read() == "a\n"
+t = io.TextIOWrapper(io.BytesIO(data))
+t.readline()
+assert t.tell() == 3
+assertRaises(ValueError, io.TextIOWrapper, b, newline="x")
+
+doc = "TextIOWrapper write"
+b = io.BytesIO()
+t = io.TextIOWrapper(b, newline="\r\n")
+assert t.write("a\nb") == 3
+t.flush()
+assert b.getvalue() == b"a\r\nb"
+b = io.BytesIO()
+t = io.TextIOWrapper(b)
+assert t.write("é") == 1
+assertRaisesText(TypeError, "must be str", t.write, b"x")
+t.flush()
+assert b.getvalue() == b"\xc3\xa9"
+
+doc = "TextIOWrapper read and write"
+b = io.BytesIO(b"one\ntwo\n")
+t = io.TextIOWrapper(b)
+assert t.readline() == "one\n"
+t.write("TWO")
+t.seek(0)
+assert t.read() == "one\nTWO\n"
+
+doc = "TextIOWrapper encodings"
+t = io.TextIOWrapper(io.BytesIO(b"caf\xe9"), encoding="latin-1")
+assert t.read() == "café"
+t = io.TextIOWrapper(io.BytesIO(b"caf\xe9"), encoding="ascii")
+assertRaisesText(UnicodeDecodeError, "'ascii' codec can't decode byte 0xe9 in position 3", t.read)
+t = io.TextIOWrapper(io.BytesIO(b"caf\xe9"), encoding="ascii", errors="replace")
+assert t.read() == "caf�"
+t = io.TextIOWrapper(io.BytesIO(b"caf\xe9!"), errors="ignore")
+assert t.read() == "caf!"
+t = io.TextIOWrapper(io.BytesIO(b"caf\xe9!"))
+assertRaisesText(UnicodeDecodeError, "invalid continuation byte", t.read)
+t = io.TextIOWrapper(io.BytesIO(b"caf\xff"))
+assertRaisesText(UnicodeDecodeError, "invalid start byte", t.read)
+t = io.TextIOWrapper(io.BytesIO(b"caf\xc3"))
+assertRaisesText(UnicodeDecodeError, "unexpected end of data", t.read)
+b = io.BytesIO()
+t = io.TextIOWrapper(b, encoding="ascii")
+assertRaisesText(UnicodeEncodeError, "'ascii' codec can't encode character '\\xe9' in position 0", t.write, "éé")
+t = io.TextIOWrapper(b, encoding="ascii", errors="backslashreplace")
+t.write("é€")
+t = io.TextIOWrapper(b, encoding="latin_1", errors="xmlcharrefreplace")
+t.write("é€")
+assert b.getvalue() == b"\\xe9\\u20ac\xe9&#8364;"
+assertRaisesText(LookupError, "unknown encoding: nope", io.TextIOWrapper, b, encoding="nope")
+assertRaisesText(LookupError, "unknown error handler name 'nope'", io.TextIOWrapper, b, errors="nope")
+
+doc = "TextIOWrapper utf-16 and utf-32"
+for encoding, data in [
+        ("utf-16-le", b"c\x00\xe9\x00=\xd8\x00\xde"),
+        ("utf_16be", b"\x00c\x00\xe9\xd8=\xde\x00"),
+        ("utf-32-le", b"c\x00\x00\x00\xe9\x00\x00\x00\x00\xf6\x01\x00"),
+        ("UTF-32BE", b"\x00\x00\x00c\x00\x00\x00\xe9\x00\x01\xf6\x00"),
+        ("utf-16", b"\xff\xfec\x00\xe9\x00=\xd8\x00\xde"),
+        ("utf-32", b"\xff\xfe\x00\x00c\x00\x00\x00\xe9\x00\x00\x00\x00\xf6\x01\x00"),
+]:
+    b = io.BytesIO()
+    t = io.TextIOWrapper(b, encoding=encoding)
+    assert t.write("cé😀") == 3
+    t.flush()
+    assert b.getvalue() == data, encoding
+    t.seek(0)
+    assert t.read() == "cé😀", encoding
+    t.seek(0)
+    assert t.read(1) == "c"
+    pos = t.tell()
+    assert t.read() == "é😀"
+    t.seek(pos)
+    assert t.read() == "é😀"
+t = io.TextIOWrapper(io.BytesIO(b"\xfe\xff\x00h\x00i"), encoding="utf-16")
+assert t.read() == "hi"
+t = io.TextIOWrapper(io.BytesIO(b"h\x00i\x00"), encoding="utf-16")
+assertRaisesText(UnicodeError, "UTF-16 stream does not start with BOM", t.read)
+t = io.TextIOWrapper(io.BytesIO(b"\x00\x00\xfe\xff\x00\x00\x00h"), encoding="utf-32")
+assert t.read() == "h"
+b = io.BytesIO()
+t = io.TextIOWrapper(b, encoding="utf-16")
+t.write("a")
+t.write("b")
+t.seek(2)
+t.write("c")
+t.flush()
+assert b.getvalue() == b"\xff\xfec\x00b\x00"
+b = io.BytesIO(b"xx")
+b.seek(2)
+t = io.TextIOWrapper(b, encoding="utf-16")
+t.write("a")
+t.flush()
+assert b.getvalue() == b"xxa\x00"
+t = io.TextIOWrapper(io.BytesIO(b"a\x00b"), encoding="utf-16-le")
+assertRaisesText(UnicodeDecodeError, "'utf-16-le' codec can't decode byte 0x62 in position", t.read)
+t = io.TextIOWrapper(io.BytesIO(b"\x00\xdca\x00"), encoding="utf-16-le")
+assertRaisesText(UnicodeDecodeError, "'utf-16-le' codec can't decode bytes in position 0-1: illegal encoding", t.read)
+t = io.TextIOWrapper(io.BytesIO(b"\x00\xd8a\x00"), encoding="utf-16-le")
+assertRaisesText(UnicodeDecodeError, "'utf-16-le' codec can't decode bytes in position 0-1: illegal UTF-16 surrogate", t.read)
+t = io.TextIOWrapper(io.BytesIO(b"\x00\xd8a\x00"), encoding="utf-16-le", errors="replace")
+assert t.read() == "\ufffda"
+t = io.TextIOWrapper(io.BytesIO(b"\x00\xd8"), encoding="utf-16-le", errors="backslashreplace")
+assert t.read() == "\\x00\\xd8"
+t = io.TextIOWrapper(io.BytesIO(b"\x00\x00\x11\x00a\x00\x00\x00"), encoding="utf-32-le")
+assertRaisesText(UnicodeDecodeError, "'utf-32-le' codec can't decode bytes in position 0-3: code point not in range(0x110000)", t.read)
+t = io.TextIOWrapper(io.BytesIO(b"\x00\x00\x11\x00a\x00\x00\x00"), encoding="utf-32-le", errors="ignore")
+assert t.read() == "a"
+
+doc = "TextIOWrapper seek"
+t = io.TextIOWrapper(io.BytesIO(b"abc"))
+assertRaises(io.UnsupportedOperation, t.seek, 1, io.SEEK_CUR)
+assertRaises(io.UnsupportedOperation, t.seek, 1, io.SEEK_END)
+assertRaises(ValueError, t.seek, -1)
+assert t.seek(0, io.SEEK_END) == 3
+assert t.read() == ""
+
+doc = "TextIOWrapper close"
+b = io.BytesIO(b"abc")
+t = io.TextIOWrapper(b)
+assert not t.closed
+t.close()
+assert t.closed
+assert b.closed
+t.close()
+assertRaises(ValueError, t.read)
+assertRaises(ValueError, t.write, "x")
+
+doc = "finished"
@@ -0,0 +1,716 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// TextIOWrapper text stream
+
+package io
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-python/gpython/py"
+)
+
+var TextIOWrapperType = TextIOBaseType.NewType("TextIOWrapper", `Character and line based layer over a BufferedIOBase object, buffer.
+
+encoding gives the name of the encoding that the stream will be
+decoded or encoded with. It defaults to UTF-8.
+
+errors determines the strictness of encoding and decoding (see
+help(codecs.Codec) or the documentation for codecs.register) and
+defaults to "strict".
+
+newline controls how line endings are handled. It can be None, '',
+'\n', '\r', and '\r\n'.  It works as follows:
+
+* On input, if newline is None, universal newlines mode is
+  enabled. Lines in the input can end in '\n', '\r', or '\r\n', and
+  these are translated into '\n' before being returned to the
+  caller. If it is '', universal newline mode is enabled, but line
+  endings are returned to the caller untranslated. If it has any of
+  the other legal values, input lines are only terminated by the given
+  string, and the line ending is returned to the caller untranslated.
+
+* On output, if newline is None, any '\n' characters written are
+  translated to the system default line separator, os.linesep. If
+  newline is '' or '\n', no translation takes place. If newline is any
+  of the other legal values, any '\n' characters written are translated
+  to the given string.
+
+If line_buffering is True, a call to flush is implied when a call to
+write contains a newline character.`, TextIOWrapperNew, nil)
+
+// The line separator of the system
+var linesep = "\n"
+
+func init() {
+	if runtime.GOOS == "windows" {
+		linesep = "\r\n"
+	}
+}
+
+// How a text stream handles newlines
+type newlineMode struct {
+	translate bool   // translate "\r" and "\r\n" to "\n" when reading
+	universal bool   // lines end with any of "\r", "\n" or "\r\n"
+	readNL    string // the line ending when not universal
+	writeNL   string // what "\n" is translated to on write or "" for none
+}
+
+// Parses the newline argument of a text stream
+func parseNewline(newline py.Object) (m newlineMode, err error) {
+	if newline == py.None {
+		m = newlineMode{translate: true, universal: true}
+		if linesep != "\n" {
+			m.writeNL = linesep
+		}
+		return m, nil
+	}
+	s, ok := newline.(py.String)
+	if !ok {
+		return m, py.ExceptionNewf(py.TypeError, "newline must be str or None, not %s", newline.Type().Name)
+	}
+	switch s {
+	case "":
+		m.universal = true
+	case "\n":
+		m.readNL = "\n"
+	case "\r", "\r\n":
+		m.readNL = string(s)
+		m.writeNL = string(s)
+	default:
+		repr, _ := py.ReprAsString(s)
+		return m, py.ExceptionNewf(py.ValueError, "illegal newline value: %s", repr)
+	}
+	return m, nil
+}
+
+// Finds the end of the first line in buf returning the index after
+// the line ending and true, or false if there isn't a complete line
+//
+// A "\r" at the end of buf isn't a complete line in universal mode
+// unless eof is set as it might be followed by a "\n".
+func (m *newlineMode) lineEnd(buf []rune, eof bool) (int, bool) {
+	if m.universal {
+		for i, c := range buf {
+			switch c {
+			case '\n':
+				return i + 1, true
+			case '\r':
+				if i+1 < len(buf) {
+					if buf[i+1] == '\n' {
+						return i + 2, true
+					}
+					return i + 1, true
+				}
+				if eof {
+					return i + 1, true
+				}
+				return 0, false
+			}
+		}
+		return 0, false
+	}
+	nl := []rune(m.readNL)
+	for i := 0; i+len(nl) <= len(buf); i++ {
+		if buf[i] == nl[0] && (len(nl) == 1 || buf[i+1] == nl[1]) {
+			return i + len(nl), true
+		}
+	}
+	return 0, false
+}
+
+// Translates "\r\n" and "\r" in text to "\n"
+func translateNewlines(text []rune) []rune {
+	out := make([]rune, 0, len(text))
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		if c == '\r' {
+			if i+1 < len(text) && text[i+1] == '\n' {
+				i++
+			}
+			c = '\n'
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+// TextIOWrapper decodes and encodes text read from and written to a
+// binary stream
+type TextIOWrapper struct {
+	buffer        py.Object
+	encoding      string
+	codec         *codec
+	decoder       *codec // codec in use for reading, nil until the BOM has been read
+	writeBOM      bool   // set if a BOM should be written before the next text
+	errors        string
+	newline       newlineMode
+	lineBuffering bool
+	writeThrough  bool
+	mode          string // mode passed to open or "" if not opened
+	decoded       []rune // decoded but not returned yet with newlines untranslated
+	undecoded     []byte // read but part of a character which isn't complete
+	eof           bool
+}
+
+// Type of this object
+func (t *TextIOWrapper) Type() *py.Type {
+	return TextIOWrapperType
+}
+
+// NewTextIOWrapper makes a text stream reading and writing the binary
+// stream buffer
+//
+// encoding and errors may be "" for the defaults of UTF-8 and
+// "strict". newline is the python newline argument.
+func NewTextIOWrapper(buffer py.Object, encoding, errors string, newline py.Object, lineBuffering, writeThrough bool) (*TextIOWrapper, error) {
+	if encoding == "" {
+		encoding = defaultEncoding
+	}
+	c, err := lookupCodec(encoding)
+	if err != nil {
+		return nil, err
+	}
+	if errors == "" {
+		errors = "strict"
+	}
+	if err = checkErrorHandler(errors); err != nil {
+		return nil, err
+	}
+	m, err := parseNewline(newline)
+	if err != nil {
+		return nil, err
+	}
+	t := &TextIOWrapper{
+		buffer:        buffer,
+		encoding:      encoding,
+		codec:         c,
+		errors:        errors,
+		newline:       m,
+		lineBuffering: lineBuffering,
+		writeThrough:  writeThrough,
+	}
+	if c.hasBOM() {
+		t.writeBOM = t.atStart()
+	} else {
+		t.decoder = c
+	}
+	return t, nil
+}
+
+// Returns whether the buffer is at the start of the stream, which is
+// assumed if it can't be found out
+func (t *TextIOWrapper) atStart() bool {
+	seekable, err := callBool(t.buffer, "seekable")
+	if err != nil || !seekable {
+		return true
+	}
+	pos, err := callMethod(t.buffer, "tell")
+	return err != nil || pos == py.Int(0)
+}
+
+// Returns the string in a str or None argument
+func optionalString(obj py.Object) string {
+	if s, ok := obj.(py.String); ok {
+		return string(s)
+	}
+	return ""
+}
+
+// TextIOWrapperNew makes a TextIOWrapper from python
+func TextIOWrapperNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var (
+		buffer        py.Object
+		encoding      py.Object = py.None
+		errors        py.Object = py.None
+		newline       py.Object = py.None
+		lineBuffering py.Object = py.False
+		writeThrough  py.Object = py.False
+	)
+	kwlist := []string{"buffer", "encoding", "errors", "newline", "line_buffering", "write_through"}
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|zzzpp:TextIOWrapper", kwlist, &buffer, &encoding, &errors, &newline, &lineBuffering, &writeThrough)
+	if err != nil {
+		return nil, err
+	}
+	return NewTextIOWrapper(buffer, optionalString(encoding), optionalString(errors), newline, lineBuffering == py.True, writeThrough == py.True)
+}
+
+// Returns an error if the stream is closed or can't be read
+func (t *TextIOWrapper) checkReadable() error {
+	if err := checkClosed(t.buffer); err != nil {
+		return err
+	}
+	ok, err := callBool(t.buffer, "readable")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errUnsupported("not readable")
+	}
+	return nil
+}
+
+// Returns an error if the stream is closed or can't be written
+func (t *TextIOWrapper) checkWritable() error {
+	if err := checkClosed(t.buffer); err != nil {
+		return err
+	}
+	ok, err := callBool(t.buffer, "writable")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errUnsupported("not writable")
+	}
+	return nil
+}
+
+// Reads and decodes the next chunk of the buffer returning false if
+// the end of the buffer has been reached
+func (t *TextIOWrapper) fill() (bool, error) {
+	if t.eof {
+		return false, nil
+	}
+	method := "read1"
+	if _, err := py.GetAttrString(t.buffer, method); err != nil {
+		method = "read"
+	}
+	res, err := callMethod(t.buffer, method, py.Int(DEFAULT_BUFFER_SIZE))
+	if err != nil {
+		return false, err
+	}
+	var chunk []byte
+	if res != py.None {
+		chunk, err = bytesArg(method, res)
+		if err != nil {
+			return false, py.ExceptionNewf(py.TypeError, "underlying %s() should have returned a bytes object, not '%s'", method, res.Type().Name)
+		}
+	}
+	final := len(chunk) == 0
+	if !final {
+		// past the start so no BOM is needed when writing
+		t.writeBOM = false
+	}
+	data := append(t.undecoded, chunk...)
+	if t.decoder == nil {
+		t.decoder, data, err = t.codec.readBOM(data, final)
+		if err != nil {
+			return false, err
+		}
+		if t.decoder == nil {
+			t.undecoded = data
+			return true, nil
+		}
+	}
+	text, rest, err := t.decoder.decode(data, final, t.errors)
+	if err != nil {
+		return false, err
+	}
+	t.decoded = append(t.decoded, text...)
+	t.undecoded = rest
+	t.eof = final
+	return !final, nil
+}
+
+// Removes and returns the first n decoded characters translating
+// newlines if required
+func (t *TextIOWrapper) take(n int) py.String {
+	text := t.decoded[:n]
+	t.decoded = t.decoded[n:]
+	if t.newline.translate {
+		text = translateNewlines(text)
+	}
+	return py.String(string(text))
+}
+
+// Returns the number of decoded characters which translate to n
+// characters or false if more need to be read to find out
+func (t *TextIOWrapper) span(n int) (int, bool) {
+	if !t.newline.translate {
+		if len(t.decoded) >= n {
+			return n, true
+		}
+		return len(t.decoded), t.eof
+	}
+	i, count := 0, 0
+	for ; count < n && i < len(t.decoded); count++ {
+		if t.decoded[i] == '\r' {
+			if i+1 < len(t.decoded) {
+				if t.decoded[i+1] == '\n' {
+					i++
+				}
+			} else if !t.eof {
+				return 0, false
+			}
+		}
+		i++
+	}
+	if count < n && !t.eof {
+		return i, false
+	}
+	return i, true
+}
+
+// Read reads size characters or until the end of the stream if
+// size < 0
+func (t *TextIOWrapper) Read(size int) (py.Object, error) {
+	if err := t.checkReadable(); err != nil {
+		return nil, err
+	}
+	if size < 0 {
+		for {
+			more, err := t.fill()
+			if err != nil {
+				return nil, err
+			}
+			if !more {
+				break
+			}
+		}
+		return t.take(len(t.decoded)), nil
+	}
+	for {
+		end, ok := t.span(size)
+		if ok {
+			return t.take(end), nil
+		}
+		if _, err := t.fill(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// ReadLine reads the next line or at most size characters if size >= 0
+func (t *TextIOWrapper) ReadLine(size int) (py.Object, error) {
+	if err := t.checkReadable(); err != nil {
+		return nil, err
+	}
+	for {
+		end, ok := t.newline.lineEnd(t.decoded, t.eof)
+		if !ok && size >= 0 && len(t.decoded) >= size {
+			end, ok = size, true
+		}
+		if !ok && t.eof {
+			end, ok = len(t.decoded), true
+		}
+		if ok {
+			if size >= 0 && end > size {
+				end = size
+				// don't split a "\r\n" which is being translated
+				if t.newline.translate && end > 0 && end < len(t.decoded) && t.decoded[end-1] == '\r' && t.decoded[end] == '\n' {
+					end++
+				}
+			}
+			return t.take(end), nil
+		}
+		if _, err := t.fill(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Forgets anything read ahead
+func (t *TextIOWrapper) resetRead() {
+	t.decoded = nil
+	t.undecoded = nil
+	t.eof = false
+}
+
+// Write encodes s and writes it to the buffer returning the number of
+// characters written
+func (t *TextIOWrapper) Write(s string) (py.Object, error) {
+	if err := t.checkWritable(); err != nil {
+		return nil, err
+	}
+	text := s
+	if t.newline.writeNL != "" {
+		text = strings.Replace(text, "\n", t.newline.writeNL, -1)
+	}
+	data, err := t.codec.encode(text, t.errors)
+	if err != nil {
+		return nil, err
+	}
+	if len(t.decoded) > 0 || len(t.undecoded) > 0 {
+		// move the buffer back to where reading got to
+		pos, err := t.Tell()
+		if err != nil {
+			return nil, err
+		}
+		if _, err = callMethod(t.buffer, "seek", pos); err != nil {
+			return nil, err
+		}
+	}
+	t.resetRead()
+	if t.writeBOM {
+		data = append(t.codec.bom(), data...)
+		t.writeBOM = false
+	}
+	if _, err = callMethod(t.buffer, "write", py.Bytes(data)); err != nil {
+		return nil, err
+	}
+	if t.lineBuffering && strings.ContainsAny(s, "\n\r") {
+		if _, err = callMethod(t.buffer, "flush"); err != nil {
+			return nil, err
+		}
+	}
+	return py.Int(utf8.RuneCountInString(s)), nil
+}
+
+// Flush flushes the buffer
+func (t *TextIOWrapper) Flush() error {
+	if err := checkClosed(t.buffer); err != nil {
+		return err
+	}
+	_, err := callMethod(t.buffer, "flush")
+	return err
+}
+
+// Tell returns the position of the stream in bytes
+func (t *TextIOWrapper) Tell() (py.Object, error) {
+	if err := checkClosed(t.buffer); err != nil {
+		return nil, err
+	}
+	seekable, err := callBool(t.buffer, "seekable")
+	if err != nil {
+		return nil, err
+	}
+	if !seekable {
+		return nil, errUnsupported("underlying stream is not seekable")
+	}
+	if err = t.Flush(); err != nil {
+		return nil, err
+	}
+	res, err := callMethod(t.buffer, "tell")
+	if err != nil {
+		return nil, err
+	}
+	pos, ok := res.(py.Int)
+	if !ok {
+		return nil, py.ExceptionNewf(py.TypeError, "underlying tell() returned %s not int", res.Type().Name)
+	}
+	return pos - py.Int(len(t.undecoded)+t.codec.encodedLen(t.decoded)), nil
+}
+
+// Moves to the position returned by Tell or to the end
+//
+// As positions are byte offsets, seeking relative to the current
+// position or the end is only possible with an offset of 0.
+func (t *TextIOWrapper) seek(pos int64, whence int) (py.Object, error) {
+	if err := checkClosed(t.buffer); err != nil {
+		return nil, err
+	}
+	seekable, err := callBool(t.buffer, "seekable")
+	if err != nil {
+		return nil, err
+	}
+	if !seekable {
+		return nil, errUnsupported("underlying stream is not seekable")
+	}
+	switch whence {
+	case SEEK_CUR:
+		if pos != 0 {
+			return nil, errUnsupported("can't do nonzero cur-relative seeks")
+		}
+		return t.Tell()
+	case SEEK_END:
+		if pos != 0 {
+			return nil, errUnsupported("can't do nonzero end-relative seeks")
+		}
+	default:
+		if pos < 0 {
+			return nil, py.ExceptionNewf(py.ValueError, "negative seek position %d", pos)
+		}
+	}
+	if err = t.Flush(); err != nil {
+		return nil, err
+	}
+	t.resetRead()
+	res, err := callMethod(t.buffer, "seek", py.Int(pos), py.Int(whence))
+	if err != nil {
+		return nil, err
+	}
+	if t.codec.hasBOM() {
+		// the BOM is read and written again at the start
+		if res == py.Int(0) {
+			t.decoder, t.writeBOM = nil, true
+		} else {
+			if t.decoder == nil {
+				t.decoder = t.codec.native
+			}
+			t.writeBOM = false
+		}
+	}
+	return res, nil
+}
+
+// Truncate resizes the stream to pos bytes or the current position if
+// pos is None
+func (t *TextIOWrapper) Truncate(pos py.Object) (py.Object, error) {
+	if err := t.Flush(); err != nil {
+		return nil, err
+	}
+	if pos == py.None {
+		var err error
+		pos, err = t.Tell()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return callMethod(t.buffer, "truncate", pos)
+}
+
+// Close flushes and closes the buffer
+func (t *TextIOWrapper) Close() error {
+	closed, err := isClosed(t.buffer)
+	if err != nil || closed {
+		return err
+	}
+	flushErr := t.Flush()
+	_, err = callMethod(t.buffer, "close")
+	if flushErr != nil {
+		return flushErr
+	}
+	return err
+}
+
+func (t *TextIOWrapper) M__repr__() (py.Object, error) {
+	out := "<_io.TextIOWrapper"
+	if name, err := py.GetAttrString(t.buffer, "name"); err == nil {
+		repr, err := py.ReprAsString(name)
+		if err != nil {
+			return nil, err
+		}
+		out += " name=" + repr
+	}
+	if t.mode != "" {
+		out += fmt.Sprintf(" mode='%s'", t.mode)
+	}
+	out += fmt.Sprintf(" encoding='%s'>", t.encoding)
+	return py.String(out), nil
+}
+
+func (t *TextIOWrapper) M__iter__() (py.Object, error) {
+	if err := checkClosed(t.buffer); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *TextIOWrapper) M__next__() (py.Object, error) {
+	return iterNext(t)
+}
+
+// Check interfaces are satisfied
+var _ py.I__repr__ = (*TextIOWrapper)(nil)
+var _ py.I_iterator = (*TextIOWrapper)(nil)
+
+func init() {
+	self := func(o py.Object) *TextIOWrapper {
+		return o.(*TextIOWrapper)
+	}
+	TextIOWrapperType.Dict["buffer"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return self(o).buffer, nil
+		},
+	}
+	TextIOWrapperType.Dict["encoding"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return py.String(self(o).encoding), nil
+		},
+	}
+	TextIOWrapperType.Dict["errors"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return py.String(self(o).errors), nil
+		},
+	}
+	TextIOWrapperType.Dict["line_buffering"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return py.NewBool(self(o).lineBuffering), nil
+		},
+	}
+	TextIOWrapperType.Dict["write_through"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return py.NewBool(self(o).writeThrough), nil
+		},
+	}
+	TextIOWrapperType.Dict["mode"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			if self(o).mode == "" {
+				return nil, py.ExceptionNewf(py.AttributeError, "'TextIOWrapper' object has no attribute 'mode'")
+			}
+			return py.String(self(o).mode), nil
+		},
+		Fset: func(o, value py.Object) error {
+			mode, ok := value.(py.String)
+			if !ok {
+				return py.ExceptionNewf(py.TypeError, "mode must be str, not %s", value.Type().Name)
+			}
+			self(o).mode = string(mode)
+			return nil
+		},
+	}
+	for _, name := range []string{"name", "closed"} {
+		name := name
+		TextIOWrapperType.Dict[name] = &py.Property{
+			Fget: func(o py.Object) (py.Object, error) {
+				return py.GetAttrString(self(o).buffer, name)
+			},
+		}
+	}
+	TextIOWrapperType.Dict["read"] = py.MustNewMethod("read", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		size, err := readSizeArgs("read", args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).Read(size)
+	}, 0, "Read at most size characters from stream.\n\nRead from underlying buffer until we have size characters or we hit EOF.\nIf size is negative or omitted, read until EOF.")
+	TextIOWrapperType.Dict["readline"] = py.MustNewMethod("readline", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		size, err := readSizeArgs("readline", args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).ReadLine(size)
+	}, 0, "Read until newline or EOF.\n\nReturns an empty string if EOF is hit immediately.")
+	TextIOWrapperType.Dict["write"] = py.MustNewMethod("write", func(o py.Object, arg py.Object) (py.Object, error) {
+		s, ok := arg.(py.String)
+		if !ok {
+			return nil, py.ExceptionNewf(py.TypeError, "write() argument must be str, not %s", arg.Type().Name)
+		}
+		return self(o).Write(string(s))
+	}, 0, "Write string to stream.\nReturns the number of characters written (which is always equal to\nthe length of the string).")
+	TextIOWrapperType.Dict["flush"] = py.MustNewMethod("flush", func(o py.Object) (py.Object, error) {
+		return py.None, self(o).Flush()
+	}, 0, "Flush the write buffers of the stream if applicable.")
+	TextIOWrapperType.Dict["seek"] = py.MustNewMethod("seek", func(o py.Object, args py.Tuple) (py.Object, error) {
+		pos, whence, err := seekArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).seek(pos, whence)
+	}, 0, "Change stream position.\n\nChange the stream position to the given byte offset returned by tell().")
+	TextIOWrapperType.Dict["tell"] = py.MustNewMethod("tell", func(o py.Object) (py.Object, error) {
+		return self(o).Tell()
+	}, 0, "Return current stream position.")
+	TextIOWrapperType.Dict["truncate"] = py.MustNewMethod("truncate", func(o py.Object, args py.Tuple) (py.Object, error) {
+		var pos py.Object = py.None
+		err := py.UnpackTuple(args, nil, "truncate", 0, 1, &pos)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).Truncate(pos)
+	}, 0, "Truncate file to size bytes.")
+	TextIOWrapperType.Dict["close"] = py.MustNewMethod("close", func(o py.Object) (py.Object, error) {
+		return py.None, self(o).Close()
+	}, 0, "Flush and close the IO object.\n\nThis method has no effect if the file is already closed.")
+	for _, name := range []string{"fileno", "isatty", "readable", "writable", "seekable"} {
+		name := name
+		TextIOWrapperType.Dict[name] = py.MustNewMethod(name, func(o py.Object) (py.Object, error) {
+			return callMethod(self(o).buffer, name)
+		}, 0, "")
+	}
+}
@@ -0,0 +1,351 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Combinatoric generators
+//
+// These follow the algorithms in the CPython itertools documentation,
+// holding the indices of the items of the pool to return next.
+
+package itertools
+
+import (
+	"github.com/go-python/gpython/py"
+)
+
+// Reads the r argument of a combinatoric generator
+//
+// If r is None then def is returned
+func readR(rObj py.Object, def int) (int, error) {
+	if rObj == py.None {
+		return def, nil
+	}
+	r, err := py.IndexInt(rObj)
+	if err != nil {
+		return 0, err
+	}
+	if r < 0 {
+		return 0, py.ExceptionNewf(py.ValueError, "r must be non-negative")
+	}
+	return r, nil
+}
+
+// A python product object
+type Product struct {
+	pools   []py.Tuple
+	indices []int // nil before the first item
+	done    bool
+}
+
+var ProductType = py.NewTypeX("product", `product(*iterables, repeat=1) --> product object
+
+Cartesian product of input iterables.  Equivalent to nested for-loops.
+
+For example, product(A, B) returns the same as:  ((x,y) for x in A for y in B).
+The leftmost iterators are in the outermost for-loop, so the output tuples
+cycle in a manner similar to an odometer (with the rightmost element changing
+on every iteration).
+
+To compute the product of an iterable with itself, specify the number
+of repetitions with the optional repeat keyword argument. For example,
+product(A, repeat=4) means the same as product(A, A, A, A).
+
+product('ab', range(3)) --> ('a',0) ('a',1) ('a',2) ('b',0) ('b',1) ('b',2)
+product((0,1), (0,1), (0,1)) --> (0,0,0) (0,0,1) (0,1,0) (0,1,1) (1,0,0) ...`, ProductNew, nil)
+
+// Type of this object
+func (p *Product) Type() *py.Type {
+	return ProductType
+}
+
+// ProductNew makes a product object
+func ProductNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	repeat := 1
+	for name, value := range kwargs {
+		if name != "repeat" {
+			return nil, py.ExceptionNewf(py.TypeError, "product() got an unexpected keyword argument '%s'", name)
+		}
+		var err error
+		repeat, err = py.IndexInt(value)
+		if err != nil {
+			return nil, err
+		}
+		if repeat < 0 {
+			return nil, py.ExceptionNewf(py.ValueError, "repeat argument cannot be negative")
+		}
+	}
+	pools := make([]py.Tuple, len(args))
+	for i, iterable := range args {
+		pool, err := py.SequenceTuple(iterable)
+		if err != nil {
+			return nil, err
+		}
+		pools[i] = pool
+	}
+	p := &Product{}
+	for i := 0; i < repeat; i++ {
+		p.pools = append(p.pools, pools...)
+	}
+	return p, nil
+}
+
+func (p *Product) M__iter__() (py.Object, error) {
+	return p, nil
+}
+
+// Returns the item at the current indices
+func (p *Product) item() py.Tuple {
+	res := make(py.Tuple, len(p.pools))
+	for i, pool := range p.pools {
+		res[i] = pool[p.indices[i]]
+	}
+	return res
+}
+
+func (p *Product) M__next__() (py.Object, error) {
+	if p.done {
+		return nil, py.StopIteration
+	}
+	if p.indices == nil {
+		for _, pool := range p.pools {
+			if len(pool) == 0 {
+				p.done = true
+				return nil, py.StopIteration
+			}
+		}
+		p.indices = make([]int, len(p.pools))
+		return p.item(), nil
+	}
+	// Advance the rightmost index which isn't at the end of its
+	// pool, resetting the ones to its right
+	for i := len(p.pools) - 1; i >= 0; i-- {
+		p.indices[i]++
+		if p.indices[i] < len(p.pools[i]) {
+			return p.item(), nil
+		}
+		p.indices[i] = 0
+	}
+	p.done = true
+	return nil, py.StopIteration
+}
+
+// A python permutations object
+type Permutations struct {
+	pool    py.Tuple
+	r       int
+	indices []int
+	cycles  []int
+	started bool
+	done    bool
+}
+
+var PermutationsType = py.NewTypeX("permutations", `permutations(iterable[, r]) --> permutations object
+
+Return successive r-length permutations of elements in the iterable.
+
+permutations(range(3), 2) --> (0,1), (0,2), (1,0), (1,2), (2,0), (2,1)`, PermutationsNew, nil)
+
+// Type of this object
+func (p *Permutations) Type() *py.Type {
+	return PermutationsType
+}
+
+// PermutationsNew makes a permutations object
+func PermutationsNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var iterable py.Object
+	var rObj py.Object = py.None
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|O:permutations", []string{"iterable", "r"}, &iterable, &rObj)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := py.SequenceTuple(iterable)
+	if err != nil {
+		return nil, err
+	}
+	n := len(pool)
+	r, err := readR(rObj, n)
+	if err != nil {
+		return nil, err
+	}
+	p := &Permutations{pool: pool, r: r, done: r > n}
+	p.indices = make([]int, n)
+	for i := range p.indices {
+		p.indices[i] = i
+	}
+	p.cycles = make([]int, r)
+	for i := range p.cycles {
+		p.cycles[i] = n - i
+	}
+	return p, nil
+}
+
+func (p *Permutations) M__iter__() (py.Object, error) {
+	return p, nil
+}
+
+func (p *Permutations) M__next__() (py.Object, error) {
+	if p.done {
+		return nil, py.StopIteration
+	}
+	if !p.started {
+		p.started = true
+		return pick(p.pool, p.indices[:p.r]), nil
+	}
+	n := len(p.pool)
+	for i := p.r - 1; i >= 0; i-- {
+		p.cycles[i]--
+		if p.cycles[i] == 0 {
+			// Rotate indices[i:] left by one
+			first := p.indices[i]
+			copy(p.indices[i:], p.indices[i+1:])
+			p.indices[n-1] = first
+			p.cycles[i] = n - i
+		} else {
+			j := n - p.cycles[i]
+			p.indices[i], p.indices[j] = p.indices[j], p.indices[i]
+			return pick(p.pool, p.indices[:p.r]), nil
+		}
+	}
+	p.done = true
+	return nil, py.StopIteration
+}
+
+// A python combinations object
+type Combinations struct {
+	pool    py.Tuple
+	indices []int
+	started bool
+	done    bool
+}
+
+var CombinationsType = py.NewTypeX("combinations", `combinations(iterable, r) --> combinations object
+
+Return successive r-length combinations of elements in the iterable.
+
+combinations(range(4), 3) --> (0,1,2), (0,1,3), (0,2,3), (1,2,3)`, CombinationsNew, nil)
+
+// Type of this object
+func (c *Combinations) Type() *py.Type {
+	return CombinationsType
+}
+
+// Parses the arguments of the combinations types
+func parseCombinations(name string, args py.Tuple, kwargs py.StringDict) (pool py.Tuple, r int, err error) {
+	var iterable, rObj py.Object
+	err = py.ParseTupleAndKeywords(args, kwargs, "OO:"+name, []string{"iterable", "r"}, &iterable, &rObj)
+	if err != nil {
+		return nil, 0, err
+	}
+	pool, err = py.SequenceTuple(iterable)
+	if err != nil {
+		return nil, 0, err
+	}
+	r, err = readR(rObj, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+	return pool, r, nil
+}
+
+// CombinationsNew makes a combinations object
+func CombinationsNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	pool, r, err := parseCombinations("combinations", args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	c := &Combinations{pool: pool, indices: make([]int, r), done: r > len(pool)}
+	for i := range c.indices {
+		c.indices[i] = i
+	}
+	return c, nil
+}
+
+func (c *Combinations) M__iter__() (py.Object, error) {
+	return c, nil
+}
+
+func (c *Combinations) M__next__() (py.Object, error) {
+	if c.done {
+		return nil, py.StopIteration
+	}
+	if !c.started {
+		c.started = true
+		return pick(c.pool, c.indices), nil
+	}
+	n, r := len(c.pool), len(c.indices)
+	for i := r - 1; i >= 0; i-- {
+		if c.indices[i] != i+n-r {
+			c.indices[i]++
+			for j := i + 1; j < r; j++ {
+				c.indices[j] = c.indices[j-1] + 1
+			}
+			return pick(c.pool, c.indices), nil
+		}
+	}
+	c.done = true
+	return nil, py.StopIteration
+}
+
+// A python combinations_with_replacement object
+type CombinationsWithReplacement struct {
+	pool    py.Tuple
+	indices []int
+	started bool
+	done    bool
+}
+
+var CombinationsWithReplacementType = py.NewTypeX("combinations_with_replacement", `combinations_with_replacement(iterable, r) --> combinations_with_replacement object
+
+Return successive r-length combinations of elements in the iterable
+allowing individual elements to have successive repeats.
+combinations_with_replacement('ABC', 2) --> AA AB AC BB BC CC`, CombinationsWithReplacementNew, nil)
+
+// Type of this object
+func (c *CombinationsWithReplacement) Type() *py.Type {
+	return CombinationsWithReplacementType
+}
+
+// CombinationsWithReplacementNew makes a combinations_with_replacement object
+func CombinationsWithReplacementNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	pool, r, err := parseCombinations("combinations_with_replacement", args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	return &CombinationsWithReplacement{
+		pool:    pool,
+		indices: make([]int, r),
+		done:    len(pool) == 0 && r > 0,
+	}, nil
+}
+
+func (c *CombinationsWithReplacement) M__iter__() (py.Object, error) {
+	return c, nil
+}
+
+func (c *CombinationsWithReplacement) M__next__() (py.Object, error) {
+	if c.done {
+		return nil, py.StopIteration
+	}
+	if !c.started {
+		c.started = true
+		return pick(c.pool, c.indices), nil
+	}
+	n, r := len(c.pool), len(c.indices)
+	for i := r - 1; i >= 0; i-- {
+		if c.indices[i] != n-1 {
+			value := c.indices[i] + 1
+			for j := i; j < r; j++ {
+				c.indices[j] = value
+			}
+			return pick(c.pool, c.indices), nil
+		}
+	}
+	c.done = true
+	return nil, py.StopIteration
+}
+
+// Check interface is satisfied
+var _ py.I_iterator = (*Product)(nil)
+var _ py.I_iterator = (*Permutations)(nil)
+var _ py.I_iterator = (*Combinations)(nil)
+var _ py.I_iterator = (*CombinationsWithReplacement)(nil)
@@ -0,0 +1,210 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Infinite iterators
+
+package itertools
+
+import (
+	"fmt"
+
+	"github.com/go-python/gpython/py"
+)
+
+// A python count object
+type Count struct {
+	n    py.Object
+	step py.Object
+}
+
+var CountType = py.NewTypeX("count", `count(start=0, step=1) --> count object
+
+Return a count object whose .__next__() method returns consecutive values.
+Equivalent to:
+
+    def count(firstval=0, step=1):
+        x = firstval
+        while 1:
+            yield x
+            x += step`, CountNew, nil)
+
+// Type of this object
+func (c *Count) Type() *py.Type {
+	return CountType
+}
+
+// Returns true if o is a number count can add up
+func isNumber(o py.Object) bool {
+	switch o.(type) {
+	case py.Int, *py.BigInt, py.Float, py.Complex, py.Bool:
+		return true
+	}
+	return false
+}
+
+// CountNew makes a count object
+func CountNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var start py.Object = py.Int(0)
+	var step py.Object = py.Int(1)
+	err := py.ParseTupleAndKeywords(args, kwargs, "|OO:count", []string{"start", "step"}, &start, &step)
+	if err != nil {
+		return nil, err
+	}
+	if !isNumber(start) || !isNumber(step) {
+		return nil, py.ExceptionNewf(py.TypeError, "a number is required")
+	}
+	return &Count{n: start, step: step}, nil
+}
+
+func (c *Count) M__iter__() (py.Object, error) {
+	return c, nil
+}
+
+func (c *Count) M__next__() (py.Object, error) {
+	res := c.n
+	n, err := py.Add(c.n, c.step)
+	if err != nil {
+		return nil, err
+	}
+	c.n = n
+	return res, nil
+}
+
+func (c *Count) M__repr__() (py.Object, error) {
+	n, err := py.ReprAsString(c.n)
+	if err != nil {
+		return nil, err
+	}
+	if step, ok := c.step.(py.Int); ok && step == 1 {
+		return py.String(fmt.Sprintf("count(%s)", n)), nil
+	}
+	step, err := py.ReprAsString(c.step)
+	if err != nil {
+		return nil, err
+	}
+	return py.String(fmt.Sprintf("count(%s, %s)", n, step)), nil
+}
+
+// A python cycle object
+type Cycle struct {
+	it    py.Object // nil once it has been used up
+	saved []py.Object
+	i     int
+}
+
+var CycleType = py.NewTypeX("cycle", `cycle(iterable) --> cycle object
+
+Return elements from the iterable until it is exhausted.
+Then repeat the sequence indefinitely.`, CycleNew, nil)
+
+// Type of this object
+func (c *Cycle) Type() *py.Type {
+	return CycleType
+}
+
+// CycleNew makes a cycle object
+func CycleNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var iterable py.Object
+	err := py.UnpackTuple(args, kwargs, "cycle", 1, 1, &iterable)
+	if err != nil {
+		return nil, err
+	}
+	it, err := py.Iter(iterable)
+	if err != nil {
+		return nil, err
+	}
+	return &Cycle{it: it}, nil
+}
+
+func (c *Cycle) M__iter__() (py.Object, error) {
+	return c, nil
+}
+
+func (c *Cycle) M__next__() (py.Object, error) {
+	if c.it != nil {
+		item, err := py.Next(c.it)
+		if err == nil {
+			c.saved = append(c.saved, item)
+			return item, nil
+		}
+		if !isStop(err) {
+			return nil, err
+		}
+		c.it = nil
+	}
+	if len(c.saved) == 0 {
+		return nil, py.StopIteration
+	}
+	item := c.saved[c.i]
+	c.i = (c.i + 1) % len(c.saved)
+	return item, nil
+}
+
+// A python repeat object
+type Repeat struct {
+	object py.Object
+	times  int // number left or -1 for forever
+}
+
+var RepeatType = py.NewTypeX("repeat", `repeat(object [,times]) -> create an iterator which returns the object
+for the specified number of times.  If not specified, returns the object
+endlessly.`, RepeatNew, nil)
+
+// Type of this object
+func (r *Repeat) Type() *py.Type {
+	return RepeatType
+}
+
+// RepeatNew makes a repeat object
+func RepeatNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var object py.Object
+	var timesObj py.Object = py.None
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|O:repeat", []string{"object", "times"}, &object, &timesObj)
+	if err != nil {
+		return nil, err
+	}
+	times := -1
+	if timesObj != py.None {
+		times, err = py.IndexInt(timesObj)
+		if err != nil {
+			return nil, err
+		}
+		if times < 0 {
+			times = 0
+		}
+	}
+	return &Repeat{object: object, times: times}, nil
+}
+
+func (r *Repeat) M__iter__() (py.Object, error) {
+	return r, nil
+}
+
+func (r *Repeat) M__next__() (py.Object, error) {
+	if r.times == 0 {
+		return nil, py.StopIteration
+	}
+	if r.times > 0 {
+		r.times--
+	}
+	return r.object, nil
+}
+
+func (r *Repeat) M__repr__() (py.Object, error) {
+	object, err := py.ReprAsString(r.object)
+	if err != nil {
+		return nil, err
+	}
+	if r.times < 0 {
+		return py.String(fmt.Sprintf("repeat(%s)", object)), nil
+	}
+	return py.String(fmt.Sprintf("repeat(%s, %d)", object, r.times)), nil
+}
+
+// Check interface is satisfied
+var _ py.I_iterator = (*Count)(nil)
+var _ py.I__repr__ = (*Count)(nil)
+var _ py.I_iterator = (*Cycle)(nil)
+var _ py.I_iterator = (*Repeat)(nil)
+var _ py.I__repr__ = (*Repeat)(nil)
@@ -0,0 +1,93 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package itertools implements the python itertools module
+//
+// Each of the iterators is a Go type which works out its items
+// lazily as they are asked for, like py.Zip does.
+package itertools
+
+import (
+	"github.com/go-python/gpython/py"
+)
+
+const module_doc = `Functional tools for creating and using iterators.
+
+Infinite iterators:
+count(start=0, step=1) --> start, start+step, start+2*step, ...
+cycle(p) --> p0, p1, ... plast, p0, p1, ...
+repeat(elem [,n]) --> elem, elem, elem, ... endlessly or up to n times
+
+Iterators terminating on the shortest input sequence:
+accumulate(p[, func]) --> p0, p0+p1, p0+p1+p2
+chain(p, q, ...) --> p0, p1, ... plast, q0, q1, ...
+chain.from_iterable([p, q, ...]) --> p0, p1, ... plast, q0, q1, ...
+compress(data, selectors) --> (d[0] if s[0]), (d[1] if s[1]), ...
+dropwhile(pred, seq) --> seq[n], seq[n+1], starting when pred fails
+groupby(iterable[, keyfunc]) --> sub-iterators grouped by value of keyfunc(v)
+filterfalse(pred, seq) --> elements of seq where pred(elem) is False
+islice(seq, [start,] stop [, step]) --> elements from
+       seq[start:stop:step]
+starmap(fun, seq) --> fun(*seq[0]), fun(*seq[1]), ...
+tee(it, n=2) --> (it1, it2 , ... itn) splits one iterator into n
+takewhile(pred, seq) --> seq[0], seq[1], until pred fails
+zip_longest(p, q, ...) --> (p[0], q[0]), (p[1], q[1]), ...
+
+Combinatoric generators:
+product(p, q, ... [repeat=1]) --> cartesian product
+permutations(p[, r])
+combinations(p, r)
+combinations_with_replacement(p, r)
+`
+
+// Returns whether o is true
+func isTrue(o py.Object) (bool, error) {
+	res, err := py.MakeBool(o)
+	if err != nil {
+		return false, err
+	}
+	return res == py.True, nil
+}
+
+// Returns true if err is StopIteration
+func isStop(err error) bool {
+	return py.IsException(py.StopIteration, err)
+}
+
+// Returns the tuple of items of p at indices
+func pick(p py.Tuple, indices []int) py.Tuple {
+	res := make(py.Tuple, len(indices))
+	for i, j := range indices {
+		res[i] = p[j]
+	}
+	return res
+}
+
+func init() {
+	methods := []*py.Method{
+		py.MustNewMethod("tee", itertools_tee, 0, tee_doc),
+	}
+	globals := py.StringDict{
+		"count":                         CountType,
+		"cycle":                         CycleType,
+		"repeat":                        RepeatType,
+		"accumulate":                    AccumulateType,
+		"chain":                         ChainType,
+		"compress":                      CompressType,
+		"dropwhile":                     DropWhileType,
+		"takewhile":                     TakeWhileType,
+		"filterfalse":                   FilterFalseType,
+		"groupby":                       GroupByType,
+		"islice":                        ISliceType,
+		"starmap":                       StarMapType,
+		"zip_longest":                   ZipLongestType,
+		"product":                       ProductType,
+		"permutations":                  PermutationsType,
+		"combinations":                  CombinationsType,
+		"combinations_with_replacement": CombinationsWithReplacementType,
+		"_tee":                          TeeType,
+		"_grouper":                      GrouperType,
+	}
+	py.NewModule("itertools", module_doc, methods, globals)
+}
@@ -0,0 +1,829 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Iterators terminating on the shortest input sequence
+
+package itertools
+
+import (
+	"github.com/go-python/gpython/py"
+)
+
+// A python accumulate object
+type Accumulate struct {
+	it      py.Object
+	fn      py.Object // None to add
+	total   py.Object // nil before the first item
+	initial py.Object // nil once returned
+}
+
+var AccumulateType = py.NewTypeX("accumulate", `accumulate(iterable[, func, *, initial=None]) --> accumulate object
+
+Return series of accumulated sums (or other binary function results).`, AccumulateNew, nil)
+
+// Type of this object
+func (a *Accumulate) Type() *py.Type {
+	return AccumulateType
+}
+
+// AccumulateNew makes an accumulate object
+func AccumulateNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var iterable py.Object
+	var fn py.Object = py.None
+	var initial py.Object = py.None
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|OO:accumulate", []string{"iterable", "func", "initial"}, &iterable, &fn, &initial)
+	if err != nil {
+		return nil, err
+	}
+	if len(args) > 2 {
+		return nil, py.ExceptionNewf(py.TypeError, "accumulate() takes at most 2 positional arguments (%d given)", len(args))
+	}
+	it, err := py.Iter(iterable)
+	if err != nil {
+		return nil, err
+	}
+	a := &Accumulate{it: it, fn: fn}
+	if initial != py.None {
+		a.initial = initial
+	}
+	return a, nil
+}
+
+func (a *Accumulate) M__iter__() (py.Object, error) {
+	return a, nil
+}
+
+func (a *Accumulate) M__next__() (py.Object, error) {
+	if a.initial != nil {
+		a.total, a.initial = a.initial, nil
+		return a.total, nil
+	}
+	item, err := py.Next(a.it)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case a.total == nil:
+		a.total = item
+	case a.fn == py.None:
+		a.total, err = py.Add(a.total, item)
+	default:
+		a.total, err = py.Call(a.fn, py.Tuple{a.total, item}, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return a.total, nil
+}
+
+// A python chain object
+type Chain struct {
+	source py.Object // iterator of iterables, nil when used up
+	active py.Object // iterator of the current iterable or nil
+}
+
+var ChainType = py.NewTypeX("chain", `chain(*iterables) --> chain object
+
+Return a chain object whose .__next__() method returns elements from the
+first iterable until it is exhausted, then elements from the next
+iterable, until all of the iterables are exhausted.`, ChainNew, nil)
+
+// Type of this object
+func (c *Chain) Type() *py.Type {
+	return ChainType
+}
+
+// ChainNew makes a chain object
+func ChainNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	if len(kwargs) != 0 {
+		return nil, py.ExceptionNewf(py.TypeError, "chain() does not take keyword arguments")
+	}
+	return &Chain{source: py.NewIterator(args.Copy())}, nil
+}
+
+func (c *Chain) M__iter__() (py.Object, error) {
+	return c, nil
+}
+
+func (c *Chain) M__next__() (py.Object, error) {
+	for c.source != nil {
+		if c.active == nil {
+			iterable, err := py.Next(c.source)
+			if err != nil {
+				if isStop(err) {
+					c.source = nil
+				}
+				return nil, err
+			}
+			c.active, err = py.Iter(iterable)
+			if err != nil {
+				return nil, err
+			}
+		}
+		item, err := py.Next(c.active)
+		if err == nil || !isStop(err) {
+			return item, err
+		}
+		c.active = nil
+	}
+	return nil, py.StopIteration
+}
+
+// A python compress object
+type Compress struct {
+	data      py.Object
+	selectors py.Object
+}
+
+var CompressType = py.NewTypeX("compress", `compress(data, selectors) --> iterator over selected data
+
+Return data elements corresponding to true selector elements.
+Forms a shorter iterator from selected data elements using the
+selectors to choose the data elements.`, CompressNew, nil)
+
+// Type of this object
+func (c *Compress) Type() *py.Type {
+	return CompressType
+}
+
+// CompressNew makes a compress object
+func CompressNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var data, selectors py.Object
+	err := py.ParseTupleAndKeywords(args, kwargs, "OO:compress", []string{"data", "selectors"}, &data, &selectors)
+	if err != nil {
+		return nil, err
+	}
+	c := &Compress{}
+	c.data, err = py.Iter(data)
+	if err != nil {
+		return nil, err
+	}
+	c.selectors, err = py.Iter(selectors)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Compress) M__iter__() (py.Object, error) {
+	return c, nil
+}
+
+func (c *Compress) M__next__() (py.Object, error) {
+	for {
+		item, err := py.Next(c.data)
+		if err != nil {
+			return nil, err
+		}
+		selector, err := py.Next(c.selectors)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := isTrue(selector)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return item, nil
+		}
+	}
+}
+
+// Makes a new predicate iterator from (predicate, iterable) for name
+func newPredicate(name string, args py.Tuple, kwargs py.StringDict) (predicate, it py.Object, err error) {
+	var iterable py.Object
+	err = py.UnpackTuple(args, kwargs, name, 2, 2, &predicate, &iterable)
+	if err != nil {
+		return nil, nil, err
+	}
+	it, err = py.Iter(iterable)
+	if err != nil {
+		return nil, nil, err
+	}
+	return predicate, it, nil
+}
+
+// Calls predicate on item returning whether it is true
+//
+// A None predicate returns the truth of the item
+func callPredicate(predicate, item py.Object) (bool, error) {
+	if predicate != py.None {
+		var err error
+		item, err = py.Call(predicate, py.Tuple{item}, nil)
+		if err != nil {
+			return false, err
+		}
+	}
+	return isTrue(item)
+}
+
+// A python dropwhile object
+type DropWhile struct {
+	predicate py.Object
+	it        py.Object
+	dropped   bool
+}
+
+var DropWhileType = py.NewTypeX("dropwhile", `dropwhile(predicate, iterable) --> dropwhile object
+
+Drop items from the iterable while predicate(item) is true.
+Afterwards, return every element until the iterable is exhausted.`, DropWhileNew, nil)
+
+// Type of this object
+func (d *DropWhile) Type() *py.Type {
+	return DropWhileType
+}
+
+// DropWhileNew makes a dropwhile object
+func DropWhileNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	predicate, it, err := newPredicate("dropwhile", args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	return &DropWhile{predicate: predicate, it: it}, nil
+}
+
+func (d *DropWhile) M__iter__() (py.Object, error) {
+	return d, nil
+}
+
+func (d *DropWhile) M__next__() (py.Object, error) {
+	for {
+		item, err := py.Next(d.it)
+		if err != nil || d.dropped {
+			return item, err
+		}
+		ok, err := callPredicate(d.predicate, item)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			d.dropped = true
+			return item, nil
+		}
+	}
+}
+
+// A python takewhile object
+type TakeWhile struct {
+	predicate py.Object
+	it        py.Object
+	stopped   bool
+}
+
+var TakeWhileType = py.NewTypeX("takewhile", `takewhile(predicate, iterable) --> takewhile object
+
+Return successive entries from an iterable as long as the
+predicate evaluates to true for each entry.`, TakeWhileNew, nil)
+
+// Type of this object
+func (t *TakeWhile) Type() *py.Type {
+	return TakeWhileType
+}
+
+// TakeWhileNew makes a takewhile object
+func TakeWhileNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	predicate, it, err := newPredicate("takewhile", args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	return &TakeWhile{predicate: predicate, it: it}, nil
+}
+
+func (t *TakeWhile) M__iter__() (py.Object, error) {
+	return t, nil
+}
+
+func (t *TakeWhile) M__next__() (py.Object, error) {
+	if t.stopped {
+		return nil, py.StopIteration
+	}
+	item, err := py.Next(t.it)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := callPredicate(t.predicate, item)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		t.stopped = true
+		return nil, py.StopIteration
+	}
+	return item, nil
+}
+
+// A python filterfalse object
+type FilterFalse struct {
+	predicate py.Object
+	it        py.Object
+}
+
+var FilterFalseType = py.NewTypeX("filterfalse", `filterfalse(function or None, sequence) --> filterfalse object
+
+Return those items of sequence for which function(item) is false.
+If function is None, return the items that are false.`, FilterFalseNew, nil)
+
+// Type of this object
+func (f *FilterFalse) Type() *py.Type {
+	return FilterFalseType
+}
+
+// FilterFalseNew makes a filterfalse object
+func FilterFalseNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	predicate, it, err := newPredicate("filterfalse", args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	return &FilterFalse{predicate: predicate, it: it}, nil
+}
+
+func (f *FilterFalse) M__iter__() (py.Object, error) {
+	return f, nil
+}
+
+func (f *FilterFalse) M__next__() (py.Object, error) {
+	for {
+		item, err := py.Next(f.it)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := callPredicate(f.predicate, item)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return item, nil
+		}
+	}
+}
+
+// A python groupby object
+type GroupBy struct {
+	it        py.Object
+	keyfunc   py.Object
+	tgtkey    py.Object
+	currkey   py.Object
+	currvalue py.Object
+	grouper   *Grouper // the only grouper which may return items
+}
+
+var GroupByType = py.NewTypeX("groupby", `groupby(iterable, key=None) -> make an iterator that returns consecutive
+keys and groups from the iterable.  If the key function is not specified or
+is None, the element itself is used for grouping.`, GroupByNew, nil)
+
+// Type of this object
+func (g *GroupBy) Type() *py.Type {
+	return GroupByType
+}
+
+// GroupByNew makes a groupby object
+func GroupByNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var iterable py.Object
+	var keyfunc py.Object = py.None
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|O:groupby", []string{"iterable", "key"}, &iterable, &keyfunc)
+	if err != nil {
+		return nil, err
+	}
+	it, err := py.Iter(iterable)
+	if err != nil {
+		return nil, err
+	}
+	return &GroupBy{it: it, keyfunc: keyfunc}, nil
+}
+
+// Reads the next value and its key
+func (g *GroupBy) step() error {
+	value, err := py.Next(g.it)
+	if err != nil {
+		return err
+	}
+	key := value
+	if g.keyfunc != py.None {
+		key, err = py.Call(g.keyfunc, py.Tuple{value}, nil)
+		if err != nil {
+			return err
+		}
+	}
+	g.currvalue = value
+	g.currkey = key
+	return nil
+}
+
+func (g *GroupBy) M__iter__() (py.Object, error) {
+	return g, nil
+}
+
+func (g *GroupBy) M__next__() (py.Object, error) {
+	g.grouper = nil
+	// Skip to the start of the next group
+	for {
+		if g.currkey != nil {
+			if g.tgtkey == nil {
+				break
+			}
+			eq, err := py.Eq(g.tgtkey, g.currkey)
+			if err != nil {
+				return nil, err
+			}
+			if eq != py.True {
+				break
+			}
+		}
+		err := g.step()
+		if err != nil {
+			return nil, err
+		}
+	}
+	g.tgtkey = g.currkey
+	g.grouper = &Grouper{parent: g, tgtkey: g.tgtkey}
+	return py.Tuple{g.currkey, g.grouper}, nil
+}
+
+// A python _grouper object which returns the items of one group
+type Grouper struct {
+	parent *GroupBy
+	tgtkey py.Object
+}
+
+var GrouperType = py.NewType("_grouper", "")
+
+// Type of this object
+func (g *Grouper) Type() *py.Type {
+	return GrouperType
+}
+
+func (g *Grouper) M__iter__() (py.Object, error) {
+	return g, nil
+}
+
+func (g *Grouper) M__next__() (py.Object, error) {
+	parent := g.parent
+	if parent.grouper != g {
+		return nil, py.StopIteration
+	}
+	if parent.currvalue == nil {
+		err := parent.step()
+		if err != nil {
+			return nil, err
+		}
+	}
+	eq, err := py.Eq(g.tgtkey, parent.currkey)
+	if err != nil {
+		return nil, err
+	}
+	if eq != py.True {
+		return nil, py.StopIteration
+	}
+	value := parent.currvalue
+	parent.currvalue = nil
+	return value, nil
+}
+
+// A python islice object
+type ISlice struct {
+	it   py.Object // nil when used up
+	next int
+	stop int // -1 for no stop
+	step int
+	cnt  int
+}
+
+var ISliceType = py.NewTypeX("islice", `islice(iterable, stop) --> islice object
+islice(iterable, start, stop[, step]) --> islice object
+
+Return an iterator whose next() method returns selected values from an
+iterable.  If start is specified, will skip all preceding elements;
+otherwise, start defaults to zero.  Step defaults to one.  If
+specified as another value, step determines how many values are
+skipped between successive calls.  Works like a slice() on a list
+but returns an iterator.`, ISliceNew, nil)
+
+// Type of this object
+func (s *ISlice) Type() *py.Type {
+	return ISliceType
+}
+
+// Reads an islice argument which is None (def) or a non-negative int
+func isliceArg(o py.Object, def int, msg string) (int, error) {
+	if o == py.None {
+		return def, nil
+	}
+	n, err := py.IndexInt(o)
+	if err != nil || n < 0 {
+		return 0, py.ExceptionNewf(py.ValueError, "%s", msg)
+	}
+	return n, nil
+}
+
+// ISliceNew makes an islice object
+func ISliceNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var iterable, a1 py.Object
+	var a2, a3 py.Object = py.None, py.None
+	err := py.UnpackTuple(args, kwargs, "islice", 2, 4, &iterable, &a1, &a2, &a3)
+	if err != nil {
+		return nil, err
+	}
+	s := &ISlice{step: 1}
+	if len(args) == 2 {
+		s.stop, err = isliceArg(a1, -1, "Stop argument for islice() must be None or an integer: 0 <= x <= sys.maxsize.")
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		s.stop, err = isliceArg(a2, -1, "Stop argument for islice() must be None or an integer: 0 <= x <= sys.maxsize.")
+		if err != nil {
+			return nil, err
+		}
+		s.next, err = isliceArg(a1, 0, "Indices for islice() must be None or an integer: 0 <= x <= sys.maxsize.")
+		if err != nil {
+			return nil, err
+		}
+		s.step, err = isliceArg(a3, 1, "Step for islice() must be a positive integer or None.")
+		if err != nil || s.step == 0 {
+			return nil, py.ExceptionNewf(py.ValueError, "Step for islice() must be a positive integer or None.")
+		}
+	}
+	s.it, err = py.Iter(iterable)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *ISlice) M__iter__() (py.Object, error) {
+	return s, nil
+}
+
+func (s *ISlice) M__next__() (py.Object, error) {
+	if s.it == nil {
+		return nil, py.StopIteration
+	}
+	for s.cnt < s.next {
+		_, err := py.Next(s.it)
+		if err != nil {
+			if isStop(err) {
+				s.it = nil
+			}
+			return nil, err
+		}
+		s.cnt++
+	}
+	if s.stop >= 0 && s.cnt >= s.stop {
+		s.it = nil
+		return nil, py.StopIteration
+	}
+	item, err := py.Next(s.it)
+	if err != nil {
+		if isStop(err) {
+			s.it = nil
+		}
+		return nil, err
+	}
+	s.cnt++
+	s.next += s.step
+	if s.stop >= 0 && s.next > s.stop {
+		s.next = s.stop
+	}
+	return item, nil
+}
+
+// A python starmap object
+type StarMap struct {
+	fn py.Object
+	it py.Object
+}
+
+var StarMapType = py.NewTypeX("starmap", `starmap(function, sequence) --> starmap object
+
+Return an iterator whose values are returned from the function evaluated
+with an argument tuple taken from the given sequence.`, StarMapNew, nil)
+
+// Type of this object
+func (s *StarMap) Type() *py.Type {
+	return StarMapType
+}
+
+// StarMapNew makes a starmap object
+func StarMapNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	fn, it, err := newPredicate("starmap", args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	return &StarMap{fn: fn, it: it}, nil
+}
+
+func (s *StarMap) M__iter__() (py.Object, error) {
+	return s, nil
+}
+
+func (s *StarMap) M__next__() (py.Object, error) {
+	item, err := py.Next(s.it)
+	if err != nil {
+		return nil, err
+	}
+	args, err := py.SequenceTuple(item)
+	if err != nil {
+		return nil, err
+	}
+	return py.Call(s.fn, args, nil)
+}
+
+// An item read from the iterator shared by tee objects
+type teeLink struct {
+	value py.Object
+	next  *teeLink // nil if not read yet
+}
+
+// A python _tee object
+//
+// The tee objects made from one iterator share a linked list of the
+// items read from it.  Each points to the last item it returned so
+// the items nothing needs any more can be garbage collected.
+type Tee struct {
+	it   py.Object
+	link *teeLink
+}
+
+var TeeType = py.NewTypeX("_tee", "Iterator wrapped to make it copyable", TeeNew, nil)
+
+// Type of this object
+func (t *Tee) Type() *py.Type {
+	return TeeType
+}
+
+// Makes a tee object reading from iterable
+func newTee(iterable py.Object) (*Tee, error) {
+	if t, ok := iterable.(*Tee); ok {
+		return t.copy(), nil
+	}
+	it, err := py.Iter(iterable)
+	if err != nil {
+		return nil, err
+	}
+	return &Tee{it: it, link: &teeLink{}}, nil
+}
+
+// TeeNew makes a tee object
+func TeeNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var iterable py.Object
+	err := py.UnpackTuple(args, kwargs, "_tee", 1, 1, &iterable)
+	if err != nil {
+		return nil, err
+	}
+	return newTee(iterable)
+}
+
+// Returns a tee object at the same position as t
+func (t *Tee) copy() *Tee {
+	return &Tee{it: t.it, link: t.link}
+}
+
+func (t *Tee) M__iter__() (py.Object, error) {
+	return t, nil
+}
+
+func (t *Tee) M__next__() (py.Object, error) {
+	if t.link.next == nil {
+		value, err := py.Next(t.it)
+		if err != nil {
+			return nil, err
+		}
+		t.link.next = &teeLink{value: value}
+	}
+	t.link = t.link.next
+	return t.link.value, nil
+}
+
+const tee_doc = `tee(iterable, n=2) --> tuple of n independent iterators.`
+
+func itertools_tee(self py.Object, args py.Tuple) (py.Object, error) {
+	var iterable py.Object
+	var nObj py.Object = py.Int(2)
+	err := py.UnpackTuple(args, nil, "tee", 1, 2, &iterable, &nObj)
+	if err != nil {
+		return nil, err
+	}
+	n, err := py.IndexInt(nObj)
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 {
+		return nil, py.ExceptionNewf(py.ValueError, "n must be >= 0")
+	}
+	res := make(py.Tuple, n)
+	if n == 0 {
+		return res, nil
+	}
+	t, err := newTee(iterable)
+	if err != nil {
+		return nil, err
+	}
+	res[0] = t
+	for i := 1; i < n; i++ {
+		res[i] = t.copy()
+	}
+	return res, nil
+}
+
+// A python zip_longest object
+type ZipLongest struct {
+	its       []py.Object // nil for used up iterators
+	active    int
+	fillvalue py.Object
+}
+
+var ZipLongestType = py.NewTypeX("zip_longest", `zip_longest(iter1 [,iter2 [...]], [fillvalue=None]) --> zip_longest object
+
+Return a zip_longest object whose .__next__() method returns a tuple where
+the i-th element comes from the i-th iterable argument.  The .__next__()
+method continues until the longest iterable in the argument sequence
+is exhausted and then it raises StopIteration.  When the shorter iterables
+are exhausted, the fillvalue is substituted in their place.  The fillvalue
+defaults to None or can be specified by a keyword argument.`, ZipLongestNew, nil)
+
+// Type of this object
+func (z *ZipLongest) Type() *py.Type {
+	return ZipLongestType
+}
+
+// ZipLongestNew makes a zip_longest object
+func ZipLongestNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	z := &ZipLongest{its: make([]py.Object, len(args)), active: len(args), fillvalue: py.None}
+	for name, value := range kwargs {
+		if name != "fillvalue" {
+			return nil, py.ExceptionNewf(py.TypeError, "zip_longest() got an unexpected keyword argument '%s'", name)
+		}
+		z.fillvalue = value
+	}
+	for i, iterable := range args {
+		it, err := py.Iter(iterable)
+		if err != nil {
+			return nil, py.ExceptionNewf(py.TypeError, "zip_longest argument #%d must support iteration", i+1)
+		}
+		z.its[i] = it
+	}
+	return z, nil
+}
+
+func (z *ZipLongest) M__iter__() (py.Object, error) {
+	return z, nil
+}
+
+func (z *ZipLongest) M__next__() (py.Object, error) {
+	if z.active == 0 {
+		return nil, py.StopIteration
+	}
+	res := make(py.Tuple, len(z.its))
+	for i, it := range z.its {
+		if it == nil {
+			res[i] = z.fillvalue
+			continue
+		}
+		item, err := py.Next(it)
+		if err != nil {
+			if !isStop(err) {
+				return nil, err
+			}
+			z.its[i] = nil
+			z.active--
+			if z.active == 0 {
+				return nil, py.StopIteration
+			}
+			item = z.fillvalue
+		}
+		res[i] = item
+	}
+	return res, nil
+}
+
+func init() {
+	ChainType.Dict["from_iterable"] = py.MustNewMethod("from_iterable", func(self, iterable py.Object) (py.Object, error) {
+		source, err := py.Iter(iterable)
+		if err != nil {
+			return nil, err
+		}
+		return &Chain{source: source}, nil
+	}, 0, `chain.from_iterable(iterable) --> chain object
+
+Alternate chain() constructor taking a single iterable argument
+that evaluates lazily.`)
+	TeeType.Dict["__copy__"] = py.MustNewMethod("__copy__", func(self py.Object) (py.Object, error) {
+		return self.(*Tee).copy(), nil
+	}, 0, "Returns an independent iterator.")
+}
+
+// Check interface is satisfied
+var _ py.I_iterator = (*Accumulate)(nil)
+var _ py.I_iterator = (*Chain)(nil)
+var _ py.I_iterator = (*Compress)(nil)
+var _ py.I_iterator = (*DropWhile)(nil)
+var _ py.I_iterator = (*TakeWhile)(nil)
+var _ py.I_iterator = (*FilterFalse)(nil)
+var _ py.I_iterator = (*GroupBy)(nil)
+var _ py.I_iterator = (*Grouper)(nil)
+var _ py.I_iterator = (*ISlice)(nil)
+var _ py.I_iterator = (*StarMap)(nil)
+var _ py.I_iterator = (*Tee)(nil)
+var _ py.I_iterator = (*ZipLongest)(nil)
@@ -0,0 +1,128 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+from itertools import *
+from libtest import assertRaises, assertRaisesText
+
+doc = "count"
+assert list(islice(count(), 4)) == [0, 1, 2, 3]
+assert list(islice(count(10, -2), 3)) == [10, 8, 6]
+assert list(islice(count(step=0.5), 3)) == [0, 0.5, 1.0]
+assert repr(count(3)) == "count(3)" and repr(count(1, 2)) == "count(1, 2)"
+assertRaisesText(TypeError, "a number is required", count, "a")
+
+doc = "cycle"
+assert list(islice(cycle("ab"), 5)) == ["a", "b", "a", "b", "a"]
+assert list(cycle([])) == []
+
+doc = "repeat"
+assert list(repeat(1, 3)) == [1, 1, 1]
+assert list(repeat(1, -1)) == []
+assert list(islice(repeat("x"), 2)) == ["x", "x"]
+assert repr(repeat("a", 2)) == "repeat('a', 2)" and repr(repeat(1)) == "repeat(1)"
+
+doc = "accumulate"
+assert list(accumulate([1, 2, 3, 4])) == [1, 3, 6, 10]
+assert list(accumulate([1, 2, 3], lambda a, b: a * b)) == [1, 2, 6]
+assert list(accumulate([1, 2], initial=10)) == [10, 11, 13]
+assert list(accumulate([], initial=5)) == [5]
+assert list(accumulate([])) == []
+
+doc = "chain"
+assert list(chain("ab", [1], ())) == ["a", "b", 1]
+assert list(chain.from_iterable(["ab", "c"])) == ["a", "b", "c"]
+assert list(chain()) == []
+assertRaises(TypeError, list, chain([1], 2))
+
+doc = "compress"
+assert list(compress("abcdef", [1, 0, 1, 0, 1])) == ["a", "c", "e"]
+
+doc = "dropwhile and takewhile"
+assert list(dropwhile(lambda x: x < 3, [1, 2, 3, 1])) == [3, 1]
+assert list(takewhile(lambda x: x < 3, [1, 2, 3, 1])) == [1, 2]
+
+doc = "filterfalse"
+assert list(filterfalse(lambda x: x % 2, range(6))) == [0, 2, 4]
+assert list(filterfalse(None, [0, 1, "", "a"])) == [0, ""]
+
+doc = "groupby"
+groups = []
+for k, g in groupby("aabbbca"):
+    groups.append((k, list(g)))
+assert groups == [("a", ["a", "a"]), ("b", ["b", "b", "b"]), ("c", ["c"]), ("a", ["a"])]
+groups = []
+for k, g in groupby([1, 2, 3, 4, 5], key=lambda x: x // 2):
+    groups.append((k, list(g)))
+assert groups == [(0, [1]), (1, [2, 3]), (2, [4, 5])]
+it = groupby("aab")
+k1, g1 = next(it)
+k2, g2 = next(it)
+assert list(g1) == [] and list(g2) == ["b"]
+assert list(groupby([])) == []
+
+doc = "islice"
+assert list(islice("abcdefg", 2)) == ["a", "b"]
+assert list(islice("abcdefg", 2, 4)) == ["c", "d"]
+assert list(islice("abcdefg", 2, None)) == ["c", "d", "e", "f", "g"]
+assert list(islice("abcdefg", 0, None, 2)) == ["a", "c", "e", "g"]
+assert list(islice("ab", None)) == ["a", "b"]
+it = iter(range(10))
+assert list(islice(it, 3)) == [0, 1, 2] and next(it) == 3
+assertRaisesText(ValueError, "Stop argument for islice()", islice, "a", -1)
+assertRaisesText(ValueError, "Indices for islice()", islice, "a", -1, 2)
+assertRaisesText(ValueError, "Step for islice()", islice, "a", 0, 2, 0)
+
+doc = "starmap"
+assert list(starmap(pow, [(2, 5), (3, 2)])) == [32, 9]
+
+doc = "tee"
+a, b = tee(iter([1, 2, 3]))
+assert next(a) == 1
+assert list(b) == [1, 2, 3] and list(a) == [2, 3]
+a, b, c = tee("ab", 3)
+assert list(c) == ["a", "b"] and list(a) == ["a", "b"]
+d = b.__copy__()
+assert next(b) == "a" and list(d) == ["a", "b"]
+assert tee("a", 0) == ()
+assertRaisesText(ValueError, "n must be >= 0", tee, "a", -1)
+
+doc = "zip_longest"
+assert list(zip_longest("ab", "c")) == [("a", "c"), ("b", None)]
+assert list(zip_longest("a", "bcd", fillvalue="-")) == [("a", "b"), ("-", "c"), ("-", "d")]
+assert list(zip_longest()) == []
+assertRaisesText(TypeError, "unexpected keyword argument", zip_longest, "a", fill=1)
+
+doc = "product"
+assert list(product("ab", range(2))) == [("a", 0), ("a", 1), ("b", 0), ("b", 1)]
+assert list(product(range(2), repeat=2)) == [(0, 0), (0, 1), (1, 0), (1, 1)]
+assert list(product()) == [()]
+assert list(product("ab", [])) == []
+assertRaisesText(ValueError, "repeat argument cannot be negative", product, "a", repeat=-1)
+
+doc = "permutations"
+assert list(permutations(range(3), 2)) == [(0, 1), (0, 2), (1, 0), (1, 2), (2, 0), (2, 1)]
+assert len(list(permutations("abcd"))) == 24
+assert list(permutations("ab", 3)) == []
+assert list(permutations("ab", 0)) == [()]
+
+doc = "combinations"
+assert list(combinations("abcd", 2)) == [("a", "b"), ("a", "c"), ("a", "d"), ("b", "c"), ("b", "d"), ("c", "d")]
+assert list(combinations(range(4), 3)) == [(0, 1, 2), (0, 1, 3), (0, 2, 3), (1, 2, 3)]
+assert list(combinations("ab", 3)) == []
+assert list(combinations("ab", 0)) == [()]
+assertRaisesText(ValueError, "r must be non-negative", combinations, "ab", -1)
+assert list(combinations_with_replacement("abc", 2)) == [("a", "a"), ("a", "b"), ("a", "c"), ("b", "b"), ("b", "c"), ("c", "c")]
+assert list(combinations_with_replacement("", 2)) == []
+assert list(combinations_with_replacement("", 0)) == [()]
+
+doc = "lazy"
+def numbers():
+    n = 0
+    while True:
+        yield n
+        n += 1
+assert list(islice(filterfalse(lambda x: x % 3, numbers()), 3)) == [0, 3, 6]
+assert next(zip_longest(numbers(), "a")) == (0, "a")
+
+doc = "finished"
@@ -0,0 +1,369 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// JSON decoder
+
+package json
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/go-python/gpython/py"
+)
+
+// decoder reads JSON into python objects
+//
+// It works on the bytes of the document and only works out the
+// character positions python uses when it raises an error.
+type decoder struct {
+	objectHook      py.Object
+	parseFloat      py.Object
+	parseInt        py.Object
+	parseConstant   py.Object
+	objectPairsHook py.Object
+	strict          bool
+	s               string // the document
+}
+
+// Makes a JSONDecodeError for msg at byte offset pos of the document
+func (d *decoder) error(msg string, pos int) error {
+	before := d.s[:pos]
+	charPos := utf8.RuneCountInString(before)
+	lineno := strings.Count(before, "\n") + 1
+	colno := charPos + 1
+	if i := strings.LastIndexByte(before, '\n'); i >= 0 {
+		colno = utf8.RuneCountInString(before[i+1:]) + 1
+	}
+	e := py.ExceptionNewf(JSONDecodeError, "%s: line %d column %d (char %d)", msg, lineno, colno, charPos)
+	e.Dict["msg"] = py.String(msg)
+	e.Dict["doc"] = py.String(d.s)
+	e.Dict["pos"] = py.Int(charPos)
+	e.Dict["lineno"] = py.Int(lineno)
+	e.Dict["colno"] = py.Int(colno)
+	return e
+}
+
+// Decodes the JSON document obj which is a str or utf-8 bytes
+func (d *decoder) decodeObject(obj py.Object) (py.Object, error) {
+	switch x := obj.(type) {
+	case py.String:
+		d.s = string(x)
+		if strings.HasPrefix(d.s, "\ufeff") {
+			return nil, d.error("Unexpected UTF-8 BOM (decode using utf-8-sig)", 0)
+		}
+	case py.Bytes:
+		d.s = strings.TrimPrefix(string(x), "\ufeff")
+	default:
+		return nil, py.ExceptionNewf(py.TypeError, "the JSON object must be str, bytes or bytearray, not %s", obj.Type().Name)
+	}
+	value, pos, err := d.value(d.skipSpace(0))
+	if err != nil {
+		return nil, err
+	}
+	pos = d.skipSpace(pos)
+	if pos != len(d.s) {
+		return nil, d.error("Extra data", pos)
+	}
+	return value, nil
+}
+
+// Returns the position of the first non whitespace character at or
+// after pos
+func (d *decoder) skipSpace(pos int) int {
+	for pos < len(d.s) {
+		switch d.s[pos] {
+		case ' ', '\t', '\n', '\r':
+			pos++
+		default:
+			return pos
+		}
+	}
+	return pos
+}
+
+// Returns true if the document has word at pos
+func (d *decoder) hasWord(pos int, word string) bool {
+	return strings.HasPrefix(d.s[pos:], word)
+}
+
+// Decodes the value at pos returning it and the position after it
+func (d *decoder) value(pos int) (py.Object, int, error) {
+	if pos >= len(d.s) {
+		return nil, pos, d.error("Expecting value", pos)
+	}
+	switch d.s[pos] {
+	case '"':
+		s, end, err := d.string(pos + 1)
+		if err != nil {
+			return nil, pos, err
+		}
+		return py.String(s), end, nil
+	case '{':
+		return d.object(pos + 1)
+	case '[':
+		return d.array(pos + 1)
+	case 'n':
+		if d.hasWord(pos, "null") {
+			return py.None, pos + 4, nil
+		}
+	case 't':
+		if d.hasWord(pos, "true") {
+			return py.True, pos + 4, nil
+		}
+	case 'f':
+		if d.hasWord(pos, "false") {
+			return py.False, pos + 5, nil
+		}
+	case 'N':
+		if d.hasWord(pos, "NaN") {
+			return d.constant("NaN", pos)
+		}
+	case 'I':
+		if d.hasWord(pos, "Infinity") {
+			return d.constant("Infinity", pos)
+		}
+	case '-':
+		if d.hasWord(pos, "-Infinity") {
+			return d.constant("-Infinity", pos)
+		}
+	}
+	return d.number(pos)
+}
+
+// Decodes the constant NaN, Infinity or -Infinity at pos
+func (d *decoder) constant(name string, pos int) (py.Object, int, error) {
+	end := pos + len(name)
+	if d.parseConstant != py.None {
+		res, err := py.Call(d.parseConstant, py.Tuple{py.String(name)}, nil)
+		return res, end, err
+	}
+	res, err := py.FloatFromString(strings.Replace(name, "Infinity", "inf", 1))
+	return res, end, err
+}
+
+// Returns true if c is an ascii digit
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// Decodes the number at pos
+//
+// Ints which are too big for an Int become BigInts.
+func (d *decoder) number(pos int) (py.Object, int, error) {
+	s := d.s
+	i := pos
+	if i < len(s) && s[i] == '-' {
+		i++
+	}
+	switch {
+	case i < len(s) && s[i] == '0':
+		i++
+	case i < len(s) && '1' <= s[i] && s[i] <= '9':
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+	default:
+		return nil, pos, d.error("Expecting value", pos)
+	}
+	isFloat := false
+	if i+1 < len(s) && s[i] == '.' && isDigit(s[i+1]) {
+		i += 2
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+		isFloat = true
+	}
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j := i + 1
+		if j < len(s) && (s[j] == '+' || s[j] == '-') {
+			j++
+		}
+		if j < len(s) && isDigit(s[j]) {
+			for j < len(s) && isDigit(s[j]) {
+				j++
+			}
+			i = j
+			isFloat = true
+		}
+	}
+	num := s[pos:i]
+	if isFloat {
+		if d.parseFloat != py.None {
+			res, err := py.Call(d.parseFloat, py.Tuple{py.String(num)}, nil)
+			return res, i, err
+		}
+		// Out of range numbers give ±Inf or 0 as python does
+		f, _ := strconv.ParseFloat(num, 64)
+		return py.Float(f), i, nil
+	}
+	if d.parseInt != py.None {
+		res, err := py.Call(d.parseInt, py.Tuple{py.String(num)}, nil)
+		return res, i, err
+	}
+	if n, err := strconv.ParseInt(num, 10, 64); err == nil {
+		return py.Int(n), i, nil
+	}
+	res, err := py.IntFromString(num, 10)
+	return res, i, err
+}
+
+// Reads the 4 hex digits of a \u escape at pos
+func (d *decoder) hex4(pos int) (rune, bool) {
+	if pos+4 > len(d.s) {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(d.s[pos:pos+4], 16, 16)
+	if err != nil {
+		return 0, false
+	}
+	return rune(n), true
+}
+
+// Decodes the string whose contents start at pos, just after the
+// opening quote, returning it and the position after the closing
+// quote
+//
+// Lone surrogates become U+FFFD as Go strings can't hold them.
+func (d *decoder) string(pos int) (string, int, error) {
+	s := d.s
+	begin := pos - 1
+	var b strings.Builder
+	for {
+		i := pos
+		for i < len(s) && s[i] != '"' && s[i] != '\\' && s[i] >= 0x20 {
+			i++
+		}
+		if i >= len(s) {
+			return "", begin, d.error("Unterminated string starting at", begin)
+		}
+		b.WriteString(s[pos:i])
+		c := s[i]
+		if c == '"' {
+			return b.String(), i + 1, nil
+		}
+		if c < 0x20 {
+			if d.strict {
+				return "", begin, d.error("Invalid control character at", i)
+			}
+			b.WriteByte(c)
+			pos = i + 1
+			continue
+		}
+		// backslash escape
+		if i+1 >= len(s) {
+			return "", begin, d.error("Unterminated string starting at", begin)
+		}
+		pos = i + 2
+		switch s[i+1] {
+		case '"', '\\', '/':
+			b.WriteByte(s[i+1])
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case 'u':
+			r, ok := d.hex4(i + 2)
+			if !ok {
+				return "", begin, d.error("Invalid \\uXXXX escape", i+1)
+			}
+			pos = i + 6
+			if utf16.IsSurrogate(r) && r < 0xdc00 && d.hasWord(pos, "\\u") {
+				if r2, ok := d.hex4(pos + 2); ok && 0xdc00 <= r2 && r2 <= 0xdfff {
+					r = utf16.DecodeRune(r, r2)
+					pos += 6
+				}
+			}
+			b.WriteRune(r)
+		default:
+			return "", begin, d.error("Invalid \\escape", i)
+		}
+	}
+}
+
+// Decodes the object whose contents start at pos, just after the {
+func (d *decoder) object(pos int) (py.Object, int, error) {
+	var pairs []py.Tuple
+	pos = d.skipSpace(pos)
+	if pos < len(d.s) && d.s[pos] == '}' {
+		pos++
+	} else {
+		for {
+			if pos >= len(d.s) || d.s[pos] != '"' {
+				return nil, pos, d.error("Expecting property name enclosed in double quotes", pos)
+			}
+			key, end, err := d.string(pos + 1)
+			if err != nil {
+				return nil, pos, err
+			}
+			pos = d.skipSpace(end)
+			if pos >= len(d.s) || d.s[pos] != ':' {
+				return nil, pos, d.error("Expecting ':' delimiter", pos)
+			}
+			value, end, err := d.value(d.skipSpace(pos + 1))
+			if err != nil {
+				return nil, pos, err
+			}
+			pairs = append(pairs, py.Tuple{py.String(key), value})
+			pos = d.skipSpace(end)
+			if pos < len(d.s) && d.s[pos] == '}' {
+				pos++
+				break
+			}
+			if pos >= len(d.s) || d.s[pos] != ',' {
+				return nil, pos, d.error("Expecting ',' delimiter", pos)
+			}
+			pos = d.skipSpace(pos + 1)
+		}
+	}
+	if d.objectPairsHook != py.None {
+		items := make([]py.Object, len(pairs))
+		for i, pair := range pairs {
+			items[i] = pair
+		}
+		res, err := py.Call(d.objectPairsHook, py.Tuple{py.NewListFromItems(items)}, nil)
+		return res, pos, err
+	}
+	dict := py.NewStringDictSized(len(pairs))
+	for _, pair := range pairs {
+		dict[string(pair[0].(py.String))] = pair[1]
+	}
+	if d.objectHook != py.None {
+		res, err := py.Call(d.objectHook, py.Tuple{dict}, nil)
+		return res, pos, err
+	}
+	return dict, pos, nil
+}
+
+// Decodes the array whose contents start at pos, just after the [
+func (d *decoder) array(pos int) (py.Object, int, error) {
+	list := py.NewList()
+	pos = d.skipSpace(pos)
+	if pos < len(d.s) && d.s[pos] == ']' {
+		return list, pos + 1, nil
+	}
+	for {
+		value, end, err := d.value(pos)
+		if err != nil {
+			return nil, pos, err
+		}
+		list.Append(value)
+		pos = d.skipSpace(end)
+		if pos < len(d.s) && d.s[pos] == ']' {
+			return list, pos + 1, nil
+		}
+		if pos >= len(d.s) || d.s[pos] != ',' {
+			return nil, pos, d.error("Expecting ',' delimiter", pos)
+		}
+		pos = d.skipSpace(pos + 1)
+	}
+}
@@ -0,0 +1,312 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// JSON encoder
+
+package json
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/go-python/gpython/py"
+)
+
+// encoder writes python objects as JSON into buf
+type encoder struct {
+	ensureASCII   bool
+	allowNaN      bool
+	indent        *string // nil for no newlines
+	itemSeparator string
+	keySeparator  string
+	defaultFn     py.Object // None for the default
+	markers       map[uintptr]struct{}
+	buf           bytes.Buffer
+}
+
+const hex = "0123456789abcdef"
+
+// Writes s as a JSON string
+func (e *encoder) encodeString(s string) {
+	buf := &e.buf
+	buf.WriteByte('"')
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c < utf8.RuneSelf {
+			switch c {
+			case '"':
+				buf.WriteString(`\"`)
+			case '\\':
+				buf.WriteString(`\\`)
+			case '\n':
+				buf.WriteString(`\n`)
+			case '\r':
+				buf.WriteString(`\r`)
+			case '\t':
+				buf.WriteString(`\t`)
+			case '\b':
+				buf.WriteString(`\b`)
+			case '\f':
+				buf.WriteString(`\f`)
+			default:
+				if c < 0x20 || (c == 0x7f && e.ensureASCII) {
+					buf.WriteString(`\u00`)
+					buf.WriteByte(hex[c>>4])
+					buf.WriteByte(hex[c&0xf])
+				} else {
+					buf.WriteByte(c)
+				}
+			}
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if e.ensureASCII {
+			if r >= 0x10000 {
+				r1, r2 := utf16.EncodeRune(r)
+				e.writeU(r1)
+				e.writeU(r2)
+			} else {
+				e.writeU(r)
+			}
+		} else {
+			buf.WriteRune(r)
+		}
+		i += size
+	}
+	buf.WriteByte('"')
+}
+
+// Writes r as a \uXXXX escape
+func (e *encoder) writeU(r rune) {
+	e.buf.WriteString(`\u`)
+	for shift := uint(12); ; shift -= 4 {
+		e.buf.WriteByte(hex[(r>>shift)&0xf])
+		if shift == 0 {
+			break
+		}
+	}
+}
+
+// Returns f formatted as python's repr does, the shortest string which
+// reads back as f, eg "0.1", "1e+16" or "1.0"
+func floatRepr(f float64) string {
+	if f == 0 {
+		if math.Signbit(f) {
+			return "-0.0"
+		}
+		return "0.0"
+	}
+	s := strconv.FormatFloat(f, 'e', -1, 64)
+	sign := ""
+	if s[0] == '-' {
+		sign, s = "-", s[1:]
+	}
+	i := strings.IndexByte(s, 'e')
+	digits := strings.Replace(s[:i], ".", "", 1)
+	exp, _ := strconv.Atoi(s[i+1:])
+	// position of the decimal point relative to the start of digits
+	decpt := exp + 1
+	switch {
+	case decpt <= -4 || decpt > 16:
+		mantissa := digits[:1]
+		if len(digits) > 1 {
+			mantissa += "." + digits[1:]
+		}
+		expSign := "+"
+		if exp < 0 {
+			expSign, exp = "-", -exp
+		}
+		expDigits := strconv.Itoa(exp)
+		if len(expDigits) < 2 {
+			expDigits = "0" + expDigits
+		}
+		return sign + mantissa + "e" + expSign + expDigits
+	case decpt <= 0:
+		return sign + "0." + strings.Repeat("0", -decpt) + digits
+	case decpt >= len(digits):
+		return sign + digits + strings.Repeat("0", decpt-len(digits)) + ".0"
+	default:
+		return sign + digits[:decpt] + "." + digits[decpt:]
+	}
+}
+
+// Returns the JSON for f or an error if it is out of range and that
+// isn't allowed
+func (e *encoder) floatString(f float64) (string, error) {
+	var s string
+	switch {
+	case math.IsNaN(f):
+		s = "NaN"
+	case math.IsInf(f, 1):
+		s = "Infinity"
+	case math.IsInf(f, -1):
+		s = "-Infinity"
+	default:
+		return floatRepr(f), nil
+	}
+	if !e.allowNaN {
+		return "", py.ExceptionNewf(py.ValueError, "Out of range float values are not JSON compliant")
+	}
+	return s, nil
+}
+
+// Returns an identity for the container obj, or 0 if it isn't one
+// which can contain itself
+func containerID(obj py.Object) uintptr {
+	v := reflect.ValueOf(obj)
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map:
+		return v.Pointer()
+	case reflect.Slice:
+		if v.Len() > 0 {
+			return v.Pointer()
+		}
+	}
+	return 0
+}
+
+// Marks obj as being encoded returning an error if it already is and
+// a function to remove the mark
+func (e *encoder) mark(obj py.Object) (func(), error) {
+	id := containerID(obj)
+	if id == 0 {
+		return func() {}, nil
+	}
+	if _, found := e.markers[id]; found {
+		return nil, py.ExceptionNewf(py.ValueError, "Circular reference detected")
+	}
+	e.markers[id] = struct{}{}
+	return func() { delete(e.markers, id) }, nil
+}
+
+// Starts a new line for an item at level if indenting
+func (e *encoder) newline(level int) {
+	if e.indent == nil {
+		return
+	}
+	e.buf.WriteByte('\n')
+	for i := 0; i < level; i++ {
+		e.buf.WriteString(*e.indent)
+	}
+}
+
+// Writes obj as JSON at nesting level
+func (e *encoder) encode(obj py.Object, level int) error {
+	switch x := obj.(type) {
+	case py.String:
+		e.encodeString(string(x))
+	case py.NoneType:
+		e.buf.WriteString("null")
+	case py.Bool:
+		if x {
+			e.buf.WriteString("true")
+		} else {
+			e.buf.WriteString("false")
+		}
+	case py.Int:
+		e.buf.WriteString(strconv.FormatInt(int64(x), 10))
+	case *py.BigInt:
+		s, err := py.ReprAsString(x)
+		if err != nil {
+			return err
+		}
+		e.buf.WriteString(s)
+	case py.Float:
+		s, err := e.floatString(float64(x))
+		if err != nil {
+			return err
+		}
+		e.buf.WriteString(s)
+	case *py.List:
+		return e.encodeList(obj, x.Items, level)
+	case py.Tuple:
+		return e.encodeList(obj, x, level)
+	case py.StringDict:
+		return e.encodeDict(x, level)
+	default:
+		unmark, err := e.mark(obj)
+		if err != nil {
+			return err
+		}
+		defer unmark()
+		if e.defaultFn == py.None {
+			return py.ExceptionNewf(py.TypeError, "Object of type %s is not JSON serializable", obj.Type().Name)
+		}
+		res, err := py.Call(e.defaultFn, py.Tuple{obj}, nil)
+		if err != nil {
+			return err
+		}
+		return e.encode(res, level)
+	}
+	return nil
+}
+
+// Writes items of the list or tuple obj as a JSON array
+func (e *encoder) encodeList(obj py.Object, items []py.Object, level int) error {
+	if len(items) == 0 {
+		e.buf.WriteString("[]")
+		return nil
+	}
+	unmark, err := e.mark(obj)
+	if err != nil {
+		return err
+	}
+	defer unmark()
+	e.buf.WriteByte('[')
+	for i, item := range items {
+		if i > 0 {
+			e.buf.WriteString(e.itemSeparator)
+		}
+		e.newline(level + 1)
+		if err := e.encode(item, level+1); err != nil {
+			return err
+		}
+	}
+	e.newline(level)
+	e.buf.WriteByte(']')
+	return nil
+}
+
+// Writes d as a JSON object
+//
+// The keys are always sorted as StringDict doesn't keep them in the
+// order they were added.
+func (e *encoder) encodeDict(d py.StringDict, level int) error {
+	if len(d) == 0 {
+		e.buf.WriteString("{}")
+		return nil
+	}
+	unmark, err := e.mark(d)
+	if err != nil {
+		return err
+	}
+	defer unmark()
+	keys := make([]string, 0, len(d))
+	for key := range d {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	e.buf.WriteByte('{')
+	for i, key := range keys {
+		if i > 0 {
+			e.buf.WriteString(e.itemSeparator)
+		}
+		e.newline(level + 1)
+		e.encodeString(key)
+		e.buf.WriteString(e.keySeparator)
+		if err := e.encode(d[key], level+1); err != nil {
+			return err
+		}
+	}
+	e.newline(level)
+	e.buf.WriteByte('}')
+	return nil
+}
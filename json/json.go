@@ -0,0 +1,346 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package json implements the python json module
+//
+// The encoder and decoder are written in Go rather than python.
+// dicts in gpython don't remember the order their keys were added
+// in, so dumps always writes their keys sorted to give the same
+// output each time, and loads passes the pairs in document order to
+// object_pairs_hook.
+package json
+
+import (
+	"strings"
+
+	"github.com/go-python/gpython/py"
+)
+
+const module_doc = `JSON (JavaScript Object Notation) <http://json.org> is a subset of
+JavaScript syntax (ECMA-262 3rd edition) used as a lightweight data
+interchange format.
+
+Encoding basic Python object hierarchies::
+
+    >>> import json
+    >>> json.dumps(['foo', {'bar': ('baz', None, 1.0, 2)}])
+    '["foo", {"bar": ["baz", null, 1.0, 2]}]'
+    >>> print(json.dumps("\"foo\bar"))
+    "\"foo\bar"
+
+Compact encoding::
+
+    >>> json.dumps([1,2,3,{'4': 5, '6': 7}], separators=(',', ':'))
+    '[1,2,3,{"4":5,"6":7}]'
+
+Decoding JSON::
+
+    >>> json.loads('["foo", {"bar":["baz", null, 1.0, 2]}]')
+    ['foo', {'bar': ['baz', None, 1.0, 2]}]
+`
+
+var JSONDecodeError = py.ValueError.NewType("JSONDecodeError", `Subclass of ValueError with the following additional properties:
+
+msg: The unformatted error message
+doc: The JSON document being parsed
+pos: The start index of doc where parsing failed
+lineno: The line corresponding to pos
+colno: The column corresponding to pos`, nil, nil)
+
+// The names of the keyword arguments of dumps and dump
+var encoderKwlist = []string{"skipkeys", "ensure_ascii", "check_circular", "allow_nan", "cls", "indent", "separators", "default", "sort_keys"}
+
+// Makes an encoder from the keyword arguments of dumps or dump
+func newEncoderFromArgs(name string, kwargs py.StringDict) (*encoder, error) {
+	var skipKeys py.Object = py.False
+	var ensureASCII py.Object = py.True
+	var checkCircular py.Object = py.True
+	var allowNaN py.Object = py.True
+	var cls py.Object = py.None
+	var indent py.Object = py.None
+	var separators py.Object = py.None
+	var defaultFn py.Object = py.None
+	var sortKeys py.Object = py.False
+	err := py.ParseTupleAndKeywords(nil, kwargs, "|OOOOOOOOO:"+name, encoderKwlist,
+		&skipKeys, &ensureASCII, &checkCircular, &allowNaN, &cls, &indent, &separators, &defaultFn, &sortKeys)
+	if err != nil {
+		return nil, err
+	}
+	if cls != py.None {
+		return nil, py.ExceptionNewf(py.NotImplementedError, "%s() doesn't support cls", name)
+	}
+	e := &encoder{
+		defaultFn: defaultFn,
+		markers:   map[uintptr]struct{}{},
+	}
+	flags := []struct {
+		obj  py.Object
+		flag *bool
+	}{
+		{ensureASCII, &e.ensureASCII},
+		{allowNaN, &e.allowNaN},
+		// skipkeys, check_circular and sort_keys are only checked
+		// as dict keys are always str, circular references are
+		// always detected and keys are always sorted
+		{skipKeys, new(bool)},
+		{checkCircular, new(bool)},
+		{sortKeys, new(bool)},
+	}
+	for _, f := range flags {
+		b, err := py.MakeBool(f.obj)
+		if err != nil {
+			return nil, err
+		}
+		*f.flag = b == py.True
+	}
+	switch x := indent.(type) {
+	case py.NoneType:
+	case py.Int:
+		n, err := x.GoInt()
+		if err != nil {
+			return nil, err
+		}
+		if n < 0 {
+			n = 0
+		}
+		s := strings.Repeat(" ", n)
+		e.indent = &s
+	case py.String:
+		s := string(x)
+		e.indent = &s
+	default:
+		return nil, py.ExceptionNewf(py.TypeError, "indent must be None, an int or a str, not %s", indent.Type().Name)
+	}
+	if separators == py.None {
+		e.itemSeparator, e.keySeparator = ", ", ": "
+		if e.indent != nil {
+			e.itemSeparator = ","
+		}
+	} else {
+		seps, err := py.SequenceTuple(separators)
+		if err != nil {
+			return nil, err
+		}
+		if len(seps) != 2 {
+			return nil, py.ExceptionNewf(py.ValueError, "separators must be an (item_separator, key_separator) pair, not %d items", len(seps))
+		}
+		item, ok1 := seps[0].(py.String)
+		key, ok2 := seps[1].(py.String)
+		if !ok1 || !ok2 {
+			return nil, py.ExceptionNewf(py.TypeError, "separators must be a pair of str")
+		}
+		e.itemSeparator, e.keySeparator = string(item), string(key)
+	}
+	return e, nil
+}
+
+const dumps_doc = `dumps(obj, *, skipkeys=False, ensure_ascii=True, check_circular=True,
+      allow_nan=True, cls=None, indent=None, separators=None,
+      default=None, sort_keys=False)
+
+Serialize obj to a JSON formatted str.
+
+If skipkeys is true then dict keys that are not basic types (str,
+int, float, bool, None) will be skipped instead of raising a
+TypeError.
+
+If ensure_ascii is false, then the return value can contain non-ASCII
+characters if they appear in strings contained in obj.  Otherwise, all
+such characters are escaped in JSON strings.
+
+If check_circular is false, then the circular reference check for
+container types will be skipped.  gpython always checks so a circular
+reference raises ValueError either way.
+
+If allow_nan is false, then it will be a ValueError to serialize out
+of range float values (nan, inf, -inf) in strict compliance of the
+JSON specification, instead of using the JavaScript equivalents (NaN,
+Infinity, -Infinity).
+
+If indent is a non-negative integer, then JSON array elements and
+object members will be pretty-printed with that indent level.  An
+indent level of 0 will only insert newlines.  None is the most compact
+representation.  A string indent is used as it is for each level.
+
+If specified, separators should be an (item_separator, key_separator)
+tuple.  The default is (', ', ': ') if indent is None and (',', ': ')
+otherwise.  To get the most compact JSON representation, you should
+specify (',', ':') to eliminate whitespace.
+
+default(obj) is a function that should return a serializable version
+of obj or raise TypeError.  The default simply raises TypeError.
+
+If sort_keys is true then the output of dictionaries will be sorted
+by key.  gpython dicts don't remember the order of their keys so they
+are always sorted.
+
+cls isn't supported.`
+
+func json_dumps(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var obj py.Object
+	err := py.UnpackTuple(args, nil, "dumps", 1, 1, &obj)
+	if err != nil {
+		return nil, err
+	}
+	e, err := newEncoderFromArgs("dumps", kwargs)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.encode(obj, 0); err != nil {
+		return nil, err
+	}
+	return py.String(e.buf.String()), nil
+}
+
+const dump_doc = `dump(obj, fp, *, skipkeys=False, ensure_ascii=True, check_circular=True,
+     allow_nan=True, cls=None, indent=None, separators=None,
+     default=None, sort_keys=False)
+
+Serialize obj as a JSON formatted stream to fp (a .write()-supporting
+file-like object).
+
+The arguments are the same as for dumps.`
+
+func json_dump(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var obj, fp py.Object
+	err := py.UnpackTuple(args, nil, "dump", 2, 2, &obj, &fp)
+	if err != nil {
+		return nil, err
+	}
+	e, err := newEncoderFromArgs("dump", kwargs)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.encode(obj, 0); err != nil {
+		return nil, err
+	}
+	write, err := py.GetAttrString(fp, "write")
+	if err != nil {
+		return nil, err
+	}
+	_, err = py.Call(write, py.Tuple{py.String(e.buf.String())}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return py.None, nil
+}
+
+// The names of the keyword arguments of loads and load
+var decoderKwlist = []string{"cls", "object_hook", "parse_float", "parse_int", "parse_constant", "object_pairs_hook", "strict"}
+
+// Makes a decoder from the keyword arguments of loads or load
+func newDecoderFromArgs(name string, kwargs py.StringDict) (*decoder, error) {
+	var cls py.Object = py.None
+	var strict py.Object = py.True
+	d := &decoder{
+		objectHook:      py.None,
+		parseFloat:      py.None,
+		parseInt:        py.None,
+		parseConstant:   py.None,
+		objectPairsHook: py.None,
+	}
+	err := py.ParseTupleAndKeywords(nil, kwargs, "|OOOOOOO:"+name, decoderKwlist,
+		&cls, &d.objectHook, &d.parseFloat, &d.parseInt, &d.parseConstant, &d.objectPairsHook, &strict)
+	if err != nil {
+		return nil, err
+	}
+	if cls != py.None {
+		return nil, py.ExceptionNewf(py.NotImplementedError, "%s() doesn't support cls", name)
+	}
+	strictBool, err := py.MakeBool(strict)
+	if err != nil {
+		return nil, err
+	}
+	d.strict = strictBool == py.True
+	return d, nil
+}
+
+const loads_doc = `loads(s, *, cls=None, object_hook=None, parse_float=None,
+      parse_int=None, parse_constant=None, object_pairs_hook=None,
+      strict=True)
+
+Deserialize s (a str, bytes or bytearray instance containing a JSON
+document) to a Python object.
+
+object_hook is an optional function that will be called with the
+result of any object literal decode (a dict).  The return value of
+object_hook will be used instead of the dict.
+
+object_pairs_hook is an optional function that will be called with
+the result of any object literal decoded with an ordered list of
+pairs.  The return value of object_pairs_hook will be used instead of
+the dict.  If object_hook is also defined, the object_pairs_hook takes
+priority.
+
+parse_float, if specified, will be called with the string of every
+JSON float to be decoded.  By default this is equivalent to
+float(num_str).
+
+parse_int, if specified, will be called with the string of every JSON
+int to be decoded.  By default this is equivalent to int(num_str).
+
+parse_constant, if specified, will be called with one of the
+following strings: -Infinity, Infinity, NaN.
+
+If strict is false then control characters are allowed in strings.
+
+A JSONDecodeError is raised if s isn't valid JSON.
+
+cls isn't supported.`
+
+func json_loads(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var s py.Object
+	err := py.UnpackTuple(args, nil, "loads", 1, 1, &s)
+	if err != nil {
+		return nil, err
+	}
+	d, err := newDecoderFromArgs("loads", kwargs)
+	if err != nil {
+		return nil, err
+	}
+	return d.decodeObject(s)
+}
+
+const load_doc = `load(fp, *, cls=None, object_hook=None, parse_float=None,
+     parse_int=None, parse_constant=None, object_pairs_hook=None,
+     strict=True)
+
+Deserialize fp (a .read()-supporting file-like object containing a
+JSON document) to a Python object.
+
+The arguments are the same as for loads.`
+
+func json_load(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var fp py.Object
+	err := py.UnpackTuple(args, nil, "load", 1, 1, &fp)
+	if err != nil {
+		return nil, err
+	}
+	d, err := newDecoderFromArgs("load", kwargs)
+	if err != nil {
+		return nil, err
+	}
+	read, err := py.GetAttrString(fp, "read")
+	if err != nil {
+		return nil, err
+	}
+	s, err := py.Call(read, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return d.decodeObject(s)
+}
+
+func init() {
+	methods := []*py.Method{
+		py.MustNewMethod("dumps", json_dumps, 0, dumps_doc),
+		py.MustNewMethod("dump", json_dump, 0, dump_doc),
+		py.MustNewMethod("loads", json_loads, 0, loads_doc),
+		py.MustNewMethod("load", json_load, 0, load_doc),
+	}
+	globals := py.StringDict{
+		"JSONDecodeError": JSONDecodeError,
+	}
+	py.NewModule("json", module_doc, methods, globals)
+}
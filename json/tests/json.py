@@ -0,0 +1,213 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+import json
+from libtest import assertRaises, assertRaisesText
+
+doc = "dumps basic types"
+assert json.dumps(None) == "null"
+assert json.dumps(True) == "true"
+assert json.dumps(False) == "false"
+assert json.dumps(0) == "0"
+assert json.dumps(-42) == "-42"
+assert json.dumps("hello") == '"hello"'
+assert json.dumps([]) == "[]"
+assert json.dumps({}) == "{}"
+assert json.dumps([1, "a", None, True]) == '[1, "a", null, true]'
+assert json.dumps((1, 2)) == "[1, 2]"
+assert json.dumps({"b": 1, "a": [2, 3]}) == '{"a": [2, 3], "b": 1}'
+assert json.dumps(["foo", {"bar": ("baz", None, 1.0, 2)}]) == '["foo", {"bar": ["baz", null, 1.0, 2]}]'
+
+doc = "dumps big ints"
+big = 123456789012345678901234567890
+assert json.dumps(big) == "123456789012345678901234567890"
+assert json.dumps(-big) == "-123456789012345678901234567890"
+assert json.loads(json.dumps(big)) == big
+assert json.loads("-123456789012345678901234567890") == -big
+assert json.loads("9223372036854775807") == 9223372036854775807
+assert json.loads("9223372036854775808") == 9223372036854775808
+
+doc = "dumps floats"
+assert json.dumps(1.0) == "1.0"
+assert json.dumps(-0.0) == "-0.0"
+assert json.dumps(0.1) == "0.1"
+assert json.dumps(0.1 + 0.2) == "0.30000000000000004"
+assert json.dumps(1.5e300) == "1.5e+300"
+assert json.dumps(1e16) == "1e+16"
+assert json.dumps(1e15) == "1000000000000000.0"
+assert json.dumps(123456789.125) == "123456789.125"
+assert json.dumps(0.0001) == "0.0001"
+assert json.dumps(0.00001) == "1e-05"
+assert json.dumps(2.5e-300) == "2.5e-300"
+assert json.dumps(float("inf")) == "Infinity"
+assert json.dumps(float("-inf")) == "-Infinity"
+assert json.dumps(float("nan")) == "NaN"
+assertRaisesText(ValueError, "Out of range float values are not JSON compliant", json.dumps, float("nan"), allow_nan=False)
+assertRaises(ValueError, json.dumps, [float("inf")], allow_nan=False)
+for f in [0.1, 1/3, 2/3, 1e-7, 1.7976931348623157e308, 5e-324, 123.456, -9.87654321e-10]:
+    assert json.loads(json.dumps(f)) == f, f
+
+doc = "dumps strings"
+assert json.dumps('"\\\n\r\t\b\f') == '"\\"\\\\\\n\\r\\t\\b\\f"'
+assert json.dumps("\x00\x1f\x7f") == '"\\u0000\\u001f\\u007f"'
+assert json.dumps("\x7f", ensure_ascii=False) == '"\x7f"'
+assert json.dumps("/") == '"/"'
+assert json.dumps("héllo") == '"h\\u00e9llo"'
+assert json.dumps("héllo", ensure_ascii=False) == '"héllo"'
+assert json.dumps("€") == '"\\u20ac"'
+assert json.dumps("\U0001f600") == '"\\ud83d\\ude00"'
+assert json.dumps("\U0001f600", ensure_ascii=False) == '"\U0001f600"'
+
+doc = "dumps indent and separators"
+assert json.dumps([1, [2, 3], {"a": 4}], indent=2) == """[
+  1,
+  [
+    2,
+    3
+  ],
+  {
+    "a": 4
+  }
+]"""
+assert json.dumps({"a": [], "b": {}}, indent=0) == '{\n"a": [],\n"b": {}\n}'
+assert json.dumps([1, 2], indent="\t") == "[\n\t1,\n\t2\n]"
+assert json.dumps([1, 2], indent=-1) == "[\n1,\n2\n]"
+assert json.dumps([1, {"a": 2}], separators=(",", ":")) == '[1,{"a":2}]'
+assert json.dumps([1, {"a": 2}], indent=1, separators=(", ", " = ")) == '[\n 1, \n {\n  "a" = 2\n }\n]'
+assert json.dumps({"b": 1, "a": 2}, sort_keys=True) == '{"a": 2, "b": 1}'
+assertRaises(TypeError, json.dumps, [], indent=1.5)
+assertRaises(TypeError, json.dumps, [], separators=(1, 2))
+assertRaises(ValueError, json.dumps, [], separators=(",",))
+
+doc = "dumps default and errors"
+class Point:
+    def __init__(self, x, y):
+        self.x = x
+        self.y = y
+def point(p):
+    if type(p) is Point:
+        return {"x": p.x, "y": p.y}
+    raise TypeError("not a point")
+assert json.dumps([Point(1, 2)], default=point) == '[{"x": 1, "y": 2}]'
+assertRaisesText(TypeError, "Object of type Point is not JSON serializable", json.dumps, Point(1, 2))
+assertRaisesText(TypeError, "Object of type bytes is not JSON serializable", json.dumps, b"x")
+assertRaisesText(TypeError, "not a point", json.dumps, [1j], default=point)
+def self_default(o):
+    return o
+assertRaisesText(ValueError, "Circular reference detected", json.dumps, Point(1, 2), default=self_default)
+l = [1]
+l.append(l)
+assertRaisesText(ValueError, "Circular reference detected", json.dumps, l)
+d = {}
+d["d"] = d
+assertRaisesText(ValueError, "Circular reference detected", json.dumps, d)
+shared = [1]
+assert json.dumps([shared, shared]) == "[[1], [1]]"
+assertRaises(TypeError, json.dumps)
+assertRaises(TypeError, json.dumps, 1, 2)
+assertRaises(TypeError, json.dumps, 1, nonsense=True)
+
+doc = "loads basic types"
+assert json.loads("null") is None
+assert json.loads("true") is True
+assert json.loads("false") is False
+assert json.loads(" 42 ") == 42
+assert json.loads("-0") == 0
+assert json.loads("1.5") == 1.5
+assert json.loads("-1.5e3") == -1500.0
+assert json.loads("1E+2") == 100.0
+assert type(json.loads("1E2")) is float
+assert type(json.loads("12")) is int
+assert json.loads("1e400") == float("inf")
+assert json.loads('"hello"') == "hello"
+assert json.loads("[]") == []
+assert json.loads("{}") == {}
+assert json.loads('[1, "a", null, true, false]') == [1, "a", None, True, False]
+assert json.loads('{"a": {"b": [1, {"c": []}]}}') == {"a": {"b": [1, {"c": []}]}}
+assert json.loads(' \t\n\r{ "a" : 1 , "b" : 2 } ') == {"a": 1, "b": 2}
+assert json.loads('{"a": 1, "a": 2}') == {"a": 2}
+assert json.loads(b'{"a": "\xc3\xa9"}') == {"a": "é"}
+assert json.loads(b'\xef\xbb\xbf[1]') == [1]
+
+doc = "loads constants"
+assert json.loads("Infinity") == float("inf")
+assert json.loads("-Infinity") == float("-inf")
+nan = json.loads("NaN")
+assert nan != nan
+assert json.loads("[NaN]", parse_constant=lambda s: "const " + s) == ["const NaN"]
+
+doc = "loads strings"
+assert json.loads('"\\"\\\\\\/\\b\\f\\n\\r\\t"') == '"\\/\b\f\n\r\t'
+assert json.loads('"\\u00e9\\u20AC"') == "é€"
+assert json.loads('"\\ud83d\\ude00"') == "\U0001f600"
+assert json.loads('"héllo"') == "héllo"
+assert json.loads('"a\tb"', strict=False) == "a\tb"
+
+doc = "loads hooks"
+assert json.loads('{"a": 1}', object_hook=lambda d: ("hooked", d["a"])) == ("hooked", 1)
+assert json.loads('{"b": 1, "a": 2, "b": 3}', object_pairs_hook=lambda pairs: pairs) == [("b", 1), ("a", 2), ("b", 3)]
+assert json.loads('{"a": 1}', object_hook=lambda d: 1, object_pairs_hook=lambda p: 2) == 2
+assert json.loads('[1, 2.5]', parse_int=lambda s: "int " + s, parse_float=lambda s: "float " + s) == ["int 1", "float 2.5"]
+assert json.loads('[{"x": 1}]', object_hook=lambda d: Point(d["x"], 0))[0].x == 1
+
+doc = "loads errors"
+def decode_error(s, **kwargs):
+    try:
+        json.loads(s, **kwargs)
+    except json.JSONDecodeError as e:
+        return e
+    assert False, "JSONDecodeError not raised for %s" % s
+def check_error(s, msg, pos, lineno, colno):
+    e = decode_error(s)
+    assert e.msg == msg, (s, e.msg)
+    assert e.pos == pos, (s, e.pos)
+    assert e.lineno == lineno, (s, e.lineno)
+    assert e.colno == colno, (s, e.colno)
+    assert e.doc == s
+    assert e.args[0] == "%s: line %d column %d (char %d)" % (msg, lineno, colno, pos), e.args[0]
+check_error("", "Expecting value", 0, 1, 1)
+check_error("  ", "Expecting value", 2, 1, 3)
+check_error("[1,]", "Expecting value", 3, 1, 4)
+check_error("[1 2]", "Expecting ',' delimiter", 3, 1, 4)
+check_error("[1", "Expecting ',' delimiter", 2, 1, 3)
+check_error("{1: 2}", "Expecting property name enclosed in double quotes", 1, 1, 2)
+check_error('{"a": 1,}', "Expecting property name enclosed in double quotes", 8, 1, 9)
+check_error('{"a" 1}', "Expecting ':' delimiter", 5, 1, 6)
+check_error('{"a": 1 "b": 2}', "Expecting ',' delimiter", 8, 1, 9)
+check_error('{"a": }', "Expecting value", 6, 1, 7)
+check_error('"abc', "Unterminated string starting at", 0, 1, 1)
+check_error('["abc\\', "Unterminated string starting at", 1, 1, 2)
+check_error('"a\tb"', "Invalid control character at", 2, 1, 3)
+check_error('"\\x"', "Invalid \\escape", 1, 1, 2)
+check_error('"\\u12x4"', "Invalid \\uXXXX escape", 2, 1, 3)
+check_error("[1] 2", "Extra data", 4, 1, 5)
+check_error("tru", "Expecting value", 0, 1, 1)
+check_error("01", "Extra data", 1, 1, 2)
+check_error("1.", "Extra data", 1, 1, 2)
+check_error("-", "Expecting value", 0, 1, 1)
+check_error('{\n  "a": 1,\n  "b": x\n}', "Expecting value", 19, 3, 8)
+check_error('["é", x]', "Expecting value", 6, 1, 7)
+check_error("﻿[]", "Unexpected UTF-8 BOM (decode using utf-8-sig)", 0, 1, 1)
+try:
+    json.loads("x")
+except ValueError as e:
+    assert type(e) is json.JSONDecodeError
+assertRaisesText(TypeError, "the JSON object must be str, bytes or bytearray, not int", json.loads, 1)
+
+doc = "load and dump"
+import io
+f = io.StringIO()
+assert json.dump({"a": [1, 2.5, "x"]}, f, indent=1) is None
+assert f.getvalue() == '{\n "a": [\n  1,\n  2.5,\n  "x"\n ]\n}'
+f.seek(0)
+assert json.load(f) == {"a": [1, 2.5, "x"]}
+assert json.load(io.BytesIO(b"[1]")) == [1]
+assert json.load(io.StringIO("[1.5]"), parse_float=lambda s: s) == ["1.5"]
+
+doc = "round trip"
+data = {"name": "gpython", "list": [1, -2, 3.25, big, None, True, False, "é\n"], "nested": {"empty": {}, "a": []}}
+assert json.loads(json.dumps(data)) == data
+assert json.loads(json.dumps(data, indent=4, ensure_ascii=False)) == data
+
+doc = "finished"
@@ -7,11 +7,15 @@
 package main
 
 import (
-	"flag"
+	"errors"
 	"fmt"
+	"io"
 	"runtime"
 	"runtime/pprof"
+	"strconv"
+	"syscall"
 
+	_ "github.com/go-python/gpython/asyncio"
 	_ "github.com/go-python/gpython/builtin"
 	"github.com/go-python/gpython/repl/cli"
 
@@ -21,108 +25,552 @@ import (
 	"os"
 	"strings"
 
+	_ "github.com/go-python/gpython/bisect"
+	_ "github.com/go-python/gpython/collections"
 	"github.com/go-python/gpython/compile"
+	_ "github.com/go-python/gpython/copy"
+	"github.com/go-python/gpython/dis"
+	_ "github.com/go-python/gpython/functools"
+	_ "github.com/go-python/gpython/heapq"
+	_ "github.com/go-python/gpython/itertools"
+	_ "github.com/go-python/gpython/json"
 	"github.com/go-python/gpython/marshal"
 	_ "github.com/go-python/gpython/math"
+	_ "github.com/go-python/gpython/operator"
+	_ "github.com/go-python/gpython/os"
+	_ "github.com/go-python/gpython/pdb"
 	"github.com/go-python/gpython/py"
+	_ "github.com/go-python/gpython/random"
+	_ "github.com/go-python/gpython/re"
 	pysys "github.com/go-python/gpython/sys"
 	_ "github.com/go-python/gpython/time"
+	_ "github.com/go-python/gpython/tokenize"
+	_ "github.com/go-python/gpython/unicodedata"
 	"github.com/go-python/gpython/vm"
+	_ "github.com/go-python/gpython/zipimport"
 )
 
-// Globals
-var (
-	// Flags
-	debug      = flag.Bool("d", false, "Print lots of debugging")
-	cpuprofile = flag.String("cpuprofile", "", "Write cpu profile to file")
+// The version of python gpython implements
+const pythonVersion = "3.4.0"
+
+// The name gpython was run as for messages
+var progName = "gpython"
+
+const usage = `usage: %s [option] ... [-c cmd | -m mod | file | -] [arg] ...
+`
+
+const help = `Options and arguments:
+-b     : issue warnings about str(bytes_instance) and comparing bytes with str
+-B     : don't write .pyc files on import; also PYTHONDONTWRITEBYTECODE=x
+-c cmd : program passed in as string (terminates option list)
+-d     : debug output from parser; also PYTHONDEBUG=x
+-E     : ignore PYTHON* environment variables (such as PYTHONBREAKPOINT)
+-h     : print this help message and exit (also --help)
+-i     : inspect interactively after running script; forces a prompt even
+         if stdin does not appear to be a terminal; also PYTHONINSPECT=x
+-m mod : run library module as a script (terminates option list)
+-O     : optimize generated bytecode slightly; also PYTHONOPTIMIZE=x
+-OO    : sets sys.flags.optimize to 2 (doc-strings are kept)
+-q     : don't print version and copyright messages on interactive startup
+-s     : don't add user site directory to sys.path; also PYTHONNOUSERSITE
+-S     : don't imply 'import site' on initialization
+-u     : unbuffered binary stdout and stderr; also PYTHONUNBUFFERED=x
+-v     : verbose (trace import statements); also PYTHONVERBOSE=x
+         can be supplied multiple times to increase verbosity
+-V     : print the Python version number and exit (also --version)
+-W arg : warning control; arg is action:message:category:module:lineno
+         also PYTHONWARNINGS=arg
+file   : program read from script file
+-      : program read from stdin (default; interactive mode if a tty)
+arg ...: arguments passed to program in sys.argv[1:]
+
+Gpython options:
+-cpuprofile file : write cpu profile to file
+-dis             : disassemble the program instead of running it
+`
+
+// What the command line asks gpython to run
+type runMode int
+
+const (
+	runREPL    runMode = iota // stdin, interactively if it is a terminal
+	runCommand                // the program passed with -c
+	runModule                 // the module passed with -m
+	runScript                 // a script file or - for stdin
 )
 
-// syntaxError prints the syntax
-func syntaxError() {
-	fmt.Fprintf(os.Stderr, `GPython
+// options holds the parsed command line
+type options struct {
+	flags       pysys.Flags
+	warnoptions []string // sys.warnoptions
+	argv        []string // sys.argv
+	mode        runMode
+	target      string // command, module or script to run
+	unbuffered  bool
+	help        bool
+	version     int
+	cpuprofile  string
+	disassemble bool
+}
 
-A python implementation in Go
+// usageError is a problem with the command line
+type usageError string
 
-Full options:
-`)
-	flag.PrintDefaults()
+func (e usageError) Error() string {
+	return string(e)
 }
 
-// Exit with the message
-func fatal(message string, args ...interface{}) {
-	if !strings.HasSuffix(message, "\n") {
-		message += "\n"
+// parseArgs parses the command line arguments args the way python
+// does, using getenv to read the environment
+//
+// Short options may be combined as in -Bu and their arguments may be
+// joined to them as in -cprint(1).  The options end at -c, -m, -- or
+// the first argument which doesn't start with - with the rest of the
+// arguments going to sys.argv.
+func parseArgs(args []string, getenv func(string) string) (*options, error) {
+	opts := &options{}
+	i := 0
+options:
+	for i < len(args) {
+		arg := args[i]
+		if arg == "-" || !strings.HasPrefix(arg, "-") {
+			break
+		}
+		i++
+		switch {
+		case arg == "--":
+			break options
+		case arg == "--help":
+			opts.help = true
+			continue
+		case arg == "--version":
+			opts.version++
+			continue
+		case arg == "-dis":
+			opts.disassemble = true
+			continue
+		case arg == "-cpuprofile":
+			if i >= len(args) {
+				return nil, usageError("Argument expected for the -cpuprofile option")
+			}
+			opts.cpuprofile = args[i]
+			i++
+			continue
+		case strings.HasPrefix(arg, "-cpuprofile="):
+			opts.cpuprofile = arg[len("-cpuprofile="):]
+			continue
+		case strings.HasPrefix(arg, "--"):
+			return nil, usageError(fmt.Sprintf("unknown option %s", arg))
+		}
+		for j := 1; j < len(arg); j++ {
+			c := arg[j]
+			switch c {
+			case 'c', 'm', 'W':
+				value := arg[j+1:]
+				if value == "" {
+					if i >= len(args) {
+						return nil, usageError(fmt.Sprintf("Argument expected for the -%c option", c))
+					}
+					value = args[i]
+					i++
+				}
+				switch c {
+				case 'c':
+					opts.mode, opts.target = runCommand, value+"\n"
+					break options
+				case 'm':
+					opts.mode, opts.target = runModule, value
+					break options
+				}
+				opts.warnoptions = append(opts.warnoptions, value)
+				j = len(arg)
+			case 'b':
+				opts.flags.BytesWarning++
+			case 'B':
+				opts.flags.DontWriteBytecode = 1
+			case 'd':
+				opts.flags.Debug++
+			case 'E':
+				opts.flags.IgnoreEnvironment = 1
+			case 'h', '?':
+				opts.help = true
+			case 'i':
+				opts.flags.Inspect = 1
+				opts.flags.Interactive = 1
+			case 'O':
+				opts.flags.Optimize++
+			case 'q':
+				opts.flags.Quiet = 1
+			case 's':
+				opts.flags.NoUserSite = 1
+			case 'S':
+				opts.flags.NoSite = 1
+			case 'u':
+				opts.unbuffered = true
+			case 'v':
+				opts.flags.Verbose++
+			case 'V':
+				opts.version++
+			default:
+				return nil, usageError(fmt.Sprintf("Unknown option: -%c", c))
+			}
+		}
+	}
+	rest := args[i:]
+	switch opts.mode {
+	case runCommand:
+		opts.argv = append([]string{"-c"}, rest...)
+	case runModule:
+		opts.argv = append([]string{"-m"}, rest...)
+	default:
+		if len(rest) > 0 {
+			opts.mode, opts.target = runScript, rest[0]
+			opts.argv = rest
+		} else {
+			opts.argv = []string{""}
+		}
+	}
+	if opts.flags.IgnoreEnvironment == 0 {
+		opts.readEnvironment(getenv)
 	}
-	syntaxError()
-	fmt.Fprintf(os.Stderr, message, args...)
-	os.Exit(1)
+	return opts, nil
 }
 
-func main() {
-	flag.Usage = syntaxError
-	flag.Parse()
-	args := flag.Args()
-	py.MustGetModule("sys").Globals["argv"] = pysys.MakeArgv(args)
-	if len(args) == 0 {
+// Sets the options which weren't given on the command line from the
+// PYTHON* environment variables
+func (opts *options) readEnvironment(getenv func(string) string) {
+	// A number sets the level, anything else non empty sets it to 1
+	level := func(name string, flag *int) {
+		value := getenv(name)
+		if value == "" {
+			return
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 {
+			n = 1
+		}
+		if n > *flag {
+			*flag = n
+		}
+	}
+	level("PYTHONDEBUG", &opts.flags.Debug)
+	level("PYTHONINSPECT", &opts.flags.Inspect)
+	level("PYTHONOPTIMIZE", &opts.flags.Optimize)
+	level("PYTHONDONTWRITEBYTECODE", &opts.flags.DontWriteBytecode)
+	level("PYTHONNOUSERSITE", &opts.flags.NoUserSite)
+	level("PYTHONVERBOSE", &opts.flags.Verbose)
+	if getenv("PYTHONUNBUFFERED") != "" {
+		opts.unbuffered = true
+	}
+	if warnings := getenv("PYTHONWARNINGS"); warnings != "" {
+		opts.warnoptions = append(strings.Split(warnings, ","), opts.warnoptions...)
+	}
+}
+
+// exitError is returned to exit with its status once a message has
+// been printed
+type exitError int
 
-		fmt.Printf("Python 3.4.0 (%s, %s)\n", commit, date)
-		fmt.Printf("[Gpython %s]\n", version)
-		fmt.Printf("- os/arch: %s/%s\n", runtime.GOOS, runtime.GOARCH)
-		fmt.Printf("- go version: %s\n", runtime.Version())
+func (e exitError) Error() string {
+	return fmt.Sprintf("exit status %d", int(e))
+}
 
-		cli.RunREPL()
-		return
+// exitStatus returns the exit status for the error from running the
+// program, printing a traceback if it was an uncaught exception
+//
+// Like python, SystemExit(None) exits with 0, SystemExit(int) with
+// the int and SystemExit with anything else prints it and exits with
+// 1.
+func exitStatus(err error) int {
+	if err == nil {
+		return 0
+	}
+	if e, ok := err.(exitError); ok {
+		return int(e)
+	}
+	if !py.IsException(py.SystemExit, err) {
+		pysys.ExceptHook(py.DefaultContext, err)
+		return 1
 	}
-	prog := args[0]
-	// fmt.Printf("Running %q\n", prog)
+	var value py.Object
+	switch e := err.(type) {
+	case py.ExceptionInfo:
+		value = e.Value
+	case *py.Exception:
+		value = e
+	}
+	var code py.Object = py.None
+	if exc, ok := value.(*py.Exception); ok {
+		if args, ok := exc.Args.(py.Tuple); ok {
+			switch len(args) {
+			case 0:
+			case 1:
+				code = args[0]
+			default:
+				code = args
+			}
+		}
+	}
+	switch x := code.(type) {
+	case py.NoneType:
+		return 0
+	case py.Int:
+		return int(x)
+	case py.Bool:
+		if x {
+			return 1
+		}
+		return 0
+	}
+	if s, err := py.StrAsString(code); err == nil {
+		fmt.Fprintln(os.Stderr, s)
+	}
+	return 1
+}
+
+// Describes err from opening a file in the style of an OSError
+func osErrorString(err error) string {
+	var errno syscall.Errno
+	if errors.As(err, &errno) {
+		msg := errno.Error()
+		return fmt.Sprintf("[Errno %d] %s", int(errno), strings.ToUpper(msg[:1])+msg[1:])
+	}
+	return err.Error()
+}
+
+// Returns true if f is a terminal
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
 
-	if *cpuprofile != "" {
-		f, err := os.Create(*cpuprofile)
+// Runs code in module or disassembles it with -dis
+func (opts *options) runCode(module *py.Module, code *py.Code) error {
+	if opts.disassemble {
+		return dis.DisassembleAll(os.Stdout, code)
+	}
+	_, err := vm.Run(module.Globals, module.Globals, code, nil)
+	return err
+}
+
+// Compiles source and runs it in module
+func (opts *options) runSource(module *py.Module, source, filename string) error {
+	obj, err := compile.Compile(source, filename, "exec", 0, true)
+	if err != nil {
+		return err
+	}
+	return opts.runCode(module, obj.(*py.Code))
+}
+
+// Runs the python source or .pyc file in filename in module
+//
+// A directory or zip file runs the __main__ module inside it.
+func (opts *options) runFile(module *py.Module, filename string) error {
+	if importer, err := py.GetImporter(py.DefaultContext, filename); err == nil {
+		return opts.runApp(module, filename, importer)
+	}
+	f, err := os.Open(filename)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: can't open file '%s': %s\n", progName, filename, osErrorString(err))
+		return exitError(2)
+	}
+	defer f.Close()
+	module.Globals["__file__"] = py.String(filename)
+	if strings.HasSuffix(filename, ".pyc") {
+		obj, err := marshal.ReadPyc(f)
 		if err != nil {
-			log.Fatal(err)
+			return err
 		}
-		err = pprof.StartCPUProfile(f)
-		if err != nil {
-			log.Fatal(err)
+		code, ok := obj.(*py.Code)
+		if !ok {
+			return py.ExceptionNewf(py.RuntimeError, "Bad code object in .pyc file")
 		}
-		defer pprof.StopCPUProfile()
+		return opts.runCode(module, code)
+	}
+	source, err := ioutil.ReadAll(f)
+	if err != nil {
+		return err
+	}
+	return opts.runSource(module, string(source), filename)
+}
+
+// Runs the __main__ module from the directory or zip file app which
+// importer reads, putting app at the start of sys.path so the modules
+// next to it can be imported
+func (opts *options) runApp(module *py.Module, app string, importer py.Importer) error {
+	filename, _, err := importer.FindModule("__main__")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: can't find '__main__' module in '%s'\n", progName, app)
+		return exitError(1)
+	}
+	if path, ok := py.MustGetModule("sys").Globals["path"].(*py.List); ok {
+		path.Items = append([]py.Object{py.String(app)}, path.Items...)
 	}
+	code, err := importer.GetCode("__main__")
+	if err != nil {
+		return err
+	}
+	module.Globals["__file__"] = py.String(filename)
+	return opts.runCode(module, code)
+}
 
-	// FIXME should be using ImportModuleLevelObject() here
-	f, err := os.Open(prog)
+// Runs the module called name as __main__ like python -m
+//
+// Packages run their __main__ submodule and modules written in Go run
+// their main function.
+func (opts *options) runModule(module *py.Module, name string) error {
+	if m, err := py.GetModule(name); err == nil {
+		if _, ok := m.Globals["__file__"]; !ok {
+			fn, ok := m.Globals["main"]
+			if !ok {
+				fmt.Fprintf(os.Stderr, "%s: No code object available for %s\n", progName, name)
+				return exitError(1)
+			}
+			_, err = py.Call(fn, nil, nil)
+			return err
+		}
+	}
+	noModule := func(err error) error {
+		if py.IsException(py.ImportError, err) {
+			fmt.Fprintf(os.Stderr, "%s: No module named %s\n", progName, name)
+			return exitError(1)
+		}
+		return err
+	}
+	// Import the parent packages first
+	parts := strings.Split(name, ".")
+	for i := 1; i < len(parts); i++ {
+		_, err := py.ImportModuleLevelObject(strings.Join(parts[:i], "."), nil, nil, nil, 0)
+		if err != nil {
+			return noModule(err)
+		}
+	}
+	spec, err := py.FindModule(name, nil)
 	if err != nil {
-		log.Fatalf("Failed to open %q: %v", prog, err)
+		return noModule(err)
 	}
-	var obj py.Object
-	if strings.HasSuffix(prog, ".pyc") {
-		obj, err = marshal.ReadPyc(f)
+	pkg := strings.Join(parts[:len(parts)-1], ".")
+	if spec.IsPackage {
+		_, err = py.ImportModuleLevelObject(name, nil, nil, nil, 0)
 		if err != nil {
-			log.Fatalf("Failed to marshal %q: %v", prog, err)
+			return err
+		}
+		spec, err = py.FindModule(name+".__main__", nil)
+		if py.IsException(py.ImportError, err) {
+			fmt.Fprintf(os.Stderr, "%s: No module named %s.__main__; '%s' is a package and cannot be directly executed\n", progName, name, name)
+			return exitError(1)
 		}
-	} else if strings.HasSuffix(prog, ".py") {
-		str, err := ioutil.ReadAll(f)
 		if err != nil {
-			log.Fatalf("Failed to read %q: %v", prog, err)
+			return err
+		}
+		pkg = name
+	}
+	argv := py.MustGetModule("sys").Globals["argv"]
+	if list, ok := argv.(*py.List); ok && len(list.Items) > 0 {
+		list.Items[0] = py.String(spec.Filename)
+	}
+	code, err := spec.Importer.GetCode(spec.Name)
+	if err != nil {
+		return err
+	}
+	module.Globals["__file__"] = py.String(spec.Filename)
+	module.Globals["__package__"] = py.String(pkg)
+	return opts.runCode(module, code)
+}
+
+// run runs the program the options ask for returning the exit status
+func (opts *options) run() int {
+	module := py.DefaultContext.NewModule("__main__", "", nil, nil)
+	var err error
+	switch opts.mode {
+	case runCommand:
+		err = opts.runSource(module, opts.target, "<string>")
+	case runModule:
+		err = opts.runModule(module, opts.target)
+	case runScript:
+		if opts.target == "-" {
+			err = opts.runStdin(module)
+		} else {
+			err = opts.runFile(module, opts.target)
+		}
+	default:
+		if opts.flags.Inspect == 0 && !isTerminal(os.Stdin) {
+			err = opts.runStdin(module)
+			break
+		}
+		if opts.flags.Quiet == 0 {
+			fmt.Printf("Python %s (%s, %s)\n", pythonVersion, commit, date)
+			fmt.Printf("[Gpython %s]\n", version)
+			fmt.Printf("- os/arch: %s/%s\n", runtime.GOOS, runtime.GOARCH)
+			fmt.Printf("- go version: %s\n", runtime.Version())
+		}
+		module.Globals["__file__"] = py.String("<stdin>")
+		cli.RunREPLWithModule(module)
+		return 0
+	}
+	if opts.flags.Inspect != 0 {
+		if _, ok := err.(exitError); ok {
+			return exitStatus(err)
 		}
-		obj, err = compile.Compile(string(str), prog, "exec", 0, true)
 		if err != nil {
-			log.Fatalf("Can't compile %q: %v", prog, err)
+			pysys.ExceptHook(py.DefaultContext, err)
 		}
-	} else {
-		log.Fatalf("Can't execute %q", prog)
+		cli.RunREPLWithModule(module)
+		return 0
 	}
-	if err = f.Close(); err != nil {
-		log.Fatalf("Failed to close %q: %v", prog, err)
+	return exitStatus(err)
+}
+
+// Reads the program from stdin and runs it in module
+func (opts *options) runStdin(module *py.Module) error {
+	source, err := ioutil.ReadAll(os.Stdin)
+	if err != nil && err != io.EOF {
+		return err
 	}
-	code := obj.(*py.Code)
-	module := py.NewModule("__main__", "", nil, nil)
-	module.Globals["__file__"] = py.String(prog)
-	res, err := vm.Run(module.Globals, module.Globals, code, nil)
+	return opts.runSource(module, string(source), "<stdin>")
+}
+
+func main() {
+	progName = os.Args[0]
+	opts, err := parseArgs(os.Args[1:], os.Getenv)
 	if err != nil {
-		py.TracebackDump(err)
-		log.Fatal(err)
+		fmt.Fprintf(os.Stderr, "%v\n"+usage+"Try `%s -h' for more information.\n", err, progName, progName)
+		os.Exit(2)
 	}
-	// fmt.Printf("Return = %v\n", res)
-	_ = res
+	if opts.help {
+		fmt.Printf(usage+help, progName)
+		os.Exit(0)
+	}
+	if opts.version > 0 {
+		if opts.version > 1 {
+			fmt.Printf("Python %s (%s, %s)\n[Gpython %s]\n", pythonVersion, commit, date, version)
+		} else {
+			fmt.Printf("Python %s\n", pythonVersion)
+		}
+		os.Exit(0)
+	}
+	// stdout and stderr are unbuffered so -u needs nothing doing
+	compile.Optimize = opts.flags.Optimize
+	py.MustGetModule("builtins").Globals["__debug__"] = py.NewBool(opts.flags.Optimize == 0)
+	pysys.SetFlags(opts.flags)
+	sys := py.MustGetModule("sys")
+	sys.Globals["argv"] = pysys.MakeArgv(opts.argv)
+	sys.Globals["warnoptions"] = pysys.MakeArgv(opts.warnoptions)
 
+	if opts.cpuprofile != "" {
+		f, err := os.Create(opts.cpuprofile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = pprof.StartCPUProfile(f)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	status := opts.run()
+	py.DefaultContext.Finalize()
+	if opts.cpuprofile != "" {
+		pprof.StopCPUProfile()
+	}
+	os.Exit(status)
 }
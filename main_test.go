@@ -0,0 +1,142 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-python/gpython/py"
+	pysys "github.com/go-python/gpython/sys"
+)
+
+func TestParseArgs(t *testing.T) {
+	for _, test := range []struct {
+		args []string
+		env  map[string]string
+		want options
+		err  string
+	}{
+		{
+			args: nil,
+			want: options{argv: []string{""}},
+		},
+		{
+			args: []string{"prog.py", "-c", "x"},
+			want: options{argv: []string{"prog.py", "-c", "x"}, mode: runScript, target: "prog.py"},
+		},
+		{
+			args: []string{"-", "a"},
+			want: options{argv: []string{"-", "a"}, mode: runScript, target: "-"},
+		},
+		{
+			args: []string{"-c", "print(1)", "-O", "a"},
+			want: options{argv: []string{"-c", "-O", "a"}, mode: runCommand, target: "print(1)\n"},
+		},
+		{
+			args: []string{"-Bicprint(1)"},
+			want: options{
+				flags:  pysys.Flags{DontWriteBytecode: 1, Inspect: 1, Interactive: 1},
+				argv:   []string{"-c"},
+				mode:   runCommand,
+				target: "print(1)\n",
+			},
+		},
+		{
+			args: []string{"-OO", "-m", "pkg.mod", "-i"},
+			want: options{flags: pysys.Flags{Optimize: 2}, argv: []string{"-m", "-i"}, mode: runModule, target: "pkg.mod"},
+		},
+		{
+			args: []string{"-Werror", "-W", "ignore", "-u", "--", "-x"},
+			want: options{warnoptions: []string{"error", "ignore"}, unbuffered: true, argv: []string{"-x"}, mode: runScript, target: "-x"},
+		},
+		{
+			args: []string{"-dis", "-cpuprofile", "prof", "x.py"},
+			want: options{disassemble: true, cpuprofile: "prof", argv: []string{"x.py"}, mode: runScript, target: "x.py"},
+		},
+		{
+			args: []string{"-cpuprofile=prof", "-hVV", "--version", "--help"},
+			want: options{cpuprofile: "prof", help: true, version: 3, argv: []string{""}},
+		},
+		{
+			args: []string{"-W", "x"},
+			env:  map[string]string{"PYTHONWARNINGS": "a,b", "PYTHONOPTIMIZE": "2", "PYTHONINSPECT": "y", "PYTHONUNBUFFERED": "1"},
+			want: options{
+				flags:       pysys.Flags{Optimize: 2, Inspect: 1},
+				warnoptions: []string{"a", "b", "x"},
+				unbuffered:  true,
+				argv:        []string{""},
+			},
+		},
+		{
+			args: []string{"-E"},
+			env:  map[string]string{"PYTHONWARNINGS": "a,b", "PYTHONOPTIMIZE": "2"},
+			want: options{flags: pysys.Flags{IgnoreEnvironment: 1}, argv: []string{""}},
+		},
+		{
+			args: []string{"-Oz"},
+			err:  "Unknown option: -z",
+		},
+		{
+			args: []string{"--nope"},
+			err:  "unknown option --nope",
+		},
+		{
+			args: []string{"-O", "-m"},
+			err:  "Argument expected for the -m option",
+		},
+		{
+			args: []string{"-cpuprofile"},
+			err:  "Argument expected for the -cpuprofile option",
+		},
+	} {
+		getenv := func(name string) string {
+			return test.env[name]
+		}
+		got, err := parseArgs(test.args, getenv)
+		if test.err != "" {
+			if err == nil || err.Error() != test.err {
+				t.Errorf("%q: want error %q got %v", test.args, test.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error %v", test.args, err)
+			continue
+		}
+		if !reflect.DeepEqual(*got, test.want) {
+			t.Errorf("%q:\n got %+v\nwant %+v", test.args, *got, test.want)
+		}
+	}
+}
+
+func TestExitStatus(t *testing.T) {
+	systemExit := func(args ...py.Object) error {
+		exc, err := py.ExceptionNew(py.SystemExit, py.Tuple(args), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return py.ExceptionInfo{Type: py.SystemExit, Value: exc}
+	}
+	for _, test := range []struct {
+		err  error
+		want int
+	}{
+		{nil, 0},
+		{exitError(2), 2},
+		{systemExit(), 0},
+		{systemExit(py.None), 0},
+		{systemExit(py.Int(3)), 3},
+		{systemExit(py.True), 1},
+		{systemExit(py.False), 0},
+		{systemExit(py.String("message")), 1},
+		{systemExit(py.Int(1), py.Int(2)), 1},
+	} {
+		got := exitStatus(test.err)
+		if got != test.want {
+			t.Errorf("%v: want %d got %d", test.err, test.want, got)
+		}
+	}
+}
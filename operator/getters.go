@@ -0,0 +1,246 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// attrgetter, itemgetter and methodcaller objects
+
+package operator
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/go-python/gpython/py"
+)
+
+// Returns the result of fn for each of items as the single result if
+// there is only one item or a tuple of them otherwise
+func getAll(n int, fn func(i int) (py.Object, error)) (py.Object, error) {
+	if n == 1 {
+		return fn(0)
+	}
+	res := make(py.Tuple, n)
+	for i := range res {
+		var err error
+		res[i], err = fn(i)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// Writes the type name and the reprs of args to out as a call
+func reprCall(out *strings.Builder, name string, args py.Tuple) error {
+	out.WriteString(name)
+	out.WriteString("(")
+	for i, arg := range args {
+		repr, err := py.ReprAsString(arg)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			out.WriteString(", ")
+		}
+		out.WriteString(repr)
+	}
+	return nil
+}
+
+// A python attrgetter object
+type AttrGetter struct {
+	attrs [][]string // each attribute split on "."
+	names py.Tuple   // the attribute names as passed in
+}
+
+var AttrGetterType = py.NewTypeX("operator.attrgetter", `attrgetter(attr, ...) --> attrgetter object
+
+Return a callable object that fetches the given attribute(s) from its operand.
+After f = attrgetter('name'), the call f(r) returns r.name.
+After g = attrgetter('name', 'date'), the call g(r) returns (r.name, r.date).
+After h = attrgetter('name.first', 'name.last'), the call h(r) returns
+(r.name.first, r.name.last).`, AttrGetterNew, nil)
+
+// Type of this object
+func (a *AttrGetter) Type() *py.Type {
+	return AttrGetterType
+}
+
+// AttrGetterNew makes an attrgetter object
+func AttrGetterNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	if len(kwargs) != 0 {
+		return nil, py.ExceptionNewf(py.TypeError, "attrgetter() takes no keyword arguments")
+	}
+	if len(args) == 0 {
+		return nil, py.ExceptionNewf(py.TypeError, "attrgetter expected 1 argument, got 0")
+	}
+	a := &AttrGetter{
+		attrs: make([][]string, len(args)),
+		names: args.Copy(),
+	}
+	for i, arg := range args {
+		name, ok := arg.(py.String)
+		if !ok {
+			return nil, py.ExceptionNewf(py.TypeError, "attribute name must be a string")
+		}
+		a.attrs[i] = strings.Split(string(name), ".")
+	}
+	return a, nil
+}
+
+func (a *AttrGetter) M__call__(args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var obj py.Object
+	err := py.UnpackTuple(args, kwargs, "attrgetter", 1, 1, &obj)
+	if err != nil {
+		return nil, err
+	}
+	return getAll(len(a.attrs), func(i int) (py.Object, error) {
+		res := obj
+		for _, name := range a.attrs[i] {
+			res, err = py.GetAttrString(res, name)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return res, nil
+	})
+}
+
+func (a *AttrGetter) M__repr__() (py.Object, error) {
+	var out strings.Builder
+	err := reprCall(&out, AttrGetterType.Name, a.names)
+	if err != nil {
+		return nil, err
+	}
+	out.WriteString(")")
+	return py.String(out.String()), nil
+}
+
+// A python itemgetter object
+type ItemGetter struct {
+	items py.Tuple
+}
+
+var ItemGetterType = py.NewTypeX("operator.itemgetter", `itemgetter(item, ...) --> itemgetter object
+
+Return a callable object that fetches the given item(s) from its operand.
+After f = itemgetter(2), the call f(r) returns r[2].
+After g = itemgetter(2, 5, 3), the call g(r) returns (r[2], r[5], r[3])`, ItemGetterNew, nil)
+
+// Type of this object
+func (g *ItemGetter) Type() *py.Type {
+	return ItemGetterType
+}
+
+// ItemGetterNew makes an itemgetter object
+func ItemGetterNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	if len(kwargs) != 0 {
+		return nil, py.ExceptionNewf(py.TypeError, "itemgetter() takes no keyword arguments")
+	}
+	if len(args) == 0 {
+		return nil, py.ExceptionNewf(py.TypeError, "itemgetter expected 1 argument, got 0")
+	}
+	return &ItemGetter{items: args.Copy()}, nil
+}
+
+func (g *ItemGetter) M__call__(args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var obj py.Object
+	err := py.UnpackTuple(args, kwargs, "itemgetter", 1, 1, &obj)
+	if err != nil {
+		return nil, err
+	}
+	return getAll(len(g.items), func(i int) (py.Object, error) {
+		return py.GetItem(obj, g.items[i])
+	})
+}
+
+func (g *ItemGetter) M__repr__() (py.Object, error) {
+	var out strings.Builder
+	err := reprCall(&out, ItemGetterType.Name, g.items)
+	if err != nil {
+		return nil, err
+	}
+	out.WriteString(")")
+	return py.String(out.String()), nil
+}
+
+// A python methodcaller object
+type MethodCaller struct {
+	name   string
+	args   py.Tuple
+	kwargs py.StringDict
+}
+
+var MethodCallerType = py.NewTypeX("operator.methodcaller", `methodcaller(name, ...) --> methodcaller object
+
+Return a callable object that calls the given method on its operand.
+After f = methodcaller('name'), the call f(r) returns r.name().
+After g = methodcaller('name', 'date', foo=1), the call g(r) returns
+r.name('date', foo=1).`, MethodCallerNew, nil)
+
+// Type of this object
+func (m *MethodCaller) Type() *py.Type {
+	return MethodCallerType
+}
+
+// MethodCallerNew makes a methodcaller object
+func MethodCallerNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	if len(args) == 0 {
+		return nil, py.ExceptionNewf(py.TypeError, "methodcaller needs at least one argument, the method name")
+	}
+	name, ok := args[0].(py.String)
+	if !ok {
+		return nil, py.ExceptionNewf(py.TypeError, "method name must be a string")
+	}
+	return &MethodCaller{
+		name:   string(name),
+		args:   args[1:].Copy(),
+		kwargs: kwargs.Copy(),
+	}, nil
+}
+
+func (m *MethodCaller) M__call__(args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var obj py.Object
+	err := py.UnpackTuple(args, kwargs, "methodcaller", 1, 1, &obj)
+	if err != nil {
+		return nil, err
+	}
+	method, err := py.GetAttrString(obj, m.name)
+	if err != nil {
+		return nil, err
+	}
+	return py.Call(method, m.args, m.kwargs)
+}
+
+func (m *MethodCaller) M__repr__() (py.Object, error) {
+	var out strings.Builder
+	err := reprCall(&out, MethodCallerType.Name, append(py.Tuple{py.String(m.name)}, m.args...))
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(m.kwargs))
+	for name := range m.kwargs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		repr, err := py.ReprAsString(m.kwargs[name])
+		if err != nil {
+			return nil, err
+		}
+		out.WriteString(", ")
+		out.WriteString(name)
+		out.WriteString("=")
+		out.WriteString(repr)
+	}
+	out.WriteString(")")
+	return py.String(out.String()), nil
+}
+
+// Check interface is satisfied
+var _ py.I__call__ = (*AttrGetter)(nil)
+var _ py.I__repr__ = (*AttrGetter)(nil)
+var _ py.I__call__ = (*ItemGetter)(nil)
+var _ py.I__repr__ = (*ItemGetter)(nil)
+var _ py.I__call__ = (*MethodCaller)(nil)
+var _ py.I__repr__ = (*MethodCaller)(nil)
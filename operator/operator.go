@@ -0,0 +1,287 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package operator implements the python operator module
+//
+// The functions dispatch to the same py helpers the virtual machine
+// uses for the operators so they behave exactly like them.
+package operator
+
+import (
+	"github.com/go-python/gpython/py"
+)
+
+const module_doc = `Operator interface.
+
+This module exports a set of functions implemented in Go corresponding
+to the intrinsic operators of Python.  For example, operator.add(x, y)
+is equivalent to the expression x+y.  The function names are those
+used for special methods; variants without leading and trailing
+'__' are also provided for convenience.`
+
+// A function of one argument
+type unaryOp struct {
+	name   string
+	dunder bool // whether __name__ is an alias
+	fn     func(a py.Object) (py.Object, error)
+	doc    string
+}
+
+// A function of two arguments
+type binaryOp struct {
+	name   string
+	dunder bool // whether __name__ is an alias
+	fn     func(a, b py.Object) (py.Object, error)
+	doc    string
+}
+
+// Returns pow without a modulus
+func pow(a, b py.Object) (py.Object, error) {
+	return py.Pow(a, b, py.None)
+}
+
+// Returns inplace pow without a modulus
+func ipow(a, b py.Object) (py.Object, error) {
+	return py.IPow(a, b, py.None)
+}
+
+// Returns an error if a isn't a sequence which can be concatenated
+func checkConcat(a py.Object) error {
+	if _, ok := a.(py.I__getitem__); !ok {
+		return py.ExceptionNewf(py.TypeError, "'%s' object can't be concatenated", a.Type().Name)
+	}
+	return nil
+}
+
+var unaryOps = []unaryOp{
+	{"not_", false, py.Not, "Same as not a."},
+	{"truth", false, py.MakeBool, "Return True if a is true, False otherwise."},
+	{"abs", true, py.Abs, "Same as abs(a)."},
+	{"index", true, func(a py.Object) (py.Object, error) { return py.Index(a) }, "Same as a.__index__()"},
+	{"inv", true, py.Invert, "Same as ~a."},
+	{"invert", true, py.Invert, "Same as ~a."},
+	{"neg", true, py.Neg, "Same as -a."},
+	{"pos", true, py.Pos, "Same as +a."},
+}
+
+var binaryOps = []binaryOp{
+	{"lt", true, py.Lt, "Same as a < b."},
+	{"le", true, py.Le, "Same as a <= b."},
+	{"eq", true, py.Eq, "Same as a == b."},
+	{"ne", true, py.Ne, "Same as a != b."},
+	{"ge", true, py.Ge, "Same as a >= b."},
+	{"gt", true, py.Gt, "Same as a > b."},
+	{"is_", false, func(a, b py.Object) (py.Object, error) { return py.NewBool(py.Is(a, b)), nil }, "Same as a is b."},
+	{"is_not", false, func(a, b py.Object) (py.Object, error) { return py.NewBool(!py.Is(a, b)), nil }, "Same as a is not b."},
+	{"add", true, py.Add, "Same as a + b."},
+	{"and_", false, py.And, "Same as a & b."},
+	{"floordiv", true, py.FloorDiv, "Same as a // b."},
+	{"lshift", true, py.Lshift, "Same as a << b."},
+	{"mod", true, py.Mod, "Same as a % b."},
+	{"mul", true, py.Mul, "Same as a * b."},
+	{"matmul", true, py.MatMul, "Same as a @ b."},
+	{"or_", false, py.Or, "Same as a | b."},
+	{"pow", true, pow, "Same as a ** b."},
+	{"rshift", true, py.Rshift, "Same as a >> b."},
+	{"sub", true, py.Sub, "Same as a - b."},
+	{"truediv", true, py.TrueDiv, "Same as a / b."},
+	{"xor", true, py.Xor, "Same as a ^ b."},
+	{"iadd", true, py.IAdd, "Same as a += b."},
+	{"iand", true, py.IAnd, "Same as a &= b."},
+	{"ifloordiv", true, py.IFloorDiv, "Same as a //= b."},
+	{"ilshift", true, py.ILshift, "Same as a <<= b."},
+	{"imod", true, py.IMod, "Same as a %= b."},
+	{"imul", true, py.IMul, "Same as a *= b."},
+	{"imatmul", true, py.IMatMul, "Same as a @= b."},
+	{"ior", true, py.IOr, "Same as a |= b."},
+	{"ipow", true, ipow, "Same as a **= b."},
+	{"irshift", true, py.IRshift, "Same as a >>= b."},
+	{"isub", true, py.ISub, "Same as a -= b."},
+	{"itruediv", true, py.ITrueDiv, "Same as a /= b."},
+	{"ixor", true, py.IXor, "Same as a ^= b."},
+	{"concat", true, func(a, b py.Object) (py.Object, error) {
+		if err := checkConcat(a); err != nil {
+			return nil, err
+		}
+		return py.Add(a, b)
+	}, "Same as a + b, for a and b sequences."},
+	{"iconcat", true, func(a, b py.Object) (py.Object, error) {
+		if err := checkConcat(a); err != nil {
+			return nil, err
+		}
+		return py.IAdd(a, b)
+	}, "Same as a += b, for a and b sequences."},
+	{"contains", true, func(a, b py.Object) (py.Object, error) {
+		found, err := py.SequenceContains(a, b)
+		if err != nil {
+			return nil, err
+		}
+		return py.NewBool(found), nil
+	}, "Same as b in a (note reversed operands)."},
+	{"countOf", false, countOf, "Return the number of times b occurs in a."},
+	{"indexOf", false, indexOf, "Return the first index of b in a."},
+	{"getitem", true, py.GetItem, "Same as a[b]."},
+	{"delitem", true, func(a, b py.Object) (py.Object, error) {
+		_, err := py.DelItem(a, b)
+		if err != nil {
+			return nil, err
+		}
+		return py.None, nil
+	}, "Same as del a[b]."},
+}
+
+// Calls fn with each item of a which is b
+//
+// Items are b if they are the same object or equal to it.  If fn
+// returns true the iteration stops.
+func findItems(a, b py.Object, fn func(i int) bool) error {
+	i := 0
+	var err error
+	iterErr := py.Iterate(a, func(item py.Object) bool {
+		found := py.Is(item, b)
+		if !found {
+			var eq py.Object
+			eq, err = py.Eq(item, b)
+			if err != nil {
+				return true
+			}
+			found = eq == py.True
+		}
+		if found && fn(i) {
+			return true
+		}
+		i++
+		return false
+	})
+	if iterErr != nil {
+		return iterErr
+	}
+	return err
+}
+
+func countOf(a, b py.Object) (py.Object, error) {
+	n := 0
+	err := findItems(a, b, func(i int) bool {
+		n++
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	return py.Int(n), nil
+}
+
+func indexOf(a, b py.Object) (py.Object, error) {
+	index := -1
+	err := findItems(a, b, func(i int) bool {
+		index = i
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if index < 0 {
+		return nil, py.ExceptionNewf(py.ValueError, "sequence.index(x): x not in sequence")
+	}
+	return py.Int(index), nil
+}
+
+const setitem_doc = `Same as a[b] = c.`
+
+func operator_setitem(self py.Object, args py.Tuple) (py.Object, error) {
+	var a, b, c py.Object
+	err := py.UnpackTuple(args, nil, "setitem", 3, 3, &a, &b, &c)
+	if err != nil {
+		return nil, err
+	}
+	_, err = py.SetItem(a, b, c)
+	if err != nil {
+		return nil, err
+	}
+	return py.None, nil
+}
+
+const length_hint_doc = `Return an estimate of the number of items in obj.
+
+This is useful for presizing containers when building from an iterable.
+
+If the object supports len(), the result will be exact.
+Otherwise, it may over- or under-estimate by an arbitrary amount.
+The result will be an integer >= 0.`
+
+func operator_length_hint(self py.Object, args py.Tuple) (py.Object, error) {
+	var obj py.Object
+	var def py.Object = py.Int(0)
+	err := py.UnpackTuple(args, nil, "length_hint", 1, 2, &obj, &def)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := def.(py.Int); !ok {
+		return nil, py.ExceptionNewf(py.TypeError, "'%s' object cannot be interpreted as an integer", def.Type().Name)
+	}
+	n, err := py.Len(obj)
+	if err == nil {
+		return n, nil
+	}
+	if !py.IsException(py.TypeError, err) {
+		return nil, err
+	}
+	hint, err := py.GetAttrString(obj, "__length_hint__")
+	if err != nil {
+		return def, nil
+	}
+	res, err := py.Call(hint, nil, nil)
+	if err != nil {
+		if py.IsException(py.TypeError, err) {
+			return def, nil
+		}
+		return nil, err
+	}
+	if res == py.NotImplemented {
+		return def, nil
+	}
+	i, err := py.IndexInt(res)
+	if err != nil {
+		return nil, py.ExceptionNewf(py.TypeError, "__length_hint__ must be an integer, not %s", res.Type().Name)
+	}
+	if i < 0 {
+		return nil, py.ExceptionNewf(py.ValueError, "__length_hint__() should return >= 0")
+	}
+	return py.Int(i), nil
+}
+
+func init() {
+	var methods []*py.Method
+	add := func(name string, fn interface{}, doc string, dunder bool) {
+		methods = append(methods, py.MustNewMethod(name, fn, 0, doc))
+		if dunder {
+			methods = append(methods, py.MustNewMethod("__"+name+"__", fn, 0, doc))
+		}
+	}
+	for _, op := range unaryOps {
+		op := op
+		add(op.name, func(self, a py.Object) (py.Object, error) {
+			return op.fn(a)
+		}, op.doc, op.dunder)
+	}
+	for _, op := range binaryOps {
+		op := op
+		add(op.name, func(self py.Object, args py.Tuple) (py.Object, error) {
+			var a, b py.Object
+			err := py.UnpackTuple(args, nil, op.name, 2, 2, &a, &b)
+			if err != nil {
+				return nil, err
+			}
+			return op.fn(a, b)
+		}, op.doc, op.dunder)
+	}
+	add("setitem", operator_setitem, setitem_doc, true)
+	add("length_hint", operator_length_hint, length_hint_doc, false)
+	globals := py.StringDict{
+		"attrgetter":   AttrGetterType,
+		"itemgetter":   ItemGetterType,
+		"methodcaller": MethodCallerType,
+	}
+	py.NewModule("operator", module_doc, methods, globals)
+}
@@ -0,0 +1,100 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+import operator
+from operator import attrgetter, itemgetter, methodcaller
+from libtest import assertRaises, assertRaisesText
+
+doc = "comparison"
+assert operator.lt(1, 2) and not operator.lt(2, 1)
+assert operator.le(2, 2) and operator.__le__(1, 2)
+assert operator.eq("a", "a") and operator.ne("a", "b")
+assert operator.ge(3, 2) and operator.gt(3, 2) and operator.__gt__(3, 2)
+assertRaises(TypeError, operator.lt, 1)
+
+doc = "logical"
+assert operator.not_(0) is True and operator.not_(1) is False
+assert operator.truth([1]) is True and operator.truth([]) is False
+a = [1]
+b = [1]
+assert operator.is_(a, a) and not operator.is_(a, b)
+assert operator.is_not(a, b) and not operator.is_not(a, a)
+assert operator.is_(None, None)
+d = {}
+assert operator.is_(d, d) and operator.is_not(d, {})
+
+doc = "arithmetic"
+assert operator.abs(-3) == 3 and operator.__abs__(-3) == 3
+assert operator.add(1, 2) == 3 and operator.__add__("a", "b") == "ab"
+assert operator.and_(6, 3) == 2 and operator.or_(6, 3) == 7 and operator.xor(6, 3) == 5
+assert operator.floordiv(7, 2) == 3 and operator.truediv(7, 2) == 3.5
+assert operator.index(5) == 5
+assert operator.inv(5) == -6 and operator.invert(5) == -6
+assert operator.lshift(1, 4) == 16 and operator.rshift(16, 4) == 1
+assert operator.mod(7, 3) == 1 and operator.mul(3, 4) == 12
+assert operator.neg(3) == -3 and operator.pos(-3) == -3
+assert operator.pow(2, 10) == 1024 and operator.__pow__(3, 2) == 9
+assert operator.sub(5, 3) == 2
+assertRaises(TypeError, operator.add, 1, "a")
+
+doc = "sequences"
+assert operator.concat([1], [2]) == [1, 2]
+assertRaisesText(TypeError, "'int' object can't be concatenated", operator.concat, 1, 2)
+assert operator.contains([1, 2], 2) and not operator.contains([1, 2], 3)
+assert operator.countOf([1, 2, 1, 1], 1) == 3
+assert operator.indexOf([4, 5, 6], 6) == 2
+assertRaisesText(ValueError, "sequence.index(x): x not in sequence", operator.indexOf, [1], 2)
+l = [1, 2, 3]
+assert operator.getitem(l, 1) == 2
+assert operator.setitem(l, 1, 5) is None and l == [1, 5, 3]
+assert operator.delitem(l, 0) is None and l == [5, 3]
+assert operator.length_hint([1, 2]) == 2
+assert operator.length_hint(iter(range(5)), 7) >= 0
+assert operator.length_hint(1, 7) == 7
+
+doc = "inplace"
+l = [1]
+r = operator.iadd(l, [2])
+assert r is l and l == [1, 2]
+r = operator.iconcat(l, [3])
+assert r is l and l == [1, 2, 3]
+assert operator.iadd(1, 2) == 3 and operator.isub(5, 2) == 3
+assert operator.imul(2, 3) == 6 and operator.ipow(2, 3) == 8
+assert operator.ifloordiv(7, 2) == 3 and operator.itruediv(7, 2) == 3.5
+assert operator.imod(7, 2) == 1 and operator.ilshift(1, 2) == 4 and operator.irshift(4, 2) == 1
+assert operator.iand(6, 3) == 2 and operator.ior(6, 3) == 7 and operator.ixor(6, 3) == 5
+
+doc = "attrgetter"
+class Name:
+    def __init__(self, first, last):
+        self.first = first
+        self.last = last
+class Person:
+    def __init__(self, first, last, age):
+        self.name = Name(first, last)
+        self.age = age
+p = Person("Ada", "Lovelace", 36)
+assert attrgetter("age")(p) == 36
+assert attrgetter("name.first", "name.last")(p) == ("Ada", "Lovelace")
+assert repr(attrgetter("name.first")) == "operator.attrgetter('name.first')"
+assertRaises(AttributeError, attrgetter("missing"), p)
+assertRaisesText(TypeError, "attribute name must be a string", attrgetter, 1)
+
+doc = "itemgetter"
+assert itemgetter(1)("abc") == "b"
+assert itemgetter(0, 2)([4, 5, 6]) == (4, 6)
+assert itemgetter("k")({"k": 1}) == 1
+assert repr(itemgetter(1, "a")) == "operator.itemgetter(1, 'a')"
+assertRaises(IndexError, itemgetter(5), [1])
+
+doc = "methodcaller"
+class Greeter:
+    def greet(self, name, punctuation="!"):
+        return "hello " + name + punctuation
+assert methodcaller("greet", "bob")(Greeter()) == "hello bob!"
+assert methodcaller("greet", "bob", punctuation="?")(Greeter()) == "hello bob?"
+assert repr(methodcaller("greet", "bob", punctuation="?")) == "operator.methodcaller('greet', 'bob', punctuation='?')"
+assertRaisesText(TypeError, "method name must be a string", methodcaller, 1)
+
+doc = "finished"
@@ -0,0 +1,265 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// os.environ mapping
+
+package os
+
+import (
+	"bytes"
+	"os"
+	"strings"
+
+	"github.com/go-python/gpython/py"
+)
+
+var EnvironType = py.NewType("_Environ", `Mapping of the environment variables of the process.
+
+Setting and deleting items also changes the environment with putenv and
+unsetenv.`)
+
+// Environ is the type of os.environ
+//
+// It is a copy of the environment made when the module is loaded which
+// is kept in step with the real environment when it is changed.  The
+// keys are kept in the order they were first set.
+type Environ struct {
+	keys   []string
+	values map[string]string
+}
+
+// The os.environ mapping
+var environ = newEnviron(os.Environ())
+
+// Type of this object
+func (e *Environ) Type() *py.Type {
+	return EnvironType
+}
+
+// Makes an Environ from "key=value" strings
+func newEnviron(env []string) *Environ {
+	e := &Environ{
+		values: make(map[string]string, len(env)),
+	}
+	for _, kv := range env {
+		i := strings.Index(kv, "=")
+		// Skip the "=C:=C:\dir" entries windows has
+		if i <= 0 {
+			continue
+		}
+		e.set(kv[:i], kv[i+1:])
+	}
+	return e
+}
+
+// Sets key to value without changing the environment
+func (e *Environ) set(key, value string) {
+	if _, found := e.values[key]; !found {
+		e.keys = append(e.keys, key)
+	}
+	e.values[key] = value
+}
+
+// Removes key without changing the environment
+func (e *Environ) remove(key string) {
+	delete(e.values, key)
+	for i, k := range e.keys {
+		if k == key {
+			e.keys = append(e.keys[:i], e.keys[i+1:]...)
+			break
+		}
+	}
+}
+
+// Checks the key and value for putenv and os.environ are str
+func envArgs(key, value py.Object) (string, string, error) {
+	k, ok := key.(py.String)
+	if !ok {
+		return "", "", py.ExceptionNewf(py.TypeError, "str expected, not %s", key.Type().Name)
+	}
+	v, ok := value.(py.String)
+	if !ok {
+		return "", "", py.ExceptionNewf(py.TypeError, "str expected, not %s", value.Type().Name)
+	}
+	if k == "" || strings.ContainsRune(string(k), '=') {
+		return "", "", py.ExceptionNewf(py.ValueError, "illegal environment variable name")
+	}
+	return string(k), string(v), nil
+}
+
+func (e *Environ) M__getitem__(key py.Object) (py.Object, error) {
+	if k, ok := key.(py.String); ok {
+		if value, found := e.values[string(k)]; found {
+			return py.String(value), nil
+		}
+	}
+	return nil, py.ExceptionNewf(py.KeyError, "%v", key)
+}
+
+func (e *Environ) M__setitem__(key, value py.Object) (py.Object, error) {
+	k, v, err := envArgs(key, value)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Setenv(k, v); err != nil {
+		return nil, osError(err, nil)
+	}
+	e.set(k, v)
+	return py.None, nil
+}
+
+func (e *Environ) M__delitem__(key py.Object) (py.Object, error) {
+	k, ok := key.(py.String)
+	if !ok {
+		return nil, py.ExceptionNewf(py.KeyError, "%v", key)
+	}
+	if _, found := e.values[string(k)]; !found {
+		return nil, py.ExceptionNewf(py.KeyError, "%v", key)
+	}
+	if err := os.Unsetenv(string(k)); err != nil {
+		return nil, osError(err, nil)
+	}
+	e.remove(string(k))
+	return py.None, nil
+}
+
+func (e *Environ) M__contains__(key py.Object) (py.Object, error) {
+	k, ok := key.(py.String)
+	if !ok {
+		return py.False, nil
+	}
+	_, found := e.values[string(k)]
+	return py.NewBool(found), nil
+}
+
+func (e *Environ) M__len__() (py.Object, error) {
+	return py.Int(len(e.keys)), nil
+}
+
+func (e *Environ) M__iter__() (py.Object, error) {
+	return py.NewIterator(e.keyList()), nil
+}
+
+func (e *Environ) M__repr__() (py.Object, error) {
+	var out bytes.Buffer
+	out.WriteString("environ({")
+	for i, key := range e.keys {
+		if i > 0 {
+			out.WriteString(", ")
+		}
+		for j, s := range []string{key, e.values[key]} {
+			repr, err := py.ReprAsString(py.String(s))
+			if err != nil {
+				return nil, err
+			}
+			if j > 0 {
+				out.WriteString(": ")
+			}
+			out.WriteString(repr)
+		}
+	}
+	out.WriteString("})")
+	return py.String(out.String()), nil
+}
+
+// Returns the keys as python strings
+func (e *Environ) keyList() []py.Object {
+	keys := make([]py.Object, len(e.keys))
+	for i, key := range e.keys {
+		keys[i] = py.String(key)
+	}
+	return keys
+}
+
+// Check interfaces are satisfied
+var _ py.I__getitem__ = (*Environ)(nil)
+var _ py.I__setitem__ = (*Environ)(nil)
+var _ py.I__delitem__ = (*Environ)(nil)
+var _ py.I__contains__ = (*Environ)(nil)
+var _ py.I__len__ = (*Environ)(nil)
+var _ py.I__iter__ = (*Environ)(nil)
+var _ py.I__repr__ = (*Environ)(nil)
+
+func init() {
+	self := func(o py.Object) *Environ {
+		return o.(*Environ)
+	}
+	EnvironType.Dict["get"] = py.MustNewMethod("get", func(o py.Object, args py.Tuple) (py.Object, error) {
+		var key py.Object
+		var def py.Object = py.None
+		err := py.UnpackTuple(args, nil, "get", 1, 2, &key, &def)
+		if err != nil {
+			return nil, err
+		}
+		if k, ok := key.(py.String); ok {
+			if value, found := self(o).values[string(k)]; found {
+				return py.String(value), nil
+			}
+		}
+		return def, nil
+	}, 0, "D.get(k[,d]) -> D[k] if k in D, else d.  d defaults to None.")
+	EnvironType.Dict["keys"] = py.MustNewMethod("keys", func(o py.Object) (py.Object, error) {
+		return py.NewListFromItems(self(o).keyList()), nil
+	}, 0, "D.keys() -> a list of D's keys")
+	EnvironType.Dict["values"] = py.MustNewMethod("values", func(o py.Object) (py.Object, error) {
+		e := self(o)
+		values := py.NewListSized(len(e.keys))
+		for i, key := range e.keys {
+			values.Items[i] = py.String(e.values[key])
+		}
+		return values, nil
+	}, 0, "D.values() -> a list of D's values")
+	EnvironType.Dict["items"] = py.MustNewMethod("items", func(o py.Object) (py.Object, error) {
+		e := self(o)
+		items := py.NewListSized(len(e.keys))
+		for i, key := range e.keys {
+			items.Items[i] = py.Tuple{py.String(key), py.String(e.values[key])}
+		}
+		return items, nil
+	}, 0, "D.items() -> a list of D's (key, value) pairs, as 2-tuples")
+	EnvironType.Dict["copy"] = py.MustNewMethod("copy", func(o py.Object) (py.Object, error) {
+		e := self(o)
+		d := py.NewStringDictSized(len(e.keys))
+		for key, value := range e.values {
+			d[key] = py.String(value)
+		}
+		return d, nil
+	}, 0, "D.copy() -> a dict with a copy of the environment")
+	EnvironType.Dict["setdefault"] = py.MustNewMethod("setdefault", func(o py.Object, args py.Tuple) (py.Object, error) {
+		var key, def py.Object
+		err := py.UnpackTuple(args, nil, "setdefault", 2, 2, &key, &def)
+		if err != nil {
+			return nil, err
+		}
+		e := self(o)
+		if value, err := e.M__getitem__(key); err == nil {
+			return value, nil
+		}
+		_, err = e.M__setitem__(key, def)
+		if err != nil {
+			return nil, err
+		}
+		return def, nil
+	}, 0, "D.setdefault(k,d) -> D.get(k,d), also set D[k]=d if k not in D")
+	EnvironType.Dict["pop"] = py.MustNewMethod("pop", func(o py.Object, args py.Tuple) (py.Object, error) {
+		var key, def py.Object
+		err := py.UnpackTuple(args, nil, "pop", 1, 2, &key, &def)
+		if err != nil {
+			return nil, err
+		}
+		e := self(o)
+		value, err := e.M__getitem__(key)
+		if err != nil {
+			if def != nil && py.IsException(py.KeyError, err) {
+				return def, nil
+			}
+			return nil, err
+		}
+		_, err = e.M__delitem__(key)
+		if err != nil {
+			return nil, err
+		}
+		return value, nil
+	}, 0, "D.pop(k[,d]) -> v, remove specified key and return the corresponding value.\nIf key is not found, d is returned if given, otherwise KeyError is raised")
+}
@@ -0,0 +1,465 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package os implements the python os and os.path modules
+//
+// The files are on the filesystem of the interpreter context the
+// code runs in, the real disk unless the embedder has chosen another.
+package os
+
+import (
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"runtime"
+	"syscall"
+
+	"github.com/go-python/gpython/py"
+	"github.com/go-python/gpython/vfs"
+)
+
+const module_doc = `OS routines for NT or Posix depending on what system we're on.
+
+This exports:
+  - all functions from posix or nt, e.g. unlink, stat, etc.
+  - os.path is either posixpath or ntpath
+  - os.name is either 'posix' or 'nt'
+  - os.curdir is a string representing the current directory ('.' or ':')
+  - os.pardir is a string representing the parent directory ('..' or '::')
+  - os.sep is the (or a most common) pathname separator ('/' or ':' or '\\')
+  - os.extsep is the extension separator (always '.')
+  - os.altsep is the alternate pathname separator (None or '/')
+  - os.pathsep is the component separator used in $PATH etc
+  - os.linesep is the line separator in text files ('\r' or '\n' or '\r\n')
+  - os.defpath is the default search path for executables
+  - os.devnull is the file path of the null device ('/dev/null', etc.)
+
+Programs that import and use 'os' stand a better chance of being
+portable between different platforms.  Of course, they must then
+only use functions that are defined by all platforms (e.g., unlink
+and opendir), and leave all pathname manipulation to os.path
+(e.g., split and join).`
+
+// Path constants for the platform
+var (
+	name              = "posix"
+	sep               = string(filepath.Separator)
+	altsep  py.Object = py.None
+	pathsep           = string(filepath.ListSeparator)
+	linesep           = "\n"
+	defpath           = "/bin:/usr/bin"
+	devnull           = os.DevNull
+)
+
+const (
+	curdir = "."
+	pardir = ".."
+	extsep = "."
+)
+
+const fspath_doc = `fspath(path) -> str or bytes
+
+Return the file system path representation of the object.
+
+If the object is str or bytes, then allow it to pass through as-is. If the
+object defines __fspath__(), then return the result of that method. All other
+types raise a TypeError.`
+
+func os_fspath(self, path py.Object) (py.Object, error) {
+	return fspath(path)
+}
+
+// Returns the file system path of path which is a str, bytes or an
+// os.PathLike object with an __fspath__ method
+func fspath(path py.Object) (py.Object, error) {
+	switch path.(type) {
+	case py.String, py.Bytes:
+		return path, nil
+	}
+	method, err := py.GetAttrString(path, "__fspath__")
+	if err != nil {
+		return nil, py.ExceptionNewf(py.TypeError, "expected str, bytes or os.PathLike object, not %s", path.Type().Name)
+	}
+	res, err := py.Call(method, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	switch res.(type) {
+	case py.String, py.Bytes:
+		return res, nil
+	}
+	return nil, py.ExceptionNewf(py.TypeError, "expected %s.__fspath__() to return str or bytes, not %s", path.Type().Name, res.Type().Name)
+}
+
+// Converts the path argument to a Go string noting whether it was
+// bytes so results can be returned as bytes too
+func pathArg(path py.Object) (string, bool, error) {
+	path, err := fspath(path)
+	if err != nil {
+		return "", false, err
+	}
+	switch x := path.(type) {
+	case py.String:
+		return string(x), false, nil
+	case py.Bytes:
+		return string(x), true, nil
+	}
+	panic("unreachable")
+}
+
+// Converts the path argument to a Go string
+func pathString(path py.Object) (string, error) {
+	p, _, err := pathArg(path)
+	return p, err
+}
+
+// Makes a path result of the same type as the argument
+func pathResult(p string, isBytes bool) py.Object {
+	if isBytes {
+		return py.Bytes(p)
+	}
+	return py.String(p)
+}
+
+// Makes an OSError from an os package error for the file path
+func osError(err error, path py.Object) error {
+	if pe, ok := err.(*os.PathError); ok {
+		err = pe.Err
+	} else if le, ok := err.(*os.LinkError); ok {
+		err = le.Err
+	}
+	return py.ExceptionFromOSError(err, path)
+}
+
+const getcwd_doc = `getcwd() -> path
+
+Return a unicode string representing the current working directory.`
+
+func os_getcwd(self py.Object) (py.Object, error) {
+	dir, err := py.GetContext(self).FS().Getwd()
+	if err != nil {
+		return nil, osError(err, nil)
+	}
+	return py.String(dir), nil
+}
+
+const getcwdb_doc = `getcwdb() -> path
+
+Return a bytes string representing the current working directory.`
+
+func os_getcwdb(self py.Object) (py.Object, error) {
+	dir, err := py.GetContext(self).FS().Getwd()
+	if err != nil {
+		return nil, osError(err, nil)
+	}
+	return py.Bytes(dir), nil
+}
+
+const chdir_doc = `chdir(path)
+
+Change the current working directory to the specified path.`
+
+func os_chdir(self, path py.Object) (py.Object, error) {
+	p, err := pathString(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := py.GetContext(self).FS().Chdir(p); err != nil {
+		return nil, osError(err, path)
+	}
+	return py.None, nil
+}
+
+const listdir_doc = `listdir(path='.') -> list_of_filenames
+
+Return a list containing the names of the files in the directory.
+The list is in arbitrary order.  It does not include the special
+entries '.' and '..' even if they are present in the directory.
+
+path can be specified as either str or bytes.  If path is bytes,
+  the filenames returned will also be bytes; in all other circumstances
+  the filenames returned will be str.`
+
+func os_listdir(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var path py.Object = py.String(curdir)
+	err := py.ParseTupleAndKeywords(args, kwargs, "|O:listdir", []string{"path"}, &path)
+	if err != nil {
+		return nil, err
+	}
+	if path == py.None {
+		path = py.String(curdir)
+	}
+	p, isBytes, err := pathArg(path)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := py.GetContext(self).FS().ReadDir(p)
+	if err != nil {
+		return nil, osError(err, path)
+	}
+	names := py.NewListSized(len(entries))
+	for i, entry := range entries {
+		names.Items[i] = pathResult(entry.Name(), isBytes)
+	}
+	return names, nil
+}
+
+const mkdir_doc = `mkdir(path, mode=0o777)
+
+Create a directory.
+
+The mode argument is ignored on Windows.`
+
+func os_mkdir(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var path py.Object
+	var mode py.Object = py.Int(0777)
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|i:mkdir", []string{"path", "mode"}, &path, &mode)
+	if err != nil {
+		return nil, err
+	}
+	p, err := pathString(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := py.GetContext(self).FS().Mkdir(p, os.FileMode(mode.(py.Int))&os.ModePerm); err != nil {
+		return nil, osError(err, path)
+	}
+	return py.None, nil
+}
+
+const makedirs_doc = `makedirs(name [, mode=0o777][, exist_ok=False])
+
+Super-mkdir; create a leaf directory and all intermediate ones.  Works like
+mkdir, except that any intermediate path segment (not just the rightmost)
+will be created if it does not exist. If the target directory already
+exists, raise an OSError if exist_ok is False. Otherwise no exception is
+raised.  This is recursive.`
+
+func os_makedirs(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var name py.Object
+	var mode py.Object = py.Int(0777)
+	var existOk py.Object = py.False
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|ip:makedirs", []string{"name", "mode", "exist_ok"}, &name, &mode, &existOk)
+	if err != nil {
+		return nil, err
+	}
+	p, err := pathString(name)
+	if err != nil {
+		return nil, err
+	}
+	fsys := py.GetContext(self).FS()
+	if fi, err := fsys.Stat(p); err == nil {
+		if fi.IsDir() && existOk == py.True {
+			return py.None, nil
+		}
+		return nil, osError(syscall.EEXIST, name)
+	}
+	if err := vfs.MkdirAll(fsys, p, os.FileMode(mode.(py.Int))&os.ModePerm); err != nil {
+		return nil, osError(err, name)
+	}
+	return py.None, nil
+}
+
+const remove_doc = `remove(path)
+
+Remove a file (same as unlink()).`
+
+const unlink_doc = `unlink(path)
+
+Remove a file (same as remove()).`
+
+func os_remove(self, path py.Object) (py.Object, error) {
+	p, err := pathString(path)
+	if err != nil {
+		return nil, err
+	}
+	// Remove removes empty directories too
+	fsys := py.GetContext(self).FS()
+	if fi, err := fsys.Lstat(p); err == nil && fi.IsDir() {
+		return nil, osError(syscall.EISDIR, path)
+	}
+	if err := fsys.Remove(p); err != nil {
+		return nil, osError(err, path)
+	}
+	return py.None, nil
+}
+
+const rmdir_doc = `rmdir(path)
+
+Remove a directory.`
+
+func os_rmdir(self, path py.Object) (py.Object, error) {
+	p, err := pathString(path)
+	if err != nil {
+		return nil, err
+	}
+	// Remove removes files too
+	fsys := py.GetContext(self).FS()
+	if fi, err := fsys.Lstat(p); err == nil && !fi.IsDir() {
+		return nil, osError(syscall.ENOTDIR, path)
+	}
+	if err := fsys.Remove(p); err != nil {
+		return nil, osError(err, path)
+	}
+	return py.None, nil
+}
+
+const rename_doc = `rename(src, dst)
+
+Rename a file or directory.`
+
+func os_rename(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var src, dst py.Object
+	err := py.ParseTupleAndKeywords(args, kwargs, "OO:rename", []string{"src", "dst"}, &src, &dst)
+	if err != nil {
+		return nil, err
+	}
+	s, err := pathString(src)
+	if err != nil {
+		return nil, err
+	}
+	d, err := pathString(dst)
+	if err != nil {
+		return nil, err
+	}
+	if err := py.GetContext(self).FS().Rename(s, d); err != nil {
+		return nil, osError(err, src)
+	}
+	return py.None, nil
+}
+
+const getenv_doc = `getenv(key, default=None)
+
+Get an environment variable, return None if it doesn't exist.
+The optional second argument can specify an alternate default.
+key, default and the result are str.`
+
+func os_getenv(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var key py.Object
+	var def py.Object = py.None
+	err := py.ParseTupleAndKeywords(args, kwargs, "U|O:getenv", []string{"key", "default"}, &key, &def)
+	if err != nil {
+		return nil, err
+	}
+	if value, ok := environ.values[string(key.(py.String))]; ok {
+		return py.String(value), nil
+	}
+	return def, nil
+}
+
+const putenv_doc = `putenv(key, value)
+
+Change or add an environment variable.
+
+This doesn't update os.environ; assign to os.environ[key] to do both.`
+
+func os_putenv(self py.Object, args py.Tuple) (py.Object, error) {
+	var key, value py.Object
+	err := py.UnpackTuple(args, nil, "putenv", 2, 2, &key, &value)
+	if err != nil {
+		return nil, err
+	}
+	k, v, err := envArgs(key, value)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Setenv(k, v); err != nil {
+		return nil, osError(err, nil)
+	}
+	return py.None, nil
+}
+
+const unsetenv_doc = `unsetenv(key)
+
+Delete an environment variable.
+
+This doesn't update os.environ; delete os.environ[key] to do both.`
+
+func os_unsetenv(self, key py.Object) (py.Object, error) {
+	k, _, err := envArgs(key, py.String(""))
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Unsetenv(k); err != nil {
+		return nil, osError(err, nil)
+	}
+	return py.None, nil
+}
+
+const getpid_doc = `getpid() -> pid
+
+Return the current process id.`
+
+func os_getpid(self py.Object) (py.Object, error) {
+	return py.Int(os.Getpid()), nil
+}
+
+const urandom_doc = `urandom(n) -> bytes
+
+Return a bytes object containing random bytes suitable for cryptographic use.`
+
+func os_urandom(self, n py.Object) (py.Object, error) {
+	size, err := py.MakeGoInt(n)
+	if err != nil {
+		return nil, err
+	}
+	if size < 0 {
+		return nil, py.ExceptionNewf(py.ValueError, "negative argument not allowed")
+	}
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, osError(err, nil)
+	}
+	return py.Bytes(buf), nil
+}
+
+// Initialise the module
+func init() {
+	if runtime.GOOS == "windows" {
+		name = "nt"
+		altsep = py.String("/")
+		linesep = "\r\n"
+		defpath = ".;C:\\bin"
+	}
+	methods := []*py.Method{
+		py.MustNewMethod("fspath", os_fspath, 0, fspath_doc),
+		py.MustNewMethod("getcwd", os_getcwd, 0, getcwd_doc),
+		py.MustNewMethod("getcwdb", os_getcwdb, 0, getcwdb_doc),
+		py.MustNewMethod("chdir", os_chdir, 0, chdir_doc),
+		py.MustNewMethod("listdir", os_listdir, 0, listdir_doc),
+		py.MustNewMethod("scandir", os_scandir, 0, scandir_doc),
+		py.MustNewMethod("stat", os_stat, 0, stat_doc),
+		py.MustNewMethod("lstat", os_lstat, 0, lstat_doc),
+		py.MustNewMethod("mkdir", os_mkdir, 0, mkdir_doc),
+		py.MustNewMethod("makedirs", os_makedirs, 0, makedirs_doc),
+		py.MustNewMethod("remove", os_remove, 0, remove_doc),
+		py.MustNewMethod("unlink", os_remove, 0, unlink_doc),
+		py.MustNewMethod("rmdir", os_rmdir, 0, rmdir_doc),
+		py.MustNewMethod("rename", os_rename, 0, rename_doc),
+		py.MustNewMethod("walk", os_walk, 0, walk_doc),
+		py.MustNewMethod("getenv", os_getenv, 0, getenv_doc),
+		py.MustNewMethod("putenv", os_putenv, 0, putenv_doc),
+		py.MustNewMethod("unsetenv", os_unsetenv, 0, unsetenv_doc),
+		py.MustNewMethod("getpid", os_getpid, 0, getpid_doc),
+		py.MustNewMethod("urandom", os_urandom, 0, urandom_doc),
+	}
+	globals := py.StringDict{
+		"name":        py.String(name),
+		"curdir":      py.String(curdir),
+		"pardir":      py.String(pardir),
+		"sep":         py.String(sep),
+		"altsep":      altsep,
+		"extsep":      py.String(extsep),
+		"pathsep":     py.String(pathsep),
+		"linesep":     py.String(linesep),
+		"defpath":     py.String(defpath),
+		"devnull":     py.String(devnull),
+		"error":       py.OSError,
+		"environ":     environ,
+		"stat_result": StatResultType,
+		"DirEntry":    DirEntryType,
+		"path":        newPathModule(),
+	}
+	py.NewModule("os", module_doc, methods, globals)
+}
@@ -0,0 +1,363 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package os_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/go-python/gpython/compile"
+	_ "github.com/go-python/gpython/os"
+	"github.com/go-python/gpython/py"
+	"github.com/go-python/gpython/pytest"
+	"github.com/go-python/gpython/vfs"
+	"github.com/go-python/gpython/vm"
+)
+
+func TestVm(t *testing.T) {
+	pytest.RunTests(t, "tests")
+}
+
+const fileScript = `
+import os
+from os import path
+
+def assertRaises(expecting, fn, *args, **kwargs):
+    try:
+        fn(*args, **kwargs)
+    except expecting as e:
+        pass
+    else:
+        assert False, "%s not raised" % (expecting,)
+
+def sorted(xs):
+    xs = list(xs)
+    for i in range(1, len(xs)):
+        j = i
+        while j > 0 and xs[j-1] > xs[j]:
+            xs[j-1], xs[j] = xs[j], xs[j-1]
+            j -= 1
+    return xs
+
+def touch(name, data="x"):
+    with open(name, "w") as f:
+        f.write(data)
+
+# mkdir, makedirs and listdir
+os.mkdir(tmp + "/a")
+assert path.isdir(tmp + "/a")
+assert not path.isfile(tmp + "/a")
+assertRaises(FileExistsError, os.mkdir, tmp + "/a")
+assertRaises(FileNotFoundError, os.mkdir, tmp + "/x/y")
+os.makedirs(tmp + "/a/b/c")
+assert path.isdir(tmp + "/a/b/c")
+assertRaises(FileExistsError, os.makedirs, tmp + "/a/b")
+os.makedirs(tmp + "/a/b", exist_ok=True)
+touch(tmp + "/a/f.txt", "hello")
+assertRaises(FileExistsError, os.makedirs, tmp + "/a/f.txt", exist_ok=True)
+assert sorted(os.listdir(tmp + "/a")) == ["b", "f.txt"]
+assert os.listdir(bytes(tmp + "/a/b", "utf-8")) == [b"c"]
+assertRaises(FileNotFoundError, os.listdir, tmp + "/missing")
+assertRaises(NotADirectoryError, os.listdir, tmp + "/a/f.txt")
+
+# stat
+st = os.stat(tmp + "/a/f.txt")
+assert st.st_size == 5
+assert st[6] == 5
+assert len(st) == 10
+assert st.st_mode & 0o170000 == 0o100000
+assert st.st_mode == st[0]
+assert st.st_mtime_ns // 1000000000 == st[8]
+assert os.stat(tmp + "/a").st_mode & 0o170000 == 0o040000
+assert path.getsize(tmp + "/a/f.txt") == 5
+assert path.exists(tmp + "/a/f.txt")
+assert not path.exists(tmp + "/missing")
+assertRaises(FileNotFoundError, os.stat, tmp + "/missing")
+e = None
+try:
+    os.stat(tmp + "/missing")
+except OSError as exc:
+    e = exc
+assert e.errno == 2
+assert e.filename == tmp + "/missing"
+
+# scandir
+with os.scandir(tmp + "/a") as it:
+    entries = {}
+    for entry in it:
+        entries[entry.name] = entry
+assert sorted(entries) == ["b", "f.txt"]
+assert entries["b"].is_dir()
+assert not entries["b"].is_file()
+assert entries["f.txt"].is_file()
+assert not entries["f.txt"].is_symlink()
+assert entries["f.txt"].path == tmp + "/a/f.txt"
+assert os.fspath(entries["f.txt"]) == tmp + "/a/f.txt"
+assert entries["f.txt"].stat().st_size == 5
+assert repr(entries["b"]) == "<DirEntry 'b'>"
+
+# walk
+touch(tmp + "/a/b/g.txt")
+touch(tmp + "/a/b/c/h.txt")
+result = []
+for top, dirs, files in os.walk(tmp + "/a"):
+    result.append((top[len(tmp):], sorted(dirs), sorted(files)))
+assert result == [
+    ("/a", ["b"], ["f.txt"]),
+    ("/a/b", ["c"], ["g.txt"]),
+    ("/a/b/c", [], ["h.txt"]),
+], result
+result = []
+for top, dirs, files in os.walk(tmp + "/a", topdown=False):
+    result.append(top[len(tmp):])
+assert result == ["/a/b/c", "/a/b", "/a"], result
+result = []
+for top, dirs, files in os.walk(tmp + "/a"):
+    result.append(top[len(tmp):])
+    dirs[:] = [d for d in dirs if d != "c"]
+assert result == ["/a", "/a/b"], result
+errors = []
+assert list(os.walk(tmp + "/missing", onerror=errors.append)) == []
+assert len(errors) == 1
+assert type(errors[0]) is FileNotFoundError
+
+# rename, remove and rmdir
+os.rename(tmp + "/a/f.txt", tmp + "/a/g.txt")
+assert not path.exists(tmp + "/a/f.txt")
+assert path.isfile(tmp + "/a/g.txt")
+assertRaises(FileNotFoundError, os.rename, tmp + "/a/f.txt", tmp + "/a/h.txt")
+assertRaises(IsADirectoryError, os.remove, tmp + "/a/b")
+os.remove(tmp + "/a/g.txt")
+assert not path.exists(tmp + "/a/g.txt")
+assertRaises(FileNotFoundError, os.unlink, tmp + "/a/g.txt")
+assertRaises(OSError, os.rmdir, tmp + "/a/b")
+os.remove(tmp + "/a/b/c/h.txt")
+os.rmdir(tmp + "/a/b/c")
+assert not path.exists(tmp + "/a/b/c")
+assertRaises(NotADirectoryError, os.rmdir, tmp + "/a/b/g.txt")
+
+# chdir and getcwd
+cwd = os.getcwd()
+os.chdir(tmp + "/a")
+try:
+    assert os.getcwd() == tmp + "/a"
+    assert os.listdir() == ["b"]
+    assert path.abspath("b") == path.join(os.getcwd(), "b")
+finally:
+    os.chdir(cwd)
+assert os.getcwd() == cwd
+assertRaises(FileNotFoundError, os.chdir, tmp + "/missing")
+`
+
+// Runs script as __main__ of ctx with tmp set to dir
+func runScript(t *testing.T, ctx *py.Context, script, dir string) {
+	t.Helper()
+	if err := runIn(ctx, script, dir); err != nil {
+		py.TracebackDump(err)
+		t.Fatal(err)
+	}
+}
+
+// Runs script as __main__ of ctx with tmp set to dir returning any
+// error
+func runIn(ctx *py.Context, script, dir string) error {
+	obj, err := compile.Compile(script, filepath.Join(dir, "os_test.py"), "exec", 0, true)
+	if err != nil {
+		return err
+	}
+	module := ctx.NewModule("__main__", "", nil, nil)
+	module.Globals["tmp"] = py.String(dir)
+	_, err = vm.Run(module.Globals, module.Globals, obj.(*py.Code), nil)
+	return err
+}
+
+// Makes a context using fsys
+func fsContext(fsys vfs.FS) *py.Context {
+	opts := py.DefaultContextOpts()
+	opts.FS = fsys
+	return py.NewContext(opts)
+}
+
+func TestFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gpython-os")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	// resolve any symlinks so the paths returned by getcwd match
+	dir, err = filepath.EvalSymlinks(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	runScript(t, py.DefaultContext, fileScript, dir)
+}
+
+const memoryScript = `
+import os
+
+def assertRaises(expecting, fn, *args, **kwargs):
+    try:
+        fn(*args, **kwargs)
+    except expecting as e:
+        return e
+    else:
+        assert False, "%s not raised" % (expecting,)
+
+assert os.getcwd() == "/"
+assert os.listdir() == ["lib"]
+with open("/lib/hello.py") as f:
+    assert f.read() == "greeting = 'hello'\n"
+with open(tmp + "/data.txt", "w") as f:
+    f.write("in memory")
+with open("data.txt", "rb") as f:
+    assert f.read() == b"in memory"
+assert os.stat("data.txt").st_size == 9
+os.makedirs("a/b")
+os.rename("data.txt", "a/b/data.txt")
+assert [(top, dirs, files) for top, dirs, files in os.walk("/a")] == [
+    ("/a", ["b"], []),
+    ("/a/b", [], ["data.txt"]),
+]
+os.chdir("a")
+assert os.getcwd() == "/a"
+assert os.path.abspath("b") == "/a/b"
+os.chdir("/")
+
+import sys
+sys.path.append("/lib")
+import hello
+assert hello.greeting == "hello"
+assert hello.__file__ == "/lib/hello.py"
+sys.path[:] = sys.path[:-1]
+
+e = assertRaises(OSError, open, 0)
+assert e.errno == 9
+assertRaises(FileNotFoundError, open, "/missing")
+assertRaises(FileNotFoundError, os.stat, "/missing")
+
+import io
+with io.FileIO("/lib/hello.py") as f:
+    assert f.read() == b"greeting = 'hello'\n"
+    assert type(f) is io.FileIO
+assertRaises(TypeError, type(io.FileIO), "/lib/hello.py")
+`
+
+// Makes a memory filesystem with /lib/hello.py in
+func newMemoryFS(t *testing.T) vfs.FS {
+	fsys := vfs.NewMemory()
+	if err := fsys.Mkdir("/lib", 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := vfs.WriteFile(fsys, "/lib/hello.py", []byte("greeting = 'hello'\n"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	return fsys
+}
+
+func TestMemoryFS(t *testing.T) {
+	fsys := newMemoryFS(t)
+	runScript(t, fsContext(fsys), memoryScript, "/")
+	if _, err := os.Stat("/lib/hello.py"); err == nil {
+		t.Errorf("hello.py is on the real disk")
+	}
+	data, err := vfs.ReadFile(fsys, "/a/b/data.txt")
+	if err != nil || string(data) != "in memory" {
+		t.Errorf("data.txt: %q %v", data, err)
+	}
+}
+
+const readOnlyScript = `
+import os
+
+def assertRaises(expecting, fn, *args, **kwargs):
+    try:
+        fn(*args, **kwargs)
+    except expecting as e:
+        return e
+    else:
+        assert False, "%s not raised" % (expecting,)
+
+assert os.getcwd() == tmp
+with open("a.txt") as f:
+    assert f.read() == "read only"
+assert os.listdir(tmp) == ["a.txt"]
+e = assertRaises(OSError, open, "a.txt", "w")
+assert e.errno == 30
+e = assertRaises(OSError, os.mkdir, "d")
+assert e.errno == 30
+assertRaises(OSError, os.remove, "a.txt")
+assertRaises(PermissionError, open, os.path.dirname(tmp) + "/x")
+assertRaises(PermissionError, os.listdir, "..")
+`
+
+func TestReadOnlyFS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gpython-os")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	dir, err = filepath.EvalSymlinks(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "a.txt"), []byte("read only"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	fsys, err := vfs.NewReadOnly(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	runScript(t, fsContext(fsys), readOnlyScript, dir)
+	if _, err := os.Stat(filepath.Join(dir, "a.txt")); err != nil {
+		t.Errorf("a.txt went: %v", err)
+	}
+}
+
+func TestContextFS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gpython-os")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	dir, err = filepath.EvalSymlinks(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "a.txt"), []byte("read only"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	readOnly, err := vfs.NewReadOnly(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Scripts in contexts with different filesystems run at the
+	// same time without seeing each other's files
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i, run := range []func() error{
+		func() error { return runIn(fsContext(newMemoryFS(t)), memoryScript, "/") },
+		func() error { return runIn(fsContext(readOnly), readOnlyScript, dir) },
+	} {
+		wg.Add(1)
+		go func(i int, run func() error) {
+			defer wg.Done()
+			errs[i] = run()
+		}(i, run)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	if _, err := os.Stat("/lib/hello.py"); err == nil {
+		t.Errorf("hello.py is on the real disk")
+	}
+}
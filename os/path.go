@@ -0,0 +1,478 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// os.path module
+//
+// The path manipulations are ports of python's posixpath module so
+// they give the same results as python, which path/filepath doesn't
+// always.
+//
+// FIXME there is no ntpath so paths on windows are treated as posix
+// paths with the windows separator.
+
+package os
+
+import (
+	"os"
+	"os/user"
+	"strings"
+
+	"github.com/go-python/gpython/py"
+	"github.com/go-python/gpython/vfs"
+)
+
+const path_doc = `Common operations on Posix pathnames.
+
+Instead of importing this module directly, import os and refer to
+this module as os.path.  The "os.path" name is an alias for this
+module on Posix systems; on other systems (e.g. Mac, Windows),
+os.path provides the same operations in a manner specific to that
+platform, and is an alias to another module (e.g. macpath, ntpath).`
+
+// Converts the arguments of a path function to Go strings checking
+// they are all str or all bytes
+func pathArgs(args py.Tuple) ([]string, bool, error) {
+	paths := make([]string, len(args))
+	isBytes := false
+	for i, arg := range args {
+		p, b, err := pathArg(arg)
+		if err != nil {
+			return nil, false, err
+		}
+		if i == 0 {
+			isBytes = b
+		} else if b != isBytes {
+			return nil, false, py.ExceptionNewf(py.TypeError, "Can't mix strings and bytes in path components")
+		}
+		paths[i] = p
+	}
+	return paths, isBytes, nil
+}
+
+// Joins b onto a the way os.path.join does
+func joinPath(a string, p ...string) string {
+	path := a
+	for _, b := range p {
+		switch {
+		case strings.HasPrefix(b, sep):
+			path = b
+		case path == "" || strings.HasSuffix(path, sep):
+			path += b
+		default:
+			path += sep + b
+		}
+	}
+	return path
+}
+
+const join_doc = `join(a, *p)
+
+Join two or more pathname components, inserting '/' as needed.
+If any component is an absolute path, all previous path components
+will be discarded.  An empty last part will result in a path that
+ends with a separator.`
+
+func path_join(self py.Object, args py.Tuple) (py.Object, error) {
+	if len(args) == 0 {
+		return nil, py.ExceptionNewf(py.TypeError, "join() missing 1 required positional argument: 'a'")
+	}
+	paths, isBytes, err := pathArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	return pathResult(joinPath(paths[0], paths[1:]...), isBytes), nil
+}
+
+// Splits p into head and tail where tail is everything after the
+// final separator
+func splitPath(p string) (head, tail string) {
+	i := strings.LastIndex(p, sep) + 1
+	head, tail = p[:i], p[i:]
+	if head != "" && head != strings.Repeat(sep, len(head)/len(sep)) {
+		head = strings.TrimRight(head, sep)
+	}
+	return head, tail
+}
+
+const split_doc = `split(p) -> (head, tail)
+
+Split a pathname.  Returns tuple "(head, tail)" where "tail" is
+everything after the final slash.  Either part may be empty.`
+
+func path_split(self, p py.Object) (py.Object, error) {
+	s, isBytes, err := pathArg(p)
+	if err != nil {
+		return nil, err
+	}
+	head, tail := splitPath(s)
+	return py.Tuple{pathResult(head, isBytes), pathResult(tail, isBytes)}, nil
+}
+
+const splitext_doc = `splitext(p) -> (root, ext)
+
+Split the extension from a pathname.
+
+Extension is everything from the last dot to the end, ignoring
+leading dots.  Returns "(root, ext)"; ext may be empty.`
+
+func path_splitext(self, p py.Object) (py.Object, error) {
+	s, isBytes, err := pathArg(p)
+	if err != nil {
+		return nil, err
+	}
+	root, ext := s, ""
+	sepIndex := strings.LastIndex(s, sep)
+	dotIndex := strings.LastIndex(s, extsep)
+	if dotIndex > sepIndex {
+		// skip all leading dots
+		for i := sepIndex + 1; i < dotIndex; i++ {
+			if s[i:i+1] != extsep {
+				root, ext = s[:dotIndex], s[dotIndex:]
+				break
+			}
+		}
+	}
+	return py.Tuple{pathResult(root, isBytes), pathResult(ext, isBytes)}, nil
+}
+
+const basename_doc = `basename(p) -> str
+
+Returns the final component of a pathname`
+
+func path_basename(self, p py.Object) (py.Object, error) {
+	s, isBytes, err := pathArg(p)
+	if err != nil {
+		return nil, err
+	}
+	_, tail := splitPath(s)
+	return pathResult(tail, isBytes), nil
+}
+
+const dirname_doc = `dirname(p) -> str
+
+Returns the directory component of a pathname`
+
+func path_dirname(self, p py.Object) (py.Object, error) {
+	s, isBytes, err := pathArg(p)
+	if err != nil {
+		return nil, err
+	}
+	head, _ := splitPath(s)
+	return pathResult(head, isBytes), nil
+}
+
+const isabs_doc = `isabs(s) -> bool
+
+Test whether a path is absolute`
+
+func path_isabs(self, s py.Object) (py.Object, error) {
+	p, _, err := pathArg(s)
+	if err != nil {
+		return nil, err
+	}
+	return py.NewBool(strings.HasPrefix(p, sep)), nil
+}
+
+// Normalizes path, eliminating double slashes, etc.
+func normPath(path string) string {
+	if path == "" {
+		return curdir
+	}
+	initialSlashes := 0
+	if strings.HasPrefix(path, sep) {
+		initialSlashes = 1
+		// POSIX allows one or two initial slashes, but treats three or more
+		// as single slash.
+		if strings.HasPrefix(path, sep+sep) && !strings.HasPrefix(path, sep+sep+sep) {
+			initialSlashes = 2
+		}
+	}
+	var comps []string
+	for _, comp := range strings.Split(path, sep) {
+		if comp == "" || comp == curdir {
+			continue
+		}
+		if comp != pardir || (initialSlashes == 0 && len(comps) == 0) || (len(comps) > 0 && comps[len(comps)-1] == pardir) {
+			comps = append(comps, comp)
+		} else if len(comps) > 0 {
+			comps = comps[:len(comps)-1]
+		}
+	}
+	path = strings.Repeat(sep, initialSlashes) + strings.Join(comps, sep)
+	if path == "" {
+		return curdir
+	}
+	return path
+}
+
+const normpath_doc = `normpath(path) -> str
+
+Normalize path, eliminating double slashes, etc.`
+
+func path_normpath(self, path py.Object) (py.Object, error) {
+	p, isBytes, err := pathArg(path)
+	if err != nil {
+		return nil, err
+	}
+	return pathResult(normPath(p), isBytes), nil
+}
+
+// Returns the absolute version of path in fsys
+func absPath(fsys vfs.FS, path string) (string, error) {
+	if !strings.HasPrefix(path, sep) {
+		cwd, err := fsys.Getwd()
+		if err != nil {
+			return "", osError(err, nil)
+		}
+		path = joinPath(cwd, path)
+	}
+	return normPath(path), nil
+}
+
+const abspath_doc = `abspath(path) -> str
+
+Return an absolute path.`
+
+func path_abspath(self, path py.Object) (py.Object, error) {
+	p, isBytes, err := pathArg(path)
+	if err != nil {
+		return nil, err
+	}
+	abs, err := absPath(py.GetContext(self).FS(), p)
+	if err != nil {
+		return nil, err
+	}
+	return pathResult(abs, isBytes), nil
+}
+
+const relpath_doc = `relpath(path, start=os.curdir) -> str
+
+Return a relative version of a path`
+
+func path_relpath(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var path py.Object
+	var start py.Object = py.None
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|O:relpath", []string{"path", "start"}, &path, &start)
+	if err != nil {
+		return nil, err
+	}
+	p, isBytes, err := pathArg(path)
+	if err != nil {
+		return nil, err
+	}
+	if p == "" {
+		return nil, py.ExceptionNewf(py.ValueError, "no path specified")
+	}
+	if start == py.None {
+		start = pathResult(curdir, isBytes)
+	}
+	paths, isBytes, err := pathArgs(py.Tuple{path, start})
+	if err != nil {
+		return nil, err
+	}
+	var lists [2][]string
+	for i, p := range paths {
+		abs, err := absPath(py.GetContext(self).FS(), p)
+		if err != nil {
+			return nil, err
+		}
+		for _, comp := range strings.Split(abs, sep) {
+			if comp != "" {
+				lists[i] = append(lists[i], comp)
+			}
+		}
+	}
+	pathList, startList := lists[0], lists[1]
+	common := 0
+	for common < len(pathList) && common < len(startList) && pathList[common] == startList[common] {
+		common++
+	}
+	var rel []string
+	for i := common; i < len(startList); i++ {
+		rel = append(rel, pardir)
+	}
+	rel = append(rel, pathList[common:]...)
+	if len(rel) == 0 {
+		return pathResult(curdir, isBytes), nil
+	}
+	return pathResult(joinPath(rel[0], rel[1:]...), isBytes), nil
+}
+
+const expanduser_doc = `expanduser(path) -> str
+
+Expand ~ and ~user constructions.  If user or $HOME is unknown,
+do nothing.`
+
+func path_expanduser(self, path py.Object) (py.Object, error) {
+	p, isBytes, err := pathArg(path)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(p, "~") {
+		return path, nil
+	}
+	i := strings.Index(p[1:], sep) + 1
+	if i <= 0 {
+		i = len(p)
+	}
+	var home string
+	if i == 1 {
+		var ok bool
+		home, ok = environ.values["HOME"]
+		if !ok {
+			u, err := user.Current()
+			if err != nil {
+				return path, nil
+			}
+			home = u.HomeDir
+		}
+	} else {
+		u, err := user.Lookup(p[1:i])
+		if err != nil {
+			return path, nil
+		}
+		home = u.HomeDir
+	}
+	home = strings.TrimRight(home, sep)
+	result := home + p[i:]
+	if result == "" {
+		result = sep
+	}
+	return pathResult(result, isBytes), nil
+}
+
+// Stats path in fsys for the os.path predicates returning nil if it
+// can't be statted
+func statPredicate(fsys vfs.FS, path py.Object, follow bool) (os.FileInfo, error) {
+	p, err := pathString(path)
+	if err != nil {
+		return nil, err
+	}
+	var fi os.FileInfo
+	if follow {
+		fi, err = fsys.Stat(p)
+	} else {
+		fi, err = fsys.Lstat(p)
+	}
+	if err != nil {
+		return nil, nil
+	}
+	return fi, nil
+}
+
+const exists_doc = `exists(path) -> bool
+
+Test whether a path exists.  Returns False for broken symbolic links`
+
+func path_exists(self, path py.Object) (py.Object, error) {
+	fi, err := statPredicate(py.GetContext(self).FS(), path, true)
+	if err != nil {
+		return nil, err
+	}
+	return py.NewBool(fi != nil), nil
+}
+
+const lexists_doc = `lexists(path) -> bool
+
+Test whether a path exists.  Returns True for broken symbolic links`
+
+func path_lexists(self, path py.Object) (py.Object, error) {
+	fi, err := statPredicate(py.GetContext(self).FS(), path, false)
+	if err != nil {
+		return nil, err
+	}
+	return py.NewBool(fi != nil), nil
+}
+
+const isdir_doc = `isdir(s) -> bool
+
+Return true if the pathname refers to an existing directory.`
+
+func path_isdir(self, path py.Object) (py.Object, error) {
+	fi, err := statPredicate(py.GetContext(self).FS(), path, true)
+	if err != nil {
+		return nil, err
+	}
+	return py.NewBool(fi != nil && fi.IsDir()), nil
+}
+
+const isfile_doc = `isfile(path) -> bool
+
+Test whether a path is a regular file`
+
+func path_isfile(self, path py.Object) (py.Object, error) {
+	fi, err := statPredicate(py.GetContext(self).FS(), path, true)
+	if err != nil {
+		return nil, err
+	}
+	return py.NewBool(fi != nil && fi.Mode().IsRegular()), nil
+}
+
+const islink_doc = `islink(path) -> bool
+
+Test whether a path is a symbolic link`
+
+func path_islink(self, path py.Object) (py.Object, error) {
+	fi, err := statPredicate(py.GetContext(self).FS(), path, false)
+	if err != nil {
+		return nil, err
+	}
+	return py.NewBool(fi != nil && fi.Mode()&os.ModeSymlink != 0), nil
+}
+
+const getsize_doc = `getsize(filename) -> int
+
+Return the size of a file, reported by os.stat().`
+
+func path_getsize(self, filename py.Object) (py.Object, error) {
+	st, err := stat(py.GetContext(self).FS(), filename, true)
+	if err != nil {
+		return nil, err
+	}
+	return st.(*StatResult).Tuple[6], nil
+}
+
+const getmtime_doc = `getmtime(filename) -> float
+
+Return the last modification time of a file, reported by os.stat().`
+
+func path_getmtime(self, filename py.Object) (py.Object, error) {
+	st, err := stat(py.GetContext(self).FS(), filename, true)
+	if err != nil {
+		return nil, err
+	}
+	return py.GetAttrString(st, "st_mtime")
+}
+
+// Makes the os.path module
+func newPathModule() *py.Module {
+	return py.NewModule("os.path", path_doc, []*py.Method{
+		py.MustNewMethod("join", path_join, 0, join_doc),
+		py.MustNewMethod("split", path_split, 0, split_doc),
+		py.MustNewMethod("splitext", path_splitext, 0, splitext_doc),
+		py.MustNewMethod("basename", path_basename, 0, basename_doc),
+		py.MustNewMethod("dirname", path_dirname, 0, dirname_doc),
+		py.MustNewMethod("isabs", path_isabs, 0, isabs_doc),
+		py.MustNewMethod("normpath", path_normpath, 0, normpath_doc),
+		py.MustNewMethod("abspath", path_abspath, 0, abspath_doc),
+		py.MustNewMethod("relpath", path_relpath, 0, relpath_doc),
+		py.MustNewMethod("expanduser", path_expanduser, 0, expanduser_doc),
+		py.MustNewMethod("exists", path_exists, 0, exists_doc),
+		py.MustNewMethod("lexists", path_lexists, 0, lexists_doc),
+		py.MustNewMethod("isdir", path_isdir, 0, isdir_doc),
+		py.MustNewMethod("isfile", path_isfile, 0, isfile_doc),
+		py.MustNewMethod("islink", path_islink, 0, islink_doc),
+		py.MustNewMethod("getsize", path_getsize, 0, getsize_doc),
+		py.MustNewMethod("getmtime", path_getmtime, 0, getmtime_doc),
+	}, py.StringDict{
+		"sep":     py.String(sep),
+		"curdir":  py.String(curdir),
+		"pardir":  py.String(pardir),
+		"extsep":  py.String(extsep),
+		"altsep":  altsep,
+		"pathsep": py.String(pathsep),
+		"defpath": py.String(defpath),
+		"devnull": py.String(devnull),
+	})
+}
@@ -0,0 +1,222 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// os.scandir and os.DirEntry
+
+package os
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/go-python/gpython/py"
+	"github.com/go-python/gpython/vfs"
+)
+
+var DirEntryType = py.NewType("posix.DirEntry", "DirEntry objects are yielded by os.scandir()")
+
+// DirEntry is a directory entry returned by os.scandir
+type DirEntry struct {
+	fsys    vfs.FS // filesystem the entry is in
+	entry   os.DirEntry
+	path    string // the directory joined to the name
+	isBytes bool   // set if scandir was passed bytes
+}
+
+// Type of this object
+func (d *DirEntry) Type() *py.Type {
+	return DirEntryType
+}
+
+// Returns the FileInfo for the entry following symlinks if follow is
+// set
+func (d *DirEntry) stat(follow bool) (os.FileInfo, error) {
+	if follow && d.entry.Type()&os.ModeSymlink != 0 {
+		return d.fsys.Stat(d.path)
+	}
+	return d.entry.Info()
+}
+
+// Returns true if the entry is a directory following symlinks if
+// follow is set
+func (d *DirEntry) isDir(follow bool) bool {
+	if follow && d.entry.Type()&os.ModeSymlink != 0 {
+		fi, err := d.fsys.Stat(d.path)
+		return err == nil && fi.IsDir()
+	}
+	return d.entry.IsDir()
+}
+
+// Returns true if the entry is a regular file following symlinks if
+// follow is set
+func (d *DirEntry) isFile(follow bool) bool {
+	if follow && d.entry.Type()&os.ModeSymlink != 0 {
+		fi, err := d.fsys.Stat(d.path)
+		return err == nil && fi.Mode().IsRegular()
+	}
+	return d.entry.Type().IsRegular()
+}
+
+func (d *DirEntry) M__repr__() (py.Object, error) {
+	name, err := py.ReprAsString(pathResult(d.entry.Name(), d.isBytes))
+	if err != nil {
+		return nil, err
+	}
+	return py.String(fmt.Sprintf("<DirEntry %s>", name)), nil
+}
+
+// Check interface is satisfied
+var _ py.I__repr__ = (*DirEntry)(nil)
+
+// Parses the follow_symlinks argument of the DirEntry methods
+func followSymlinksArg(name string, args py.Tuple, kwargs py.StringDict) (bool, error) {
+	var followSymlinks py.Object = py.True
+	err := py.ParseTupleAndKeywords(args, kwargs, "|p:"+name, []string{"follow_symlinks"}, &followSymlinks)
+	return followSymlinks == py.True, err
+}
+
+func init() {
+	self := func(o py.Object) *DirEntry {
+		return o.(*DirEntry)
+	}
+	DirEntryType.Dict["name"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			d := self(o)
+			return pathResult(d.entry.Name(), d.isBytes), nil
+		},
+	}
+	DirEntryType.Dict["path"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			d := self(o)
+			return pathResult(d.path, d.isBytes), nil
+		},
+	}
+	DirEntryType.Dict["__fspath__"] = py.MustNewMethod("__fspath__", func(o py.Object) (py.Object, error) {
+		d := self(o)
+		return pathResult(d.path, d.isBytes), nil
+	}, 0, "Returns the path for the entry.")
+	DirEntryType.Dict["is_dir"] = py.MustNewMethod("is_dir", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		follow, err := followSymlinksArg("is_dir", args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+		return py.NewBool(self(o).isDir(follow)), nil
+	}, 0, "Return True if the entry is a directory.")
+	DirEntryType.Dict["is_file"] = py.MustNewMethod("is_file", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		follow, err := followSymlinksArg("is_file", args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+		return py.NewBool(self(o).isFile(follow)), nil
+	}, 0, "Return True if the entry is a file.")
+	DirEntryType.Dict["is_symlink"] = py.MustNewMethod("is_symlink", func(o py.Object) (py.Object, error) {
+		return py.NewBool(self(o).entry.Type()&os.ModeSymlink != 0), nil
+	}, 0, "Return True if the entry is a symbolic link.")
+	DirEntryType.Dict["stat"] = py.MustNewMethod("stat", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		follow, err := followSymlinksArg("stat", args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+		d := self(o)
+		fi, err := d.stat(follow)
+		if err != nil {
+			return nil, osError(err, pathResult(d.path, d.isBytes))
+		}
+		return newStatResult(fi), nil
+	}, 0, "Return stat_result object for the entry.")
+	DirEntryType.Dict["inode"] = py.MustNewMethod("inode", func(o py.Object) (py.Object, error) {
+		d := self(o)
+		fi, err := d.stat(false)
+		if err != nil {
+			return nil, osError(err, pathResult(d.path, d.isBytes))
+		}
+		return newStatResult(fi).Tuple[1], nil
+	}, 0, "Return inode of the entry.")
+}
+
+var ScandirIteratorType = py.NewType("posix.ScandirIterator", "Iterator of the DirEntry objects for a directory")
+
+// ScandirIterator is returned by os.scandir
+type ScandirIterator struct {
+	entries []*DirEntry
+	pos     int
+}
+
+// Type of this object
+func (it *ScandirIterator) Type() *py.Type {
+	return ScandirIteratorType
+}
+
+func (it *ScandirIterator) M__iter__() (py.Object, error) {
+	return it, nil
+}
+
+func (it *ScandirIterator) M__next__() (py.Object, error) {
+	if it.pos >= len(it.entries) {
+		return nil, py.StopIteration
+	}
+	entry := it.entries[it.pos]
+	it.pos++
+	return entry, nil
+}
+
+// Check interface is satisfied
+var _ py.I_iterator = (*ScandirIterator)(nil)
+
+func init() {
+	closeIterator := func(o py.Object) (py.Object, error) {
+		it := o.(*ScandirIterator)
+		it.pos = len(it.entries)
+		return py.None, nil
+	}
+	ScandirIteratorType.Dict["close"] = py.MustNewMethod("close", closeIterator, 0, "close() -> None.  Stop the iteration.")
+	ScandirIteratorType.Dict["__enter__"] = py.MustNewMethod("__enter__", func(o py.Object) (py.Object, error) {
+		return o, nil
+	}, 0, "")
+	ScandirIteratorType.Dict["__exit__"] = py.MustNewMethod("__exit__", func(o py.Object, args py.Tuple) (py.Object, error) {
+		return closeIterator(o)
+	}, 0, "")
+}
+
+const scandir_doc = `scandir(path='.') -> iterator of DirEntry objects for given path
+
+Return an iterator of DirEntry objects for the directory path.  The
+entries are yielded in arbitrary order and don't include the special
+entries '.' and '..'.`
+
+func os_scandir(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var path py.Object = py.String(curdir)
+	err := py.ParseTupleAndKeywords(args, kwargs, "|O:scandir", []string{"path"}, &path)
+	if err != nil {
+		return nil, err
+	}
+	if path == py.None {
+		path = py.String(curdir)
+	}
+	return scandir(py.GetContext(self).FS(), path)
+}
+
+// Reads the directory path in fsys into a ScandirIterator
+func scandir(fsys vfs.FS, path py.Object) (*ScandirIterator, error) {
+	p, isBytes, err := pathArg(path)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := fsys.ReadDir(p)
+	if err != nil {
+		return nil, osError(err, path)
+	}
+	it := &ScandirIterator{
+		entries: make([]*DirEntry, len(entries)),
+	}
+	for i, entry := range entries {
+		it.entries[i] = &DirEntry{
+			fsys:    fsys,
+			entry:   entry,
+			path:    joinPath(p, entry.Name()),
+			isBytes: isBytes,
+		}
+	}
+	return it, nil
+}
@@ -0,0 +1,233 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// os.stat_result
+
+package os
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-python/gpython/py"
+	"github.com/go-python/gpython/vfs"
+)
+
+const stat_result_doc = `stat_result: Result from stat, fstat, or lstat.
+
+This object may be accessed either as a tuple of
+  (mode, ino, dev, nlink, uid, gid, size, atime, mtime, ctime)
+or via the attributes st_mode, st_ino, st_dev, st_nlink, st_uid, and so on.
+
+See os.stat for more information.`
+
+var StatResultType = py.NewType("os.stat_result", stat_result_doc)
+
+// The fields of a stat_result in tuple order
+var statFields = []string{
+	"st_mode",
+	"st_ino",
+	"st_dev",
+	"st_nlink",
+	"st_uid",
+	"st_gid",
+	"st_size",
+	"st_atime",
+	"st_mtime",
+	"st_ctime",
+}
+
+// The file type bits of st_mode
+const (
+	s_IFMT   = 0170000
+	s_IFSOCK = 0140000
+	s_IFLNK  = 0120000
+	s_IFREG  = 0100000
+	s_IFBLK  = 0060000
+	s_IFDIR  = 0040000
+	s_IFCHR  = 0020000
+	s_IFIFO  = 0010000
+	s_ISUID  = 0004000
+	s_ISGID  = 0002000
+	s_ISVTX  = 0001000
+)
+
+// StatResult is the type of the result of os.stat
+//
+// It behaves like a tuple of ints with the fields also available as
+// attributes.  The times in the tuple are whole seconds and the
+// attributes have them as floats, with st_atime_ns etc in
+// nanoseconds.
+type StatResult struct {
+	py.Tuple
+	atime, mtime, ctime time.Time
+}
+
+// Type of this object
+func (s *StatResult) Type() *py.Type {
+	return StatResultType
+}
+
+// The fields which come from the system specific part of a FileInfo
+type sysStat struct {
+	ino, dev, nlink, uid, gid int64
+	atime, ctime              time.Time
+}
+
+// Converts Go's FileMode into a unix st_mode
+func unixMode(mode os.FileMode) int64 {
+	m := int64(mode.Perm())
+	switch {
+	case mode&os.ModeDir != 0:
+		m |= s_IFDIR
+	case mode&os.ModeSymlink != 0:
+		m |= s_IFLNK
+	case mode&os.ModeNamedPipe != 0:
+		m |= s_IFIFO
+	case mode&os.ModeSocket != 0:
+		m |= s_IFSOCK
+	case mode&os.ModeCharDevice != 0:
+		m |= s_IFCHR
+	case mode&os.ModeDevice != 0:
+		m |= s_IFBLK
+	default:
+		m |= s_IFREG
+	}
+	if mode&os.ModeSetuid != 0 {
+		m |= s_ISUID
+	}
+	if mode&os.ModeSetgid != 0 {
+		m |= s_ISGID
+	}
+	if mode&os.ModeSticky != 0 {
+		m |= s_ISVTX
+	}
+	return m
+}
+
+// Makes a StatResult from fi
+func newStatResult(fi os.FileInfo) *StatResult {
+	sys, ok := getSysStat(fi)
+	if !ok {
+		sys.atime = fi.ModTime()
+		sys.ctime = fi.ModTime()
+	}
+	s := &StatResult{
+		atime: sys.atime,
+		mtime: fi.ModTime(),
+		ctime: sys.ctime,
+	}
+	s.Tuple = py.Tuple{
+		py.Int(unixMode(fi.Mode())),
+		py.Int(sys.ino),
+		py.Int(sys.dev),
+		py.Int(sys.nlink),
+		py.Int(sys.uid),
+		py.Int(sys.gid),
+		py.Int(fi.Size()),
+		py.Int(s.atime.Unix()),
+		py.Int(s.mtime.Unix()),
+		py.Int(s.ctime.Unix()),
+	}
+	return s
+}
+
+func (s *StatResult) M__repr__() (py.Object, error) {
+	fields := make([]string, len(statFields))
+	for i, name := range statFields {
+		repr, err := py.ReprAsString(s.Tuple[i])
+		if err != nil {
+			return nil, err
+		}
+		fields[i] = name + "=" + repr
+	}
+	return py.String("os.stat_result(" + strings.Join(fields, ", ") + ")"), nil
+}
+
+func (s *StatResult) M__str__() (py.Object, error) {
+	return s.M__repr__()
+}
+
+// Check interface is satisfied
+var _ py.I__repr__ = (*StatResult)(nil)
+var _ py.I__getitem__ = (*StatResult)(nil)
+var _ py.I__len__ = (*StatResult)(nil)
+var _ py.I__iter__ = (*StatResult)(nil)
+
+func init() {
+	self := func(o py.Object) *StatResult {
+		return o.(*StatResult)
+	}
+	for i, name := range statFields[:7] {
+		i := i
+		StatResultType.Dict[name] = &py.Property{
+			Fget: func(o py.Object) (py.Object, error) {
+				return self(o).Tuple[i], nil
+			},
+		}
+	}
+	times := map[string]func(s *StatResult) time.Time{
+		"atime": func(s *StatResult) time.Time { return s.atime },
+		"mtime": func(s *StatResult) time.Time { return s.mtime },
+		"ctime": func(s *StatResult) time.Time { return s.ctime },
+	}
+	for name, get := range times {
+		get := get
+		StatResultType.Dict["st_"+name] = &py.Property{
+			Fget: func(o py.Object) (py.Object, error) {
+				return py.Float(get(self(o)).UnixNano()) / 1e9, nil
+			},
+		}
+		StatResultType.Dict["st_"+name+"_ns"] = &py.Property{
+			Fget: func(o py.Object) (py.Object, error) {
+				return py.Int(get(self(o)).UnixNano()), nil
+			},
+		}
+	}
+}
+
+const stat_doc = `stat(path, follow_symlinks=True) -> stat result
+
+Perform a stat system call on the given path.
+
+If follow_symlinks is False, and the last element of the path is a
+symbolic link, stat will examine the symbolic link itself instead of
+the file the link points to.`
+
+func os_stat(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var path py.Object
+	var followSymlinks py.Object = py.True
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|p:stat", []string{"path", "follow_symlinks"}, &path, &followSymlinks)
+	if err != nil {
+		return nil, err
+	}
+	return stat(py.GetContext(self).FS(), path, followSymlinks == py.True)
+}
+
+const lstat_doc = `lstat(path) -> stat result
+
+Like stat(), but do not follow symbolic links.`
+
+func os_lstat(self, path py.Object) (py.Object, error) {
+	return stat(py.GetContext(self).FS(), path, false)
+}
+
+// Stats path in fsys following symlinks if follow is set
+func stat(fsys vfs.FS, path py.Object, follow bool) (py.Object, error) {
+	p, err := pathString(path)
+	if err != nil {
+		return nil, err
+	}
+	var fi os.FileInfo
+	if follow {
+		fi, err = fsys.Stat(p)
+	} else {
+		fi, err = fsys.Lstat(p)
+	}
+	if err != nil {
+		return nil, osError(err, path)
+	}
+	return newStatResult(fi), nil
+}
@@ -0,0 +1,31 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+// +build linux
+
+package os
+
+import (
+	"os"
+	"syscall"
+	"time"
+)
+
+// Reads the fields of a stat_result which only the system knows
+func getSysStat(fi os.FileInfo) (sysStat, bool) {
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return sysStat{}, false
+	}
+	return sysStat{
+		ino:   int64(st.Ino),
+		dev:   int64(st.Dev),
+		nlink: int64(st.Nlink),
+		uid:   int64(st.Uid),
+		gid:   int64(st.Gid),
+		atime: time.Unix(int64(st.Atim.Sec), int64(st.Atim.Nsec)),
+		ctime: time.Unix(int64(st.Ctim.Sec), int64(st.Ctim.Nsec)),
+	}, true
+}
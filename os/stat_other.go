@@ -0,0 +1,20 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !linux
+// +build !linux
+
+package os
+
+import (
+	"os"
+)
+
+// Reads the fields of a stat_result which only the system knows
+//
+// FIXME only linux is supported, elsewhere the ids are 0 and the
+// access and change times are the modification time.
+func getSysStat(fi os.FileInfo) (sysStat, bool) {
+	return sysStat{}, false
+}
@@ -0,0 +1,62 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+import os
+from libtest import assertRaises, assertRaisesText
+
+doc = "constants"
+assert os.name == "posix" or os.name == "nt"
+assert os.curdir == "."
+assert os.pardir == ".."
+assert os.extsep == "."
+assert os.linesep == "\n" or os.linesep == "\r\n"
+assert os.error is OSError
+
+doc = "environ"
+key = "GPYTHON_OS_TEST"
+assert key not in os.environ
+assert os.getenv(key) is None
+assert os.getenv(key, "default") == "default"
+os.environ[key] = "value"
+assert key in os.environ
+assert os.environ[key] == "value"
+assert os.getenv(key) == "value"
+assert os.environ.get(key) == "value"
+assert key in os.environ.keys()
+assert (key, "value") in os.environ.items()
+assert os.environ.copy()[key] == "value"
+n = len(os.environ)
+assert os.environ.setdefault(key, "other") == "value"
+assert len(os.environ) == n
+assert os.environ.pop(key) == "value"
+assert key not in os.environ
+assert os.environ.pop(key, "gone") == "gone"
+assertRaises(KeyError, os.environ.pop, key)
+assertRaises(KeyError, lambda: os.environ[key])
+def delete():
+    del os.environ[key]
+assertRaises(KeyError, delete)
+def set_int():
+    os.environ[key] = 1
+assertRaisesText(TypeError, "str expected, not int", set_int)
+def set_bad_name():
+    os.environ["a=b"] = "c"
+assertRaises(ValueError, set_bad_name)
+assert repr(os.environ).startswith("environ({")
+
+doc = "putenv"
+os.putenv(key, "put")
+assert key not in os.environ
+os.unsetenv(key)
+
+doc = "misc"
+assert os.getpid() > 0
+b = os.urandom(16)
+assert type(b) is bytes
+assert os.urandom(0) == b""
+assertRaises(ValueError, os.urandom, -1)
+assert type(os.getcwd()) is str
+assert type(os.getcwdb()) is bytes
+
+doc = "finished"
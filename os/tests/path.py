@@ -0,0 +1,91 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+import os
+import os.path
+from os import path
+from libtest import assertRaises, assertRaisesText
+
+doc = "import"
+assert os.path is path
+from os.path import join
+assert join is path.join
+
+doc = "join"
+assert path.join("a") == "a"
+assert path.join("a", "b", "c") == "a/b/c"
+assert path.join("a/", "b") == "a/b"
+assert path.join("a", "/b", "c") == "/b/c"
+assert path.join("a", "") == "a/"
+assert path.join("", "a") == "a"
+assert path.join(b"a", b"b") == b"a/b"
+assertRaisesText(TypeError, "Can't mix strings and bytes", path.join, "a", b"b")
+assertRaisesText(TypeError, "expected str, bytes or os.PathLike object, not int", path.join, 1)
+
+doc = "split"
+assert path.split("a/b") == ("a", "b")
+assert path.split("a/b/") == ("a/b", "")
+assert path.split("/a") == ("/", "a")
+assert path.split("//a") == ("//", "a")
+assert path.split("a//b") == ("a", "b")
+assert path.split("a") == ("", "a")
+assert path.split("") == ("", "")
+assert path.dirname("/a/b/c") == "/a/b"
+assert path.basename("/a/b/c") == "c"
+assert path.basename("/a/b/") == ""
+
+doc = "splitext"
+assert path.splitext("a.b") == ("a", ".b")
+assert path.splitext("a/b.c.d") == ("a/b.c", ".d")
+assert path.splitext(".bashrc") == (".bashrc", "")
+assert path.splitext("a/..b") == ("a/..b", "")
+assert path.splitext("a.b/c") == ("a.b/c", "")
+assert path.splitext(b"a.b") == (b"a", b".b")
+
+doc = "normpath"
+assert path.normpath("") == "."
+assert path.normpath("a/./b/../c") == "a/c"
+assert path.normpath("../a/..") == ".."
+assert path.normpath("/../a") == "/a"
+assert path.normpath("//a//b/") == "//a/b"
+assert path.normpath("///a") == "/a"
+assert path.normpath("a/..") == "."
+
+doc = "abspath and relpath"
+cwd = os.getcwd()
+assert path.isabs(cwd)
+assert not path.isabs("a")
+assert path.abspath("a/../b") == cwd + "/b"
+assert path.abspath("/x/./y") == "/x/y"
+assert path.relpath("/a/b/c", "/a/d") == "../b/c"
+assert path.relpath("/a/b", "/a/b") == "."
+assert path.relpath(cwd + "/x") == "x"
+assertRaisesText(ValueError, "no path specified", path.relpath, "")
+
+doc = "expanduser"
+home = os.environ.get("HOME")
+os.environ["HOME"] = "/home/test/"
+assert path.expanduser("~") == "/home/test"
+assert path.expanduser("~/a") == "/home/test/a"
+assert path.expanduser("a/~") == "a/~"
+assert path.expanduser("~no-such-user-xyz/a") == "~no-such-user-xyz/a"
+if home is None:
+    del os.environ["HOME"]
+else:
+    os.environ["HOME"] = home
+
+doc = "fspath"
+class P:
+    def __fspath__(self):
+        return "p/q"
+assert os.fspath(P()) == "p/q"
+assert os.fspath(b"x") == b"x"
+assert path.join(P(), "r") == "p/q/r"
+class Bad:
+    def __fspath__(self):
+        return 1
+assertRaisesText(TypeError, "to return str or bytes, not int", os.fspath, Bad())
+assertRaises(TypeError, os.fspath, 1.0)
+
+doc = "finished"
@@ -0,0 +1,188 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// os.walk
+
+package os
+
+import (
+	"os"
+
+	"github.com/go-python/gpython/py"
+	"github.com/go-python/gpython/vfs"
+)
+
+const walk_doc = `walk(top, topdown=True, onerror=None, followlinks=False)
+
+Directory tree generator.
+
+For each directory in the directory tree rooted at top (including top
+itself, but excluding '.' and '..'), yields a 3-tuple
+
+    dirpath, dirnames, filenames
+
+dirpath is a string, the path to the directory.  dirnames is a list of
+the names of the subdirectories in dirpath (excluding '.' and '..').
+filenames is a list of the names of the non-directory files in dirpath.
+Note that the names in the lists are just names, with no path components.
+To get a full path (which begins with top) to a file or directory in
+dirpath, do os.path.join(dirpath, name).
+
+If optional arg 'topdown' is true or not specified, the triple for a
+directory is generated before the triples for any of its subdirectories
+(directories are generated top down).  If topdown is false, the triple
+for a directory is generated after the triples for all of its
+subdirectories (directories are generated bottom up).
+
+When topdown is true, the caller can modify the dirnames list in-place
+(e.g., via del or slice assignment), and walk will only recurse into the
+subdirectories whose names remain in dirnames; this can be used to prune the
+search, or to impose a specific order of visiting.
+
+By default errors from the os.scandir() call are ignored.  If
+optional arg 'onerror' is specified, it should be a function; it
+will be called with one argument, an OSError instance.  It can
+report the error to continue with the walk, or raise the exception
+to abort the walk.
+
+By default, os.walk does not follow symbolic links to subdirectories on
+systems that support them.  In order to get this functionality, set the
+optional argument 'followlinks' to true.`
+
+var WalkType = py.NewType("walk", "Iterator returned by os.walk")
+
+// A directory being walked
+type walkDir struct {
+	top    string
+	dirs   *py.List
+	files  *py.List
+	listed bool // set once dirs and files are read
+	next   int  // index of the next dir in dirs to walk
+}
+
+// Walk is the iterator returned by os.walk
+//
+// It keeps a stack of the directories being walked so the dirnames
+// list can be changed by the caller before the walk goes into them
+// when walking top down.
+type Walk struct {
+	fsys        vfs.FS // filesystem being walked
+	stack       []*walkDir
+	topdown     bool
+	onerror     py.Object
+	followlinks bool
+	isBytes     bool
+}
+
+// Type of this object
+func (w *Walk) Type() *py.Type {
+	return WalkType
+}
+
+// Reads the entries of d into its dirs and files
+func (w *Walk) list(d *walkDir) error {
+	it, err := scandir(w.fsys, pathResult(d.top, w.isBytes))
+	if err != nil {
+		return err
+	}
+	d.dirs = py.NewList()
+	d.files = py.NewList()
+	for _, entry := range it.entries {
+		name := pathResult(entry.entry.Name(), w.isBytes)
+		if entry.isDir(true) {
+			d.dirs.Append(name)
+		} else {
+			d.files.Append(name)
+		}
+	}
+	return nil
+}
+
+// Returns the triple for d
+func (w *Walk) result(d *walkDir) py.Object {
+	return py.Tuple{pathResult(d.top, w.isBytes), d.dirs, d.files}
+}
+
+func (w *Walk) M__iter__() (py.Object, error) {
+	return w, nil
+}
+
+func (w *Walk) M__next__() (py.Object, error) {
+	for len(w.stack) > 0 {
+		d := w.stack[len(w.stack)-1]
+		if !d.listed {
+			d.listed = true
+			if err := w.list(d); err != nil {
+				w.stack = w.stack[:len(w.stack)-1]
+				if w.onerror != py.None {
+					_, err = py.Call(w.onerror, py.Tuple{py.MakeException(err)}, nil)
+					if err != nil {
+						return nil, err
+					}
+				}
+				continue
+			}
+			if w.topdown {
+				return w.result(d), nil
+			}
+		}
+		if d.next < len(d.dirs.Items) {
+			name, isBytes, err := pathArg(d.dirs.Items[d.next])
+			if err != nil {
+				return nil, err
+			}
+			if isBytes != w.isBytes {
+				return nil, py.ExceptionNewf(py.TypeError, "Can't mix strings and bytes in path components")
+			}
+			d.next++
+			p := joinPath(d.top, name)
+			if !w.followlinks {
+				if fi, err := w.fsys.Lstat(p); err == nil && fi.Mode()&os.ModeSymlink != 0 {
+					continue
+				}
+			}
+			w.stack = append(w.stack, &walkDir{top: p})
+			continue
+		}
+		w.stack = w.stack[:len(w.stack)-1]
+		if !w.topdown {
+			return w.result(d), nil
+		}
+	}
+	return nil, py.StopIteration
+}
+
+// Check interface is satisfied
+var _ py.I_iterator = (*Walk)(nil)
+
+func os_walk(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var top py.Object
+	var topdown py.Object = py.True
+	var onerror py.Object = py.None
+	var followlinks py.Object = py.False
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|OOO:walk", []string{"top", "topdown", "onerror", "followlinks"}, &top, &topdown, &onerror, &followlinks)
+	if err != nil {
+		return nil, err
+	}
+	p, isBytes, err := pathArg(top)
+	if err != nil {
+		return nil, err
+	}
+	topdownBool, err := py.MakeBool(topdown)
+	if err != nil {
+		return nil, err
+	}
+	followlinksBool, err := py.MakeBool(followlinks)
+	if err != nil {
+		return nil, err
+	}
+	return &Walk{
+		fsys:        py.GetContext(self).FS(),
+		stack:       []*walkDir{{top: p}},
+		topdown:     topdownBool == py.True,
+		onerror:     onerror,
+		followlinks: followlinksBool == py.True,
+		isBytes:     isBytes,
+	}, nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Syntax error diagnostics
+
+package parser
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-python/gpython/ast"
+	"github.com/go-python/gpython/py"
+)
+
+// Diagnostic describes a syntax error found while parsing
+//
+// Lines start from 1 and columns are byte offsets starting from 0 as
+// in ast.Pos.  The end is just past the last character in error.
+type Diagnostic struct {
+	Lineno       int
+	ColOffset    int
+	EndLineno    int
+	EndColOffset int
+	Msg          string // description of the error, eg "expected ':'"
+	line         string // the source line the error was found on
+	indentation  bool   // set if this is an IndentationError
+}
+
+// Error satisfies the error interface
+func (d Diagnostic) Error() string {
+	return fmt.Sprintf("%d:%d: %s", d.Lineno, d.ColOffset, d.Msg)
+}
+
+// Makes a python SyntaxError from the diagnostic
+func (d Diagnostic) syntaxError(filename string) *py.Exception {
+	excType := py.SyntaxError
+	if d.indentation {
+		excType = py.IndentationError
+	}
+	err := py.MakeSyntaxError(py.ExceptionNewf(excType, "%s", d.Msg), filename, d.Lineno, d.ColOffset, d.line)
+	py.SyntaxErrorSetEnd(err, d.EndLineno, d.EndColOffset)
+	return err
+}
+
+// Make the parser describe what it expected when it finds an error
+func init() {
+	yyErrorVerbose = true
+}
+
+// Converts the message the parser gives on an error, eg "syntax
+// error: unexpected NEWLINE, expecting ':'", into one for the user
+// and whether it is an IndentationError
+func (x *yyLex) parserErrorMessage(s string) (msg string, indentation bool) {
+	if x.eof && x.interactive {
+		return "unexpected EOF while parsing", false
+	}
+	s = strings.TrimPrefix(s, "syntax error: ")
+	if !strings.HasPrefix(s, "unexpected ") {
+		return "invalid syntax", false
+	}
+	s = s[len("unexpected "):]
+	unexpected, expecting := s, ""
+	if i := strings.Index(s, ", expecting "); i >= 0 {
+		unexpected, expecting = s[:i], s[i+len(", expecting "):]
+	}
+	switch unexpected {
+	case "INDENT":
+		return "unexpected indent", true
+	case "DEDENT":
+		return "unindent does not match any outer indentation level", true
+	case "ENDMARKER", "$end":
+		if x.openBrackets() {
+			return "unexpected EOF while parsing", false
+		}
+	}
+	if expecting == "" || strings.Contains(expecting, " or ") {
+		return "invalid syntax", false
+	}
+	if expecting == "INDENT" {
+		return "expected an indented block", true
+	}
+	if strings.HasPrefix(expecting, "'") {
+		return "expected " + expecting, false
+	}
+	// Keyword tokens are named after the keyword in upper case
+	for keyword := range tokens {
+		if strings.ToUpper(keyword) == expecting {
+			return "expected '" + keyword + "'", false
+		}
+	}
+	return "invalid syntax", false
+}
+
+// ParseWithDiagnostics parses a file like Parse but carries on after
+// syntax errors, returning all the errors found
+//
+// The Mod returned contains the statements which could be parsed and
+// may be nil if the parser could not recover from an error.  An
+// error is only returned if the input could not be parsed at all,
+// eg for a bad mode.
+func ParseWithDiagnostics(in io.Reader, filename string, mode string) (mod ast.Mod, diagnostics []Diagnostic, err error) {
+	lex, err := NewLex(in, filename, mode)
+	if err != nil {
+		return nil, nil, err
+	}
+	lex.recover = true
+	defer func() {
+		if r := recover(); r != nil {
+			err = py.MakeSyntaxError(r, filename, lex.pos.Lineno, lex.pos.ColOffset, lex.lastLine)
+		}
+	}()
+	yyParse(lex)
+	return lex.mod, lex.diagnostics, nil
+}
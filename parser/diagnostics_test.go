@@ -0,0 +1,83 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parser
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/go-python/gpython/ast"
+	"github.com/go-python/gpython/py"
+)
+
+func TestParseWithDiagnostics(t *testing.T) {
+	for _, test := range []struct {
+		in          string
+		diagnostics []string
+		out         string
+	}{
+		{"x = 1\n", nil, `Module(body=[Assign(targets=[Name(id='x', ctx=Store())], value=Num(n=1))])`},
+		{"x = 1\nif x\n    pass\ny = (1 +)\nz = 2\n", []string{"2:4: expected ':'", "4:8: invalid syntax"}, `Module(body=[Assign(targets=[Name(id='x', ctx=Store())], value=Num(n=1)), Assign(targets=[Name(id='z', ctx=Store())], value=Num(n=2))])`},
+		{"def f():\n    a = 1 +\n    return a\n", []string{"2:11: invalid syntax"}, `Module(body=[FunctionDef(name='f', args=arguments(posonlyargs=[], args=[], vararg=None, kwonlyargs=[], kw_defaults=[], kwarg=None, defaults=[]), body=[Return(value=Name(id='a', ctx=Load()))], decorator_list=[], returns=None)])`},
+		{"class A\n    def g(self)\n        return 1\n", []string{"1:7: expected ':'", "2:15: expected ':'"}, `Module(body=[])`},
+		{"for x y:\n    pass\nx = 1\n", []string{"1:6: expected 'in'"}, `Module(body=[Assign(targets=[Name(id='x', ctx=Store())], value=Num(n=1))])`},
+		{"a = $\nb = 1\n", []string{"1:4: invalid syntax"}, `Module(body=[Assign(targets=[Name(id='b', ctx=Store())], value=Num(n=1))])`},
+		{"'abc\nb = 1\n", []string{"1:1: EOL while scanning string literal"}, `Module(body=[Assign(targets=[Name(id='b', ctx=Store())], value=Num(n=1))])`},
+		{"if 1:\n    a\n  b\nc\n", []string{"3:2: Inconsistent indent"}, `Module(body=[If(test=Num(n=1), body=[Expr(value=Name(id='a', ctx=Load()))], orelse=[]), Expr(value=Name(id='b', ctx=Load())), Expr(value=Name(id='c', ctx=Load()))])`},
+		{"f() = 1\ng() = 2\n", []string{"1:7: can't assign to function call", "2:7: can't assign to function call"}, ``},
+		{"x = (1,\n", []string{"2:0: unexpected EOF while parsing"}, ``},
+	} {
+		mod, diagnostics, err := ParseWithDiagnostics(bytes.NewBufferString(test.in), "<string>", "exec")
+		if err != nil {
+			t.Errorf("%q: unexpected error %v", test.in, err)
+			continue
+		}
+		var got []string
+		for _, d := range diagnostics {
+			got = append(got, d.Error())
+		}
+		if len(got) != len(test.diagnostics) {
+			t.Errorf("%q: want diagnostics %q got %q", test.in, test.diagnostics, got)
+		} else {
+			for i := range got {
+				if got[i] != test.diagnostics[i] {
+					t.Errorf("%q: want diagnostic %q got %q", test.in, test.diagnostics[i], got[i])
+				}
+			}
+		}
+		if test.out != "" {
+			if mod == nil {
+				t.Errorf("%q: want %s got nil", test.in, test.out)
+			} else if out := ast.Dump(mod); out != test.out {
+				t.Errorf("%q: want %s got %s", test.in, test.out, out)
+			}
+		}
+	}
+}
+
+func TestParseSyntaxError(t *testing.T) {
+	_, err := ParseString("x = 1\nif x\n    pass\ny = (1 +)\n", "exec")
+	exc, ok := err.(*py.Exception)
+	if !ok {
+		t.Fatalf("want *py.Exception got %T %v", err, err)
+	}
+	for name, want := range map[string]py.Object{
+		"filename":   py.String("<string>"),
+		"lineno":     py.Int(2),
+		"offset":     py.Int(5),
+		"text":       py.String("if x\n"),
+		"msg":        py.String("expected ':'"),
+		"end_lineno": py.Int(2),
+		"end_offset": py.Int(6),
+	} {
+		if got := exc.Dict[name]; got != want {
+			t.Errorf("%s: want %#v got %#v", name, want, got)
+		}
+	}
+	want := "  File \"<string>\", line 2\n    if x\n        ^\nSyntaxError: expected ':'"
+	if got := exc.Error(); got != want {
+		t.Errorf("want %q got %q", want, got)
+	}
+}
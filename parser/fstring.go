@@ -0,0 +1,303 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Parse f-string literals
+
+package parser
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/go-python/gpython/ast"
+	"github.com/go-python/gpython/py"
+)
+
+// State for parsing the contents of a single f-string
+type fStringParser struct {
+	x      *yyLex // lexer to report errors to
+	in     []rune // the undecoded contents of the string
+	i      int    // current position in in
+	raw    bool   // set if this is a rf"" string
+	lineno int    // line the string started on
+	err    bool   // set if an error has been reported
+	lit    []rune // literal text accumulated so far
+}
+
+// readFString parses the undecoded contents of an f-string
+//
+// It returns a py.String if there were no replacement fields,
+// otherwise an *ast.JoinedStr. It returns nil if there was an error
+// which will have been reported to x.
+func (x *yyLex) readFString(in string, raw bool, lineno int) py.Object {
+	p := &fStringParser{
+		x:      x,
+		in:     []rune(in),
+		raw:    raw,
+		lineno: lineno,
+	}
+	values := p.parse(0)
+	if p.err {
+		return nil
+	}
+	if len(values) == 0 {
+		return py.String("")
+	}
+	if s, ok := values[0].(*ast.Str); ok && len(values) == 1 {
+		return s.S
+	}
+	return &ast.JoinedStr{Values: values}
+}
+
+// Report a syntax error
+func (p *fStringParser) error(s string) {
+	if !p.err {
+		p.x.SyntaxError(s)
+		p.err = true
+	}
+}
+
+// Append the pending literal text to values as an ast.Str
+func (p *fStringParser) flush(values []ast.Expr) []ast.Expr {
+	if len(p.lit) == 0 || p.err {
+		return values
+	}
+	buf := bytes.NewBufferString(string(p.lit))
+	p.lit = p.lit[:0]
+	if !p.raw {
+		var err error
+		buf, err = DecodeEscape(buf, false)
+		if err != nil {
+			p.error("(unicode error) " + err.Error())
+			return values
+		}
+	}
+	return append(values, &ast.Str{S: py.String(buf.String())})
+}
+
+// parse reads literal text and replacement fields until the end of
+// the input or, if depth > 0, until the '}' closing a format spec
+func (p *fStringParser) parse(depth int) []ast.Expr {
+	var values []ast.Expr
+	for p.i < len(p.in) && !p.err {
+		c := p.in[p.i]
+		switch {
+		case c == '\\' && !p.raw && p.i+1 < len(p.in):
+			// Copy escapes verbatim so \N{...} isn't seen as a field
+			end := p.i + 2
+			if p.in[p.i+1] == 'N' && end < len(p.in) && p.in[end] == '{' {
+				for end < len(p.in) && p.in[end] != '}' {
+					end++
+				}
+				if end < len(p.in) {
+					end++
+				}
+			}
+			p.lit = append(p.lit, p.in[p.i:end]...)
+			p.i = end
+		case c == '{':
+			if depth == 0 && p.i+1 < len(p.in) && p.in[p.i+1] == '{' {
+				p.lit = append(p.lit, '{')
+				p.i += 2
+				continue
+			}
+			values = p.flush(values)
+			value := p.parseField(depth)
+			if value != nil {
+				values = append(values, value)
+			}
+		case c == '}':
+			if depth > 0 {
+				return p.flush(values)
+			}
+			if p.i+1 < len(p.in) && p.in[p.i+1] == '}' {
+				p.lit = append(p.lit, '}')
+				p.i += 2
+				continue
+			}
+			p.error("f-string: single '}' is not allowed")
+		default:
+			p.lit = append(p.lit, c)
+			p.i++
+		}
+	}
+	return p.flush(values)
+}
+
+// parseField reads a replacement field starting at the '{'
+func (p *fStringParser) parseField(depth int) ast.Expr {
+	if depth >= 2 {
+		p.error("f-string: expressions nested too deeply")
+		return nil
+	}
+	p.i++
+	start := p.i
+	nesting := 0
+	quote := ""
+scan:
+	for ; p.i < len(p.in); p.i++ {
+		c := p.in[p.i]
+		if c == '\\' {
+			p.error("f-string expression part cannot include a backslash")
+			return nil
+		}
+		if quote != "" {
+			if strings.HasPrefix(string(p.in[p.i:]), quote) {
+				p.i += len(quote) - 1
+				quote = ""
+			}
+			continue
+		}
+		switch c {
+		case '\'', '"':
+			quote = string(c)
+			rest := string(p.in[p.i:])
+			if strings.HasPrefix(rest, quote+quote+quote) {
+				quote += quote + quote
+				p.i += 2
+			}
+		case '(', '[', '{':
+			nesting++
+		case ')', ']':
+			nesting--
+		case '}':
+			if nesting == 0 {
+				break scan
+			}
+			nesting--
+		case '#':
+			p.error("f-string expression part cannot include '#'")
+			return nil
+		case '!':
+			if p.i+1 < len(p.in) && p.in[p.i+1] == '=' {
+				p.i++
+			} else if nesting == 0 {
+				break scan
+			}
+		case ':':
+			if nesting == 0 {
+				break scan
+			}
+		}
+	}
+	if quote != "" {
+		p.error("f-string: unterminated string")
+		return nil
+	}
+	if p.i >= len(p.in) {
+		p.error("f-string: expecting '}'")
+		return nil
+	}
+	source := string(p.in[start:p.i])
+	if strings.TrimSpace(source) == "" {
+		p.error("f-string: empty expression not allowed")
+		return nil
+	}
+	value := p.parseExpr(source)
+	if value == nil {
+		return nil
+	}
+	field := &ast.FormattedValue{Value: value, Conversion: -1}
+	if p.in[p.i] == '!' {
+		p.i++
+		if p.i >= len(p.in) {
+			p.error("f-string: expecting '}'")
+			return nil
+		}
+		switch c := p.in[p.i]; c {
+		case 's', 'r', 'a':
+			field.Conversion = int(c)
+		default:
+			p.error("f-string: invalid conversion character: expected 's', 'r', or 'a'")
+			return nil
+		}
+		p.i++
+	}
+	if p.i < len(p.in) && p.in[p.i] == ':' {
+		p.i++
+		spec := p.parse(depth + 1)
+		if p.err {
+			return nil
+		}
+		field.FormatSpec = &ast.JoinedStr{Values: spec}
+	}
+	if p.i >= len(p.in) || p.in[p.i] != '}' {
+		p.error("f-string: expecting '}'")
+		return nil
+	}
+	p.i++
+	return field
+}
+
+// parseExpr parses the expression part of a replacement field
+//
+// Line numbers are those of the start of the f-string, column
+// offsets are relative to the start of the expression, as CPython does.
+func (p *fStringParser) parseExpr(source string) ast.Expr {
+	lex, err := NewLex(strings.NewReader("("+source+")"), p.x.filename, "eval")
+	if err != nil {
+		panic(err)
+	}
+	lex.pos.Lineno = p.lineno - 1
+	yyParse(lex)
+	if lex.error {
+		msg := "invalid syntax"
+		// Don't report what the parser expected as that refers
+		// to the parentheses added around the expression
+		if len(lex.diagnostics) > 0 && !strings.HasPrefix(lex.diagnostics[0].Msg, "expected ") {
+			msg = lex.diagnostics[0].Msg
+		}
+		p.error(msg)
+		return nil
+	}
+	return lex.mod.(*ast.Expression).Body
+}
+
+// concatStrings joins adjacent string literals where at least one
+// is an f-string, merging adjacent constant parts
+func concatStrings(a, b py.Object) *ast.JoinedStr {
+	js := &ast.JoinedStr{}
+	add := func(value ast.Expr) {
+		if s, ok := value.(*ast.Str); ok {
+			if s.S == "" {
+				return
+			}
+			if n := len(js.Values); n > 0 {
+				if last, ok := js.Values[n-1].(*ast.Str); ok {
+					js.Values[n-1] = &ast.Str{S: last.S + s.S}
+					return
+				}
+			}
+		}
+		js.Values = append(js.Values, value)
+	}
+	for _, obj := range []py.Object{a, b} {
+		switch x := obj.(type) {
+		case py.String:
+			add(&ast.Str{S: x})
+		case *ast.JoinedStr:
+			for _, value := range x.Values {
+				add(value)
+			}
+		}
+	}
+	return js
+}
+
+// setFStringPos sets the position of an f-string and its constant
+// and replacement field parts
+func setFStringPos(js *ast.JoinedStr, pos ast.Pos) {
+	js.Pos = pos
+	for _, value := range js.Values {
+		switch x := value.(type) {
+		case *ast.Str:
+			x.Pos = pos
+		case *ast.FormattedValue:
+			x.Pos = pos
+			if spec, ok := x.FormatSpec.(*ast.JoinedStr); ok {
+				setFStringPos(spec, pos)
+			}
+		}
+	}
+}
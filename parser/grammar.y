@@ -10,6 +10,7 @@ package parser
 
 import (
 	"fmt"
+	"strings"
 	"github.com/go-python/gpython/py"
 	"github.com/go-python/gpython/ast"
 )
@@ -50,47 +51,108 @@ func applyTrailers(expr ast.Expr, trailers []ast.Expr) ast.Expr {
 func setCtx(yylex yyLexer, expr ast.Expr, ctx ast.ExprContext) {
 	setctxer, ok := expr.(ast.SetCtxer)
 	if !ok {
-		expr_name := ""
-		switch expr.(type) {
-		case *ast.Lambda:
-			expr_name = "lambda"
-		case *ast.Call:
-			expr_name = "function call"
-		case *ast.BoolOp, *ast.BinOp, *ast.UnaryOp:
-			expr_name = "operator"
-		case *ast.GeneratorExp:
-			expr_name = "generator expression"
-		case *ast.Yield, *ast.YieldFrom:
-			expr_name = "yield expression"
-		case *ast.ListComp:
-			expr_name = "list comprehension"
-		case *ast.SetComp:
-			expr_name = "set comprehension"
-		case *ast.DictComp:
-			expr_name = "dict comprehension"
-		case *ast.Dict, *ast.Set, *ast.Num, *ast.Str, *ast.Bytes:
-			expr_name = "literal"
-		case *ast.NameConstant:
-			expr_name = "keyword"
-		case *ast.Ellipsis:
-			expr_name = "Ellipsis"
-		case *ast.Compare:
-			expr_name = "comparison"
-		case *ast.IfExp:
-			expr_name = "conditional expression"
-		default:
-			expr_name = fmt.Sprintf("unexpected %T", expr)
-		}
 		action := "assign to"
 		if ctx == ast.Del {
 			action = "delete"
 		}
-		yylex.(*yyLex).SyntaxErrorf("can't %s %s", action, expr_name)
+		yylex.(*yyLex).SyntaxErrorf("can't %s %s", action, exprName(expr))
 		return
 	}
 	setctxer.SetCtx(ctx)
 }
 
+// Describe the kind of expression for error messages
+func exprName(expr ast.Expr) string {
+	switch expr.(type) {
+	case *ast.Lambda:
+		return "lambda"
+	case *ast.Call:
+		return "function call"
+	case *ast.BoolOp, *ast.BinOp, *ast.UnaryOp:
+		return "operator"
+	case *ast.GeneratorExp:
+		return "generator expression"
+	case *ast.Yield, *ast.YieldFrom:
+		return "yield expression"
+	case *ast.Await:
+		return "await expression"
+	case *ast.ListComp:
+		return "list comprehension"
+	case *ast.SetComp:
+		return "set comprehension"
+	case *ast.DictComp:
+		return "dict comprehension"
+	case *ast.Dict, *ast.Set, *ast.Num, *ast.Str, *ast.Bytes, *ast.JoinedStr:
+		return "literal"
+	case *ast.NameConstant:
+		return "keyword"
+	case *ast.Ellipsis:
+		return "Ellipsis"
+	case *ast.Compare:
+		return "comparison"
+	case *ast.IfExp:
+		return "conditional expression"
+	case *ast.NamedExpr:
+		return "named expression"
+	case *ast.Attribute:
+		return "attribute"
+	case *ast.Subscript:
+		return "subscript"
+	case *ast.Starred:
+		return "starred"
+	case *ast.Name:
+		return "name"
+	case *ast.List:
+		return "list"
+	case *ast.Tuple:
+		return "tuple"
+	}
+	return fmt.Sprintf("unexpected %T", expr)
+}
+
+// Make an assignment expression checking the target is a name
+func namedExpr(yylex yyLexer, pos ast.Pos, target, value ast.Expr) ast.Expr {
+	if _, ok := target.(*ast.Name); !ok {
+		yylex.(*yyLex).SyntaxErrorf("cannot use named assignment with %s", exprName(target))
+		return nil
+	}
+	setCtx(yylex, target, ast.Store)
+	return &ast.NamedExpr{ExprBase: ast.ExprBase{Pos: pos}, Target: target, Value: value}
+}
+
+// Make an annotated assignment checking the target can be annotated
+//
+// pos is the start of the statement which is used to find out
+// whether a Name target was in parentheses
+func annAssign(yylex yyLexer, pos ast.Pos, target, annotation, value ast.Expr) ast.Stmt {
+	simple := 0
+	switch x := target.(type) {
+	case *ast.Name:
+		if x.Pos == pos {
+			simple = 1
+		}
+	case *ast.Attribute, *ast.Subscript:
+	case *ast.Tuple:
+		yylex.(*yyLex).SyntaxError("only single target (not tuple) can be annotated")
+		return nil
+	case *ast.List:
+		yylex.(*yyLex).SyntaxError("only single target (not list) can be annotated")
+		return nil
+	default:
+		yylex.(*yyLex).SyntaxError("illegal target for annotation")
+		return nil
+	}
+	setCtx(yylex, target, ast.Store)
+	return &ast.AnnAssign{StmtBase: ast.StmtBase{Pos: pos}, Target: target, Annotation: annotation, Value: value, Simple: simple}
+}
+
+// Add the positional only parameters and their defaults to args
+func addPosonlyargs(args *ast.Arguments, posonlyargs []*ast.Arg, defaults []ast.Expr) *ast.Arguments {
+	args.Posonlyargs = posonlyargs
+	args.Defaults = append(append([]ast.Expr(nil), defaults...), args.Defaults...)
+	return args
+}
+
 // Set the context for all the items in exprs
 func setCtxs(yylex yyLexer, exprs []ast.Expr, ctx ast.ExprContext) {
 	for i := range exprs {
@@ -98,6 +160,30 @@ func setCtxs(yylex yyLexer, exprs []ast.Expr, ctx ast.ExprContext) {
 	}
 }
 
+// Add the single argument in arg to call checking that it is allowed
+// to follow the arguments already there
+func addArgument(yylex yyLexer, call *ast.Call, arg *ast.Call) {
+	kwUnpacking := false
+	for _, kw := range call.Keywords {
+		if kw.Arg == "" {
+			kwUnpacking = true
+		}
+	}
+	if len(arg.Args) != 0 {
+		_, starred := arg.Args[0].(*ast.Starred)
+		switch {
+		case starred && kwUnpacking:
+			yylex.(*yyLex).SyntaxError("iterable argument unpacking follows keyword argument unpacking")
+		case !starred && kwUnpacking:
+			yylex.(*yyLex).SyntaxError("positional argument follows keyword argument unpacking")
+		case !starred && len(call.Keywords) != 0:
+			yylex.(*yyLex).SyntaxError("positional argument follows keyword argument")
+		}
+	}
+	call.Args = append(call.Args, arg.Args...)
+	call.Keywords = append(call.Keywords, arg.Keywords...)
+}
+
 %}
 
 %union {
@@ -133,15 +219,15 @@ func setCtxs(yylex yyLexer, exprs []ast.Expr, ctx ast.ExprContext) {
 %type <obj> strings
 %type <mod> inputs file_input single_input eval_input
 %type <stmts> simple_stmt stmt nl_or_stmt small_stmts stmts suite optional_else
-%type <stmt> compound_stmt small_stmt expr_stmt del_stmt pass_stmt flow_stmt import_stmt global_stmt nonlocal_stmt assert_stmt break_stmt continue_stmt return_stmt raise_stmt yield_stmt import_name import_from while_stmt if_stmt for_stmt try_stmt with_stmt funcdef classdef classdef_or_funcdef decorated
+%type <stmt> compound_stmt small_stmt expr_stmt del_stmt pass_stmt flow_stmt import_stmt global_stmt nonlocal_stmt assert_stmt break_stmt continue_stmt return_stmt raise_stmt yield_stmt import_name import_from while_stmt if_stmt for_stmt try_stmt with_stmt funcdef classdef classdef_or_funcdef decorated async_funcdef async_stmt
 %type <op> augassign
-%type <expr> expr_or_star_expr expr star_expr xor_expr and_expr shift_expr arith_expr term factor power trailer atom test_or_star_expr test not_test lambdef test_nocond lambdef_nocond or_test and_test comparison testlist testlist_star_expr yield_expr_or_testlist yield_expr yield_expr_or_testlist_star_expr dictorsetmaker sliceop except_clause optional_return_type decorator
-%type <exprs> exprlist testlistraw comp_if comp_iter expr_or_star_exprs test_or_star_exprs tests test_colon_tests trailers equals_yield_expr_or_testlist_star_expr decorators
+%type <expr> namedexpr_test namedexpr_test_or_star_expr expr_or_star_expr expr star_expr xor_expr and_expr shift_expr arith_expr term factor power atom_expr trailer atom test_or_star_expr test not_test lambdef test_nocond lambdef_nocond or_test and_test comparison testlist testlist_star_expr yield_expr_or_testlist yield_expr yield_expr_or_testlist_star_expr dictorsetmaker sliceop except_clause optional_return_type decorator
+%type <exprs> exprlist comp_if comp_iter expr_or_star_exprs test_or_star_exprs namedexpr_test_or_star_exprs tests test_colon_tests trailers equals_yield_expr_or_testlist_star_expr decorators
 %type <cmpop> comp_op
 %type <comma> optional_comma
 %type <comprehensions> comp_for
 %type <slice> subscript subscriptlist subscripts
-%type <call> argument arguments optional_arguments arguments2 arglist optional_arglist_call optional_arglist
+%type <call> argument arguments arglist optional_arglist_call optional_arglist
 %type <level> dot dots
 %type <str> dotted_name from_arg
 %type <identifiers> names
@@ -153,7 +239,7 @@ func setCtxs(yylex yyLexer, exprs []ast.Expr, ctx ast.ExprContext) {
 %type <withitems> with_items
 %type <arg> vfpdeftest vfpdef optional_vfpdef tfpdeftest tfpdef optional_tfpdef
 %type <args> vfpdeftests vfpdeftests1 tfpdeftests tfpdeftests1
-%type <arguments> varargslist parameters optional_typedargslist typedargslist
+%type <arguments> varargslist varargslist_args parameters optional_typedargslist typedargslist typedargslist_args
 
 %token NEWLINE
 %token ENDMARKER
@@ -186,6 +272,8 @@ func setCtxs(yylex yyLexer, exprs []ast.Expr, ctx ast.ExprContext) {
 %token GTGTEQ // >>=
 %token HATEQ // ^=
 %token PIPEEQ // |=
+%token ATEQ // @=
+%token COLONEQ // :=
 
 %token FALSE // False
 %token NONE // None
@@ -193,6 +281,8 @@ func setCtxs(yylex yyLexer, exprs []ast.Expr, ctx ast.ExprContext) {
 %token AND // and
 %token AS // as
 %token ASSERT // assert
+%token ASYNC // async
+%token AWAIT // await
 %token BREAK // break
 %token CLASS // class
 %token CONTINUE // continue
@@ -225,6 +315,12 @@ func setCtxs(yylex yyLexer, exprs []ast.Expr, ctx ast.ExprContext) {
 
 %token SINGLE_INPUT FILE_INPUT EVAL_INPUT
 
+%token ERRORTOKEN // returned after a lexing error when recovering
+
+// These are only returned when tokenizing
+%token COMMENT
+%token NL
+
 // Note:  Changing the grammar specified in this file will most likely
 //        require corresponding changes in the parser module
 //        (../Modules/parsermodule.c).  If you can't make the changes to
@@ -332,7 +428,11 @@ optional_arglist_call:
 decorator:
 	'@' dotted_name optional_arglist_call NEWLINE
 	{
-		fn := &ast.Name{ExprBase: ast.ExprBase{Pos: $<pos>$}, Id: ast.Identifier($2), Ctx: ast.Load}
+		names := strings.Split($2, ".")
+		var fn ast.Expr = &ast.Name{ExprBase: ast.ExprBase{Pos: $<pos>$}, Id: ast.Identifier(names[0]), Ctx: ast.Load}
+		for _, name := range names[1:] {
+			fn = &ast.Attribute{ExprBase: ast.ExprBase{Pos: $<pos>$}, Value: fn, Attr: ast.Identifier(name), Ctx: ast.Load}
+		}
 		if $3 == nil {
 			$$ = fn
 		} else {
@@ -362,6 +462,10 @@ classdef_or_funcdef:
 	{
 		$$ = $1
 	}
+|	async_funcdef
+	{
+		$$ = $1
+	}
 
 decorated:
 	decorators classdef_or_funcdef
@@ -373,6 +477,9 @@ decorated:
 		case *ast.FunctionDef:
 			x.DecoratorList = $1
 			$$ = x
+		case *ast.AsyncFunctionDef:
+			x.DecoratorList = $1
+			$$ = x
 		default:
 			panic("bad type for decorated")
 		}
@@ -387,6 +494,13 @@ optional_return_type:
 		$$ = $2
 	}
 
+async_funcdef:
+	ASYNC funcdef
+	{
+		fn := $2.(*ast.FunctionDef)
+		$$ = &ast.AsyncFunctionDef{StmtBase: ast.StmtBase{Pos: $<pos>$}, Name: fn.Name, Args: fn.Args, Body: fn.Body, Returns: fn.Returns}
+	}
+
 funcdef:
 	DEF NAME parameters optional_return_type ':' suite
 	{
@@ -461,8 +575,23 @@ optional_tfpdef:
 		$$ = $1
 	}
 
+typedargslist:
+	typedargslist_args
+	{
+		$$ = $1
+	}
+|	tfpdeftests1 ',' '/' optional_comma
+	{
+		$$ = addPosonlyargs(&ast.Arguments{Pos: $<pos>$}, $1, $<exprs>1)
+	}
+|	tfpdeftests1 ',' '/' ',' typedargslist_args
+	{
+		$$ = addPosonlyargs($5, $1, $<exprs>1)
+		$$.Pos = $<pos>$
+	}
+
 // FIXME this isn't checking all the python rules for args before kwargs etc
-typedargslist: 
+typedargslist_args:
 	tfpdeftests1 optional_comma
 	{
 		$$ = &ast.Arguments{Pos: $<pos>$, Args: $1, Defaults: $<exprs>1}
@@ -554,8 +683,23 @@ optional_vfpdef:
 		$$ = $1
 	}
 
-// FIXME this isn't checking all the python rules for args before kwargs etc
 varargslist:
+	varargslist_args
+	{
+		$$ = $1
+	}
+|	vfpdeftests1 ',' '/' optional_comma
+	{
+		$$ = addPosonlyargs(&ast.Arguments{Pos: $<pos>$}, $1, $<exprs>1)
+	}
+|	vfpdeftests1 ',' '/' ',' varargslist_args
+	{
+		$$ = addPosonlyargs($5, $1, $<exprs>1)
+		$$.Pos = $<pos>$
+	}
+
+// FIXME this isn't checking all the python rules for args before kwargs etc
+varargslist_args:
 	vfpdeftests1 optional_comma
 	{
 		$$ = &ast.Arguments{Pos: $<pos>$, Args: $1, Defaults: $<exprs>1}
@@ -600,6 +744,17 @@ stmt:
 	{
 		$$ = []ast.Stmt{$1}
 	}
+// Recover from a syntax error by skipping to the end of the
+// statement, including the block following a broken compound
+// statement header
+|	error NEWLINE
+	{
+		$$ = nil
+	}
+|	error NEWLINE INDENT stmts DEDENT
+	{
+		$$ = nil
+	}
 
 optional_semicolon: | ';'
 
@@ -691,6 +846,14 @@ expr_stmt:
 		setCtxs(yylex, targets, ast.Store)
 		$$ = &ast.Assign{StmtBase: ast.StmtBase{Pos: $<pos>$}, Targets: targets, Value: value}
 	}
+|	testlist_star_expr ':' test
+	{
+		$$ = annAssign(yylex, $<pos>$, $1, $3, nil)
+	}
+|	testlist_star_expr ':' test '=' yield_expr_or_testlist_star_expr
+	{
+		$$ = annAssign(yylex, $<pos>$, $1, $3, $5)
+	}
 |	testlist_star_expr
 	{
 		$$ = &ast.ExprStmt{StmtBase: ast.StmtBase{Pos: $<pos>$}, Value: $1}
@@ -812,6 +975,10 @@ augassign:
 	{
 		$$ = ast.FloorDiv
 	}
+|	ATEQ
+	{
+		$$ = ast.MatMult
+	}
 
 // For normal assignments, additional restrictions enforced by the interpreter
 del_stmt:
@@ -1094,13 +1261,33 @@ compound_stmt:
 	{
 		$$ = $1
 	}
+|	async_stmt
+	{
+		$$ = $1
+	}
+
+async_stmt:
+	async_funcdef
+	{
+		$$ = $1
+	}
+|	ASYNC with_stmt
+	{
+		with := $2.(*ast.With)
+		$$ = &ast.AsyncWith{StmtBase: ast.StmtBase{Pos: $<pos>$}, Items: with.Items, Body: with.Body}
+	}
+|	ASYNC for_stmt
+	{
+		forStmt := $2.(*ast.For)
+		$$ = &ast.AsyncFor{StmtBase: ast.StmtBase{Pos: $<pos>$}, Target: forStmt.Target, Iter: forStmt.Iter, Body: forStmt.Body, Orelse: forStmt.Orelse}
+	}
 
 elifs:
 	{
 		$$ = nil
 		$<lastif>$ = nil
 	}
-|	elifs ELIF test ':' suite
+|	elifs ELIF namedexpr_test ':' suite
 	{
 		elifs := $$
 		newif := &ast.If{StmtBase: ast.StmtBase{Pos: $<pos>$}, Test: $3, Body: $5}
@@ -1122,7 +1309,7 @@ optional_else:
 	}
 
 if_stmt:
-	IF test ':' suite elifs optional_else
+	IF namedexpr_test ':' suite elifs optional_else
 	{
 		newif := &ast.If{StmtBase: ast.StmtBase{Pos: $<pos>$}, Test: $2, Body: $4}
 		$$ = newif
@@ -1143,7 +1330,7 @@ if_stmt:
 	}
 
 while_stmt:
-	WHILE test ':' suite optional_else
+	WHILE namedexpr_test ':' suite optional_else
 	{
 		$$ = &ast.While{StmtBase: ast.StmtBase{Pos: $<pos>$}, Test: $2, Body: $4, Orelse: $5}
 	}
@@ -1162,7 +1349,7 @@ except_clauses:
 	}
 |	except_clauses except_clause ':' suite
 	{
-		exc := &ast.ExceptHandler{Pos: $<pos>$, ExprType: $2, Name: ast.Identifier($<str>2), Body: $4}
+		exc := &ast.ExceptHandler{Pos: $<pos>2, ExprType: $2, Name: ast.Identifier($<str>2), Body: $4}
 		$$ = append($$, exc)
 	}
 
@@ -1252,6 +1439,37 @@ suite:
 		$$ = $3
 	}
 
+namedexpr_test:
+	test
+	{
+		$$ = $1
+	}
+|	test COLONEQ test
+	{
+		$$ = namedExpr(yylex, $<pos>$, $1, $3)
+	}
+
+namedexpr_test_or_star_expr:
+	namedexpr_test
+	{
+		$$ = $1
+	}
+|	star_expr
+	{
+		$$ = $1
+	}
+
+namedexpr_test_or_star_exprs:
+	namedexpr_test_or_star_expr
+	{
+		$$ = nil
+		$$ = append($$, $1)
+	}
+|	namedexpr_test_or_star_exprs ',' namedexpr_test_or_star_expr
+	{
+		$$ = append($$, $3)
+	}
+
 test:
 	or_test
 	{
@@ -1481,6 +1699,10 @@ term:
 	{
 		$$ = &ast.BinOp{ExprBase: ast.ExprBase{Pos: $<pos>$}, Left: $1, Op: ast.Mult, Right: $3}
 	}
+|	term '@' factor
+	{
+		$$ = &ast.BinOp{ExprBase: ast.ExprBase{Pos: $<pos>$}, Left: $1, Op: ast.MatMult, Right: $3}
+	}
 |	term '/' factor
 	{
 		$$ = &ast.BinOp{ExprBase: ast.ExprBase{Pos: $<pos>$}, Left: $1, Op: ast.Div, Right: $3}
@@ -1513,13 +1735,23 @@ factor:
 	}
 
 power:
+	atom_expr
+	{
+		$$ = $1
+	}
+|	atom_expr STARSTAR factor
+	{
+		$$ = &ast.BinOp{ExprBase: ast.ExprBase{Pos: $<pos>$}, Left: $1, Op: ast.Pow, Right: $3}
+	}
+
+atom_expr:
 	atom trailers
 	{
 		$$ = applyTrailers($1, $2)
 	}
-|	atom trailers STARSTAR factor
+|	AWAIT atom trailers
 	{
-		$$ = &ast.BinOp{ExprBase: ast.ExprBase{Pos: $<pos>$}, Left: applyTrailers($1, $2), Op: ast.Pow, Right: $4}
+		$$ = &ast.Await{ExprBase: ast.ExprBase{Pos: $<pos>$}, Value: applyTrailers($2, $3)}
 	}
 
 // Trailers are half made Call, Attribute or Subscript
@@ -1544,6 +1776,8 @@ strings:
 			switch b := $2.(type) {
 			case py.String:
 				$$ = a + b
+			case *ast.JoinedStr:
+				$$ = concatStrings(a, b)
 			default:
 				yylex.(*yyLex).SyntaxError("cannot mix string and nonstring literals")
 			}
@@ -1554,6 +1788,13 @@ strings:
 			default:
 				yylex.(*yyLex).SyntaxError("cannot mix bytes and nonbytes literals")
 			}
+		case *ast.JoinedStr:
+			switch b := $2.(type) {
+			case py.String, *ast.JoinedStr:
+				$$ = concatStrings(a, b)
+			default:
+				yylex.(*yyLex).SyntaxError("cannot mix string and nonstring literals")
+			}
 		}
 	}
 
@@ -1566,11 +1807,11 @@ atom:
 	{
 		$$ = $2
 	}
-|	'(' test_or_star_expr comp_for ')'
+|	'(' namedexpr_test_or_star_expr comp_for ')'
 	{
 		$$ = &ast.GeneratorExp{ExprBase: ast.ExprBase{Pos: $<pos>$}, Elt: $2, Generators: $3}
 	}
-|	'(' test_or_star_exprs optional_comma ')' 
+|	'(' namedexpr_test_or_star_exprs optional_comma ')' 
 	{
 		$$ = tupleOrExpr($<pos>$, $2, $3)
 	}
@@ -1578,11 +1819,11 @@ atom:
 	{
 		$$ = &ast.List{ExprBase: ast.ExprBase{Pos: $<pos>$}, Ctx: ast.Load}
 	}
-|	'[' test_or_star_expr comp_for ']'
+|	'[' namedexpr_test_or_star_expr comp_for ']'
 	{
 		$$ = &ast.ListComp{ExprBase: ast.ExprBase{Pos: $<pos>$}, Elt: $2, Generators: $3}
 	}
-|	'[' test_or_star_exprs optional_comma ']'
+|	'[' namedexpr_test_or_star_exprs optional_comma ']'
 	{
 		$$ = &ast.List{ExprBase: ast.ExprBase{Pos: $<pos>$}, Elts: $2, Ctx: ast.Load}
 	}
@@ -1609,6 +1850,9 @@ atom:
 			$$ = &ast.Str{ExprBase: ast.ExprBase{Pos: $<pos>$}, S: s}
 		case py.Bytes:
 			$$ = &ast.Bytes{ExprBase: ast.ExprBase{Pos: $<pos>$}, S: s}
+		case *ast.JoinedStr:
+			setFStringPos(s, $<pos>$)
+			$$ = s
 		default:
 			panic("not Bytes or String in strings")
 		}
@@ -1777,23 +2021,28 @@ testlist:
 		}
 	}
 
-testlistraw:
-	tests optional_comma
-	{
-		$$ = $1
-	}
-
-// (',' test ':' test)*
+// (test ':' test | '**' expr) (',' (test ':' test | '**' expr))*
+//
+// A nil key marks a '**' dictionary unpacking
 test_colon_tests:
 	test ':' test
 	{
 		$$ = nil
 		$$ = append($$, $1, $3)	// key, value order
 	}
+|	STARSTAR expr
+	{
+		$$ = nil
+		$$ = append($$, nil, $2)
+	}
 |	test_colon_tests ',' test ':' test
 	{
 		$$ = append($$, $3, $5)
 	}
+|	test_colon_tests ',' STARSTAR expr
+	{
+		$$ = append($$, nil, $4)
+	}
 
 dictorsetmaker:
 	test_colon_tests optional_comma
@@ -1810,7 +2059,7 @@ dictorsetmaker:
 	{
 		$$ = &ast.DictComp{ExprBase: ast.ExprBase{Pos: $<pos>$}, Key: $1, Value: $3, Generators: $4}
 	}
-|	testlistraw
+|	test_or_star_exprs optional_comma
 	{
 		$$ = &ast.Set{ExprBase: ast.ExprBase{Pos: $<pos>$}, Elts: $1}
 	}
@@ -1828,8 +2077,6 @@ classdef:
 		if args != nil {
 			classDef.Bases = args.Args
 			classDef.Keywords = args.Keywords
-			classDef.Starargs = args.Starargs
-			classDef.Kwargs = args.Kwargs
 		}
 	}
 
@@ -1840,27 +2087,7 @@ arguments:
 	}
 |	arguments ',' argument
 	{
-		$$.Args = append($$.Args, $3.Args...)
-		$$.Keywords = append($$.Keywords, $3.Keywords...)
-	}
-
-optional_arguments:
-	{
-		$$ = &ast.Call{}
-	}
-|	arguments ','
-	{
-		$$ = $1
-	}
-
-arguments2:
-	{
-		$$ = &ast.Call{}
-	}
-|	arguments2 ',' argument
-	{
-		$$.Args = append($$.Args, $3.Args...)
-		$$.Keywords = append($$.Keywords, $3.Keywords...)
+		addArgument(yylex, $$, $3)
 	}
 
 arglist:
@@ -1868,33 +2095,6 @@ arglist:
 	{
 		$$ = $1
 	}
-|	optional_arguments '*' test arguments2
-	{
-		call := $1
-		call.Starargs = $3
-		if len($4.Args) != 0 {
-			yylex.(*yyLex).SyntaxError("only named arguments may follow *expression")
-		}
-		call.Keywords = append(call.Keywords, $4.Keywords...)
-		$$ = call
-	}
-|	optional_arguments '*' test arguments2 ',' STARSTAR test
-	{
-		call := $1
-		call.Starargs = $3
-		call.Kwargs = $7
-		if len($4.Args) != 0 {
-			yylex.(*yyLex).SyntaxError("only named arguments may follow *expression")
-		}
-		call.Keywords = append(call.Keywords, $4.Keywords...)
-		$$ = call
-	}
-|	optional_arguments STARSTAR test
-	{
-		call := $1
-		call.Kwargs = $3
-		$$ = call
-	}
 
 // The reason that keywords are test nodes instead of NAME is that using NAME
 // results in an ambiguity. ast.c makes sure it's a NAME.
@@ -1911,6 +2111,21 @@ argument:
 			&ast.GeneratorExp{ExprBase: ast.ExprBase{Pos: $<pos>$}, Elt: $1, Generators: $2},
 		}
 	}
+|	'*' test
+	{
+		$$ = &ast.Call{}
+		$$.Args = []ast.Expr{&ast.Starred{ExprBase: ast.ExprBase{Pos: $<pos>$}, Value: $2, Ctx: ast.Load}}
+	}
+|	STARSTAR test
+	{
+		$$ = &ast.Call{}
+		$$.Keywords = []*ast.Keyword{&ast.Keyword{Pos: $<pos>$, Value: $2}}
+	}
+|	test COLONEQ test
+	{
+		$$ = &ast.Call{}
+		$$.Args = []ast.Expr{namedExpr(yylex, $<pos>$, $1, $3)}
+	}
 |	test '=' test  // Really [keyword '='] test
 	{
 		$$ = &ast.Call{}
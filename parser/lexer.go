@@ -17,6 +17,7 @@ import (
 	"regexp"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/go-python/gpython/ast"
 	"github.com/go-python/gpython/py"
@@ -36,24 +37,29 @@ const tabSize = 8
 // the methods Lex(*<prefix>SymType) int and Error(string).
 type yyLex struct {
 	reader        *bufio.Reader
-	filename      string     // name of the file being read
-	line          string     // current line being parsed
-	lastLine      string     // last line that was parsed
-	pos           ast.Pos    // current position within file
-	yylval        *yySymType // last token
-	eof           bool       // flag to show EOF was read
-	error         bool       // set if an error has ocurred
-	errorString   string     // the string of the error
-	indentStack   []int      // indent stack to control INDENT / DEDENT tokens
-	state         int        // current state of state machine
-	currentIndent string     // whitespace at start of current line
-	interactive   bool       // set if mode "single" reading interactive input
-	exec          bool       // set if mode "exec" reading from file
-	bracket       int        // number of open [ ]
-	parenthesis   int        // number of open ( )
-	brace         int        // number of open { }
-	mod           ast.Mod    // output
-	tokens        []int      // buffered tokens to output
+	filename      string       // name of the file being read
+	line          string       // current line being parsed
+	lastLine      string       // last line that was parsed
+	pos           ast.Pos      // current position within file
+	yylval        *yySymType   // last token
+	eof           bool         // flag to show EOF was read
+	error         bool         // set if an error has ocurred
+	indentStack   []int        // indent stack to control INDENT / DEDENT tokens
+	state         int          // current state of state machine
+	currentIndent string       // whitespace at start of current line
+	interactive   bool         // set if mode "single" reading interactive input
+	exec          bool         // set if mode "exec" reading from file
+	bracket       int          // number of open [ ]
+	parenthesis   int          // number of open ( )
+	brace         int          // number of open { }
+	mod           ast.Mod      // output
+	tokens        []int        // buffered tokens to output
+	recover       bool         // set to carry on after errors collecting diagnostics
+	diagnostics   []Diagnostic // errors found so far
+	lexError      bool         // set if the last token was returned because of a lexing error
+	tokenize      bool         // set to return COMMENT and NL tokens and carry on after errors
+	blankLine     bool         // set if the current line is blank or only a comment
+	lines         []string     // lines read so far if tokenizing
 }
 
 // Create a new lexer
@@ -123,6 +129,9 @@ func (x *yyLex) refill() {
 		x.eof = true
 		x.SyntaxErrorf("Error reading input: %v", err)
 	}
+	if x.tokenize {
+		x.lines = append(x.lines, x.line)
+	}
 	// If this is exec input, add a newline to the end of the
 	// string if there isn't one already.
 	if x.eof && x.exec && len(x.line) > 0 && x.line[len(x.line)-1] != '\n' {
@@ -203,6 +212,8 @@ var operators = map[string]int{
 	"+=": PLUSEQ,
 	"-=": MINUSEQ,
 	"->": MINUSGT,
+	":=": COLONEQ,
+	"@=": ATEQ,
 	"//": DIVDIV,
 	"/=": DIVEQ,
 	"<<": LTLT,
@@ -230,6 +241,8 @@ var tokens = map[string]int{
 	"and":      AND,
 	"as":       AS,
 	"assert":   ASSERT,
+	"async":    ASYNC,
+	"await":    AWAIT,
 	"break":    BREAK,
 	"class":    CLASS,
 	"continue": CONTINUE,
@@ -279,6 +292,9 @@ func init() {
 	tokenToString[DEDENT] = "DEDENT"
 	tokenToString[STRING] = "STRING"
 	tokenToString[NUMBER] = "NUMBER"
+	tokenToString[ERRORTOKEN] = "ERRORTOKEN"
+	tokenToString[COMMENT] = "COMMENT"
+	tokenToString[NL] = "NL"
 	tokenToString[FILE_INPUT] = "FILE_INPUT"
 	tokenToString[SINGLE_INPUT] = "SINGLE_INPUT"
 	tokenToString[EVAL_INPUT] = "EVAL_INPUT"
@@ -376,6 +392,7 @@ func (x *yyLex) Lex(yylval *yySymType) (ret int) {
 	// Clear out the yySymType on each token (copied from rsc's cc)
 	*yylval = yySymType{}
 	x.yylval = yylval
+	x.lexError = false
 	if yyDebug >= 2 {
 		defer func() {
 			lt := newLexToken(ret, yylval)
@@ -383,6 +400,12 @@ func (x *yyLex) Lex(yylval *yySymType) (ret int) {
 		}()
 	}
 
+	// Stop the parse at the first error unless recovering
+	if x.error && !x.recover {
+		x.lexError = true
+		return eof
+	}
+
 	// Return queued tokens if there are any
 	if !x.queueEmpty() {
 		yylval.pos = x.pos
@@ -422,6 +445,16 @@ func (x *yyLex) Lex(yylval *yySymType) (ret int) {
 			// Ignore line if just white space or whitespace then comment
 			if despaced == "" || despaced[0] == '#' {
 				x.state = checkEof
+				// When tokenizing return the comment and NL
+				// unless this is trailing whitespace at the end
+				if x.tokenize {
+					if despaced != "" || !x.eof {
+						x.state = parseTokens
+						x.blankLine = true
+					} else {
+						x.pos.ColOffset = 0
+					}
+				}
 				continue
 			}
 			x.state++
@@ -446,10 +479,16 @@ func (x *yyLex) Lex(yylval *yySymType) (ret int) {
 					if x.indentStack[i] == indent {
 						goto foundIndent
 					}
+					if x.recover && x.indentStack[i] < indent {
+						// Carry on as if the indent matched the
+						// enclosing block
+						x.IndentationError("Inconsistent indent")
+						goto foundIndent
+					}
 					x.queue(DEDENT)
 				}
-				x.SyntaxError("Inconsistent indent")
-				return eof
+				x.IndentationError("Inconsistent indent")
+				return x.errorToken()
 			foundIndent:
 				x.indentStack = x.indentStack[:i+1]
 				return x.dequeue()
@@ -466,6 +505,25 @@ func (x *yyLex) Lex(yylval *yySymType) (ret int) {
 				continue
 			}
 
+			// Return comments and NL tokens if tokenizing
+			if x.tokenize {
+				switch x.line[0] {
+				case '#':
+					yylval.pos = x.pos
+					x.cut(len(strings.TrimRight(x.line, "\r\n")))
+					return COMMENT
+				case '\n':
+					yylval.pos = x.pos
+					x.cut(1)
+					x.state = checkEof
+					if x.openBrackets() || x.blankLine {
+						x.blankLine = false
+						return NL
+					}
+					return NEWLINE
+				}
+			}
+
 			// Check if newline or comment reached
 			if x.line[0] == '\n' || x.line[0] == '#' {
 				x.state = checkEof
@@ -494,7 +552,7 @@ func (x *yyLex) Lex(yylval *yySymType) (ret int) {
 			token, value := x.readNumber()
 			if token != eof {
 				if token == eofError {
-					return eof
+					return x.errorToken()
 				}
 				yylval.obj = value
 				return token
@@ -504,7 +562,7 @@ func (x *yyLex) Lex(yylval *yySymType) (ret int) {
 			token, value = x.readString()
 			if token != eof {
 				if token == eofError {
-					return eof
+					return x.errorToken()
 				}
 				yylval.obj = value
 				return token
@@ -540,9 +598,15 @@ func (x *yyLex) Lex(yylval *yySymType) (ret int) {
 
 			// Nothing we recognise found
 			x.SyntaxError("invalid syntax")
-			return eof
+			return x.errorToken()
 		case checkEof:
 			if x.eof {
+				// Tokenizing puts the end on the line after the last
+				if x.tokenize && x.pos.ColOffset != 0 {
+					x.pos.Lineno++
+					x.pos.ColOffset = 0
+					yylval.pos = x.pos
+				}
 				x.queueDedents()
 				// then return ENDMARKER
 				x.state = isEof
@@ -662,17 +726,26 @@ func (x *yyLex) readOperator() int {
 	return eof
 }
 
-const pointFloat = `([0-9]*\.[0-9]+|[0-9]+\.)`
+// Digits may be separated by single underscores
+const (
+	digitPart  = `[0-9](?:_?[0-9])*`
+	pointFloat = `((?:` + digitPart + `)?\.` + digitPart + `|` + digitPart + `\.)`
+)
 
 var (
-	decimalInteger        = regexp.MustCompile(`^[0-9]+[jJ]?`)
+	decimalInteger        = regexp.MustCompile(`^` + digitPart + `[jJ]?`)
 	illegalDecimalInteger = regexp.MustCompile(`^0[0-9]*[1-9][0-9]*$`)
-	octalInteger          = regexp.MustCompile(`^0[oO][0-7]+`)
-	hexInteger            = regexp.MustCompile(`^0[xX][0-9a-fA-F]+`)
-	binaryInteger         = regexp.MustCompile(`^0[bB][01]+`)
-	floatNumber           = regexp.MustCompile(`^(([0-9]+|` + pointFloat + `)[eE][+-]?[0-9]+|` + pointFloat + `)[jJ]?`)
+	octalInteger          = regexp.MustCompile(`^0[oO](?:_?[0-7])+`)
+	hexInteger            = regexp.MustCompile(`^0[xX](?:_?[0-9a-fA-F])+`)
+	binaryInteger         = regexp.MustCompile(`^0[bB](?:_?[01])+`)
+	floatNumber           = regexp.MustCompile(`^((` + digitPart + `|` + pointFloat + `)[eE][+-]?` + digitPart + `|` + pointFloat + `)[jJ]?`)
 )
 
+// Removes the underscores used to group digits from a number
+func removeUnderscores(s string) string {
+	return strings.Replace(s, "_", "", -1)
+}
+
 // Read one of the many types of python number
 //
 // Returns eof for couldn't read number or eofError on a bad read
@@ -698,27 +771,27 @@ isNumber:
 	var s string
 	var err error
 	if s = octalInteger.FindString(x.line); s != "" {
-		value, err = py.IntFromString(s[2:], 8)
+		value, err = py.IntFromString(removeUnderscores(s)[2:], 8)
 		if err != nil {
 			panic(err)
 		}
 	} else if s = hexInteger.FindString(x.line); s != "" {
-		value, err = py.IntFromString(s[2:], 16)
+		value, err = py.IntFromString(removeUnderscores(s)[2:], 16)
 		if err != nil {
 			panic(err)
 		}
 	} else if s = binaryInteger.FindString(x.line); s != "" {
-		value, err = py.IntFromString(s[2:], 2)
+		value, err = py.IntFromString(removeUnderscores(s)[2:], 2)
 		if err != nil {
 			panic(err)
 		}
 	} else if s = floatNumber.FindString(x.line); s != "" {
 		last := s[len(s)-1]
 		imaginary := false
-		toParse := s
+		toParse := removeUnderscores(s)
 		if last == 'j' || last == 'J' {
 			imaginary = true
-			toParse = s[:len(s)-1]
+			toParse = toParse[:len(toParse)-1]
 		}
 		value, err = py.FloatFromString(toParse)
 		if err != nil {
@@ -730,7 +803,8 @@ isNumber:
 	} else if s = decimalInteger.FindString(x.line); s != "" {
 		last := s[len(s)-1]
 		if last == 'j' || last == 'J' {
-			toParse := s[:len(s)-1]
+			toParse := removeUnderscores(s)
+			toParse = toParse[:len(toParse)-1]
 			value, err = py.FloatFromString(toParse)
 			if err != nil {
 				panic(err)
@@ -738,12 +812,12 @@ isNumber:
 			value = py.Complex(complex(0, value.(py.Float)))
 		} else {
 			// Discard numbers with leading 0 except all 0s
-			if illegalDecimalInteger.FindString(s) != "" {
+			if illegalDecimalInteger.FindString(removeUnderscores(s)) != "" {
 				// FIXME where is this error going in the grammar?
 				x.SyntaxError("illegal decimal with leading zero")
 				return eofError, nil
 			}
-			value, err = py.IntFromString(s, 10)
+			value, err = py.IntFromString(removeUnderscores(s), 10)
 			if err != nil {
 				panic(err)
 			}
@@ -777,6 +851,7 @@ func (x *yyLex) readString() (token int, value py.Object) {
 
 	rawString := false  // whether we are parsing a r"" string
 	byteString := false // whether we are parsing a b"" string
+	fString := false    // whether we are parsing a f"" string
 	// u"" strings are just normal strings so we ignore that qualifier
 
 	// Start of string
@@ -798,6 +873,11 @@ func (x *yyLex) readString() (token int, value py.Object) {
 		x.cut(1)
 		goto found
 	}
+	if (r0 == 'f' || r0 == 'F') && (r1 == '\'' || r1 == '"') {
+		fString = true
+		x.cut(1)
+		goto found
+	}
 	// Or start of br"" Br"" bR"" BR"" rb"" rB"" Rb"" RB""
 	if (r0 == 'r' || r0 == 'R') && (r1 == 'b' || r1 == 'B') && (r2 == '\'' || r2 == '"') {
 		rawString = true
@@ -811,8 +891,22 @@ func (x *yyLex) readString() (token int, value py.Object) {
 		x.cut(2)
 		goto found
 	}
+	// Or start of fr"" Fr"" fR"" FR"" rf"" rF"" Rf"" RF""
+	if (r0 == 'r' || r0 == 'R') && (r1 == 'f' || r1 == 'F') && (r2 == '\'' || r2 == '"') {
+		rawString = true
+		fString = true
+		x.cut(2)
+		goto found
+	}
+	if (r0 == 'f' || r0 == 'F') && (r1 == 'r' || r1 == 'R') && (r2 == '\'' || r2 == '"') {
+		rawString = true
+		fString = true
+		x.cut(2)
+		goto found
+	}
 	return eof, nil
 found:
+	lineno := x.pos.Lineno
 	multiLineString := false
 	stringEnd := ""
 
@@ -877,6 +971,17 @@ found:
 		x.refill()
 	}
 foundEndOfString:
+	if x.tokenize {
+		// Only the text of the string is needed
+		return STRING, nil
+	}
+	if fString {
+		value := x.readFString(buf.String(), rawString, lineno)
+		if value == nil {
+			return eofError, nil
+		}
+		return STRING, value
+	}
 	if !rawString {
 		var err error
 		buf, err = DecodeEscape(buf, byteString)
@@ -893,6 +998,13 @@ foundEndOfString:
 
 // The parser calls this method on a parse error.
 func (x *yyLex) Error(s string) {
+	// Errors caused by a token returned after a lexing error
+	// have been reported already
+	if !x.lexError {
+		msg, indentation := x.parserErrorMessage(s)
+		x.addDiagnostic(x.yylval.pos, x.pos, msg)
+		x.diagnostics[len(x.diagnostics)-1].indentation = indentation
+	}
 	x.error = true
 	if yyDebug >= 1 {
 		log.Printf("Parse error: %s", s)
@@ -901,10 +1013,16 @@ func (x *yyLex) Error(s string) {
 	}
 }
 
-// The parser calls this method on a parse error.
+// The lexer and the grammar actions call this method on a syntax error.
 func (x *yyLex) SyntaxError(s string) {
-	x.errorString = s
-	x.Error(s)
+	x.addDiagnostic(x.pos, x.pos, s)
+	x.error = true
+}
+
+// The lexer calls this method on an error in the indentation.
+func (x *yyLex) IndentationError(s string) {
+	x.SyntaxError(s)
+	x.diagnostics[len(x.diagnostics)-1].indentation = true
 }
 
 // Call this to write formatted errors
@@ -912,19 +1030,59 @@ func (x *yyLex) SyntaxErrorf(format string, a ...interface{}) {
 	x.SyntaxError(fmt.Sprintf(format, a...))
 }
 
-// Returns an python error for the current yyLex
-func (x *yyLex) ErrorReturn() error {
-	if x.error {
-		if x.errorString == "" {
-			if x.eof && x.interactive {
-				x.errorString = "unexpected EOF while parsing"
-			} else {
-				x.errorString = "invalid syntax"
-			}
+// Records a diagnostic for an error from start to end
+func (x *yyLex) addDiagnostic(start, end ast.Pos, msg string) {
+	if end.Lineno != start.Lineno || end.ColOffset <= start.ColOffset {
+		end = ast.Pos{Lineno: start.Lineno, ColOffset: start.ColOffset + 1}
+	}
+	x.diagnostics = append(x.diagnostics, Diagnostic{
+		Lineno:       start.Lineno,
+		ColOffset:    start.ColOffset,
+		EndLineno:    end.Lineno,
+		EndColOffset: end.ColOffset,
+		Msg:          msg,
+		line:         x.lastLine,
+	})
+}
+
+// Returns the token to give the parser after a lexing error
+//
+// This is eof to stop the parse unless recovering from errors, when
+// the rest of the line is skipped and ERRORTOKEN is returned to
+// start the parser's error recovery.
+//
+// When tokenizing ERRORTOKEN covers the text which couldn't be lexed
+// and lexing carries on after it.
+func (x *yyLex) errorToken() int {
+	x.lexError = true
+	if !x.recover {
+		return eof
+	}
+	if x.tokenize {
+		start := x.yylval.pos
+		if x.pos == start {
+			// Skip the character which wasn't recognised
+			_, size := utf8.DecodeRuneInString(x.line)
+			x.cut(size)
+		} else if x.pos.Lineno != start.Lineno {
+			// Unterminated string continued to the end of the input
+			x.cut(len(x.line))
 		}
-		return py.ExceptionNewf(py.SyntaxError, "%s", x.errorString)
+		x.state = parseTokens
+		return ERRORTOKEN
 	}
-	return nil
+	x.line = "\n"
+	x.state = parseTokens
+	x.bracket, x.parenthesis, x.brace = 0, 0, 0
+	return ERRORTOKEN
+}
+
+// Returns a python SyntaxError for the first error found or nil
+func (x *yyLex) ErrorReturn() error {
+	if !x.error || len(x.diagnostics) == 0 {
+		return nil
+	}
+	return x.diagnostics[0].syntaxError(x.filename)
 }
 
 // Set the debug level 0 = off, 4 = max
@@ -945,9 +1103,6 @@ func Parse(in io.Reader, filename string, mode string) (mod ast.Mod, err error)
 	}()
 	yyParse(lex)
 	err = lex.ErrorReturn()
-	if err != nil {
-		err = py.MakeSyntaxError(err, filename, lex.pos.Lineno, lex.pos.ColOffset, lex.lastLine)
-	}
 	return lex.mod, err
 }
 
@@ -977,9 +1132,6 @@ func Lex(in io.Reader, filename string, mode string) (lts LexTokens, err error)
 		lts = append(lts, lt)
 	}
 	err = lex.ErrorReturn()
-	if err != nil {
-		err = py.MakeSyntaxError(err, filename, lex.pos.Lineno, lex.pos.ColOffset, lex.lastLine)
-	}
 	return
 }
 
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"math"
+	"strings"
 	"testing"
 
 	"github.com/go-python/gpython/ast"
@@ -244,7 +245,7 @@ func TestLex(t *testing.T) {
 			{NUMBER, py.Int(1), ast.Pos{1, 0}},
 			{ENDMARKER, nil, ast.Pos{1, 1}},
 		}},
-		{"01", "illegal decimal with leading zero 1:0", "eval", LexTokens{
+		{"01", "illegal decimal with leading zero 1:1", "eval", LexTokens{
 			{EVAL_INPUT, nil, ast.Pos{0, 0}},
 		}},
 		{"1", "", "exec", LexTokens{
@@ -261,7 +262,7 @@ func TestLex(t *testing.T) {
 			{NEWLINE, nil, ast.Pos{1, 5}},
 			{ENDMARKER, nil, ast.Pos{1, 5}},
 		}},
-		{"01", "illegal decimal with leading zero 1:0", "exec", LexTokens{
+		{"01", "illegal decimal with leading zero 1:1", "exec", LexTokens{
 			{FILE_INPUT, nil, ast.Pos{0, 0}},
 		}},
 		{"1\n 2\n  3\n4\n", "", "exec", LexTokens{
@@ -280,7 +281,7 @@ func TestLex(t *testing.T) {
 			{NEWLINE, nil, ast.Pos{4, 1}},
 			{ENDMARKER, nil, ast.Pos{5, 0}},
 		}},
-		{"if 1:\n  pass \n pass\n", "Inconsistent indent 3:1", "exec", LexTokens{
+		{"if 1:\n  pass \n pass\n", "Inconsistent indent 3:2", "exec", LexTokens{
 			{FILE_INPUT, nil, ast.Pos{0, 0}},
 			{IF, nil, ast.Pos{1, 0}},
 			{NUMBER, py.Int(1), ast.Pos{1, 3}},
@@ -340,7 +341,7 @@ func TestLex(t *testing.T) {
 			{NUMBER, py.Complex(complex(0, 6.1)), ast.Pos{1, 13}},
 			{ENDMARKER, nil, ast.Pos{1, 17}},
 		}},
-		{"001", "illegal decimal with leading zero 1:0", "eval", LexTokens{
+		{"001", "illegal decimal with leading zero 1:1", "eval", LexTokens{
 			{EVAL_INPUT, nil, ast.Pos{0, 0}},
 		}},
 		{"u'''1\n2\n'''", "", "eval", LexTokens{
@@ -348,7 +349,7 @@ func TestLex(t *testing.T) {
 			{STRING, py.String("1\n2\n"), ast.Pos{1, 0}},
 			{ENDMARKER, nil, ast.Pos{3, 3}},
 		}},
-		{"\"hello\n", "EOL while scanning string literal 1:1", "eval", LexTokens{
+		{"\"hello\n", "EOL while scanning string literal 1:2", "eval", LexTokens{
 			{EVAL_INPUT, nil, ast.Pos{0, 0}},
 		}},
 		{"1 >>-3\na <<=+12", "", "eval", LexTokens{
@@ -364,7 +365,7 @@ func TestLex(t *testing.T) {
 			{NUMBER, py.Int(12), ast.Pos{2, 6}},
 			{ENDMARKER, nil, ast.Pos{2, 8}},
 		}},
-		{"$asdasd", "invalid syntax 1:0", "eval", LexTokens{
+		{"$asdasd", "invalid syntax 1:1", "eval", LexTokens{
 			{EVAL_INPUT, nil, ast.Pos{0, 0}},
 		}},
 		{"if True:\n   pass\n\n", "", "single", LexTokens{
@@ -631,6 +632,20 @@ func TestLexerReadNumber(t *testing.T) {
 		{"0123", eofError, nil, "0123"},
 		{"0123j", NUMBER, py.Complex(complex(0, 123)), ""},
 		{"00j", NUMBER, py.Complex(complex(0, 0)), ""},
+
+		{"1_000_000", NUMBER, py.Int(1000000), ""},
+		{"1__0", NUMBER, py.Int(1), "__0"},
+		{"1_", NUMBER, py.Int(1), "_"},
+		{"0_0", NUMBER, py.Int(0), ""},
+		{"0_7", eofError, nil, "0_7"},
+		{"0x_ff_ff", NUMBER, py.Int(0xffff), ""},
+		{"0o_7_7", NUMBER, py.Int(077), ""},
+		{"0b_1_0", NUMBER, py.Int(2), ""},
+		{"0x_", NUMBER, py.Int(0), "x_"},
+		{"1_0.2_5", NUMBER, py.Float(10.25), ""},
+		{"1_0e1_0", NUMBER, py.Float(10e10), ""},
+		{".5_5j", NUMBER, py.Complex(complex(0, .55)), ""},
+		{"1_0j", NUMBER, py.Complex(complex(0, 10)), ""},
 	} {
 		x.line = test.in
 		token, value := x.readNumber()
@@ -698,6 +713,16 @@ func TestLexerReadString(t *testing.T) {
 		{`bR'abc'`, STRING, py.Bytes(string(`abc`)), ``},
 		{`BR"""a\nc"""`, STRING, py.Bytes(string(`a\nc`)), ``},
 		{`rB'''a\"c'''`, STRING, py.Bytes(string(`a\"c`)), ``},
+
+		{`f""a`, STRING, py.String(""), "a"},
+		{`F'abc'`, STRING, py.String(`abc`), ``},
+		{`f'a\nc'`, STRING, py.String("a\nc"), ``},
+		{`f'{{a}}'`, STRING, py.String("{a}"), ``},
+		{`Rf'a\n{{'`, STRING, py.String(`a\n{`), ``},
+		{`fR'''a\n}}'''`, STRING, py.String(`a\n}`), ``},
+		{`f'{'`, eofError, nil, ``},
+		{`f'}'`, eofError, nil, ``},
+		{`fb''`, eof, nil, `fb''`},
 	} {
 		x, err := NewLex(bytes.NewBufferString(test.in), "<string>", "eval")
 		if err != nil {
@@ -721,3 +746,47 @@ func TestLexerReadString(t *testing.T) {
 		}
 	}
 }
+
+func TestLexerReadFString(t *testing.T) {
+	for _, test := range []struct {
+		in  string
+		out string
+		err string
+	}{
+		{`f'{a}'`, `JoinedStr(values=[FormattedValue(value=Name(id='a', ctx=Load()), conversion=-1, format_spec=None)])`, ""},
+		{`f'a{b!r}c'`, `JoinedStr(values=[Str(s='a'), FormattedValue(value=Name(id='b', ctx=Load()), conversion=114, format_spec=None), Str(s='c')])`, ""},
+		{`f'{a:>{b}}'`, `JoinedStr(values=[FormattedValue(value=Name(id='a', ctx=Load()), conversion=-1, format_spec=JoinedStr(values=[Str(s='>'), FormattedValue(value=Name(id='b', ctx=Load()), conversion=-1, format_spec=None)]))])`, ""},
+		{`f'{a["}"]}'`, `JoinedStr(values=[FormattedValue(value=Subscript(value=Name(id='a', ctx=Load()), slice=Index(value=Str(s='}')), ctx=Load()), conversion=-1, format_spec=None)])`, ""},
+		{"f'''{a['}']}'''", `JoinedStr(values=[FormattedValue(value=Subscript(value=Name(id='a', ctx=Load()), slice=Index(value=Str(s='}')), ctx=Load()), conversion=-1, format_spec=None)])`, ""},
+		{`f'{a!=b}'`, `JoinedStr(values=[FormattedValue(value=Compare(left=Name(id='a', ctx=Load()), ops=[NotEq()], comparators=[Name(id='b', ctx=Load())]), conversion=-1, format_spec=None)])`, ""},
+		{`f'{a\n}'`, ``, "f-string expression part cannot include a backslash"},
+		{`f'{a!}'`, ``, "f-string: invalid conversion character: expected 's', 'r', or 'a'"},
+		{`f'{a:{b:{c}}}'`, ``, "f-string: expressions nested too deeply"},
+		{`f'{"a}'`, ``, "f-string: unterminated string"},
+	} {
+		x, err := NewLex(bytes.NewBufferString(test.in), "<string>", "eval")
+		if err != nil {
+			t.Fatal(err)
+		}
+		x.refill()
+		token, value := x.readString()
+		if test.err != "" {
+			errString := ""
+			if len(x.diagnostics) > 0 {
+				errString = x.diagnostics[0].Msg
+			}
+			if token != eofError || !strings.HasPrefix(errString, test.err) {
+				t.Errorf("readString(%q) got token %q error %q, expected error %q", test.in, tokenToString[token], errString, test.err)
+			}
+			continue
+		}
+		js, ok := value.(*ast.JoinedStr)
+		if token != STRING || !ok {
+			t.Errorf("readString(%q) got (%q,%T), expected JoinedStr", test.in, tokenToString[token], value)
+			continue
+		}
+		if out := ast.Dump(js); out != test.out {
+			t.Errorf("readString(%q)\nwant> %q\n got> %q", test.in, test.out, out)
+		}
+	}
+}
@@ -29,6 +29,30 @@ inp = [
     ('"abc" """123"""', "eval"),
     ("b'abc'", "eval"),
     ("b'abc' b'''123'''", "eval"),
+    # f-strings
+    ("f'abc'", "eval"),
+    ("f'{a}'", "eval"),
+    ("f'a{b}c'", "eval"),
+    ("f'{{a}}'", "eval"),
+    ("f'{a!r}'", "eval"),
+    ("f'{a!s:>10}'", "eval"),
+    ("f'{a!a:{b}.{c}f}'", "eval"),
+    ('f\'{a["b"]} {c}\'', "eval"),
+    ("'x' f'{a}' 'y' f'z'", "eval"),
+    ("F'{a}' rf'\\n{b}'", "eval"),
+    ("f'{(lambda x: x)(1)}'", "eval"),
+    ("f'{ {1: 2}[1] }'", "eval"),
+    ("f'{a!=b}'", "eval"),
+    ("f'{}'", "eval", SyntaxError, "f-string: empty expression not allowed"),
+    ("f'{ }'", "eval", SyntaxError, "f-string: empty expression not allowed"),
+    ("f'}'", "eval", SyntaxError, "f-string: single '}' is not allowed"),
+    ("f'{a'", "eval", SyntaxError, "f-string: expecting '}'"),
+    ("f'{a!x}'", "eval", SyntaxError, "f-string: invalid conversion character: expected 's', 'r', or 'a'"),
+    ("f'{a#}'", "eval", SyntaxError, "f-string expression part cannot include '#'"),
+    ("f'{a:{b:{c}}}'", "eval", SyntaxError, "f-string: expressions nested too deeply"),
+    ("f'{a b}'", "eval", SyntaxError, "invalid syntax"),
+    ("b'a' f'b'", "eval", SyntaxError, "cannot mix bytes and nonbytes literals"),
+    ("f'a' b'b'", "eval", SyntaxError, "cannot mix string and nonstring literals"),
     ("1234", "eval"),
     ("01234", "eval", SyntaxError, "illegal decimal with leading zero"),
     ("1234d", "eval", SyntaxError, "invalid syntax"),
@@ -52,6 +76,15 @@ inp = [
     ("(1,2)", "eval"),
     ("(1,2,)", "eval"),
     ("{(1,2)}", "eval"),
+    ("[*a]", "eval"),
+    ("[*a,*b,c]", "eval"),
+    ("(*a,b)", "eval"),
+    ("*a,b", "eval", SyntaxError),
+    ("{*a}", "eval"),
+    ("{*a,b,*c}", "eval"),
+    ("{**a}", "eval"),
+    ("{**a,b:c,**d}", "eval"),
+    ("{a:b,**c,}", "eval"),
     ("(((((1,),(2,),),(2,),),((1,),(2,),),),((1,),(2,),))", "eval"),
     ("(((1)))", "eval"),
     ("[1]", "eval"),
@@ -107,6 +140,7 @@ inp = [
     ("a+b", "eval"),
     ("a-b", "eval"),
     ("a*b", "eval"),
+    ("a@b", "eval"),
     ("a/b", "eval"),
     ("a//b", "eval"),
     ("a**b", "eval"),
@@ -159,12 +193,19 @@ inp = [
     ("a(b,c)", "eval"),
     ("a(b,*c)", "eval"),
     ("a(*b)", "eval"),
-    ("a(*b,c)", "eval", SyntaxError),
+    ("a(*b,c)", "eval"),
     ("a(b,*c,**d)", "eval"),
     ("a(b,**c)", "eval"),
     ("a(a=b)", "eval"),
     ("a(a,a=b,*args,**kwargs)", "eval"),
     ("a(a,a=b,*args,e=f,**kwargs)", "eval"),
+    ("a(*b,*c)", "eval"),
+    ("a(**b,**c)", "eval"),
+    ("a(**b,c=d)", "eval"),
+    ("a(*b,**c,*d)", "eval", SyntaxError, "iterable argument unpacking follows keyword argument unpacking"),
+    ("a(**b,c)", "eval", SyntaxError, "positional argument follows keyword argument unpacking"),
+    ("a(b=c,d)", "eval", SyntaxError, "positional argument follows keyword argument"),
+    ("a(b=c,*d)", "eval"),
     ("a(b for c in d)", "eval"),
     ("a.b", "eval"),
     ("a.b.c.d", "eval"),
@@ -364,6 +405,7 @@ with x as y, a as b, c, d as e:
     ("a >>= b", "exec"),
     ("a **= b", "exec"),
     ("a //= b", "exec"),
+    ("a @= b", "exec"),
     ("a //= yield b", "exec"),
     ("a <> b", "exec", SyntaxError),
     ('''a.b += 1''', "exec"),
@@ -437,6 +479,7 @@ with x as y, a as b, c, d as e:
     ("class A(B,C): pass", "exec"),
     ("class A(B,C,D=F): pass", "exec"),
     ("class A(B,C,D=F,*AS,**KWS): pass", "exec"),
+    ("class A(*B,**C): pass", "exec"),
 
     # decorators
     ("""\
@@ -470,6 +513,59 @@ def fn():
 class A(B):
     pass
 """, "exec"),
+    ("""\
+@a.b
+@a.b.c(d)
+def fn():
+    pass
+""", "exec"),
+
+    # async/await
+    ("async def fn(): pass", "exec"),
+    ("async def fn(a) -> b:\n    await a\n", "exec"),
+    ("@dec\nasync def fn():\n    pass\n", "exec"),
+    ("async def fn():\n    async with a as b, c:\n        pass\n", "exec"),
+    ("async def fn():\n    async for a in b:\n        pass\n    else:\n        pass\n", "exec"),
+    ("async def fn():\n    return await a.b(c)[d] ** -await e\n", "exec"),
+    ("await", "eval", SyntaxError),
+    ("async x = 1", "exec", SyntaxError),
+
+    # variable annotations
+    ("x: int", "exec"),
+    ("x: int = 1", "exec"),
+    ("(x): int = 1", "exec"),
+    ("a.b: c", "exec"),
+    ("a[b]: c = d", "exec"),
+    ("class A:\n    x: int = 0\n", "exec"),
+    ("x, y: int", "exec", SyntaxError),
+    ("[x]: int", "exec", SyntaxError),
+    ("f(): int", "exec", SyntaxError),
+
+    # assignment expressions
+    ("(a := 1)", "eval"),
+    ("[y := f(x), y**2]", "eval"),
+    ("f(a := 1)", "eval"),
+    ("if n := len(a):\n    pass\n", "exec"),
+    ("while (x := f()) != 0:\n    pass\n", "exec"),
+    ("[y for x in a if (y := f(x))]", "eval"),
+    ("a := 1", "exec", SyntaxError),
+    ("(a.b := 1)", "eval", SyntaxError),
+
+    # positional-only parameters
+    ("def f(a, /): pass", "exec"),
+    ("def f(a, /, b): pass", "exec"),
+    ("def f(a, b=1, /, c=2, *d, e=3, **f): pass", "exec"),
+    ("def f(a: int, /,): pass", "exec"),
+    ("lambda a, /: a", "eval"),
+    ("lambda a=1, /, b=2: a", "eval"),
+    ("def f(/): pass", "exec", SyntaxError, "expected ')'"),
+    ("def f(a, /, /): pass", "exec", SyntaxError, "expected ')'"),
+    ("def f(*, a, /): pass", "exec", SyntaxError),
+
+    # underscores in numbers
+    ("1_000_000", "eval"),
+    ("0x_ff_ff + 0o7_7 + 0b1_0", "eval"),
+    ("1_0.2_5", "eval"),
 
     # single input
     ("", "single", SyntaxError),
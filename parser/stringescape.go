@@ -9,6 +9,7 @@ import (
 	"strconv"
 
 	"github.com/go-python/gpython/py"
+	"github.com/go-python/gpython/unicodedata"
 )
 
 // DecodeEscape unescapes a backslash-escaped buffer
@@ -131,8 +132,22 @@ func DecodeEscape(in *bytes.Buffer, byteMode bool) (out *bytes.Buffer, err error
 				ignoreEscape = true
 				break
 			}
-			// FIXME go can't do this as builtin so ignore for the moment
-			ignoreEscape = true
+			if i+1 >= len(runes) || runes[i+1] != '{' {
+				return nil, py.ExceptionNewf(py.ValueError, "malformed \\N character escape at position %d", i-1)
+			}
+			end := i + 2
+			for end < len(runes) && runes[end] != '}' {
+				end++
+			}
+			if end >= len(runes) || end == i+2 {
+				return nil, py.ExceptionNewf(py.ValueError, "malformed \\N character escape at position %d", i-1)
+			}
+			cout, ok := unicodedata.Lookup(string(runes[i+2 : end]))
+			if !ok {
+				return nil, py.ExceptionNewf(py.ValueError, "unknown Unicode character name at position %d", i-1)
+			}
+			out.WriteRune(cout)
+			i = end
 		default:
 			ignoreEscape = true
 			break
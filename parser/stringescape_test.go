@@ -65,7 +65,14 @@ func TestDecodeEscape(t *testing.T) {
 		{`{\U00001234}`, "{\U00001234}", "", false},
 		{`\U00000001\U0000018a\U000012ff`, "\U00000001\U0000018a\U000012ff", "", false},
 		{`\U00000001\U0000018A\U000012FF`, "\U00000001\U0000018a\U000012ff", "", false},
-		{`\N{potato}`, `\N{potato}`, "", false},
+		{`\N{LATIN SMALL LETTER A}`, "a", "", false},
+		{`x\N{latin capital letter a with grave}y`, "x\u00c0y", "", false},
+		{`\N{EM DASH}\N{HANGUL SYLLABLE GAG}\N{CJK UNIFIED IDEOGRAPH-4E00}`, "\u2014\uac01\u4e00", "", false},
+		{`\N{spudnik}`, "", `unknown Unicode character name at position 0`, false},
+		{`z\N`, "", `malformed \N character escape at position 1`, false},
+		{`\Nx`, "", `malformed \N character escape at position 0`, false},
+		{`\N{}`, "", `malformed \N character escape at position 0`, false},
+		{`\N{EM DASH`, "", `malformed \N character escape at position 0`, false},
 
 		// Bytemode tests
 		{``, ``, "", true},
@@ -0,0 +1,175 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Public interface to the token stream
+
+package parser
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-python/gpython/ast"
+	"github.com/go-python/gpython/py"
+)
+
+// Token is a token read by a Tokenizer
+//
+// Lines start from 1 and columns are byte offsets starting from 0 as
+// in ast.Pos.
+type Token struct {
+	Kind  int     // kind of token, eg NAME, NUMBER, COMMENT, IF or '+'
+	Text  string  // source text of the token
+	Start ast.Pos // position of the start of the token
+	End   ast.Pos // position just past the end of the token
+	Line  string  // the physical lines the token was found on
+}
+
+// TokenName returns the name of a token kind, eg "NAME", "if" or "+"
+func TokenName(kind int) string {
+	if name, ok := tokenToString[kind]; ok {
+		return name
+	}
+	return fmt.Sprintf("token(%d)", kind)
+}
+
+// String a Token
+func (t Token) String() string {
+	return fmt.Sprintf("%s %q %d:%d-%d:%d", TokenName(t.Kind), t.Text, t.Start.Lineno, t.Start.ColOffset, t.End.Lineno, t.End.ColOffset)
+}
+
+// Tokenizer reads the tokens of python source in exec mode
+//
+// Unlike the parser it returns COMMENT and NL tokens so that the
+// source can be reconstructed from the tokens.  INDENT tokens cover
+// the indent and DEDENT and ENDMARKER tokens are empty.
+type Tokenizer struct {
+	lex  *yyLex
+	done bool
+}
+
+// NewTokenizer makes a Tokenizer reading python source from in
+func NewTokenizer(in io.Reader, filename string) *Tokenizer {
+	lex, err := NewLex(in, filename, "exec")
+	if err != nil {
+		panic(err) // "exec" is always a valid mode
+	}
+	lex.recover = true
+	lex.tokenize = true
+	lex.dequeue() // drop FILE_INPUT
+	return &Tokenizer{lex: lex}
+}
+
+// Next returns the next token or io.EOF after ENDMARKER has been
+// returned.
+//
+// If the source couldn't be lexed then a SyntaxError is returned as
+// well as a token, which is normally an ERRORTOKEN.  Tokenizing can
+// carry on by calling Next again.  ENDMARKER is returned with a
+// SyntaxError if the input ends with brackets open.
+func (t *Tokenizer) Next() (tok Token, err error) {
+	if t.done {
+		return tok, io.EOF
+	}
+	x := t.lex
+	defer func() {
+		if r := recover(); r != nil {
+			t.done = true
+			tok = Token{Kind: ERRORTOKEN, Start: x.pos, End: x.pos}
+			err = py.MakeSyntaxError(r, x.filename, x.pos.Lineno, x.pos.ColOffset, x.lastLine)
+		}
+	}()
+	nDiagnostics := len(x.diagnostics)
+	yylval := yySymType{}
+	tok.Kind = x.Lex(&yylval)
+	tok.Start = yylval.pos
+	tok.End = x.pos
+	switch tok.Kind {
+	case eof:
+		t.done = true
+		return tok, io.EOF
+	case ENDMARKER:
+		t.done = true
+		if x.openBrackets() {
+			x.addDiagnostic(tok.Start, tok.Start, "unexpected EOF while parsing")
+		}
+		fallthrough
+	case DEDENT:
+		tok.End = tok.Start
+	case INDENT:
+		tok.End.ColOffset = len(x.currentIndent)
+	}
+	tok.Text = t.text(tok.Start, tok.End)
+	tok.Line = t.line(tok.Start, tok.End)
+	if len(x.diagnostics) > nDiagnostics {
+		err = x.diagnostics[nDiagnostics].syntaxError(x.filename)
+	}
+	return tok, err
+}
+
+// Returns the physical lines from start to end
+func (t *Tokenizer) line(start, end ast.Pos) string {
+	lines := t.lex.lines
+	if start.Lineno < 1 || start.Lineno > len(lines) {
+		return ""
+	}
+	if end.Lineno > len(lines) {
+		end.Lineno = len(lines)
+	}
+	return strings.Join(lines[start.Lineno-1:end.Lineno], "")
+}
+
+// Returns the source text from start to end
+func (t *Tokenizer) text(start, end ast.Pos) string {
+	lines := t.lex.lines
+	// clip returns line i from column col to column end
+	clip := func(i, col, end int) string {
+		if i < 1 || i > len(lines) {
+			return ""
+		}
+		line := lines[i-1]
+		if end < 0 || end > len(line) {
+			end = len(line)
+		}
+		if col > end {
+			col = end
+		}
+		return line[col:end]
+	}
+	if start.Lineno == end.Lineno {
+		return clip(start.Lineno, start.ColOffset, end.ColOffset)
+	}
+	var b strings.Builder
+	b.WriteString(clip(start.Lineno, start.ColOffset, -1))
+	for i := start.Lineno + 1; i < end.Lineno; i++ {
+		b.WriteString(clip(i, 0, -1))
+	}
+	b.WriteString(clip(end.Lineno, 0, end.ColOffset))
+	return b.String()
+}
+
+// Tokenize reads all the tokens from in
+//
+// It returns the tokens up to and including ENDMARKER and the first
+// error found, if any.
+func Tokenize(in io.Reader, filename string) (toks []Token, err error) {
+	t := NewTokenizer(in, filename)
+	for {
+		tok, tokErr := t.Next()
+		if tokErr == io.EOF {
+			break
+		}
+		if tokErr != nil && err == nil {
+			err = tokErr
+		}
+		toks = append(toks, tok)
+	}
+	return toks, err
+}
+
+// TokenizeString reads all the tokens from a string
+func TokenizeString(in string) ([]Token, error) {
+	return Tokenize(strings.NewReader(in), "<string>")
+}
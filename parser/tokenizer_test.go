@@ -0,0 +1,54 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parser
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-python/gpython/py"
+)
+
+func TestTokenize(t *testing.T) {
+	for _, test := range []struct {
+		in     string
+		tokens []string
+		err    string
+	}{
+		{"x", []string{`NAME "x" 1:0-1:1`, `NEWLINE "" 1:1-1:2`, `ENDMARKER "" 2:0-2:0`}, ""},
+		{"x = 1  # one\n", []string{`NAME "x" 1:0-1:1`, `= "=" 1:2-1:3`, `NUMBER "1" 1:4-1:5`, `COMMENT "# one" 1:7-1:12`, `NEWLINE "\n" 1:12-1:13`, `ENDMARKER "" 2:0-2:0`}, ""},
+		{"if x:\n  # c\n\n  y\n", []string{`if "if" 1:0-1:2`, `NAME "x" 1:3-1:4`, `: ":" 1:4-1:5`, `NEWLINE "\n" 1:5-1:6`, `COMMENT "# c" 2:2-2:5`, `NL "\n" 2:5-2:6`, `NL "\n" 3:0-3:1`, `INDENT "  " 4:0-4:2`, `NAME "y" 4:2-4:3`, `NEWLINE "\n" 4:3-4:4`, `DEDENT "" 5:0-5:0`, `ENDMARKER "" 5:0-5:0`}, ""},
+		{"f(1,\n  2)\n", []string{`NAME "f" 1:0-1:1`, `( "(" 1:1-1:2`, `NUMBER "1" 1:2-1:3`, `, "," 1:3-1:4`, `NL "\n" 1:4-1:5`, `NUMBER "2" 2:2-2:3`, `) ")" 2:3-2:4`, `NEWLINE "\n" 2:4-2:5`, `ENDMARKER "" 3:0-3:0`}, ""},
+		{"s = '''a\nb''' + f'{x}'\n", []string{`NAME "s" 1:0-1:1`, `= "=" 1:2-1:3`, `STRING "'''a\nb'''" 1:4-2:4`, `+ "+" 2:5-2:6`, `STRING "f'{x}'" 2:7-2:13`, `NEWLINE "\n" 2:13-2:14`, `ENDMARKER "" 3:0-3:0`}, ""},
+		{"é = 'ü'\n", []string{`NAME "é" 1:0-1:2`, `= "=" 1:3-1:4`, `STRING "'ü'" 1:5-1:9`, `NEWLINE "\n" 1:9-1:10`, `ENDMARKER "" 2:0-2:0`}, ""},
+		{"a $ b\n", []string{`NAME "a" 1:0-1:1`, `ERRORTOKEN "$" 1:2-1:3`, `NAME "b" 1:4-1:5`, `NEWLINE "\n" 1:5-1:6`, `ENDMARKER "" 2:0-2:0`}, "1:3: invalid syntax"},
+		{"a = 'b\n", []string{`NAME "a" 1:0-1:1`, `= "=" 1:2-1:3`, `ERRORTOKEN "'" 1:4-1:5`, `NAME "b" 1:5-1:6`, `NEWLINE "\n" 1:6-1:7`, `ENDMARKER "" 2:0-2:0`}, "1:6: EOL while scanning string literal"},
+		{"a = '''b\nc", []string{`NAME "a" 1:0-1:1`, `= "=" 1:2-1:3`, `ERRORTOKEN "'''b\nc" 1:4-2:2`, `ENDMARKER "" 3:0-3:0`}, "2:1: EOF while scanning triple-quoted string literal"},
+		{"f(1,\n", []string{`NAME "f" 1:0-1:1`, `( "(" 1:1-1:2`, `NUMBER "1" 1:2-1:3`, `, "," 1:3-1:4`, `NL "\n" 1:4-1:5`, `ENDMARKER "" 2:0-2:0`}, "2:1: unexpected EOF while parsing"},
+	} {
+		toks, err := TokenizeString(test.in)
+		var got []string
+		for _, tok := range toks {
+			got = append(got, tok.String())
+		}
+		if len(got) != len(test.tokens) {
+			t.Errorf("%q: want tokens %q got %q", test.in, test.tokens, got)
+		} else {
+			for i := range got {
+				if got[i] != test.tokens[i] {
+					t.Errorf("%q: want token %q got %q", test.in, test.tokens[i], got[i])
+				}
+			}
+		}
+		errString := ""
+		if err != nil {
+			exc := err.(*py.Exception)
+			errString = fmt.Sprintf("%v:%v: %v", exc.Dict["lineno"], exc.Dict["offset"], exc.Dict["msg"])
+		}
+		if errString != test.err {
+			t.Errorf("%q: want error %q got %q", test.in, test.err, errString)
+		}
+	}
+}
@@ -3,19 +3,22 @@
 // license that can be found in the LICENSE file.
 
 // Code generated by goyacc -v y.output grammar.y. DO NOT EDIT.
+
 //line grammar.y:6
+
 package parser
 
 import __yyfmt__ "fmt"
 
 //line grammar.y:7
+
 // Grammar for Python
 
 import (
 	"fmt"
-
 	"github.com/go-python/gpython/ast"
 	"github.com/go-python/gpython/py"
+	"strings"
 )
 
 // NB can put code blocks in not just at the end
@@ -54,47 +57,108 @@ func applyTrailers(expr ast.Expr, trailers []ast.Expr) ast.Expr {
 func setCtx(yylex yyLexer, expr ast.Expr, ctx ast.ExprContext) {
 	setctxer, ok := expr.(ast.SetCtxer)
 	if !ok {
-		expr_name := ""
-		switch expr.(type) {
-		case *ast.Lambda:
-			expr_name = "lambda"
-		case *ast.Call:
-			expr_name = "function call"
-		case *ast.BoolOp, *ast.BinOp, *ast.UnaryOp:
-			expr_name = "operator"
-		case *ast.GeneratorExp:
-			expr_name = "generator expression"
-		case *ast.Yield, *ast.YieldFrom:
-			expr_name = "yield expression"
-		case *ast.ListComp:
-			expr_name = "list comprehension"
-		case *ast.SetComp:
-			expr_name = "set comprehension"
-		case *ast.DictComp:
-			expr_name = "dict comprehension"
-		case *ast.Dict, *ast.Set, *ast.Num, *ast.Str, *ast.Bytes:
-			expr_name = "literal"
-		case *ast.NameConstant:
-			expr_name = "keyword"
-		case *ast.Ellipsis:
-			expr_name = "Ellipsis"
-		case *ast.Compare:
-			expr_name = "comparison"
-		case *ast.IfExp:
-			expr_name = "conditional expression"
-		default:
-			expr_name = fmt.Sprintf("unexpected %T", expr)
-		}
 		action := "assign to"
 		if ctx == ast.Del {
 			action = "delete"
 		}
-		yylex.(*yyLex).SyntaxErrorf("can't %s %s", action, expr_name)
+		yylex.(*yyLex).SyntaxErrorf("can't %s %s", action, exprName(expr))
 		return
 	}
 	setctxer.SetCtx(ctx)
 }
 
+// Describe the kind of expression for error messages
+func exprName(expr ast.Expr) string {
+	switch expr.(type) {
+	case *ast.Lambda:
+		return "lambda"
+	case *ast.Call:
+		return "function call"
+	case *ast.BoolOp, *ast.BinOp, *ast.UnaryOp:
+		return "operator"
+	case *ast.GeneratorExp:
+		return "generator expression"
+	case *ast.Yield, *ast.YieldFrom:
+		return "yield expression"
+	case *ast.Await:
+		return "await expression"
+	case *ast.ListComp:
+		return "list comprehension"
+	case *ast.SetComp:
+		return "set comprehension"
+	case *ast.DictComp:
+		return "dict comprehension"
+	case *ast.Dict, *ast.Set, *ast.Num, *ast.Str, *ast.Bytes, *ast.JoinedStr:
+		return "literal"
+	case *ast.NameConstant:
+		return "keyword"
+	case *ast.Ellipsis:
+		return "Ellipsis"
+	case *ast.Compare:
+		return "comparison"
+	case *ast.IfExp:
+		return "conditional expression"
+	case *ast.NamedExpr:
+		return "named expression"
+	case *ast.Attribute:
+		return "attribute"
+	case *ast.Subscript:
+		return "subscript"
+	case *ast.Starred:
+		return "starred"
+	case *ast.Name:
+		return "name"
+	case *ast.List:
+		return "list"
+	case *ast.Tuple:
+		return "tuple"
+	}
+	return fmt.Sprintf("unexpected %T", expr)
+}
+
+// Make an assignment expression checking the target is a name
+func namedExpr(yylex yyLexer, pos ast.Pos, target, value ast.Expr) ast.Expr {
+	if _, ok := target.(*ast.Name); !ok {
+		yylex.(*yyLex).SyntaxErrorf("cannot use named assignment with %s", exprName(target))
+		return nil
+	}
+	setCtx(yylex, target, ast.Store)
+	return &ast.NamedExpr{ExprBase: ast.ExprBase{Pos: pos}, Target: target, Value: value}
+}
+
+// Make an annotated assignment checking the target can be annotated
+//
+// pos is the start of the statement which is used to find out
+// whether a Name target was in parentheses
+func annAssign(yylex yyLexer, pos ast.Pos, target, annotation, value ast.Expr) ast.Stmt {
+	simple := 0
+	switch x := target.(type) {
+	case *ast.Name:
+		if x.Pos == pos {
+			simple = 1
+		}
+	case *ast.Attribute, *ast.Subscript:
+	case *ast.Tuple:
+		yylex.(*yyLex).SyntaxError("only single target (not tuple) can be annotated")
+		return nil
+	case *ast.List:
+		yylex.(*yyLex).SyntaxError("only single target (not list) can be annotated")
+		return nil
+	default:
+		yylex.(*yyLex).SyntaxError("illegal target for annotation")
+		return nil
+	}
+	setCtx(yylex, target, ast.Store)
+	return &ast.AnnAssign{StmtBase: ast.StmtBase{Pos: pos}, Target: target, Annotation: annotation, Value: value, Simple: simple}
+}
+
+// Add the positional only parameters and their defaults to args
+func addPosonlyargs(args *ast.Arguments, posonlyargs []*ast.Arg, defaults []ast.Expr) *ast.Arguments {
+	args.Posonlyargs = posonlyargs
+	args.Defaults = append(append([]ast.Expr(nil), defaults...), args.Defaults...)
+	return args
+}
+
 // Set the context for all the items in exprs
 func setCtxs(yylex yyLexer, exprs []ast.Expr, ctx ast.ExprContext) {
 	for i := range exprs {
@@ -102,7 +166,31 @@ func setCtxs(yylex yyLexer, exprs []ast.Expr, ctx ast.ExprContext) {
 	}
 }
 
-//line grammar.y:103
+// Add the single argument in arg to call checking that it is allowed
+// to follow the arguments already there
+func addArgument(yylex yyLexer, call *ast.Call, arg *ast.Call) {
+	kwUnpacking := false
+	for _, kw := range call.Keywords {
+		if kw.Arg == "" {
+			kwUnpacking = true
+		}
+	}
+	if len(arg.Args) != 0 {
+		_, starred := arg.Args[0].(*ast.Starred)
+		switch {
+		case starred && kwUnpacking:
+			yylex.(*yyLex).SyntaxError("iterable argument unpacking follows keyword argument unpacking")
+		case !starred && kwUnpacking:
+			yylex.(*yyLex).SyntaxError("positional argument follows keyword argument unpacking")
+		case !starred && len(call.Keywords) != 0:
+			yylex.(*yyLex).SyntaxError("positional argument follows keyword argument")
+		}
+	}
+	call.Args = append(call.Args, arg.Args...)
+	call.Keywords = append(call.Keywords, arg.Keywords...)
+}
+
+//line grammar.y:189
 type yySymType struct {
 	yys            int
 	pos            ast.Pos // kept up to date by the lexer
@@ -164,42 +252,49 @@ const GTGT = 57372
 const GTGTEQ = 57373
 const HATEQ = 57374
 const PIPEEQ = 57375
-const FALSE = 57376
-const NONE = 57377
-const TRUE = 57378
-const AND = 57379
-const AS = 57380
-const ASSERT = 57381
-const BREAK = 57382
-const CLASS = 57383
-const CONTINUE = 57384
-const DEF = 57385
-const DEL = 57386
-const ELIF = 57387
-const ELSE = 57388
-const EXCEPT = 57389
-const FINALLY = 57390
-const FOR = 57391
-const FROM = 57392
-const GLOBAL = 57393
-const IF = 57394
-const IMPORT = 57395
-const IN = 57396
-const IS = 57397
-const LAMBDA = 57398
-const NONLOCAL = 57399
-const NOT = 57400
-const OR = 57401
-const PASS = 57402
-const RAISE = 57403
-const RETURN = 57404
-const TRY = 57405
-const WHILE = 57406
-const WITH = 57407
-const YIELD = 57408
-const SINGLE_INPUT = 57409
-const FILE_INPUT = 57410
-const EVAL_INPUT = 57411
+const ATEQ = 57376
+const COLONEQ = 57377
+const FALSE = 57378
+const NONE = 57379
+const TRUE = 57380
+const AND = 57381
+const AS = 57382
+const ASSERT = 57383
+const ASYNC = 57384
+const AWAIT = 57385
+const BREAK = 57386
+const CLASS = 57387
+const CONTINUE = 57388
+const DEF = 57389
+const DEL = 57390
+const ELIF = 57391
+const ELSE = 57392
+const EXCEPT = 57393
+const FINALLY = 57394
+const FOR = 57395
+const FROM = 57396
+const GLOBAL = 57397
+const IF = 57398
+const IMPORT = 57399
+const IN = 57400
+const IS = 57401
+const LAMBDA = 57402
+const NONLOCAL = 57403
+const NOT = 57404
+const OR = 57405
+const PASS = 57406
+const RAISE = 57407
+const RETURN = 57408
+const TRY = 57409
+const WHILE = 57410
+const WITH = 57411
+const YIELD = 57412
+const SINGLE_INPUT = 57413
+const FILE_INPUT = 57414
+const EVAL_INPUT = 57415
+const ERRORTOKEN = 57416
+const COMMENT = 57417
+const NL = 57418
 
 var yyToknames = [...]string{
 	"$end",
@@ -235,12 +330,16 @@ var yyToknames = [...]string{
 	"GTGTEQ",
 	"HATEQ",
 	"PIPEEQ",
+	"ATEQ",
+	"COLONEQ",
 	"FALSE",
 	"NONE",
 	"TRUE",
 	"AND",
 	"AS",
 	"ASSERT",
+	"ASYNC",
+	"AWAIT",
 	"BREAK",
 	"CLASS",
 	"CONTINUE",
@@ -294,7 +393,11 @@ var yyToknames = [...]string{
 	"SINGLE_INPUT",
 	"FILE_INPUT",
 	"EVAL_INPUT",
+	"ERRORTOKEN",
+	"COMMENT",
+	"NL",
 }
+
 var yyStatenames = [...]string{}
 
 const yyEofCode = 1
@@ -302,439 +405,479 @@ const yyErrCode = 2
 const yyInitialStackSize = 16
 
 //line yacctab:1
-var yyExca = [...]int{
+var yyExca = [...]int8{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 232,
-	68, 13,
-	-2, 291,
-	-1, 382,
-	68, 91,
-	-2, 292,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 1441
-
-var yyAct = [...]int{
-
-	59, 468, 61, 314, 160, 97, 165, 164, 456, 421,
-	401, 375, 321, 349, 361, 342, 141, 464, 224, 101,
-	102, 6, 259, 111, 335, 223, 334, 103, 210, 69,
-	318, 145, 457, 54, 60, 35, 237, 110, 95, 105,
-	74, 71, 72, 66, 64, 146, 150, 73, 137, 57,
-	106, 75, 231, 97, 143, 107, 70, 180, 24, 97,
-	23, 17, 189, 288, 133, 247, 106, 277, 49, 124,
-	125, 107, 130, 122, 120, 121, 2, 3, 4, 131,
-	123, 284, 128, 96, 204, 139, 243, 232, 129, 127,
-	126, 142, 378, 157, 138, 262, 236, 154, 181, 152,
-	179, 315, 148, 243, 184, 185, 48, 227, 226, 420,
-	243, 167, 211, 215, 387, 195, 166, 186, 187, 97,
-	279, 222, 280, 336, 341, 188, 385, 166, 190, 191,
-	192, 196, 199, 315, 166, 163, 281, 99, 474, 132,
-	466, 312, 163, 453, 450, 390, 398, 395, 382, 373,
-	197, 200, 234, 214, 251, 284, 289, 235, 252, 140,
-	255, 216, 151, 257, 246, 175, 238, 206, 239, 260,
-	261, 419, 241, 240, 221, 486, 473, 291, 258, 460,
-	173, 174, 171, 172, 333, 403, 340, 413, 384, 412,
-	244, 242, 480, 332, 411, 250, 249, 162, 263, 159,
-	409, 253, 254, 311, 162, 405, 400, 379, 176, 178,
-	370, 363, 177, 316, 285, 296, 256, 287, 219, 218,
-	290, 97, 267, 293, 268, 271, 272, 111, 108, 283,
-	269, 270, 286, 322, 169, 170, 266, 292, 273, 274,
-	275, 276, 325, 397, 297, 298, 328, 357, 313, 356,
-	452, 106, 396, 304, 381, 372, 107, 338, 305, 299,
-	355, 300, 353, 343, 303, 339, 282, 232, 230, 337,
-	238, 84, 239, 323, 91, 85, 284, 155, 329, 459,
-	322, 350, 156, 156, 156, 87, 156, 265, 404, 264,
-	358, 220, 359, 284, 248, 245, 459, 284, 461, 90,
-	88, 89, 365, 367, 366, 407, 362, 134, 371, 362,
-	346, 447, 354, 391, 106, 376, 377, 228, 158, 107,
-	182, 211, 307, 67, 207, 302, 183, 374, 315, 344,
-	34, 369, 81, 15, 82, 14, 383, 315, 392, 76,
-	77, 166, 380, 166, 462, 483, 430, 260, 394, 467,
-	83, 389, 402, 78, 136, 386, 114, 336, 315, 116,
-	388, 117, 166, 393, 352, 399, 465, 330, 414, 139,
-	433, 327, 324, 295, 294, 408, 135, 113, 112, 422,
-	423, 326, 217, 322, 98, 425, 426, 211, 427, 410,
-	212, 418, 406, 7, 100, 424, 417, 415, 213, 350,
-	309, 436, 308, 432, 438, 428, 440, 439, 441, 431,
-	229, 435, 434, 437, 310, 429, 161, 109, 301, 360,
-	331, 144, 147, 376, 449, 443, 149, 317, 451, 320,
-	319, 448, 348, 347, 168, 442, 25, 444, 445, 446,
-	454, 119, 193, 203, 104, 458, 205, 455, 306, 364,
-	202, 233, 68, 470, 62, 80, 278, 79, 463, 118,
-	16, 432, 469, 115, 13, 12, 11, 322, 9, 475,
-	10, 44, 43, 42, 478, 41, 481, 479, 484, 476,
-	40, 39, 485, 469, 38, 33, 472, 487, 488, 469,
-	209, 208, 84, 32, 31, 91, 85, 30, 29, 482,
-	28, 27, 26, 368, 8, 93, 87, 94, 5, 92,
-	1, 86, 0, 0, 0, 0, 0, 0, 0, 0,
-	90, 88, 89, 0, 0, 47, 50, 24, 51, 23,
-	36, 0, 0, 0, 0, 20, 56, 45, 18, 55,
-	0, 0, 65, 46, 67, 0, 37, 53, 52, 21,
-	19, 22, 58, 81, 84, 82, 416, 91, 85, 0,
-	76, 77, 63, 0, 0, 0, 0, 0, 87, 0,
-	0, 83, 0, 0, 78, 48, 0, 0, 0, 0,
-	0, 0, 90, 88, 89, 0, 0, 47, 50, 24,
-	51, 23, 36, 0, 0, 0, 0, 20, 56, 45,
-	18, 55, 0, 0, 65, 46, 67, 0, 37, 53,
-	52, 21, 19, 22, 58, 81, 84, 82, 0, 91,
-	85, 0, 76, 77, 63, 0, 0, 0, 0, 0,
-	87, 0, 0, 83, 0, 0, 78, 48, 0, 0,
-	0, 0, 0, 0, 90, 88, 89, 0, 0, 47,
-	50, 24, 51, 23, 36, 0, 0, 0, 0, 20,
-	56, 45, 18, 55, 0, 0, 65, 46, 67, 0,
-	37, 53, 52, 21, 19, 22, 58, 81, 0, 82,
-	0, 0, 0, 0, 76, 77, 63, 225, 0, 84,
-	0, 0, 91, 85, 0, 83, 0, 0, 78, 48,
-	0, 0, 0, 87, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 90, 88, 89,
-	0, 0, 47, 50, 0, 51, 0, 36, 0, 0,
-	0, 0, 0, 56, 45, 0, 55, 0, 0, 65,
-	46, 67, 0, 37, 53, 52, 0, 0, 0, 58,
-	81, 84, 82, 0, 91, 85, 0, 76, 77, 63,
-	0, 0, 0, 0, 0, 87, 0, 0, 83, 0,
-	0, 78, 0, 0, 0, 0, 0, 0, 0, 90,
-	88, 89, 0, 0, 47, 50, 0, 51, 0, 36,
-	0, 0, 0, 0, 0, 56, 45, 0, 55, 0,
-	0, 65, 46, 67, 0, 37, 53, 52, 0, 0,
-	0, 58, 81, 84, 82, 0, 91, 85, 0, 76,
-	77, 63, 0, 0, 0, 0, 0, 87, 0, 0,
-	83, 0, 0, 78, 0, 0, 0, 0, 0, 0,
-	0, 90, 88, 89, 0, 0, 0, 0, 0, 0,
-	84, 0, 0, 91, 85, 0, 0, 0, 0, 0,
-	0, 0, 0, 65, 87, 67, 0, 0, 0, 0,
-	0, 0, 0, 58, 81, 194, 82, 0, 90, 88,
-	89, 76, 77, 63, 0, 0, 0, 84, 0, 0,
-	91, 85, 83, 0, 0, 78, 0, 0, 0, 0,
-	65, 87, 67, 0, 0, 0, 0, 0, 0, 0,
-	58, 81, 0, 82, 0, 90, 88, 89, 76, 77,
-	63, 0, 0, 0, 0, 0, 0, 0, 0, 83,
-	84, 0, 78, 91, 85, 0, 0, 65, 477, 67,
-	0, 0, 0, 0, 87, 0, 0, 0, 81, 0,
-	82, 198, 0, 0, 0, 76, 77, 63, 90, 88,
-	89, 0, 0, 0, 0, 0, 83, 84, 0, 78,
-	91, 85, 0, 0, 0, 0, 0, 0, 0, 0,
-	65, 87, 67, 0, 0, 0, 0, 0, 0, 0,
-	0, 81, 0, 82, 0, 90, 88, 89, 76, 77,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 83,
-	84, 0, 78, 91, 85, 0, 0, 65, 0, 67,
-	0, 0, 0, 0, 87, 0, 0, 0, 81, 0,
-	82, 0, 403, 0, 0, 76, 77, 0, 90, 88,
-	89, 0, 0, 0, 0, 0, 83, 0, 0, 78,
-	0, 0, 84, 0, 0, 91, 85, 0, 0, 0,
-	65, 0, 67, 0, 0, 0, 87, 0, 0, 0,
-	0, 81, 0, 82, 0, 351, 0, 0, 76, 77,
-	90, 88, 89, 0, 0, 0, 0, 0, 0, 83,
-	0, 0, 78, 0, 84, 0, 0, 91, 85, 0,
-	0, 0, 65, 0, 67, 0, 0, 0, 87, 0,
-	0, 0, 0, 81, 345, 82, 0, 0, 0, 0,
-	76, 77, 90, 88, 89, 0, 0, 0, 0, 0,
-	0, 83, 0, 0, 78, 0, 0, 84, 0, 0,
-	91, 85, 0, 0, 65, 0, 67, 0, 0, 0,
-	0, 87, 0, 0, 0, 81, 0, 82, 0, 0,
-	0, 0, 76, 77, 63, 90, 88, 89, 0, 0,
-	0, 0, 0, 83, 84, 0, 78, 91, 85, 0,
-	0, 0, 0, 0, 0, 0, 0, 65, 87, 67,
-	0, 0, 0, 0, 0, 0, 0, 58, 81, 0,
-	82, 0, 90, 88, 89, 76, 77, 0, 0, 0,
-	0, 84, 0, 0, 91, 85, 83, 0, 0, 78,
-	0, 0, 0, 0, 65, 87, 67, 0, 0, 0,
-	0, 0, 0, 0, 0, 81, 0, 82, 0, 90,
-	88, 89, 76, 77, 0, 0, 0, 0, 84, 0,
-	0, 91, 85, 83, 201, 153, 78, 0, 0, 0,
-	0, 65, 87, 67, 0, 0, 0, 0, 0, 0,
-	0, 0, 81, 0, 82, 0, 90, 88, 89, 76,
-	77, 0, 0, 0, 0, 84, 0, 0, 91, 85,
-	83, 0, 0, 78, 0, 0, 0, 0, 471, 87,
-	67, 0, 0, 0, 0, 0, 0, 0, 0, 81,
-	0, 82, 0, 90, 88, 89, 76, 77, 0, 0,
-	0, 0, 84, 0, 0, 91, 85, 83, 0, 0,
-	78, 0, 0, 0, 0, 65, 87, 67, 0, 0,
-	0, 0, 0, 0, 0, 0, 81, 0, 82, 0,
-	90, 88, 89, 76, 77, 0, 0, 0, 84, 0,
-	0, 91, 85, 0, 83, 0, 0, 78, 0, 0,
-	0, 0, 87, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 81, 0, 82, 90, 88, 89, 0,
-	76, 77, 63, 0, 0, 0, 0, 0, 0, 0,
-	0, 83, 0, 0, 78, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 81,
-	0, 82, 0, 0, 0, 0, 76, 77, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 83, 0, 0,
-	78,
+const yyLast = 1707
+
+var yyAct = [...]int16{
+	64, 343, 514, 244, 173, 280, 179, 462, 178, 501,
+	338, 339, 403, 409, 342, 395, 174, 380, 374, 439,
+	349, 281, 245, 215, 502, 6, 402, 72, 365, 213,
+	175, 62, 38, 259, 347, 57, 102, 366, 109, 158,
+	100, 63, 76, 106, 108, 75, 116, 77, 67, 112,
+	209, 107, 107, 111, 78, 117, 74, 60, 154, 69,
+	73, 163, 252, 113, 194, 204, 18, 112, 14, 313,
+	253, 159, 2, 3, 4, 302, 265, 303, 146, 52,
+	269, 113, 150, 412, 214, 152, 265, 102, 156, 354,
+	84, 304, 284, 102, 170, 123, 155, 126, 257, 165,
+	344, 125, 167, 195, 25, 180, 24, 235, 461, 193,
+	104, 229, 367, 373, 198, 199, 243, 218, 107, 107,
+	223, 230, 212, 151, 200, 202, 521, 265, 216, 216,
+	181, 161, 203, 520, 205, 206, 207, 201, 136, 137,
+	498, 142, 134, 132, 133, 344, 419, 180, 143, 135,
+	497, 140, 51, 461, 495, 177, 492, 141, 139, 138,
+	144, 102, 426, 258, 308, 255, 164, 436, 224, 274,
+	256, 236, 219, 433, 460, 459, 210, 364, 261, 372,
+	371, 260, 530, 282, 283, 430, 363, 308, 180, 248,
+	247, 180, 416, 407, 126, 344, 373, 420, 273, 177,
+	180, 131, 519, 341, 277, 153, 314, 309, 177, 316,
+	285, 266, 145, 279, 264, 505, 525, 441, 275, 460,
+	310, 176, 268, 263, 271, 312, 262, 242, 315, 234,
+	318, 276, 453, 452, 272, 451, 449, 443, 307, 291,
+	292, 290, 324, 311, 326, 438, 293, 294, 317, 413,
+	404, 289, 332, 319, 288, 295, 296, 297, 298, 299,
+	172, 305, 372, 300, 435, 176, 323, 397, 345, 340,
+	112, 325, 278, 102, 176, 240, 238, 333, 114, 117,
+	389, 388, 327, 496, 113, 350, 434, 415, 370, 406,
+	261, 353, 387, 260, 331, 356, 328, 384, 89, 359,
+	306, 96, 90, 253, 251, 24, 368, 169, 360, 287,
+	369, 21, 92, 168, 308, 308, 375, 504, 442, 169,
+	169, 286, 241, 308, 169, 270, 504, 23, 95, 93,
+	94, 399, 401, 400, 350, 381, 112, 377, 267, 386,
+	506, 107, 410, 411, 396, 408, 390, 385, 392, 414,
+	113, 216, 147, 230, 417, 447, 396, 24, 490, 427,
+	249, 171, 239, 86, 196, 87, 13, 405, 11, 37,
+	197, 425, 27, 335, 344, 418, 15, 429, 180, 282,
+	432, 88, 528, 421, 422, 437, 513, 261, 423, 208,
+	260, 226, 393, 424, 431, 127, 120, 128, 344, 124,
+	428, 450, 149, 122, 180, 344, 512, 330, 457, 180,
+	448, 507, 475, 470, 440, 455, 230, 445, 367, 444,
+	446, 383, 458, 361, 152, 230, 358, 355, 322, 321,
+	468, 148, 119, 454, 118, 357, 320, 465, 237, 103,
+	105, 233, 474, 481, 463, 464, 231, 471, 350, 7,
+	473, 466, 467, 485, 476, 487, 488, 489, 477, 455,
+	479, 472, 410, 494, 469, 491, 486, 337, 381, 230,
+	478, 484, 336, 480, 493, 482, 250, 115, 329, 107,
+	394, 89, 499, 362, 96, 90, 157, 160, 162, 346,
+	348, 379, 378, 182, 26, 92, 130, 222, 500, 101,
+	110, 510, 503, 508, 509, 515, 370, 474, 334, 518,
+	511, 95, 93, 94, 522, 408, 398, 221, 85, 254,
+	523, 71, 526, 527, 524, 516, 515, 65, 529, 301,
+	531, 515, 83, 532, 82, 458, 511, 232, 129, 228,
+	227, 89, 17, 16, 96, 90, 86, 121, 87, 12,
+	9, 10, 47, 79, 80, 92, 46, 45, 44, 43,
+	42, 41, 36, 35, 88, 34, 33, 81, 32, 31,
+	30, 95, 93, 94, 29, 8, 50, 28, 85, 53,
+	25, 54, 24, 39, 98, 99, 5, 97, 21, 59,
+	48, 19, 58, 1, 91, 68, 49, 70, 0, 40,
+	56, 55, 22, 20, 23, 61, 86, 0, 87, 0,
+	0, 0, 232, 79, 80, 66, 89, 0, 483, 96,
+	90, 0, 0, 0, 88, 0, 0, 81, 51, 0,
+	92, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 95, 93, 94, 0,
+	0, 50, 28, 85, 53, 25, 54, 24, 39, 0,
+	0, 0, 0, 21, 59, 48, 19, 58, 0, 0,
+	68, 49, 70, 0, 40, 56, 55, 22, 20, 23,
+	61, 86, 0, 87, 0, 0, 0, 232, 79, 80,
+	66, 89, 0, 456, 96, 90, 0, 0, 0, 88,
+	0, 0, 81, 51, 0, 92, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 95, 93, 94, 0, 0, 50, 28, 85, 53,
+	25, 54, 24, 39, 0, 0, 0, 0, 21, 59,
+	48, 19, 58, 0, 0, 68, 49, 70, 0, 40,
+	56, 55, 22, 20, 23, 61, 86, 0, 87, 0,
+	0, 0, 232, 79, 80, 66, 89, 0, 0, 96,
+	90, 0, 0, 0, 88, 0, 0, 81, 51, 0,
+	92, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 95, 93, 94, 0,
+	0, 50, 28, 85, 53, 25, 54, 24, 39, 0,
+	0, 0, 0, 21, 59, 48, 19, 58, 0, 0,
+	68, 49, 70, 0, 40, 56, 55, 22, 20, 23,
+	61, 86, 89, 87, 0, 96, 90, 0, 79, 80,
+	66, 0, 0, 0, 0, 0, 92, 0, 0, 88,
+	0, 0, 81, 51, 0, 0, 0, 0, 0, 0,
+	0, 0, 95, 93, 94, 0, 0, 50, 28, 85,
+	53, 25, 54, 24, 39, 0, 0, 0, 0, 21,
+	59, 48, 19, 58, 0, 0, 68, 49, 70, 0,
+	40, 56, 55, 22, 20, 23, 61, 86, 0, 87,
+	0, 0, 0, 0, 79, 80, 66, 246, 0, 89,
+	0, 0, 96, 90, 0, 88, 0, 0, 81, 51,
+	0, 0, 0, 92, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 95,
+	93, 94, 0, 0, 50, 0, 85, 53, 0, 54,
+	0, 39, 0, 0, 0, 0, 0, 59, 48, 0,
+	58, 0, 0, 68, 49, 70, 0, 40, 56, 55,
+	0, 0, 0, 61, 86, 89, 87, 0, 96, 90,
+	0, 79, 80, 66, 0, 0, 0, 0, 0, 92,
+	89, 0, 88, 96, 90, 81, 0, 0, 352, 0,
+	0, 0, 0, 0, 92, 95, 93, 94, 0, 0,
+	50, 0, 85, 53, 0, 54, 0, 39, 0, 0,
+	95, 93, 94, 59, 48, 0, 58, 85, 0, 68,
+	49, 70, 0, 40, 56, 55, 0, 0, 0, 61,
+	86, 0, 87, 0, 68, 0, 70, 79, 80, 66,
+	0, 0, 0, 0, 0, 86, 376, 87, 88, 0,
+	0, 81, 79, 80, 351, 0, 89, 0, 0, 96,
+	90, 0, 0, 88, 225, 0, 81, 0, 0, 0,
+	92, 89, 0, 0, 96, 90, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 92, 95, 93, 94, 0,
+	0, 0, 0, 85, 0, 0, 0, 0, 0, 0,
+	0, 95, 93, 94, 0, 0, 0, 0, 85, 0,
+	68, 0, 70, 0, 0, 0, 189, 0, 0, 0,
+	0, 86, 0, 87, 0, 68, 0, 70, 79, 80,
+	66, 187, 188, 185, 186, 61, 86, 211, 87, 88,
+	220, 0, 81, 79, 80, 66, 0, 89, 0, 0,
+	96, 90, 0, 0, 88, 352, 0, 81, 0, 0,
+	0, 92, 89, 190, 192, 96, 90, 191, 0, 0,
+	0, 0, 0, 0, 0, 0, 92, 95, 93, 94,
+	0, 0, 0, 0, 85, 0, 0, 0, 0, 183,
+	184, 0, 95, 93, 94, 0, 0, 0, 0, 85,
+	0, 68, 0, 70, 0, 0, 0, 0, 0, 0,
+	0, 0, 86, 0, 87, 0, 68, 0, 70, 79,
+	80, 351, 0, 0, 0, 0, 61, 86, 89, 87,
+	88, 96, 90, 81, 79, 80, 66, 0, 0, 0,
+	0, 0, 92, 89, 0, 88, 96, 90, 81, 0,
+	0, 0, 0, 0, 0, 0, 0, 92, 95, 93,
+	94, 0, 0, 0, 0, 85, 0, 0, 0, 0,
+	0, 0, 0, 95, 93, 94, 0, 0, 0, 0,
+	85, 0, 68, 0, 70, 0, 0, 0, 0, 0,
+	0, 0, 0, 86, 0, 87, 217, 68, 0, 70,
+	79, 80, 66, 89, 0, 0, 96, 90, 86, 0,
+	87, 88, 441, 0, 81, 79, 80, 92, 0, 0,
+	0, 0, 0, 0, 0, 0, 88, 0, 0, 81,
+	0, 0, 0, 95, 93, 94, 0, 0, 0, 0,
+	85, 0, 0, 0, 0, 0, 0, 89, 0, 0,
+	96, 90, 0, 0, 0, 391, 0, 68, 0, 70,
+	0, 92, 0, 0, 0, 0, 0, 0, 86, 0,
+	87, 0, 382, 0, 0, 79, 80, 95, 93, 94,
+	0, 0, 0, 0, 85, 0, 88, 0, 0, 81,
+	0, 89, 0, 0, 96, 90, 0, 0, 0, 0,
+	0, 68, 0, 70, 0, 92, 0, 0, 0, 0,
+	0, 0, 86, 0, 87, 0, 0, 0, 0, 79,
+	80, 95, 93, 94, 0, 0, 0, 0, 85, 0,
+	88, 0, 0, 81, 0, 0, 89, 0, 0, 96,
+	90, 0, 0, 0, 0, 68, 0, 70, 89, 0,
+	92, 96, 90, 0, 0, 0, 86, 0, 87, 0,
+	0, 0, 92, 79, 80, 66, 95, 93, 94, 0,
+	0, 0, 0, 85, 88, 0, 0, 81, 95, 93,
+	94, 0, 0, 0, 0, 85, 0, 0, 0, 0,
+	68, 0, 70, 0, 0, 0, 166, 0, 0, 0,
+	61, 86, 68, 87, 70, 0, 0, 0, 79, 80,
+	0, 0, 0, 86, 89, 87, 0, 96, 90, 88,
+	79, 80, 81, 0, 0, 0, 89, 0, 92, 96,
+	90, 88, 0, 0, 81, 0, 0, 0, 0, 0,
+	92, 0, 0, 0, 95, 93, 94, 0, 0, 0,
+	0, 85, 0, 0, 0, 0, 95, 93, 94, 0,
+	0, 0, 0, 85, 0, 0, 0, 0, 517, 0,
+	70, 89, 0, 0, 96, 90, 0, 0, 0, 86,
+	68, 87, 70, 0, 0, 92, 79, 80, 0, 0,
+	0, 86, 0, 87, 0, 0, 0, 88, 79, 80,
+	81, 95, 93, 94, 0, 0, 0, 0, 85, 88,
+	89, 0, 81, 96, 90, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 92, 0, 0, 70, 0, 0,
+	0, 0, 0, 0, 0, 0, 86, 0, 87, 0,
+	95, 93, 94, 79, 80, 0, 0, 85, 0, 0,
+	0, 0, 0, 0, 88, 0, 0, 81, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 86, 0, 87, 0, 0,
+	0, 0, 79, 80, 66, 0, 0, 0, 0, 0,
+	0, 0, 0, 88, 0, 0, 81,
 }
-var yyPact = [...]int{
-
-	-14, -1000, 610, -1000, 1279, -1000, -1000, 380, 64, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1279, 1279,
-	1316, 157, 1279, 372, 371, 17, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 57, 1316, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 370, 370, 1279, 363, 87,
-	-1000, -1000, 1279, 1279, -1000, 363, 79, -1000, 1205, -1000,
-	-1000, 225, -1000, 1352, 281, 128, -1000, 265, 154, 22,
-	-30, 19, 296, 30, 41, -1000, 1352, 1352, 1352, -1000,
-	-1000, 807, 881, 1168, -1000, -1000, 315, -1000, -1000, -1000,
-	-1000, -1000, -1000, 486, -1000, -1000, 81, -1000, -1000, 745,
-	378, 148, 147, 237, 102, -1000, 22, -1000, 683, 36,
-	-1000, 279, 201, 200, -1000, -1000, -1000, -1000, 1131, 14,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 844, -1000, 101, -1000, 101, 100, 20, -1000,
-	1088, -1000, -1000, 245, 92, -1000, 27, 241, 3, 79,
-	-1000, -1000, -1000, 1279, -1000, 265, 265, 22, 265, 1279,
-	145, 91, 337, 337, -1000, 13, -1000, -1000, 1352, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 235, 229, 1352,
-	1352, 1352, 1352, 1352, 1352, 1352, 1352, 1352, 1352, 1352,
-	-1000, -1000, -1000, 53, -1000, 198, 248, 87, -1000, 248,
-	87, -1000, -23, 84, 106, -1000, 81, -1000, -1000, -1000,
-	-1000, -1000, -1000, 369, 1279, -1000, -1000, -1000, 683, 683,
-	1279, 1316, -1000, -1000, -1000, 318, 1279, 683, 1352, 303,
-	127, 142, 1279, -1000, -1000, -1000, 844, -1000, -1000, -1000,
-	366, 1279, 377, 365, -1000, 1279, 363, 361, 117, -1000,
-	3, -1000, 223, 281, -1000, -1000, 1279, 110, -1000, -1000,
-	-1000, -1000, 1279, 22, -1000, -1000, -30, 19, 296, 30,
-	30, 41, 41, -1000, -1000, -1000, -1000, 1352, -1000, 1046,
-	1004, 358, -1000, 194, 1316, 192, 179, 177, -1000, 1279,
-	-1000, 1279, -1000, -1000, -1000, -1000, -1000, -1000, 263, 140,
-	-1000, 256, 610, -1000, -1000, 22, 139, 1279, 187, -1000,
-	77, 322, 322, -1000, 10, 136, 683, 186, -1000, 76,
-	112, -1000, 32, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 351, 73, -1000, 275, 1279, -1000, -1000,
-	337, 337, 75, -1000, -1000, -1000, 184, 173, 74, -1000,
-	135, 961, -1000, -1000, 234, -1000, -1000, -1000, 134, 248,
-	260, -1000, 129, 683, 123, 118, 116, 1279, 548, -1000,
-	683, -1000, -1000, 95, -1000, -1000, -1000, -1000, 1279, 1279,
-	-1000, -1000, 1279, -1000, 1279, 1279, -1000, 1279, 73, -1000,
-	351, 340, -1000, -1000, -1000, 356, -1000, -1000, 1004, -1000,
-	961, -1000, 114, 1279, 265, 1279, -1000, 1279, -1000, 683,
-	263, 683, 683, 683, 273, -1000, -1000, -1000, -1000, 322,
-	322, 72, -1000, -1000, -1000, -1000, -1000, -1000, 182, -1000,
-	-1000, 71, -1000, 337, -1000, -1000, 114, -1000, -1000, 227,
-	-1000, 108, -1000, -1000, -1000, 250, -1000, 338, -1000, -1000,
-	352, 68, -1000, 335, -1000, -1000, -1000, -1000, -1000, 1242,
-	683, 105, -1000, 66, -1000, 322, 924, 337, 244, 224,
-	-1000, 121, -1000, 683, 331, -1000, -1000, 1279, -1000, -1000,
-	1242, 104, -1000, 322, -1000, -1000, 1242, -1000, -1000,
+
+var yyPact = [...]int16{
+	-22, -32768, 826, -32768, 1530, -32768, -32768, 435, 33, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, 1530,
+	1530, 1614, 203, 1530, 428, 426, 59, -32768, 258, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, 126, 1614,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, 425, 425,
+	1530, 418, 129, -32768, -32768, 1530, 1530, -32768, 418, 79,
+	-32768, 1452, -32768, -32768, 257, -32768, 475, 322, 185, -32768,
+	1575, 1115, 27, -27, 20, 340, 36, 44, -32768, 475,
+	475, 475, -32768, 375, -32768, 292, 1075, 1232, 1060, -32768,
+	-32768, 382, -32768, -32768, -32768, -32768, -32768, -32768, 535, -32768,
+	-32768, 153, -32768, -32768, 969, 434, 201, 327, 200, 264,
+	151, -32768, 27, -32768, 903, 114, -32768, 320, 233, 232,
+	-32768, -32768, -32768, -32768, -32768, 310, -32768, -32768, -32768, 1440,
+	12, 1530, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, 1166, -32768, 150, -32768, 150,
+	147, -1, -32768, 1395, -32768, -32768, 284, 146, -32768, 40,
+	268, -11, 79, -32768, -32768, -32768, 1530, -32768, 1575, 1575,
+	27, 1575, 1530, 197, -32768, 137, 403, 403, -32768, 6,
+	-32768, -32768, 475, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, 263, 247, 475, 475, 475, 475, 475, 475, 475,
+	475, 475, 475, 475, 475, -32768, -32768, -32768, 475, 4,
+	-32768, -32768, 228, 262, 131, -32768, -32768, -32768, 262, 131,
+	-32768, -21, 130, 134, 129, 475, -32768, -32768, -32768, -32768,
+	-32768, -32768, 432, 424, 1530, -32768, -32768, -32768, 903, 1530,
+	903, 1530, 1614, -32768, -32768, -32768, 400, 1530, 903, 475,
+	354, 189, 193, 1151, -32768, -32768, -32768, 1166, 3, -32768,
+	-32768, -32768, 421, 1530, 431, 420, -32768, 1530, 418, 417,
+	106, -32768, -11, -32768, 256, 322, -32768, -32768, 1530, 99,
+	-32768, -32768, -32768, -32768, 1530, 27, -32768, -32768, -27, 20,
+	340, 36, 36, 44, 44, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, 984, 1307, 415, 4, -32768, 225, 1614, 1395,
+	220, 207, 206, -32768, 1351, -32768, 1530, -32768, -32768, 27,
+	385, -32768, -32768, -32768, -32768, -32768, 294, 192, -32768, 281,
+	760, -32768, -32768, 27, 175, 1530, 217, -32768, -32768, 117,
+	399, 399, -32768, -3, 174, 903, 215, -32768, 116, -32768,
+	111, 1530, 1530, -32768, 1166, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, 412, 86, -32768, 319, 1530, -32768,
+	-32768, 109, 403, 403, 97, -32768, -32768, 214, 190, 91,
+	-32768, 170, 1247, -32768, -32768, 260, -32768, -32768, -32768, -32768,
+	162, 475, 262, 760, 306, -32768, 161, 903, 160, 158,
+	157, 1530, 685, -32768, 903, -32768, -32768, 94, -32768, -32768,
+	-32768, -32768, 1530, 1530, -32768, -32768, 1151, -32768, -32768, 1530,
+	1530, -32768, -32768, -32768, 86, -32768, 412, 407, -32768, -32768,
+	194, -32768, -32768, 398, -32768, -32768, 1307, -32768, 1247, -32768,
+	142, 1530, 1575, 1530, 27, -32768, 610, 1530, -32768, 903,
+	294, 903, 903, 903, 318, -32768, -32768, -32768, -32768, 80,
+	399, 399, 78, -32768, -32768, -32768, -32768, -32768, 211, -32768,
+	-32768, -32768, 74, 64, -32768, 403, -32768, -32768, 142, -32768,
+	-32768, 261, -32768, -32768, 140, -32768, -32768, -32768, 288, -32768,
+	405, -32768, 189, -32768, -32768, 392, -32768, 182, 372, -32768,
+	-32768, -32768, -32768, -32768, 1518, 903, 127, -32768, -32768, 57,
+	50, -32768, 399, 403, 270, 244, -32768, 141, -32768, 903,
+	139, 368, -32768, -32768, -32768, 1518, 107, -32768, 399, -32768,
+	1518, -32768, -32768,
 }
-var yyPgo = [...]int{
-
-	0, 511, 510, 509, 508, 507, 18, 28, 505, 504,
-	503, 25, 14, 390, 61, 502, 501, 500, 498, 497,
-	494, 493, 485, 484, 481, 480, 475, 473, 472, 471,
-	470, 468, 466, 465, 464, 335, 333, 463, 460, 459,
-	39, 29, 34, 56, 41, 42, 47, 40, 51, 457,
-	456, 455, 49, 0, 43, 454, 1, 453, 2, 44,
-	452, 38, 35, 451, 33, 36, 450, 10, 449, 448,
-	330, 27, 446, 445, 8, 444, 68, 83, 443, 442,
-	441, 436, 434, 16, 32, 13, 433, 432, 12, 430,
-	429, 428, 30, 52, 427, 46, 426, 45, 422, 307,
-	31, 24, 421, 26, 420, 419, 418, 37, 417, 7,
-	6, 22, 17, 3, 11, 15, 416, 9, 414, 4,
-	410, 402, 400, 398, 394,
+
+var yyPgo = [...]int16{
+	0, 594, 593, 587, 586, 585, 22, 12, 584, 575,
+	26, 3, 15, 446, 66, 574, 570, 569, 568, 566,
+	565, 563, 562, 561, 560, 559, 558, 557, 556, 552,
+	551, 550, 368, 549, 366, 68, 376, 547, 543, 372,
+	542, 538, 23, 29, 53, 27, 41, 60, 56, 45,
+	42, 47, 54, 534, 532, 529, 90, 57, 31, 59,
+	527, 2, 525, 0, 48, 521, 40, 32, 519, 35,
+	33, 517, 19, 516, 508, 369, 38, 502, 9, 500,
+	79, 84, 499, 497, 50, 496, 494, 493, 5, 24,
+	17, 492, 491, 20, 490, 34, 62, 489, 61, 488,
+	71, 487, 352, 39, 37, 486, 28, 483, 480, 478,
+	46, 477, 8, 6, 21, 14, 1, 13, 18, 30,
+	7, 11, 4, 16, 476, 472, 467, 10, 441, 440,
 }
-var yyR1 = [...]int{
 
+var yyR1 = [...]uint8{
 	0, 2, 2, 2, 4, 4, 3, 8, 8, 8,
-	5, 123, 123, 94, 94, 93, 93, 70, 81, 81,
-	37, 37, 38, 69, 69, 35, 120, 121, 121, 112,
-	112, 117, 117, 118, 118, 114, 114, 122, 122, 122,
-	122, 122, 122, 122, 113, 113, 109, 109, 115, 115,
-	116, 116, 111, 111, 119, 119, 119, 119, 119, 119,
-	119, 110, 7, 7, 124, 124, 9, 9, 6, 14,
+	5, 128, 128, 97, 97, 96, 96, 75, 86, 86,
+	37, 37, 37, 38, 74, 74, 39, 35, 124, 125,
+	125, 115, 115, 120, 120, 121, 121, 117, 117, 126,
+	126, 126, 127, 127, 127, 127, 127, 127, 127, 116,
+	116, 112, 112, 118, 118, 119, 119, 114, 114, 122,
+	122, 122, 123, 123, 123, 123, 123, 123, 123, 113,
+	7, 7, 7, 7, 129, 129, 9, 9, 6, 14,
 	14, 14, 14, 14, 14, 14, 14, 15, 15, 15,
-	63, 63, 65, 65, 80, 80, 76, 76, 52, 52,
-	83, 83, 62, 39, 39, 39, 39, 39, 39, 39,
-	39, 39, 39, 39, 39, 16, 17, 18, 18, 18,
-	18, 18, 23, 24, 25, 25, 27, 26, 26, 26,
-	19, 19, 28, 95, 95, 96, 96, 98, 98, 98,
-	104, 104, 104, 29, 101, 101, 100, 100, 103, 103,
-	102, 102, 97, 97, 99, 99, 20, 21, 77, 77,
-	22, 22, 13, 13, 13, 13, 13, 13, 13, 13,
-	105, 105, 12, 12, 31, 30, 32, 106, 106, 33,
-	33, 33, 33, 108, 108, 34, 107, 107, 68, 68,
-	68, 10, 10, 11, 11, 53, 53, 53, 56, 56,
-	55, 55, 57, 57, 58, 58, 59, 59, 54, 54,
-	60, 60, 82, 82, 82, 82, 82, 82, 82, 82,
-	82, 82, 82, 42, 41, 41, 43, 43, 44, 44,
-	45, 45, 45, 46, 46, 46, 47, 47, 47, 47,
-	47, 48, 48, 48, 48, 49, 49, 79, 79, 1,
-	1, 51, 51, 51, 51, 51, 51, 51, 51, 51,
-	51, 51, 51, 51, 51, 51, 51, 50, 50, 50,
-	50, 87, 87, 86, 85, 85, 85, 85, 85, 85,
-	85, 85, 85, 67, 67, 40, 40, 75, 75, 71,
-	61, 72, 78, 78, 66, 66, 66, 66, 36, 89,
-	89, 90, 90, 91, 91, 92, 92, 92, 92, 88,
-	88, 88, 74, 74, 84, 84, 73, 73, 64, 64,
-	64,
+	15, 15, 68, 68, 70, 70, 85, 85, 80, 80,
+	57, 57, 88, 88, 67, 41, 41, 41, 41, 41,
+	41, 41, 41, 41, 41, 41, 41, 41, 16, 17,
+	18, 18, 18, 18, 18, 23, 24, 25, 25, 27,
+	26, 26, 26, 19, 19, 28, 98, 98, 99, 99,
+	101, 101, 101, 107, 107, 107, 29, 104, 104, 103,
+	103, 106, 106, 105, 105, 100, 100, 102, 102, 20,
+	21, 82, 82, 22, 22, 13, 13, 13, 13, 13,
+	13, 13, 13, 13, 40, 40, 40, 108, 108, 12,
+	12, 31, 30, 32, 109, 109, 33, 33, 33, 33,
+	111, 111, 34, 110, 110, 73, 73, 73, 10, 10,
+	11, 11, 42, 42, 43, 43, 81, 81, 58, 58,
+	58, 61, 61, 60, 60, 62, 62, 63, 63, 64,
+	64, 59, 59, 65, 65, 87, 87, 87, 87, 87,
+	87, 87, 87, 87, 87, 87, 46, 45, 45, 47,
+	47, 48, 48, 49, 49, 49, 50, 50, 50, 51,
+	51, 51, 51, 51, 51, 52, 52, 52, 52, 53,
+	53, 54, 54, 84, 84, 1, 1, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 55, 55, 55, 55, 92, 92, 91,
+	90, 90, 90, 90, 90, 90, 90, 90, 90, 72,
+	72, 44, 44, 79, 79, 76, 66, 83, 83, 83,
+	83, 71, 71, 71, 71, 36, 94, 94, 95, 93,
+	93, 93, 93, 93, 93, 78, 78, 89, 89, 77,
+	77, 69, 69, 69,
 }
-var yyR2 = [...]int{
 
+var yyR2 = [...]int8{
 	0, 2, 2, 2, 1, 2, 2, 0, 2, 2,
 	3, 0, 2, 0, 1, 0, 3, 4, 1, 2,
-	1, 1, 2, 0, 2, 6, 3, 0, 1, 1,
-	3, 0, 3, 1, 3, 0, 1, 2, 5, 8,
-	4, 3, 6, 2, 1, 3, 1, 3, 0, 3,
-	1, 3, 0, 1, 2, 5, 8, 4, 3, 6,
-	2, 1, 1, 1, 0, 1, 1, 3, 3, 1,
-	1, 1, 1, 1, 1, 1, 1, 3, 2, 1,
-	1, 1, 1, 1, 2, 3, 1, 3, 1, 1,
-	0, 1, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 2, 1, 1, 2, 4,
-	1, 1, 2, 1, 1, 1, 2, 1, 2, 1,
-	1, 4, 2, 4, 1, 3, 1, 3, 1, 3,
-	1, 3, 1, 3, 1, 3, 2, 2, 1, 3,
-	2, 4, 1, 1, 1, 1, 1, 1, 1, 1,
-	0, 5, 0, 3, 6, 5, 7, 0, 4, 4,
-	7, 7, 10, 1, 3, 4, 1, 3, 1, 2,
-	4, 1, 2, 1, 4, 1, 5, 1, 1, 1,
-	3, 4, 3, 4, 1, 3, 1, 3, 2, 1,
-	1, 3, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 1, 2, 2, 1, 3, 1, 3, 1, 3,
-	1, 3, 3, 1, 3, 3, 1, 3, 3, 3,
-	3, 2, 2, 2, 1, 2, 4, 0, 2, 1,
-	2, 2, 3, 4, 4, 2, 4, 4, 2, 3,
-	1, 1, 1, 1, 1, 1, 1, 2, 3, 3,
-	2, 1, 3, 2, 1, 1, 2, 2, 3, 2,
-	3, 3, 4, 1, 2, 1, 1, 1, 3, 2,
-	2, 2, 3, 5, 2, 4, 1, 2, 5, 1,
-	3, 0, 2, 0, 3, 2, 4, 7, 3, 1,
-	2, 3, 1, 1, 4, 5, 2, 3, 1, 3,
-	2,
+	1, 1, 1, 2, 0, 2, 2, 6, 3, 0,
+	1, 1, 3, 0, 3, 1, 3, 0, 1, 1,
+	4, 5, 2, 5, 8, 4, 3, 6, 2, 1,
+	3, 1, 3, 0, 3, 1, 3, 0, 1, 1,
+	4, 5, 2, 5, 8, 4, 3, 6, 2, 1,
+	1, 1, 2, 5, 0, 1, 1, 3, 3, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 2, 3,
+	5, 1, 1, 1, 1, 1, 2, 3, 1, 3,
+	1, 1, 0, 1, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 2, 1,
+	1, 2, 4, 1, 1, 2, 1, 1, 1, 2,
+	1, 2, 1, 1, 4, 2, 4, 1, 3, 1,
+	3, 1, 3, 1, 3, 1, 3, 1, 3, 2,
+	2, 1, 3, 2, 4, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 2, 2, 0, 5, 0,
+	3, 6, 5, 7, 0, 4, 4, 7, 7, 10,
+	1, 3, 4, 1, 3, 1, 2, 4, 1, 2,
+	1, 4, 1, 3, 1, 1, 1, 3, 1, 5,
+	1, 1, 1, 3, 4, 3, 4, 1, 3, 1,
+	3, 2, 1, 1, 3, 1, 1, 1, 1, 1,
+	1, 1, 1, 2, 1, 2, 2, 1, 3, 1,
+	3, 1, 3, 1, 3, 3, 1, 3, 3, 1,
+	3, 3, 3, 3, 3, 2, 2, 2, 1, 1,
+	3, 2, 3, 0, 2, 1, 2, 2, 3, 4,
+	4, 2, 4, 4, 2, 3, 1, 1, 1, 1,
+	1, 1, 1, 2, 3, 3, 2, 1, 3, 2,
+	1, 1, 2, 2, 3, 2, 3, 3, 4, 1,
+	2, 1, 1, 1, 3, 2, 2, 3, 2, 5,
+	4, 2, 4, 2, 2, 5, 1, 3, 2, 1,
+	2, 2, 2, 3, 3, 1, 1, 4, 5, 2,
+	3, 1, 3, 2,
 }
-var yyChk = [...]int{
-
-	-1000, -2, 90, 91, 92, -4, -6, -13, -9, -31,
-	-30, -32, -33, -34, -35, -36, -38, -14, 52, 64,
-	49, 63, 65, 43, 41, -81, -15, -16, -17, -18,
-	-19, -20, -21, -22, -70, -62, 44, 60, -23, -24,
-	-25, -26, -27, -28, -29, 51, 57, 39, 89, -76,
-	40, 42, 62, 61, -64, 53, 50, -52, 66, -53,
-	-42, -58, -55, 76, -59, 56, -54, 58, -60, -41,
-	-43, -44, -45, -46, -47, -48, 74, 75, 88, -49,
-	-51, 67, 69, 85, 6, 10, -1, 20, 35, 36,
-	34, 9, -3, -8, -5, -61, -77, -53, 4, 73,
-	-124, -53, -53, -71, -75, -40, -41, -42, 71, -108,
-	-107, -53, 6, 6, -70, -37, -36, -35, -39, -80,
-	17, 18, 16, 23, 12, 13, 33, 32, 25, 31,
-	15, 22, 82, -71, -99, 6, -99, -53, -97, 6,
-	72, -83, -61, -53, -102, -100, -97, -98, -97, -96,
-	-95, 83, 20, 50, -61, 52, 59, -41, 37, 71,
-	-119, -116, 76, 14, -109, -110, 6, -54, -82, 80,
-	81, 28, 29, 26, 27, 11, 54, 58, 55, 78,
-	87, 79, 24, 30, 74, 75, 76, 77, 84, 21,
-	-48, -48, -48, -79, 68, -64, -52, -76, 70, -52,
-	-76, 86, -66, -78, -53, -72, -77, 9, 5, 4,
-	-7, -6, -13, -123, 72, -83, -14, 4, 71, 71,
-	54, 72, -83, -11, -6, 4, 72, 71, 38, -120,
-	67, -93, 67, -63, -64, -61, 82, -65, -64, -62,
-	72, 72, -93, 83, -52, 50, 72, 38, 53, -95,
-	-97, -53, -58, -59, -54, -53, 71, 72, -83, -111,
-	-110, -110, 82, -41, 54, 58, -43, -44, -45, -46,
-	-46, -47, -47, -48, -48, -48, -48, 14, -50, 67,
-	69, 83, 68, -84, 49, -83, -84, -83, 86, 72,
-	-83, 71, -84, -83, 5, 4, -53, -11, -11, -61,
-	-40, -106, 7, -107, -11, -41, -69, 19, -121, -122,
-	-118, 76, 14, -112, -113, 6, 71, -94, -92, -89,
-	-90, -88, -53, -65, 6, -53, 4, 6, -53, -100,
-	6, -104, 76, 67, -103, -101, 6, 46, -53, -109,
-	76, 14, -115, -53, -48, 68, -92, -86, -87, -85,
-	-53, 71, 6, 68, -71, 68, 70, 70, -53, -53,
-	-105, -12, 46, 71, -68, 46, 48, 47, -10, -7,
-	71, -53, 68, 72, -83, -114, -113, -113, 82, 71,
-	-11, 68, 72, -83, 76, 14, -84, 82, -103, -83,
-	72, 38, -53, -111, -110, 72, 68, 70, 72, -83,
-	71, -67, -53, 71, 54, 71, -84, 45, -12, 71,
-	-11, 71, 71, 71, -53, -7, 8, -11, -112, 76,
-	14, -117, -53, -53, -88, -53, -53, -53, -83, -101,
-	6, -115, -109, 14, -85, -67, -53, -67, -53, -58,
-	-53, -53, -11, -12, -11, -11, -11, 38, -114, -113,
-	72, -91, 68, 72, -110, -67, -74, -84, -73, 52,
-	71, 48, 6, -117, -112, 14, 72, 14, -56, -58,
-	-57, 56, -11, 71, 72, -113, -88, 14, -110, -74,
-	71, -119, -11, 14, -53, -56, 71, -113, -56,
+
+var yyChk = [...]int16{
+	-32768, -2, 94, 95, 96, -4, -6, -13, -9, -31,
+	-30, -32, -33, -34, -35, -36, -38, -40, -14, 56,
+	68, 53, 67, 69, 47, 45, -86, -39, 42, -15,
+	-16, -17, -18, -19, -20, -21, -22, -75, -67, 48,
+	64, -23, -24, -25, -26, -27, -28, -29, 55, 61,
+	41, 93, -80, 44, 46, 66, 65, -69, 57, 54,
+	-57, 70, -58, -46, -63, -60, 80, -64, 60, -59,
+	62, -65, -45, -47, -48, -49, -50, -51, -52, 78,
+	79, 92, -53, -54, -56, 43, 71, 73, 89, 6,
+	10, -1, 20, 37, 38, 36, 9, -3, -8, -5,
+	-66, -82, -58, 4, 77, -129, -42, -58, -42, -76,
+	-79, -44, -45, -46, 75, -111, -110, -58, 6, 6,
+	-75, -37, -36, -35, -39, 42, -35, -34, -32, -41,
+	-85, 75, 17, 18, 16, 23, 12, 13, 33, 32,
+	25, 31, 15, 22, 34, 86, -76, -102, 6, -102,
+	-58, -100, 6, 76, -88, -66, -58, -105, -103, -100,
+	-101, -100, -99, -98, 87, 20, 54, -66, 56, 63,
+	-45, 39, 75, -122, -123, -119, 80, 14, -112, -113,
+	6, -59, -87, 84, 85, 28, 29, 26, 27, 11,
+	58, 62, 59, 82, 91, 83, 24, 30, 78, 79,
+	80, 93, 81, 88, 21, -52, -52, -52, 14, -84,
+	-56, 72, -69, -43, -81, -42, -46, 74, -43, -81,
+	90, -71, -83, -58, -80, 14, 9, 5, 4, -7,
+	-6, -13, 2, -128, 76, -88, -14, 4, 75, 35,
+	75, 58, 76, -88, -11, -6, 4, 76, 75, 40,
+	-124, 71, -96, 71, -68, -69, -66, 86, -58, -70,
+	-69, -67, 76, 76, -96, 87, -57, 54, 76, 40,
+	57, -98, -100, -58, -63, -64, -59, -58, 75, 76,
+	-88, -114, -113, -113, 86, -45, 58, 62, -47, -48,
+	-49, -50, -50, -51, -51, -52, -52, -52, -52, -52,
+	-52, -55, 71, 73, 87, -84, 72, -89, 53, 76,
+	-88, -89, -88, 90, 76, -88, 75, -89, -88, -45,
+	4, 5, 4, -58, -11, -58, -11, -66, -44, -109,
+	7, -110, -11, -45, -74, 19, -125, -126, -127, -121,
+	80, 14, -115, -116, 6, 75, -97, -95, -94, -93,
+	-58, 80, 14, -70, 86, 6, -58, 4, 6, -58,
+	-103, 6, -107, 80, 71, -106, -104, 6, 50, -58,
+	-112, 81, 80, 14, -118, -58, 72, -95, -91, -92,
+	-90, -58, 75, 6, 72, -76, -43, 72, 74, 74,
+	-58, 14, -58, 7, -108, -12, 50, 75, -73, 50,
+	52, 51, -10, -7, 75, -58, 72, 76, -88, -117,
+	-116, -116, 86, 75, -11, 72, 76, -88, -89, 35,
+	86, -58, -58, -70, -106, -88, 76, 40, -58, -88,
+	76, -114, -113, 76, 72, 74, 76, -88, 75, -72,
+	-58, 75, 58, 75, -45, -89, -10, 49, -12, 75,
+	-11, 75, 75, 75, -58, -7, 8, -11, -115, 81,
+	80, 14, -120, -58, -58, -93, -58, -58, -88, -104,
+	6, -123, -119, -118, -112, 14, -90, -72, -58, -72,
+	-58, -63, -58, 8, -42, -11, -12, -11, -11, -11,
+	40, -88, 76, -117, -116, 76, 72, 76, 76, -113,
+	-72, -78, -89, -77, 56, 75, 52, 6, -127, -121,
+	-120, -115, 14, 14, -61, -63, -62, 60, -11, 75,
+	76, 76, -116, -113, -78, 75, -122, -11, 14, -61,
+	75, -116, -61,
 }
-var yyDef = [...]int{
-
-	0, -2, 0, 7, 0, 1, 4, 0, 64, 152,
-	153, 154, 155, 156, 157, 158, 159, 66, 0, 0,
-	0, 0, 0, 0, 0, 0, 69, 70, 71, 72,
-	73, 74, 75, 76, 18, 79, 0, 106, 107, 108,
-	109, 110, 111, 120, 121, 0, 0, 0, 0, 90,
-	112, 113, 114, 117, 116, 0, 0, 86, 308, 88,
-	89, 185, 187, 0, 194, 0, 196, 0, 199, 200,
-	214, 216, 218, 220, 223, 226, 0, 0, 0, 234,
-	237, 0, 0, 0, 250, 251, 252, 253, 254, 255,
-	256, 239, 2, 0, 3, 11, 90, 148, 5, 65,
-	0, 0, 0, 0, 90, 277, 275, 276, 0, 0,
-	173, 176, 0, 15, 19, 22, 20, 21, 0, 78,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 0, 105, 146, 144, 147, 150, 15, 142,
-	91, 92, 115, 118, 122, 140, 136, 0, 127, 129,
-	125, 123, 124, 0, 310, 0, 0, 213, 0, 0,
-	0, 90, 52, 0, 50, 46, 61, 198, 0, 202,
-	203, 204, 205, 206, 207, 208, 209, 0, 211, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	231, 232, 233, 235, 241, 0, 86, 90, 245, 86,
-	90, 248, 0, 90, 148, 286, 90, 240, 6, 8,
-	9, 62, 63, 0, 91, 280, 67, 68, 0, 0,
-	0, 91, 279, 167, 183, 0, 0, 0, 0, 23,
-	27, 0, -2, 77, 80, 81, 0, 84, 82, 83,
-	0, 0, 0, 0, 87, 0, 0, 0, 0, 126,
-	128, 309, 0, 195, 197, 190, 0, 91, 54, 48,
-	53, 60, 0, 201, 210, 212, 215, 217, 219, 221,
-	222, 224, 225, 227, 228, 229, 230, 0, 238, 291,
-	0, 0, 242, 0, 0, 0, 0, 0, 249, 91,
-	284, 0, 287, 281, 10, 12, 149, 160, 162, 0,
-	278, 169, 0, 174, 175, 177, 0, 0, 0, 28,
-	90, 35, 0, 33, 29, 44, 0, 0, 14, 90,
-	0, 289, 299, 85, 145, 151, 17, 143, 119, 141,
-	137, 133, 130, 0, 90, 138, 134, 0, 191, 51,
-	52, 0, 58, 47, 236, 257, 0, 0, 90, 261,
-	264, 265, 260, 243, 0, 244, 246, 247, 0, 282,
-	162, 165, 0, 0, 0, 0, 0, 178, 0, 181,
-	0, 24, 26, 91, 37, 31, 36, 43, 0, 0,
-	288, 16, -2, 295, 0, 0, 300, 0, 90, 132,
-	91, 0, 186, 48, 57, 0, 258, 259, 91, 263,
-	269, 266, 267, 273, 0, 0, 285, 0, 164, 0,
-	162, 0, 0, 0, 179, 182, 184, 25, 34, 35,
-	0, 41, 30, 45, 290, 293, 298, 301, 0, 139,
-	135, 55, 49, 0, 262, 270, 271, 268, 274, 304,
-	283, 0, 163, 166, 168, 170, 171, 0, 31, 40,
-	0, 296, 131, 0, 59, 272, 305, 302, 303, 0,
-	0, 0, 180, 38, 32, 0, 0, 0, 306, 188,
-	189, 0, 161, 0, 0, 42, 294, 0, 56, 307,
-	0, 0, 172, 0, 297, 192, 0, 39, 193,
+
+var yyDef = [...]int16{
+	0, -2, 0, 7, 0, 1, 4, 0, 74, 165,
+	166, 167, 168, 169, 170, 171, 172, 173, 76, 0,
+	0, 0, 0, 0, 0, 0, 0, 174, 0, 79,
+	80, 81, 82, 83, 84, 85, 86, 18, 91, 0,
+	119, 120, 121, 122, 123, 124, 133, 134, 0, 0,
+	0, 0, 102, 125, 126, 127, 130, 129, 0, 0,
+	98, 331, 100, 101, 208, 210, 0, 217, 0, 219,
+	0, 222, 223, 237, 239, 241, 243, 246, 249, 0,
+	0, 0, 258, 259, 263, 0, 0, 0, 0, 276,
+	277, 278, 279, 280, 281, 282, 265, 2, 0, 3,
+	11, 102, 161, 5, 75, 0, 0, 202, 0, 0,
+	102, 303, 301, 302, 0, 0, 190, 193, 0, 15,
+	19, 23, 20, 21, 22, 0, 26, 175, 176, 0,
+	88, 0, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 0, 118, 159, 157, 160,
+	163, 15, 155, 103, 104, 128, 131, 135, 153, 149,
+	0, 140, 142, 138, 136, 137, 0, 333, 0, 0,
+	236, 0, 0, 0, 59, 102, 57, 0, 55, 51,
+	69, 221, 0, 225, 226, 227, 228, 229, 230, 231,
+	232, 0, 234, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 255, 256, 257, 0, 261,
+	263, 267, 0, 206, 102, 204, 205, 271, 206, 102,
+	274, 0, 102, 100, 102, 0, 266, 6, 8, 9,
+	70, 71, 0, 0, 103, 306, 77, 78, 0, 0,
+	0, 0, 103, 305, 184, 200, 0, 0, 0, 0,
+	24, 29, 0, 13, 87, 92, 93, 0, 89, 96,
+	94, 95, 0, 0, 0, 0, 99, 0, 0, 0,
+	0, 139, 141, 332, 0, 218, 220, 213, 0, 103,
+	62, 53, 58, 68, 0, 224, 233, 235, 238, 240,
+	242, 244, 245, 247, 248, 250, 251, 252, 253, 254,
+	260, 264, 0, 0, 0, 262, 268, 0, 0, 103,
+	0, 0, 0, 275, 103, 311, 0, 314, 313, 308,
+	72, 10, 12, 162, 177, 203, 179, 0, 304, 186,
+	0, 191, 192, 194, 0, 0, 0, 30, 39, 102,
+	37, 0, 35, 31, 49, 0, 0, 14, 102, 316,
+	319, 0, 0, 97, 0, 158, 164, 17, 156, 132,
+	154, 150, 146, 143, 0, 102, 151, 147, 0, 214,
+	56, 102, 57, 0, 66, 52, 283, 0, 0, 102,
+	287, 290, 291, 286, 269, 0, 207, 270, 272, 273,
+	0, 0, 307, 0, 179, 182, 0, 0, 0, 0,
+	0, 195, 0, 198, 0, 25, 28, 103, 42, 33,
+	38, 48, 0, 0, 315, 16, 103, 318, 320, 0,
+	0, 321, 322, 90, 102, 145, 103, 0, 209, 60,
+	103, 53, 65, 0, 284, 285, 103, 289, 295, 292,
+	293, 299, 0, 0, 310, 312, 0, 0, 181, 0,
+	179, 0, 0, 0, 196, 199, 201, 27, 36, 102,
+	37, 0, 46, 32, 50, 317, 323, 324, 0, 152,
+	148, 61, 102, 63, 54, 0, 288, 296, 297, 294,
+	300, 327, 309, 73, 0, 180, 183, 185, 187, 188,
+	0, 40, 103, 33, 45, 0, 144, 103, 0, 67,
+	298, 328, 325, 326, 0, 0, 0, 197, 41, 102,
+	43, 34, 0, 0, 329, 211, 212, 0, 178, 0,
+	103, 0, 47, 64, 330, 0, 0, 189, 0, 215,
+	0, 44, 216,
 }
-var yyTok1 = [...]int{
 
+var yyTok1 = [...]int8{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 84, 79, 3,
-	67, 68, 76, 74, 72, 75, 83, 77, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 71, 73,
-	80, 82, 81, 3, 89, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 88, 83, 3,
+	71, 72, 80, 78, 76, 79, 87, 81, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 75, 77,
+	84, 86, 85, 3, 93, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 69, 3, 70, 87, 3, 3, 3, 3, 3,
+	3, 73, 3, 74, 91, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 85, 78, 86, 88,
+	3, 3, 3, 89, 82, 90, 92,
 }
-var yyTok2 = [...]int{
 
+var yyTok2 = [...]int8{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
 	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
-	62, 63, 64, 65, 66, 90, 91, 92,
+	62, 63, 64, 65, 66, 67, 68, 69, 70, 94,
+	95, 96, 97, 98, 99,
 }
-var yyTok3 = [...]int{
+
+var yyTok3 = [...]int8{
 	0,
 }
 
@@ -777,7 +920,7 @@ func yyNewParser() yyParser {
 	return &yyParserImpl{}
 }
 
-const yyFlag = -1000
+const yyFlag = -32768
 
 func yyTokname(c int) string {
 	if c >= 1 && c-1 < len(yyToknames) {
@@ -816,9 +959,9 @@ func yyErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := yyPact[state]
+	base := int(yyPact[state])
 	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
-		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -828,13 +971,13 @@ func yyErrorMessage(state, lookAhead int) string {
 
 	if yyDef[state] == -2 {
 		i := 0
-		for yyExca[i] != -1 || yyExca[i+1] != state {
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; yyExca[i] >= 0; i += 2 {
-			tok := yyExca[i]
+			tok := int(yyExca[i])
 			if tok < TOKSTART || yyExca[i+1] == 0 {
 				continue
 			}
@@ -865,30 +1008,30 @@ func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = yyTok1[0]
+		token = int(yyTok1[0])
 		goto out
 	}
 	if char < len(yyTok1) {
-		token = yyTok1[char]
+		token = int(yyTok1[char])
 		goto out
 	}
 	if char >= yyPrivate {
 		if char < yyPrivate+len(yyTok2) {
-			token = yyTok2[char-yyPrivate]
+			token = int(yyTok2[char-yyPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(yyTok3); i += 2 {
-		token = yyTok3[i+0]
+		token = int(yyTok3[i+0])
 		if token == char {
-			token = yyTok3[i+1]
+			token = int(yyTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = yyTok2[1] /* unknown char */
+		token = int(yyTok2[1]) /* unknown char */
 	}
 	if yyDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
@@ -943,7 +1086,7 @@ yystack:
 	yyS[yyp].yys = yystate
 
 yynewstate:
-	yyn = yyPact[yystate]
+	yyn = int(yyPact[yystate])
 	if yyn <= yyFlag {
 		goto yydefault /* simple state */
 	}
@@ -954,8 +1097,8 @@ yynewstate:
 	if yyn < 0 || yyn >= yyLast {
 		goto yydefault
 	}
-	yyn = yyAct[yyn]
-	if yyChk[yyn] == yytoken { /* valid shift */
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
 		yyrcvr.char = -1
 		yytoken = -1
 		yyVAL = yyrcvr.lval
@@ -968,7 +1111,7 @@ yynewstate:
 
 yydefault:
 	/* default state action */
-	yyn = yyDef[yystate]
+	yyn = int(yyDef[yystate])
 	if yyn == -2 {
 		if yyrcvr.char < 0 {
 			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
@@ -977,18 +1120,18 @@ yydefault:
 		/* look through exception table */
 		xi := 0
 		for {
-			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			yyn = yyExca[xi+0]
+			yyn = int(yyExca[xi+0])
 			if yyn < 0 || yyn == yytoken {
 				break
 			}
 		}
-		yyn = yyExca[xi+1]
+		yyn = int(yyExca[xi+1])
 		if yyn < 0 {
 			goto ret0
 		}
@@ -1010,10 +1153,10 @@ yydefault:
 
 			/* find a state where "error" is a legal shift action */
 			for yyp >= 0 {
-				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
 				if yyn >= 0 && yyn < yyLast {
-					yystate = yyAct[yyn] /* simulate a shift of "error" */
-					if yyChk[yystate] == yyErrCode {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
 						goto yystack
 					}
 				}
@@ -1049,7 +1192,7 @@ yydefault:
 	yypt := yyp
 	_ = yypt // guard against "declared and not used"
 
-	yyp -= yyR2[yyn]
+	yyp -= int(yyR2[yyn])
 	// yyp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if yyp+1 >= len(yyS) {
@@ -1060,16 +1203,16 @@ yydefault:
 	yyVAL = yyS[yyp+1]
 
 	/* consult goto table to find next state */
-	yyn = yyR1[yyn]
-	yyg := yyPgo[yyn]
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
 	yyj := yyg + yyS[yyp].yys + 1
 
 	if yyj >= yyLast {
-		yystate = yyAct[yyg]
+		yystate = int(yyAct[yyg])
 	} else {
-		yystate = yyAct[yyj]
-		if yyChk[yystate] != -yyn {
-			yystate = yyAct[yyg]
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -1077,96 +1220,100 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:250
+//line grammar.y:346
 		{
 			yylex.(*yyLex).mod = yyDollar[2].mod
 			return 0
 		}
 	case 2:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:255
+//line grammar.y:351
 		{
 			yylex.(*yyLex).mod = yyDollar[2].mod
 			return 0
 		}
 	case 3:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:260
+//line grammar.y:356
 		{
 			yylex.(*yyLex).mod = yyDollar[2].mod
 			return 0
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:274
+//line grammar.y:370
 		{
 			yyVAL.mod = &ast.Interactive{ModBase: ast.ModBase{Pos: yyVAL.pos}, Body: yyDollar[1].stmts}
 		}
 	case 5:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:278
+//line grammar.y:374
 		{
 			//  NB: compound_stmt in single_input is followed by extra NEWLINE!
 			yyVAL.mod = &ast.Interactive{ModBase: ast.ModBase{Pos: yyVAL.pos}, Body: []ast.Stmt{yyDollar[1].stmt}}
 		}
 	case 6:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:286
+//line grammar.y:382
 		{
 			yyVAL.mod = &ast.Module{ModBase: ast.ModBase{Pos: yyVAL.pos}, Body: yyDollar[1].stmts}
 		}
 	case 7:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line grammar.y:292
+//line grammar.y:388
 		{
 			yyVAL.stmts = nil
 		}
 	case 8:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:296
+//line grammar.y:392
 		{
 		}
 	case 9:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:299
+//line grammar.y:395
 		{
 			yyVAL.stmts = append(yyVAL.stmts, yyDollar[2].stmts...)
 		}
 	case 10:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:306
+//line grammar.y:402
 		{
 			yyVAL.mod = &ast.Expression{ModBase: ast.ModBase{Pos: yyVAL.pos}, Body: yyDollar[1].expr}
 		}
 	case 13:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line grammar.y:315
+//line grammar.y:411
 		{
 			yyVAL.call = &ast.Call{ExprBase: ast.ExprBase{Pos: yyVAL.pos}}
 		}
 	case 14:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:319
+//line grammar.y:415
 		{
 			yyVAL.call = yyDollar[1].call
 		}
 	case 15:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line grammar.y:324
+//line grammar.y:420
 		{
 			yyVAL.call = nil
 		}
 	case 16:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:328
+//line grammar.y:424
 		{
 			yyVAL.call = yyDollar[2].call
 		}
 	case 17:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line grammar.y:334
+//line grammar.y:430
 		{
-			fn := &ast.Name{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Id: ast.Identifier(yyDollar[2].str), Ctx: ast.Load}
+			names := strings.Split(yyDollar[2].str, ".")
+			var fn ast.Expr = &ast.Name{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Id: ast.Identifier(names[0]), Ctx: ast.Load}
+			for _, name := range names[1:] {
+				fn = &ast.Attribute{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Value: fn, Attr: ast.Identifier(name), Ctx: ast.Load}
+			}
 			if yyDollar[3].call == nil {
 				yyVAL.expr = fn
 			} else {
@@ -1177,32 +1324,38 @@ yydefault:
 		}
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:347
+//line grammar.y:447
 		{
 			yyVAL.exprs = nil
 			yyVAL.exprs = append(yyVAL.exprs, yyDollar[1].expr)
 		}
 	case 19:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:352
+//line grammar.y:452
 		{
 			yyVAL.exprs = append(yyVAL.exprs, yyDollar[2].expr)
 		}
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:358
+//line grammar.y:458
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 21:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:362
+//line grammar.y:462
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 22:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line grammar.y:466
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 23:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:368
+//line grammar.y:472
 		{
 			switch x := (yyDollar[2].stmt).(type) {
 			case *ast.ClassDef:
@@ -1211,79 +1364,89 @@ yydefault:
 			case *ast.FunctionDef:
 				x.DecoratorList = yyDollar[1].exprs
 				yyVAL.stmt = x
+			case *ast.AsyncFunctionDef:
+				x.DecoratorList = yyDollar[1].exprs
+				yyVAL.stmt = x
 			default:
 				panic("bad type for decorated")
 			}
 		}
-	case 23:
+	case 24:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line grammar.y:382
+//line grammar.y:489
 		{
 			yyVAL.expr = nil
 		}
-	case 24:
+	case 25:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:386
+//line grammar.y:493
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 25:
+	case 26:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line grammar.y:499
+		{
+			fn := yyDollar[2].stmt.(*ast.FunctionDef)
+			yyVAL.stmt = &ast.AsyncFunctionDef{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Name: fn.Name, Args: fn.Args, Body: fn.Body, Returns: fn.Returns}
+		}
+	case 27:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line grammar.y:392
+//line grammar.y:506
 		{
 			yyVAL.stmt = &ast.FunctionDef{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Name: ast.Identifier(yyDollar[2].str), Args: yyDollar[3].arguments, Body: yyDollar[6].stmts, Returns: yyDollar[4].expr}
 		}
-	case 26:
+	case 28:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:398
+//line grammar.y:512
 		{
 			yyVAL.arguments = yyDollar[2].arguments
 		}
-	case 27:
+	case 29:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line grammar.y:403
+//line grammar.y:517
 		{
 			yyVAL.arguments = &ast.Arguments{Pos: yyVAL.pos}
 		}
-	case 28:
+	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:407
+//line grammar.y:521
 		{
 			yyVAL.arguments = yyDollar[1].arguments
 		}
-	case 29:
+	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:414
+//line grammar.y:528
 		{
 			yyVAL.arg = yyDollar[1].arg
 			yyVAL.expr = nil
 		}
-	case 30:
+	case 32:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:419
+//line grammar.y:533
 		{
 			yyVAL.arg = yyDollar[1].arg
 			yyVAL.expr = yyDollar[3].expr
 		}
-	case 31:
+	case 33:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line grammar.y:425
+//line grammar.y:539
 		{
 			yyVAL.args = nil
 			yyVAL.exprs = nil
 		}
-	case 32:
+	case 34:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:430
+//line grammar.y:544
 		{
 			yyVAL.args = append(yyVAL.args, yyDollar[3].arg)
 			if yyDollar[3].expr != nil {
 				yyVAL.exprs = append(yyVAL.exprs, yyDollar[3].expr)
 			}
 		}
-	case 33:
+	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:439
+//line grammar.y:553
 		{
 			yyVAL.args = nil
 			yyVAL.args = append(yyVAL.args, yyDollar[1].arg)
@@ -1292,114 +1455,133 @@ yydefault:
 				yyVAL.exprs = append(yyVAL.exprs, yyDollar[1].expr)
 			}
 		}
-	case 34:
+	case 36:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:448
+//line grammar.y:562
 		{
 			yyVAL.args = append(yyVAL.args, yyDollar[3].arg)
 			if yyDollar[3].expr != nil {
 				yyVAL.exprs = append(yyVAL.exprs, yyDollar[3].expr)
 			}
 		}
-	case 35:
+	case 37:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line grammar.y:456
+//line grammar.y:570
 		{
 			yyVAL.arg = nil
 		}
-	case 36:
+	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:460
+//line grammar.y:574
 		{
 			yyVAL.arg = yyDollar[1].arg
 		}
-	case 37:
+	case 39:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line grammar.y:580
+		{
+			yyVAL.arguments = yyDollar[1].arguments
+		}
+	case 40:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line grammar.y:584
+		{
+			yyVAL.arguments = addPosonlyargs(&ast.Arguments{Pos: yyVAL.pos}, yyDollar[1].args, yyDollar[1].exprs)
+		}
+	case 41:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line grammar.y:588
+		{
+			yyVAL.arguments = addPosonlyargs(yyDollar[5].arguments, yyDollar[1].args, yyDollar[1].exprs)
+			yyVAL.arguments.Pos = yyVAL.pos
+		}
+	case 42:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:467
+//line grammar.y:596
 		{
 			yyVAL.arguments = &ast.Arguments{Pos: yyVAL.pos, Args: yyDollar[1].args, Defaults: yyDollar[1].exprs}
 		}
-	case 38:
+	case 43:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line grammar.y:471
+//line grammar.y:600
 		{
 			yyVAL.arguments = &ast.Arguments{Pos: yyVAL.pos, Args: yyDollar[1].args, Defaults: yyDollar[1].exprs, Vararg: yyDollar[4].arg, Kwonlyargs: yyDollar[5].args, KwDefaults: yyDollar[5].exprs}
 		}
-	case 39:
+	case 44:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line grammar.y:475
+//line grammar.y:604
 		{
 			yyVAL.arguments = &ast.Arguments{Pos: yyVAL.pos, Args: yyDollar[1].args, Defaults: yyDollar[1].exprs, Vararg: yyDollar[4].arg, Kwonlyargs: yyDollar[5].args, KwDefaults: yyDollar[5].exprs, Kwarg: yyDollar[8].arg}
 		}
-	case 40:
+	case 45:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line grammar.y:479
+//line grammar.y:608
 		{
 			yyVAL.arguments = &ast.Arguments{Pos: yyVAL.pos, Args: yyDollar[1].args, Defaults: yyDollar[1].exprs, Kwarg: yyDollar[4].arg}
 		}
-	case 41:
+	case 46:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:483
+//line grammar.y:612
 		{
 			yyVAL.arguments = &ast.Arguments{Pos: yyVAL.pos, Vararg: yyDollar[2].arg, Kwonlyargs: yyDollar[3].args, KwDefaults: yyDollar[3].exprs}
 		}
-	case 42:
+	case 47:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line grammar.y:487
+//line grammar.y:616
 		{
 			yyVAL.arguments = &ast.Arguments{Pos: yyVAL.pos, Vararg: yyDollar[2].arg, Kwonlyargs: yyDollar[3].args, KwDefaults: yyDollar[3].exprs, Kwarg: yyDollar[6].arg}
 		}
-	case 43:
+	case 48:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:491
+//line grammar.y:620
 		{
 			yyVAL.arguments = &ast.Arguments{Pos: yyVAL.pos, Kwarg: yyDollar[2].arg}
 		}
-	case 44:
+	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:497
+//line grammar.y:626
 		{
 			yyVAL.arg = &ast.Arg{Pos: yyVAL.pos, Arg: ast.Identifier(yyDollar[1].str)}
 		}
-	case 45:
+	case 50:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:501
+//line grammar.y:630
 		{
 			yyVAL.arg = &ast.Arg{Pos: yyVAL.pos, Arg: ast.Identifier(yyDollar[1].str), Annotation: yyDollar[3].expr}
 		}
-	case 46:
+	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:507
+//line grammar.y:636
 		{
 			yyVAL.arg = yyDollar[1].arg
 			yyVAL.expr = nil
 		}
-	case 47:
+	case 52:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:512
+//line grammar.y:641
 		{
 			yyVAL.arg = yyDollar[1].arg
 			yyVAL.expr = yyDollar[3].expr
 		}
-	case 48:
+	case 53:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line grammar.y:518
+//line grammar.y:647
 		{
 			yyVAL.args = nil
 			yyVAL.exprs = nil
 		}
-	case 49:
+	case 54:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:523
+//line grammar.y:652
 		{
 			yyVAL.args = append(yyVAL.args, yyDollar[3].arg)
 			if yyDollar[3].expr != nil {
 				yyVAL.exprs = append(yyVAL.exprs, yyDollar[3].expr)
 			}
 		}
-	case 50:
+	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:532
+//line grammar.y:661
 		{
 			yyVAL.args = nil
 			yyVAL.args = append(yyVAL.args, yyDollar[1].arg)
@@ -1408,165 +1590,196 @@ yydefault:
 				yyVAL.exprs = append(yyVAL.exprs, yyDollar[1].expr)
 			}
 		}
-	case 51:
+	case 56:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:541
+//line grammar.y:670
 		{
 			yyVAL.args = append(yyVAL.args, yyDollar[3].arg)
 			if yyDollar[3].expr != nil {
 				yyVAL.exprs = append(yyVAL.exprs, yyDollar[3].expr)
 			}
 		}
-	case 52:
+	case 57:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line grammar.y:549
+//line grammar.y:678
 		{
 			yyVAL.arg = nil
 		}
-	case 53:
+	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:553
+//line grammar.y:682
 		{
 			yyVAL.arg = yyDollar[1].arg
 		}
-	case 54:
+	case 59:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line grammar.y:688
+		{
+			yyVAL.arguments = yyDollar[1].arguments
+		}
+	case 60:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line grammar.y:692
+		{
+			yyVAL.arguments = addPosonlyargs(&ast.Arguments{Pos: yyVAL.pos}, yyDollar[1].args, yyDollar[1].exprs)
+		}
+	case 61:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line grammar.y:696
+		{
+			yyVAL.arguments = addPosonlyargs(yyDollar[5].arguments, yyDollar[1].args, yyDollar[1].exprs)
+			yyVAL.arguments.Pos = yyVAL.pos
+		}
+	case 62:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:560
+//line grammar.y:704
 		{
 			yyVAL.arguments = &ast.Arguments{Pos: yyVAL.pos, Args: yyDollar[1].args, Defaults: yyDollar[1].exprs}
 		}
-	case 55:
+	case 63:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line grammar.y:564
+//line grammar.y:708
 		{
 			yyVAL.arguments = &ast.Arguments{Pos: yyVAL.pos, Args: yyDollar[1].args, Defaults: yyDollar[1].exprs, Vararg: yyDollar[4].arg, Kwonlyargs: yyDollar[5].args, KwDefaults: yyDollar[5].exprs}
 		}
-	case 56:
+	case 64:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line grammar.y:568
+//line grammar.y:712
 		{
 			yyVAL.arguments = &ast.Arguments{Pos: yyVAL.pos, Args: yyDollar[1].args, Defaults: yyDollar[1].exprs, Vararg: yyDollar[4].arg, Kwonlyargs: yyDollar[5].args, KwDefaults: yyDollar[5].exprs, Kwarg: yyDollar[8].arg}
 		}
-	case 57:
+	case 65:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line grammar.y:572
+//line grammar.y:716
 		{
 			yyVAL.arguments = &ast.Arguments{Pos: yyVAL.pos, Args: yyDollar[1].args, Defaults: yyDollar[1].exprs, Kwarg: yyDollar[4].arg}
 		}
-	case 58:
+	case 66:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:576
+//line grammar.y:720
 		{
 			yyVAL.arguments = &ast.Arguments{Pos: yyVAL.pos, Vararg: yyDollar[2].arg, Kwonlyargs: yyDollar[3].args, KwDefaults: yyDollar[3].exprs}
 		}
-	case 59:
+	case 67:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line grammar.y:580
+//line grammar.y:724
 		{
 			yyVAL.arguments = &ast.Arguments{Pos: yyVAL.pos, Vararg: yyDollar[2].arg, Kwonlyargs: yyDollar[3].args, KwDefaults: yyDollar[3].exprs, Kwarg: yyDollar[6].arg}
 		}
-	case 60:
+	case 68:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:584
+//line grammar.y:728
 		{
 			yyVAL.arguments = &ast.Arguments{Pos: yyVAL.pos, Kwarg: yyDollar[2].arg}
 		}
-	case 61:
+	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:590
+//line grammar.y:734
 		{
 			yyVAL.arg = &ast.Arg{Pos: yyVAL.pos, Arg: ast.Identifier(yyDollar[1].str)}
 		}
-	case 62:
+	case 70:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:596
+//line grammar.y:740
 		{
 			yyVAL.stmts = yyDollar[1].stmts
 		}
-	case 63:
+	case 71:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:600
+//line grammar.y:744
 		{
 			yyVAL.stmts = []ast.Stmt{yyDollar[1].stmt}
 		}
-	case 66:
+	case 72:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line grammar.y:751
+		{
+			yyVAL.stmts = nil
+		}
+	case 73:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line grammar.y:755
+		{
+			yyVAL.stmts = nil
+		}
+	case 76:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:608
+//line grammar.y:763
 		{
 			yyVAL.stmts = nil
 			yyVAL.stmts = append(yyVAL.stmts, yyDollar[1].stmt)
 		}
-	case 67:
+	case 77:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:613
+//line grammar.y:768
 		{
 			yyVAL.stmts = append(yyVAL.stmts, yyDollar[3].stmt)
 		}
-	case 68:
+	case 78:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:619
+//line grammar.y:774
 		{
 			yyVAL.stmts = yyDollar[1].stmts
 		}
-	case 69:
+	case 79:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:625
+//line grammar.y:780
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 70:
+	case 80:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:629
+//line grammar.y:784
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 71:
+	case 81:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:633
+//line grammar.y:788
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 72:
+	case 82:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:637
+//line grammar.y:792
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 73:
+	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:641
+//line grammar.y:796
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 74:
+	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:645
+//line grammar.y:800
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 75:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:649
+//line grammar.y:804
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 76:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:653
+//line grammar.y:808
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 77:
+	case 87:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:680
+//line grammar.y:835
 		{
 			target := yyDollar[1].expr
 			setCtx(yylex, target, ast.Store)
 			yyVAL.stmt = &ast.AugAssign{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Target: target, Op: yyDollar[2].op, Value: yyDollar[3].expr}
 		}
-	case 78:
+	case 88:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:686
+//line grammar.y:841
 		{
 			targets := []ast.Expr{yyDollar[1].expr}
 			targets = append(targets, yyDollar[2].exprs...)
@@ -1575,512 +1788,556 @@ yydefault:
 			setCtxs(yylex, targets, ast.Store)
 			yyVAL.stmt = &ast.Assign{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Targets: targets, Value: value}
 		}
-	case 79:
+	case 89:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line grammar.y:850
+		{
+			yyVAL.stmt = annAssign(yylex, yyVAL.pos, yyDollar[1].expr, yyDollar[3].expr, nil)
+		}
+	case 90:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line grammar.y:854
+		{
+			yyVAL.stmt = annAssign(yylex, yyVAL.pos, yyDollar[1].expr, yyDollar[3].expr, yyDollar[5].expr)
+		}
+	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:695
+//line grammar.y:858
 		{
 			yyVAL.stmt = &ast.ExprStmt{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Value: yyDollar[1].expr}
 		}
-	case 80:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:701
+//line grammar.y:864
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 81:
+	case 93:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:705
+//line grammar.y:868
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 82:
+	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:711
+//line grammar.y:874
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 83:
+	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:715
+//line grammar.y:878
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 84:
+	case 96:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:721
+//line grammar.y:884
 		{
 			yyVAL.exprs = nil
 			yyVAL.exprs = append(yyVAL.exprs, yyDollar[2].expr)
 		}
-	case 85:
+	case 97:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:726
+//line grammar.y:889
 		{
 			yyVAL.exprs = append(yyVAL.exprs, yyDollar[3].expr)
 		}
-	case 86:
+	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:732
+//line grammar.y:895
 		{
 			yyVAL.exprs = nil
 			yyVAL.exprs = append(yyVAL.exprs, yyDollar[1].expr)
 		}
-	case 87:
+	case 99:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:737
+//line grammar.y:900
 		{
 			yyVAL.exprs = append(yyVAL.exprs, yyDollar[3].expr)
 		}
-	case 88:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:743
+//line grammar.y:906
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 89:
+	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:747
+//line grammar.y:910
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 90:
+	case 102:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line grammar.y:752
+//line grammar.y:915
 		{
 			yyVAL.comma = false
 		}
-	case 91:
+	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:756
+//line grammar.y:919
 		{
 			yyVAL.comma = true
 		}
-	case 92:
+	case 104:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:762
+//line grammar.y:925
 		{
 			yyVAL.expr = tupleOrExpr(yyVAL.pos, yyDollar[1].exprs, yyDollar[2].comma)
 		}
-	case 93:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:768
+//line grammar.y:931
 		{
 			yyVAL.op = ast.Add
 		}
-	case 94:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:772
+//line grammar.y:935
 		{
 			yyVAL.op = ast.Sub
 		}
-	case 95:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:776
+//line grammar.y:939
 		{
 			yyVAL.op = ast.Mult
 		}
-	case 96:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:780
+//line grammar.y:943
 		{
 			yyVAL.op = ast.Div
 		}
-	case 97:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:784
+//line grammar.y:947
 		{
 			yyVAL.op = ast.Modulo
 		}
-	case 98:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:788
+//line grammar.y:951
 		{
 			yyVAL.op = ast.BitAnd
 		}
-	case 99:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:792
+//line grammar.y:955
 		{
 			yyVAL.op = ast.BitOr
 		}
-	case 100:
+	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:796
+//line grammar.y:959
 		{
 			yyVAL.op = ast.BitXor
 		}
-	case 101:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:800
+//line grammar.y:963
 		{
 			yyVAL.op = ast.LShift
 		}
-	case 102:
+	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:804
+//line grammar.y:967
 		{
 			yyVAL.op = ast.RShift
 		}
-	case 103:
+	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:808
+//line grammar.y:971
 		{
 			yyVAL.op = ast.Pow
 		}
-	case 104:
+	case 116:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:812
+//line grammar.y:975
 		{
 			yyVAL.op = ast.FloorDiv
 		}
-	case 105:
+	case 117:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line grammar.y:979
+		{
+			yyVAL.op = ast.MatMult
+		}
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:819
+//line grammar.y:986
 		{
 			setCtxs(yylex, yyDollar[2].exprs, ast.Del)
 			yyVAL.stmt = &ast.Delete{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Targets: yyDollar[2].exprs}
 		}
-	case 106:
+	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:826
+//line grammar.y:993
 		{
 			yyVAL.stmt = &ast.Pass{StmtBase: ast.StmtBase{Pos: yyVAL.pos}}
 		}
-	case 107:
+	case 120:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:832
+//line grammar.y:999
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 108:
+	case 121:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:836
+//line grammar.y:1003
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 109:
+	case 122:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:840
+//line grammar.y:1007
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 110:
+	case 123:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:844
+//line grammar.y:1011
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 111:
+	case 124:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:848
+//line grammar.y:1015
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 112:
+	case 125:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:854
+//line grammar.y:1021
 		{
 			yyVAL.stmt = &ast.Break{StmtBase: ast.StmtBase{Pos: yyVAL.pos}}
 		}
-	case 113:
+	case 126:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:860
+//line grammar.y:1027
 		{
 			yyVAL.stmt = &ast.Continue{StmtBase: ast.StmtBase{Pos: yyVAL.pos}}
 		}
-	case 114:
+	case 127:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:866
+//line grammar.y:1033
 		{
 			yyVAL.stmt = &ast.Return{StmtBase: ast.StmtBase{Pos: yyVAL.pos}}
 		}
-	case 115:
+	case 128:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:870
+//line grammar.y:1037
 		{
 			yyVAL.stmt = &ast.Return{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Value: yyDollar[2].expr}
 		}
-	case 116:
+	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:876
+//line grammar.y:1043
 		{
 			yyVAL.stmt = &ast.ExprStmt{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Value: yyDollar[1].expr}
 		}
-	case 117:
+	case 130:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:882
+//line grammar.y:1049
 		{
 			yyVAL.stmt = &ast.Raise{StmtBase: ast.StmtBase{Pos: yyVAL.pos}}
 		}
-	case 118:
+	case 131:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:886
+//line grammar.y:1053
 		{
 			yyVAL.stmt = &ast.Raise{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Exc: yyDollar[2].expr}
 		}
-	case 119:
+	case 132:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line grammar.y:890
+//line grammar.y:1057
 		{
 			yyVAL.stmt = &ast.Raise{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Exc: yyDollar[2].expr, Cause: yyDollar[4].expr}
 		}
-	case 120:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:896
+//line grammar.y:1063
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 121:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:900
+//line grammar.y:1067
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 122:
+	case 135:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:906
+//line grammar.y:1073
 		{
 			yyVAL.stmt = &ast.Import{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Names: yyDollar[2].aliases}
 		}
-	case 123:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:913
+//line grammar.y:1080
 		{
 			yyVAL.level = 1
 		}
-	case 124:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:917
+//line grammar.y:1084
 		{
 			yyVAL.level = 3
 		}
-	case 125:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:923
+//line grammar.y:1090
 		{
 			yyVAL.level = yyDollar[1].level
 		}
-	case 126:
+	case 139:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:927
+//line grammar.y:1094
 		{
 			yyVAL.level += yyDollar[2].level
 		}
-	case 127:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:933
+//line grammar.y:1100
 		{
 			yyVAL.level = 0
 			yyVAL.str = yyDollar[1].str
 		}
-	case 128:
+	case 141:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:938
+//line grammar.y:1105
 		{
 			yyVAL.level = yyDollar[1].level
 			yyVAL.str = yyDollar[2].str
 		}
-	case 129:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:943
+//line grammar.y:1110
 		{
 			yyVAL.level = yyDollar[1].level
 			yyVAL.str = ""
 		}
-	case 130:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:950
+//line grammar.y:1117
 		{
 			yyVAL.aliases = []*ast.Alias{&ast.Alias{Pos: yyVAL.pos, Name: ast.Identifier("*")}}
 		}
-	case 131:
+	case 144:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line grammar.y:954
+//line grammar.y:1121
 		{
 			yyVAL.aliases = yyDollar[2].aliases
 		}
-	case 132:
+	case 145:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:958
+//line grammar.y:1125
 		{
 			yyVAL.aliases = yyDollar[1].aliases
 		}
-	case 133:
+	case 146:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line grammar.y:964
+//line grammar.y:1131
 		{
 			yyVAL.stmt = &ast.ImportFrom{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Module: ast.Identifier(yyDollar[2].str), Names: yyDollar[4].aliases, Level: yyDollar[2].level}
 		}
-	case 134:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:970
+//line grammar.y:1137
 		{
 			yyVAL.alias = &ast.Alias{Pos: yyVAL.pos, Name: ast.Identifier(yyDollar[1].str)}
 		}
-	case 135:
+	case 148:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:974
+//line grammar.y:1141
 		{
 			yyVAL.alias = &ast.Alias{Pos: yyVAL.pos, Name: ast.Identifier(yyDollar[1].str), AsName: ast.Identifier(yyDollar[3].str)}
 		}
-	case 136:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:980
+//line grammar.y:1147
 		{
 			yyVAL.alias = &ast.Alias{Pos: yyVAL.pos, Name: ast.Identifier(yyDollar[1].str)}
 		}
-	case 137:
+	case 150:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:984
+//line grammar.y:1151
 		{
 			yyVAL.alias = &ast.Alias{Pos: yyVAL.pos, Name: ast.Identifier(yyDollar[1].str), AsName: ast.Identifier(yyDollar[3].str)}
 		}
-	case 138:
+	case 151:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:990
+//line grammar.y:1157
 		{
 			yyVAL.aliases = nil
 			yyVAL.aliases = append(yyVAL.aliases, yyDollar[1].alias)
 		}
-	case 139:
+	case 152:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:995
+//line grammar.y:1162
 		{
 			yyVAL.aliases = append(yyVAL.aliases, yyDollar[3].alias)
 		}
-	case 140:
+	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1001
+//line grammar.y:1168
 		{
 			yyVAL.aliases = nil
 			yyVAL.aliases = append(yyVAL.aliases, yyDollar[1].alias)
 		}
-	case 141:
+	case 154:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1006
+//line grammar.y:1173
 		{
 			yyVAL.aliases = append(yyVAL.aliases, yyDollar[3].alias)
 		}
-	case 142:
+	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1012
+//line grammar.y:1179
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 143:
+	case 156:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1016
+//line grammar.y:1183
 		{
 			yyVAL.str += "." + yyDollar[3].str
 		}
-	case 144:
+	case 157:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1022
+//line grammar.y:1189
 		{
 			yyVAL.identifiers = nil
 			yyVAL.identifiers = append(yyVAL.identifiers, ast.Identifier(yyDollar[1].str))
 		}
-	case 145:
+	case 158:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1027
+//line grammar.y:1194
 		{
 			yyVAL.identifiers = append(yyVAL.identifiers, ast.Identifier(yyDollar[3].str))
 		}
-	case 146:
+	case 159:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1033
+//line grammar.y:1200
 		{
 			yyVAL.stmt = &ast.Global{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Names: yyDollar[2].identifiers}
 		}
-	case 147:
+	case 160:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1039
+//line grammar.y:1206
 		{
 			yyVAL.stmt = &ast.Nonlocal{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Names: yyDollar[2].identifiers}
 		}
-	case 148:
+	case 161:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1045
+//line grammar.y:1212
 		{
 			yyVAL.exprs = nil
 			yyVAL.exprs = append(yyVAL.exprs, yyDollar[1].expr)
 		}
-	case 149:
+	case 162:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1050
+//line grammar.y:1217
 		{
 			yyVAL.exprs = append(yyVAL.exprs, yyDollar[3].expr)
 		}
-	case 150:
+	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1056
+//line grammar.y:1223
 		{
 			yyVAL.stmt = &ast.Assert{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Test: yyDollar[2].expr}
 		}
-	case 151:
+	case 164:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line grammar.y:1060
+//line grammar.y:1227
 		{
 			yyVAL.stmt = &ast.Assert{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Test: yyDollar[2].expr, Msg: yyDollar[4].expr}
 		}
-	case 152:
+	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1066
+//line grammar.y:1233
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 153:
+	case 166:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1070
+//line grammar.y:1237
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 154:
+	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1074
+//line grammar.y:1241
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 155:
+	case 168:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1078
+//line grammar.y:1245
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 156:
+	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1082
+//line grammar.y:1249
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 157:
+	case 170:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1086
+//line grammar.y:1253
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 158:
+	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1090
+//line grammar.y:1257
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 159:
+	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1094
+//line grammar.y:1261
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 160:
+	case 173:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line grammar.y:1265
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 174:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line grammar.y:1271
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 175:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line grammar.y:1275
+		{
+			with := yyDollar[2].stmt.(*ast.With)
+			yyVAL.stmt = &ast.AsyncWith{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Items: with.Items, Body: with.Body}
+		}
+	case 176:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line grammar.y:1280
+		{
+			forStmt := yyDollar[2].stmt.(*ast.For)
+			yyVAL.stmt = &ast.AsyncFor{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Target: forStmt.Target, Iter: forStmt.Iter, Body: forStmt.Body, Orelse: forStmt.Orelse}
+		}
+	case 177:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line grammar.y:1099
+//line grammar.y:1286
 		{
 			yyVAL.ifstmt = nil
 			yyVAL.lastif = nil
 		}
-	case 161:
+	case 178:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line grammar.y:1104
+//line grammar.y:1291
 		{
 			elifs := yyVAL.ifstmt
 			newif := &ast.If{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Test: yyDollar[3].expr, Body: yyDollar[5].stmts}
@@ -2091,21 +2348,21 @@ yydefault:
 			}
 			yyVAL.lastif = newif
 		}
-	case 162:
+	case 179:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line grammar.y:1116
+//line grammar.y:1303
 		{
 			yyVAL.stmts = nil
 		}
-	case 163:
+	case 180:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1120
+//line grammar.y:1307
 		{
 			yyVAL.stmts = yyDollar[3].stmts
 		}
-	case 164:
+	case 181:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line grammar.y:1126
+//line grammar.y:1313
 		{
 			newif := &ast.If{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Test: yyDollar[2].expr, Body: yyDollar[4].stmts}
 			yyVAL.stmt = newif
@@ -2124,202 +2381,239 @@ yydefault:
 				}
 			}
 		}
-	case 165:
+	case 182:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line grammar.y:1147
+//line grammar.y:1334
 		{
 			yyVAL.stmt = &ast.While{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Test: yyDollar[2].expr, Body: yyDollar[4].stmts, Orelse: yyDollar[5].stmts}
 		}
-	case 166:
+	case 183:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line grammar.y:1153
+//line grammar.y:1340
 		{
 			target := tupleOrExpr(yyVAL.pos, yyDollar[2].exprs, false)
 			setCtx(yylex, target, ast.Store)
 			yyVAL.stmt = &ast.For{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Target: target, Iter: yyDollar[4].expr, Body: yyDollar[6].stmts, Orelse: yyDollar[7].stmts}
 		}
-	case 167:
+	case 184:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line grammar.y:1160
+//line grammar.y:1347
 		{
 			yyVAL.exchandlers = nil
 		}
-	case 168:
+	case 185:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line grammar.y:1164
+//line grammar.y:1351
 		{
-			exc := &ast.ExceptHandler{Pos: yyVAL.pos, ExprType: yyDollar[2].expr, Name: ast.Identifier(yyDollar[2].str), Body: yyDollar[4].stmts}
+			exc := &ast.ExceptHandler{Pos: yyDollar[2].pos, ExprType: yyDollar[2].expr, Name: ast.Identifier(yyDollar[2].str), Body: yyDollar[4].stmts}
 			yyVAL.exchandlers = append(yyVAL.exchandlers, exc)
 		}
-	case 169:
+	case 186:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line grammar.y:1171
+//line grammar.y:1358
 		{
 			yyVAL.stmt = &ast.Try{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Body: yyDollar[3].stmts, Handlers: yyDollar[4].exchandlers}
 		}
-	case 170:
+	case 187:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line grammar.y:1175
+//line grammar.y:1362
 		{
 			yyVAL.stmt = &ast.Try{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Body: yyDollar[3].stmts, Handlers: yyDollar[4].exchandlers, Orelse: yyDollar[7].stmts}
 		}
-	case 171:
+	case 188:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line grammar.y:1179
+//line grammar.y:1366
 		{
 			yyVAL.stmt = &ast.Try{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Body: yyDollar[3].stmts, Handlers: yyDollar[4].exchandlers, Finalbody: yyDollar[7].stmts}
 		}
-	case 172:
+	case 189:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line grammar.y:1183
+//line grammar.y:1370
 		{
 			yyVAL.stmt = &ast.Try{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Body: yyDollar[3].stmts, Handlers: yyDollar[4].exchandlers, Orelse: yyDollar[7].stmts, Finalbody: yyDollar[10].stmts}
 		}
-	case 173:
+	case 190:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1189
+//line grammar.y:1376
 		{
 			yyVAL.withitems = nil
 			yyVAL.withitems = append(yyVAL.withitems, yyDollar[1].withitem)
 		}
-	case 174:
+	case 191:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1194
+//line grammar.y:1381
 		{
 			yyVAL.withitems = append(yyVAL.withitems, yyDollar[3].withitem)
 		}
-	case 175:
+	case 192:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line grammar.y:1200
+//line grammar.y:1387
 		{
 			yyVAL.stmt = &ast.With{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Items: yyDollar[2].withitems, Body: yyDollar[4].stmts}
 		}
-	case 176:
+	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1206
+//line grammar.y:1393
 		{
 			yyVAL.withitem = &ast.WithItem{Pos: yyVAL.pos, ContextExpr: yyDollar[1].expr}
 		}
-	case 177:
+	case 194:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1210
+//line grammar.y:1397
 		{
 			v := yyDollar[3].expr
 			setCtx(yylex, v, ast.Store)
 			yyVAL.withitem = &ast.WithItem{Pos: yyVAL.pos, ContextExpr: yyDollar[1].expr, OptionalVars: v}
 		}
-	case 178:
+	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1219
+//line grammar.y:1406
 		{
 			yyVAL.expr = nil
 			yyVAL.str = ""
 		}
-	case 179:
+	case 196:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1224
+//line grammar.y:1411
 		{
 			yyVAL.expr = yyDollar[2].expr
 			yyVAL.str = ""
 		}
-	case 180:
+	case 197:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line grammar.y:1229
+//line grammar.y:1416
 		{
 			yyVAL.expr = yyDollar[2].expr
 			yyVAL.str = yyDollar[4].str
 		}
-	case 181:
+	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1236
+//line grammar.y:1423
 		{
 			yyVAL.stmts = nil
 			yyVAL.stmts = append(yyVAL.stmts, yyDollar[1].stmts...)
 		}
-	case 182:
+	case 199:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1241
+//line grammar.y:1428
 		{
 			yyVAL.stmts = append(yyVAL.stmts, yyDollar[2].stmts...)
 		}
-	case 183:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1247
+//line grammar.y:1434
 		{
 			yyVAL.stmts = yyDollar[1].stmts
 		}
-	case 184:
+	case 201:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line grammar.y:1251
+//line grammar.y:1438
 		{
 			yyVAL.stmts = yyDollar[3].stmts
 		}
-	case 185:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1257
+//line grammar.y:1444
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 186:
+	case 203:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line grammar.y:1448
+		{
+			yyVAL.expr = namedExpr(yylex, yyVAL.pos, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 204:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line grammar.y:1454
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 205:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line grammar.y:1458
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 206:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line grammar.y:1464
+		{
+			yyVAL.exprs = nil
+			yyVAL.exprs = append(yyVAL.exprs, yyDollar[1].expr)
+		}
+	case 207:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line grammar.y:1469
+		{
+			yyVAL.exprs = append(yyVAL.exprs, yyDollar[3].expr)
+		}
+	case 208:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line grammar.y:1475
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 209:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line grammar.y:1261
+//line grammar.y:1479
 		{
 			yyVAL.expr = &ast.IfExp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Test: yyDollar[3].expr, Body: yyDollar[1].expr, Orelse: yyDollar[5].expr}
 		}
-	case 187:
+	case 210:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1265
+//line grammar.y:1483
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 188:
+	case 211:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1271
+//line grammar.y:1489
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 189:
+	case 212:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1275
+//line grammar.y:1493
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 190:
+	case 213:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1281
+//line grammar.y:1499
 		{
 			args := &ast.Arguments{Pos: yyVAL.pos}
 			yyVAL.expr = &ast.Lambda{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Args: args, Body: yyDollar[3].expr}
 		}
-	case 191:
+	case 214:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line grammar.y:1286
+//line grammar.y:1504
 		{
 			yyVAL.expr = &ast.Lambda{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Args: yyDollar[2].arguments, Body: yyDollar[4].expr}
 		}
-	case 192:
+	case 215:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1292
+//line grammar.y:1510
 		{
 			args := &ast.Arguments{Pos: yyVAL.pos}
 			yyVAL.expr = &ast.Lambda{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Args: args, Body: yyDollar[3].expr}
 		}
-	case 193:
+	case 216:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line grammar.y:1297
+//line grammar.y:1515
 		{
 			yyVAL.expr = &ast.Lambda{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Args: yyDollar[2].arguments, Body: yyDollar[4].expr}
 		}
-	case 194:
+	case 217:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1303
+//line grammar.y:1521
 		{
 			yyVAL.expr = yyDollar[1].expr
 			yyVAL.isExpr = true
 		}
-	case 195:
+	case 218:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1308
+//line grammar.y:1526
 		{
 			if !yyDollar[1].isExpr {
 				boolop := yyVAL.expr.(*ast.BoolOp)
@@ -2329,16 +2623,16 @@ yydefault:
 			}
 			yyVAL.isExpr = false
 		}
-	case 196:
+	case 219:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1320
+//line grammar.y:1538
 		{
 			yyVAL.expr = yyDollar[1].expr
 			yyVAL.isExpr = true
 		}
-	case 197:
+	case 220:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1325
+//line grammar.y:1543
 		{
 			if !yyDollar[1].isExpr {
 				boolop := yyVAL.expr.(*ast.BoolOp)
@@ -2348,28 +2642,28 @@ yydefault:
 			}
 			yyVAL.isExpr = false
 		}
-	case 198:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1337
+//line grammar.y:1555
 		{
 			yyVAL.expr = &ast.UnaryOp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Op: ast.Not, Operand: yyDollar[2].expr}
 		}
-	case 199:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1341
+//line grammar.y:1559
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 200:
+	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1347
+//line grammar.y:1565
 		{
 			yyVAL.expr = yyDollar[1].expr
 			yyVAL.isExpr = true
 		}
-	case 201:
+	case 224:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1352
+//line grammar.y:1570
 		{
 			if !yyDollar[1].isExpr {
 				comp := yyVAL.expr.(*ast.Compare)
@@ -2380,243 +2674,263 @@ yydefault:
 			}
 			yyVAL.isExpr = false
 		}
-	case 202:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1367
+//line grammar.y:1585
 		{
 			yyVAL.cmpop = ast.Lt
 		}
-	case 203:
+	case 226:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1371
+//line grammar.y:1589
 		{
 			yyVAL.cmpop = ast.Gt
 		}
-	case 204:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1375
+//line grammar.y:1593
 		{
 			yyVAL.cmpop = ast.Eq
 		}
-	case 205:
+	case 228:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1379
+//line grammar.y:1597
 		{
 			yyVAL.cmpop = ast.GtE
 		}
-	case 206:
+	case 229:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1383
+//line grammar.y:1601
 		{
 			yyVAL.cmpop = ast.LtE
 		}
-	case 207:
+	case 230:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1387
+//line grammar.y:1605
 		{
 			yylex.(*yyLex).SyntaxError("invalid syntax")
 		}
-	case 208:
+	case 231:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1391
+//line grammar.y:1609
 		{
 			yyVAL.cmpop = ast.NotEq
 		}
-	case 209:
+	case 232:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1395
+//line grammar.y:1613
 		{
 			yyVAL.cmpop = ast.In
 		}
-	case 210:
+	case 233:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1399
+//line grammar.y:1617
 		{
 			yyVAL.cmpop = ast.NotIn
 		}
-	case 211:
+	case 234:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1403
+//line grammar.y:1621
 		{
 			yyVAL.cmpop = ast.Is
 		}
-	case 212:
+	case 235:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1407
+//line grammar.y:1625
 		{
 			yyVAL.cmpop = ast.IsNot
 		}
-	case 213:
+	case 236:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1413
+//line grammar.y:1631
 		{
 			yyVAL.expr = &ast.Starred{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Value: yyDollar[2].expr, Ctx: ast.Load}
 		}
-	case 214:
+	case 237:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1419
+//line grammar.y:1637
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 215:
+	case 238:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1423
+//line grammar.y:1641
 		{
 			yyVAL.expr = &ast.BinOp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Left: yyDollar[1].expr, Op: ast.BitOr, Right: yyDollar[3].expr}
 		}
-	case 216:
+	case 239:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1429
+//line grammar.y:1647
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 217:
+	case 240:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1433
+//line grammar.y:1651
 		{
 			yyVAL.expr = &ast.BinOp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Left: yyDollar[1].expr, Op: ast.BitXor, Right: yyDollar[3].expr}
 		}
-	case 218:
+	case 241:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1439
+//line grammar.y:1657
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 219:
+	case 242:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1443
+//line grammar.y:1661
 		{
 			yyVAL.expr = &ast.BinOp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Left: yyDollar[1].expr, Op: ast.BitAnd, Right: yyDollar[3].expr}
 		}
-	case 220:
+	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1449
+//line grammar.y:1667
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 221:
+	case 244:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1453
+//line grammar.y:1671
 		{
 			yyVAL.expr = &ast.BinOp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Left: yyDollar[1].expr, Op: ast.LShift, Right: yyDollar[3].expr}
 		}
-	case 222:
+	case 245:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1457
+//line grammar.y:1675
 		{
 			yyVAL.expr = &ast.BinOp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Left: yyDollar[1].expr, Op: ast.RShift, Right: yyDollar[3].expr}
 		}
-	case 223:
+	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1463
+//line grammar.y:1681
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 224:
+	case 247:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1467
+//line grammar.y:1685
 		{
 			yyVAL.expr = &ast.BinOp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Left: yyDollar[1].expr, Op: ast.Add, Right: yyDollar[3].expr}
 		}
-	case 225:
+	case 248:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1471
+//line grammar.y:1689
 		{
 			yyVAL.expr = &ast.BinOp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Left: yyDollar[1].expr, Op: ast.Sub, Right: yyDollar[3].expr}
 		}
-	case 226:
+	case 249:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1477
+//line grammar.y:1695
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 227:
+	case 250:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1481
+//line grammar.y:1699
 		{
 			yyVAL.expr = &ast.BinOp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Left: yyDollar[1].expr, Op: ast.Mult, Right: yyDollar[3].expr}
 		}
-	case 228:
+	case 251:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1485
+//line grammar.y:1703
+		{
+			yyVAL.expr = &ast.BinOp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Left: yyDollar[1].expr, Op: ast.MatMult, Right: yyDollar[3].expr}
+		}
+	case 252:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line grammar.y:1707
 		{
 			yyVAL.expr = &ast.BinOp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Left: yyDollar[1].expr, Op: ast.Div, Right: yyDollar[3].expr}
 		}
-	case 229:
+	case 253:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1489
+//line grammar.y:1711
 		{
 			yyVAL.expr = &ast.BinOp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Left: yyDollar[1].expr, Op: ast.Modulo, Right: yyDollar[3].expr}
 		}
-	case 230:
+	case 254:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1493
+//line grammar.y:1715
 		{
 			yyVAL.expr = &ast.BinOp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Left: yyDollar[1].expr, Op: ast.FloorDiv, Right: yyDollar[3].expr}
 		}
-	case 231:
+	case 255:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1499
+//line grammar.y:1721
 		{
 			yyVAL.expr = &ast.UnaryOp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Op: ast.UAdd, Operand: yyDollar[2].expr}
 		}
-	case 232:
+	case 256:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1503
+//line grammar.y:1725
 		{
 			yyVAL.expr = &ast.UnaryOp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Op: ast.USub, Operand: yyDollar[2].expr}
 		}
-	case 233:
+	case 257:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1507
+//line grammar.y:1729
 		{
 			yyVAL.expr = &ast.UnaryOp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Op: ast.Invert, Operand: yyDollar[2].expr}
 		}
-	case 234:
+	case 258:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1511
+//line grammar.y:1733
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 235:
+	case 259:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line grammar.y:1739
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 260:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line grammar.y:1743
+		{
+			yyVAL.expr = &ast.BinOp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Left: yyDollar[1].expr, Op: ast.Pow, Right: yyDollar[3].expr}
+		}
+	case 261:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1517
+//line grammar.y:1749
 		{
 			yyVAL.expr = applyTrailers(yyDollar[1].expr, yyDollar[2].exprs)
 		}
-	case 236:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line grammar.y:1521
+	case 262:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line grammar.y:1753
 		{
-			yyVAL.expr = &ast.BinOp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Left: applyTrailers(yyDollar[1].expr, yyDollar[2].exprs), Op: ast.Pow, Right: yyDollar[4].expr}
+			yyVAL.expr = &ast.Await{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Value: applyTrailers(yyDollar[2].expr, yyDollar[3].exprs)}
 		}
-	case 237:
+	case 263:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line grammar.y:1527
+//line grammar.y:1759
 		{
 			yyVAL.exprs = nil
 		}
-	case 238:
+	case 264:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1531
+//line grammar.y:1763
 		{
 			yyVAL.exprs = append(yyVAL.exprs, yyDollar[2].expr)
 		}
-	case 239:
+	case 265:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1537
+//line grammar.y:1769
 		{
 			yyVAL.obj = yyDollar[1].obj
 		}
-	case 240:
+	case 266:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1541
+//line grammar.y:1773
 		{
 			switch a := yyVAL.obj.(type) {
 			case py.String:
 				switch b := yyDollar[2].obj.(type) {
 				case py.String:
 					yyVAL.obj = a + b
+				case *ast.JoinedStr:
+					yyVAL.obj = concatStrings(a, b)
 				default:
 					yylex.(*yyLex).SyntaxError("cannot mix string and nonstring literals")
 				}
@@ -2627,126 +2941,136 @@ yydefault:
 				default:
 					yylex.(*yyLex).SyntaxError("cannot mix bytes and nonbytes literals")
 				}
+			case *ast.JoinedStr:
+				switch b := yyDollar[2].obj.(type) {
+				case py.String, *ast.JoinedStr:
+					yyVAL.obj = concatStrings(a, b)
+				default:
+					yylex.(*yyLex).SyntaxError("cannot mix string and nonstring literals")
+				}
 			}
 		}
-	case 241:
+	case 267:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1562
+//line grammar.y:1803
 		{
 			yyVAL.expr = &ast.Tuple{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Ctx: ast.Load}
 		}
-	case 242:
+	case 268:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1566
+//line grammar.y:1807
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 243:
+	case 269:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line grammar.y:1570
+//line grammar.y:1811
 		{
 			yyVAL.expr = &ast.GeneratorExp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Elt: yyDollar[2].expr, Generators: yyDollar[3].comprehensions}
 		}
-	case 244:
+	case 270:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line grammar.y:1574
+//line grammar.y:1815
 		{
 			yyVAL.expr = tupleOrExpr(yyVAL.pos, yyDollar[2].exprs, yyDollar[3].comma)
 		}
-	case 245:
+	case 271:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1578
+//line grammar.y:1819
 		{
 			yyVAL.expr = &ast.List{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Ctx: ast.Load}
 		}
-	case 246:
+	case 272:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line grammar.y:1582
+//line grammar.y:1823
 		{
 			yyVAL.expr = &ast.ListComp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Elt: yyDollar[2].expr, Generators: yyDollar[3].comprehensions}
 		}
-	case 247:
+	case 273:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line grammar.y:1586
+//line grammar.y:1827
 		{
 			yyVAL.expr = &ast.List{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Elts: yyDollar[2].exprs, Ctx: ast.Load}
 		}
-	case 248:
+	case 274:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1590
+//line grammar.y:1831
 		{
 			yyVAL.expr = &ast.Dict{ExprBase: ast.ExprBase{Pos: yyVAL.pos}}
 		}
-	case 249:
+	case 275:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1594
+//line grammar.y:1835
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 250:
+	case 276:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1598
+//line grammar.y:1839
 		{
 			yyVAL.expr = &ast.Name{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Id: ast.Identifier(yyDollar[1].str), Ctx: ast.Load}
 		}
-	case 251:
+	case 277:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1602
+//line grammar.y:1843
 		{
 			yyVAL.expr = &ast.Num{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, N: yyDollar[1].obj}
 		}
-	case 252:
+	case 278:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1606
+//line grammar.y:1847
 		{
 			switch s := yyDollar[1].obj.(type) {
 			case py.String:
 				yyVAL.expr = &ast.Str{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, S: s}
 			case py.Bytes:
 				yyVAL.expr = &ast.Bytes{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, S: s}
+			case *ast.JoinedStr:
+				setFStringPos(s, yyVAL.pos)
+				yyVAL.expr = s
 			default:
 				panic("not Bytes or String in strings")
 			}
 		}
-	case 253:
+	case 279:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1617
+//line grammar.y:1861
 		{
 			yyVAL.expr = &ast.Ellipsis{ExprBase: ast.ExprBase{Pos: yyVAL.pos}}
 		}
-	case 254:
+	case 280:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1621
+//line grammar.y:1865
 		{
 			yyVAL.expr = &ast.NameConstant{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Value: py.None}
 		}
-	case 255:
+	case 281:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1625
+//line grammar.y:1869
 		{
 			yyVAL.expr = &ast.NameConstant{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Value: py.True}
 		}
-	case 256:
+	case 282:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1629
+//line grammar.y:1873
 		{
 			yyVAL.expr = &ast.NameConstant{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Value: py.False}
 		}
-	case 257:
+	case 283:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1636
+//line grammar.y:1880
 		{
 			yyVAL.expr = &ast.Call{ExprBase: ast.ExprBase{Pos: yyVAL.pos}}
 		}
-	case 258:
+	case 284:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1640
+//line grammar.y:1884
 		{
 			yyVAL.expr = yyDollar[2].call
 		}
-	case 259:
+	case 285:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1644
+//line grammar.y:1888
 		{
 			slice := yyDollar[2].slice
 			// If all items of a ExtSlice are just Index then return as tuple
@@ -2764,22 +3088,22 @@ yydefault:
 			}
 			yyVAL.expr = &ast.Subscript{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Slice: slice, Ctx: ast.Load}
 		}
-	case 260:
+	case 286:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1662
+//line grammar.y:1906
 		{
 			yyVAL.expr = &ast.Attribute{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Attr: ast.Identifier(yyDollar[2].str), Ctx: ast.Load}
 		}
-	case 261:
+	case 287:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1668
+//line grammar.y:1912
 		{
 			yyVAL.slice = yyDollar[1].slice
 			yyVAL.isExpr = true
 		}
-	case 262:
+	case 288:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1673
+//line grammar.y:1917
 		{
 			if !yyDollar[1].isExpr {
 				extSlice := yyVAL.slice.(*ast.ExtSlice)
@@ -2789,9 +3113,9 @@ yydefault:
 			}
 			yyVAL.isExpr = false
 		}
-	case 263:
+	case 289:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1685
+//line grammar.y:1929
 		{
 			if yyDollar[2].comma && yyDollar[1].isExpr {
 				yyVAL.slice = &ast.ExtSlice{SliceBase: ast.SliceBase{Pos: yyVAL.pos}, Dims: []ast.Slicer{yyDollar[1].slice}}
@@ -2799,107 +3123,107 @@ yydefault:
 				yyVAL.slice = yyDollar[1].slice
 			}
 		}
-	case 264:
+	case 290:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1695
+//line grammar.y:1939
 		{
 			yyVAL.slice = &ast.Index{SliceBase: ast.SliceBase{Pos: yyVAL.pos}, Value: yyDollar[1].expr}
 		}
-	case 265:
+	case 291:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1699
+//line grammar.y:1943
 		{
 			yyVAL.slice = &ast.Slice{SliceBase: ast.SliceBase{Pos: yyVAL.pos}, Lower: nil, Upper: nil, Step: nil}
 		}
-	case 266:
+	case 292:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1703
+//line grammar.y:1947
 		{
 			yyVAL.slice = &ast.Slice{SliceBase: ast.SliceBase{Pos: yyVAL.pos}, Lower: nil, Upper: nil, Step: yyDollar[2].expr}
 		}
-	case 267:
+	case 293:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1707
+//line grammar.y:1951
 		{
 			yyVAL.slice = &ast.Slice{SliceBase: ast.SliceBase{Pos: yyVAL.pos}, Lower: nil, Upper: yyDollar[2].expr, Step: nil}
 		}
-	case 268:
+	case 294:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1711
+//line grammar.y:1955
 		{
 			yyVAL.slice = &ast.Slice{SliceBase: ast.SliceBase{Pos: yyVAL.pos}, Lower: nil, Upper: yyDollar[2].expr, Step: yyDollar[3].expr}
 		}
-	case 269:
+	case 295:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1715
+//line grammar.y:1959
 		{
 			yyVAL.slice = &ast.Slice{SliceBase: ast.SliceBase{Pos: yyVAL.pos}, Lower: yyDollar[1].expr, Upper: nil, Step: nil}
 		}
-	case 270:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1719
+//line grammar.y:1963
 		{
 			yyVAL.slice = &ast.Slice{SliceBase: ast.SliceBase{Pos: yyVAL.pos}, Lower: yyDollar[1].expr, Upper: nil, Step: yyDollar[3].expr}
 		}
-	case 271:
+	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1723
+//line grammar.y:1967
 		{
 			yyVAL.slice = &ast.Slice{SliceBase: ast.SliceBase{Pos: yyVAL.pos}, Lower: yyDollar[1].expr, Upper: yyDollar[3].expr, Step: nil}
 		}
-	case 272:
+	case 298:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line grammar.y:1727
+//line grammar.y:1971
 		{
 			yyVAL.slice = &ast.Slice{SliceBase: ast.SliceBase{Pos: yyVAL.pos}, Lower: yyDollar[1].expr, Upper: yyDollar[3].expr, Step: yyDollar[4].expr}
 		}
-	case 273:
+	case 299:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1733
+//line grammar.y:1977
 		{
 			yyVAL.expr = nil
 		}
-	case 274:
+	case 300:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1737
+//line grammar.y:1981
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 275:
+	case 301:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1743
+//line grammar.y:1987
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 276:
+	case 302:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1747
+//line grammar.y:1991
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 277:
+	case 303:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1753
+//line grammar.y:1997
 		{
 			yyVAL.exprs = nil
 			yyVAL.exprs = append(yyVAL.exprs, yyDollar[1].expr)
 		}
-	case 278:
+	case 304:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1758
+//line grammar.y:2002
 		{
 			yyVAL.exprs = append(yyVAL.exprs, yyDollar[3].expr)
 		}
-	case 279:
+	case 305:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1764
+//line grammar.y:2008
 		{
 			yyVAL.exprs = yyDollar[1].exprs
 			yyVAL.comma = yyDollar[2].comma
 		}
-	case 280:
+	case 306:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1771
+//line grammar.y:2015
 		{
 			elts := yyDollar[1].exprs
 			if yyDollar[2].comma || len(elts) > 1 {
@@ -2908,28 +3232,35 @@ yydefault:
 				yyVAL.expr = elts[0]
 			}
 		}
-	case 281:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1782
-		{
-			yyVAL.exprs = yyDollar[1].exprs
-		}
-	case 282:
+	case 307:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1789
+//line grammar.y:2029
 		{
 			yyVAL.exprs = nil
 			yyVAL.exprs = append(yyVAL.exprs, yyDollar[1].expr, yyDollar[3].expr) // key, value order
 		}
-	case 283:
+	case 308:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line grammar.y:2034
+		{
+			yyVAL.exprs = nil
+			yyVAL.exprs = append(yyVAL.exprs, nil, yyDollar[2].expr)
+		}
+	case 309:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line grammar.y:1794
+//line grammar.y:2039
 		{
 			yyVAL.exprs = append(yyVAL.exprs, yyDollar[3].expr, yyDollar[5].expr)
 		}
-	case 284:
+	case 310:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line grammar.y:2043
+		{
+			yyVAL.exprs = append(yyVAL.exprs, nil, yyDollar[4].expr)
+		}
+	case 311:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1800
+//line grammar.y:2049
 		{
 			keyValues := yyDollar[1].exprs
 			d := &ast.Dict{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Keys: nil, Values: nil}
@@ -2939,27 +3270,27 @@ yydefault:
 			}
 			yyVAL.expr = d
 		}
-	case 285:
+	case 312:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line grammar.y:1810
+//line grammar.y:2059
 		{
 			yyVAL.expr = &ast.DictComp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Key: yyDollar[1].expr, Value: yyDollar[3].expr, Generators: yyDollar[4].comprehensions}
 		}
-	case 286:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1814
+	case 313:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line grammar.y:2063
 		{
 			yyVAL.expr = &ast.Set{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Elts: yyDollar[1].exprs}
 		}
-	case 287:
+	case 314:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1818
+//line grammar.y:2067
 		{
 			yyVAL.expr = &ast.SetComp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Elt: yyDollar[1].expr, Generators: yyDollar[2].comprehensions}
 		}
-	case 288:
+	case 315:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line grammar.y:1824
+//line grammar.y:2073
 		{
 			classDef := &ast.ClassDef{StmtBase: ast.StmtBase{Pos: yyVAL.pos}, Name: ast.Identifier(yyDollar[2].str), Body: yyDollar[5].stmts}
 			yyVAL.stmt = classDef
@@ -2967,106 +3298,66 @@ yydefault:
 			if args != nil {
 				classDef.Bases = args.Args
 				classDef.Keywords = args.Keywords
-				classDef.Starargs = args.Starargs
-				classDef.Kwargs = args.Kwargs
 			}
 		}
-	case 289:
+	case 316:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1838
+//line grammar.y:2085
 		{
 			yyVAL.call = yyDollar[1].call
 		}
-	case 290:
+	case 317:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1842
-		{
-			yyVAL.call.Args = append(yyVAL.call.Args, yyDollar[3].call.Args...)
-			yyVAL.call.Keywords = append(yyVAL.call.Keywords, yyDollar[3].call.Keywords...)
-		}
-	case 291:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line grammar.y:1848
+//line grammar.y:2089
 		{
-			yyVAL.call = &ast.Call{}
+			addArgument(yylex, yyVAL.call, yyDollar[3].call)
 		}
-	case 292:
+	case 318:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1852
+//line grammar.y:2095
 		{
 			yyVAL.call = yyDollar[1].call
 		}
-	case 293:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line grammar.y:1857
+	case 319:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line grammar.y:2103
 		{
 			yyVAL.call = &ast.Call{}
+			yyVAL.call.Args = []ast.Expr{yyDollar[1].expr}
 		}
-	case 294:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1861
-		{
-			yyVAL.call.Args = append(yyVAL.call.Args, yyDollar[3].call.Args...)
-			yyVAL.call.Keywords = append(yyVAL.call.Keywords, yyDollar[3].call.Keywords...)
-		}
-	case 295:
+	case 320:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1868
+//line grammar.y:2108
 		{
-			yyVAL.call = yyDollar[1].call
-		}
-	case 296:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line grammar.y:1872
-		{
-			call := yyDollar[1].call
-			call.Starargs = yyDollar[3].expr
-			if len(yyDollar[4].call.Args) != 0 {
-				yylex.(*yyLex).SyntaxError("only named arguments may follow *expression")
-			}
-			call.Keywords = append(call.Keywords, yyDollar[4].call.Keywords...)
-			yyVAL.call = call
-		}
-	case 297:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line grammar.y:1882
-		{
-			call := yyDollar[1].call
-			call.Starargs = yyDollar[3].expr
-			call.Kwargs = yyDollar[7].expr
-			if len(yyDollar[4].call.Args) != 0 {
-				yylex.(*yyLex).SyntaxError("only named arguments may follow *expression")
+			yyVAL.call = &ast.Call{}
+			yyVAL.call.Args = []ast.Expr{
+				&ast.GeneratorExp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Elt: yyDollar[1].expr, Generators: yyDollar[2].comprehensions},
 			}
-			call.Keywords = append(call.Keywords, yyDollar[4].call.Keywords...)
-			yyVAL.call = call
 		}
-	case 298:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1893
+	case 321:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line grammar.y:2115
 		{
-			call := yyDollar[1].call
-			call.Kwargs = yyDollar[3].expr
-			yyVAL.call = call
+			yyVAL.call = &ast.Call{}
+			yyVAL.call.Args = []ast.Expr{&ast.Starred{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Value: yyDollar[2].expr, Ctx: ast.Load}}
 		}
-	case 299:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1903
+	case 322:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line grammar.y:2120
 		{
 			yyVAL.call = &ast.Call{}
-			yyVAL.call.Args = []ast.Expr{yyDollar[1].expr}
+			yyVAL.call.Keywords = []*ast.Keyword{&ast.Keyword{Pos: yyVAL.pos, Value: yyDollar[2].expr}}
 		}
-	case 300:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1908
+	case 323:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line grammar.y:2125
 		{
 			yyVAL.call = &ast.Call{}
-			yyVAL.call.Args = []ast.Expr{
-				&ast.GeneratorExp{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Elt: yyDollar[1].expr, Generators: yyDollar[2].comprehensions},
-			}
+			yyVAL.call.Args = []ast.Expr{namedExpr(yylex, yyVAL.pos, yyDollar[1].expr, yyDollar[3].expr)}
 		}
-	case 301:
+	case 324:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1915
+//line grammar.y:2130
 		{
 			yyVAL.call = &ast.Call{}
 			test := yyDollar[1].expr
@@ -3076,23 +3367,23 @@ yydefault:
 				yylex.(*yyLex).SyntaxError("keyword can't be an expression")
 			}
 		}
-	case 302:
+	case 325:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1927
+//line grammar.y:2142
 		{
 			yyVAL.comprehensions = yyDollar[1].comprehensions
 			yyVAL.exprs = nil
 		}
-	case 303:
+	case 326:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1932
+//line grammar.y:2147
 		{
 			yyVAL.comprehensions = yyDollar[1].comprehensions
 			yyVAL.exprs = yyDollar[1].exprs
 		}
-	case 304:
+	case 327:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line grammar.y:1939
+//line grammar.y:2154
 		{
 			c := ast.Comprehension{
 				Target: tupleOrExpr(yyVAL.pos, yyDollar[2].exprs, yyDollar[2].comma),
@@ -3101,9 +3392,9 @@ yydefault:
 			setCtx(yylex, c.Target, ast.Store)
 			yyVAL.comprehensions = []ast.Comprehension{c}
 		}
-	case 305:
+	case 328:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line grammar.y:1948
+//line grammar.y:2163
 		{
 			c := ast.Comprehension{
 				Target: tupleOrExpr(yyVAL.pos, yyDollar[2].exprs, yyDollar[2].comma),
@@ -3114,36 +3405,36 @@ yydefault:
 			yyVAL.comprehensions = []ast.Comprehension{c}
 			yyVAL.comprehensions = append(yyVAL.comprehensions, yyDollar[5].comprehensions...)
 		}
-	case 306:
+	case 329:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1961
+//line grammar.y:2176
 		{
 			yyVAL.exprs = []ast.Expr{yyDollar[2].expr}
 			yyVAL.comprehensions = nil
 		}
-	case 307:
+	case 330:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1966
+//line grammar.y:2181
 		{
 			yyVAL.exprs = []ast.Expr{yyDollar[2].expr}
 			yyVAL.exprs = append(yyVAL.exprs, yyDollar[3].exprs...)
 			yyVAL.comprehensions = yyDollar[3].comprehensions
 		}
-	case 308:
+	case 331:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line grammar.y:1977
+//line grammar.y:2192
 		{
 			yyVAL.expr = &ast.Yield{ExprBase: ast.ExprBase{Pos: yyVAL.pos}}
 		}
-	case 309:
+	case 332:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line grammar.y:1981
+//line grammar.y:2196
 		{
 			yyVAL.expr = &ast.YieldFrom{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Value: yyDollar[3].expr}
 		}
-	case 310:
+	case 333:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line grammar.y:1985
+//line grammar.y:2200
 		{
 			yyVAL.expr = &ast.Yield{ExprBase: ast.ExprBase{Pos: yyVAL.pos}, Value: yyDollar[2].expr}
 		}
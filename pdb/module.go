@@ -0,0 +1,306 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Pdb module
+
+package pdb
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/go-python/gpython/py"
+	pysys "github.com/go-python/gpython/sys"
+	"github.com/go-python/gpython/vfs"
+	"github.com/go-python/gpython/vm"
+)
+
+const module_doc = `The Python Debugger Pdb
+
+To use the debugger in its simplest form:
+
+        >>> import pdb
+        >>> pdb.run('<a statement>')
+
+The debugger's prompt is '(Pdb) '.  This will stop in the first
+function call in <a statement>.
+
+Alternatively, if a statement terminated with an unhandled exception,
+you can use pdb's post-mortem facility to inspect the contents of the
+traceback.
+
+The commands recognized by the debugger are h(elp), w(here), d(own),
+u(p), b(reak), cl(ear), s(tep), n(ext), r(eturn), c(ont(inue)),
+l(ist), a(rgs), p, pp and q(uit).  Type help <command> at the prompt
+for more.  Any other input is executed as a python statement in the
+context of the current frame.
+
+The debugger can also be run on a script with
+
+        gpython -m pdb script.py`
+
+// The key the default debugger of a context is stored under
+type defaultKey struct{}
+
+// Returns the debugger used by the module functions in ctx, which
+// reads from its stdin and writes to its stdout, making it if
+// necessary
+func getDefault(ctx *py.Context) *Pdb {
+	p, ok := ctx.Value(defaultKey{}).(*Pdb)
+	if !ok {
+		in, out, _ := ctx.Stdio()
+		if in == nil {
+			in = strings.NewReader("")
+		}
+		if out == nil {
+			out = ioutil.Discard
+		}
+		p = New(in, out)
+		p.ctx = ctx
+		ctx.SetValue(defaultKey{}, p)
+	}
+	return p
+}
+
+// Returns the globals of __main__ in ctx for when none are passed in
+func mainGlobals(ctx *py.Context) py.StringDict {
+	if module, err := ctx.GetModule("__main__"); err == nil {
+		return module.Globals
+	}
+	return py.NewStringDict()
+}
+
+const set_trace_doc = `set_trace()
+
+Enter the debugger at the calling stack frame.`
+
+func pdb_set_trace(self py.Object) (py.Object, error) {
+	ctx := py.GetContext(self)
+	frame := vm.CurrentFrame(ctx)
+	if frame == nil {
+		return nil, py.ExceptionNewf(py.RuntimeError, "set_trace() called with no python frame running")
+	}
+	getDefault(ctx).SetTrace(frame)
+	return py.None, nil
+}
+
+const run_doc = `run(statement, globals=None, locals=None)
+
+Execute the statement (given as a string or a code object) under
+debugger control starting with the first line.`
+
+func pdb_run(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var statement py.Object
+	var globals, locals py.Object = py.None, py.None
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|OO:run", []string{"statement", "globals", "locals"}, &statement, &globals, &locals)
+	if err != nil {
+		return nil, err
+	}
+	var code *py.Code
+	switch x := statement.(type) {
+	case *py.Code:
+		code = x
+	case py.String:
+		obj, err := py.Compile(string(x), "<string>", "exec", 0, true)
+		if err != nil {
+			return nil, err
+		}
+		code = obj.(*py.Code)
+	default:
+		return nil, py.ExceptionNewf(py.TypeError, "run() arg 1 must be a string or code object")
+	}
+	ctx := py.GetContext(self)
+	globalsDict := mainGlobals(ctx)
+	if globals != py.None {
+		d, ok := globals.(py.StringDict)
+		if !ok {
+			return nil, py.ExceptionNewf(py.TypeError, "globals must be a dict")
+		}
+		globalsDict = d
+	}
+	localsDict := globalsDict
+	if locals != py.None {
+		d, ok := locals.(py.StringDict)
+		if !ok {
+			return nil, py.ExceptionNewf(py.TypeError, "locals must be a dict")
+		}
+		localsDict = d
+	}
+	err = getDefault(ctx).Run(code, globalsDict, localsDict)
+	if err != nil {
+		return nil, err
+	}
+	return py.None, nil
+}
+
+const runcall_doc = `runcall(function, *args, **kwds)
+
+Call the function with the given arguments under debugger control,
+returning whatever the function call returns.`
+
+func pdb_runcall(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	if len(args) < 1 {
+		return nil, py.ExceptionNewf(py.TypeError, "runcall() missing 1 required positional argument: 'function'")
+	}
+	return getDefault(py.GetContext(self)).RunCall(args[0], args[1:], kwargs)
+}
+
+const usage = `usage: pdb.py pyfile [arg] ...
+
+Debug the Python program given by pyfile.`
+
+// Main runs the script named by args[0] with arguments args[1:] in the
+// debugger, as "gpython -m pdb", returning the exit status
+//
+// The script is restarted when it finishes until the user quits.
+func Main(args []string) int {
+	return runMain(py.DefaultContext, args)
+}
+
+// Runs the script named by args[0] in the debugger in ctx - see Main
+func runMain(ctx *py.Context, args []string) int {
+	p := getDefault(ctx)
+	if len(args) == 0 || args[0] == "-h" || args[0] == "--help" {
+		p.message("%s", usage)
+		return 2
+	}
+	filename := args[0]
+	if _, err := ctx.FS().Stat(filename); err != nil {
+		p.message("Error: %s does not exist", filename)
+		return 1
+	}
+	filename = p.canonic(filename)
+	ctx.MustGetModule("sys").Globals["argv"] = pysys.MakeArgv(append([]string{filename}, args[1:]...))
+	for {
+		err := runScript(ctx, p, filename)
+		if p.quitting {
+			return 0
+		}
+		switch {
+		case err == nil:
+			p.message("The program finished and will be restarted")
+		case py.IsException(py.SystemExit, err):
+			p.message("The program exited via sys.exit(). Exit status: %s", exitStatus(err))
+		default:
+			pysys.ExceptHook(ctx, err)
+			p.message("Uncaught exception. Entering post mortem debugging")
+			p.message("Running 'cont' or 'step' will restart the program")
+			p.PostMortem(err)
+			if p.quitting {
+				return 0
+			}
+			p.message("Post mortem debugger finished. The %s will be restarted", filename)
+		}
+	}
+}
+
+const main_doc = `main()
+
+Debug the script named by sys.argv[1] passing it the arguments after
+that, as "gpython -m pdb" does.`
+
+func pdb_main(self py.Object) (py.Object, error) {
+	ctx := py.GetContext(self)
+	argv, err := py.SequenceTuple(ctx.MustGetModule("sys").Globals["argv"])
+	if err != nil {
+		return nil, err
+	}
+	var args []string
+	if len(argv) > 0 {
+		argv = argv[1:]
+	}
+	for _, arg := range argv {
+		s, ok := arg.(py.String)
+		if !ok {
+			return nil, py.ExceptionNewf(py.TypeError, "sys.argv must contain strings")
+		}
+		args = append(args, string(s))
+	}
+	status := runMain(ctx, args)
+	if status != 0 {
+		exc, err := py.ExceptionNew(py.SystemExit, py.Tuple{py.Int(status)}, nil)
+		if err != nil {
+			return nil, err
+		}
+		return nil, exc.(*py.Exception)
+	}
+	return py.None, nil
+}
+
+// Returns the status of a SystemExit as a string
+func exitStatus(err error) string {
+	exc, ok := exceptionValue(err).(*py.Exception)
+	if !ok {
+		return "None"
+	}
+	args, ok := exc.Args.(py.Tuple)
+	if !ok || len(args) == 0 {
+		return "None"
+	}
+	s, e := py.StrAsString(args[0])
+	if e != nil {
+		return "None"
+	}
+	return s
+}
+
+// Runs the script in filename as __main__ of ctx in the debugger
+func runScript(ctx *py.Context, p *Pdb, filename string) error {
+	source, err := vfs.ReadFile(ctx.FS(), filename)
+	if err != nil {
+		return err
+	}
+	obj, err := py.Compile(string(source), filename, "exec", 0, true)
+	if err != nil {
+		return err
+	}
+	module := ctx.NewModule("__main__", "", nil, nil)
+	module.Globals["__file__"] = py.String(filename)
+	return p.Run(obj.(*py.Code), module.Globals, module.Globals)
+}
+
+// PostMortem examines the frames in the traceback of err, stopping
+// in the innermost one
+func (p *Pdb) PostMortem(err error) {
+	exc, ok := err.(py.ExceptionInfo)
+	if !ok || exc.Traceback == nil {
+		p.error("A valid traceback must be passed if no exception is being handled")
+		return
+	}
+	p.reset()
+	var stack []stackEntry
+	for tb := exc.Traceback; tb != nil; tb = tb.Next {
+		stack = append(stack, stackEntry{frame: tb.Frame, lineno: int(tb.Lineno)})
+	}
+	_ = p.interaction(stack, nil)
+}
+
+const post_mortem_doc = `post_mortem()
+
+Enter post-mortem debugging of the exception being handled.`
+
+func pdb_post_mortem(self py.Object) (py.Object, error) {
+	ctx := py.GetContext(self)
+	exc := py.VmExcInfo(ctx)
+	if !exc.IsSet() {
+		return nil, py.ExceptionNewf(py.ValueError, "A valid traceback must be passed if no exception is being handled")
+	}
+	getDefault(ctx).PostMortem(exc)
+	return py.None, nil
+}
+
+// Initialise the module
+func init() {
+	methods := []*py.Method{
+		py.MustNewMethod("set_trace", pdb_set_trace, 0, set_trace_doc),
+		py.MustNewMethod("run", pdb_run, 0, run_doc),
+		py.MustNewMethod("runcall", pdb_runcall, 0, runcall_doc),
+		py.MustNewMethod("post_mortem", pdb_post_mortem, 0, post_mortem_doc),
+		py.MustNewMethod("main", pdb_main, 0, main_doc),
+	}
+	globals := py.StringDict{
+		"BdbQuit": BdbQuit,
+	}
+	py.NewModule("pdb", module_doc, methods, globals)
+}
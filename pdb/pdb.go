@@ -0,0 +1,948 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package pdb is an interactive step debugger for python code
+//
+// It implements a subset of the commands of python's pdb using the
+// trace hook of the vm.
+package pdb
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-python/gpython/py"
+	"github.com/go-python/gpython/vfs"
+	"github.com/go-python/gpython/vm"
+)
+
+// BdbQuit is raised in the program being debugged when the quit
+// command is used
+var BdbQuit = py.ExceptionType.NewType("BdbQuit", "Exception to give up completely.", nil, nil)
+
+// Breakpoint is a breakpoint set by line or by function
+type Breakpoint struct {
+	Number   int    // number of the breakpoint, starting from 1
+	File     string // canonical name of the file
+	Line     int    // line of the breakpoint, the def line for a function
+	Funcname string // name of the function if set by function
+	Hits     int    // number of times the breakpoint has been hit
+}
+
+// String returns where the breakpoint is as "file:line"
+func (bp *Breakpoint) String() string {
+	return fmt.Sprintf("%s:%d", bp.File, bp.Line)
+}
+
+// A frame on the stack being examined with the line it is on
+type stackEntry struct {
+	frame  *py.Frame
+	lineno int
+}
+
+// Pdb is a debugger reading commands from in and writing to out
+type Pdb struct {
+	in      *bufio.Reader
+	out     io.Writer
+	breaks  []*Breakpoint       // set breakpoints, nil when cleared
+	sources map[string][]string // lines of source files read by name
+	ctx     *py.Context         // context of the code being debugged
+
+	// Stepping state
+	botFrame    *py.Frame // outermost frame being debugged
+	stopFrame   *py.Frame // frame to stop in or nil to stop anywhere
+	returnFrame *py.Frame // frame whose return to stop at
+	continuing  bool      // set to only stop at breakpoints
+	quitting    bool      // set when the quit command is used
+
+	// State while stopped
+	stack    []stackEntry // frames oldest first
+	curIndex int          // index of the selected frame in stack
+	retval   py.Object    // value being returned or nil
+	lastCmd  string       // last command for repeating with an empty line
+	lineno   int          // last line listed or 0
+}
+
+// New makes a Pdb which reads commands from in and writes to out
+func New(in io.Reader, out io.Writer) *Pdb {
+	return &Pdb{
+		in:      bufio.NewReader(in),
+		out:     out,
+		sources: make(map[string][]string),
+	}
+}
+
+// Writes a line of output
+func (p *Pdb) message(format string, args ...interface{}) {
+	fmt.Fprintf(p.out, format+"\n", args...)
+}
+
+// Writes a line of output marked as an error
+func (p *Pdb) error(format string, args ...interface{}) {
+	p.message("*** "+format, args...)
+}
+
+// Sets the state for stepping from the start
+func (p *Pdb) reset() {
+	p.botFrame = nil
+	p.stopFrame = nil
+	p.returnFrame = nil
+	p.continuing = false
+	p.quitting = false
+	p.forget()
+}
+
+// Forgets the state of the last stop
+func (p *Pdb) forget() {
+	p.stack = nil
+	p.curIndex = 0
+	p.retval = nil
+	p.lineno = 0
+}
+
+// Run runs code in the debugger stopping before its first line
+//
+// It returns any exception the code raised, except BdbQuit which
+// means the user quit the debugger.
+func (p *Pdb) Run(code *py.Code, globals, locals py.StringDict) error {
+	p.reset()
+	p.ctx = py.GlobalsContext(globals)
+	old := vm.GetTrace(p.ctx)
+	vm.SetTrace(p.ctx, p.trace)
+	defer vm.SetTrace(p.ctx, old)
+	_, err := vm.EvalCode(code, globals, locals)
+	if py.IsException(BdbQuit, err) {
+		return nil
+	}
+	return err
+}
+
+// RunCall calls fn in the debugger stopping before its first line
+//
+// Errors are returned as for Run.
+func (p *Pdb) RunCall(fn py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	p.reset()
+	p.ctx = callContext(fn)
+	old := vm.GetTrace(p.ctx)
+	vm.SetTrace(p.ctx, p.trace)
+	defer vm.SetTrace(p.ctx, old)
+	res, err := py.Call(fn, args, kwargs)
+	if py.IsException(BdbQuit, err) {
+		return py.None, nil
+	}
+	return res, err
+}
+
+// SetTrace starts debugging frame, stopping at the next line it runs
+func (p *Pdb) SetTrace(frame *py.Frame) {
+	p.reset()
+	p.botFrame = frame
+	for p.botFrame != nil && p.botFrame.Back != nil {
+		p.botFrame = p.botFrame.Back
+	}
+	p.ctx = frame.Context
+	vm.SetTrace(p.ctx, p.trace)
+}
+
+// Returns the context fn runs its code in
+func callContext(fn py.Object) *py.Context {
+	switch x := fn.(type) {
+	case *py.Function:
+		return py.GlobalsContext(x.Globals)
+	case *py.BoundMethod:
+		return callContext(x.Method)
+	}
+	return py.DefaultContext
+}
+
+// Returns the filesystem of the code being debugged
+func (p *Pdb) fs() vfs.FS {
+	if p.ctx == nil {
+		return py.DefaultContext.FS()
+	}
+	return p.ctx.FS()
+}
+
+// Returns the canonical form of a filename
+func (p *Pdb) canonic(filename string) string {
+	if strings.HasPrefix(filename, "<") && strings.HasSuffix(filename, ">") {
+		return filename
+	}
+	abs, err := vfs.Abs(p.fs(), filename)
+	if err != nil {
+		return filename
+	}
+	return abs
+}
+
+// Returns the lines of a source file or nil if it can't be read
+func (p *Pdb) getLines(filename string) []string {
+	if lines, ok := p.sources[filename]; ok {
+		return lines
+	}
+	var lines []string
+	data, err := vfs.ReadFile(p.fs(), filename)
+	if err == nil {
+		lines = strings.SplitAfter(string(data), "\n")
+		if lines[len(lines)-1] == "" {
+			lines = lines[:len(lines)-1]
+		}
+	}
+	p.sources[filename] = lines
+	return lines
+}
+
+// Returns line lineno of a source file or "" if not found
+func (p *Pdb) getLine(filename string, lineno int) string {
+	lines := p.getLines(filename)
+	if lineno < 1 || lineno > len(lines) {
+		return ""
+	}
+	return lines[lineno-1]
+}
+
+// Returns the line a frame not at the top of the stack is on
+//
+// Its Lasti is just past the instruction it is running.
+func callerLineno(frame *py.Frame) int {
+	return int(frame.Code.Addr2Line(frame.Lasti - 1))
+}
+
+// Returns the stack from botFrame to frame which is on line lineno
+func (p *Pdb) getStack(frame *py.Frame, lineno int) []stackEntry {
+	stack := []stackEntry{{frame: frame, lineno: lineno}}
+	for frame != p.botFrame && frame.Back != nil {
+		frame = frame.Back
+		stack = append(stack, stackEntry{frame: frame, lineno: callerLineno(frame)})
+	}
+	for i, j := 0, len(stack)-1; i < j; i, j = i+1, j-1 {
+		stack[i], stack[j] = stack[j], stack[i]
+	}
+	return stack
+}
+
+// Returns whether the debugger should stop in frame because of
+// stepping
+func (p *Pdb) stopHere(frame *py.Frame) bool {
+	if p.continuing {
+		return false
+	}
+	return p.stopFrame == nil || frame == p.stopFrame
+}
+
+// Returns the breakpoint frame is stopped at on line lineno or nil
+func (p *Pdb) breakHere(frame *py.Frame, lineno int) *Breakpoint {
+	if len(p.breaks) == 0 {
+		return nil
+	}
+	filename := p.canonic(frame.Code.Filename)
+	for _, bp := range p.breaks {
+		if bp == nil || bp.File != filename {
+			continue
+		}
+		if bp.Funcname == "" {
+			if bp.Line == lineno {
+				return bp
+			}
+		} else if bp.Funcname == frame.Code.Name && bp.Line == int(frame.Code.Firstlineno) && frame.Lasti == 0 {
+			// Function breakpoints stop at the first line run
+			return bp
+		}
+	}
+	return nil
+}
+
+// Returns whether any breakpoints are set
+func (p *Pdb) haveBreaks() bool {
+	for _, bp := range p.breaks {
+		if bp != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// The vm.TraceFunc which implements stepping and breakpoints
+func (p *Pdb) trace(frame *py.Frame, event string, arg py.Object) error {
+	if p.botFrame == nil {
+		// Start stepping at the first line of the first frame
+		p.botFrame = frame
+		return nil
+	}
+	var err error
+	switch event {
+	case vm.TraceCall:
+		if p.stopHere(frame) && frame.Lasti == 0 {
+			p.message("--Call--")
+			err = p.interaction(p.getStack(frame, int(frame.Code.Firstlineno)), nil)
+		}
+	case vm.TraceLine:
+		lineno := int(frame.Code.Addr2Line(frame.Lasti))
+		bp := p.breakHere(frame, lineno)
+		if bp != nil {
+			bp.Hits++
+		}
+		if bp != nil || p.stopHere(frame) {
+			err = p.interaction(p.getStack(frame, lineno), nil)
+		}
+	case vm.TraceReturn:
+		if p.stopHere(frame) || frame == p.returnFrame {
+			p.message("--Return--")
+			err = p.interaction(p.getStack(frame, callerLineno(frame)), arg)
+		}
+		if frame == p.stopFrame && !p.continuing {
+			// Carry on stepping in the caller
+			p.stopFrame = nil
+		}
+	}
+	return err
+}
+
+// Stops at the top of stack and runs commands until one resumes
+// the program
+func (p *Pdb) interaction(stack []stackEntry, retval py.Object) error {
+	p.stack = stack
+	p.curIndex = len(stack) - 1
+	p.retval = retval
+	p.printStackEntry(p.curIndex, "> ")
+	defer p.forget()
+	for {
+		fmt.Fprint(p.out, "(Pdb) ")
+		line, err := p.in.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if err == io.EOF && line == "" {
+			line = "EOF"
+		}
+		resume, err := p.onecmd(strings.TrimRight(line, "\r\n"))
+		if resume {
+			return err
+		}
+	}
+}
+
+// Returns the selected frame
+func (p *Pdb) curFrame() *py.Frame {
+	return p.stack[p.curIndex].frame
+}
+
+// Formats a stack entry as "file(line)function()" with the return
+// value and the source line
+func (p *Pdb) formatStackEntry(i int) string {
+	entry := p.stack[i]
+	code := entry.frame.Code
+	name := code.Name
+	if name == "" {
+		name = "<lambda>"
+	}
+	s := fmt.Sprintf("%s(%d)%s()", p.canonic(code.Filename), entry.lineno, name)
+	if p.retval != nil && i == len(p.stack)-1 {
+		repr, err := py.ReprAsString(p.retval)
+		if err != nil {
+			repr = "<unprintable>"
+		}
+		s += "->" + repr
+	}
+	if line := strings.TrimSpace(p.getLine(p.canonic(code.Filename), entry.lineno)); line != "" {
+		s += "\n-> " + line
+	}
+	return s
+}
+
+// Prints a stack entry with prefix
+func (p *Pdb) printStackEntry(i int, prefix string) {
+	p.message("%s%s", prefix, p.formatStackEntry(i))
+}
+
+// A debugger command
+//
+// It returns true if the program should resume.
+type command struct {
+	names []string
+	fn    func(p *Pdb, arg string) (bool, error)
+	doc   string
+}
+
+var commands []*command
+
+// Looks up a command by name
+func lookupCommand(name string) *command {
+	for _, cmd := range commands {
+		for _, cmdName := range cmd.names {
+			if cmdName == name {
+				return cmd
+			}
+		}
+	}
+	return nil
+}
+
+// Matches a command name at the start of a line
+var commandRe = regexp.MustCompile(`^([A-Za-z_]*)\s*(.*)$`)
+
+// Runs the command in line, returning true if the program should
+// resume
+func (p *Pdb) onecmd(line string) (bool, error) {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		line = p.lastCmd
+		if line == "" {
+			return false, nil
+		}
+	} else if line != "EOF" {
+		p.lastCmd = line
+	}
+	if strings.HasPrefix(line, "!") {
+		return p.exec(line[1:])
+	}
+	m := commandRe.FindStringSubmatch(line)
+	if m != nil && m[1] != "" {
+		if cmd := lookupCommand(m[1]); cmd != nil {
+			return cmd.fn(p, m[2])
+		}
+	}
+	return p.exec(line)
+}
+
+// Returns the value of an exception stored as an error
+func exceptionValue(err error) py.Object {
+	switch x := err.(type) {
+	case py.ExceptionInfo:
+		return x.Value
+	case *py.Exception:
+		return x
+	}
+	return py.MakeException(err)
+}
+
+// Formats the error as "Type: message"
+func formatError(err error) string {
+	exc := exceptionValue(err)
+	name := exc.Type().Name
+	var msg py.Object = exc
+	if e, ok := exc.(*py.Exception); ok {
+		args, _ := e.Args.(py.Tuple)
+		switch len(args) {
+		case 0:
+			return name
+		case 1:
+			msg = args[0]
+		default:
+			msg = args
+		}
+	}
+	s, e := py.StrAsString(msg)
+	if e != nil || s == "" {
+		return name
+	}
+	return name + ": " + s
+}
+
+// Returns the locals of the selected frame
+func (p *Pdb) locals() py.StringDict {
+	frame := p.curFrame()
+	if frame.Code.Nlocals != 0 {
+		frame.FastToLocals()
+	}
+	if frame.Locals == nil {
+		return frame.Globals
+	}
+	return frame.Locals
+}
+
+// Evaluates expr in the selected frame
+func (p *Pdb) eval(expr string) (py.Object, error) {
+	code, err := py.Compile(expr, "<stdin>", "eval", 0, true)
+	if err != nil {
+		return nil, err
+	}
+	frame := p.curFrame()
+	return vm.EvalCode(code.(*py.Code), frame.Globals, p.locals())
+}
+
+// Runs a python statement in the selected frame, printing the value
+// if it is an expression
+func (p *Pdb) exec(stmt string) (bool, error) {
+	if code, err := py.Compile(stmt, "<stdin>", "eval", 0, true); err == nil {
+		frame := p.curFrame()
+		res, err := vm.EvalCode(code.(*py.Code), frame.Globals, p.locals())
+		if err != nil {
+			p.error("%s", formatError(err))
+		} else if res != py.None {
+			p.printRepr(res)
+		}
+		return false, nil
+	}
+	code, err := py.Compile(stmt+"\n", "<stdin>", "exec", 0, true)
+	if err != nil {
+		p.error("%s", formatError(err))
+		return false, nil
+	}
+	frame := p.curFrame()
+	_, err = vm.EvalCode(code.(*py.Code), frame.Globals, p.locals())
+	if frame.Code.Nlocals != 0 {
+		frame.LocalsToFast(true)
+	}
+	if err != nil {
+		p.error("%s", formatError(err))
+	}
+	return false, nil
+}
+
+// Prints the repr of obj
+func (p *Pdb) printRepr(obj py.Object) {
+	repr, err := py.ReprAsString(obj)
+	if err != nil {
+		p.error("%s", formatError(err))
+		return
+	}
+	p.message("%s", repr)
+}
+
+func do_help(p *Pdb, arg string) (bool, error) {
+	if arg != "" {
+		cmd := lookupCommand(arg)
+		if cmd == nil {
+			p.error("No help on %s", arg)
+			return false, nil
+		}
+		p.message("%s", cmd.doc)
+		return false, nil
+	}
+	p.message("Commands (type help <command>):")
+	for _, cmd := range commands {
+		p.message("  %s", strings.Join(cmd.names, ", "))
+	}
+	return false, nil
+}
+
+func do_step(p *Pdb, arg string) (bool, error) {
+	p.stopFrame = nil
+	p.returnFrame = nil
+	p.continuing = false
+	return true, nil
+}
+
+func do_next(p *Pdb, arg string) (bool, error) {
+	p.stopFrame = p.stack[len(p.stack)-1].frame
+	p.returnFrame = nil
+	p.continuing = false
+	return true, nil
+}
+
+func do_return(p *Pdb, arg string) (bool, error) {
+	frame := p.stack[len(p.stack)-1].frame
+	p.stopFrame = frame.Back
+	p.returnFrame = frame
+	p.continuing = false
+	if p.stopFrame == nil {
+		// Don't stop anywhere other than the return
+		p.continuing = true
+	}
+	return true, nil
+}
+
+func do_continue(p *Pdb, arg string) (bool, error) {
+	p.stopFrame = nil
+	p.returnFrame = nil
+	p.continuing = true
+	if !p.haveBreaks() {
+		// Nothing to stop at so run at full speed
+		vm.SetTrace(p.ctx, nil)
+	}
+	return true, nil
+}
+
+func do_quit(p *Pdb, arg string) (bool, error) {
+	p.quitting = true
+	vm.SetTrace(p.ctx, nil)
+	return true, py.ExceptionNewf(BdbQuit, "")
+}
+
+func do_EOF(p *Pdb, arg string) (bool, error) {
+	p.message("")
+	return do_quit(p, arg)
+}
+
+// Parses an optional count for up and down
+func (p *Pdb) parseCount(arg string) (int, bool) {
+	if arg == "" {
+		return 1, true
+	}
+	count, err := strconv.Atoi(arg)
+	if err != nil {
+		p.error("Invalid frame count (%s)", arg)
+		return 0, false
+	}
+	return count, true
+}
+
+// Selects frame i
+func (p *Pdb) selectFrame(i int) {
+	p.curIndex = i
+	p.lineno = 0
+	p.printStackEntry(i, "> ")
+}
+
+func do_up(p *Pdb, arg string) (bool, error) {
+	if p.curIndex == 0 {
+		p.error("Oldest frame")
+		return false, nil
+	}
+	count, ok := p.parseCount(arg)
+	if !ok {
+		return false, nil
+	}
+	i := p.curIndex - count
+	if count < 0 || i < 0 {
+		i = 0
+	}
+	p.selectFrame(i)
+	return false, nil
+}
+
+func do_down(p *Pdb, arg string) (bool, error) {
+	if p.curIndex+1 == len(p.stack) {
+		p.error("Newest frame")
+		return false, nil
+	}
+	count, ok := p.parseCount(arg)
+	if !ok {
+		return false, nil
+	}
+	i := p.curIndex + count
+	if count < 0 || i >= len(p.stack) {
+		i = len(p.stack) - 1
+	}
+	p.selectFrame(i)
+	return false, nil
+}
+
+func do_where(p *Pdb, arg string) (bool, error) {
+	for i := range p.stack {
+		prefix := "  "
+		if i == p.curIndex {
+			prefix = "> "
+		}
+		p.printStackEntry(i, prefix)
+	}
+	return false, nil
+}
+
+func do_p(p *Pdb, arg string) (bool, error) {
+	res, err := p.eval(arg)
+	if err != nil {
+		p.error("%s", formatError(err))
+		return false, nil
+	}
+	p.printRepr(res)
+	return false, nil
+}
+
+func do_args(p *Pdb, arg string) (bool, error) {
+	code := p.curFrame().Code
+	n := int(code.Argcount + code.Kwonlyargcount)
+	if code.Flags&py.CO_VARARGS != 0 {
+		n++
+	}
+	if code.Flags&py.CO_VARKEYWORDS != 0 {
+		n++
+	}
+	locals := p.locals()
+	for _, name := range code.Varnames[:n] {
+		if value, ok := locals[name]; ok {
+			repr, err := py.ReprAsString(value)
+			if err != nil {
+				repr = "<unprintable>"
+			}
+			p.message("%s = %s", name, repr)
+		} else {
+			p.message("%s = *** undefined ***", name)
+		}
+	}
+	return false, nil
+}
+
+// Matches the def of a function
+func funcDefRe(funcname string) *regexp.Regexp {
+	return regexp.MustCompile(`^\s*def\s+` + regexp.QuoteMeta(funcname) + `\s*\(`)
+}
+
+// Works out the file, line and function name a break command refers
+// to, returning false after printing an error if it can't
+func (p *Pdb) parseBreak(arg string) (filename string, lineno int, funcname string, ok bool) {
+	filename = p.canonic(p.curFrame().Code.Filename)
+	where := arg
+	if i := strings.LastIndex(arg, ":"); i >= 0 {
+		file := strings.TrimSpace(arg[:i])
+		where = strings.TrimSpace(arg[i+1:])
+		if !strings.HasSuffix(file, ".py") && p.getLines(p.canonic(file)) == nil {
+			file += ".py"
+		}
+		filename = p.canonic(file)
+		if p.getLines(filename) == nil {
+			p.error("'%s' not found", file)
+			return "", 0, "", false
+		}
+	}
+	lineno, err := strconv.Atoi(where)
+	if err == nil {
+		return filename, lineno, "", true
+	}
+	if filename == p.canonic(p.curFrame().Code.Filename) {
+		// Look the function up in the selected frame
+		if obj, err := p.eval(where); err == nil {
+			if fn, ok := obj.(*py.Function); ok {
+				return p.canonic(fn.Code.Filename), int(fn.Code.Firstlineno), fn.Code.Name, true
+			}
+		}
+	}
+	// Otherwise look for its definition in the source
+	name := where
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	re := funcDefRe(name)
+	for i, line := range p.getLines(filename) {
+		if re.MatchString(line) {
+			return filename, i + 1, name, true
+		}
+	}
+	p.error("The specified object '%s' is not a function or was not found along sys.path.", where)
+	return "", 0, "", false
+}
+
+// Prints the table of breakpoints
+func (p *Pdb) printBreaks() {
+	if !p.haveBreaks() {
+		return
+	}
+	p.message("Num Type         Disp Enb   Where")
+	for _, bp := range p.breaks {
+		if bp == nil {
+			continue
+		}
+		p.message("%-4dbreakpoint   keep yes   at %s", bp.Number, bp)
+		if bp.Hits == 1 {
+			p.message("\tbreakpoint already hit 1 time")
+		} else if bp.Hits > 1 {
+			p.message("\tbreakpoint already hit %d times", bp.Hits)
+		}
+	}
+}
+
+func do_break(p *Pdb, arg string) (bool, error) {
+	if arg == "" {
+		p.printBreaks()
+		return false, nil
+	}
+	filename, lineno, funcname, ok := p.parseBreak(arg)
+	if !ok {
+		return false, nil
+	}
+	if funcname == "" {
+		lines := p.getLines(filename)
+		if lineno < 1 || lineno > len(lines) {
+			p.message("End of file")
+			return false, nil
+		}
+		line := strings.TrimSpace(lines[lineno-1])
+		if line == "" || strings.HasPrefix(line, "#") {
+			p.error("Blank or comment")
+			return false, nil
+		}
+	}
+	_, err := p.SetBreak(filename, lineno, funcname)
+	if err != nil {
+		p.error("%v", err)
+	}
+	return false, nil
+}
+
+// SetBreak sets a breakpoint at filename:lineno, or on the function
+// funcname defined at that line if funcname isn't empty
+func (p *Pdb) SetBreak(filename string, lineno int, funcname string) (*Breakpoint, error) {
+	if lineno < 1 {
+		return nil, fmt.Errorf("line %d out of range", lineno)
+	}
+	bp := &Breakpoint{
+		Number:   len(p.breaks) + 1,
+		File:     p.canonic(filename),
+		Line:     lineno,
+		Funcname: funcname,
+	}
+	p.breaks = append(p.breaks, bp)
+	p.message("Breakpoint %d at %s", bp.Number, bp)
+	return bp, nil
+}
+
+// Clears a breakpoint
+func (p *Pdb) clearBreak(bp *Breakpoint) {
+	p.breaks[bp.Number-1] = nil
+	p.message("Deleted breakpoint %d at %s", bp.Number, bp)
+}
+
+func do_clear(p *Pdb, arg string) (bool, error) {
+	if arg == "" {
+		fmt.Fprint(p.out, "Clear all breaks? ")
+		reply, _ := p.in.ReadString('\n')
+		reply = strings.ToLower(strings.TrimSpace(reply))
+		if reply == "y" || reply == "yes" {
+			for _, bp := range p.breaks {
+				if bp != nil {
+					p.clearBreak(bp)
+				}
+			}
+		}
+		return false, nil
+	}
+	for _, field := range strings.Fields(arg) {
+		n, err := strconv.Atoi(field)
+		if err != nil {
+			p.error("Non-numeric breakpoint number %s", field)
+			continue
+		}
+		if n < 1 || n > len(p.breaks) {
+			p.error("Breakpoint number %d out of range", n)
+			continue
+		}
+		if p.breaks[n-1] == nil {
+			p.error("Breakpoint %d already deleted", n)
+			continue
+		}
+		p.clearBreak(p.breaks[n-1])
+	}
+	return false, nil
+}
+
+func do_list(p *Pdb, arg string) (bool, error) {
+	entry := p.stack[p.curIndex]
+	filename := p.canonic(entry.frame.Code.Filename)
+	var first, last int
+	switch {
+	case arg == "" && p.lineno != 0:
+		first = p.lineno + 1
+	case arg == "" || arg == ".":
+		first = entry.lineno - 5
+	default:
+		parts := strings.SplitN(arg, ",", 2)
+		var err error
+		first, err = strconv.Atoi(strings.TrimSpace(parts[0]))
+		if err == nil && len(parts) == 2 {
+			last, err = strconv.Atoi(strings.TrimSpace(parts[1]))
+			if last < first {
+				// A count rather than a line
+				last += first
+			}
+		} else {
+			first -= 5
+		}
+		if err != nil {
+			p.error("Error in argument: %q", arg)
+			return false, nil
+		}
+	}
+	if first < 1 {
+		first = 1
+	}
+	if last == 0 {
+		last = first + 10
+	}
+	lines := p.getLines(filename)
+	for lineno := first; lineno <= last; lineno++ {
+		if lineno > len(lines) {
+			p.message("[EOF]")
+			break
+		}
+		s := fmt.Sprintf("%3d", lineno)
+		if len(s) < 4 {
+			s += " "
+		}
+		if p.hasBreakAt(filename, lineno) {
+			s += "B"
+		} else {
+			s += " "
+		}
+		if lineno == entry.lineno {
+			s += "->"
+		}
+		p.message("%s\t%s", s, strings.TrimRight(lines[lineno-1], " \t\r\n"))
+		p.lineno = lineno
+	}
+	return false, nil
+}
+
+// Returns whether there is a breakpoint on filename:lineno
+func (p *Pdb) hasBreakAt(filename string, lineno int) bool {
+	for _, bp := range p.breaks {
+		if bp != nil && bp.File == filename && bp.Line == lineno {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	commands = []*command{
+		{[]string{"h", "help"}, do_help, `h(elp) [command]
+        Without argument, print the list of available commands.
+        With a command name as argument, print help about that command.`},
+		{[]string{"w", "where", "bt"}, do_where, `w(here)
+        Print a stack trace, with the most recent frame at the bottom.
+        An arrow indicates the "current frame", which determines the
+        context of most commands.`},
+		{[]string{"d", "down"}, do_down, `d(own) [count]
+        Move the current frame count (default one) levels down in the
+        stack trace (to a newer frame).`},
+		{[]string{"u", "up"}, do_up, `u(p) [count]
+        Move the current frame count (default one) levels up in the
+        stack trace (to an older frame).`},
+		{[]string{"b", "break"}, do_break, `b(reak) [ ([filename:]lineno | function) ]
+        Without argument, list all breaks.
+
+        With a line number argument, set a break at this line in the
+        current file.  With a function name, set a break at the first
+        executable line of that function.  The line number may be
+        prefixed with a filename and a colon, to specify a breakpoint
+        in another file.`},
+		{[]string{"cl", "clear"}, do_clear, `cl(ear) [bpnumber [bpnumber...]]
+        With a space separated list of breakpoint numbers, clear
+        those breakpoints.  Without argument, clear all breaks (but
+        first ask confirmation).`},
+		{[]string{"s", "step"}, do_step, `s(tep)
+        Execute the current line, stop at the first possible occasion
+        (either in a function that is called or in the current
+        function).`},
+		{[]string{"n", "next"}, do_next, `n(ext)
+        Continue execution until the next line in the current function
+        is reached or it returns.`},
+		{[]string{"r", "return"}, do_return, `r(eturn)
+        Continue execution until the current function returns.`},
+		{[]string{"c", "cont", "continue"}, do_continue, `c(ont(inue))
+        Continue execution, only stop when a breakpoint is encountered.`},
+		{[]string{"l", "list"}, do_list, `l(ist) [first [,last] | .]
+        List source code for the current file.  Without arguments,
+        list 11 lines around the current line or continue the previous
+        listing.  With . as argument, list 11 lines around the current
+        line.  With one argument, list 11 lines starting at that line.
+        With two arguments, list the given range; if the second
+        argument is less than the first, it is a count.`},
+		{[]string{"a", "args"}, do_args, `a(rgs)
+        Print the argument list of the current function.`},
+		{[]string{"p"}, do_p, `p expression
+        Print the value of the expression.`},
+		{[]string{"pp"}, do_p, `pp expression
+        Print the value of the expression.`},
+		{[]string{"q", "quit", "exit"}, do_quit, `q(uit)
+exit
+        Quit from the debugger. The program being executed is aborted.`},
+		{[]string{"EOF"}, do_EOF, `EOF
+        Handles the receipt of EOF as a command.`},
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pdb_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	_ "github.com/go-python/gpython/builtin"
+	"github.com/go-python/gpython/compile"
+	"github.com/go-python/gpython/pdb"
+	"github.com/go-python/gpython/py"
+	_ "github.com/go-python/gpython/sys"
+)
+
+const stepScript = `def g(n):
+    m = n * 3
+    return m
+
+def h():
+    return g(2) + 1
+
+x = h()
+`
+
+const breakScript = `def f(a):
+    b = a + 1
+    return b * 2
+
+x = 1
+y = f(x)
+for i in range(2):
+    x += i
+z = x, y
+`
+
+// The output was checked against python 3 with its own frames
+// removed from the stack listings
+var pdbTests = []struct {
+	name     string
+	script   string
+	commands string
+	out      string
+}{
+	{
+		name:     "step",
+		script:   stepScript,
+		commands: "s\ns\ns\ns\ns\ns\ns\ns\ns\n\nq\n",
+		out: `> FILE(1)<module>()
+-> def g(n):
+(Pdb) > FILE(5)<module>()
+-> def h():
+(Pdb) > FILE(8)<module>()
+-> x = h()
+(Pdb) --Call--
+> FILE(5)h()
+-> def h():
+(Pdb) > FILE(6)h()
+-> return g(2) + 1
+(Pdb) --Call--
+> FILE(1)g()
+-> def g(n):
+(Pdb) > FILE(2)g()
+-> m = n * 3
+(Pdb) > FILE(3)g()
+-> return m
+(Pdb) --Return--
+> FILE(3)g()->6
+-> return m
+(Pdb) --Return--
+> FILE(6)h()->7
+-> return g(2) + 1
+(Pdb) --Return--
+> FILE(8)<module>()->None
+-> x = h()
+(Pdb) `,
+	},
+	{
+		name:     "next",
+		script:   stepScript,
+		commands: "n\nn\nn\nn\n",
+		out: `> FILE(1)<module>()
+-> def g(n):
+(Pdb) > FILE(5)<module>()
+-> def h():
+(Pdb) > FILE(8)<module>()
+-> x = h()
+(Pdb) --Return--
+> FILE(8)<module>()->None
+-> x = h()
+(Pdb) `,
+	},
+	{
+		name:     "return",
+		script:   stepScript,
+		commands: "n\nn\ns\ns\nr\nn\np x\nn\n",
+		out: `> FILE(1)<module>()
+-> def g(n):
+(Pdb) > FILE(5)<module>()
+-> def h():
+(Pdb) > FILE(8)<module>()
+-> x = h()
+(Pdb) --Call--
+> FILE(5)h()
+-> def h():
+(Pdb) > FILE(6)h()
+-> return g(2) + 1
+(Pdb) --Return--
+> FILE(6)h()->7
+-> return g(2) + 1
+(Pdb) --Return--
+> FILE(8)<module>()->None
+-> x = h()
+(Pdb) 7
+(Pdb) `,
+	},
+	{
+		name:     "breakpoints",
+		script:   breakScript,
+		commands: "l\nb f\nb 8\nb\nc\nw\np a\nu\np x\nd\nn\nn\nn\ns\np y\nc\np i\nc\n",
+		out: `> FILE(1)<module>()
+-> def f(a):
+(Pdb)   1  ->	def f(a):
+  2  	    b = a + 1
+  3  	    return b * 2
+  4  	
+  5  	x = 1
+  6  	y = f(x)
+  7  	for i in range(2):
+  8  	    x += i
+  9  	z = x, y
+[EOF]
+(Pdb) Breakpoint 1 at FILE:1
+(Pdb) Breakpoint 2 at FILE:8
+(Pdb) Num Type         Disp Enb   Where
+1   breakpoint   keep yes   at FILE:1
+2   breakpoint   keep yes   at FILE:8
+(Pdb) > FILE(2)f()
+-> b = a + 1
+(Pdb)   FILE(6)<module>()
+-> y = f(x)
+> FILE(2)f()
+-> b = a + 1
+(Pdb) 1
+(Pdb) > FILE(6)<module>()
+-> y = f(x)
+(Pdb) 1
+(Pdb) > FILE(2)f()
+-> b = a + 1
+(Pdb) > FILE(3)f()
+-> return b * 2
+(Pdb) --Return--
+> FILE(3)f()->4
+-> return b * 2
+(Pdb) > FILE(7)<module>()
+-> for i in range(2):
+(Pdb) > FILE(8)<module>()
+-> x += i
+(Pdb) 4
+(Pdb) > FILE(8)<module>()
+-> x += i
+(Pdb) 1
+(Pdb) `,
+	},
+	{
+		name:     "errors",
+		script:   breakScript,
+		commands: "n\np nope\n1/0\nb nosuch\nb 99\nb 4\ncl x\ncl 3\nup\ndown\nq\n",
+		out: `> FILE(1)<module>()
+-> def f(a):
+(Pdb) > FILE(5)<module>()
+-> x = 1
+(Pdb) *** NameError: name 'nope' is not defined
+(Pdb) *** ZeroDivisionError: division by zero
+(Pdb) *** The specified object 'nosuch' is not a function or was not found along sys.path.
+(Pdb) End of file
+(Pdb) *** Blank or comment
+(Pdb) *** Non-numeric breakpoint number x
+(Pdb) *** Breakpoint number 3 out of range
+(Pdb) *** Oldest frame
+(Pdb) *** Newest frame
+(Pdb) `,
+	},
+	{
+		name:     "statements",
+		script:   breakScript,
+		commands: "b 3\nc\n!b = 10\n!a = 5\na\np b\nb\nc\n",
+		out: `> FILE(1)<module>()
+-> def f(a):
+(Pdb) Breakpoint 1 at FILE:3
+(Pdb) > FILE(3)f()
+-> return b * 2
+(Pdb) (Pdb) (Pdb) a = 5
+(Pdb) 10
+(Pdb) Num Type         Disp Enb   Where
+1   breakpoint   keep yes   at FILE:3
+	breakpoint already hit 1 time
+(Pdb) `,
+	},
+}
+
+func TestPdb(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "t.py")
+	for _, test := range pdbTests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ioutil.WriteFile(filename, []byte(test.script), 0666)
+			if err != nil {
+				t.Fatal(err)
+			}
+			obj, err := compile.Compile(test.script, filename, "exec", 0, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var out bytes.Buffer
+			p := pdb.New(strings.NewReader(test.commands), &out)
+			module := py.DefaultContext.NewModule("__main__", "", nil, nil)
+			err = p.Run(obj.(*py.Code), module.Globals, module.Globals)
+			if err != nil {
+				t.Fatalf("Run failed: %v", err)
+			}
+			got := strings.Replace(out.String(), filename, "FILE", -1)
+			if got != test.out {
+				t.Errorf("got\n%s\nwant\n%s", got, test.out)
+			}
+		})
+	}
+}
@@ -447,13 +447,22 @@ func ParseTupleAndKeywords(args Tuple, kwargs StringDict, format string, kwlist
 				return ExceptionNewf(TypeError, "%s() got multiple values for argument '%s'", name, kw)
 			}
 			args = append(args, value)
-		} else if keywordOnly {
+		} else if keywordOnly || len(args) <= i {
+			// Leave a gap for missing arguments so later
+			// keyword arguments end up in the right place
 			args = append(args, nil)
 		}
 	}
 	for i, arg := range args {
 		op := ops[i]
 		result := results[i]
+		if arg == nil && !keywordOnly {
+			if i < min && i < len(kwlist) {
+				return ExceptionNewf(TypeError, "%s() missing required argument '%s' (pos %d)", name, kwlist[i], i+1)
+			}
+			// Optional argument not supplied - leave default
+			continue
+		}
 		switch op {
 		case "O":
 			*result = arg
@@ -0,0 +1,50 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package py
+
+import "testing"
+
+func TestParseTupleAndKeywords(t *testing.T) {
+	for _, test := range []struct {
+		args    Tuple
+		kwargs  StringDict
+		want    Tuple
+		wantErr string
+	}{
+		{args: Tuple{Int(1)}, want: Tuple{Int(1), String("b"), String("c")}},
+		{args: Tuple{Int(1), Int(2), Int(3)}, want: Tuple{Int(1), Int(2), Int(3)}},
+		{args: Tuple{Int(1)}, kwargs: StringDict{"b": Int(2)}, want: Tuple{Int(1), Int(2), String("c")}},
+		// keyword argument after a missing optional argument
+		{args: Tuple{Int(1)}, kwargs: StringDict{"c": Int(3)}, want: Tuple{Int(1), String("b"), Int(3)}},
+		{kwargs: StringDict{"a": Int(1), "c": Int(3)}, want: Tuple{Int(1), String("b"), Int(3)}},
+		// missing required argument
+		{kwargs: StringDict{"b": Int(2), "c": Int(3)}, wantErr: "f() missing required argument 'a' (pos 1)"},
+		{wantErr: "f() takes at least 1 arguments (0 given)"},
+		{args: Tuple{Int(1)}, kwargs: StringDict{"a": Int(1)}, wantErr: "f() got multiple values for argument 'a'"},
+		{args: Tuple{Int(1)}, kwargs: StringDict{"d": Int(1)}, wantErr: "f() got an unexpected keyword argument 'd'"},
+	} {
+		var a, b, c Object = String("a"), String("b"), String("c")
+		err := ParseTupleAndKeywords(test.args, test.kwargs, "O|OO:f", []string{"a", "b", "c"}, &a, &b, &c)
+		if test.wantErr != "" {
+			if err == nil {
+				t.Errorf("%v %v: want error %q got none", test.args, test.kwargs, test.wantErr)
+			} else if got := err.(*Exception).Args.(Tuple)[0]; got != String(test.wantErr) {
+				t.Errorf("%v %v: want error %q got %q", test.args, test.kwargs, test.wantErr, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v %v: unexpected error %v", test.args, test.kwargs, err)
+			continue
+		}
+		got := Tuple{a, b, c}
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Errorf("%v %v: want %v got %v", test.args, test.kwargs, test.want, got)
+				break
+			}
+		}
+	}
+}
@@ -22,6 +22,8 @@ func Neg(a Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall0(a, "__neg__"); ok {
+		return res, err
 	}
 
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for -: '%s'", a.Type().Name)
@@ -40,6 +42,8 @@ func Pos(a Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall0(a, "__pos__"); ok {
+		return res, err
 	}
 
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for +: '%s'", a.Type().Name)
@@ -58,6 +62,8 @@ func Abs(a Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall0(a, "__abs__"); ok {
+		return res, err
 	}
 
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for abs: '%s'", a.Type().Name)
@@ -76,6 +82,8 @@ func Invert(a Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall0(a, "__invert__"); ok {
+		return res, err
 	}
 
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for ~: '%s'", a.Type().Name)
@@ -98,6 +106,8 @@ func MakeComplex(a Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall0(a, "__complex__"); ok {
+		return res, err
 	}
 
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for complex: '%s'", a.Type().Name)
@@ -120,6 +130,8 @@ func MakeInt(a Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall0(a, "__int__"); ok {
+		return res, err
 	}
 
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for int: '%s'", a.Type().Name)
@@ -142,6 +154,8 @@ func MakeFloat(a Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall0(a, "__float__"); ok {
+		return res, err
 	}
 
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for float: '%s'", a.Type().Name)
@@ -160,6 +174,8 @@ func Iter(a Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall0(a, "__iter__"); ok {
+		return res, err
 	}
 
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for iter: '%s'", a.Type().Name)
@@ -178,6 +194,13 @@ func Add(a, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__add__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	// Now using b to radd if different in type to a
@@ -190,6 +213,13 @@ func Add(a, b Object) (Object, error) {
 			if res != NotImplemented {
 				return res, nil
 			}
+		} else if res, ok, err := TypeCall1(b, "__radd__", a); ok {
+			if err != nil {
+				return nil, err
+			}
+			if res != NotImplemented {
+				return res, nil
+			}
 		}
 	}
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for +: '%s' and '%s'", a.Type().Name, b.Type().Name)
@@ -205,6 +235,13 @@ func IAdd(a, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__iadd__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 	return Add(a, b)
 }
@@ -222,6 +259,13 @@ func Sub(a, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__sub__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	// Now using b to rsub if different in type to a
@@ -234,6 +278,13 @@ func Sub(a, b Object) (Object, error) {
 			if res != NotImplemented {
 				return res, nil
 			}
+		} else if res, ok, err := TypeCall1(b, "__rsub__", a); ok {
+			if err != nil {
+				return nil, err
+			}
+			if res != NotImplemented {
+				return res, nil
+			}
 		}
 	}
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for -: '%s' and '%s'", a.Type().Name, b.Type().Name)
@@ -249,6 +300,13 @@ func ISub(a, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__isub__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 	return Sub(a, b)
 }
@@ -266,6 +324,13 @@ func Mul(a, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__mul__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	// Now using b to rmul if different in type to a
@@ -278,6 +343,13 @@ func Mul(a, b Object) (Object, error) {
 			if res != NotImplemented {
 				return res, nil
 			}
+		} else if res, ok, err := TypeCall1(b, "__rmul__", a); ok {
+			if err != nil {
+				return nil, err
+			}
+			if res != NotImplemented {
+				return res, nil
+			}
 		}
 	}
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for *: '%s' and '%s'", a.Type().Name, b.Type().Name)
@@ -293,10 +365,82 @@ func IMul(a, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__imul__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 	return Mul(a, b)
 }
 
+// MatMul two python objects together returning an Object
+//
+// Will raise TypeError if can't be matmul can't be run on these objects
+func MatMul(a, b Object) (Object, error) {
+	// Try using a to matmul
+	if A, ok := a.(I__matmul__); ok {
+		res, err := A.M__matmul__(b)
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
+	} else if res, ok, err := TypeCall1(a, "__matmul__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
+	}
+
+	// Now using b to rmatmul if different in type to a
+	if a.Type() != b.Type() {
+		if B, ok := b.(I__rmatmul__); ok {
+			res, err := B.M__rmatmul__(a)
+			if err != nil {
+				return nil, err
+			}
+			if res != NotImplemented {
+				return res, nil
+			}
+		} else if res, ok, err := TypeCall1(b, "__rmatmul__", a); ok {
+			if err != nil {
+				return nil, err
+			}
+			if res != NotImplemented {
+				return res, nil
+			}
+		}
+	}
+	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for @: '%s' and '%s'", a.Type().Name, b.Type().Name)
+}
+
+// Inplace matmul
+func IMatMul(a, b Object) (Object, error) {
+	if A, ok := a.(I__imatmul__); ok {
+		res, err := A.M__imatmul__(b)
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
+	} else if res, ok, err := TypeCall1(a, "__imatmul__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
+	}
+	return MatMul(a, b)
+}
+
 // TrueDiv two python objects together returning an Object
 //
 // Will raise TypeError if can't be truediv can't be run on these objects
@@ -310,6 +454,13 @@ func TrueDiv(a, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__truediv__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	// Now using b to rtruediv if different in type to a
@@ -322,6 +473,13 @@ func TrueDiv(a, b Object) (Object, error) {
 			if res != NotImplemented {
 				return res, nil
 			}
+		} else if res, ok, err := TypeCall1(b, "__rtruediv__", a); ok {
+			if err != nil {
+				return nil, err
+			}
+			if res != NotImplemented {
+				return res, nil
+			}
 		}
 	}
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for /: '%s' and '%s'", a.Type().Name, b.Type().Name)
@@ -337,6 +495,13 @@ func ITrueDiv(a, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__itruediv__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 	return TrueDiv(a, b)
 }
@@ -354,6 +519,13 @@ func FloorDiv(a, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__floordiv__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	// Now using b to rfloordiv if different in type to a
@@ -366,6 +538,13 @@ func FloorDiv(a, b Object) (Object, error) {
 			if res != NotImplemented {
 				return res, nil
 			}
+		} else if res, ok, err := TypeCall1(b, "__rfloordiv__", a); ok {
+			if err != nil {
+				return nil, err
+			}
+			if res != NotImplemented {
+				return res, nil
+			}
 		}
 	}
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for //: '%s' and '%s'", a.Type().Name, b.Type().Name)
@@ -381,6 +560,13 @@ func IFloorDiv(a, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__ifloordiv__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 	return FloorDiv(a, b)
 }
@@ -398,6 +584,13 @@ func Mod(a, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__mod__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	// Now using b to rmod if different in type to a
@@ -410,6 +603,13 @@ func Mod(a, b Object) (Object, error) {
 			if res != NotImplemented {
 				return res, nil
 			}
+		} else if res, ok, err := TypeCall1(b, "__rmod__", a); ok {
+			if err != nil {
+				return nil, err
+			}
+			if res != NotImplemented {
+				return res, nil
+			}
 		}
 	}
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for %%: '%s' and '%s'", a.Type().Name, b.Type().Name)
@@ -425,6 +625,13 @@ func IMod(a, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__imod__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 	return Mod(a, b)
 }
@@ -472,6 +679,13 @@ func Lshift(a, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__lshift__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	// Now using b to rlshift if different in type to a
@@ -484,6 +698,13 @@ func Lshift(a, b Object) (Object, error) {
 			if res != NotImplemented {
 				return res, nil
 			}
+		} else if res, ok, err := TypeCall1(b, "__rlshift__", a); ok {
+			if err != nil {
+				return nil, err
+			}
+			if res != NotImplemented {
+				return res, nil
+			}
 		}
 	}
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for <<: '%s' and '%s'", a.Type().Name, b.Type().Name)
@@ -499,6 +720,13 @@ func ILshift(a, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__ilshift__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 	return Lshift(a, b)
 }
@@ -516,6 +744,13 @@ func Rshift(a, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__rshift__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	// Now using b to rrshift if different in type to a
@@ -528,6 +763,13 @@ func Rshift(a, b Object) (Object, error) {
 			if res != NotImplemented {
 				return res, nil
 			}
+		} else if res, ok, err := TypeCall1(b, "__rrshift__", a); ok {
+			if err != nil {
+				return nil, err
+			}
+			if res != NotImplemented {
+				return res, nil
+			}
 		}
 	}
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for >>: '%s' and '%s'", a.Type().Name, b.Type().Name)
@@ -543,6 +785,13 @@ func IRshift(a, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__irshift__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 	return Rshift(a, b)
 }
@@ -560,6 +809,13 @@ func And(a, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__and__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	// Now using b to rand if different in type to a
@@ -572,6 +828,13 @@ func And(a, b Object) (Object, error) {
 			if res != NotImplemented {
 				return res, nil
 			}
+		} else if res, ok, err := TypeCall1(b, "__rand__", a); ok {
+			if err != nil {
+				return nil, err
+			}
+			if res != NotImplemented {
+				return res, nil
+			}
 		}
 	}
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for &: '%s' and '%s'", a.Type().Name, b.Type().Name)
@@ -587,6 +850,13 @@ func IAnd(a, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__iand__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 	return And(a, b)
 }
@@ -604,6 +874,13 @@ func Xor(a, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__xor__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	// Now using b to rxor if different in type to a
@@ -616,6 +893,13 @@ func Xor(a, b Object) (Object, error) {
 			if res != NotImplemented {
 				return res, nil
 			}
+		} else if res, ok, err := TypeCall1(b, "__rxor__", a); ok {
+			if err != nil {
+				return nil, err
+			}
+			if res != NotImplemented {
+				return res, nil
+			}
 		}
 	}
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for ^: '%s' and '%s'", a.Type().Name, b.Type().Name)
@@ -631,6 +915,13 @@ func IXor(a, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__ixor__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 	return Xor(a, b)
 }
@@ -648,6 +939,13 @@ func Or(a, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__or__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	// Now using b to ror if different in type to a
@@ -660,6 +958,13 @@ func Or(a, b Object) (Object, error) {
 			if res != NotImplemented {
 				return res, nil
 			}
+		} else if res, ok, err := TypeCall1(b, "__ror__", a); ok {
+			if err != nil {
+				return nil, err
+			}
+			if res != NotImplemented {
+				return res, nil
+			}
 		}
 	}
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for |: '%s' and '%s'", a.Type().Name, b.Type().Name)
@@ -675,6 +980,13 @@ func IOr(a, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__ior__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 	return Or(a, b)
 }
@@ -738,6 +1050,13 @@ func Gt(a Object, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__gt__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	// Try using b to lt with reversed parameters
@@ -749,6 +1068,13 @@ func Gt(a Object, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(b, "__lt__", a); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for >: '%s' and '%s'", a.Type().Name, b.Type().Name)
@@ -767,6 +1093,13 @@ func Ge(a Object, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__ge__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	// Try using b to le with reversed parameters
@@ -778,6 +1111,13 @@ func Ge(a Object, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(b, "__le__", a); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for >=: '%s' and '%s'", a.Type().Name, b.Type().Name)
@@ -796,6 +1136,13 @@ func Lt(a Object, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__lt__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	// Try using b to gt with reversed parameters
@@ -807,6 +1154,13 @@ func Lt(a Object, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(b, "__gt__", a); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for <: '%s' and '%s'", a.Type().Name, b.Type().Name)
@@ -825,6 +1179,13 @@ func Le(a Object, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__le__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	// Try using b to ge with reversed parameters
@@ -836,6 +1197,13 @@ func Le(a Object, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(b, "__ge__", a); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for <=: '%s' and '%s'", a.Type().Name, b.Type().Name)
@@ -854,6 +1222,13 @@ func Eq(a Object, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__eq__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	// Try using b to eq with reversed parameters
@@ -865,6 +1240,13 @@ func Eq(a Object, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(b, "__eq__", a); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	if a.Type() != b.Type() {
@@ -887,6 +1269,13 @@ func Ne(a Object, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__ne__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	// Try using b to ne with reversed parameters
@@ -898,6 +1287,13 @@ func Ne(a Object, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(b, "__ne__", a); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	if a.Type() != b.Type() {
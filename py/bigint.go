@@ -29,6 +29,10 @@ func (a *BigInt) M__repr__() (Object, error) {
 	return a.M__str__()
 }
 
+func (a *BigInt) M__format__(formatSpec Object) (Object, error) {
+	return formatInt(a, (*big.Int)(a), string(formatSpec.(String)))
+}
+
 // Some common BigInts
 var (
 	bigInt0   = (*BigInt)(big.NewInt(0))
@@ -589,5 +593,6 @@ var _ conversionBetweenTypes = (*BigInt)(nil)
 var _ I__bool__ = (*BigInt)(nil)
 var _ I__index__ = (*BigInt)(nil)
 var _ richComparison = (*BigInt)(nil)
+var _ I__format__ = (*BigInt)(nil)
 var _ IGoInt = (*BigInt)(nil)
 var _ IGoInt64 = (*BigInt)(nil)
@@ -6,6 +6,8 @@
 
 package py
 
+import "math/big"
+
 type Bool bool
 
 var (
@@ -50,6 +52,14 @@ func (a Bool) M__repr__() (Object, error) {
 	return String("False"), nil
 }
 
+func (a Bool) M__format__(formatSpec Object) (Object, error) {
+	if formatSpec.(String) == "" {
+		return a.M__str__()
+	}
+	i, _ := a.M__index__()
+	return formatInt(a, big.NewInt(int64(i)), string(formatSpec.(String)))
+}
+
 // Convert an Object to an Bool
 //
 // Retrurns ok as to whether the conversion worked or not
@@ -98,5 +108,6 @@ var _ I__bool__ = Bool(false)
 var _ I__index__ = Bool(false)
 var _ I__str__ = Bool(false)
 var _ I__repr__ = Bool(false)
+var _ I__format__ = Bool(false)
 var _ I__eq__ = Bool(false)
 var _ I__ne__ = Bool(false)
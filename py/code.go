@@ -7,6 +7,7 @@
 package py
 
 import (
+	"fmt"
 	"strings"
 )
 
@@ -19,17 +20,18 @@ import (
 // Cellvars are names of local variables referenced by functions with
 // a closure.
 type Code struct {
-	Argcount       int32    // #arguments, except *args
-	Kwonlyargcount int32    // #keyword only arguments
-	Nlocals        int32    // #local variables
-	Stacksize      int32    // #entries needed for evaluation stack
-	Flags          int32    // CO_..., see below
-	Code           string   // instruction opcodes
-	Consts         Tuple    // list (constants used)
-	Names          []string // list of strings (names used)
-	Varnames       []string // tuple of strings (local variable names)
-	Freevars       []string // tuple of strings (free variable names)
-	Cellvars       []string // tuple of strings (cell variable names)
+	Argcount        int32    // #arguments, except *args
+	Posonlyargcount int32    // #positional only arguments, included in Argcount
+	Kwonlyargcount  int32    // #keyword only arguments
+	Nlocals         int32    // #local variables
+	Stacksize       int32    // #entries needed for evaluation stack
+	Flags           int32    // CO_..., see below
+	Code            string   // instruction opcodes
+	Consts          Tuple    // list (constants used)
+	Names           []string // list of strings (names used)
+	Varnames        []string // tuple of strings (local variable names)
+	Freevars        []string // tuple of strings (free variable names)
+	Cellvars        []string // tuple of strings (cell variable names)
 	// The rest doesn't count for hash or comparisons
 	Cell2arg    []byte // Maps cell vars which are arguments.
 	Filename    string // unicode (where it was loaded from)
@@ -65,6 +67,8 @@ const (
 	// work to be done when the call frame it setup.
 
 	CO_NOFREE                  = 0x0040
+	CO_COROUTINE               = 0x0080
+	CO_ITERABLE_COROUTINE      = 0x0100
 	CO_GENERATOR_ALLOWED       = 0x1000
 	CO_FUTURE_DIVISION         = 0x2000
 	CO_FUTURE_ABSOLUTE_IMPORT  = 0x4000 // do absolute imports by default
@@ -104,6 +108,47 @@ func all_name_chars(s String) bool {
 	return true
 }
 
+// Makes the mapping of the cell variables which are also arguments
+// to the arguments, or nil if there are none
+func makeCell2arg(argcount, kwonlyargcount, flags int32, varnames, cellvars []string) []byte {
+	if len(cellvars) == 0 {
+		return nil
+	}
+	total_args := argcount + kwonlyargcount
+	if flags&CO_VARARGS != 0 {
+		total_args++
+	}
+	if flags&CO_VARKEYWORDS != 0 {
+		total_args++
+	}
+	used_cell2arg := false
+	cell2arg := make([]byte, len(cellvars))
+	for i := range cell2arg {
+		cell2arg[i] = CO_CELL_NOT_AN_ARG
+	}
+	// Find cells which are also arguments.
+	for i, cell := range cellvars {
+		for j := int32(0); j < total_args; j++ {
+			arg := varnames[j]
+			if cell == arg {
+				cell2arg[i] = byte(j)
+				used_cell2arg = true
+				break
+			}
+		}
+	}
+	if !used_cell2arg {
+		return nil
+	}
+	return cell2arg
+}
+
+// SetCell2arg works out Cell2arg for code made without NewCode once
+// its arguments and cell variables are known
+func (co *Code) SetCell2arg() {
+	co.Cell2arg = makeCell2arg(co.Argcount, co.Kwonlyargcount, co.Flags, co.Varnames, co.Cellvars)
+}
+
 // Make a new code object
 func NewCode(argcount int32, kwonlyargcount int32,
 	nlocals int32, stacksize int32, flags int32,
@@ -112,8 +157,6 @@ func NewCode(argcount int32, kwonlyargcount int32,
 	filename_ Object, name_ Object, firstlineno int32,
 	lnotab_ Object) *Code {
 
-	var cell2arg []byte
-
 	// Type assert the objects
 	consts := consts_.(Tuple)
 	namesTuple := names_.(Tuple)
@@ -154,7 +197,6 @@ func NewCode(argcount int32, kwonlyargcount int32,
 	// 	return nil;
 	// }
 
-	n_cellvars := len(cellvars)
 	intern_strings(namesTuple)
 	intern_strings(varnamesTuple)
 	intern_strings(freevarsTuple)
@@ -168,34 +210,7 @@ func NewCode(argcount int32, kwonlyargcount int32,
 		}
 	}
 	/* Create mapping between cells and arguments if needed. */
-	if n_cellvars != 0 {
-		total_args := argcount + kwonlyargcount
-		if flags&CO_VARARGS != 0 {
-			total_args++
-		}
-		if flags&CO_VARKEYWORDS != 0 {
-			total_args++
-		}
-		used_cell2arg := false
-		cell2arg = make([]byte, n_cellvars)
-		for i := range cell2arg {
-			cell2arg[i] = CO_CELL_NOT_AN_ARG
-		}
-		// Find cells which are also arguments.
-		for i, cell := range cellvars {
-			for j := int32(0); j < total_args; j++ {
-				arg := varnames[j]
-				if cell == arg {
-					cell2arg[i] = byte(j)
-					used_cell2arg = true
-					break
-				}
-			}
-		}
-		if !used_cell2arg {
-			cell2arg = nil
-		}
-	}
+	cell2arg := makeCell2arg(argcount, kwonlyargcount, flags, varnames, cellvars)
 
 	return &Code{
 		Argcount:       argcount,
@@ -255,6 +270,49 @@ func (co *Code) M__ne__(other Object) (Object, error) {
 	return True, nil
 }
 
+func (co *Code) M__repr__() (Object, error) {
+	return String(fmt.Sprintf("<code object %s at %p, file %q, line %d>", co.Name, co, co.Filename, co.Firstlineno)), nil
+}
+
 // Check interface is satisfied
 var _ I__eq__ = (*Code)(nil)
 var _ I__ne__ = (*Code)(nil)
+var _ I__repr__ = (*Code)(nil)
+
+// Make a tuple of strings from a []string
+func stringsToTuple(xs []string) Tuple {
+	t := make(Tuple, len(xs))
+	for i, x := range xs {
+		t[i] = String(x)
+	}
+	return t
+}
+
+// Properties
+func init() {
+	for name, fget := range map[string]func(co *Code) Object{
+		"co_argcount":        func(co *Code) Object { return Int(co.Argcount) },
+		"co_posonlyargcount": func(co *Code) Object { return Int(co.Posonlyargcount) },
+		"co_kwonlyargcount":  func(co *Code) Object { return Int(co.Kwonlyargcount) },
+		"co_nlocals":         func(co *Code) Object { return Int(co.Nlocals) },
+		"co_stacksize":       func(co *Code) Object { return Int(co.Stacksize) },
+		"co_flags":           func(co *Code) Object { return Int(co.Flags) },
+		"co_code":            func(co *Code) Object { return Bytes(co.Code) },
+		"co_consts":          func(co *Code) Object { return co.Consts.Copy() },
+		"co_names":           func(co *Code) Object { return stringsToTuple(co.Names) },
+		"co_varnames":        func(co *Code) Object { return stringsToTuple(co.Varnames) },
+		"co_freevars":        func(co *Code) Object { return stringsToTuple(co.Freevars) },
+		"co_cellvars":        func(co *Code) Object { return stringsToTuple(co.Cellvars) },
+		"co_filename":        func(co *Code) Object { return String(co.Filename) },
+		"co_name":            func(co *Code) Object { return String(co.Name) },
+		"co_firstlineno":     func(co *Code) Object { return Int(co.Firstlineno) },
+		"co_lnotab":          func(co *Code) Object { return Bytes(co.Lnotab) },
+	} {
+		fget := fget
+		CodeType.Dict[name] = &Property{
+			Fget: func(self Object) (Object, error) {
+				return fget(self.(*Code)), nil
+			},
+		}
+	}
+}
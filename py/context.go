@@ -0,0 +1,291 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Interpreter contexts
+
+package py
+
+import (
+	"io"
+	"os"
+
+	"github.com/go-python/gpython/vfs"
+)
+
+// ContextOpts configures a Context made with NewContext
+type ContextOpts struct {
+	// The Go streams sys.stdin, sys.stdout and sys.stderr are bound
+	// to.  Any which are nil are set to None as python does when
+	// there is no console.
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+
+	// The filesystem open, import and the os module use, eg
+	// vfs.NewReadOnly(dir) to stop scripts changing anything or
+	// seeing anything outside dir.  If nil it is vfs.OS, the real
+	// disk.  Opening files by descriptor, eg open(0), is only
+	// allowed on vfs.OS as descriptors are for the real disk.
+	FS vfs.FS
+}
+
+// DefaultContextOpts returns the options for a context using the
+// standard streams of the process
+func DefaultContextOpts() ContextOpts {
+	return ContextOpts{
+		Stdin:  os.Stdin,
+		Stdout: os.Stdout,
+		Stderr: os.Stderr,
+		FS:     vfs.OS,
+	}
+}
+
+// Context is an interpreter which python code runs in
+//
+// Each context has its own copy of every module, made from the module
+// registered with NewModule the first time it is imported, and the
+// modules imported from python source.  The code running in a context
+// finds it through the __builtins__ module in its globals and module
+// functions find it through the module passed as self, so contexts
+// can run at the same time on different goroutines.  A context must
+// only be used by one goroutine at a time.
+type Context struct {
+	opts     ContextOpts
+	modules  map[string]*Module
+	builtins *Module
+	values   map[interface{}]interface{}
+	atExit   []func()
+}
+
+// DefaultContext is the context which code is run in when it isn't
+// given one, eg by vm.Run with globals which don't have
+// __builtins__ set
+var DefaultContext = NewContext(DefaultContextOpts())
+
+// NewContext makes a new context with opts
+func NewContext(opts ContextOpts) *Context {
+	return &Context{
+		opts:    opts,
+		modules: make(map[string]*Module),
+	}
+}
+
+// Stdio returns the Go streams the context's sys.stdin, sys.stdout
+// and sys.stderr are bound to
+func (ctx *Context) Stdio() (stdin io.Reader, stdout, stderr io.Writer) {
+	return ctx.opts.Stdin, ctx.opts.Stdout, ctx.opts.Stderr
+}
+
+// Value returns the value stored with SetValue under key or nil
+func (ctx *Context) Value(key interface{}) interface{} {
+	return ctx.values[key]
+}
+
+// SetValue stores value under key in the context
+//
+// This is for Go packages to keep state for the code running in the
+// context, eg the vm keeps the running frames.  Like the keys of
+// context.WithValue the key should be of a type the package defines
+// so it can't collide with others.
+func (ctx *Context) SetValue(key, value interface{}) {
+	if ctx.values == nil {
+		ctx.values = make(map[interface{}]interface{})
+	}
+	ctx.values[key] = value
+}
+
+// AtExit registers fn to be called by Finalize
+//
+// This is for Go packages to tidy up after the code run in the
+// context, eg the io module flushes the files which weren't closed.
+func (ctx *Context) AtExit(fn func()) {
+	ctx.atExit = append(ctx.atExit, fn)
+}
+
+// Finalize calls the functions registered with AtExit, the last
+// registered first, as python does when the interpreter exits.
+//
+// Embedders should call it when they have finished running code in
+// the context, otherwise anything written to files which weren't
+// closed may be lost.
+func (ctx *Context) Finalize() {
+	for len(ctx.atExit) > 0 {
+		fn := ctx.atExit[len(ctx.atExit)-1]
+		ctx.atExit = ctx.atExit[:len(ctx.atExit)-1]
+		fn()
+	}
+}
+
+// FS returns the filesystem of the context
+func (ctx *Context) FS() vfs.FS {
+	if ctx.opts.FS == nil {
+		return vfs.OS
+	}
+	return ctx.opts.FS
+}
+
+// The key the subtypes made by SubType are stored under
+type subTypeKey struct {
+	t *Type
+}
+
+// SubType returns the subtype of the Go type t for the context whose
+// instances are made by New, making it the first time
+//
+// Types are shared by all contexts so a Go type whose constructor
+// needs the context, eg to open files on its filesystem, can't make
+// instances itself.  Its module gives each context one of these
+// instead.
+func (ctx *Context) SubType(t *Type, New func(ctx *Context, args Tuple, kwargs StringDict) (Object, error)) *Type {
+	key := subTypeKey{t: t}
+	if sub, ok := ctx.Value(key).(*Type); ok {
+		return sub
+	}
+	sub := &Type{
+		ObjectType: t,
+		Name:       t.Name,
+		Doc:        t.Doc,
+		Base:       t,
+		Bases:      Tuple{t},
+		Dict:       t.Dict.Copy(),
+		New: func(metatype *Type, args Tuple, kwargs StringDict) (Object, error) {
+			return New(ctx, args, kwargs)
+		},
+		Init:     t.Init,
+		Flags:    t.Flags,
+		Qualname: t.Qualname,
+	}
+	if t.Mro != nil {
+		// Put the context's subtypes of the bases made so far in
+		// the MRO too so isinstance works between the subtypes
+		mro := Tuple{sub}
+		for _, base := range t.Mro {
+			if baseSub, ok := ctx.Value(subTypeKey{t: base.(*Type)}).(*Type); ok {
+				mro = append(mro, baseSub)
+			}
+			mro = append(mro, base)
+		}
+		sub.Mro = mro
+	}
+	ctx.SetValue(key, sub)
+	return sub
+}
+
+// GetModule returns the module called name in the context, copying
+// the module registered with NewModule if it hasn't been used yet
+func (ctx *Context) GetModule(name string) (*Module, error) {
+	if m, ok := ctx.modules[name]; ok {
+		return m, nil
+	}
+	impl := getModuleImpl(name)
+	if impl == nil {
+		return nil, ExceptionNewf(ImportError, "Module %q not found", name)
+	}
+	m := &Module{
+		Name:    impl.Name,
+		Doc:     impl.Doc,
+		Globals: make(StringDict, len(impl.Globals)),
+		Context: ctx,
+	}
+	// Register it first so modules which refer to each other work
+	ctx.modules[name] = m
+	methods := make(map[*Method]*Method)
+	for key, value := range impl.Globals {
+		switch x := value.(type) {
+		case *Method:
+			value = ctx.copyMethod(impl, m, x, methods)
+		case *Module:
+			if getModuleImpl(x.Name) == x {
+				if copied, err := ctx.GetModule(x.Name); err == nil {
+					value = copied
+				}
+			}
+		}
+		m.Globals[key] = value
+	}
+	if impl.ContextInit != nil {
+		impl.ContextInit(m)
+	}
+	return m, nil
+}
+
+// MustGetModule returns the module called name in the context or
+// panics
+func (ctx *Context) MustGetModule(name string) *Module {
+	m, err := ctx.GetModule(name)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// Returns the copy of method for m, a copy of the module impl.
+// Functions of other modules are looked up in the context's copy of
+// their module.
+func (ctx *Context) copyMethod(impl, m *Module, method *Method, methods map[*Method]*Method) *Method {
+	if method.module != impl && method.module != nil {
+		if other, err := ctx.GetModule(method.module.Name); err == nil {
+			if copied, ok := other.Globals[method.Name].(*Method); ok && copied.module == other {
+				return copied
+			}
+		}
+	}
+	if copied, ok := methods[method]; ok {
+		return copied
+	}
+	copied := *method
+	copied.module = m
+	methods[method] = &copied
+	return &copied
+}
+
+// Builtins returns the context's builtins module
+func (ctx *Context) Builtins() *Module {
+	if ctx.builtins == nil {
+		ctx.builtins = ctx.MustGetModule("builtins")
+	}
+	return ctx.builtins
+}
+
+// NewModule makes a module called name in the context for python code
+// to run in, eg "__main__", and registers it so it can be imported.
+//
+// Unlike the NewModule function this doesn't register a Go module
+// which every context gets a copy of.
+func (ctx *Context) NewModule(name, doc string, methods []*Method, globals StringDict) *Module {
+	m := &Module{
+		Name:    name,
+		Doc:     doc,
+		Globals: globals.Copy(),
+		Context: ctx,
+	}
+	for _, method := range methods {
+		copied := *method
+		copied.module = m
+		m.Globals[method.Name] = &copied
+	}
+	m.Globals["__name__"] = String(name)
+	m.Globals["__doc__"] = String(doc)
+	m.Globals["__package__"] = None
+	m.Globals["__builtins__"] = ctx.Builtins()
+	ctx.modules[name] = m
+	return m
+}
+
+// GetContext returns the context of self, the module passed to a
+// module function, or DefaultContext if it isn't a module belonging to
+// a context
+func GetContext(self Object) *Context {
+	if m, ok := self.(*Module); ok && m.Context != nil {
+		return m.Context
+	}
+	return DefaultContext
+}
+
+// GlobalsContext returns the context code running with globals
+// belongs to, found from its __builtins__, or DefaultContext if it
+// doesn't have one
+func GlobalsContext(globals StringDict) *Context {
+	return GetContext(globals["__builtins__"])
+}
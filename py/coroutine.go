@@ -0,0 +1,195 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Coroutine objects
+
+package py
+
+// A python Coroutine object
+//
+// Coroutines are suspended and resumed exactly like generators but
+// they are awaitable rather than iterable, so the generator isn't
+// embedded to stop its iterator methods being promoted.
+type Coroutine struct {
+	gen Generator
+}
+
+var CoroutineType = NewType("coroutine", "coroutine object")
+
+// The iterator returned by coroutine.__await__()
+type CoroutineWrapper struct {
+	coroutine *Coroutine
+}
+
+var CoroutineWrapperType = NewType("coroutine_wrapper", "coroutine_wrapper object")
+
+func init() {
+	CoroutineType.Dict["send"] = MustNewMethod("send", func(self Object, value Object) (Object, error) {
+		return self.(*Coroutine).Send(value)
+	}, 0, "send(arg) -> send 'arg' into coroutine,\nreturn next iterated value or raise StopIteration.")
+	CoroutineType.Dict["throw"] = MustNewMethod("throw", func(self Object, args Tuple, kwargs StringDict) (Object, error) {
+		return self.(*Coroutine).Throw(args, kwargs)
+	}, 0, "throw(typ[,val[,tb]]) -> raise exception in coroutine,\nreturn next iterated value or raise StopIteration.")
+	CoroutineType.Dict["close"] = MustNewMethod("close", func(self Object) (Object, error) {
+		return self.(*Coroutine).Close()
+	}, 0, "close() -> raise GeneratorExit inside coroutine.")
+	CoroutineType.Dict["__await__"] = MustNewMethod("__await__", func(self Object) (Object, error) {
+		return self.(*Coroutine).M__await__()
+	}, 0, "__await__() -> return an iterator to be used in await expression.")
+	CoroutineType.Dict["cr_running"] = &Property{
+		Fget: func(self Object) (Object, error) {
+			return NewBool(self.(*Coroutine).gen.Running), nil
+		},
+	}
+	CoroutineType.Dict["cr_code"] = &Property{
+		Fget: func(self Object) (Object, error) {
+			return self.(*Coroutine).gen.Code, nil
+		},
+	}
+
+	CoroutineWrapperType.Dict["send"] = MustNewMethod("send", func(self Object, value Object) (Object, error) {
+		return self.(*CoroutineWrapper).Send(value)
+	}, 0, "send(arg) -> send 'arg' into coroutine,\nreturn next iterated value or raise StopIteration.")
+	CoroutineWrapperType.Dict["throw"] = MustNewMethod("throw", func(self Object, args Tuple, kwargs StringDict) (Object, error) {
+		return self.(*CoroutineWrapper).Throw(args, kwargs)
+	}, 0, "throw(typ[,val[,tb]]) -> raise exception in coroutine,\nreturn next iterated value or raise StopIteration.")
+	CoroutineWrapperType.Dict["close"] = MustNewMethod("close", func(self Object) (Object, error) {
+		return self.(*CoroutineWrapper).Close()
+	}, 0, "close() -> raise GeneratorExit inside coroutine.")
+}
+
+// Type of this object
+func (o *Coroutine) Type() *Type {
+	return CoroutineType
+}
+
+// Type of this object
+func (o *CoroutineWrapper) Type() *Type {
+	return CoroutineWrapperType
+}
+
+// Define a new coroutine
+func NewCoroutine(frame *Frame) *Coroutine {
+	return &Coroutine{
+		gen: Generator{
+			Frame: frame,
+			Code:  frame.Code,
+		},
+	}
+}
+
+// Frame returns the frame the coroutine is running in or nil if it
+// has finished
+func (c *Coroutine) Frame() *Frame {
+	return c.gen.Frame
+}
+
+// coroutine.send(value)
+//
+// Starts or resumes execution of the coroutine. If value is None,
+// this is equivalent to advancing the iterator returned by
+// __await__(). If value is not None, this method delegates to the
+// send() method of the iterator that caused the coroutine to
+// suspend. The result (return value, StopIteration, or other
+// exception) is the same as when iterating over the __await__()
+// return value.
+func (c *Coroutine) Send(arg Object) (Object, error) {
+	return c.gen.resume(arg, nil, "coroutine")
+}
+
+// coroutine.throw(type[, value[, traceback]])
+//
+// Raises the specified exception in the coroutine. This method
+// delegates to the throw() method of the iterator that caused the
+// coroutine to suspend, if it has such a method. Otherwise, the
+// exception is raised at the suspension point.
+func (c *Coroutine) Throw(args Tuple, kwargs StringDict) (Object, error) {
+	exc, err := makeThrowException(args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	return c.gen.resume(None, exc, "coroutine")
+}
+
+// coroutine.close()
+//
+// Causes the coroutine to clean itself up and exit. If the coroutine
+// is suspended, this method first delegates to the close() method of
+// the iterator that caused the coroutine to suspend, if it has such
+// a method. Then it raises GeneratorExit at the suspension point,
+// causing the coroutine to immediately clean itself up.
+func (c *Coroutine) Close() (Object, error) {
+	return c.gen.close("coroutine")
+}
+
+// coroutine.__await__()
+//
+// Returns an iterator which drives the coroutine
+func (c *Coroutine) M__await__() (Object, error) {
+	return &CoroutineWrapper{coroutine: c}, nil
+}
+
+func (w *CoroutineWrapper) M__iter__() (Object, error) {
+	return w, nil
+}
+
+func (w *CoroutineWrapper) M__next__() (Object, error) {
+	return w.coroutine.Send(None)
+}
+
+func (w *CoroutineWrapper) Send(arg Object) (Object, error) {
+	return w.coroutine.Send(arg)
+}
+
+func (w *CoroutineWrapper) Throw(args Tuple, kwargs StringDict) (Object, error) {
+	return w.coroutine.Throw(args, kwargs)
+}
+
+func (w *CoroutineWrapper) Close() (Object, error) {
+	return w.coroutine.Close()
+}
+
+// isIterableCoroutine returns true if o is a generator made from a
+// function decorated with types.coroutine
+func isIterableCoroutine(o Object) bool {
+	gen, ok := o.(*Generator)
+	return ok && gen.Code.Flags&CO_ITERABLE_COROUTINE != 0
+}
+
+// GetAwaitableIter returns the iterator to use for an await expression
+//
+// This is the coroutine itself for native coroutines, otherwise the
+// result of calling __await__ which must be an iterator.
+//
+// This is the equivalent of _PyCoro_GetAwaitableIter
+func GetAwaitableIter(o Object) (Object, error) {
+	if _, ok := o.(*Coroutine); ok || isIterableCoroutine(o) {
+		return o, nil
+	}
+	var res Object
+	var err error
+	if I, ok := o.(I__await__); ok {
+		res, err = I.M__await__()
+	} else if r, ok, e := TypeCall0(o, "__await__"); ok {
+		res, err = r, e
+	} else {
+		return nil, ExceptionNewf(TypeError, "object %s can't be used in 'await' expression", o.Type().Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := res.(*Coroutine); ok || isIterableCoroutine(res) {
+		return nil, ExceptionNewf(TypeError, "__await__() returned a coroutine")
+	}
+	if _, ok := res.(I__next__); !ok {
+		if _, err := GetAttrString(res, "__next__"); err != nil {
+			return nil, ExceptionNewf(TypeError, "__await__() returned non-iterator of type '%s'", res.Type().Name)
+		}
+	}
+	return res, nil
+}
+
+// Check interface is satisfied
+var _ I_coroutine = (*Coroutine)(nil)
+var _ I_generator = (*CoroutineWrapper)(nil)
@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"strings"
+	"unicode/utf8"
 )
 
 // A python Exception object
@@ -40,6 +42,7 @@ var (
 	GeneratorExit             = BaseException.NewType("GeneratorExit", "Request that a generator exit.", nil, nil)
 	ExceptionType             = BaseException.NewType("Exception", "Common base class for all non-exit exceptions.", nil, nil)
 	StopIteration             = ExceptionType.NewType("StopIteration", "Signal the end from iterator.__next__().", nil, nil)
+	StopAsyncIteration        = ExceptionType.NewType("StopAsyncIteration", "Signal the end from iterator.__anext__().", nil, nil)
 	ArithmeticError           = ExceptionType.NewType("ArithmeticError", "Base class for arithmetic errors.", nil, nil)
 	FloatingPointError        = ArithmeticError.NewType("FloatingPointError", "Floating point operation failed.", nil, nil)
 	OverflowError             = ArithmeticError.NewType("OverflowError", "Result too large to be represented.", nil, nil)
@@ -74,7 +77,7 @@ var (
 	ReferenceError            = ExceptionType.NewType("ReferenceError", "Weak ref proxy used after referent went away.", nil, nil)
 	RuntimeError              = ExceptionType.NewType("RuntimeError", "Unspecified run-time error.", nil, nil)
 	NotImplementedError       = RuntimeError.NewType("NotImplementedError", "Method or function hasn't been implemented yet.", nil, nil)
-	SyntaxError               = ExceptionType.NewType("SyntaxError", "Invalid syntax.", nil, nil)
+	SyntaxError               = ExceptionType.NewType("SyntaxError", "Invalid syntax.", nil, syntaxErrorInit)
 	IndentationError          = SyntaxError.NewType("IndentationError", "Improper indentation.", nil, nil)
 	TabError                  = IndentationError.NewType("TabError", "Improper mixture of spaces and tabs.", nil, nil)
 	SystemError               = ExceptionType.NewType("SystemError", "Internal error in the Gpython interpreter.\n\nPlease report this to the Gpython maintainer, along with the traceback,\nthe Gpython version, and the hardware/OS platform and version.", nil, nil)
@@ -132,13 +135,44 @@ func (e *Exception) Error() string {
 			}
 		}
 	}
-	// FIXME Print out special stuff for things which look like SyntaxErrors
-	if e.Dict["lineno"] != nil {
-		message = fmt.Sprintf("\n  File \"%v\", line %v, offset %v\n    %s\n\n", e.Dict["filename"], e.Dict["lineno"], e.Dict["offset"], e.Dict["line"]) + message
+	if _, ok := e.Dict["lineno"].(Int); ok && e.Base.IsSubtype(SyntaxError) {
+		return e.syntaxErrorString()
 	}
 	return message
 }
 
+// syntaxErrorString renders an exception with a source location in
+// the way python shows a SyntaxError in a traceback, with a caret
+// pointing at the error, eg
+//
+//	  File "<string>", line 1
+//	    if
+//	      ^
+//	SyntaxError: invalid syntax
+func (e *Exception) syntaxErrorString() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "  File \"%v\", line %v\n", e.Dict["filename"], e.Dict["lineno"])
+	text, _ := e.Dict["text"].(String)
+	line := strings.TrimRight(string(text), "\r\n")
+	if trimmed := strings.TrimLeft(line, " \t\f"); trimmed != "" {
+		indent := utf8.RuneCountInString(line) - utf8.RuneCountInString(trimmed)
+		fmt.Fprintf(&b, "    %s\n", trimmed)
+		if offset, ok := e.Dict["offset"].(Int); ok && int(offset) > indent {
+			carets := 1
+			endOffset, ok := e.Dict["end_offset"].(Int)
+			if ok && e.Dict["end_lineno"] == e.Dict["lineno"] && endOffset > offset {
+				carets = int(endOffset - offset)
+			}
+			fmt.Fprintf(&b, "    %s%s\n", strings.Repeat(" ", int(offset)-1-indent), strings.Repeat("^", carets))
+		}
+	}
+	b.WriteString(e.Base.Name)
+	if msg, ok := e.Dict["msg"]; ok && msg != None {
+		fmt.Fprintf(&b, ": %v", msg)
+	}
+	return b.String()
+}
+
 // Go error interface
 func (e ExceptionInfo) Error() string {
 	if e.Value == nil {
@@ -156,8 +190,10 @@ func (exc *ExceptionInfo) TracebackDump(w io.Writer) {
 		fmt.Fprintf(w, "Traceback <nil>\n")
 		return
 	}
-	fmt.Fprintf(w, "Traceback (most recent call last):\n")
-	exc.Traceback.TracebackDump(w)
+	if exc.Traceback != nil {
+		fmt.Fprintf(w, "Traceback (most recent call last):\n")
+		exc.Traceback.TracebackDump(w)
+	}
 	fmt.Fprintf(w, "%v\n", exc.Value)
 }
 
@@ -175,6 +211,32 @@ func exceptionNew(metatype *Type, args Tuple) *Exception {
 	}
 }
 
+// StopIterationValue returns the value carried by a StopIteration
+// exception or None if it doesn't have one
+func StopIterationValue(err error) Object {
+	switch x := err.(type) {
+	case *Exception:
+		return x.stopIterationValue()
+	case ExceptionInfo:
+		if e, ok := x.Value.(*Exception); ok {
+			return e.stopIterationValue()
+		}
+	}
+	return None
+}
+
+// stopIterationValue returns the value attribute of a StopIteration
+// which is the first argument unless it has been set explicitly
+func (e *Exception) stopIterationValue() Object {
+	if value, ok := e.Dict["value"]; ok {
+		return value
+	}
+	if args, ok := e.Args.(Tuple); ok && len(args) > 0 {
+		return args[0]
+	}
+	return None
+}
+
 // ExceptionNew
 func ExceptionNew(metatype *Type, args Tuple, kwargs StringDict) (Object, error) {
 	if len(kwargs) != 0 {
@@ -236,18 +298,112 @@ func MakeException(r interface{}) *Exception {
 	}
 }
 
+// The attributes of a SyntaxError in the order they are in its args
+var syntaxErrorAttrs = []string{"filename", "lineno", "offset", "text", "end_lineno", "end_offset"}
+
+// syntaxErrorInit sets the attributes of a SyntaxError from its
+// arguments which are (msg, (filename, lineno, offset, text)) with
+// end_lineno and end_offset optionally following text
+//
+// See Objects/exceptions.c SyntaxError_init
+func syntaxErrorInit(self Object, args Tuple, kwargs StringDict) error {
+	e := self.(*Exception)
+	e.Dict["msg"] = None
+	for _, name := range syntaxErrorAttrs {
+		e.Dict[name] = None
+	}
+	if len(args) >= 1 {
+		e.Dict["msg"] = args[0]
+	}
+	if len(args) == 2 {
+		info, err := SequenceTuple(args[1])
+		if err != nil {
+			return err
+		}
+		if len(info) < 4 || len(info) > len(syntaxErrorAttrs) {
+			return ExceptionNewf(TypeError, "function takes at least 4 arguments (%d given)", len(info))
+		}
+		for i, value := range info {
+			e.Dict[syntaxErrorAttrs[i]] = value
+		}
+	}
+	return nil
+}
+
+// Sets the args of a SyntaxError made in Go from its attributes in
+// the form SyntaxError takes them
+func (e *Exception) syntaxErrorSetArgs() {
+	info := Tuple{}
+	for _, name := range syntaxErrorAttrs {
+		value, ok := e.Dict[name]
+		if !ok {
+			break
+		}
+		info = append(info, value)
+	}
+	e.Args = Tuple{e.Dict["msg"], info}
+}
+
 // First calls MakeException then adds the extra details in to make it a SyntaxError
-func MakeSyntaxError(r interface{}, filename string, lineno int, offset int, line string) *Exception {
-	// FIXME add more stuff to make it a SyntaxError!
+//
+// lineno starts from 1 and colOffset is the byte offset of the error
+// from the start of line (which may be empty if the source isn't
+// available).  These are stored as the filename, lineno, offset,
+// text and msg attributes as python does where offset counts
+// characters starting from 1.
+func MakeSyntaxError(r interface{}, filename string, lineno int, colOffset int, line string) *Exception {
 	// see Python/errors.c PyErr_SyntaxLocationObject
 	e := MakeException(r)
 	e.Dict["filename"] = String(filename)
 	e.Dict["lineno"] = Int(lineno)
-	e.Dict["offset"] = Int(offset)
-	e.Dict["line"] = String(line)
+	e.Dict["offset"] = Int(colOffset + 1)
+	e.Dict["text"] = None
+	if args, ok := e.Args.(Tuple); ok && len(args) > 0 {
+		e.Dict["msg"] = args[0]
+	} else {
+		e.Dict["msg"] = None
+	}
+	if line != "" {
+		SyntaxErrorSetText(e, line)
+	}
+	e.syntaxErrorSetArgs()
 	return e
 }
 
+// SyntaxErrorSetEnd sets the end of the source that a SyntaxError
+// refers to in the same units as MakeSyntaxError
+//
+// It must be called after the text is set
+func SyntaxErrorSetEnd(e *Exception, endLineno int, endColOffset int) {
+	e.Dict["end_lineno"] = Int(endLineno)
+	e.Dict["end_offset"] = Int(endColOffset + 1)
+	if text, ok := e.Dict["text"].(String); ok && endLineno == int(e.Dict["lineno"].(Int)) {
+		e.Dict["end_offset"] = Int(charOffset(string(text), endColOffset) + 1)
+	}
+	e.syntaxErrorSetArgs()
+}
+
+// SyntaxErrorSetText sets the source line of a SyntaxError made
+// without one, converting its offset from bytes to characters
+func SyntaxErrorSetText(e *Exception, line string) {
+	e.Dict["text"] = String(line)
+	if offset, ok := e.Dict["offset"].(Int); ok {
+		e.Dict["offset"] = Int(charOffset(line, int(offset)-1) + 1)
+	}
+	e.syntaxErrorSetArgs()
+}
+
+// Converts a byte offset in line into a character offset
+func charOffset(line string, offset int) int {
+	if offset < 0 {
+		return offset
+	}
+	if offset > len(line) {
+		return utf8.RuneCountInString(line) + offset - len(line)
+	}
+	return utf8.RuneCountInString(line[:offset])
+}
+
 /*
 #define PyType_HasFeature(t,f)  (((t)->tp_flags & (f)) != 0)
 
@@ -340,6 +496,11 @@ func IsException(exception *Type, r interface{}) bool {
 		t = ex.Type()
 	case *Type:
 		t = ex
+	case ExceptionInfo:
+		t = ex.Type
+		if t == nil {
+			return false
+		}
 	default:
 		return false
 	}
@@ -357,6 +518,12 @@ func IsException(exception *Type, r interface{}) bool {
 
 // FIXME prototype __getattr__ before we do introspection!
 func (e *Exception) M__getattr__(name string) (Object, error) {
+	if value, ok := e.Dict[name]; ok {
+		return value, nil
+	}
+	if name == "value" && e.Base.IsSubtype(StopIteration) {
+		return e.stopIterationValue(), nil
+	}
 	return e.Args, nil // FIXME All attributes are args!
 }
 
@@ -0,0 +1,41 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package py
+
+import "testing"
+
+func TestExceptionError(t *testing.T) {
+	// Other exceptions with a lineno attribute, eg re.error,
+	// aren't shown as a SyntaxError
+	withLineno := ExceptionNewf(ValueError, "bad escape")
+	withLineno.Dict["lineno"] = Int(1)
+	withLineno.Dict["colno"] = Int(2)
+	for _, test := range []struct {
+		e    *Exception
+		want string
+	}{
+		{withLineno, "ValueError: 'bad escape'"},
+		{MakeSyntaxError(ExceptionNewf(SyntaxError, "invalid syntax"), "f.py", 1, 3, "if x\n"), "  File \"f.py\", line 1\n    if x\n       ^\nSyntaxError: invalid syntax"},
+		{MakeSyntaxError(ExceptionNewf(IndentationError, "unexpected indent"), "f.py", 2, 0, ""), "  File \"f.py\", line 2\nIndentationError: unexpected indent"},
+	} {
+		got := test.e.Error()
+		if got != test.want {
+			t.Errorf("want %q got %q", test.want, got)
+		}
+	}
+}
+
+func TestMakeSyntaxErrorArgs(t *testing.T) {
+	e := MakeSyntaxError(ExceptionNewf(SyntaxError, "invalid syntax"), "f.py", 1, 3, "if é\n")
+	SyntaxErrorSetEnd(e, 1, 5)
+	want := Tuple{String("invalid syntax"), Tuple{String("f.py"), Int(1), Int(4), String("if é\n"), Int(1), Int(5)}}
+	eq, err := Eq(e.Args, want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if eq != True {
+		t.Errorf("want %v got %v", want, e.Args)
+	}
+}
@@ -55,6 +55,10 @@ func (a Float) M__repr__() (Object, error) {
 	return a.M__str__()
 }
 
+func (a Float) M__format__(formatSpec Object) (Object, error) {
+	return formatFloat(a, float64(a), string(formatSpec.(String)))
+}
+
 // FloatFromString turns a string into a Float
 func FloatFromString(str string) (Object, error) {
 	str = strings.TrimSpace(str)
@@ -396,3 +400,4 @@ var _ floatArithmetic = Float(0)
 var _ conversionBetweenTypes = Float(0)
 var _ I__bool__ = Float(0)
 var _ richComparison = Float(0)
+var _ I__format__ = Float(0)
@@ -0,0 +1,375 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Format Specification Mini-Language
+
+package py
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Format calls __format__ on the object with the format spec given
+//
+// This is the implementation of the format() builtin
+func Format(self Object, formatSpec Object) (Object, error) {
+	if _, ok := formatSpec.(String); !ok {
+		return nil, ExceptionNewf(TypeError, "format() argument 2 must be str, not %s", formatSpec.Type().Name)
+	}
+	var res Object
+	var err error
+	if I, ok := self.(I__format__); ok {
+		res, err = I.M__format__(formatSpec)
+	} else if r, ok, e := TypeCall1(self, "__format__", formatSpec); ok {
+		res, err = r, e
+	} else {
+		// object.__format__
+		if formatSpec.(String) != "" {
+			return nil, ExceptionNewf(TypeError, "non-empty format string passed to object.__format__")
+		}
+		res, err = Str(self)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := res.(String); !ok {
+		return nil, ExceptionNewf(TypeError, "__format__ must return a str, not %s", res.Type().Name)
+	}
+	return res, nil
+}
+
+// formatSpec is a parsed standard format specifier
+//
+//	[[fill]align][sign][#][0][width][,][.precision][type]
+type formatSpec struct {
+	fill      rune
+	align     rune // 0 for default
+	sign      rune // 0 for default
+	alternate bool
+	width     int
+	thousands bool
+	precision int  // -1 for none
+	typ       rune // 0 for none
+}
+
+func isAlign(c rune) bool {
+	return c == '<' || c == '>' || c == '=' || c == '^'
+}
+
+// parseFormatSpec parses a standard format specifier
+func parseFormatSpec(spec string) (*formatSpec, error) {
+	f := &formatSpec{fill: ' ', precision: -1}
+	in := []rune(spec)
+	i := 0
+	if len(in) >= 2 && isAlign(in[1]) {
+		f.fill, f.align = in[0], in[1]
+		i = 2
+	} else if len(in) >= 1 && isAlign(in[0]) {
+		f.align = in[0]
+		i = 1
+	}
+	if i < len(in) && (in[i] == '+' || in[i] == '-' || in[i] == ' ') {
+		f.sign = in[i]
+		i++
+	}
+	if i < len(in) && in[i] == '#' {
+		f.alternate = true
+		i++
+	}
+	if i < len(in) && in[i] == '0' {
+		if f.align == 0 {
+			f.fill, f.align = '0', '='
+		}
+		i++
+	}
+	readNumber := func() (int, bool) {
+		start := i
+		for i < len(in) && in[i] >= '0' && in[i] <= '9' {
+			i++
+		}
+		if i == start {
+			return 0, false
+		}
+		n, err := strconv.Atoi(string(in[start:i]))
+		return n, err == nil
+	}
+	f.width, _ = readNumber()
+	if i < len(in) && in[i] == ',' {
+		f.thousands = true
+		i++
+	}
+	if i < len(in) && in[i] == '.' {
+		i++
+		var ok bool
+		f.precision, ok = readNumber()
+		if !ok {
+			return nil, ExceptionNewf(ValueError, "Format specifier missing precision")
+		}
+	}
+	if len(in)-i > 1 {
+		return nil, ExceptionNewf(ValueError, "Invalid format specifier")
+	}
+	if i < len(in) {
+		f.typ = in[i]
+	}
+	return f, nil
+}
+
+// unknownFormat returns the error for a format code not supported by a type
+func (f *formatSpec) unknownFormat(self Object) error {
+	return ExceptionNewf(ValueError, "Unknown format code '%c' for object of type '%s'", f.typ, self.Type().Name)
+}
+
+// pad aligns s to the width of the format spec
+//
+// sign is the sign and any prefix which goes before any '=' padding
+func (f *formatSpec) pad(sign, s string, defaultAlign rune) String {
+	n := f.width - utf8.RuneCountInString(sign) - utf8.RuneCountInString(s)
+	if n <= 0 {
+		return String(sign + s)
+	}
+	align := f.align
+	if align == 0 {
+		align = defaultAlign
+	}
+	fill := strings.Repeat(string(f.fill), n)
+	switch align {
+	case '<':
+		return String(sign + s + fill)
+	case '=':
+		return String(sign + fill + s)
+	case '^':
+		left := strings.Repeat(string(f.fill), n/2)
+		right := strings.Repeat(string(f.fill), n-n/2)
+		return String(left + sign + s + right)
+	}
+	return String(fill + sign + s)
+}
+
+// signString returns the sign to use for a number
+func (f *formatSpec) signString(negative bool) string {
+	if negative {
+		return "-"
+	}
+	switch f.sign {
+	case '+':
+		return "+"
+	case ' ':
+		return " "
+	}
+	return ""
+}
+
+// groupThousands inserts a comma every three digits of the integer
+// part of digits
+func groupThousands(digits string) string {
+	end := strings.IndexFunc(digits, func(c rune) bool { return c < '0' || c > '9' })
+	if end < 0 {
+		end = len(digits)
+	}
+	intPart, rest := digits[:end], digits[end:]
+	if len(intPart) <= 3 {
+		return digits
+	}
+	var out strings.Builder
+	lead := len(intPart) % 3
+	if lead > 0 {
+		out.WriteString(intPart[:lead])
+	}
+	for i := lead; i < len(intPart); i += 3 {
+		if out.Len() > 0 {
+			out.WriteByte(',')
+		}
+		out.WriteString(intPart[i : i+3])
+	}
+	out.WriteString(rest)
+	return out.String()
+}
+
+// formatString formats a str according to the format spec
+func formatString(self Object, s string, spec string) (Object, error) {
+	f, err := parseFormatSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	if f.typ != 0 && f.typ != 's' {
+		return nil, f.unknownFormat(self)
+	}
+	if f.sign != 0 {
+		return nil, ExceptionNewf(ValueError, "Sign not allowed in string format specifier")
+	}
+	if f.alternate {
+		return nil, ExceptionNewf(ValueError, "Alternate form (#) not allowed in string format specifier")
+	}
+	if f.align == '=' {
+		return nil, ExceptionNewf(ValueError, "'=' alignment not allowed in string format specifier")
+	}
+	if f.precision >= 0 && utf8.RuneCountInString(s) > f.precision {
+		s = string([]rune(s)[:f.precision])
+	}
+	return f.pad("", s, '<'), nil
+}
+
+// formatInt formats an integer according to the format spec
+func formatInt(self Object, x *big.Int, spec string) (Object, error) {
+	f, err := parseFormatSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	switch f.typ {
+	case 'e', 'E', 'f', 'F', 'g', 'G', '%':
+		fx, _ := new(big.Float).SetInt(x).Float64()
+		return formatFloat(self, fx, spec)
+	}
+	if f.precision >= 0 {
+		return nil, ExceptionNewf(ValueError, "Precision not allowed in integer format specifier")
+	}
+	negative := x.Sign() < 0
+	abs := new(big.Int).Abs(x)
+	var digits, prefix string
+	switch f.typ {
+	case 0, 'd', 'n':
+		digits = abs.Text(10)
+	case 'b':
+		digits, prefix = abs.Text(2), "0b"
+	case 'o':
+		digits, prefix = abs.Text(8), "0o"
+	case 'x':
+		digits, prefix = abs.Text(16), "0x"
+	case 'X':
+		digits, prefix = strings.ToUpper(abs.Text(16)), "0X"
+	case 'c':
+		if f.sign != 0 {
+			return nil, ExceptionNewf(ValueError, "Sign not allowed with integer format specifier 'c'")
+		}
+		if !x.IsInt64() || x.Int64() < 0 || x.Int64() > 0x10ffff {
+			return nil, ExceptionNewf(OverflowError, "%%c arg not in range(0x110000)")
+		}
+		return f.pad("", string(rune(x.Int64())), '<'), nil
+	default:
+		return nil, f.unknownFormat(self)
+	}
+	if f.thousands {
+		if f.typ != 0 && f.typ != 'd' {
+			return nil, ExceptionNewf(ValueError, "Cannot specify ',' with '%c'.", f.typ)
+		}
+		digits = groupThousands(digits)
+	}
+	sign := f.signString(negative)
+	if f.alternate {
+		sign += prefix
+	}
+	return f.pad(sign, digits, '>'), nil
+}
+
+// formatFloat formats a float according to the format spec
+func formatFloat(self Object, x float64, spec string) (Object, error) {
+	f, err := parseFormatSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	typ := f.typ
+	precision := f.precision
+	switch typ {
+	case 0:
+		if precision < 0 {
+			// Same as str() but padded
+			s, err := Str(Float(math.Abs(x)))
+			if err != nil {
+				return nil, err
+			}
+			return f.pad(f.signString(math.Signbit(x) && !math.IsNaN(x)), string(s.(String)), '>'), nil
+		}
+	case 'e', 'E', 'f', 'F', 'g', 'G', '%':
+	case 'n':
+		typ = 'g'
+	default:
+		return nil, f.unknownFormat(self)
+	}
+	if precision < 0 {
+		precision = 6
+	}
+	negative := math.Signbit(x) && !math.IsNaN(x)
+	x = math.Abs(x)
+	var digits string
+	switch {
+	case math.IsInf(x, 0):
+		digits = "inf"
+	case math.IsNaN(x):
+		digits = "nan"
+	case typ == 'e' || typ == 'E':
+		digits = strconv.FormatFloat(x, 'e', precision, 64)
+		if f.alternate && precision == 0 {
+			digits = strings.Replace(digits, "e", ".e", 1)
+		}
+	case typ == 'f' || typ == 'F':
+		digits = strconv.FormatFloat(x, 'f', precision, 64)
+		if f.alternate && precision == 0 {
+			digits += "."
+		}
+	case typ == '%':
+		digits = strconv.FormatFloat(x*100, 'f', precision, 64)
+		if f.alternate && precision == 0 {
+			digits += "."
+		}
+	default:
+		digits = formatGeneral(x, precision, f.alternate, typ == 0)
+	}
+	if typ == 'E' || typ == 'F' || typ == 'G' {
+		digits = strings.ToUpper(digits)
+	}
+	if typ == '%' {
+		digits += "%"
+	}
+	if f.thousands {
+		digits = groupThousands(digits)
+	}
+	return f.pad(f.signString(negative), digits, '>'), nil
+}
+
+// formatGeneral formats x using the rules of the 'g' format type
+//
+// If noType is set then fixed point output always has at least one
+// digit after the decimal point, as used when no type is given
+func formatGeneral(x float64, precision int, alternate bool, noType bool) string {
+	if precision == 0 {
+		precision = 1
+	}
+	exp := 0
+	if x != 0 {
+		e := strconv.FormatFloat(x, 'e', precision-1, 64)
+		exp, _ = strconv.Atoi(e[strings.IndexByte(e, 'e')+1:])
+	}
+	var s string
+	if exp >= -4 && exp < precision {
+		s = strconv.FormatFloat(x, 'f', precision-1-exp, 64)
+		if !alternate {
+			if strings.IndexByte(s, '.') >= 0 {
+				s = strings.TrimRight(s, "0")
+				s = strings.TrimSuffix(s, ".")
+			}
+			if noType && strings.IndexByte(s, '.') < 0 {
+				s += ".0"
+			}
+		} else if strings.IndexByte(s, '.') < 0 {
+			s += "."
+		}
+	} else {
+		s = strconv.FormatFloat(x, 'e', precision-1, 64)
+		if !alternate {
+			i := strings.IndexByte(s, 'e')
+			mantissa := s[:i]
+			if strings.IndexByte(mantissa, '.') >= 0 {
+				mantissa = strings.TrimRight(mantissa, "0")
+				mantissa = strings.TrimSuffix(mantissa, ".")
+			}
+			s = mantissa + s[i:]
+		}
+	}
+	return s
+}
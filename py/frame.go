@@ -25,7 +25,7 @@ type TryBlock struct {
 
 // A python Frame object
 type Frame struct {
-	// Back       *Frame        // previous frame, or nil
+	Back            *Frame     // previous frame, or nil
 	Code            *Code      // code segment
 	Builtins        StringDict // builtin symbol table
 	Globals         StringDict // global symbol table
@@ -54,7 +54,8 @@ type Frame struct {
 	// Borrowed reference to a generator, or NULL
 	// Gen Object
 
-	// FIXME Tstate *PyThreadState
+	Context *Context // interpreter the frame is running in
+
 	Lasti int32 // Last instruction if called
 	// Call PyFrame_GetLineNumber() instead of reading this field
 	// directly.  As of 2.3 f_lineno is only valid when tracing is
@@ -89,13 +90,15 @@ func NewFrame(globals, locals StringDict, code *Code, closure Tuple) *Frame {
 	//freeVars := allocation[nlocals+ncells : varsize]
 	cellAndFreeVars := allocation[nlocals:varsize]
 
+	ctx := GlobalsContext(globals)
 	return &Frame{
 		Globals:         globals,
 		Locals:          locals,
 		Code:            code,
 		LocalVars:       localVars,
 		CellAndFreeVars: cellAndFreeVars,
-		Builtins:        Builtins.Globals,
+		Context:         ctx,
+		Builtins:        ctx.Builtins().Globals,
 		Localsplus:      allocation,
 		Stack:           make([]Object, 0, code.Stacksize),
 	}
@@ -209,6 +209,32 @@ func init() {
 			return nil
 		},
 	}
+	FunctionType.Dict["__doc__"] = &Property{
+		Fget: func(self Object) (Object, error) {
+			doc := self.(*Function).Doc
+			if doc == nil {
+				return None, nil
+			}
+			return doc, nil
+		},
+		Fset: func(self, value Object) error {
+			self.(*Function).Doc = value
+			return nil
+		},
+	}
+	FunctionType.Dict["__module__"] = &Property{
+		Fget: func(self Object) (Object, error) {
+			module := self.(*Function).Module
+			if module == nil {
+				return None, nil
+			}
+			return module, nil
+		},
+		Fset: func(self, value Object) error {
+			self.(*Function).Module = value
+			return nil
+		},
+	}
 	FunctionType.Dict["__qualname__"] = &Property{
 		Fget: func(self Object) (Object, error) {
 			return String(self.(*Function).Qualname), nil
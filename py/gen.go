@@ -50,6 +50,7 @@ var data = Data{
 		{Name: "add", Title: "Add", Operator: "+", Binary: true},
 		{Name: "sub", Title: "Sub", Operator: "-", Binary: true},
 		{Name: "mul", Title: "Mul", Operator: "*", Binary: true},
+		{Name: "matmul", Title: "MatMul", Operator: "@", Binary: true},
 		{Name: "truediv", Title: "TrueDiv", Operator: "/", Binary: true},
 		{Name: "floordiv", Title: "FloorDiv", Operator: "//", Binary: true},
 		{Name: "mod", Title: "Mod", Operator: "%%", Binary: true},
@@ -90,7 +91,11 @@ func main() {
 	}
 }
 
-var program = `// Automatically generated - DO NOT EDIT
+var program = `// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Automatically generated - DO NOT EDIT
 // Regenerate with: go generate
 
 // Arithmetic operations
@@ -115,6 +120,8 @@ func {{.Title}}(a Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall0(a, "__{{.Name}}__"); ok {
+		return res, err
 	}
 
 	return nil, ExceptionNewf(TypeError, "unsupported operand type(s) for {{.Operator}}: '%s'", a.Type().Name)
@@ -137,7 +144,14 @@ func {{.Title}}(a, b {{ if .Ternary }}, c{{ end }} Object) (Object {{ if .TwoRet
 		if res != NotImplemented {
 			return res {{ if .TwoReturnParameters }}, res2{{ end }}, nil
 		}
-	}
+	}{{ if .Binary }}{{ if not .TwoReturnParameters }} else if res, ok, err := TypeCall1(a, "__{{.Name}}__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
+	}{{ end }}{{ end }}
 
 	// Now using b to r{{.Name}} if different in type to a
 	if {{ if .Ternary }} c == None && {{ end }} a.Type() != b.Type() {
@@ -149,7 +163,14 @@ func {{.Title}}(a, b {{ if .Ternary }}, c{{ end }} Object) (Object {{ if .TwoRet
 			if res != NotImplemented {
 				return res{{ if .TwoReturnParameters}}, res2{{ end }}, nil
 			}
-		}
+		}{{ if .Binary }}{{ if not .TwoReturnParameters }} else if res, ok, err := TypeCall1(b, "__r{{.Name}}__", a); ok {
+			if err != nil {
+				return nil, err
+			}
+			if res != NotImplemented {
+				return res, nil
+			}
+		}{{ end }}{{ end }}
 	}
 	return nil{{ if .TwoReturnParameters}}, nil{{ end }}, ExceptionNewf(TypeError, "unsupported operand type(s) for {{.Operator}}: '%s' and '%s'", a.Type().Name, b.Type().Name)
 }
@@ -165,7 +186,14 @@ func I{{.Title}}(a, b {{ if .Ternary }}, c{{ end }} Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
-	}
+	}{{ if .Binary }} else if res, ok, err := TypeCall1(a, "__i{{.Name}}__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
+	}{{ end }}
 	return {{.Title}}(a, b {{ if .Ternary }}, c{{ end }})
 }
 {{end}}
@@ -185,6 +213,13 @@ func {{.Title}}(a Object, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(a, "__{{.Name}}__", b); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 	// Try using b to {{.Reversed}} with reversed parameters
@@ -196,6 +231,13 @@ func {{.Title}}(a Object, b Object) (Object, error) {
 		if res != NotImplemented {
 			return res, nil
 		}
+	} else if res, ok, err := TypeCall1(b, "__{{.Reversed}}__", a); ok {
+		if err != nil {
+			return nil, err
+		}
+		if res != NotImplemented {
+			return res, nil
+		}
 	}
 
 {{ if .FailReturn}}
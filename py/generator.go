@@ -8,7 +8,7 @@ package py
 
 // A python Generator object
 type Generator struct {
-	// Note: gi_frame can be NULL if the generator is "finished"
+	// Note: gi_frame is nil if the generator is "finished"
 	Frame *Frame
 
 	// True if generator is being executed.
@@ -81,31 +81,7 @@ func (it *Generator) M__next__() (Object, error) {
 // generator, it must be called with None as the argument, because
 // there is no yield expression that could receive the value.
 func (it *Generator) Send(arg Object) (Object, error) {
-	if it.Running {
-		return nil, ExceptionNewf(ValueError, "generator already executing")
-	}
-	if it.Frame.Lasti == 0 {
-		if arg != None {
-			return nil, ExceptionNewf(TypeError, "can't send non-None value to a just-started generator")
-		}
-	} else {
-		// If already returned a non yield value then stop
-		if !it.Frame.Yielded {
-			return nil, StopIteration
-		}
-		// Push arg onto the frame's value stack
-		it.Frame.Stack = append(it.Frame.Stack, arg)
-	}
-	it.Running = true
-	res, err := VmRunFrame(it.Frame)
-	it.Running = false
-	if err != nil {
-		return nil, err
-	}
-	if it.Frame.Yielded {
-		return res, nil
-	}
-	return nil, StopIteration
+	return it.resume(arg, nil, "generator")
 }
 
 // generator.throw(type[, value[, traceback]])
@@ -117,7 +93,11 @@ func (it *Generator) Send(arg Object) (Object, error) {
 // not catch the passed-in exception, or raises a different exception,
 // then that exception propagates to the caller.
 func (it *Generator) Throw(args Tuple, kwargs StringDict) (Object, error) {
-	return nil, NotImplementedError
+	exc, err := makeThrowException(args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	return it.resume(None, exc, "generator")
 }
 
 // generator.close()
@@ -131,7 +111,132 @@ func (it *Generator) Throw(args Tuple, kwargs StringDict) (Object, error) {
 // caller. close() does nothing if the generator has already exited
 // due to an exception or normal exit.
 func (it *Generator) Close() (Object, error) {
-	return nil, NotImplementedError
+	return it.close("generator")
+}
+
+// resume runs the generator until it next yields or finishes
+//
+// If exc is set it is raised at the point where the generator was
+// paused, otherwise arg becomes the result of the yield
+// expression. kind is the name of the object used in error messages.
+//
+// This is the equivalent of gen_send_ex
+func (it *Generator) resume(arg Object, exc error, kind string) (Object, error) {
+	if it.Running {
+		return nil, ExceptionNewf(ValueError, "%s already executing", kind)
+	}
+	if it.Frame == nil {
+		// Already finished
+		if exc != nil {
+			return nil, exc
+		}
+		if kind == "coroutine" {
+			return nil, ExceptionNewf(RuntimeError, "cannot reuse already awaited coroutine")
+		}
+		return nil, StopIteration
+	}
+	if it.Frame.Lasti == 0 {
+		if exc == nil && arg != None {
+			return nil, ExceptionNewf(TypeError, "can't send non-None value to a just-started %s", kind)
+		}
+	} else if exc == nil {
+		// Push arg onto the frame's value stack
+		it.Frame.Stack = append(it.Frame.Stack, arg)
+	}
+	it.Running = true
+	var res Object
+	var err error
+	if exc != nil {
+		res, err = VmRunFrameThrow(it.Frame, exc)
+	} else {
+		res, err = VmRunFrame(it.Frame)
+	}
+	it.Running = false
+	if err == nil && it.Frame.Yielded {
+		return res, nil
+	}
+	// The generator has finished so release the frame
+	it.Frame = nil
+	if err != nil {
+		if kind == "coroutine" && IsException(StopIteration, err) {
+			return nil, ExceptionNewf(RuntimeError, "coroutine raised StopIteration")
+		}
+		return nil, err
+	}
+	if res != None {
+		return nil, exceptionNew(StopIteration, Tuple{res})
+	}
+	return nil, StopIteration
+}
+
+// close raises GeneratorExit in the generator
+func (it *Generator) close(kind string) (Object, error) {
+	_, err := it.resume(None, exceptionNew(GeneratorExit, nil), kind)
+	if err == nil {
+		return nil, ExceptionNewf(RuntimeError, "%s ignored GeneratorExit", kind)
+	}
+	if IsException(GeneratorExit, err) || IsException(StopIteration, err) {
+		return None, nil
+	}
+	return nil, err
+}
+
+// makeThrowException makes the exception to raise from the arguments
+// to throw(typ[,val[,tb]])
+func makeThrowException(args Tuple, kwargs StringDict) (error, error) {
+	var typ, val, tb Object = nil, None, None
+	err := UnpackTuple(args, kwargs, "throw", 1, 3, &typ, &val, &tb)
+	if err != nil {
+		return nil, err
+	}
+	if tb != None {
+		if _, ok := tb.(*Traceback); !ok {
+			return nil, ExceptionNewf(TypeError, "throw() third argument must be a traceback object")
+		}
+	}
+	switch x := typ.(type) {
+	case *Exception:
+		if val != None {
+			return nil, ExceptionNewf(TypeError, "instance exception may not have a separate value")
+		}
+		return x, nil
+	case *Type:
+		if !ExceptionClassCheck(x) {
+			break
+		}
+		if e, ok := val.(*Exception); ok && e.Type().IsSubtype(x) {
+			return e, nil
+		}
+		var callArgs Tuple
+		switch v := val.(type) {
+		case Tuple:
+			callArgs = v
+		default:
+			if val != None {
+				callArgs = Tuple{val}
+			}
+		}
+		res, err := Call(x, callArgs, nil)
+		if err != nil {
+			return nil, err
+		}
+		return MakeException(res), nil
+	}
+	return nil, ExceptionNewf(TypeError, "exceptions must be classes or instances deriving from BaseException, not %s", typ.Type().Name)
+}
+
+// Properties
+func init() {
+	GeneratorType.Dict["gi_running"] = &Property{
+		Fget: func(self Object) (Object, error) {
+			return NewBool(self.(*Generator).Running), nil
+		},
+	}
+	GeneratorType.Dict["gi_code"] = &Property{
+		Fget: func(self Object) (Object, error) {
+			return self.(*Generator).Code, nil
+		},
+	}
 }
 
 // Check interface is satisfied
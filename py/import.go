@@ -7,18 +7,215 @@
 package py
 
 import (
-	"io/ioutil"
-	"os"
 	"path"
 	"path/filepath"
 	"strings"
+
+	"github.com/go-python/gpython/vfs"
 )
 
 var (
-	// This will become sys.path one day ;-)
+	// The default module search path used for sys.path
 	modulePath = []string{"", "/usr/lib/python3.4", "/usr/local/lib/python3.4/dist-packages", "/usr/lib/python3/dist-packages"}
+
+	// Made Importers for path entries which aren't directories
+	pathHooks []PathHook
 )
 
+// Importer finds and loads the modules in a module search path entry
+type Importer interface {
+	// FindModule returns the filename of the module name, a full
+	// dotted name, and whether it is a package, or an ImportError
+	// if it can't be found
+	FindModule(name string) (filename string, isPackage bool, err error)
+
+	// GetCode returns the code object for the module name
+	GetCode(name string) (*Code, error)
+}
+
+// PathHook makes an Importer for a module search path entry of ctx
+// or returns an ImportError if it can't handle the entry
+type PathHook func(ctx *Context, entry string) (Importer, error)
+
+// AddPathHook registers hook to make Importers for module search path
+// entries which aren't directories, such as zip files
+func AddPathHook(hook PathHook) {
+	pathHooks = append(pathHooks, hook)
+}
+
+// DefaultModulePath returns the module search path used to initialise
+// sys.path
+func DefaultModulePath() []string {
+	return append([]string(nil), modulePath...)
+}
+
+// Returns the module search path which is sys.path of ctx if it has
+// been set up or the default path if not
+func searchPath(ctx *Context) []string {
+	sys, ok := ctx.modules["sys"]
+	if !ok {
+		return modulePath
+	}
+	list, ok := sys.Globals["path"].(*List)
+	if !ok {
+		return modulePath
+	}
+	var entries []string
+	for _, item := range list.Items {
+		if entry, ok := item.(String); ok {
+			entries = append(entries, string(entry))
+		}
+	}
+	return entries
+}
+
+// dirImporter imports python source files from a directory
+type dirImporter struct {
+	fsys vfs.FS
+	dir  string
+}
+
+// FindModule looks for a package directory containing __init__.py
+// or a name.py file
+func (d dirImporter) FindModule(name string) (string, bool, error) {
+	fullPath := filepath.Join(d.dir, filepath.Join(strings.Split(name, ".")...))
+	// FIXME Read pyc/pyo too
+	if fi, err := d.fsys.Stat(fullPath); err == nil && fi.IsDir() {
+		// FIXME this is a massive simplification!
+		initPath := filepath.Join(fullPath, "__init__.py")
+		if _, err := d.fsys.Stat(initPath); err == nil {
+			return initPath, true, nil
+		}
+	}
+	fullPath += ".py"
+	if _, err := d.fsys.Stat(fullPath); err == nil {
+		return fullPath, false, nil
+	}
+	return "", false, ExceptionNewf(ImportError, "No module named '%s'", name)
+}
+
+// GetCode compiles the source of the module
+func (d dirImporter) GetCode(name string) (*Code, error) {
+	fullPath, _, err := d.FindModule(name)
+	if err != nil {
+		return nil, err
+	}
+	str, err := vfs.ReadFile(d.fsys, fullPath)
+	if err != nil {
+		return nil, ExceptionNewf(OSError, "Couldn't read %q: %v", fullPath, err)
+	}
+	codeObj, err := Compile(string(str), fullPath, "exec", 0, true)
+	if err != nil {
+		return nil, err
+	}
+	code, ok := codeObj.(*Code)
+	if !ok {
+		return nil, ExceptionNewf(ImportError, "Compile didn't return code object")
+	}
+	return code, nil
+}
+
+// GetImporter returns the Importer for a module search path entry of
+// ctx
+//
+// Directories of the context's filesystem are handled directly and
+// other entries are passed to the hooks registered with AddPathHook.
+// It returns an ImportError if nothing can import from entry.
+func GetImporter(ctx *Context, entry string) (Importer, error) {
+	fsys := ctx.FS()
+	if fi, err := fsys.Stat(entry); err == nil && fi.IsDir() {
+		return dirImporter{fsys: fsys, dir: entry}, nil
+	}
+	for _, hook := range pathHooks {
+		importer, err := hook(ctx, entry)
+		if err == nil {
+			return importer, nil
+		}
+		if !IsException(ImportError, err) {
+			return nil, err
+		}
+	}
+	return nil, ExceptionNewf(ImportError, "No importer for path %q", entry)
+}
+
+// ModuleSpec says where FindModule found a module
+type ModuleSpec struct {
+	Name      string   // full dotted name of the module
+	Filename  string   // where the module was found, used for __file__
+	IsPackage bool     // set if the module is a package
+	Importer  Importer // to load the module with
+}
+
+// FindModule searches the module path for the module name
+//
+// "" in the path means the directory of __file__ in globals or the
+// current directory if there isn't one.  The path is sys.path of the
+// context globals belong to.  If the module isn't found it returns an
+// ImportError.
+func FindModule(name string, globals StringDict) (*ModuleSpec, error) {
+	return findModule(GlobalsContext(globals), name, globals)
+}
+
+// Searches sys.path of ctx for the module name - see FindModule
+func findModule(ctx *Context, name string, globals StringDict) (*ModuleSpec, error) {
+	for _, mpath := range searchPath(ctx) {
+		if mpath == "" {
+			mpathObj, ok := globals["__file__"]
+			if !ok {
+				var err error
+				mpath, err = ctx.FS().Getwd()
+				if err != nil {
+					return nil, err
+				}
+			} else {
+				mpath = path.Dir(string(mpathObj.(String)))
+			}
+		}
+		mpath, err := vfs.Abs(ctx.FS(), mpath)
+		if err != nil {
+			continue
+		}
+		importer, err := GetImporter(ctx, mpath)
+		if err != nil {
+			continue
+		}
+		filename, isPackage, err := importer.FindModule(name)
+		if err == nil {
+			return &ModuleSpec{
+				Name:      name,
+				Filename:  filename,
+				IsPackage: isPackage,
+				Importer:  importer,
+			}, nil
+		}
+		if !IsException(ImportError, err) {
+			return nil, err
+		}
+	}
+	return nil, ExceptionNewf(ImportError, "No module named '%s'", name)
+}
+
+// LoadModule runs the module found by FindModule in ctx and returns
+// it
+//
+// The module is registered under spec.Name before it runs.
+func LoadModule(ctx *Context, spec *ModuleSpec) (*Module, error) {
+	code, err := spec.Importer.GetCode(spec.Name)
+	if err != nil {
+		return nil, err
+	}
+	module := ctx.NewModule(spec.Name, "", nil, nil)
+	module.Globals["__file__"] = String(spec.Filename)
+	if obj, ok := spec.Importer.(Object); ok {
+		module.Globals["__loader__"] = obj
+	}
+	_, err = VmRun(module.Globals, module.Globals, code, nil)
+	if err != nil {
+		return nil, err
+	}
+	return module, nil
+}
+
 // The workings of __import__
 //
 // __import__(name, globals=None, locals=None, fromlist=(), level=0)
@@ -78,68 +275,45 @@ var (
 //
 // Changed in version 3.3: Negative values for level are no longer
 // supported (which also changes the default value to 0).
+//
+// The module is imported into the context globals belong to.
 func ImportModuleLevelObject(name string, globals, locals StringDict, fromlist Tuple, level int) (Object, error) {
-	// Module already loaded - return that
-	if module, ok := modules[name]; ok {
-		return module, nil
-	}
-
+	ctx := GlobalsContext(globals)
 	if level != 0 {
+		if module, ok := ctx.modules[name]; ok {
+			return module, nil
+		}
 		return nil, ExceptionNewf(SystemError, "Relative import not supported yet")
 	}
 
-	parts := strings.Split(name, ".")
-	pathParts := path.Join(parts...)
+	module, err := ctx.importModule(name, globals)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, mpath := range modulePath {
-		if mpath == "" {
-			mpathObj, ok := globals["__file__"]
-			if !ok {
-				var err error
-				mpath, err = os.Getwd()
-				if err != nil {
-					return nil, err
-				}
-			} else {
-				mpath = path.Dir(string(mpathObj.(String)))
-			}
+	// import a.b binds a so return the top level package
+	if len(fromlist) == 0 {
+		if i := strings.Index(name, "."); i >= 0 {
+			return ctx.modules[name[:i]], nil
 		}
-		fullPath := path.Join(mpath, pathParts)
-		// FIXME Read pyc/pyo too
-		fullPath, err := filepath.Abs(fullPath)
-		if err != nil {
+		return module, nil
+	}
+
+	// from a import b imports the submodule a.b if b isn't in a
+	for _, item := range fromlist {
+		item, ok := item.(String)
+		if !ok || item == "*" {
 			continue
 		}
-		if fi, err := os.Stat(fullPath); err == nil && fi.IsDir() {
-			// FIXME this is a massive simplification!
-			fullPath = path.Join(fullPath, "__init__.py")
-		} else {
-			fullPath += ".py"
+		if _, found := module.Globals[string(item)]; found {
+			continue
 		}
-		// Check if file exists
-		if _, err := os.Stat(fullPath); err == nil {
-			str, err := ioutil.ReadFile(fullPath)
-			if err != nil {
-				return nil, ExceptionNewf(OSError, "Couldn't read %q: %v", fullPath, err)
-			}
-			codeObj, err := Compile(string(str), fullPath, "exec", 0, true)
-			if err != nil {
-				return nil, err
-			}
-			code, ok := codeObj.(*Code)
-			if !ok {
-				return nil, ExceptionNewf(ImportError, "Compile didn't return code object")
-			}
-			module := NewModule(name, "", nil, nil)
-			_, err = VmRun(module.Globals, module.Globals, code, nil)
-			if err != nil {
-				return nil, err
-			}
-			module.Globals["__file__"] = String(fullPath)
-			return module, nil
+		_, err := ctx.importModule(name+"."+string(item), globals)
+		if err != nil && !IsException(ImportError, err) {
+			return nil, err
 		}
 	}
-	return nil, ExceptionNewf(ImportError, "No module named '%s'", name)
+	return module, nil
 
 	// Convert to absolute path if relative
 	// Use __file__ from globals to work out what we are relative to
@@ -158,6 +332,49 @@ func ImportModuleLevelObject(name string, globals, locals StringDict, fromlist T
 
 }
 
+// Imports the module name, importing the packages it is in first
+//
+// A submodule is set as an attribute of its package once loaded.
+func (ctx *Context) importModule(name string, globals StringDict) (*Module, error) {
+	// Module already loaded - return that
+	if module, ok := ctx.modules[name]; ok {
+		return module, nil
+	}
+	var parent *Module
+	i := strings.LastIndex(name, ".")
+	if i >= 0 {
+		var err error
+		parent, err = ctx.importModule(name[:i], globals)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Module implemented in Go - return the context's copy
+	if module, err := ctx.GetModule(name); err == nil {
+		return module, nil
+	}
+	spec, err := findModule(ctx, name, globals)
+	if err != nil {
+		return nil, err
+	}
+	module, err := LoadModule(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	if parent != nil {
+		parent.Globals[name[i+1:]] = module
+	}
+	return module, nil
+}
+
+// Import imports the module name, a full dotted name, into the
+// context and returns it
+//
+// An empty entry in sys.path means the current directory.
+func (ctx *Context) Import(name string) (*Module, error) {
+	return ctx.importModule(name, nil)
+}
+
 // Straight port of the python code
 //
 // This calls functins from _bootstrap.py which is a frozen module
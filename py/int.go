@@ -201,6 +201,10 @@ func (a Int) M__repr__() (Object, error) {
 	return a.M__str__()
 }
 
+func (a Int) M__format__(formatSpec Object) (Object, error) {
+	return formatInt(a, big.NewInt(int64(a)), string(formatSpec.(String)))
+}
+
 // Arithmetic
 
 // Errors
@@ -723,5 +727,6 @@ var _ conversionBetweenTypes = Int(0)
 var _ I__bool__ = Int(0)
 var _ I__index__ = Int(0)
 var _ richComparison = Int(0)
+var _ I__format__ = Int(0)
 var _ IGoInt = Int(0)
 var _ IGoInt64 = Int(0)
@@ -156,6 +156,29 @@ func Not(a Object) (Object, error) {
 	return nil, ExceptionNewf(TypeError, "bool() didn't return True or False")
 }
 
+// Return the result of a is b
+//
+// Objects which are Go maps or slices (such as StringDict and Tuple)
+// can't be compared with == so they are the same object if they
+// share the same storage.
+func Is(a, b Object) bool {
+	ta := reflect.TypeOf(a)
+	if ta != reflect.TypeOf(b) {
+		return false
+	}
+	if ta == nil || ta.Comparable() {
+		return a == b
+	}
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	switch va.Kind() {
+	case reflect.Map:
+		return va.Pointer() == vb.Pointer()
+	case reflect.Slice:
+		return va.Pointer() == vb.Pointer() && va.Len() == vb.Len()
+	}
+	return false
+}
+
 // Calls function fnObj with args and kwargs in a new vm (or directly
 // if Go code)
 //
@@ -202,6 +225,14 @@ func DelItem(self Object, key Object) (Object, error) {
 	return nil, ExceptionNewf(TypeError, "'%s' object does not support item deletion", self.Type().Name)
 }
 
+// Binds a classmethod read from the class T to T
+func classAttr(T *Type, res Object) (Object, error) {
+	if c, ok := res.(*ClassMethod); ok {
+		return c.M__get__(None, T)
+	}
+	return res, nil
+}
+
 // GetAttrString - returns the result or an err to be raised if not found
 //
 // If not found err will be an AttributeError
@@ -227,10 +258,21 @@ func GetAttrString(self Object, key string) (res Object, err error) {
 		dict := I.GetDict()
 		res, ok = dict[key]
 		if ok {
+			if T, ok := self.(*Type); ok {
+				return classAttr(T, res)
+			}
 			return res, err
 		}
 	}
 
+	// If this is a class then look in its base classes
+	if T, ok := self.(*Type); ok {
+		res = T.Lookup(key)
+		if res != nil {
+			return classAttr(T, res)
+		}
+	}
+
 	// Now look in type's dictionary etc
 	t := self.Type()
 	res = t.NativeGetAttrOrNil(key)
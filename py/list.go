@@ -331,3 +331,35 @@ func (a *List) M__ne__(other Object) (Object, error) {
 	}
 	return False, nil
 }
+
+func (a *List) M__lt__(other Object) (Object, error) {
+	b, ok := other.(*List)
+	if !ok {
+		return NotImplemented, nil
+	}
+	return SequenceRichCompare(a.Items, b.Items, Lt)
+}
+
+func (a *List) M__le__(other Object) (Object, error) {
+	b, ok := other.(*List)
+	if !ok {
+		return NotImplemented, nil
+	}
+	return SequenceRichCompare(a.Items, b.Items, Le)
+}
+
+func (a *List) M__gt__(other Object) (Object, error) {
+	b, ok := other.(*List)
+	if !ok {
+		return NotImplemented, nil
+	}
+	return SequenceRichCompare(a.Items, b.Items, Gt)
+}
+
+func (a *List) M__ge__(other Object) (Object, error) {
+	b, ok := other.(*List)
+	if !ok {
+		return NotImplemented, nil
+	}
+	return SequenceRichCompare(a.Items, b.Items, Ge)
+}
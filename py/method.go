@@ -70,6 +70,8 @@ type Method struct {
 	Flags int
 	// Go function implementation
 	method interface{}
+	// Module this is a function of, passed as self
+	module *Module
 }
 
 // Internal method types implemented within eval.go
@@ -231,7 +233,10 @@ func newBoundMethod(name string, fn interface{}) (Object, error) {
 
 // Call a method
 func (m *Method) M__call__(args Tuple, kwargs StringDict) (Object, error) {
-	self := None // FIXME should be the module
+	var self Object = None
+	if m.module != nil {
+		self = m.module
+	}
 	if kwargs != nil {
 		return m.CallWithKeywords(self, args, kwargs)
 	}
@@ -240,7 +245,8 @@ func (m *Method) M__call__(args Tuple, kwargs StringDict) (Object, error) {
 
 // Read a method from a class which makes a bound method
 func (m *Method) M__get__(instance, owner Object) (Object, error) {
-	if instance != None {
+	// Module functions aren't bound as they are passed their module
+	if instance != None && m.module == nil {
 		return NewBoundMethod(instance, m), nil
 	}
 	return m, nil
@@ -262,6 +268,29 @@ func (m *Method) M__ne__(other Object) (Object, error) {
 	return True, nil
 }
 
+// Properties
+func init() {
+	MethodType.Dict["__name__"] = &Property{
+		Fget: func(self Object) (Object, error) {
+			return String(self.(*Method).Name), nil
+		},
+	}
+	MethodType.Dict["__qualname__"] = &Property{
+		Fget: func(self Object) (Object, error) {
+			return String(self.(*Method).Name), nil
+		},
+	}
+	MethodType.Dict["__doc__"] = &Property{
+		Fget: func(self Object) (Object, error) {
+			doc := self.(*Method).Doc
+			if doc == "" {
+				return None, nil
+			}
+			return String(doc), nil
+		},
+	}
+}
+
 // Make sure it satisfies the interface
 var _ Object = (*Method)(nil)
 var _ I__call__ = (*Method)(nil)
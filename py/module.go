@@ -6,12 +6,17 @@
 
 package py
 
-import "fmt"
+import (
+	"fmt"
+	"sync"
+)
 
 var (
-	// Registry of installed modules
-	modules = make(map[string]*Module)
-	// Builtin module
+	// Registry of modules implemented in Go which each Context
+	// gets a copy of
+	modulesMu sync.RWMutex
+	modules   = make(map[string]*Module)
+	// Builtin module of DefaultContext
 	Builtins *Module
 	// this should be the frozen module importlib/_bootstrap.py generated
 	// by Modules/_freeze_importlib.c into Python/importlib.h
@@ -24,6 +29,14 @@ type Module struct {
 	Doc     string
 	Globals StringDict
 	//	dict Dict
+
+	// The interpreter the module belongs to, nil for a module
+	// registered with NewModule which is copied into each Context
+	Context *Context
+
+	// If set on a module registered with NewModule this is called
+	// with each copy made for a Context to give it its own state
+	ContextInit func(m *Module)
 }
 
 var ModuleType = NewType("module", "module object")
@@ -43,6 +56,11 @@ func (m *Module) GetDict() StringDict {
 }
 
 // Define a new module
+//
+// This registers a module implemented in Go which each Context makes
+// its own copy of when it is first used.  The methods are the module
+// functions which are passed the context's copy of the module as
+// self.
 func NewModule(name, doc string, methods []*Method, globals StringDict) *Module {
 	m := &Module{
 		Name:    name,
@@ -51,6 +69,7 @@ func NewModule(name, doc string, methods []*Method, globals StringDict) *Module
 	}
 	// Insert the methods into the module dictionary
 	for _, method := range methods {
+		method.module = m
 		m.Globals[method.Name] = method
 	}
 	// Set some module globals
@@ -58,11 +77,13 @@ func NewModule(name, doc string, methods []*Method, globals StringDict) *Module
 	m.Globals["__doc__"] = String(doc)
 	m.Globals["__package__"] = None
 	// Register the module
+	modulesMu.Lock()
 	modules[name] = m
+	modulesMu.Unlock()
 	// Make a note of some modules
 	switch name {
 	case "builtins":
-		Builtins = m
+		Builtins = DefaultContext.Builtins()
 	case "importlib":
 		Importlib = m
 	}
@@ -70,22 +91,21 @@ func NewModule(name, doc string, methods []*Method, globals StringDict) *Module
 	return m
 }
 
-// Gets a module
+// Returns the module registered with NewModule called name or nil
+func getModuleImpl(name string) *Module {
+	modulesMu.RLock()
+	defer modulesMu.RUnlock()
+	return modules[name]
+}
+
+// Gets a module from DefaultContext
 func GetModule(name string) (*Module, error) {
-	m, ok := modules[name]
-	if !ok {
-		return nil, ExceptionNewf(ImportError, "Module %q not found", name)
-	}
-	return m, nil
+	return DefaultContext.GetModule(name)
 }
 
-// Gets a module or panics
+// Gets a module from DefaultContext or panics
 func MustGetModule(name string) *Module {
-	m, err := GetModule(name)
-	if err != nil {
-		panic(err)
-	}
-	return m
+	return DefaultContext.MustGetModule(name)
 }
 
 // Calls a named method of a module
@@ -0,0 +1,90 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Conversion of Go errors from the OS into OSError
+
+package py
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"syscall"
+)
+
+// The subclasses of OSError raised for particular errnos
+var errnoExceptions = map[syscall.Errno]*Type{
+	syscall.EAGAIN:       BlockingIOError,
+	syscall.EALREADY:     BlockingIOError,
+	syscall.EINPROGRESS:  BlockingIOError,
+	syscall.ECHILD:       ChildProcessError,
+	syscall.EPIPE:        BrokenPipeError,
+	syscall.ECONNABORTED: ConnectionAbortedError,
+	syscall.ECONNREFUSED: ConnectionRefusedError,
+	syscall.ECONNRESET:   ConnectionResetError,
+	syscall.EEXIST:       FileExistsError,
+	syscall.ENOENT:       FileNotFoundError,
+	syscall.EINTR:        InterruptedError,
+	syscall.EISDIR:       IsADirectoryError,
+	syscall.ENOTDIR:      NotADirectoryError,
+	syscall.EACCES:       PermissionError,
+	syscall.EPERM:        PermissionError,
+	syscall.ESRCH:        ProcessLookupError,
+	syscall.ETIMEDOUT:    TimeoutError,
+}
+
+// Returns the errno for err if it has one
+func errnoOf(err error) (syscall.Errno, bool) {
+	var errno syscall.Errno
+	switch {
+	case errors.As(err, &errno):
+		return errno, true
+	case errors.Is(err, os.ErrNotExist):
+		return syscall.ENOENT, true
+	case errors.Is(err, os.ErrExist):
+		return syscall.EEXIST, true
+	case errors.Is(err, os.ErrPermission):
+		return syscall.EACCES, true
+	}
+	return 0, false
+}
+
+// ExceptionFromOSError makes an OSError from an error returned by the
+// os package, choosing the subclass of OSError which matches its errno
+// the way python does, eg FileNotFoundError for ENOENT
+//
+// The message is in python's "[Errno 2] No such file or directory:
+// 'name'" form and the errno, strerror and filename attributes are
+// set. filename may be nil if there isn't one.
+func ExceptionFromOSError(err error, filename Object) *Exception {
+	errno, ok := errnoOf(err)
+	if !ok {
+		e := ExceptionNewf(OSError, "%s", err.Error())
+		e.Dict["errno"] = None
+		e.Dict["strerror"] = String(err.Error())
+		e.Dict["filename"] = None
+		return e
+	}
+	t := errnoExceptions[errno]
+	if t == nil {
+		t = OSError
+	}
+	strerror := errno.Error()
+	if strerror != "" {
+		strerror = strings.ToUpper(strerror[:1]) + strerror[1:]
+	}
+	message := fmt.Sprintf("[Errno %d] %s", int(errno), strerror)
+	if filename == nil {
+		filename = None
+	} else {
+		repr, _ := ReprAsString(filename)
+		message += ": " + repr
+	}
+	e := ExceptionNewf(t, "%s", message)
+	e.Dict["errno"] = Int(errno)
+	e.Dict["strerror"] = String(strerror)
+	e.Dict["filename"] = filename
+	return e
+}
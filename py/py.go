@@ -27,9 +27,11 @@ type IGoInt64 interface {
 // Some well known objects
 var (
 	// Set in vm/eval.go - to avoid circular import
-	VmRun        func(globals, locals StringDict, code *Code, closure Tuple) (res Object, err error)
-	VmRunFrame   func(frame *Frame) (res Object, err error)
-	VmEvalCodeEx func(co *Code, globals, locals StringDict, args []Object, kws StringDict, defs []Object, kwdefs StringDict, closure Tuple) (retval Object, err error)
+	VmRun           func(globals, locals StringDict, code *Code, closure Tuple) (res Object, err error)
+	VmRunFrame      func(frame *Frame) (res Object, err error)
+	VmRunFrameThrow func(frame *Frame, exc error) (res Object, err error)
+	VmEvalCodeEx    func(co *Code, globals, locals StringDict, args []Object, kws StringDict, defs []Object, kwdefs StringDict, closure Tuple) (retval Object, err error)
+	VmExcInfo       func(ctx *Context) ExceptionInfo
 
 	// See compile/compile.go - set to avoid circular import
 	Compile func(str, filename, mode string, flags int, dont_inherit bool) (Object, error)
@@ -600,6 +602,36 @@ type I_generator interface {
 	I_close
 }
 
+// Must return an iterator. Used to implement awaitable objects, for
+// instance asyncio.Future implements this method to be compatible
+// with the await expression.
+//object.__await__(self)
+type I__await__ interface {
+	M__await__() (Object, error)
+}
+
+// Must return an asynchronous iterator object.
+//object.__aiter__(self)
+type I__aiter__ interface {
+	M__aiter__() (Object, error)
+}
+
+// Must return an awaitable resulting in a next value of the
+// iterator. Should raise a StopAsyncIteration error when the
+// iteration is over.
+//object.__anext__(self)
+type I__anext__ interface {
+	M__anext__() (Object, error)
+}
+
+// Interface all coroutines must satisfy
+type I_coroutine interface {
+	I__await__
+	I_send
+	I_throw
+	I_close
+}
+
 // Called (if present) by the reversed() built-in to implement reverse
 // iteration. It should return a new iterator object that iterates
 // over all the objects in the container in reverse order.
@@ -636,8 +668,8 @@ type I__contains__ interface {
 }
 
 // These methods are called to implement the binary arithmetic
-// operations (+, -, *, /, //, %, divmod(), pow(), **, <<, >>, &, ^,
-// |). For instance, to evaluate the expression x + y, where x is an
+// operations (+, -, *, @, /, //, %, divmod(), pow(), **, <<, >>, &,
+// ^, |). For instance, to evaluate the expression x + y, where x is an
 // instance of a class that has an __add__() method, x.__add__(y) is
 // called. The __divmod__() method should be the equivalent to using
 // __floordiv__() and __mod__(); it should not be related to
@@ -663,6 +695,11 @@ type I__mul__ interface {
 	M__mul__(other Object) (Object, error)
 }
 
+// object.__matmul__(self, other)
+type I__matmul__ interface {
+	M__matmul__(other Object) (Object, error)
+}
+
 // object.__truediv__(self, other)
 type I__truediv__ interface {
 	M__truediv__(other Object) (Object, error)
@@ -714,8 +751,8 @@ type I__or__ interface {
 }
 
 // These methods are called to implement the binary arithmetic
-// operations (+, -, *, /, //, %, divmod(), pow(), **, <<, >>, &, ^,
-// |) with reflected (swapped) operands. These functions are only
+// operations (+, -, *, @, /, //, %, divmod(), pow(), **, <<, >>, &,
+// ^, |) with reflected (swapped) operands. These functions are only
 // called if the left operand does not support the corresponding
 // operation and the operands are of different types. [2] For
 // instance, to evaluate the expression x - y, where y is an instance
@@ -746,6 +783,11 @@ type I__rmul__ interface {
 	M__rmul__(other Object) (Object, error)
 }
 
+// object.__rmatmul__(self, other)
+type I__rmatmul__ interface {
+	M__rmatmul__(other Object) (Object, error)
+}
+
 // object.__rtruediv__(self, other)
 type I__rtruediv__ interface {
 	M__rtruediv__(other Object) (Object, error)
@@ -797,8 +839,8 @@ type I__ror__ interface {
 }
 
 // These methods are called to implement the augmented arithmetic
-// assignments (+=, -=, *=, /=, //=, %=, **=, <<=, >>=, &=, ^=,
-// |=). These methods should attempt to do the operation in-place
+// assignments (+=, -=, *=, @=, /=, //=, %=, **=, <<=, >>=, &=,
+// ^=, |=). These methods should attempt to do the operation in-place
 // (modifying self) and return the result (which could be, but does
 // not have to be, self). If a specific method is not defined, the
 // augmented assignment falls back to the normal methods. For
@@ -823,6 +865,11 @@ type I__imul__ interface {
 	M__imul__(other Object) (Object, error)
 }
 
+// object.__imatmul__(self, other)
+type I__imatmul__ interface {
+	M__imatmul__(other Object) (Object, error)
+}
+
 // object.__itruediv__(self, other)
 type I__itruediv__ interface {
 	M__itruediv__(other Object) (Object, error)
@@ -94,7 +94,7 @@ func Iterate(obj Object, fn func(Object) bool) error {
 		}
 		for {
 			item, err := Next(iterator)
-			if err == StopIteration {
+			if IsException(StopIteration, err) {
 				break
 			}
 			if err != nil {
@@ -150,3 +150,26 @@ func SequenceContains(seq, obj Object) (found bool, err error) {
 	}
 	return found, err
 }
+
+// SequenceRichCompare compares the items of a and b in order
+//
+// The first items which aren't equal are compared with op, which
+// should be one of Lt, Le, Gt or Ge.  If all the items of the shorter
+// sequence are equal then the lengths are compared with op.
+func SequenceRichCompare(a, b []Object, op func(a, b Object) (Object, error)) (Object, error) {
+	n := min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		eq, err := Eq(a[i], b[i])
+		if err != nil {
+			return nil, err
+		}
+		eq, err = MakeBool(eq)
+		if err != nil {
+			return nil, err
+		}
+		if eq == False {
+			return op(a[i], b[i])
+		}
+	}
+	return op(Int(len(a)), Int(len(b)))
+}
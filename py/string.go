@@ -253,6 +253,10 @@ func (a String) M__repr__() (Object, error) {
 	return String(out), nil
 }
 
+func (a String) M__format__(formatSpec Object) (Object, error) {
+	return formatString(a, string(a), string(formatSpec.(String)))
+}
+
 func (s String) M__bool__() (Object, error) {
 	return NewBool(len(s) > 0), nil
 }
@@ -598,6 +602,15 @@ func (s String) M__contains__(item Object) (Object, error) {
 	return NewBool(strings.Contains(string(s), string(needle))), nil
 }
 
+// Returns an iterator over the characters of the string
+func (s String) M__iter__() (Object, error) {
+	chars := make([]Object, 0, len(s))
+	for _, c := range s {
+		chars = append(chars, String(c))
+	}
+	return NewIterator(chars), nil
+}
+
 // Check stringerface is satisfied
 var _ richComparison = String("")
 var _ sequenceArithmetic = String("")
@@ -608,3 +621,5 @@ var _ I__len__ = String("")
 var _ I__bool__ = String("")
 var _ I__getitem__ = String("")
 var _ I__contains__ = String("")
+var _ I__iter__ = String("")
+var _ I__format__ = String("")
@@ -12,6 +12,19 @@ class A:
 
 a = A()
 assert a.fn(1) == 2
+assert A.fn(1) == 2
+
+class B(A):
+    @classmethod
+    def name(cls):
+        return cls.NAME
+B.NAME = "B"
+assert B.name() == "B"
+assert B().name() == "B"
+
+class C(B):
+    NAME = "C"
+assert C.name() == "C"
 
 a.x = 3
 assert a.x == 3
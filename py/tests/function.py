@@ -10,10 +10,9 @@ def fn(p):
 
 assert fn(1) == 2
 
-# FIXME this doesn't work yet
-#assert fn.__doc__ == "docstring"
-#fn.__doc__ = "hello"
-#assert fn.__doc__ == "hello"
+assert fn.__doc__ == "docstring"
+fn.__doc__ = "hello"
+assert fn.__doc__ == "hello"
 
 assert str(type(fn)) == "<class 'function'>"
 
@@ -22,6 +21,7 @@ assert fn.x == 3
 
 def f2(p):
     return p+2
+assert f2.__doc__ is None
 
 doc="check __code__"
 fn.__code__ = f2.__code__
@@ -127,4 +127,15 @@ except TypeError:
 else:
     assert False, "TypeError not raised"
 
+doc="check __module__"
+def f9():
+    pass
+f9.__module__ = "mod"
+assert f9.__module__ == "mod"
+
+doc="check builtin method attributes"
+assert len.__name__ == "len"
+assert len.__qualname__ == "len"
+assert "len" in len.__doc__
+
 doc="finished"
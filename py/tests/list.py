@@ -39,4 +39,14 @@ assert a * 2  == [1, 2, 3, 1, 2, 3]
 assert a * 0 == []
 assert a * -1 == []
 
+doc="comparison"
+assert [1, 2] < [1, 3]
+assert [1, 2] < [1, 2, 0]
+assert not [1, 2] < [1, 2]
+assert [1, 2] <= [1, 2]
+assert [2] > [1, 9] and [2] >= [2]
+assert [[1], 2] < [[1, 0], 0]
+assertRaises(TypeError, lambda: [1] < ["a"])
+assertRaises(TypeError, lambda: [1] < (1,))
+
 doc="finished"
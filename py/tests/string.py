@@ -886,4 +886,12 @@ assert uni[7:7:1] == ''
 assert uni[7:7:2] == ''
 assert uni[7:7:3] == ''
 
+doc="iter"
+assert list("abc") == ["a", "b", "c"]
+assert list(iter("a𠜎")) == ["a", "𠜎"]
+chars = []
+for c in "xy":
+    chars.append(c)
+assert chars == ["x", "y"]
+
 doc="finished"
@@ -2,6 +2,8 @@
 # Use of this source code is governed by a BSD-style
 # license that can be found in the LICENSE file.
 
+from libtest import assertRaises
+
 doc="str"
 assert str(()) == "()"
 assert str((1,2,3)) == "(1, 2, 3)"
@@ -12,6 +14,8 @@ doc="repr"
 assert repr(()) == "()"
 assert repr((1,2,3)) == "(1, 2, 3)"
 assert repr((1,(2,3),4)) == "(1, (2, 3), 4)"
+assert repr((1,)) == "(1,)"
+assert str(((),)) == "((),)"
 assert repr(("1",(2.5,17,()))) == "('1', (2.5, 17, ()))"
 
 doc="mul"
@@ -20,4 +24,20 @@ assert a * 2  == (1, 2, 3, 1, 2, 3)
 assert a * 0 == ()
 assert a * -1 == ()
 
+doc="add"
+assert (1, 2) + (3,) == (1, 2, 3)
+assert () + (1,) == (1,)
+assert (1,) + () == (1,)
+
+doc="comparison"
+assert (1, 2) < (1, 3)
+assert (1, 2) < (1, 2, 0)
+assert not (1, 2) < (1, 2)
+assert (1, 2) <= (1, 2)
+assert (2,) > (1, 9) and (2,) >= (2,)
+assert (1, "b") > (1, "a")
+assert ((1, 2), 0) < ((1, 3), 0)
+assertRaises(TypeError, lambda: (1,) < ("a",))
+assertRaises(TypeError, lambda: (1,) < [1])
+
 doc="finished"
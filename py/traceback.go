@@ -58,17 +58,22 @@ func (tb *Traceback) TracebackDump(w io.Writer) {
 
 // Dumps a traceback to stderr
 func TracebackDump(err interface{}) {
+	TracebackDumpTo(os.Stderr, err)
+}
+
+// Dumps a traceback to w
+func TracebackDumpTo(w io.Writer, err interface{}) {
 	switch e := err.(type) {
 	case ExceptionInfo:
-		e.TracebackDump(os.Stderr)
+		e.TracebackDump(w)
 	case *ExceptionInfo:
-		e.TracebackDump(os.Stderr)
+		e.TracebackDump(w)
 	case *Exception:
-		fmt.Fprintf(os.Stderr, "Exception %#v\n", e)
-		fmt.Fprintf(os.Stderr, "-- No traceback available --\n")
+		fmt.Fprintf(w, "Exception %#v\n", e)
+		fmt.Fprintf(w, "-- No traceback available --\n")
 	default:
-		fmt.Fprintf(os.Stderr, "Error %#v\n", err)
-		fmt.Fprintf(os.Stderr, "-- No traceback available --\n")
+		fmt.Fprintf(w, "Error %#v\n", err)
+		fmt.Fprintf(w, "-- No traceback available --\n")
 	}
 }
 
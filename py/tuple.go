@@ -68,6 +68,9 @@ func (t Tuple) M__str__() (Object, error) {
 }
 
 func (t Tuple) M__repr__() (Object, error) {
+	if len(t) == 1 {
+		return t.repr("(", ",)")
+	}
 	return t.repr("(", ")")
 }
 
@@ -110,7 +113,7 @@ func (a Tuple) M__add__(other Object) (Object, error) {
 	if b, ok := other.(Tuple); ok {
 		newTuple := make(Tuple, len(a)+len(b))
 		copy(newTuple, a)
-		copy(newTuple[len(b):], b)
+		copy(newTuple[len(a):], b)
 		return newTuple, nil
 	}
 
@@ -192,6 +195,38 @@ func (a Tuple) M__ne__(other Object) (Object, error) {
 	return False, nil
 }
 
+func (a Tuple) M__lt__(other Object) (Object, error) {
+	b, ok := other.(Tuple)
+	if !ok {
+		return NotImplemented, nil
+	}
+	return SequenceRichCompare(a, b, Lt)
+}
+
+func (a Tuple) M__le__(other Object) (Object, error) {
+	b, ok := other.(Tuple)
+	if !ok {
+		return NotImplemented, nil
+	}
+	return SequenceRichCompare(a, b, Le)
+}
+
+func (a Tuple) M__gt__(other Object) (Object, error) {
+	b, ok := other.(Tuple)
+	if !ok {
+		return NotImplemented, nil
+	}
+	return SequenceRichCompare(a, b, Gt)
+}
+
+func (a Tuple) M__ge__(other Object) (Object, error) {
+	b, ok := other.(Tuple)
+	if !ok {
+		return NotImplemented, nil
+	}
+	return SequenceRichCompare(a, b, Ge)
+}
+
 // Check interface is satisfied
 var _ sequenceArithmetic = Tuple(nil)
 var _ I__str__ = Tuple(nil)
@@ -202,5 +237,9 @@ var _ I__iter__ = Tuple(nil)
 var _ I__getitem__ = Tuple(nil)
 var _ I__eq__ = Tuple(nil)
 var _ I__ne__ = Tuple(nil)
+var _ I__lt__ = Tuple(nil)
+var _ I__le__ = Tuple(nil)
+var _ I__gt__ = Tuple(nil)
+var _ I__ge__ = Tuple(nil)
 
 // var _ richComparison = Tuple(nil)
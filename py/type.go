@@ -54,6 +54,11 @@ const (
 	// Objects support garbage collection (see objimp.h)
 	TPFLAGS_HAVE_GC uint = 1 << 14
 
+	// Set if the type's New can make instances of its subclasses so
+	// subclasses made with class statements should inherit it rather
+	// than using object's New.  This is gpython specific.
+	TPFLAGS_INHERIT_NEW uint = 1 << 16
+
 	// Objects support type attribute cache
 	TPFLAGS_HAVE_VERSION_TAG  uint = 1 << 18
 	TPFLAGS_VALID_VERSION_TAG uint = 1 << 19
@@ -304,6 +309,7 @@ func (t *Type) NewTypeFlags(Name string, Doc string, New NewFunc, Init InitFunc,
 		Init:       Init,
 		Flags:      Flags,
 		Dict:       StringDict{},
+		Base:       t,
 		Bases:      Tuple{t},
 	}
 	TypeDelayReady(t)
@@ -493,7 +499,11 @@ func (t *Type) GetAttrOrNil(name string) Object {
 		return res
 	}
 	// Now look through base classes etc
-	return t.Lookup(name)
+	if res := t.Lookup(name); res != nil {
+		return res
+	}
+	// Finally look through the base classes of the type
+	return t.Type().Lookup(name)
 }
 
 // Calls method on name
@@ -1092,7 +1102,7 @@ func (t *Type) Ready() error {
 
 	// if the type dictionary doesn't contain a __doc__, set it from
 	// the tp_doc slot.
-	if _, ok := t.Dict["__doc__"]; ok {
+	if _, ok := t.Dict["__doc__"]; !ok {
 		if t.Doc != "" {
 			t.Dict["__doc__"] = String(t.Doc)
 		} else {
@@ -1421,6 +1431,10 @@ func TypeNew(metatype *Type, args Tuple, kwargs StringDict) (Object, error) {
 
 	// Initialize tp_flags
 	new_type.Flags = TPFLAGS_DEFAULT | TPFLAGS_HEAPTYPE | TPFLAGS_BASETYPE
+	if base.Flags&TPFLAGS_INHERIT_NEW != 0 {
+		new_type.New = base.New
+		new_type.Flags |= TPFLAGS_INHERIT_NEW
+	}
 
 	// Set tp_base and tp_bases
 	new_type.Bases = bases
@@ -1638,8 +1652,8 @@ func ObjectInit(self Object, args Tuple, kwargs StringDict) error {
 
 	// Call the __init__ method if it exists
 	// FIXME this isn't the way cpython does it - it adjusts the function pointers
-	// Only do this for non built in types
-	if _, ok := self.(*Type); ok {
+	// Only do this for non built in types and their subclasses
+	if _, ok := self.(*Type); ok || t.Flags&(TPFLAGS_HEAPTYPE|TPFLAGS_INHERIT_NEW) == TPFLAGS_HEAPTYPE|TPFLAGS_INHERIT_NEW {
 		init := t.GetAttrOrNil("__init__")
 		// fmt.Printf("init = %v\n", init)
 		if init != nil {
@@ -1723,6 +1737,9 @@ func ObjectNew(t *Type, args Tuple, kwargs StringDict) (Object, error) {
 
 // FIXME this should be the default?
 func (ty *Type) M__eq__(other Object) (Object, error) {
+	if res, ok, err := ty.CallMethod("__eq__", Tuple{ty, other}, nil); ok {
+		return res, err
+	}
 	if otherTy, ok := other.(*Type); ok && ty == otherTy {
 		return True, nil
 	}
@@ -1731,6 +1748,16 @@ func (ty *Type) M__eq__(other Object) (Object, error) {
 
 // FIXME this should be the default?
 func (ty *Type) M__ne__(other Object) (Object, error) {
+	if res, ok, err := ty.CallMethod("__ne__", Tuple{ty, other}, nil); ok {
+		return res, err
+	}
+	// If only __eq__ is defined then __ne__ is its inverse
+	if res, ok, err := ty.CallMethod("__eq__", Tuple{ty, other}, nil); ok {
+		if err != nil || res == NotImplemented {
+			return res, err
+		}
+		return Not(res)
+	}
 	if otherTy, ok := other.(*Type); ok && ty == otherTy {
 		return False, nil
 	}
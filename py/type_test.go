@@ -48,3 +48,61 @@ func TestMro(t *testing.T) {
 		}
 	}
 }
+
+// An instance of a Go type which python classes can subclass
+type inheritNewInstance struct {
+	typ  *Type
+	args Tuple
+	dict StringDict
+}
+
+func (o *inheritNewInstance) Type() *Type {
+	return o.typ
+}
+
+func (o *inheritNewInstance) GetDict() StringDict {
+	return o.dict
+}
+
+func TestInheritNew(t *testing.T) {
+	base := NewTypeX("InheritNew", "", func(metatype *Type, args Tuple, kwargs StringDict) (Object, error) {
+		return &inheritNewInstance{typ: metatype, args: args, dict: StringDict{}}, nil
+	}, nil)
+	base.Flags |= TPFLAGS_BASETYPE | TPFLAGS_INHERIT_NEW
+	err := base.Ready()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var initArgs Tuple
+	dict := StringDict{
+		"__init__": MustNewMethod("__init__", func(self Object, args Tuple) (Object, error) {
+			initArgs = args
+			return None, nil
+		}, 0, ""),
+	}
+	subObj, err := TypeNew(TypeType, Tuple{String("Sub"), Tuple{base}, dict}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sub := subObj.(*Type)
+	if sub.Flags&TPFLAGS_INHERIT_NEW == 0 {
+		t.Errorf("subclass didn't inherit TPFLAGS_INHERIT_NEW")
+	}
+	obj, err := Call(sub, Tuple{Int(1)}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inst, ok := obj.(*inheritNewInstance)
+	if !ok {
+		t.Fatalf("want *inheritNewInstance got %T", obj)
+	}
+	if inst.typ != sub {
+		t.Errorf("want instance of %s got %s", sub.Name, inst.typ.Name)
+	}
+	if len(inst.args) != 1 || inst.args[0] != Int(1) {
+		t.Errorf("New got args %v", inst.args)
+	}
+	if len(initArgs) != 2 || initArgs[0] != obj || initArgs[1] != Int(1) {
+		t.Errorf("__init__ got args %v", initArgs)
+	}
+}
@@ -0,0 +1,593 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Python classes for the AST nodes and conversion to and from the Go
+// ast package
+
+package pyast
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/go-python/gpython/ast"
+	"github.com/go-python/gpython/py"
+)
+
+// A python class representing a Go ast node
+type nodeClass struct {
+	class      *py.Type     // python class
+	goType     reflect.Type // struct type of the Go node
+	fields     []nodeField  // fields in order
+	attributes bool         // set if node has lineno and col_offset
+}
+
+// A field of a node
+type nodeField struct {
+	name     string // python name
+	index    int    // index into the Go struct
+	optional bool   // set if the field may be None
+}
+
+// An enumeration (eg operator) represented as a python class per value
+type enumClass struct {
+	base    *py.Type                   // abstract python class
+	values  map[int64]py.Object        // singleton instance for each value
+	classes map[*py.Type]reflect.Value // value for each python class
+}
+
+var (
+	// ASTType is the base class of all the AST nodes
+	ASTType *py.Type
+
+	// Python classes by name
+	classes = py.StringDict{}
+
+	// Node classes indexed by Go type and python class
+	nodeByGoType = map[reflect.Type]*nodeClass{}
+	nodeByClass  = map[*py.Type]*nodeClass{}
+
+	// Enum classes indexed by Go type
+	enumByGoType = map[reflect.Type]*enumClass{}
+)
+
+// Go types which need special treatment when converting
+var (
+	identifierGoType = reflect.TypeOf(ast.Identifier(""))
+	stringGoType     = reflect.TypeOf(py.String(""))
+	bytesGoType      = reflect.TypeOf(py.Bytes(nil))
+	objectGoType     = reflect.TypeOf((*ast.Object)(nil)).Elem()
+	singletonGoType  = reflect.TypeOf((*ast.Singleton)(nil)).Elem()
+)
+
+// Abstract classes with the Go node types which belong to them
+var nodeClassTable = []struct {
+	base       string
+	attributes bool
+	nodes      []interface{}
+}{
+	{"mod", false, []interface{}{
+		(*ast.Module)(nil),
+		(*ast.Interactive)(nil),
+		(*ast.Expression)(nil),
+		(*ast.Suite)(nil),
+	}},
+	{"stmt", true, []interface{}{
+		(*ast.FunctionDef)(nil),
+		(*ast.AsyncFunctionDef)(nil),
+		(*ast.ClassDef)(nil),
+		(*ast.Return)(nil),
+		(*ast.Delete)(nil),
+		(*ast.Assign)(nil),
+		(*ast.AugAssign)(nil),
+		(*ast.AnnAssign)(nil),
+		(*ast.For)(nil),
+		(*ast.AsyncFor)(nil),
+		(*ast.While)(nil),
+		(*ast.If)(nil),
+		(*ast.With)(nil),
+		(*ast.AsyncWith)(nil),
+		(*ast.Raise)(nil),
+		(*ast.Try)(nil),
+		(*ast.Assert)(nil),
+		(*ast.Import)(nil),
+		(*ast.ImportFrom)(nil),
+		(*ast.Global)(nil),
+		(*ast.Nonlocal)(nil),
+		(*ast.ExprStmt)(nil),
+		(*ast.Pass)(nil),
+		(*ast.Break)(nil),
+		(*ast.Continue)(nil),
+	}},
+	{"expr", true, []interface{}{
+		(*ast.BoolOp)(nil),
+		(*ast.NamedExpr)(nil),
+		(*ast.BinOp)(nil),
+		(*ast.UnaryOp)(nil),
+		(*ast.Lambda)(nil),
+		(*ast.IfExp)(nil),
+		(*ast.Dict)(nil),
+		(*ast.Set)(nil),
+		(*ast.ListComp)(nil),
+		(*ast.SetComp)(nil),
+		(*ast.DictComp)(nil),
+		(*ast.GeneratorExp)(nil),
+		(*ast.Await)(nil),
+		(*ast.Yield)(nil),
+		(*ast.YieldFrom)(nil),
+		(*ast.Compare)(nil),
+		(*ast.Call)(nil),
+		(*ast.Num)(nil),
+		(*ast.Str)(nil),
+		(*ast.Bytes)(nil),
+		(*ast.JoinedStr)(nil),
+		(*ast.FormattedValue)(nil),
+		(*ast.NameConstant)(nil),
+		(*ast.Ellipsis)(nil),
+		(*ast.Attribute)(nil),
+		(*ast.Subscript)(nil),
+		(*ast.Starred)(nil),
+		(*ast.Name)(nil),
+		(*ast.List)(nil),
+		(*ast.Tuple)(nil),
+	}},
+	{"slice", false, []interface{}{
+		(*ast.Slice)(nil),
+		(*ast.ExtSlice)(nil),
+		(*ast.Index)(nil),
+	}},
+	{"excepthandler", true, []interface{}{
+		(*ast.ExceptHandler)(nil),
+	}},
+	{"", false, []interface{}{
+		(*ast.Comprehension)(nil),
+		(*ast.Arguments)(nil),
+		(*ast.Keyword)(nil),
+		(*ast.Alias)(nil),
+		(*ast.WithItem)(nil),
+	}},
+	{"", true, []interface{}{
+		(*ast.Arg)(nil),
+	}},
+}
+
+// Abstract classes for the enumerations with the first and last values
+var enumClassTable = []struct {
+	base        string
+	first, last interface{}
+}{
+	{"expr_context", ast.Load, ast.Param},
+	{"boolop", ast.And, ast.Or},
+	{"operator", ast.Add, ast.FloorDiv},
+	{"unaryop", ast.Invert, ast.USub},
+	{"cmpop", ast.Eq, ast.NotIn},
+}
+
+// Python names for Go node types where they differ
+var pyNodeNames = map[string]string{
+	"ExprStmt":      "Expr",
+	"Comprehension": "comprehension",
+	"Arguments":     "arguments",
+	"Arg":           "arg",
+	"Keyword":       "keyword",
+	"Alias":         "alias",
+	"WithItem":      "withitem",
+}
+
+// Python names for Go field names where they differ from the lower
+// case version
+var fieldNames = map[string]string{
+	"ExprType":      "type",
+	"ContextExpr":   "context_expr",
+	"OptionalVars":  "optional_vars",
+	"KwDefaults":    "kw_defaults",
+	"DecoratorList": "decorator_list",
+	"FormatSpec":    "format_spec",
+}
+
+// Fields which may be None
+var optionalFields = map[string]bool{
+	"FunctionDef.returns":        true,
+	"AsyncFunctionDef.returns":   true,
+	"Return.value":               true,
+	"AnnAssign.value":            true,
+	"Raise.exc":                  true,
+	"Raise.cause":                true,
+	"Assert.msg":                 true,
+	"ImportFrom.module":          true,
+	"ImportFrom.level":           true,
+	"Yield.value":                true,
+	"FormattedValue.format_spec": true,
+	"Slice.lower":                true,
+	"Slice.upper":                true,
+	"Slice.step":                 true,
+	"ExceptHandler.type":         true,
+	"ExceptHandler.name":         true,
+	"arguments.vararg":           true,
+	"arguments.kwarg":            true,
+	"arg.annotation":             true,
+	"keyword.arg":                true,
+	"alias.asname":               true,
+	"withitem.optional_vars":     true,
+}
+
+// Embedded structs which aren't fields
+var baseGoTypes = map[string]bool{
+	"Pos":       true,
+	"ModBase":   true,
+	"StmtBase":  true,
+	"ExprBase":  true,
+	"SliceBase": true,
+}
+
+// Make a new python AST class
+//
+// _fields and _attributes are set on every class as class attributes
+// aren't inherited when read from the class itself
+func newClass(name string, base *py.Type, dict py.StringDict) *py.Type {
+	dict["__module__"] = py.String("_ast")
+	for _, attr := range []string{"_fields", "_attributes"} {
+		if _, ok := dict[attr]; !ok {
+			dict[attr] = py.Tuple{}
+		}
+	}
+	class := newPyClass(name, base, dict)
+	classes[name] = class
+	return class
+}
+
+// Make a new python class as a class statement would
+func newPyClass(name string, base *py.Type, dict py.StringDict) *py.Type {
+	class, err := py.TypeNew(py.TypeType, py.Tuple{py.String(name), py.Tuple{base}, dict}, nil)
+	if err != nil {
+		panic(err)
+	}
+	return class.(*py.Type)
+}
+
+// Make a tuple of strings
+func stringTuple(xs []string) py.Tuple {
+	t := make(py.Tuple, len(xs))
+	for i, x := range xs {
+		t[i] = py.String(x)
+	}
+	return t
+}
+
+// Make the python classes for the nodes
+func init() {
+	ASTType = newClass("AST", py.ObjectType, py.StringDict{
+		"__init__": newMethod("__init__", ast_init, "Initialise the node from its fields"),
+	})
+	posAttributes := stringTuple([]string{"lineno", "col_offset"})
+	for _, group := range nodeClassTable {
+		base := ASTType
+		if group.base != "" {
+			dict := py.StringDict{}
+			if group.attributes {
+				dict["_attributes"] = posAttributes
+			}
+			base = newClass(group.base, ASTType, dict)
+		}
+		for _, node := range group.nodes {
+			goType := reflect.TypeOf(node).Elem()
+			name := goType.Name()
+			if pyName, ok := pyNodeNames[name]; ok {
+				name = pyName
+			}
+			nc := &nodeClass{
+				goType:     goType,
+				attributes: group.attributes,
+			}
+			var names []string
+			for i := 0; i < goType.NumField(); i++ {
+				field := goType.Field(i)
+				if field.Anonymous && baseGoTypes[field.Name] {
+					continue
+				}
+				fieldName, ok := fieldNames[field.Name]
+				if !ok {
+					fieldName = strings.ToLower(field.Name)
+				}
+				nc.fields = append(nc.fields, nodeField{
+					name:     fieldName,
+					index:    i,
+					optional: optionalFields[name+"."+fieldName],
+				})
+				names = append(names, fieldName)
+			}
+			dict := py.StringDict{
+				"_fields": stringTuple(names),
+			}
+			if group.attributes {
+				dict["_attributes"] = posAttributes
+			}
+			nc.class = newClass(name, base, dict)
+			nodeByGoType[goType] = nc
+			nodeByClass[nc.class] = nc
+		}
+	}
+	for _, group := range enumClassTable {
+		base := newClass(group.base, ASTType, py.StringDict{})
+		ec := &enumClass{
+			base:    base,
+			values:  map[int64]py.Object{},
+			classes: map[*py.Type]reflect.Value{},
+		}
+		first, last := reflect.ValueOf(group.first), reflect.ValueOf(group.last)
+		goType := first.Type()
+		for i := first.Int(); i <= last.Int(); i++ {
+			value := reflect.New(goType).Elem()
+			value.SetInt(i)
+			name := strings.TrimSuffix(value.Interface().(interface{ String() string }).String(), "()")
+			class := newClass(name, base, py.StringDict{})
+			ec.values[i] = class.Alloc()
+			ec.classes[class] = value
+		}
+		enumByGoType[goType] = ec
+	}
+}
+
+// Returns true if obj is a python AST node
+func IsAst(obj py.Object) bool {
+	return obj.Type().IsSubtype(ASTType)
+}
+
+// Finds the node class of obj or its nearest superclass with one
+func findNodeClass(obj py.Object) *nodeClass {
+	t := obj.Type()
+	if nc, ok := nodeByClass[t]; ok {
+		return nc
+	}
+	if t.Mro != nil {
+		for _, base := range t.Mro {
+			if nc, ok := nodeByClass[base.(*py.Type)]; ok {
+				return nc
+			}
+		}
+	}
+	return nil
+}
+
+// AstToPy converts a Go ast node into python AST objects
+func AstToPy(node ast.Ast) (py.Object, error) {
+	return toPy(reflect.ValueOf(node))
+}
+
+// Convert a Go value from the ast into a python object
+func toPy(v reflect.Value) (py.Object, error) {
+	t := v.Type()
+	switch t {
+	case identifierGoType:
+		if v.String() == "" {
+			return py.None, nil
+		}
+		return py.String(v.String()), nil
+	case stringGoType:
+		return v.Interface().(py.String), nil
+	case bytesGoType:
+		return v.Interface().(py.Bytes), nil
+	case objectGoType, singletonGoType:
+		if v.IsNil() {
+			return py.None, nil
+		}
+		return v.Interface().(py.Object), nil
+	}
+	if ec, ok := enumByGoType[t]; ok {
+		value, ok := ec.values[v.Int()]
+		if !ok {
+			return nil, py.ExceptionNewf(py.SystemError, "unknown %s value %d", ec.base.Name, v.Int())
+		}
+		return value, nil
+	}
+	switch t.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if v.IsNil() {
+			return py.None, nil
+		}
+		return toPy(v.Elem())
+	case reflect.Slice:
+		list := py.NewListSized(v.Len())
+		for i := range list.Items {
+			item, err := toPy(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			list.Items[i] = item
+		}
+		return list, nil
+	case reflect.Int:
+		return py.Int(v.Int()), nil
+	case reflect.Struct:
+		nc, ok := nodeByGoType[t]
+		if !ok {
+			break
+		}
+		node := nc.class.Alloc()
+		for _, field := range nc.fields {
+			value, err := toPy(v.Field(field.index))
+			if err != nil {
+				return nil, err
+			}
+			node.Dict[field.name] = value
+		}
+		if nc.attributes {
+			pos := v.FieldByName("Pos").Interface().(ast.Pos)
+			node.Dict["lineno"] = py.Int(pos.Lineno)
+			node.Dict["col_offset"] = py.Int(pos.ColOffset)
+		}
+		return node, nil
+	}
+	return nil, py.ExceptionNewf(py.SystemError, "can't convert %v to python AST", t)
+}
+
+// PyToAst converts python AST objects into a Go ast suitable for
+// compiling in mode
+func PyToAst(obj py.Object, mode string) (ast.Mod, error) {
+	var want string
+	switch mode {
+	case "exec":
+		want = "Module"
+	case "eval":
+		want = "Expression"
+	case "single":
+		want = "Interactive"
+	default:
+		return nil, py.ExceptionNewf(py.ValueError, "compile() arg 3 must be 'exec', 'eval' or 'single'")
+	}
+	if !obj.Type().IsSubtype(classes[want].(*py.Type)) {
+		return nil, py.ExceptionNewf(py.TypeError, "expected %s node, got %s", want, obj.Type().Name)
+	}
+	v, err := fromPy(obj, reflect.TypeOf((*ast.Mod)(nil)).Elem(), "mod")
+	if err != nil {
+		return nil, err
+	}
+	return v.Interface().(ast.Mod), nil
+}
+
+// Convert a python object into a Go value of type t for the ast
+//
+// what describes the object for error messages
+func fromPy(obj py.Object, t reflect.Type, what string) (reflect.Value, error) {
+	v := reflect.New(t).Elem()
+	switch t {
+	case identifierGoType:
+		if obj == py.None {
+			return v, nil
+		}
+		s, ok := obj.(py.String)
+		if !ok {
+			return v, py.ExceptionNewf(py.TypeError, "AST identifier must be of type str")
+		}
+		v.SetString(string(s))
+		return v, nil
+	case stringGoType:
+		s, ok := obj.(py.String)
+		if !ok {
+			return v, py.ExceptionNewf(py.TypeError, "AST string must be of type str")
+		}
+		v.Set(reflect.ValueOf(s))
+		return v, nil
+	case bytesGoType:
+		b, ok := obj.(py.Bytes)
+		if !ok {
+			return v, py.ExceptionNewf(py.TypeError, "AST bytes must be of type bytes")
+		}
+		v.Set(reflect.ValueOf(b))
+		return v, nil
+	case objectGoType, singletonGoType:
+		v.Set(reflect.ValueOf(obj))
+		return v, nil
+	}
+	if ec, ok := enumByGoType[t]; ok {
+		if value, ok := ec.classes[obj.Type()]; ok {
+			return value, nil
+		}
+		return v, py.ExceptionNewf(py.TypeError, "expected some sort of %s, but got %s", ec.base.Name, reprOrType(obj))
+	}
+	switch t.Kind() {
+	case reflect.Int:
+		i, err := py.MakeGoInt(obj)
+		if err != nil {
+			return v, err
+		}
+		v.SetInt(int64(i))
+		return v, nil
+	case reflect.Slice:
+		var items py.Tuple
+		switch o := obj.(type) {
+		case *py.List:
+			items = o.Items
+		case py.Tuple:
+			items = o
+		default:
+			return v, py.ExceptionNewf(py.TypeError, "%s must be a list, not %s", what, obj.Type().Name)
+		}
+		v = reflect.MakeSlice(t, len(items), len(items))
+		for i, item := range items {
+			itemV, err := fromPy(item, t.Elem(), what)
+			if err != nil {
+				return v, err
+			}
+			v.Index(i).Set(itemV)
+		}
+		return v, nil
+	case reflect.Interface, reflect.Ptr, reflect.Struct:
+		nc := findNodeClass(obj)
+		if nc == nil {
+			return v, py.ExceptionNewf(py.TypeError, "expected some sort of %s, but got %s", what, reprOrType(obj))
+		}
+		node, err := nodeFromPy(obj, nc)
+		if err != nil {
+			return v, err
+		}
+		switch t.Kind() {
+		case reflect.Struct:
+			node = node.Elem()
+		}
+		if !node.Type().AssignableTo(t) {
+			return v, py.ExceptionNewf(py.TypeError, "expected some sort of %s, but got %s", what, reprOrType(obj))
+		}
+		v.Set(node)
+		return v, nil
+	}
+	return v, py.ExceptionNewf(py.SystemError, "can't convert %s to %v", obj.Type().Name, t)
+}
+
+// Convert a python node into a pointer to a Go node
+func nodeFromPy(obj py.Object, nc *nodeClass) (reflect.Value, error) {
+	node := reflect.New(nc.goType)
+	v := node.Elem()
+	name := nc.class.Name
+	for _, field := range nc.fields {
+		value, err := py.GetAttrString(obj, field.name)
+		if err != nil && !py.IsException(py.AttributeError, err) {
+			return node, err
+		}
+		if value == nil || value == py.None {
+			if field.optional {
+				continue
+			}
+			return node, py.ExceptionNewf(py.TypeError, "required field \"%s\" missing from %s", field.name, name)
+		}
+		fieldV, err := fromPy(value, nc.goType.Field(field.index).Type, name+" field \""+field.name+"\"")
+		if err != nil {
+			return node, err
+		}
+		v.Field(field.index).Set(fieldV)
+	}
+	if nc.attributes {
+		pos := v.FieldByName("Pos")
+		for _, attr := range []struct {
+			name  string
+			field string
+		}{
+			{"lineno", "Lineno"},
+			{"col_offset", "ColOffset"},
+		} {
+			value, err := py.GetAttrString(obj, attr.name)
+			if err != nil {
+				if py.IsException(py.AttributeError, err) {
+					err = py.ExceptionNewf(py.TypeError, "required field \"%s\" missing from %s", attr.name, name)
+				}
+				return node, err
+			}
+			i, err := py.MakeGoInt(value)
+			if err != nil {
+				return node, err
+			}
+			pos.FieldByName(attr.field).SetInt(int64(i))
+		}
+	}
+	return node, nil
+}
+
+// Returns the repr of obj or its type name if that fails
+func reprOrType(obj py.Object) string {
+	repr, err := py.ReprAsString(obj)
+	if err != nil {
+		return obj.Type().Name
+	}
+	return repr
+}
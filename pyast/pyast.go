@@ -0,0 +1,888 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Ast module
+//
+// Gives python code access to the abstract syntax trees made by the
+// parser.
+
+package pyast
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/go-python/gpython/compile"
+	"github.com/go-python/gpython/parser"
+	"github.com/go-python/gpython/py"
+)
+
+const module_doc = `The ast module helps Python applications to process trees of the Python
+abstract syntax grammar.  The abstract syntax itself might change with
+each Python release; this module helps to find out programmatically what
+the current grammar looks like and allows modifications of it.
+
+An abstract syntax tree can be generated by passing ast.PyCF_ONLY_AST as
+a flag to the compile() builtin function or by using the parse()
+function from this module.  The result will be a tree of objects whose
+classes all inherit from ast.AST.
+
+A modified abstract syntax tree can be compiled into a Python code object
+using the built-in compile() function.`
+
+// Parse parses source into python AST objects
+func Parse(source, filename, mode string) (py.Object, error) {
+	node, err := parser.Parse(bytes.NewBufferString(source), filename, mode)
+	if err != nil {
+		return nil, err
+	}
+	return AstToPy(node)
+}
+
+// Makes a method for a python class which may be called bound or
+// unbound
+func newMethod(name string, fn func(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error), doc string) *py.Method {
+	return py.MustNewMethod(name, func(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		if self == nil || self == py.None {
+			if len(args) == 0 {
+				return nil, py.ExceptionNewf(py.TypeError, "%s() missing 1 required positional argument: 'self'", name)
+			}
+			self, args = args[0], args[1:]
+		}
+		return fn(self, args, kwargs)
+	}, 0, doc)
+}
+
+// Reads the names in the _fields or _attributes of node
+func nodeNames(node py.Object, attr string) ([]string, error) {
+	obj, err := py.GetAttrString(node, attr)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	var nameErr error
+	err = py.Iterate(obj, func(item py.Object) bool {
+		name, ok := item.(py.String)
+		if !ok {
+			nameErr = py.ExceptionNewf(py.TypeError, "%s must contain strings", attr)
+			return true
+		}
+		names = append(names, string(name))
+		return false
+	})
+	if err == nil {
+		err = nameErr
+	}
+	return names, err
+}
+
+// Returns true if node has name in its _attributes
+func hasAttribute(node py.Object, name string) (bool, error) {
+	attributes, err := nodeNames(node, "_attributes")
+	if err != nil {
+		return false, err
+	}
+	for _, attribute := range attributes {
+		if attribute == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Reads attribute name of obj returning nil if it isn't set
+func getAttrOrNil(obj py.Object, name string) (py.Object, error) {
+	value, err := py.GetAttrString(obj, name)
+	if err != nil {
+		if py.IsException(py.AttributeError, err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return value, nil
+}
+
+// A field of a python node
+type field struct {
+	name  string
+	value py.Object
+}
+
+// Returns the fields present on node in order
+func iterFields(node py.Object) ([]field, error) {
+	names, err := nodeNames(node, "_fields")
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]field, 0, len(names))
+	for _, name := range names {
+		value, err := getAttrOrNil(node, name)
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			fields = append(fields, field{name: name, value: value})
+		}
+	}
+	return fields, nil
+}
+
+// Returns the direct child nodes of node
+func iterChildNodes(node py.Object) ([]py.Object, error) {
+	fields, err := iterFields(node)
+	if err != nil {
+		return nil, err
+	}
+	var children []py.Object
+	for _, f := range fields {
+		if IsAst(f.value) {
+			children = append(children, f.value)
+		} else if list, ok := f.value.(*py.List); ok {
+			for _, item := range list.Items {
+				if IsAst(item) {
+					children = append(children, item)
+				}
+			}
+		}
+	}
+	return children, nil
+}
+
+// Returns node and all its descendants in breadth first order
+func walk(node py.Object) ([]py.Object, error) {
+	nodes := []py.Object{node}
+	for i := 0; i < len(nodes); i++ {
+		children, err := iterChildNodes(nodes[i])
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, children...)
+	}
+	return nodes, nil
+}
+
+// Checks that node is an AST node
+func checkAst(node py.Object) error {
+	if !IsAst(node) {
+		return py.ExceptionNewf(py.TypeError, "expected AST, got '%s'", node.Type().Name)
+	}
+	return nil
+}
+
+// Returns true if node is an instance of one of the named classes
+func isInstance(node py.Object, names ...string) bool {
+	t := node.Type()
+	for _, name := range names {
+		if t.IsSubtype(classes[name].(*py.Type)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Initialise an AST node from its fields
+func ast_init(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	names, err := nodeNames(self, "_fields")
+	if err != nil {
+		return nil, err
+	}
+	if len(args) > 0 {
+		if len(args) != len(names) {
+			plural := "s"
+			if len(names) == 1 {
+				plural = ""
+			}
+			return nil, py.ExceptionNewf(py.TypeError, "%s constructor takes %d positional argument%s", self.Type().Name, len(names), plural)
+		}
+		for i, arg := range args {
+			_, err = py.SetAttrString(self, names[i], arg)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	for name, value := range kwargs {
+		_, err = py.SetAttrString(self, name, value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return py.None, nil
+}
+
+const parse_doc = `parse(source, filename='<unknown>', mode='exec')
+
+Parse the source into an AST node.
+Equivalent to compile(source, filename, mode, PyCF_ONLY_AST).`
+
+func ast_parse(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var source py.Object
+	var filename py.Object = py.String("<unknown>")
+	var mode py.Object = py.String("exec")
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|ss:parse", []string{"source", "filename", "mode"}, &source, &filename, &mode)
+	if err != nil {
+		return nil, err
+	}
+	if IsAst(source) {
+		return source, nil
+	}
+	var str string
+	switch x := source.(type) {
+	case py.String:
+		str = string(x)
+	case py.Bytes:
+		str = string(x)
+	default:
+		return nil, py.ExceptionNewf(py.TypeError, "parse() arg 1 must be a string, bytes or AST object")
+	}
+	return Parse(str, string(filename.(py.String)), string(mode.(py.String)))
+}
+
+const dump_doc = `dump(node, annotate_fields=True, include_attributes=False)
+
+Return a formatted dump of the tree in *node*.  This is mainly useful for
+debugging purposes.  The returned string will show the names and the values
+for fields.  This makes the code impossible to evaluate, so if evaluation is
+wanted *annotate_fields* must be set to False.  Attributes such as line
+numbers and column offsets are not dumped by default.  If this is wanted,
+*include_attributes* can be set to True.`
+
+// Dump node as a string
+func dump(node py.Object, annotateFields, includeAttributes bool) (string, error) {
+	if list, ok := node.(*py.List); ok {
+		items := make([]string, len(list.Items))
+		for i, item := range list.Items {
+			s, err := dump(item, annotateFields, includeAttributes)
+			if err != nil {
+				return "", err
+			}
+			items[i] = s
+		}
+		return "[" + strings.Join(items, ", ") + "]", nil
+	}
+	if !IsAst(node) {
+		return py.ReprAsString(node)
+	}
+	fields, err := iterFields(node)
+	if err != nil {
+		return "", err
+	}
+	var out []string
+	for _, f := range fields {
+		s, err := dump(f.value, annotateFields, includeAttributes)
+		if err != nil {
+			return "", err
+		}
+		if annotateFields {
+			s = f.name + "=" + s
+		}
+		out = append(out, s)
+	}
+	if includeAttributes {
+		attributes, err := nodeNames(node, "_attributes")
+		if err != nil {
+			return "", err
+		}
+		for _, name := range attributes {
+			value, err := py.GetAttrString(node, name)
+			if err != nil {
+				return "", err
+			}
+			s, err := dump(value, annotateFields, includeAttributes)
+			if err != nil {
+				return "", err
+			}
+			out = append(out, name+"="+s)
+		}
+	}
+	return node.Type().Name + "(" + strings.Join(out, ", ") + ")", nil
+}
+
+func ast_dump(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var node py.Object
+	var annotateFields py.Object = py.True
+	var includeAttributes py.Object = py.False
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|OO:dump", []string{"node", "annotate_fields", "include_attributes"}, &node, &annotateFields, &includeAttributes)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAst(node)
+	if err != nil {
+		return nil, err
+	}
+	annotate, err := py.MakeBool(annotateFields)
+	if err != nil {
+		return nil, err
+	}
+	include, err := py.MakeBool(includeAttributes)
+	if err != nil {
+		return nil, err
+	}
+	out, err := dump(node, annotate == py.True, include == py.True)
+	if err != nil {
+		return nil, err
+	}
+	return py.String(out), nil
+}
+
+const literal_eval_doc = `literal_eval(node_or_string)
+
+Safely evaluate an expression node or a string containing a Python
+expression.  The string or node provided may only consist of the following
+Python literal structures: strings, bytes, numbers, tuples, lists, dicts,
+sets, booleans, and None.`
+
+// Evaluate a node made of literals
+func literalEval(node py.Object) (py.Object, error) {
+	malformed := func() (py.Object, error) {
+		return nil, py.ExceptionNewf(py.ValueError, "malformed node or string: %s", reprOrType(node))
+	}
+	attr := func(name string) py.Object {
+		value, _ := getAttrOrNil(node, name)
+		if value == nil {
+			value = py.None
+		}
+		return value
+	}
+	evalItems := func(name string) ([]py.Object, error) {
+		var items []py.Object
+		var evalErr error
+		err := py.Iterate(attr(name), func(item py.Object) bool {
+			var value py.Object
+			value, evalErr = literalEval(item)
+			items = append(items, value)
+			return evalErr != nil
+		})
+		if err == nil {
+			err = evalErr
+		}
+		return items, err
+	}
+	if !IsAst(node) {
+		return malformed()
+	}
+	switch {
+	case isInstance(node, "Str", "Bytes"):
+		return attr("s"), nil
+	case isInstance(node, "Num"):
+		return attr("n"), nil
+	case isInstance(node, "NameConstant"):
+		return attr("value"), nil
+	case isInstance(node, "Tuple"):
+		items, err := evalItems("elts")
+		if err != nil {
+			return nil, err
+		}
+		return py.Tuple(items), nil
+	case isInstance(node, "List"):
+		items, err := evalItems("elts")
+		if err != nil {
+			return nil, err
+		}
+		return py.NewListFromItems(items), nil
+	case isInstance(node, "Set"):
+		items, err := evalItems("elts")
+		if err != nil {
+			return nil, err
+		}
+		return py.NewSetFromItems(items), nil
+	case isInstance(node, "Dict"):
+		keys, err := evalItems("keys")
+		if err != nil {
+			return nil, err
+		}
+		values, err := evalItems("values")
+		if err != nil {
+			return nil, err
+		}
+		dict := py.NewStringDict()
+		for i := range keys {
+			if i >= len(values) {
+				break
+			}
+			_, err = py.SetItem(dict, keys[i], values[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+		return dict, nil
+	case isInstance(node, "UnaryOp"):
+		op, operand := attr("op"), attr("operand")
+		if !isInstance(op, "UAdd", "USub") || !isInstance(operand, "Num", "UnaryOp", "BinOp") {
+			return malformed()
+		}
+		value, err := literalEval(operand)
+		if err != nil {
+			return nil, err
+		}
+		if isInstance(op, "UAdd") {
+			return py.Pos(value)
+		}
+		return py.Neg(value)
+	case isInstance(node, "BinOp"):
+		op, left, right := attr("op"), attr("left"), attr("right")
+		if !isInstance(op, "Add", "Sub") || !isInstance(right, "Num") || !isInstance(left, "Num", "UnaryOp", "BinOp") {
+			return malformed()
+		}
+		leftValue, err := literalEval(left)
+		if err != nil {
+			return nil, err
+		}
+		rightValue, err := literalEval(right)
+		if err != nil {
+			return nil, err
+		}
+		_, leftOk := leftValue.(py.Int)
+		if _, ok := leftValue.(py.Float); ok {
+			leftOk = true
+		}
+		if _, ok := rightValue.(py.Complex); !ok || !leftOk {
+			return malformed()
+		}
+		if isInstance(op, "Add") {
+			return py.Add(leftValue, rightValue)
+		}
+		return py.Sub(leftValue, rightValue)
+	}
+	return malformed()
+}
+
+func ast_literal_eval(self py.Object, nodeOrString py.Object) (py.Object, error) {
+	node := nodeOrString
+	if s, ok := nodeOrString.(py.String); ok {
+		var err error
+		node, err = Parse(strings.TrimLeft(string(s), " \t"), "<unknown>", "eval")
+		if err != nil {
+			return nil, err
+		}
+	}
+	if IsAst(node) && isInstance(node, "Expression") {
+		body, err := py.GetAttrString(node, "body")
+		if err != nil {
+			return nil, err
+		}
+		node = body
+	}
+	return literalEval(node)
+}
+
+const iter_fields_doc = `iter_fields(node)
+
+Yield a tuple of ` + "``(fieldname, value)``" + ` for each field in ` + "``node._fields``" + `
+that is present on *node*.`
+
+func ast_iter_fields(self py.Object, node py.Object) (py.Object, error) {
+	fields, err := iterFields(node)
+	if err != nil {
+		return nil, err
+	}
+	objs := make([]py.Object, len(fields))
+	for i, f := range fields {
+		objs[i] = py.Tuple{py.String(f.name), f.value}
+	}
+	return py.NewIterator(objs), nil
+}
+
+const iter_child_nodes_doc = `iter_child_nodes(node)
+
+Yield all direct child nodes of *node*, that is, all fields that are nodes
+and all items of fields that are lists of nodes.`
+
+func ast_iter_child_nodes(self py.Object, node py.Object) (py.Object, error) {
+	children, err := iterChildNodes(node)
+	if err != nil {
+		return nil, err
+	}
+	return py.NewIterator(children), nil
+}
+
+const walk_doc = `walk(node)
+
+Recursively yield all descendant nodes in the tree starting at *node*
+(including *node* itself), in no specified order.  This is useful if you
+only want to modify nodes in place and don't care about the context.`
+
+func ast_walk(self py.Object, node py.Object) (py.Object, error) {
+	nodes, err := walk(node)
+	if err != nil {
+		return nil, err
+	}
+	return py.NewIterator(nodes), nil
+}
+
+const fix_missing_locations_doc = `fix_missing_locations(node)
+
+When you compile a node tree with compile(), the compiler expects lineno and
+col_offset attributes for every node that supports them.  This is rather
+tedious to fill in for generated nodes, so this helper adds these attributes
+recursively where not already set, by setting them to the values of the
+parent node.  It works recursively starting at *node*.`
+
+// Set missing lineno and col_offset attributes on node and its children
+func fixMissingLocations(node py.Object, lineno, colOffset py.Object) error {
+	for _, attr := range []struct {
+		name  string
+		value *py.Object
+	}{
+		{"lineno", &lineno},
+		{"col_offset", &colOffset},
+	} {
+		ok, err := hasAttribute(node, attr.name)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		value, err := getAttrOrNil(node, attr.name)
+		if err != nil {
+			return err
+		}
+		if value == nil {
+			_, err = py.SetAttrString(node, attr.name, *attr.value)
+			if err != nil {
+				return err
+			}
+		} else {
+			*attr.value = value
+		}
+	}
+	children, err := iterChildNodes(node)
+	if err != nil {
+		return err
+	}
+	for _, child := range children {
+		err = fixMissingLocations(child, lineno, colOffset)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ast_fix_missing_locations(self py.Object, node py.Object) (py.Object, error) {
+	err := fixMissingLocations(node, py.Int(1), py.Int(0))
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+const copy_location_doc = `copy_location(new_node, old_node)
+
+Copy source location (` + "`lineno` and `col_offset`" + ` attributes) from
+*old_node* to *new_node* if possible, and return *new_node*.`
+
+func ast_copy_location(self py.Object, args py.Tuple) (py.Object, error) {
+	var newNode, oldNode py.Object
+	err := py.UnpackTuple(args, nil, "copy_location", 2, 2, &newNode, &oldNode)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range []string{"lineno", "col_offset"} {
+		oldOk, err := hasAttribute(oldNode, name)
+		if err != nil {
+			return nil, err
+		}
+		newOk, err := hasAttribute(newNode, name)
+		if err != nil {
+			return nil, err
+		}
+		if !oldOk || !newOk {
+			continue
+		}
+		value, err := getAttrOrNil(oldNode, name)
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			_, err = py.SetAttrString(newNode, name, value)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return newNode, nil
+}
+
+const increment_lineno_doc = `increment_lineno(node, n=1)
+
+Increment the line number of each node in the tree starting at *node* by *n*.
+This is useful to "move code" to a different location in a file.`
+
+func ast_increment_lineno(self py.Object, args py.Tuple) (py.Object, error) {
+	var node py.Object
+	var n py.Object = py.Int(1)
+	err := py.UnpackTuple(args, nil, "increment_lineno", 1, 2, &node, &n)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := walk(node)
+	if err != nil {
+		return nil, err
+	}
+	for _, child := range nodes {
+		ok, err := hasAttribute(child, "lineno")
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		lineno, err := getAttrOrNil(child, "lineno")
+		if err != nil {
+			return nil, err
+		}
+		if lineno == nil {
+			lineno = py.Int(0)
+		}
+		lineno, err = py.Add(lineno, n)
+		if err != nil {
+			return nil, err
+		}
+		_, err = py.SetAttrString(child, "lineno", lineno)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return node, nil
+}
+
+const get_docstring_doc = `get_docstring(node, clean=True)
+
+Return the docstring for the given node or None if no docstring can
+be found.  If the node provided does not have docstrings a TypeError
+will be raised.`
+
+// Clean up indentation from docstrings as inspect.cleandoc does
+func cleanDoc(doc string) string {
+	lines := strings.Split(strings.Replace(doc, "\t", "        ", -1), "\n")
+	margin := -1
+	for _, line := range lines[1:] {
+		content := len(strings.TrimLeft(line, " "))
+		if content > 0 {
+			indent := len(line) - content
+			if margin < 0 || indent < margin {
+				margin = indent
+			}
+		}
+	}
+	lines[0] = strings.TrimLeft(lines[0], " ")
+	if margin >= 0 {
+		for i := 1; i < len(lines); i++ {
+			if len(lines[i]) >= margin {
+				lines[i] = lines[i][margin:]
+			} else {
+				lines[i] = strings.TrimLeft(lines[i], " ")
+			}
+		}
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+func ast_get_docstring(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var node py.Object
+	var clean py.Object = py.True
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|O:get_docstring", []string{"node", "clean"}, &node, &clean)
+	if err != nil {
+		return nil, err
+	}
+	if !isInstance(node, "FunctionDef", "ClassDef", "Module") {
+		return nil, py.ExceptionNewf(py.TypeError, "'%s' can't have docstrings", node.Type().Name)
+	}
+	body, err := getAttrOrNil(node, "body")
+	if err != nil {
+		return nil, err
+	}
+	list, ok := body.(*py.List)
+	if !ok || len(list.Items) == 0 || !isInstance(list.Items[0], "Expr") {
+		return py.None, nil
+	}
+	value, err := getAttrOrNil(list.Items[0], "value")
+	if err != nil || value == nil || !isInstance(value, "Str") {
+		return py.None, err
+	}
+	doc, err := py.GetAttrString(value, "s")
+	if err != nil {
+		return nil, err
+	}
+	cleanOk, err := py.MakeBool(clean)
+	if err != nil {
+		return nil, err
+	}
+	if s, ok := doc.(py.String); ok && cleanOk == py.True {
+		return py.String(cleanDoc(string(s))), nil
+	}
+	return doc, nil
+}
+
+// Calls the visitor method on self for node
+func visit(self py.Object, node py.Object) (py.Object, error) {
+	visitor, err := getAttrOrNil(self, "visit_"+node.Type().Name)
+	if err != nil {
+		return nil, err
+	}
+	if visitor == nil {
+		visitor, err = py.GetAttrString(self, "generic_visit")
+		if err != nil {
+			return nil, err
+		}
+	}
+	return py.Call(visitor, py.Tuple{node}, nil)
+}
+
+func nodevisitor_visit(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var node py.Object
+	err := py.ParseTupleAndKeywords(args, kwargs, "O:visit", []string{"node"}, &node)
+	if err != nil {
+		return nil, err
+	}
+	return visit(self, node)
+}
+
+// Calls the visit method of self on node
+func callVisit(self py.Object, node py.Object) (py.Object, error) {
+	method, err := py.GetAttrString(self, "visit")
+	if err != nil {
+		return nil, err
+	}
+	return py.Call(method, py.Tuple{node}, nil)
+}
+
+func nodevisitor_generic_visit(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var node py.Object
+	err := py.ParseTupleAndKeywords(args, kwargs, "O:generic_visit", []string{"node"}, &node)
+	if err != nil {
+		return nil, err
+	}
+	children, err := iterChildNodes(node)
+	if err != nil {
+		return nil, err
+	}
+	for _, child := range children {
+		_, err = callVisit(self, child)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return py.None, nil
+}
+
+func nodetransformer_generic_visit(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var node py.Object
+	err := py.ParseTupleAndKeywords(args, kwargs, "O:generic_visit", []string{"node"}, &node)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := iterFields(node)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range fields {
+		if list, ok := f.value.(*py.List); ok {
+			newItems := make([]py.Object, 0, len(list.Items))
+			for _, item := range list.Items {
+				if IsAst(item) {
+					item, err = callVisit(self, item)
+					if err != nil {
+						return nil, err
+					}
+					if item == py.None {
+						continue
+					}
+					if !IsAst(item) {
+						err = py.Iterate(item, func(x py.Object) bool {
+							newItems = append(newItems, x)
+							return false
+						})
+						if err != nil {
+							return nil, err
+						}
+						continue
+					}
+				}
+				newItems = append(newItems, item)
+			}
+			list.Items = newItems
+		} else if IsAst(f.value) {
+			newNode, err := callVisit(self, f.value)
+			if err != nil {
+				return nil, err
+			}
+			if newNode == py.None {
+				err = py.DeleteAttrString(node, f.name)
+			} else {
+				_, err = py.SetAttrString(node, f.name, newNode)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return node, nil
+}
+
+const nodevisitor_doc = `A node visitor base class that walks the abstract syntax tree and calls a
+visitor function for every node found.  This function may return a value
+which is forwarded by the ` + "`visit`" + ` method.
+
+This class is meant to be subclassed, with the subclass adding visitor
+methods.
+
+Per default the visitor functions for the nodes are ` + "``'visit_'``" + ` +
+class name of the node.  So a ` + "`TryFinally`" + ` node visit function would
+be ` + "`visit_TryFinally`" + `.  This behavior can be changed by overriding
+the ` + "`visit`" + ` method.  If no visitor function exists for a node
+(return value ` + "`None`" + `) the ` + "`generic_visit`" + ` visitor is used instead.`
+
+const nodetransformer_doc = `A :class:` + "`NodeVisitor`" + ` subclass that walks the abstract syntax tree and
+allows modification of nodes.
+
+The ` + "`NodeTransformer`" + ` will walk the AST and use the return value of the
+visitor methods to replace or remove the old node.  If the return value of
+the visitor method is ` + "``None``" + `, the node will be removed from its location,
+otherwise it is replaced with the return value.  The return value may be the
+original node in which case no replacement takes place.`
+
+// Initialise the module
+func init() {
+	methods := []*py.Method{
+		py.MustNewMethod("parse", ast_parse, 0, parse_doc),
+		py.MustNewMethod("dump", ast_dump, 0, dump_doc),
+		py.MustNewMethod("literal_eval", ast_literal_eval, 0, literal_eval_doc),
+		py.MustNewMethod("iter_fields", ast_iter_fields, 0, iter_fields_doc),
+		py.MustNewMethod("iter_child_nodes", ast_iter_child_nodes, 0, iter_child_nodes_doc),
+		py.MustNewMethod("walk", ast_walk, 0, walk_doc),
+		py.MustNewMethod("fix_missing_locations", ast_fix_missing_locations, 0, fix_missing_locations_doc),
+		py.MustNewMethod("copy_location", ast_copy_location, 0, copy_location_doc),
+		py.MustNewMethod("increment_lineno", ast_increment_lineno, 0, increment_lineno_doc),
+		py.MustNewMethod("get_docstring", ast_get_docstring, 0, get_docstring_doc),
+	}
+	nodeVisitor := newPyClass("NodeVisitor", py.ObjectType, py.StringDict{
+		"__module__":    py.String("ast"),
+		"__doc__":       py.String(nodevisitor_doc),
+		"visit":         newMethod("visit", nodevisitor_visit, "Visit a node."),
+		"generic_visit": newMethod("generic_visit", nodevisitor_generic_visit, "Called if no explicit visitor function exists for a node."),
+	})
+	nodeTransformer := newPyClass("NodeTransformer", nodeVisitor, py.StringDict{
+		"__module__":    py.String("ast"),
+		"__doc__":       py.String(nodetransformer_doc),
+		"generic_visit": newMethod("generic_visit", nodetransformer_generic_visit, "Called if no explicit visitor function exists for a node."),
+	})
+	globals := py.StringDict{
+		"PyCF_ONLY_AST":   py.Int(compile.PyCF_ONLY_AST),
+		"NodeVisitor":     nodeVisitor,
+		"NodeTransformer": nodeTransformer,
+	}
+	for name, class := range classes {
+		globals[name] = class
+	}
+	py.NewModule("ast", module_doc, methods, globals)
+}
@@ -0,0 +1,154 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+import ast
+
+doc="parse and dump"
+tree = ast.parse("x = 1 + 2")
+assert type(tree) == ast.Module
+assert ast.dump(tree) == "Module(body=[Assign(targets=[Name(id='x', ctx=Store())], value=BinOp(left=Num(n=1), op=Add(), right=Num(n=2)))])", ast.dump(tree)
+assert ast.dump(tree, False) == "Module([Assign([Name('x', Store())], BinOp(Num(1), Add(), Num(2)))])"
+assign = tree.body[0]
+assert assign.lineno == 1
+assert assign.col_offset == 0
+assert assign.value.col_offset == 4
+assert "lineno=1, col_offset=4" in ast.dump(tree, include_attributes=True)
+assert ast.dump(ast.parse("a", mode="eval")) == "Expression(body=Name(id='a', ctx=Load()))"
+
+doc="fields"
+assert ast.BinOp._fields == ('left', 'op', 'right')
+assert ast.Name._attributes == ('lineno', 'col_offset')
+assert ast.keyword._attributes == ()
+assert ast.FunctionDef._fields == ('name', 'args', 'body', 'decorator_list', 'returns')
+assert ast.ExceptHandler._fields == ('type', 'name', 'body')
+assert [name for name, value in ast.iter_fields(assign)] == ['targets', 'value']
+
+doc="node constructors"
+n = ast.Num(42)
+assert n.n == 42
+n = ast.Name(id="y", ctx=ast.Load())
+assert n.id == "y"
+assert ast.dump(n) == "Name(id='y', ctx=Load())"
+try:
+    ast.BinOp(1, 2)
+except TypeError:
+    pass
+else:
+    assert False, "TypeError not raised"
+
+doc="subclasses"
+class MyNum(ast.Num):
+    pass
+tree = ast.fix_missing_locations(ast.Expression(body=MyNum(n=5)))
+assert eval(compile(tree, "<test>", "eval")) == 5
+assert ast.literal_eval(MyNum(n=6)) == 6
+
+doc="PyCF_ONLY_AST"
+tree = compile("a + b", "<test>", "eval", ast.PyCF_ONLY_AST)
+assert type(tree) == ast.Expression
+assert compile(tree, "<test>", "eval", ast.PyCF_ONLY_AST) is tree
+assert eval(compile(tree, "<test>", "eval"), {"a": 1, "b": 2}) == 3
+glob = {}
+exec(compile(ast.parse("def f(a, *b, c=1, **d):\n    return a + c\nr = f(2)"), "<test>", "exec"), glob)
+assert glob["r"] == 3
+
+doc="f-strings"
+tree = ast.parse("f'a{b!r:>{w}}'", mode="eval")
+assert type(tree.body) == ast.JoinedStr
+assert ast.JoinedStr._fields == ('values',)
+assert ast.FormattedValue._fields == ('value', 'conversion', 'format_spec')
+value = tree.body.values[1]
+assert value.conversion == ord("r")
+assert type(value.format_spec) == ast.JoinedStr
+assert eval(compile(tree, "<test>", "eval"), {"b": 1, "w": 4}) == "a   1"
+tree = ast.fix_missing_locations(ast.Expression(body=ast.JoinedStr([ast.Str("x="), ast.FormattedValue(ast.Num(1), -1, None)])))
+assert eval(compile(tree, "<test>", "eval")) == "x=1"
+
+doc="compile bad ast"
+try:
+    compile(ast.parse("1"), "<test>", "eval")
+except TypeError:
+    pass
+else:
+    assert False, "TypeError not raised"
+try:
+    compile(ast.Expression(body=ast.Num(n=1)), "<test>", "eval")
+except TypeError as e:
+    pass
+else:
+    assert False, "TypeError not raised"
+
+doc="fix_missing_locations"
+tree = ast.Expression(body=ast.BinOp(left=ast.Num(n=6), op=ast.Mult(), right=ast.Num(n=7)))
+assert ast.fix_missing_locations(tree) is tree
+assert tree.body.lineno == 1
+assert tree.body.left.col_offset == 0
+assert eval(compile(tree, "<test>", "eval")) == 42
+
+doc="copy_location and increment_lineno"
+old = ast.parse("\n\nx").body[0].value
+new = ast.copy_location(ast.Num(n=1), old)
+assert new.lineno == 3
+tree = ast.parse("x\ny")
+ast.increment_lineno(tree, 10)
+assert [stmt.lineno for stmt in tree.body] == [11, 12]
+
+doc="walk and iter_child_nodes"
+tree = ast.parse("f(a, b)", mode="eval")
+names = [node.id for node in ast.walk(tree) if type(node) == ast.Name]
+assert names == ["f", "a", "b"], names
+assert len(list(ast.iter_child_nodes(tree.body))) == 3
+
+doc="NodeVisitor"
+class Visitor(ast.NodeVisitor):
+    def __init__(self):
+        self.names = []
+    def visit_Name(self, node):
+        self.names.append(node.id)
+    def visit_Call(self, node):
+        self.names.append("call")
+        self.generic_visit(node)
+v = Visitor()
+v.visit(ast.parse("x = f(y)\nz"))
+assert v.names == ["x", "call", "f", "y", "z"], v.names
+
+doc="NodeTransformer"
+class Transformer(ast.NodeTransformer):
+    def visit_Num(self, node):
+        return ast.copy_location(ast.Num(n=node.n * 10), node)
+    def visit_Pass(self, node):
+        return None
+tree = Transformer().visit(ast.parse("pass\nr = 1 + 2\npass"))
+assert len(tree.body) == 1
+glob = {}
+exec(compile(tree, "<test>", "exec"), glob)
+assert glob["r"] == 30
+
+doc="literal_eval"
+assert ast.literal_eval("[1, (2, 'a'), {'k': None}, -3, True]") == [1, (2, 'a'), {'k': None}, -3, True]
+assert ast.literal_eval("b'x'") == b'x'
+assert ast.literal_eval("1+2j") == 1+2j
+assert ast.literal_eval(ast.parse("-1.5", mode="eval")) == -1.5
+assert ast.literal_eval(ast.Num(n=3)) == 3
+for bad in ["a", "f()", "1+2", "[x for x in y]"]:
+    try:
+        ast.literal_eval(bad)
+    except ValueError as e:
+        assert e.args[0].startswith("malformed node or string"), e.args
+    else:
+        assert False, "ValueError not raised for %s" % bad
+
+doc="get_docstring"
+tree = ast.parse('def f():\n    """  hello\n    there\n    """\n    pass\nclass C:\n    pass')
+assert ast.get_docstring(tree.body[0]) == "hello\nthere"
+assert ast.get_docstring(tree.body[0], clean=False) == "  hello\n    there\n    "
+assert ast.get_docstring(tree.body[1]) is None
+try:
+    ast.get_docstring(tree.body[1].body[0])
+except TypeError:
+    pass
+else:
+    assert False, "TypeError not raised"
+
+doc="finished"
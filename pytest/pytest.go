@@ -41,7 +41,7 @@ func compileProgram(t testing.TB, prog string) (*py.Module, *py.Code) {
 	}
 
 	code := obj.(*py.Code)
-	module := py.NewModule("__main__", "", nil, nil)
+	module := py.DefaultContext.NewModule("__main__", "", nil, nil)
 	module.Globals["__file__"] = py.String(prog)
 	return module, code
 }
@@ -0,0 +1,106 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// The Mersenne Twister
+//
+// This is MT19937 as written by Makoto Matsumoto and Takuji Nishimura
+// and as used by CPython's _random module, so the same seed makes the
+// same sequence.
+
+package random
+
+const (
+	mtN         = 624
+	mtM         = 397
+	matrixA     = 0x9908b0df // constant vector a
+	upperMask   = 0x80000000 // most significant w-r bits
+	lowerMask   = 0x7fffffff // least significant r bits
+	mtStateSize = mtN + 1    // state words and the index
+)
+
+// The state of a Mersenne Twister
+type mt19937 struct {
+	state [mtN]uint32
+	index int // next word of state to use, mtN to generate more
+}
+
+// Initializes the state with a seed
+func (mt *mt19937) initGenrand(s uint32) {
+	mt.state[0] = s
+	for i := 1; i < mtN; i++ {
+		mt.state[i] = 1812433253*(mt.state[i-1]^(mt.state[i-1]>>30)) + uint32(i)
+	}
+	mt.index = mtN
+}
+
+// Initializes the state with an array of seed words
+func (mt *mt19937) initByArray(key []uint32) {
+	mt.initGenrand(19650218)
+	i, j := 1, 0
+	k := mtN
+	if len(key) > k {
+		k = len(key)
+	}
+	for ; k > 0; k-- {
+		mt.state[i] = (mt.state[i] ^ ((mt.state[i-1] ^ (mt.state[i-1] >> 30)) * 1664525)) + key[j] + uint32(j)
+		i++
+		j++
+		if i >= mtN {
+			mt.state[0] = mt.state[mtN-1]
+			i = 1
+		}
+		if j >= len(key) {
+			j = 0
+		}
+	}
+	for k = mtN - 1; k > 0; k-- {
+		mt.state[i] = (mt.state[i] ^ ((mt.state[i-1] ^ (mt.state[i-1] >> 30)) * 1566083941)) - uint32(i)
+		i++
+		if i >= mtN {
+			mt.state[0] = mt.state[mtN-1]
+			i = 1
+		}
+	}
+	// MSB is 1 assuring non-zero initial array
+	mt.state[0] = 0x80000000
+}
+
+// Generates the next mtN words of state
+func (mt *mt19937) generate() {
+	mag01 := [2]uint32{0, matrixA}
+	var kk int
+	for kk = 0; kk < mtN-mtM; kk++ {
+		y := (mt.state[kk] & upperMask) | (mt.state[kk+1] & lowerMask)
+		mt.state[kk] = mt.state[kk+mtM] ^ (y >> 1) ^ mag01[y&1]
+	}
+	for ; kk < mtN-1; kk++ {
+		y := (mt.state[kk] & upperMask) | (mt.state[kk+1] & lowerMask)
+		mt.state[kk] = mt.state[kk+(mtM-mtN)] ^ (y >> 1) ^ mag01[y&1]
+	}
+	y := (mt.state[mtN-1] & upperMask) | (mt.state[0] & lowerMask)
+	mt.state[mtN-1] = mt.state[mtM-1] ^ (y >> 1) ^ mag01[y&1]
+	mt.index = 0
+}
+
+// Returns a random number on [0,0xffffffff]
+func (mt *mt19937) genrand() uint32 {
+	if mt.index >= mtN {
+		mt.generate()
+	}
+	y := mt.state[mt.index]
+	mt.index++
+	// Tempering
+	y ^= y >> 11
+	y ^= (y << 7) & 0x9d2c5680
+	y ^= (y << 15) & 0xefc60000
+	y ^= y >> 18
+	return y
+}
+
+// Returns a random float on [0,1) with 53 bit resolution
+func (mt *mt19937) random() float64 {
+	a := mt.genrand() >> 5
+	b := mt.genrand() >> 6
+	return (float64(a)*67108864.0 + float64(b)) * (1.0 / 9007199254740992.0)
+}
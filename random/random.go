@@ -0,0 +1,1228 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package random implements the python random module
+//
+// Random uses the same Mersenne Twister and seeding as CPython so the
+// same seed gives the same sequence of numbers, and the methods
+// consume random numbers in the same way so they give the same
+// results too.  SystemRandom reads from crypto/rand instead.
+package random
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"math"
+	"math/big"
+	"math/bits"
+	"time"
+
+	"github.com/go-python/gpython/collections"
+	"github.com/go-python/gpython/py"
+)
+
+const module_doc = `Random variable generators.
+
+    bytes
+    -----
+           uniform bytes (values between 0 and 255)
+
+    integers
+    --------
+           uniform within range
+
+    sequences
+    ---------
+           pick random element
+           pick random sample
+           pick weighted random sample
+           generate random permutation
+
+    distributions on the real line:
+    ------------------------------
+           uniform
+           normal (Gaussian)
+
+General notes on the underlying Mersenne Twister core generator:
+
+* The period is 2**19937-1.
+* It is one of the most extensively tested generators in existence.
+* The random() method is implemented in Go, executes in a single Go step,
+  and is, therefore, threadsafe.`
+
+// Version of the state returned by getstate
+const version = 3
+
+// A python Random object
+type Random struct {
+	typ       *py.Type
+	mt        *mt19937  // nil for a SystemRandom
+	gaussNext py.Object // the next value for gauss or None
+	dict      py.StringDict
+}
+
+// Makes a new Go type which python classes can subclass
+func newBaseType(name, doc string, new py.NewFunc, base *py.Type) *py.Type {
+	t := py.NewTypeX(name, doc, new, nil)
+	t.Flags |= py.TPFLAGS_BASETYPE | py.TPFLAGS_INHERIT_NEW
+	if base != nil {
+		t.Base = base
+		t.Bases = py.Tuple{base}
+	}
+	// Ready it now as py has already readied the types made before it
+	// was initialised
+	err := t.Ready()
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+var RandomType = newBaseType("Random", `Random number generator base class used by bound module functions.
+
+Used to instantiate instances of Random to get generators that don't
+share state.
+
+Class Random can also be subclassed if you want to use a different basic
+generator of your own devising: in that case, override the following
+methods:  random(), seed(), getstate(), and setstate().
+Optionally, implement a getrandbits() method so that randrange()
+can cover arbitrarily large ranges.`, RandomNew, nil)
+
+var SystemRandomType = newBaseType("SystemRandom", `Alternate random number generator using sources provided
+by the operating system (such as /dev/urandom on Unix or
+CryptGenRandom on Windows).
+
+ Not available on all systems (see os.urandom() for details).`, SystemRandomNew, RandomType)
+
+// Type of this object
+func (r *Random) Type() *py.Type {
+	return r.typ
+}
+
+// Returns the instance dictionary for the attributes of subclasses
+func (r *Random) GetDict() py.StringDict {
+	return r.dict
+}
+
+// Makes a new unseeded Random of type t
+func newRandom(t *py.Type) *Random {
+	return &Random{
+		typ:       t,
+		mt:        &mt19937{},
+		gaussNext: py.None,
+		dict:      py.NewStringDict(),
+	}
+}
+
+// RandomNew makes a Random or SystemRandom seeded from its argument
+//
+// Python subclasses are seeded from the system and then seeded again
+// by __init__ so they can override it.
+func RandomNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	r := newRandom(metatype)
+	if metatype.Flags&py.TPFLAGS_HEAPTYPE != 0 {
+		return r, r.seed(py.None, 2)
+	}
+	var x py.Object = py.None
+	err := py.UnpackTuple(args, kwargs, metatype.Name, 0, 1, &x)
+	if err != nil {
+		return nil, err
+	}
+	return r, r.seed(x, 2)
+}
+
+// SystemRandomNew makes a SystemRandom, ignoring any seed passed in
+func SystemRandomNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	r := newRandom(metatype)
+	r.mt = nil
+	var x py.Object = py.None
+	err := py.UnpackTuple(args, kwargs, metatype.Name, 0, 1, &x)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Fills buf from the system entropy source
+func urandom(buf []byte) {
+	// crypto/rand.Read never returns an error
+	_, _ = rand.Read(buf)
+}
+
+// Returns the 32 bit words of the absolute value of n, least
+// significant first, to seed the generator with
+func seedKey(n *big.Int) []uint32 {
+	b := new(big.Int).Abs(n).Bytes()
+	if len(b) == 0 {
+		return []uint32{0}
+	}
+	key := make([]uint32, (len(b)+3)/4)
+	for i := range b {
+		key[i/4] |= uint32(b[len(b)-1-i]) << (8 * (i % 4))
+	}
+	return key
+}
+
+// Returns hash(x) for a float as CPython works it out
+func floatHash(x float64) int64 {
+	const bits = 61
+	const modulus = 1<<bits - 1
+	switch {
+	case math.IsInf(x, 1):
+		return 314159
+	case math.IsInf(x, -1):
+		return -314159
+	case math.IsNaN(x):
+		return 0
+	}
+	m, e := math.Frexp(x)
+	sign := int64(1)
+	if m < 0 {
+		sign = -1
+		m = -m
+	}
+	var h uint64
+	for m != 0 {
+		h = ((h << 28) & modulus) | h>>(bits-28)
+		m *= 268435456.0 // 2**28
+		e -= 28
+		y := uint64(m)
+		m -= float64(y)
+		h += y
+		if h >= modulus {
+			h -= modulus
+		}
+	}
+	if e >= 0 {
+		e %= bits
+	} else {
+		e = bits - 1 - ((-1 - e) % bits)
+	}
+	h = ((h << uint(e)) & modulus) | h>>(bits-uint(e))
+	res := int64(h) * sign
+	if res == -1 {
+		res = -2
+	}
+	return res
+}
+
+// Seeds the generator from a as CPython does
+//
+// A str or bytes seed is turned into an int with the version 2 scheme
+// (from its bytes and their sha512 digest) unless version is 1.
+func (r *Random) seed(a py.Object, version int) error {
+	if r.mt == nil {
+		// The system entropy source can't be seeded
+		return nil
+	}
+	r.gaussNext = py.None
+	var n *big.Int
+	switch x := a.(type) {
+	case py.NoneType:
+		buf := make([]byte, 4*mtN)
+		urandom(buf)
+		key := make([]uint32, mtN)
+		for i := range key {
+			key[i] = uint32(buf[4*i]) | uint32(buf[4*i+1])<<8 | uint32(buf[4*i+2])<<16 | uint32(buf[4*i+3])<<24
+		}
+		// Mix in the time in case the entropy source is broken
+		key[0] ^= uint32(time.Now().UnixNano())
+		r.mt.initByArray(key)
+		return nil
+	case py.Float:
+		n = big.NewInt(floatHash(float64(x)))
+	case py.String, py.Bytes:
+		var b []byte
+		if s, ok := x.(py.String); ok {
+			b = []byte(string(s))
+		} else {
+			b = []byte(x.(py.Bytes))
+		}
+		if version == 1 {
+			// The scheme used before python 3.2
+			var h uint64
+			var runes []rune
+			if s, ok := x.(py.String); ok {
+				runes = []rune(string(s))
+			} else {
+				for _, c := range b {
+					runes = append(runes, rune(c))
+				}
+			}
+			if len(runes) > 0 {
+				h = uint64(runes[0]) << 7
+			}
+			for _, c := range runes {
+				h = (1000003 * h) ^ uint64(c)
+			}
+			h ^= uint64(len(runes))
+			n = new(big.Int).SetUint64(h)
+		} else {
+			digest := sha512.Sum512(b)
+			n = new(big.Int).SetBytes(append(b, digest[:]...))
+		}
+	default:
+		bigInt, ok := py.ConvertToBigInt(a)
+		if !ok {
+			return py.ExceptionNewf(py.TypeError, "The only supported seed types are: None,\nint, float, str, bytes, and bytearray.")
+		}
+		n = (*big.Int)(bigInt)
+	}
+	r.mt.initByArray(seedKey(n))
+	return nil
+}
+
+// Returns which of names a python subclass overrides first in its MRO
+// or "" if it doesn't override any of them
+func (r *Random) overrides(names ...string) string {
+	for _, o := range r.typ.Mro {
+		t := o.(*py.Type)
+		if t.Flags&py.TPFLAGS_HEAPTYPE == 0 {
+			break
+		}
+		for _, name := range names {
+			if _, ok := t.Dict[name]; ok {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// Calls the method name of r
+func (r *Random) call(name string, args py.Tuple) (py.Object, error) {
+	method, err := py.GetAttrString(r, name)
+	if err != nil {
+		return nil, err
+	}
+	return py.Call(method, args, nil)
+}
+
+// Returns a random float in [0, 1)
+func (r *Random) random() (float64, error) {
+	if r.overrides("random") != "" {
+		res, err := r.call("random", nil)
+		if err != nil {
+			return 0, err
+		}
+		return py.FloatAsFloat64(res)
+	}
+	if r.mt == nil {
+		// 53 bits from 7 bytes
+		var buf [7]byte
+		urandom(buf[:])
+		var x uint64
+		for _, b := range buf {
+			x = x<<8 | uint64(b)
+		}
+		return float64(x>>3) * (1.0 / 9007199254740992.0), nil
+	}
+	return r.mt.random(), nil
+}
+
+// Returns k random bits for k <= 64
+func (r *Random) bits(k int) uint64 {
+	var x uint64
+	if r.mt == nil {
+		numbytes := (k + 7) / 8
+		buf := make([]byte, numbytes)
+		urandom(buf)
+		for _, b := range buf {
+			x = x<<8 | uint64(b)
+		}
+		return x >> uint(numbytes*8-k)
+	}
+	for i := 0; k > 0; i, k = i+1, k-32 {
+		w := r.mt.genrand()
+		if k < 32 {
+			w >>= uint(32 - k)
+		}
+		x |= uint64(w) << uint(32*i)
+	}
+	return x
+}
+
+// Returns k random bits as a big.Int
+func (r *Random) bigBits(k int) *big.Int {
+	x := new(big.Int)
+	if r.mt == nil {
+		numbytes := (k + 7) / 8
+		buf := make([]byte, numbytes)
+		urandom(buf)
+		x.SetBytes(buf)
+		return x.Rsh(x, uint(numbytes*8-k))
+	}
+	// The words are made least significant first
+	buf := make([]byte, 4*((k+31)/32))
+	for i := len(buf) - 4; k > 0; i, k = i-4, k-32 {
+		w := r.mt.genrand()
+		if k < 32 {
+			w >>= uint(32 - k)
+		}
+		buf[i], buf[i+1], buf[i+2], buf[i+3] = byte(w>>24), byte(w>>16), byte(w>>8), byte(w)
+	}
+	return x.SetBytes(buf)
+}
+
+// Returns a random int in [0, n)
+//
+// As in CPython this uses getrandbits unless a python subclass only
+// overrides random.
+func (r *Random) randbelow(n int) (int, error) {
+	if n <= 0 {
+		return 0, nil
+	}
+	k := bits.Len(uint(n))
+	switch r.overrides("getrandbits", "random") {
+	case "getrandbits":
+		for {
+			res, err := r.call("getrandbits", py.Tuple{py.Int(k)})
+			if err != nil {
+				return 0, err
+			}
+			x, err := py.IndexInt(res)
+			if err != nil {
+				return 0, err
+			}
+			if x < n {
+				return x, nil
+			}
+		}
+	case "random":
+		const maxsize = 1 << 53
+		if n >= maxsize {
+			x, err := r.random()
+			return int(math.Floor(x * float64(n))), err
+		}
+		rem := maxsize % n
+		limit := float64(maxsize-rem) / maxsize
+		x, err := r.random()
+		for err == nil && x >= limit {
+			x, err = r.random()
+		}
+		return int(math.Floor(x*maxsize)) % n, err
+	}
+	for {
+		x := r.bits(k)
+		if x < uint64(n) {
+			return int(x), nil
+		}
+	}
+}
+
+// Returns a random int in [0, n) for an n of any size
+//
+// An n which fits in an int is passed to randbelow so both draw the
+// same numbers, as CPython's _randbelow does for ints of any size.
+func (r *Random) randbelowBig(n *big.Int) (*big.Int, error) {
+	if n.IsInt64() && int64(int(n.Int64())) == n.Int64() {
+		x, err := r.randbelow(int(n.Int64()))
+		return big.NewInt(int64(x)), err
+	}
+	if n.Sign() <= 0 {
+		return new(big.Int), nil
+	}
+	k := n.BitLen()
+	switch r.overrides("getrandbits", "random") {
+	case "getrandbits":
+		for {
+			res, err := r.call("getrandbits", py.Tuple{py.Int(k)})
+			if err != nil {
+				return nil, err
+			}
+			x, err := index(res)
+			if err != nil {
+				return nil, err
+			}
+			if x.Sign() >= 0 && x.Cmp(n) < 0 {
+				return x, nil
+			}
+		}
+	case "random":
+		// As in CPython this only has the 53 bits of the float
+		fn, _ := new(big.Float).SetInt(n).Float64()
+		if math.IsInf(fn, 0) {
+			return nil, py.ExceptionNewf(py.OverflowError, "int too large to convert to float")
+		}
+		f, err := r.random()
+		if err != nil {
+			return nil, err
+		}
+		x, _ := big.NewFloat(math.Floor(f * fn)).Int(nil)
+		return x, nil
+	}
+	for {
+		x := r.bigBits(k)
+		if x.Cmp(n) < 0 {
+			return x, nil
+		}
+	}
+}
+
+var bigOne = big.NewInt(1)
+
+// Returns obj as a new big.Int, as operator.index does
+func index(obj py.Object) (*big.Int, error) {
+	if x, ok := obj.(*py.BigInt); ok {
+		return new(big.Int).Set((*big.Int)(x)), nil
+	}
+	i, err := py.Index(obj)
+	if err != nil {
+		return nil, err
+	}
+	return big.NewInt(int64(i)), nil
+}
+
+// Returns an integer in range(start, stop, step)
+//
+// The bounds may be any size so the arithmetic is done with big.Ints.
+func (r *Random) randrange(start, stop, step *big.Int) (py.Object, error) {
+	width := new(big.Int).Sub(stop, start)
+	if step.Cmp(bigOne) == 0 {
+		if width.Sign() > 0 {
+			x, err := r.randbelowBig(width)
+			if err != nil {
+				return nil, err
+			}
+			return (*py.BigInt)(x.Add(x, start)).MaybeInt(), nil
+		}
+		return nil, py.ExceptionNewf(py.ValueError, "empty range for randrange() (%d, %d, %d)", start, stop, width)
+	}
+	n := new(big.Int)
+	switch step.Sign() {
+	case 1:
+		n.Add(width, step)
+		n.Sub(n, bigOne)
+	case -1:
+		n.Add(width, step)
+		n.Add(n, bigOne)
+	default:
+		return nil, py.ExceptionNewf(py.ValueError, "zero step for randrange()")
+	}
+	// Truncating rather than floor division only differs when n <= 0
+	n.Quo(n, step)
+	if n.Sign() <= 0 {
+		return nil, py.ExceptionNewf(py.ValueError, "empty range for randrange()")
+	}
+	x, err := r.randbelowBig(n)
+	if err != nil {
+		return nil, err
+	}
+	x.Mul(x, step)
+	return (*py.BigInt)(x.Add(x, start)).MaybeInt(), nil
+}
+
+// Returns the indices of a sample of k of n items
+//
+// This chooses them in the same way CPython chooses the items.
+func (r *Random) sampleIndices(n, k int) ([]int, error) {
+	if k < 0 || k > n {
+		return nil, py.ExceptionNewf(py.ValueError, "Sample larger than population or is negative")
+	}
+	result := make([]int, k)
+	setsize := 21 // size of a small set minus size of an empty list
+	if k > 5 {
+		// table size for big sets
+		setsize += int(math.Pow(4, math.Ceil(math.Log(float64(k*3))/math.Log(4))))
+	}
+	if n <= setsize {
+		// An n-length list is smaller than a k-length set
+		pool := make([]int, n)
+		for i := range pool {
+			pool[i] = i
+		}
+		for i := range result {
+			j, err := r.randbelow(n - i)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = pool[j]
+			pool[j] = pool[n-i-1] // move non-selected item into vacancy
+		}
+		return result, nil
+	}
+	selected := make(map[int]struct{}, k)
+	for i := range result {
+		j, err := r.randbelow(n)
+		if err != nil {
+			return nil, err
+		}
+		for {
+			if _, found := selected[j]; !found {
+				break
+			}
+			j, err = r.randbelow(n)
+			if err != nil {
+				return nil, err
+			}
+		}
+		selected[j] = struct{}{}
+		result[i] = j
+	}
+	return result, nil
+}
+
+// Returns the running totals of the items of iterable
+func accumulate(iterable py.Object) ([]py.Object, error) {
+	var res []py.Object
+	var total py.Object
+	var err error
+	iterErr := py.Iterate(iterable, func(item py.Object) bool {
+		if total == nil {
+			total = item
+		} else {
+			total, err = py.Add(total, item)
+			if err != nil {
+				return true
+			}
+		}
+		res = append(res, total)
+		return false
+	})
+	if iterErr != nil {
+		return nil, iterErr
+	}
+	return res, err
+}
+
+// Returns the index to insert x at in the sorted a[lo:hi] after any
+// items equal to it
+func bisectRight(a []py.Object, x py.Object, lo, hi int) (int, error) {
+	for lo < hi {
+		mid := (lo + hi) / 2
+		lt, err := py.Lt(x, a[mid])
+		if err != nil {
+			return 0, err
+		}
+		if lt == py.True {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return lo, nil
+}
+
+// Removes the keyword only argument name from kwargs returning it or
+// def if it wasn't there
+func keywordOnly(kwargs *py.StringDict, name string, def py.Object) py.Object {
+	value, ok := (*kwargs)[name]
+	if !ok {
+		return def
+	}
+	*kwargs = kwargs.Copy()
+	delete(*kwargs, name)
+	return value
+}
+
+// Returns an error if population isn't a sequence
+func checkSequence(population py.Object) error {
+	switch population.(type) {
+	case py.StringDict, *py.Set, *py.FrozenSet:
+	default:
+		if _, err := py.GetAttrString(population, "keys"); err != nil {
+			return nil
+		}
+	}
+	return py.ExceptionNewf(py.TypeError, "Population must be a sequence.  For dicts or sets, use sorted(d).")
+}
+
+// Returns the length of seq
+func length(seq py.Object) (int, error) {
+	n, err := py.Len(seq)
+	if err != nil {
+		return 0, err
+	}
+	return py.IndexInt(n)
+}
+
+// The methods of Random which are also the module functions
+var methods = []struct {
+	name string
+	fn   func(r *Random, args py.Tuple, kwargs py.StringDict) (py.Object, error)
+	doc  string
+}{
+	{"seed", random_seed, `Initialize internal state from a seed.
+
+The only supported seed types are None, int, float,
+str, bytes, and bytearray.
+
+None or no argument seeds from current time or from an operating
+system specific randomness source if available.
+
+If *a* is an int, all bits are used.
+
+For version 2 (the default), all of the bits are used if *a* is a str,
+bytes, or bytearray.  For version 1 (provided for reproducing random
+sequences from older versions of Python), the algorithm for str and
+bytes generates a narrower range of seeds.`},
+	{"random", random_random, `random() -> x in the interval [0, 1).`},
+	{"getrandbits", random_getrandbits, `getrandbits(k) -> x.  Generates an int with k random bits.`},
+	{"getstate", random_getstate, `Return internal state; can be passed to setstate() later.`},
+	{"setstate", random_setstate, `Restore internal state from object returned by getstate().`},
+	{"randrange", random_randrange, `Choose a random item from range(stop) or range(start, stop[, step]).
+
+Roughly equivalent to ` + "``choice(range(start, stop, step))``" + ` but
+supports arbitrarily large ranges and is optimized for common cases.`},
+	{"randint", random_randint, `Return random integer in range [a, b], including both end points.`},
+	{"choice", random_choice, `Choose a random element from a non-empty sequence.`},
+	{"shuffle", random_shuffle, `Shuffle list x in place, and return None.`},
+	{"sample", random_sample, `Chooses k unique random elements from a population sequence.
+
+Returns a new list containing elements from the population while
+leaving the original population unchanged.  The resulting list is
+in selection order so that all sub-slices will also be valid random
+samples.  This allows raffle winners (the sample) to be partitioned
+into grand prize and second place winners (the subslices).
+
+Members of the population need not be hashable or unique.  If the
+population contains repeats, then each occurrence is a possible
+selection in the sample.
+
+Repeated elements can be specified one at a time or with the optional
+counts parameter.  For example:
+
+    sample(['red', 'blue'], counts=[4, 2], k=5)
+
+is equivalent to:
+
+    sample(['red', 'red', 'red', 'red', 'blue', 'blue'], k=5)
+
+To choose a sample from a range of integers, use range() for the
+population argument.  This is especially fast and space efficient
+for sampling from a large population:
+
+    sample(range(10000000), 60)`},
+	{"choices", random_choices, `Return a k sized list of population elements chosen with replacement.
+
+If the relative weights or cumulative weights are not specified,
+the selections are made with equal probability.`},
+	{"uniform", random_uniform, `Get a random number in the range [a, b) or [a, b] depending on rounding.`},
+	{"gauss", random_gauss, `Gaussian distribution.
+
+mu is the mean, and sigma is the standard deviation.  This is
+slightly faster than the normalvariate() function.
+
+The results may differ from CPython's in the last place as the
+underlying log, sin and cos are not the C library's.
+
+Not thread-safe without a lock around calls.`},
+}
+
+func random_seed(r *Random, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var a py.Object = py.None
+	var versionObj py.Object = py.Int(2)
+	err := py.ParseTupleAndKeywords(args, kwargs, "|OO:seed", []string{"a", "version"}, &a, &versionObj)
+	if err != nil {
+		return nil, err
+	}
+	v, err := py.IndexInt(versionObj)
+	if err != nil {
+		return nil, err
+	}
+	return py.None, r.seed(a, v)
+}
+
+func random_random(r *Random, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	err := py.UnpackTuple(args, kwargs, "random", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	if r.mt == nil {
+		x, err := r.random()
+		return py.Float(x), err
+	}
+	return py.Float(r.mt.random()), nil
+}
+
+func random_getrandbits(r *Random, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var kObj py.Object
+	err := py.UnpackTuple(args, kwargs, "getrandbits", 1, 1, &kObj)
+	if err != nil {
+		return nil, err
+	}
+	k, err := py.IndexInt(kObj)
+	if err != nil {
+		return nil, err
+	}
+	if k < 0 {
+		return nil, py.ExceptionNewf(py.ValueError, "number of bits must be non-negative")
+	}
+	if k < 64 {
+		return py.Int(r.bits(k)), nil
+	}
+	return (*py.BigInt)(r.bigBits(k)).MaybeInt(), nil
+}
+
+// Returns an error for the methods a SystemRandom doesn't have
+func notImplemented() error {
+	return py.ExceptionNewf(py.NotImplementedError, "System entropy source does not have state.")
+}
+
+func random_getstate(r *Random, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	err := py.UnpackTuple(args, kwargs, "getstate", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	if r.mt == nil {
+		return nil, notImplemented()
+	}
+	state := make(py.Tuple, mtStateSize)
+	for i, w := range r.mt.state {
+		state[i] = py.Int(w)
+	}
+	state[mtN] = py.Int(r.mt.index)
+	return py.Tuple{py.Int(version), state, r.gaussNext}, nil
+}
+
+func random_setstate(r *Random, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var stateObj py.Object
+	err := py.UnpackTuple(args, kwargs, "setstate", 1, 1, &stateObj)
+	if err != nil {
+		return nil, err
+	}
+	if r.mt == nil {
+		return nil, notImplemented()
+	}
+	state, ok := stateObj.(py.Tuple)
+	if !ok || len(state) == 0 {
+		return nil, py.ExceptionNewf(py.TypeError, "state must be a tuple returned by getstate()")
+	}
+	if v, err := py.IndexInt(state[0]); err != nil || v != version || len(state) != 3 {
+		repr, err := py.ReprAsString(state[0])
+		if err != nil {
+			return nil, err
+		}
+		return nil, py.ExceptionNewf(py.ValueError, "state with version %s passed to Random.setstate() of version %d", repr, version)
+	}
+	internal, ok := state[1].(py.Tuple)
+	if !ok {
+		return nil, py.ExceptionNewf(py.TypeError, "state vector must be a tuple")
+	}
+	if len(internal) != mtStateSize {
+		return nil, py.ExceptionNewf(py.ValueError, "state vector is the wrong size")
+	}
+	var mt mt19937
+	for i := range mt.state {
+		w, err := py.MakeGoInt64(internal[i])
+		if err != nil {
+			return nil, err
+		}
+		if w < 0 || w > math.MaxUint32 {
+			return nil, py.ExceptionNewf(py.OverflowError, "state vector element out of range")
+		}
+		mt.state[i] = uint32(w)
+	}
+	index, err := py.MakeGoInt(internal[mtN])
+	if err != nil {
+		return nil, err
+	}
+	if index < 0 || index > mtN {
+		return nil, py.ExceptionNewf(py.ValueError, "invalid state")
+	}
+	mt.index = index
+	*r.mt = mt
+	r.gaussNext = state[2]
+	return py.None, nil
+}
+
+func random_randrange(r *Random, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var startObj py.Object
+	var stopObj py.Object = py.None
+	var stepObj py.Object = py.Int(1)
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|OO:randrange", []string{"start", "stop", "step"}, &startObj, &stopObj, &stepObj)
+	if err != nil {
+		return nil, err
+	}
+	start, err := index(startObj)
+	if err != nil {
+		return nil, err
+	}
+	step, err := index(stepObj)
+	if err != nil {
+		return nil, err
+	}
+	if stopObj == py.None {
+		if step.Cmp(bigOne) != 0 {
+			return nil, py.ExceptionNewf(py.TypeError, "Missing a non-None stop argument")
+		}
+		if start.Sign() > 0 {
+			x, err := r.randbelowBig(start)
+			if err != nil {
+				return nil, err
+			}
+			return (*py.BigInt)(x).MaybeInt(), nil
+		}
+		return nil, py.ExceptionNewf(py.ValueError, "empty range for randrange()")
+	}
+	stop, err := index(stopObj)
+	if err != nil {
+		return nil, err
+	}
+	return r.randrange(start, stop, step)
+}
+
+func random_randint(r *Random, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var aObj, bObj py.Object
+	err := py.ParseTupleAndKeywords(args, kwargs, "OO:randint", []string{"a", "b"}, &aObj, &bObj)
+	if err != nil {
+		return nil, err
+	}
+	a, err := index(aObj)
+	if err != nil {
+		return nil, err
+	}
+	b, err := index(bObj)
+	if err != nil {
+		return nil, err
+	}
+	return r.randrange(a, b.Add(b, bigOne), bigOne)
+}
+
+func random_choice(r *Random, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var seq py.Object
+	err := py.ParseTupleAndKeywords(args, kwargs, "O:choice", []string{"seq"}, &seq)
+	if err != nil {
+		return nil, err
+	}
+	n, err := length(seq)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, py.ExceptionNewf(py.IndexError, "Cannot choose from an empty sequence")
+	}
+	i, err := r.randbelow(n)
+	if err != nil {
+		return nil, err
+	}
+	return py.GetItem(seq, py.Int(i))
+}
+
+func random_shuffle(r *Random, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var x py.Object
+	err := py.ParseTupleAndKeywords(args, kwargs, "O:shuffle", []string{"x"}, &x)
+	if err != nil {
+		return nil, err
+	}
+	n, err := length(x)
+	if err != nil {
+		return nil, err
+	}
+	for i := n - 1; i > 0; i-- {
+		// pick an element in x[:i+1] with which to exchange x[i]
+		j, err := r.randbelow(i + 1)
+		if err != nil {
+			return nil, err
+		}
+		if l, ok := x.(*py.List); ok && len(l.Items) == n {
+			l.Items[i], l.Items[j] = l.Items[j], l.Items[i]
+			continue
+		}
+		xi, err := py.GetItem(x, py.Int(i))
+		if err != nil {
+			return nil, err
+		}
+		xj, err := py.GetItem(x, py.Int(j))
+		if err != nil {
+			return nil, err
+		}
+		_, err = py.SetItem(x, py.Int(i), xj)
+		if err != nil {
+			return nil, err
+		}
+		_, err = py.SetItem(x, py.Int(j), xi)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return py.None, nil
+}
+
+func random_sample(r *Random, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	counts := keywordOnly(&kwargs, "counts", py.None)
+	var population, kObj py.Object
+	err := py.ParseTupleAndKeywords(args, kwargs, "OO:sample", []string{"population", "k"}, &population, &kObj)
+	if err != nil {
+		return nil, err
+	}
+	err = checkSequence(population)
+	if err != nil {
+		return nil, err
+	}
+	k, err := py.IndexInt(kObj)
+	if err != nil {
+		return nil, err
+	}
+	n, err := length(population)
+	if err != nil {
+		return nil, err
+	}
+	if counts != py.None {
+		cumCounts, err := accumulate(counts)
+		if err != nil {
+			return nil, err
+		}
+		if len(cumCounts) != n {
+			return nil, py.ExceptionNewf(py.ValueError, "The number of counts does not match the population")
+		}
+		if n == 0 {
+			return nil, py.ExceptionNewf(py.IndexError, "pop from empty list")
+		}
+		totalObj := cumCounts[n-1]
+		cumCounts = cumCounts[:n-1]
+		if _, ok := totalObj.(py.Int); !ok {
+			return nil, py.ExceptionNewf(py.TypeError, "Counts must be integers")
+		}
+		total := int(totalObj.(py.Int))
+		if total <= 0 {
+			return nil, py.ExceptionNewf(py.ValueError, "Total of counts must be greater than zero")
+		}
+		selections, err := r.sampleIndices(total, k)
+		if err != nil {
+			return nil, err
+		}
+		res := py.NewListWithCapacity(len(selections))
+		for _, s := range selections {
+			i, err := bisectRight(cumCounts, py.Int(s), 0, len(cumCounts))
+			if err != nil {
+				return nil, err
+			}
+			item, err := py.GetItem(population, py.Int(i))
+			if err != nil {
+				return nil, err
+			}
+			res.Append(item)
+		}
+		return res, nil
+	}
+	indices, err := r.sampleIndices(n, k)
+	if err != nil {
+		return nil, err
+	}
+	res := py.NewListWithCapacity(len(indices))
+	for _, i := range indices {
+		item, err := py.GetItem(population, py.Int(i))
+		if err != nil {
+			return nil, err
+		}
+		res.Append(item)
+	}
+	return res, nil
+}
+
+func random_choices(r *Random, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	cumWeightsObj := keywordOnly(&kwargs, "cum_weights", py.None)
+	kObj := keywordOnly(&kwargs, "k", py.Int(1))
+	var population py.Object
+	var weights py.Object = py.None
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|O:choices", []string{"population", "weights"}, &population, &weights)
+	if err != nil {
+		return nil, err
+	}
+	k, err := py.IndexInt(kObj)
+	if err != nil {
+		return nil, err
+	}
+	if k < 0 {
+		k = 0
+	}
+	n, err := length(population)
+	if err != nil {
+		return nil, err
+	}
+	res := py.NewListWithCapacity(k)
+	var cumWeights []py.Object
+	switch {
+	case cumWeightsObj == py.None && weights == py.None:
+		for i := 0; i < k; i++ {
+			x, err := r.random()
+			if err != nil {
+				return nil, err
+			}
+			item, err := py.GetItem(population, py.Int(math.Floor(x*float64(n))))
+			if err != nil {
+				return nil, err
+			}
+			res.Append(item)
+		}
+		return res, nil
+	case cumWeightsObj == py.None:
+		cumWeights, err = accumulate(weights)
+		if err != nil {
+			if k, ok := weights.(py.Int); ok && py.IsException(py.TypeError, err) {
+				return nil, py.ExceptionNewf(py.TypeError, "The number of choices must be a keyword argument: k=%d", k)
+			}
+			return nil, err
+		}
+	case weights != py.None:
+		return nil, py.ExceptionNewf(py.TypeError, "Cannot specify both weights and cumulative weights")
+	default:
+		l, err := py.SequenceList(cumWeightsObj)
+		if err != nil {
+			return nil, err
+		}
+		cumWeights = l.Items
+	}
+	if len(cumWeights) != n {
+		return nil, py.ExceptionNewf(py.ValueError, "The number of weights does not match the population")
+	}
+	if n == 0 {
+		return nil, py.ExceptionNewf(py.IndexError, "list index out of range")
+	}
+	total, err := py.FloatAsFloat64(cumWeights[n-1])
+	if err != nil {
+		return nil, err
+	}
+	if total <= 0 {
+		return nil, py.ExceptionNewf(py.ValueError, "Total of weights must be greater than zero")
+	}
+	if math.IsInf(total, 0) || math.IsNaN(total) {
+		return nil, py.ExceptionNewf(py.ValueError, "Total of weights must be finite")
+	}
+	for i := 0; i < k; i++ {
+		x, err := r.random()
+		if err != nil {
+			return nil, err
+		}
+		j, err := bisectRight(cumWeights, py.Float(x*total), 0, n-1)
+		if err != nil {
+			return nil, err
+		}
+		item, err := py.GetItem(population, py.Int(j))
+		if err != nil {
+			return nil, err
+		}
+		res.Append(item)
+	}
+	return res, nil
+}
+
+func random_uniform(r *Random, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var aObj, bObj py.Object
+	err := py.ParseTupleAndKeywords(args, kwargs, "OO:uniform", []string{"a", "b"}, &aObj, &bObj)
+	if err != nil {
+		return nil, err
+	}
+	a, err := py.FloatAsFloat64(aObj)
+	if err != nil {
+		return nil, err
+	}
+	b, err := py.FloatAsFloat64(bObj)
+	if err != nil {
+		return nil, err
+	}
+	x, err := r.random()
+	if err != nil {
+		return nil, err
+	}
+	return py.Float(a + (b-a)*x), nil
+}
+
+func random_gauss(r *Random, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var muObj py.Object = py.Float(0)
+	var sigmaObj py.Object = py.Float(1)
+	err := py.ParseTupleAndKeywords(args, kwargs, "|OO:gauss", []string{"mu", "sigma"}, &muObj, &sigmaObj)
+	if err != nil {
+		return nil, err
+	}
+	mu, err := py.FloatAsFloat64(muObj)
+	if err != nil {
+		return nil, err
+	}
+	sigma, err := py.FloatAsFloat64(sigmaObj)
+	if err != nil {
+		return nil, err
+	}
+	// When x and y are two variables from [0, 1), uniformly
+	// distributed, then
+	//
+	//    cos(2*pi*x)*sqrt(-2*log(1-y))
+	//    sin(2*pi*x)*sqrt(-2*log(1-y))
+	//
+	// are two *independent* variables with normal distribution
+	// (mu = 0, sigma = 1).
+	//
+	// Go's math.Log, math.Sin and math.Cos are accurate to within
+	// 1 ulp but aren't always rounded the same way as the C
+	// library's, so z can differ from CPython's in the last place.
+	var z float64
+	if next := r.gaussNext; next != py.None {
+		r.gaussNext = py.None
+		z, err = py.FloatAsFloat64(next)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		x, err := r.random()
+		if err != nil {
+			return nil, err
+		}
+		y, err := r.random()
+		if err != nil {
+			return nil, err
+		}
+		x2pi := x * (2 * math.Pi)
+		g2rad := math.Sqrt(-2.0 * math.Log(1.0-y))
+		z = math.Cos(x2pi) * g2rad
+		r.gaussNext = py.Float(math.Sin(x2pi) * g2rad)
+	}
+	return py.Float(mu + z*sigma), nil
+}
+
+func init() {
+	self := func(o py.Object) *Random {
+		return o.(*Random)
+	}
+	RandomType.Dict["VERSION"] = py.Int(version)
+	RandomType.Dict["__init__"] = &collections.Function{
+		Name: "__init__",
+		Doc:  "Initialize an instance.\n\nOptional argument x controls seeding, as for Random.seed().",
+		Fn: func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+			var x py.Object = py.None
+			err := py.UnpackTuple(args, kwargs, "Random", 0, 1, &x)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := o.(*Random); !ok {
+				return nil, py.ExceptionNewf(py.TypeError, "descriptor '__init__' requires a 'Random' object but received a '%s'", o.Type().Name)
+			}
+			return self(o).call("seed", py.Tuple{x})
+		},
+	}
+	for _, m := range methods {
+		m := m
+		RandomType.Dict[m.name] = py.MustNewMethod(m.name, func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+			return m.fn(self(o), args, kwargs)
+		}, 0, m.doc)
+	}
+	globals := py.StringDict{
+		"Random":       RandomType,
+		"SystemRandom": SystemRandomType,
+	}
+	module := py.NewModule("random", module_doc, nil, globals)
+	module.ContextInit = contextInit
+}
+
+// Sets up the random module m of a context with its own instance of
+// Random, which the module functions are the bound methods of as in
+// CPython, so seeding it doesn't change the numbers other contexts see
+func contextInit(m *py.Module) {
+	inst := newRandom(RandomType)
+	err := inst.seed(py.None, 2)
+	if err != nil {
+		panic(err)
+	}
+	m.Globals["_inst"] = inst
+	for _, method := range methods {
+		m.Globals[method.name], err = py.GetAttrString(inst, method.name)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Check interface is satisfied
+var _ py.IGetDict = (*Random)(nil)
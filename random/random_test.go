@@ -0,0 +1,79 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package random_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/go-python/gpython/compile"
+	"github.com/go-python/gpython/py"
+	"github.com/go-python/gpython/pytest"
+	_ "github.com/go-python/gpython/random"
+	"github.com/go-python/gpython/vm"
+)
+
+func TestVm(t *testing.T) {
+	pytest.RunTests(t, "tests")
+}
+
+const contextScript = `
+import random
+random.seed(seed)
+got = [random.random() for i in range(1000)]
+got.append(random.getrandbits(100))
+`
+
+// Runs contextScript in a new context seeding the random module with
+// seed and returns the numbers it got
+func runSeeded(seed int) (py.Object, error) {
+	obj, err := compile.Compile(contextScript, "<string>", "exec", 0, true)
+	if err != nil {
+		return nil, err
+	}
+	ctx := py.NewContext(py.DefaultContextOpts())
+	module := ctx.NewModule("__main__", "", nil, nil)
+	module.Globals["seed"] = py.Int(seed)
+	_, err = vm.Run(module.Globals, module.Globals, obj.(*py.Code), nil)
+	if err != nil {
+		return nil, err
+	}
+	return module.Globals["got"], nil
+}
+
+func TestContexts(t *testing.T) {
+	const n = 4
+	var wants [n]py.Object
+	for i := range wants {
+		want, err := runSeeded(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wants[i] = want
+	}
+	var gots [n]py.Object
+	var errs [n]error
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			gots[i], errs[i] = runSeeded(i)
+		}(i)
+	}
+	wg.Wait()
+	for i := 0; i < n; i++ {
+		if errs[i] != nil {
+			t.Fatalf("seed %d: %v", i, errs[i])
+		}
+		eq, err := py.Eq(gots[i], wants[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if eq != py.True {
+			t.Errorf("seed %d: numbers differ from those of a context run on its own", i)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+# The expected values were made with CPython
+
+import random
+from random import Random, SystemRandom
+from libtest import assertRaises, assertRaisesText, assertAlmostEqual
+
+doc = "seed"
+random.seed(42)
+assert [random.random() for i in range(3)] == [0.6394267984578837, 0.025010755222666936, 0.27502931836911926]
+random.seed(0)
+assert random.random() == 0.8444218515250481
+random.seed(-12345678901234567890123)
+assert random.random() == 0.15803717805068784
+random.seed(3.5)
+assert random.random() == 0.3039190124834461
+random.seed("hello")
+assert random.random() == 0.3537754404730722
+random.seed(b"hello")
+assert random.random() == 0.3537754404730722
+random.seed("hello", version=1)
+assert random.random() == 0.8180391270568783
+random.seed(True)
+a = random.random()
+random.seed(1)
+assert a == random.random()
+random.seed()
+random.seed(None)
+assertRaisesText(TypeError, "The only supported seed types are", random.seed, [1])
+
+doc = "integers"
+random.seed(42)
+assert random.randint(1, 100) == 82
+assert random.randrange(0, 100, 7) == 7
+assert random.randrange(10) == 0
+assert random.randrange(1000000000000) == 303832645883
+assertRaisesText(ValueError, "empty range for randrange()", random.randrange, 0)
+assertRaisesText(ValueError, "empty range for randrange() (5, 5, 0)", random.randrange, 5, 5)
+assertRaisesText(ValueError, "empty range for randrange()", random.randrange, 0, 10, -1)
+assertRaisesText(ValueError, "zero step for randrange()", random.randrange, 0, 10, 0)
+assertRaisesText(TypeError, "Missing a non-None stop argument", random.randrange, 10, step=2)
+assertRaisesText(ValueError, "empty range for randrange()", random.randint, 2, 1)
+for i in range(100):
+    x = random.randrange(-5, 5, 3)
+    assert x in (-5, -2, 1, 4)
+    assert 3 <= random.randint(3, 4) <= 4
+random.seed(42)
+assert random.randrange(10**30) == 873491343714207852616756591005
+assert random.randint(0, 2**64) == 4517457392071889495
+assert random.randint(-2**62, 2**62) == -3007947693069884364
+assert random.randint(0, 2**63-1) == 7783083932390163561
+assert random.randrange(-10**20, 10**20, 3) == -98351014814587241767
+assert random.randrange(10**20, -10**20, -7) == -59170060452283325389
+assert random.randrange(2**63, 2**63+1) == 2**63
+assertRaisesText(ValueError, "empty range for randrange()", random.randrange, -2**64)
+assertRaisesText(ValueError, "empty range for randrange()", random.randrange, 2**64, 2**65, -1)
+assertRaisesText(ValueError, "empty range for randrange()", random.randint, 2**64, 2**63)
+for i in range(100):
+    assert 2**70 <= random.randint(2**70, 2**70+1) <= 2**70+1
+
+doc = "getrandbits"
+random.seed(42)
+assert random.getrandbits(0) == 0
+assert random.getrandbits(5) == 20
+assert random.getrandbits(64) == 461366972257415551
+assert random.getrandbits(100) == 257086819302815227845767741691
+assertRaisesText(ValueError, "number of bits must be non-negative", random.getrandbits, -1)
+
+doc = "sequences"
+random.seed(42)
+assert random.choice("abcdef") == "f"
+assertRaisesText(IndexError, "Cannot choose from an empty sequence", random.choice, [])
+l = list(range(10))
+random.shuffle(l)
+assert l == [7, 3, 2, 8, 5, 6, 9, 4, 0, 1]
+assert random.sample(range(100), 10) == [75, 54, 4, 3, 11, 27, 29, 64, 77, 71]
+assert random.sample(list(range(30)), 5) == [6, 22, 20, 17, 13]
+assert random.sample(["red", "blue"], counts=[4, 2], k=5) == ["red", "red", "red", "red", "blue"]
+assert random.sample([1, 2], 0) == []
+assertRaisesText(ValueError, "Sample larger than population or is negative", random.sample, [1, 2], 3)
+assertRaisesText(ValueError, "Sample larger than population or is negative", random.sample, [1, 2], -1)
+assertRaisesText(TypeError, "Population must be a sequence", random.sample, {"a": 1}, 1)
+assertRaisesText(TypeError, "Population must be a sequence", random.sample, {1, 2}, 1)
+assertRaisesText(ValueError, "The number of counts does not match the population", random.sample, "ab", 1, counts=[1])
+assertRaisesText(ValueError, "Total of counts must be greater than zero", random.sample, "ab", 1, counts=[0, 0])
+
+doc = "choices"
+random.seed(42)
+assert random.choices("abc", k=5) == ["b", "a", "a", "a", "c"]
+assert random.choices("abc", [1, 2, 3], k=5) == ["c", "c", "a", "b", "a"]
+assert random.choices("abc", cum_weights=[1, 2, 3], k=3) == ["a", "b", "a"]
+assert random.choices("abc", [0, 0, 1], k=3) == ["c", "c", "c"]
+assert len(random.choices("abc")) == 1
+assert random.choices("abc", k=0) == []
+assertRaisesText(TypeError, "Cannot specify both weights and cumulative weights", random.choices, "ab", [1, 1], cum_weights=[1, 2])
+assertRaisesText(ValueError, "The number of weights does not match the population", random.choices, "ab", [1])
+assertRaisesText(ValueError, "Total of weights must be greater than zero", random.choices, "ab", [0, 0])
+assertRaisesText(ValueError, "Total of weights must be finite", random.choices, "ab", [1, float("inf")])
+assertRaisesText(TypeError, "The number of choices must be a keyword argument: k=2", random.choices, "ab", 2)
+
+doc = "distributions"
+random.seed(42)
+x = random.uniform(1, 5)
+assert 1 <= x <= 5
+random.seed(42)
+assert random.uniform(1, 5) == x
+random.seed(42)
+assertAlmostEqual(random.gauss(), -0.14409032957792836, 12)
+assertAlmostEqual(random.gauss(), -0.1729036003315193, 12)
+assertAlmostEqual(random.gauss(10, 2), 9.777368276864674, 12)
+
+# gauss can differ from CPython in the last place so pin our own
+# outputs (CPython gives -0.1729036003315193 and -0.11131586156766246)
+random.seed(42)
+assert random.gauss() == -0.14409032957792836
+assert random.gauss() == -0.17290360033151933
+assert random.gauss() == -0.11131586156766247
+assert random.gauss() == 0.7019837250988631
+
+doc = "state"
+random.seed(42)
+s = random.getstate()
+assert s[0] == 3 and len(s[1]) == 625 and s[2] is None
+a = [random.random() for i in range(700)]
+random.setstate(s)
+assert a == [random.random() for i in range(700)]
+random.gauss()
+s = random.getstate()
+a = random.gauss()
+random.setstate(s)
+assert a == random.gauss()
+assertRaisesText(ValueError, "state with version 1 passed to Random.setstate() of version 3", random.setstate, (1, s[1], None))
+assertRaisesText(TypeError, "state vector must be a tuple", random.setstate, (3, list(s[1]), None))
+assertRaisesText(ValueError, "state vector is the wrong size", random.setstate, (3, s[1][1:], None))
+assertRaisesText(ValueError, "invalid state", random.setstate, (3, s[1][:-1] + (625,), None))
+
+doc = "Random"
+r = Random(7)
+assert r.random() == 0.32383276483316237
+assert r.randrange(1000000000000) == 434439589175
+r2 = Random(7)
+assert r2.random() == 0.32383276483316237
+assert Random.VERSION == 3
+r.seed(42)
+assert r.random() == 0.6394267984578837
+
+doc = "subclass"
+class Half(Random):
+    def random(self):
+        return 0.5
+assert Half(1).randrange(10) == 6
+assert Half().choice([1, 2, 3]) == 2
+assert Half().randrange(10**30) == 500000000000000009942312419328
+class Ones(Random):
+    def getrandbits(self, k):
+        return 1
+assert Ones().randrange(10) == 1
+assert Ones().randrange(10**30) == 1
+assert Ones().randint(-2**64, 2**64) == -2**64 + 1
+class Seeded(Random):
+    def __init__(self, x):
+        Random.__init__(self, x * 2)
+        self.x = x
+s = Seeded(21)
+assert s.x == 21 and s.random() == 0.6394267984578837
+
+doc = "SystemRandom"
+s = SystemRandom()
+for i in range(100):
+    assert 0 <= s.random() < 1
+    assert 0 <= s.getrandbits(10) < 1024
+    assert 0 <= s.randrange(3) < 3
+assert s.getrandbits(0) == 0
+assert s.getrandbits(200) < 2**200
+assert s.seed(1) is None
+assert len(s.sample(range(10), 10)) == 10
+assertRaisesText(NotImplementedError, "System entropy source does not have state.", s.getstate)
+assertRaisesText(NotImplementedError, "System entropy source does not have state.", s.setstate, None)
+
+doc = "finished"
@@ -0,0 +1,150 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Character classes and case folding
+
+package re
+
+import (
+	"unicode"
+)
+
+// A category is one of the \d \s \w classes or their negations
+type category int
+
+const (
+	categoryDigit category = iota
+	categoryNotDigit
+	categorySpace
+	categoryNotSpace
+	categoryWord
+	categoryNotWord
+)
+
+// Returns true if r is a digit as \d matches it
+func isDigit(r rune, ascii bool) bool {
+	if ascii || r < 0x80 {
+		return '0' <= r && r <= '9'
+	}
+	return unicode.IsDigit(r)
+}
+
+// Returns true if r is white space as \s matches it
+func isSpace(r rune, ascii bool) bool {
+	switch r {
+	case ' ', '\t', '\n', '\r', '\f', '\v':
+		return true
+	}
+	if ascii || r < 0x1c {
+		return false
+	}
+	// python also counts the information separators as space
+	return r <= 0x1f || unicode.IsSpace(r)
+}
+
+// Returns true if r is a word character as \w matches it
+func isWord(r rune, ascii bool) bool {
+	if r < 0x80 {
+		return r == '_' || ('0' <= r && r <= '9') || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z')
+	}
+	if ascii {
+		return false
+	}
+	return unicode.IsLetter(r) || unicode.IsNumber(r)
+}
+
+// Returns true if r is in category c
+func (c category) matches(r rune, ascii bool) bool {
+	switch c {
+	case categoryDigit:
+		return isDigit(r, ascii)
+	case categoryNotDigit:
+		return !isDigit(r, ascii)
+	case categorySpace:
+		return isSpace(r, ascii)
+	case categoryNotSpace:
+		return !isSpace(r, ascii)
+	case categoryWord:
+		return isWord(r, ascii)
+	case categoryNotWord:
+		return !isWord(r, ascii)
+	}
+	return false
+}
+
+// Returns true if a and b are the same letter ignoring case
+//
+// With ascii only the ascii letters have cases.
+func foldEqual(a, b rune, ascii bool) bool {
+	if a == b {
+		return true
+	}
+	if ascii || a < 0x80 && b < 0x80 {
+		return a < 0x80 && b < 0x80 && toLowerASCII(a) == toLowerASCII(b)
+	}
+	for r := unicode.SimpleFold(a); r != a; r = unicode.SimpleFold(r) {
+		if r == b {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns r in lower case if it is an ascii letter
+func toLowerASCII(r rune) rune {
+	if 'A' <= r && r <= 'Z' {
+		return r + 'a' - 'A'
+	}
+	return r
+}
+
+// A charRange is an inclusive range of characters
+type charRange struct {
+	lo, hi rune
+}
+
+// A charSet is a [...] set of characters
+type charSet struct {
+	negate     bool
+	ranges     []charRange
+	categories []category
+}
+
+// Returns true if r is in the set ignoring negation
+func (s *charSet) contains(r rune, ascii bool) bool {
+	for _, rng := range s.ranges {
+		if rng.lo <= r && r <= rng.hi {
+			return true
+		}
+	}
+	for _, c := range s.categories {
+		if c.matches(r, ascii) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns true if the set matches r
+//
+// If ignoreCase is set r matches if any of its other cases is in the
+// set.
+func (s *charSet) matches(r rune, ignoreCase, ascii bool) bool {
+	found := s.contains(r, ascii)
+	if !found && ignoreCase {
+		if ascii {
+			switch {
+			case 'a' <= r && r <= 'z':
+				found = s.contains(r-'a'+'A', ascii)
+			case 'A' <= r && r <= 'Z':
+				found = s.contains(r-'A'+'a', ascii)
+			}
+		} else {
+			for f := unicode.SimpleFold(r); f != r && !found; f = unicode.SimpleFold(f) {
+				found = s.contains(f, ascii)
+			}
+		}
+	}
+	return found != s.negate
+}
@@ -0,0 +1,282 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Backtracking matcher
+
+package re
+
+// matchMode says how a match must be positioned in the string
+type matchMode int
+
+const (
+	modeMatch  matchMode = iota // starting at pos
+	modeFull                    // starting at pos and ending at endpos
+	modeSearch                  // starting anywhere from pos
+)
+
+// matcher holds the state of matching a pattern against input
+//
+// Nodes are matched with a continuation, k, which is called with the
+// position after the node and returns whether the rest of the pattern
+// matched from there.  Returning false backtracks into the node to try
+// its next way of matching.
+type matcher struct {
+	input     []rune
+	end       int   // the end of the string as far as the pattern sees
+	caps      []int // start and end of each group, -1 if unset
+	lastIndex int   // the last group which was closed, -1 for none
+}
+
+// A copy of the groups so they can be put back after a look around
+type savedGroups struct {
+	caps      []int
+	lastIndex int
+}
+
+func (m *matcher) save() savedGroups {
+	return savedGroups{append([]int(nil), m.caps...), m.lastIndex}
+}
+
+func (m *matcher) restore(s savedGroups) {
+	copy(m.caps, s.caps)
+	m.lastIndex = s.lastIndex
+}
+
+// Returns true if the character at i is a word character
+func (m *matcher) isWordAt(i int, ascii bool) bool {
+	return i >= 0 && i < m.end && isWord(m.input[i], ascii)
+}
+
+// Matches n at i then calls k
+func (m *matcher) match(n *node, i int, k func(int) bool) bool {
+	switch n.op {
+	case opEmpty:
+		return k(i)
+	case opLiteral, opAny, opSet:
+		return i < m.end && n.matchesChar(m.input[i]) && k(i+1)
+	case opBeginLine:
+		return (i == 0 || (n.flags&flagMultiline != 0 && m.input[i-1] == '\n')) && k(i)
+	case opEndLine:
+		if n.flags&flagMultiline != 0 {
+			return (i == m.end || m.input[i] == '\n') && k(i)
+		}
+		return (i == m.end || (i == m.end-1 && m.input[i] == '\n')) && k(i)
+	case opBeginText:
+		return i == 0 && k(i)
+	case opEndText:
+		return i == m.end && k(i)
+	case opWordBoundary, opNotWordBoundary:
+		ascii := n.flags&flagASCII != 0
+		boundary := m.isWordAt(i-1, ascii) != m.isWordAt(i, ascii)
+		return boundary == (n.op == opWordBoundary) && k(i)
+	case opConcat:
+		return m.sequence(n.subs, i, k)
+	case opAlternate:
+		for _, sub := range n.subs {
+			if m.match(sub, i, k) {
+				return true
+			}
+		}
+		return false
+	case opGroup:
+		g := 2 * n.group
+		return m.match(n.subs[0], i, func(j int) bool {
+			start, end, lastIndex := m.caps[g], m.caps[g+1], m.lastIndex
+			m.caps[g], m.caps[g+1], m.lastIndex = i, j, n.group
+			if k(j) {
+				return true
+			}
+			m.caps[g], m.caps[g+1], m.lastIndex = start, end, lastIndex
+			return false
+		})
+	case opRepeat:
+		if n.subs[0].isSingle() {
+			return m.repeatSingle(n, i, k)
+		}
+		return m.repeat(n, 0, i, k)
+	case opBackref:
+		return m.backref(n, i, k)
+	case opLookahead, opLookbehind:
+		saved := m.save()
+		var found bool
+		if n.op == opLookahead {
+			found = m.match(n.subs[0], i, func(int) bool { return true })
+		} else if start := i - n.width; start >= 0 {
+			found = m.match(n.subs[0], start, func(j int) bool { return j == i })
+		}
+		if found == n.negate {
+			m.restore(saved)
+			return false
+		}
+		if k(i) {
+			return true
+		}
+		m.restore(saved)
+		return false
+	case opConditional:
+		if m.caps[2*n.group+1] >= 0 {
+			return m.match(n.subs[0], i, k)
+		}
+		return m.match(n.subs[1], i, k)
+	}
+	panic("re: unknown opcode")
+}
+
+// Matches each of nodes in turn from i then calls k
+func (m *matcher) sequence(nodes []*node, i int, k func(int) bool) bool {
+	// single characters can't backtrack so match them directly
+	for len(nodes) > 0 && nodes[0].isSingle() {
+		if i >= m.end || !nodes[0].matchesChar(m.input[i]) {
+			return false
+		}
+		i++
+		nodes = nodes[1:]
+	}
+	switch len(nodes) {
+	case 0:
+		return k(i)
+	case 1:
+		return m.match(nodes[0], i, k)
+	}
+	return m.match(nodes[0], i, func(j int) bool {
+		return m.sequence(nodes[1:], j, k)
+	})
+}
+
+// Matches the repeat n of a single character without recursing
+func (m *matcher) repeatSingle(n *node, i int, k func(int) bool) bool {
+	sub := n.subs[0]
+	limit := m.end - i
+	if n.max >= 0 && n.max < limit {
+		limit = n.max
+	}
+	count := 0
+	if n.greedy {
+		for count < limit && sub.matchesChar(m.input[i+count]) {
+			count++
+		}
+		for ; count >= n.min; count-- {
+			if k(i + count) {
+				return true
+			}
+		}
+		return false
+	}
+	for ; count < n.min; count++ {
+		if count >= limit || !sub.matchesChar(m.input[i+count]) {
+			return false
+		}
+	}
+	for {
+		if k(i + count) {
+			return true
+		}
+		if count >= limit || !sub.matchesChar(m.input[i+count]) {
+			return false
+		}
+		count++
+	}
+}
+
+// Matches the repeat n having matched count times so far
+//
+// Like python an empty match stops the repeat once the minimum has
+// been reached so it can't loop forever.
+func (m *matcher) repeat(n *node, count, i int, k func(int) bool) bool {
+	more := func() bool {
+		if n.max >= 0 && count >= n.max {
+			return false
+		}
+		return m.match(n.subs[0], i, func(j int) bool {
+			if j == i && count >= n.min {
+				return k(j)
+			}
+			return m.repeat(n, count+1, j, k)
+		})
+	}
+	switch {
+	case count < n.min:
+		return more()
+	case n.greedy:
+		return more() || k(i)
+	}
+	return k(i) || more()
+}
+
+// Matches the text of a group again
+func (m *matcher) backref(n *node, i int, k func(int) bool) bool {
+	start, end := m.caps[2*n.group], m.caps[2*n.group+1]
+	if end < 0 || i+end-start > m.end {
+		return false
+	}
+	ignoreCase := n.flags&flagIgnoreCase != 0
+	ascii := n.flags&flagASCII != 0
+	for j := start; j < end; j++ {
+		a, b := m.input[j], m.input[i+j-start]
+		if a != b && !(ignoreCase && foldEqual(a, b, ascii)) {
+			return false
+		}
+	}
+	return k(i + end - start)
+}
+
+// Runs the pattern over input from pos to endpos
+//
+// It returns the start and end of each group, or nil if there was no
+// match, and the last group closed.  If mustAdvance is set an empty
+// match at pos isn't allowed, which is how the searches after an
+// empty match move on.
+func (p *Pattern) exec(input []rune, pos, endpos int, mode matchMode, mustAdvance bool) ([]int, int) {
+	if pos > endpos {
+		return nil, -1
+	}
+	m := &matcher{
+		input: input,
+		end:   endpos,
+		caps:  make([]int, 2*(p.groups+1)),
+	}
+	try := func(start int) bool {
+		for i := range m.caps {
+			m.caps[i] = -1
+		}
+		m.lastIndex = -1
+		return m.match(p.root, start, func(j int) bool {
+			if mode == modeFull && j != endpos {
+				return false
+			}
+			if mustAdvance && start == pos && j == start {
+				return false
+			}
+			m.caps[0], m.caps[1] = start, j
+			return true
+		})
+	}
+	if mode != modeSearch {
+		if try(pos) {
+			return m.caps, m.lastIndex
+		}
+		return nil, -1
+	}
+	for start := pos; start <= endpos; start++ {
+		if p.anchored && start > 0 {
+			break
+		}
+		if p.prefix != nil && (start >= endpos || !p.prefix.matchesChar(input[start])) {
+			continue
+		}
+		if try(start) {
+			return m.caps, m.lastIndex
+		}
+	}
+	return nil, -1
+}
+
+// Returns the first node matched by n, the node itself if it isn't
+// a sequence
+func firstNode(n *node) *node {
+	for n.op == opConcat || n.op == opGroup {
+		n = n.subs[0]
+	}
+	return n
+}
@@ -0,0 +1,248 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Match objects
+
+package re
+
+import (
+	"fmt"
+
+	"github.com/go-python/gpython/py"
+)
+
+var MatchType = py.NewType("Match", "The result of re.match() and re.search().")
+
+// Match is a successful match of a pattern
+type Match struct {
+	re        *Pattern
+	s         py.Object // the string which was searched
+	input     []rune
+	pos       int
+	endpos    int
+	caps      []int // start and end of each group, -1 if unset
+	lastIndex int
+}
+
+// Type of this object
+func (m *Match) Type() *py.Type {
+	return MatchType
+}
+
+// Makes a match of p in s from the result of exec
+func (p *Pattern) newMatch(s py.Object, input []rune, pos, endpos int, caps []int, lastIndex int) *Match {
+	return &Match{
+		re:        p,
+		s:         s,
+		input:     input,
+		pos:       pos,
+		endpos:    endpos,
+		caps:      caps,
+		lastIndex: lastIndex,
+	}
+}
+
+// Returns the number of the group given by a python int or name
+func (m *Match) groupNumber(g py.Object) (int, error) {
+	if name, ok := g.(py.String); ok {
+		if n, found := m.re.groupIndex[string(name)]; found {
+			return n, nil
+		}
+	} else if n, err := py.IndexInt(g); err == nil && n >= 0 && n <= m.re.groups {
+		return n, nil
+	}
+	return 0, py.ExceptionNewf(py.IndexError, "no such group")
+}
+
+// Returns the text of the group given by g or def if it didn't match
+func (m *Match) group(g py.Object, def py.Object) (py.Object, error) {
+	n, err := m.groupNumber(g)
+	if err != nil {
+		return nil, err
+	}
+	if m.caps[2*n+1] < 0 {
+		return def, nil
+	}
+	return m.re.group(m.input, m.caps, n), nil
+}
+
+// Returns the start and end of the group given by g or -1, -1
+func (m *Match) span(args py.Tuple, name string) (int, int, error) {
+	var g py.Object = py.Int(0)
+	err := py.UnpackTuple(args, nil, name, 0, 1, &g)
+	if err != nil {
+		return 0, 0, err
+	}
+	n, err := m.groupNumber(g)
+	if err != nil {
+		return 0, 0, err
+	}
+	return m.caps[2*n], m.caps[2*n+1], nil
+}
+
+// Reads the default argument of groups and groupdict
+func defaultArg(name string, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var def py.Object = py.None
+	err := py.ParseTupleAndKeywords(args, kwargs, "|O:"+name, []string{"default"}, &def)
+	return def, err
+}
+
+func (m *Match) M__getitem__(key py.Object) (py.Object, error) {
+	return m.group(key, py.None)
+}
+
+func (m *Match) M__repr__() (py.Object, error) {
+	match, err := py.ReprAsString(m.re.group(m.input, m.caps, 0))
+	if err != nil {
+		return nil, err
+	}
+	return py.String(fmt.Sprintf("<re.Match object; span=(%d, %d), match=%s>", m.caps[0], m.caps[1], match)), nil
+}
+
+// Check interface is satisfied
+var _ py.I__getitem__ = (*Match)(nil)
+var _ py.I__repr__ = (*Match)(nil)
+
+func init() {
+	self := func(o py.Object) *Match {
+		return o.(*Match)
+	}
+	MatchType.Dict["string"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return self(o).s, nil
+		},
+		Doc: "The string passed to match() or search().",
+	}
+	MatchType.Dict["re"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return self(o).re, nil
+		},
+		Doc: "The regular expression object.",
+	}
+	MatchType.Dict["pos"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return py.Int(self(o).pos), nil
+		},
+		Doc: "The index into the string at which the RE engine started looking for a match.",
+	}
+	MatchType.Dict["endpos"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return py.Int(self(o).endpos), nil
+		},
+		Doc: "The index into the string beyond which the RE engine will not go.",
+	}
+	MatchType.Dict["lastindex"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			if self(o).lastIndex < 0 {
+				return py.None, nil
+			}
+			return py.Int(self(o).lastIndex), nil
+		},
+		Doc: "The integer index of the last matched capturing group.",
+	}
+	MatchType.Dict["lastgroup"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			m := self(o)
+			for name, g := range m.re.groupIndex {
+				if g == m.lastIndex {
+					return py.String(name), nil
+				}
+			}
+			return py.None, nil
+		},
+		Doc: "The name of the last matched capturing group.",
+	}
+	MatchType.Dict["regs"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			m := self(o)
+			regs := make(py.Tuple, m.re.groups+1)
+			for g := range regs {
+				regs[g] = py.Tuple{py.Int(m.caps[2*g]), py.Int(m.caps[2*g+1])}
+			}
+			return regs, nil
+		},
+	}
+	MatchType.Dict["group"] = py.MustNewMethod("group", func(o py.Object, args py.Tuple) (py.Object, error) {
+		m := self(o)
+		switch len(args) {
+		case 0:
+			return m.group(py.Int(0), py.None)
+		case 1:
+			return m.group(args[0], py.None)
+		}
+		res := make(py.Tuple, len(args))
+		for i, g := range args {
+			var err error
+			res[i], err = m.group(g, py.None)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return res, nil
+	}, 0, `group([group1, ...]) -> str or tuple.
+    Return subgroup(s) of the match by indices or names.
+    For 0 returns the entire match.`)
+	MatchType.Dict["groups"] = py.MustNewMethod("groups", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		def, err := defaultArg("groups", args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+		m := self(o)
+		res := make(py.Tuple, m.re.groups)
+		for i := range res {
+			res[i], _ = m.group(py.Int(i+1), def)
+		}
+		return res, nil
+	}, 0, `groups([default=None]) -> tuple.
+    Return a tuple containing all the subgroups of the match, from 1.
+    The default argument is used for groups
+    that did not participate in the match`)
+	MatchType.Dict["groupdict"] = py.MustNewMethod("groupdict", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		def, err := defaultArg("groupdict", args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+		m := self(o)
+		res := py.NewStringDict()
+		for name, g := range m.re.groupIndex {
+			res[name], _ = m.group(py.Int(g), def)
+		}
+		return res, nil
+	}, 0, `groupdict([default=None]) -> dict.
+    Return a dictionary containing all the named subgroups of the match,
+    keyed by the subgroup name. The default argument is used for groups
+    that did not participate in the match`)
+	MatchType.Dict["start"] = py.MustNewMethod("start", func(o py.Object, args py.Tuple) (py.Object, error) {
+		start, _, err := self(o).span(args, "start")
+		if err != nil {
+			return nil, err
+		}
+		return py.Int(start), nil
+	}, 0, "start([group=0]) -> int.\n    Return index of the start of the substring matched by group.")
+	MatchType.Dict["end"] = py.MustNewMethod("end", func(o py.Object, args py.Tuple) (py.Object, error) {
+		_, end, err := self(o).span(args, "end")
+		if err != nil {
+			return nil, err
+		}
+		return py.Int(end), nil
+	}, 0, "end([group=0]) -> int.\n    Return index of the end of the substring matched by group.")
+	MatchType.Dict["span"] = py.MustNewMethod("span", func(o py.Object, args py.Tuple) (py.Object, error) {
+		start, end, err := self(o).span(args, "span")
+		if err != nil {
+			return nil, err
+		}
+		return py.Tuple{py.Int(start), py.Int(end)}, nil
+	}, 0, "span([group]) -> tuple.\n    For MatchObject m, return the 2-tuple (m.start(group), m.end(group)).")
+	MatchType.Dict["expand"] = py.MustNewMethod("expand", func(o py.Object, template py.Object) (py.Object, error) {
+		m := self(o)
+		if _, err := m.re.subject(template); err != nil {
+			return nil, err
+		}
+		items, err := m.re.parseTemplate(template)
+		if err != nil {
+			return nil, err
+		}
+		return m.re.text(expandTemplate(nil, items, m.input, m.caps)), nil
+	}, 0, "expand(template) -> str.\n    Return the string obtained by doing backslash substitution\n    on the string template, as done by the sub() method.")
+}
@@ -0,0 +1,929 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Regular expression parser
+
+package re
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-python/gpython/py"
+)
+
+// Flags numbered as python numbers them
+const (
+	flagTemplate   = 1
+	flagIgnoreCase = 2
+	flagLocale     = 4
+	flagMultiline  = 8
+	flagDotAll     = 16
+	flagUnicode    = 32
+	flagVerbose    = 64
+	flagDebug      = 128
+	flagASCII      = 256
+)
+
+// The letters of the inline flags
+var inlineFlags = map[rune]int{
+	'a': flagASCII,
+	'i': flagIgnoreCase,
+	'L': flagLocale,
+	'm': flagMultiline,
+	's': flagDotAll,
+	'u': flagUnicode,
+	'x': flagVerbose,
+}
+
+// An opcode says what a node matches
+type opcode int
+
+const (
+	opEmpty           opcode = iota // the empty string
+	opLiteral                       // the character ch
+	opAny                           // any character, . in the pattern
+	opSet                           // a character in set
+	opBeginLine                     // ^
+	opEndLine                       // $
+	opBeginText                     // \A
+	opEndText                       // \Z
+	opWordBoundary                  // \b
+	opNotWordBoundary               // \B
+	opConcat                        // each of subs in turn
+	opAlternate                     // one of subs
+	opGroup                         // subs[0] captured as group
+	opRepeat                        // subs[0] repeated min to max times
+	opBackref                       // the text matched by group
+	opLookahead                     // (?=subs[0]) or (?!subs[0]) if negate
+	opLookbehind                    // (?<=subs[0]) or (?<!subs[0]) if negate
+	opConditional                   // subs[0] if group matched else subs[1]
+)
+
+// A node is part of a parsed pattern
+type node struct {
+	op     opcode
+	flags  int // the flags in force where the node was in the pattern
+	ch     rune
+	set    *charSet
+	subs   []*node
+	group  int
+	min    int
+	max    int // -1 for no maximum
+	greedy bool
+	negate bool
+	width  int // the width of a look behind
+}
+
+// Returns true if n matches exactly one character
+func (n *node) isSingle() bool {
+	return n.op == opLiteral || n.op == opAny || n.op == opSet
+}
+
+// Returns true if n is an anchor which can't be repeated
+func (n *node) isAnchor() bool {
+	switch n.op {
+	case opBeginLine, opEndLine, opBeginText, opEndText, opWordBoundary, opNotWordBoundary:
+		return true
+	}
+	return false
+}
+
+// Returns true if r matches the single character node n
+func (n *node) matchesChar(r rune) bool {
+	ignoreCase := n.flags&flagIgnoreCase != 0
+	ascii := n.flags&flagASCII != 0
+	switch n.op {
+	case opLiteral:
+		if ignoreCase {
+			return foldEqual(n.ch, r, ascii)
+		}
+		return n.ch == r
+	case opAny:
+		return r != '\n' || n.flags&flagDotAll != 0
+	case opSet:
+		return n.set.matches(r, ignoreCase, ascii)
+	}
+	return false
+}
+
+// Returns the least and greatest number of characters n can match
+//
+// max is -1 if there is no limit.
+func (n *node) widths() (min, max int) {
+	switch n.op {
+	case opLiteral, opAny, opSet:
+		return 1, 1
+	case opConcat:
+		for _, sub := range n.subs {
+			lo, hi := sub.widths()
+			min += lo
+			if max >= 0 {
+				if hi < 0 {
+					max = -1
+				} else {
+					max += hi
+				}
+			}
+		}
+		return min, max
+	case opAlternate, opConditional:
+		for i, sub := range n.subs {
+			lo, hi := sub.widths()
+			if i == 0 || lo < min {
+				min = lo
+			}
+			if i == 0 || (max >= 0 && (hi < 0 || hi > max)) {
+				max = hi
+			}
+		}
+		return min, max
+	case opGroup:
+		return n.subs[0].widths()
+	case opRepeat:
+		lo, hi := n.subs[0].widths()
+		min = lo * n.min
+		switch {
+		case hi == 0:
+			max = 0
+		case hi < 0 || n.max < 0:
+			max = -1
+		default:
+			max = hi * n.max
+		}
+		return min, max
+	case opBackref:
+		return 0, -1
+	}
+	return 0, 0
+}
+
+// Returns a node matching each of items in turn
+func concat(items []*node) *node {
+	switch len(items) {
+	case 0:
+		return &node{op: opEmpty}
+	case 1:
+		return items[0]
+	}
+	return &node{op: opConcat, subs: items}
+}
+
+// Returns a node matching any one of branches
+func alternate(branches []*node) *node {
+	if len(branches) == 1 {
+		return branches[0]
+	}
+	return &node{op: opAlternate, subs: branches}
+}
+
+// parser reads a pattern into a tree of nodes
+type parser struct {
+	pattern    py.Object // the pattern for errors
+	src        []rune
+	pos        int
+	isBytes    bool
+	flags      int            // the flags in force at pos
+	global     int            // the flags set by (?x) groups
+	groups     int            // the number of groups opened so far
+	names      map[string]int // group numbers by name
+	open       map[int]bool   // groups which haven't been closed yet
+	condRefs   map[int]int    // positions of conditional group numbers
+	lookbehind int            // groups opened before the innermost look behind or -1
+}
+
+// Parses the pattern src
+//
+// If inline flags which change the whole pattern are found the pattern
+// is parsed again with them so they apply from the start.
+func parse(pattern py.Object, src []rune, isBytes bool, flags int) (*parser, *node, error) {
+	for {
+		p := &parser{
+			pattern:    pattern,
+			src:        src,
+			isBytes:    isBytes,
+			flags:      flags,
+			names:      map[string]int{},
+			open:       map[int]bool{},
+			condRefs:   map[int]int{},
+			lookbehind: -1,
+		}
+		branches, err := p.parseAlternation()
+		if err != nil {
+			return nil, nil, err
+		}
+		if p.more() {
+			return nil, nil, p.error("unbalanced parenthesis", p.pos)
+		}
+		for group, pos := range p.condRefs {
+			if group > p.groups {
+				return nil, nil, p.error(fmt.Sprintf("invalid group reference %d", group), pos)
+			}
+		}
+		if p.global&^flags == 0 {
+			return p, alternate(branches), nil
+		}
+		flags |= p.global
+	}
+}
+
+// Makes a re.error for msg at pos in the pattern
+func (p *parser) error(msg string, pos int) error {
+	return newError(msg, p.pattern, p.src, pos)
+}
+
+// Returns true if there is more of the pattern to read
+func (p *parser) more() bool {
+	return p.pos < len(p.src)
+}
+
+// Returns the next character without reading it or -1 at the end
+func (p *parser) peek() rune {
+	if p.pos < len(p.src) {
+		return p.src[p.pos]
+	}
+	return -1
+}
+
+// Reads the next character
+func (p *parser) next() rune {
+	r := p.src[p.pos]
+	p.pos++
+	return r
+}
+
+// Reads the next character if it is r
+func (p *parser) accept(r rune) bool {
+	if p.pos < len(p.src) && p.src[p.pos] == r {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// Makes a node of op with the flags in force
+func (p *parser) newNode(op opcode) *node {
+	return &node{op: op, flags: p.flags}
+}
+
+// Makes a node matching r
+func (p *parser) literal(r rune) *node {
+	n := p.newNode(opLiteral)
+	n.ch = r
+	return n
+}
+
+// Skips white space and comments in verbose mode
+func (p *parser) skipVerbose() {
+	if p.flags&flagVerbose == 0 {
+		return
+	}
+	for p.more() {
+		switch c := p.src[p.pos]; {
+		case c == '#':
+			for p.more() && p.src[p.pos] != '\n' {
+				p.pos++
+			}
+		case c == ' ' || ('\t' <= c && c <= '\r'):
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+// Parses branches separated by | up to a ) or the end
+func (p *parser) parseAlternation() ([]*node, error) {
+	var branches []*node
+	for {
+		seq, err := p.parseSequence()
+		if err != nil {
+			return nil, err
+		}
+		branches = append(branches, seq)
+		if !p.accept('|') {
+			return branches, nil
+		}
+	}
+}
+
+// Parses items up to a |, ) or the end
+func (p *parser) parseSequence() (*node, error) {
+	var items []*node
+	repeated := false // whether the last item has a quantifier
+	for {
+		p.skipVerbose()
+		if !p.more() {
+			break
+		}
+		c := p.src[p.pos]
+		if c == '|' || c == ')' {
+			break
+		}
+		if c == '*' || c == '+' || c == '?' || c == '{' {
+			start := p.pos
+			min, max, ok, err := p.parseQuantifier()
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				// a { which doesn't start a quantifier is itself
+				p.pos++
+				items = append(items, p.literal('{'))
+				repeated = false
+				continue
+			}
+			if len(items) == 0 || items[len(items)-1].isAnchor() {
+				return nil, p.error("nothing to repeat", start)
+			}
+			if repeated {
+				return nil, p.error("multiple repeat", start)
+			}
+			n := p.newNode(opRepeat)
+			n.min, n.max = min, max
+			n.greedy = !p.accept('?')
+			n.subs = []*node{items[len(items)-1]}
+			items[len(items)-1] = n
+			repeated = true
+			continue
+		}
+		item, err := p.parseAtom()
+		if err != nil {
+			return nil, err
+		}
+		if item != nil {
+			items = append(items, item)
+			repeated = false
+		}
+	}
+	return concat(items), nil
+}
+
+// Parses the quantifier at pos
+//
+// ok is false if it is a { which isn't a quantifier.
+func (p *parser) parseQuantifier() (min, max int, ok bool, err error) {
+	start := p.pos
+	switch p.next() {
+	case '*':
+		return 0, -1, true, nil
+	case '+':
+		return 1, -1, true, nil
+	case '?':
+		return 0, 1, true, nil
+	}
+	digits := func() string {
+		begin := p.pos
+		for p.more() && '0' <= p.src[p.pos] && p.src[p.pos] <= '9' {
+			p.pos++
+		}
+		return string(p.src[begin:p.pos])
+	}
+	lo := digits()
+	hi := lo
+	if p.accept(',') {
+		hi = digits()
+	}
+	if !p.accept('}') {
+		p.pos = start
+		return 0, 0, false, nil
+	}
+	min, max = 0, -1
+	if lo != "" {
+		min, err = strconv.Atoi(lo)
+		if err != nil {
+			return 0, 0, false, py.ExceptionNewf(py.OverflowError, "the repetition number is too large")
+		}
+	}
+	if hi != "" {
+		max, err = strconv.Atoi(hi)
+		if err != nil {
+			return 0, 0, false, py.ExceptionNewf(py.OverflowError, "the repetition number is too large")
+		}
+		if max < min {
+			return 0, 0, false, p.error("min repeat greater than max repeat", start+1)
+		}
+	}
+	return min, max, true, nil
+}
+
+// Parses a character, escape, set or group
+//
+// It returns nil if the item matches nothing, like a comment.
+func (p *parser) parseAtom() (*node, error) {
+	start := p.pos
+	c := p.next()
+	switch c {
+	case '.':
+		return p.newNode(opAny), nil
+	case '^':
+		return p.newNode(opBeginLine), nil
+	case '$':
+		return p.newNode(opEndLine), nil
+	case '(':
+		return p.parseGroup(start)
+	case '[':
+		return p.parseSet(start)
+	case '\\':
+		return p.parseEscape(start)
+	}
+	return p.literal(c), nil
+}
+
+// Parses the rest of a group, sub pattern and closing ) which started
+// at start
+func (p *parser) parseGroupBody(start int) (*node, error) {
+	branches, err := p.parseAlternation()
+	if err != nil {
+		return nil, err
+	}
+	if !p.accept(')') {
+		return nil, p.error("missing ), unterminated subpattern", start)
+	}
+	return alternate(branches), nil
+}
+
+// Returns true if name is a valid group name
+func (p *parser) isName(name string) bool {
+	for i, r := range name {
+		if p.isBytes && r >= 0x80 {
+			return false
+		}
+		if !(r == '_' || unicode.IsLetter(r) || (i > 0 && unicode.IsDigit(r))) {
+			return false
+		}
+	}
+	return name != ""
+}
+
+// Reads a group name up to the terminator term
+func (p *parser) parseName(term rune) (string, error) {
+	start := p.pos
+	for p.more() && p.src[p.pos] != term {
+		p.pos++
+	}
+	if !p.more() {
+		if start == p.pos {
+			return "", p.error("missing group name", start)
+		}
+		return "", p.error(fmt.Sprintf("missing %c, unterminated name", term), start)
+	}
+	name := string(p.src[start:p.pos])
+	p.pos++
+	if name == "" {
+		return "", p.error("missing group name", start)
+	}
+	if !p.isName(name) {
+		return "", p.error(fmt.Sprintf("bad character in group name '%s'", name), start)
+	}
+	return name, nil
+}
+
+// Makes a back reference to group checking it can be referred to
+func (p *parser) backref(group int, pos int) (*node, error) {
+	if p.open[group] {
+		return nil, p.error("cannot refer to an open group", pos)
+	}
+	if p.lookbehind >= 0 && group > p.lookbehind {
+		return nil, p.error("cannot refer to group defined in the same lookbehind subpattern", pos)
+	}
+	n := p.newNode(opBackref)
+	n.group = group
+	return n, nil
+}
+
+// Parses a group which started with the ( at start
+func (p *parser) parseGroup(start int) (*node, error) {
+	if !p.accept('?') {
+		return p.parseCapture(start, "")
+	}
+	if !p.more() {
+		return nil, p.error("unexpected end of pattern", p.pos)
+	}
+	c := p.next()
+	switch c {
+	case ':':
+		return p.parseGroupBody(start)
+	case 'P':
+		switch {
+		case p.accept('<'):
+			name, err := p.parseName('>')
+			if err != nil {
+				return nil, err
+			}
+			return p.parseCapture(start, name)
+		case p.accept('='):
+			nameStart := p.pos
+			name, err := p.parseName(')')
+			if err != nil {
+				return nil, err
+			}
+			group, ok := p.names[name]
+			if !ok {
+				return nil, p.error(fmt.Sprintf("unknown group name '%s'", name), nameStart)
+			}
+			return p.backref(group, nameStart)
+		case !p.more():
+			return nil, p.error("unexpected end of pattern", p.pos)
+		}
+		return nil, p.error("unknown extension ?P"+string(p.peek()), start+1)
+	case '#':
+		for p.more() && p.src[p.pos] != ')' {
+			p.pos++
+		}
+		if !p.accept(')') {
+			return nil, p.error("missing ), unterminated comment", start)
+		}
+		return nil, nil
+	case '=', '!':
+		sub, err := p.parseGroupBody(start)
+		if err != nil {
+			return nil, err
+		}
+		n := p.newNode(opLookahead)
+		n.negate = c == '!'
+		n.subs = []*node{sub}
+		return n, nil
+	case '<':
+		if !p.more() {
+			return nil, p.error("unexpected end of pattern", p.pos)
+		}
+		c = p.next()
+		if c != '=' && c != '!' {
+			return nil, p.error("unknown extension ?<"+string(c), start+1)
+		}
+		oldLookbehind := p.lookbehind
+		if oldLookbehind < 0 {
+			p.lookbehind = p.groups
+		}
+		sub, err := p.parseGroupBody(start)
+		p.lookbehind = oldLookbehind
+		if err != nil {
+			return nil, err
+		}
+		min, max := sub.widths()
+		if min != max {
+			return nil, p.error("look-behind requires fixed-width pattern", -1)
+		}
+		n := p.newNode(opLookbehind)
+		n.negate = c == '!'
+		n.width = min
+		n.subs = []*node{sub}
+		return n, nil
+	case '(':
+		return p.parseConditional(start)
+	}
+	if _, ok := inlineFlags[c]; ok || c == '-' {
+		p.pos--
+		return p.parseFlags(start)
+	}
+	return nil, p.error("unknown extension ?"+string(c), start+1)
+}
+
+// Parses a capturing group called name, or unnamed if name is ""
+func (p *parser) parseCapture(start int, name string) (*node, error) {
+	p.groups++
+	group := p.groups
+	if name != "" {
+		if old, found := p.names[name]; found {
+			return nil, p.error(fmt.Sprintf("redefinition of group name '%s' as group %d; was group %d", name, group, old), start+4)
+		}
+		p.names[name] = group
+	}
+	p.open[group] = true
+	sub, err := p.parseGroupBody(start)
+	if err != nil {
+		return nil, err
+	}
+	delete(p.open, group)
+	n := p.newNode(opGroup)
+	n.group = group
+	n.subs = []*node{sub}
+	return n, nil
+}
+
+// Parses (?(group)yes|no) after the (?(
+func (p *parser) parseConditional(start int) (*node, error) {
+	nameStart := p.pos
+	for p.more() && p.src[p.pos] != ')' {
+		p.pos++
+	}
+	if !p.more() {
+		return nil, p.error("missing ), unterminated name", nameStart)
+	}
+	name := string(p.src[nameStart:p.pos])
+	p.pos++
+	if name == "" {
+		return nil, p.error("missing group name", nameStart)
+	}
+	var group int
+	if n, err := strconv.Atoi(name); err == nil && strings.Trim(name, "0123456789") == "" {
+		if n == 0 {
+			return nil, p.error("bad group number", nameStart)
+		}
+		group = n
+		if group > p.groups {
+			p.condRefs[group] = nameStart
+		}
+	} else {
+		if !p.isName(name) {
+			return nil, p.error(fmt.Sprintf("bad character in group name '%s'", name), nameStart)
+		}
+		var found bool
+		group, found = p.names[name]
+		if !found {
+			return nil, p.error(fmt.Sprintf("unknown group name '%s'", name), nameStart)
+		}
+	}
+	if p.lookbehind >= 0 && group > p.lookbehind {
+		return nil, p.error("cannot refer to group defined in the same lookbehind subpattern", nameStart)
+	}
+	var branches []*node
+	for {
+		seq, err := p.parseSequence()
+		if err != nil {
+			return nil, err
+		}
+		branches = append(branches, seq)
+		if p.peek() != '|' {
+			break
+		}
+		if len(branches) == 2 {
+			return nil, p.error("conditional backref with more than two branches", p.pos)
+		}
+		p.pos++
+	}
+	if !p.accept(')') {
+		return nil, p.error("missing ), unterminated subpattern", start)
+	}
+	n := p.newNode(opConditional)
+	n.group = group
+	if len(branches) == 1 {
+		branches = append(branches, &node{op: opEmpty})
+	}
+	n.subs = branches
+	return n, nil
+}
+
+// Reads inline flag letters
+func (p *parser) readFlags() int {
+	flags := 0
+	for p.more() {
+		flag, ok := inlineFlags[p.src[p.pos]]
+		if !ok {
+			break
+		}
+		flags |= flag
+		p.pos++
+	}
+	return flags
+}
+
+// Parses (?flags) or (?flags-flags:...) after the (?
+func (p *parser) parseFlags(start int) (*node, error) {
+	add := p.readFlags()
+	if p.isBytes && add&flagUnicode != 0 {
+		return nil, p.error("bad inline flags: cannot use 'u' flag with a bytes pattern", p.pos)
+	}
+	if !p.isBytes && add&flagLocale != 0 {
+		return nil, p.error("bad inline flags: cannot use 'L' flag with a str pattern", p.pos)
+	}
+	if n := add & (flagASCII | flagUnicode | flagLocale); n&(n-1) != 0 {
+		return nil, p.error("bad inline flags: flags 'a', 'u' and 'L' are incompatible", p.pos)
+	}
+	remove := 0
+	if p.accept('-') {
+		if !p.more() {
+			return nil, p.error("missing flag", p.pos)
+		}
+		remove = p.readFlags()
+		if remove == 0 {
+			return nil, p.error("missing flag", p.pos)
+		}
+		if remove&(flagASCII|flagUnicode|flagLocale) != 0 {
+			return nil, p.error("bad inline flags: cannot turn off flags 'a', 'u' and 'L'", p.pos)
+		}
+		if add&remove != 0 {
+			return nil, p.error("bad inline flags: flag turned on and off", p.pos)
+		}
+	}
+	switch {
+	case p.accept(')'):
+		if remove != 0 {
+			return nil, p.error("missing :", p.pos-1)
+		}
+		p.global |= add
+		p.flags |= add
+		return nil, nil
+	case p.accept(':'):
+		oldFlags := p.flags
+		if add&(flagUnicode|flagLocale) != 0 {
+			p.flags &^= flagASCII
+		}
+		p.flags = (p.flags | add) &^ remove
+		sub, err := p.parseGroupBody(start)
+		p.flags = oldFlags
+		return sub, err
+	case !p.more():
+		return nil, p.error("missing -, : or )", p.pos)
+	case unicode.IsLetter(p.peek()):
+		return nil, p.error("unknown flag", p.pos)
+	}
+	return nil, p.error("missing -, : or )", p.pos)
+}
+
+// Reads n hex digits of an escape which started at start
+func (p *parser) hexEscape(start, n int) (rune, error) {
+	begin := p.pos
+	for p.pos < begin+n && p.more() && strings.ContainsRune("0123456789abcdefABCDEF", p.src[p.pos]) {
+		p.pos++
+	}
+	if p.pos-begin != n {
+		return 0, p.error("incomplete escape "+string(p.src[start:p.pos]), start)
+	}
+	value, _ := strconv.ParseUint(string(p.src[begin:p.pos]), 16, 32)
+	return rune(value), nil
+}
+
+// Reads up to n more octal digits of an escape which started at
+// start with the digit at pos-1
+func (p *parser) octalEscape(start, n int) (rune, error) {
+	begin := p.pos - 1
+	for p.pos < begin+1+n && p.more() && '0' <= p.src[p.pos] && p.src[p.pos] <= '7' {
+		p.pos++
+	}
+	value, _ := strconv.ParseUint(string(p.src[begin:p.pos]), 8, 32)
+	if value > 0377 {
+		return 0, p.error(fmt.Sprintf("octal escape value %s outside of range 0-0o377", string(p.src[start:p.pos])), start)
+	}
+	return rune(value), nil
+}
+
+// Reads the character escape \c which started at start
+func (p *parser) charEscape(c rune, start int) (rune, error) {
+	switch c {
+	case '0':
+		return p.octalEscape(start, 2)
+	case 'x':
+		return p.hexEscape(start, 2)
+	case 'u', 'U':
+		if p.isBytes {
+			break
+		}
+		n := 4
+		if c == 'U' {
+			n = 8
+		}
+		r, err := p.hexEscape(start, n)
+		if err == nil && r > unicode.MaxRune {
+			return 0, p.error("bad escape "+string(p.src[start:p.pos]), start)
+		}
+		return r, err
+	case 'a':
+		return '\a', nil
+	case 'f':
+		return '\f', nil
+	case 'n':
+		return '\n', nil
+	case 'r':
+		return '\r', nil
+	case 't':
+		return '\t', nil
+	case 'v':
+		return '\v', nil
+	}
+	if c < 0x80 && (unicode.IsLetter(c) || unicode.IsDigit(c)) {
+		return 0, p.error("bad escape \\"+string(c), start)
+	}
+	return c, nil
+}
+
+// The categories of the \d \D \s \S \w \W escapes
+var escapeCategories = map[rune]category{
+	'd': categoryDigit,
+	'D': categoryNotDigit,
+	's': categorySpace,
+	'S': categoryNotSpace,
+	'w': categoryWord,
+	'W': categoryNotWord,
+}
+
+// Parses the escape outside a set which started with the \ at start
+func (p *parser) parseEscape(start int) (*node, error) {
+	if !p.more() {
+		return nil, p.error("bad escape (end of pattern)", start)
+	}
+	c := p.next()
+	switch c {
+	case 'A':
+		return p.newNode(opBeginText), nil
+	case 'Z':
+		return p.newNode(opEndText), nil
+	case 'b':
+		return p.newNode(opWordBoundary), nil
+	case 'B':
+		return p.newNode(opNotWordBoundary), nil
+	}
+	if cat, ok := escapeCategories[c]; ok {
+		n := p.newNode(opSet)
+		n.set = &charSet{categories: []category{cat}}
+		return n, nil
+	}
+	if '1' <= c && c <= '9' {
+		// an octal escape if there are 3 octal digits otherwise a
+		// group reference
+		if p.more() && '0' <= p.peek() && p.peek() <= '9' {
+			if c <= '7' && p.peek() <= '7' && p.pos+1 < len(p.src) && '0' <= p.src[p.pos+1] && p.src[p.pos+1] <= '7' {
+				r, err := p.octalEscape(start, 2)
+				if err != nil {
+					return nil, err
+				}
+				return p.literal(r), nil
+			}
+			p.pos++
+		}
+		group, _ := strconv.Atoi(string(p.src[start+1 : p.pos]))
+		if group > p.groups {
+			return nil, p.error(fmt.Sprintf("invalid group reference %d", group), start+1)
+		}
+		return p.backref(group, start)
+	}
+	r, err := p.charEscape(c, start)
+	if err != nil {
+		return nil, err
+	}
+	return p.literal(r), nil
+}
+
+// Parses a character or escape in a set returning either the
+// character or a category
+func (p *parser) parseSetItem() (r rune, cat category, isCat bool, err error) {
+	start := p.pos
+	c := p.next()
+	if c != '\\' {
+		return c, 0, false, nil
+	}
+	if !p.more() {
+		return 0, 0, false, p.error("bad escape (end of pattern)", start)
+	}
+	c = p.next()
+	if cat, ok := escapeCategories[c]; ok {
+		return 0, cat, true, nil
+	}
+	switch {
+	case c == 'b':
+		return '\b', 0, false, nil
+	case '0' <= c && c <= '7':
+		r, err = p.octalEscape(start, 2)
+		return r, 0, false, err
+	case c == '8' || c == '9':
+		return 0, 0, false, p.error("bad escape \\"+string(c), start)
+	}
+	r, err = p.charEscape(c, start)
+	return r, 0, false, err
+}
+
+// Parses a set which started with the [ at start
+func (p *parser) parseSet(start int) (*node, error) {
+	set := &charSet{}
+	set.negate = p.accept('^')
+	first := true
+	for {
+		if !p.more() {
+			return nil, p.error("unterminated character set", start)
+		}
+		if p.peek() == ']' && !first {
+			p.pos++
+			break
+		}
+		first = false
+		itemStart := p.pos
+		lo, cat, isCat, err := p.parseSetItem()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() == '-' && p.pos+1 < len(p.src) && p.src[p.pos+1] != ']' {
+			p.pos++
+			hi, _, hiIsCat, err := p.parseSetItem()
+			if err != nil {
+				return nil, err
+			}
+			if isCat || hiIsCat || hi < lo {
+				return nil, p.error("bad character range "+string(p.src[itemStart:p.pos]), itemStart)
+			}
+			set.ranges = append(set.ranges, charRange{lo, hi})
+			continue
+		}
+		if isCat {
+			set.categories = append(set.categories, cat)
+		} else {
+			set.ranges = append(set.ranges, charRange{lo, lo})
+		}
+	}
+	n := p.newNode(opSet)
+	n.set = set
+	return n, nil
+}
@@ -0,0 +1,524 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Compiled regular expression objects
+
+package re
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-python/gpython/py"
+)
+
+var PatternType = py.NewType("Pattern", "Compiled regular expression object.")
+
+// Pattern is a compiled regular expression
+type Pattern struct {
+	pattern    py.Object // the str or bytes it was compiled from
+	isBytes    bool
+	flags      int
+	root       *node
+	groups     int
+	groupIndex map[string]int
+	prefix     *node // the single character every match starts with or nil
+	anchored   bool  // whether matches can only start at the beginning
+}
+
+// Type of this object
+func (p *Pattern) Type() *py.Type {
+	return PatternType
+}
+
+// Returns the characters of the str or bytes s
+func toRunes(s py.Object) []rune {
+	switch x := s.(type) {
+	case py.String:
+		return []rune(string(x))
+	case py.Bytes:
+		runes := make([]rune, len(x))
+		for i, c := range x {
+			runes[i] = rune(c)
+		}
+		return runes
+	}
+	return nil
+}
+
+// Makes a pattern from the str or bytes pattern
+func newPattern(pattern py.Object, isBytes bool, flags int) (*Pattern, error) {
+	if isBytes {
+		if flags&flagUnicode != 0 {
+			return nil, py.ExceptionNewf(py.ValueError, "cannot use UNICODE flag with a bytes pattern")
+		}
+		if flags&flagASCII != 0 && flags&flagLocale != 0 {
+			return nil, py.ExceptionNewf(py.ValueError, "ASCII and LOCALE flags are incompatible")
+		}
+	} else {
+		if flags&flagLocale != 0 {
+			return nil, py.ExceptionNewf(py.ValueError, "cannot use LOCALE flag with a str pattern")
+		}
+		if flags&flagASCII != 0 && flags&flagUnicode != 0 {
+			return nil, py.ExceptionNewf(py.ValueError, "ASCII and UNICODE flags are incompatible")
+		}
+	}
+	parseFlags := flags
+	if isBytes {
+		// bytes patterns only know about ascii
+		parseFlags |= flagASCII
+	}
+	parser, root, err := parse(pattern, toRunes(pattern), isBytes, parseFlags)
+	if err != nil {
+		return nil, err
+	}
+	flags |= parser.global
+	if !isBytes && flags&flagASCII == 0 {
+		flags |= flagUnicode
+	}
+	p := &Pattern{
+		pattern:    pattern,
+		isBytes:    isBytes,
+		flags:      flags,
+		root:       root,
+		groups:     parser.groups,
+		groupIndex: parser.names,
+	}
+	first := firstNode(root)
+	if first.isSingle() {
+		p.prefix = first
+	}
+	p.anchored = first.op == opBeginText
+	return p, nil
+}
+
+// Returns the characters of the string to match or an error if it
+// isn't the same kind of string as the pattern
+func (p *Pattern) subject(s py.Object) ([]rune, error) {
+	switch s.(type) {
+	case py.String:
+		if p.isBytes {
+			return nil, py.ExceptionNewf(py.TypeError, "cannot use a bytes pattern on a string-like object")
+		}
+	case py.Bytes:
+		if !p.isBytes {
+			return nil, py.ExceptionNewf(py.TypeError, "cannot use a string pattern on a bytes-like object")
+		}
+	default:
+		return nil, py.ExceptionNewf(py.TypeError, "expected string or bytes-like object")
+	}
+	return toRunes(s), nil
+}
+
+// Makes a str or bytes, whichever the pattern is, from runes
+func (p *Pattern) text(runes []rune) py.Object {
+	if p.isBytes {
+		b := make(py.Bytes, len(runes))
+		for i, r := range runes {
+			b[i] = byte(r)
+		}
+		return b
+	}
+	return py.String(string(runes))
+}
+
+// Reads the string, pos and endpos arguments of the matching methods
+func (p *Pattern) searchArgs(name string, args py.Tuple, kwargs py.StringDict) (py.Object, []rune, int, int, error) {
+	var s py.Object
+	var pos py.Object = py.Int(0)
+	var endpos py.Object = py.None
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|OO:"+name, []string{"string", "pos", "endpos"}, &s, &pos, &endpos)
+	if err != nil {
+		return nil, nil, 0, 0, err
+	}
+	input, err := p.subject(s)
+	if err != nil {
+		return nil, nil, 0, 0, err
+	}
+	start, err := py.IndexInt(pos)
+	if err != nil {
+		return nil, nil, 0, 0, err
+	}
+	end := len(input)
+	if endpos != py.None {
+		end, err = py.IndexInt(endpos)
+		if err != nil {
+			return nil, nil, 0, 0, err
+		}
+	}
+	clamp := func(i int) int {
+		if i < 0 {
+			return 0
+		}
+		if i > len(input) {
+			return len(input)
+		}
+		return i
+	}
+	return s, input, clamp(start), clamp(end), nil
+}
+
+// Runs the pattern returning a Match or None
+func (p *Pattern) matchObject(name string, mode matchMode, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	s, input, pos, endpos, err := p.searchArgs(name, args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	caps, lastIndex := p.exec(input, pos, endpos, mode, false)
+	if caps == nil {
+		return py.None, nil
+	}
+	return p.newMatch(s, input, pos, endpos, caps, lastIndex), nil
+}
+
+// Calls fn with each match from pos to endpos until it returns false
+//
+// After an empty match the next match may start at the same place as
+// long as it isn't empty too.
+func (p *Pattern) scan(input []rune, pos, endpos int, fn func(caps []int, lastIndex int) (bool, error)) error {
+	mustAdvance := false
+	for pos <= endpos {
+		caps, lastIndex := p.exec(input, pos, endpos, modeSearch, mustAdvance)
+		if caps == nil {
+			return nil
+		}
+		more, err := fn(caps, lastIndex)
+		if err != nil || !more {
+			return err
+		}
+		mustAdvance = caps[0] == caps[1]
+		pos = caps[1]
+	}
+	return nil
+}
+
+// Returns group g of caps or None if it didn't match
+func (p *Pattern) group(input []rune, caps []int, g int) py.Object {
+	if caps[2*g+1] < 0 {
+		return py.None
+	}
+	return p.text(input[caps[2*g]:caps[2*g+1]])
+}
+
+// Returns the findall item for a match
+func (p *Pattern) findallItem(input []rune, caps []int) py.Object {
+	item := func(g int) py.Object {
+		if caps[2*g+1] < 0 {
+			return p.text(nil)
+		}
+		return p.group(input, caps, g)
+	}
+	switch p.groups {
+	case 0:
+		return item(0)
+	case 1:
+		return item(1)
+	}
+	t := make(py.Tuple, p.groups)
+	for g := range t {
+		t[g] = item(g + 1)
+	}
+	return t
+}
+
+// Reads the count argument of sub and subn
+func (p *Pattern) subArgs(name string, args py.Tuple, kwargs py.StringDict) (repl, s py.Object, count int, err error) {
+	var countObj py.Object = py.Int(0)
+	err = py.ParseTupleAndKeywords(args, kwargs, "OO|O:"+name, []string{"repl", "string", "count"}, &repl, &s, &countObj)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	count, err = py.IndexInt(countObj)
+	return repl, s, count, err
+}
+
+// Substitutes repl for up to count matches in s, all of them if
+// count is 0, returning the new string and how many were made
+func (p *Pattern) Sub(repl, s py.Object, count int) (py.Object, int, error) {
+	input, err := p.subject(s)
+	if err != nil {
+		return nil, 0, err
+	}
+	var items []templateItem
+	callable := true
+	switch repl.(type) {
+	case py.String, py.Bytes:
+		if _, err := p.subject(repl); err != nil {
+			return nil, 0, err
+		}
+		items, err = p.parseTemplate(repl)
+		if err != nil {
+			return nil, 0, err
+		}
+		callable = false
+	}
+	var out []rune
+	last, n := 0, 0
+	err = p.scan(input, 0, len(input), func(caps []int, lastIndex int) (bool, error) {
+		out = append(out, input[last:caps[0]]...)
+		if callable {
+			res, err := py.Call(repl, py.Tuple{p.newMatch(s, input, 0, len(input), caps, lastIndex)}, nil)
+			if err != nil {
+				return false, err
+			}
+			if res != py.None {
+				if _, err := p.subject(res); err != nil {
+					kind := "str"
+					if p.isBytes {
+						kind = "bytes-like object"
+					}
+					return false, py.ExceptionNewf(py.TypeError, "expected %s instance, %s found", kind, res.Type().Name)
+				}
+				out = append(out, toRunes(res)...)
+			}
+		} else {
+			out = expandTemplate(out, items, input, caps)
+		}
+		last = caps[1]
+		n++
+		return count <= 0 || n < count, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	out = append(out, input[last:]...)
+	return p.text(out), n, nil
+}
+
+// Splits s by the matches, at most maxsplit times if it is positive
+func (p *Pattern) Split(s py.Object, maxsplit int) (py.Object, error) {
+	input, err := p.subject(s)
+	if err != nil {
+		return nil, err
+	}
+	if maxsplit < 0 {
+		return py.NewListFromItems([]py.Object{s}), nil
+	}
+	var items []py.Object
+	last, n := 0, 0
+	err = p.scan(input, 0, len(input), func(caps []int, lastIndex int) (bool, error) {
+		items = append(items, p.text(input[last:caps[0]]))
+		for g := 1; g <= p.groups; g++ {
+			items = append(items, p.group(input, caps, g))
+		}
+		last = caps[1]
+		n++
+		return maxsplit == 0 || n < maxsplit, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	items = append(items, p.text(input[last:]))
+	return py.NewListFromItems(items), nil
+}
+
+// The names of the flags in the order repr shows them
+var flagNames = []struct {
+	flag int
+	name string
+}{
+	{flagTemplate, "re.TEMPLATE"},
+	{flagIgnoreCase, "re.IGNORECASE"},
+	{flagLocale, "re.LOCALE"},
+	{flagMultiline, "re.MULTILINE"},
+	{flagDotAll, "re.DOTALL"},
+	{flagUnicode, "re.UNICODE"},
+	{flagVerbose, "re.VERBOSE"},
+	{flagDebug, "re.DEBUG"},
+	{flagASCII, "re.ASCII"},
+}
+
+func (p *Pattern) M__repr__() (py.Object, error) {
+	pattern, err := py.ReprAsString(p.pattern)
+	if err != nil {
+		return nil, err
+	}
+	if len(pattern) > 200 {
+		pattern = pattern[:200]
+	}
+	flags := p.flags
+	if !p.isBytes {
+		// UNICODE is the default for str patterns
+		flags &^= flagUnicode
+	}
+	if flags == 0 {
+		return py.String(fmt.Sprintf("re.compile(%s)", pattern)), nil
+	}
+	var names []string
+	for _, f := range flagNames {
+		if flags&f.flag != 0 {
+			names = append(names, f.name)
+			flags &^= f.flag
+		}
+	}
+	if flags != 0 {
+		names = append(names, fmt.Sprintf("0x%x", flags))
+	}
+	return py.String(fmt.Sprintf("re.compile(%s, %s)", pattern, strings.Join(names, "|"))), nil
+}
+
+func (p *Pattern) M__eq__(other py.Object) (py.Object, error) {
+	q, ok := other.(*Pattern)
+	if !ok {
+		return py.NotImplemented, nil
+	}
+	if p.isBytes != q.isBytes || p.flags != q.flags {
+		return py.False, nil
+	}
+	return py.Eq(p.pattern, q.pattern)
+}
+
+func (p *Pattern) M__ne__(other py.Object) (py.Object, error) {
+	eq, err := p.M__eq__(other)
+	if err != nil || eq == py.NotImplemented {
+		return eq, err
+	}
+	return py.Not(eq)
+}
+
+// Check interface is satisfied
+var _ py.I__repr__ = (*Pattern)(nil)
+var _ py.I__eq__ = (*Pattern)(nil)
+var _ py.I__ne__ = (*Pattern)(nil)
+
+// ScannerIterator iterates over the matches of a pattern in a string
+type ScannerIterator struct {
+	re          *Pattern
+	s           py.Object
+	input       []rune
+	start       int // the pos argument
+	pos         int // where the next search starts
+	endpos      int
+	mustAdvance bool
+	done        bool
+}
+
+var ScannerIteratorType = py.NewType("callable_iterator", "Iterator over the matches of a pattern.")
+
+// Type of this object
+func (it *ScannerIterator) Type() *py.Type {
+	return ScannerIteratorType
+}
+
+func (it *ScannerIterator) M__iter__() (py.Object, error) {
+	return it, nil
+}
+
+func (it *ScannerIterator) M__next__() (py.Object, error) {
+	if it.done || it.pos > it.endpos {
+		return nil, py.StopIteration
+	}
+	caps, lastIndex := it.re.exec(it.input, it.pos, it.endpos, modeSearch, it.mustAdvance)
+	if caps == nil {
+		it.done = true
+		return nil, py.StopIteration
+	}
+	it.mustAdvance = caps[0] == caps[1]
+	it.pos = caps[1]
+	return it.re.newMatch(it.s, it.input, it.start, it.endpos, caps, lastIndex), nil
+}
+
+// Check interface is satisfied
+var _ py.I_iterator = (*ScannerIterator)(nil)
+
+func init() {
+	self := func(o py.Object) *Pattern {
+		return o.(*Pattern)
+	}
+	PatternType.Dict["pattern"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return self(o).pattern, nil
+		},
+		Doc: "The pattern string from which the RE object was compiled.",
+	}
+	PatternType.Dict["flags"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return py.Int(self(o).flags), nil
+		},
+		Doc: "The regex matching flags.",
+	}
+	PatternType.Dict["groups"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return py.Int(self(o).groups), nil
+		},
+		Doc: "The number of capturing groups in the pattern.",
+	}
+	PatternType.Dict["groupindex"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			d := py.NewStringDict()
+			for name, g := range self(o).groupIndex {
+				d[name] = py.Int(g)
+			}
+			return d, nil
+		},
+		Doc: "A dictionary mapping group names to group numbers.",
+	}
+	PatternType.Dict["match"] = py.MustNewMethod("match", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		return self(o).matchObject("match", modeMatch, args, kwargs)
+	}, 0, "Matches zero or more characters at the beginning of the string.")
+	PatternType.Dict["fullmatch"] = py.MustNewMethod("fullmatch", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		return self(o).matchObject("fullmatch", modeFull, args, kwargs)
+	}, 0, "Matches against all of the string.")
+	PatternType.Dict["search"] = py.MustNewMethod("search", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		return self(o).matchObject("search", modeSearch, args, kwargs)
+	}, 0, "Scan through string looking for a match, and return a corresponding match object instance.\n\nReturn None if no position in the string matches.")
+	PatternType.Dict["findall"] = py.MustNewMethod("findall", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		p := self(o)
+		_, input, pos, endpos, err := p.searchArgs("findall", args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+		var items []py.Object
+		err = p.scan(input, pos, endpos, func(caps []int, lastIndex int) (bool, error) {
+			items = append(items, p.findallItem(input, caps))
+			return true, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return py.NewListFromItems(items), nil
+	}, 0, "Return a list of all non-overlapping matches of pattern in string.")
+	PatternType.Dict["finditer"] = py.MustNewMethod("finditer", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		p := self(o)
+		s, input, pos, endpos, err := p.searchArgs("finditer", args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+		return &ScannerIterator{re: p, s: s, input: input, start: pos, pos: pos, endpos: endpos}, nil
+	}, 0, "Return an iterator over all non-overlapping matches for the RE pattern in string.\n\nFor each match, the iterator returns a match object.")
+	PatternType.Dict["sub"] = py.MustNewMethod("sub", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		p := self(o)
+		repl, s, count, err := p.subArgs("sub", args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+		res, _, err := p.Sub(repl, s, count)
+		return res, err
+	}, 0, "Return the string obtained by replacing the leftmost non-overlapping occurrences of pattern in string by the replacement repl.")
+	PatternType.Dict["subn"] = py.MustNewMethod("subn", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		p := self(o)
+		repl, s, count, err := p.subArgs("subn", args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+		res, n, err := p.Sub(repl, s, count)
+		if err != nil {
+			return nil, err
+		}
+		return py.Tuple{res, py.Int(n)}, nil
+	}, 0, "Return the tuple (new_string, number_of_subs_made) found by replacing the leftmost non-overlapping occurrences of pattern with the replacement repl.")
+	PatternType.Dict["split"] = py.MustNewMethod("split", func(o py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		var s py.Object
+		var maxsplit py.Object = py.Int(0)
+		err := py.ParseTupleAndKeywords(args, kwargs, "O|O:split", []string{"string", "maxsplit"}, &s, &maxsplit)
+		if err != nil {
+			return nil, err
+		}
+		n, err := py.IndexInt(maxsplit)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).Split(s, n)
+	}, 0, "Split string by the occurrences of pattern.")
+}
@@ -0,0 +1,411 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package re implements the python re module
+//
+// Go's regexp package doesn't do back references or look arounds so
+// patterns are run by a backtracking matcher over the characters of
+// the string, which means positions are in characters as python
+// expects.
+//
+// Empty matches follow python 3.7 and later: they are found next to
+// a previous match, so re.sub('x*', '-', 'abxd') gives '-a-b--d-', and
+// split splits on them.
+package re
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/go-python/gpython/py"
+)
+
+const module_doc = `Support for regular expressions (RE).
+
+This module provides regular expression matching operations similar to
+those found in Perl.  It supports both 8-bit and Unicode strings; both
+the pattern and the strings being processed can contain null bytes and
+characters outside the US ASCII range.
+
+Regular expressions can contain both special and ordinary characters.
+Most ordinary characters, like "A", "a", or "0", are the simplest
+regular expressions; they simply match themselves.
+
+The special characters are:
+    "."      Matches any character except a newline.
+    "^"      Matches the start of the string.
+    "$"      Matches the end of the string or just before the newline at
+             the end of the string.
+    "*"      Matches 0 or more (greedy) repetitions of the preceding RE.
+             Greedy means that it will match as many repetitions as possible.
+    "+"      Matches 1 or more (greedy) repetitions of the preceding RE.
+    "?"      Matches 0 or 1 (greedy) of the preceding RE.
+    *?,+?,?? Non-greedy versions of the previous three special characters.
+    {m,n}    Matches from m to n repetitions of the preceding RE.
+    {m,n}?   Non-greedy version of the above.
+    "\\"     Either escapes special characters or signals a special sequence.
+    []       Indicates a set of characters.
+             A "^" as the first character indicates a complementing set.
+    "|"      A|B, creates an RE that will match either A or B.
+    (...)    Matches the RE inside the parentheses.
+             The contents can be retrieved or matched later in the string.
+    (?aiLmsux) Set the A, I, L, M, S, U, or X flag for the RE (see below).
+    (?:...)  Non-grouping version of regular parentheses.
+    (?P<name>...) The substring matched by the group is accessible by name.
+    (?P=name)     Matches the text matched earlier by the group named name.
+    (?#...)  A comment; ignored.
+    (?=...)  Matches if ... matches next, but doesn't consume the string.
+    (?!...)  Matches if ... doesn't match next.
+    (?<=...) Matches if preceded by ... (must be fixed length).
+    (?<!...) Matches if not preceded by ... (must be fixed length).
+    (?(id/name)yes|no) Matches yes pattern if the group with id/name matched,
+                       the (optional) no pattern otherwise.
+
+The special sequences consist of "\\" and a character from the list
+below.  If the ordinary character is not on the list, then the
+resulting RE will match the second character.
+    \number  Matches the contents of the group of the same number.
+    \A       Matches only at the start of the string.
+    \Z       Matches only at the end of the string.
+    \b       Matches the empty string, but only at the start or end of a word.
+    \B       Matches the empty string, but not at the start or end of a word.
+    \d       Matches any decimal digit; equivalent to the set [0-9] in
+             bytes patterns or string patterns with the ASCII flag.
+             In string patterns without the ASCII flag, it will match the whole
+             range of Unicode digits.
+    \D       Matches any non-digit character; equivalent to [^\d].
+    \s       Matches any whitespace character; equivalent to [ \t\n\r\f\v] in
+             bytes patterns or string patterns with the ASCII flag.
+             In string patterns without the ASCII flag, it will match the whole
+             range of Unicode whitespace characters.
+    \S       Matches any non-whitespace character; equivalent to [^\s].
+    \w       Matches any alphanumeric character; equivalent to [a-zA-Z0-9_]
+             in bytes patterns or string patterns with the ASCII flag.
+             In string patterns without the ASCII flag, it will match the
+             range of Unicode alphanumeric characters (letters plus digits
+             plus underscore).
+    \W       Matches the complement of \w.
+    \\       Matches a literal backslash.
+
+This module exports the following functions:
+    match     Match a regular expression pattern to the beginning of a string.
+    fullmatch Match a regular expression pattern to all of a string.
+    search    Search a string for the presence of a pattern.
+    sub       Substitute occurrences of a pattern found in a string.
+    subn      Same as sub, but also return the number of substitutions made.
+    split     Split a string by the occurrences of a pattern.
+    findall   Find all occurrences of a pattern in a string.
+    finditer  Return an iterator yielding a match object for each match.
+    compile   Compile a pattern into a RegexObject.
+    purge     Clear the regular expression cache.
+    escape    Backslash the special characters in a string.
+
+Some of the functions in this module takes flags as optional parameters:
+    A  ASCII       For string patterns, make \w, \W, \b, \B, \d, \D
+                   match the corresponding ASCII character categories
+                   (rather than the whole Unicode categories, which is the
+                   default).
+                   For bytes patterns, this flag is the only available
+                   behaviour and needn't be specified.
+    I  IGNORECASE  Perform case-insensitive matching.
+    L  LOCALE      Make \w, \W, \b, \B, dependent on the current locale.
+    M  MULTILINE   "^" matches the beginning of lines (after a newline)
+                   as well as the string.
+                   "$" matches the end of lines (before a newline) as well
+                   as the end of the string.
+    S  DOTALL      "." matches any character at all, including the newline.
+    X  VERBOSE     Ignore whitespace and comments for nicer looking RE's.
+    U  UNICODE     For compatibility only. Ignored for string patterns (it
+                   is the default), and forbidden for bytes patterns.
+
+This module also defines an exception 'error'.
+`
+
+var Error = py.ExceptionType.NewType("error", `Exception raised for invalid regular expressions.
+
+Attributes:
+
+    msg: The unformatted error message
+    pattern: The regular expression pattern
+    pos: The index in the pattern where compilation failed (may be None)
+    lineno: The line corresponding to pos (may be None)
+    colno: The column corresponding to pos (may be None)`, nil, nil)
+
+// Makes a re.error for msg at pos in pattern whose characters are src
+//
+// If pos is -1 the error has no position, or pattern, as python's
+// errors about the whole pattern don't.
+func newError(msg string, pattern py.Object, src []rune, pos int) error {
+	if pos < 0 {
+		e := py.ExceptionNewf(Error, "%s", msg)
+		e.Dict["msg"] = py.String(msg)
+		e.Dict["pattern"] = py.None
+		e.Dict["pos"] = py.None
+		e.Dict["lineno"] = py.None
+		e.Dict["colno"] = py.None
+		return e
+	}
+	lineno := 1
+	colno := pos + 1
+	for i, r := range src[:pos] {
+		if r == '\n' {
+			lineno++
+			colno = pos - i
+		}
+	}
+	text := fmt.Sprintf("%s at position %d", msg, pos)
+	for _, r := range src {
+		if r == '\n' {
+			text += fmt.Sprintf(" (line %d, column %d)", lineno, colno)
+			break
+		}
+	}
+	e := py.ExceptionNewf(Error, "%s", text)
+	e.Dict["msg"] = py.String(msg)
+	e.Dict["pattern"] = pattern
+	e.Dict["pos"] = py.Int(pos)
+	e.Dict["lineno"] = py.Int(lineno)
+	e.Dict["colno"] = py.Int(colno)
+	return e
+}
+
+// The most patterns kept in the cache before it is emptied
+const maxCache = 512
+
+// cacheKey identifies a compiled pattern in the cache
+type cacheKey struct {
+	pattern string
+	isBytes bool
+	flags   int
+}
+
+var (
+	cacheMu sync.Mutex
+	cache   = map[cacheKey]*Pattern{}
+)
+
+// Compile compiles the python str or bytes pattern with flags
+//
+// Compiled patterns are cached so compiling the same pattern again is
+// cheap.
+func Compile(pattern py.Object, flags int) (*Pattern, error) {
+	var key cacheKey
+	switch x := pattern.(type) {
+	case *Pattern:
+		if flags != 0 {
+			return nil, py.ExceptionNewf(py.ValueError, "cannot process flags argument with a compiled pattern")
+		}
+		return x, nil
+	case py.String:
+		key = cacheKey{string(x), false, flags}
+	case py.Bytes:
+		key = cacheKey{string(x), true, flags}
+	default:
+		return nil, py.ExceptionNewf(py.TypeError, "first argument must be string or compiled pattern")
+	}
+	cacheMu.Lock()
+	p, found := cache[key]
+	cacheMu.Unlock()
+	if found {
+		return p, nil
+	}
+	p, err := newPattern(pattern, key.isBytes, flags)
+	if err != nil {
+		return nil, err
+	}
+	cacheMu.Lock()
+	if len(cache) >= maxCache {
+		cache = map[cacheKey]*Pattern{}
+	}
+	cache[key] = p
+	cacheMu.Unlock()
+	return p, nil
+}
+
+// Reads the flags argument of a module function
+func flagsArg(flags py.Object) (int, error) {
+	if flags == nil {
+		return 0, nil
+	}
+	return py.MakeGoInt(flags)
+}
+
+const compile_doc = `Compile a regular expression pattern, returning a pattern object.`
+
+func re_compile(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var pattern, flags py.Object
+	err := py.ParseTupleAndKeywords(args, kwargs, "O|O:compile", []string{"pattern", "flags"}, &pattern, &flags)
+	if err != nil {
+		return nil, err
+	}
+	n, err := flagsArg(flags)
+	if err != nil {
+		return nil, err
+	}
+	return Compile(pattern, n)
+}
+
+// Makes a module function which compiles its pattern then calls the
+// pattern method of the same name with the other arguments
+//
+// kwlist are the names of the arguments, the first required of which
+// must be given, starting with the pattern and ending with flags.
+func patternFunction(name string, required int, kwlist []string) func(py.Object, py.Tuple, py.StringDict) (py.Object, error) {
+	format := strings.Repeat("O", required) + "|" + strings.Repeat("O", len(kwlist)-required) + ":" + name
+	return func(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+		values := make([]py.Object, len(kwlist))
+		results := make([]*py.Object, len(values))
+		for i := range values {
+			results[i] = &values[i]
+		}
+		err := py.ParseTupleAndKeywords(args, kwargs, format, kwlist, results...)
+		if err != nil {
+			return nil, err
+		}
+		flags, err := flagsArg(values[len(values)-1])
+		if err != nil {
+			return nil, err
+		}
+		p, err := Compile(values[0], flags)
+		if err != nil {
+			return nil, err
+		}
+		method, err := py.GetAttrString(p, name)
+		if err != nil {
+			return nil, err
+		}
+		var methodArgs py.Tuple
+		for _, value := range values[1 : len(values)-1] {
+			if value == nil {
+				break
+			}
+			methodArgs = append(methodArgs, value)
+		}
+		return py.Call(method, methodArgs, nil)
+	}
+}
+
+const match_doc = `Try to apply the pattern at the start of the string, returning
+a match object, or None if no match was found.`
+
+const fullmatch_doc = `Try to apply the pattern to all of the string, returning
+a match object, or None if no match was found.`
+
+const search_doc = `Scan through string looking for a match to the pattern, returning
+a match object, or None if no match was found.`
+
+const sub_doc = `Return the string obtained by replacing the leftmost
+non-overlapping occurrences of the pattern in string by the
+replacement repl.  repl can be either a string or a callable;
+if a string, backslash escapes in it are processed.  If it is
+a callable, it's passed the match object and must return
+a replacement string to be used.`
+
+const subn_doc = `Return a 2-tuple containing (new_string, number).
+new_string is the string obtained by replacing the leftmost
+non-overlapping occurrences of the pattern in the source
+string by the replacement repl.  number is the number of
+substitutions that were made. repl can be either a string or a
+callable; if a string, backslash escapes in it are processed.
+If it is a callable, it's passed the match object and must
+return a replacement string to be used.`
+
+const split_doc = `Split the source string by the occurrences of the pattern,
+returning a list containing the resulting substrings.  If
+capturing parentheses are used in pattern, then the text of all
+groups in the pattern are also returned as part of the resulting
+list.  If maxsplit is nonzero, at most maxsplit splits occur,
+and the remainder of the string is returned as the final element
+of the list.`
+
+const findall_doc = `Return a list of all non-overlapping matches in the string.
+
+If one or more capturing groups are present in the pattern, return
+a list of groups; this will be a list of tuples if the pattern
+has more than one group.
+
+Empty matches are included in the result.`
+
+const finditer_doc = `Return an iterator over all non-overlapping matches in the
+string.  For each match, the iterator returns a match object.
+
+Empty matches are included in the result.`
+
+const purge_doc = `Clear the regular expression caches`
+
+func re_purge(self py.Object) (py.Object, error) {
+	cacheMu.Lock()
+	cache = map[cacheKey]*Pattern{}
+	cacheMu.Unlock()
+	return py.None, nil
+}
+
+// The characters escape puts a backslash before
+const specialChars = "()[]{}?*+-|^$\\.&~# \t\n\r\v\f"
+
+const escape_doc = `Escape special characters in a string.`
+
+func re_escape(self py.Object, pattern py.Object) (py.Object, error) {
+	switch x := pattern.(type) {
+	case py.String:
+		var b strings.Builder
+		for _, r := range string(x) {
+			if strings.ContainsRune(specialChars, r) {
+				b.WriteByte('\\')
+			}
+			b.WriteRune(r)
+		}
+		return py.String(b.String()), nil
+	case py.Bytes:
+		res := make(py.Bytes, 0, len(x))
+		for _, c := range x {
+			if c < 0x80 && strings.IndexByte(specialChars, c) >= 0 {
+				res = append(res, '\\')
+			}
+			res = append(res, c)
+		}
+		return res, nil
+	}
+	return nil, py.ExceptionNewf(py.TypeError, "escape() argument must be str or bytes, not %s", pattern.Type().Name)
+}
+
+func init() {
+	methods := []*py.Method{
+		py.MustNewMethod("compile", re_compile, 0, compile_doc),
+		py.MustNewMethod("match", patternFunction("match", 2, []string{"pattern", "string", "flags"}), 0, match_doc),
+		py.MustNewMethod("fullmatch", patternFunction("fullmatch", 2, []string{"pattern", "string", "flags"}), 0, fullmatch_doc),
+		py.MustNewMethod("search", patternFunction("search", 2, []string{"pattern", "string", "flags"}), 0, search_doc),
+		py.MustNewMethod("sub", patternFunction("sub", 3, []string{"pattern", "repl", "string", "count", "flags"}), 0, sub_doc),
+		py.MustNewMethod("subn", patternFunction("subn", 3, []string{"pattern", "repl", "string", "count", "flags"}), 0, subn_doc),
+		py.MustNewMethod("split", patternFunction("split", 2, []string{"pattern", "string", "maxsplit", "flags"}), 0, split_doc),
+		py.MustNewMethod("findall", patternFunction("findall", 2, []string{"pattern", "string", "flags"}), 0, findall_doc),
+		py.MustNewMethod("finditer", patternFunction("finditer", 2, []string{"pattern", "string", "flags"}), 0, finditer_doc),
+		py.MustNewMethod("purge", re_purge, 0, purge_doc),
+		py.MustNewMethod("escape", re_escape, 0, escape_doc),
+	}
+	globals := py.StringDict{
+		"error":      Error,
+		"Pattern":    PatternType,
+		"Match":      MatchType,
+		"A":          py.Int(flagASCII),
+		"ASCII":      py.Int(flagASCII),
+		"DEBUG":      py.Int(flagDebug),
+		"I":          py.Int(flagIgnoreCase),
+		"IGNORECASE": py.Int(flagIgnoreCase),
+		"L":          py.Int(flagLocale),
+		"LOCALE":     py.Int(flagLocale),
+		"M":          py.Int(flagMultiline),
+		"MULTILINE":  py.Int(flagMultiline),
+		"S":          py.Int(flagDotAll),
+		"DOTALL":     py.Int(flagDotAll),
+		"T":          py.Int(flagTemplate),
+		"TEMPLATE":   py.Int(flagTemplate),
+		"U":          py.Int(flagUnicode),
+		"UNICODE":    py.Int(flagUnicode),
+		"X":          py.Int(flagVerbose),
+		"VERBOSE":    py.Int(flagVerbose),
+	}
+	py.NewModule("re", module_doc, methods, globals)
+}
@@ -0,0 +1,169 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Replacement templates for sub and expand
+
+package re
+
+import (
+	"fmt"
+	"strconv"
+	"unicode"
+
+	"github.com/go-python/gpython/py"
+)
+
+// templateItem is a piece of a replacement template, either literal
+// text or, if group >= 0, the text of a group
+type templateItem struct {
+	literal []rune
+	group   int
+}
+
+// The escapes allowed in templates
+var templateEscapes = map[rune]rune{
+	'a':  '\a',
+	'b':  '\b',
+	'f':  '\f',
+	'n':  '\n',
+	'r':  '\r',
+	't':  '\t',
+	'v':  '\v',
+	'\\': '\\',
+}
+
+// Returns true if r is an octal digit
+func isOctal(r rune) bool {
+	return '0' <= r && r <= '7'
+}
+
+// Parses the str or bytes template repl into items
+//
+// Group references are \1 to \99, \g<number> and \g<name>.
+func (p *Pattern) parseTemplate(repl py.Object) ([]templateItem, error) {
+	src := toRunes(repl)
+	var items []templateItem
+	var literal []rune
+	addGroup := func(group int) {
+		if len(literal) > 0 {
+			items = append(items, templateItem{literal: literal, group: -1})
+			literal = nil
+		}
+		items = append(items, templateItem{group: group})
+	}
+	checkGroup := func(group, pos int) error {
+		if group > p.groups {
+			return newError(fmt.Sprintf("invalid group reference %d", group), repl, src, pos)
+		}
+		return nil
+	}
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		if c != '\\' {
+			literal = append(literal, c)
+			continue
+		}
+		start := i
+		i++
+		if i >= len(src) {
+			return nil, newError("bad escape (end of pattern)", repl, src, start)
+		}
+		c = src[i]
+		switch {
+		case c == 'g':
+			if i+1 >= len(src) || src[i+1] != '<' {
+				return nil, newError("missing <", repl, src, i+1)
+			}
+			nameStart := i + 2
+			end := nameStart
+			for end < len(src) && src[end] != '>' {
+				end++
+			}
+			if end >= len(src) {
+				if nameStart == len(src) {
+					return nil, newError("missing group name", repl, src, nameStart)
+				}
+				return nil, newError("missing >, unterminated name", repl, src, nameStart)
+			}
+			name := string(src[nameStart:end])
+			if name == "" {
+				return nil, newError("missing group name", repl, src, nameStart)
+			}
+			group, err := strconv.Atoi(name)
+			if err != nil || group < 0 || name[0] == '+' {
+				isName := true
+				for j, r := range name {
+					if (p.isBytes && r >= 0x80) || !(r == '_' || unicode.IsLetter(r) || (j > 0 && unicode.IsDigit(r))) {
+						isName = false
+					}
+				}
+				if !isName {
+					return nil, newError(fmt.Sprintf("bad character in group name '%s'", name), repl, src, nameStart)
+				}
+				var found bool
+				group, found = p.groupIndex[name]
+				if !found {
+					return nil, py.ExceptionNewf(py.IndexError, "unknown group name '%s'", name)
+				}
+			} else if err := checkGroup(group, nameStart); err != nil {
+				return nil, err
+			}
+			addGroup(group)
+			i = end
+		case c == '0':
+			end := i + 1
+			for end < len(src) && end < i+3 && isOctal(src[end]) {
+				end++
+			}
+			value, _ := strconv.ParseUint(string(src[i:end]), 8, 32)
+			literal = append(literal, rune(value))
+			i = end - 1
+		case '1' <= c && c <= '9':
+			if i+2 < len(src) && isOctal(c) && isOctal(src[i+1]) && isOctal(src[i+2]) {
+				value, _ := strconv.ParseUint(string(src[i:i+3]), 8, 32)
+				if value > 0377 {
+					return nil, newError(fmt.Sprintf("octal escape value %s outside of range 0-0o377", string(src[start:i+3])), repl, src, start)
+				}
+				literal = append(literal, rune(value))
+				i += 2
+				continue
+			}
+			group := int(c - '0')
+			if i+1 < len(src) && '0' <= src[i+1] && src[i+1] <= '9' {
+				i++
+				group = group*10 + int(src[i]-'0')
+			}
+			if err := checkGroup(group, start+1); err != nil {
+				return nil, err
+			}
+			addGroup(group)
+		default:
+			if r, ok := templateEscapes[c]; ok {
+				literal = append(literal, r)
+			} else if c < 0x80 && unicode.IsLetter(c) {
+				return nil, newError("bad escape \\"+string(c), repl, src, start)
+			} else {
+				literal = append(literal, '\\', c)
+			}
+		}
+	}
+	if len(literal) > 0 {
+		items = append(items, templateItem{literal: literal, group: -1})
+	}
+	return items, nil
+}
+
+// Appends the template items to out filling in the groups from caps
+//
+// Groups which didn't match are replaced by nothing.
+func expandTemplate(out []rune, items []templateItem, input []rune, caps []int) []rune {
+	for _, item := range items {
+		if item.group < 0 {
+			out = append(out, item.literal...)
+		} else if start, end := caps[2*item.group], caps[2*item.group+1]; end >= 0 {
+			out = append(out, input[start:end]...)
+		}
+	}
+	return out
+}
@@ -0,0 +1,290 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+import re
+from libtest import assertRaises, assertRaisesText
+
+doc = "match and search"
+m = re.match(r"(\w+) (\w+)", "hello world foo")
+assert m.group() == "hello world"
+assert m.group(0, 1, 2) == ("hello world", "hello", "world")
+assert m.span() == (0, 11)
+assert m.start(2) == 6 and m.end(2) == 11
+assert m[1] == "hello"
+assert re.match("world", "hello world") is None
+assert re.search("world", "hello world").span() == (6, 11)
+assert re.search("x", "hello world") is None
+assert re.fullmatch("a|ab", "ab").group() == "ab"
+assert re.fullmatch("a", "ab") is None
+assert repr(re.match("ab", "abc")) == "<re.Match object; span=(0, 2), match='ab'>"
+
+doc = "pos and endpos"
+p = re.compile(r"\d+")
+assert p.search("ab12cd34", 4).group() == "34"
+assert p.search("ab12cd34", 0, 3).group() == "1"
+assert p.match("12", 1).group() == "2"
+assert p.fullmatch("123", 0, 2).group() == "12"
+assert p.search("12", 5) is None
+m = p.search("ab12", 1, 10)
+assert m.pos == 1 and m.endpos == 4 and m.string == "ab12" and m.re is p
+assert re.compile("^a").search("ba", 1) is None
+assert re.compile("a$").search("ab", 0, 1).span() == (0, 1)
+
+doc = "groups"
+m = re.match(r"(?P<first>\w+) (?P<last>\w+)?", "Jane ")
+assert m.groups() == ("Jane", None)
+assert m.groups("") == ("Jane", "")
+assert m.groupdict() == {"first": "Jane", "last": None}
+assert m.groupdict("x") == {"first": "Jane", "last": "x"}
+assert m.group("first") == "Jane"
+assert m["first"] == "Jane"
+assert m.span(2) == (-1, -1)
+assert m.lastindex == 1 and m.lastgroup == "first"
+assert m.regs == ((0, 5), (0, 4), (-1, -1))
+assertRaisesText(IndexError, "no such group", m.group, 3)
+assertRaisesText(IndexError, "no such group", m.group, "middle")
+assert re.match("(a)(b)?", "a").lastindex == 1
+assert re.match("((a)b)", "ab").lastindex == 1
+assert re.match("a", "a").lastindex is None
+p = re.compile(r"(?P<x>a)(b)")
+assert p.groups == 2
+assert p.groupindex == {"x": 1}
+assert re.match(r"(?:a)(b)", "ab").groups() == ("b",)
+
+doc = "repeats"
+assert re.match("a*", "aaab").group() == "aaa"
+assert re.match("a*?", "aaab").group() == ""
+assert re.match("a+?", "aaab").group() == "a"
+assert re.match("a??b", "ab").group() == "ab"
+assert re.match("a{2}", "aaa").group() == "aa"
+assert re.match("a{2,}", "aaaa").group() == "aaaa"
+assert re.match("a{,2}", "aaa").group() == "aa"
+assert re.match("a{1,2}?", "aaa").group() == "a"
+assert re.match("a{", "a{").group() == "a{"
+assert re.match("a{1,x}", "a{1,x}").group() == "a{1,x}"
+assert re.match("(ab)+", "ababa").group() == "abab"
+assert re.match("(ab)+", "ababa").group(1) == "ab"
+assert re.match("(a|b)*?c", "ababc").group(1) == "b"
+assert re.match("(a?)*", "b").group(1) == ""
+assert re.match("(?:a|ab)*c", "abac").group() == "abac"
+assert re.search(r"(.*)x", "a" * 3000 + "x").end() == 3001
+assert re.match("(ab)*", "ab" * 5000).end() == 10000
+
+doc = "sets and classes"
+assert re.match(r"[\d-]+", "1-2").group() == "1-2"
+assert re.match(r"[]a]+", "]a]").group() == "]a]"
+assert re.match(r"[^]]+", "ab]").group() == "ab"
+assert re.match(r"[a-c]+", "abcd").group() == "abc"
+assert re.match(r"[^a-c]+", "xyza").group() == "xyz"
+assert re.match(r"[\w.]+", "a.b c").group() == "a.b"
+assert re.match(r"[\b]", "\b") is not None
+assert re.findall(r"\d", "1a٣") == ["1", "٣"]
+assert re.findall(r"\d", "1a٣", re.ASCII) == ["1"]
+assert re.findall(r"\w+", "à été") == ["à", "été"]
+assert re.findall(r"\w+", "à été", re.A) == ["t"]
+assert re.findall(r"\s", "a b\tc d") == [" ", "\t", " "]
+assert re.findall(r"\s", "a b\tc d", re.A) == [" ", "\t"]
+assert re.findall(r"\S+", "a b") == ["a", "b"]
+assert re.findall(r"\W", "a-b") == ["-"]
+assert re.findall(r"\D+", "ab1cd") == ["ab", "cd"]
+
+doc = "escapes"
+assert re.match(r"\x41\101é\U0001F600\0\n\t", "AAé😀\0\n\t") is not None
+assert re.match(r"\.\*\\", ".*\\") is not None
+assert re.match(r"(a)\1", "aa").group() == "aa"
+assert re.match(r"(a)\1", "ab") is None
+assert re.match(r"(?P<x>a)(?P=x)", "aa").group() == "aa"
+assert re.match(r"(a)|\1", "x") is None
+
+doc = "anchors"
+assert re.match(r"^$", "") is not None
+assert re.search("$", "a\n").span() == (1, 1)
+assert re.findall("$", "a\nb\n", re.M) == ["", "", ""]
+assert re.findall(r"^\w+$", "ab\ncd", re.M) == ["ab", "cd"]
+assert re.findall(r"^\w+$", "ab\ncd") == []
+assert re.search(r"\Aa", "ba") is None
+assert re.search(r"a\Z", "a\n") is None
+assert re.search(r"a\Z", "ba").span() == (1, 2)
+assert re.findall(r"\bo\w+", "one two oops") == ["one", "oops"]
+assert re.findall(r"\Bo", "one two oops") == ["o", "o"]
+assert re.search(r"\b", "") is None
+
+doc = "look arounds"
+assert re.search(r"(?<=abc)def", "abcdef").span() == (3, 6)
+assert re.search(r"(?<!abc)def", "abcdef") is None
+assert re.search(r"(?<!abc)def", "abxdef").span() == (3, 6)
+assert re.search(r"\w+(?=!)", "hi there!").group() == "there"
+assert re.search(r"a(?!b)", "abac").span() == (2, 3)
+assert re.match(r"(?=(a+))a", "aaa").group(1) == "aaa"
+assert re.findall(r"(?<=[$])\d+", "$12 and 34") == ["12"]
+assert re.search(r"(?<=\bx)y", "xy").span() == (1, 2)
+
+doc = "conditionals"
+p = re.compile(r"(<)?(\w+)(?(1)>|$)")
+assert p.match("<user>").group(2) == "user"
+assert p.match("user").group(2) == "user"
+assert p.match("<user") is None
+assert re.match(r"(?P<q>')?x(?(q)')", "'x'").group() == "'x'"
+assert re.match(r"(a)?(?(1)b|c)", "c").group() == "c"
+
+doc = "flags"
+assert re.match("hello", "HeLLo", re.I) is not None
+assert re.match("(?i)hello", "HeLLo") is not None
+assert re.match("a(?i)b", "aB") is not None
+assert re.match("(?i:a)b", "Ab") is not None
+assert re.match("(?i:a)b", "AB") is None
+assert re.match("(?i)a(?-i:b)", "Ab") is not None
+assert re.match("(?i)a(?-i:b)", "AB") is None
+assert re.match("[a-z]+", "ABC", re.I).group() == "ABC"
+assert re.match("(?i)k", "K") is not None
+assert re.match("(?ia)k", "K") is None
+assert re.match("(?i)é", "É") is not None
+assert re.match(r"(a)\1", "aA", re.I) is not None
+assert re.match(".", "\n") is None
+assert re.match(".", "\n", re.S) is not None
+assert re.match("(?s).", "\n") is not None
+assert re.match(r"""
+    \d+   # the number
+    \s*   # spaces
+    [ ]x  # a space in a set is kept
+""", "12  x", re.X).group() == "12  x"
+assert re.match(r"(?x) a b \# c", "ab#c").group() == "ab#c"
+assert re.compile("a", re.I | re.M).flags == re.I | re.M | re.U
+assert re.compile("a", re.A).flags == re.A
+assert re.compile(b"a").flags == 0
+assert re.compile("(?i)a").flags == re.I | re.U
+assert re.IGNORECASE == 2 and re.MULTILINE == 8 and re.DOTALL == 16 and re.VERBOSE == 64 and re.ASCII == 256
+assert repr(re.compile("abc")) == "re.compile('abc')"
+assert repr(re.compile("abc", re.I | re.M)) == "re.compile('abc', re.IGNORECASE|re.MULTILINE)"
+assertRaisesText(ValueError, "cannot use LOCALE flag with a str pattern", re.compile, "a", re.L)
+assertRaisesText(ValueError, "cannot use UNICODE flag with a bytes pattern", re.compile, b"a", re.U)
+assertRaisesText(ValueError, "ASCII and UNICODE flags are incompatible", re.compile, "a", re.A | re.U)
+assertRaisesText(ValueError, "cannot process flags argument with a compiled pattern", re.compile, re.compile("a"), re.I)
+
+doc = "findall and finditer"
+assert re.findall(r"\d+", "a1b22c333") == ["1", "22", "333"]
+assert re.findall(r"(\w)(\d)", "a1b2") == [("a", "1"), ("b", "2")]
+assert re.findall(r"(\w)=(\d)?", "a=1b=") == [("a", "1"), ("b", "")]
+assert re.findall(r"a(b)?", "aab") == ["", "b"]
+assert re.findall("", "ab") == ["", "", ""]
+assert re.findall("x*", "axx") == ["", "xx", ""]
+assert re.compile("a").findall("aaaa", 1, 3) == ["a", "a"]
+spans = []
+for m in re.finditer(r"\w+", "hi there"):
+    spans.append(m.span())
+assert spans == [(0, 2), (3, 8)]
+it = re.compile("a").finditer("bab", 1)
+m = next(it)
+assert m.span() == (1, 2) and m.pos == 1
+assertRaises(StopIteration, next, it)
+
+doc = "sub"
+assert re.sub("x*", "-", "abxd") == "-a-b--d-"
+assert re.sub(r"(\w+)@(\w+)", r"\2 at \g<1>", "me@here you@there") == "here at me there at you"
+assert re.sub(r"(?P<name>\w)", r"<\g<name>>", "ab") == "<a><b>"
+assert re.sub("a", r"\g<0>\g<0>", "bab") == "baab"
+assert re.sub(r"\d", lambda m: str(int(m.group()) * 2), "a1b2c3") == "a2b4c6"
+assert re.sub("a", lambda m: None, "bab") == "bb"
+assert re.sub("(b)|c", r"[\1]", "abc") == "a[b][]"
+assert re.sub("a", r"\n\t\\", "a") == "\n\t\\"
+assert re.sub("a", r"x\.", "a") == "x\\."
+assert re.sub("a", "b", "aaa", 2) == "bba"
+assert re.sub("a", "b", "aaa", count=1) == "baa"
+assert re.subn("a", "b", "aaa") == ("bbb", 3)
+assert re.subn("a", "b", "aaa", 2) == ("bba", 2)
+assert re.compile("o").sub("0", "foo") == "f00"
+assert re.compile("o").subn("0", "foo", 1) == ("f0o", 1)
+assertRaisesText(re.error, "invalid group reference 2", re.sub, "(a)", r"\2", "a")
+assertRaisesText(re.error, "bad escape \\q", re.sub, "a", r"\q", "a")
+assertRaisesText(re.error, "missing >, unterminated name", re.sub, "(a)", r"\g<1", "a")
+assertRaisesText(IndexError, "unknown group name 'x'", re.sub, "(a)", r"\g<x>", "a")
+assertRaisesText(TypeError, "expected str instance, int found", re.sub, "a", lambda m: 1, "a")
+
+doc = "expand"
+m = re.match(r"(?P<a>\w) (\w)", "x y")
+assert m.expand(r"\2-\g<a>") == "y-x"
+
+doc = "split"
+assert re.split(r"\s+", "a b  c") == ["a", "b", "c"]
+assert re.split(r"(,)", "a,b,c") == ["a", ",", "b", ",", "c"]
+assert re.split(r"(,)", "a,b,c", maxsplit=1) == ["a", ",", "b,c"]
+assert re.split(r"(,)|;", "a,b;c") == ["a", ",", "b", None, "c"]
+assert re.split(r"\b", "a b") == ["", "a", " ", "b", ""]
+assert re.split("x*", "ab") == ["", "a", "b", ""]
+assert re.split("x", "axbxc", -1) == ["axbxc"]
+assert re.compile(",").split("a,b,c", 1) == ["a", "b,c"]
+
+doc = "bytes"
+m = re.search(rb"\d+", b"ab123")
+assert m.group() == b"123" and m.span() == (2, 5)
+assert re.findall(rb"\w+", b"ab cd") == [b"ab", b"cd"]
+assert re.sub(rb"b", rb"[\g<0>]", b"abc") == b"a[b]c"
+assert re.split(rb",", b"a,b") == [b"a", b"b"]
+assert re.match(rb"(?i)A", b"a") is not None
+assertRaisesText(TypeError, "cannot use a string pattern on a bytes-like object", re.match, "a", b"a")
+assertRaisesText(TypeError, "cannot use a bytes pattern on a string-like object", re.match, b"a", "a")
+assertRaisesText(TypeError, "expected string or bytes-like object", re.match, "a", 1)
+
+doc = "escape"
+assert re.escape("a.b*c") == "a\\.b\\*c"
+assert re.escape("a_b c-1é") == "a_b\\ c\\-1é"
+assert re.escape(b"a.b") == b"a\\.b"
+assert re.match(re.escape("(a+b)"), "(a+b)") is not None
+
+doc = "compile and purge"
+p = re.compile("a+")
+assert re.compile(p) is p
+assert re.compile("a+") == p
+assert re.compile("a+") != re.compile("a+", re.I)
+assert p.pattern == "a+"
+assert re.match(p, "aa").group() == "aa"
+assert re.purge() is None
+assertRaisesText(TypeError, "first argument must be string or compiled pattern", re.compile, 1)
+
+doc = "errors"
+def error(pattern, msg, pos):
+    try:
+        re.compile(pattern)
+    except re.error as e:
+        assert e.msg == msg, (pattern, e.msg)
+        assert e.pos == pos, (pattern, e.pos)
+        if pos is None:
+            assert e.args[0] == msg, e.args[0]
+        else:
+            assert e.pattern == pattern
+            assert e.args[0] == "%s at position %d" % (msg, pos), e.args[0]
+    else:
+        assert False, "no error for %r" % pattern
+error("a**", "multiple repeat", 2)
+error("*a", "nothing to repeat", 0)
+error("^*", "nothing to repeat", 1)
+error("(?<=a+)b", "look-behind requires fixed-width pattern", None)
+error("[z-a]", "bad character range z-a", 1)
+error(r"[\d-z]", "bad character range \\d-z", 1)
+error(r"\q", "bad escape \\q", 0)
+error("\\", "bad escape (end of pattern)", 0)
+error("(?P<1>a)", "bad character in group name '1'", 4)
+error("(?P<a>x)(?P<a>y)", "redefinition of group name 'a' as group 2; was group 1", 12)
+error("(?P=x)", "unknown group name 'x'", 4)
+error("(?P<a", "missing >, unterminated name", 4)
+error("a)", "unbalanced parenthesis", 1)
+error("(a", "missing ), unterminated subpattern", 0)
+error("[abc", "unterminated character set", 0)
+error(r"(a)\2", "invalid group reference 2", 4)
+error(r"(a\1)", "cannot refer to an open group", 2)
+error("(?z)", "unknown extension ?z", 1)
+error(r"\x4", "incomplete escape \\x4", 0)
+error("x{3,2}", "min repeat greater than max repeat", 2)
+error("(?#abc", "missing ), unterminated comment", 0)
+error("(?(2)a|b)", "invalid group reference 2", 3)
+error("(?(1)a|b|c)", "conditional backref with more than two branches", 8)
+error(r"\777", "octal escape value \\777 outside of range 0-0o377", 0)
+try:
+    re.compile("a\n(")
+except re.error as e:
+    assert e.lineno == 2 and e.colno == 1
+    assert e.args[0] == "missing ), unterminated subpattern at position 2 (line 2, column 1)"
+assertRaises(Exception, re.compile, "(")
+
+doc = "finished"
@@ -120,9 +120,20 @@ func (rl *readline) Print(out string) {
 
 // RunREPL starts the REPL loop
 func RunREPL() {
-	repl := repl.New()
-	rl := newReadline(repl)
-	repl.SetUI(rl)
+	RunREPLWithModule(nil)
+}
+
+// RunREPLWithModule starts the REPL loop running the input in module,
+// or a new __main__ module if module is nil
+func RunREPLWithModule(module *py.Module) {
+	var r *repl.REPL
+	if module == nil {
+		r = repl.New()
+	} else {
+		r = repl.NewWithModule(module)
+	}
+	rl := newReadline(r)
+	r.SetUI(rl)
 	defer rl.Close()
 	err := rl.ReadHistory()
 	if err != nil {
@@ -6,12 +6,12 @@
 package repl
 
 import (
-	"fmt"
 	"sort"
 	"strings"
 
 	"github.com/go-python/gpython/compile"
 	"github.com/go-python/gpython/py"
+	pysys "github.com/go-python/gpython/sys"
 	"github.com/go-python/gpython/vm"
 )
 
@@ -23,11 +23,13 @@ const (
 
 // Repl state
 type REPL struct {
+	ctx          *py.Context
 	module       *py.Module
 	prog         string
 	continuation bool
 	previous     string
 	term         UI
+	displayhook  *py.Method
 }
 
 // UI implements the user interface for the REPL
@@ -41,16 +43,44 @@ type UI interface {
 
 // New create a new REPL and initialises the state machine
 func New() *REPL {
+	module := py.DefaultContext.NewModule("__main__", "", nil, nil)
+	module.Globals["__file__"] = py.String("<stdin>")
+	return NewWithModule(module)
+}
+
+// NewWithModule creates a new REPL which runs its input in module
+//
+// This is used to carry on in the __main__ module of a script which
+// has been run with "gpython -i".
+func NewWithModule(module *py.Module) *REPL {
 	r := &REPL{
-		module:       py.NewModule("__main__", "", nil, nil),
+		ctx:          py.GlobalsContext(module.Globals),
+		module:       module,
 		prog:         "<stdin>",
 		continuation: false,
 		previous:     "",
 	}
-	r.module.Globals["__file__"] = py.String(r.prog)
+	r.displayhook = py.MustNewMethod("displayhook", r.displayHook, 0, "Print an object to the REPL and also save it in builtins._")
 	return r
 }
 
+// displayHook is used in place of the default sys.displayhook to
+// send the output of expressions to the UI
+func (r *REPL) displayHook(self, o py.Object) (py.Object, error) {
+	if o == py.None {
+		return py.None, nil
+	}
+	builtins := r.ctx.Builtins().Globals
+	builtins["_"] = py.None
+	repr, err := py.ReprAsString(o)
+	if err != nil {
+		return nil, err
+	}
+	r.term.Print(repr)
+	builtins["_"] = o
+	return py.None, nil
+}
+
 // SetUI initialises the output user interface
 func (r *REPL) SetUI(term UI) {
 	r.term = term
@@ -59,12 +89,17 @@ func (r *REPL) SetUI(term UI) {
 
 // Run runs a single line of the REPL
 func (r *REPL) Run(line string) {
-	// Override the PrintExpr output temporarily
-	oldPrintExpr := vm.PrintExpr
-	vm.PrintExpr = r.term.Print
-	defer func() {
-		vm.PrintExpr = oldPrintExpr
-	}()
+	// Override the default sys.displayhook temporarily so the
+	// output goes to the UI, leaving any user supplied hook alone
+	sys := r.ctx.MustGetModule("sys")
+	if sys.Globals["displayhook"] == sys.Globals["__displayhook__"] {
+		sys.Globals["displayhook"] = r.displayhook
+		defer func() {
+			if sys.Globals["displayhook"] == r.displayhook {
+				sys.Globals["displayhook"] = sys.Globals["__displayhook__"]
+			}
+		}()
+	}
 	if r.continuation {
 		if line != "" {
 			r.previous += string(line) + "\n"
@@ -92,13 +127,13 @@ func (r *REPL) Run(line string) {
 	r.term.SetPrompt(NormalPrompt)
 	r.previous = ""
 	if err != nil {
-		r.term.Print(fmt.Sprintf("Compile error: %v", err))
+		r.term.Print(err.Error())
 		return
 	}
 	code := obj.(*py.Code)
 	_, err = vm.Run(r.module.Globals, r.module.Globals, code, nil)
 	if err != nil {
-		py.TracebackDump(err)
+		pysys.ExceptHook(r.ctx, err)
 	}
 }
 
@@ -126,7 +161,7 @@ func (r *REPL) Completer(line string, pos int) (head string, completions []strin
 		}
 	}
 	match(r.module.Globals)
-	match(py.Builtins.Globals)
+	match(r.ctx.Builtins().Globals)
 	sort.Strings(completions)
 	return head, completions, tail
 }
@@ -8,8 +8,12 @@ import (
 	// import required modules
 	_ "github.com/go-python/gpython/builtin"
 	_ "github.com/go-python/gpython/math"
+	_ "github.com/go-python/gpython/pdb"
 	_ "github.com/go-python/gpython/sys"
 	_ "github.com/go-python/gpython/time"
+	_ "github.com/go-python/gpython/tokenize"
+	_ "github.com/go-python/gpython/unicodedata"
+	_ "github.com/go-python/gpython/zipimport"
 )
 
 type replTest struct {
@@ -65,8 +69,20 @@ func TestREPL(t *testing.T) {
 	r.Run("sum")
 	rt.assert(t, "multi#5", NormalPrompt, "45")
 
+	r.Run("_")
+	rt.assert(t, "underscore", NormalPrompt, "45")
+
+	r.Run("import sys; sys.displayhook = lambda x: None")
+	rt.assert(t, "displayhook#1", NormalPrompt, "")
+	r.Run("1+2")
+	rt.assert(t, "displayhook#2", NormalPrompt, "")
+	r.Run("sys.displayhook = sys.__displayhook__")
+	rt.assert(t, "displayhook#3", NormalPrompt, "")
+	r.Run("1+2")
+	rt.assert(t, "displayhook#4", NormalPrompt, "3")
+
 	r.Run("if")
-	rt.assert(t, "compileError", NormalPrompt, "Compile error: \n  File \"<string>\", line 1, offset 2\n    if\n\n\nSyntaxError: 'invalid syntax'")
+	rt.assert(t, "compileError", NormalPrompt, "  File \"<stdin>\", line 1\n    if\n      ^\nSyntaxError: invalid syntax")
 }
 
 func TestCompleter(t *testing.T) {
@@ -15,11 +15,28 @@ import (
 	"github.com/gopherjs/gopherwasm/js" // gopherjs to wasm converter shim
 
 	// import required modules
+	_ "github.com/go-python/gpython/asyncio"
 	_ "github.com/go-python/gpython/builtin"
+	_ "github.com/go-python/gpython/bisect"
+	_ "github.com/go-python/gpython/collections"
+	_ "github.com/go-python/gpython/copy"
+	_ "github.com/go-python/gpython/dis"
+	_ "github.com/go-python/gpython/functools"
+	_ "github.com/go-python/gpython/heapq"
+	_ "github.com/go-python/gpython/itertools"
+	_ "github.com/go-python/gpython/json"
 	_ "github.com/go-python/gpython/math"
+	_ "github.com/go-python/gpython/operator"
+	_ "github.com/go-python/gpython/os"
+	_ "github.com/go-python/gpython/pdb"
+	_ "github.com/go-python/gpython/random"
+	_ "github.com/go-python/gpython/re"
 	"github.com/go-python/gpython/repl"
 	_ "github.com/go-python/gpython/sys"
 	_ "github.com/go-python/gpython/time"
+	_ "github.com/go-python/gpython/tokenize"
+	_ "github.com/go-python/gpython/unicodedata"
+	_ "github.com/go-python/gpython/zipimport"
 )
 
 // Implement the replUI interface
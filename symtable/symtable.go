@@ -108,16 +108,22 @@ type SymTable struct {
 	Free              bool      // true if block has free variables
 	ChildFree         bool      // true if a child block has free vars, including free refs to globals
 	Generator         bool      // true if namespace is a generator
+	Coroutine         bool      // true if namespace is a coroutine
 	Varargs           bool      // true if block has varargs
 	Varkeywords       bool      // true if block has varkeywords
 	ReturnsValue      bool      // true if namespace uses return with an argument
 	NeedsClassClosure bool      // for class scopes, true if a closure over __class__ should be created
+	Comprehension     bool      // true if block is a comprehension
 	// col_offset        int       // offset of first line of block
 	// opt_lineno        int       // lineno of last exec or import *
 	// opt_col_offset    int       // offset of last exec or import *
 	TmpName int    // counter for listcomp temp vars
 	Private string // name of current class or ""
 
+	compIterExpr   int       // > 0 while parsing a comprehension iterable
+	compIterTarget bool      // true while parsing a comprehension target
+	compIterVars   StringSet // comprehension iteration variables
+
 	Symbols     Symbols
 	Global      *SymTable // symbol table entry for module
 	Parent      *SymTable
@@ -204,6 +210,9 @@ func (st *SymTable) GetScope(name string) Scope {
 func (st *SymTable) addArgumentsToSymbolTable(node *ast.Arguments) {
 	// skip default arguments inside function block
 	// XXX should ast be different?
+	for _, arg := range node.Posonlyargs {
+		st.AddDef(node, arg.Arg, DefParam)
+	}
 	for _, arg := range node.Args {
 		st.AddDef(node, arg.Arg, DefParam)
 	}
@@ -261,6 +270,9 @@ func (st *SymTable) Parse(Ast ast.Ast) {
 				st.AddDef(node, node.Id, DefUse)
 			} else {
 				st.AddDef(node, node.Id, DefLocal)
+				if st.compIterTarget {
+					st.compIterVars.Add(string(node.Id))
+				}
 			}
 			// Special-case super: it counts as a use of __class__
 			if node.Ctx == ast.Load && st.Type == FunctionBlock && node.Id == "super" {
@@ -291,6 +303,34 @@ func (st *SymTable) Parse(Ast ast.Ast) {
 				stNew.Parse(stmt)
 			}
 
+			// return false to stop the parse
+			return false
+		case *ast.AsyncFunctionDef:
+			// Add the function name to the SymTable
+			st.AddDef(node, node.Name, DefLocal)
+			name := string(node.Name)
+
+			// Walk these things in original symbol table
+			if node.Args != nil {
+				st.Parse(node.Args)
+			}
+			for _, expr := range node.DecoratorList {
+				st.Parse(expr)
+			}
+			st.Parse(node.Returns)
+
+			// Make a new symtable
+			stNew := newSymTableBlock(Ast, FunctionBlock, name, st)
+			stNew.Coroutine = true
+
+			// Add the arguments to the new symbol table
+			stNew.addArgumentsToSymbolTable(node.Args)
+
+			// Walk the Body in the new symtable
+			for _, stmt := range node.Body {
+				stNew.Parse(stmt)
+			}
+
 			// return false to stop the parse
 			return false
 		case *ast.ClassDef:
@@ -303,12 +343,6 @@ func (st *SymTable) Parse(Ast ast.Ast) {
 			for _, keyword := range node.Keywords {
 				st.Parse(keyword)
 			}
-			if node.Starargs != nil {
-				st.Parse(node.Starargs)
-			}
-			if node.Kwargs != nil {
-				st.Parse(node.Kwargs)
-			}
 			for _, expr := range node.DecoratorList {
 				st.Parse(expr)
 			}
@@ -350,6 +384,34 @@ func (st *SymTable) Parse(Ast ast.Ast) {
 		case *ast.GeneratorExp:
 			st.parseComprehension(Ast, "genexpr", node.Generators, node.Elt, nil)
 			return false
+		case *ast.AnnAssign:
+			if name, ok := node.Target.(*ast.Name); ok {
+				cur := st.Symbols[string(name.Id)]
+				if (cur.Flags&(DefGlobal|DefNonlocal)) != 0 && st != st.Global && node.Simple != 0 {
+					if (cur.Flags & DefGlobal) != 0 {
+						st.panicSyntaxErrorf(node, "annotated name '%s' can't be global", name.Id)
+					}
+					st.panicSyntaxErrorf(node, "annotated name '%s' can't be nonlocal", name.Id)
+				}
+				// A parenthesized name without a value is not bound
+				if node.Simple != 0 || node.Value != nil {
+					st.AddDef(name, name.Id, DefLocal)
+				}
+			} else {
+				st.Parse(node.Target)
+			}
+			st.Parse(node.Annotation)
+			if node.Value != nil {
+				st.Parse(node.Value)
+			}
+			return false
+		case *ast.NamedExpr:
+			if st.compIterExpr > 0 {
+				st.panicSyntaxErrorf(node, "assignment expression cannot be used in a comprehension iterable expression")
+			}
+			if name, ok := node.Target.(*ast.Name); ok && st.Comprehension {
+				st.extendNamedExprScope(name)
+			}
 		case *ast.ExceptHandler:
 			if node.Name != "" {
 				st.AddDef(node, node.Name, DefLocal)
@@ -380,12 +442,55 @@ func (st *SymTable) Parse(Ast ast.Ast) {
 				st.ReturnsValue = true
 			}
 		case *ast.Yield, *ast.YieldFrom:
+			if st.Coroutine {
+				st.panicSyntaxErrorf(node, "'yield' inside async function")
+			}
 			st.Generator = true
 		}
 		return true
 	})
 }
 
+// Binds the target of an assignment expression found in a
+// comprehension in the nearest enclosing scope which isn't a
+// comprehension.
+func (st *SymTable) extendNamedExprScope(target *ast.Name) {
+	name := string(target.Id)
+	for e := st; e != nil; e = e.Parent {
+		if e.Comprehension {
+			if e.compIterVars.Contains(name) {
+				st.panicSyntaxErrorf(target, "assignment expression cannot rebind comprehension iteration variable '%s'", name)
+			}
+			continue
+		}
+		switch e.Type {
+		case FunctionBlock:
+			st.AddDef(target, target.Id, DefNonlocal)
+			e.AddDef(target, target.Id, DefLocal)
+			return
+		case ModuleBlock:
+			st.AddDef(target, target.Id, DefGlobal)
+			return
+		case ClassBlock:
+			st.panicSyntaxErrorf(target, "assignment expression within a comprehension cannot be used in a class body")
+		}
+	}
+}
+
+// Parses the target of a comprehension noting the iteration variables
+func (st *SymTable) parseCompTarget(target ast.Expr) {
+	st.compIterTarget = true
+	st.Parse(target)
+	st.compIterTarget = false
+}
+
+// Parses the iterable of a comprehension
+func (st *SymTable) parseCompIter(iter ast.Expr) {
+	st.compIterExpr++
+	st.Parse(iter)
+	st.compIterExpr--
+}
+
 // make a new temporary name
 func (st *SymTable) newTmpName(node ast.Ast) {
 	st.TmpName++
@@ -398,10 +503,12 @@ func (st *SymTable) parseComprehension(Ast ast.Ast, scopeName string, generators
 	needsTmp := !isGenerator
 	outermost := generators[0]
 	// Outermost iterator is evaluated in current scope
-	st.Parse(outermost.Iter)
+	st.parseCompIter(outermost.Iter)
 	// Create comprehension scope for the rest
 	stNew := newSymTableBlock(Ast, FunctionBlock, scopeName, st)
 	stNew.Generator = isGenerator
+	stNew.Comprehension = true
+	stNew.compIterVars = make(StringSet)
 	// Outermost iter is received as an argument
 	id := ast.Identifier(fmt.Sprintf(".%d", 0))
 	stNew.AddDef(Ast, id, DefParam)
@@ -409,13 +516,13 @@ func (st *SymTable) parseComprehension(Ast ast.Ast, scopeName string, generators
 	if needsTmp {
 		stNew.newTmpName(Ast)
 	}
-	stNew.Parse(outermost.Target)
+	stNew.parseCompTarget(outermost.Target)
 	for _, expr := range outermost.Ifs {
 		stNew.Parse(expr)
 	}
 	for _, comprehension := range generators[1:] {
-		stNew.Parse(comprehension.Target)
-		stNew.Parse(comprehension.Iter)
+		stNew.parseCompTarget(comprehension.Target)
+		stNew.parseCompIter(comprehension.Iter)
 		for _, expr := range comprehension.Ifs {
 			stNew.Parse(expr)
 		}
@@ -228,3 +228,51 @@ func TestSymTableFind(t *testing.T) {
 		EqStrings(t, fmt.Sprintf("Scope %v, Flag %v", test.scope, test.flag), test.want, got)
 	}
 }
+
+func TestSymTableNamedExpr(t *testing.T) {
+	for _, test := range []struct {
+		in     string
+		path   []int // child indices of the table to check
+		name   string
+		scope  Scope
+		errMsg string
+	}{
+		{in: "[y := x for x in xs]", path: []int{0}, name: "y", scope: ScopeGlobalExplicit},
+		{in: "[y := x for x in xs]", path: nil, name: "y", scope: ScopeGlobalExplicit},
+		{in: "def f():\n [y := x for x in xs]\n", path: []int{0}, name: "y", scope: ScopeCell},
+		{in: "def f():\n [y := x for x in xs]\n", path: []int{0, 0}, name: "y", scope: ScopeFree},
+		{in: "def f():\n [[y := x for x in xs] for z in zs]\n", path: []int{0}, name: "y", scope: ScopeCell},
+		{in: "def f():\n [lambda: (y := x) for x in xs]\n", path: []int{0, 0, 0}, name: "y", scope: ScopeLocal},
+		{in: "def f():\n (y := 1)\n", path: []int{0}, name: "y", scope: ScopeLocal},
+		{in: "[x := 0 for x in xs]", errMsg: "assignment expression cannot rebind comprehension iteration variable 'x'"},
+		{in: "[[x := 0 for y in ys] for x in xs]", errMsg: "assignment expression cannot rebind comprehension iteration variable 'x'"},
+		{in: "[x for x in (y := xs)]", errMsg: "assignment expression cannot be used in a comprehension iterable expression"},
+		{in: "[x for z in zs for x in (y := z)]", errMsg: "assignment expression cannot be used in a comprehension iterable expression"},
+		{in: "class A:\n [(y := x) for x in xs]\n", errMsg: "assignment expression within a comprehension cannot be used in a class body"},
+		{in: "def f():\n global x\n x: int\n", errMsg: "annotated name 'x' can't be global"},
+		{in: "def f():\n x = 1\n def g():\n  nonlocal x\n  x: int = 1\n", errMsg: "annotated name 'x' can't be nonlocal"},
+	} {
+		Ast, err := parser.ParseString(test.in, "exec")
+		if err != nil {
+			t.Fatalf("%q: parse failed: %v", test.in, err)
+		}
+		st, err := NewSymTable(Ast, "<string>")
+		if test.errMsg != "" {
+			if err == nil {
+				t.Errorf("%q: expecting SyntaxError %q", test.in, test.errMsg)
+				continue
+			}
+			exc := err.(*py.Exception)
+			EqString(t, test.in, test.errMsg, string(exc.Args.(py.Tuple)[0].(py.String)))
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", test.in, err)
+			continue
+		}
+		for _, i := range test.path {
+			st = st.Children[i]
+		}
+		EqScope(t, fmt.Sprintf("%q %v %s", test.in, test.path, test.name), test.scope, st.GetScope(test.name))
+	}
+}
@@ -18,8 +18,13 @@
 package sys
 
 import (
+	"fmt"
+	goio "io"
+	"io/ioutil"
 	"os"
+	"strings"
 
+	"github.com/go-python/gpython/io"
 	"github.com/go-python/gpython/py"
 )
 
@@ -53,8 +58,10 @@ last_traceback -- traceback of last uncaught exception
 
 builtin_module_names -- tuple of module names built into this interpreter
 copyright -- copyright notice pertaining to this interpreter
+dont_write_bytecode -- True if -B was given on the command line
 exec_prefix -- prefix used to find the machine-specific Python library
 executable -- absolute path of the executable binary of the Python interpreter
+flags -- a struct sequence with the command line flags
 float_info -- a struct sequence with information about the float implementation.
 float_repr_style -- string indicating the style of repr() output for floats
 hexversion -- version information encoded as a single integer
@@ -67,14 +74,17 @@ prefix -- prefix used to find the Python library
 thread_info -- a struct sequence with information about the thread implementation.
 version -- the version of this interpreter as a string
 version_info -- version information as a named tuple
+warnoptions -- list of the -W options given on the command line
 __stdin__ -- the original stdin; don't touch!
 __stdout__ -- the original stdout; don't touch!
 __stderr__ -- the original stderr; don't touch!
 __displayhook__ -- the original displayhook; don't touch!
 __excepthook__ -- the original excepthook; don't touch!
+__breakpointhook__ -- the original breakpointhook; don't touch!
 
 Functions:
 
+breakpointhook() -- called by breakpoint() to enter the debugger
 displayhook() -- print an object to the screen, and save it in builtins._
 excepthook() -- print an exception and its traceback to sys.stderr
 exc_info() -- return thread-safe information about the current exception
@@ -97,7 +107,74 @@ const displayhook_doc = `displayhook(object) -> None
 Print an object to sys.stdout and also save it in builtins._`
 
 func sys_displayhook(self, o py.Object) (py.Object, error) {
-	return nil, py.NotImplementedError
+	// Print value except if None
+	// After printing, also assign to '_'
+	// Before, set '_' to None to avoid recursion
+	if o == py.None {
+		return py.None, nil
+	}
+	ctx := py.GetContext(self)
+	builtins := ctx.Builtins().Globals
+	builtins["_"] = py.None
+	repr, err := py.ReprAsString(o)
+	if err != nil {
+		return nil, err
+	}
+	err = writeString(ctx, "stdout", repr+"\n")
+	if err != nil {
+		return nil, err
+	}
+	builtins["_"] = o
+	return py.None, nil
+}
+
+// Writes out to the sys stream of ctx called name
+func writeString(ctx *py.Context, name string, out string) error {
+	file, ok := ctx.MustGetModule("sys").Globals[name]
+	if !ok || file == py.None {
+		return py.ExceptionNewf(py.RuntimeError, "lost sys.%s", name)
+	}
+	write, err := py.GetAttrString(file, "write")
+	if err != nil {
+		return err
+	}
+	_, err = py.Call(write, py.Tuple{py.String(out)}, nil)
+	return err
+}
+
+const breakpointhook_doc = `breakpointhook(*args, **kws)
+
+This hook function is called by built-in breakpoint().`
+
+// Calls the function named by $PYTHONBREAKPOINT, pdb.set_trace by
+// default, or does nothing if it is set to "0"
+func sys_breakpointhook(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	hookName := ""
+	if flags.IgnoreEnvironment == 0 {
+		hookName = os.Getenv("PYTHONBREAKPOINT")
+	}
+	if hookName == "0" {
+		return py.None, nil
+	}
+	if hookName == "" {
+		hookName = "pdb.set_trace"
+	}
+	modName, funcName := "builtins", hookName
+	if i := strings.LastIndex(hookName, "."); i >= 0 {
+		modName, funcName = hookName[:i], hookName[i+1:]
+	}
+	ctx := py.GetContext(self)
+	module, err := ctx.Import(modName)
+	if err != nil {
+		fmt.Fprintf(goStderr(ctx), "RuntimeWarning: Ignoring unimportable $PYTHONBREAKPOINT: %q\n", hookName)
+		return py.None, nil
+	}
+	hook, err := py.GetAttrString(module, funcName)
+	if err != nil {
+		fmt.Fprintf(goStderr(ctx), "RuntimeWarning: Ignoring unimportable $PYTHONBREAKPOINT: %q\n", hookName)
+		return py.None, nil
+	}
+	return py.Call(hook, args, kwargs)
 }
 
 const excepthook_doc = `excepthook(exctype, value, traceback) -> None
@@ -105,7 +182,53 @@ const excepthook_doc = `excepthook(exctype, value, traceback) -> None
 Handle an exception by displaying it with a traceback on sys.stderr.`
 
 func sys_excepthook(self py.Object, args py.Tuple) (py.Object, error) {
-	return nil, py.NotImplementedError
+	var exctype, value, traceback py.Object
+	err := py.UnpackTuple(args, nil, "excepthook", 3, 3, &exctype, &value, &traceback)
+	if err != nil {
+		return nil, err
+	}
+	exc := py.ExceptionInfo{Value: value}
+	exc.Type, _ = exctype.(*py.Type)
+	exc.Traceback, _ = traceback.(*py.Traceback)
+	var out strings.Builder
+	exc.TracebackDump(&out)
+	err = writeString(py.GetContext(self), "stderr", out.String())
+	if err != nil {
+		return nil, err
+	}
+	return py.None, nil
+}
+
+// ExceptHook reports an uncaught exception from code run in ctx by
+// passing it to its sys.excepthook.
+//
+// If sys.excepthook is missing or raises an exception itself then
+// both exceptions are dumped to the Go stream the context's
+// sys.stderr is bound to instead.
+func ExceptHook(ctx *py.Context, err error) {
+	exc, ok := err.(py.ExceptionInfo)
+	if !ok {
+		value := py.MakeException(err)
+		exc = py.ExceptionInfo{Type: value.Type(), Value: value}
+	}
+	var traceback py.Object = py.None
+	if exc.Traceback != nil {
+		traceback = exc.Traceback
+	}
+	var hookErr error
+	hook, ok := ctx.MustGetModule("sys").Globals["excepthook"]
+	if ok {
+		_, hookErr = py.Call(hook, py.Tuple{exc.Type, exc.Value, traceback}, nil)
+	} else {
+		hookErr = py.ExceptionNewf(py.RuntimeError, "lost sys.excepthook")
+	}
+	if hookErr != nil {
+		w := goStderr(ctx)
+		fmt.Fprintf(w, "Error in sys.excepthook:\n")
+		py.TracebackDumpTo(w, hookErr)
+		fmt.Fprintf(w, "\nOriginal exception was:\n")
+		py.TracebackDumpTo(w, exc)
+	}
 }
 
 const exc_info_doc = `exc_info() -> (type, value, traceback)
@@ -114,7 +237,15 @@ Return information about the most recent exception caught by an except
 clause in the current stack frame or in an older stack frame.`
 
 func sys_exc_info(self py.Object) (py.Object, error) {
-	return nil, py.NotImplementedError
+	exc := py.VmExcInfo(py.GetContext(self))
+	if !exc.IsSet() {
+		return py.Tuple{py.None, py.None, py.None}, nil
+	}
+	var traceback py.Object = py.None
+	if exc.Traceback != nil {
+		traceback = exc.Traceback
+	}
+	return py.Tuple{exc.Type, exc.Value, traceback}, nil
 }
 
 const exit_doc = `exit([status])
@@ -132,7 +263,11 @@ func sys_exit(self py.Object, args py.Tuple) (py.Object, error) {
 		return nil, err
 	}
 	// Raise SystemExit so callers may catch it or clean up.
-	return py.ExceptionNew(py.SystemExit, args, nil)
+	exc, err := py.ExceptionNew(py.SystemExit, args, nil)
+	if err != nil {
+		return nil, err
+	}
+	return nil, exc.(*py.Exception)
 }
 
 const getdefaultencoding_doc = `getdefaultencoding() -> string
@@ -543,65 +678,175 @@ const flags__doc__ = `sys.flags
 
 Flags provided through command line arguments or environment vars.`
 
-//  PyTypeObject FlagsType;
-
-//  PyStructSequence_Field flags_fields[] = {
-//     {"debug",                   "-d"},
-//     {"inspect",                 "-i"},
-//     {"interactive",             "-i"},
-//     {"optimize",                "-O or -OO"},
-//     {"dont_write_bytecode",     "-B"},
-//     {"no_user_site",            "-s"},
-//     {"no_site",                 "-S"},
-//     {"ignore_environment",      "-E"},
-//     {"verbose",                 "-v"},
-//     /* {"unbuffered",                   "-u"}, */
-//     /* {"skip_first",                   "-x"}, */
-//     {"bytes_warning",           "-b"},
-//     {"quiet",                   "-q"},
-//     {"hash_randomization",      "-R"},
-//     {0}
-// };
+// Flags holds the command line options which are visible in sys.flags
+type Flags struct {
+	Debug             int // -d
+	Inspect           int // -i
+	Interactive       int // -i
+	Optimize          int // -O or -OO
+	DontWriteBytecode int // -B
+	NoUserSite        int // -s
+	NoSite            int // -S
+	IgnoreEnvironment int // -E
+	Verbose           int // -v
+	BytesWarning      int // -b
+	Quiet             int // -q
+	HashRandomization int // -R
+}
 
-//  PyStructSequence_Desc flags_desc = {
-//     "sys.flags",        /* name */
-//     flags__doc__,       /* doc */
-//     flags_fields,       /* fields */
-// };
+// Names of the fields of sys.flags in order
+var flagsFields = []string{
+	"debug",
+	"inspect",
+	"interactive",
+	"optimize",
+	"dont_write_bytecode",
+	"no_user_site",
+	"no_site",
+	"ignore_environment",
+	"verbose",
+	"bytes_warning",
+	"quiet",
+	"hash_randomization",
+}
 
-//  PyObject*
-// make_flags(void) {
-//     int pos = 0;
-//     py.Object seq;
+// The flags set with SetFlags
+var flags Flags
 
-//     seq = PyStructSequence_New(&FlagsType);
-//     if (seq == nil) {
-//         return nil;
-//     }
+// FlagsObject is the type of sys.flags
+//
+// It behaves like a tuple of ints with the fields also available as
+// attributes.
+type FlagsObject struct {
+	py.Tuple
+}
 
-// #define SetFlag(flag) \
-//     PyStructSequence_SET_ITEM(seq, pos++, PyLong_FromLong(flag))
-
-//     SetFlag(Py_DebugFlag);
-//     SetFlag(Py_InspectFlag);
-//     SetFlag(Py_InteractiveFlag);
-//     SetFlag(Py_OptimizeFlag);
-//     SetFlag(Py_DontWriteBytecodeFlag);
-//     SetFlag(Py_NoUserSiteDirectory);
-//     SetFlag(Py_NoSiteFlag);
-//     SetFlag(Py_IgnoreEnvironmentFlag);
-//     SetFlag(Py_VerboseFlag);
-//     /* SetFlag(saw_unbuffered_flag); */
-//     /* SetFlag(skipfirstline); */
-//     SetFlag(Py_BytesWarningFlag);
-//     SetFlag(Py_QuietFlag);
-//     SetFlag(Py_HashRandomizationFlag);
+var FlagsType = py.NewType("sys.flags", flags__doc__)
 
-//     if (PyErr_Occurred()) {
-//         return nil;
-//     }
-//     return seq;
-// }
+// Type of this object
+func (f *FlagsObject) Type() *py.Type {
+	return FlagsType
+}
+
+// Makes the sys.flags object from f
+func makeFlags(f *Flags) *FlagsObject {
+	values := []int{
+		f.Debug,
+		f.Inspect,
+		f.Interactive,
+		f.Optimize,
+		f.DontWriteBytecode,
+		f.NoUserSite,
+		f.NoSite,
+		f.IgnoreEnvironment,
+		f.Verbose,
+		f.BytesWarning,
+		f.Quiet,
+		f.HashRandomization,
+	}
+	t := make(py.Tuple, len(values))
+	for i, v := range values {
+		t[i] = py.Int(v)
+	}
+	return &FlagsObject{Tuple: t}
+}
+
+func (f *FlagsObject) M__repr__() (py.Object, error) {
+	fields := make([]string, len(flagsFields))
+	for i, name := range flagsFields {
+		repr, err := py.ReprAsString(f.Tuple[i])
+		if err != nil {
+			return nil, err
+		}
+		fields[i] = name + "=" + repr
+	}
+	return py.String("sys.flags(" + strings.Join(fields, ", ") + ")"), nil
+}
+
+func (f *FlagsObject) M__str__() (py.Object, error) {
+	return f.M__repr__()
+}
+
+// Check interface is satisfied
+var _ py.I__repr__ = (*FlagsObject)(nil)
+var _ py.I__getitem__ = (*FlagsObject)(nil)
+var _ py.I__len__ = (*FlagsObject)(nil)
+var _ py.I__iter__ = (*FlagsObject)(nil)
+
+func init() {
+	for i, name := range flagsFields {
+		i := i
+		FlagsType.Dict[name] = &py.Property{
+			Fget: func(self py.Object) (py.Object, error) {
+				return self.(*FlagsObject).Tuple[i], nil
+			},
+		}
+	}
+}
+
+// GetFlags returns the flags set with SetFlags
+func GetFlags() Flags {
+	return flags
+}
+
+// SetFlags sets sys.flags and sys.dont_write_bytecode from f in the
+// default context and in contexts made afterwards
+//
+// This is called by the interpreter when it has parsed its command
+// line.
+func SetFlags(f Flags) {
+	flags = f
+	globals := py.MustGetModule("sys").Globals
+	globals["flags"] = makeFlags(&flags)
+	globals["dont_write_bytecode"] = py.NewBool(flags.DontWriteBytecode != 0)
+}
+
+// Makes the python standard streams for ctx
+func makeStdio(ctx *py.Context) (stdin, stdout, stderr py.Object) {
+	r, w, e := ctx.Stdio()
+	return stdStream(ctx, r, nil, "<stdin>", "strict"),
+		stdStream(ctx, nil, w, "<stdout>", "strict"),
+		stdStream(ctx, nil, e, "<stderr>", "backslashreplace")
+}
+
+// Makes the python standard stream called name reading from r or
+// writing to w, or None if neither is set
+//
+// An *os.File gets a FileIO underneath so fileno() works.
+func stdStream(ctx *py.Context, r goio.Reader, w goio.Writer, name, errors string) py.Object {
+	var stream *io.TextIOWrapper
+	var err error
+	switch {
+	case r == nil && w == nil:
+		return py.None
+	case r != nil:
+		if f, ok := r.(*os.File); ok {
+			stream, err = io.NewStdStream(ctx, f, name, "r", errors)
+		} else {
+			stream, err = io.NewGoStdStream(r, nil, name, errors)
+		}
+	default:
+		if f, ok := w.(*os.File); ok {
+			stream, err = io.NewStdStream(ctx, f, name, "w", errors)
+		} else {
+			stream, err = io.NewGoStdStream(nil, w, name, errors)
+		}
+	}
+	if err != nil {
+		panic(err)
+	}
+	return stream
+}
+
+// Returns the Go stream of ctx for messages which can't go through
+// sys.stderr
+func goStderr(ctx *py.Context) goio.Writer {
+	_, _, stderr := ctx.Stdio()
+	if stderr == nil {
+		return ioutil.Discard
+	}
+	return stderr
+}
 
 const version_info__doc__ = `sys.version_info
 
@@ -626,6 +871,7 @@ Version information as a named tuple.`
 // Initialise the module
 func init() {
 	methods := []*py.Method{
+		py.MustNewMethod("breakpointhook", sys_breakpointhook, 0, breakpointhook_doc),
 		py.MustNewMethod("callstats", sys_callstats, 0, callstats_doc),
 		py.MustNewMethod("_clear_type_cache", sys_clear_type_cache, 0, sys_clear_type_cache__doc__),
 		py.MustNewMethod("_current_frames", sys_current_frames, 0, current_frames_doc),
@@ -652,18 +898,10 @@ func init() {
 		py.MustNewMethod("call_tracing", sys_call_tracing, 0, call_tracing_doc),
 		py.MustNewMethod("_debugmallocstats", sys_debugmallocstats, 0, debugmallocstats_doc),
 	}
-	argv := MakeArgv(os.Args[1:])
-	stdin, stdout, stderr := &py.File{os.Stdin, py.FileRead},
-		&py.File{os.Stdout, py.FileWrite},
-		&py.File{os.Stderr, py.FileWrite}
 	globals := py.StringDict{
-		"argv":       argv,
-		"stdin":      stdin,
-		"stdout":     stdout,
-		"stderr":     stderr,
-		"__stdin__":  stdin,
-		"__stdout__": stdout,
-		"__stderr__": stderr,
+		// argv, path, warnoptions, flags, dont_write_bytecode
+		// and the standard streams are set for each context by
+		// contextInit
 		//"version": py.Int(MARSHAL_VERSION),
 		//     /* stdin/stdout/stderr are now set by pythonrun.c */
 
@@ -678,8 +916,6 @@ func init() {
 		//     SET_SYS_FROM_STRING("_mercurial",
 		//                         Py_BuildValue("(szz)", "CPython", _Py_hgidentifier(),
 		//                                       _Py_hgversion()));
-		//     SET_SYS_FROM_STRING("dont_write_bytecode",
-		//                         PyBool_FromLong(Py_DontWriteBytecodeFlag));
 		//     SET_SYS_FROM_STRING("api_version",
 		//                         PyLong_FromLong(PYTHON_API_VERSION));
 		//     SET_SYS_FROM_STRING("copyright",
@@ -738,15 +974,6 @@ func init() {
 		//     SET_SYS_FROM_STRING("abiflags",
 		//                         PyUnicode_FromString(ABIFLAGS));
 		// #endif
-		//     if (warnoptions == nil) {
-		//         warnoptions = PyList_New(0);
-		//     } else {
-		//         Py_INCREF(warnoptions);
-		//     }
-		//     if (warnoptions != nil) {
-		//         PyDict_SetItemString(sysdict, "warnoptions", warnoptions);
-		//     }
-
 		//     v = get_xoptions();
 		//     if (v != nil) {
 		//         PyDict_SetItemString(sysdict, "_xoptions", v);
@@ -765,15 +992,6 @@ func init() {
 		//     /* implementation */
 		//     SET_SYS_FROM_STRING("implementation", make_impl_info(version_info));
 
-		//     /* flags */
-		//     if (FlagsType.tp_name == 0) {
-		//         PyStructSequence_InitType(&FlagsType, &flags_desc);
-		//     }
-		//     SET_SYS_FROM_STRING("flags", make_flags());
-		//     /* prevent user from creating new instances */
-		//     FlagsType.tp_init = nil;
-		//     FlagsType.tp_new = nil;
-
 		//     /* float repr style: 0.03 (short) vs 0.029999999999999999 (legacy) */
 		// #ifndef PY_NO_SHORT_FLOAT_REPR
 		//     SET_SYS_FROM_STRING("float_repr_style",
@@ -787,7 +1005,25 @@ func init() {
 		//     SET_SYS_FROM_STRING("thread_info", PyThread_GetInfo());
 		// #endif
 	}
-	py.NewModule("sys", module_doc, methods, globals)
+	module := py.NewModule("sys", module_doc, methods, globals)
+	module.Globals["__displayhook__"] = module.Globals["displayhook"]
+	module.Globals["__excepthook__"] = module.Globals["excepthook"]
+	module.Globals["__breakpointhook__"] = module.Globals["breakpointhook"]
+	module.ContextInit = contextInit
+}
+
+// Sets up the sys module m of a context with its own lists and the
+// standard streams bound to the Go streams of the context
+func contextInit(m *py.Module) {
+	stdin, stdout, stderr := makeStdio(m.Context)
+	m.Globals["argv"] = MakeArgv(os.Args[1:])
+	m.Globals["path"] = MakeArgv(py.DefaultModulePath())
+	m.Globals["warnoptions"] = py.NewList()
+	m.Globals["flags"] = makeFlags(&flags)
+	m.Globals["dont_write_bytecode"] = py.NewBool(flags.DontWriteBytecode != 0)
+	m.Globals["stdin"], m.Globals["__stdin__"] = stdin, stdin
+	m.Globals["stdout"], m.Globals["__stdout__"] = stdout, stdout
+	m.Globals["stderr"], m.Globals["__stderr__"] = stderr, stderr
 }
 
 // Makes an argv into a tuple
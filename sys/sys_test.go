@@ -0,0 +1,131 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sys_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-python/gpython/compile"
+	"github.com/go-python/gpython/py"
+	"github.com/go-python/gpython/pytest"
+	pysys "github.com/go-python/gpython/sys"
+	"github.com/go-python/gpython/vm"
+)
+
+func TestSys(t *testing.T) {
+	pytest.RunTests(t, "tests")
+}
+
+const stdioScript = `
+import sys
+assert sys.stdin is sys.__stdin__
+assert sys.stdout.name == "<stdout>"
+assert sys.stdout.isatty() is False
+for line in sys.stdin:
+    print("> " + line, end="")
+print("to stderr", file=sys.stderr)
+sys.stdout.write("é\n")
+sys.stderr.buffer.write(b"bytes\n")
+raise ValueError("boom")
+`
+
+// Runs src as __main__ in ctx
+func runIn(ctx *py.Context, src string) error {
+	obj, err := compile.Compile(src, "<stdio>", "exec", 0, true)
+	if err != nil {
+		return err
+	}
+	module := ctx.NewModule("__main__", "", nil, nil)
+	_, err = vm.Run(module.Globals, module.Globals, obj.(*py.Code), nil)
+	return err
+}
+
+func TestContextStdio(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	ctx := py.NewContext(py.ContextOpts{
+		Stdin:  strings.NewReader("one\ntwo\n"),
+		Stdout: &stdout,
+		Stderr: &stderr,
+	})
+	err := runIn(ctx, stdioScript)
+	if err == nil {
+		t.Fatal("expecting ValueError")
+	}
+	pysys.ExceptHook(ctx, err)
+
+	if got, want := stdout.String(), "> one\n> two\né\n"; got != want {
+		t.Errorf("stdout: want %q got %q", want, got)
+	}
+	got := stderr.String()
+	if !strings.HasPrefix(got, "to stderr\nbytes\nTraceback (most recent call last):\n") {
+		t.Errorf("stderr: unexpected start %q", got)
+	}
+	if !strings.HasSuffix(got, "ValueError: 'boom'\n") {
+		t.Errorf("stderr: unexpected end %q", got)
+	}
+	if py.MustGetModule("sys").Globals["stdout"] == ctx.MustGetModule("sys").Globals["stdout"] {
+		t.Error("default context shares sys.stdout")
+	}
+}
+
+func TestContextStdioNone(t *testing.T) {
+	ctx := py.NewContext(py.ContextOpts{})
+	globals := ctx.MustGetModule("sys").Globals
+	for _, name := range []string{"stdin", "stdout", "stderr"} {
+		if globals[name] != py.None {
+			t.Errorf("sys.%s: want None got %v", name, globals[name])
+		}
+	}
+	err := runIn(ctx, `print("nowhere")`)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+const concurrentScript = `
+import sys
+for i in range(100):
+    print(name, i)
+    sys.displayhook(name)
+    exec("print(name)")
+    try:
+        raise ValueError(name)
+    except ValueError:
+        assert sys.exc_info()[1].args[0] == name
+`
+
+func TestContextsConcurrent(t *testing.T) {
+	const n = 4
+	var outs [n]bytes.Buffer
+	var errs [n]error
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		ctx := py.NewContext(py.ContextOpts{Stdout: &outs[i]})
+		ctx.Builtins().Globals["name"] = py.String(fmt.Sprintf("ctx%d", i))
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = runIn(ctx, concurrentScript)
+		}(i)
+	}
+	wg.Wait()
+	for i := 0; i < n; i++ {
+		if errs[i] != nil {
+			t.Fatalf("ctx%d: %v", i, errs[i])
+		}
+		var want strings.Builder
+		name := fmt.Sprintf("ctx%d", i)
+		for j := 0; j < 100; j++ {
+			fmt.Fprintf(&want, "%s %d\n'%s'\n%s\n", name, j, name, name)
+		}
+		if got := outs[i].String(); got != want.String() {
+			t.Errorf("%s: unexpected output %q", name, got)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+import sys
+
+doc="exc_info outside handler"
+assert sys.exc_info() == (None, None, None)
+
+doc="exc_info in handler"
+try:
+    raise ValueError("potato")
+except ValueError as e:
+    t, v, tb = sys.exc_info()
+    assert t is ValueError
+    assert v is e
+    assert tb is not None
+assert sys.exc_info() == (None, None, None)
+
+doc="exc_info in called function"
+def inner():
+    return sys.exc_info()[1]
+try:
+    raise KeyError("x")
+except KeyError as e:
+    assert inner() is e
+
+doc="exc_info nested"
+try:
+    raise KeyError("outer")
+except KeyError as outer:
+    try:
+        raise IndexError("inner")
+    except IndexError as e:
+        assert sys.exc_info()[0] is IndexError
+    assert sys.exc_info()[1] is outer
+
+doc="__displayhook__ and __excepthook__"
+assert sys.__displayhook__ is sys.displayhook
+assert sys.__excepthook__ is sys.excepthook
+
+doc="displayhook"
+class Writer:
+    def __init__(self):
+        self.out = ""
+    def write(self, s):
+        self.out += s
+w = Writer()
+old_stdout = sys.stdout
+sys.stdout = w
+try:
+    sys.displayhook(None)
+    assert w.out == ""
+    sys.displayhook([1, "two"])
+finally:
+    sys.stdout = old_stdout
+assert w.out == "[1, 'two']\n"
+import builtins
+assert builtins._ == [1, "two"]
+
+doc="excepthook"
+w = Writer()
+old_stderr = sys.stderr
+sys.stderr = w
+try:
+    try:
+        raise ValueError("boom")
+    except ValueError:
+        sys.excepthook(*sys.exc_info())
+finally:
+    sys.stderr = old_stderr
+assert w.out.startswith("Traceback (most recent call last):\n")
+assert "ValueError: 'boom'" in w.out
+
+doc="finished"
@@ -0,0 +1,211 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+import tokenize
+from tokenize import NAME, NUMBER, STRING, OP, NEWLINE, NL, COMMENT, INDENT, DEDENT, ENDMARKER, ERRORTOKEN
+
+def readlines(s):
+    """Returns a readline function reading the lines of s"""
+    parts = s.split("\n")
+    lines = [part + "\n" for part in parts[:-1]]
+    if parts[-1]:
+        lines.append(parts[-1])
+    i = 0
+    def readline():
+        nonlocal i
+        if i < len(lines):
+            i += 1
+            return lines[i-1]
+        return ""
+    return readline
+
+def tokens(s):
+    return list(tokenize.generate_tokens(readlines(s)))
+
+def summary(s):
+    return [(tokenize.tok_name[t.type], t.string, t.start, t.end) for t in tokens(s)]
+
+def ck_error(s, exc, args):
+    g = tokenize.generate_tokens(readlines(s))
+    try:
+        while True:
+            next(g)
+    except exc as e:
+        assert e.args == args, "%r: got %r" % (s, e.args)
+    else:
+        assert False, "%r: %s not raised" % (s, exc)
+
+doc="constants"
+assert tokenize.ENDMARKER == 0
+assert tokenize.NAME == 1
+assert tokenize.OP == 54
+assert tokenize.ERRORTOKEN == 59
+assert tokenize.COMMENT == 60
+assert tokenize.NL == 61
+assert tokenize.N_TOKENS == 63
+assert tokenize.NT_OFFSET == 256
+assert tokenize.tok_name[tokenize.OP] == "OP"
+assert tokenize.tok_name[tokenize.LPAR] == "LPAR"
+assert tokenize.EXACT_TOKEN_TYPES["**="] == tokenize.DOUBLESTAREQUAL
+
+doc="simple"
+assert summary("x = 1\n") == [
+    ("NAME", "x", (1, 0), (1, 1)),
+    ("OP", "=", (1, 2), (1, 3)),
+    ("NUMBER", "1", (1, 4), (1, 5)),
+    ("NEWLINE", "\n", (1, 5), (1, 6)),
+    ("ENDMARKER", "", (2, 0), (2, 0)),
+]
+
+doc="no newline at end"
+assert summary("x") == [
+    ("NAME", "x", (1, 0), (1, 1)),
+    ("NEWLINE", "", (1, 1), (1, 2)),
+    ("ENDMARKER", "", (2, 0), (2, 0)),
+]
+assert summary("x\n   ") == [
+    ("NAME", "x", (1, 0), (1, 1)),
+    ("NEWLINE", "\n", (1, 1), (1, 2)),
+    ("ENDMARKER", "", (2, 0), (2, 0)),
+]
+
+doc="keywords are names"
+assert summary("if not x: pass\n")[:4] == [
+    ("NAME", "if", (1, 0), (1, 2)),
+    ("NAME", "not", (1, 3), (1, 6)),
+    ("NAME", "x", (1, 7), (1, 8)),
+    ("OP", ":", (1, 8), (1, 9)),
+]
+
+doc="comments, NL and indents"
+assert summary("if x:\n  y = (1,\n # k\n 2)  # t\n\n# c\nz\n") == [
+    ("NAME", "if", (1, 0), (1, 2)),
+    ("NAME", "x", (1, 3), (1, 4)),
+    ("OP", ":", (1, 4), (1, 5)),
+    ("NEWLINE", "\n", (1, 5), (1, 6)),
+    ("INDENT", "  ", (2, 0), (2, 2)),
+    ("NAME", "y", (2, 2), (2, 3)),
+    ("OP", "=", (2, 4), (2, 5)),
+    ("OP", "(", (2, 6), (2, 7)),
+    ("NUMBER", "1", (2, 7), (2, 8)),
+    ("OP", ",", (2, 8), (2, 9)),
+    ("NL", "\n", (2, 9), (2, 10)),
+    ("COMMENT", "# k", (3, 1), (3, 4)),
+    ("NL", "\n", (3, 4), (3, 5)),
+    ("NUMBER", "2", (4, 1), (4, 2)),
+    ("OP", ")", (4, 2), (4, 3)),
+    ("COMMENT", "# t", (4, 5), (4, 8)),
+    ("NEWLINE", "\n", (4, 8), (4, 9)),
+    ("NL", "\n", (5, 0), (5, 1)),
+    ("COMMENT", "# c", (6, 0), (6, 3)),
+    ("NL", "\n", (6, 3), (6, 4)),
+    ("DEDENT", "", (7, 0), (7, 0)),
+    ("NAME", "z", (7, 0), (7, 1)),
+    ("NEWLINE", "\n", (7, 1), (7, 2)),
+    ("ENDMARKER", "", (8, 0), (8, 0)),
+]
+
+doc="dedents at end"
+assert summary("def f():\n  if x:\n    y\n")[-3:] == [
+    ("DEDENT", "", (4, 0), (4, 0)),
+    ("DEDENT", "", (4, 0), (4, 0)),
+    ("ENDMARKER", "", (4, 0), (4, 0)),
+]
+
+doc="strings"
+toks = tokens('s = """ab\ncd""" + f"{x}"\n')
+assert toks[2].type == STRING
+assert toks[2].string == '"""ab\ncd"""'
+assert toks[2].start == (1, 4)
+assert toks[2].end == (2, 5)
+assert toks[2].line == 's = """ab\ncd""" + f"{x}"\n'
+assert toks[3].string == "+"
+assert toks[3].line == 'cd""" + f"{x}"\n'
+assert toks[4].type == STRING
+assert toks[4].string == 'f"{x}"'
+
+doc="columns count characters"
+assert summary("é = 'ü'\n") == [
+    ("NAME", "é", (1, 0), (1, 1)),
+    ("OP", "=", (1, 2), (1, 3)),
+    ("STRING", "'ü'", (1, 4), (1, 7)),
+    ("NEWLINE", "\n", (1, 7), (1, 8)),
+    ("ENDMARKER", "", (2, 0), (2, 0)),
+]
+
+doc="exact_type"
+toks = tokens("a **= b // c @ d\n")
+assert [t.exact_type for t in toks] == [NAME, tokenize.DOUBLESTAREQUAL, NAME, tokenize.DOUBLESLASH, NAME, tokenize.AT, NAME, NEWLINE, ENDMARKER]
+assert [t.type for t in toks] == [NAME, OP, NAME, OP, NAME, OP, NAME, NEWLINE, ENDMARKER]
+
+doc="errors"
+assert summary("a $ b\n")[:3] == [
+    ("NAME", "a", (1, 0), (1, 1)),
+    ("ERRORTOKEN", "$", (1, 2), (1, 3)),
+    ("NAME", "b", (1, 4), (1, 5)),
+]
+assert summary("a = 'b\n")[2:4] == [
+    ("ERRORTOKEN", "'", (1, 4), (1, 5)),
+    ("NAME", "b", (1, 5), (1, 6)),
+]
+ck_error("x = '''abc\nde", tokenize.TokenError, ("EOF in multi-line string", (1, 4)))
+ck_error("f(1,\n", tokenize.TokenError, ("EOF in multi-line statement", (2, 0)))
+ck_error("if x:\n    y\n  z\n", IndentationError, ("Inconsistent indent", ("<tokenize>", 3, 3, "  z\n", 3, 4)))
+
+def bad_readline():
+    raise ValueError("bad readline")
+try:
+    list(tokenize.generate_tokens(bad_readline))
+except ValueError as e:
+    assert e.args == ("bad readline",)
+else:
+    assert False, "ValueError not raised"
+
+doc="TokenInfo"
+t = tokenize.TokenInfo(NAME, "x", (1, 0), (1, 1), "x\n")
+assert t.type == NAME
+assert t.string == "x"
+assert t.start == (1, 0)
+assert t.end == (1, 1)
+assert t.line == "x\n"
+assert t.exact_type == NAME
+assert len(t) == 5
+assert t[1] == "x"
+assert t[-1] == "x\n"
+assert t[:2] == (NAME, "x")
+typ, string, start, end, line = t
+assert (typ, string, start, end, line) == (NAME, "x", (1, 0), (1, 1), "x\n")
+assert t == (NAME, "x", (1, 0), (1, 1), "x\n")
+assert t == tokenize.TokenInfo(type=NAME, string="x", start=(1, 0), end=(1, 1), line="x\n")
+assert t != tokenize.TokenInfo(NAME, "y", (1, 0), (1, 1), "y\n")
+assert repr(t) == "TokenInfo(type=1 (NAME), string='x', start=(1, 0), end=(1, 1), line='x\\n')"
+assert tokenize.TokenInfo._fields == ("type", "string", "start", "end", "line")
+assert tokens("x\n")[0] == t
+
+doc="untokenize round trip"
+for src in [
+    "x = 1\n",
+    "if x:\n  y = (1,\n # k\n 2)  # t\n\n# c\nz\n",
+    "def f(a, *b, **c):\n    '''doc'''\n    return a  # ok\n",
+    "class A:\n\tdef f(self):\n\t\tpass\n\nA().f()\n",
+    's = """ab\ncd""" + f"{x}"\n',
+]:
+    toks = tokens(src)
+    assert tokenize.untokenize(toks) == src, "%r: got %r" % (src, tokenize.untokenize(toks))
+    compat = tokenize.untokenize([t[:2] for t in toks])
+    assert [t[:2] for t in tokens(compat)] == [t[:2] for t in toks], "%r: got %r" % (src, compat)
+
+doc="untokenize compat"
+assert tokenize.untokenize([(NAME, "x"), (OP, "="), (NUMBER, "1"), (NEWLINE, "\n")]) == "x =1 \n"
+assert tokenize.untokenize([(STRING, "'a'"), (STRING, "'b'")]) == "'a' 'b'"
+
+doc="untokenize errors"
+try:
+    tokenize.untokenize([(NAME, "x", (1, 5), (1, 6), ""), (NAME, "y", (1, 0), (1, 1), "")])
+except ValueError as e:
+    assert e.args == ("start (1,0) precedes previous end (1,6)",)
+else:
+    assert False, "ValueError not raised"
+
+doc="finished"
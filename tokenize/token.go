@@ -0,0 +1,203 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Token constants as in the python token module
+
+package tokenize
+
+import (
+	"github.com/go-python/gpython/parser"
+	"github.com/go-python/gpython/py"
+)
+
+// Token types
+const (
+	ENDMARKER = iota
+	NAME
+	NUMBER
+	STRING
+	NEWLINE
+	INDENT
+	DEDENT
+	LPAR
+	RPAR
+	LSQB
+	RSQB
+	COLON
+	COMMA
+	SEMI
+	PLUS
+	MINUS
+	STAR
+	SLASH
+	VBAR
+	AMPER
+	LESS
+	GREATER
+	EQUAL
+	DOT
+	PERCENT
+	LBRACE
+	RBRACE
+	EQEQUAL
+	NOTEQUAL
+	LESSEQUAL
+	GREATEREQUAL
+	TILDE
+	CIRCUMFLEX
+	LEFTSHIFT
+	RIGHTSHIFT
+	DOUBLESTAR
+	PLUSEQUAL
+	MINEQUAL
+	STAREQUAL
+	SLASHEQUAL
+	PERCENTEQUAL
+	AMPEREQUAL
+	VBAREQUAL
+	CIRCUMFLEXEQUAL
+	LEFTSHIFTEQUAL
+	RIGHTSHIFTEQUAL
+	DOUBLESTAREQUAL
+	DOUBLESLASH
+	DOUBLESLASHEQUAL
+	AT
+	ATEQUAL
+	RARROW
+	ELLIPSIS
+	COLONEQUAL
+	OP
+	AWAIT
+	ASYNC
+	TYPE_IGNORE
+	TYPE_COMMENT
+	ERRORTOKEN
+	COMMENT
+	NL
+	ENCODING
+	N_TOKENS
+	NT_OFFSET = 256
+)
+
+// Names of the token types in order
+var tokNames = []string{
+	"ENDMARKER", "NAME", "NUMBER", "STRING", "NEWLINE", "INDENT", "DEDENT",
+	"LPAR", "RPAR", "LSQB", "RSQB", "COLON", "COMMA", "SEMI", "PLUS",
+	"MINUS", "STAR", "SLASH", "VBAR", "AMPER", "LESS", "GREATER", "EQUAL",
+	"DOT", "PERCENT", "LBRACE", "RBRACE", "EQEQUAL", "NOTEQUAL",
+	"LESSEQUAL", "GREATEREQUAL", "TILDE", "CIRCUMFLEX", "LEFTSHIFT",
+	"RIGHTSHIFT", "DOUBLESTAR", "PLUSEQUAL", "MINEQUAL", "STAREQUAL",
+	"SLASHEQUAL", "PERCENTEQUAL", "AMPEREQUAL", "VBAREQUAL",
+	"CIRCUMFLEXEQUAL", "LEFTSHIFTEQUAL", "RIGHTSHIFTEQUAL",
+	"DOUBLESTAREQUAL", "DOUBLESLASH", "DOUBLESLASHEQUAL", "AT", "ATEQUAL",
+	"RARROW", "ELLIPSIS", "COLONEQUAL", "OP", "AWAIT", "ASYNC",
+	"TYPE_IGNORE", "TYPE_COMMENT", "ERRORTOKEN", "COMMENT", "NL",
+	"ENCODING", "N_TOKENS",
+}
+
+// Exact token types of the operators
+var exactTokenTypes = map[string]int{
+	"!=":  NOTEQUAL,
+	"%":   PERCENT,
+	"%=":  PERCENTEQUAL,
+	"&":   AMPER,
+	"&=":  AMPEREQUAL,
+	"(":   LPAR,
+	")":   RPAR,
+	"*":   STAR,
+	"**":  DOUBLESTAR,
+	"**=": DOUBLESTAREQUAL,
+	"*=":  STAREQUAL,
+	"+":   PLUS,
+	"+=":  PLUSEQUAL,
+	",":   COMMA,
+	"-":   MINUS,
+	"-=":  MINEQUAL,
+	"->":  RARROW,
+	".":   DOT,
+	"...": ELLIPSIS,
+	"/":   SLASH,
+	"//":  DOUBLESLASH,
+	"//=": DOUBLESLASHEQUAL,
+	"/=":  SLASHEQUAL,
+	":":   COLON,
+	":=":  COLONEQUAL,
+	";":   SEMI,
+	"<":   LESS,
+	"<<":  LEFTSHIFT,
+	"<<=": LEFTSHIFTEQUAL,
+	"<=":  LESSEQUAL,
+	"<>":  NOTEQUAL,
+	"=":   EQUAL,
+	"==":  EQEQUAL,
+	">":   GREATER,
+	">=":  GREATEREQUAL,
+	">>":  RIGHTSHIFT,
+	">>=": RIGHTSHIFTEQUAL,
+	"@":   AT,
+	"@=":  ATEQUAL,
+	"[":   LSQB,
+	"]":   RSQB,
+	"^":   CIRCUMFLEX,
+	"^=":  CIRCUMFLEXEQUAL,
+	"{":   LBRACE,
+	"|":   VBAR,
+	"|=":  VBAREQUAL,
+	"}":   RBRACE,
+	"~":   TILDE,
+}
+
+// Converts the kind of a token from the parser into a token type
+//
+// Keywords are NAME tokens and operators are OP tokens.
+func tokenType(tok parser.Token) int {
+	switch tok.Kind {
+	case parser.ENDMARKER:
+		return ENDMARKER
+	case parser.NAME:
+		return NAME
+	case parser.NUMBER:
+		return NUMBER
+	case parser.STRING:
+		return STRING
+	case parser.NEWLINE:
+		return NEWLINE
+	case parser.INDENT:
+		return INDENT
+	case parser.DEDENT:
+		return DEDENT
+	case parser.ERRORTOKEN:
+		return ERRORTOKEN
+	case parser.COMMENT:
+		return COMMENT
+	case parser.NL:
+		return NL
+	}
+	if _, ok := exactTokenTypes[tok.Text]; ok {
+		return OP
+	}
+	return NAME
+}
+
+// Adds the token constants to the module globals
+//
+// tok_name is a list indexed by token type as dicts may only have
+// string keys.
+func addTokenGlobals(globals py.StringDict) {
+	tokName := make([]py.Object, len(tokNames))
+	for i, name := range tokNames {
+		globals[name] = py.Int(i)
+		tokName[i] = py.String(name)
+	}
+	globals["NT_OFFSET"] = py.Int(NT_OFFSET)
+	globals["tok_name"] = py.NewListFromItems(tokName)
+	exact := py.NewStringDict()
+	for op, i := range exactTokenTypes {
+		if op == "<>" {
+			continue
+		}
+		exact[op] = py.Int(i)
+	}
+	globals["EXACT_TOKEN_TYPES"] = exact
+}
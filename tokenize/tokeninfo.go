@@ -0,0 +1,170 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// TokenInfo objects
+
+package tokenize
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-python/gpython/parser"
+	"github.com/go-python/gpython/py"
+)
+
+// TokenInfo is a token returned by generate_tokens
+//
+// It behaves like the tuple (type, string, start, end, line) with
+// the fields also available as attributes.
+type TokenInfo struct {
+	py.Tuple
+}
+
+var TokenInfoType = py.NewTypeX("TokenInfo", "TokenInfo(type, string, start, end, line)", TokenInfoNew, nil)
+
+// Type of this object
+func (t *TokenInfo) Type() *py.Type {
+	return TokenInfoType
+}
+
+// Names of the fields of a TokenInfo in order
+var tokenInfoFields = []string{"type", "string", "start", "end", "line"}
+
+// TokenInfoNew makes a TokenInfo from python
+func TokenInfoNew(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	t := &TokenInfo{Tuple: make(py.Tuple, len(tokenInfoFields))}
+	err := py.ParseTupleAndKeywords(args, kwargs, "OOOOO:TokenInfo", tokenInfoFields, &t.Tuple[0], &t.Tuple[1], &t.Tuple[2], &t.Tuple[3], &t.Tuple[4])
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Returns the number of characters in line before the byte offset col
+//
+// Offsets past the end of the line count one per byte.
+func charColumn(line string, col int) int {
+	if col <= len(line) {
+		return utf8.RuneCountInString(line[:col])
+	}
+	return utf8.RuneCountInString(line) + col - len(line)
+}
+
+// Makes the (row, col) position of the start or end of tok with the
+// column counted in characters
+func position(tok parser.Token, start bool) py.Tuple {
+	p, lines := tok.End, strings.SplitAfter(tok.Line, "\n")
+	if start {
+		p = tok.Start
+	}
+	n := p.Lineno - tok.Start.Lineno
+	col := p.ColOffset
+	if n < len(lines) {
+		col = charColumn(lines[n], col)
+	}
+	return py.Tuple{py.Int(p.Lineno), py.Int(col)}
+}
+
+// newTokenInfo makes a TokenInfo from a token from the parser
+func newTokenInfo(tok parser.Token) *TokenInfo {
+	return &TokenInfo{Tuple: py.Tuple{
+		py.Int(tokenType(tok)),
+		py.String(tok.Text),
+		position(tok, true),
+		position(tok, false),
+		py.String(tok.Line),
+	}}
+}
+
+func (t *TokenInfo) M__repr__() (py.Object, error) {
+	fields := make([]string, len(tokenInfoFields))
+	for i, name := range tokenInfoFields {
+		repr, err := py.ReprAsString(t.Tuple[i])
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			if tokType, ok := t.Tuple[i].(py.Int); ok && tokType >= 0 && int(tokType) < len(tokNames) {
+				repr = fmt.Sprintf("%d (%s)", tokType, tokNames[tokType])
+			}
+		}
+		fields[i] = name + "=" + repr
+	}
+	return py.String("TokenInfo(" + strings.Join(fields, ", ") + ")"), nil
+}
+
+func (t *TokenInfo) M__str__() (py.Object, error) {
+	return t.M__repr__()
+}
+
+// Returns the TokenInfo or tuple other as a Tuple
+func asTuple(other py.Object) (py.Tuple, bool) {
+	switch x := other.(type) {
+	case *TokenInfo:
+		return x.Tuple, true
+	case py.Tuple:
+		return x, true
+	}
+	return nil, false
+}
+
+func (t *TokenInfo) M__eq__(other py.Object) (py.Object, error) {
+	b, ok := asTuple(other)
+	if !ok {
+		return py.NotImplemented, nil
+	}
+	return t.Tuple.M__eq__(b)
+}
+
+func (t *TokenInfo) M__ne__(other py.Object) (py.Object, error) {
+	b, ok := asTuple(other)
+	if !ok {
+		return py.NotImplemented, nil
+	}
+	return t.Tuple.M__ne__(b)
+}
+
+// Check interface is satisfied
+var _ py.I__repr__ = (*TokenInfo)(nil)
+var _ py.I__eq__ = (*TokenInfo)(nil)
+var _ py.I__ne__ = (*TokenInfo)(nil)
+var _ py.I__getitem__ = (*TokenInfo)(nil)
+var _ py.I__len__ = (*TokenInfo)(nil)
+var _ py.I__iter__ = (*TokenInfo)(nil)
+
+func init() {
+	for i, name := range tokenInfoFields {
+		i := i
+		TokenInfoType.Dict[name] = &py.Property{
+			Fget: func(self py.Object) (py.Object, error) {
+				return self.(*TokenInfo).Tuple[i], nil
+			},
+		}
+	}
+	TokenInfoType.Dict["exact_type"] = &py.Property{
+		Fget: func(self py.Object) (py.Object, error) {
+			t := self.(*TokenInfo)
+			if t.Tuple[0] == py.Int(OP) {
+				if s, ok := t.Tuple[1].(py.String); ok {
+					if exactType, ok := exactTokenTypes[string(s)]; ok {
+						return py.Int(exactType), nil
+					}
+				}
+			}
+			return t.Tuple[0], nil
+		},
+	}
+	TokenInfoType.Dict["_fields"] = stringTuple(tokenInfoFields)
+}
+
+// Make a Tuple of Strings
+func stringTuple(xs []string) py.Tuple {
+	t := make(py.Tuple, len(xs))
+	for i, x := range xs {
+		t[i] = py.String(x)
+	}
+	return t
+}
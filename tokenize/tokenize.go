@@ -0,0 +1,420 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Tokenize module
+//
+// Tokenizes python source with the lexer the parser uses.
+
+package tokenize
+
+import (
+	"io"
+	"strings"
+
+	"github.com/go-python/gpython/parser"
+	"github.com/go-python/gpython/py"
+)
+
+const module_doc = `Tokenization help for Python programs.
+
+generate_tokens(readline) is a generator that breaks a stream of text
+into Python tokens.  It accepts a readline-like method which is called
+repeatedly to get the next line of input (or "" for EOF).  It
+generates TokenInfo 5-tuples with these members:
+
+    the token type (see token.py)
+    the token (a string)
+    the starting (row, column) indices of the token (a 2-tuple of ints)
+    the ending (row, column) indices of the token (a 2-tuple of ints)
+    the original line (string)
+
+It is designed to match the working of the Python tokenizer exactly,
+except that it produces COMMENT tokens for comments and gives type OP
+for all operators.`
+
+var TokenError = py.ExceptionType.NewType("TokenError", "Raised for input which can't be tokenized.", nil, nil)
+
+// readlineReader is an io.Reader which reads from a python readline
+// function
+type readlineReader struct {
+	readline py.Object
+	buf      string
+	err      error // error from readline
+}
+
+// Read calls readline when the buffered line has been used up
+//
+// Errors from readline are stored and io.EOF returned.
+func (r *readlineReader) Read(p []byte) (int, error) {
+	if r.buf == "" {
+		if r.err != nil {
+			return 0, io.EOF
+		}
+		line, err := py.Call(r.readline, nil, nil)
+		if py.IsException(py.StopIteration, err) {
+			line, err = py.String(""), nil
+		}
+		if err != nil {
+			r.err = err
+			return 0, io.EOF
+		}
+		s, ok := line.(py.String)
+		if !ok {
+			r.err = py.ExceptionNewf(py.TypeError, "readline() should return a str, not %s", line.Type().Name)
+			return 0, io.EOF
+		}
+		if s == "" {
+			return 0, io.EOF
+		}
+		r.buf = string(s)
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// TokenIterator is the iterator returned by generate_tokens
+type TokenIterator struct {
+	reader    *readlineReader
+	tokenizer *parser.Tokenizer
+	done      bool
+}
+
+var TokenIteratorType = py.NewType("TokenIterator", "Iterator over the TokenInfo tuples of python source.")
+
+// Type of this object
+func (it *TokenIterator) Type() *py.Type {
+	return TokenIteratorType
+}
+
+// NewTokenIterator makes an iterator over the tokens of the source
+// returned by calling readline
+func NewTokenIterator(readline py.Object) *TokenIterator {
+	reader := &readlineReader{readline: readline}
+	return &TokenIterator{
+		reader:    reader,
+		tokenizer: parser.NewTokenizer(reader, "<tokenize>"),
+	}
+}
+
+func (it *TokenIterator) M__iter__() (py.Object, error) {
+	return it, nil
+}
+
+// Makes a TokenError with msg for the (row, col) position pos
+func tokenError(msg string, pos py.Tuple) error {
+	exc, err := py.ExceptionNew(TokenError, py.Tuple{py.String(msg), pos}, nil)
+	if err != nil {
+		return err
+	}
+	return exc.(*py.Exception)
+}
+
+// Returns the next TokenInfo
+//
+// Like python's tokenize an unterminated triple quoted string or
+// statement at the end of the input raises TokenError and bad
+// indentation raises IndentationError.  Other errors give ERRORTOKEN
+// tokens.
+func (it *TokenIterator) M__next__() (py.Object, error) {
+	if it.done {
+		return nil, py.StopIteration
+	}
+	tok, err := it.tokenizer.Next()
+	if it.reader.err != nil {
+		it.done = true
+		return nil, it.reader.err
+	}
+	if err == io.EOF {
+		it.done = true
+		return nil, py.StopIteration
+	}
+	if err != nil {
+		switch {
+		case py.IsException(py.IndentationError, err):
+			it.done = true
+			return nil, err
+		case tok.Kind == parser.ENDMARKER:
+			it.done = true
+			return nil, tokenError("EOF in multi-line statement", py.Tuple{py.Int(tok.Start.Lineno), py.Int(0)})
+		case strings.Contains(err.Error(), "EOF while scanning triple-quoted string literal"):
+			it.done = true
+			return nil, tokenError("EOF in multi-line string", position(tok, true))
+		}
+	}
+	return newTokenInfo(tok), nil
+}
+
+// Check interface is satisfied
+var _ py.I__iter__ = (*TokenIterator)(nil)
+var _ py.I__next__ = (*TokenIterator)(nil)
+
+const generate_tokens_doc = `generate_tokens(readline) -> iterator of TokenInfo
+
+Tokenize the python source returned by calling readline repeatedly
+until it returns "".`
+
+func tokenize_generate_tokens(self py.Object, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var readline py.Object
+	err := py.ParseTupleAndKeywords(args, kwargs, "O:generate_tokens", []string{"readline"}, &readline)
+	if err != nil {
+		return nil, err
+	}
+	return NewTokenIterator(readline), nil
+}
+
+// untokenizer converts tokens back into python source
+type untokenizer struct {
+	tokens   []string
+	prevRow  int
+	prevCol  int
+	encoding py.Object
+}
+
+// Adds whitespace to move from the end of the previous token to start
+func (u *untokenizer) addWhitespace(row, col int) error {
+	if row < u.prevRow || row == u.prevRow && col < u.prevCol {
+		return py.ExceptionNewf(py.ValueError, "start (%d,%d) precedes previous end (%d,%d)", row, col, u.prevRow, u.prevCol)
+	}
+	if rowOffset := row - u.prevRow; rowOffset != 0 {
+		u.tokens = append(u.tokens, strings.Repeat("\\\n", rowOffset))
+		u.prevCol = 0
+	}
+	if colOffset := col - u.prevCol; colOffset != 0 {
+		u.tokens = append(u.tokens, strings.Repeat(" ", colOffset))
+	}
+	return nil
+}
+
+// Reads a (row, col) position
+func getPosition(pos py.Object) (row, col int, err error) {
+	var rowObj, colObj py.Object
+	t, err := py.SequenceTuple(pos)
+	if err != nil {
+		return 0, 0, err
+	}
+	err = py.UnpackTuple(t, nil, "position", 2, 2, &rowObj, &colObj)
+	if err != nil {
+		return 0, 0, err
+	}
+	row, err = py.IndexInt(rowObj)
+	if err != nil {
+		return 0, 0, err
+	}
+	col, err = py.IndexInt(colObj)
+	return row, col, err
+}
+
+// Reads the type and string from the start of a token
+func getTypeString(tok py.Tuple) (tokType int, s string, err error) {
+	tokType, err = py.IndexInt(tok[0])
+	if err != nil {
+		return 0, "", err
+	}
+	str, ok := tok[1].(py.String)
+	if !ok {
+		return 0, "", py.ExceptionNewf(py.TypeError, "token string must be str, not %s", tok[1].Type().Name)
+	}
+	return tokType, string(str), nil
+}
+
+// Untokenizes full 5-tuples reproducing the source exactly, falling
+// back to compat for 2-tuples
+func (u *untokenizer) untokenize(iterable py.Object) error {
+	it, err := py.Iter(iterable)
+	if err != nil {
+		return err
+	}
+	var indents []string
+	startLine := false
+	for {
+		item, err := py.Next(it)
+		if py.IsException(py.StopIteration, err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		tok, err := py.SequenceTuple(item)
+		if err != nil {
+			return err
+		}
+		if len(tok) == 2 {
+			return u.compat(tok, it)
+		}
+		if len(tok) != 5 {
+			return py.ExceptionNewf(py.ValueError, "expected a 2 or 5 item token, got %d items", len(tok))
+		}
+		tokType, s, err := getTypeString(tok)
+		if err != nil {
+			return err
+		}
+		switch tokType {
+		case ENCODING:
+			u.encoding = tok[1]
+			continue
+		case ENDMARKER:
+			return nil
+		case INDENT:
+			indents = append(indents, s)
+			continue
+		case DEDENT:
+			if len(indents) > 0 {
+				indents = indents[:len(indents)-1]
+			}
+			u.prevRow, u.prevCol, err = getPosition(tok[3])
+			if err != nil {
+				return err
+			}
+			continue
+		case NEWLINE, NL:
+			startLine = true
+		default:
+			if startLine && len(indents) > 0 {
+				indent := indents[len(indents)-1]
+				_, col, err := getPosition(tok[2])
+				if err != nil {
+					return err
+				}
+				if col >= len(indent) {
+					u.tokens = append(u.tokens, indent)
+					u.prevCol = len(indent)
+				}
+				startLine = false
+			}
+		}
+		row, col, err := getPosition(tok[2])
+		if err != nil {
+			return err
+		}
+		err = u.addWhitespace(row, col)
+		if err != nil {
+			return err
+		}
+		u.tokens = append(u.tokens, s)
+		u.prevRow, u.prevCol, err = getPosition(tok[3])
+		if err != nil {
+			return err
+		}
+		if tokType == NEWLINE || tokType == NL {
+			u.prevRow++
+			u.prevCol = 0
+		}
+	}
+}
+
+// Untokenizes (type, string) tokens starting with tok, spacing them
+// so that they tokenize the same way again
+func (u *untokenizer) compat(tok py.Tuple, it py.Object) error {
+	var indents []string
+	startLine := false
+	prevString := false
+	first := true
+	for {
+		if !first {
+			item, err := py.Next(it)
+			if py.IsException(py.StopIteration, err) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			tok, err = py.SequenceTuple(item)
+			if err != nil {
+				return err
+			}
+			if len(tok) < 2 {
+				return py.ExceptionNewf(py.ValueError, "expected a token with at least 2 items, got %d", len(tok))
+			}
+		}
+		tokType, s, err := getTypeString(tok)
+		if err != nil {
+			return err
+		}
+		if first {
+			startLine = tokType == NEWLINE || tokType == NL
+			first = false
+		}
+		if tokType == ENCODING {
+			u.encoding = tok[1]
+			continue
+		}
+		if tokType == NAME || tokType == NUMBER {
+			s += " "
+		}
+		// Insert a space between two consecutive strings
+		if tokType == STRING {
+			if prevString {
+				s = " " + s
+			}
+			prevString = true
+		} else {
+			prevString = false
+		}
+		switch tokType {
+		case INDENT:
+			indents = append(indents, s)
+			continue
+		case DEDENT:
+			if len(indents) > 0 {
+				indents = indents[:len(indents)-1]
+			}
+			continue
+		case NEWLINE, NL:
+			startLine = true
+		default:
+			if startLine && len(indents) > 0 {
+				u.tokens = append(u.tokens, indents[len(indents)-1])
+				startLine = false
+			}
+		}
+		u.tokens = append(u.tokens, s)
+	}
+}
+
+const untokenize_doc = `untokenize(iterable) -> str
+
+Transform tokens back into Python source code.
+
+Each element returned by the iterable must be a token sequence with
+at least two elements, a token number and token value.  If only two
+tokens are passed, the resulting output is poor.
+
+Round-trip invariant for full input:
+    Untokenized source will match input source exactly
+
+Round-trip invariant for limited input:
+    # Output text will tokenize the back to the input
+    t1 = [tok[:2] for tok in generate_tokens(f.readline)]
+    newcode = untokenize(t1)
+    readline = iter(newcode.splitlines(1)).__next__
+    t2 = [tok[:2] for tok in generate_tokens(readline)]
+    assert t1 == t2`
+
+func tokenize_untokenize(self py.Object, iterable py.Object) (py.Object, error) {
+	u := &untokenizer{prevRow: 1}
+	err := u.untokenize(iterable)
+	if err != nil {
+		return nil, err
+	}
+	out := strings.Join(u.tokens, "")
+	if u.encoding != nil {
+		return py.Bytes(out), nil
+	}
+	return py.String(out), nil
+}
+
+// Initialise the module
+func init() {
+	methods := []*py.Method{
+		py.MustNewMethod("generate_tokens", tokenize_generate_tokens, 0, generate_tokens_doc),
+		py.MustNewMethod("untokenize", tokenize_untokenize, 0, untokenize_doc),
+	}
+	globals := py.StringDict{
+		"TokenInfo":  TokenInfoType,
+		"TokenError": TokenError,
+	}
+	addTokenGlobals(globals)
+	py.NewModule("tokenize", module_doc, methods, globals)
+}
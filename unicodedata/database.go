@@ -0,0 +1,268 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Access to the Unicode character database
+
+package unicodedata
+
+//go:generate ./make_tables.py
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// A range of ideographs named by prefix and code point
+type ideographRange struct {
+	lo, hi rune
+	prefix string
+}
+
+// The decomposition of a character
+type decomposition struct {
+	r        rune
+	tag      string // eg "<compat>" or "" for a canonical decomposition
+	runes    []rune
+	excluded bool // set if excluded from canonical composition
+}
+
+// The numeric values of a character
+type numeric struct {
+	r       rune
+	decimal int8
+	digit   int8
+	value   float64
+}
+
+// Names decoded from namesData
+var (
+	namesOnce   sync.Once
+	nameRunes   []rune          // code points with names in order
+	nameStrings []string        // names of nameRunes
+	nameLookup  map[string]rune // name to code point
+)
+
+// Decode namesData the first time it is needed
+func loadNames() {
+	namesOnce.Do(func() {
+		n := strings.Count(namesData, "\n") + 1
+		nameRunes = make([]rune, 0, n)
+		nameStrings = make([]string, 0, n)
+		nameLookup = make(map[string]rune, n)
+		var r rune
+		last := ""
+		for _, line := range strings.Split(namesData, "\n") {
+			fields := strings.SplitN(line, " ", 3)
+			delta, err := strconv.ParseInt(fields[0], 16, 32)
+			if err != nil {
+				panic(fmt.Sprintf("unicodedata: bad names data %q", line))
+			}
+			prefix, err := strconv.Atoi(fields[1])
+			if err != nil {
+				panic(fmt.Sprintf("unicodedata: bad names data %q", line))
+			}
+			r += rune(delta)
+			name := last[:prefix] + fields[2]
+			nameRunes = append(nameRunes, r)
+			nameStrings = append(nameStrings, name)
+			nameLookup[name] = r
+			last = name
+		}
+	})
+}
+
+// Hangul syllables are named algorithmically from their jamo, see
+// section 3.12 of the Unicode standard
+const (
+	hangulBase   = 0xAC00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11A7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulCount  = hangulLCount * hangulNCount
+	hangulPrefix = "HANGUL SYLLABLE "
+)
+
+// Short names of the leading consonant, vowel and trailing consonant jamo
+var (
+	jamoL = []string{"G", "GG", "N", "D", "DD", "R", "M", "B", "BB", "S", "SS", "", "J", "JJ", "C", "K", "T", "P", "H"}
+	jamoV = []string{"A", "AE", "YA", "YAE", "EO", "E", "YEO", "YE", "O", "WA", "WAE", "OE", "YO", "U", "WEO", "WE", "WI", "YU", "EU", "YI", "I"}
+	jamoT = []string{"", "G", "GG", "GS", "N", "NJ", "NH", "D", "L", "LG", "LM", "LB", "LS", "LT", "LP", "LH", "M", "B", "BS", "S", "SS", "NG", "J", "C", "K", "T", "P", "H"}
+)
+
+// Finds the index of the longest jamo name which prefixes s
+func findJamo(s string, names []string) (index, length int) {
+	index = -1
+	for i, name := range names {
+		if len(name) >= length && strings.HasPrefix(s, name) {
+			index, length = i, len(name)
+		}
+	}
+	return index, length
+}
+
+// Name returns the name of the character r
+//
+// It returns false if r has no name
+func Name(r rune) (string, bool) {
+	if r >= hangulBase && r < hangulBase+hangulCount {
+		s := r - hangulBase
+		return hangulPrefix + jamoL[s/hangulNCount] + jamoV[(s%hangulNCount)/hangulTCount] + jamoT[s%hangulTCount], true
+	}
+	for _, ideographs := range ideographRanges {
+		if r >= ideographs.lo && r <= ideographs.hi {
+			return fmt.Sprintf("%s%X", ideographs.prefix, r), true
+		}
+	}
+	loadNames()
+	i := sort.Search(len(nameRunes), func(i int) bool { return nameRunes[i] >= r })
+	if i < len(nameRunes) && nameRunes[i] == r {
+		return nameStrings[i], true
+	}
+	return "", false
+}
+
+// Lookup returns the character with the name given
+//
+// Names are matched without regard to case. It returns false if
+// there is no character with that name.
+func Lookup(name string) (rune, bool) {
+	name = strings.ToUpper(name)
+	if strings.HasPrefix(name, hangulPrefix) {
+		s := name[len(hangulPrefix):]
+		l, n := findJamo(s, jamoL)
+		s = s[n:]
+		v, n := findJamo(s, jamoV)
+		s = s[n:]
+		t, n := findJamo(s, jamoT)
+		if l >= 0 && v >= 0 && t >= 0 && n == len(s) {
+			return hangulBase + rune((l*hangulVCount+v)*hangulTCount+t), true
+		}
+		return 0, false
+	}
+	for _, ideographs := range ideographRanges {
+		if strings.HasPrefix(name, ideographs.prefix) {
+			hex := name[len(ideographs.prefix):]
+			if len(hex) < 4 || len(hex) > 5 {
+				continue
+			}
+			r, err := strconv.ParseUint(hex, 16, 32)
+			if err == nil && rune(r) >= ideographs.lo && rune(r) <= ideographs.hi {
+				return rune(r), true
+			}
+		}
+	}
+	loadNames()
+	r, ok := nameLookup[name]
+	return r, ok
+}
+
+// Finds the value for r in runs made by make_tables.py
+func findRun(runs []uint32, r rune) uint8 {
+	i := sort.Search(len(runs), func(i int) bool { return rune(runs[i]>>8) > r })
+	return uint8(runs[i-1])
+}
+
+// Category returns the general category of r, eg "Lu"
+func Category(r rune) string {
+	if r < 0 || r > maxUnicode {
+		return "Cn"
+	}
+	return categoryNames[findRun(categoryRuns, r)]
+}
+
+// EastAsianWidth returns the east asian width of r, eg "W"
+func EastAsianWidth(r rune) string {
+	if r < 0 || r > maxUnicode {
+		return "N"
+	}
+	return eastAsianWidthNames[findRun(eastAsianWidthRuns, r)]
+}
+
+// Combining returns the canonical combining class of r or 0 if it
+// isn't defined
+func Combining(r rune) int {
+	if r < 0 || r > maxUnicode {
+		return 0
+	}
+	return int(findRun(combiningRuns, r))
+}
+
+// Finds the decomposition of r or nil if there isn't one
+func findDecomposition(r rune) *decomposition {
+	i := sort.Search(len(decompositions), func(i int) bool { return decompositions[i].r >= r })
+	if i < len(decompositions) && decompositions[i].r == r {
+		return &decompositions[i]
+	}
+	return nil
+}
+
+// Decomposition returns the decomposition mapping of r in the same
+// format as UnicodeData.txt, eg "<compat> 0020 0308", or "" if there
+// isn't one
+func Decomposition(r rune) string {
+	d := findDecomposition(r)
+	if d == nil {
+		return ""
+	}
+	var out []string
+	if d.tag != "" {
+		out = append(out, d.tag)
+	}
+	for _, c := range d.runes {
+		out = append(out, fmt.Sprintf("%04X", c))
+	}
+	return strings.Join(out, " ")
+}
+
+// Finds the numeric values of r or nil if it hasn't got any
+func findNumeric(r rune) *numeric {
+	i := sort.Search(len(numerics), func(i int) bool { return numerics[i].r >= r })
+	if i < len(numerics) && numerics[i].r == r {
+		return &numerics[i]
+	}
+	return nil
+}
+
+// Decimal returns the decimal value of r
+//
+// It returns false if r isn't a decimal digit
+func Decimal(r rune) (int, bool) {
+	n := findNumeric(r)
+	if n == nil || n.decimal < 0 {
+		return 0, false
+	}
+	return int(n.decimal), true
+}
+
+// Digit returns the digit value of r
+//
+// It returns false if r isn't a digit
+func Digit(r rune) (int, bool) {
+	n := findNumeric(r)
+	if n == nil || n.digit < 0 {
+		return 0, false
+	}
+	return int(n.digit), true
+}
+
+// Numeric returns the numeric value of r
+//
+// It returns false if r hasn't got a numeric value
+func Numeric(r rune) (float64, bool) {
+	n := findNumeric(r)
+	if n == nil {
+		return 0, false
+	}
+	return n.value, true
+}
+
+// The largest valid code point
+const maxUnicode = 0x10FFFF
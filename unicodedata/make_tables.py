@@ -0,0 +1,161 @@
+#!/usr/bin/env python3
+
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+"""
+Write tables.go from the unicodedata module of the running python
+"""
+
+import subprocess
+import unicodedata
+
+MAX_UNICODE = 0x110000
+
+# Prefixes of names which are generated from the code point
+IDEOGRAPH_PREFIXES = (
+    "CJK UNIFIED IDEOGRAPH-",
+    "CJK COMPATIBILITY IDEOGRAPH-",
+    "TANGUT IDEOGRAPH-",
+    "KHITAN SMALL SCRIPT CHARACTER-",
+    "NUSHU CHARACTER-",
+)
+
+# Hangul syllables, see section 3.12 of the Unicode standard
+HANGUL_FIRST = 0xAC00
+HANGUL_LAST = 0xD7A3
+
+def runs(fn):
+    """Find the runs of code points with the same value of fn"""
+    out = []
+    last = None
+    for c in range(MAX_UNICODE):
+        value = fn(chr(c))
+        if value != last:
+            out.append((c, value))
+            last = value
+    return out
+
+def dump_runs(out, name, comment, fn, values=None):
+    """Dump runs of fn as code point << 8 | value"""
+    rs = runs(fn)
+    if values is not None:
+        rs = [(c, values.index(v)) for c, v in rs]
+        out.append("// %s names indexed by the values in %sRuns" % (comment, name))
+        out.append("var %sNames = []string{%s}" % (name, ", ".join('"%s"' % v for v in values)))
+        out.append("")
+    out.append("// Runs of code points with the same %s: each entry is the" % comment.lower())
+    out.append("// first code point of the run << 8 | its value")
+    out.append("var %sRuns = []uint32{" % name)
+    for i in range(0, len(rs), 8):
+        out.append("\t" + " ".join("0x%08x," % (c << 8 | v) for c, v in rs[i:i+8]))
+    out.append("}")
+    out.append("")
+
+def is_algorithmic(c, name):
+    if HANGUL_FIRST <= c <= HANGUL_LAST:
+        return True
+    for prefix in IDEOGRAPH_PREFIXES:
+        if name == "%s%X" % (prefix, c):
+            return True
+    return False
+
+def dump_names(out):
+    ideographs = []
+    lines = []
+    last_c = 0
+    last_name = ""
+    for c in range(MAX_UNICODE):
+        name = unicodedata.name(chr(c), None)
+        if name is None:
+            continue
+        if is_algorithmic(c, name):
+            if HANGUL_FIRST <= c <= HANGUL_LAST:
+                continue
+            prefix = name[:name.rindex("-")+1]
+            if ideographs and ideographs[-1][2] == prefix and ideographs[-1][1] == c - 1:
+                ideographs[-1][1] = c
+            else:
+                ideographs.append([c, c, prefix])
+            continue
+        prefix = 0
+        while prefix < min(len(name), len(last_name)) and name[prefix] == last_name[prefix]:
+            prefix += 1
+        lines.append("%x %d %s" % (c - last_c, prefix, name[prefix:]))
+        last_c = c
+        last_name = name
+    out.append("// Ranges of ideographs named by their prefix and hex code point")
+    out.append("var ideographRanges = []ideographRange{")
+    for lo, hi, prefix in ideographs:
+        out.append('\t{0x%04X, 0x%04X, "%s"},' % (lo, hi, prefix))
+    out.append("}")
+    out.append("")
+    out.append("// Names of the other characters, one per line. Each line has")
+    out.append("// the difference from the previous code point in hex, the number")
+    out.append("// of leading bytes shared with the previous name and the rest of")
+    out.append("// the name.")
+    out.append("const namesData = `" + "\n".join(lines) + "`")
+    out.append("")
+
+def dump_decompositions(out):
+    out.append("// Character decompositions sorted by code point")
+    out.append("var decompositions = []decomposition{")
+    for c in range(MAX_UNICODE):
+        ch = chr(c)
+        d = unicodedata.decomposition(ch)
+        if not d:
+            continue
+        parts = d.split()
+        tag = ""
+        if parts[0].startswith("<"):
+            tag = parts[0]
+            parts = parts[1:]
+        runes = ", ".join("0x%s" % p for p in parts)
+        # Canonical decompositions which don't recompose under NFC
+        # are excluded from composition
+        excluded = tag == "" and unicodedata.normalize("NFC", ch) != ch
+        out.append('\t{0x%04X, "%s", []rune{%s}, %s},' % (c, tag, runes, "true" if excluded else "false"))
+    out.append("}")
+    out.append("")
+
+def dump_numerics(out):
+    out.append("// Numeric values of characters sorted by code point, with -1 for")
+    out.append("// no decimal or digit value")
+    out.append("var numerics = []numeric{")
+    for c in range(MAX_UNICODE):
+        ch = chr(c)
+        value = unicodedata.numeric(ch, None)
+        if value is None:
+            continue
+        out.append('\t{0x%04X, %d, %d, %r},' % (c, unicodedata.decimal(ch, -1), unicodedata.digit(ch, -1), value))
+    out.append("}")
+    out.append("")
+
+def main():
+    path = "tables.go"
+    out = ["""// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Tables generated by make_tables.py - do not edit
+
+package unicodedata
+"""]
+    out.append("// Version of the Unicode database the tables were made from")
+    out.append('const unidataVersion = "%s"' % unicodedata.unidata_version)
+    out.append("")
+    categories = sorted(set(v for c, v in runs(unicodedata.category)))
+    dump_runs(out, "category", "General category", unicodedata.category, categories)
+    widths = sorted(set(v for c, v in runs(unicodedata.east_asian_width)))
+    dump_runs(out, "eastAsianWidth", "East Asian width", unicodedata.east_asian_width, widths)
+    dump_runs(out, "combining", "Canonical combining class", unicodedata.combining)
+    dump_decompositions(out)
+    dump_numerics(out)
+    dump_names(out)
+    with open(path, "w") as f:
+        f.write("\n".join(out))
+    subprocess.check_call(["gofmt", "-w", path])
+    print("Written %s" % path)
+
+main()
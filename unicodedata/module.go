@@ -0,0 +1,240 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// unicodedata module
+
+package unicodedata
+
+import (
+	"unicode/utf8"
+
+	"github.com/go-python/gpython/py"
+)
+
+const unicodedata_doc = `This module provides access to the Unicode Character Database which
+defines character properties for all Unicode characters. The data in
+this database is based on the UnicodeData.txt file version
+` + unidataVersion + ` which is publicly available from ftp://ftp.unicode.org/.
+
+The module uses the same names and symbols as defined by the
+UnicodeData File Format ` + unidataVersion + `.`
+
+// Returns the character in the single character string arg
+func getChar(name string, arg py.Object) (rune, error) {
+	s, ok := arg.(py.String)
+	if !ok || utf8.RuneCountInString(string(s)) != 1 {
+		return 0, py.ExceptionNewf(py.TypeError, "%s() argument 1 must be a unicode character, not %s", name, arg.Type().Name)
+	}
+	r, _ := utf8.DecodeRuneInString(string(s))
+	return r, nil
+}
+
+// Parses the (chr[, default]) arguments of name
+func parseCharDefault(name string, args py.Tuple) (r rune, def py.Object, err error) {
+	var arg py.Object
+	err = py.UnpackTuple(args, nil, name, 1, 2, &arg, &def)
+	if err != nil {
+		return 0, nil, err
+	}
+	r, err = getChar(name, arg)
+	return r, def, err
+}
+
+const unicodedata_name_doc = `name(chr[, default])
+
+Returns the name assigned to the character chr as a string. If no
+name is defined, default is returned, or, if not given, ValueError is
+raised.`
+
+func unicodedata_name(self py.Object, args py.Tuple) (py.Object, error) {
+	r, def, err := parseCharDefault("name", args)
+	if err != nil {
+		return nil, err
+	}
+	if name, ok := Name(r); ok {
+		return py.String(name), nil
+	}
+	if def != nil {
+		return def, nil
+	}
+	return nil, py.ExceptionNewf(py.ValueError, "no such name")
+}
+
+const unicodedata_lookup_doc = `lookup(name)
+
+Look up character by name.  If a character with the given name is
+found, return the corresponding character.  If not found, KeyError is
+raised.`
+
+func unicodedata_lookup(self py.Object, arg py.Object) (py.Object, error) {
+	name, ok := arg.(py.String)
+	if !ok {
+		return nil, py.ExceptionNewf(py.TypeError, "lookup() argument must be str, not %s", arg.Type().Name)
+	}
+	r, ok := Lookup(string(name))
+	if !ok {
+		return nil, py.ExceptionNewf(py.KeyError, "undefined character name '%s'", string(name))
+	}
+	return py.String(string(r)), nil
+}
+
+const unicodedata_category_doc = `category(chr)
+
+Returns the general category assigned to the character chr as
+string.`
+
+func unicodedata_category(self py.Object, arg py.Object) (py.Object, error) {
+	r, err := getChar("category", arg)
+	if err != nil {
+		return nil, err
+	}
+	return py.String(Category(r)), nil
+}
+
+const unicodedata_east_asian_width_doc = `east_asian_width(chr)
+
+Returns the east asian width assigned to the character chr as
+string.`
+
+func unicodedata_east_asian_width(self py.Object, arg py.Object) (py.Object, error) {
+	r, err := getChar("east_asian_width", arg)
+	if err != nil {
+		return nil, err
+	}
+	return py.String(EastAsianWidth(r)), nil
+}
+
+const unicodedata_combining_doc = `combining(chr)
+
+Returns the canonical combining class assigned to the character chr
+as integer. Returns 0 if no combining class is defined.`
+
+func unicodedata_combining(self py.Object, arg py.Object) (py.Object, error) {
+	r, err := getChar("combining", arg)
+	if err != nil {
+		return nil, err
+	}
+	return py.Int(Combining(r)), nil
+}
+
+const unicodedata_decomposition_doc = `decomposition(chr)
+
+Returns the character decomposition mapping assigned to the character
+chr as string. An empty string is returned in case no such mapping is
+defined.`
+
+func unicodedata_decomposition(self py.Object, arg py.Object) (py.Object, error) {
+	r, err := getChar("decomposition", arg)
+	if err != nil {
+		return nil, err
+	}
+	return py.String(Decomposition(r)), nil
+}
+
+const unicodedata_decimal_doc = `decimal(chr[, default])
+
+Returns the decimal value assigned to the character chr as integer.
+If no such value is defined, default is returned, or, if not given,
+ValueError is raised.`
+
+func unicodedata_decimal(self py.Object, args py.Tuple) (py.Object, error) {
+	r, def, err := parseCharDefault("decimal", args)
+	if err != nil {
+		return nil, err
+	}
+	if value, ok := Decimal(r); ok {
+		return py.Int(value), nil
+	}
+	if def != nil {
+		return def, nil
+	}
+	return nil, py.ExceptionNewf(py.ValueError, "not a decimal")
+}
+
+const unicodedata_digit_doc = `digit(chr[, default])
+
+Returns the digit value assigned to the character chr as integer.
+If no such value is defined, default is returned, or, if not given,
+ValueError is raised.`
+
+func unicodedata_digit(self py.Object, args py.Tuple) (py.Object, error) {
+	r, def, err := parseCharDefault("digit", args)
+	if err != nil {
+		return nil, err
+	}
+	if value, ok := Digit(r); ok {
+		return py.Int(value), nil
+	}
+	if def != nil {
+		return def, nil
+	}
+	return nil, py.ExceptionNewf(py.ValueError, "not a digit")
+}
+
+const unicodedata_numeric_doc = `numeric(chr[, default])
+
+Returns the numeric value assigned to the character chr as float.
+If no such value is defined, default is returned, or, if not given,
+ValueError is raised.`
+
+func unicodedata_numeric(self py.Object, args py.Tuple) (py.Object, error) {
+	r, def, err := parseCharDefault("numeric", args)
+	if err != nil {
+		return nil, err
+	}
+	if value, ok := Numeric(r); ok {
+		return py.Float(value), nil
+	}
+	if def != nil {
+		return def, nil
+	}
+	return nil, py.ExceptionNewf(py.ValueError, "not a numeric character")
+}
+
+const unicodedata_normalize_doc = `normalize(form, unistr)
+
+Return the normal form 'form' for the Unicode string unistr.  Valid
+values for form are 'NFC', 'NFKC', 'NFD', and 'NFKD'.`
+
+func unicodedata_normalize(self py.Object, args py.Tuple) (py.Object, error) {
+	var formObj, strObj py.Object
+	err := py.ParseTuple(args, "UU:normalize", &formObj, &strObj)
+	if err != nil {
+		return nil, err
+	}
+	var form Form
+	switch formObj.(py.String) {
+	case "NFC":
+		form = NFC
+	case "NFD":
+		form = NFD
+	case "NFKC":
+		form = NFKC
+	case "NFKD":
+		form = NFKD
+	default:
+		return nil, py.ExceptionNewf(py.ValueError, "invalid normalization form")
+	}
+	return py.String(Normalize(form, string(strObj.(py.String)))), nil
+}
+
+// Initialise the module
+func init() {
+	methods := []*py.Method{
+		py.MustNewMethod("category", unicodedata_category, 0, unicodedata_category_doc),
+		py.MustNewMethod("combining", unicodedata_combining, 0, unicodedata_combining_doc),
+		py.MustNewMethod("decimal", unicodedata_decimal, 0, unicodedata_decimal_doc),
+		py.MustNewMethod("decomposition", unicodedata_decomposition, 0, unicodedata_decomposition_doc),
+		py.MustNewMethod("digit", unicodedata_digit, 0, unicodedata_digit_doc),
+		py.MustNewMethod("east_asian_width", unicodedata_east_asian_width, 0, unicodedata_east_asian_width_doc),
+		py.MustNewMethod("lookup", unicodedata_lookup, 0, unicodedata_lookup_doc),
+		py.MustNewMethod("name", unicodedata_name, 0, unicodedata_name_doc),
+		py.MustNewMethod("normalize", unicodedata_normalize, 0, unicodedata_normalize_doc),
+		py.MustNewMethod("numeric", unicodedata_numeric, 0, unicodedata_numeric_doc),
+	}
+	globals := py.StringDict{
+		"unidata_version": py.String(unidataVersion),
+	}
+	py.NewModule("unicodedata", unicodedata_doc, methods, globals)
+}
@@ -0,0 +1,143 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Unicode normalization, see Unicode Standard Annex #15
+
+package unicodedata
+
+import (
+	"sort"
+	"sync"
+)
+
+// Form is a Unicode normalization form
+type Form int
+
+// Normalization forms
+const (
+	NFC Form = iota
+	NFD
+	NFKC
+	NFKD
+)
+
+// Hangul jamo counts for composition
+const (
+	hangulLLast = hangulLBase + hangulLCount
+	hangulVLast = hangulVBase + hangulVCount
+	hangulTLast = hangulTBase + hangulTCount
+)
+
+// Canonical compositions keyed by the pair of characters they
+// decompose into
+var (
+	compositionsOnce sync.Once
+	compositions     map[[2]rune]rune
+)
+
+// Build the compositions the first time they are needed
+func loadCompositions() {
+	compositionsOnce.Do(func() {
+		compositions = make(map[[2]rune]rune)
+		for _, d := range decompositions {
+			if d.tag == "" && !d.excluded && len(d.runes) == 2 {
+				compositions[[2]rune{d.runes[0], d.runes[1]}] = d.r
+			}
+		}
+	})
+}
+
+// Appends the full decomposition of r to out
+func decompose(out []rune, r rune, compat bool) []rune {
+	if r >= hangulBase && r < hangulBase+hangulCount {
+		s := r - hangulBase
+		out = append(out, hangulLBase+s/hangulNCount, hangulVBase+(s%hangulNCount)/hangulTCount)
+		if t := s % hangulTCount; t != 0 {
+			out = append(out, hangulTBase+t)
+		}
+		return out
+	}
+	d := findDecomposition(r)
+	if d == nil || (d.tag != "" && !compat) {
+		return append(out, r)
+	}
+	for _, c := range d.runes {
+		out = decompose(out, c, compat)
+	}
+	return out
+}
+
+// Returns the composition of a and b if there is one
+func compose(a, b rune) (rune, bool) {
+	if a >= hangulLBase && a < hangulLLast && b >= hangulVBase && b < hangulVLast {
+		return hangulBase + ((a-hangulLBase)*hangulVCount+(b-hangulVBase))*hangulTCount, true
+	}
+	if a >= hangulBase && a < hangulBase+hangulCount && (a-hangulBase)%hangulTCount == 0 && b > hangulTBase && b < hangulTLast {
+		return a + b - hangulTBase, true
+	}
+	loadCompositions()
+	r, ok := compositions[[2]rune{a, b}]
+	return r, ok
+}
+
+// Sorts runs of non starters into canonical order
+func canonicalOrder(rs []rune) {
+	for i := 0; i < len(rs); {
+		if Combining(rs[i]) == 0 {
+			i++
+			continue
+		}
+		j := i + 1
+		for j < len(rs) && Combining(rs[j]) != 0 {
+			j++
+		}
+		run := rs[i:j]
+		sort.SliceStable(run, func(a, b int) bool {
+			return Combining(run[a]) < Combining(run[b])
+		})
+		i = j
+	}
+}
+
+// Canonically composes decomposed rs in place
+func canonicalCompose(rs []rune) []rune {
+	if len(rs) == 0 {
+		return rs
+	}
+	starter := 0
+	lastClass := Combining(rs[0])
+	if lastClass != 0 {
+		// Nothing can compose with a leading non starter
+		lastClass = 256
+	}
+	n := 1
+	for _, r := range rs[1:] {
+		class := Combining(r)
+		if composite, ok := compose(rs[starter], r); ok && (lastClass < class || lastClass == 0) {
+			rs[starter] = composite
+			continue
+		}
+		if class == 0 {
+			starter = n
+		}
+		lastClass = class
+		rs[n] = r
+		n++
+	}
+	return rs[:n]
+}
+
+// Normalize returns s converted to the normalization form given
+func Normalize(form Form, s string) string {
+	compat := form == NFKC || form == NFKD
+	rs := make([]rune, 0, len(s))
+	for _, r := range s {
+		rs = decompose(rs, r, compat)
+	}
+	canonicalOrder(rs)
+	if form == NFC || form == NFKC {
+		rs = canonicalCompose(rs)
+	}
+	return string(rs)
+}
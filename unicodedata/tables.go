@@ -0,0 +1,41177 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Tables generated by make_tables.py - do not edit
+
+package unicodedata
+
+// Version of the Unicode database the tables were made from
+const unidataVersion = "14.0.0"
+
+// General category names indexed by the values in categoryRuns
+var categoryNames = []string{"Cc", "Cf", "Cn", "Co", "Cs", "Ll", "Lm", "Lo", "Lt", "Lu", "Mc", "Me", "Mn", "Nd", "Nl", "No", "Pc", "Pd", "Pe", "Pf", "Pi", "Po", "Ps", "Sc", "Sk", "Sm", "So", "Zl", "Zp", "Zs"}
+
+// Runs of code points with the same general category: each entry is the
+// first code point of the run << 8 | its value
+var categoryRuns = []uint32{
+	0x00000000, 0x0000201d, 0x00002115, 0x00002417, 0x00002515, 0x00002816, 0x00002912, 0x00002a15,
+	0x00002b19, 0x00002c15, 0x00002d11, 0x00002e15, 0x0000300d, 0x00003a15, 0x00003c19, 0x00003f15,
+	0x00004109, 0x00005b16, 0x00005c15, 0x00005d12, 0x00005e18, 0x00005f10, 0x00006018, 0x00006105,
+	0x00007b16, 0x00007c19, 0x00007d12, 0x00007e19, 0x00007f00, 0x0000a01d, 0x0000a115, 0x0000a217,
+	0x0000a61a, 0x0000a715, 0x0000a818, 0x0000a91a, 0x0000aa07, 0x0000ab14, 0x0000ac19, 0x0000ad01,
+	0x0000ae1a, 0x0000af18, 0x0000b01a, 0x0000b119, 0x0000b20f, 0x0000b418, 0x0000b505, 0x0000b615,
+	0x0000b818, 0x0000b90f, 0x0000ba07, 0x0000bb13, 0x0000bc0f, 0x0000bf15, 0x0000c009, 0x0000d719,
+	0x0000d809, 0x0000df05, 0x0000f719, 0x0000f805, 0x00010009, 0x00010105, 0x00010209, 0x00010305,
+	0x00010409, 0x00010505, 0x00010609, 0x00010705, 0x00010809, 0x00010905, 0x00010a09, 0x00010b05,
+	0x00010c09, 0x00010d05, 0x00010e09, 0x00010f05, 0x00011009, 0x00011105, 0x00011209, 0x00011305,
+	0x00011409, 0x00011505, 0x00011609, 0x00011705, 0x00011809, 0x00011905, 0x00011a09, 0x00011b05,
+	0x00011c09, 0x00011d05, 0x00011e09, 0x00011f05, 0x00012009, 0x00012105, 0x00012209, 0x00012305,
+	0x00012409, 0x00012505, 0x00012609, 0x00012705, 0x00012809, 0x00012905, 0x00012a09, 0x00012b05,
+	0x00012c09, 0x00012d05, 0x00012e09, 0x00012f05, 0x00013009, 0x00013105, 0x00013209, 0x00013305,
+	0x00013409, 0x00013505, 0x00013609, 0x00013705, 0x00013909, 0x00013a05, 0x00013b09, 0x00013c05,
+	0x00013d09, 0x00013e05, 0x00013f09, 0x00014005, 0x00014109, 0x00014205, 0x00014309, 0x00014405,
+	0x00014509, 0x00014605, 0x00014709, 0x00014805, 0x00014a09, 0x00014b05, 0x00014c09, 0x00014d05,
+	0x00014e09, 0x00014f05, 0x00015009, 0x00015105, 0x00015209, 0x00015305, 0x00015409, 0x00015505,
+	0x00015609, 0x00015705, 0x00015809, 0x00015905, 0x00015a09, 0x00015b05, 0x00015c09, 0x00015d05,
+	0x00015e09, 0x00015f05, 0x00016009, 0x00016105, 0x00016209, 0x00016305, 0x00016409, 0x00016505,
+	0x00016609, 0x00016705, 0x00016809, 0x00016905, 0x00016a09, 0x00016b05, 0x00016c09, 0x00016d05,
+	0x00016e09, 0x00016f05, 0x00017009, 0x00017105, 0x00017209, 0x00017305, 0x00017409, 0x00017505,
+	0x00017609, 0x00017705, 0x00017809, 0x00017a05, 0x00017b09, 0x00017c05, 0x00017d09, 0x00017e05,
+	0x00018109, 0x00018305, 0x00018409, 0x00018505, 0x00018609, 0x00018805, 0x00018909, 0x00018c05,
+	0x00018e09, 0x00019205, 0x00019309, 0x00019505, 0x00019609, 0x00019905, 0x00019c09, 0x00019e05,
+	0x00019f09, 0x0001a105, 0x0001a209, 0x0001a305, 0x0001a409, 0x0001a505, 0x0001a609, 0x0001a805,
+	0x0001a909, 0x0001aa05, 0x0001ac09, 0x0001ad05, 0x0001ae09, 0x0001b005, 0x0001b109, 0x0001b405,
+	0x0001b509, 0x0001b605, 0x0001b709, 0x0001b905, 0x0001bb07, 0x0001bc09, 0x0001bd05, 0x0001c007,
+	0x0001c409, 0x0001c508, 0x0001c605, 0x0001c709, 0x0001c808, 0x0001c905, 0x0001ca09, 0x0001cb08,
+	0x0001cc05, 0x0001cd09, 0x0001ce05, 0x0001cf09, 0x0001d005, 0x0001d109, 0x0001d205, 0x0001d309,
+	0x0001d405, 0x0001d509, 0x0001d605, 0x0001d709, 0x0001d805, 0x0001d909, 0x0001da05, 0x0001db09,
+	0x0001dc05, 0x0001de09, 0x0001df05, 0x0001e009, 0x0001e105, 0x0001e209, 0x0001e305, 0x0001e409,
+	0x0001e505, 0x0001e609, 0x0001e705, 0x0001e809, 0x0001e905, 0x0001ea09, 0x0001eb05, 0x0001ec09,
+	0x0001ed05, 0x0001ee09, 0x0001ef05, 0x0001f109, 0x0001f208, 0x0001f305, 0x0001f409, 0x0001f505,
+	0x0001f609, 0x0001f905, 0x0001fa09, 0x0001fb05, 0x0001fc09, 0x0001fd05, 0x0001fe09, 0x0001ff05,
+	0x00020009, 0x00020105, 0x00020209, 0x00020305, 0x00020409, 0x00020505, 0x00020609, 0x00020705,
+	0x00020809, 0x00020905, 0x00020a09, 0x00020b05, 0x00020c09, 0x00020d05, 0x00020e09, 0x00020f05,
+	0x00021009, 0x00021105, 0x00021209, 0x00021305, 0x00021409, 0x00021505, 0x00021609, 0x00021705,
+	0x00021809, 0x00021905, 0x00021a09, 0x00021b05, 0x00021c09, 0x00021d05, 0x00021e09, 0x00021f05,
+	0x00022009, 0x00022105, 0x00022209, 0x00022305, 0x00022409, 0x00022505, 0x00022609, 0x00022705,
+	0x00022809, 0x00022905, 0x00022a09, 0x00022b05, 0x00022c09, 0x00022d05, 0x00022e09, 0x00022f05,
+	0x00023009, 0x00023105, 0x00023209, 0x00023305, 0x00023a09, 0x00023c05, 0x00023d09, 0x00023f05,
+	0x00024109, 0x00024205, 0x00024309, 0x00024705, 0x00024809, 0x00024905, 0x00024a09, 0x00024b05,
+	0x00024c09, 0x00024d05, 0x00024e09, 0x00024f05, 0x00029407, 0x00029505, 0x0002b006, 0x0002c218,
+	0x0002c606, 0x0002d218, 0x0002e006, 0x0002e518, 0x0002ec06, 0x0002ed18, 0x0002ee06, 0x0002ef18,
+	0x0003000c, 0x00037009, 0x00037105, 0x00037209, 0x00037305, 0x00037406, 0x00037518, 0x00037609,
+	0x00037705, 0x00037802, 0x00037a06, 0x00037b05, 0x00037e15, 0x00037f09, 0x00038002, 0x00038418,
+	0x00038609, 0x00038715, 0x00038809, 0x00038b02, 0x00038c09, 0x00038d02, 0x00038e09, 0x00039005,
+	0x00039109, 0x0003a202, 0x0003a309, 0x0003ac05, 0x0003cf09, 0x0003d005, 0x0003d209, 0x0003d505,
+	0x0003d809, 0x0003d905, 0x0003da09, 0x0003db05, 0x0003dc09, 0x0003dd05, 0x0003de09, 0x0003df05,
+	0x0003e009, 0x0003e105, 0x0003e209, 0x0003e305, 0x0003e409, 0x0003e505, 0x0003e609, 0x0003e705,
+	0x0003e809, 0x0003e905, 0x0003ea09, 0x0003eb05, 0x0003ec09, 0x0003ed05, 0x0003ee09, 0x0003ef05,
+	0x0003f409, 0x0003f505, 0x0003f619, 0x0003f709, 0x0003f805, 0x0003f909, 0x0003fb05, 0x0003fd09,
+	0x00043005, 0x00046009, 0x00046105, 0x00046209, 0x00046305, 0x00046409, 0x00046505, 0x00046609,
+	0x00046705, 0x00046809, 0x00046905, 0x00046a09, 0x00046b05, 0x00046c09, 0x00046d05, 0x00046e09,
+	0x00046f05, 0x00047009, 0x00047105, 0x00047209, 0x00047305, 0x00047409, 0x00047505, 0x00047609,
+	0x00047705, 0x00047809, 0x00047905, 0x00047a09, 0x00047b05, 0x00047c09, 0x00047d05, 0x00047e09,
+	0x00047f05, 0x00048009, 0x00048105, 0x0004821a, 0x0004830c, 0x0004880b, 0x00048a09, 0x00048b05,
+	0x00048c09, 0x00048d05, 0x00048e09, 0x00048f05, 0x00049009, 0x00049105, 0x00049209, 0x00049305,
+	0x00049409, 0x00049505, 0x00049609, 0x00049705, 0x00049809, 0x00049905, 0x00049a09, 0x00049b05,
+	0x00049c09, 0x00049d05, 0x00049e09, 0x00049f05, 0x0004a009, 0x0004a105, 0x0004a209, 0x0004a305,
+	0x0004a409, 0x0004a505, 0x0004a609, 0x0004a705, 0x0004a809, 0x0004a905, 0x0004aa09, 0x0004ab05,
+	0x0004ac09, 0x0004ad05, 0x0004ae09, 0x0004af05, 0x0004b009, 0x0004b105, 0x0004b209, 0x0004b305,
+	0x0004b409, 0x0004b505, 0x0004b609, 0x0004b705, 0x0004b809, 0x0004b905, 0x0004ba09, 0x0004bb05,
+	0x0004bc09, 0x0004bd05, 0x0004be09, 0x0004bf05, 0x0004c009, 0x0004c205, 0x0004c309, 0x0004c405,
+	0x0004c509, 0x0004c605, 0x0004c709, 0x0004c805, 0x0004c909, 0x0004ca05, 0x0004cb09, 0x0004cc05,
+	0x0004cd09, 0x0004ce05, 0x0004d009, 0x0004d105, 0x0004d209, 0x0004d305, 0x0004d409, 0x0004d505,
+	0x0004d609, 0x0004d705, 0x0004d809, 0x0004d905, 0x0004da09, 0x0004db05, 0x0004dc09, 0x0004dd05,
+	0x0004de09, 0x0004df05, 0x0004e009, 0x0004e105, 0x0004e209, 0x0004e305, 0x0004e409, 0x0004e505,
+	0x0004e609, 0x0004e705, 0x0004e809, 0x0004e905, 0x0004ea09, 0x0004eb05, 0x0004ec09, 0x0004ed05,
+	0x0004ee09, 0x0004ef05, 0x0004f009, 0x0004f105, 0x0004f209, 0x0004f305, 0x0004f409, 0x0004f505,
+	0x0004f609, 0x0004f705, 0x0004f809, 0x0004f905, 0x0004fa09, 0x0004fb05, 0x0004fc09, 0x0004fd05,
+	0x0004fe09, 0x0004ff05, 0x00050009, 0x00050105, 0x00050209, 0x00050305, 0x00050409, 0x00050505,
+	0x00050609, 0x00050705, 0x00050809, 0x00050905, 0x00050a09, 0x00050b05, 0x00050c09, 0x00050d05,
+	0x00050e09, 0x00050f05, 0x00051009, 0x00051105, 0x00051209, 0x00051305, 0x00051409, 0x00051505,
+	0x00051609, 0x00051705, 0x00051809, 0x00051905, 0x00051a09, 0x00051b05, 0x00051c09, 0x00051d05,
+	0x00051e09, 0x00051f05, 0x00052009, 0x00052105, 0x00052209, 0x00052305, 0x00052409, 0x00052505,
+	0x00052609, 0x00052705, 0x00052809, 0x00052905, 0x00052a09, 0x00052b05, 0x00052c09, 0x00052d05,
+	0x00052e09, 0x00052f05, 0x00053002, 0x00053109, 0x00055702, 0x00055906, 0x00055a15, 0x00056005,
+	0x00058915, 0x00058a11, 0x00058b02, 0x00058d1a, 0x00058f17, 0x00059002, 0x0005910c, 0x0005be11,
+	0x0005bf0c, 0x0005c015, 0x0005c10c, 0x0005c315, 0x0005c40c, 0x0005c615, 0x0005c70c, 0x0005c802,
+	0x0005d007, 0x0005eb02, 0x0005ef07, 0x0005f315, 0x0005f502, 0x00060001, 0x00060619, 0x00060915,
+	0x00060b17, 0x00060c15, 0x00060e1a, 0x0006100c, 0x00061b15, 0x00061c01, 0x00061d15, 0x00062007,
+	0x00064006, 0x00064107, 0x00064b0c, 0x0006600d, 0x00066a15, 0x00066e07, 0x0006700c, 0x00067107,
+	0x0006d415, 0x0006d507, 0x0006d60c, 0x0006dd01, 0x0006de1a, 0x0006df0c, 0x0006e506, 0x0006e70c,
+	0x0006e91a, 0x0006ea0c, 0x0006ee07, 0x0006f00d, 0x0006fa07, 0x0006fd1a, 0x0006ff07, 0x00070015,
+	0x00070e02, 0x00070f01, 0x00071007, 0x0007110c, 0x00071207, 0x0007300c, 0x00074b02, 0x00074d07,
+	0x0007a60c, 0x0007b107, 0x0007b202, 0x0007c00d, 0x0007ca07, 0x0007eb0c, 0x0007f406, 0x0007f61a,
+	0x0007f715, 0x0007fa06, 0x0007fb02, 0x0007fd0c, 0x0007fe17, 0x00080007, 0x0008160c, 0x00081a06,
+	0x00081b0c, 0x00082406, 0x0008250c, 0x00082806, 0x0008290c, 0x00082e02, 0x00083015, 0x00083f02,
+	0x00084007, 0x0008590c, 0x00085c02, 0x00085e15, 0x00085f02, 0x00086007, 0x00086b02, 0x00087007,
+	0x00088818, 0x00088907, 0x00088f02, 0x00089001, 0x00089202, 0x0008980c, 0x0008a007, 0x0008c906,
+	0x0008ca0c, 0x0008e201, 0x0008e30c, 0x0009030a, 0x00090407, 0x00093a0c, 0x00093b0a, 0x00093c0c,
+	0x00093d07, 0x00093e0a, 0x0009410c, 0x0009490a, 0x00094d0c, 0x00094e0a, 0x00095007, 0x0009510c,
+	0x00095807, 0x0009620c, 0x00096415, 0x0009660d, 0x00097015, 0x00097106, 0x00097207, 0x0009810c,
+	0x0009820a, 0x00098402, 0x00098507, 0x00098d02, 0x00098f07, 0x00099102, 0x00099307, 0x0009a902,
+	0x0009aa07, 0x0009b102, 0x0009b207, 0x0009b302, 0x0009b607, 0x0009ba02, 0x0009bc0c, 0x0009bd07,
+	0x0009be0a, 0x0009c10c, 0x0009c502, 0x0009c70a, 0x0009c902, 0x0009cb0a, 0x0009cd0c, 0x0009ce07,
+	0x0009cf02, 0x0009d70a, 0x0009d802, 0x0009dc07, 0x0009de02, 0x0009df07, 0x0009e20c, 0x0009e402,
+	0x0009e60d, 0x0009f007, 0x0009f217, 0x0009f40f, 0x0009fa1a, 0x0009fb17, 0x0009fc07, 0x0009fd15,
+	0x0009fe0c, 0x0009ff02, 0x000a010c, 0x000a030a, 0x000a0402, 0x000a0507, 0x000a0b02, 0x000a0f07,
+	0x000a1102, 0x000a1307, 0x000a2902, 0x000a2a07, 0x000a3102, 0x000a3207, 0x000a3402, 0x000a3507,
+	0x000a3702, 0x000a3807, 0x000a3a02, 0x000a3c0c, 0x000a3d02, 0x000a3e0a, 0x000a410c, 0x000a4302,
+	0x000a470c, 0x000a4902, 0x000a4b0c, 0x000a4e02, 0x000a510c, 0x000a5202, 0x000a5907, 0x000a5d02,
+	0x000a5e07, 0x000a5f02, 0x000a660d, 0x000a700c, 0x000a7207, 0x000a750c, 0x000a7615, 0x000a7702,
+	0x000a810c, 0x000a830a, 0x000a8402, 0x000a8507, 0x000a8e02, 0x000a8f07, 0x000a9202, 0x000a9307,
+	0x000aa902, 0x000aaa07, 0x000ab102, 0x000ab207, 0x000ab402, 0x000ab507, 0x000aba02, 0x000abc0c,
+	0x000abd07, 0x000abe0a, 0x000ac10c, 0x000ac602, 0x000ac70c, 0x000ac90a, 0x000aca02, 0x000acb0a,
+	0x000acd0c, 0x000ace02, 0x000ad007, 0x000ad102, 0x000ae007, 0x000ae20c, 0x000ae402, 0x000ae60d,
+	0x000af015, 0x000af117, 0x000af202, 0x000af907, 0x000afa0c, 0x000b0002, 0x000b010c, 0x000b020a,
+	0x000b0402, 0x000b0507, 0x000b0d02, 0x000b0f07, 0x000b1102, 0x000b1307, 0x000b2902, 0x000b2a07,
+	0x000b3102, 0x000b3207, 0x000b3402, 0x000b3507, 0x000b3a02, 0x000b3c0c, 0x000b3d07, 0x000b3e0a,
+	0x000b3f0c, 0x000b400a, 0x000b410c, 0x000b4502, 0x000b470a, 0x000b4902, 0x000b4b0a, 0x000b4d0c,
+	0x000b4e02, 0x000b550c, 0x000b570a, 0x000b5802, 0x000b5c07, 0x000b5e02, 0x000b5f07, 0x000b620c,
+	0x000b6402, 0x000b660d, 0x000b701a, 0x000b7107, 0x000b720f, 0x000b7802, 0x000b820c, 0x000b8307,
+	0x000b8402, 0x000b8507, 0x000b8b02, 0x000b8e07, 0x000b9102, 0x000b9207, 0x000b9602, 0x000b9907,
+	0x000b9b02, 0x000b9c07, 0x000b9d02, 0x000b9e07, 0x000ba002, 0x000ba307, 0x000ba502, 0x000ba807,
+	0x000bab02, 0x000bae07, 0x000bba02, 0x000bbe0a, 0x000bc00c, 0x000bc10a, 0x000bc302, 0x000bc60a,
+	0x000bc902, 0x000bca0a, 0x000bcd0c, 0x000bce02, 0x000bd007, 0x000bd102, 0x000bd70a, 0x000bd802,
+	0x000be60d, 0x000bf00f, 0x000bf31a, 0x000bf917, 0x000bfa1a, 0x000bfb02, 0x000c000c, 0x000c010a,
+	0x000c040c, 0x000c0507, 0x000c0d02, 0x000c0e07, 0x000c1102, 0x000c1207, 0x000c2902, 0x000c2a07,
+	0x000c3a02, 0x000c3c0c, 0x000c3d07, 0x000c3e0c, 0x000c410a, 0x000c4502, 0x000c460c, 0x000c4902,
+	0x000c4a0c, 0x000c4e02, 0x000c550c, 0x000c5702, 0x000c5807, 0x000c5b02, 0x000c5d07, 0x000c5e02,
+	0x000c6007, 0x000c620c, 0x000c6402, 0x000c660d, 0x000c7002, 0x000c7715, 0x000c780f, 0x000c7f1a,
+	0x000c8007, 0x000c810c, 0x000c820a, 0x000c8415, 0x000c8507, 0x000c8d02, 0x000c8e07, 0x000c9102,
+	0x000c9207, 0x000ca902, 0x000caa07, 0x000cb402, 0x000cb507, 0x000cba02, 0x000cbc0c, 0x000cbd07,
+	0x000cbe0a, 0x000cbf0c, 0x000cc00a, 0x000cc502, 0x000cc60c, 0x000cc70a, 0x000cc902, 0x000cca0a,
+	0x000ccc0c, 0x000cce02, 0x000cd50a, 0x000cd702, 0x000cdd07, 0x000cdf02, 0x000ce007, 0x000ce20c,
+	0x000ce402, 0x000ce60d, 0x000cf002, 0x000cf107, 0x000cf302, 0x000d000c, 0x000d020a, 0x000d0407,
+	0x000d0d02, 0x000d0e07, 0x000d1102, 0x000d1207, 0x000d3b0c, 0x000d3d07, 0x000d3e0a, 0x000d410c,
+	0x000d4502, 0x000d460a, 0x000d4902, 0x000d4a0a, 0x000d4d0c, 0x000d4e07, 0x000d4f1a, 0x000d5002,
+	0x000d5407, 0x000d570a, 0x000d580f, 0x000d5f07, 0x000d620c, 0x000d6402, 0x000d660d, 0x000d700f,
+	0x000d791a, 0x000d7a07, 0x000d8002, 0x000d810c, 0x000d820a, 0x000d8402, 0x000d8507, 0x000d9702,
+	0x000d9a07, 0x000db202, 0x000db307, 0x000dbc02, 0x000dbd07, 0x000dbe02, 0x000dc007, 0x000dc702,
+	0x000dca0c, 0x000dcb02, 0x000dcf0a, 0x000dd20c, 0x000dd502, 0x000dd60c, 0x000dd702, 0x000dd80a,
+	0x000de002, 0x000de60d, 0x000df002, 0x000df20a, 0x000df415, 0x000df502, 0x000e0107, 0x000e310c,
+	0x000e3207, 0x000e340c, 0x000e3b02, 0x000e3f17, 0x000e4007, 0x000e4606, 0x000e470c, 0x000e4f15,
+	0x000e500d, 0x000e5a15, 0x000e5c02, 0x000e8107, 0x000e8302, 0x000e8407, 0x000e8502, 0x000e8607,
+	0x000e8b02, 0x000e8c07, 0x000ea402, 0x000ea507, 0x000ea602, 0x000ea707, 0x000eb10c, 0x000eb207,
+	0x000eb40c, 0x000ebd07, 0x000ebe02, 0x000ec007, 0x000ec502, 0x000ec606, 0x000ec702, 0x000ec80c,
+	0x000ece02, 0x000ed00d, 0x000eda02, 0x000edc07, 0x000ee002, 0x000f0007, 0x000f011a, 0x000f0415,
+	0x000f131a, 0x000f1415, 0x000f151a, 0x000f180c, 0x000f1a1a, 0x000f200d, 0x000f2a0f, 0x000f341a,
+	0x000f350c, 0x000f361a, 0x000f370c, 0x000f381a, 0x000f390c, 0x000f3a16, 0x000f3b12, 0x000f3c16,
+	0x000f3d12, 0x000f3e0a, 0x000f4007, 0x000f4802, 0x000f4907, 0x000f6d02, 0x000f710c, 0x000f7f0a,
+	0x000f800c, 0x000f8515, 0x000f860c, 0x000f8807, 0x000f8d0c, 0x000f9802, 0x000f990c, 0x000fbd02,
+	0x000fbe1a, 0x000fc60c, 0x000fc71a, 0x000fcd02, 0x000fce1a, 0x000fd015, 0x000fd51a, 0x000fd915,
+	0x000fdb02, 0x00100007, 0x00102b0a, 0x00102d0c, 0x0010310a, 0x0010320c, 0x0010380a, 0x0010390c,
+	0x00103b0a, 0x00103d0c, 0x00103f07, 0x0010400d, 0x00104a15, 0x00105007, 0x0010560a, 0x0010580c,
+	0x00105a07, 0x00105e0c, 0x00106107, 0x0010620a, 0x00106507, 0x0010670a, 0x00106e07, 0x0010710c,
+	0x00107507, 0x0010820c, 0x0010830a, 0x0010850c, 0x0010870a, 0x00108d0c, 0x00108e07, 0x00108f0a,
+	0x0010900d, 0x00109a0a, 0x00109d0c, 0x00109e1a, 0x0010a009, 0x0010c602, 0x0010c709, 0x0010c802,
+	0x0010cd09, 0x0010ce02, 0x0010d005, 0x0010fb15, 0x0010fc06, 0x0010fd05, 0x00110007, 0x00124902,
+	0x00124a07, 0x00124e02, 0x00125007, 0x00125702, 0x00125807, 0x00125902, 0x00125a07, 0x00125e02,
+	0x00126007, 0x00128902, 0x00128a07, 0x00128e02, 0x00129007, 0x0012b102, 0x0012b207, 0x0012b602,
+	0x0012b807, 0x0012bf02, 0x0012c007, 0x0012c102, 0x0012c207, 0x0012c602, 0x0012c807, 0x0012d702,
+	0x0012d807, 0x00131102, 0x00131207, 0x00131602, 0x00131807, 0x00135b02, 0x00135d0c, 0x00136015,
+	0x0013690f, 0x00137d02, 0x00138007, 0x0013901a, 0x00139a02, 0x0013a009, 0x0013f602, 0x0013f805,
+	0x0013fe02, 0x00140011, 0x00140107, 0x00166d1a, 0x00166e15, 0x00166f07, 0x0016801d, 0x00168107,
+	0x00169b16, 0x00169c12, 0x00169d02, 0x0016a007, 0x0016eb15, 0x0016ee0e, 0x0016f107, 0x0016f902,
+	0x00170007, 0x0017120c, 0x0017150a, 0x00171602, 0x00171f07, 0x0017320c, 0x0017340a, 0x00173515,
+	0x00173702, 0x00174007, 0x0017520c, 0x00175402, 0x00176007, 0x00176d02, 0x00176e07, 0x00177102,
+	0x0017720c, 0x00177402, 0x00178007, 0x0017b40c, 0x0017b60a, 0x0017b70c, 0x0017be0a, 0x0017c60c,
+	0x0017c70a, 0x0017c90c, 0x0017d415, 0x0017d706, 0x0017d815, 0x0017db17, 0x0017dc07, 0x0017dd0c,
+	0x0017de02, 0x0017e00d, 0x0017ea02, 0x0017f00f, 0x0017fa02, 0x00180015, 0x00180611, 0x00180715,
+	0x00180b0c, 0x00180e01, 0x00180f0c, 0x0018100d, 0x00181a02, 0x00182007, 0x00184306, 0x00184407,
+	0x00187902, 0x00188007, 0x0018850c, 0x00188707, 0x0018a90c, 0x0018aa07, 0x0018ab02, 0x0018b007,
+	0x0018f602, 0x00190007, 0x00191f02, 0x0019200c, 0x0019230a, 0x0019270c, 0x0019290a, 0x00192c02,
+	0x0019300a, 0x0019320c, 0x0019330a, 0x0019390c, 0x00193c02, 0x0019401a, 0x00194102, 0x00194415,
+	0x0019460d, 0x00195007, 0x00196e02, 0x00197007, 0x00197502, 0x00198007, 0x0019ac02, 0x0019b007,
+	0x0019ca02, 0x0019d00d, 0x0019da0f, 0x0019db02, 0x0019de1a, 0x001a0007, 0x001a170c, 0x001a190a,
+	0x001a1b0c, 0x001a1c02, 0x001a1e15, 0x001a2007, 0x001a550a, 0x001a560c, 0x001a570a, 0x001a580c,
+	0x001a5f02, 0x001a600c, 0x001a610a, 0x001a620c, 0x001a630a, 0x001a650c, 0x001a6d0a, 0x001a730c,
+	0x001a7d02, 0x001a7f0c, 0x001a800d, 0x001a8a02, 0x001a900d, 0x001a9a02, 0x001aa015, 0x001aa706,
+	0x001aa815, 0x001aae02, 0x001ab00c, 0x001abe0b, 0x001abf0c, 0x001acf02, 0x001b000c, 0x001b040a,
+	0x001b0507, 0x001b340c, 0x001b350a, 0x001b360c, 0x001b3b0a, 0x001b3c0c, 0x001b3d0a, 0x001b420c,
+	0x001b430a, 0x001b4507, 0x001b4d02, 0x001b500d, 0x001b5a15, 0x001b611a, 0x001b6b0c, 0x001b741a,
+	0x001b7d15, 0x001b7f02, 0x001b800c, 0x001b820a, 0x001b8307, 0x001ba10a, 0x001ba20c, 0x001ba60a,
+	0x001ba80c, 0x001baa0a, 0x001bab0c, 0x001bae07, 0x001bb00d, 0x001bba07, 0x001be60c, 0x001be70a,
+	0x001be80c, 0x001bea0a, 0x001bed0c, 0x001bee0a, 0x001bef0c, 0x001bf20a, 0x001bf402, 0x001bfc15,
+	0x001c0007, 0x001c240a, 0x001c2c0c, 0x001c340a, 0x001c360c, 0x001c3802, 0x001c3b15, 0x001c400d,
+	0x001c4a02, 0x001c4d07, 0x001c500d, 0x001c5a07, 0x001c7806, 0x001c7e15, 0x001c8005, 0x001c8902,
+	0x001c9009, 0x001cbb02, 0x001cbd09, 0x001cc015, 0x001cc802, 0x001cd00c, 0x001cd315, 0x001cd40c,
+	0x001ce10a, 0x001ce20c, 0x001ce907, 0x001ced0c, 0x001cee07, 0x001cf40c, 0x001cf507, 0x001cf70a,
+	0x001cf80c, 0x001cfa07, 0x001cfb02, 0x001d0005, 0x001d2c06, 0x001d6b05, 0x001d7806, 0x001d7905,
+	0x001d9b06, 0x001dc00c, 0x001e0009, 0x001e0105, 0x001e0209, 0x001e0305, 0x001e0409, 0x001e0505,
+	0x001e0609, 0x001e0705, 0x001e0809, 0x001e0905, 0x001e0a09, 0x001e0b05, 0x001e0c09, 0x001e0d05,
+	0x001e0e09, 0x001e0f05, 0x001e1009, 0x001e1105, 0x001e1209, 0x001e1305, 0x001e1409, 0x001e1505,
+	0x001e1609, 0x001e1705, 0x001e1809, 0x001e1905, 0x001e1a09, 0x001e1b05, 0x001e1c09, 0x001e1d05,
+	0x001e1e09, 0x001e1f05, 0x001e2009, 0x001e2105, 0x001e2209, 0x001e2305, 0x001e2409, 0x001e2505,
+	0x001e2609, 0x001e2705, 0x001e2809, 0x001e2905, 0x001e2a09, 0x001e2b05, 0x001e2c09, 0x001e2d05,
+	0x001e2e09, 0x001e2f05, 0x001e3009, 0x001e3105, 0x001e3209, 0x001e3305, 0x001e3409, 0x001e3505,
+	0x001e3609, 0x001e3705, 0x001e3809, 0x001e3905, 0x001e3a09, 0x001e3b05, 0x001e3c09, 0x001e3d05,
+	0x001e3e09, 0x001e3f05, 0x001e4009, 0x001e4105, 0x001e4209, 0x001e4305, 0x001e4409, 0x001e4505,
+	0x001e4609, 0x001e4705, 0x001e4809, 0x001e4905, 0x001e4a09, 0x001e4b05, 0x001e4c09, 0x001e4d05,
+	0x001e4e09, 0x001e4f05, 0x001e5009, 0x001e5105, 0x001e5209, 0x001e5305, 0x001e5409, 0x001e5505,
+	0x001e5609, 0x001e5705, 0x001e5809, 0x001e5905, 0x001e5a09, 0x001e5b05, 0x001e5c09, 0x001e5d05,
+	0x001e5e09, 0x001e5f05, 0x001e6009, 0x001e6105, 0x001e6209, 0x001e6305, 0x001e6409, 0x001e6505,
+	0x001e6609, 0x001e6705, 0x001e6809, 0x001e6905, 0x001e6a09, 0x001e6b05, 0x001e6c09, 0x001e6d05,
+	0x001e6e09, 0x001e6f05, 0x001e7009, 0x001e7105, 0x001e7209, 0x001e7305, 0x001e7409, 0x001e7505,
+	0x001e7609, 0x001e7705, 0x001e7809, 0x001e7905, 0x001e7a09, 0x001e7b05, 0x001e7c09, 0x001e7d05,
+	0x001e7e09, 0x001e7f05, 0x001e8009, 0x001e8105, 0x001e8209, 0x001e8305, 0x001e8409, 0x001e8505,
+	0x001e8609, 0x001e8705, 0x001e8809, 0x001e8905, 0x001e8a09, 0x001e8b05, 0x001e8c09, 0x001e8d05,
+	0x001e8e09, 0x001e8f05, 0x001e9009, 0x001e9105, 0x001e9209, 0x001e9305, 0x001e9409, 0x001e9505,
+	0x001e9e09, 0x001e9f05, 0x001ea009, 0x001ea105, 0x001ea209, 0x001ea305, 0x001ea409, 0x001ea505,
+	0x001ea609, 0x001ea705, 0x001ea809, 0x001ea905, 0x001eaa09, 0x001eab05, 0x001eac09, 0x001ead05,
+	0x001eae09, 0x001eaf05, 0x001eb009, 0x001eb105, 0x001eb209, 0x001eb305, 0x001eb409, 0x001eb505,
+	0x001eb609, 0x001eb705, 0x001eb809, 0x001eb905, 0x001eba09, 0x001ebb05, 0x001ebc09, 0x001ebd05,
+	0x001ebe09, 0x001ebf05, 0x001ec009, 0x001ec105, 0x001ec209, 0x001ec305, 0x001ec409, 0x001ec505,
+	0x001ec609, 0x001ec705, 0x001ec809, 0x001ec905, 0x001eca09, 0x001ecb05, 0x001ecc09, 0x001ecd05,
+	0x001ece09, 0x001ecf05, 0x001ed009, 0x001ed105, 0x001ed209, 0x001ed305, 0x001ed409, 0x001ed505,
+	0x001ed609, 0x001ed705, 0x001ed809, 0x001ed905, 0x001eda09, 0x001edb05, 0x001edc09, 0x001edd05,
+	0x001ede09, 0x001edf05, 0x001ee009, 0x001ee105, 0x001ee209, 0x001ee305, 0x001ee409, 0x001ee505,
+	0x001ee609, 0x001ee705, 0x001ee809, 0x001ee905, 0x001eea09, 0x001eeb05, 0x001eec09, 0x001eed05,
+	0x001eee09, 0x001eef05, 0x001ef009, 0x001ef105, 0x001ef209, 0x001ef305, 0x001ef409, 0x001ef505,
+	0x001ef609, 0x001ef705, 0x001ef809, 0x001ef905, 0x001efa09, 0x001efb05, 0x001efc09, 0x001efd05,
+	0x001efe09, 0x001eff05, 0x001f0809, 0x001f1005, 0x001f1602, 0x001f1809, 0x001f1e02, 0x001f2005,
+	0x001f2809, 0x001f3005, 0x001f3809, 0x001f4005, 0x001f4602, 0x001f4809, 0x001f4e02, 0x001f5005,
+	0x001f5802, 0x001f5909, 0x001f5a02, 0x001f5b09, 0x001f5c02, 0x001f5d09, 0x001f5e02, 0x001f5f09,
+	0x001f6005, 0x001f6809, 0x001f7005, 0x001f7e02, 0x001f8005, 0x001f8808, 0x001f9005, 0x001f9808,
+	0x001fa005, 0x001fa808, 0x001fb005, 0x001fb502, 0x001fb605, 0x001fb809, 0x001fbc08, 0x001fbd18,
+	0x001fbe05, 0x001fbf18, 0x001fc205, 0x001fc502, 0x001fc605, 0x001fc809, 0x001fcc08, 0x001fcd18,
+	0x001fd005, 0x001fd402, 0x001fd605, 0x001fd809, 0x001fdc02, 0x001fdd18, 0x001fe005, 0x001fe809,
+	0x001fed18, 0x001ff002, 0x001ff205, 0x001ff502, 0x001ff605, 0x001ff809, 0x001ffc08, 0x001ffd18,
+	0x001fff02, 0x0020001d, 0x00200b01, 0x00201011, 0x00201615, 0x00201814, 0x00201913, 0x00201a16,
+	0x00201b14, 0x00201d13, 0x00201e16, 0x00201f14, 0x00202015, 0x0020281b, 0x0020291c, 0x00202a01,
+	0x00202f1d, 0x00203015, 0x00203914, 0x00203a13, 0x00203b15, 0x00203f10, 0x00204115, 0x00204419,
+	0x00204516, 0x00204612, 0x00204715, 0x00205219, 0x00205315, 0x00205410, 0x00205515, 0x00205f1d,
+	0x00206001, 0x00206502, 0x00206601, 0x0020700f, 0x00207106, 0x00207202, 0x0020740f, 0x00207a19,
+	0x00207d16, 0x00207e12, 0x00207f06, 0x0020800f, 0x00208a19, 0x00208d16, 0x00208e12, 0x00208f02,
+	0x00209006, 0x00209d02, 0x0020a017, 0x0020c102, 0x0020d00c, 0x0020dd0b, 0x0020e10c, 0x0020e20b,
+	0x0020e50c, 0x0020f102, 0x0021001a, 0x00210209, 0x0021031a, 0x00210709, 0x0021081a, 0x00210a05,
+	0x00210b09, 0x00210e05, 0x00211009, 0x00211305, 0x0021141a, 0x00211509, 0x0021161a, 0x00211819,
+	0x00211909, 0x00211e1a, 0x00212409, 0x0021251a, 0x00212609, 0x0021271a, 0x00212809, 0x0021291a,
+	0x00212a09, 0x00212e1a, 0x00212f05, 0x00213009, 0x00213405, 0x00213507, 0x00213905, 0x00213a1a,
+	0x00213c05, 0x00213e09, 0x00214019, 0x00214509, 0x00214605, 0x00214a1a, 0x00214b19, 0x00214c1a,
+	0x00214e05, 0x00214f1a, 0x0021500f, 0x0021600e, 0x00218309, 0x00218405, 0x0021850e, 0x0021890f,
+	0x00218a1a, 0x00218c02, 0x00219019, 0x0021951a, 0x00219a19, 0x00219c1a, 0x0021a019, 0x0021a11a,
+	0x0021a319, 0x0021a41a, 0x0021a619, 0x0021a71a, 0x0021ae19, 0x0021af1a, 0x0021ce19, 0x0021d01a,
+	0x0021d219, 0x0021d31a, 0x0021d419, 0x0021d51a, 0x0021f419, 0x0023001a, 0x00230816, 0x00230912,
+	0x00230a16, 0x00230b12, 0x00230c1a, 0x00232019, 0x0023221a, 0x00232916, 0x00232a12, 0x00232b1a,
+	0x00237c19, 0x00237d1a, 0x00239b19, 0x0023b41a, 0x0023dc19, 0x0023e21a, 0x00242702, 0x0024401a,
+	0x00244b02, 0x0024600f, 0x00249c1a, 0x0024ea0f, 0x0025001a, 0x0025b719, 0x0025b81a, 0x0025c119,
+	0x0025c21a, 0x0025f819, 0x0026001a, 0x00266f19, 0x0026701a, 0x00276816, 0x00276912, 0x00276a16,
+	0x00276b12, 0x00276c16, 0x00276d12, 0x00276e16, 0x00276f12, 0x00277016, 0x00277112, 0x00277216,
+	0x00277312, 0x00277416, 0x00277512, 0x0027760f, 0x0027941a, 0x0027c019, 0x0027c516, 0x0027c612,
+	0x0027c719, 0x0027e616, 0x0027e712, 0x0027e816, 0x0027e912, 0x0027ea16, 0x0027eb12, 0x0027ec16,
+	0x0027ed12, 0x0027ee16, 0x0027ef12, 0x0027f019, 0x0028001a, 0x00290019, 0x00298316, 0x00298412,
+	0x00298516, 0x00298612, 0x00298716, 0x00298812, 0x00298916, 0x00298a12, 0x00298b16, 0x00298c12,
+	0x00298d16, 0x00298e12, 0x00298f16, 0x00299012, 0x00299116, 0x00299212, 0x00299316, 0x00299412,
+	0x00299516, 0x00299612, 0x00299716, 0x00299812, 0x00299919, 0x0029d816, 0x0029d912, 0x0029da16,
+	0x0029db12, 0x0029dc19, 0x0029fc16, 0x0029fd12, 0x0029fe19, 0x002b001a, 0x002b3019, 0x002b451a,
+	0x002b4719, 0x002b4d1a, 0x002b7402, 0x002b761a, 0x002b9602, 0x002b971a, 0x002c0009, 0x002c3005,
+	0x002c6009, 0x002c6105, 0x002c6209, 0x002c6505, 0x002c6709, 0x002c6805, 0x002c6909, 0x002c6a05,
+	0x002c6b09, 0x002c6c05, 0x002c6d09, 0x002c7105, 0x002c7209, 0x002c7305, 0x002c7509, 0x002c7605,
+	0x002c7c06, 0x002c7e09, 0x002c8105, 0x002c8209, 0x002c8305, 0x002c8409, 0x002c8505, 0x002c8609,
+	0x002c8705, 0x002c8809, 0x002c8905, 0x002c8a09, 0x002c8b05, 0x002c8c09, 0x002c8d05, 0x002c8e09,
+	0x002c8f05, 0x002c9009, 0x002c9105, 0x002c9209, 0x002c9305, 0x002c9409, 0x002c9505, 0x002c9609,
+	0x002c9705, 0x002c9809, 0x002c9905, 0x002c9a09, 0x002c9b05, 0x002c9c09, 0x002c9d05, 0x002c9e09,
+	0x002c9f05, 0x002ca009, 0x002ca105, 0x002ca209, 0x002ca305, 0x002ca409, 0x002ca505, 0x002ca609,
+	0x002ca705, 0x002ca809, 0x002ca905, 0x002caa09, 0x002cab05, 0x002cac09, 0x002cad05, 0x002cae09,
+	0x002caf05, 0x002cb009, 0x002cb105, 0x002cb209, 0x002cb305, 0x002cb409, 0x002cb505, 0x002cb609,
+	0x002cb705, 0x002cb809, 0x002cb905, 0x002cba09, 0x002cbb05, 0x002cbc09, 0x002cbd05, 0x002cbe09,
+	0x002cbf05, 0x002cc009, 0x002cc105, 0x002cc209, 0x002cc305, 0x002cc409, 0x002cc505, 0x002cc609,
+	0x002cc705, 0x002cc809, 0x002cc905, 0x002cca09, 0x002ccb05, 0x002ccc09, 0x002ccd05, 0x002cce09,
+	0x002ccf05, 0x002cd009, 0x002cd105, 0x002cd209, 0x002cd305, 0x002cd409, 0x002cd505, 0x002cd609,
+	0x002cd705, 0x002cd809, 0x002cd905, 0x002cda09, 0x002cdb05, 0x002cdc09, 0x002cdd05, 0x002cde09,
+	0x002cdf05, 0x002ce009, 0x002ce105, 0x002ce209, 0x002ce305, 0x002ce51a, 0x002ceb09, 0x002cec05,
+	0x002ced09, 0x002cee05, 0x002cef0c, 0x002cf209, 0x002cf305, 0x002cf402, 0x002cf915, 0x002cfd0f,
+	0x002cfe15, 0x002d0005, 0x002d2602, 0x002d2705, 0x002d2802, 0x002d2d05, 0x002d2e02, 0x002d3007,
+	0x002d6802, 0x002d6f06, 0x002d7015, 0x002d7102, 0x002d7f0c, 0x002d8007, 0x002d9702, 0x002da007,
+	0x002da702, 0x002da807, 0x002daf02, 0x002db007, 0x002db702, 0x002db807, 0x002dbf02, 0x002dc007,
+	0x002dc702, 0x002dc807, 0x002dcf02, 0x002dd007, 0x002dd702, 0x002dd807, 0x002ddf02, 0x002de00c,
+	0x002e0015, 0x002e0214, 0x002e0313, 0x002e0414, 0x002e0513, 0x002e0615, 0x002e0914, 0x002e0a13,
+	0x002e0b15, 0x002e0c14, 0x002e0d13, 0x002e0e15, 0x002e1711, 0x002e1815, 0x002e1a11, 0x002e1b15,
+	0x002e1c14, 0x002e1d13, 0x002e1e15, 0x002e2014, 0x002e2113, 0x002e2216, 0x002e2312, 0x002e2416,
+	0x002e2512, 0x002e2616, 0x002e2712, 0x002e2816, 0x002e2912, 0x002e2a15, 0x002e2f06, 0x002e3015,
+	0x002e3a11, 0x002e3c15, 0x002e4011, 0x002e4115, 0x002e4216, 0x002e4315, 0x002e501a, 0x002e5215,
+	0x002e5516, 0x002e5612, 0x002e5716, 0x002e5812, 0x002e5916, 0x002e5a12, 0x002e5b16, 0x002e5c12,
+	0x002e5d11, 0x002e5e02, 0x002e801a, 0x002e9a02, 0x002e9b1a, 0x002ef402, 0x002f001a, 0x002fd602,
+	0x002ff01a, 0x002ffc02, 0x0030001d, 0x00300115, 0x0030041a, 0x00300506, 0x00300607, 0x0030070e,
+	0x00300816, 0x00300912, 0x00300a16, 0x00300b12, 0x00300c16, 0x00300d12, 0x00300e16, 0x00300f12,
+	0x00301016, 0x00301112, 0x0030121a, 0x00301416, 0x00301512, 0x00301616, 0x00301712, 0x00301816,
+	0x00301912, 0x00301a16, 0x00301b12, 0x00301c11, 0x00301d16, 0x00301e12, 0x0030201a, 0x0030210e,
+	0x00302a0c, 0x00302e0a, 0x00303011, 0x00303106, 0x0030361a, 0x0030380e, 0x00303b06, 0x00303c07,
+	0x00303d15, 0x00303e1a, 0x00304002, 0x00304107, 0x00309702, 0x0030990c, 0x00309b18, 0x00309d06,
+	0x00309f07, 0x0030a011, 0x0030a107, 0x0030fb15, 0x0030fc06, 0x0030ff07, 0x00310002, 0x00310507,
+	0x00313002, 0x00313107, 0x00318f02, 0x0031901a, 0x0031920f, 0x0031961a, 0x0031a007, 0x0031c01a,
+	0x0031e402, 0x0031f007, 0x0032001a, 0x00321f02, 0x0032200f, 0x00322a1a, 0x0032480f, 0x0032501a,
+	0x0032510f, 0x0032601a, 0x0032800f, 0x00328a1a, 0x0032b10f, 0x0032c01a, 0x00340007, 0x004dc01a,
+	0x004e0007, 0x00a01506, 0x00a01607, 0x00a48d02, 0x00a4901a, 0x00a4c702, 0x00a4d007, 0x00a4f806,
+	0x00a4fe15, 0x00a50007, 0x00a60c06, 0x00a60d15, 0x00a61007, 0x00a6200d, 0x00a62a07, 0x00a62c02,
+	0x00a64009, 0x00a64105, 0x00a64209, 0x00a64305, 0x00a64409, 0x00a64505, 0x00a64609, 0x00a64705,
+	0x00a64809, 0x00a64905, 0x00a64a09, 0x00a64b05, 0x00a64c09, 0x00a64d05, 0x00a64e09, 0x00a64f05,
+	0x00a65009, 0x00a65105, 0x00a65209, 0x00a65305, 0x00a65409, 0x00a65505, 0x00a65609, 0x00a65705,
+	0x00a65809, 0x00a65905, 0x00a65a09, 0x00a65b05, 0x00a65c09, 0x00a65d05, 0x00a65e09, 0x00a65f05,
+	0x00a66009, 0x00a66105, 0x00a66209, 0x00a66305, 0x00a66409, 0x00a66505, 0x00a66609, 0x00a66705,
+	0x00a66809, 0x00a66905, 0x00a66a09, 0x00a66b05, 0x00a66c09, 0x00a66d05, 0x00a66e07, 0x00a66f0c,
+	0x00a6700b, 0x00a67315, 0x00a6740c, 0x00a67e15, 0x00a67f06, 0x00a68009, 0x00a68105, 0x00a68209,
+	0x00a68305, 0x00a68409, 0x00a68505, 0x00a68609, 0x00a68705, 0x00a68809, 0x00a68905, 0x00a68a09,
+	0x00a68b05, 0x00a68c09, 0x00a68d05, 0x00a68e09, 0x00a68f05, 0x00a69009, 0x00a69105, 0x00a69209,
+	0x00a69305, 0x00a69409, 0x00a69505, 0x00a69609, 0x00a69705, 0x00a69809, 0x00a69905, 0x00a69a09,
+	0x00a69b05, 0x00a69c06, 0x00a69e0c, 0x00a6a007, 0x00a6e60e, 0x00a6f00c, 0x00a6f215, 0x00a6f802,
+	0x00a70018, 0x00a71706, 0x00a72018, 0x00a72209, 0x00a72305, 0x00a72409, 0x00a72505, 0x00a72609,
+	0x00a72705, 0x00a72809, 0x00a72905, 0x00a72a09, 0x00a72b05, 0x00a72c09, 0x00a72d05, 0x00a72e09,
+	0x00a72f05, 0x00a73209, 0x00a73305, 0x00a73409, 0x00a73505, 0x00a73609, 0x00a73705, 0x00a73809,
+	0x00a73905, 0x00a73a09, 0x00a73b05, 0x00a73c09, 0x00a73d05, 0x00a73e09, 0x00a73f05, 0x00a74009,
+	0x00a74105, 0x00a74209, 0x00a74305, 0x00a74409, 0x00a74505, 0x00a74609, 0x00a74705, 0x00a74809,
+	0x00a74905, 0x00a74a09, 0x00a74b05, 0x00a74c09, 0x00a74d05, 0x00a74e09, 0x00a74f05, 0x00a75009,
+	0x00a75105, 0x00a75209, 0x00a75305, 0x00a75409, 0x00a75505, 0x00a75609, 0x00a75705, 0x00a75809,
+	0x00a75905, 0x00a75a09, 0x00a75b05, 0x00a75c09, 0x00a75d05, 0x00a75e09, 0x00a75f05, 0x00a76009,
+	0x00a76105, 0x00a76209, 0x00a76305, 0x00a76409, 0x00a76505, 0x00a76609, 0x00a76705, 0x00a76809,
+	0x00a76905, 0x00a76a09, 0x00a76b05, 0x00a76c09, 0x00a76d05, 0x00a76e09, 0x00a76f05, 0x00a77006,
+	0x00a77105, 0x00a77909, 0x00a77a05, 0x00a77b09, 0x00a77c05, 0x00a77d09, 0x00a77f05, 0x00a78009,
+	0x00a78105, 0x00a78209, 0x00a78305, 0x00a78409, 0x00a78505, 0x00a78609, 0x00a78705, 0x00a78806,
+	0x00a78918, 0x00a78b09, 0x00a78c05, 0x00a78d09, 0x00a78e05, 0x00a78f07, 0x00a79009, 0x00a79105,
+	0x00a79209, 0x00a79305, 0x00a79609, 0x00a79705, 0x00a79809, 0x00a79905, 0x00a79a09, 0x00a79b05,
+	0x00a79c09, 0x00a79d05, 0x00a79e09, 0x00a79f05, 0x00a7a009, 0x00a7a105, 0x00a7a209, 0x00a7a305,
+	0x00a7a409, 0x00a7a505, 0x00a7a609, 0x00a7a705, 0x00a7a809, 0x00a7a905, 0x00a7aa09, 0x00a7af05,
+	0x00a7b009, 0x00a7b505, 0x00a7b609, 0x00a7b705, 0x00a7b809, 0x00a7b905, 0x00a7ba09, 0x00a7bb05,
+	0x00a7bc09, 0x00a7bd05, 0x00a7be09, 0x00a7bf05, 0x00a7c009, 0x00a7c105, 0x00a7c209, 0x00a7c305,
+	0x00a7c409, 0x00a7c805, 0x00a7c909, 0x00a7ca05, 0x00a7cb02, 0x00a7d009, 0x00a7d105, 0x00a7d202,
+	0x00a7d305, 0x00a7d402, 0x00a7d505, 0x00a7d609, 0x00a7d705, 0x00a7d809, 0x00a7d905, 0x00a7da02,
+	0x00a7f206, 0x00a7f509, 0x00a7f605, 0x00a7f707, 0x00a7f806, 0x00a7fa05, 0x00a7fb07, 0x00a8020c,
+	0x00a80307, 0x00a8060c, 0x00a80707, 0x00a80b0c, 0x00a80c07, 0x00a8230a, 0x00a8250c, 0x00a8270a,
+	0x00a8281a, 0x00a82c0c, 0x00a82d02, 0x00a8300f, 0x00a8361a, 0x00a83817, 0x00a8391a, 0x00a83a02,
+	0x00a84007, 0x00a87415, 0x00a87802, 0x00a8800a, 0x00a88207, 0x00a8b40a, 0x00a8c40c, 0x00a8c602,
+	0x00a8ce15, 0x00a8d00d, 0x00a8da02, 0x00a8e00c, 0x00a8f207, 0x00a8f815, 0x00a8fb07, 0x00a8fc15,
+	0x00a8fd07, 0x00a8ff0c, 0x00a9000d, 0x00a90a07, 0x00a9260c, 0x00a92e15, 0x00a93007, 0x00a9470c,
+	0x00a9520a, 0x00a95402, 0x00a95f15, 0x00a96007, 0x00a97d02, 0x00a9800c, 0x00a9830a, 0x00a98407,
+	0x00a9b30c, 0x00a9b40a, 0x00a9b60c, 0x00a9ba0a, 0x00a9bc0c, 0x00a9be0a, 0x00a9c115, 0x00a9ce02,
+	0x00a9cf06, 0x00a9d00d, 0x00a9da02, 0x00a9de15, 0x00a9e007, 0x00a9e50c, 0x00a9e606, 0x00a9e707,
+	0x00a9f00d, 0x00a9fa07, 0x00a9ff02, 0x00aa0007, 0x00aa290c, 0x00aa2f0a, 0x00aa310c, 0x00aa330a,
+	0x00aa350c, 0x00aa3702, 0x00aa4007, 0x00aa430c, 0x00aa4407, 0x00aa4c0c, 0x00aa4d0a, 0x00aa4e02,
+	0x00aa500d, 0x00aa5a02, 0x00aa5c15, 0x00aa6007, 0x00aa7006, 0x00aa7107, 0x00aa771a, 0x00aa7a07,
+	0x00aa7b0a, 0x00aa7c0c, 0x00aa7d0a, 0x00aa7e07, 0x00aab00c, 0x00aab107, 0x00aab20c, 0x00aab507,
+	0x00aab70c, 0x00aab907, 0x00aabe0c, 0x00aac007, 0x00aac10c, 0x00aac207, 0x00aac302, 0x00aadb07,
+	0x00aadd06, 0x00aade15, 0x00aae007, 0x00aaeb0a, 0x00aaec0c, 0x00aaee0a, 0x00aaf015, 0x00aaf207,
+	0x00aaf306, 0x00aaf50a, 0x00aaf60c, 0x00aaf702, 0x00ab0107, 0x00ab0702, 0x00ab0907, 0x00ab0f02,
+	0x00ab1107, 0x00ab1702, 0x00ab2007, 0x00ab2702, 0x00ab2807, 0x00ab2f02, 0x00ab3005, 0x00ab5b18,
+	0x00ab5c06, 0x00ab6005, 0x00ab6906, 0x00ab6a18, 0x00ab6c02, 0x00ab7005, 0x00abc007, 0x00abe30a,
+	0x00abe50c, 0x00abe60a, 0x00abe80c, 0x00abe90a, 0x00abeb15, 0x00abec0a, 0x00abed0c, 0x00abee02,
+	0x00abf00d, 0x00abfa02, 0x00ac0007, 0x00d7a402, 0x00d7b007, 0x00d7c702, 0x00d7cb07, 0x00d7fc02,
+	0x00d80004, 0x00e00003, 0x00f90007, 0x00fa6e02, 0x00fa7007, 0x00fada02, 0x00fb0005, 0x00fb0702,
+	0x00fb1305, 0x00fb1802, 0x00fb1d07, 0x00fb1e0c, 0x00fb1f07, 0x00fb2919, 0x00fb2a07, 0x00fb3702,
+	0x00fb3807, 0x00fb3d02, 0x00fb3e07, 0x00fb3f02, 0x00fb4007, 0x00fb4202, 0x00fb4307, 0x00fb4502,
+	0x00fb4607, 0x00fbb218, 0x00fbc302, 0x00fbd307, 0x00fd3e12, 0x00fd3f16, 0x00fd401a, 0x00fd5007,
+	0x00fd9002, 0x00fd9207, 0x00fdc802, 0x00fdcf1a, 0x00fdd002, 0x00fdf007, 0x00fdfc17, 0x00fdfd1a,
+	0x00fe000c, 0x00fe1015, 0x00fe1716, 0x00fe1812, 0x00fe1915, 0x00fe1a02, 0x00fe200c, 0x00fe3015,
+	0x00fe3111, 0x00fe3310, 0x00fe3516, 0x00fe3612, 0x00fe3716, 0x00fe3812, 0x00fe3916, 0x00fe3a12,
+	0x00fe3b16, 0x00fe3c12, 0x00fe3d16, 0x00fe3e12, 0x00fe3f16, 0x00fe4012, 0x00fe4116, 0x00fe4212,
+	0x00fe4316, 0x00fe4412, 0x00fe4515, 0x00fe4716, 0x00fe4812, 0x00fe4915, 0x00fe4d10, 0x00fe5015,
+	0x00fe5302, 0x00fe5415, 0x00fe5811, 0x00fe5916, 0x00fe5a12, 0x00fe5b16, 0x00fe5c12, 0x00fe5d16,
+	0x00fe5e12, 0x00fe5f15, 0x00fe6219, 0x00fe6311, 0x00fe6419, 0x00fe6702, 0x00fe6815, 0x00fe6917,
+	0x00fe6a15, 0x00fe6c02, 0x00fe7007, 0x00fe7502, 0x00fe7607, 0x00fefd02, 0x00feff01, 0x00ff0002,
+	0x00ff0115, 0x00ff0417, 0x00ff0515, 0x00ff0816, 0x00ff0912, 0x00ff0a15, 0x00ff0b19, 0x00ff0c15,
+	0x00ff0d11, 0x00ff0e15, 0x00ff100d, 0x00ff1a15, 0x00ff1c19, 0x00ff1f15, 0x00ff2109, 0x00ff3b16,
+	0x00ff3c15, 0x00ff3d12, 0x00ff3e18, 0x00ff3f10, 0x00ff4018, 0x00ff4105, 0x00ff5b16, 0x00ff5c19,
+	0x00ff5d12, 0x00ff5e19, 0x00ff5f16, 0x00ff6012, 0x00ff6115, 0x00ff6216, 0x00ff6312, 0x00ff6415,
+	0x00ff6607, 0x00ff7006, 0x00ff7107, 0x00ff9e06, 0x00ffa007, 0x00ffbf02, 0x00ffc207, 0x00ffc802,
+	0x00ffca07, 0x00ffd002, 0x00ffd207, 0x00ffd802, 0x00ffda07, 0x00ffdd02, 0x00ffe017, 0x00ffe219,
+	0x00ffe318, 0x00ffe41a, 0x00ffe517, 0x00ffe702, 0x00ffe81a, 0x00ffe919, 0x00ffed1a, 0x00ffef02,
+	0x00fff901, 0x00fffc1a, 0x00fffe02, 0x01000007, 0x01000c02, 0x01000d07, 0x01002702, 0x01002807,
+	0x01003b02, 0x01003c07, 0x01003e02, 0x01003f07, 0x01004e02, 0x01005007, 0x01005e02, 0x01008007,
+	0x0100fb02, 0x01010015, 0x01010302, 0x0101070f, 0x01013402, 0x0101371a, 0x0101400e, 0x0101750f,
+	0x0101791a, 0x01018a0f, 0x01018c1a, 0x01018f02, 0x0101901a, 0x01019d02, 0x0101a01a, 0x0101a102,
+	0x0101d01a, 0x0101fd0c, 0x0101fe02, 0x01028007, 0x01029d02, 0x0102a007, 0x0102d102, 0x0102e00c,
+	0x0102e10f, 0x0102fc02, 0x01030007, 0x0103200f, 0x01032402, 0x01032d07, 0x0103410e, 0x01034207,
+	0x01034a0e, 0x01034b02, 0x01035007, 0x0103760c, 0x01037b02, 0x01038007, 0x01039e02, 0x01039f15,
+	0x0103a007, 0x0103c402, 0x0103c807, 0x0103d015, 0x0103d10e, 0x0103d602, 0x01040009, 0x01042805,
+	0x01045007, 0x01049e02, 0x0104a00d, 0x0104aa02, 0x0104b009, 0x0104d402, 0x0104d805, 0x0104fc02,
+	0x01050007, 0x01052802, 0x01053007, 0x01056402, 0x01056f15, 0x01057009, 0x01057b02, 0x01057c09,
+	0x01058b02, 0x01058c09, 0x01059302, 0x01059409, 0x01059602, 0x01059705, 0x0105a202, 0x0105a305,
+	0x0105b202, 0x0105b305, 0x0105ba02, 0x0105bb05, 0x0105bd02, 0x01060007, 0x01073702, 0x01074007,
+	0x01075602, 0x01076007, 0x01076802, 0x01078006, 0x01078602, 0x01078706, 0x0107b102, 0x0107b206,
+	0x0107bb02, 0x01080007, 0x01080602, 0x01080807, 0x01080902, 0x01080a07, 0x01083602, 0x01083707,
+	0x01083902, 0x01083c07, 0x01083d02, 0x01083f07, 0x01085602, 0x01085715, 0x0108580f, 0x01086007,
+	0x0108771a, 0x0108790f, 0x01088007, 0x01089f02, 0x0108a70f, 0x0108b002, 0x0108e007, 0x0108f302,
+	0x0108f407, 0x0108f602, 0x0108fb0f, 0x01090007, 0x0109160f, 0x01091c02, 0x01091f15, 0x01092007,
+	0x01093a02, 0x01093f15, 0x01094002, 0x01098007, 0x0109b802, 0x0109bc0f, 0x0109be07, 0x0109c00f,
+	0x0109d002, 0x0109d20f, 0x010a0007, 0x010a010c, 0x010a0402, 0x010a050c, 0x010a0702, 0x010a0c0c,
+	0x010a1007, 0x010a1402, 0x010a1507, 0x010a1802, 0x010a1907, 0x010a3602, 0x010a380c, 0x010a3b02,
+	0x010a3f0c, 0x010a400f, 0x010a4902, 0x010a5015, 0x010a5902, 0x010a6007, 0x010a7d0f, 0x010a7f15,
+	0x010a8007, 0x010a9d0f, 0x010aa002, 0x010ac007, 0x010ac81a, 0x010ac907, 0x010ae50c, 0x010ae702,
+	0x010aeb0f, 0x010af015, 0x010af702, 0x010b0007, 0x010b3602, 0x010b3915, 0x010b4007, 0x010b5602,
+	0x010b580f, 0x010b6007, 0x010b7302, 0x010b780f, 0x010b8007, 0x010b9202, 0x010b9915, 0x010b9d02,
+	0x010ba90f, 0x010bb002, 0x010c0007, 0x010c4902, 0x010c8009, 0x010cb302, 0x010cc005, 0x010cf302,
+	0x010cfa0f, 0x010d0007, 0x010d240c, 0x010d2802, 0x010d300d, 0x010d3a02, 0x010e600f, 0x010e7f02,
+	0x010e8007, 0x010eaa02, 0x010eab0c, 0x010ead11, 0x010eae02, 0x010eb007, 0x010eb202, 0x010f0007,
+	0x010f1d0f, 0x010f2707, 0x010f2802, 0x010f3007, 0x010f460c, 0x010f510f, 0x010f5515, 0x010f5a02,
+	0x010f7007, 0x010f820c, 0x010f8615, 0x010f8a02, 0x010fb007, 0x010fc50f, 0x010fcc02, 0x010fe007,
+	0x010ff702, 0x0110000a, 0x0110010c, 0x0110020a, 0x01100307, 0x0110380c, 0x01104715, 0x01104e02,
+	0x0110520f, 0x0110660d, 0x0110700c, 0x01107107, 0x0110730c, 0x01107507, 0x01107602, 0x01107f0c,
+	0x0110820a, 0x01108307, 0x0110b00a, 0x0110b30c, 0x0110b70a, 0x0110b90c, 0x0110bb15, 0x0110bd01,
+	0x0110be15, 0x0110c20c, 0x0110c302, 0x0110cd01, 0x0110ce02, 0x0110d007, 0x0110e902, 0x0110f00d,
+	0x0110fa02, 0x0111000c, 0x01110307, 0x0111270c, 0x01112c0a, 0x01112d0c, 0x01113502, 0x0111360d,
+	0x01114015, 0x01114407, 0x0111450a, 0x01114707, 0x01114802, 0x01115007, 0x0111730c, 0x01117415,
+	0x01117607, 0x01117702, 0x0111800c, 0x0111820a, 0x01118307, 0x0111b30a, 0x0111b60c, 0x0111bf0a,
+	0x0111c107, 0x0111c515, 0x0111c90c, 0x0111cd15, 0x0111ce0a, 0x0111cf0c, 0x0111d00d, 0x0111da07,
+	0x0111db15, 0x0111dc07, 0x0111dd15, 0x0111e002, 0x0111e10f, 0x0111f502, 0x01120007, 0x01121202,
+	0x01121307, 0x01122c0a, 0x01122f0c, 0x0112320a, 0x0112340c, 0x0112350a, 0x0112360c, 0x01123815,
+	0x01123e0c, 0x01123f02, 0x01128007, 0x01128702, 0x01128807, 0x01128902, 0x01128a07, 0x01128e02,
+	0x01128f07, 0x01129e02, 0x01129f07, 0x0112a915, 0x0112aa02, 0x0112b007, 0x0112df0c, 0x0112e00a,
+	0x0112e30c, 0x0112eb02, 0x0112f00d, 0x0112fa02, 0x0113000c, 0x0113020a, 0x01130402, 0x01130507,
+	0x01130d02, 0x01130f07, 0x01131102, 0x01131307, 0x01132902, 0x01132a07, 0x01133102, 0x01133207,
+	0x01133402, 0x01133507, 0x01133a02, 0x01133b0c, 0x01133d07, 0x01133e0a, 0x0113400c, 0x0113410a,
+	0x01134502, 0x0113470a, 0x01134902, 0x01134b0a, 0x01134e02, 0x01135007, 0x01135102, 0x0113570a,
+	0x01135802, 0x01135d07, 0x0113620a, 0x01136402, 0x0113660c, 0x01136d02, 0x0113700c, 0x01137502,
+	0x01140007, 0x0114350a, 0x0114380c, 0x0114400a, 0x0114420c, 0x0114450a, 0x0114460c, 0x01144707,
+	0x01144b15, 0x0114500d, 0x01145a15, 0x01145c02, 0x01145d15, 0x01145e0c, 0x01145f07, 0x01146202,
+	0x01148007, 0x0114b00a, 0x0114b30c, 0x0114b90a, 0x0114ba0c, 0x0114bb0a, 0x0114bf0c, 0x0114c10a,
+	0x0114c20c, 0x0114c407, 0x0114c615, 0x0114c707, 0x0114c802, 0x0114d00d, 0x0114da02, 0x01158007,
+	0x0115af0a, 0x0115b20c, 0x0115b602, 0x0115b80a, 0x0115bc0c, 0x0115be0a, 0x0115bf0c, 0x0115c115,
+	0x0115d807, 0x0115dc0c, 0x0115de02, 0x01160007, 0x0116300a, 0x0116330c, 0x01163b0a, 0x01163d0c,
+	0x01163e0a, 0x01163f0c, 0x01164115, 0x01164407, 0x01164502, 0x0116500d, 0x01165a02, 0x01166015,
+	0x01166d02, 0x01168007, 0x0116ab0c, 0x0116ac0a, 0x0116ad0c, 0x0116ae0a, 0x0116b00c, 0x0116b60a,
+	0x0116b70c, 0x0116b807, 0x0116b915, 0x0116ba02, 0x0116c00d, 0x0116ca02, 0x01170007, 0x01171b02,
+	0x01171d0c, 0x0117200a, 0x0117220c, 0x0117260a, 0x0117270c, 0x01172c02, 0x0117300d, 0x01173a0f,
+	0x01173c15, 0x01173f1a, 0x01174007, 0x01174702, 0x01180007, 0x01182c0a, 0x01182f0c, 0x0118380a,
+	0x0118390c, 0x01183b15, 0x01183c02, 0x0118a009, 0x0118c005, 0x0118e00d, 0x0118ea0f, 0x0118f302,
+	0x0118ff07, 0x01190702, 0x01190907, 0x01190a02, 0x01190c07, 0x01191402, 0x01191507, 0x01191702,
+	0x01191807, 0x0119300a, 0x01193602, 0x0119370a, 0x01193902, 0x01193b0c, 0x01193d0a, 0x01193e0c,
+	0x01193f07, 0x0119400a, 0x01194107, 0x0119420a, 0x0119430c, 0x01194415, 0x01194702, 0x0119500d,
+	0x01195a02, 0x0119a007, 0x0119a802, 0x0119aa07, 0x0119d10a, 0x0119d40c, 0x0119d802, 0x0119da0c,
+	0x0119dc0a, 0x0119e00c, 0x0119e107, 0x0119e215, 0x0119e307, 0x0119e40a, 0x0119e502, 0x011a0007,
+	0x011a010c, 0x011a0b07, 0x011a330c, 0x011a390a, 0x011a3a07, 0x011a3b0c, 0x011a3f15, 0x011a470c,
+	0x011a4802, 0x011a5007, 0x011a510c, 0x011a570a, 0x011a590c, 0x011a5c07, 0x011a8a0c, 0x011a970a,
+	0x011a980c, 0x011a9a15, 0x011a9d07, 0x011a9e15, 0x011aa302, 0x011ab007, 0x011af902, 0x011c0007,
+	0x011c0902, 0x011c0a07, 0x011c2f0a, 0x011c300c, 0x011c3702, 0x011c380c, 0x011c3e0a, 0x011c3f0c,
+	0x011c4007, 0x011c4115, 0x011c4602, 0x011c500d, 0x011c5a0f, 0x011c6d02, 0x011c7015, 0x011c7207,
+	0x011c9002, 0x011c920c, 0x011ca802, 0x011ca90a, 0x011caa0c, 0x011cb10a, 0x011cb20c, 0x011cb40a,
+	0x011cb50c, 0x011cb702, 0x011d0007, 0x011d0702, 0x011d0807, 0x011d0a02, 0x011d0b07, 0x011d310c,
+	0x011d3702, 0x011d3a0c, 0x011d3b02, 0x011d3c0c, 0x011d3e02, 0x011d3f0c, 0x011d4607, 0x011d470c,
+	0x011d4802, 0x011d500d, 0x011d5a02, 0x011d6007, 0x011d6602, 0x011d6707, 0x011d6902, 0x011d6a07,
+	0x011d8a0a, 0x011d8f02, 0x011d900c, 0x011d9202, 0x011d930a, 0x011d950c, 0x011d960a, 0x011d970c,
+	0x011d9807, 0x011d9902, 0x011da00d, 0x011daa02, 0x011ee007, 0x011ef30c, 0x011ef50a, 0x011ef715,
+	0x011ef902, 0x011fb007, 0x011fb102, 0x011fc00f, 0x011fd51a, 0x011fdd17, 0x011fe11a, 0x011ff202,
+	0x011fff15, 0x01200007, 0x01239a02, 0x0124000e, 0x01246f02, 0x01247015, 0x01247502, 0x01248007,
+	0x01254402, 0x012f9007, 0x012ff115, 0x012ff302, 0x01300007, 0x01342f02, 0x01343001, 0x01343902,
+	0x01440007, 0x01464702, 0x01680007, 0x016a3902, 0x016a4007, 0x016a5f02, 0x016a600d, 0x016a6a02,
+	0x016a6e15, 0x016a7007, 0x016abf02, 0x016ac00d, 0x016aca02, 0x016ad007, 0x016aee02, 0x016af00c,
+	0x016af515, 0x016af602, 0x016b0007, 0x016b300c, 0x016b3715, 0x016b3c1a, 0x016b4006, 0x016b4415,
+	0x016b451a, 0x016b4602, 0x016b500d, 0x016b5a02, 0x016b5b0f, 0x016b6202, 0x016b6307, 0x016b7802,
+	0x016b7d07, 0x016b9002, 0x016e4009, 0x016e6005, 0x016e800f, 0x016e9715, 0x016e9b02, 0x016f0007,
+	0x016f4b02, 0x016f4f0c, 0x016f5007, 0x016f510a, 0x016f8802, 0x016f8f0c, 0x016f9306, 0x016fa002,
+	0x016fe006, 0x016fe215, 0x016fe306, 0x016fe40c, 0x016fe502, 0x016ff00a, 0x016ff202, 0x01700007,
+	0x0187f802, 0x01880007, 0x018cd602, 0x018d0007, 0x018d0902, 0x01aff006, 0x01aff402, 0x01aff506,
+	0x01affc02, 0x01affd06, 0x01afff02, 0x01b00007, 0x01b12302, 0x01b15007, 0x01b15302, 0x01b16407,
+	0x01b16802, 0x01b17007, 0x01b2fc02, 0x01bc0007, 0x01bc6b02, 0x01bc7007, 0x01bc7d02, 0x01bc8007,
+	0x01bc8902, 0x01bc9007, 0x01bc9a02, 0x01bc9c1a, 0x01bc9d0c, 0x01bc9f15, 0x01bca001, 0x01bca402,
+	0x01cf000c, 0x01cf2e02, 0x01cf300c, 0x01cf4702, 0x01cf501a, 0x01cfc402, 0x01d0001a, 0x01d0f602,
+	0x01d1001a, 0x01d12702, 0x01d1291a, 0x01d1650a, 0x01d1670c, 0x01d16a1a, 0x01d16d0a, 0x01d17301,
+	0x01d17b0c, 0x01d1831a, 0x01d1850c, 0x01d18c1a, 0x01d1aa0c, 0x01d1ae1a, 0x01d1eb02, 0x01d2001a,
+	0x01d2420c, 0x01d2451a, 0x01d24602, 0x01d2e00f, 0x01d2f402, 0x01d3001a, 0x01d35702, 0x01d3600f,
+	0x01d37902, 0x01d40009, 0x01d41a05, 0x01d43409, 0x01d44e05, 0x01d45502, 0x01d45605, 0x01d46809,
+	0x01d48205, 0x01d49c09, 0x01d49d02, 0x01d49e09, 0x01d4a002, 0x01d4a209, 0x01d4a302, 0x01d4a509,
+	0x01d4a702, 0x01d4a909, 0x01d4ad02, 0x01d4ae09, 0x01d4b605, 0x01d4ba02, 0x01d4bb05, 0x01d4bc02,
+	0x01d4bd05, 0x01d4c402, 0x01d4c505, 0x01d4d009, 0x01d4ea05, 0x01d50409, 0x01d50602, 0x01d50709,
+	0x01d50b02, 0x01d50d09, 0x01d51502, 0x01d51609, 0x01d51d02, 0x01d51e05, 0x01d53809, 0x01d53a02,
+	0x01d53b09, 0x01d53f02, 0x01d54009, 0x01d54502, 0x01d54609, 0x01d54702, 0x01d54a09, 0x01d55102,
+	0x01d55205, 0x01d56c09, 0x01d58605, 0x01d5a009, 0x01d5ba05, 0x01d5d409, 0x01d5ee05, 0x01d60809,
+	0x01d62205, 0x01d63c09, 0x01d65605, 0x01d67009, 0x01d68a05, 0x01d6a602, 0x01d6a809, 0x01d6c119,
+	0x01d6c205, 0x01d6db19, 0x01d6dc05, 0x01d6e209, 0x01d6fb19, 0x01d6fc05, 0x01d71519, 0x01d71605,
+	0x01d71c09, 0x01d73519, 0x01d73605, 0x01d74f19, 0x01d75005, 0x01d75609, 0x01d76f19, 0x01d77005,
+	0x01d78919, 0x01d78a05, 0x01d79009, 0x01d7a919, 0x01d7aa05, 0x01d7c319, 0x01d7c405, 0x01d7ca09,
+	0x01d7cb05, 0x01d7cc02, 0x01d7ce0d, 0x01d8001a, 0x01da000c, 0x01da371a, 0x01da3b0c, 0x01da6d1a,
+	0x01da750c, 0x01da761a, 0x01da840c, 0x01da851a, 0x01da8715, 0x01da8c02, 0x01da9b0c, 0x01daa002,
+	0x01daa10c, 0x01dab002, 0x01df0005, 0x01df0a07, 0x01df0b05, 0x01df1f02, 0x01e0000c, 0x01e00702,
+	0x01e0080c, 0x01e01902, 0x01e01b0c, 0x01e02202, 0x01e0230c, 0x01e02502, 0x01e0260c, 0x01e02b02,
+	0x01e10007, 0x01e12d02, 0x01e1300c, 0x01e13706, 0x01e13e02, 0x01e1400d, 0x01e14a02, 0x01e14e07,
+	0x01e14f1a, 0x01e15002, 0x01e29007, 0x01e2ae0c, 0x01e2af02, 0x01e2c007, 0x01e2ec0c, 0x01e2f00d,
+	0x01e2fa02, 0x01e2ff17, 0x01e30002, 0x01e7e007, 0x01e7e702, 0x01e7e807, 0x01e7ec02, 0x01e7ed07,
+	0x01e7ef02, 0x01e7f007, 0x01e7ff02, 0x01e80007, 0x01e8c502, 0x01e8c70f, 0x01e8d00c, 0x01e8d702,
+	0x01e90009, 0x01e92205, 0x01e9440c, 0x01e94b06, 0x01e94c02, 0x01e9500d, 0x01e95a02, 0x01e95e15,
+	0x01e96002, 0x01ec710f, 0x01ecac1a, 0x01ecad0f, 0x01ecb017, 0x01ecb10f, 0x01ecb502, 0x01ed010f,
+	0x01ed2e1a, 0x01ed2f0f, 0x01ed3e02, 0x01ee0007, 0x01ee0402, 0x01ee0507, 0x01ee2002, 0x01ee2107,
+	0x01ee2302, 0x01ee2407, 0x01ee2502, 0x01ee2707, 0x01ee2802, 0x01ee2907, 0x01ee3302, 0x01ee3407,
+	0x01ee3802, 0x01ee3907, 0x01ee3a02, 0x01ee3b07, 0x01ee3c02, 0x01ee4207, 0x01ee4302, 0x01ee4707,
+	0x01ee4802, 0x01ee4907, 0x01ee4a02, 0x01ee4b07, 0x01ee4c02, 0x01ee4d07, 0x01ee5002, 0x01ee5107,
+	0x01ee5302, 0x01ee5407, 0x01ee5502, 0x01ee5707, 0x01ee5802, 0x01ee5907, 0x01ee5a02, 0x01ee5b07,
+	0x01ee5c02, 0x01ee5d07, 0x01ee5e02, 0x01ee5f07, 0x01ee6002, 0x01ee6107, 0x01ee6302, 0x01ee6407,
+	0x01ee6502, 0x01ee6707, 0x01ee6b02, 0x01ee6c07, 0x01ee7302, 0x01ee7407, 0x01ee7802, 0x01ee7907,
+	0x01ee7d02, 0x01ee7e07, 0x01ee7f02, 0x01ee8007, 0x01ee8a02, 0x01ee8b07, 0x01ee9c02, 0x01eea107,
+	0x01eea402, 0x01eea507, 0x01eeaa02, 0x01eeab07, 0x01eebc02, 0x01eef019, 0x01eef202, 0x01f0001a,
+	0x01f02c02, 0x01f0301a, 0x01f09402, 0x01f0a01a, 0x01f0af02, 0x01f0b11a, 0x01f0c002, 0x01f0c11a,
+	0x01f0d002, 0x01f0d11a, 0x01f0f602, 0x01f1000f, 0x01f10d1a, 0x01f1ae02, 0x01f1e61a, 0x01f20302,
+	0x01f2101a, 0x01f23c02, 0x01f2401a, 0x01f24902, 0x01f2501a, 0x01f25202, 0x01f2601a, 0x01f26602,
+	0x01f3001a, 0x01f3fb18, 0x01f4001a, 0x01f6d802, 0x01f6dd1a, 0x01f6ed02, 0x01f6f01a, 0x01f6fd02,
+	0x01f7001a, 0x01f77402, 0x01f7801a, 0x01f7d902, 0x01f7e01a, 0x01f7ec02, 0x01f7f01a, 0x01f7f102,
+	0x01f8001a, 0x01f80c02, 0x01f8101a, 0x01f84802, 0x01f8501a, 0x01f85a02, 0x01f8601a, 0x01f88802,
+	0x01f8901a, 0x01f8ae02, 0x01f8b01a, 0x01f8b202, 0x01f9001a, 0x01fa5402, 0x01fa601a, 0x01fa6e02,
+	0x01fa701a, 0x01fa7502, 0x01fa781a, 0x01fa7d02, 0x01fa801a, 0x01fa8702, 0x01fa901a, 0x01faad02,
+	0x01fab01a, 0x01fabb02, 0x01fac01a, 0x01fac602, 0x01fad01a, 0x01fada02, 0x01fae01a, 0x01fae802,
+	0x01faf01a, 0x01faf702, 0x01fb001a, 0x01fb9302, 0x01fb941a, 0x01fbcb02, 0x01fbf00d, 0x01fbfa02,
+	0x02000007, 0x02a6e002, 0x02a70007, 0x02b73902, 0x02b74007, 0x02b81e02, 0x02b82007, 0x02cea202,
+	0x02ceb007, 0x02ebe102, 0x02f80007, 0x02fa1e02, 0x03000007, 0x03134b02, 0x0e000101, 0x0e000202,
+	0x0e002001, 0x0e008002, 0x0e01000c, 0x0e01f002, 0x0f000003, 0x0ffffe02, 0x10000003, 0x10fffe02,
+}
+
+// East Asian width names indexed by the values in eastAsianWidthRuns
+var eastAsianWidthNames = []string{"A", "F", "H", "N", "Na", "W"}
+
+// Runs of code points with the same east asian width: each entry is the
+// first code point of the run << 8 | its value
+var eastAsianWidthRuns = []uint32{
+	0x00000003, 0x00002004, 0x00007f03, 0x0000a100, 0x0000a204, 0x0000a400, 0x0000a504, 0x0000a700,
+	0x0000a903, 0x0000aa00, 0x0000ab03, 0x0000ac04, 0x0000ad00, 0x0000af04, 0x0000b000, 0x0000b503,
+	0x0000b600, 0x0000bb03, 0x0000bc00, 0x0000c003, 0x0000c600, 0x0000c703, 0x0000d000, 0x0000d103,
+	0x0000d700, 0x0000d903, 0x0000de00, 0x0000e203, 0x0000e600, 0x0000e703, 0x0000e800, 0x0000eb03,
+	0x0000ec00, 0x0000ee03, 0x0000f000, 0x0000f103, 0x0000f200, 0x0000f403, 0x0000f700, 0x0000fb03,
+	0x0000fc00, 0x0000fd03, 0x0000fe00, 0x0000ff03, 0x00010100, 0x00010203, 0x00011100, 0x00011203,
+	0x00011300, 0x00011403, 0x00011b00, 0x00011c03, 0x00012600, 0x00012803, 0x00012b00, 0x00012c03,
+	0x00013100, 0x00013403, 0x00013800, 0x00013903, 0x00013f00, 0x00014303, 0x00014400, 0x00014503,
+	0x00014800, 0x00014c03, 0x00014d00, 0x00014e03, 0x00015200, 0x00015403, 0x00016600, 0x00016803,
+	0x00016b00, 0x00016c03, 0x0001ce00, 0x0001cf03, 0x0001d000, 0x0001d103, 0x0001d200, 0x0001d303,
+	0x0001d400, 0x0001d503, 0x0001d600, 0x0001d703, 0x0001d800, 0x0001d903, 0x0001da00, 0x0001db03,
+	0x0001dc00, 0x0001dd03, 0x00025100, 0x00025203, 0x00026100, 0x00026203, 0x0002c400, 0x0002c503,
+	0x0002c700, 0x0002c803, 0x0002c900, 0x0002cc03, 0x0002cd00, 0x0002ce03, 0x0002d000, 0x0002d103,
+	0x0002d800, 0x0002dc03, 0x0002dd00, 0x0002de03, 0x0002df00, 0x0002e003, 0x00030000, 0x00037003,
+	0x00037801, 0x00037a03, 0x00038001, 0x00038403, 0x00038b01, 0x00038c03, 0x00038d01, 0x00038e03,
+	0x00039100, 0x0003a201, 0x0003a300, 0x0003aa03, 0x0003b100, 0x0003c203, 0x0003c300, 0x0003ca03,
+	0x00040100, 0x00040203, 0x00041000, 0x00045003, 0x00045100, 0x00045203, 0x00053001, 0x00053103,
+	0x00055701, 0x00055903, 0x00058b01, 0x00058d03, 0x00059001, 0x00059103, 0x0005c801, 0x0005d003,
+	0x0005eb01, 0x0005ef03, 0x0005f501, 0x00060003, 0x00070e01, 0x00070f03, 0x00074b01, 0x00074d03,
+	0x0007b201, 0x0007c003, 0x0007fb01, 0x0007fd03, 0x00082e01, 0x00083003, 0x00083f01, 0x00084003,
+	0x00085c01, 0x00085e03, 0x00085f01, 0x00086003, 0x00086b01, 0x00087003, 0x00088f01, 0x00089003,
+	0x00089201, 0x00089803, 0x00098401, 0x00098503, 0x00098d01, 0x00098f03, 0x00099101, 0x00099303,
+	0x0009a901, 0x0009aa03, 0x0009b101, 0x0009b203, 0x0009b301, 0x0009b603, 0x0009ba01, 0x0009bc03,
+	0x0009c501, 0x0009c703, 0x0009c901, 0x0009cb03, 0x0009cf01, 0x0009d703, 0x0009d801, 0x0009dc03,
+	0x0009de01, 0x0009df03, 0x0009e401, 0x0009e603, 0x0009ff01, 0x000a0103, 0x000a0401, 0x000a0503,
+	0x000a0b01, 0x000a0f03, 0x000a1101, 0x000a1303, 0x000a2901, 0x000a2a03, 0x000a3101, 0x000a3203,
+	0x000a3401, 0x000a3503, 0x000a3701, 0x000a3803, 0x000a3a01, 0x000a3c03, 0x000a3d01, 0x000a3e03,
+	0x000a4301, 0x000a4703, 0x000a4901, 0x000a4b03, 0x000a4e01, 0x000a5103, 0x000a5201, 0x000a5903,
+	0x000a5d01, 0x000a5e03, 0x000a5f01, 0x000a6603, 0x000a7701, 0x000a8103, 0x000a8401, 0x000a8503,
+	0x000a8e01, 0x000a8f03, 0x000a9201, 0x000a9303, 0x000aa901, 0x000aaa03, 0x000ab101, 0x000ab203,
+	0x000ab401, 0x000ab503, 0x000aba01, 0x000abc03, 0x000ac601, 0x000ac703, 0x000aca01, 0x000acb03,
+	0x000ace01, 0x000ad003, 0x000ad101, 0x000ae003, 0x000ae401, 0x000ae603, 0x000af201, 0x000af903,
+	0x000b0001, 0x000b0103, 0x000b0401, 0x000b0503, 0x000b0d01, 0x000b0f03, 0x000b1101, 0x000b1303,
+	0x000b2901, 0x000b2a03, 0x000b3101, 0x000b3203, 0x000b3401, 0x000b3503, 0x000b3a01, 0x000b3c03,
+	0x000b4501, 0x000b4703, 0x000b4901, 0x000b4b03, 0x000b4e01, 0x000b5503, 0x000b5801, 0x000b5c03,
+	0x000b5e01, 0x000b5f03, 0x000b6401, 0x000b6603, 0x000b7801, 0x000b8203, 0x000b8401, 0x000b8503,
+	0x000b8b01, 0x000b8e03, 0x000b9101, 0x000b9203, 0x000b9601, 0x000b9903, 0x000b9b01, 0x000b9c03,
+	0x000b9d01, 0x000b9e03, 0x000ba001, 0x000ba303, 0x000ba501, 0x000ba803, 0x000bab01, 0x000bae03,
+	0x000bba01, 0x000bbe03, 0x000bc301, 0x000bc603, 0x000bc901, 0x000bca03, 0x000bce01, 0x000bd003,
+	0x000bd101, 0x000bd703, 0x000bd801, 0x000be603, 0x000bfb01, 0x000c0003, 0x000c0d01, 0x000c0e03,
+	0x000c1101, 0x000c1203, 0x000c2901, 0x000c2a03, 0x000c3a01, 0x000c3c03, 0x000c4501, 0x000c4603,
+	0x000c4901, 0x000c4a03, 0x000c4e01, 0x000c5503, 0x000c5701, 0x000c5803, 0x000c5b01, 0x000c5d03,
+	0x000c5e01, 0x000c6003, 0x000c6401, 0x000c6603, 0x000c7001, 0x000c7703, 0x000c8d01, 0x000c8e03,
+	0x000c9101, 0x000c9203, 0x000ca901, 0x000caa03, 0x000cb401, 0x000cb503, 0x000cba01, 0x000cbc03,
+	0x000cc501, 0x000cc603, 0x000cc901, 0x000cca03, 0x000cce01, 0x000cd503, 0x000cd701, 0x000cdd03,
+	0x000cdf01, 0x000ce003, 0x000ce401, 0x000ce603, 0x000cf001, 0x000cf103, 0x000cf301, 0x000d0003,
+	0x000d0d01, 0x000d0e03, 0x000d1101, 0x000d1203, 0x000d4501, 0x000d4603, 0x000d4901, 0x000d4a03,
+	0x000d5001, 0x000d5403, 0x000d6401, 0x000d6603, 0x000d8001, 0x000d8103, 0x000d8401, 0x000d8503,
+	0x000d9701, 0x000d9a03, 0x000db201, 0x000db303, 0x000dbc01, 0x000dbd03, 0x000dbe01, 0x000dc003,
+	0x000dc701, 0x000dca03, 0x000dcb01, 0x000dcf03, 0x000dd501, 0x000dd603, 0x000dd701, 0x000dd803,
+	0x000de001, 0x000de603, 0x000df001, 0x000df203, 0x000df501, 0x000e0103, 0x000e3b01, 0x000e3f03,
+	0x000e5c01, 0x000e8103, 0x000e8301, 0x000e8403, 0x000e8501, 0x000e8603, 0x000e8b01, 0x000e8c03,
+	0x000ea401, 0x000ea503, 0x000ea601, 0x000ea703, 0x000ebe01, 0x000ec003, 0x000ec501, 0x000ec603,
+	0x000ec701, 0x000ec803, 0x000ece01, 0x000ed003, 0x000eda01, 0x000edc03, 0x000ee001, 0x000f0003,
+	0x000f4801, 0x000f4903, 0x000f6d01, 0x000f7103, 0x000f9801, 0x000f9903, 0x000fbd01, 0x000fbe03,
+	0x000fcd01, 0x000fce03, 0x000fdb01, 0x00100003, 0x0010c601, 0x0010c703, 0x0010c801, 0x0010cd03,
+	0x0010ce01, 0x0010d003, 0x00110005, 0x00116003, 0x00124901, 0x00124a03, 0x00124e01, 0x00125003,
+	0x00125701, 0x00125803, 0x00125901, 0x00125a03, 0x00125e01, 0x00126003, 0x00128901, 0x00128a03,
+	0x00128e01, 0x00129003, 0x0012b101, 0x0012b203, 0x0012b601, 0x0012b803, 0x0012bf01, 0x0012c003,
+	0x0012c101, 0x0012c203, 0x0012c601, 0x0012c803, 0x0012d701, 0x0012d803, 0x00131101, 0x00131203,
+	0x00131601, 0x00131803, 0x00135b01, 0x00135d03, 0x00137d01, 0x00138003, 0x00139a01, 0x0013a003,
+	0x0013f601, 0x0013f803, 0x0013fe01, 0x00140003, 0x00169d01, 0x0016a003, 0x0016f901, 0x00170003,
+	0x00171601, 0x00171f03, 0x00173701, 0x00174003, 0x00175401, 0x00176003, 0x00176d01, 0x00176e03,
+	0x00177101, 0x00177203, 0x00177401, 0x00178003, 0x0017de01, 0x0017e003, 0x0017ea01, 0x0017f003,
+	0x0017fa01, 0x00180003, 0x00181a01, 0x00182003, 0x00187901, 0x00188003, 0x0018ab01, 0x0018b003,
+	0x0018f601, 0x00190003, 0x00191f01, 0x00192003, 0x00192c01, 0x00193003, 0x00193c01, 0x00194003,
+	0x00194101, 0x00194403, 0x00196e01, 0x00197003, 0x00197501, 0x00198003, 0x0019ac01, 0x0019b003,
+	0x0019ca01, 0x0019d003, 0x0019db01, 0x0019de03, 0x001a1c01, 0x001a1e03, 0x001a5f01, 0x001a6003,
+	0x001a7d01, 0x001a7f03, 0x001a8a01, 0x001a9003, 0x001a9a01, 0x001aa003, 0x001aae01, 0x001ab003,
+	0x001acf01, 0x001b0003, 0x001b4d01, 0x001b5003, 0x001b7f01, 0x001b8003, 0x001bf401, 0x001bfc03,
+	0x001c3801, 0x001c3b03, 0x001c4a01, 0x001c4d03, 0x001c8901, 0x001c9003, 0x001cbb01, 0x001cbd03,
+	0x001cc801, 0x001cd003, 0x001cfb01, 0x001d0003, 0x001f1601, 0x001f1803, 0x001f1e01, 0x001f2003,
+	0x001f4601, 0x001f4803, 0x001f4e01, 0x001f5003, 0x001f5801, 0x001f5903, 0x001f5a01, 0x001f5b03,
+	0x001f5c01, 0x001f5d03, 0x001f5e01, 0x001f5f03, 0x001f7e01, 0x001f8003, 0x001fb501, 0x001fb603,
+	0x001fc501, 0x001fc603, 0x001fd401, 0x001fd603, 0x001fdc01, 0x001fdd03, 0x001ff001, 0x001ff203,
+	0x001ff501, 0x001ff603, 0x001fff01, 0x00200003, 0x00201000, 0x00201103, 0x00201300, 0x00201703,
+	0x00201800, 0x00201a03, 0x00201c00, 0x00201e03, 0x00202000, 0x00202303, 0x00202400, 0x00202803,
+	0x00203000, 0x00203103, 0x00203200, 0x00203403, 0x00203500, 0x00203603, 0x00203b00, 0x00203c03,
+	0x00203e00, 0x00203f03, 0x00206501, 0x00206603, 0x00207201, 0x00207400, 0x00207503, 0x00207f00,
+	0x00208003, 0x00208100, 0x00208503, 0x00208f01, 0x00209003, 0x00209d01, 0x0020a003, 0x0020a902,
+	0x0020aa03, 0x0020ac00, 0x0020ad03, 0x0020c101, 0x0020d003, 0x0020f101, 0x00210003, 0x00210300,
+	0x00210403, 0x00210500, 0x00210603, 0x00210900, 0x00210a03, 0x00211300, 0x00211403, 0x00211600,
+	0x00211703, 0x00212100, 0x00212303, 0x00212600, 0x00212703, 0x00212b00, 0x00212c03, 0x00215300,
+	0x00215503, 0x00215b00, 0x00215f03, 0x00216000, 0x00216c03, 0x00217000, 0x00217a03, 0x00218900,
+	0x00218a03, 0x00218c01, 0x00219000, 0x00219a03, 0x0021b800, 0x0021ba03, 0x0021d200, 0x0021d303,
+	0x0021d400, 0x0021d503, 0x0021e700, 0x0021e803, 0x00220000, 0x00220103, 0x00220200, 0x00220403,
+	0x00220700, 0x00220903, 0x00220b00, 0x00220c03, 0x00220f00, 0x00221003, 0x00221100, 0x00221203,
+	0x00221500, 0x00221603, 0x00221a00, 0x00221b03, 0x00221d00, 0x00222103, 0x00222300, 0x00222403,
+	0x00222500, 0x00222603, 0x00222700, 0x00222d03, 0x00222e00, 0x00222f03, 0x00223400, 0x00223803,
+	0x00223c00, 0x00223e03, 0x00224800, 0x00224903, 0x00224c00, 0x00224d03, 0x00225200, 0x00225303,
+	0x00226000, 0x00226203, 0x00226400, 0x00226803, 0x00226a00, 0x00226c03, 0x00226e00, 0x00227003,
+	0x00228200, 0x00228403, 0x00228600, 0x00228803, 0x00229500, 0x00229603, 0x00229900, 0x00229a03,
+	0x0022a500, 0x0022a603, 0x0022bf00, 0x0022c003, 0x00231200, 0x00231303, 0x00231a05, 0x00231c03,
+	0x00232905, 0x00232b03, 0x0023e905, 0x0023ed03, 0x0023f005, 0x0023f103, 0x0023f305, 0x0023f403,
+	0x00242701, 0x00244003, 0x00244b01, 0x00246000, 0x0024ea03, 0x0024eb00, 0x00254c03, 0x00255000,
+	0x00257403, 0x00258000, 0x00259003, 0x00259200, 0x00259603, 0x0025a000, 0x0025a203, 0x0025a300,
+	0x0025aa03, 0x0025b200, 0x0025b403, 0x0025b600, 0x0025b803, 0x0025bc00, 0x0025be03, 0x0025c000,
+	0x0025c203, 0x0025c600, 0x0025c903, 0x0025cb00, 0x0025cc03, 0x0025ce00, 0x0025d203, 0x0025e200,
+	0x0025e603, 0x0025ef00, 0x0025f003, 0x0025fd05, 0x0025ff03, 0x00260500, 0x00260703, 0x00260900,
+	0x00260a03, 0x00260e00, 0x00261003, 0x00261405, 0x00261603, 0x00261c00, 0x00261d03, 0x00261e00,
+	0x00261f03, 0x00264000, 0x00264103, 0x00264200, 0x00264303, 0x00264805, 0x00265403, 0x00266000,
+	0x00266203, 0x00266300, 0x00266603, 0x00266700, 0x00266b03, 0x00266c00, 0x00266e03, 0x00266f00,
+	0x00267003, 0x00267f05, 0x00268003, 0x00269305, 0x00269403, 0x00269e00, 0x0026a003, 0x0026a105,
+	0x0026a203, 0x0026aa05, 0x0026ac03, 0x0026bd05, 0x0026bf00, 0x0026c003, 0x0026c405, 0x0026c600,
+	0x0026ce05, 0x0026cf00, 0x0026d405, 0x0026d500, 0x0026e203, 0x0026e300, 0x0026e403, 0x0026e800,
+	0x0026ea05, 0x0026eb00, 0x0026f205, 0x0026f400, 0x0026f505, 0x0026f600, 0x0026fa05, 0x0026fb00,
+	0x0026fd05, 0x0026fe00, 0x00270003, 0x00270505, 0x00270603, 0x00270a05, 0x00270c03, 0x00272805,
+	0x00272903, 0x00273d00, 0x00273e03, 0x00274c05, 0x00274d03, 0x00274e05, 0x00274f03, 0x00275305,
+	0x00275603, 0x00275705, 0x00275803, 0x00277600, 0x00278003, 0x00279505, 0x00279803, 0x0027b005,
+	0x0027b103, 0x0027bf05, 0x0027c003, 0x0027e604, 0x0027ee03, 0x00298504, 0x00298703, 0x002b1b05,
+	0x002b1d03, 0x002b5005, 0x002b5103, 0x002b5505, 0x002b5600, 0x002b5a03, 0x002b7401, 0x002b7603,
+	0x002b9601, 0x002b9703, 0x002cf401, 0x002cf903, 0x002d2601, 0x002d2703, 0x002d2801, 0x002d2d03,
+	0x002d2e01, 0x002d3003, 0x002d6801, 0x002d6f03, 0x002d7101, 0x002d7f03, 0x002d9701, 0x002da003,
+	0x002da701, 0x002da803, 0x002daf01, 0x002db003, 0x002db701, 0x002db803, 0x002dbf01, 0x002dc003,
+	0x002dc701, 0x002dc803, 0x002dcf01, 0x002dd003, 0x002dd701, 0x002dd803, 0x002ddf01, 0x002de003,
+	0x002e5e01, 0x002e8005, 0x002e9a01, 0x002e9b05, 0x002ef401, 0x002f0005, 0x002fd601, 0x002ff005,
+	0x002ffc01, 0x00300105, 0x00303f03, 0x00304001, 0x00304105, 0x00309701, 0x00309905, 0x00310001,
+	0x00310505, 0x00313001, 0x00313105, 0x00318f01, 0x00319005, 0x0031e401, 0x0031f005, 0x00321f01,
+	0x00322005, 0x00324800, 0x00325005, 0x004dc003, 0x004e0005, 0x00a48d01, 0x00a49005, 0x00a4c701,
+	0x00a4d003, 0x00a62c01, 0x00a64003, 0x00a6f801, 0x00a70003, 0x00a7cb01, 0x00a7d003, 0x00a7d201,
+	0x00a7d303, 0x00a7d401, 0x00a7d503, 0x00a7da01, 0x00a7f203, 0x00a82d01, 0x00a83003, 0x00a83a01,
+	0x00a84003, 0x00a87801, 0x00a88003, 0x00a8c601, 0x00a8ce03, 0x00a8da01, 0x00a8e003, 0x00a95401,
+	0x00a95f03, 0x00a96005, 0x00a97d01, 0x00a98003, 0x00a9ce01, 0x00a9cf03, 0x00a9da01, 0x00a9de03,
+	0x00a9ff01, 0x00aa0003, 0x00aa3701, 0x00aa4003, 0x00aa4e01, 0x00aa5003, 0x00aa5a01, 0x00aa5c03,
+	0x00aac301, 0x00aadb03, 0x00aaf701, 0x00ab0103, 0x00ab0701, 0x00ab0903, 0x00ab0f01, 0x00ab1103,
+	0x00ab1701, 0x00ab2003, 0x00ab2701, 0x00ab2803, 0x00ab2f01, 0x00ab3003, 0x00ab6c01, 0x00ab7003,
+	0x00abee01, 0x00abf003, 0x00abfa01, 0x00ac0005, 0x00d7a401, 0x00d7b003, 0x00d7c701, 0x00d7cb03,
+	0x00d7fc01, 0x00d80003, 0x00e00000, 0x00f90005, 0x00fa6e01, 0x00fa7005, 0x00fada01, 0x00fb0003,
+	0x00fb0701, 0x00fb1303, 0x00fb1801, 0x00fb1d03, 0x00fb3701, 0x00fb3803, 0x00fb3d01, 0x00fb3e03,
+	0x00fb3f01, 0x00fb4003, 0x00fb4201, 0x00fb4303, 0x00fb4501, 0x00fb4603, 0x00fbc301, 0x00fbd303,
+	0x00fd9001, 0x00fd9203, 0x00fdc801, 0x00fdcf03, 0x00fdd001, 0x00fdf003, 0x00fe0000, 0x00fe1005,
+	0x00fe1a01, 0x00fe2003, 0x00fe3005, 0x00fe5301, 0x00fe5405, 0x00fe6701, 0x00fe6805, 0x00fe6c01,
+	0x00fe7003, 0x00fe7501, 0x00fe7603, 0x00fefd01, 0x00feff03, 0x00ff0001, 0x00ff6102, 0x00ffbf01,
+	0x00ffc202, 0x00ffc801, 0x00ffca02, 0x00ffd001, 0x00ffd202, 0x00ffd801, 0x00ffda02, 0x00ffdd01,
+	0x00ffe802, 0x00ffef01, 0x00fff903, 0x00fffd00, 0x00fffe01, 0x01000003, 0x01000c01, 0x01000d03,
+	0x01002701, 0x01002803, 0x01003b01, 0x01003c03, 0x01003e01, 0x01003f03, 0x01004e01, 0x01005003,
+	0x01005e01, 0x01008003, 0x0100fb01, 0x01010003, 0x01010301, 0x01010703, 0x01013401, 0x01013703,
+	0x01018f01, 0x01019003, 0x01019d01, 0x0101a003, 0x0101a101, 0x0101d003, 0x0101fe01, 0x01028003,
+	0x01029d01, 0x0102a003, 0x0102d101, 0x0102e003, 0x0102fc01, 0x01030003, 0x01032401, 0x01032d03,
+	0x01034b01, 0x01035003, 0x01037b01, 0x01038003, 0x01039e01, 0x01039f03, 0x0103c401, 0x0103c803,
+	0x0103d601, 0x01040003, 0x01049e01, 0x0104a003, 0x0104aa01, 0x0104b003, 0x0104d401, 0x0104d803,
+	0x0104fc01, 0x01050003, 0x01052801, 0x01053003, 0x01056401, 0x01056f03, 0x01057b01, 0x01057c03,
+	0x01058b01, 0x01058c03, 0x01059301, 0x01059403, 0x01059601, 0x01059703, 0x0105a201, 0x0105a303,
+	0x0105b201, 0x0105b303, 0x0105ba01, 0x0105bb03, 0x0105bd01, 0x01060003, 0x01073701, 0x01074003,
+	0x01075601, 0x01076003, 0x01076801, 0x01078003, 0x01078601, 0x01078703, 0x0107b101, 0x0107b203,
+	0x0107bb01, 0x01080003, 0x01080601, 0x01080803, 0x01080901, 0x01080a03, 0x01083601, 0x01083703,
+	0x01083901, 0x01083c03, 0x01083d01, 0x01083f03, 0x01085601, 0x01085703, 0x01089f01, 0x0108a703,
+	0x0108b001, 0x0108e003, 0x0108f301, 0x0108f403, 0x0108f601, 0x0108fb03, 0x01091c01, 0x01091f03,
+	0x01093a01, 0x01093f03, 0x01094001, 0x01098003, 0x0109b801, 0x0109bc03, 0x0109d001, 0x0109d203,
+	0x010a0401, 0x010a0503, 0x010a0701, 0x010a0c03, 0x010a1401, 0x010a1503, 0x010a1801, 0x010a1903,
+	0x010a3601, 0x010a3803, 0x010a3b01, 0x010a3f03, 0x010a4901, 0x010a5003, 0x010a5901, 0x010a6003,
+	0x010aa001, 0x010ac003, 0x010ae701, 0x010aeb03, 0x010af701, 0x010b0003, 0x010b3601, 0x010b3903,
+	0x010b5601, 0x010b5803, 0x010b7301, 0x010b7803, 0x010b9201, 0x010b9903, 0x010b9d01, 0x010ba903,
+	0x010bb001, 0x010c0003, 0x010c4901, 0x010c8003, 0x010cb301, 0x010cc003, 0x010cf301, 0x010cfa03,
+	0x010d2801, 0x010d3003, 0x010d3a01, 0x010e6003, 0x010e7f01, 0x010e8003, 0x010eaa01, 0x010eab03,
+	0x010eae01, 0x010eb003, 0x010eb201, 0x010f0003, 0x010f2801, 0x010f3003, 0x010f5a01, 0x010f7003,
+	0x010f8a01, 0x010fb003, 0x010fcc01, 0x010fe003, 0x010ff701, 0x01100003, 0x01104e01, 0x01105203,
+	0x01107601, 0x01107f03, 0x0110c301, 0x0110cd03, 0x0110ce01, 0x0110d003, 0x0110e901, 0x0110f003,
+	0x0110fa01, 0x01110003, 0x01113501, 0x01113603, 0x01114801, 0x01115003, 0x01117701, 0x01118003,
+	0x0111e001, 0x0111e103, 0x0111f501, 0x01120003, 0x01121201, 0x01121303, 0x01123f01, 0x01128003,
+	0x01128701, 0x01128803, 0x01128901, 0x01128a03, 0x01128e01, 0x01128f03, 0x01129e01, 0x01129f03,
+	0x0112aa01, 0x0112b003, 0x0112eb01, 0x0112f003, 0x0112fa01, 0x01130003, 0x01130401, 0x01130503,
+	0x01130d01, 0x01130f03, 0x01131101, 0x01131303, 0x01132901, 0x01132a03, 0x01133101, 0x01133203,
+	0x01133401, 0x01133503, 0x01133a01, 0x01133b03, 0x01134501, 0x01134703, 0x01134901, 0x01134b03,
+	0x01134e01, 0x01135003, 0x01135101, 0x01135703, 0x01135801, 0x01135d03, 0x01136401, 0x01136603,
+	0x01136d01, 0x01137003, 0x01137501, 0x01140003, 0x01145c01, 0x01145d03, 0x01146201, 0x01148003,
+	0x0114c801, 0x0114d003, 0x0114da01, 0x01158003, 0x0115b601, 0x0115b803, 0x0115de01, 0x01160003,
+	0x01164501, 0x01165003, 0x01165a01, 0x01166003, 0x01166d01, 0x01168003, 0x0116ba01, 0x0116c003,
+	0x0116ca01, 0x01170003, 0x01171b01, 0x01171d03, 0x01172c01, 0x01173003, 0x01174701, 0x01180003,
+	0x01183c01, 0x0118a003, 0x0118f301, 0x0118ff03, 0x01190701, 0x01190903, 0x01190a01, 0x01190c03,
+	0x01191401, 0x01191503, 0x01191701, 0x01191803, 0x01193601, 0x01193703, 0x01193901, 0x01193b03,
+	0x01194701, 0x01195003, 0x01195a01, 0x0119a003, 0x0119a801, 0x0119aa03, 0x0119d801, 0x0119da03,
+	0x0119e501, 0x011a0003, 0x011a4801, 0x011a5003, 0x011aa301, 0x011ab003, 0x011af901, 0x011c0003,
+	0x011c0901, 0x011c0a03, 0x011c3701, 0x011c3803, 0x011c4601, 0x011c5003, 0x011c6d01, 0x011c7003,
+	0x011c9001, 0x011c9203, 0x011ca801, 0x011ca903, 0x011cb701, 0x011d0003, 0x011d0701, 0x011d0803,
+	0x011d0a01, 0x011d0b03, 0x011d3701, 0x011d3a03, 0x011d3b01, 0x011d3c03, 0x011d3e01, 0x011d3f03,
+	0x011d4801, 0x011d5003, 0x011d5a01, 0x011d6003, 0x011d6601, 0x011d6703, 0x011d6901, 0x011d6a03,
+	0x011d8f01, 0x011d9003, 0x011d9201, 0x011d9303, 0x011d9901, 0x011da003, 0x011daa01, 0x011ee003,
+	0x011ef901, 0x011fb003, 0x011fb101, 0x011fc003, 0x011ff201, 0x011fff03, 0x01239a01, 0x01240003,
+	0x01246f01, 0x01247003, 0x01247501, 0x01248003, 0x01254401, 0x012f9003, 0x012ff301, 0x01300003,
+	0x01342f01, 0x01343003, 0x01343901, 0x01440003, 0x01464701, 0x01680003, 0x016a3901, 0x016a4003,
+	0x016a5f01, 0x016a6003, 0x016a6a01, 0x016a6e03, 0x016abf01, 0x016ac003, 0x016aca01, 0x016ad003,
+	0x016aee01, 0x016af003, 0x016af601, 0x016b0003, 0x016b4601, 0x016b5003, 0x016b5a01, 0x016b5b03,
+	0x016b6201, 0x016b6303, 0x016b7801, 0x016b7d03, 0x016b9001, 0x016e4003, 0x016e9b01, 0x016f0003,
+	0x016f4b01, 0x016f4f03, 0x016f8801, 0x016f8f03, 0x016fa001, 0x016fe005, 0x016fe501, 0x016ff005,
+	0x016ff201, 0x01700005, 0x0187f801, 0x01880005, 0x018cd601, 0x018d0005, 0x018d0901, 0x01aff005,
+	0x01aff401, 0x01aff505, 0x01affc01, 0x01affd05, 0x01afff01, 0x01b00005, 0x01b12301, 0x01b15005,
+	0x01b15301, 0x01b16405, 0x01b16801, 0x01b17005, 0x01b2fc01, 0x01bc0003, 0x01bc6b01, 0x01bc7003,
+	0x01bc7d01, 0x01bc8003, 0x01bc8901, 0x01bc9003, 0x01bc9a01, 0x01bc9c03, 0x01bca401, 0x01cf0003,
+	0x01cf2e01, 0x01cf3003, 0x01cf4701, 0x01cf5003, 0x01cfc401, 0x01d00003, 0x01d0f601, 0x01d10003,
+	0x01d12701, 0x01d12903, 0x01d1eb01, 0x01d20003, 0x01d24601, 0x01d2e003, 0x01d2f401, 0x01d30003,
+	0x01d35701, 0x01d36003, 0x01d37901, 0x01d40003, 0x01d45501, 0x01d45603, 0x01d49d01, 0x01d49e03,
+	0x01d4a001, 0x01d4a203, 0x01d4a301, 0x01d4a503, 0x01d4a701, 0x01d4a903, 0x01d4ad01, 0x01d4ae03,
+	0x01d4ba01, 0x01d4bb03, 0x01d4bc01, 0x01d4bd03, 0x01d4c401, 0x01d4c503, 0x01d50601, 0x01d50703,
+	0x01d50b01, 0x01d50d03, 0x01d51501, 0x01d51603, 0x01d51d01, 0x01d51e03, 0x01d53a01, 0x01d53b03,
+	0x01d53f01, 0x01d54003, 0x01d54501, 0x01d54603, 0x01d54701, 0x01d54a03, 0x01d55101, 0x01d55203,
+	0x01d6a601, 0x01d6a803, 0x01d7cc01, 0x01d7ce03, 0x01da8c01, 0x01da9b03, 0x01daa001, 0x01daa103,
+	0x01dab001, 0x01df0003, 0x01df1f01, 0x01e00003, 0x01e00701, 0x01e00803, 0x01e01901, 0x01e01b03,
+	0x01e02201, 0x01e02303, 0x01e02501, 0x01e02603, 0x01e02b01, 0x01e10003, 0x01e12d01, 0x01e13003,
+	0x01e13e01, 0x01e14003, 0x01e14a01, 0x01e14e03, 0x01e15001, 0x01e29003, 0x01e2af01, 0x01e2c003,
+	0x01e2fa01, 0x01e2ff03, 0x01e30001, 0x01e7e003, 0x01e7e701, 0x01e7e803, 0x01e7ec01, 0x01e7ed03,
+	0x01e7ef01, 0x01e7f003, 0x01e7ff01, 0x01e80003, 0x01e8c501, 0x01e8c703, 0x01e8d701, 0x01e90003,
+	0x01e94c01, 0x01e95003, 0x01e95a01, 0x01e95e03, 0x01e96001, 0x01ec7103, 0x01ecb501, 0x01ed0103,
+	0x01ed3e01, 0x01ee0003, 0x01ee0401, 0x01ee0503, 0x01ee2001, 0x01ee2103, 0x01ee2301, 0x01ee2403,
+	0x01ee2501, 0x01ee2703, 0x01ee2801, 0x01ee2903, 0x01ee3301, 0x01ee3403, 0x01ee3801, 0x01ee3903,
+	0x01ee3a01, 0x01ee3b03, 0x01ee3c01, 0x01ee4203, 0x01ee4301, 0x01ee4703, 0x01ee4801, 0x01ee4903,
+	0x01ee4a01, 0x01ee4b03, 0x01ee4c01, 0x01ee4d03, 0x01ee5001, 0x01ee5103, 0x01ee5301, 0x01ee5403,
+	0x01ee5501, 0x01ee5703, 0x01ee5801, 0x01ee5903, 0x01ee5a01, 0x01ee5b03, 0x01ee5c01, 0x01ee5d03,
+	0x01ee5e01, 0x01ee5f03, 0x01ee6001, 0x01ee6103, 0x01ee6301, 0x01ee6403, 0x01ee6501, 0x01ee6703,
+	0x01ee6b01, 0x01ee6c03, 0x01ee7301, 0x01ee7403, 0x01ee7801, 0x01ee7903, 0x01ee7d01, 0x01ee7e03,
+	0x01ee7f01, 0x01ee8003, 0x01ee8a01, 0x01ee8b03, 0x01ee9c01, 0x01eea103, 0x01eea401, 0x01eea503,
+	0x01eeaa01, 0x01eeab03, 0x01eebc01, 0x01eef003, 0x01eef201, 0x01f00003, 0x01f00405, 0x01f00503,
+	0x01f02c01, 0x01f03003, 0x01f09401, 0x01f0a003, 0x01f0af01, 0x01f0b103, 0x01f0c001, 0x01f0c103,
+	0x01f0cf05, 0x01f0d001, 0x01f0d103, 0x01f0f601, 0x01f10000, 0x01f10b03, 0x01f11000, 0x01f12e03,
+	0x01f13000, 0x01f16a03, 0x01f17000, 0x01f18e05, 0x01f18f00, 0x01f19105, 0x01f19b00, 0x01f1ad03,
+	0x01f1ae01, 0x01f1e603, 0x01f20005, 0x01f20301, 0x01f21005, 0x01f23c01, 0x01f24005, 0x01f24901,
+	0x01f25005, 0x01f25201, 0x01f26005, 0x01f26601, 0x01f30005, 0x01f32103, 0x01f32d05, 0x01f33603,
+	0x01f33705, 0x01f37d03, 0x01f37e05, 0x01f39403, 0x01f3a005, 0x01f3cb03, 0x01f3cf05, 0x01f3d403,
+	0x01f3e005, 0x01f3f103, 0x01f3f405, 0x01f3f503, 0x01f3f805, 0x01f43f03, 0x01f44005, 0x01f44103,
+	0x01f44205, 0x01f4fd03, 0x01f4ff05, 0x01f53e03, 0x01f54b05, 0x01f54f03, 0x01f55005, 0x01f56803,
+	0x01f57a05, 0x01f57b03, 0x01f59505, 0x01f59703, 0x01f5a405, 0x01f5a503, 0x01f5fb05, 0x01f65003,
+	0x01f68005, 0x01f6c603, 0x01f6cc05, 0x01f6cd03, 0x01f6d005, 0x01f6d303, 0x01f6d505, 0x01f6d801,
+	0x01f6dd05, 0x01f6e003, 0x01f6eb05, 0x01f6ed01, 0x01f6f003, 0x01f6f405, 0x01f6fd01, 0x01f70003,
+	0x01f77401, 0x01f78003, 0x01f7d901, 0x01f7e005, 0x01f7ec01, 0x01f7f005, 0x01f7f101, 0x01f80003,
+	0x01f80c01, 0x01f81003, 0x01f84801, 0x01f85003, 0x01f85a01, 0x01f86003, 0x01f88801, 0x01f89003,
+	0x01f8ae01, 0x01f8b003, 0x01f8b201, 0x01f90003, 0x01f90c05, 0x01f93b03, 0x01f93c05, 0x01f94603,
+	0x01f94705, 0x01fa0003, 0x01fa5401, 0x01fa6003, 0x01fa6e01, 0x01fa7005, 0x01fa7501, 0x01fa7805,
+	0x01fa7d01, 0x01fa8005, 0x01fa8701, 0x01fa9005, 0x01faad01, 0x01fab005, 0x01fabb01, 0x01fac005,
+	0x01fac601, 0x01fad005, 0x01fada01, 0x01fae005, 0x01fae801, 0x01faf005, 0x01faf701, 0x01fb0003,
+	0x01fb9301, 0x01fb9403, 0x01fbcb01, 0x01fbf003, 0x01fbfa01, 0x02000005, 0x02a6e001, 0x02a70005,
+	0x02b73901, 0x02b74005, 0x02b81e01, 0x02b82005, 0x02cea201, 0x02ceb005, 0x02ebe101, 0x02f80005,
+	0x02fa1e01, 0x03000005, 0x03134b01, 0x0e000103, 0x0e000201, 0x0e002003, 0x0e008001, 0x0e010000,
+	0x0e01f001, 0x0f000000, 0x0ffffe01, 0x10000000, 0x10fffe01,
+}
+
+// Runs of code points with the same canonical combining class: each entry is the
+// first code point of the run << 8 | its value
+var combiningRuns = []uint32{
+	0x00000000, 0x000300e6, 0x000315e8, 0x000316dc, 0x00031ae8, 0x00031bd8, 0x00031cdc, 0x000321ca,
+	0x000323dc, 0x000327ca, 0x000329dc, 0x00033401, 0x000339dc, 0x00033de6, 0x000345f0, 0x000346e6,
+	0x000347dc, 0x00034ae6, 0x00034ddc, 0x00034f00, 0x000350e6, 0x000353dc, 0x000357e6, 0x000358e8,
+	0x000359dc, 0x00035be6, 0x00035ce9, 0x00035dea, 0x00035fe9, 0x000360ea, 0x000362e9, 0x000363e6,
+	0x00037000, 0x000483e6, 0x00048800, 0x000591dc, 0x000592e6, 0x000596dc, 0x000597e6, 0x00059ade,
+	0x00059bdc, 0x00059ce6, 0x0005a2dc, 0x0005a8e6, 0x0005aadc, 0x0005abe6, 0x0005adde, 0x0005aee4,
+	0x0005afe6, 0x0005b00a, 0x0005b10b, 0x0005b20c, 0x0005b30d, 0x0005b40e, 0x0005b50f, 0x0005b610,
+	0x0005b711, 0x0005b812, 0x0005b913, 0x0005bb14, 0x0005bc15, 0x0005bd16, 0x0005be00, 0x0005bf17,
+	0x0005c000, 0x0005c118, 0x0005c219, 0x0005c300, 0x0005c4e6, 0x0005c5dc, 0x0005c600, 0x0005c712,
+	0x0005c800, 0x000610e6, 0x0006181e, 0x0006191f, 0x00061a20, 0x00061b00, 0x00064b1b, 0x00064c1c,
+	0x00064d1d, 0x00064e1e, 0x00064f1f, 0x00065020, 0x00065121, 0x00065222, 0x000653e6, 0x000655dc,
+	0x000657e6, 0x00065cdc, 0x00065de6, 0x00065fdc, 0x00066000, 0x00067023, 0x00067100, 0x0006d6e6,
+	0x0006dd00, 0x0006dfe6, 0x0006e3dc, 0x0006e4e6, 0x0006e500, 0x0006e7e6, 0x0006e900, 0x0006eadc,
+	0x0006ebe6, 0x0006eddc, 0x0006ee00, 0x00071124, 0x00071200, 0x000730e6, 0x000731dc, 0x000732e6,
+	0x000734dc, 0x000735e6, 0x000737dc, 0x00073ae6, 0x00073bdc, 0x00073de6, 0x00073edc, 0x00073fe6,
+	0x000742dc, 0x000743e6, 0x000744dc, 0x000745e6, 0x000746dc, 0x000747e6, 0x000748dc, 0x000749e6,
+	0x00074b00, 0x0007ebe6, 0x0007f2dc, 0x0007f3e6, 0x0007f400, 0x0007fddc, 0x0007fe00, 0x000816e6,
+	0x00081a00, 0x00081be6, 0x00082400, 0x000825e6, 0x00082800, 0x000829e6, 0x00082e00, 0x000859dc,
+	0x00085c00, 0x000898e6, 0x000899dc, 0x00089ce6, 0x0008a000, 0x0008cae6, 0x0008cfdc, 0x0008d4e6,
+	0x0008e200, 0x0008e3dc, 0x0008e4e6, 0x0008e6dc, 0x0008e7e6, 0x0008e9dc, 0x0008eae6, 0x0008eddc,
+	0x0008f01b, 0x0008f11c, 0x0008f21d, 0x0008f3e6, 0x0008f6dc, 0x0008f7e6, 0x0008f9dc, 0x0008fbe6,
+	0x00090000, 0x00093c07, 0x00093d00, 0x00094d09, 0x00094e00, 0x000951e6, 0x000952dc, 0x000953e6,
+	0x00095500, 0x0009bc07, 0x0009bd00, 0x0009cd09, 0x0009ce00, 0x0009fee6, 0x0009ff00, 0x000a3c07,
+	0x000a3d00, 0x000a4d09, 0x000a4e00, 0x000abc07, 0x000abd00, 0x000acd09, 0x000ace00, 0x000b3c07,
+	0x000b3d00, 0x000b4d09, 0x000b4e00, 0x000bcd09, 0x000bce00, 0x000c3c07, 0x000c3d00, 0x000c4d09,
+	0x000c4e00, 0x000c5554, 0x000c565b, 0x000c5700, 0x000cbc07, 0x000cbd00, 0x000ccd09, 0x000cce00,
+	0x000d3b09, 0x000d3d00, 0x000d4d09, 0x000d4e00, 0x000dca09, 0x000dcb00, 0x000e3867, 0x000e3a09,
+	0x000e3b00, 0x000e486b, 0x000e4c00, 0x000eb876, 0x000eba09, 0x000ebb00, 0x000ec87a, 0x000ecc00,
+	0x000f18dc, 0x000f1a00, 0x000f35dc, 0x000f3600, 0x000f37dc, 0x000f3800, 0x000f39d8, 0x000f3a00,
+	0x000f7181, 0x000f7282, 0x000f7300, 0x000f7484, 0x000f7500, 0x000f7a82, 0x000f7e00, 0x000f8082,
+	0x000f8100, 0x000f82e6, 0x000f8409, 0x000f8500, 0x000f86e6, 0x000f8800, 0x000fc6dc, 0x000fc700,
+	0x00103707, 0x00103800, 0x00103909, 0x00103b00, 0x00108ddc, 0x00108e00, 0x00135de6, 0x00136000,
+	0x00171409, 0x00171600, 0x00173409, 0x00173500, 0x0017d209, 0x0017d300, 0x0017dde6, 0x0017de00,
+	0x0018a9e4, 0x0018aa00, 0x001939de, 0x00193ae6, 0x00193bdc, 0x00193c00, 0x001a17e6, 0x001a18dc,
+	0x001a1900, 0x001a6009, 0x001a6100, 0x001a75e6, 0x001a7d00, 0x001a7fdc, 0x001a8000, 0x001ab0e6,
+	0x001ab5dc, 0x001abbe6, 0x001abddc, 0x001abe00, 0x001abfdc, 0x001ac1e6, 0x001ac3dc, 0x001ac5e6,
+	0x001acadc, 0x001acbe6, 0x001acf00, 0x001b3407, 0x001b3500, 0x001b4409, 0x001b4500, 0x001b6be6,
+	0x001b6cdc, 0x001b6de6, 0x001b7400, 0x001baa09, 0x001bac00, 0x001be607, 0x001be700, 0x001bf209,
+	0x001bf400, 0x001c3707, 0x001c3800, 0x001cd0e6, 0x001cd300, 0x001cd401, 0x001cd5dc, 0x001cdae6,
+	0x001cdcdc, 0x001ce0e6, 0x001ce100, 0x001ce201, 0x001ce900, 0x001ceddc, 0x001cee00, 0x001cf4e6,
+	0x001cf500, 0x001cf8e6, 0x001cfa00, 0x001dc0e6, 0x001dc2dc, 0x001dc3e6, 0x001dcadc, 0x001dcbe6,
+	0x001dcdea, 0x001dced6, 0x001dcfdc, 0x001dd0ca, 0x001dd1e6, 0x001df6e8, 0x001df7e4, 0x001df9dc,
+	0x001dfada, 0x001dfbe6, 0x001dfce9, 0x001dfddc, 0x001dfee6, 0x001dffdc, 0x001e0000, 0x0020d0e6,
+	0x0020d201, 0x0020d4e6, 0x0020d801, 0x0020dbe6, 0x0020dd00, 0x0020e1e6, 0x0020e200, 0x0020e501,
+	0x0020e7e6, 0x0020e8dc, 0x0020e9e6, 0x0020ea01, 0x0020ecdc, 0x0020f0e6, 0x0020f100, 0x002cefe6,
+	0x002cf200, 0x002d7f09, 0x002d8000, 0x002de0e6, 0x002e0000, 0x00302ada, 0x00302be4, 0x00302ce8,
+	0x00302dde, 0x00302ee0, 0x00303000, 0x00309908, 0x00309b00, 0x00a66fe6, 0x00a67000, 0x00a674e6,
+	0x00a67e00, 0x00a69ee6, 0x00a6a000, 0x00a6f0e6, 0x00a6f200, 0x00a80609, 0x00a80700, 0x00a82c09,
+	0x00a82d00, 0x00a8c409, 0x00a8c500, 0x00a8e0e6, 0x00a8f200, 0x00a92bdc, 0x00a92e00, 0x00a95309,
+	0x00a95400, 0x00a9b307, 0x00a9b400, 0x00a9c009, 0x00a9c100, 0x00aab0e6, 0x00aab100, 0x00aab2e6,
+	0x00aab4dc, 0x00aab500, 0x00aab7e6, 0x00aab900, 0x00aabee6, 0x00aac000, 0x00aac1e6, 0x00aac200,
+	0x00aaf609, 0x00aaf700, 0x00abed09, 0x00abee00, 0x00fb1e1a, 0x00fb1f00, 0x00fe20e6, 0x00fe27dc,
+	0x00fe2ee6, 0x00fe3000, 0x0101fddc, 0x0101fe00, 0x0102e0dc, 0x0102e100, 0x010376e6, 0x01037b00,
+	0x010a0ddc, 0x010a0e00, 0x010a0fe6, 0x010a1000, 0x010a38e6, 0x010a3901, 0x010a3adc, 0x010a3b00,
+	0x010a3f09, 0x010a4000, 0x010ae5e6, 0x010ae6dc, 0x010ae700, 0x010d24e6, 0x010d2800, 0x010eabe6,
+	0x010ead00, 0x010f46dc, 0x010f48e6, 0x010f4bdc, 0x010f4ce6, 0x010f4ddc, 0x010f5100, 0x010f82e6,
+	0x010f83dc, 0x010f84e6, 0x010f85dc, 0x010f8600, 0x01104609, 0x01104700, 0x01107009, 0x01107100,
+	0x01107f09, 0x01108000, 0x0110b909, 0x0110ba07, 0x0110bb00, 0x011100e6, 0x01110300, 0x01113309,
+	0x01113500, 0x01117307, 0x01117400, 0x0111c009, 0x0111c100, 0x0111ca07, 0x0111cb00, 0x01123509,
+	0x01123607, 0x01123700, 0x0112e907, 0x0112ea09, 0x0112eb00, 0x01133b07, 0x01133d00, 0x01134d09,
+	0x01134e00, 0x011366e6, 0x01136d00, 0x011370e6, 0x01137500, 0x01144209, 0x01144300, 0x01144607,
+	0x01144700, 0x01145ee6, 0x01145f00, 0x0114c209, 0x0114c307, 0x0114c400, 0x0115bf09, 0x0115c007,
+	0x0115c100, 0x01163f09, 0x01164000, 0x0116b609, 0x0116b707, 0x0116b800, 0x01172b09, 0x01172c00,
+	0x01183909, 0x01183a07, 0x01183b00, 0x01193d09, 0x01193f00, 0x01194307, 0x01194400, 0x0119e009,
+	0x0119e100, 0x011a3409, 0x011a3500, 0x011a4709, 0x011a4800, 0x011a9909, 0x011a9a00, 0x011c3f09,
+	0x011c4000, 0x011d4207, 0x011d4300, 0x011d4409, 0x011d4600, 0x011d9709, 0x011d9800, 0x016af001,
+	0x016af500, 0x016b30e6, 0x016b3700, 0x016ff006, 0x016ff200, 0x01bc9e01, 0x01bc9f00, 0x01d165d8,
+	0x01d16701, 0x01d16a00, 0x01d16de2, 0x01d16ed8, 0x01d17300, 0x01d17bdc, 0x01d18300, 0x01d185e6,
+	0x01d18adc, 0x01d18c00, 0x01d1aae6, 0x01d1ae00, 0x01d242e6, 0x01d24500, 0x01e000e6, 0x01e00700,
+	0x01e008e6, 0x01e01900, 0x01e01be6, 0x01e02200, 0x01e023e6, 0x01e02500, 0x01e026e6, 0x01e02b00,
+	0x01e130e6, 0x01e13700, 0x01e2aee6, 0x01e2af00, 0x01e2ece6, 0x01e2f000, 0x01e8d0dc, 0x01e8d700,
+	0x01e944e6, 0x01e94a07, 0x01e94b00,
+}
+
+// Character decompositions sorted by code point
+var decompositions = []decomposition{
+	{0x00A0, "<noBreak>", []rune{0x0020}, false},
+	{0x00A8, "<compat>", []rune{0x0020, 0x0308}, false},
+	{0x00AA, "<super>", []rune{0x0061}, false},
+	{0x00AF, "<compat>", []rune{0x0020, 0x0304}, false},
+	{0x00B2, "<super>", []rune{0x0032}, false},
+	{0x00B3, "<super>", []rune{0x0033}, false},
+	{0x00B4, "<compat>", []rune{0x0020, 0x0301}, false},
+	{0x00B5, "<compat>", []rune{0x03BC}, false},
+	{0x00B8, "<compat>", []rune{0x0020, 0x0327}, false},
+	{0x00B9, "<super>", []rune{0x0031}, false},
+	{0x00BA, "<super>", []rune{0x006F}, false},
+	{0x00BC, "<fraction>", []rune{0x0031, 0x2044, 0x0034}, false},
+	{0x00BD, "<fraction>", []rune{0x0031, 0x2044, 0x0032}, false},
+	{0x00BE, "<fraction>", []rune{0x0033, 0x2044, 0x0034}, false},
+	{0x00C0, "", []rune{0x0041, 0x0300}, false},
+	{0x00C1, "", []rune{0x0041, 0x0301}, false},
+	{0x00C2, "", []rune{0x0041, 0x0302}, false},
+	{0x00C3, "", []rune{0x0041, 0x0303}, false},
+	{0x00C4, "", []rune{0x0041, 0x0308}, false},
+	{0x00C5, "", []rune{0x0041, 0x030A}, false},
+	{0x00C7, "", []rune{0x0043, 0x0327}, false},
+	{0x00C8, "", []rune{0x0045, 0x0300}, false},
+	{0x00C9, "", []rune{0x0045, 0x0301}, false},
+	{0x00CA, "", []rune{0x0045, 0x0302}, false},
+	{0x00CB, "", []rune{0x0045, 0x0308}, false},
+	{0x00CC, "", []rune{0x0049, 0x0300}, false},
+	{0x00CD, "", []rune{0x0049, 0x0301}, false},
+	{0x00CE, "", []rune{0x0049, 0x0302}, false},
+	{0x00CF, "", []rune{0x0049, 0x0308}, false},
+	{0x00D1, "", []rune{0x004E, 0x0303}, false},
+	{0x00D2, "", []rune{0x004F, 0x0300}, false},
+	{0x00D3, "", []rune{0x004F, 0x0301}, false},
+	{0x00D4, "", []rune{0x004F, 0x0302}, false},
+	{0x00D5, "", []rune{0x004F, 0x0303}, false},
+	{0x00D6, "", []rune{0x004F, 0x0308}, false},
+	{0x00D9, "", []rune{0x0055, 0x0300}, false},
+	{0x00DA, "", []rune{0x0055, 0x0301}, false},
+	{0x00DB, "", []rune{0x0055, 0x0302}, false},
+	{0x00DC, "", []rune{0x0055, 0x0308}, false},
+	{0x00DD, "", []rune{0x0059, 0x0301}, false},
+	{0x00E0, "", []rune{0x0061, 0x0300}, false},
+	{0x00E1, "", []rune{0x0061, 0x0301}, false},
+	{0x00E2, "", []rune{0x0061, 0x0302}, false},
+	{0x00E3, "", []rune{0x0061, 0x0303}, false},
+	{0x00E4, "", []rune{0x0061, 0x0308}, false},
+	{0x00E5, "", []rune{0x0061, 0x030A}, false},
+	{0x00E7, "", []rune{0x0063, 0x0327}, false},
+	{0x00E8, "", []rune{0x0065, 0x0300}, false},
+	{0x00E9, "", []rune{0x0065, 0x0301}, false},
+	{0x00EA, "", []rune{0x0065, 0x0302}, false},
+	{0x00EB, "", []rune{0x0065, 0x0308}, false},
+	{0x00EC, "", []rune{0x0069, 0x0300}, false},
+	{0x00ED, "", []rune{0x0069, 0x0301}, false},
+	{0x00EE, "", []rune{0x0069, 0x0302}, false},
+	{0x00EF, "", []rune{0x0069, 0x0308}, false},
+	{0x00F1, "", []rune{0x006E, 0x0303}, false},
+	{0x00F2, "", []rune{0x006F, 0x0300}, false},
+	{0x00F3, "", []rune{0x006F, 0x0301}, false},
+	{0x00F4, "", []rune{0x006F, 0x0302}, false},
+	{0x00F5, "", []rune{0x006F, 0x0303}, false},
+	{0x00F6, "", []rune{0x006F, 0x0308}, false},
+	{0x00F9, "", []rune{0x0075, 0x0300}, false},
+	{0x00FA, "", []rune{0x0075, 0x0301}, false},
+	{0x00FB, "", []rune{0x0075, 0x0302}, false},
+	{0x00FC, "", []rune{0x0075, 0x0308}, false},
+	{0x00FD, "", []rune{0x0079, 0x0301}, false},
+	{0x00FF, "", []rune{0x0079, 0x0308}, false},
+	{0x0100, "", []rune{0x0041, 0x0304}, false},
+	{0x0101, "", []rune{0x0061, 0x0304}, false},
+	{0x0102, "", []rune{0x0041, 0x0306}, false},
+	{0x0103, "", []rune{0x0061, 0x0306}, false},
+	{0x0104, "", []rune{0x0041, 0x0328}, false},
+	{0x0105, "", []rune{0x0061, 0x0328}, false},
+	{0x0106, "", []rune{0x0043, 0x0301}, false},
+	{0x0107, "", []rune{0x0063, 0x0301}, false},
+	{0x0108, "", []rune{0x0043, 0x0302}, false},
+	{0x0109, "", []rune{0x0063, 0x0302}, false},
+	{0x010A, "", []rune{0x0043, 0x0307}, false},
+	{0x010B, "", []rune{0x0063, 0x0307}, false},
+	{0x010C, "", []rune{0x0043, 0x030C}, false},
+	{0x010D, "", []rune{0x0063, 0x030C}, false},
+	{0x010E, "", []rune{0x0044, 0x030C}, false},
+	{0x010F, "", []rune{0x0064, 0x030C}, false},
+	{0x0112, "", []rune{0x0045, 0x0304}, false},
+	{0x0113, "", []rune{0x0065, 0x0304}, false},
+	{0x0114, "", []rune{0x0045, 0x0306}, false},
+	{0x0115, "", []rune{0x0065, 0x0306}, false},
+	{0x0116, "", []rune{0x0045, 0x0307}, false},
+	{0x0117, "", []rune{0x0065, 0x0307}, false},
+	{0x0118, "", []rune{0x0045, 0x0328}, false},
+	{0x0119, "", []rune{0x0065, 0x0328}, false},
+	{0x011A, "", []rune{0x0045, 0x030C}, false},
+	{0x011B, "", []rune{0x0065, 0x030C}, false},
+	{0x011C, "", []rune{0x0047, 0x0302}, false},
+	{0x011D, "", []rune{0x0067, 0x0302}, false},
+	{0x011E, "", []rune{0x0047, 0x0306}, false},
+	{0x011F, "", []rune{0x0067, 0x0306}, false},
+	{0x0120, "", []rune{0x0047, 0x0307}, false},
+	{0x0121, "", []rune{0x0067, 0x0307}, false},
+	{0x0122, "", []rune{0x0047, 0x0327}, false},
+	{0x0123, "", []rune{0x0067, 0x0327}, false},
+	{0x0124, "", []rune{0x0048, 0x0302}, false},
+	{0x0125, "", []rune{0x0068, 0x0302}, false},
+	{0x0128, "", []rune{0x0049, 0x0303}, false},
+	{0x0129, "", []rune{0x0069, 0x0303}, false},
+	{0x012A, "", []rune{0x0049, 0x0304}, false},
+	{0x012B, "", []rune{0x0069, 0x0304}, false},
+	{0x012C, "", []rune{0x0049, 0x0306}, false},
+	{0x012D, "", []rune{0x0069, 0x0306}, false},
+	{0x012E, "", []rune{0x0049, 0x0328}, false},
+	{0x012F, "", []rune{0x0069, 0x0328}, false},
+	{0x0130, "", []rune{0x0049, 0x0307}, false},
+	{0x0132, "<compat>", []rune{0x0049, 0x004A}, false},
+	{0x0133, "<compat>", []rune{0x0069, 0x006A}, false},
+	{0x0134, "", []rune{0x004A, 0x0302}, false},
+	{0x0135, "", []rune{0x006A, 0x0302}, false},
+	{0x0136, "", []rune{0x004B, 0x0327}, false},
+	{0x0137, "", []rune{0x006B, 0x0327}, false},
+	{0x0139, "", []rune{0x004C, 0x0301}, false},
+	{0x013A, "", []rune{0x006C, 0x0301}, false},
+	{0x013B, "", []rune{0x004C, 0x0327}, false},
+	{0x013C, "", []rune{0x006C, 0x0327}, false},
+	{0x013D, "", []rune{0x004C, 0x030C}, false},
+	{0x013E, "", []rune{0x006C, 0x030C}, false},
+	{0x013F, "<compat>", []rune{0x004C, 0x00B7}, false},
+	{0x0140, "<compat>", []rune{0x006C, 0x00B7}, false},
+	{0x0143, "", []rune{0x004E, 0x0301}, false},
+	{0x0144, "", []rune{0x006E, 0x0301}, false},
+	{0x0145, "", []rune{0x004E, 0x0327}, false},
+	{0x0146, "", []rune{0x006E, 0x0327}, false},
+	{0x0147, "", []rune{0x004E, 0x030C}, false},
+	{0x0148, "", []rune{0x006E, 0x030C}, false},
+	{0x0149, "<compat>", []rune{0x02BC, 0x006E}, false},
+	{0x014C, "", []rune{0x004F, 0x0304}, false},
+	{0x014D, "", []rune{0x006F, 0x0304}, false},
+	{0x014E, "", []rune{0x004F, 0x0306}, false},
+	{0x014F, "", []rune{0x006F, 0x0306}, false},
+	{0x0150, "", []rune{0x004F, 0x030B}, false},
+	{0x0151, "", []rune{0x006F, 0x030B}, false},
+	{0x0154, "", []rune{0x0052, 0x0301}, false},
+	{0x0155, "", []rune{0x0072, 0x0301}, false},
+	{0x0156, "", []rune{0x0052, 0x0327}, false},
+	{0x0157, "", []rune{0x0072, 0x0327}, false},
+	{0x0158, "", []rune{0x0052, 0x030C}, false},
+	{0x0159, "", []rune{0x0072, 0x030C}, false},
+	{0x015A, "", []rune{0x0053, 0x0301}, false},
+	{0x015B, "", []rune{0x0073, 0x0301}, false},
+	{0x015C, "", []rune{0x0053, 0x0302}, false},
+	{0x015D, "", []rune{0x0073, 0x0302}, false},
+	{0x015E, "", []rune{0x0053, 0x0327}, false},
+	{0x015F, "", []rune{0x0073, 0x0327}, false},
+	{0x0160, "", []rune{0x0053, 0x030C}, false},
+	{0x0161, "", []rune{0x0073, 0x030C}, false},
+	{0x0162, "", []rune{0x0054, 0x0327}, false},
+	{0x0163, "", []rune{0x0074, 0x0327}, false},
+	{0x0164, "", []rune{0x0054, 0x030C}, false},
+	{0x0165, "", []rune{0x0074, 0x030C}, false},
+	{0x0168, "", []rune{0x0055, 0x0303}, false},
+	{0x0169, "", []rune{0x0075, 0x0303}, false},
+	{0x016A, "", []rune{0x0055, 0x0304}, false},
+	{0x016B, "", []rune{0x0075, 0x0304}, false},
+	{0x016C, "", []rune{0x0055, 0x0306}, false},
+	{0x016D, "", []rune{0x0075, 0x0306}, false},
+	{0x016E, "", []rune{0x0055, 0x030A}, false},
+	{0x016F, "", []rune{0x0075, 0x030A}, false},
+	{0x0170, "", []rune{0x0055, 0x030B}, false},
+	{0x0171, "", []rune{0x0075, 0x030B}, false},
+	{0x0172, "", []rune{0x0055, 0x0328}, false},
+	{0x0173, "", []rune{0x0075, 0x0328}, false},
+	{0x0174, "", []rune{0x0057, 0x0302}, false},
+	{0x0175, "", []rune{0x0077, 0x0302}, false},
+	{0x0176, "", []rune{0x0059, 0x0302}, false},
+	{0x0177, "", []rune{0x0079, 0x0302}, false},
+	{0x0178, "", []rune{0x0059, 0x0308}, false},
+	{0x0179, "", []rune{0x005A, 0x0301}, false},
+	{0x017A, "", []rune{0x007A, 0x0301}, false},
+	{0x017B, "", []rune{0x005A, 0x0307}, false},
+	{0x017C, "", []rune{0x007A, 0x0307}, false},
+	{0x017D, "", []rune{0x005A, 0x030C}, false},
+	{0x017E, "", []rune{0x007A, 0x030C}, false},
+	{0x017F, "<compat>", []rune{0x0073}, false},
+	{0x01A0, "", []rune{0x004F, 0x031B}, false},
+	{0x01A1, "", []rune{0x006F, 0x031B}, false},
+	{0x01AF, "", []rune{0x0055, 0x031B}, false},
+	{0x01B0, "", []rune{0x0075, 0x031B}, false},
+	{0x01C4, "<compat>", []rune{0x0044, 0x017D}, false},
+	{0x01C5, "<compat>", []rune{0x0044, 0x017E}, false},
+	{0x01C6, "<compat>", []rune{0x0064, 0x017E}, false},
+	{0x01C7, "<compat>", []rune{0x004C, 0x004A}, false},
+	{0x01C8, "<compat>", []rune{0x004C, 0x006A}, false},
+	{0x01C9, "<compat>", []rune{0x006C, 0x006A}, false},
+	{0x01CA, "<compat>", []rune{0x004E, 0x004A}, false},
+	{0x01CB, "<compat>", []rune{0x004E, 0x006A}, false},
+	{0x01CC, "<compat>", []rune{0x006E, 0x006A}, false},
+	{0x01CD, "", []rune{0x0041, 0x030C}, false},
+	{0x01CE, "", []rune{0x0061, 0x030C}, false},
+	{0x01CF, "", []rune{0x0049, 0x030C}, false},
+	{0x01D0, "", []rune{0x0069, 0x030C}, false},
+	{0x01D1, "", []rune{0x004F, 0x030C}, false},
+	{0x01D2, "", []rune{0x006F, 0x030C}, false},
+	{0x01D3, "", []rune{0x0055, 0x030C}, false},
+	{0x01D4, "", []rune{0x0075, 0x030C}, false},
+	{0x01D5, "", []rune{0x00DC, 0x0304}, false},
+	{0x01D6, "", []rune{0x00FC, 0x0304}, false},
+	{0x01D7, "", []rune{0x00DC, 0x0301}, false},
+	{0x01D8, "", []rune{0x00FC, 0x0301}, false},
+	{0x01D9, "", []rune{0x00DC, 0x030C}, false},
+	{0x01DA, "", []rune{0x00FC, 0x030C}, false},
+	{0x01DB, "", []rune{0x00DC, 0x0300}, false},
+	{0x01DC, "", []rune{0x00FC, 0x0300}, false},
+	{0x01DE, "", []rune{0x00C4, 0x0304}, false},
+	{0x01DF, "", []rune{0x00E4, 0x0304}, false},
+	{0x01E0, "", []rune{0x0226, 0x0304}, false},
+	{0x01E1, "", []rune{0x0227, 0x0304}, false},
+	{0x01E2, "", []rune{0x00C6, 0x0304}, false},
+	{0x01E3, "", []rune{0x00E6, 0x0304}, false},
+	{0x01E6, "", []rune{0x0047, 0x030C}, false},
+	{0x01E7, "", []rune{0x0067, 0x030C}, false},
+	{0x01E8, "", []rune{0x004B, 0x030C}, false},
+	{0x01E9, "", []rune{0x006B, 0x030C}, false},
+	{0x01EA, "", []rune{0x004F, 0x0328}, false},
+	{0x01EB, "", []rune{0x006F, 0x0328}, false},
+	{0x01EC, "", []rune{0x01EA, 0x0304}, false},
+	{0x01ED, "", []rune{0x01EB, 0x0304}, false},
+	{0x01EE, "", []rune{0x01B7, 0x030C}, false},
+	{0x01EF, "", []rune{0x0292, 0x030C}, false},
+	{0x01F0, "", []rune{0x006A, 0x030C}, false},
+	{0x01F1, "<compat>", []rune{0x0044, 0x005A}, false},
+	{0x01F2, "<compat>", []rune{0x0044, 0x007A}, false},
+	{0x01F3, "<compat>", []rune{0x0064, 0x007A}, false},
+	{0x01F4, "", []rune{0x0047, 0x0301}, false},
+	{0x01F5, "", []rune{0x0067, 0x0301}, false},
+	{0x01F8, "", []rune{0x004E, 0x0300}, false},
+	{0x01F9, "", []rune{0x006E, 0x0300}, false},
+	{0x01FA, "", []rune{0x00C5, 0x0301}, false},
+	{0x01FB, "", []rune{0x00E5, 0x0301}, false},
+	{0x01FC, "", []rune{0x00C6, 0x0301}, false},
+	{0x01FD, "", []rune{0x00E6, 0x0301}, false},
+	{0x01FE, "", []rune{0x00D8, 0x0301}, false},
+	{0x01FF, "", []rune{0x00F8, 0x0301}, false},
+	{0x0200, "", []rune{0x0041, 0x030F}, false},
+	{0x0201, "", []rune{0x0061, 0x030F}, false},
+	{0x0202, "", []rune{0x0041, 0x0311}, false},
+	{0x0203, "", []rune{0x0061, 0x0311}, false},
+	{0x0204, "", []rune{0x0045, 0x030F}, false},
+	{0x0205, "", []rune{0x0065, 0x030F}, false},
+	{0x0206, "", []rune{0x0045, 0x0311}, false},
+	{0x0207, "", []rune{0x0065, 0x0311}, false},
+	{0x0208, "", []rune{0x0049, 0x030F}, false},
+	{0x0209, "", []rune{0x0069, 0x030F}, false},
+	{0x020A, "", []rune{0x0049, 0x0311}, false},
+	{0x020B, "", []rune{0x0069, 0x0311}, false},
+	{0x020C, "", []rune{0x004F, 0x030F}, false},
+	{0x020D, "", []rune{0x006F, 0x030F}, false},
+	{0x020E, "", []rune{0x004F, 0x0311}, false},
+	{0x020F, "", []rune{0x006F, 0x0311}, false},
+	{0x0210, "", []rune{0x0052, 0x030F}, false},
+	{0x0211, "", []rune{0x0072, 0x030F}, false},
+	{0x0212, "", []rune{0x0052, 0x0311}, false},
+	{0x0213, "", []rune{0x0072, 0x0311}, false},
+	{0x0214, "", []rune{0x0055, 0x030F}, false},
+	{0x0215, "", []rune{0x0075, 0x030F}, false},
+	{0x0216, "", []rune{0x0055, 0x0311}, false},
+	{0x0217, "", []rune{0x0075, 0x0311}, false},
+	{0x0218, "", []rune{0x0053, 0x0326}, false},
+	{0x0219, "", []rune{0x0073, 0x0326}, false},
+	{0x021A, "", []rune{0x0054, 0x0326}, false},
+	{0x021B, "", []rune{0x0074, 0x0326}, false},
+	{0x021E, "", []rune{0x0048, 0x030C}, false},
+	{0x021F, "", []rune{0x0068, 0x030C}, false},
+	{0x0226, "", []rune{0x0041, 0x0307}, false},
+	{0x0227, "", []rune{0x0061, 0x0307}, false},
+	{0x0228, "", []rune{0x0045, 0x0327}, false},
+	{0x0229, "", []rune{0x0065, 0x0327}, false},
+	{0x022A, "", []rune{0x00D6, 0x0304}, false},
+	{0x022B, "", []rune{0x00F6, 0x0304}, false},
+	{0x022C, "", []rune{0x00D5, 0x0304}, false},
+	{0x022D, "", []rune{0x00F5, 0x0304}, false},
+	{0x022E, "", []rune{0x004F, 0x0307}, false},
+	{0x022F, "", []rune{0x006F, 0x0307}, false},
+	{0x0230, "", []rune{0x022E, 0x0304}, false},
+	{0x0231, "", []rune{0x022F, 0x0304}, false},
+	{0x0232, "", []rune{0x0059, 0x0304}, false},
+	{0x0233, "", []rune{0x0079, 0x0304}, false},
+	{0x02B0, "<super>", []rune{0x0068}, false},
+	{0x02B1, "<super>", []rune{0x0266}, false},
+	{0x02B2, "<super>", []rune{0x006A}, false},
+	{0x02B3, "<super>", []rune{0x0072}, false},
+	{0x02B4, "<super>", []rune{0x0279}, false},
+	{0x02B5, "<super>", []rune{0x027B}, false},
+	{0x02B6, "<super>", []rune{0x0281}, false},
+	{0x02B7, "<super>", []rune{0x0077}, false},
+	{0x02B8, "<super>", []rune{0x0079}, false},
+	{0x02D8, "<compat>", []rune{0x0020, 0x0306}, false},
+	{0x02D9, "<compat>", []rune{0x0020, 0x0307}, false},
+	{0x02DA, "<compat>", []rune{0x0020, 0x030A}, false},
+	{0x02DB, "<compat>", []rune{0x0020, 0x0328}, false},
+	{0x02DC, "<compat>", []rune{0x0020, 0x0303}, false},
+	{0x02DD, "<compat>", []rune{0x0020, 0x030B}, false},
+	{0x02E0, "<super>", []rune{0x0263}, false},
+	{0x02E1, "<super>", []rune{0x006C}, false},
+	{0x02E2, "<super>", []rune{0x0073}, false},
+	{0x02E3, "<super>", []rune{0x0078}, false},
+	{0x02E4, "<super>", []rune{0x0295}, false},
+	{0x0340, "", []rune{0x0300}, true},
+	{0x0341, "", []rune{0x0301}, true},
+	{0x0343, "", []rune{0x0313}, true},
+	{0x0344, "", []rune{0x0308, 0x0301}, true},
+	{0x0374, "", []rune{0x02B9}, true},
+	{0x037A, "<compat>", []rune{0x0020, 0x0345}, false},
+	{0x037E, "", []rune{0x003B}, true},
+	{0x0384, "<compat>", []rune{0x0020, 0x0301}, false},
+	{0x0385, "", []rune{0x00A8, 0x0301}, false},
+	{0x0386, "", []rune{0x0391, 0x0301}, false},
+	{0x0387, "", []rune{0x00B7}, true},
+	{0x0388, "", []rune{0x0395, 0x0301}, false},
+	{0x0389, "", []rune{0x0397, 0x0301}, false},
+	{0x038A, "", []rune{0x0399, 0x0301}, false},
+	{0x038C, "", []rune{0x039F, 0x0301}, false},
+	{0x038E, "", []rune{0x03A5, 0x0301}, false},
+	{0x038F, "", []rune{0x03A9, 0x0301}, false},
+	{0x0390, "", []rune{0x03CA, 0x0301}, false},
+	{0x03AA, "", []rune{0x0399, 0x0308}, false},
+	{0x03AB, "", []rune{0x03A5, 0x0308}, false},
+	{0x03AC, "", []rune{0x03B1, 0x0301}, false},
+	{0x03AD, "", []rune{0x03B5, 0x0301}, false},
+	{0x03AE, "", []rune{0x03B7, 0x0301}, false},
+	{0x03AF, "", []rune{0x03B9, 0x0301}, false},
+	{0x03B0, "", []rune{0x03CB, 0x0301}, false},
+	{0x03CA, "", []rune{0x03B9, 0x0308}, false},
+	{0x03CB, "", []rune{0x03C5, 0x0308}, false},
+	{0x03CC, "", []rune{0x03BF, 0x0301}, false},
+	{0x03CD, "", []rune{0x03C5, 0x0301}, false},
+	{0x03CE, "", []rune{0x03C9, 0x0301}, false},
+	{0x03D0, "<compat>", []rune{0x03B2}, false},
+	{0x03D1, "<compat>", []rune{0x03B8}, false},
+	{0x03D2, "<compat>", []rune{0x03A5}, false},
+	{0x03D3, "", []rune{0x03D2, 0x0301}, false},
+	{0x03D4, "", []rune{0x03D2, 0x0308}, false},
+	{0x03D5, "<compat>", []rune{0x03C6}, false},
+	{0x03D6, "<compat>", []rune{0x03C0}, false},
+	{0x03F0, "<compat>", []rune{0x03BA}, false},
+	{0x03F1, "<compat>", []rune{0x03C1}, false},
+	{0x03F2, "<compat>", []rune{0x03C2}, false},
+	{0x03F4, "<compat>", []rune{0x0398}, false},
+	{0x03F5, "<compat>", []rune{0x03B5}, false},
+	{0x03F9, "<compat>", []rune{0x03A3}, false},
+	{0x0400, "", []rune{0x0415, 0x0300}, false},
+	{0x0401, "", []rune{0x0415, 0x0308}, false},
+	{0x0403, "", []rune{0x0413, 0x0301}, false},
+	{0x0407, "", []rune{0x0406, 0x0308}, false},
+	{0x040C, "", []rune{0x041A, 0x0301}, false},
+	{0x040D, "", []rune{0x0418, 0x0300}, false},
+	{0x040E, "", []rune{0x0423, 0x0306}, false},
+	{0x0419, "", []rune{0x0418, 0x0306}, false},
+	{0x0439, "", []rune{0x0438, 0x0306}, false},
+	{0x0450, "", []rune{0x0435, 0x0300}, false},
+	{0x0451, "", []rune{0x0435, 0x0308}, false},
+	{0x0453, "", []rune{0x0433, 0x0301}, false},
+	{0x0457, "", []rune{0x0456, 0x0308}, false},
+	{0x045C, "", []rune{0x043A, 0x0301}, false},
+	{0x045D, "", []rune{0x0438, 0x0300}, false},
+	{0x045E, "", []rune{0x0443, 0x0306}, false},
+	{0x0476, "", []rune{0x0474, 0x030F}, false},
+	{0x0477, "", []rune{0x0475, 0x030F}, false},
+	{0x04C1, "", []rune{0x0416, 0x0306}, false},
+	{0x04C2, "", []rune{0x0436, 0x0306}, false},
+	{0x04D0, "", []rune{0x0410, 0x0306}, false},
+	{0x04D1, "", []rune{0x0430, 0x0306}, false},
+	{0x04D2, "", []rune{0x0410, 0x0308}, false},
+	{0x04D3, "", []rune{0x0430, 0x0308}, false},
+	{0x04D6, "", []rune{0x0415, 0x0306}, false},
+	{0x04D7, "", []rune{0x0435, 0x0306}, false},
+	{0x04DA, "", []rune{0x04D8, 0x0308}, false},
+	{0x04DB, "", []rune{0x04D9, 0x0308}, false},
+	{0x04DC, "", []rune{0x0416, 0x0308}, false},
+	{0x04DD, "", []rune{0x0436, 0x0308}, false},
+	{0x04DE, "", []rune{0x0417, 0x0308}, false},
+	{0x04DF, "", []rune{0x0437, 0x0308}, false},
+	{0x04E2, "", []rune{0x0418, 0x0304}, false},
+	{0x04E3, "", []rune{0x0438, 0x0304}, false},
+	{0x04E4, "", []rune{0x0418, 0x0308}, false},
+	{0x04E5, "", []rune{0x0438, 0x0308}, false},
+	{0x04E6, "", []rune{0x041E, 0x0308}, false},
+	{0x04E7, "", []rune{0x043E, 0x0308}, false},
+	{0x04EA, "", []rune{0x04E8, 0x0308}, false},
+	{0x04EB, "", []rune{0x04E9, 0x0308}, false},
+	{0x04EC, "", []rune{0x042D, 0x0308}, false},
+	{0x04ED, "", []rune{0x044D, 0x0308}, false},
+	{0x04EE, "", []rune{0x0423, 0x0304}, false},
+	{0x04EF, "", []rune{0x0443, 0x0304}, false},
+	{0x04F0, "", []rune{0x0423, 0x0308}, false},
+	{0x04F1, "", []rune{0x0443, 0x0308}, false},
+	{0x04F2, "", []rune{0x0423, 0x030B}, false},
+	{0x04F3, "", []rune{0x0443, 0x030B}, false},
+	{0x04F4, "", []rune{0x0427, 0x0308}, false},
+	{0x04F5, "", []rune{0x0447, 0x0308}, false},
+	{0x04F8, "", []rune{0x042B, 0x0308}, false},
+	{0x04F9, "", []rune{0x044B, 0x0308}, false},
+	{0x0587, "<compat>", []rune{0x0565, 0x0582}, false},
+	{0x0622, "", []rune{0x0627, 0x0653}, false},
+	{0x0623, "", []rune{0x0627, 0x0654}, false},
+	{0x0624, "", []rune{0x0648, 0x0654}, false},
+	{0x0625, "", []rune{0x0627, 0x0655}, false},
+	{0x0626, "", []rune{0x064A, 0x0654}, false},
+	{0x0675, "<compat>", []rune{0x0627, 0x0674}, false},
+	{0x0676, "<compat>", []rune{0x0648, 0x0674}, false},
+	{0x0677, "<compat>", []rune{0x06C7, 0x0674}, false},
+	{0x0678, "<compat>", []rune{0x064A, 0x0674}, false},
+	{0x06C0, "", []rune{0x06D5, 0x0654}, false},
+	{0x06C2, "", []rune{0x06C1, 0x0654}, false},
+	{0x06D3, "", []rune{0x06D2, 0x0654}, false},
+	{0x0929, "", []rune{0x0928, 0x093C}, false},
+	{0x0931, "", []rune{0x0930, 0x093C}, false},
+	{0x0934, "", []rune{0x0933, 0x093C}, false},
+	{0x0958, "", []rune{0x0915, 0x093C}, true},
+	{0x0959, "", []rune{0x0916, 0x093C}, true},
+	{0x095A, "", []rune{0x0917, 0x093C}, true},
+	{0x095B, "", []rune{0x091C, 0x093C}, true},
+	{0x095C, "", []rune{0x0921, 0x093C}, true},
+	{0x095D, "", []rune{0x0922, 0x093C}, true},
+	{0x095E, "", []rune{0x092B, 0x093C}, true},
+	{0x095F, "", []rune{0x092F, 0x093C}, true},
+	{0x09CB, "", []rune{0x09C7, 0x09BE}, false},
+	{0x09CC, "", []rune{0x09C7, 0x09D7}, false},
+	{0x09DC, "", []rune{0x09A1, 0x09BC}, true},
+	{0x09DD, "", []rune{0x09A2, 0x09BC}, true},
+	{0x09DF, "", []rune{0x09AF, 0x09BC}, true},
+	{0x0A33, "", []rune{0x0A32, 0x0A3C}, true},
+	{0x0A36, "", []rune{0x0A38, 0x0A3C}, true},
+	{0x0A59, "", []rune{0x0A16, 0x0A3C}, true},
+	{0x0A5A, "", []rune{0x0A17, 0x0A3C}, true},
+	{0x0A5B, "", []rune{0x0A1C, 0x0A3C}, true},
+	{0x0A5E, "", []rune{0x0A2B, 0x0A3C}, true},
+	{0x0B48, "", []rune{0x0B47, 0x0B56}, false},
+	{0x0B4B, "", []rune{0x0B47, 0x0B3E}, false},
+	{0x0B4C, "", []rune{0x0B47, 0x0B57}, false},
+	{0x0B5C, "", []rune{0x0B21, 0x0B3C}, true},
+	{0x0B5D, "", []rune{0x0B22, 0x0B3C}, true},
+	{0x0B94, "", []rune{0x0B92, 0x0BD7}, false},
+	{0x0BCA, "", []rune{0x0BC6, 0x0BBE}, false},
+	{0x0BCB, "", []rune{0x0BC7, 0x0BBE}, false},
+	{0x0BCC, "", []rune{0x0BC6, 0x0BD7}, false},
+	{0x0C48, "", []rune{0x0C46, 0x0C56}, false},
+	{0x0CC0, "", []rune{0x0CBF, 0x0CD5}, false},
+	{0x0CC7, "", []rune{0x0CC6, 0x0CD5}, false},
+	{0x0CC8, "", []rune{0x0CC6, 0x0CD6}, false},
+	{0x0CCA, "", []rune{0x0CC6, 0x0CC2}, false},
+	{0x0CCB, "", []rune{0x0CCA, 0x0CD5}, false},
+	{0x0D4A, "", []rune{0x0D46, 0x0D3E}, false},
+	{0x0D4B, "", []rune{0x0D47, 0x0D3E}, false},
+	{0x0D4C, "", []rune{0x0D46, 0x0D57}, false},
+	{0x0DDA, "", []rune{0x0DD9, 0x0DCA}, false},
+	{0x0DDC, "", []rune{0x0DD9, 0x0DCF}, false},
+	{0x0DDD, "", []rune{0x0DDC, 0x0DCA}, false},
+	{0x0DDE, "", []rune{0x0DD9, 0x0DDF}, false},
+	{0x0E33, "<compat>", []rune{0x0E4D, 0x0E32}, false},
+	{0x0EB3, "<compat>", []rune{0x0ECD, 0x0EB2}, false},
+	{0x0EDC, "<compat>", []rune{0x0EAB, 0x0E99}, false},
+	{0x0EDD, "<compat>", []rune{0x0EAB, 0x0EA1}, false},
+	{0x0F0C, "<noBreak>", []rune{0x0F0B}, false},
+	{0x0F43, "", []rune{0x0F42, 0x0FB7}, true},
+	{0x0F4D, "", []rune{0x0F4C, 0x0FB7}, true},
+	{0x0F52, "", []rune{0x0F51, 0x0FB7}, true},
+	{0x0F57, "", []rune{0x0F56, 0x0FB7}, true},
+	{0x0F5C, "", []rune{0x0F5B, 0x0FB7}, true},
+	{0x0F69, "", []rune{0x0F40, 0x0FB5}, true},
+	{0x0F73, "", []rune{0x0F71, 0x0F72}, true},
+	{0x0F75, "", []rune{0x0F71, 0x0F74}, true},
+	{0x0F76, "", []rune{0x0FB2, 0x0F80}, true},
+	{0x0F77, "<compat>", []rune{0x0FB2, 0x0F81}, false},
+	{0x0F78, "", []rune{0x0FB3, 0x0F80}, true},
+	{0x0F79, "<compat>", []rune{0x0FB3, 0x0F81}, false},
+	{0x0F81, "", []rune{0x0F71, 0x0F80}, true},
+	{0x0F93, "", []rune{0x0F92, 0x0FB7}, true},
+	{0x0F9D, "", []rune{0x0F9C, 0x0FB7}, true},
+	{0x0FA2, "", []rune{0x0FA1, 0x0FB7}, true},
+	{0x0FA7, "", []rune{0x0FA6, 0x0FB7}, true},
+	{0x0FAC, "", []rune{0x0FAB, 0x0FB7}, true},
+	{0x0FB9, "", []rune{0x0F90, 0x0FB5}, true},
+	{0x1026, "", []rune{0x1025, 0x102E}, false},
+	{0x10FC, "<super>", []rune{0x10DC}, false},
+	{0x1B06, "", []rune{0x1B05, 0x1B35}, false},
+	{0x1B08, "", []rune{0x1B07, 0x1B35}, false},
+	{0x1B0A, "", []rune{0x1B09, 0x1B35}, false},
+	{0x1B0C, "", []rune{0x1B0B, 0x1B35}, false},
+	{0x1B0E, "", []rune{0x1B0D, 0x1B35}, false},
+	{0x1B12, "", []rune{0x1B11, 0x1B35}, false},
+	{0x1B3B, "", []rune{0x1B3A, 0x1B35}, false},
+	{0x1B3D, "", []rune{0x1B3C, 0x1B35}, false},
+	{0x1B40, "", []rune{0x1B3E, 0x1B35}, false},
+	{0x1B41, "", []rune{0x1B3F, 0x1B35}, false},
+	{0x1B43, "", []rune{0x1B42, 0x1B35}, false},
+	{0x1D2C, "<super>", []rune{0x0041}, false},
+	{0x1D2D, "<super>", []rune{0x00C6}, false},
+	{0x1D2E, "<super>", []rune{0x0042}, false},
+	{0x1D30, "<super>", []rune{0x0044}, false},
+	{0x1D31, "<super>", []rune{0x0045}, false},
+	{0x1D32, "<super>", []rune{0x018E}, false},
+	{0x1D33, "<super>", []rune{0x0047}, false},
+	{0x1D34, "<super>", []rune{0x0048}, false},
+	{0x1D35, "<super>", []rune{0x0049}, false},
+	{0x1D36, "<super>", []rune{0x004A}, false},
+	{0x1D37, "<super>", []rune{0x004B}, false},
+	{0x1D38, "<super>", []rune{0x004C}, false},
+	{0x1D39, "<super>", []rune{0x004D}, false},
+	{0x1D3A, "<super>", []rune{0x004E}, false},
+	{0x1D3C, "<super>", []rune{0x004F}, false},
+	{0x1D3D, "<super>", []rune{0x0222}, false},
+	{0x1D3E, "<super>", []rune{0x0050}, false},
+	{0x1D3F, "<super>", []rune{0x0052}, false},
+	{0x1D40, "<super>", []rune{0x0054}, false},
+	{0x1D41, "<super>", []rune{0x0055}, false},
+	{0x1D42, "<super>", []rune{0x0057}, false},
+	{0x1D43, "<super>", []rune{0x0061}, false},
+	{0x1D44, "<super>", []rune{0x0250}, false},
+	{0x1D45, "<super>", []rune{0x0251}, false},
+	{0x1D46, "<super>", []rune{0x1D02}, false},
+	{0x1D47, "<super>", []rune{0x0062}, false},
+	{0x1D48, "<super>", []rune{0x0064}, false},
+	{0x1D49, "<super>", []rune{0x0065}, false},
+	{0x1D4A, "<super>", []rune{0x0259}, false},
+	{0x1D4B, "<super>", []rune{0x025B}, false},
+	{0x1D4C, "<super>", []rune{0x025C}, false},
+	{0x1D4D, "<super>", []rune{0x0067}, false},
+	{0x1D4F, "<super>", []rune{0x006B}, false},
+	{0x1D50, "<super>", []rune{0x006D}, false},
+	{0x1D51, "<super>", []rune{0x014B}, false},
+	{0x1D52, "<super>", []rune{0x006F}, false},
+	{0x1D53, "<super>", []rune{0x0254}, false},
+	{0x1D54, "<super>", []rune{0x1D16}, false},
+	{0x1D55, "<super>", []rune{0x1D17}, false},
+	{0x1D56, "<super>", []rune{0x0070}, false},
+	{0x1D57, "<super>", []rune{0x0074}, false},
+	{0x1D58, "<super>", []rune{0x0075}, false},
+	{0x1D59, "<super>", []rune{0x1D1D}, false},
+	{0x1D5A, "<super>", []rune{0x026F}, false},
+	{0x1D5B, "<super>", []rune{0x0076}, false},
+	{0x1D5C, "<super>", []rune{0x1D25}, false},
+	{0x1D5D, "<super>", []rune{0x03B2}, false},
+	{0x1D5E, "<super>", []rune{0x03B3}, false},
+	{0x1D5F, "<super>", []rune{0x03B4}, false},
+	{0x1D60, "<super>", []rune{0x03C6}, false},
+	{0x1D61, "<super>", []rune{0x03C7}, false},
+	{0x1D62, "<sub>", []rune{0x0069}, false},
+	{0x1D63, "<sub>", []rune{0x0072}, false},
+	{0x1D64, "<sub>", []rune{0x0075}, false},
+	{0x1D65, "<sub>", []rune{0x0076}, false},
+	{0x1D66, "<sub>", []rune{0x03B2}, false},
+	{0x1D67, "<sub>", []rune{0x03B3}, false},
+	{0x1D68, "<sub>", []rune{0x03C1}, false},
+	{0x1D69, "<sub>", []rune{0x03C6}, false},
+	{0x1D6A, "<sub>", []rune{0x03C7}, false},
+	{0x1D78, "<super>", []rune{0x043D}, false},
+	{0x1D9B, "<super>", []rune{0x0252}, false},
+	{0x1D9C, "<super>", []rune{0x0063}, false},
+	{0x1D9D, "<super>", []rune{0x0255}, false},
+	{0x1D9E, "<super>", []rune{0x00F0}, false},
+	{0x1D9F, "<super>", []rune{0x025C}, false},
+	{0x1DA0, "<super>", []rune{0x0066}, false},
+	{0x1DA1, "<super>", []rune{0x025F}, false},
+	{0x1DA2, "<super>", []rune{0x0261}, false},
+	{0x1DA3, "<super>", []rune{0x0265}, false},
+	{0x1DA4, "<super>", []rune{0x0268}, false},
+	{0x1DA5, "<super>", []rune{0x0269}, false},
+	{0x1DA6, "<super>", []rune{0x026A}, false},
+	{0x1DA7, "<super>", []rune{0x1D7B}, false},
+	{0x1DA8, "<super>", []rune{0x029D}, false},
+	{0x1DA9, "<super>", []rune{0x026D}, false},
+	{0x1DAA, "<super>", []rune{0x1D85}, false},
+	{0x1DAB, "<super>", []rune{0x029F}, false},
+	{0x1DAC, "<super>", []rune{0x0271}, false},
+	{0x1DAD, "<super>", []rune{0x0270}, false},
+	{0x1DAE, "<super>", []rune{0x0272}, false},
+	{0x1DAF, "<super>", []rune{0x0273}, false},
+	{0x1DB0, "<super>", []rune{0x0274}, false},
+	{0x1DB1, "<super>", []rune{0x0275}, false},
+	{0x1DB2, "<super>", []rune{0x0278}, false},
+	{0x1DB3, "<super>", []rune{0x0282}, false},
+	{0x1DB4, "<super>", []rune{0x0283}, false},
+	{0x1DB5, "<super>", []rune{0x01AB}, false},
+	{0x1DB6, "<super>", []rune{0x0289}, false},
+	{0x1DB7, "<super>", []rune{0x028A}, false},
+	{0x1DB8, "<super>", []rune{0x1D1C}, false},
+	{0x1DB9, "<super>", []rune{0x028B}, false},
+	{0x1DBA, "<super>", []rune{0x028C}, false},
+	{0x1DBB, "<super>", []rune{0x007A}, false},
+	{0x1DBC, "<super>", []rune{0x0290}, false},
+	{0x1DBD, "<super>", []rune{0x0291}, false},
+	{0x1DBE, "<super>", []rune{0x0292}, false},
+	{0x1DBF, "<super>", []rune{0x03B8}, false},
+	{0x1E00, "", []rune{0x0041, 0x0325}, false},
+	{0x1E01, "", []rune{0x0061, 0x0325}, false},
+	{0x1E02, "", []rune{0x0042, 0x0307}, false},
+	{0x1E03, "", []rune{0x0062, 0x0307}, false},
+	{0x1E04, "", []rune{0x0042, 0x0323}, false},
+	{0x1E05, "", []rune{0x0062, 0x0323}, false},
+	{0x1E06, "", []rune{0x0042, 0x0331}, false},
+	{0x1E07, "", []rune{0x0062, 0x0331}, false},
+	{0x1E08, "", []rune{0x00C7, 0x0301}, false},
+	{0x1E09, "", []rune{0x00E7, 0x0301}, false},
+	{0x1E0A, "", []rune{0x0044, 0x0307}, false},
+	{0x1E0B, "", []rune{0x0064, 0x0307}, false},
+	{0x1E0C, "", []rune{0x0044, 0x0323}, false},
+	{0x1E0D, "", []rune{0x0064, 0x0323}, false},
+	{0x1E0E, "", []rune{0x0044, 0x0331}, false},
+	{0x1E0F, "", []rune{0x0064, 0x0331}, false},
+	{0x1E10, "", []rune{0x0044, 0x0327}, false},
+	{0x1E11, "", []rune{0x0064, 0x0327}, false},
+	{0x1E12, "", []rune{0x0044, 0x032D}, false},
+	{0x1E13, "", []rune{0x0064, 0x032D}, false},
+	{0x1E14, "", []rune{0x0112, 0x0300}, false},
+	{0x1E15, "", []rune{0x0113, 0x0300}, false},
+	{0x1E16, "", []rune{0x0112, 0x0301}, false},
+	{0x1E17, "", []rune{0x0113, 0x0301}, false},
+	{0x1E18, "", []rune{0x0045, 0x032D}, false},
+	{0x1E19, "", []rune{0x0065, 0x032D}, false},
+	{0x1E1A, "", []rune{0x0045, 0x0330}, false},
+	{0x1E1B, "", []rune{0x0065, 0x0330}, false},
+	{0x1E1C, "", []rune{0x0228, 0x0306}, false},
+	{0x1E1D, "", []rune{0x0229, 0x0306}, false},
+	{0x1E1E, "", []rune{0x0046, 0x0307}, false},
+	{0x1E1F, "", []rune{0x0066, 0x0307}, false},
+	{0x1E20, "", []rune{0x0047, 0x0304}, false},
+	{0x1E21, "", []rune{0x0067, 0x0304}, false},
+	{0x1E22, "", []rune{0x0048, 0x0307}, false},
+	{0x1E23, "", []rune{0x0068, 0x0307}, false},
+	{0x1E24, "", []rune{0x0048, 0x0323}, false},
+	{0x1E25, "", []rune{0x0068, 0x0323}, false},
+	{0x1E26, "", []rune{0x0048, 0x0308}, false},
+	{0x1E27, "", []rune{0x0068, 0x0308}, false},
+	{0x1E28, "", []rune{0x0048, 0x0327}, false},
+	{0x1E29, "", []rune{0x0068, 0x0327}, false},
+	{0x1E2A, "", []rune{0x0048, 0x032E}, false},
+	{0x1E2B, "", []rune{0x0068, 0x032E}, false},
+	{0x1E2C, "", []rune{0x0049, 0x0330}, false},
+	{0x1E2D, "", []rune{0x0069, 0x0330}, false},
+	{0x1E2E, "", []rune{0x00CF, 0x0301}, false},
+	{0x1E2F, "", []rune{0x00EF, 0x0301}, false},
+	{0x1E30, "", []rune{0x004B, 0x0301}, false},
+	{0x1E31, "", []rune{0x006B, 0x0301}, false},
+	{0x1E32, "", []rune{0x004B, 0x0323}, false},
+	{0x1E33, "", []rune{0x006B, 0x0323}, false},
+	{0x1E34, "", []rune{0x004B, 0x0331}, false},
+	{0x1E35, "", []rune{0x006B, 0x0331}, false},
+	{0x1E36, "", []rune{0x004C, 0x0323}, false},
+	{0x1E37, "", []rune{0x006C, 0x0323}, false},
+	{0x1E38, "", []rune{0x1E36, 0x0304}, false},
+	{0x1E39, "", []rune{0x1E37, 0x0304}, false},
+	{0x1E3A, "", []rune{0x004C, 0x0331}, false},
+	{0x1E3B, "", []rune{0x006C, 0x0331}, false},
+	{0x1E3C, "", []rune{0x004C, 0x032D}, false},
+	{0x1E3D, "", []rune{0x006C, 0x032D}, false},
+	{0x1E3E, "", []rune{0x004D, 0x0301}, false},
+	{0x1E3F, "", []rune{0x006D, 0x0301}, false},
+	{0x1E40, "", []rune{0x004D, 0x0307}, false},
+	{0x1E41, "", []rune{0x006D, 0x0307}, false},
+	{0x1E42, "", []rune{0x004D, 0x0323}, false},
+	{0x1E43, "", []rune{0x006D, 0x0323}, false},
+	{0x1E44, "", []rune{0x004E, 0x0307}, false},
+	{0x1E45, "", []rune{0x006E, 0x0307}, false},
+	{0x1E46, "", []rune{0x004E, 0x0323}, false},
+	{0x1E47, "", []rune{0x006E, 0x0323}, false},
+	{0x1E48, "", []rune{0x004E, 0x0331}, false},
+	{0x1E49, "", []rune{0x006E, 0x0331}, false},
+	{0x1E4A, "", []rune{0x004E, 0x032D}, false},
+	{0x1E4B, "", []rune{0x006E, 0x032D}, false},
+	{0x1E4C, "", []rune{0x00D5, 0x0301}, false},
+	{0x1E4D, "", []rune{0x00F5, 0x0301}, false},
+	{0x1E4E, "", []rune{0x00D5, 0x0308}, false},
+	{0x1E4F, "", []rune{0x00F5, 0x0308}, false},
+	{0x1E50, "", []rune{0x014C, 0x0300}, false},
+	{0x1E51, "", []rune{0x014D, 0x0300}, false},
+	{0x1E52, "", []rune{0x014C, 0x0301}, false},
+	{0x1E53, "", []rune{0x014D, 0x0301}, false},
+	{0x1E54, "", []rune{0x0050, 0x0301}, false},
+	{0x1E55, "", []rune{0x0070, 0x0301}, false},
+	{0x1E56, "", []rune{0x0050, 0x0307}, false},
+	{0x1E57, "", []rune{0x0070, 0x0307}, false},
+	{0x1E58, "", []rune{0x0052, 0x0307}, false},
+	{0x1E59, "", []rune{0x0072, 0x0307}, false},
+	{0x1E5A, "", []rune{0x0052, 0x0323}, false},
+	{0x1E5B, "", []rune{0x0072, 0x0323}, false},
+	{0x1E5C, "", []rune{0x1E5A, 0x0304}, false},
+	{0x1E5D, "", []rune{0x1E5B, 0x0304}, false},
+	{0x1E5E, "", []rune{0x0052, 0x0331}, false},
+	{0x1E5F, "", []rune{0x0072, 0x0331}, false},
+	{0x1E60, "", []rune{0x0053, 0x0307}, false},
+	{0x1E61, "", []rune{0x0073, 0x0307}, false},
+	{0x1E62, "", []rune{0x0053, 0x0323}, false},
+	{0x1E63, "", []rune{0x0073, 0x0323}, false},
+	{0x1E64, "", []rune{0x015A, 0x0307}, false},
+	{0x1E65, "", []rune{0x015B, 0x0307}, false},
+	{0x1E66, "", []rune{0x0160, 0x0307}, false},
+	{0x1E67, "", []rune{0x0161, 0x0307}, false},
+	{0x1E68, "", []rune{0x1E62, 0x0307}, false},
+	{0x1E69, "", []rune{0x1E63, 0x0307}, false},
+	{0x1E6A, "", []rune{0x0054, 0x0307}, false},
+	{0x1E6B, "", []rune{0x0074, 0x0307}, false},
+	{0x1E6C, "", []rune{0x0054, 0x0323}, false},
+	{0x1E6D, "", []rune{0x0074, 0x0323}, false},
+	{0x1E6E, "", []rune{0x0054, 0x0331}, false},
+	{0x1E6F, "", []rune{0x0074, 0x0331}, false},
+	{0x1E70, "", []rune{0x0054, 0x032D}, false},
+	{0x1E71, "", []rune{0x0074, 0x032D}, false},
+	{0x1E72, "", []rune{0x0055, 0x0324}, false},
+	{0x1E73, "", []rune{0x0075, 0x0324}, false},
+	{0x1E74, "", []rune{0x0055, 0x0330}, false},
+	{0x1E75, "", []rune{0x0075, 0x0330}, false},
+	{0x1E76, "", []rune{0x0055, 0x032D}, false},
+	{0x1E77, "", []rune{0x0075, 0x032D}, false},
+	{0x1E78, "", []rune{0x0168, 0x0301}, false},
+	{0x1E79, "", []rune{0x0169, 0x0301}, false},
+	{0x1E7A, "", []rune{0x016A, 0x0308}, false},
+	{0x1E7B, "", []rune{0x016B, 0x0308}, false},
+	{0x1E7C, "", []rune{0x0056, 0x0303}, false},
+	{0x1E7D, "", []rune{0x0076, 0x0303}, false},
+	{0x1E7E, "", []rune{0x0056, 0x0323}, false},
+	{0x1E7F, "", []rune{0x0076, 0x0323}, false},
+	{0x1E80, "", []rune{0x0057, 0x0300}, false},
+	{0x1E81, "", []rune{0x0077, 0x0300}, false},
+	{0x1E82, "", []rune{0x0057, 0x0301}, false},
+	{0x1E83, "", []rune{0x0077, 0x0301}, false},
+	{0x1E84, "", []rune{0x0057, 0x0308}, false},
+	{0x1E85, "", []rune{0x0077, 0x0308}, false},
+	{0x1E86, "", []rune{0x0057, 0x0307}, false},
+	{0x1E87, "", []rune{0x0077, 0x0307}, false},
+	{0x1E88, "", []rune{0x0057, 0x0323}, false},
+	{0x1E89, "", []rune{0x0077, 0x0323}, false},
+	{0x1E8A, "", []rune{0x0058, 0x0307}, false},
+	{0x1E8B, "", []rune{0x0078, 0x0307}, false},
+	{0x1E8C, "", []rune{0x0058, 0x0308}, false},
+	{0x1E8D, "", []rune{0x0078, 0x0308}, false},
+	{0x1E8E, "", []rune{0x0059, 0x0307}, false},
+	{0x1E8F, "", []rune{0x0079, 0x0307}, false},
+	{0x1E90, "", []rune{0x005A, 0x0302}, false},
+	{0x1E91, "", []rune{0x007A, 0x0302}, false},
+	{0x1E92, "", []rune{0x005A, 0x0323}, false},
+	{0x1E93, "", []rune{0x007A, 0x0323}, false},
+	{0x1E94, "", []rune{0x005A, 0x0331}, false},
+	{0x1E95, "", []rune{0x007A, 0x0331}, false},
+	{0x1E96, "", []rune{0x0068, 0x0331}, false},
+	{0x1E97, "", []rune{0x0074, 0x0308}, false},
+	{0x1E98, "", []rune{0x0077, 0x030A}, false},
+	{0x1E99, "", []rune{0x0079, 0x030A}, false},
+	{0x1E9A, "<compat>", []rune{0x0061, 0x02BE}, false},
+	{0x1E9B, "", []rune{0x017F, 0x0307}, false},
+	{0x1EA0, "", []rune{0x0041, 0x0323}, false},
+	{0x1EA1, "", []rune{0x0061, 0x0323}, false},
+	{0x1EA2, "", []rune{0x0041, 0x0309}, false},
+	{0x1EA3, "", []rune{0x0061, 0x0309}, false},
+	{0x1EA4, "", []rune{0x00C2, 0x0301}, false},
+	{0x1EA5, "", []rune{0x00E2, 0x0301}, false},
+	{0x1EA6, "", []rune{0x00C2, 0x0300}, false},
+	{0x1EA7, "", []rune{0x00E2, 0x0300}, false},
+	{0x1EA8, "", []rune{0x00C2, 0x0309}, false},
+	{0x1EA9, "", []rune{0x00E2, 0x0309}, false},
+	{0x1EAA, "", []rune{0x00C2, 0x0303}, false},
+	{0x1EAB, "", []rune{0x00E2, 0x0303}, false},
+	{0x1EAC, "", []rune{0x1EA0, 0x0302}, false},
+	{0x1EAD, "", []rune{0x1EA1, 0x0302}, false},
+	{0x1EAE, "", []rune{0x0102, 0x0301}, false},
+	{0x1EAF, "", []rune{0x0103, 0x0301}, false},
+	{0x1EB0, "", []rune{0x0102, 0x0300}, false},
+	{0x1EB1, "", []rune{0x0103, 0x0300}, false},
+	{0x1EB2, "", []rune{0x0102, 0x0309}, false},
+	{0x1EB3, "", []rune{0x0103, 0x0309}, false},
+	{0x1EB4, "", []rune{0x0102, 0x0303}, false},
+	{0x1EB5, "", []rune{0x0103, 0x0303}, false},
+	{0x1EB6, "", []rune{0x1EA0, 0x0306}, false},
+	{0x1EB7, "", []rune{0x1EA1, 0x0306}, false},
+	{0x1EB8, "", []rune{0x0045, 0x0323}, false},
+	{0x1EB9, "", []rune{0x0065, 0x0323}, false},
+	{0x1EBA, "", []rune{0x0045, 0x0309}, false},
+	{0x1EBB, "", []rune{0x0065, 0x0309}, false},
+	{0x1EBC, "", []rune{0x0045, 0x0303}, false},
+	{0x1EBD, "", []rune{0x0065, 0x0303}, false},
+	{0x1EBE, "", []rune{0x00CA, 0x0301}, false},
+	{0x1EBF, "", []rune{0x00EA, 0x0301}, false},
+	{0x1EC0, "", []rune{0x00CA, 0x0300}, false},
+	{0x1EC1, "", []rune{0x00EA, 0x0300}, false},
+	{0x1EC2, "", []rune{0x00CA, 0x0309}, false},
+	{0x1EC3, "", []rune{0x00EA, 0x0309}, false},
+	{0x1EC4, "", []rune{0x00CA, 0x0303}, false},
+	{0x1EC5, "", []rune{0x00EA, 0x0303}, false},
+	{0x1EC6, "", []rune{0x1EB8, 0x0302}, false},
+	{0x1EC7, "", []rune{0x1EB9, 0x0302}, false},
+	{0x1EC8, "", []rune{0x0049, 0x0309}, false},
+	{0x1EC9, "", []rune{0x0069, 0x0309}, false},
+	{0x1ECA, "", []rune{0x0049, 0x0323}, false},
+	{0x1ECB, "", []rune{0x0069, 0x0323}, false},
+	{0x1ECC, "", []rune{0x004F, 0x0323}, false},
+	{0x1ECD, "", []rune{0x006F, 0x0323}, false},
+	{0x1ECE, "", []rune{0x004F, 0x0309}, false},
+	{0x1ECF, "", []rune{0x006F, 0x0309}, false},
+	{0x1ED0, "", []rune{0x00D4, 0x0301}, false},
+	{0x1ED1, "", []rune{0x00F4, 0x0301}, false},
+	{0x1ED2, "", []rune{0x00D4, 0x0300}, false},
+	{0x1ED3, "", []rune{0x00F4, 0x0300}, false},
+	{0x1ED4, "", []rune{0x00D4, 0x0309}, false},
+	{0x1ED5, "", []rune{0x00F4, 0x0309}, false},
+	{0x1ED6, "", []rune{0x00D4, 0x0303}, false},
+	{0x1ED7, "", []rune{0x00F4, 0x0303}, false},
+	{0x1ED8, "", []rune{0x1ECC, 0x0302}, false},
+	{0x1ED9, "", []rune{0x1ECD, 0x0302}, false},
+	{0x1EDA, "", []rune{0x01A0, 0x0301}, false},
+	{0x1EDB, "", []rune{0x01A1, 0x0301}, false},
+	{0x1EDC, "", []rune{0x01A0, 0x0300}, false},
+	{0x1EDD, "", []rune{0x01A1, 0x0300}, false},
+	{0x1EDE, "", []rune{0x01A0, 0x0309}, false},
+	{0x1EDF, "", []rune{0x01A1, 0x0309}, false},
+	{0x1EE0, "", []rune{0x01A0, 0x0303}, false},
+	{0x1EE1, "", []rune{0x01A1, 0x0303}, false},
+	{0x1EE2, "", []rune{0x01A0, 0x0323}, false},
+	{0x1EE3, "", []rune{0x01A1, 0x0323}, false},
+	{0x1EE4, "", []rune{0x0055, 0x0323}, false},
+	{0x1EE5, "", []rune{0x0075, 0x0323}, false},
+	{0x1EE6, "", []rune{0x0055, 0x0309}, false},
+	{0x1EE7, "", []rune{0x0075, 0x0309}, false},
+	{0x1EE8, "", []rune{0x01AF, 0x0301}, false},
+	{0x1EE9, "", []rune{0x01B0, 0x0301}, false},
+	{0x1EEA, "", []rune{0x01AF, 0x0300}, false},
+	{0x1EEB, "", []rune{0x01B0, 0x0300}, false},
+	{0x1EEC, "", []rune{0x01AF, 0x0309}, false},
+	{0x1EED, "", []rune{0x01B0, 0x0309}, false},
+	{0x1EEE, "", []rune{0x01AF, 0x0303}, false},
+	{0x1EEF, "", []rune{0x01B0, 0x0303}, false},
+	{0x1EF0, "", []rune{0x01AF, 0x0323}, false},
+	{0x1EF1, "", []rune{0x01B0, 0x0323}, false},
+	{0x1EF2, "", []rune{0x0059, 0x0300}, false},
+	{0x1EF3, "", []rune{0x0079, 0x0300}, false},
+	{0x1EF4, "", []rune{0x0059, 0x0323}, false},
+	{0x1EF5, "", []rune{0x0079, 0x0323}, false},
+	{0x1EF6, "", []rune{0x0059, 0x0309}, false},
+	{0x1EF7, "", []rune{0x0079, 0x0309}, false},
+	{0x1EF8, "", []rune{0x0059, 0x0303}, false},
+	{0x1EF9, "", []rune{0x0079, 0x0303}, false},
+	{0x1F00, "", []rune{0x03B1, 0x0313}, false},
+	{0x1F01, "", []rune{0x03B1, 0x0314}, false},
+	{0x1F02, "", []rune{0x1F00, 0x0300}, false},
+	{0x1F03, "", []rune{0x1F01, 0x0300}, false},
+	{0x1F04, "", []rune{0x1F00, 0x0301}, false},
+	{0x1F05, "", []rune{0x1F01, 0x0301}, false},
+	{0x1F06, "", []rune{0x1F00, 0x0342}, false},
+	{0x1F07, "", []rune{0x1F01, 0x0342}, false},
+	{0x1F08, "", []rune{0x0391, 0x0313}, false},
+	{0x1F09, "", []rune{0x0391, 0x0314}, false},
+	{0x1F0A, "", []rune{0x1F08, 0x0300}, false},
+	{0x1F0B, "", []rune{0x1F09, 0x0300}, false},
+	{0x1F0C, "", []rune{0x1F08, 0x0301}, false},
+	{0x1F0D, "", []rune{0x1F09, 0x0301}, false},
+	{0x1F0E, "", []rune{0x1F08, 0x0342}, false},
+	{0x1F0F, "", []rune{0x1F09, 0x0342}, false},
+	{0x1F10, "", []rune{0x03B5, 0x0313}, false},
+	{0x1F11, "", []rune{0x03B5, 0x0314}, false},
+	{0x1F12, "", []rune{0x1F10, 0x0300}, false},
+	{0x1F13, "", []rune{0x1F11, 0x0300}, false},
+	{0x1F14, "", []rune{0x1F10, 0x0301}, false},
+	{0x1F15, "", []rune{0x1F11, 0x0301}, false},
+	{0x1F18, "", []rune{0x0395, 0x0313}, false},
+	{0x1F19, "", []rune{0x0395, 0x0314}, false},
+	{0x1F1A, "", []rune{0x1F18, 0x0300}, false},
+	{0x1F1B, "", []rune{0x1F19, 0x0300}, false},
+	{0x1F1C, "", []rune{0x1F18, 0x0301}, false},
+	{0x1F1D, "", []rune{0x1F19, 0x0301}, false},
+	{0x1F20, "", []rune{0x03B7, 0x0313}, false},
+	{0x1F21, "", []rune{0x03B7, 0x0314}, false},
+	{0x1F22, "", []rune{0x1F20, 0x0300}, false},
+	{0x1F23, "", []rune{0x1F21, 0x0300}, false},
+	{0x1F24, "", []rune{0x1F20, 0x0301}, false},
+	{0x1F25, "", []rune{0x1F21, 0x0301}, false},
+	{0x1F26, "", []rune{0x1F20, 0x0342}, false},
+	{0x1F27, "", []rune{0x1F21, 0x0342}, false},
+	{0x1F28, "", []rune{0x0397, 0x0313}, false},
+	{0x1F29, "", []rune{0x0397, 0x0314}, false},
+	{0x1F2A, "", []rune{0x1F28, 0x0300}, false},
+	{0x1F2B, "", []rune{0x1F29, 0x0300}, false},
+	{0x1F2C, "", []rune{0x1F28, 0x0301}, false},
+	{0x1F2D, "", []rune{0x1F29, 0x0301}, false},
+	{0x1F2E, "", []rune{0x1F28, 0x0342}, false},
+	{0x1F2F, "", []rune{0x1F29, 0x0342}, false},
+	{0x1F30, "", []rune{0x03B9, 0x0313}, false},
+	{0x1F31, "", []rune{0x03B9, 0x0314}, false},
+	{0x1F32, "", []rune{0x1F30, 0x0300}, false},
+	{0x1F33, "", []rune{0x1F31, 0x0300}, false},
+	{0x1F34, "", []rune{0x1F30, 0x0301}, false},
+	{0x1F35, "", []rune{0x1F31, 0x0301}, false},
+	{0x1F36, "", []rune{0x1F30, 0x0342}, false},
+	{0x1F37, "", []rune{0x1F31, 0x0342}, false},
+	{0x1F38, "", []rune{0x0399, 0x0313}, false},
+	{0x1F39, "", []rune{0x0399, 0x0314}, false},
+	{0x1F3A, "", []rune{0x1F38, 0x0300}, false},
+	{0x1F3B, "", []rune{0x1F39, 0x0300}, false},
+	{0x1F3C, "", []rune{0x1F38, 0x0301}, false},
+	{0x1F3D, "", []rune{0x1F39, 0x0301}, false},
+	{0x1F3E, "", []rune{0x1F38, 0x0342}, false},
+	{0x1F3F, "", []rune{0x1F39, 0x0342}, false},
+	{0x1F40, "", []rune{0x03BF, 0x0313}, false},
+	{0x1F41, "", []rune{0x03BF, 0x0314}, false},
+	{0x1F42, "", []rune{0x1F40, 0x0300}, false},
+	{0x1F43, "", []rune{0x1F41, 0x0300}, false},
+	{0x1F44, "", []rune{0x1F40, 0x0301}, false},
+	{0x1F45, "", []rune{0x1F41, 0x0301}, false},
+	{0x1F48, "", []rune{0x039F, 0x0313}, false},
+	{0x1F49, "", []rune{0x039F, 0x0314}, false},
+	{0x1F4A, "", []rune{0x1F48, 0x0300}, false},
+	{0x1F4B, "", []rune{0x1F49, 0x0300}, false},
+	{0x1F4C, "", []rune{0x1F48, 0x0301}, false},
+	{0x1F4D, "", []rune{0x1F49, 0x0301}, false},
+	{0x1F50, "", []rune{0x03C5, 0x0313}, false},
+	{0x1F51, "", []rune{0x03C5, 0x0314}, false},
+	{0x1F52, "", []rune{0x1F50, 0x0300}, false},
+	{0x1F53, "", []rune{0x1F51, 0x0300}, false},
+	{0x1F54, "", []rune{0x1F50, 0x0301}, false},
+	{0x1F55, "", []rune{0x1F51, 0x0301}, false},
+	{0x1F56, "", []rune{0x1F50, 0x0342}, false},
+	{0x1F57, "", []rune{0x1F51, 0x0342}, false},
+	{0x1F59, "", []rune{0x03A5, 0x0314}, false},
+	{0x1F5B, "", []rune{0x1F59, 0x0300}, false},
+	{0x1F5D, "", []rune{0x1F59, 0x0301}, false},
+	{0x1F5F, "", []rune{0x1F59, 0x0342}, false},
+	{0x1F60, "", []rune{0x03C9, 0x0313}, false},
+	{0x1F61, "", []rune{0x03C9, 0x0314}, false},
+	{0x1F62, "", []rune{0x1F60, 0x0300}, false},
+	{0x1F63, "", []rune{0x1F61, 0x0300}, false},
+	{0x1F64, "", []rune{0x1F60, 0x0301}, false},
+	{0x1F65, "", []rune{0x1F61, 0x0301}, false},
+	{0x1F66, "", []rune{0x1F60, 0x0342}, false},
+	{0x1F67, "", []rune{0x1F61, 0x0342}, false},
+	{0x1F68, "", []rune{0x03A9, 0x0313}, false},
+	{0x1F69, "", []rune{0x03A9, 0x0314}, false},
+	{0x1F6A, "", []rune{0x1F68, 0x0300}, false},
+	{0x1F6B, "", []rune{0x1F69, 0x0300}, false},
+	{0x1F6C, "", []rune{0x1F68, 0x0301}, false},
+	{0x1F6D, "", []rune{0x1F69, 0x0301}, false},
+	{0x1F6E, "", []rune{0x1F68, 0x0342}, false},
+	{0x1F6F, "", []rune{0x1F69, 0x0342}, false},
+	{0x1F70, "", []rune{0x03B1, 0x0300}, false},
+	{0x1F71, "", []rune{0x03AC}, true},
+	{0x1F72, "", []rune{0x03B5, 0x0300}, false},
+	{0x1F73, "", []rune{0x03AD}, true},
+	{0x1F74, "", []rune{0x03B7, 0x0300}, false},
+	{0x1F75, "", []rune{0x03AE}, true},
+	{0x1F76, "", []rune{0x03B9, 0x0300}, false},
+	{0x1F77, "", []rune{0x03AF}, true},
+	{0x1F78, "", []rune{0x03BF, 0x0300}, false},
+	{0x1F79, "", []rune{0x03CC}, true},
+	{0x1F7A, "", []rune{0x03C5, 0x0300}, false},
+	{0x1F7B, "", []rune{0x03CD}, true},
+	{0x1F7C, "", []rune{0x03C9, 0x0300}, false},
+	{0x1F7D, "", []rune{0x03CE}, true},
+	{0x1F80, "", []rune{0x1F00, 0x0345}, false},
+	{0x1F81, "", []rune{0x1F01, 0x0345}, false},
+	{0x1F82, "", []rune{0x1F02, 0x0345}, false},
+	{0x1F83, "", []rune{0x1F03, 0x0345}, false},
+	{0x1F84, "", []rune{0x1F04, 0x0345}, false},
+	{0x1F85, "", []rune{0x1F05, 0x0345}, false},
+	{0x1F86, "", []rune{0x1F06, 0x0345}, false},
+	{0x1F87, "", []rune{0x1F07, 0x0345}, false},
+	{0x1F88, "", []rune{0x1F08, 0x0345}, false},
+	{0x1F89, "", []rune{0x1F09, 0x0345}, false},
+	{0x1F8A, "", []rune{0x1F0A, 0x0345}, false},
+	{0x1F8B, "", []rune{0x1F0B, 0x0345}, false},
+	{0x1F8C, "", []rune{0x1F0C, 0x0345}, false},
+	{0x1F8D, "", []rune{0x1F0D, 0x0345}, false},
+	{0x1F8E, "", []rune{0x1F0E, 0x0345}, false},
+	{0x1F8F, "", []rune{0x1F0F, 0x0345}, false},
+	{0x1F90, "", []rune{0x1F20, 0x0345}, false},
+	{0x1F91, "", []rune{0x1F21, 0x0345}, false},
+	{0x1F92, "", []rune{0x1F22, 0x0345}, false},
+	{0x1F93, "", []rune{0x1F23, 0x0345}, false},
+	{0x1F94, "", []rune{0x1F24, 0x0345}, false},
+	{0x1F95, "", []rune{0x1F25, 0x0345}, false},
+	{0x1F96, "", []rune{0x1F26, 0x0345}, false},
+	{0x1F97, "", []rune{0x1F27, 0x0345}, false},
+	{0x1F98, "", []rune{0x1F28, 0x0345}, false},
+	{0x1F99, "", []rune{0x1F29, 0x0345}, false},
+	{0x1F9A, "", []rune{0x1F2A, 0x0345}, false},
+	{0x1F9B, "", []rune{0x1F2B, 0x0345}, false},
+	{0x1F9C, "", []rune{0x1F2C, 0x0345}, false},
+	{0x1F9D, "", []rune{0x1F2D, 0x0345}, false},
+	{0x1F9E, "", []rune{0x1F2E, 0x0345}, false},
+	{0x1F9F, "", []rune{0x1F2F, 0x0345}, false},
+	{0x1FA0, "", []rune{0x1F60, 0x0345}, false},
+	{0x1FA1, "", []rune{0x1F61, 0x0345}, false},
+	{0x1FA2, "", []rune{0x1F62, 0x0345}, false},
+	{0x1FA3, "", []rune{0x1F63, 0x0345}, false},
+	{0x1FA4, "", []rune{0x1F64, 0x0345}, false},
+	{0x1FA5, "", []rune{0x1F65, 0x0345}, false},
+	{0x1FA6, "", []rune{0x1F66, 0x0345}, false},
+	{0x1FA7, "", []rune{0x1F67, 0x0345}, false},
+	{0x1FA8, "", []rune{0x1F68, 0x0345}, false},
+	{0x1FA9, "", []rune{0x1F69, 0x0345}, false},
+	{0x1FAA, "", []rune{0x1F6A, 0x0345}, false},
+	{0x1FAB, "", []rune{0x1F6B, 0x0345}, false},
+	{0x1FAC, "", []rune{0x1F6C, 0x0345}, false},
+	{0x1FAD, "", []rune{0x1F6D, 0x0345}, false},
+	{0x1FAE, "", []rune{0x1F6E, 0x0345}, false},
+	{0x1FAF, "", []rune{0x1F6F, 0x0345}, false},
+	{0x1FB0, "", []rune{0x03B1, 0x0306}, false},
+	{0x1FB1, "", []rune{0x03B1, 0x0304}, false},
+	{0x1FB2, "", []rune{0x1F70, 0x0345}, false},
+	{0x1FB3, "", []rune{0x03B1, 0x0345}, false},
+	{0x1FB4, "", []rune{0x03AC, 0x0345}, false},
+	{0x1FB6, "", []rune{0x03B1, 0x0342}, false},
+	{0x1FB7, "", []rune{0x1FB6, 0x0345}, false},
+	{0x1FB8, "", []rune{0x0391, 0x0306}, false},
+	{0x1FB9, "", []rune{0x0391, 0x0304}, false},
+	{0x1FBA, "", []rune{0x0391, 0x0300}, false},
+	{0x1FBB, "", []rune{0x0386}, true},
+	{0x1FBC, "", []rune{0x0391, 0x0345}, false},
+	{0x1FBD, "<compat>", []rune{0x0020, 0x0313}, false},
+	{0x1FBE, "", []rune{0x03B9}, true},
+	{0x1FBF, "<compat>", []rune{0x0020, 0x0313}, false},
+	{0x1FC0, "<compat>", []rune{0x0020, 0x0342}, false},
+	{0x1FC1, "", []rune{0x00A8, 0x0342}, false},
+	{0x1FC2, "", []rune{0x1F74, 0x0345}, false},
+	{0x1FC3, "", []rune{0x03B7, 0x0345}, false},
+	{0x1FC4, "", []rune{0x03AE, 0x0345}, false},
+	{0x1FC6, "", []rune{0x03B7, 0x0342}, false},
+	{0x1FC7, "", []rune{0x1FC6, 0x0345}, false},
+	{0x1FC8, "", []rune{0x0395, 0x0300}, false},
+	{0x1FC9, "", []rune{0x0388}, true},
+	{0x1FCA, "", []rune{0x0397, 0x0300}, false},
+	{0x1FCB, "", []rune{0x0389}, true},
+	{0x1FCC, "", []rune{0x0397, 0x0345}, false},
+	{0x1FCD, "", []rune{0x1FBF, 0x0300}, false},
+	{0x1FCE, "", []rune{0x1FBF, 0x0301}, false},
+	{0x1FCF, "", []rune{0x1FBF, 0x0342}, false},
+	{0x1FD0, "", []rune{0x03B9, 0x0306}, false},
+	{0x1FD1, "", []rune{0x03B9, 0x0304}, false},
+	{0x1FD2, "", []rune{0x03CA, 0x0300}, false},
+	{0x1FD3, "", []rune{0x0390}, true},
+	{0x1FD6, "", []rune{0x03B9, 0x0342}, false},
+	{0x1FD7, "", []rune{0x03CA, 0x0342}, false},
+	{0x1FD8, "", []rune{0x0399, 0x0306}, false},
+	{0x1FD9, "", []rune{0x0399, 0x0304}, false},
+	{0x1FDA, "", []rune{0x0399, 0x0300}, false},
+	{0x1FDB, "", []rune{0x038A}, true},
+	{0x1FDD, "", []rune{0x1FFE, 0x0300}, false},
+	{0x1FDE, "", []rune{0x1FFE, 0x0301}, false},
+	{0x1FDF, "", []rune{0x1FFE, 0x0342}, false},
+	{0x1FE0, "", []rune{0x03C5, 0x0306}, false},
+	{0x1FE1, "", []rune{0x03C5, 0x0304}, false},
+	{0x1FE2, "", []rune{0x03CB, 0x0300}, false},
+	{0x1FE3, "", []rune{0x03B0}, true},
+	{0x1FE4, "", []rune{0x03C1, 0x0313}, false},
+	{0x1FE5, "", []rune{0x03C1, 0x0314}, false},
+	{0x1FE6, "", []rune{0x03C5, 0x0342}, false},
+	{0x1FE7, "", []rune{0x03CB, 0x0342}, false},
+	{0x1FE8, "", []rune{0x03A5, 0x0306}, false},
+	{0x1FE9, "", []rune{0x03A5, 0x0304}, false},
+	{0x1FEA, "", []rune{0x03A5, 0x0300}, false},
+	{0x1FEB, "", []rune{0x038E}, true},
+	{0x1FEC, "", []rune{0x03A1, 0x0314}, false},
+	{0x1FED, "", []rune{0x00A8, 0x0300}, false},
+	{0x1FEE, "", []rune{0x0385}, true},
+	{0x1FEF, "", []rune{0x0060}, true},
+	{0x1FF2, "", []rune{0x1F7C, 0x0345}, false},
+	{0x1FF3, "", []rune{0x03C9, 0x0345}, false},
+	{0x1FF4, "", []rune{0x03CE, 0x0345}, false},
+	{0x1FF6, "", []rune{0x03C9, 0x0342}, false},
+	{0x1FF7, "", []rune{0x1FF6, 0x0345}, false},
+	{0x1FF8, "", []rune{0x039F, 0x0300}, false},
+	{0x1FF9, "", []rune{0x038C}, true},
+	{0x1FFA, "", []rune{0x03A9, 0x0300}, false},
+	{0x1FFB, "", []rune{0x038F}, true},
+	{0x1FFC, "", []rune{0x03A9, 0x0345}, false},
+	{0x1FFD, "", []rune{0x00B4}, true},
+	{0x1FFE, "<compat>", []rune{0x0020, 0x0314}, false},
+	{0x2000, "", []rune{0x2002}, true},
+	{0x2001, "", []rune{0x2003}, true},
+	{0x2002, "<compat>", []rune{0x0020}, false},
+	{0x2003, "<compat>", []rune{0x0020}, false},
+	{0x2004, "<compat>", []rune{0x0020}, false},
+	{0x2005, "<compat>", []rune{0x0020}, false},
+	{0x2006, "<compat>", []rune{0x0020}, false},
+	{0x2007, "<noBreak>", []rune{0x0020}, false},
+	{0x2008, "<compat>", []rune{0x0020}, false},
+	{0x2009, "<compat>", []rune{0x0020}, false},
+	{0x200A, "<compat>", []rune{0x0020}, false},
+	{0x2011, "<noBreak>", []rune{0x2010}, false},
+	{0x2017, "<compat>", []rune{0x0020, 0x0333}, false},
+	{0x2024, "<compat>", []rune{0x002E}, false},
+	{0x2025, "<compat>", []rune{0x002E, 0x002E}, false},
+	{0x2026, "<compat>", []rune{0x002E, 0x002E, 0x002E}, false},
+	{0x202F, "<noBreak>", []rune{0x0020}, false},
+	{0x2033, "<compat>", []rune{0x2032, 0x2032}, false},
+	{0x2034, "<compat>", []rune{0x2032, 0x2032, 0x2032}, false},
+	{0x2036, "<compat>", []rune{0x2035, 0x2035}, false},
+	{0x2037, "<compat>", []rune{0x2035, 0x2035, 0x2035}, false},
+	{0x203C, "<compat>", []rune{0x0021, 0x0021}, false},
+	{0x203E, "<compat>", []rune{0x0020, 0x0305}, false},
+	{0x2047, "<compat>", []rune{0x003F, 0x003F}, false},
+	{0x2048, "<compat>", []rune{0x003F, 0x0021}, false},
+	{0x2049, "<compat>", []rune{0x0021, 0x003F}, false},
+	{0x2057, "<compat>", []rune{0x2032, 0x2032, 0x2032, 0x2032}, false},
+	{0x205F, "<compat>", []rune{0x0020}, false},
+	{0x2070, "<super>", []rune{0x0030}, false},
+	{0x2071, "<super>", []rune{0x0069}, false},
+	{0x2074, "<super>", []rune{0x0034}, false},
+	{0x2075, "<super>", []rune{0x0035}, false},
+	{0x2076, "<super>", []rune{0x0036}, false},
+	{0x2077, "<super>", []rune{0x0037}, false},
+	{0x2078, "<super>", []rune{0x0038}, false},
+	{0x2079, "<super>", []rune{0x0039}, false},
+	{0x207A, "<super>", []rune{0x002B}, false},
+	{0x207B, "<super>", []rune{0x2212}, false},
+	{0x207C, "<super>", []rune{0x003D}, false},
+	{0x207D, "<super>", []rune{0x0028}, false},
+	{0x207E, "<super>", []rune{0x0029}, false},
+	{0x207F, "<super>", []rune{0x006E}, false},
+	{0x2080, "<sub>", []rune{0x0030}, false},
+	{0x2081, "<sub>", []rune{0x0031}, false},
+	{0x2082, "<sub>", []rune{0x0032}, false},
+	{0x2083, "<sub>", []rune{0x0033}, false},
+	{0x2084, "<sub>", []rune{0x0034}, false},
+	{0x2085, "<sub>", []rune{0x0035}, false},
+	{0x2086, "<sub>", []rune{0x0036}, false},
+	{0x2087, "<sub>", []rune{0x0037}, false},
+	{0x2088, "<sub>", []rune{0x0038}, false},
+	{0x2089, "<sub>", []rune{0x0039}, false},
+	{0x208A, "<sub>", []rune{0x002B}, false},
+	{0x208B, "<sub>", []rune{0x2212}, false},
+	{0x208C, "<sub>", []rune{0x003D}, false},
+	{0x208D, "<sub>", []rune{0x0028}, false},
+	{0x208E, "<sub>", []rune{0x0029}, false},
+	{0x2090, "<sub>", []rune{0x0061}, false},
+	{0x2091, "<sub>", []rune{0x0065}, false},
+	{0x2092, "<sub>", []rune{0x006F}, false},
+	{0x2093, "<sub>", []rune{0x0078}, false},
+	{0x2094, "<sub>", []rune{0x0259}, false},
+	{0x2095, "<sub>", []rune{0x0068}, false},
+	{0x2096, "<sub>", []rune{0x006B}, false},
+	{0x2097, "<sub>", []rune{0x006C}, false},
+	{0x2098, "<sub>", []rune{0x006D}, false},
+	{0x2099, "<sub>", []rune{0x006E}, false},
+	{0x209A, "<sub>", []rune{0x0070}, false},
+	{0x209B, "<sub>", []rune{0x0073}, false},
+	{0x209C, "<sub>", []rune{0x0074}, false},
+	{0x20A8, "<compat>", []rune{0x0052, 0x0073}, false},
+	{0x2100, "<compat>", []rune{0x0061, 0x002F, 0x0063}, false},
+	{0x2101, "<compat>", []rune{0x0061, 0x002F, 0x0073}, false},
+	{0x2102, "<font>", []rune{0x0043}, false},
+	{0x2103, "<compat>", []rune{0x00B0, 0x0043}, false},
+	{0x2105, "<compat>", []rune{0x0063, 0x002F, 0x006F}, false},
+	{0x2106, "<compat>", []rune{0x0063, 0x002F, 0x0075}, false},
+	{0x2107, "<compat>", []rune{0x0190}, false},
+	{0x2109, "<compat>", []rune{0x00B0, 0x0046}, false},
+	{0x210A, "<font>", []rune{0x0067}, false},
+	{0x210B, "<font>", []rune{0x0048}, false},
+	{0x210C, "<font>", []rune{0x0048}, false},
+	{0x210D, "<font>", []rune{0x0048}, false},
+	{0x210E, "<font>", []rune{0x0068}, false},
+	{0x210F, "<font>", []rune{0x0127}, false},
+	{0x2110, "<font>", []rune{0x0049}, false},
+	{0x2111, "<font>", []rune{0x0049}, false},
+	{0x2112, "<font>", []rune{0x004C}, false},
+	{0x2113, "<font>", []rune{0x006C}, false},
+	{0x2115, "<font>", []rune{0x004E}, false},
+	{0x2116, "<compat>", []rune{0x004E, 0x006F}, false},
+	{0x2119, "<font>", []rune{0x0050}, false},
+	{0x211A, "<font>", []rune{0x0051}, false},
+	{0x211B, "<font>", []rune{0x0052}, false},
+	{0x211C, "<font>", []rune{0x0052}, false},
+	{0x211D, "<font>", []rune{0x0052}, false},
+	{0x2120, "<super>", []rune{0x0053, 0x004D}, false},
+	{0x2121, "<compat>", []rune{0x0054, 0x0045, 0x004C}, false},
+	{0x2122, "<super>", []rune{0x0054, 0x004D}, false},
+	{0x2124, "<font>", []rune{0x005A}, false},
+	{0x2126, "", []rune{0x03A9}, true},
+	{0x2128, "<font>", []rune{0x005A}, false},
+	{0x212A, "", []rune{0x004B}, true},
+	{0x212B, "", []rune{0x00C5}, true},
+	{0x212C, "<font>", []rune{0x0042}, false},
+	{0x212D, "<font>", []rune{0x0043}, false},
+	{0x212F, "<font>", []rune{0x0065}, false},
+	{0x2130, "<font>", []rune{0x0045}, false},
+	{0x2131, "<font>", []rune{0x0046}, false},
+	{0x2133, "<font>", []rune{0x004D}, false},
+	{0x2134, "<font>", []rune{0x006F}, false},
+	{0x2135, "<compat>", []rune{0x05D0}, false},
+	{0x2136, "<compat>", []rune{0x05D1}, false},
+	{0x2137, "<compat>", []rune{0x05D2}, false},
+	{0x2138, "<compat>", []rune{0x05D3}, false},
+	{0x2139, "<font>", []rune{0x0069}, false},
+	{0x213B, "<compat>", []rune{0x0046, 0x0041, 0x0058}, false},
+	{0x213C, "<font>", []rune{0x03C0}, false},
+	{0x213D, "<font>", []rune{0x03B3}, false},
+	{0x213E, "<font>", []rune{0x0393}, false},
+	{0x213F, "<font>", []rune{0x03A0}, false},
+	{0x2140, "<font>", []rune{0x2211}, false},
+	{0x2145, "<font>", []rune{0x0044}, false},
+	{0x2146, "<font>", []rune{0x0064}, false},
+	{0x2147, "<font>", []rune{0x0065}, false},
+	{0x2148, "<font>", []rune{0x0069}, false},
+	{0x2149, "<font>", []rune{0x006A}, false},
+	{0x2150, "<fraction>", []rune{0x0031, 0x2044, 0x0037}, false},
+	{0x2151, "<fraction>", []rune{0x0031, 0x2044, 0x0039}, false},
+	{0x2152, "<fraction>", []rune{0x0031, 0x2044, 0x0031, 0x0030}, false},
+	{0x2153, "<fraction>", []rune{0x0031, 0x2044, 0x0033}, false},
+	{0x2154, "<fraction>", []rune{0x0032, 0x2044, 0x0033}, false},
+	{0x2155, "<fraction>", []rune{0x0031, 0x2044, 0x0035}, false},
+	{0x2156, "<fraction>", []rune{0x0032, 0x2044, 0x0035}, false},
+	{0x2157, "<fraction>", []rune{0x0033, 0x2044, 0x0035}, false},
+	{0x2158, "<fraction>", []rune{0x0034, 0x2044, 0x0035}, false},
+	{0x2159, "<fraction>", []rune{0x0031, 0x2044, 0x0036}, false},
+	{0x215A, "<fraction>", []rune{0x0035, 0x2044, 0x0036}, false},
+	{0x215B, "<fraction>", []rune{0x0031, 0x2044, 0x0038}, false},
+	{0x215C, "<fraction>", []rune{0x0033, 0x2044, 0x0038}, false},
+	{0x215D, "<fraction>", []rune{0x0035, 0x2044, 0x0038}, false},
+	{0x215E, "<fraction>", []rune{0x0037, 0x2044, 0x0038}, false},
+	{0x215F, "<fraction>", []rune{0x0031, 0x2044}, false},
+	{0x2160, "<compat>", []rune{0x0049}, false},
+	{0x2161, "<compat>", []rune{0x0049, 0x0049}, false},
+	{0x2162, "<compat>", []rune{0x0049, 0x0049, 0x0049}, false},
+	{0x2163, "<compat>", []rune{0x0049, 0x0056}, false},
+	{0x2164, "<compat>", []rune{0x0056}, false},
+	{0x2165, "<compat>", []rune{0x0056, 0x0049}, false},
+	{0x2166, "<compat>", []rune{0x0056, 0x0049, 0x0049}, false},
+	{0x2167, "<compat>", []rune{0x0056, 0x0049, 0x0049, 0x0049}, false},
+	{0x2168, "<compat>", []rune{0x0049, 0x0058}, false},
+	{0x2169, "<compat>", []rune{0x0058}, false},
+	{0x216A, "<compat>", []rune{0x0058, 0x0049}, false},
+	{0x216B, "<compat>", []rune{0x0058, 0x0049, 0x0049}, false},
+	{0x216C, "<compat>", []rune{0x004C}, false},
+	{0x216D, "<compat>", []rune{0x0043}, false},
+	{0x216E, "<compat>", []rune{0x0044}, false},
+	{0x216F, "<compat>", []rune{0x004D}, false},
+	{0x2170, "<compat>", []rune{0x0069}, false},
+	{0x2171, "<compat>", []rune{0x0069, 0x0069}, false},
+	{0x2172, "<compat>", []rune{0x0069, 0x0069, 0x0069}, false},
+	{0x2173, "<compat>", []rune{0x0069, 0x0076}, false},
+	{0x2174, "<compat>", []rune{0x0076}, false},
+	{0x2175, "<compat>", []rune{0x0076, 0x0069}, false},
+	{0x2176, "<compat>", []rune{0x0076, 0x0069, 0x0069}, false},
+	{0x2177, "<compat>", []rune{0x0076, 0x0069, 0x0069, 0x0069}, false},
+	{0x2178, "<compat>", []rune{0x0069, 0x0078}, false},
+	{0x2179, "<compat>", []rune{0x0078}, false},
+	{0x217A, "<compat>", []rune{0x0078, 0x0069}, false},
+	{0x217B, "<compat>", []rune{0x0078, 0x0069, 0x0069}, false},
+	{0x217C, "<compat>", []rune{0x006C}, false},
+	{0x217D, "<compat>", []rune{0x0063}, false},
+	{0x217E, "<compat>", []rune{0x0064}, false},
+	{0x217F, "<compat>", []rune{0x006D}, false},
+	{0x2189, "<fraction>", []rune{0x0030, 0x2044, 0x0033}, false},
+	{0x219A, "", []rune{0x2190, 0x0338}, false},
+	{0x219B, "", []rune{0x2192, 0x0338}, false},
+	{0x21AE, "", []rune{0x2194, 0x0338}, false},
+	{0x21CD, "", []rune{0x21D0, 0x0338}, false},
+	{0x21CE, "", []rune{0x21D4, 0x0338}, false},
+	{0x21CF, "", []rune{0x21D2, 0x0338}, false},
+	{0x2204, "", []rune{0x2203, 0x0338}, false},
+	{0x2209, "", []rune{0x2208, 0x0338}, false},
+	{0x220C, "", []rune{0x220B, 0x0338}, false},
+	{0x2224, "", []rune{0x2223, 0x0338}, false},
+	{0x2226, "", []rune{0x2225, 0x0338}, false},
+	{0x222C, "<compat>", []rune{0x222B, 0x222B}, false},
+	{0x222D, "<compat>", []rune{0x222B, 0x222B, 0x222B}, false},
+	{0x222F, "<compat>", []rune{0x222E, 0x222E}, false},
+	{0x2230, "<compat>", []rune{0x222E, 0x222E, 0x222E}, false},
+	{0x2241, "", []rune{0x223C, 0x0338}, false},
+	{0x2244, "", []rune{0x2243, 0x0338}, false},
+	{0x2247, "", []rune{0x2245, 0x0338}, false},
+	{0x2249, "", []rune{0x2248, 0x0338}, false},
+	{0x2260, "", []rune{0x003D, 0x0338}, false},
+	{0x2262, "", []rune{0x2261, 0x0338}, false},
+	{0x226D, "", []rune{0x224D, 0x0338}, false},
+	{0x226E, "", []rune{0x003C, 0x0338}, false},
+	{0x226F, "", []rune{0x003E, 0x0338}, false},
+	{0x2270, "", []rune{0x2264, 0x0338}, false},
+	{0x2271, "", []rune{0x2265, 0x0338}, false},
+	{0x2274, "", []rune{0x2272, 0x0338}, false},
+	{0x2275, "", []rune{0x2273, 0x0338}, false},
+	{0x2278, "", []rune{0x2276, 0x0338}, false},
+	{0x2279, "", []rune{0x2277, 0x0338}, false},
+	{0x2280, "", []rune{0x227A, 0x0338}, false},
+	{0x2281, "", []rune{0x227B, 0x0338}, false},
+	{0x2284, "", []rune{0x2282, 0x0338}, false},
+	{0x2285, "", []rune{0x2283, 0x0338}, false},
+	{0x2288, "", []rune{0x2286, 0x0338}, false},
+	{0x2289, "", []rune{0x2287, 0x0338}, false},
+	{0x22AC, "", []rune{0x22A2, 0x0338}, false},
+	{0x22AD, "", []rune{0x22A8, 0x0338}, false},
+	{0x22AE, "", []rune{0x22A9, 0x0338}, false},
+	{0x22AF, "", []rune{0x22AB, 0x0338}, false},
+	{0x22E0, "", []rune{0x227C, 0x0338}, false},
+	{0x22E1, "", []rune{0x227D, 0x0338}, false},
+	{0x22E2, "", []rune{0x2291, 0x0338}, false},
+	{0x22E3, "", []rune{0x2292, 0x0338}, false},
+	{0x22EA, "", []rune{0x22B2, 0x0338}, false},
+	{0x22EB, "", []rune{0x22B3, 0x0338}, false},
+	{0x22EC, "", []rune{0x22B4, 0x0338}, false},
+	{0x22ED, "", []rune{0x22B5, 0x0338}, false},
+	{0x2329, "", []rune{0x3008}, true},
+	{0x232A, "", []rune{0x3009}, true},
+	{0x2460, "<circle>", []rune{0x0031}, false},
+	{0x2461, "<circle>", []rune{0x0032}, false},
+	{0x2462, "<circle>", []rune{0x0033}, false},
+	{0x2463, "<circle>", []rune{0x0034}, false},
+	{0x2464, "<circle>", []rune{0x0035}, false},
+	{0x2465, "<circle>", []rune{0x0036}, false},
+	{0x2466, "<circle>", []rune{0x0037}, false},
+	{0x2467, "<circle>", []rune{0x0038}, false},
+	{0x2468, "<circle>", []rune{0x0039}, false},
+	{0x2469, "<circle>", []rune{0x0031, 0x0030}, false},
+	{0x246A, "<circle>", []rune{0x0031, 0x0031}, false},
+	{0x246B, "<circle>", []rune{0x0031, 0x0032}, false},
+	{0x246C, "<circle>", []rune{0x0031, 0x0033}, false},
+	{0x246D, "<circle>", []rune{0x0031, 0x0034}, false},
+	{0x246E, "<circle>", []rune{0x0031, 0x0035}, false},
+	{0x246F, "<circle>", []rune{0x0031, 0x0036}, false},
+	{0x2470, "<circle>", []rune{0x0031, 0x0037}, false},
+	{0x2471, "<circle>", []rune{0x0031, 0x0038}, false},
+	{0x2472, "<circle>", []rune{0x0031, 0x0039}, false},
+	{0x2473, "<circle>", []rune{0x0032, 0x0030}, false},
+	{0x2474, "<compat>", []rune{0x0028, 0x0031, 0x0029}, false},
+	{0x2475, "<compat>", []rune{0x0028, 0x0032, 0x0029}, false},
+	{0x2476, "<compat>", []rune{0x0028, 0x0033, 0x0029}, false},
+	{0x2477, "<compat>", []rune{0x0028, 0x0034, 0x0029}, false},
+	{0x2478, "<compat>", []rune{0x0028, 0x0035, 0x0029}, false},
+	{0x2479, "<compat>", []rune{0x0028, 0x0036, 0x0029}, false},
+	{0x247A, "<compat>", []rune{0x0028, 0x0037, 0x0029}, false},
+	{0x247B, "<compat>", []rune{0x0028, 0x0038, 0x0029}, false},
+	{0x247C, "<compat>", []rune{0x0028, 0x0039, 0x0029}, false},
+	{0x247D, "<compat>", []rune{0x0028, 0x0031, 0x0030, 0x0029}, false},
+	{0x247E, "<compat>", []rune{0x0028, 0x0031, 0x0031, 0x0029}, false},
+	{0x247F, "<compat>", []rune{0x0028, 0x0031, 0x0032, 0x0029}, false},
+	{0x2480, "<compat>", []rune{0x0028, 0x0031, 0x0033, 0x0029}, false},
+	{0x2481, "<compat>", []rune{0x0028, 0x0031, 0x0034, 0x0029}, false},
+	{0x2482, "<compat>", []rune{0x0028, 0x0031, 0x0035, 0x0029}, false},
+	{0x2483, "<compat>", []rune{0x0028, 0x0031, 0x0036, 0x0029}, false},
+	{0x2484, "<compat>", []rune{0x0028, 0x0031, 0x0037, 0x0029}, false},
+	{0x2485, "<compat>", []rune{0x0028, 0x0031, 0x0038, 0x0029}, false},
+	{0x2486, "<compat>", []rune{0x0028, 0x0031, 0x0039, 0x0029}, false},
+	{0x2487, "<compat>", []rune{0x0028, 0x0032, 0x0030, 0x0029}, false},
+	{0x2488, "<compat>", []rune{0x0031, 0x002E}, false},
+	{0x2489, "<compat>", []rune{0x0032, 0x002E}, false},
+	{0x248A, "<compat>", []rune{0x0033, 0x002E}, false},
+	{0x248B, "<compat>", []rune{0x0034, 0x002E}, false},
+	{0x248C, "<compat>", []rune{0x0035, 0x002E}, false},
+	{0x248D, "<compat>", []rune{0x0036, 0x002E}, false},
+	{0x248E, "<compat>", []rune{0x0037, 0x002E}, false},
+	{0x248F, "<compat>", []rune{0x0038, 0x002E}, false},
+	{0x2490, "<compat>", []rune{0x0039, 0x002E}, false},
+	{0x2491, "<compat>", []rune{0x0031, 0x0030, 0x002E}, false},
+	{0x2492, "<compat>", []rune{0x0031, 0x0031, 0x002E}, false},
+	{0x2493, "<compat>", []rune{0x0031, 0x0032, 0x002E}, false},
+	{0x2494, "<compat>", []rune{0x0031, 0x0033, 0x002E}, false},
+	{0x2495, "<compat>", []rune{0x0031, 0x0034, 0x002E}, false},
+	{0x2496, "<compat>", []rune{0x0031, 0x0035, 0x002E}, false},
+	{0x2497, "<compat>", []rune{0x0031, 0x0036, 0x002E}, false},
+	{0x2498, "<compat>", []rune{0x0031, 0x0037, 0x002E}, false},
+	{0x2499, "<compat>", []rune{0x0031, 0x0038, 0x002E}, false},
+	{0x249A, "<compat>", []rune{0x0031, 0x0039, 0x002E}, false},
+	{0x249B, "<compat>", []rune{0x0032, 0x0030, 0x002E}, false},
+	{0x249C, "<compat>", []rune{0x0028, 0x0061, 0x0029}, false},
+	{0x249D, "<compat>", []rune{0x0028, 0x0062, 0x0029}, false},
+	{0x249E, "<compat>", []rune{0x0028, 0x0063, 0x0029}, false},
+	{0x249F, "<compat>", []rune{0x0028, 0x0064, 0x0029}, false},
+	{0x24A0, "<compat>", []rune{0x0028, 0x0065, 0x0029}, false},
+	{0x24A1, "<compat>", []rune{0x0028, 0x0066, 0x0029}, false},
+	{0x24A2, "<compat>", []rune{0x0028, 0x0067, 0x0029}, false},
+	{0x24A3, "<compat>", []rune{0x0028, 0x0068, 0x0029}, false},
+	{0x24A4, "<compat>", []rune{0x0028, 0x0069, 0x0029}, false},
+	{0x24A5, "<compat>", []rune{0x0028, 0x006A, 0x0029}, false},
+	{0x24A6, "<compat>", []rune{0x0028, 0x006B, 0x0029}, false},
+	{0x24A7, "<compat>", []rune{0x0028, 0x006C, 0x0029}, false},
+	{0x24A8, "<compat>", []rune{0x0028, 0x006D, 0x0029}, false},
+	{0x24A9, "<compat>", []rune{0x0028, 0x006E, 0x0029}, false},
+	{0x24AA, "<compat>", []rune{0x0028, 0x006F, 0x0029}, false},
+	{0x24AB, "<compat>", []rune{0x0028, 0x0070, 0x0029}, false},
+	{0x24AC, "<compat>", []rune{0x0028, 0x0071, 0x0029}, false},
+	{0x24AD, "<compat>", []rune{0x0028, 0x0072, 0x0029}, false},
+	{0x24AE, "<compat>", []rune{0x0028, 0x0073, 0x0029}, false},
+	{0x24AF, "<compat>", []rune{0x0028, 0x0074, 0x0029}, false},
+	{0x24B0, "<compat>", []rune{0x0028, 0x0075, 0x0029}, false},
+	{0x24B1, "<compat>", []rune{0x0028, 0x0076, 0x0029}, false},
+	{0x24B2, "<compat>", []rune{0x0028, 0x0077, 0x0029}, false},
+	{0x24B3, "<compat>", []rune{0x0028, 0x0078, 0x0029}, false},
+	{0x24B4, "<compat>", []rune{0x0028, 0x0079, 0x0029}, false},
+	{0x24B5, "<compat>", []rune{0x0028, 0x007A, 0x0029}, false},
+	{0x24B6, "<circle>", []rune{0x0041}, false},
+	{0x24B7, "<circle>", []rune{0x0042}, false},
+	{0x24B8, "<circle>", []rune{0x0043}, false},
+	{0x24B9, "<circle>", []rune{0x0044}, false},
+	{0x24BA, "<circle>", []rune{0x0045}, false},
+	{0x24BB, "<circle>", []rune{0x0046}, false},
+	{0x24BC, "<circle>", []rune{0x0047}, false},
+	{0x24BD, "<circle>", []rune{0x0048}, false},
+	{0x24BE, "<circle>", []rune{0x0049}, false},
+	{0x24BF, "<circle>", []rune{0x004A}, false},
+	{0x24C0, "<circle>", []rune{0x004B}, false},
+	{0x24C1, "<circle>", []rune{0x004C}, false},
+	{0x24C2, "<circle>", []rune{0x004D}, false},
+	{0x24C3, "<circle>", []rune{0x004E}, false},
+	{0x24C4, "<circle>", []rune{0x004F}, false},
+	{0x24C5, "<circle>", []rune{0x0050}, false},
+	{0x24C6, "<circle>", []rune{0x0051}, false},
+	{0x24C7, "<circle>", []rune{0x0052}, false},
+	{0x24C8, "<circle>", []rune{0x0053}, false},
+	{0x24C9, "<circle>", []rune{0x0054}, false},
+	{0x24CA, "<circle>", []rune{0x0055}, false},
+	{0x24CB, "<circle>", []rune{0x0056}, false},
+	{0x24CC, "<circle>", []rune{0x0057}, false},
+	{0x24CD, "<circle>", []rune{0x0058}, false},
+	{0x24CE, "<circle>", []rune{0x0059}, false},
+	{0x24CF, "<circle>", []rune{0x005A}, false},
+	{0x24D0, "<circle>", []rune{0x0061}, false},
+	{0x24D1, "<circle>", []rune{0x0062}, false},
+	{0x24D2, "<circle>", []rune{0x0063}, false},
+	{0x24D3, "<circle>", []rune{0x0064}, false},
+	{0x24D4, "<circle>", []rune{0x0065}, false},
+	{0x24D5, "<circle>", []rune{0x0066}, false},
+	{0x24D6, "<circle>", []rune{0x0067}, false},
+	{0x24D7, "<circle>", []rune{0x0068}, false},
+	{0x24D8, "<circle>", []rune{0x0069}, false},
+	{0x24D9, "<circle>", []rune{0x006A}, false},
+	{0x24DA, "<circle>", []rune{0x006B}, false},
+	{0x24DB, "<circle>", []rune{0x006C}, false},
+	{0x24DC, "<circle>", []rune{0x006D}, false},
+	{0x24DD, "<circle>", []rune{0x006E}, false},
+	{0x24DE, "<circle>", []rune{0x006F}, false},
+	{0x24DF, "<circle>", []rune{0x0070}, false},
+	{0x24E0, "<circle>", []rune{0x0071}, false},
+	{0x24E1, "<circle>", []rune{0x0072}, false},
+	{0x24E2, "<circle>", []rune{0x0073}, false},
+	{0x24E3, "<circle>", []rune{0x0074}, false},
+	{0x24E4, "<circle>", []rune{0x0075}, false},
+	{0x24E5, "<circle>", []rune{0x0076}, false},
+	{0x24E6, "<circle>", []rune{0x0077}, false},
+	{0x24E7, "<circle>", []rune{0x0078}, false},
+	{0x24E8, "<circle>", []rune{0x0079}, false},
+	{0x24E9, "<circle>", []rune{0x007A}, false},
+	{0x24EA, "<circle>", []rune{0x0030}, false},
+	{0x2A0C, "<compat>", []rune{0x222B, 0x222B, 0x222B, 0x222B}, false},
+	{0x2A74, "<compat>", []rune{0x003A, 0x003A, 0x003D}, false},
+	{0x2A75, "<compat>", []rune{0x003D, 0x003D}, false},
+	{0x2A76, "<compat>", []rune{0x003D, 0x003D, 0x003D}, false},
+	{0x2ADC, "", []rune{0x2ADD, 0x0338}, true},
+	{0x2C7C, "<sub>", []rune{0x006A}, false},
+	{0x2C7D, "<super>", []rune{0x0056}, false},
+	{0x2D6F, "<super>", []rune{0x2D61}, false},
+	{0x2E9F, "<compat>", []rune{0x6BCD}, false},
+	{0x2EF3, "<compat>", []rune{0x9F9F}, false},
+	{0x2F00, "<compat>", []rune{0x4E00}, false},
+	{0x2F01, "<compat>", []rune{0x4E28}, false},
+	{0x2F02, "<compat>", []rune{0x4E36}, false},
+	{0x2F03, "<compat>", []rune{0x4E3F}, false},
+	{0x2F04, "<compat>", []rune{0x4E59}, false},
+	{0x2F05, "<compat>", []rune{0x4E85}, false},
+	{0x2F06, "<compat>", []rune{0x4E8C}, false},
+	{0x2F07, "<compat>", []rune{0x4EA0}, false},
+	{0x2F08, "<compat>", []rune{0x4EBA}, false},
+	{0x2F09, "<compat>", []rune{0x513F}, false},
+	{0x2F0A, "<compat>", []rune{0x5165}, false},
+	{0x2F0B, "<compat>", []rune{0x516B}, false},
+	{0x2F0C, "<compat>", []rune{0x5182}, false},
+	{0x2F0D, "<compat>", []rune{0x5196}, false},
+	{0x2F0E, "<compat>", []rune{0x51AB}, false},
+	{0x2F0F, "<compat>", []rune{0x51E0}, false},
+	{0x2F10, "<compat>", []rune{0x51F5}, false},
+	{0x2F11, "<compat>", []rune{0x5200}, false},
+	{0x2F12, "<compat>", []rune{0x529B}, false},
+	{0x2F13, "<compat>", []rune{0x52F9}, false},
+	{0x2F14, "<compat>", []rune{0x5315}, false},
+	{0x2F15, "<compat>", []rune{0x531A}, false},
+	{0x2F16, "<compat>", []rune{0x5338}, false},
+	{0x2F17, "<compat>", []rune{0x5341}, false},
+	{0x2F18, "<compat>", []rune{0x535C}, false},
+	{0x2F19, "<compat>", []rune{0x5369}, false},
+	{0x2F1A, "<compat>", []rune{0x5382}, false},
+	{0x2F1B, "<compat>", []rune{0x53B6}, false},
+	{0x2F1C, "<compat>", []rune{0x53C8}, false},
+	{0x2F1D, "<compat>", []rune{0x53E3}, false},
+	{0x2F1E, "<compat>", []rune{0x56D7}, false},
+	{0x2F1F, "<compat>", []rune{0x571F}, false},
+	{0x2F20, "<compat>", []rune{0x58EB}, false},
+	{0x2F21, "<compat>", []rune{0x5902}, false},
+	{0x2F22, "<compat>", []rune{0x590A}, false},
+	{0x2F23, "<compat>", []rune{0x5915}, false},
+	{0x2F24, "<compat>", []rune{0x5927}, false},
+	{0x2F25, "<compat>", []rune{0x5973}, false},
+	{0x2F26, "<compat>", []rune{0x5B50}, false},
+	{0x2F27, "<compat>", []rune{0x5B80}, false},
+	{0x2F28, "<compat>", []rune{0x5BF8}, false},
+	{0x2F29, "<compat>", []rune{0x5C0F}, false},
+	{0x2F2A, "<compat>", []rune{0x5C22}, false},
+	{0x2F2B, "<compat>", []rune{0x5C38}, false},
+	{0x2F2C, "<compat>", []rune{0x5C6E}, false},
+	{0x2F2D, "<compat>", []rune{0x5C71}, false},
+	{0x2F2E, "<compat>", []rune{0x5DDB}, false},
+	{0x2F2F, "<compat>", []rune{0x5DE5}, false},
+	{0x2F30, "<compat>", []rune{0x5DF1}, false},
+	{0x2F31, "<compat>", []rune{0x5DFE}, false},
+	{0x2F32, "<compat>", []rune{0x5E72}, false},
+	{0x2F33, "<compat>", []rune{0x5E7A}, false},
+	{0x2F34, "<compat>", []rune{0x5E7F}, false},
+	{0x2F35, "<compat>", []rune{0x5EF4}, false},
+	{0x2F36, "<compat>", []rune{0x5EFE}, false},
+	{0x2F37, "<compat>", []rune{0x5F0B}, false},
+	{0x2F38, "<compat>", []rune{0x5F13}, false},
+	{0x2F39, "<compat>", []rune{0x5F50}, false},
+	{0x2F3A, "<compat>", []rune{0x5F61}, false},
+	{0x2F3B, "<compat>", []rune{0x5F73}, false},
+	{0x2F3C, "<compat>", []rune{0x5FC3}, false},
+	{0x2F3D, "<compat>", []rune{0x6208}, false},
+	{0x2F3E, "<compat>", []rune{0x6236}, false},
+	{0x2F3F, "<compat>", []rune{0x624B}, false},
+	{0x2F40, "<compat>", []rune{0x652F}, false},
+	{0x2F41, "<compat>", []rune{0x6534}, false},
+	{0x2F42, "<compat>", []rune{0x6587}, false},
+	{0x2F43, "<compat>", []rune{0x6597}, false},
+	{0x2F44, "<compat>", []rune{0x65A4}, false},
+	{0x2F45, "<compat>", []rune{0x65B9}, false},
+	{0x2F46, "<compat>", []rune{0x65E0}, false},
+	{0x2F47, "<compat>", []rune{0x65E5}, false},
+	{0x2F48, "<compat>", []rune{0x66F0}, false},
+	{0x2F49, "<compat>", []rune{0x6708}, false},
+	{0x2F4A, "<compat>", []rune{0x6728}, false},
+	{0x2F4B, "<compat>", []rune{0x6B20}, false},
+	{0x2F4C, "<compat>", []rune{0x6B62}, false},
+	{0x2F4D, "<compat>", []rune{0x6B79}, false},
+	{0x2F4E, "<compat>", []rune{0x6BB3}, false},
+	{0x2F4F, "<compat>", []rune{0x6BCB}, false},
+	{0x2F50, "<compat>", []rune{0x6BD4}, false},
+	{0x2F51, "<compat>", []rune{0x6BDB}, false},
+	{0x2F52, "<compat>", []rune{0x6C0F}, false},
+	{0x2F53, "<compat>", []rune{0x6C14}, false},
+	{0x2F54, "<compat>", []rune{0x6C34}, false},
+	{0x2F55, "<compat>", []rune{0x706B}, false},
+	{0x2F56, "<compat>", []rune{0x722A}, false},
+	{0x2F57, "<compat>", []rune{0x7236}, false},
+	{0x2F58, "<compat>", []rune{0x723B}, false},
+	{0x2F59, "<compat>", []rune{0x723F}, false},
+	{0x2F5A, "<compat>", []rune{0x7247}, false},
+	{0x2F5B, "<compat>", []rune{0x7259}, false},
+	{0x2F5C, "<compat>", []rune{0x725B}, false},
+	{0x2F5D, "<compat>", []rune{0x72AC}, false},
+	{0x2F5E, "<compat>", []rune{0x7384}, false},
+	{0x2F5F, "<compat>", []rune{0x7389}, false},
+	{0x2F60, "<compat>", []rune{0x74DC}, false},
+	{0x2F61, "<compat>", []rune{0x74E6}, false},
+	{0x2F62, "<compat>", []rune{0x7518}, false},
+	{0x2F63, "<compat>", []rune{0x751F}, false},
+	{0x2F64, "<compat>", []rune{0x7528}, false},
+	{0x2F65, "<compat>", []rune{0x7530}, false},
+	{0x2F66, "<compat>", []rune{0x758B}, false},
+	{0x2F67, "<compat>", []rune{0x7592}, false},
+	{0x2F68, "<compat>", []rune{0x7676}, false},
+	{0x2F69, "<compat>", []rune{0x767D}, false},
+	{0x2F6A, "<compat>", []rune{0x76AE}, false},
+	{0x2F6B, "<compat>", []rune{0x76BF}, false},
+	{0x2F6C, "<compat>", []rune{0x76EE}, false},
+	{0x2F6D, "<compat>", []rune{0x77DB}, false},
+	{0x2F6E, "<compat>", []rune{0x77E2}, false},
+	{0x2F6F, "<compat>", []rune{0x77F3}, false},
+	{0x2F70, "<compat>", []rune{0x793A}, false},
+	{0x2F71, "<compat>", []rune{0x79B8}, false},
+	{0x2F72, "<compat>", []rune{0x79BE}, false},
+	{0x2F73, "<compat>", []rune{0x7A74}, false},
+	{0x2F74, "<compat>", []rune{0x7ACB}, false},
+	{0x2F75, "<compat>", []rune{0x7AF9}, false},
+	{0x2F76, "<compat>", []rune{0x7C73}, false},
+	{0x2F77, "<compat>", []rune{0x7CF8}, false},
+	{0x2F78, "<compat>", []rune{0x7F36}, false},
+	{0x2F79, "<compat>", []rune{0x7F51}, false},
+	{0x2F7A, "<compat>", []rune{0x7F8A}, false},
+	{0x2F7B, "<compat>", []rune{0x7FBD}, false},
+	{0x2F7C, "<compat>", []rune{0x8001}, false},
+	{0x2F7D, "<compat>", []rune{0x800C}, false},
+	{0x2F7E, "<compat>", []rune{0x8012}, false},
+	{0x2F7F, "<compat>", []rune{0x8033}, false},
+	{0x2F80, "<compat>", []rune{0x807F}, false},
+	{0x2F81, "<compat>", []rune{0x8089}, false},
+	{0x2F82, "<compat>", []rune{0x81E3}, false},
+	{0x2F83, "<compat>", []rune{0x81EA}, false},
+	{0x2F84, "<compat>", []rune{0x81F3}, false},
+	{0x2F85, "<compat>", []rune{0x81FC}, false},
+	{0x2F86, "<compat>", []rune{0x820C}, false},
+	{0x2F87, "<compat>", []rune{0x821B}, false},
+	{0x2F88, "<compat>", []rune{0x821F}, false},
+	{0x2F89, "<compat>", []rune{0x826E}, false},
+	{0x2F8A, "<compat>", []rune{0x8272}, false},
+	{0x2F8B, "<compat>", []rune{0x8278}, false},
+	{0x2F8C, "<compat>", []rune{0x864D}, false},
+	{0x2F8D, "<compat>", []rune{0x866B}, false},
+	{0x2F8E, "<compat>", []rune{0x8840}, false},
+	{0x2F8F, "<compat>", []rune{0x884C}, false},
+	{0x2F90, "<compat>", []rune{0x8863}, false},
+	{0x2F91, "<compat>", []rune{0x897E}, false},
+	{0x2F92, "<compat>", []rune{0x898B}, false},
+	{0x2F93, "<compat>", []rune{0x89D2}, false},
+	{0x2F94, "<compat>", []rune{0x8A00}, false},
+	{0x2F95, "<compat>", []rune{0x8C37}, false},
+	{0x2F96, "<compat>", []rune{0x8C46}, false},
+	{0x2F97, "<compat>", []rune{0x8C55}, false},
+	{0x2F98, "<compat>", []rune{0x8C78}, false},
+	{0x2F99, "<compat>", []rune{0x8C9D}, false},
+	{0x2F9A, "<compat>", []rune{0x8D64}, false},
+	{0x2F9B, "<compat>", []rune{0x8D70}, false},
+	{0x2F9C, "<compat>", []rune{0x8DB3}, false},
+	{0x2F9D, "<compat>", []rune{0x8EAB}, false},
+	{0x2F9E, "<compat>", []rune{0x8ECA}, false},
+	{0x2F9F, "<compat>", []rune{0x8F9B}, false},
+	{0x2FA0, "<compat>", []rune{0x8FB0}, false},
+	{0x2FA1, "<compat>", []rune{0x8FB5}, false},
+	{0x2FA2, "<compat>", []rune{0x9091}, false},
+	{0x2FA3, "<compat>", []rune{0x9149}, false},
+	{0x2FA4, "<compat>", []rune{0x91C6}, false},
+	{0x2FA5, "<compat>", []rune{0x91CC}, false},
+	{0x2FA6, "<compat>", []rune{0x91D1}, false},
+	{0x2FA7, "<compat>", []rune{0x9577}, false},
+	{0x2FA8, "<compat>", []rune{0x9580}, false},
+	{0x2FA9, "<compat>", []rune{0x961C}, false},
+	{0x2FAA, "<compat>", []rune{0x96B6}, false},
+	{0x2FAB, "<compat>", []rune{0x96B9}, false},
+	{0x2FAC, "<compat>", []rune{0x96E8}, false},
+	{0x2FAD, "<compat>", []rune{0x9751}, false},
+	{0x2FAE, "<compat>", []rune{0x975E}, false},
+	{0x2FAF, "<compat>", []rune{0x9762}, false},
+	{0x2FB0, "<compat>", []rune{0x9769}, false},
+	{0x2FB1, "<compat>", []rune{0x97CB}, false},
+	{0x2FB2, "<compat>", []rune{0x97ED}, false},
+	{0x2FB3, "<compat>", []rune{0x97F3}, false},
+	{0x2FB4, "<compat>", []rune{0x9801}, false},
+	{0x2FB5, "<compat>", []rune{0x98A8}, false},
+	{0x2FB6, "<compat>", []rune{0x98DB}, false},
+	{0x2FB7, "<compat>", []rune{0x98DF}, false},
+	{0x2FB8, "<compat>", []rune{0x9996}, false},
+	{0x2FB9, "<compat>", []rune{0x9999}, false},
+	{0x2FBA, "<compat>", []rune{0x99AC}, false},
+	{0x2FBB, "<compat>", []rune{0x9AA8}, false},
+	{0x2FBC, "<compat>", []rune{0x9AD8}, false},
+	{0x2FBD, "<compat>", []rune{0x9ADF}, false},
+	{0x2FBE, "<compat>", []rune{0x9B25}, false},
+	{0x2FBF, "<compat>", []rune{0x9B2F}, false},
+	{0x2FC0, "<compat>", []rune{0x9B32}, false},
+	{0x2FC1, "<compat>", []rune{0x9B3C}, false},
+	{0x2FC2, "<compat>", []rune{0x9B5A}, false},
+	{0x2FC3, "<compat>", []rune{0x9CE5}, false},
+	{0x2FC4, "<compat>", []rune{0x9E75}, false},
+	{0x2FC5, "<compat>", []rune{0x9E7F}, false},
+	{0x2FC6, "<compat>", []rune{0x9EA5}, false},
+	{0x2FC7, "<compat>", []rune{0x9EBB}, false},
+	{0x2FC8, "<compat>", []rune{0x9EC3}, false},
+	{0x2FC9, "<compat>", []rune{0x9ECD}, false},
+	{0x2FCA, "<compat>", []rune{0x9ED1}, false},
+	{0x2FCB, "<compat>", []rune{0x9EF9}, false},
+	{0x2FCC, "<compat>", []rune{0x9EFD}, false},
+	{0x2FCD, "<compat>", []rune{0x9F0E}, false},
+	{0x2FCE, "<compat>", []rune{0x9F13}, false},
+	{0x2FCF, "<compat>", []rune{0x9F20}, false},
+	{0x2FD0, "<compat>", []rune{0x9F3B}, false},
+	{0x2FD1, "<compat>", []rune{0x9F4A}, false},
+	{0x2FD2, "<compat>", []rune{0x9F52}, false},
+	{0x2FD3, "<compat>", []rune{0x9F8D}, false},
+	{0x2FD4, "<compat>", []rune{0x9F9C}, false},
+	{0x2FD5, "<compat>", []rune{0x9FA0}, false},
+	{0x3000, "<wide>", []rune{0x0020}, false},
+	{0x3036, "<compat>", []rune{0x3012}, false},
+	{0x3038, "<compat>", []rune{0x5341}, false},
+	{0x3039, "<compat>", []rune{0x5344}, false},
+	{0x303A, "<compat>", []rune{0x5345}, false},
+	{0x304C, "", []rune{0x304B, 0x3099}, false},
+	{0x304E, "", []rune{0x304D, 0x3099}, false},
+	{0x3050, "", []rune{0x304F, 0x3099}, false},
+	{0x3052, "", []rune{0x3051, 0x3099}, false},
+	{0x3054, "", []rune{0x3053, 0x3099}, false},
+	{0x3056, "", []rune{0x3055, 0x3099}, false},
+	{0x3058, "", []rune{0x3057, 0x3099}, false},
+	{0x305A, "", []rune{0x3059, 0x3099}, false},
+	{0x305C, "", []rune{0x305B, 0x3099}, false},
+	{0x305E, "", []rune{0x305D, 0x3099}, false},
+	{0x3060, "", []rune{0x305F, 0x3099}, false},
+	{0x3062, "", []rune{0x3061, 0x3099}, false},
+	{0x3065, "", []rune{0x3064, 0x3099}, false},
+	{0x3067, "", []rune{0x3066, 0x3099}, false},
+	{0x3069, "", []rune{0x3068, 0x3099}, false},
+	{0x3070, "", []rune{0x306F, 0x3099}, false},
+	{0x3071, "", []rune{0x306F, 0x309A}, false},
+	{0x3073, "", []rune{0x3072, 0x3099}, false},
+	{0x3074, "", []rune{0x3072, 0x309A}, false},
+	{0x3076, "", []rune{0x3075, 0x3099}, false},
+	{0x3077, "", []rune{0x3075, 0x309A}, false},
+	{0x3079, "", []rune{0x3078, 0x3099}, false},
+	{0x307A, "", []rune{0x3078, 0x309A}, false},
+	{0x307C, "", []rune{0x307B, 0x3099}, false},
+	{0x307D, "", []rune{0x307B, 0x309A}, false},
+	{0x3094, "", []rune{0x3046, 0x3099}, false},
+	{0x309B, "<compat>", []rune{0x0020, 0x3099}, false},
+	{0x309C, "<compat>", []rune{0x0020, 0x309A}, false},
+	{0x309E, "", []rune{0x309D, 0x3099}, false},
+	{0x309F, "<vertical>", []rune{0x3088, 0x308A}, false},
+	{0x30AC, "", []rune{0x30AB, 0x3099}, false},
+	{0x30AE, "", []rune{0x30AD, 0x3099}, false},
+	{0x30B0, "", []rune{0x30AF, 0x3099}, false},
+	{0x30B2, "", []rune{0x30B1, 0x3099}, false},
+	{0x30B4, "", []rune{0x30B3, 0x3099}, false},
+	{0x30B6, "", []rune{0x30B5, 0x3099}, false},
+	{0x30B8, "", []rune{0x30B7, 0x3099}, false},
+	{0x30BA, "", []rune{0x30B9, 0x3099}, false},
+	{0x30BC, "", []rune{0x30BB, 0x3099}, false},
+	{0x30BE, "", []rune{0x30BD, 0x3099}, false},
+	{0x30C0, "", []rune{0x30BF, 0x3099}, false},
+	{0x30C2, "", []rune{0x30C1, 0x3099}, false},
+	{0x30C5, "", []rune{0x30C4, 0x3099}, false},
+	{0x30C7, "", []rune{0x30C6, 0x3099}, false},
+	{0x30C9, "", []rune{0x30C8, 0x3099}, false},
+	{0x30D0, "", []rune{0x30CF, 0x3099}, false},
+	{0x30D1, "", []rune{0x30CF, 0x309A}, false},
+	{0x30D3, "", []rune{0x30D2, 0x3099}, false},
+	{0x30D4, "", []rune{0x30D2, 0x309A}, false},
+	{0x30D6, "", []rune{0x30D5, 0x3099}, false},
+	{0x30D7, "", []rune{0x30D5, 0x309A}, false},
+	{0x30D9, "", []rune{0x30D8, 0x3099}, false},
+	{0x30DA, "", []rune{0x30D8, 0x309A}, false},
+	{0x30DC, "", []rune{0x30DB, 0x3099}, false},
+	{0x30DD, "", []rune{0x30DB, 0x309A}, false},
+	{0x30F4, "", []rune{0x30A6, 0x3099}, false},
+	{0x30F7, "", []rune{0x30EF, 0x3099}, false},
+	{0x30F8, "", []rune{0x30F0, 0x3099}, false},
+	{0x30F9, "", []rune{0x30F1, 0x3099}, false},
+	{0x30FA, "", []rune{0x30F2, 0x3099}, false},
+	{0x30FE, "", []rune{0x30FD, 0x3099}, false},
+	{0x30FF, "<vertical>", []rune{0x30B3, 0x30C8}, false},
+	{0x3131, "<compat>", []rune{0x1100}, false},
+	{0x3132, "<compat>", []rune{0x1101}, false},
+	{0x3133, "<compat>", []rune{0x11AA}, false},
+	{0x3134, "<compat>", []rune{0x1102}, false},
+	{0x3135, "<compat>", []rune{0x11AC}, false},
+	{0x3136, "<compat>", []rune{0x11AD}, false},
+	{0x3137, "<compat>", []rune{0x1103}, false},
+	{0x3138, "<compat>", []rune{0x1104}, false},
+	{0x3139, "<compat>", []rune{0x1105}, false},
+	{0x313A, "<compat>", []rune{0x11B0}, false},
+	{0x313B, "<compat>", []rune{0x11B1}, false},
+	{0x313C, "<compat>", []rune{0x11B2}, false},
+	{0x313D, "<compat>", []rune{0x11B3}, false},
+	{0x313E, "<compat>", []rune{0x11B4}, false},
+	{0x313F, "<compat>", []rune{0x11B5}, false},
+	{0x3140, "<compat>", []rune{0x111A}, false},
+	{0x3141, "<compat>", []rune{0x1106}, false},
+	{0x3142, "<compat>", []rune{0x1107}, false},
+	{0x3143, "<compat>", []rune{0x1108}, false},
+	{0x3144, "<compat>", []rune{0x1121}, false},
+	{0x3145, "<compat>", []rune{0x1109}, false},
+	{0x3146, "<compat>", []rune{0x110A}, false},
+	{0x3147, "<compat>", []rune{0x110B}, false},
+	{0x3148, "<compat>", []rune{0x110C}, false},
+	{0x3149, "<compat>", []rune{0x110D}, false},
+	{0x314A, "<compat>", []rune{0x110E}, false},
+	{0x314B, "<compat>", []rune{0x110F}, false},
+	{0x314C, "<compat>", []rune{0x1110}, false},
+	{0x314D, "<compat>", []rune{0x1111}, false},
+	{0x314E, "<compat>", []rune{0x1112}, false},
+	{0x314F, "<compat>", []rune{0x1161}, false},
+	{0x3150, "<compat>", []rune{0x1162}, false},
+	{0x3151, "<compat>", []rune{0x1163}, false},
+	{0x3152, "<compat>", []rune{0x1164}, false},
+	{0x3153, "<compat>", []rune{0x1165}, false},
+	{0x3154, "<compat>", []rune{0x1166}, false},
+	{0x3155, "<compat>", []rune{0x1167}, false},
+	{0x3156, "<compat>", []rune{0x1168}, false},
+	{0x3157, "<compat>", []rune{0x1169}, false},
+	{0x3158, "<compat>", []rune{0x116A}, false},
+	{0x3159, "<compat>", []rune{0x116B}, false},
+	{0x315A, "<compat>", []rune{0x116C}, false},
+	{0x315B, "<compat>", []rune{0x116D}, false},
+	{0x315C, "<compat>", []rune{0x116E}, false},
+	{0x315D, "<compat>", []rune{0x116F}, false},
+	{0x315E, "<compat>", []rune{0x1170}, false},
+	{0x315F, "<compat>", []rune{0x1171}, false},
+	{0x3160, "<compat>", []rune{0x1172}, false},
+	{0x3161, "<compat>", []rune{0x1173}, false},
+	{0x3162, "<compat>", []rune{0x1174}, false},
+	{0x3163, "<compat>", []rune{0x1175}, false},
+	{0x3164, "<compat>", []rune{0x1160}, false},
+	{0x3165, "<compat>", []rune{0x1114}, false},
+	{0x3166, "<compat>", []rune{0x1115}, false},
+	{0x3167, "<compat>", []rune{0x11C7}, false},
+	{0x3168, "<compat>", []rune{0x11C8}, false},
+	{0x3169, "<compat>", []rune{0x11CC}, false},
+	{0x316A, "<compat>", []rune{0x11CE}, false},
+	{0x316B, "<compat>", []rune{0x11D3}, false},
+	{0x316C, "<compat>", []rune{0x11D7}, false},
+	{0x316D, "<compat>", []rune{0x11D9}, false},
+	{0x316E, "<compat>", []rune{0x111C}, false},
+	{0x316F, "<compat>", []rune{0x11DD}, false},
+	{0x3170, "<compat>", []rune{0x11DF}, false},
+	{0x3171, "<compat>", []rune{0x111D}, false},
+	{0x3172, "<compat>", []rune{0x111E}, false},
+	{0x3173, "<compat>", []rune{0x1120}, false},
+	{0x3174, "<compat>", []rune{0x1122}, false},
+	{0x3175, "<compat>", []rune{0x1123}, false},
+	{0x3176, "<compat>", []rune{0x1127}, false},
+	{0x3177, "<compat>", []rune{0x1129}, false},
+	{0x3178, "<compat>", []rune{0x112B}, false},
+	{0x3179, "<compat>", []rune{0x112C}, false},
+	{0x317A, "<compat>", []rune{0x112D}, false},
+	{0x317B, "<compat>", []rune{0x112E}, false},
+	{0x317C, "<compat>", []rune{0x112F}, false},
+	{0x317D, "<compat>", []rune{0x1132}, false},
+	{0x317E, "<compat>", []rune{0x1136}, false},
+	{0x317F, "<compat>", []rune{0x1140}, false},
+	{0x3180, "<compat>", []rune{0x1147}, false},
+	{0x3181, "<compat>", []rune{0x114C}, false},
+	{0x3182, "<compat>", []rune{0x11F1}, false},
+	{0x3183, "<compat>", []rune{0x11F2}, false},
+	{0x3184, "<compat>", []rune{0x1157}, false},
+	{0x3185, "<compat>", []rune{0x1158}, false},
+	{0x3186, "<compat>", []rune{0x1159}, false},
+	{0x3187, "<compat>", []rune{0x1184}, false},
+	{0x3188, "<compat>", []rune{0x1185}, false},
+	{0x3189, "<compat>", []rune{0x1188}, false},
+	{0x318A, "<compat>", []rune{0x1191}, false},
+	{0x318B, "<compat>", []rune{0x1192}, false},
+	{0x318C, "<compat>", []rune{0x1194}, false},
+	{0x318D, "<compat>", []rune{0x119E}, false},
+	{0x318E, "<compat>", []rune{0x11A1}, false},
+	{0x3192, "<super>", []rune{0x4E00}, false},
+	{0x3193, "<super>", []rune{0x4E8C}, false},
+	{0x3194, "<super>", []rune{0x4E09}, false},
+	{0x3195, "<super>", []rune{0x56DB}, false},
+	{0x3196, "<super>", []rune{0x4E0A}, false},
+	{0x3197, "<super>", []rune{0x4E2D}, false},
+	{0x3198, "<super>", []rune{0x4E0B}, false},
+	{0x3199, "<super>", []rune{0x7532}, false},
+	{0x319A, "<super>", []rune{0x4E59}, false},
+	{0x319B, "<super>", []rune{0x4E19}, false},
+	{0x319C, "<super>", []rune{0x4E01}, false},
+	{0x319D, "<super>", []rune{0x5929}, false},
+	{0x319E, "<super>", []rune{0x5730}, false},
+	{0x319F, "<super>", []rune{0x4EBA}, false},
+	{0x3200, "<compat>", []rune{0x0028, 0x1100, 0x0029}, false},
+	{0x3201, "<compat>", []rune{0x0028, 0x1102, 0x0029}, false},
+	{0x3202, "<compat>", []rune{0x0028, 0x1103, 0x0029}, false},
+	{0x3203, "<compat>", []rune{0x0028, 0x1105, 0x0029}, false},
+	{0x3204, "<compat>", []rune{0x0028, 0x1106, 0x0029}, false},
+	{0x3205, "<compat>", []rune{0x0028, 0x1107, 0x0029}, false},
+	{0x3206, "<compat>", []rune{0x0028, 0x1109, 0x0029}, false},
+	{0x3207, "<compat>", []rune{0x0028, 0x110B, 0x0029}, false},
+	{0x3208, "<compat>", []rune{0x0028, 0x110C, 0x0029}, false},
+	{0x3209, "<compat>", []rune{0x0028, 0x110E, 0x0029}, false},
+	{0x320A, "<compat>", []rune{0x0028, 0x110F, 0x0029}, false},
+	{0x320B, "<compat>", []rune{0x0028, 0x1110, 0x0029}, false},
+	{0x320C, "<compat>", []rune{0x0028, 0x1111, 0x0029}, false},
+	{0x320D, "<compat>", []rune{0x0028, 0x1112, 0x0029}, false},
+	{0x320E, "<compat>", []rune{0x0028, 0x1100, 0x1161, 0x0029}, false},
+	{0x320F, "<compat>", []rune{0x0028, 0x1102, 0x1161, 0x0029}, false},
+	{0x3210, "<compat>", []rune{0x0028, 0x1103, 0x1161, 0x0029}, false},
+	{0x3211, "<compat>", []rune{0x0028, 0x1105, 0x1161, 0x0029}, false},
+	{0x3212, "<compat>", []rune{0x0028, 0x1106, 0x1161, 0x0029}, false},
+	{0x3213, "<compat>", []rune{0x0028, 0x1107, 0x1161, 0x0029}, false},
+	{0x3214, "<compat>", []rune{0x0028, 0x1109, 0x1161, 0x0029}, false},
+	{0x3215, "<compat>", []rune{0x0028, 0x110B, 0x1161, 0x0029}, false},
+	{0x3216, "<compat>", []rune{0x0028, 0x110C, 0x1161, 0x0029}, false},
+	{0x3217, "<compat>", []rune{0x0028, 0x110E, 0x1161, 0x0029}, false},
+	{0x3218, "<compat>", []rune{0x0028, 0x110F, 0x1161, 0x0029}, false},
+	{0x3219, "<compat>", []rune{0x0028, 0x1110, 0x1161, 0x0029}, false},
+	{0x321A, "<compat>", []rune{0x0028, 0x1111, 0x1161, 0x0029}, false},
+	{0x321B, "<compat>", []rune{0x0028, 0x1112, 0x1161, 0x0029}, false},
+	{0x321C, "<compat>", []rune{0x0028, 0x110C, 0x116E, 0x0029}, false},
+	{0x321D, "<compat>", []rune{0x0028, 0x110B, 0x1169, 0x110C, 0x1165, 0x11AB, 0x0029}, false},
+	{0x321E, "<compat>", []rune{0x0028, 0x110B, 0x1169, 0x1112, 0x116E, 0x0029}, false},
+	{0x3220, "<compat>", []rune{0x0028, 0x4E00, 0x0029}, false},
+	{0x3221, "<compat>", []rune{0x0028, 0x4E8C, 0x0029}, false},
+	{0x3222, "<compat>", []rune{0x0028, 0x4E09, 0x0029}, false},
+	{0x3223, "<compat>", []rune{0x0028, 0x56DB, 0x0029}, false},
+	{0x3224, "<compat>", []rune{0x0028, 0x4E94, 0x0029}, false},
+	{0x3225, "<compat>", []rune{0x0028, 0x516D, 0x0029}, false},
+	{0x3226, "<compat>", []rune{0x0028, 0x4E03, 0x0029}, false},
+	{0x3227, "<compat>", []rune{0x0028, 0x516B, 0x0029}, false},
+	{0x3228, "<compat>", []rune{0x0028, 0x4E5D, 0x0029}, false},
+	{0x3229, "<compat>", []rune{0x0028, 0x5341, 0x0029}, false},
+	{0x322A, "<compat>", []rune{0x0028, 0x6708, 0x0029}, false},
+	{0x322B, "<compat>", []rune{0x0028, 0x706B, 0x0029}, false},
+	{0x322C, "<compat>", []rune{0x0028, 0x6C34, 0x0029}, false},
+	{0x322D, "<compat>", []rune{0x0028, 0x6728, 0x0029}, false},
+	{0x322E, "<compat>", []rune{0x0028, 0x91D1, 0x0029}, false},
+	{0x322F, "<compat>", []rune{0x0028, 0x571F, 0x0029}, false},
+	{0x3230, "<compat>", []rune{0x0028, 0x65E5, 0x0029}, false},
+	{0x3231, "<compat>", []rune{0x0028, 0x682A, 0x0029}, false},
+	{0x3232, "<compat>", []rune{0x0028, 0x6709, 0x0029}, false},
+	{0x3233, "<compat>", []rune{0x0028, 0x793E, 0x0029}, false},
+	{0x3234, "<compat>", []rune{0x0028, 0x540D, 0x0029}, false},
+	{0x3235, "<compat>", []rune{0x0028, 0x7279, 0x0029}, false},
+	{0x3236, "<compat>", []rune{0x0028, 0x8CA1, 0x0029}, false},
+	{0x3237, "<compat>", []rune{0x0028, 0x795D, 0x0029}, false},
+	{0x3238, "<compat>", []rune{0x0028, 0x52B4, 0x0029}, false},
+	{0x3239, "<compat>", []rune{0x0028, 0x4EE3, 0x0029}, false},
+	{0x323A, "<compat>", []rune{0x0028, 0x547C, 0x0029}, false},
+	{0x323B, "<compat>", []rune{0x0028, 0x5B66, 0x0029}, false},
+	{0x323C, "<compat>", []rune{0x0028, 0x76E3, 0x0029}, false},
+	{0x323D, "<compat>", []rune{0x0028, 0x4F01, 0x0029}, false},
+	{0x323E, "<compat>", []rune{0x0028, 0x8CC7, 0x0029}, false},
+	{0x323F, "<compat>", []rune{0x0028, 0x5354, 0x0029}, false},
+	{0x3240, "<compat>", []rune{0x0028, 0x796D, 0x0029}, false},
+	{0x3241, "<compat>", []rune{0x0028, 0x4F11, 0x0029}, false},
+	{0x3242, "<compat>", []rune{0x0028, 0x81EA, 0x0029}, false},
+	{0x3243, "<compat>", []rune{0x0028, 0x81F3, 0x0029}, false},
+	{0x3244, "<circle>", []rune{0x554F}, false},
+	{0x3245, "<circle>", []rune{0x5E7C}, false},
+	{0x3246, "<circle>", []rune{0x6587}, false},
+	{0x3247, "<circle>", []rune{0x7B8F}, false},
+	{0x3250, "<square>", []rune{0x0050, 0x0054, 0x0045}, false},
+	{0x3251, "<circle>", []rune{0x0032, 0x0031}, false},
+	{0x3252, "<circle>", []rune{0x0032, 0x0032}, false},
+	{0x3253, "<circle>", []rune{0x0032, 0x0033}, false},
+	{0x3254, "<circle>", []rune{0x0032, 0x0034}, false},
+	{0x3255, "<circle>", []rune{0x0032, 0x0035}, false},
+	{0x3256, "<circle>", []rune{0x0032, 0x0036}, false},
+	{0x3257, "<circle>", []rune{0x0032, 0x0037}, false},
+	{0x3258, "<circle>", []rune{0x0032, 0x0038}, false},
+	{0x3259, "<circle>", []rune{0x0032, 0x0039}, false},
+	{0x325A, "<circle>", []rune{0x0033, 0x0030}, false},
+	{0x325B, "<circle>", []rune{0x0033, 0x0031}, false},
+	{0x325C, "<circle>", []rune{0x0033, 0x0032}, false},
+	{0x325D, "<circle>", []rune{0x0033, 0x0033}, false},
+	{0x325E, "<circle>", []rune{0x0033, 0x0034}, false},
+	{0x325F, "<circle>", []rune{0x0033, 0x0035}, false},
+	{0x3260, "<circle>", []rune{0x1100}, false},
+	{0x3261, "<circle>", []rune{0x1102}, false},
+	{0x3262, "<circle>", []rune{0x1103}, false},
+	{0x3263, "<circle>", []rune{0x1105}, false},
+	{0x3264, "<circle>", []rune{0x1106}, false},
+	{0x3265, "<circle>", []rune{0x1107}, false},
+	{0x3266, "<circle>", []rune{0x1109}, false},
+	{0x3267, "<circle>", []rune{0x110B}, false},
+	{0x3268, "<circle>", []rune{0x110C}, false},
+	{0x3269, "<circle>", []rune{0x110E}, false},
+	{0x326A, "<circle>", []rune{0x110F}, false},
+	{0x326B, "<circle>", []rune{0x1110}, false},
+	{0x326C, "<circle>", []rune{0x1111}, false},
+	{0x326D, "<circle>", []rune{0x1112}, false},
+	{0x326E, "<circle>", []rune{0x1100, 0x1161}, false},
+	{0x326F, "<circle>", []rune{0x1102, 0x1161}, false},
+	{0x3270, "<circle>", []rune{0x1103, 0x1161}, false},
+	{0x3271, "<circle>", []rune{0x1105, 0x1161}, false},
+	{0x3272, "<circle>", []rune{0x1106, 0x1161}, false},
+	{0x3273, "<circle>", []rune{0x1107, 0x1161}, false},
+	{0x3274, "<circle>", []rune{0x1109, 0x1161}, false},
+	{0x3275, "<circle>", []rune{0x110B, 0x1161}, false},
+	{0x3276, "<circle>", []rune{0x110C, 0x1161}, false},
+	{0x3277, "<circle>", []rune{0x110E, 0x1161}, false},
+	{0x3278, "<circle>", []rune{0x110F, 0x1161}, false},
+	{0x3279, "<circle>", []rune{0x1110, 0x1161}, false},
+	{0x327A, "<circle>", []rune{0x1111, 0x1161}, false},
+	{0x327B, "<circle>", []rune{0x1112, 0x1161}, false},
+	{0x327C, "<circle>", []rune{0x110E, 0x1161, 0x11B7, 0x1100, 0x1169}, false},
+	{0x327D, "<circle>", []rune{0x110C, 0x116E, 0x110B, 0x1174}, false},
+	{0x327E, "<circle>", []rune{0x110B, 0x116E}, false},
+	{0x3280, "<circle>", []rune{0x4E00}, false},
+	{0x3281, "<circle>", []rune{0x4E8C}, false},
+	{0x3282, "<circle>", []rune{0x4E09}, false},
+	{0x3283, "<circle>", []rune{0x56DB}, false},
+	{0x3284, "<circle>", []rune{0x4E94}, false},
+	{0x3285, "<circle>", []rune{0x516D}, false},
+	{0x3286, "<circle>", []rune{0x4E03}, false},
+	{0x3287, "<circle>", []rune{0x516B}, false},
+	{0x3288, "<circle>", []rune{0x4E5D}, false},
+	{0x3289, "<circle>", []rune{0x5341}, false},
+	{0x328A, "<circle>", []rune{0x6708}, false},
+	{0x328B, "<circle>", []rune{0x706B}, false},
+	{0x328C, "<circle>", []rune{0x6C34}, false},
+	{0x328D, "<circle>", []rune{0x6728}, false},
+	{0x328E, "<circle>", []rune{0x91D1}, false},
+	{0x328F, "<circle>", []rune{0x571F}, false},
+	{0x3290, "<circle>", []rune{0x65E5}, false},
+	{0x3291, "<circle>", []rune{0x682A}, false},
+	{0x3292, "<circle>", []rune{0x6709}, false},
+	{0x3293, "<circle>", []rune{0x793E}, false},
+	{0x3294, "<circle>", []rune{0x540D}, false},
+	{0x3295, "<circle>", []rune{0x7279}, false},
+	{0x3296, "<circle>", []rune{0x8CA1}, false},
+	{0x3297, "<circle>", []rune{0x795D}, false},
+	{0x3298, "<circle>", []rune{0x52B4}, false},
+	{0x3299, "<circle>", []rune{0x79D8}, false},
+	{0x329A, "<circle>", []rune{0x7537}, false},
+	{0x329B, "<circle>", []rune{0x5973}, false},
+	{0x329C, "<circle>", []rune{0x9069}, false},
+	{0x329D, "<circle>", []rune{0x512A}, false},
+	{0x329E, "<circle>", []rune{0x5370}, false},
+	{0x329F, "<circle>", []rune{0x6CE8}, false},
+	{0x32A0, "<circle>", []rune{0x9805}, false},
+	{0x32A1, "<circle>", []rune{0x4F11}, false},
+	{0x32A2, "<circle>", []rune{0x5199}, false},
+	{0x32A3, "<circle>", []rune{0x6B63}, false},
+	{0x32A4, "<circle>", []rune{0x4E0A}, false},
+	{0x32A5, "<circle>", []rune{0x4E2D}, false},
+	{0x32A6, "<circle>", []rune{0x4E0B}, false},
+	{0x32A7, "<circle>", []rune{0x5DE6}, false},
+	{0x32A8, "<circle>", []rune{0x53F3}, false},
+	{0x32A9, "<circle>", []rune{0x533B}, false},
+	{0x32AA, "<circle>", []rune{0x5B97}, false},
+	{0x32AB, "<circle>", []rune{0x5B66}, false},
+	{0x32AC, "<circle>", []rune{0x76E3}, false},
+	{0x32AD, "<circle>", []rune{0x4F01}, false},
+	{0x32AE, "<circle>", []rune{0x8CC7}, false},
+	{0x32AF, "<circle>", []rune{0x5354}, false},
+	{0x32B0, "<circle>", []rune{0x591C}, false},
+	{0x32B1, "<circle>", []rune{0x0033, 0x0036}, false},
+	{0x32B2, "<circle>", []rune{0x0033, 0x0037}, false},
+	{0x32B3, "<circle>", []rune{0x0033, 0x0038}, false},
+	{0x32B4, "<circle>", []rune{0x0033, 0x0039}, false},
+	{0x32B5, "<circle>", []rune{0x0034, 0x0030}, false},
+	{0x32B6, "<circle>", []rune{0x0034, 0x0031}, false},
+	{0x32B7, "<circle>", []rune{0x0034, 0x0032}, false},
+	{0x32B8, "<circle>", []rune{0x0034, 0x0033}, false},
+	{0x32B9, "<circle>", []rune{0x0034, 0x0034}, false},
+	{0x32BA, "<circle>", []rune{0x0034, 0x0035}, false},
+	{0x32BB, "<circle>", []rune{0x0034, 0x0036}, false},
+	{0x32BC, "<circle>", []rune{0x0034, 0x0037}, false},
+	{0x32BD, "<circle>", []rune{0x0034, 0x0038}, false},
+	{0x32BE, "<circle>", []rune{0x0034, 0x0039}, false},
+	{0x32BF, "<circle>", []rune{0x0035, 0x0030}, false},
+	{0x32C0, "<compat>", []rune{0x0031, 0x6708}, false},
+	{0x32C1, "<compat>", []rune{0x0032, 0x6708}, false},
+	{0x32C2, "<compat>", []rune{0x0033, 0x6708}, false},
+	{0x32C3, "<compat>", []rune{0x0034, 0x6708}, false},
+	{0x32C4, "<compat>", []rune{0x0035, 0x6708}, false},
+	{0x32C5, "<compat>", []rune{0x0036, 0x6708}, false},
+	{0x32C6, "<compat>", []rune{0x0037, 0x6708}, false},
+	{0x32C7, "<compat>", []rune{0x0038, 0x6708}, false},
+	{0x32C8, "<compat>", []rune{0x0039, 0x6708}, false},
+	{0x32C9, "<compat>", []rune{0x0031, 0x0030, 0x6708}, false},
+	{0x32CA, "<compat>", []rune{0x0031, 0x0031, 0x6708}, false},
+	{0x32CB, "<compat>", []rune{0x0031, 0x0032, 0x6708}, false},
+	{0x32CC, "<square>", []rune{0x0048, 0x0067}, false},
+	{0x32CD, "<square>", []rune{0x0065, 0x0072, 0x0067}, false},
+	{0x32CE, "<square>", []rune{0x0065, 0x0056}, false},
+	{0x32CF, "<square>", []rune{0x004C, 0x0054, 0x0044}, false},
+	{0x32D0, "<circle>", []rune{0x30A2}, false},
+	{0x32D1, "<circle>", []rune{0x30A4}, false},
+	{0x32D2, "<circle>", []rune{0x30A6}, false},
+	{0x32D3, "<circle>", []rune{0x30A8}, false},
+	{0x32D4, "<circle>", []rune{0x30AA}, false},
+	{0x32D5, "<circle>", []rune{0x30AB}, false},
+	{0x32D6, "<circle>", []rune{0x30AD}, false},
+	{0x32D7, "<circle>", []rune{0x30AF}, false},
+	{0x32D8, "<circle>", []rune{0x30B1}, false},
+	{0x32D9, "<circle>", []rune{0x30B3}, false},
+	{0x32DA, "<circle>", []rune{0x30B5}, false},
+	{0x32DB, "<circle>", []rune{0x30B7}, false},
+	{0x32DC, "<circle>", []rune{0x30B9}, false},
+	{0x32DD, "<circle>", []rune{0x30BB}, false},
+	{0x32DE, "<circle>", []rune{0x30BD}, false},
+	{0x32DF, "<circle>", []rune{0x30BF}, false},
+	{0x32E0, "<circle>", []rune{0x30C1}, false},
+	{0x32E1, "<circle>", []rune{0x30C4}, false},
+	{0x32E2, "<circle>", []rune{0x30C6}, false},
+	{0x32E3, "<circle>", []rune{0x30C8}, false},
+	{0x32E4, "<circle>", []rune{0x30CA}, false},
+	{0x32E5, "<circle>", []rune{0x30CB}, false},
+	{0x32E6, "<circle>", []rune{0x30CC}, false},
+	{0x32E7, "<circle>", []rune{0x30CD}, false},
+	{0x32E8, "<circle>", []rune{0x30CE}, false},
+	{0x32E9, "<circle>", []rune{0x30CF}, false},
+	{0x32EA, "<circle>", []rune{0x30D2}, false},
+	{0x32EB, "<circle>", []rune{0x30D5}, false},
+	{0x32EC, "<circle>", []rune{0x30D8}, false},
+	{0x32ED, "<circle>", []rune{0x30DB}, false},
+	{0x32EE, "<circle>", []rune{0x30DE}, false},
+	{0x32EF, "<circle>", []rune{0x30DF}, false},
+	{0x32F0, "<circle>", []rune{0x30E0}, false},
+	{0x32F1, "<circle>", []rune{0x30E1}, false},
+	{0x32F2, "<circle>", []rune{0x30E2}, false},
+	{0x32F3, "<circle>", []rune{0x30E4}, false},
+	{0x32F4, "<circle>", []rune{0x30E6}, false},
+	{0x32F5, "<circle>", []rune{0x30E8}, false},
+	{0x32F6, "<circle>", []rune{0x30E9}, false},
+	{0x32F7, "<circle>", []rune{0x30EA}, false},
+	{0x32F8, "<circle>", []rune{0x30EB}, false},
+	{0x32F9, "<circle>", []rune{0x30EC}, false},
+	{0x32FA, "<circle>", []rune{0x30ED}, false},
+	{0x32FB, "<circle>", []rune{0x30EF}, false},
+	{0x32FC, "<circle>", []rune{0x30F0}, false},
+	{0x32FD, "<circle>", []rune{0x30F1}, false},
+	{0x32FE, "<circle>", []rune{0x30F2}, false},
+	{0x32FF, "<square>", []rune{0x4EE4, 0x548C}, false},
+	{0x3300, "<square>", []rune{0x30A2, 0x30D1, 0x30FC, 0x30C8}, false},
+	{0x3301, "<square>", []rune{0x30A2, 0x30EB, 0x30D5, 0x30A1}, false},
+	{0x3302, "<square>", []rune{0x30A2, 0x30F3, 0x30DA, 0x30A2}, false},
+	{0x3303, "<square>", []rune{0x30A2, 0x30FC, 0x30EB}, false},
+	{0x3304, "<square>", []rune{0x30A4, 0x30CB, 0x30F3, 0x30B0}, false},
+	{0x3305, "<square>", []rune{0x30A4, 0x30F3, 0x30C1}, false},
+	{0x3306, "<square>", []rune{0x30A6, 0x30A9, 0x30F3}, false},
+	{0x3307, "<square>", []rune{0x30A8, 0x30B9, 0x30AF, 0x30FC, 0x30C9}, false},
+	{0x3308, "<square>", []rune{0x30A8, 0x30FC, 0x30AB, 0x30FC}, false},
+	{0x3309, "<square>", []rune{0x30AA, 0x30F3, 0x30B9}, false},
+	{0x330A, "<square>", []rune{0x30AA, 0x30FC, 0x30E0}, false},
+	{0x330B, "<square>", []rune{0x30AB, 0x30A4, 0x30EA}, false},
+	{0x330C, "<square>", []rune{0x30AB, 0x30E9, 0x30C3, 0x30C8}, false},
+	{0x330D, "<square>", []rune{0x30AB, 0x30ED, 0x30EA, 0x30FC}, false},
+	{0x330E, "<square>", []rune{0x30AC, 0x30ED, 0x30F3}, false},
+	{0x330F, "<square>", []rune{0x30AC, 0x30F3, 0x30DE}, false},
+	{0x3310, "<square>", []rune{0x30AE, 0x30AC}, false},
+	{0x3311, "<square>", []rune{0x30AE, 0x30CB, 0x30FC}, false},
+	{0x3312, "<square>", []rune{0x30AD, 0x30E5, 0x30EA, 0x30FC}, false},
+	{0x3313, "<square>", []rune{0x30AE, 0x30EB, 0x30C0, 0x30FC}, false},
+	{0x3314, "<square>", []rune{0x30AD, 0x30ED}, false},
+	{0x3315, "<square>", []rune{0x30AD, 0x30ED, 0x30B0, 0x30E9, 0x30E0}, false},
+	{0x3316, "<square>", []rune{0x30AD, 0x30ED, 0x30E1, 0x30FC, 0x30C8, 0x30EB}, false},
+	{0x3317, "<square>", []rune{0x30AD, 0x30ED, 0x30EF, 0x30C3, 0x30C8}, false},
+	{0x3318, "<square>", []rune{0x30B0, 0x30E9, 0x30E0}, false},
+	{0x3319, "<square>", []rune{0x30B0, 0x30E9, 0x30E0, 0x30C8, 0x30F3}, false},
+	{0x331A, "<square>", []rune{0x30AF, 0x30EB, 0x30BC, 0x30A4, 0x30ED}, false},
+	{0x331B, "<square>", []rune{0x30AF, 0x30ED, 0x30FC, 0x30CD}, false},
+	{0x331C, "<square>", []rune{0x30B1, 0x30FC, 0x30B9}, false},
+	{0x331D, "<square>", []rune{0x30B3, 0x30EB, 0x30CA}, false},
+	{0x331E, "<square>", []rune{0x30B3, 0x30FC, 0x30DD}, false},
+	{0x331F, "<square>", []rune{0x30B5, 0x30A4, 0x30AF, 0x30EB}, false},
+	{0x3320, "<square>", []rune{0x30B5, 0x30F3, 0x30C1, 0x30FC, 0x30E0}, false},
+	{0x3321, "<square>", []rune{0x30B7, 0x30EA, 0x30F3, 0x30B0}, false},
+	{0x3322, "<square>", []rune{0x30BB, 0x30F3, 0x30C1}, false},
+	{0x3323, "<square>", []rune{0x30BB, 0x30F3, 0x30C8}, false},
+	{0x3324, "<square>", []rune{0x30C0, 0x30FC, 0x30B9}, false},
+	{0x3325, "<square>", []rune{0x30C7, 0x30B7}, false},
+	{0x3326, "<square>", []rune{0x30C9, 0x30EB}, false},
+	{0x3327, "<square>", []rune{0x30C8, 0x30F3}, false},
+	{0x3328, "<square>", []rune{0x30CA, 0x30CE}, false},
+	{0x3329, "<square>", []rune{0x30CE, 0x30C3, 0x30C8}, false},
+	{0x332A, "<square>", []rune{0x30CF, 0x30A4, 0x30C4}, false},
+	{0x332B, "<square>", []rune{0x30D1, 0x30FC, 0x30BB, 0x30F3, 0x30C8}, false},
+	{0x332C, "<square>", []rune{0x30D1, 0x30FC, 0x30C4}, false},
+	{0x332D, "<square>", []rune{0x30D0, 0x30FC, 0x30EC, 0x30EB}, false},
+	{0x332E, "<square>", []rune{0x30D4, 0x30A2, 0x30B9, 0x30C8, 0x30EB}, false},
+	{0x332F, "<square>", []rune{0x30D4, 0x30AF, 0x30EB}, false},
+	{0x3330, "<square>", []rune{0x30D4, 0x30B3}, false},
+	{0x3331, "<square>", []rune{0x30D3, 0x30EB}, false},
+	{0x3332, "<square>", []rune{0x30D5, 0x30A1, 0x30E9, 0x30C3, 0x30C9}, false},
+	{0x3333, "<square>", []rune{0x30D5, 0x30A3, 0x30FC, 0x30C8}, false},
+	{0x3334, "<square>", []rune{0x30D6, 0x30C3, 0x30B7, 0x30A7, 0x30EB}, false},
+	{0x3335, "<square>", []rune{0x30D5, 0x30E9, 0x30F3}, false},
+	{0x3336, "<square>", []rune{0x30D8, 0x30AF, 0x30BF, 0x30FC, 0x30EB}, false},
+	{0x3337, "<square>", []rune{0x30DA, 0x30BD}, false},
+	{0x3338, "<square>", []rune{0x30DA, 0x30CB, 0x30D2}, false},
+	{0x3339, "<square>", []rune{0x30D8, 0x30EB, 0x30C4}, false},
+	{0x333A, "<square>", []rune{0x30DA, 0x30F3, 0x30B9}, false},
+	{0x333B, "<square>", []rune{0x30DA, 0x30FC, 0x30B8}, false},
+	{0x333C, "<square>", []rune{0x30D9, 0x30FC, 0x30BF}, false},
+	{0x333D, "<square>", []rune{0x30DD, 0x30A4, 0x30F3, 0x30C8}, false},
+	{0x333E, "<square>", []rune{0x30DC, 0x30EB, 0x30C8}, false},
+	{0x333F, "<square>", []rune{0x30DB, 0x30F3}, false},
+	{0x3340, "<square>", []rune{0x30DD, 0x30F3, 0x30C9}, false},
+	{0x3341, "<square>", []rune{0x30DB, 0x30FC, 0x30EB}, false},
+	{0x3342, "<square>", []rune{0x30DB, 0x30FC, 0x30F3}, false},
+	{0x3343, "<square>", []rune{0x30DE, 0x30A4, 0x30AF, 0x30ED}, false},
+	{0x3344, "<square>", []rune{0x30DE, 0x30A4, 0x30EB}, false},
+	{0x3345, "<square>", []rune{0x30DE, 0x30C3, 0x30CF}, false},
+	{0x3346, "<square>", []rune{0x30DE, 0x30EB, 0x30AF}, false},
+	{0x3347, "<square>", []rune{0x30DE, 0x30F3, 0x30B7, 0x30E7, 0x30F3}, false},
+	{0x3348, "<square>", []rune{0x30DF, 0x30AF, 0x30ED, 0x30F3}, false},
+	{0x3349, "<square>", []rune{0x30DF, 0x30EA}, false},
+	{0x334A, "<square>", []rune{0x30DF, 0x30EA, 0x30D0, 0x30FC, 0x30EB}, false},
+	{0x334B, "<square>", []rune{0x30E1, 0x30AC}, false},
+	{0x334C, "<square>", []rune{0x30E1, 0x30AC, 0x30C8, 0x30F3}, false},
+	{0x334D, "<square>", []rune{0x30E1, 0x30FC, 0x30C8, 0x30EB}, false},
+	{0x334E, "<square>", []rune{0x30E4, 0x30FC, 0x30C9}, false},
+	{0x334F, "<square>", []rune{0x30E4, 0x30FC, 0x30EB}, false},
+	{0x3350, "<square>", []rune{0x30E6, 0x30A2, 0x30F3}, false},
+	{0x3351, "<square>", []rune{0x30EA, 0x30C3, 0x30C8, 0x30EB}, false},
+	{0x3352, "<square>", []rune{0x30EA, 0x30E9}, false},
+	{0x3353, "<square>", []rune{0x30EB, 0x30D4, 0x30FC}, false},
+	{0x3354, "<square>", []rune{0x30EB, 0x30FC, 0x30D6, 0x30EB}, false},
+	{0x3355, "<square>", []rune{0x30EC, 0x30E0}, false},
+	{0x3356, "<square>", []rune{0x30EC, 0x30F3, 0x30C8, 0x30B2, 0x30F3}, false},
+	{0x3357, "<square>", []rune{0x30EF, 0x30C3, 0x30C8}, false},
+	{0x3358, "<compat>", []rune{0x0030, 0x70B9}, false},
+	{0x3359, "<compat>", []rune{0x0031, 0x70B9}, false},
+	{0x335A, "<compat>", []rune{0x0032, 0x70B9}, false},
+	{0x335B, "<compat>", []rune{0x0033, 0x70B9}, false},
+	{0x335C, "<compat>", []rune{0x0034, 0x70B9}, false},
+	{0x335D, "<compat>", []rune{0x0035, 0x70B9}, false},
+	{0x335E, "<compat>", []rune{0x0036, 0x70B9}, false},
+	{0x335F, "<compat>", []rune{0x0037, 0x70B9}, false},
+	{0x3360, "<compat>", []rune{0x0038, 0x70B9}, false},
+	{0x3361, "<compat>", []rune{0x0039, 0x70B9}, false},
+	{0x3362, "<compat>", []rune{0x0031, 0x0030, 0x70B9}, false},
+	{0x3363, "<compat>", []rune{0x0031, 0x0031, 0x70B9}, false},
+	{0x3364, "<compat>", []rune{0x0031, 0x0032, 0x70B9}, false},
+	{0x3365, "<compat>", []rune{0x0031, 0x0033, 0x70B9}, false},
+	{0x3366, "<compat>", []rune{0x0031, 0x0034, 0x70B9}, false},
+	{0x3367, "<compat>", []rune{0x0031, 0x0035, 0x70B9}, false},
+	{0x3368, "<compat>", []rune{0x0031, 0x0036, 0x70B9}, false},
+	{0x3369, "<compat>", []rune{0x0031, 0x0037, 0x70B9}, false},
+	{0x336A, "<compat>", []rune{0x0031, 0x0038, 0x70B9}, false},
+	{0x336B, "<compat>", []rune{0x0031, 0x0039, 0x70B9}, false},
+	{0x336C, "<compat>", []rune{0x0032, 0x0030, 0x70B9}, false},
+	{0x336D, "<compat>", []rune{0x0032, 0x0031, 0x70B9}, false},
+	{0x336E, "<compat>", []rune{0x0032, 0x0032, 0x70B9}, false},
+	{0x336F, "<compat>", []rune{0x0032, 0x0033, 0x70B9}, false},
+	{0x3370, "<compat>", []rune{0x0032, 0x0034, 0x70B9}, false},
+	{0x3371, "<square>", []rune{0x0068, 0x0050, 0x0061}, false},
+	{0x3372, "<square>", []rune{0x0064, 0x0061}, false},
+	{0x3373, "<square>", []rune{0x0041, 0x0055}, false},
+	{0x3374, "<square>", []rune{0x0062, 0x0061, 0x0072}, false},
+	{0x3375, "<square>", []rune{0x006F, 0x0056}, false},
+	{0x3376, "<square>", []rune{0x0070, 0x0063}, false},
+	{0x3377, "<square>", []rune{0x0064, 0x006D}, false},
+	{0x3378, "<square>", []rune{0x0064, 0x006D, 0x00B2}, false},
+	{0x3379, "<square>", []rune{0x0064, 0x006D, 0x00B3}, false},
+	{0x337A, "<square>", []rune{0x0049, 0x0055}, false},
+	{0x337B, "<square>", []rune{0x5E73, 0x6210}, false},
+	{0x337C, "<square>", []rune{0x662D, 0x548C}, false},
+	{0x337D, "<square>", []rune{0x5927, 0x6B63}, false},
+	{0x337E, "<square>", []rune{0x660E, 0x6CBB}, false},
+	{0x337F, "<square>", []rune{0x682A, 0x5F0F, 0x4F1A, 0x793E}, false},
+	{0x3380, "<square>", []rune{0x0070, 0x0041}, false},
+	{0x3381, "<square>", []rune{0x006E, 0x0041}, false},
+	{0x3382, "<square>", []rune{0x03BC, 0x0041}, false},
+	{0x3383, "<square>", []rune{0x006D, 0x0041}, false},
+	{0x3384, "<square>", []rune{0x006B, 0x0041}, false},
+	{0x3385, "<square>", []rune{0x004B, 0x0042}, false},
+	{0x3386, "<square>", []rune{0x004D, 0x0042}, false},
+	{0x3387, "<square>", []rune{0x0047, 0x0042}, false},
+	{0x3388, "<square>", []rune{0x0063, 0x0061, 0x006C}, false},
+	{0x3389, "<square>", []rune{0x006B, 0x0063, 0x0061, 0x006C}, false},
+	{0x338A, "<square>", []rune{0x0070, 0x0046}, false},
+	{0x338B, "<square>", []rune{0x006E, 0x0046}, false},
+	{0x338C, "<square>", []rune{0x03BC, 0x0046}, false},
+	{0x338D, "<square>", []rune{0x03BC, 0x0067}, false},
+	{0x338E, "<square>", []rune{0x006D, 0x0067}, false},
+	{0x338F, "<square>", []rune{0x006B, 0x0067}, false},
+	{0x3390, "<square>", []rune{0x0048, 0x007A}, false},
+	{0x3391, "<square>", []rune{0x006B, 0x0048, 0x007A}, false},
+	{0x3392, "<square>", []rune{0x004D, 0x0048, 0x007A}, false},
+	{0x3393, "<square>", []rune{0x0047, 0x0048, 0x007A}, false},
+	{0x3394, "<square>", []rune{0x0054, 0x0048, 0x007A}, false},
+	{0x3395, "<square>", []rune{0x03BC, 0x2113}, false},
+	{0x3396, "<square>", []rune{0x006D, 0x2113}, false},
+	{0x3397, "<square>", []rune{0x0064, 0x2113}, false},
+	{0x3398, "<square>", []rune{0x006B, 0x2113}, false},
+	{0x3399, "<square>", []rune{0x0066, 0x006D}, false},
+	{0x339A, "<square>", []rune{0x006E, 0x006D}, false},
+	{0x339B, "<square>", []rune{0x03BC, 0x006D}, false},
+	{0x339C, "<square>", []rune{0x006D, 0x006D}, false},
+	{0x339D, "<square>", []rune{0x0063, 0x006D}, false},
+	{0x339E, "<square>", []rune{0x006B, 0x006D}, false},
+	{0x339F, "<square>", []rune{0x006D, 0x006D, 0x00B2}, false},
+	{0x33A0, "<square>", []rune{0x0063, 0x006D, 0x00B2}, false},
+	{0x33A1, "<square>", []rune{0x006D, 0x00B2}, false},
+	{0x33A2, "<square>", []rune{0x006B, 0x006D, 0x00B2}, false},
+	{0x33A3, "<square>", []rune{0x006D, 0x006D, 0x00B3}, false},
+	{0x33A4, "<square>", []rune{0x0063, 0x006D, 0x00B3}, false},
+	{0x33A5, "<square>", []rune{0x006D, 0x00B3}, false},
+	{0x33A6, "<square>", []rune{0x006B, 0x006D, 0x00B3}, false},
+	{0x33A7, "<square>", []rune{0x006D, 0x2215, 0x0073}, false},
+	{0x33A8, "<square>", []rune{0x006D, 0x2215, 0x0073, 0x00B2}, false},
+	{0x33A9, "<square>", []rune{0x0050, 0x0061}, false},
+	{0x33AA, "<square>", []rune{0x006B, 0x0050, 0x0061}, false},
+	{0x33AB, "<square>", []rune{0x004D, 0x0050, 0x0061}, false},
+	{0x33AC, "<square>", []rune{0x0047, 0x0050, 0x0061}, false},
+	{0x33AD, "<square>", []rune{0x0072, 0x0061, 0x0064}, false},
+	{0x33AE, "<square>", []rune{0x0072, 0x0061, 0x0064, 0x2215, 0x0073}, false},
+	{0x33AF, "<square>", []rune{0x0072, 0x0061, 0x0064, 0x2215, 0x0073, 0x00B2}, false},
+	{0x33B0, "<square>", []rune{0x0070, 0x0073}, false},
+	{0x33B1, "<square>", []rune{0x006E, 0x0073}, false},
+	{0x33B2, "<square>", []rune{0x03BC, 0x0073}, false},
+	{0x33B3, "<square>", []rune{0x006D, 0x0073}, false},
+	{0x33B4, "<square>", []rune{0x0070, 0x0056}, false},
+	{0x33B5, "<square>", []rune{0x006E, 0x0056}, false},
+	{0x33B6, "<square>", []rune{0x03BC, 0x0056}, false},
+	{0x33B7, "<square>", []rune{0x006D, 0x0056}, false},
+	{0x33B8, "<square>", []rune{0x006B, 0x0056}, false},
+	{0x33B9, "<square>", []rune{0x004D, 0x0056}, false},
+	{0x33BA, "<square>", []rune{0x0070, 0x0057}, false},
+	{0x33BB, "<square>", []rune{0x006E, 0x0057}, false},
+	{0x33BC, "<square>", []rune{0x03BC, 0x0057}, false},
+	{0x33BD, "<square>", []rune{0x006D, 0x0057}, false},
+	{0x33BE, "<square>", []rune{0x006B, 0x0057}, false},
+	{0x33BF, "<square>", []rune{0x004D, 0x0057}, false},
+	{0x33C0, "<square>", []rune{0x006B, 0x03A9}, false},
+	{0x33C1, "<square>", []rune{0x004D, 0x03A9}, false},
+	{0x33C2, "<square>", []rune{0x0061, 0x002E, 0x006D, 0x002E}, false},
+	{0x33C3, "<square>", []rune{0x0042, 0x0071}, false},
+	{0x33C4, "<square>", []rune{0x0063, 0x0063}, false},
+	{0x33C5, "<square>", []rune{0x0063, 0x0064}, false},
+	{0x33C6, "<square>", []rune{0x0043, 0x2215, 0x006B, 0x0067}, false},
+	{0x33C7, "<square>", []rune{0x0043, 0x006F, 0x002E}, false},
+	{0x33C8, "<square>", []rune{0x0064, 0x0042}, false},
+	{0x33C9, "<square>", []rune{0x0047, 0x0079}, false},
+	{0x33CA, "<square>", []rune{0x0068, 0x0061}, false},
+	{0x33CB, "<square>", []rune{0x0048, 0x0050}, false},
+	{0x33CC, "<square>", []rune{0x0069, 0x006E}, false},
+	{0x33CD, "<square>", []rune{0x004B, 0x004B}, false},
+	{0x33CE, "<square>", []rune{0x004B, 0x004D}, false},
+	{0x33CF, "<square>", []rune{0x006B, 0x0074}, false},
+	{0x33D0, "<square>", []rune{0x006C, 0x006D}, false},
+	{0x33D1, "<square>", []rune{0x006C, 0x006E}, false},
+	{0x33D2, "<square>", []rune{0x006C, 0x006F, 0x0067}, false},
+	{0x33D3, "<square>", []rune{0x006C, 0x0078}, false},
+	{0x33D4, "<square>", []rune{0x006D, 0x0062}, false},
+	{0x33D5, "<square>", []rune{0x006D, 0x0069, 0x006C}, false},
+	{0x33D6, "<square>", []rune{0x006D, 0x006F, 0x006C}, false},
+	{0x33D7, "<square>", []rune{0x0050, 0x0048}, false},
+	{0x33D8, "<square>", []rune{0x0070, 0x002E, 0x006D, 0x002E}, false},
+	{0x33D9, "<square>", []rune{0x0050, 0x0050, 0x004D}, false},
+	{0x33DA, "<square>", []rune{0x0050, 0x0052}, false},
+	{0x33DB, "<square>", []rune{0x0073, 0x0072}, false},
+	{0x33DC, "<square>", []rune{0x0053, 0x0076}, false},
+	{0x33DD, "<square>", []rune{0x0057, 0x0062}, false},
+	{0x33DE, "<square>", []rune{0x0056, 0x2215, 0x006D}, false},
+	{0x33DF, "<square>", []rune{0x0041, 0x2215, 0x006D}, false},
+	{0x33E0, "<compat>", []rune{0x0031, 0x65E5}, false},
+	{0x33E1, "<compat>", []rune{0x0032, 0x65E5}, false},
+	{0x33E2, "<compat>", []rune{0x0033, 0x65E5}, false},
+	{0x33E3, "<compat>", []rune{0x0034, 0x65E5}, false},
+	{0x33E4, "<compat>", []rune{0x0035, 0x65E5}, false},
+	{0x33E5, "<compat>", []rune{0x0036, 0x65E5}, false},
+	{0x33E6, "<compat>", []rune{0x0037, 0x65E5}, false},
+	{0x33E7, "<compat>", []rune{0x0038, 0x65E5}, false},
+	{0x33E8, "<compat>", []rune{0x0039, 0x65E5}, false},
+	{0x33E9, "<compat>", []rune{0x0031, 0x0030, 0x65E5}, false},
+	{0x33EA, "<compat>", []rune{0x0031, 0x0031, 0x65E5}, false},
+	{0x33EB, "<compat>", []rune{0x0031, 0x0032, 0x65E5}, false},
+	{0x33EC, "<compat>", []rune{0x0031, 0x0033, 0x65E5}, false},
+	{0x33ED, "<compat>", []rune{0x0031, 0x0034, 0x65E5}, false},
+	{0x33EE, "<compat>", []rune{0x0031, 0x0035, 0x65E5}, false},
+	{0x33EF, "<compat>", []rune{0x0031, 0x0036, 0x65E5}, false},
+	{0x33F0, "<compat>", []rune{0x0031, 0x0037, 0x65E5}, false},
+	{0x33F1, "<compat>", []rune{0x0031, 0x0038, 0x65E5}, false},
+	{0x33F2, "<compat>", []rune{0x0031, 0x0039, 0x65E5}, false},
+	{0x33F3, "<compat>", []rune{0x0032, 0x0030, 0x65E5}, false},
+	{0x33F4, "<compat>", []rune{0x0032, 0x0031, 0x65E5}, false},
+	{0x33F5, "<compat>", []rune{0x0032, 0x0032, 0x65E5}, false},
+	{0x33F6, "<compat>", []rune{0x0032, 0x0033, 0x65E5}, false},
+	{0x33F7, "<compat>", []rune{0x0032, 0x0034, 0x65E5}, false},
+	{0x33F8, "<compat>", []rune{0x0032, 0x0035, 0x65E5}, false},
+	{0x33F9, "<compat>", []rune{0x0032, 0x0036, 0x65E5}, false},
+	{0x33FA, "<compat>", []rune{0x0032, 0x0037, 0x65E5}, false},
+	{0x33FB, "<compat>", []rune{0x0032, 0x0038, 0x65E5}, false},
+	{0x33FC, "<compat>", []rune{0x0032, 0x0039, 0x65E5}, false},
+	{0x33FD, "<compat>", []rune{0x0033, 0x0030, 0x65E5}, false},
+	{0x33FE, "<compat>", []rune{0x0033, 0x0031, 0x65E5}, false},
+	{0x33FF, "<square>", []rune{0x0067, 0x0061, 0x006C}, false},
+	{0xA69C, "<super>", []rune{0x044A}, false},
+	{0xA69D, "<super>", []rune{0x044C}, false},
+	{0xA770, "<super>", []rune{0xA76F}, false},
+	{0xA7F2, "<super>", []rune{0x0043}, false},
+	{0xA7F3, "<super>", []rune{0x0046}, false},
+	{0xA7F4, "<super>", []rune{0x0051}, false},
+	{0xA7F8, "<super>", []rune{0x0126}, false},
+	{0xA7F9, "<super>", []rune{0x0153}, false},
+	{0xAB5C, "<super>", []rune{0xA727}, false},
+	{0xAB5D, "<super>", []rune{0xAB37}, false},
+	{0xAB5E, "<super>", []rune{0x026B}, false},
+	{0xAB5F, "<super>", []rune{0xAB52}, false},
+	{0xAB69, "<super>", []rune{0x028D}, false},
+	{0xF900, "", []rune{0x8C48}, true},
+	{0xF901, "", []rune{0x66F4}, true},
+	{0xF902, "", []rune{0x8ECA}, true},
+	{0xF903, "", []rune{0x8CC8}, true},
+	{0xF904, "", []rune{0x6ED1}, true},
+	{0xF905, "", []rune{0x4E32}, true},
+	{0xF906, "", []rune{0x53E5}, true},
+	{0xF907, "", []rune{0x9F9C}, true},
+	{0xF908, "", []rune{0x9F9C}, true},
+	{0xF909, "", []rune{0x5951}, true},
+	{0xF90A, "", []rune{0x91D1}, true},
+	{0xF90B, "", []rune{0x5587}, true},
+	{0xF90C, "", []rune{0x5948}, true},
+	{0xF90D, "", []rune{0x61F6}, true},
+	{0xF90E, "", []rune{0x7669}, true},
+	{0xF90F, "", []rune{0x7F85}, true},
+	{0xF910, "", []rune{0x863F}, true},
+	{0xF911, "", []rune{0x87BA}, true},
+	{0xF912, "", []rune{0x88F8}, true},
+	{0xF913, "", []rune{0x908F}, true},
+	{0xF914, "", []rune{0x6A02}, true},
+	{0xF915, "", []rune{0x6D1B}, true},
+	{0xF916, "", []rune{0x70D9}, true},
+	{0xF917, "", []rune{0x73DE}, true},
+	{0xF918, "", []rune{0x843D}, true},
+	{0xF919, "", []rune{0x916A}, true},
+	{0xF91A, "", []rune{0x99F1}, true},
+	{0xF91B, "", []rune{0x4E82}, true},
+	{0xF91C, "", []rune{0x5375}, true},
+	{0xF91D, "", []rune{0x6B04}, true},
+	{0xF91E, "", []rune{0x721B}, true},
+	{0xF91F, "", []rune{0x862D}, true},
+	{0xF920, "", []rune{0x9E1E}, true},
+	{0xF921, "", []rune{0x5D50}, true},
+	{0xF922, "", []rune{0x6FEB}, true},
+	{0xF923, "", []rune{0x85CD}, true},
+	{0xF924, "", []rune{0x8964}, true},
+	{0xF925, "", []rune{0x62C9}, true},
+	{0xF926, "", []rune{0x81D8}, true},
+	{0xF927, "", []rune{0x881F}, true},
+	{0xF928, "", []rune{0x5ECA}, true},
+	{0xF929, "", []rune{0x6717}, true},
+	{0xF92A, "", []rune{0x6D6A}, true},
+	{0xF92B, "", []rune{0x72FC}, true},
+	{0xF92C, "", []rune{0x90CE}, true},
+	{0xF92D, "", []rune{0x4F86}, true},
+	{0xF92E, "", []rune{0x51B7}, true},
+	{0xF92F, "", []rune{0x52DE}, true},
+	{0xF930, "", []rune{0x64C4}, true},
+	{0xF931, "", []rune{0x6AD3}, true},
+	{0xF932, "", []rune{0x7210}, true},
+	{0xF933, "", []rune{0x76E7}, true},
+	{0xF934, "", []rune{0x8001}, true},
+	{0xF935, "", []rune{0x8606}, true},
+	{0xF936, "", []rune{0x865C}, true},
+	{0xF937, "", []rune{0x8DEF}, true},
+	{0xF938, "", []rune{0x9732}, true},
+	{0xF939, "", []rune{0x9B6F}, true},
+	{0xF93A, "", []rune{0x9DFA}, true},
+	{0xF93B, "", []rune{0x788C}, true},
+	{0xF93C, "", []rune{0x797F}, true},
+	{0xF93D, "", []rune{0x7DA0}, true},
+	{0xF93E, "", []rune{0x83C9}, true},
+	{0xF93F, "", []rune{0x9304}, true},
+	{0xF940, "", []rune{0x9E7F}, true},
+	{0xF941, "", []rune{0x8AD6}, true},
+	{0xF942, "", []rune{0x58DF}, true},
+	{0xF943, "", []rune{0x5F04}, true},
+	{0xF944, "", []rune{0x7C60}, true},
+	{0xF945, "", []rune{0x807E}, true},
+	{0xF946, "", []rune{0x7262}, true},
+	{0xF947, "", []rune{0x78CA}, true},
+	{0xF948, "", []rune{0x8CC2}, true},
+	{0xF949, "", []rune{0x96F7}, true},
+	{0xF94A, "", []rune{0x58D8}, true},
+	{0xF94B, "", []rune{0x5C62}, true},
+	{0xF94C, "", []rune{0x6A13}, true},
+	{0xF94D, "", []rune{0x6DDA}, true},
+	{0xF94E, "", []rune{0x6F0F}, true},
+	{0xF94F, "", []rune{0x7D2F}, true},
+	{0xF950, "", []rune{0x7E37}, true},
+	{0xF951, "", []rune{0x964B}, true},
+	{0xF952, "", []rune{0x52D2}, true},
+	{0xF953, "", []rune{0x808B}, true},
+	{0xF954, "", []rune{0x51DC}, true},
+	{0xF955, "", []rune{0x51CC}, true},
+	{0xF956, "", []rune{0x7A1C}, true},
+	{0xF957, "", []rune{0x7DBE}, true},
+	{0xF958, "", []rune{0x83F1}, true},
+	{0xF959, "", []rune{0x9675}, true},
+	{0xF95A, "", []rune{0x8B80}, true},
+	{0xF95B, "", []rune{0x62CF}, true},
+	{0xF95C, "", []rune{0x6A02}, true},
+	{0xF95D, "", []rune{0x8AFE}, true},
+	{0xF95E, "", []rune{0x4E39}, true},
+	{0xF95F, "", []rune{0x5BE7}, true},
+	{0xF960, "", []rune{0x6012}, true},
+	{0xF961, "", []rune{0x7387}, true},
+	{0xF962, "", []rune{0x7570}, true},
+	{0xF963, "", []rune{0x5317}, true},
+	{0xF964, "", []rune{0x78FB}, true},
+	{0xF965, "", []rune{0x4FBF}, true},
+	{0xF966, "", []rune{0x5FA9}, true},
+	{0xF967, "", []rune{0x4E0D}, true},
+	{0xF968, "", []rune{0x6CCC}, true},
+	{0xF969, "", []rune{0x6578}, true},
+	{0xF96A, "", []rune{0x7D22}, true},
+	{0xF96B, "", []rune{0x53C3}, true},
+	{0xF96C, "", []rune{0x585E}, true},
+	{0xF96D, "", []rune{0x7701}, true},
+	{0xF96E, "", []rune{0x8449}, true},
+	{0xF96F, "", []rune{0x8AAA}, true},
+	{0xF970, "", []rune{0x6BBA}, true},
+	{0xF971, "", []rune{0x8FB0}, true},
+	{0xF972, "", []rune{0x6C88}, true},
+	{0xF973, "", []rune{0x62FE}, true},
+	{0xF974, "", []rune{0x82E5}, true},
+	{0xF975, "", []rune{0x63A0}, true},
+	{0xF976, "", []rune{0x7565}, true},
+	{0xF977, "", []rune{0x4EAE}, true},
+	{0xF978, "", []rune{0x5169}, true},
+	{0xF979, "", []rune{0x51C9}, true},
+	{0xF97A, "", []rune{0x6881}, true},
+	{0xF97B, "", []rune{0x7CE7}, true},
+	{0xF97C, "", []rune{0x826F}, true},
+	{0xF97D, "", []rune{0x8AD2}, true},
+	{0xF97E, "", []rune{0x91CF}, true},
+	{0xF97F, "", []rune{0x52F5}, true},
+	{0xF980, "", []rune{0x5442}, true},
+	{0xF981, "", []rune{0x5973}, true},
+	{0xF982, "", []rune{0x5EEC}, true},
+	{0xF983, "", []rune{0x65C5}, true},
+	{0xF984, "", []rune{0x6FFE}, true},
+	{0xF985, "", []rune{0x792A}, true},
+	{0xF986, "", []rune{0x95AD}, true},
+	{0xF987, "", []rune{0x9A6A}, true},
+	{0xF988, "", []rune{0x9E97}, true},
+	{0xF989, "", []rune{0x9ECE}, true},
+	{0xF98A, "", []rune{0x529B}, true},
+	{0xF98B, "", []rune{0x66C6}, true},
+	{0xF98C, "", []rune{0x6B77}, true},
+	{0xF98D, "", []rune{0x8F62}, true},
+	{0xF98E, "", []rune{0x5E74}, true},
+	{0xF98F, "", []rune{0x6190}, true},
+	{0xF990, "", []rune{0x6200}, true},
+	{0xF991, "", []rune{0x649A}, true},
+	{0xF992, "", []rune{0x6F23}, true},
+	{0xF993, "", []rune{0x7149}, true},
+	{0xF994, "", []rune{0x7489}, true},
+	{0xF995, "", []rune{0x79CA}, true},
+	{0xF996, "", []rune{0x7DF4}, true},
+	{0xF997, "", []rune{0x806F}, true},
+	{0xF998, "", []rune{0x8F26}, true},
+	{0xF999, "", []rune{0x84EE}, true},
+	{0xF99A, "", []rune{0x9023}, true},
+	{0xF99B, "", []rune{0x934A}, true},
+	{0xF99C, "", []rune{0x5217}, true},
+	{0xF99D, "", []rune{0x52A3}, true},
+	{0xF99E, "", []rune{0x54BD}, true},
+	{0xF99F, "", []rune{0x70C8}, true},
+	{0xF9A0, "", []rune{0x88C2}, true},
+	{0xF9A1, "", []rune{0x8AAA}, true},
+	{0xF9A2, "", []rune{0x5EC9}, true},
+	{0xF9A3, "", []rune{0x5FF5}, true},
+	{0xF9A4, "", []rune{0x637B}, true},
+	{0xF9A5, "", []rune{0x6BAE}, true},
+	{0xF9A6, "", []rune{0x7C3E}, true},
+	{0xF9A7, "", []rune{0x7375}, true},
+	{0xF9A8, "", []rune{0x4EE4}, true},
+	{0xF9A9, "", []rune{0x56F9}, true},
+	{0xF9AA, "", []rune{0x5BE7}, true},
+	{0xF9AB, "", []rune{0x5DBA}, true},
+	{0xF9AC, "", []rune{0x601C}, true},
+	{0xF9AD, "", []rune{0x73B2}, true},
+	{0xF9AE, "", []rune{0x7469}, true},
+	{0xF9AF, "", []rune{0x7F9A}, true},
+	{0xF9B0, "", []rune{0x8046}, true},
+	{0xF9B1, "", []rune{0x9234}, true},
+	{0xF9B2, "", []rune{0x96F6}, true},
+	{0xF9B3, "", []rune{0x9748}, true},
+	{0xF9B4, "", []rune{0x9818}, true},
+	{0xF9B5, "", []rune{0x4F8B}, true},
+	{0xF9B6, "", []rune{0x79AE}, true},
+	{0xF9B7, "", []rune{0x91B4}, true},
+	{0xF9B8, "", []rune{0x96B8}, true},
+	{0xF9B9, "", []rune{0x60E1}, true},
+	{0xF9BA, "", []rune{0x4E86}, true},
+	{0xF9BB, "", []rune{0x50DA}, true},
+	{0xF9BC, "", []rune{0x5BEE}, true},
+	{0xF9BD, "", []rune{0x5C3F}, true},
+	{0xF9BE, "", []rune{0x6599}, true},
+	{0xF9BF, "", []rune{0x6A02}, true},
+	{0xF9C0, "", []rune{0x71CE}, true},
+	{0xF9C1, "", []rune{0x7642}, true},
+	{0xF9C2, "", []rune{0x84FC}, true},
+	{0xF9C3, "", []rune{0x907C}, true},
+	{0xF9C4, "", []rune{0x9F8D}, true},
+	{0xF9C5, "", []rune{0x6688}, true},
+	{0xF9C6, "", []rune{0x962E}, true},
+	{0xF9C7, "", []rune{0x5289}, true},
+	{0xF9C8, "", []rune{0x677B}, true},
+	{0xF9C9, "", []rune{0x67F3}, true},
+	{0xF9CA, "", []rune{0x6D41}, true},
+	{0xF9CB, "", []rune{0x6E9C}, true},
+	{0xF9CC, "", []rune{0x7409}, true},
+	{0xF9CD, "", []rune{0x7559}, true},
+	{0xF9CE, "", []rune{0x786B}, true},
+	{0xF9CF, "", []rune{0x7D10}, true},
+	{0xF9D0, "", []rune{0x985E}, true},
+	{0xF9D1, "", []rune{0x516D}, true},
+	{0xF9D2, "", []rune{0x622E}, true},
+	{0xF9D3, "", []rune{0x9678}, true},
+	{0xF9D4, "", []rune{0x502B}, true},
+	{0xF9D5, "", []rune{0x5D19}, true},
+	{0xF9D6, "", []rune{0x6DEA}, true},
+	{0xF9D7, "", []rune{0x8F2A}, true},
+	{0xF9D8, "", []rune{0x5F8B}, true},
+	{0xF9D9, "", []rune{0x6144}, true},
+	{0xF9DA, "", []rune{0x6817}, true},
+	{0xF9DB, "", []rune{0x7387}, true},
+	{0xF9DC, "", []rune{0x9686}, true},
+	{0xF9DD, "", []rune{0x5229}, true},
+	{0xF9DE, "", []rune{0x540F}, true},
+	{0xF9DF, "", []rune{0x5C65}, true},
+	{0xF9E0, "", []rune{0x6613}, true},
+	{0xF9E1, "", []rune{0x674E}, true},
+	{0xF9E2, "", []rune{0x68A8}, true},
+	{0xF9E3, "", []rune{0x6CE5}, true},
+	{0xF9E4, "", []rune{0x7406}, true},
+	{0xF9E5, "", []rune{0x75E2}, true},
+	{0xF9E6, "", []rune{0x7F79}, true},
+	{0xF9E7, "", []rune{0x88CF}, true},
+	{0xF9E8, "", []rune{0x88E1}, true},
+	{0xF9E9, "", []rune{0x91CC}, true},
+	{0xF9EA, "", []rune{0x96E2}, true},
+	{0xF9EB, "", []rune{0x533F}, true},
+	{0xF9EC, "", []rune{0x6EBA}, true},
+	{0xF9ED, "", []rune{0x541D}, true},
+	{0xF9EE, "", []rune{0x71D0}, true},
+	{0xF9EF, "", []rune{0x7498}, true},
+	{0xF9F0, "", []rune{0x85FA}, true},
+	{0xF9F1, "", []rune{0x96A3}, true},
+	{0xF9F2, "", []rune{0x9C57}, true},
+	{0xF9F3, "", []rune{0x9E9F}, true},
+	{0xF9F4, "", []rune{0x6797}, true},
+	{0xF9F5, "", []rune{0x6DCB}, true},
+	{0xF9F6, "", []rune{0x81E8}, true},
+	{0xF9F7, "", []rune{0x7ACB}, true},
+	{0xF9F8, "", []rune{0x7B20}, true},
+	{0xF9F9, "", []rune{0x7C92}, true},
+	{0xF9FA, "", []rune{0x72C0}, true},
+	{0xF9FB, "", []rune{0x7099}, true},
+	{0xF9FC, "", []rune{0x8B58}, true},
+	{0xF9FD, "", []rune{0x4EC0}, true},
+	{0xF9FE, "", []rune{0x8336}, true},
+	{0xF9FF, "", []rune{0x523A}, true},
+	{0xFA00, "", []rune{0x5207}, true},
+	{0xFA01, "", []rune{0x5EA6}, true},
+	{0xFA02, "", []rune{0x62D3}, true},
+	{0xFA03, "", []rune{0x7CD6}, true},
+	{0xFA04, "", []rune{0x5B85}, true},
+	{0xFA05, "", []rune{0x6D1E}, true},
+	{0xFA06, "", []rune{0x66B4}, true},
+	{0xFA07, "", []rune{0x8F3B}, true},
+	{0xFA08, "", []rune{0x884C}, true},
+	{0xFA09, "", []rune{0x964D}, true},
+	{0xFA0A, "", []rune{0x898B}, true},
+	{0xFA0B, "", []rune{0x5ED3}, true},
+	{0xFA0C, "", []rune{0x5140}, true},
+	{0xFA0D, "", []rune{0x55C0}, true},
+	{0xFA10, "", []rune{0x585A}, true},
+	{0xFA12, "", []rune{0x6674}, true},
+	{0xFA15, "", []rune{0x51DE}, true},
+	{0xFA16, "", []rune{0x732A}, true},
+	{0xFA17, "", []rune{0x76CA}, true},
+	{0xFA18, "", []rune{0x793C}, true},
+	{0xFA19, "", []rune{0x795E}, true},
+	{0xFA1A, "", []rune{0x7965}, true},
+	{0xFA1B, "", []rune{0x798F}, true},
+	{0xFA1C, "", []rune{0x9756}, true},
+	{0xFA1D, "", []rune{0x7CBE}, true},
+	{0xFA1E, "", []rune{0x7FBD}, true},
+	{0xFA20, "", []rune{0x8612}, true},
+	{0xFA22, "", []rune{0x8AF8}, true},
+	{0xFA25, "", []rune{0x9038}, true},
+	{0xFA26, "", []rune{0x90FD}, true},
+	{0xFA2A, "", []rune{0x98EF}, true},
+	{0xFA2B, "", []rune{0x98FC}, true},
+	{0xFA2C, "", []rune{0x9928}, true},
+	{0xFA2D, "", []rune{0x9DB4}, true},
+	{0xFA2E, "", []rune{0x90DE}, true},
+	{0xFA2F, "", []rune{0x96B7}, true},
+	{0xFA30, "", []rune{0x4FAE}, true},
+	{0xFA31, "", []rune{0x50E7}, true},
+	{0xFA32, "", []rune{0x514D}, true},
+	{0xFA33, "", []rune{0x52C9}, true},
+	{0xFA34, "", []rune{0x52E4}, true},
+	{0xFA35, "", []rune{0x5351}, true},
+	{0xFA36, "", []rune{0x559D}, true},
+	{0xFA37, "", []rune{0x5606}, true},
+	{0xFA38, "", []rune{0x5668}, true},
+	{0xFA39, "", []rune{0x5840}, true},
+	{0xFA3A, "", []rune{0x58A8}, true},
+	{0xFA3B, "", []rune{0x5C64}, true},
+	{0xFA3C, "", []rune{0x5C6E}, true},
+	{0xFA3D, "", []rune{0x6094}, true},
+	{0xFA3E, "", []rune{0x6168}, true},
+	{0xFA3F, "", []rune{0x618E}, true},
+	{0xFA40, "", []rune{0x61F2}, true},
+	{0xFA41, "", []rune{0x654F}, true},
+	{0xFA42, "", []rune{0x65E2}, true},
+	{0xFA43, "", []rune{0x6691}, true},
+	{0xFA44, "", []rune{0x6885}, true},
+	{0xFA45, "", []rune{0x6D77}, true},
+	{0xFA46, "", []rune{0x6E1A}, true},
+	{0xFA47, "", []rune{0x6F22}, true},
+	{0xFA48, "", []rune{0x716E}, true},
+	{0xFA49, "", []rune{0x722B}, true},
+	{0xFA4A, "", []rune{0x7422}, true},
+	{0xFA4B, "", []rune{0x7891}, true},
+	{0xFA4C, "", []rune{0x793E}, true},
+	{0xFA4D, "", []rune{0x7949}, true},
+	{0xFA4E, "", []rune{0x7948}, true},
+	{0xFA4F, "", []rune{0x7950}, true},
+	{0xFA50, "", []rune{0x7956}, true},
+	{0xFA51, "", []rune{0x795D}, true},
+	{0xFA52, "", []rune{0x798D}, true},
+	{0xFA53, "", []rune{0x798E}, true},
+	{0xFA54, "", []rune{0x7A40}, true},
+	{0xFA55, "", []rune{0x7A81}, true},
+	{0xFA56, "", []rune{0x7BC0}, true},
+	{0xFA57, "", []rune{0x7DF4}, true},
+	{0xFA58, "", []rune{0x7E09}, true},
+	{0xFA59, "", []rune{0x7E41}, true},
+	{0xFA5A, "", []rune{0x7F72}, true},
+	{0xFA5B, "", []rune{0x8005}, true},
+	{0xFA5C, "", []rune{0x81ED}, true},
+	{0xFA5D, "", []rune{0x8279}, true},
+	{0xFA5E, "", []rune{0x8279}, true},
+	{0xFA5F, "", []rune{0x8457}, true},
+	{0xFA60, "", []rune{0x8910}, true},
+	{0xFA61, "", []rune{0x8996}, true},
+	{0xFA62, "", []rune{0x8B01}, true},
+	{0xFA63, "", []rune{0x8B39}, true},
+	{0xFA64, "", []rune{0x8CD3}, true},
+	{0xFA65, "", []rune{0x8D08}, true},
+	{0xFA66, "", []rune{0x8FB6}, true},
+	{0xFA67, "", []rune{0x9038}, true},
+	{0xFA68, "", []rune{0x96E3}, true},
+	{0xFA69, "", []rune{0x97FF}, true},
+	{0xFA6A, "", []rune{0x983B}, true},
+	{0xFA6B, "", []rune{0x6075}, true},
+	{0xFA6C, "", []rune{0x242EE}, true},
+	{0xFA6D, "", []rune{0x8218}, true},
+	{0xFA70, "", []rune{0x4E26}, true},
+	{0xFA71, "", []rune{0x51B5}, true},
+	{0xFA72, "", []rune{0x5168}, true},
+	{0xFA73, "", []rune{0x4F80}, true},
+	{0xFA74, "", []rune{0x5145}, true},
+	{0xFA75, "", []rune{0x5180}, true},
+	{0xFA76, "", []rune{0x52C7}, true},
+	{0xFA77, "", []rune{0x52FA}, true},
+	{0xFA78, "", []rune{0x559D}, true},
+	{0xFA79, "", []rune{0x5555}, true},
+	{0xFA7A, "", []rune{0x5599}, true},
+	{0xFA7B, "", []rune{0x55E2}, true},
+	{0xFA7C, "", []rune{0x585A}, true},
+	{0xFA7D, "", []rune{0x58B3}, true},
+	{0xFA7E, "", []rune{0x5944}, true},
+	{0xFA7F, "", []rune{0x5954}, true},
+	{0xFA80, "", []rune{0x5A62}, true},
+	{0xFA81, "", []rune{0x5B28}, true},
+	{0xFA82, "", []rune{0x5ED2}, true},
+	{0xFA83, "", []rune{0x5ED9}, true},
+	{0xFA84, "", []rune{0x5F69}, true},
+	{0xFA85, "", []rune{0x5FAD}, true},
+	{0xFA86, "", []rune{0x60D8}, true},
+	{0xFA87, "", []rune{0x614E}, true},
+	{0xFA88, "", []rune{0x6108}, true},
+	{0xFA89, "", []rune{0x618E}, true},
+	{0xFA8A, "", []rune{0x6160}, true},
+	{0xFA8B, "", []rune{0x61F2}, true},
+	{0xFA8C, "", []rune{0x6234}, true},
+	{0xFA8D, "", []rune{0x63C4}, true},
+	{0xFA8E, "", []rune{0x641C}, true},
+	{0xFA8F, "", []rune{0x6452}, true},
+	{0xFA90, "", []rune{0x6556}, true},
+	{0xFA91, "", []rune{0x6674}, true},
+	{0xFA92, "", []rune{0x6717}, true},
+	{0xFA93, "", []rune{0x671B}, true},
+	{0xFA94, "", []rune{0x6756}, true},
+	{0xFA95, "", []rune{0x6B79}, true},
+	{0xFA96, "", []rune{0x6BBA}, true},
+	{0xFA97, "", []rune{0x6D41}, true},
+	{0xFA98, "", []rune{0x6EDB}, true},
+	{0xFA99, "", []rune{0x6ECB}, true},
+	{0xFA9A, "", []rune{0x6F22}, true},
+	{0xFA9B, "", []rune{0x701E}, true},
+	{0xFA9C, "", []rune{0x716E}, true},
+	{0xFA9D, "", []rune{0x77A7}, true},
+	{0xFA9E, "", []rune{0x7235}, true},
+	{0xFA9F, "", []rune{0x72AF}, true},
+	{0xFAA0, "", []rune{0x732A}, true},
+	{0xFAA1, "", []rune{0x7471}, true},
+	{0xFAA2, "", []rune{0x7506}, true},
+	{0xFAA3, "", []rune{0x753B}, true},
+	{0xFAA4, "", []rune{0x761D}, true},
+	{0xFAA5, "", []rune{0x761F}, true},
+	{0xFAA6, "", []rune{0x76CA}, true},
+	{0xFAA7, "", []rune{0x76DB}, true},
+	{0xFAA8, "", []rune{0x76F4}, true},
+	{0xFAA9, "", []rune{0x774A}, true},
+	{0xFAAA, "", []rune{0x7740}, true},
+	{0xFAAB, "", []rune{0x78CC}, true},
+	{0xFAAC, "", []rune{0x7AB1}, true},
+	{0xFAAD, "", []rune{0x7BC0}, true},
+	{0xFAAE, "", []rune{0x7C7B}, true},
+	{0xFAAF, "", []rune{0x7D5B}, true},
+	{0xFAB0, "", []rune{0x7DF4}, true},
+	{0xFAB1, "", []rune{0x7F3E}, true},
+	{0xFAB2, "", []rune{0x8005}, true},
+	{0xFAB3, "", []rune{0x8352}, true},
+	{0xFAB4, "", []rune{0x83EF}, true},
+	{0xFAB5, "", []rune{0x8779}, true},
+	{0xFAB6, "", []rune{0x8941}, true},
+	{0xFAB7, "", []rune{0x8986}, true},
+	{0xFAB8, "", []rune{0x8996}, true},
+	{0xFAB9, "", []rune{0x8ABF}, true},
+	{0xFABA, "", []rune{0x8AF8}, true},
+	{0xFABB, "", []rune{0x8ACB}, true},
+	{0xFABC, "", []rune{0x8B01}, true},
+	{0xFABD, "", []rune{0x8AFE}, true},
+	{0xFABE, "", []rune{0x8AED}, true},
+	{0xFABF, "", []rune{0x8B39}, true},
+	{0xFAC0, "", []rune{0x8B8A}, true},
+	{0xFAC1, "", []rune{0x8D08}, true},
+	{0xFAC2, "", []rune{0x8F38}, true},
+	{0xFAC3, "", []rune{0x9072}, true},
+	{0xFAC4, "", []rune{0x9199}, true},
+	{0xFAC5, "", []rune{0x9276}, true},
+	{0xFAC6, "", []rune{0x967C}, true},
+	{0xFAC7, "", []rune{0x96E3}, true},
+	{0xFAC8, "", []rune{0x9756}, true},
+	{0xFAC9, "", []rune{0x97DB}, true},
+	{0xFACA, "", []rune{0x97FF}, true},
+	{0xFACB, "", []rune{0x980B}, true},
+	{0xFACC, "", []rune{0x983B}, true},
+	{0xFACD, "", []rune{0x9B12}, true},
+	{0xFACE, "", []rune{0x9F9C}, true},
+	{0xFACF, "", []rune{0x2284A}, true},
+	{0xFAD0, "", []rune{0x22844}, true},
+	{0xFAD1, "", []rune{0x233D5}, true},
+	{0xFAD2, "", []rune{0x3B9D}, true},
+	{0xFAD3, "", []rune{0x4018}, true},
+	{0xFAD4, "", []rune{0x4039}, true},
+	{0xFAD5, "", []rune{0x25249}, true},
+	{0xFAD6, "", []rune{0x25CD0}, true},
+	{0xFAD7, "", []rune{0x27ED3}, true},
+	{0xFAD8, "", []rune{0x9F43}, true},
+	{0xFAD9, "", []rune{0x9F8E}, true},
+	{0xFB00, "<compat>", []rune{0x0066, 0x0066}, false},
+	{0xFB01, "<compat>", []rune{0x0066, 0x0069}, false},
+	{0xFB02, "<compat>", []rune{0x0066, 0x006C}, false},
+	{0xFB03, "<compat>", []rune{0x0066, 0x0066, 0x0069}, false},
+	{0xFB04, "<compat>", []rune{0x0066, 0x0066, 0x006C}, false},
+	{0xFB05, "<compat>", []rune{0x017F, 0x0074}, false},
+	{0xFB06, "<compat>", []rune{0x0073, 0x0074}, false},
+	{0xFB13, "<compat>", []rune{0x0574, 0x0576}, false},
+	{0xFB14, "<compat>", []rune{0x0574, 0x0565}, false},
+	{0xFB15, "<compat>", []rune{0x0574, 0x056B}, false},
+	{0xFB16, "<compat>", []rune{0x057E, 0x0576}, false},
+	{0xFB17, "<compat>", []rune{0x0574, 0x056D}, false},
+	{0xFB1D, "", []rune{0x05D9, 0x05B4}, true},
+	{0xFB1F, "", []rune{0x05F2, 0x05B7}, true},
+	{0xFB20, "<font>", []rune{0x05E2}, false},
+	{0xFB21, "<font>", []rune{0x05D0}, false},
+	{0xFB22, "<font>", []rune{0x05D3}, false},
+	{0xFB23, "<font>", []rune{0x05D4}, false},
+	{0xFB24, "<font>", []rune{0x05DB}, false},
+	{0xFB25, "<font>", []rune{0x05DC}, false},
+	{0xFB26, "<font>", []rune{0x05DD}, false},
+	{0xFB27, "<font>", []rune{0x05E8}, false},
+	{0xFB28, "<font>", []rune{0x05EA}, false},
+	{0xFB29, "<font>", []rune{0x002B}, false},
+	{0xFB2A, "", []rune{0x05E9, 0x05C1}, true},
+	{0xFB2B, "", []rune{0x05E9, 0x05C2}, true},
+	{0xFB2C, "", []rune{0xFB49, 0x05C1}, true},
+	{0xFB2D, "", []rune{0xFB49, 0x05C2}, true},
+	{0xFB2E, "", []rune{0x05D0, 0x05B7}, true},
+	{0xFB2F, "", []rune{0x05D0, 0x05B8}, true},
+	{0xFB30, "", []rune{0x05D0, 0x05BC}, true},
+	{0xFB31, "", []rune{0x05D1, 0x05BC}, true},
+	{0xFB32, "", []rune{0x05D2, 0x05BC}, true},
+	{0xFB33, "", []rune{0x05D3, 0x05BC}, true},
+	{0xFB34, "", []rune{0x05D4, 0x05BC}, true},
+	{0xFB35, "", []rune{0x05D5, 0x05BC}, true},
+	{0xFB36, "", []rune{0x05D6, 0x05BC}, true},
+	{0xFB38, "", []rune{0x05D8, 0x05BC}, true},
+	{0xFB39, "", []rune{0x05D9, 0x05BC}, true},
+	{0xFB3A, "", []rune{0x05DA, 0x05BC}, true},
+	{0xFB3B, "", []rune{0x05DB, 0x05BC}, true},
+	{0xFB3C, "", []rune{0x05DC, 0x05BC}, true},
+	{0xFB3E, "", []rune{0x05DE, 0x05BC}, true},
+	{0xFB40, "", []rune{0x05E0, 0x05BC}, true},
+	{0xFB41, "", []rune{0x05E1, 0x05BC}, true},
+	{0xFB43, "", []rune{0x05E3, 0x05BC}, true},
+	{0xFB44, "", []rune{0x05E4, 0x05BC}, true},
+	{0xFB46, "", []rune{0x05E6, 0x05BC}, true},
+	{0xFB47, "", []rune{0x05E7, 0x05BC}, true},
+	{0xFB48, "", []rune{0x05E8, 0x05BC}, true},
+	{0xFB49, "", []rune{0x05E9, 0x05BC}, true},
+	{0xFB4A, "", []rune{0x05EA, 0x05BC}, true},
+	{0xFB4B, "", []rune{0x05D5, 0x05B9}, true},
+	{0xFB4C, "", []rune{0x05D1, 0x05BF}, true},
+	{0xFB4D, "", []rune{0x05DB, 0x05BF}, true},
+	{0xFB4E, "", []rune{0x05E4, 0x05BF}, true},
+	{0xFB4F, "<compat>", []rune{0x05D0, 0x05DC}, false},
+	{0xFB50, "<isolated>", []rune{0x0671}, false},
+	{0xFB51, "<final>", []rune{0x0671}, false},
+	{0xFB52, "<isolated>", []rune{0x067B}, false},
+	{0xFB53, "<final>", []rune{0x067B}, false},
+	{0xFB54, "<initial>", []rune{0x067B}, false},
+	{0xFB55, "<medial>", []rune{0x067B}, false},
+	{0xFB56, "<isolated>", []rune{0x067E}, false},
+	{0xFB57, "<final>", []rune{0x067E}, false},
+	{0xFB58, "<initial>", []rune{0x067E}, false},
+	{0xFB59, "<medial>", []rune{0x067E}, false},
+	{0xFB5A, "<isolated>", []rune{0x0680}, false},
+	{0xFB5B, "<final>", []rune{0x0680}, false},
+	{0xFB5C, "<initial>", []rune{0x0680}, false},
+	{0xFB5D, "<medial>", []rune{0x0680}, false},
+	{0xFB5E, "<isolated>", []rune{0x067A}, false},
+	{0xFB5F, "<final>", []rune{0x067A}, false},
+	{0xFB60, "<initial>", []rune{0x067A}, false},
+	{0xFB61, "<medial>", []rune{0x067A}, false},
+	{0xFB62, "<isolated>", []rune{0x067F}, false},
+	{0xFB63, "<final>", []rune{0x067F}, false},
+	{0xFB64, "<initial>", []rune{0x067F}, false},
+	{0xFB65, "<medial>", []rune{0x067F}, false},
+	{0xFB66, "<isolated>", []rune{0x0679}, false},
+	{0xFB67, "<final>", []rune{0x0679}, false},
+	{0xFB68, "<initial>", []rune{0x0679}, false},
+	{0xFB69, "<medial>", []rune{0x0679}, false},
+	{0xFB6A, "<isolated>", []rune{0x06A4}, false},
+	{0xFB6B, "<final>", []rune{0x06A4}, false},
+	{0xFB6C, "<initial>", []rune{0x06A4}, false},
+	{0xFB6D, "<medial>", []rune{0x06A4}, false},
+	{0xFB6E, "<isolated>", []rune{0x06A6}, false},
+	{0xFB6F, "<final>", []rune{0x06A6}, false},
+	{0xFB70, "<initial>", []rune{0x06A6}, false},
+	{0xFB71, "<medial>", []rune{0x06A6}, false},
+	{0xFB72, "<isolated>", []rune{0x0684}, false},
+	{0xFB73, "<final>", []rune{0x0684}, false},
+	{0xFB74, "<initial>", []rune{0x0684}, false},
+	{0xFB75, "<medial>", []rune{0x0684}, false},
+	{0xFB76, "<isolated>", []rune{0x0683}, false},
+	{0xFB77, "<final>", []rune{0x0683}, false},
+	{0xFB78, "<initial>", []rune{0x0683}, false},
+	{0xFB79, "<medial>", []rune{0x0683}, false},
+	{0xFB7A, "<isolated>", []rune{0x0686}, false},
+	{0xFB7B, "<final>", []rune{0x0686}, false},
+	{0xFB7C, "<initial>", []rune{0x0686}, false},
+	{0xFB7D, "<medial>", []rune{0x0686}, false},
+	{0xFB7E, "<isolated>", []rune{0x0687}, false},
+	{0xFB7F, "<final>", []rune{0x0687}, false},
+	{0xFB80, "<initial>", []rune{0x0687}, false},
+	{0xFB81, "<medial>", []rune{0x0687}, false},
+	{0xFB82, "<isolated>", []rune{0x068D}, false},
+	{0xFB83, "<final>", []rune{0x068D}, false},
+	{0xFB84, "<isolated>", []rune{0x068C}, false},
+	{0xFB85, "<final>", []rune{0x068C}, false},
+	{0xFB86, "<isolated>", []rune{0x068E}, false},
+	{0xFB87, "<final>", []rune{0x068E}, false},
+	{0xFB88, "<isolated>", []rune{0x0688}, false},
+	{0xFB89, "<final>", []rune{0x0688}, false},
+	{0xFB8A, "<isolated>", []rune{0x0698}, false},
+	{0xFB8B, "<final>", []rune{0x0698}, false},
+	{0xFB8C, "<isolated>", []rune{0x0691}, false},
+	{0xFB8D, "<final>", []rune{0x0691}, false},
+	{0xFB8E, "<isolated>", []rune{0x06A9}, false},
+	{0xFB8F, "<final>", []rune{0x06A9}, false},
+	{0xFB90, "<initial>", []rune{0x06A9}, false},
+	{0xFB91, "<medial>", []rune{0x06A9}, false},
+	{0xFB92, "<isolated>", []rune{0x06AF}, false},
+	{0xFB93, "<final>", []rune{0x06AF}, false},
+	{0xFB94, "<initial>", []rune{0x06AF}, false},
+	{0xFB95, "<medial>", []rune{0x06AF}, false},
+	{0xFB96, "<isolated>", []rune{0x06B3}, false},
+	{0xFB97, "<final>", []rune{0x06B3}, false},
+	{0xFB98, "<initial>", []rune{0x06B3}, false},
+	{0xFB99, "<medial>", []rune{0x06B3}, false},
+	{0xFB9A, "<isolated>", []rune{0x06B1}, false},
+	{0xFB9B, "<final>", []rune{0x06B1}, false},
+	{0xFB9C, "<initial>", []rune{0x06B1}, false},
+	{0xFB9D, "<medial>", []rune{0x06B1}, false},
+	{0xFB9E, "<isolated>", []rune{0x06BA}, false},
+	{0xFB9F, "<final>", []rune{0x06BA}, false},
+	{0xFBA0, "<isolated>", []rune{0x06BB}, false},
+	{0xFBA1, "<final>", []rune{0x06BB}, false},
+	{0xFBA2, "<initial>", []rune{0x06BB}, false},
+	{0xFBA3, "<medial>", []rune{0x06BB}, false},
+	{0xFBA4, "<isolated>", []rune{0x06C0}, false},
+	{0xFBA5, "<final>", []rune{0x06C0}, false},
+	{0xFBA6, "<isolated>", []rune{0x06C1}, false},
+	{0xFBA7, "<final>", []rune{0x06C1}, false},
+	{0xFBA8, "<initial>", []rune{0x06C1}, false},
+	{0xFBA9, "<medial>", []rune{0x06C1}, false},
+	{0xFBAA, "<isolated>", []rune{0x06BE}, false},
+	{0xFBAB, "<final>", []rune{0x06BE}, false},
+	{0xFBAC, "<initial>", []rune{0x06BE}, false},
+	{0xFBAD, "<medial>", []rune{0x06BE}, false},
+	{0xFBAE, "<isolated>", []rune{0x06D2}, false},
+	{0xFBAF, "<final>", []rune{0x06D2}, false},
+	{0xFBB0, "<isolated>", []rune{0x06D3}, false},
+	{0xFBB1, "<final>", []rune{0x06D3}, false},
+	{0xFBD3, "<isolated>", []rune{0x06AD}, false},
+	{0xFBD4, "<final>", []rune{0x06AD}, false},
+	{0xFBD5, "<initial>", []rune{0x06AD}, false},
+	{0xFBD6, "<medial>", []rune{0x06AD}, false},
+	{0xFBD7, "<isolated>", []rune{0x06C7}, false},
+	{0xFBD8, "<final>", []rune{0x06C7}, false},
+	{0xFBD9, "<isolated>", []rune{0x06C6}, false},
+	{0xFBDA, "<final>", []rune{0x06C6}, false},
+	{0xFBDB, "<isolated>", []rune{0x06C8}, false},
+	{0xFBDC, "<final>", []rune{0x06C8}, false},
+	{0xFBDD, "<isolated>", []rune{0x0677}, false},
+	{0xFBDE, "<isolated>", []rune{0x06CB}, false},
+	{0xFBDF, "<final>", []rune{0x06CB}, false},
+	{0xFBE0, "<isolated>", []rune{0x06C5}, false},
+	{0xFBE1, "<final>", []rune{0x06C5}, false},
+	{0xFBE2, "<isolated>", []rune{0x06C9}, false},
+	{0xFBE3, "<final>", []rune{0x06C9}, false},
+	{0xFBE4, "<isolated>", []rune{0x06D0}, false},
+	{0xFBE5, "<final>", []rune{0x06D0}, false},
+	{0xFBE6, "<initial>", []rune{0x06D0}, false},
+	{0xFBE7, "<medial>", []rune{0x06D0}, false},
+	{0xFBE8, "<initial>", []rune{0x0649}, false},
+	{0xFBE9, "<medial>", []rune{0x0649}, false},
+	{0xFBEA, "<isolated>", []rune{0x0626, 0x0627}, false},
+	{0xFBEB, "<final>", []rune{0x0626, 0x0627}, false},
+	{0xFBEC, "<isolated>", []rune{0x0626, 0x06D5}, false},
+	{0xFBED, "<final>", []rune{0x0626, 0x06D5}, false},
+	{0xFBEE, "<isolated>", []rune{0x0626, 0x0648}, false},
+	{0xFBEF, "<final>", []rune{0x0626, 0x0648}, false},
+	{0xFBF0, "<isolated>", []rune{0x0626, 0x06C7}, false},
+	{0xFBF1, "<final>", []rune{0x0626, 0x06C7}, false},
+	{0xFBF2, "<isolated>", []rune{0x0626, 0x06C6}, false},
+	{0xFBF3, "<final>", []rune{0x0626, 0x06C6}, false},
+	{0xFBF4, "<isolated>", []rune{0x0626, 0x06C8}, false},
+	{0xFBF5, "<final>", []rune{0x0626, 0x06C8}, false},
+	{0xFBF6, "<isolated>", []rune{0x0626, 0x06D0}, false},
+	{0xFBF7, "<final>", []rune{0x0626, 0x06D0}, false},
+	{0xFBF8, "<initial>", []rune{0x0626, 0x06D0}, false},
+	{0xFBF9, "<isolated>", []rune{0x0626, 0x0649}, false},
+	{0xFBFA, "<final>", []rune{0x0626, 0x0649}, false},
+	{0xFBFB, "<initial>", []rune{0x0626, 0x0649}, false},
+	{0xFBFC, "<isolated>", []rune{0x06CC}, false},
+	{0xFBFD, "<final>", []rune{0x06CC}, false},
+	{0xFBFE, "<initial>", []rune{0x06CC}, false},
+	{0xFBFF, "<medial>", []rune{0x06CC}, false},
+	{0xFC00, "<isolated>", []rune{0x0626, 0x062C}, false},
+	{0xFC01, "<isolated>", []rune{0x0626, 0x062D}, false},
+	{0xFC02, "<isolated>", []rune{0x0626, 0x0645}, false},
+	{0xFC03, "<isolated>", []rune{0x0626, 0x0649}, false},
+	{0xFC04, "<isolated>", []rune{0x0626, 0x064A}, false},
+	{0xFC05, "<isolated>", []rune{0x0628, 0x062C}, false},
+	{0xFC06, "<isolated>", []rune{0x0628, 0x062D}, false},
+	{0xFC07, "<isolated>", []rune{0x0628, 0x062E}, false},
+	{0xFC08, "<isolated>", []rune{0x0628, 0x0645}, false},
+	{0xFC09, "<isolated>", []rune{0x0628, 0x0649}, false},
+	{0xFC0A, "<isolated>", []rune{0x0628, 0x064A}, false},
+	{0xFC0B, "<isolated>", []rune{0x062A, 0x062C}, false},
+	{0xFC0C, "<isolated>", []rune{0x062A, 0x062D}, false},
+	{0xFC0D, "<isolated>", []rune{0x062A, 0x062E}, false},
+	{0xFC0E, "<isolated>", []rune{0x062A, 0x0645}, false},
+	{0xFC0F, "<isolated>", []rune{0x062A, 0x0649}, false},
+	{0xFC10, "<isolated>", []rune{0x062A, 0x064A}, false},
+	{0xFC11, "<isolated>", []rune{0x062B, 0x062C}, false},
+	{0xFC12, "<isolated>", []rune{0x062B, 0x0645}, false},
+	{0xFC13, "<isolated>", []rune{0x062B, 0x0649}, false},
+	{0xFC14, "<isolated>", []rune{0x062B, 0x064A}, false},
+	{0xFC15, "<isolated>", []rune{0x062C, 0x062D}, false},
+	{0xFC16, "<isolated>", []rune{0x062C, 0x0645}, false},
+	{0xFC17, "<isolated>", []rune{0x062D, 0x062C}, false},
+	{0xFC18, "<isolated>", []rune{0x062D, 0x0645}, false},
+	{0xFC19, "<isolated>", []rune{0x062E, 0x062C}, false},
+	{0xFC1A, "<isolated>", []rune{0x062E, 0x062D}, false},
+	{0xFC1B, "<isolated>", []rune{0x062E, 0x0645}, false},
+	{0xFC1C, "<isolated>", []rune{0x0633, 0x062C}, false},
+	{0xFC1D, "<isolated>", []rune{0x0633, 0x062D}, false},
+	{0xFC1E, "<isolated>", []rune{0x0633, 0x062E}, false},
+	{0xFC1F, "<isolated>", []rune{0x0633, 0x0645}, false},
+	{0xFC20, "<isolated>", []rune{0x0635, 0x062D}, false},
+	{0xFC21, "<isolated>", []rune{0x0635, 0x0645}, false},
+	{0xFC22, "<isolated>", []rune{0x0636, 0x062C}, false},
+	{0xFC23, "<isolated>", []rune{0x0636, 0x062D}, false},
+	{0xFC24, "<isolated>", []rune{0x0636, 0x062E}, false},
+	{0xFC25, "<isolated>", []rune{0x0636, 0x0645}, false},
+	{0xFC26, "<isolated>", []rune{0x0637, 0x062D}, false},
+	{0xFC27, "<isolated>", []rune{0x0637, 0x0645}, false},
+	{0xFC28, "<isolated>", []rune{0x0638, 0x0645}, false},
+	{0xFC29, "<isolated>", []rune{0x0639, 0x062C}, false},
+	{0xFC2A, "<isolated>", []rune{0x0639, 0x0645}, false},
+	{0xFC2B, "<isolated>", []rune{0x063A, 0x062C}, false},
+	{0xFC2C, "<isolated>", []rune{0x063A, 0x0645}, false},
+	{0xFC2D, "<isolated>", []rune{0x0641, 0x062C}, false},
+	{0xFC2E, "<isolated>", []rune{0x0641, 0x062D}, false},
+	{0xFC2F, "<isolated>", []rune{0x0641, 0x062E}, false},
+	{0xFC30, "<isolated>", []rune{0x0641, 0x0645}, false},
+	{0xFC31, "<isolated>", []rune{0x0641, 0x0649}, false},
+	{0xFC32, "<isolated>", []rune{0x0641, 0x064A}, false},
+	{0xFC33, "<isolated>", []rune{0x0642, 0x062D}, false},
+	{0xFC34, "<isolated>", []rune{0x0642, 0x0645}, false},
+	{0xFC35, "<isolated>", []rune{0x0642, 0x0649}, false},
+	{0xFC36, "<isolated>", []rune{0x0642, 0x064A}, false},
+	{0xFC37, "<isolated>", []rune{0x0643, 0x0627}, false},
+	{0xFC38, "<isolated>", []rune{0x0643, 0x062C}, false},
+	{0xFC39, "<isolated>", []rune{0x0643, 0x062D}, false},
+	{0xFC3A, "<isolated>", []rune{0x0643, 0x062E}, false},
+	{0xFC3B, "<isolated>", []rune{0x0643, 0x0644}, false},
+	{0xFC3C, "<isolated>", []rune{0x0643, 0x0645}, false},
+	{0xFC3D, "<isolated>", []rune{0x0643, 0x0649}, false},
+	{0xFC3E, "<isolated>", []rune{0x0643, 0x064A}, false},
+	{0xFC3F, "<isolated>", []rune{0x0644, 0x062C}, false},
+	{0xFC40, "<isolated>", []rune{0x0644, 0x062D}, false},
+	{0xFC41, "<isolated>", []rune{0x0644, 0x062E}, false},
+	{0xFC42, "<isolated>", []rune{0x0644, 0x0645}, false},
+	{0xFC43, "<isolated>", []rune{0x0644, 0x0649}, false},
+	{0xFC44, "<isolated>", []rune{0x0644, 0x064A}, false},
+	{0xFC45, "<isolated>", []rune{0x0645, 0x062C}, false},
+	{0xFC46, "<isolated>", []rune{0x0645, 0x062D}, false},
+	{0xFC47, "<isolated>", []rune{0x0645, 0x062E}, false},
+	{0xFC48, "<isolated>", []rune{0x0645, 0x0645}, false},
+	{0xFC49, "<isolated>", []rune{0x0645, 0x0649}, false},
+	{0xFC4A, "<isolated>", []rune{0x0645, 0x064A}, false},
+	{0xFC4B, "<isolated>", []rune{0x0646, 0x062C}, false},
+	{0xFC4C, "<isolated>", []rune{0x0646, 0x062D}, false},
+	{0xFC4D, "<isolated>", []rune{0x0646, 0x062E}, false},
+	{0xFC4E, "<isolated>", []rune{0x0646, 0x0645}, false},
+	{0xFC4F, "<isolated>", []rune{0x0646, 0x0649}, false},
+	{0xFC50, "<isolated>", []rune{0x0646, 0x064A}, false},
+	{0xFC51, "<isolated>", []rune{0x0647, 0x062C}, false},
+	{0xFC52, "<isolated>", []rune{0x0647, 0x0645}, false},
+	{0xFC53, "<isolated>", []rune{0x0647, 0x0649}, false},
+	{0xFC54, "<isolated>", []rune{0x0647, 0x064A}, false},
+	{0xFC55, "<isolated>", []rune{0x064A, 0x062C}, false},
+	{0xFC56, "<isolated>", []rune{0x064A, 0x062D}, false},
+	{0xFC57, "<isolated>", []rune{0x064A, 0x062E}, false},
+	{0xFC58, "<isolated>", []rune{0x064A, 0x0645}, false},
+	{0xFC59, "<isolated>", []rune{0x064A, 0x0649}, false},
+	{0xFC5A, "<isolated>", []rune{0x064A, 0x064A}, false},
+	{0xFC5B, "<isolated>", []rune{0x0630, 0x0670}, false},
+	{0xFC5C, "<isolated>", []rune{0x0631, 0x0670}, false},
+	{0xFC5D, "<isolated>", []rune{0x0649, 0x0670}, false},
+	{0xFC5E, "<isolated>", []rune{0x0020, 0x064C, 0x0651}, false},
+	{0xFC5F, "<isolated>", []rune{0x0020, 0x064D, 0x0651}, false},
+	{0xFC60, "<isolated>", []rune{0x0020, 0x064E, 0x0651}, false},
+	{0xFC61, "<isolated>", []rune{0x0020, 0x064F, 0x0651}, false},
+	{0xFC62, "<isolated>", []rune{0x0020, 0x0650, 0x0651}, false},
+	{0xFC63, "<isolated>", []rune{0x0020, 0x0651, 0x0670}, false},
+	{0xFC64, "<final>", []rune{0x0626, 0x0631}, false},
+	{0xFC65, "<final>", []rune{0x0626, 0x0632}, false},
+	{0xFC66, "<final>", []rune{0x0626, 0x0645}, false},
+	{0xFC67, "<final>", []rune{0x0626, 0x0646}, false},
+	{0xFC68, "<final>", []rune{0x0626, 0x0649}, false},
+	{0xFC69, "<final>", []rune{0x0626, 0x064A}, false},
+	{0xFC6A, "<final>", []rune{0x0628, 0x0631}, false},
+	{0xFC6B, "<final>", []rune{0x0628, 0x0632}, false},
+	{0xFC6C, "<final>", []rune{0x0628, 0x0645}, false},
+	{0xFC6D, "<final>", []rune{0x0628, 0x0646}, false},
+	{0xFC6E, "<final>", []rune{0x0628, 0x0649}, false},
+	{0xFC6F, "<final>", []rune{0x0628, 0x064A}, false},
+	{0xFC70, "<final>", []rune{0x062A, 0x0631}, false},
+	{0xFC71, "<final>", []rune{0x062A, 0x0632}, false},
+	{0xFC72, "<final>", []rune{0x062A, 0x0645}, false},
+	{0xFC73, "<final>", []rune{0x062A, 0x0646}, false},
+	{0xFC74, "<final>", []rune{0x062A, 0x0649}, false},
+	{0xFC75, "<final>", []rune{0x062A, 0x064A}, false},
+	{0xFC76, "<final>", []rune{0x062B, 0x0631}, false},
+	{0xFC77, "<final>", []rune{0x062B, 0x0632}, false},
+	{0xFC78, "<final>", []rune{0x062B, 0x0645}, false},
+	{0xFC79, "<final>", []rune{0x062B, 0x0646}, false},
+	{0xFC7A, "<final>", []rune{0x062B, 0x0649}, false},
+	{0xFC7B, "<final>", []rune{0x062B, 0x064A}, false},
+	{0xFC7C, "<final>", []rune{0x0641, 0x0649}, false},
+	{0xFC7D, "<final>", []rune{0x0641, 0x064A}, false},
+	{0xFC7E, "<final>", []rune{0x0642, 0x0649}, false},
+	{0xFC7F, "<final>", []rune{0x0642, 0x064A}, false},
+	{0xFC80, "<final>", []rune{0x0643, 0x0627}, false},
+	{0xFC81, "<final>", []rune{0x0643, 0x0644}, false},
+	{0xFC82, "<final>", []rune{0x0643, 0x0645}, false},
+	{0xFC83, "<final>", []rune{0x0643, 0x0649}, false},
+	{0xFC84, "<final>", []rune{0x0643, 0x064A}, false},
+	{0xFC85, "<final>", []rune{0x0644, 0x0645}, false},
+	{0xFC86, "<final>", []rune{0x0644, 0x0649}, false},
+	{0xFC87, "<final>", []rune{0x0644, 0x064A}, false},
+	{0xFC88, "<final>", []rune{0x0645, 0x0627}, false},
+	{0xFC89, "<final>", []rune{0x0645, 0x0645}, false},
+	{0xFC8A, "<final>", []rune{0x0646, 0x0631}, false},
+	{0xFC8B, "<final>", []rune{0x0646, 0x0632}, false},
+	{0xFC8C, "<final>", []rune{0x0646, 0x0645}, false},
+	{0xFC8D, "<final>", []rune{0x0646, 0x0646}, false},
+	{0xFC8E, "<final>", []rune{0x0646, 0x0649}, false},
+	{0xFC8F, "<final>", []rune{0x0646, 0x064A}, false},
+	{0xFC90, "<final>", []rune{0x0649, 0x0670}, false},
+	{0xFC91, "<final>", []rune{0x064A, 0x0631}, false},
+	{0xFC92, "<final>", []rune{0x064A, 0x0632}, false},
+	{0xFC93, "<final>", []rune{0x064A, 0x0645}, false},
+	{0xFC94, "<final>", []rune{0x064A, 0x0646}, false},
+	{0xFC95, "<final>", []rune{0x064A, 0x0649}, false},
+	{0xFC96, "<final>", []rune{0x064A, 0x064A}, false},
+	{0xFC97, "<initial>", []rune{0x0626, 0x062C}, false},
+	{0xFC98, "<initial>", []rune{0x0626, 0x062D}, false},
+	{0xFC99, "<initial>", []rune{0x0626, 0x062E}, false},
+	{0xFC9A, "<initial>", []rune{0x0626, 0x0645}, false},
+	{0xFC9B, "<initial>", []rune{0x0626, 0x0647}, false},
+	{0xFC9C, "<initial>", []rune{0x0628, 0x062C}, false},
+	{0xFC9D, "<initial>", []rune{0x0628, 0x062D}, false},
+	{0xFC9E, "<initial>", []rune{0x0628, 0x062E}, false},
+	{0xFC9F, "<initial>", []rune{0x0628, 0x0645}, false},
+	{0xFCA0, "<initial>", []rune{0x0628, 0x0647}, false},
+	{0xFCA1, "<initial>", []rune{0x062A, 0x062C}, false},
+	{0xFCA2, "<initial>", []rune{0x062A, 0x062D}, false},
+	{0xFCA3, "<initial>", []rune{0x062A, 0x062E}, false},
+	{0xFCA4, "<initial>", []rune{0x062A, 0x0645}, false},
+	{0xFCA5, "<initial>", []rune{0x062A, 0x0647}, false},
+	{0xFCA6, "<initial>", []rune{0x062B, 0x0645}, false},
+	{0xFCA7, "<initial>", []rune{0x062C, 0x062D}, false},
+	{0xFCA8, "<initial>", []rune{0x062C, 0x0645}, false},
+	{0xFCA9, "<initial>", []rune{0x062D, 0x062C}, false},
+	{0xFCAA, "<initial>", []rune{0x062D, 0x0645}, false},
+	{0xFCAB, "<initial>", []rune{0x062E, 0x062C}, false},
+	{0xFCAC, "<initial>", []rune{0x062E, 0x0645}, false},
+	{0xFCAD, "<initial>", []rune{0x0633, 0x062C}, false},
+	{0xFCAE, "<initial>", []rune{0x0633, 0x062D}, false},
+	{0xFCAF, "<initial>", []rune{0x0633, 0x062E}, false},
+	{0xFCB0, "<initial>", []rune{0x0633, 0x0645}, false},
+	{0xFCB1, "<initial>", []rune{0x0635, 0x062D}, false},
+	{0xFCB2, "<initial>", []rune{0x0635, 0x062E}, false},
+	{0xFCB3, "<initial>", []rune{0x0635, 0x0645}, false},
+	{0xFCB4, "<initial>", []rune{0x0636, 0x062C}, false},
+	{0xFCB5, "<initial>", []rune{0x0636, 0x062D}, false},
+	{0xFCB6, "<initial>", []rune{0x0636, 0x062E}, false},
+	{0xFCB7, "<initial>", []rune{0x0636, 0x0645}, false},
+	{0xFCB8, "<initial>", []rune{0x0637, 0x062D}, false},
+	{0xFCB9, "<initial>", []rune{0x0638, 0x0645}, false},
+	{0xFCBA, "<initial>", []rune{0x0639, 0x062C}, false},
+	{0xFCBB, "<initial>", []rune{0x0639, 0x0645}, false},
+	{0xFCBC, "<initial>", []rune{0x063A, 0x062C}, false},
+	{0xFCBD, "<initial>", []rune{0x063A, 0x0645}, false},
+	{0xFCBE, "<initial>", []rune{0x0641, 0x062C}, false},
+	{0xFCBF, "<initial>", []rune{0x0641, 0x062D}, false},
+	{0xFCC0, "<initial>", []rune{0x0641, 0x062E}, false},
+	{0xFCC1, "<initial>", []rune{0x0641, 0x0645}, false},
+	{0xFCC2, "<initial>", []rune{0x0642, 0x062D}, false},
+	{0xFCC3, "<initial>", []rune{0x0642, 0x0645}, false},
+	{0xFCC4, "<initial>", []rune{0x0643, 0x062C}, false},
+	{0xFCC5, "<initial>", []rune{0x0643, 0x062D}, false},
+	{0xFCC6, "<initial>", []rune{0x0643, 0x062E}, false},
+	{0xFCC7, "<initial>", []rune{0x0643, 0x0644}, false},
+	{0xFCC8, "<initial>", []rune{0x0643, 0x0645}, false},
+	{0xFCC9, "<initial>", []rune{0x0644, 0x062C}, false},
+	{0xFCCA, "<initial>", []rune{0x0644, 0x062D}, false},
+	{0xFCCB, "<initial>", []rune{0x0644, 0x062E}, false},
+	{0xFCCC, "<initial>", []rune{0x0644, 0x0645}, false},
+	{0xFCCD, "<initial>", []rune{0x0644, 0x0647}, false},
+	{0xFCCE, "<initial>", []rune{0x0645, 0x062C}, false},
+	{0xFCCF, "<initial>", []rune{0x0645, 0x062D}, false},
+	{0xFCD0, "<initial>", []rune{0x0645, 0x062E}, false},
+	{0xFCD1, "<initial>", []rune{0x0645, 0x0645}, false},
+	{0xFCD2, "<initial>", []rune{0x0646, 0x062C}, false},
+	{0xFCD3, "<initial>", []rune{0x0646, 0x062D}, false},
+	{0xFCD4, "<initial>", []rune{0x0646, 0x062E}, false},
+	{0xFCD5, "<initial>", []rune{0x0646, 0x0645}, false},
+	{0xFCD6, "<initial>", []rune{0x0646, 0x0647}, false},
+	{0xFCD7, "<initial>", []rune{0x0647, 0x062C}, false},
+	{0xFCD8, "<initial>", []rune{0x0647, 0x0645}, false},
+	{0xFCD9, "<initial>", []rune{0x0647, 0x0670}, false},
+	{0xFCDA, "<initial>", []rune{0x064A, 0x062C}, false},
+	{0xFCDB, "<initial>", []rune{0x064A, 0x062D}, false},
+	{0xFCDC, "<initial>", []rune{0x064A, 0x062E}, false},
+	{0xFCDD, "<initial>", []rune{0x064A, 0x0645}, false},
+	{0xFCDE, "<initial>", []rune{0x064A, 0x0647}, false},
+	{0xFCDF, "<medial>", []rune{0x0626, 0x0645}, false},
+	{0xFCE0, "<medial>", []rune{0x0626, 0x0647}, false},
+	{0xFCE1, "<medial>", []rune{0x0628, 0x0645}, false},
+	{0xFCE2, "<medial>", []rune{0x0628, 0x0647}, false},
+	{0xFCE3, "<medial>", []rune{0x062A, 0x0645}, false},
+	{0xFCE4, "<medial>", []rune{0x062A, 0x0647}, false},
+	{0xFCE5, "<medial>", []rune{0x062B, 0x0645}, false},
+	{0xFCE6, "<medial>", []rune{0x062B, 0x0647}, false},
+	{0xFCE7, "<medial>", []rune{0x0633, 0x0645}, false},
+	{0xFCE8, "<medial>", []rune{0x0633, 0x0647}, false},
+	{0xFCE9, "<medial>", []rune{0x0634, 0x0645}, false},
+	{0xFCEA, "<medial>", []rune{0x0634, 0x0647}, false},
+	{0xFCEB, "<medial>", []rune{0x0643, 0x0644}, false},
+	{0xFCEC, "<medial>", []rune{0x0643, 0x0645}, false},
+	{0xFCED, "<medial>", []rune{0x0644, 0x0645}, false},
+	{0xFCEE, "<medial>", []rune{0x0646, 0x0645}, false},
+	{0xFCEF, "<medial>", []rune{0x0646, 0x0647}, false},
+	{0xFCF0, "<medial>", []rune{0x064A, 0x0645}, false},
+	{0xFCF1, "<medial>", []rune{0x064A, 0x0647}, false},
+	{0xFCF2, "<medial>", []rune{0x0640, 0x064E, 0x0651}, false},
+	{0xFCF3, "<medial>", []rune{0x0640, 0x064F, 0x0651}, false},
+	{0xFCF4, "<medial>", []rune{0x0640, 0x0650, 0x0651}, false},
+	{0xFCF5, "<isolated>", []rune{0x0637, 0x0649}, false},
+	{0xFCF6, "<isolated>", []rune{0x0637, 0x064A}, false},
+	{0xFCF7, "<isolated>", []rune{0x0639, 0x0649}, false},
+	{0xFCF8, "<isolated>", []rune{0x0639, 0x064A}, false},
+	{0xFCF9, "<isolated>", []rune{0x063A, 0x0649}, false},
+	{0xFCFA, "<isolated>", []rune{0x063A, 0x064A}, false},
+	{0xFCFB, "<isolated>", []rune{0x0633, 0x0649}, false},
+	{0xFCFC, "<isolated>", []rune{0x0633, 0x064A}, false},
+	{0xFCFD, "<isolated>", []rune{0x0634, 0x0649}, false},
+	{0xFCFE, "<isolated>", []rune{0x0634, 0x064A}, false},
+	{0xFCFF, "<isolated>", []rune{0x062D, 0x0649}, false},
+	{0xFD00, "<isolated>", []rune{0x062D, 0x064A}, false},
+	{0xFD01, "<isolated>", []rune{0x062C, 0x0649}, false},
+	{0xFD02, "<isolated>", []rune{0x062C, 0x064A}, false},
+	{0xFD03, "<isolated>", []rune{0x062E, 0x0649}, false},
+	{0xFD04, "<isolated>", []rune{0x062E, 0x064A}, false},
+	{0xFD05, "<isolated>", []rune{0x0635, 0x0649}, false},
+	{0xFD06, "<isolated>", []rune{0x0635, 0x064A}, false},
+	{0xFD07, "<isolated>", []rune{0x0636, 0x0649}, false},
+	{0xFD08, "<isolated>", []rune{0x0636, 0x064A}, false},
+	{0xFD09, "<isolated>", []rune{0x0634, 0x062C}, false},
+	{0xFD0A, "<isolated>", []rune{0x0634, 0x062D}, false},
+	{0xFD0B, "<isolated>", []rune{0x0634, 0x062E}, false},
+	{0xFD0C, "<isolated>", []rune{0x0634, 0x0645}, false},
+	{0xFD0D, "<isolated>", []rune{0x0634, 0x0631}, false},
+	{0xFD0E, "<isolated>", []rune{0x0633, 0x0631}, false},
+	{0xFD0F, "<isolated>", []rune{0x0635, 0x0631}, false},
+	{0xFD10, "<isolated>", []rune{0x0636, 0x0631}, false},
+	{0xFD11, "<final>", []rune{0x0637, 0x0649}, false},
+	{0xFD12, "<final>", []rune{0x0637, 0x064A}, false},
+	{0xFD13, "<final>", []rune{0x0639, 0x0649}, false},
+	{0xFD14, "<final>", []rune{0x0639, 0x064A}, false},
+	{0xFD15, "<final>", []rune{0x063A, 0x0649}, false},
+	{0xFD16, "<final>", []rune{0x063A, 0x064A}, false},
+	{0xFD17, "<final>", []rune{0x0633, 0x0649}, false},
+	{0xFD18, "<final>", []rune{0x0633, 0x064A}, false},
+	{0xFD19, "<final>", []rune{0x0634, 0x0649}, false},
+	{0xFD1A, "<final>", []rune{0x0634, 0x064A}, false},
+	{0xFD1B, "<final>", []rune{0x062D, 0x0649}, false},
+	{0xFD1C, "<final>", []rune{0x062D, 0x064A}, false},
+	{0xFD1D, "<final>", []rune{0x062C, 0x0649}, false},
+	{0xFD1E, "<final>", []rune{0x062C, 0x064A}, false},
+	{0xFD1F, "<final>", []rune{0x062E, 0x0649}, false},
+	{0xFD20, "<final>", []rune{0x062E, 0x064A}, false},
+	{0xFD21, "<final>", []rune{0x0635, 0x0649}, false},
+	{0xFD22, "<final>", []rune{0x0635, 0x064A}, false},
+	{0xFD23, "<final>", []rune{0x0636, 0x0649}, false},
+	{0xFD24, "<final>", []rune{0x0636, 0x064A}, false},
+	{0xFD25, "<final>", []rune{0x0634, 0x062C}, false},
+	{0xFD26, "<final>", []rune{0x0634, 0x062D}, false},
+	{0xFD27, "<final>", []rune{0x0634, 0x062E}, false},
+	{0xFD28, "<final>", []rune{0x0634, 0x0645}, false},
+	{0xFD29, "<final>", []rune{0x0634, 0x0631}, false},
+	{0xFD2A, "<final>", []rune{0x0633, 0x0631}, false},
+	{0xFD2B, "<final>", []rune{0x0635, 0x0631}, false},
+	{0xFD2C, "<final>", []rune{0x0636, 0x0631}, false},
+	{0xFD2D, "<initial>", []rune{0x0634, 0x062C}, false},
+	{0xFD2E, "<initial>", []rune{0x0634, 0x062D}, false},
+	{0xFD2F, "<initial>", []rune{0x0634, 0x062E}, false},
+	{0xFD30, "<initial>", []rune{0x0634, 0x0645}, false},
+	{0xFD31, "<initial>", []rune{0x0633, 0x0647}, false},
+	{0xFD32, "<initial>", []rune{0x0634, 0x0647}, false},
+	{0xFD33, "<initial>", []rune{0x0637, 0x0645}, false},
+	{0xFD34, "<medial>", []rune{0x0633, 0x062C}, false},
+	{0xFD35, "<medial>", []rune{0x0633, 0x062D}, false},
+	{0xFD36, "<medial>", []rune{0x0633, 0x062E}, false},
+	{0xFD37, "<medial>", []rune{0x0634, 0x062C}, false},
+	{0xFD38, "<medial>", []rune{0x0634, 0x062D}, false},
+	{0xFD39, "<medial>", []rune{0x0634, 0x062E}, false},
+	{0xFD3A, "<medial>", []rune{0x0637, 0x0645}, false},
+	{0xFD3B, "<medial>", []rune{0x0638, 0x0645}, false},
+	{0xFD3C, "<final>", []rune{0x0627, 0x064B}, false},
+	{0xFD3D, "<isolated>", []rune{0x0627, 0x064B}, false},
+	{0xFD50, "<initial>", []rune{0x062A, 0x062C, 0x0645}, false},
+	{0xFD51, "<final>", []rune{0x062A, 0x062D, 0x062C}, false},
+	{0xFD52, "<initial>", []rune{0x062A, 0x062D, 0x062C}, false},
+	{0xFD53, "<initial>", []rune{0x062A, 0x062D, 0x0645}, false},
+	{0xFD54, "<initial>", []rune{0x062A, 0x062E, 0x0645}, false},
+	{0xFD55, "<initial>", []rune{0x062A, 0x0645, 0x062C}, false},
+	{0xFD56, "<initial>", []rune{0x062A, 0x0645, 0x062D}, false},
+	{0xFD57, "<initial>", []rune{0x062A, 0x0645, 0x062E}, false},
+	{0xFD58, "<final>", []rune{0x062C, 0x0645, 0x062D}, false},
+	{0xFD59, "<initial>", []rune{0x062C, 0x0645, 0x062D}, false},
+	{0xFD5A, "<final>", []rune{0x062D, 0x0645, 0x064A}, false},
+	{0xFD5B, "<final>", []rune{0x062D, 0x0645, 0x0649}, false},
+	{0xFD5C, "<initial>", []rune{0x0633, 0x062D, 0x062C}, false},
+	{0xFD5D, "<initial>", []rune{0x0633, 0x062C, 0x062D}, false},
+	{0xFD5E, "<final>", []rune{0x0633, 0x062C, 0x0649}, false},
+	{0xFD5F, "<final>", []rune{0x0633, 0x0645, 0x062D}, false},
+	{0xFD60, "<initial>", []rune{0x0633, 0x0645, 0x062D}, false},
+	{0xFD61, "<initial>", []rune{0x0633, 0x0645, 0x062C}, false},
+	{0xFD62, "<final>", []rune{0x0633, 0x0645, 0x0645}, false},
+	{0xFD63, "<initial>", []rune{0x0633, 0x0645, 0x0645}, false},
+	{0xFD64, "<final>", []rune{0x0635, 0x062D, 0x062D}, false},
+	{0xFD65, "<initial>", []rune{0x0635, 0x062D, 0x062D}, false},
+	{0xFD66, "<final>", []rune{0x0635, 0x0645, 0x0645}, false},
+	{0xFD67, "<final>", []rune{0x0634, 0x062D, 0x0645}, false},
+	{0xFD68, "<initial>", []rune{0x0634, 0x062D, 0x0645}, false},
+	{0xFD69, "<final>", []rune{0x0634, 0x062C, 0x064A}, false},
+	{0xFD6A, "<final>", []rune{0x0634, 0x0645, 0x062E}, false},
+	{0xFD6B, "<initial>", []rune{0x0634, 0x0645, 0x062E}, false},
+	{0xFD6C, "<final>", []rune{0x0634, 0x0645, 0x0645}, false},
+	{0xFD6D, "<initial>", []rune{0x0634, 0x0645, 0x0645}, false},
+	{0xFD6E, "<final>", []rune{0x0636, 0x062D, 0x0649}, false},
+	{0xFD6F, "<final>", []rune{0x0636, 0x062E, 0x0645}, false},
+	{0xFD70, "<initial>", []rune{0x0636, 0x062E, 0x0645}, false},
+	{0xFD71, "<final>", []rune{0x0637, 0x0645, 0x062D}, false},
+	{0xFD72, "<initial>", []rune{0x0637, 0x0645, 0x062D}, false},
+	{0xFD73, "<initial>", []rune{0x0637, 0x0645, 0x0645}, false},
+	{0xFD74, "<final>", []rune{0x0637, 0x0645, 0x064A}, false},
+	{0xFD75, "<final>", []rune{0x0639, 0x062C, 0x0645}, false},
+	{0xFD76, "<final>", []rune{0x0639, 0x0645, 0x0645}, false},
+	{0xFD77, "<initial>", []rune{0x0639, 0x0645, 0x0645}, false},
+	{0xFD78, "<final>", []rune{0x0639, 0x0645, 0x0649}, false},
+	{0xFD79, "<final>", []rune{0x063A, 0x0645, 0x0645}, false},
+	{0xFD7A, "<final>", []rune{0x063A, 0x0645, 0x064A}, false},
+	{0xFD7B, "<final>", []rune{0x063A, 0x0645, 0x0649}, false},
+	{0xFD7C, "<final>", []rune{0x0641, 0x062E, 0x0645}, false},
+	{0xFD7D, "<initial>", []rune{0x0641, 0x062E, 0x0645}, false},
+	{0xFD7E, "<final>", []rune{0x0642, 0x0645, 0x062D}, false},
+	{0xFD7F, "<final>", []rune{0x0642, 0x0645, 0x0645}, false},
+	{0xFD80, "<final>", []rune{0x0644, 0x062D, 0x0645}, false},
+	{0xFD81, "<final>", []rune{0x0644, 0x062D, 0x064A}, false},
+	{0xFD82, "<final>", []rune{0x0644, 0x062D, 0x0649}, false},
+	{0xFD83, "<initial>", []rune{0x0644, 0x062C, 0x062C}, false},
+	{0xFD84, "<final>", []rune{0x0644, 0x062C, 0x062C}, false},
+	{0xFD85, "<final>", []rune{0x0644, 0x062E, 0x0645}, false},
+	{0xFD86, "<initial>", []rune{0x0644, 0x062E, 0x0645}, false},
+	{0xFD87, "<final>", []rune{0x0644, 0x0645, 0x062D}, false},
+	{0xFD88, "<initial>", []rune{0x0644, 0x0645, 0x062D}, false},
+	{0xFD89, "<initial>", []rune{0x0645, 0x062D, 0x062C}, false},
+	{0xFD8A, "<initial>", []rune{0x0645, 0x062D, 0x0645}, false},
+	{0xFD8B, "<final>", []rune{0x0645, 0x062D, 0x064A}, false},
+	{0xFD8C, "<initial>", []rune{0x0645, 0x062C, 0x062D}, false},
+	{0xFD8D, "<initial>", []rune{0x0645, 0x062C, 0x0645}, false},
+	{0xFD8E, "<initial>", []rune{0x0645, 0x062E, 0x062C}, false},
+	{0xFD8F, "<initial>", []rune{0x0645, 0x062E, 0x0645}, false},
+	{0xFD92, "<initial>", []rune{0x0645, 0x062C, 0x062E}, false},
+	{0xFD93, "<initial>", []rune{0x0647, 0x0645, 0x062C}, false},
+	{0xFD94, "<initial>", []rune{0x0647, 0x0645, 0x0645}, false},
+	{0xFD95, "<initial>", []rune{0x0646, 0x062D, 0x0645}, false},
+	{0xFD96, "<final>", []rune{0x0646, 0x062D, 0x0649}, false},
+	{0xFD97, "<final>", []rune{0x0646, 0x062C, 0x0645}, false},
+	{0xFD98, "<initial>", []rune{0x0646, 0x062C, 0x0645}, false},
+	{0xFD99, "<final>", []rune{0x0646, 0x062C, 0x0649}, false},
+	{0xFD9A, "<final>", []rune{0x0646, 0x0645, 0x064A}, false},
+	{0xFD9B, "<final>", []rune{0x0646, 0x0645, 0x0649}, false},
+	{0xFD9C, "<final>", []rune{0x064A, 0x0645, 0x0645}, false},
+	{0xFD9D, "<initial>", []rune{0x064A, 0x0645, 0x0645}, false},
+	{0xFD9E, "<final>", []rune{0x0628, 0x062E, 0x064A}, false},
+	{0xFD9F, "<final>", []rune{0x062A, 0x062C, 0x064A}, false},
+	{0xFDA0, "<final>", []rune{0x062A, 0x062C, 0x0649}, false},
+	{0xFDA1, "<final>", []rune{0x062A, 0x062E, 0x064A}, false},
+	{0xFDA2, "<final>", []rune{0x062A, 0x062E, 0x0649}, false},
+	{0xFDA3, "<final>", []rune{0x062A, 0x0645, 0x064A}, false},
+	{0xFDA4, "<final>", []rune{0x062A, 0x0645, 0x0649}, false},
+	{0xFDA5, "<final>", []rune{0x062C, 0x0645, 0x064A}, false},
+	{0xFDA6, "<final>", []rune{0x062C, 0x062D, 0x0649}, false},
+	{0xFDA7, "<final>", []rune{0x062C, 0x0645, 0x0649}, false},
+	{0xFDA8, "<final>", []rune{0x0633, 0x062E, 0x0649}, false},
+	{0xFDA9, "<final>", []rune{0x0635, 0x062D, 0x064A}, false},
+	{0xFDAA, "<final>", []rune{0x0634, 0x062D, 0x064A}, false},
+	{0xFDAB, "<final>", []rune{0x0636, 0x062D, 0x064A}, false},
+	{0xFDAC, "<final>", []rune{0x0644, 0x062C, 0x064A}, false},
+	{0xFDAD, "<final>", []rune{0x0644, 0x0645, 0x064A}, false},
+	{0xFDAE, "<final>", []rune{0x064A, 0x062D, 0x064A}, false},
+	{0xFDAF, "<final>", []rune{0x064A, 0x062C, 0x064A}, false},
+	{0xFDB0, "<final>", []rune{0x064A, 0x0645, 0x064A}, false},
+	{0xFDB1, "<final>", []rune{0x0645, 0x0645, 0x064A}, false},
+	{0xFDB2, "<final>", []rune{0x0642, 0x0645, 0x064A}, false},
+	{0xFDB3, "<final>", []rune{0x0646, 0x062D, 0x064A}, false},
+	{0xFDB4, "<initial>", []rune{0x0642, 0x0645, 0x062D}, false},
+	{0xFDB5, "<initial>", []rune{0x0644, 0x062D, 0x0645}, false},
+	{0xFDB6, "<final>", []rune{0x0639, 0x0645, 0x064A}, false},
+	{0xFDB7, "<final>", []rune{0x0643, 0x0645, 0x064A}, false},
+	{0xFDB8, "<initial>", []rune{0x0646, 0x062C, 0x062D}, false},
+	{0xFDB9, "<final>", []rune{0x0645, 0x062E, 0x064A}, false},
+	{0xFDBA, "<initial>", []rune{0x0644, 0x062C, 0x0645}, false},
+	{0xFDBB, "<final>", []rune{0x0643, 0x0645, 0x0645}, false},
+	{0xFDBC, "<final>", []rune{0x0644, 0x062C, 0x0645}, false},
+	{0xFDBD, "<final>", []rune{0x0646, 0x062C, 0x062D}, false},
+	{0xFDBE, "<final>", []rune{0x062C, 0x062D, 0x064A}, false},
+	{0xFDBF, "<final>", []rune{0x062D, 0x062C, 0x064A}, false},
+	{0xFDC0, "<final>", []rune{0x0645, 0x062C, 0x064A}, false},
+	{0xFDC1, "<final>", []rune{0x0641, 0x0645, 0x064A}, false},
+	{0xFDC2, "<final>", []rune{0x0628, 0x062D, 0x064A}, false},
+	{0xFDC3, "<initial>", []rune{0x0643, 0x0645, 0x0645}, false},
+	{0xFDC4, "<initial>", []rune{0x0639, 0x062C, 0x0645}, false},
+	{0xFDC5, "<initial>", []rune{0x0635, 0x0645, 0x0645}, false},
+	{0xFDC6, "<final>", []rune{0x0633, 0x062E, 0x064A}, false},
+	{0xFDC7, "<final>", []rune{0x0646, 0x062C, 0x064A}, false},
+	{0xFDF0, "<isolated>", []rune{0x0635, 0x0644, 0x06D2}, false},
+	{0xFDF1, "<isolated>", []rune{0x0642, 0x0644, 0x06D2}, false},
+	{0xFDF2, "<isolated>", []rune{0x0627, 0x0644, 0x0644, 0x0647}, false},
+	{0xFDF3, "<isolated>", []rune{0x0627, 0x0643, 0x0628, 0x0631}, false},
+	{0xFDF4, "<isolated>", []rune{0x0645, 0x062D, 0x0645, 0x062F}, false},
+	{0xFDF5, "<isolated>", []rune{0x0635, 0x0644, 0x0639, 0x0645}, false},
+	{0xFDF6, "<isolated>", []rune{0x0631, 0x0633, 0x0648, 0x0644}, false},
+	{0xFDF7, "<isolated>", []rune{0x0639, 0x0644, 0x064A, 0x0647}, false},
+	{0xFDF8, "<isolated>", []rune{0x0648, 0x0633, 0x0644, 0x0645}, false},
+	{0xFDF9, "<isolated>", []rune{0x0635, 0x0644, 0x0649}, false},
+	{0xFDFA, "<isolated>", []rune{0x0635, 0x0644, 0x0649, 0x0020, 0x0627, 0x0644, 0x0644, 0x0647, 0x0020, 0x0639, 0x0644, 0x064A, 0x0647, 0x0020, 0x0648, 0x0633, 0x0644, 0x0645}, false},
+	{0xFDFB, "<isolated>", []rune{0x062C, 0x0644, 0x0020, 0x062C, 0x0644, 0x0627, 0x0644, 0x0647}, false},
+	{0xFDFC, "<isolated>", []rune{0x0631, 0x06CC, 0x0627, 0x0644}, false},
+	{0xFE10, "<vertical>", []rune{0x002C}, false},
+	{0xFE11, "<vertical>", []rune{0x3001}, false},
+	{0xFE12, "<vertical>", []rune{0x3002}, false},
+	{0xFE13, "<vertical>", []rune{0x003A}, false},
+	{0xFE14, "<vertical>", []rune{0x003B}, false},
+	{0xFE15, "<vertical>", []rune{0x0021}, false},
+	{0xFE16, "<vertical>", []rune{0x003F}, false},
+	{0xFE17, "<vertical>", []rune{0x3016}, false},
+	{0xFE18, "<vertical>", []rune{0x3017}, false},
+	{0xFE19, "<vertical>", []rune{0x2026}, false},
+	{0xFE30, "<vertical>", []rune{0x2025}, false},
+	{0xFE31, "<vertical>", []rune{0x2014}, false},
+	{0xFE32, "<vertical>", []rune{0x2013}, false},
+	{0xFE33, "<vertical>", []rune{0x005F}, false},
+	{0xFE34, "<vertical>", []rune{0x005F}, false},
+	{0xFE35, "<vertical>", []rune{0x0028}, false},
+	{0xFE36, "<vertical>", []rune{0x0029}, false},
+	{0xFE37, "<vertical>", []rune{0x007B}, false},
+	{0xFE38, "<vertical>", []rune{0x007D}, false},
+	{0xFE39, "<vertical>", []rune{0x3014}, false},
+	{0xFE3A, "<vertical>", []rune{0x3015}, false},
+	{0xFE3B, "<vertical>", []rune{0x3010}, false},
+	{0xFE3C, "<vertical>", []rune{0x3011}, false},
+	{0xFE3D, "<vertical>", []rune{0x300A}, false},
+	{0xFE3E, "<vertical>", []rune{0x300B}, false},
+	{0xFE3F, "<vertical>", []rune{0x3008}, false},
+	{0xFE40, "<vertical>", []rune{0x3009}, false},
+	{0xFE41, "<vertical>", []rune{0x300C}, false},
+	{0xFE42, "<vertical>", []rune{0x300D}, false},
+	{0xFE43, "<vertical>", []rune{0x300E}, false},
+	{0xFE44, "<vertical>", []rune{0x300F}, false},
+	{0xFE47, "<vertical>", []rune{0x005B}, false},
+	{0xFE48, "<vertical>", []rune{0x005D}, false},
+	{0xFE49, "<compat>", []rune{0x203E}, false},
+	{0xFE4A, "<compat>", []rune{0x203E}, false},
+	{0xFE4B, "<compat>", []rune{0x203E}, false},
+	{0xFE4C, "<compat>", []rune{0x203E}, false},
+	{0xFE4D, "<compat>", []rune{0x005F}, false},
+	{0xFE4E, "<compat>", []rune{0x005F}, false},
+	{0xFE4F, "<compat>", []rune{0x005F}, false},
+	{0xFE50, "<small>", []rune{0x002C}, false},
+	{0xFE51, "<small>", []rune{0x3001}, false},
+	{0xFE52, "<small>", []rune{0x002E}, false},
+	{0xFE54, "<small>", []rune{0x003B}, false},
+	{0xFE55, "<small>", []rune{0x003A}, false},
+	{0xFE56, "<small>", []rune{0x003F}, false},
+	{0xFE57, "<small>", []rune{0x0021}, false},
+	{0xFE58, "<small>", []rune{0x2014}, false},
+	{0xFE59, "<small>", []rune{0x0028}, false},
+	{0xFE5A, "<small>", []rune{0x0029}, false},
+	{0xFE5B, "<small>", []rune{0x007B}, false},
+	{0xFE5C, "<small>", []rune{0x007D}, false},
+	{0xFE5D, "<small>", []rune{0x3014}, false},
+	{0xFE5E, "<small>", []rune{0x3015}, false},
+	{0xFE5F, "<small>", []rune{0x0023}, false},
+	{0xFE60, "<small>", []rune{0x0026}, false},
+	{0xFE61, "<small>", []rune{0x002A}, false},
+	{0xFE62, "<small>", []rune{0x002B}, false},
+	{0xFE63, "<small>", []rune{0x002D}, false},
+	{0xFE64, "<small>", []rune{0x003C}, false},
+	{0xFE65, "<small>", []rune{0x003E}, false},
+	{0xFE66, "<small>", []rune{0x003D}, false},
+	{0xFE68, "<small>", []rune{0x005C}, false},
+	{0xFE69, "<small>", []rune{0x0024}, false},
+	{0xFE6A, "<small>", []rune{0x0025}, false},
+	{0xFE6B, "<small>", []rune{0x0040}, false},
+	{0xFE70, "<isolated>", []rune{0x0020, 0x064B}, false},
+	{0xFE71, "<medial>", []rune{0x0640, 0x064B}, false},
+	{0xFE72, "<isolated>", []rune{0x0020, 0x064C}, false},
+	{0xFE74, "<isolated>", []rune{0x0020, 0x064D}, false},
+	{0xFE76, "<isolated>", []rune{0x0020, 0x064E}, false},
+	{0xFE77, "<medial>", []rune{0x0640, 0x064E}, false},
+	{0xFE78, "<isolated>", []rune{0x0020, 0x064F}, false},
+	{0xFE79, "<medial>", []rune{0x0640, 0x064F}, false},
+	{0xFE7A, "<isolated>", []rune{0x0020, 0x0650}, false},
+	{0xFE7B, "<medial>", []rune{0x0640, 0x0650}, false},
+	{0xFE7C, "<isolated>", []rune{0x0020, 0x0651}, false},
+	{0xFE7D, "<medial>", []rune{0x0640, 0x0651}, false},
+	{0xFE7E, "<isolated>", []rune{0x0020, 0x0652}, false},
+	{0xFE7F, "<medial>", []rune{0x0640, 0x0652}, false},
+	{0xFE80, "<isolated>", []rune{0x0621}, false},
+	{0xFE81, "<isolated>", []rune{0x0622}, false},
+	{0xFE82, "<final>", []rune{0x0622}, false},
+	{0xFE83, "<isolated>", []rune{0x0623}, false},
+	{0xFE84, "<final>", []rune{0x0623}, false},
+	{0xFE85, "<isolated>", []rune{0x0624}, false},
+	{0xFE86, "<final>", []rune{0x0624}, false},
+	{0xFE87, "<isolated>", []rune{0x0625}, false},
+	{0xFE88, "<final>", []rune{0x0625}, false},
+	{0xFE89, "<isolated>", []rune{0x0626}, false},
+	{0xFE8A, "<final>", []rune{0x0626}, false},
+	{0xFE8B, "<initial>", []rune{0x0626}, false},
+	{0xFE8C, "<medial>", []rune{0x0626}, false},
+	{0xFE8D, "<isolated>", []rune{0x0627}, false},
+	{0xFE8E, "<final>", []rune{0x0627}, false},
+	{0xFE8F, "<isolated>", []rune{0x0628}, false},
+	{0xFE90, "<final>", []rune{0x0628}, false},
+	{0xFE91, "<initial>", []rune{0x0628}, false},
+	{0xFE92, "<medial>", []rune{0x0628}, false},
+	{0xFE93, "<isolated>", []rune{0x0629}, false},
+	{0xFE94, "<final>", []rune{0x0629}, false},
+	{0xFE95, "<isolated>", []rune{0x062A}, false},
+	{0xFE96, "<final>", []rune{0x062A}, false},
+	{0xFE97, "<initial>", []rune{0x062A}, false},
+	{0xFE98, "<medial>", []rune{0x062A}, false},
+	{0xFE99, "<isolated>", []rune{0x062B}, false},
+	{0xFE9A, "<final>", []rune{0x062B}, false},
+	{0xFE9B, "<initial>", []rune{0x062B}, false},
+	{0xFE9C, "<medial>", []rune{0x062B}, false},
+	{0xFE9D, "<isolated>", []rune{0x062C}, false},
+	{0xFE9E, "<final>", []rune{0x062C}, false},
+	{0xFE9F, "<initial>", []rune{0x062C}, false},
+	{0xFEA0, "<medial>", []rune{0x062C}, false},
+	{0xFEA1, "<isolated>", []rune{0x062D}, false},
+	{0xFEA2, "<final>", []rune{0x062D}, false},
+	{0xFEA3, "<initial>", []rune{0x062D}, false},
+	{0xFEA4, "<medial>", []rune{0x062D}, false},
+	{0xFEA5, "<isolated>", []rune{0x062E}, false},
+	{0xFEA6, "<final>", []rune{0x062E}, false},
+	{0xFEA7, "<initial>", []rune{0x062E}, false},
+	{0xFEA8, "<medial>", []rune{0x062E}, false},
+	{0xFEA9, "<isolated>", []rune{0x062F}, false},
+	{0xFEAA, "<final>", []rune{0x062F}, false},
+	{0xFEAB, "<isolated>", []rune{0x0630}, false},
+	{0xFEAC, "<final>", []rune{0x0630}, false},
+	{0xFEAD, "<isolated>", []rune{0x0631}, false},
+	{0xFEAE, "<final>", []rune{0x0631}, false},
+	{0xFEAF, "<isolated>", []rune{0x0632}, false},
+	{0xFEB0, "<final>", []rune{0x0632}, false},
+	{0xFEB1, "<isolated>", []rune{0x0633}, false},
+	{0xFEB2, "<final>", []rune{0x0633}, false},
+	{0xFEB3, "<initial>", []rune{0x0633}, false},
+	{0xFEB4, "<medial>", []rune{0x0633}, false},
+	{0xFEB5, "<isolated>", []rune{0x0634}, false},
+	{0xFEB6, "<final>", []rune{0x0634}, false},
+	{0xFEB7, "<initial>", []rune{0x0634}, false},
+	{0xFEB8, "<medial>", []rune{0x0634}, false},
+	{0xFEB9, "<isolated>", []rune{0x0635}, false},
+	{0xFEBA, "<final>", []rune{0x0635}, false},
+	{0xFEBB, "<initial>", []rune{0x0635}, false},
+	{0xFEBC, "<medial>", []rune{0x0635}, false},
+	{0xFEBD, "<isolated>", []rune{0x0636}, false},
+	{0xFEBE, "<final>", []rune{0x0636}, false},
+	{0xFEBF, "<initial>", []rune{0x0636}, false},
+	{0xFEC0, "<medial>", []rune{0x0636}, false},
+	{0xFEC1, "<isolated>", []rune{0x0637}, false},
+	{0xFEC2, "<final>", []rune{0x0637}, false},
+	{0xFEC3, "<initial>", []rune{0x0637}, false},
+	{0xFEC4, "<medial>", []rune{0x0637}, false},
+	{0xFEC5, "<isolated>", []rune{0x0638}, false},
+	{0xFEC6, "<final>", []rune{0x0638}, false},
+	{0xFEC7, "<initial>", []rune{0x0638}, false},
+	{0xFEC8, "<medial>", []rune{0x0638}, false},
+	{0xFEC9, "<isolated>", []rune{0x0639}, false},
+	{0xFECA, "<final>", []rune{0x0639}, false},
+	{0xFECB, "<initial>", []rune{0x0639}, false},
+	{0xFECC, "<medial>", []rune{0x0639}, false},
+	{0xFECD, "<isolated>", []rune{0x063A}, false},
+	{0xFECE, "<final>", []rune{0x063A}, false},
+	{0xFECF, "<initial>", []rune{0x063A}, false},
+	{0xFED0, "<medial>", []rune{0x063A}, false},
+	{0xFED1, "<isolated>", []rune{0x0641}, false},
+	{0xFED2, "<final>", []rune{0x0641}, false},
+	{0xFED3, "<initial>", []rune{0x0641}, false},
+	{0xFED4, "<medial>", []rune{0x0641}, false},
+	{0xFED5, "<isolated>", []rune{0x0642}, false},
+	{0xFED6, "<final>", []rune{0x0642}, false},
+	{0xFED7, "<initial>", []rune{0x0642}, false},
+	{0xFED8, "<medial>", []rune{0x0642}, false},
+	{0xFED9, "<isolated>", []rune{0x0643}, false},
+	{0xFEDA, "<final>", []rune{0x0643}, false},
+	{0xFEDB, "<initial>", []rune{0x0643}, false},
+	{0xFEDC, "<medial>", []rune{0x0643}, false},
+	{0xFEDD, "<isolated>", []rune{0x0644}, false},
+	{0xFEDE, "<final>", []rune{0x0644}, false},
+	{0xFEDF, "<initial>", []rune{0x0644}, false},
+	{0xFEE0, "<medial>", []rune{0x0644}, false},
+	{0xFEE1, "<isolated>", []rune{0x0645}, false},
+	{0xFEE2, "<final>", []rune{0x0645}, false},
+	{0xFEE3, "<initial>", []rune{0x0645}, false},
+	{0xFEE4, "<medial>", []rune{0x0645}, false},
+	{0xFEE5, "<isolated>", []rune{0x0646}, false},
+	{0xFEE6, "<final>", []rune{0x0646}, false},
+	{0xFEE7, "<initial>", []rune{0x0646}, false},
+	{0xFEE8, "<medial>", []rune{0x0646}, false},
+	{0xFEE9, "<isolated>", []rune{0x0647}, false},
+	{0xFEEA, "<final>", []rune{0x0647}, false},
+	{0xFEEB, "<initial>", []rune{0x0647}, false},
+	{0xFEEC, "<medial>", []rune{0x0647}, false},
+	{0xFEED, "<isolated>", []rune{0x0648}, false},
+	{0xFEEE, "<final>", []rune{0x0648}, false},
+	{0xFEEF, "<isolated>", []rune{0x0649}, false},
+	{0xFEF0, "<final>", []rune{0x0649}, false},
+	{0xFEF1, "<isolated>", []rune{0x064A}, false},
+	{0xFEF2, "<final>", []rune{0x064A}, false},
+	{0xFEF3, "<initial>", []rune{0x064A}, false},
+	{0xFEF4, "<medial>", []rune{0x064A}, false},
+	{0xFEF5, "<isolated>", []rune{0x0644, 0x0622}, false},
+	{0xFEF6, "<final>", []rune{0x0644, 0x0622}, false},
+	{0xFEF7, "<isolated>", []rune{0x0644, 0x0623}, false},
+	{0xFEF8, "<final>", []rune{0x0644, 0x0623}, false},
+	{0xFEF9, "<isolated>", []rune{0x0644, 0x0625}, false},
+	{0xFEFA, "<final>", []rune{0x0644, 0x0625}, false},
+	{0xFEFB, "<isolated>", []rune{0x0644, 0x0627}, false},
+	{0xFEFC, "<final>", []rune{0x0644, 0x0627}, false},
+	{0xFF01, "<wide>", []rune{0x0021}, false},
+	{0xFF02, "<wide>", []rune{0x0022}, false},
+	{0xFF03, "<wide>", []rune{0x0023}, false},
+	{0xFF04, "<wide>", []rune{0x0024}, false},
+	{0xFF05, "<wide>", []rune{0x0025}, false},
+	{0xFF06, "<wide>", []rune{0x0026}, false},
+	{0xFF07, "<wide>", []rune{0x0027}, false},
+	{0xFF08, "<wide>", []rune{0x0028}, false},
+	{0xFF09, "<wide>", []rune{0x0029}, false},
+	{0xFF0A, "<wide>", []rune{0x002A}, false},
+	{0xFF0B, "<wide>", []rune{0x002B}, false},
+	{0xFF0C, "<wide>", []rune{0x002C}, false},
+	{0xFF0D, "<wide>", []rune{0x002D}, false},
+	{0xFF0E, "<wide>", []rune{0x002E}, false},
+	{0xFF0F, "<wide>", []rune{0x002F}, false},
+	{0xFF10, "<wide>", []rune{0x0030}, false},
+	{0xFF11, "<wide>", []rune{0x0031}, false},
+	{0xFF12, "<wide>", []rune{0x0032}, false},
+	{0xFF13, "<wide>", []rune{0x0033}, false},
+	{0xFF14, "<wide>", []rune{0x0034}, false},
+	{0xFF15, "<wide>", []rune{0x0035}, false},
+	{0xFF16, "<wide>", []rune{0x0036}, false},
+	{0xFF17, "<wide>", []rune{0x0037}, false},
+	{0xFF18, "<wide>", []rune{0x0038}, false},
+	{0xFF19, "<wide>", []rune{0x0039}, false},
+	{0xFF1A, "<wide>", []rune{0x003A}, false},
+	{0xFF1B, "<wide>", []rune{0x003B}, false},
+	{0xFF1C, "<wide>", []rune{0x003C}, false},
+	{0xFF1D, "<wide>", []rune{0x003D}, false},
+	{0xFF1E, "<wide>", []rune{0x003E}, false},
+	{0xFF1F, "<wide>", []rune{0x003F}, false},
+	{0xFF20, "<wide>", []rune{0x0040}, false},
+	{0xFF21, "<wide>", []rune{0x0041}, false},
+	{0xFF22, "<wide>", []rune{0x0042}, false},
+	{0xFF23, "<wide>", []rune{0x0043}, false},
+	{0xFF24, "<wide>", []rune{0x0044}, false},
+	{0xFF25, "<wide>", []rune{0x0045}, false},
+	{0xFF26, "<wide>", []rune{0x0046}, false},
+	{0xFF27, "<wide>", []rune{0x0047}, false},
+	{0xFF28, "<wide>", []rune{0x0048}, false},
+	{0xFF29, "<wide>", []rune{0x0049}, false},
+	{0xFF2A, "<wide>", []rune{0x004A}, false},
+	{0xFF2B, "<wide>", []rune{0x004B}, false},
+	{0xFF2C, "<wide>", []rune{0x004C}, false},
+	{0xFF2D, "<wide>", []rune{0x004D}, false},
+	{0xFF2E, "<wide>", []rune{0x004E}, false},
+	{0xFF2F, "<wide>", []rune{0x004F}, false},
+	{0xFF30, "<wide>", []rune{0x0050}, false},
+	{0xFF31, "<wide>", []rune{0x0051}, false},
+	{0xFF32, "<wide>", []rune{0x0052}, false},
+	{0xFF33, "<wide>", []rune{0x0053}, false},
+	{0xFF34, "<wide>", []rune{0x0054}, false},
+	{0xFF35, "<wide>", []rune{0x0055}, false},
+	{0xFF36, "<wide>", []rune{0x0056}, false},
+	{0xFF37, "<wide>", []rune{0x0057}, false},
+	{0xFF38, "<wide>", []rune{0x0058}, false},
+	{0xFF39, "<wide>", []rune{0x0059}, false},
+	{0xFF3A, "<wide>", []rune{0x005A}, false},
+	{0xFF3B, "<wide>", []rune{0x005B}, false},
+	{0xFF3C, "<wide>", []rune{0x005C}, false},
+	{0xFF3D, "<wide>", []rune{0x005D}, false},
+	{0xFF3E, "<wide>", []rune{0x005E}, false},
+	{0xFF3F, "<wide>", []rune{0x005F}, false},
+	{0xFF40, "<wide>", []rune{0x0060}, false},
+	{0xFF41, "<wide>", []rune{0x0061}, false},
+	{0xFF42, "<wide>", []rune{0x0062}, false},
+	{0xFF43, "<wide>", []rune{0x0063}, false},
+	{0xFF44, "<wide>", []rune{0x0064}, false},
+	{0xFF45, "<wide>", []rune{0x0065}, false},
+	{0xFF46, "<wide>", []rune{0x0066}, false},
+	{0xFF47, "<wide>", []rune{0x0067}, false},
+	{0xFF48, "<wide>", []rune{0x0068}, false},
+	{0xFF49, "<wide>", []rune{0x0069}, false},
+	{0xFF4A, "<wide>", []rune{0x006A}, false},
+	{0xFF4B, "<wide>", []rune{0x006B}, false},
+	{0xFF4C, "<wide>", []rune{0x006C}, false},
+	{0xFF4D, "<wide>", []rune{0x006D}, false},
+	{0xFF4E, "<wide>", []rune{0x006E}, false},
+	{0xFF4F, "<wide>", []rune{0x006F}, false},
+	{0xFF50, "<wide>", []rune{0x0070}, false},
+	{0xFF51, "<wide>", []rune{0x0071}, false},
+	{0xFF52, "<wide>", []rune{0x0072}, false},
+	{0xFF53, "<wide>", []rune{0x0073}, false},
+	{0xFF54, "<wide>", []rune{0x0074}, false},
+	{0xFF55, "<wide>", []rune{0x0075}, false},
+	{0xFF56, "<wide>", []rune{0x0076}, false},
+	{0xFF57, "<wide>", []rune{0x0077}, false},
+	{0xFF58, "<wide>", []rune{0x0078}, false},
+	{0xFF59, "<wide>", []rune{0x0079}, false},
+	{0xFF5A, "<wide>", []rune{0x007A}, false},
+	{0xFF5B, "<wide>", []rune{0x007B}, false},
+	{0xFF5C, "<wide>", []rune{0x007C}, false},
+	{0xFF5D, "<wide>", []rune{0x007D}, false},
+	{0xFF5E, "<wide>", []rune{0x007E}, false},
+	{0xFF5F, "<wide>", []rune{0x2985}, false},
+	{0xFF60, "<wide>", []rune{0x2986}, false},
+	{0xFF61, "<narrow>", []rune{0x3002}, false},
+	{0xFF62, "<narrow>", []rune{0x300C}, false},
+	{0xFF63, "<narrow>", []rune{0x300D}, false},
+	{0xFF64, "<narrow>", []rune{0x3001}, false},
+	{0xFF65, "<narrow>", []rune{0x30FB}, false},
+	{0xFF66, "<narrow>", []rune{0x30F2}, false},
+	{0xFF67, "<narrow>", []rune{0x30A1}, false},
+	{0xFF68, "<narrow>", []rune{0x30A3}, false},
+	{0xFF69, "<narrow>", []rune{0x30A5}, false},
+	{0xFF6A, "<narrow>", []rune{0x30A7}, false},
+	{0xFF6B, "<narrow>", []rune{0x30A9}, false},
+	{0xFF6C, "<narrow>", []rune{0x30E3}, false},
+	{0xFF6D, "<narrow>", []rune{0x30E5}, false},
+	{0xFF6E, "<narrow>", []rune{0x30E7}, false},
+	{0xFF6F, "<narrow>", []rune{0x30C3}, false},
+	{0xFF70, "<narrow>", []rune{0x30FC}, false},
+	{0xFF71, "<narrow>", []rune{0x30A2}, false},
+	{0xFF72, "<narrow>", []rune{0x30A4}, false},
+	{0xFF73, "<narrow>", []rune{0x30A6}, false},
+	{0xFF74, "<narrow>", []rune{0x30A8}, false},
+	{0xFF75, "<narrow>", []rune{0x30AA}, false},
+	{0xFF76, "<narrow>", []rune{0x30AB}, false},
+	{0xFF77, "<narrow>", []rune{0x30AD}, false},
+	{0xFF78, "<narrow>", []rune{0x30AF}, false},
+	{0xFF79, "<narrow>", []rune{0x30B1}, false},
+	{0xFF7A, "<narrow>", []rune{0x30B3}, false},
+	{0xFF7B, "<narrow>", []rune{0x30B5}, false},
+	{0xFF7C, "<narrow>", []rune{0x30B7}, false},
+	{0xFF7D, "<narrow>", []rune{0x30B9}, false},
+	{0xFF7E, "<narrow>", []rune{0x30BB}, false},
+	{0xFF7F, "<narrow>", []rune{0x30BD}, false},
+	{0xFF80, "<narrow>", []rune{0x30BF}, false},
+	{0xFF81, "<narrow>", []rune{0x30C1}, false},
+	{0xFF82, "<narrow>", []rune{0x30C4}, false},
+	{0xFF83, "<narrow>", []rune{0x30C6}, false},
+	{0xFF84, "<narrow>", []rune{0x30C8}, false},
+	{0xFF85, "<narrow>", []rune{0x30CA}, false},
+	{0xFF86, "<narrow>", []rune{0x30CB}, false},
+	{0xFF87, "<narrow>", []rune{0x30CC}, false},
+	{0xFF88, "<narrow>", []rune{0x30CD}, false},
+	{0xFF89, "<narrow>", []rune{0x30CE}, false},
+	{0xFF8A, "<narrow>", []rune{0x30CF}, false},
+	{0xFF8B, "<narrow>", []rune{0x30D2}, false},
+	{0xFF8C, "<narrow>", []rune{0x30D5}, false},
+	{0xFF8D, "<narrow>", []rune{0x30D8}, false},
+	{0xFF8E, "<narrow>", []rune{0x30DB}, false},
+	{0xFF8F, "<narrow>", []rune{0x30DE}, false},
+	{0xFF90, "<narrow>", []rune{0x30DF}, false},
+	{0xFF91, "<narrow>", []rune{0x30E0}, false},
+	{0xFF92, "<narrow>", []rune{0x30E1}, false},
+	{0xFF93, "<narrow>", []rune{0x30E2}, false},
+	{0xFF94, "<narrow>", []rune{0x30E4}, false},
+	{0xFF95, "<narrow>", []rune{0x30E6}, false},
+	{0xFF96, "<narrow>", []rune{0x30E8}, false},
+	{0xFF97, "<narrow>", []rune{0x30E9}, false},
+	{0xFF98, "<narrow>", []rune{0x30EA}, false},
+	{0xFF99, "<narrow>", []rune{0x30EB}, false},
+	{0xFF9A, "<narrow>", []rune{0x30EC}, false},
+	{0xFF9B, "<narrow>", []rune{0x30ED}, false},
+	{0xFF9C, "<narrow>", []rune{0x30EF}, false},
+	{0xFF9D, "<narrow>", []rune{0x30F3}, false},
+	{0xFF9E, "<narrow>", []rune{0x3099}, false},
+	{0xFF9F, "<narrow>", []rune{0x309A}, false},
+	{0xFFA0, "<narrow>", []rune{0x3164}, false},
+	{0xFFA1, "<narrow>", []rune{0x3131}, false},
+	{0xFFA2, "<narrow>", []rune{0x3132}, false},
+	{0xFFA3, "<narrow>", []rune{0x3133}, false},
+	{0xFFA4, "<narrow>", []rune{0x3134}, false},
+	{0xFFA5, "<narrow>", []rune{0x3135}, false},
+	{0xFFA6, "<narrow>", []rune{0x3136}, false},
+	{0xFFA7, "<narrow>", []rune{0x3137}, false},
+	{0xFFA8, "<narrow>", []rune{0x3138}, false},
+	{0xFFA9, "<narrow>", []rune{0x3139}, false},
+	{0xFFAA, "<narrow>", []rune{0x313A}, false},
+	{0xFFAB, "<narrow>", []rune{0x313B}, false},
+	{0xFFAC, "<narrow>", []rune{0x313C}, false},
+	{0xFFAD, "<narrow>", []rune{0x313D}, false},
+	{0xFFAE, "<narrow>", []rune{0x313E}, false},
+	{0xFFAF, "<narrow>", []rune{0x313F}, false},
+	{0xFFB0, "<narrow>", []rune{0x3140}, false},
+	{0xFFB1, "<narrow>", []rune{0x3141}, false},
+	{0xFFB2, "<narrow>", []rune{0x3142}, false},
+	{0xFFB3, "<narrow>", []rune{0x3143}, false},
+	{0xFFB4, "<narrow>", []rune{0x3144}, false},
+	{0xFFB5, "<narrow>", []rune{0x3145}, false},
+	{0xFFB6, "<narrow>", []rune{0x3146}, false},
+	{0xFFB7, "<narrow>", []rune{0x3147}, false},
+	{0xFFB8, "<narrow>", []rune{0x3148}, false},
+	{0xFFB9, "<narrow>", []rune{0x3149}, false},
+	{0xFFBA, "<narrow>", []rune{0x314A}, false},
+	{0xFFBB, "<narrow>", []rune{0x314B}, false},
+	{0xFFBC, "<narrow>", []rune{0x314C}, false},
+	{0xFFBD, "<narrow>", []rune{0x314D}, false},
+	{0xFFBE, "<narrow>", []rune{0x314E}, false},
+	{0xFFC2, "<narrow>", []rune{0x314F}, false},
+	{0xFFC3, "<narrow>", []rune{0x3150}, false},
+	{0xFFC4, "<narrow>", []rune{0x3151}, false},
+	{0xFFC5, "<narrow>", []rune{0x3152}, false},
+	{0xFFC6, "<narrow>", []rune{0x3153}, false},
+	{0xFFC7, "<narrow>", []rune{0x3154}, false},
+	{0xFFCA, "<narrow>", []rune{0x3155}, false},
+	{0xFFCB, "<narrow>", []rune{0x3156}, false},
+	{0xFFCC, "<narrow>", []rune{0x3157}, false},
+	{0xFFCD, "<narrow>", []rune{0x3158}, false},
+	{0xFFCE, "<narrow>", []rune{0x3159}, false},
+	{0xFFCF, "<narrow>", []rune{0x315A}, false},
+	{0xFFD2, "<narrow>", []rune{0x315B}, false},
+	{0xFFD3, "<narrow>", []rune{0x315C}, false},
+	{0xFFD4, "<narrow>", []rune{0x315D}, false},
+	{0xFFD5, "<narrow>", []rune{0x315E}, false},
+	{0xFFD6, "<narrow>", []rune{0x315F}, false},
+	{0xFFD7, "<narrow>", []rune{0x3160}, false},
+	{0xFFDA, "<narrow>", []rune{0x3161}, false},
+	{0xFFDB, "<narrow>", []rune{0x3162}, false},
+	{0xFFDC, "<narrow>", []rune{0x3163}, false},
+	{0xFFE0, "<wide>", []rune{0x00A2}, false},
+	{0xFFE1, "<wide>", []rune{0x00A3}, false},
+	{0xFFE2, "<wide>", []rune{0x00AC}, false},
+	{0xFFE3, "<wide>", []rune{0x00AF}, false},
+	{0xFFE4, "<wide>", []rune{0x00A6}, false},
+	{0xFFE5, "<wide>", []rune{0x00A5}, false},
+	{0xFFE6, "<wide>", []rune{0x20A9}, false},
+	{0xFFE8, "<narrow>", []rune{0x2502}, false},
+	{0xFFE9, "<narrow>", []rune{0x2190}, false},
+	{0xFFEA, "<narrow>", []rune{0x2191}, false},
+	{0xFFEB, "<narrow>", []rune{0x2192}, false},
+	{0xFFEC, "<narrow>", []rune{0x2193}, false},
+	{0xFFED, "<narrow>", []rune{0x25A0}, false},
+	{0xFFEE, "<narrow>", []rune{0x25CB}, false},
+	{0x10781, "<super>", []rune{0x02D0}, false},
+	{0x10782, "<super>", []rune{0x02D1}, false},
+	{0x10783, "<super>", []rune{0x00E6}, false},
+	{0x10784, "<super>", []rune{0x0299}, false},
+	{0x10785, "<super>", []rune{0x0253}, false},
+	{0x10787, "<super>", []rune{0x02A3}, false},
+	{0x10788, "<super>", []rune{0xAB66}, false},
+	{0x10789, "<super>", []rune{0x02A5}, false},
+	{0x1078A, "<super>", []rune{0x02A4}, false},
+	{0x1078B, "<super>", []rune{0x0256}, false},
+	{0x1078C, "<super>", []rune{0x0257}, false},
+	{0x1078D, "<super>", []rune{0x1D91}, false},
+	{0x1078E, "<super>", []rune{0x0258}, false},
+	{0x1078F, "<super>", []rune{0x025E}, false},
+	{0x10790, "<super>", []rune{0x02A9}, false},
+	{0x10791, "<super>", []rune{0x0264}, false},
+	{0x10792, "<super>", []rune{0x0262}, false},
+	{0x10793, "<super>", []rune{0x0260}, false},
+	{0x10794, "<super>", []rune{0x029B}, false},
+	{0x10795, "<super>", []rune{0x0127}, false},
+	{0x10796, "<super>", []rune{0x029C}, false},
+	{0x10797, "<super>", []rune{0x0267}, false},
+	{0x10798, "<super>", []rune{0x0284}, false},
+	{0x10799, "<super>", []rune{0x02AA}, false},
+	{0x1079A, "<super>", []rune{0x02AB}, false},
+	{0x1079B, "<super>", []rune{0x026C}, false},
+	{0x1079C, "<super>", []rune{0x1DF04}, false},
+	{0x1079D, "<super>", []rune{0xA78E}, false},
+	{0x1079E, "<super>", []rune{0x026E}, false},
+	{0x1079F, "<super>", []rune{0x1DF05}, false},
+	{0x107A0, "<super>", []rune{0x028E}, false},
+	{0x107A1, "<super>", []rune{0x1DF06}, false},
+	{0x107A2, "<super>", []rune{0x00F8}, false},
+	{0x107A3, "<super>", []rune{0x0276}, false},
+	{0x107A4, "<super>", []rune{0x0277}, false},
+	{0x107A5, "<super>", []rune{0x0071}, false},
+	{0x107A6, "<super>", []rune{0x027A}, false},
+	{0x107A7, "<super>", []rune{0x1DF08}, false},
+	{0x107A8, "<super>", []rune{0x027D}, false},
+	{0x107A9, "<super>", []rune{0x027E}, false},
+	{0x107AA, "<super>", []rune{0x0280}, false},
+	{0x107AB, "<super>", []rune{0x02A8}, false},
+	{0x107AC, "<super>", []rune{0x02A6}, false},
+	{0x107AD, "<super>", []rune{0xAB67}, false},
+	{0x107AE, "<super>", []rune{0x02A7}, false},
+	{0x107AF, "<super>", []rune{0x0288}, false},
+	{0x107B0, "<super>", []rune{0x2C71}, false},
+	{0x107B2, "<super>", []rune{0x028F}, false},
+	{0x107B3, "<super>", []rune{0x02A1}, false},
+	{0x107B4, "<super>", []rune{0x02A2}, false},
+	{0x107B5, "<super>", []rune{0x0298}, false},
+	{0x107B6, "<super>", []rune{0x01C0}, false},
+	{0x107B7, "<super>", []rune{0x01C1}, false},
+	{0x107B8, "<super>", []rune{0x01C2}, false},
+	{0x107B9, "<super>", []rune{0x1DF0A}, false},
+	{0x107BA, "<super>", []rune{0x1DF1E}, false},
+	{0x1109A, "", []rune{0x11099, 0x110BA}, false},
+	{0x1109C, "", []rune{0x1109B, 0x110BA}, false},
+	{0x110AB, "", []rune{0x110A5, 0x110BA}, false},
+	{0x1112E, "", []rune{0x11131, 0x11127}, false},
+	{0x1112F, "", []rune{0x11132, 0x11127}, false},
+	{0x1134B, "", []rune{0x11347, 0x1133E}, false},
+	{0x1134C, "", []rune{0x11347, 0x11357}, false},
+	{0x114BB, "", []rune{0x114B9, 0x114BA}, false},
+	{0x114BC, "", []rune{0x114B9, 0x114B0}, false},
+	{0x114BE, "", []rune{0x114B9, 0x114BD}, false},
+	{0x115BA, "", []rune{0x115B8, 0x115AF}, false},
+	{0x115BB, "", []rune{0x115B9, 0x115AF}, false},
+	{0x11938, "", []rune{0x11935, 0x11930}, false},
+	{0x1D15E, "", []rune{0x1D157, 0x1D165}, true},
+	{0x1D15F, "", []rune{0x1D158, 0x1D165}, true},
+	{0x1D160, "", []rune{0x1D15F, 0x1D16E}, true},
+	{0x1D161, "", []rune{0x1D15F, 0x1D16F}, true},
+	{0x1D162, "", []rune{0x1D15F, 0x1D170}, true},
+	{0x1D163, "", []rune{0x1D15F, 0x1D171}, true},
+	{0x1D164, "", []rune{0x1D15F, 0x1D172}, true},
+	{0x1D1BB, "", []rune{0x1D1B9, 0x1D165}, true},
+	{0x1D1BC, "", []rune{0x1D1BA, 0x1D165}, true},
+	{0x1D1BD, "", []rune{0x1D1BB, 0x1D16E}, true},
+	{0x1D1BE, "", []rune{0x1D1BC, 0x1D16E}, true},
+	{0x1D1BF, "", []rune{0x1D1BB, 0x1D16F}, true},
+	{0x1D1C0, "", []rune{0x1D1BC, 0x1D16F}, true},
+	{0x1D400, "<font>", []rune{0x0041}, false},
+	{0x1D401, "<font>", []rune{0x0042}, false},
+	{0x1D402, "<font>", []rune{0x0043}, false},
+	{0x1D403, "<font>", []rune{0x0044}, false},
+	{0x1D404, "<font>", []rune{0x0045}, false},
+	{0x1D405, "<font>", []rune{0x0046}, false},
+	{0x1D406, "<font>", []rune{0x0047}, false},
+	{0x1D407, "<font>", []rune{0x0048}, false},
+	{0x1D408, "<font>", []rune{0x0049}, false},
+	{0x1D409, "<font>", []rune{0x004A}, false},
+	{0x1D40A, "<font>", []rune{0x004B}, false},
+	{0x1D40B, "<font>", []rune{0x004C}, false},
+	{0x1D40C, "<font>", []rune{0x004D}, false},
+	{0x1D40D, "<font>", []rune{0x004E}, false},
+	{0x1D40E, "<font>", []rune{0x004F}, false},
+	{0x1D40F, "<font>", []rune{0x0050}, false},
+	{0x1D410, "<font>", []rune{0x0051}, false},
+	{0x1D411, "<font>", []rune{0x0052}, false},
+	{0x1D412, "<font>", []rune{0x0053}, false},
+	{0x1D413, "<font>", []rune{0x0054}, false},
+	{0x1D414, "<font>", []rune{0x0055}, false},
+	{0x1D415, "<font>", []rune{0x0056}, false},
+	{0x1D416, "<font>", []rune{0x0057}, false},
+	{0x1D417, "<font>", []rune{0x0058}, false},
+	{0x1D418, "<font>", []rune{0x0059}, false},
+	{0x1D419, "<font>", []rune{0x005A}, false},
+	{0x1D41A, "<font>", []rune{0x0061}, false},
+	{0x1D41B, "<font>", []rune{0x0062}, false},
+	{0x1D41C, "<font>", []rune{0x0063}, false},
+	{0x1D41D, "<font>", []rune{0x0064}, false},
+	{0x1D41E, "<font>", []rune{0x0065}, false},
+	{0x1D41F, "<font>", []rune{0x0066}, false},
+	{0x1D420, "<font>", []rune{0x0067}, false},
+	{0x1D421, "<font>", []rune{0x0068}, false},
+	{0x1D422, "<font>", []rune{0x0069}, false},
+	{0x1D423, "<font>", []rune{0x006A}, false},
+	{0x1D424, "<font>", []rune{0x006B}, false},
+	{0x1D425, "<font>", []rune{0x006C}, false},
+	{0x1D426, "<font>", []rune{0x006D}, false},
+	{0x1D427, "<font>", []rune{0x006E}, false},
+	{0x1D428, "<font>", []rune{0x006F}, false},
+	{0x1D429, "<font>", []rune{0x0070}, false},
+	{0x1D42A, "<font>", []rune{0x0071}, false},
+	{0x1D42B, "<font>", []rune{0x0072}, false},
+	{0x1D42C, "<font>", []rune{0x0073}, false},
+	{0x1D42D, "<font>", []rune{0x0074}, false},
+	{0x1D42E, "<font>", []rune{0x0075}, false},
+	{0x1D42F, "<font>", []rune{0x0076}, false},
+	{0x1D430, "<font>", []rune{0x0077}, false},
+	{0x1D431, "<font>", []rune{0x0078}, false},
+	{0x1D432, "<font>", []rune{0x0079}, false},
+	{0x1D433, "<font>", []rune{0x007A}, false},
+	{0x1D434, "<font>", []rune{0x0041}, false},
+	{0x1D435, "<font>", []rune{0x0042}, false},
+	{0x1D436, "<font>", []rune{0x0043}, false},
+	{0x1D437, "<font>", []rune{0x0044}, false},
+	{0x1D438, "<font>", []rune{0x0045}, false},
+	{0x1D439, "<font>", []rune{0x0046}, false},
+	{0x1D43A, "<font>", []rune{0x0047}, false},
+	{0x1D43B, "<font>", []rune{0x0048}, false},
+	{0x1D43C, "<font>", []rune{0x0049}, false},
+	{0x1D43D, "<font>", []rune{0x004A}, false},
+	{0x1D43E, "<font>", []rune{0x004B}, false},
+	{0x1D43F, "<font>", []rune{0x004C}, false},
+	{0x1D440, "<font>", []rune{0x004D}, false},
+	{0x1D441, "<font>", []rune{0x004E}, false},
+	{0x1D442, "<font>", []rune{0x004F}, false},
+	{0x1D443, "<font>", []rune{0x0050}, false},
+	{0x1D444, "<font>", []rune{0x0051}, false},
+	{0x1D445, "<font>", []rune{0x0052}, false},
+	{0x1D446, "<font>", []rune{0x0053}, false},
+	{0x1D447, "<font>", []rune{0x0054}, false},
+	{0x1D448, "<font>", []rune{0x0055}, false},
+	{0x1D449, "<font>", []rune{0x0056}, false},
+	{0x1D44A, "<font>", []rune{0x0057}, false},
+	{0x1D44B, "<font>", []rune{0x0058}, false},
+	{0x1D44C, "<font>", []rune{0x0059}, false},
+	{0x1D44D, "<font>", []rune{0x005A}, false},
+	{0x1D44E, "<font>", []rune{0x0061}, false},
+	{0x1D44F, "<font>", []rune{0x0062}, false},
+	{0x1D450, "<font>", []rune{0x0063}, false},
+	{0x1D451, "<font>", []rune{0x0064}, false},
+	{0x1D452, "<font>", []rune{0x0065}, false},
+	{0x1D453, "<font>", []rune{0x0066}, false},
+	{0x1D454, "<font>", []rune{0x0067}, false},
+	{0x1D456, "<font>", []rune{0x0069}, false},
+	{0x1D457, "<font>", []rune{0x006A}, false},
+	{0x1D458, "<font>", []rune{0x006B}, false},
+	{0x1D459, "<font>", []rune{0x006C}, false},
+	{0x1D45A, "<font>", []rune{0x006D}, false},
+	{0x1D45B, "<font>", []rune{0x006E}, false},
+	{0x1D45C, "<font>", []rune{0x006F}, false},
+	{0x1D45D, "<font>", []rune{0x0070}, false},
+	{0x1D45E, "<font>", []rune{0x0071}, false},
+	{0x1D45F, "<font>", []rune{0x0072}, false},
+	{0x1D460, "<font>", []rune{0x0073}, false},
+	{0x1D461, "<font>", []rune{0x0074}, false},
+	{0x1D462, "<font>", []rune{0x0075}, false},
+	{0x1D463, "<font>", []rune{0x0076}, false},
+	{0x1D464, "<font>", []rune{0x0077}, false},
+	{0x1D465, "<font>", []rune{0x0078}, false},
+	{0x1D466, "<font>", []rune{0x0079}, false},
+	{0x1D467, "<font>", []rune{0x007A}, false},
+	{0x1D468, "<font>", []rune{0x0041}, false},
+	{0x1D469, "<font>", []rune{0x0042}, false},
+	{0x1D46A, "<font>", []rune{0x0043}, false},
+	{0x1D46B, "<font>", []rune{0x0044}, false},
+	{0x1D46C, "<font>", []rune{0x0045}, false},
+	{0x1D46D, "<font>", []rune{0x0046}, false},
+	{0x1D46E, "<font>", []rune{0x0047}, false},
+	{0x1D46F, "<font>", []rune{0x0048}, false},
+	{0x1D470, "<font>", []rune{0x0049}, false},
+	{0x1D471, "<font>", []rune{0x004A}, false},
+	{0x1D472, "<font>", []rune{0x004B}, false},
+	{0x1D473, "<font>", []rune{0x004C}, false},
+	{0x1D474, "<font>", []rune{0x004D}, false},
+	{0x1D475, "<font>", []rune{0x004E}, false},
+	{0x1D476, "<font>", []rune{0x004F}, false},
+	{0x1D477, "<font>", []rune{0x0050}, false},
+	{0x1D478, "<font>", []rune{0x0051}, false},
+	{0x1D479, "<font>", []rune{0x0052}, false},
+	{0x1D47A, "<font>", []rune{0x0053}, false},
+	{0x1D47B, "<font>", []rune{0x0054}, false},
+	{0x1D47C, "<font>", []rune{0x0055}, false},
+	{0x1D47D, "<font>", []rune{0x0056}, false},
+	{0x1D47E, "<font>", []rune{0x0057}, false},
+	{0x1D47F, "<font>", []rune{0x0058}, false},
+	{0x1D480, "<font>", []rune{0x0059}, false},
+	{0x1D481, "<font>", []rune{0x005A}, false},
+	{0x1D482, "<font>", []rune{0x0061}, false},
+	{0x1D483, "<font>", []rune{0x0062}, false},
+	{0x1D484, "<font>", []rune{0x0063}, false},
+	{0x1D485, "<font>", []rune{0x0064}, false},
+	{0x1D486, "<font>", []rune{0x0065}, false},
+	{0x1D487, "<font>", []rune{0x0066}, false},
+	{0x1D488, "<font>", []rune{0x0067}, false},
+	{0x1D489, "<font>", []rune{0x0068}, false},
+	{0x1D48A, "<font>", []rune{0x0069}, false},
+	{0x1D48B, "<font>", []rune{0x006A}, false},
+	{0x1D48C, "<font>", []rune{0x006B}, false},
+	{0x1D48D, "<font>", []rune{0x006C}, false},
+	{0x1D48E, "<font>", []rune{0x006D}, false},
+	{0x1D48F, "<font>", []rune{0x006E}, false},
+	{0x1D490, "<font>", []rune{0x006F}, false},
+	{0x1D491, "<font>", []rune{0x0070}, false},
+	{0x1D492, "<font>", []rune{0x0071}, false},
+	{0x1D493, "<font>", []rune{0x0072}, false},
+	{0x1D494, "<font>", []rune{0x0073}, false},
+	{0x1D495, "<font>", []rune{0x0074}, false},
+	{0x1D496, "<font>", []rune{0x0075}, false},
+	{0x1D497, "<font>", []rune{0x0076}, false},
+	{0x1D498, "<font>", []rune{0x0077}, false},
+	{0x1D499, "<font>", []rune{0x0078}, false},
+	{0x1D49A, "<font>", []rune{0x0079}, false},
+	{0x1D49B, "<font>", []rune{0x007A}, false},
+	{0x1D49C, "<font>", []rune{0x0041}, false},
+	{0x1D49E, "<font>", []rune{0x0043}, false},
+	{0x1D49F, "<font>", []rune{0x0044}, false},
+	{0x1D4A2, "<font>", []rune{0x0047}, false},
+	{0x1D4A5, "<font>", []rune{0x004A}, false},
+	{0x1D4A6, "<font>", []rune{0x004B}, false},
+	{0x1D4A9, "<font>", []rune{0x004E}, false},
+	{0x1D4AA, "<font>", []rune{0x004F}, false},
+	{0x1D4AB, "<font>", []rune{0x0050}, false},
+	{0x1D4AC, "<font>", []rune{0x0051}, false},
+	{0x1D4AE, "<font>", []rune{0x0053}, false},
+	{0x1D4AF, "<font>", []rune{0x0054}, false},
+	{0x1D4B0, "<font>", []rune{0x0055}, false},
+	{0x1D4B1, "<font>", []rune{0x0056}, false},
+	{0x1D4B2, "<font>", []rune{0x0057}, false},
+	{0x1D4B3, "<font>", []rune{0x0058}, false},
+	{0x1D4B4, "<font>", []rune{0x0059}, false},
+	{0x1D4B5, "<font>", []rune{0x005A}, false},
+	{0x1D4B6, "<font>", []rune{0x0061}, false},
+	{0x1D4B7, "<font>", []rune{0x0062}, false},
+	{0x1D4B8, "<font>", []rune{0x0063}, false},
+	{0x1D4B9, "<font>", []rune{0x0064}, false},
+	{0x1D4BB, "<font>", []rune{0x0066}, false},
+	{0x1D4BD, "<font>", []rune{0x0068}, false},
+	{0x1D4BE, "<font>", []rune{0x0069}, false},
+	{0x1D4BF, "<font>", []rune{0x006A}, false},
+	{0x1D4C0, "<font>", []rune{0x006B}, false},
+	{0x1D4C1, "<font>", []rune{0x006C}, false},
+	{0x1D4C2, "<font>", []rune{0x006D}, false},
+	{0x1D4C3, "<font>", []rune{0x006E}, false},
+	{0x1D4C5, "<font>", []rune{0x0070}, false},
+	{0x1D4C6, "<font>", []rune{0x0071}, false},
+	{0x1D4C7, "<font>", []rune{0x0072}, false},
+	{0x1D4C8, "<font>", []rune{0x0073}, false},
+	{0x1D4C9, "<font>", []rune{0x0074}, false},
+	{0x1D4CA, "<font>", []rune{0x0075}, false},
+	{0x1D4CB, "<font>", []rune{0x0076}, false},
+	{0x1D4CC, "<font>", []rune{0x0077}, false},
+	{0x1D4CD, "<font>", []rune{0x0078}, false},
+	{0x1D4CE, "<font>", []rune{0x0079}, false},
+	{0x1D4CF, "<font>", []rune{0x007A}, false},
+	{0x1D4D0, "<font>", []rune{0x0041}, false},
+	{0x1D4D1, "<font>", []rune{0x0042}, false},
+	{0x1D4D2, "<font>", []rune{0x0043}, false},
+	{0x1D4D3, "<font>", []rune{0x0044}, false},
+	{0x1D4D4, "<font>", []rune{0x0045}, false},
+	{0x1D4D5, "<font>", []rune{0x0046}, false},
+	{0x1D4D6, "<font>", []rune{0x0047}, false},
+	{0x1D4D7, "<font>", []rune{0x0048}, false},
+	{0x1D4D8, "<font>", []rune{0x0049}, false},
+	{0x1D4D9, "<font>", []rune{0x004A}, false},
+	{0x1D4DA, "<font>", []rune{0x004B}, false},
+	{0x1D4DB, "<font>", []rune{0x004C}, false},
+	{0x1D4DC, "<font>", []rune{0x004D}, false},
+	{0x1D4DD, "<font>", []rune{0x004E}, false},
+	{0x1D4DE, "<font>", []rune{0x004F}, false},
+	{0x1D4DF, "<font>", []rune{0x0050}, false},
+	{0x1D4E0, "<font>", []rune{0x0051}, false},
+	{0x1D4E1, "<font>", []rune{0x0052}, false},
+	{0x1D4E2, "<font>", []rune{0x0053}, false},
+	{0x1D4E3, "<font>", []rune{0x0054}, false},
+	{0x1D4E4, "<font>", []rune{0x0055}, false},
+	{0x1D4E5, "<font>", []rune{0x0056}, false},
+	{0x1D4E6, "<font>", []rune{0x0057}, false},
+	{0x1D4E7, "<font>", []rune{0x0058}, false},
+	{0x1D4E8, "<font>", []rune{0x0059}, false},
+	{0x1D4E9, "<font>", []rune{0x005A}, false},
+	{0x1D4EA, "<font>", []rune{0x0061}, false},
+	{0x1D4EB, "<font>", []rune{0x0062}, false},
+	{0x1D4EC, "<font>", []rune{0x0063}, false},
+	{0x1D4ED, "<font>", []rune{0x0064}, false},
+	{0x1D4EE, "<font>", []rune{0x0065}, false},
+	{0x1D4EF, "<font>", []rune{0x0066}, false},
+	{0x1D4F0, "<font>", []rune{0x0067}, false},
+	{0x1D4F1, "<font>", []rune{0x0068}, false},
+	{0x1D4F2, "<font>", []rune{0x0069}, false},
+	{0x1D4F3, "<font>", []rune{0x006A}, false},
+	{0x1D4F4, "<font>", []rune{0x006B}, false},
+	{0x1D4F5, "<font>", []rune{0x006C}, false},
+	{0x1D4F6, "<font>", []rune{0x006D}, false},
+	{0x1D4F7, "<font>", []rune{0x006E}, false},
+	{0x1D4F8, "<font>", []rune{0x006F}, false},
+	{0x1D4F9, "<font>", []rune{0x0070}, false},
+	{0x1D4FA, "<font>", []rune{0x0071}, false},
+	{0x1D4FB, "<font>", []rune{0x0072}, false},
+	{0x1D4FC, "<font>", []rune{0x0073}, false},
+	{0x1D4FD, "<font>", []rune{0x0074}, false},
+	{0x1D4FE, "<font>", []rune{0x0075}, false},
+	{0x1D4FF, "<font>", []rune{0x0076}, false},
+	{0x1D500, "<font>", []rune{0x0077}, false},
+	{0x1D501, "<font>", []rune{0x0078}, false},
+	{0x1D502, "<font>", []rune{0x0079}, false},
+	{0x1D503, "<font>", []rune{0x007A}, false},
+	{0x1D504, "<font>", []rune{0x0041}, false},
+	{0x1D505, "<font>", []rune{0x0042}, false},
+	{0x1D507, "<font>", []rune{0x0044}, false},
+	{0x1D508, "<font>", []rune{0x0045}, false},
+	{0x1D509, "<font>", []rune{0x0046}, false},
+	{0x1D50A, "<font>", []rune{0x0047}, false},
+	{0x1D50D, "<font>", []rune{0x004A}, false},
+	{0x1D50E, "<font>", []rune{0x004B}, false},
+	{0x1D50F, "<font>", []rune{0x004C}, false},
+	{0x1D510, "<font>", []rune{0x004D}, false},
+	{0x1D511, "<font>", []rune{0x004E}, false},
+	{0x1D512, "<font>", []rune{0x004F}, false},
+	{0x1D513, "<font>", []rune{0x0050}, false},
+	{0x1D514, "<font>", []rune{0x0051}, false},
+	{0x1D516, "<font>", []rune{0x0053}, false},
+	{0x1D517, "<font>", []rune{0x0054}, false},
+	{0x1D518, "<font>", []rune{0x0055}, false},
+	{0x1D519, "<font>", []rune{0x0056}, false},
+	{0x1D51A, "<font>", []rune{0x0057}, false},
+	{0x1D51B, "<font>", []rune{0x0058}, false},
+	{0x1D51C, "<font>", []rune{0x0059}, false},
+	{0x1D51E, "<font>", []rune{0x0061}, false},
+	{0x1D51F, "<font>", []rune{0x0062}, false},
+	{0x1D520, "<font>", []rune{0x0063}, false},
+	{0x1D521, "<font>", []rune{0x0064}, false},
+	{0x1D522, "<font>", []rune{0x0065}, false},
+	{0x1D523, "<font>", []rune{0x0066}, false},
+	{0x1D524, "<font>", []rune{0x0067}, false},
+	{0x1D525, "<font>", []rune{0x0068}, false},
+	{0x1D526, "<font>", []rune{0x0069}, false},
+	{0x1D527, "<font>", []rune{0x006A}, false},
+	{0x1D528, "<font>", []rune{0x006B}, false},
+	{0x1D529, "<font>", []rune{0x006C}, false},
+	{0x1D52A, "<font>", []rune{0x006D}, false},
+	{0x1D52B, "<font>", []rune{0x006E}, false},
+	{0x1D52C, "<font>", []rune{0x006F}, false},
+	{0x1D52D, "<font>", []rune{0x0070}, false},
+	{0x1D52E, "<font>", []rune{0x0071}, false},
+	{0x1D52F, "<font>", []rune{0x0072}, false},
+	{0x1D530, "<font>", []rune{0x0073}, false},
+	{0x1D531, "<font>", []rune{0x0074}, false},
+	{0x1D532, "<font>", []rune{0x0075}, false},
+	{0x1D533, "<font>", []rune{0x0076}, false},
+	{0x1D534, "<font>", []rune{0x0077}, false},
+	{0x1D535, "<font>", []rune{0x0078}, false},
+	{0x1D536, "<font>", []rune{0x0079}, false},
+	{0x1D537, "<font>", []rune{0x007A}, false},
+	{0x1D538, "<font>", []rune{0x0041}, false},
+	{0x1D539, "<font>", []rune{0x0042}, false},
+	{0x1D53B, "<font>", []rune{0x0044}, false},
+	{0x1D53C, "<font>", []rune{0x0045}, false},
+	{0x1D53D, "<font>", []rune{0x0046}, false},
+	{0x1D53E, "<font>", []rune{0x0047}, false},
+	{0x1D540, "<font>", []rune{0x0049}, false},
+	{0x1D541, "<font>", []rune{0x004A}, false},
+	{0x1D542, "<font>", []rune{0x004B}, false},
+	{0x1D543, "<font>", []rune{0x004C}, false},
+	{0x1D544, "<font>", []rune{0x004D}, false},
+	{0x1D546, "<font>", []rune{0x004F}, false},
+	{0x1D54A, "<font>", []rune{0x0053}, false},
+	{0x1D54B, "<font>", []rune{0x0054}, false},
+	{0x1D54C, "<font>", []rune{0x0055}, false},
+	{0x1D54D, "<font>", []rune{0x0056}, false},
+	{0x1D54E, "<font>", []rune{0x0057}, false},
+	{0x1D54F, "<font>", []rune{0x0058}, false},
+	{0x1D550, "<font>", []rune{0x0059}, false},
+	{0x1D552, "<font>", []rune{0x0061}, false},
+	{0x1D553, "<font>", []rune{0x0062}, false},
+	{0x1D554, "<font>", []rune{0x0063}, false},
+	{0x1D555, "<font>", []rune{0x0064}, false},
+	{0x1D556, "<font>", []rune{0x0065}, false},
+	{0x1D557, "<font>", []rune{0x0066}, false},
+	{0x1D558, "<font>", []rune{0x0067}, false},
+	{0x1D559, "<font>", []rune{0x0068}, false},
+	{0x1D55A, "<font>", []rune{0x0069}, false},
+	{0x1D55B, "<font>", []rune{0x006A}, false},
+	{0x1D55C, "<font>", []rune{0x006B}, false},
+	{0x1D55D, "<font>", []rune{0x006C}, false},
+	{0x1D55E, "<font>", []rune{0x006D}, false},
+	{0x1D55F, "<font>", []rune{0x006E}, false},
+	{0x1D560, "<font>", []rune{0x006F}, false},
+	{0x1D561, "<font>", []rune{0x0070}, false},
+	{0x1D562, "<font>", []rune{0x0071}, false},
+	{0x1D563, "<font>", []rune{0x0072}, false},
+	{0x1D564, "<font>", []rune{0x0073}, false},
+	{0x1D565, "<font>", []rune{0x0074}, false},
+	{0x1D566, "<font>", []rune{0x0075}, false},
+	{0x1D567, "<font>", []rune{0x0076}, false},
+	{0x1D568, "<font>", []rune{0x0077}, false},
+	{0x1D569, "<font>", []rune{0x0078}, false},
+	{0x1D56A, "<font>", []rune{0x0079}, false},
+	{0x1D56B, "<font>", []rune{0x007A}, false},
+	{0x1D56C, "<font>", []rune{0x0041}, false},
+	{0x1D56D, "<font>", []rune{0x0042}, false},
+	{0x1D56E, "<font>", []rune{0x0043}, false},
+	{0x1D56F, "<font>", []rune{0x0044}, false},
+	{0x1D570, "<font>", []rune{0x0045}, false},
+	{0x1D571, "<font>", []rune{0x0046}, false},
+	{0x1D572, "<font>", []rune{0x0047}, false},
+	{0x1D573, "<font>", []rune{0x0048}, false},
+	{0x1D574, "<font>", []rune{0x0049}, false},
+	{0x1D575, "<font>", []rune{0x004A}, false},
+	{0x1D576, "<font>", []rune{0x004B}, false},
+	{0x1D577, "<font>", []rune{0x004C}, false},
+	{0x1D578, "<font>", []rune{0x004D}, false},
+	{0x1D579, "<font>", []rune{0x004E}, false},
+	{0x1D57A, "<font>", []rune{0x004F}, false},
+	{0x1D57B, "<font>", []rune{0x0050}, false},
+	{0x1D57C, "<font>", []rune{0x0051}, false},
+	{0x1D57D, "<font>", []rune{0x0052}, false},
+	{0x1D57E, "<font>", []rune{0x0053}, false},
+	{0x1D57F, "<font>", []rune{0x0054}, false},
+	{0x1D580, "<font>", []rune{0x0055}, false},
+	{0x1D581, "<font>", []rune{0x0056}, false},
+	{0x1D582, "<font>", []rune{0x0057}, false},
+	{0x1D583, "<font>", []rune{0x0058}, false},
+	{0x1D584, "<font>", []rune{0x0059}, false},
+	{0x1D585, "<font>", []rune{0x005A}, false},
+	{0x1D586, "<font>", []rune{0x0061}, false},
+	{0x1D587, "<font>", []rune{0x0062}, false},
+	{0x1D588, "<font>", []rune{0x0063}, false},
+	{0x1D589, "<font>", []rune{0x0064}, false},
+	{0x1D58A, "<font>", []rune{0x0065}, false},
+	{0x1D58B, "<font>", []rune{0x0066}, false},
+	{0x1D58C, "<font>", []rune{0x0067}, false},
+	{0x1D58D, "<font>", []rune{0x0068}, false},
+	{0x1D58E, "<font>", []rune{0x0069}, false},
+	{0x1D58F, "<font>", []rune{0x006A}, false},
+	{0x1D590, "<font>", []rune{0x006B}, false},
+	{0x1D591, "<font>", []rune{0x006C}, false},
+	{0x1D592, "<font>", []rune{0x006D}, false},
+	{0x1D593, "<font>", []rune{0x006E}, false},
+	{0x1D594, "<font>", []rune{0x006F}, false},
+	{0x1D595, "<font>", []rune{0x0070}, false},
+	{0x1D596, "<font>", []rune{0x0071}, false},
+	{0x1D597, "<font>", []rune{0x0072}, false},
+	{0x1D598, "<font>", []rune{0x0073}, false},
+	{0x1D599, "<font>", []rune{0x0074}, false},
+	{0x1D59A, "<font>", []rune{0x0075}, false},
+	{0x1D59B, "<font>", []rune{0x0076}, false},
+	{0x1D59C, "<font>", []rune{0x0077}, false},
+	{0x1D59D, "<font>", []rune{0x0078}, false},
+	{0x1D59E, "<font>", []rune{0x0079}, false},
+	{0x1D59F, "<font>", []rune{0x007A}, false},
+	{0x1D5A0, "<font>", []rune{0x0041}, false},
+	{0x1D5A1, "<font>", []rune{0x0042}, false},
+	{0x1D5A2, "<font>", []rune{0x0043}, false},
+	{0x1D5A3, "<font>", []rune{0x0044}, false},
+	{0x1D5A4, "<font>", []rune{0x0045}, false},
+	{0x1D5A5, "<font>", []rune{0x0046}, false},
+	{0x1D5A6, "<font>", []rune{0x0047}, false},
+	{0x1D5A7, "<font>", []rune{0x0048}, false},
+	{0x1D5A8, "<font>", []rune{0x0049}, false},
+	{0x1D5A9, "<font>", []rune{0x004A}, false},
+	{0x1D5AA, "<font>", []rune{0x004B}, false},
+	{0x1D5AB, "<font>", []rune{0x004C}, false},
+	{0x1D5AC, "<font>", []rune{0x004D}, false},
+	{0x1D5AD, "<font>", []rune{0x004E}, false},
+	{0x1D5AE, "<font>", []rune{0x004F}, false},
+	{0x1D5AF, "<font>", []rune{0x0050}, false},
+	{0x1D5B0, "<font>", []rune{0x0051}, false},
+	{0x1D5B1, "<font>", []rune{0x0052}, false},
+	{0x1D5B2, "<font>", []rune{0x0053}, false},
+	{0x1D5B3, "<font>", []rune{0x0054}, false},
+	{0x1D5B4, "<font>", []rune{0x0055}, false},
+	{0x1D5B5, "<font>", []rune{0x0056}, false},
+	{0x1D5B6, "<font>", []rune{0x0057}, false},
+	{0x1D5B7, "<font>", []rune{0x0058}, false},
+	{0x1D5B8, "<font>", []rune{0x0059}, false},
+	{0x1D5B9, "<font>", []rune{0x005A}, false},
+	{0x1D5BA, "<font>", []rune{0x0061}, false},
+	{0x1D5BB, "<font>", []rune{0x0062}, false},
+	{0x1D5BC, "<font>", []rune{0x0063}, false},
+	{0x1D5BD, "<font>", []rune{0x0064}, false},
+	{0x1D5BE, "<font>", []rune{0x0065}, false},
+	{0x1D5BF, "<font>", []rune{0x0066}, false},
+	{0x1D5C0, "<font>", []rune{0x0067}, false},
+	{0x1D5C1, "<font>", []rune{0x0068}, false},
+	{0x1D5C2, "<font>", []rune{0x0069}, false},
+	{0x1D5C3, "<font>", []rune{0x006A}, false},
+	{0x1D5C4, "<font>", []rune{0x006B}, false},
+	{0x1D5C5, "<font>", []rune{0x006C}, false},
+	{0x1D5C6, "<font>", []rune{0x006D}, false},
+	{0x1D5C7, "<font>", []rune{0x006E}, false},
+	{0x1D5C8, "<font>", []rune{0x006F}, false},
+	{0x1D5C9, "<font>", []rune{0x0070}, false},
+	{0x1D5CA, "<font>", []rune{0x0071}, false},
+	{0x1D5CB, "<font>", []rune{0x0072}, false},
+	{0x1D5CC, "<font>", []rune{0x0073}, false},
+	{0x1D5CD, "<font>", []rune{0x0074}, false},
+	{0x1D5CE, "<font>", []rune{0x0075}, false},
+	{0x1D5CF, "<font>", []rune{0x0076}, false},
+	{0x1D5D0, "<font>", []rune{0x0077}, false},
+	{0x1D5D1, "<font>", []rune{0x0078}, false},
+	{0x1D5D2, "<font>", []rune{0x0079}, false},
+	{0x1D5D3, "<font>", []rune{0x007A}, false},
+	{0x1D5D4, "<font>", []rune{0x0041}, false},
+	{0x1D5D5, "<font>", []rune{0x0042}, false},
+	{0x1D5D6, "<font>", []rune{0x0043}, false},
+	{0x1D5D7, "<font>", []rune{0x0044}, false},
+	{0x1D5D8, "<font>", []rune{0x0045}, false},
+	{0x1D5D9, "<font>", []rune{0x0046}, false},
+	{0x1D5DA, "<font>", []rune{0x0047}, false},
+	{0x1D5DB, "<font>", []rune{0x0048}, false},
+	{0x1D5DC, "<font>", []rune{0x0049}, false},
+	{0x1D5DD, "<font>", []rune{0x004A}, false},
+	{0x1D5DE, "<font>", []rune{0x004B}, false},
+	{0x1D5DF, "<font>", []rune{0x004C}, false},
+	{0x1D5E0, "<font>", []rune{0x004D}, false},
+	{0x1D5E1, "<font>", []rune{0x004E}, false},
+	{0x1D5E2, "<font>", []rune{0x004F}, false},
+	{0x1D5E3, "<font>", []rune{0x0050}, false},
+	{0x1D5E4, "<font>", []rune{0x0051}, false},
+	{0x1D5E5, "<font>", []rune{0x0052}, false},
+	{0x1D5E6, "<font>", []rune{0x0053}, false},
+	{0x1D5E7, "<font>", []rune{0x0054}, false},
+	{0x1D5E8, "<font>", []rune{0x0055}, false},
+	{0x1D5E9, "<font>", []rune{0x0056}, false},
+	{0x1D5EA, "<font>", []rune{0x0057}, false},
+	{0x1D5EB, "<font>", []rune{0x0058}, false},
+	{0x1D5EC, "<font>", []rune{0x0059}, false},
+	{0x1D5ED, "<font>", []rune{0x005A}, false},
+	{0x1D5EE, "<font>", []rune{0x0061}, false},
+	{0x1D5EF, "<font>", []rune{0x0062}, false},
+	{0x1D5F0, "<font>", []rune{0x0063}, false},
+	{0x1D5F1, "<font>", []rune{0x0064}, false},
+	{0x1D5F2, "<font>", []rune{0x0065}, false},
+	{0x1D5F3, "<font>", []rune{0x0066}, false},
+	{0x1D5F4, "<font>", []rune{0x0067}, false},
+	{0x1D5F5, "<font>", []rune{0x0068}, false},
+	{0x1D5F6, "<font>", []rune{0x0069}, false},
+	{0x1D5F7, "<font>", []rune{0x006A}, false},
+	{0x1D5F8, "<font>", []rune{0x006B}, false},
+	{0x1D5F9, "<font>", []rune{0x006C}, false},
+	{0x1D5FA, "<font>", []rune{0x006D}, false},
+	{0x1D5FB, "<font>", []rune{0x006E}, false},
+	{0x1D5FC, "<font>", []rune{0x006F}, false},
+	{0x1D5FD, "<font>", []rune{0x0070}, false},
+	{0x1D5FE, "<font>", []rune{0x0071}, false},
+	{0x1D5FF, "<font>", []rune{0x0072}, false},
+	{0x1D600, "<font>", []rune{0x0073}, false},
+	{0x1D601, "<font>", []rune{0x0074}, false},
+	{0x1D602, "<font>", []rune{0x0075}, false},
+	{0x1D603, "<font>", []rune{0x0076}, false},
+	{0x1D604, "<font>", []rune{0x0077}, false},
+	{0x1D605, "<font>", []rune{0x0078}, false},
+	{0x1D606, "<font>", []rune{0x0079}, false},
+	{0x1D607, "<font>", []rune{0x007A}, false},
+	{0x1D608, "<font>", []rune{0x0041}, false},
+	{0x1D609, "<font>", []rune{0x0042}, false},
+	{0x1D60A, "<font>", []rune{0x0043}, false},
+	{0x1D60B, "<font>", []rune{0x0044}, false},
+	{0x1D60C, "<font>", []rune{0x0045}, false},
+	{0x1D60D, "<font>", []rune{0x0046}, false},
+	{0x1D60E, "<font>", []rune{0x0047}, false},
+	{0x1D60F, "<font>", []rune{0x0048}, false},
+	{0x1D610, "<font>", []rune{0x0049}, false},
+	{0x1D611, "<font>", []rune{0x004A}, false},
+	{0x1D612, "<font>", []rune{0x004B}, false},
+	{0x1D613, "<font>", []rune{0x004C}, false},
+	{0x1D614, "<font>", []rune{0x004D}, false},
+	{0x1D615, "<font>", []rune{0x004E}, false},
+	{0x1D616, "<font>", []rune{0x004F}, false},
+	{0x1D617, "<font>", []rune{0x0050}, false},
+	{0x1D618, "<font>", []rune{0x0051}, false},
+	{0x1D619, "<font>", []rune{0x0052}, false},
+	{0x1D61A, "<font>", []rune{0x0053}, false},
+	{0x1D61B, "<font>", []rune{0x0054}, false},
+	{0x1D61C, "<font>", []rune{0x0055}, false},
+	{0x1D61D, "<font>", []rune{0x0056}, false},
+	{0x1D61E, "<font>", []rune{0x0057}, false},
+	{0x1D61F, "<font>", []rune{0x0058}, false},
+	{0x1D620, "<font>", []rune{0x0059}, false},
+	{0x1D621, "<font>", []rune{0x005A}, false},
+	{0x1D622, "<font>", []rune{0x0061}, false},
+	{0x1D623, "<font>", []rune{0x0062}, false},
+	{0x1D624, "<font>", []rune{0x0063}, false},
+	{0x1D625, "<font>", []rune{0x0064}, false},
+	{0x1D626, "<font>", []rune{0x0065}, false},
+	{0x1D627, "<font>", []rune{0x0066}, false},
+	{0x1D628, "<font>", []rune{0x0067}, false},
+	{0x1D629, "<font>", []rune{0x0068}, false},
+	{0x1D62A, "<font>", []rune{0x0069}, false},
+	{0x1D62B, "<font>", []rune{0x006A}, false},
+	{0x1D62C, "<font>", []rune{0x006B}, false},
+	{0x1D62D, "<font>", []rune{0x006C}, false},
+	{0x1D62E, "<font>", []rune{0x006D}, false},
+	{0x1D62F, "<font>", []rune{0x006E}, false},
+	{0x1D630, "<font>", []rune{0x006F}, false},
+	{0x1D631, "<font>", []rune{0x0070}, false},
+	{0x1D632, "<font>", []rune{0x0071}, false},
+	{0x1D633, "<font>", []rune{0x0072}, false},
+	{0x1D634, "<font>", []rune{0x0073}, false},
+	{0x1D635, "<font>", []rune{0x0074}, false},
+	{0x1D636, "<font>", []rune{0x0075}, false},
+	{0x1D637, "<font>", []rune{0x0076}, false},
+	{0x1D638, "<font>", []rune{0x0077}, false},
+	{0x1D639, "<font>", []rune{0x0078}, false},
+	{0x1D63A, "<font>", []rune{0x0079}, false},
+	{0x1D63B, "<font>", []rune{0x007A}, false},
+	{0x1D63C, "<font>", []rune{0x0041}, false},
+	{0x1D63D, "<font>", []rune{0x0042}, false},
+	{0x1D63E, "<font>", []rune{0x0043}, false},
+	{0x1D63F, "<font>", []rune{0x0044}, false},
+	{0x1D640, "<font>", []rune{0x0045}, false},
+	{0x1D641, "<font>", []rune{0x0046}, false},
+	{0x1D642, "<font>", []rune{0x0047}, false},
+	{0x1D643, "<font>", []rune{0x0048}, false},
+	{0x1D644, "<font>", []rune{0x0049}, false},
+	{0x1D645, "<font>", []rune{0x004A}, false},
+	{0x1D646, "<font>", []rune{0x004B}, false},
+	{0x1D647, "<font>", []rune{0x004C}, false},
+	{0x1D648, "<font>", []rune{0x004D}, false},
+	{0x1D649, "<font>", []rune{0x004E}, false},
+	{0x1D64A, "<font>", []rune{0x004F}, false},
+	{0x1D64B, "<font>", []rune{0x0050}, false},
+	{0x1D64C, "<font>", []rune{0x0051}, false},
+	{0x1D64D, "<font>", []rune{0x0052}, false},
+	{0x1D64E, "<font>", []rune{0x0053}, false},
+	{0x1D64F, "<font>", []rune{0x0054}, false},
+	{0x1D650, "<font>", []rune{0x0055}, false},
+	{0x1D651, "<font>", []rune{0x0056}, false},
+	{0x1D652, "<font>", []rune{0x0057}, false},
+	{0x1D653, "<font>", []rune{0x0058}, false},
+	{0x1D654, "<font>", []rune{0x0059}, false},
+	{0x1D655, "<font>", []rune{0x005A}, false},
+	{0x1D656, "<font>", []rune{0x0061}, false},
+	{0x1D657, "<font>", []rune{0x0062}, false},
+	{0x1D658, "<font>", []rune{0x0063}, false},
+	{0x1D659, "<font>", []rune{0x0064}, false},
+	{0x1D65A, "<font>", []rune{0x0065}, false},
+	{0x1D65B, "<font>", []rune{0x0066}, false},
+	{0x1D65C, "<font>", []rune{0x0067}, false},
+	{0x1D65D, "<font>", []rune{0x0068}, false},
+	{0x1D65E, "<font>", []rune{0x0069}, false},
+	{0x1D65F, "<font>", []rune{0x006A}, false},
+	{0x1D660, "<font>", []rune{0x006B}, false},
+	{0x1D661, "<font>", []rune{0x006C}, false},
+	{0x1D662, "<font>", []rune{0x006D}, false},
+	{0x1D663, "<font>", []rune{0x006E}, false},
+	{0x1D664, "<font>", []rune{0x006F}, false},
+	{0x1D665, "<font>", []rune{0x0070}, false},
+	{0x1D666, "<font>", []rune{0x0071}, false},
+	{0x1D667, "<font>", []rune{0x0072}, false},
+	{0x1D668, "<font>", []rune{0x0073}, false},
+	{0x1D669, "<font>", []rune{0x0074}, false},
+	{0x1D66A, "<font>", []rune{0x0075}, false},
+	{0x1D66B, "<font>", []rune{0x0076}, false},
+	{0x1D66C, "<font>", []rune{0x0077}, false},
+	{0x1D66D, "<font>", []rune{0x0078}, false},
+	{0x1D66E, "<font>", []rune{0x0079}, false},
+	{0x1D66F, "<font>", []rune{0x007A}, false},
+	{0x1D670, "<font>", []rune{0x0041}, false},
+	{0x1D671, "<font>", []rune{0x0042}, false},
+	{0x1D672, "<font>", []rune{0x0043}, false},
+	{0x1D673, "<font>", []rune{0x0044}, false},
+	{0x1D674, "<font>", []rune{0x0045}, false},
+	{0x1D675, "<font>", []rune{0x0046}, false},
+	{0x1D676, "<font>", []rune{0x0047}, false},
+	{0x1D677, "<font>", []rune{0x0048}, false},
+	{0x1D678, "<font>", []rune{0x0049}, false},
+	{0x1D679, "<font>", []rune{0x004A}, false},
+	{0x1D67A, "<font>", []rune{0x004B}, false},
+	{0x1D67B, "<font>", []rune{0x004C}, false},
+	{0x1D67C, "<font>", []rune{0x004D}, false},
+	{0x1D67D, "<font>", []rune{0x004E}, false},
+	{0x1D67E, "<font>", []rune{0x004F}, false},
+	{0x1D67F, "<font>", []rune{0x0050}, false},
+	{0x1D680, "<font>", []rune{0x0051}, false},
+	{0x1D681, "<font>", []rune{0x0052}, false},
+	{0x1D682, "<font>", []rune{0x0053}, false},
+	{0x1D683, "<font>", []rune{0x0054}, false},
+	{0x1D684, "<font>", []rune{0x0055}, false},
+	{0x1D685, "<font>", []rune{0x0056}, false},
+	{0x1D686, "<font>", []rune{0x0057}, false},
+	{0x1D687, "<font>", []rune{0x0058}, false},
+	{0x1D688, "<font>", []rune{0x0059}, false},
+	{0x1D689, "<font>", []rune{0x005A}, false},
+	{0x1D68A, "<font>", []rune{0x0061}, false},
+	{0x1D68B, "<font>", []rune{0x0062}, false},
+	{0x1D68C, "<font>", []rune{0x0063}, false},
+	{0x1D68D, "<font>", []rune{0x0064}, false},
+	{0x1D68E, "<font>", []rune{0x0065}, false},
+	{0x1D68F, "<font>", []rune{0x0066}, false},
+	{0x1D690, "<font>", []rune{0x0067}, false},
+	{0x1D691, "<font>", []rune{0x0068}, false},
+	{0x1D692, "<font>", []rune{0x0069}, false},
+	{0x1D693, "<font>", []rune{0x006A}, false},
+	{0x1D694, "<font>", []rune{0x006B}, false},
+	{0x1D695, "<font>", []rune{0x006C}, false},
+	{0x1D696, "<font>", []rune{0x006D}, false},
+	{0x1D697, "<font>", []rune{0x006E}, false},
+	{0x1D698, "<font>", []rune{0x006F}, false},
+	{0x1D699, "<font>", []rune{0x0070}, false},
+	{0x1D69A, "<font>", []rune{0x0071}, false},
+	{0x1D69B, "<font>", []rune{0x0072}, false},
+	{0x1D69C, "<font>", []rune{0x0073}, false},
+	{0x1D69D, "<font>", []rune{0x0074}, false},
+	{0x1D69E, "<font>", []rune{0x0075}, false},
+	{0x1D69F, "<font>", []rune{0x0076}, false},
+	{0x1D6A0, "<font>", []rune{0x0077}, false},
+	{0x1D6A1, "<font>", []rune{0x0078}, false},
+	{0x1D6A2, "<font>", []rune{0x0079}, false},
+	{0x1D6A3, "<font>", []rune{0x007A}, false},
+	{0x1D6A4, "<font>", []rune{0x0131}, false},
+	{0x1D6A5, "<font>", []rune{0x0237}, false},
+	{0x1D6A8, "<font>", []rune{0x0391}, false},
+	{0x1D6A9, "<font>", []rune{0x0392}, false},
+	{0x1D6AA, "<font>", []rune{0x0393}, false},
+	{0x1D6AB, "<font>", []rune{0x0394}, false},
+	{0x1D6AC, "<font>", []rune{0x0395}, false},
+	{0x1D6AD, "<font>", []rune{0x0396}, false},
+	{0x1D6AE, "<font>", []rune{0x0397}, false},
+	{0x1D6AF, "<font>", []rune{0x0398}, false},
+	{0x1D6B0, "<font>", []rune{0x0399}, false},
+	{0x1D6B1, "<font>", []rune{0x039A}, false},
+	{0x1D6B2, "<font>", []rune{0x039B}, false},
+	{0x1D6B3, "<font>", []rune{0x039C}, false},
+	{0x1D6B4, "<font>", []rune{0x039D}, false},
+	{0x1D6B5, "<font>", []rune{0x039E}, false},
+	{0x1D6B6, "<font>", []rune{0x039F}, false},
+	{0x1D6B7, "<font>", []rune{0x03A0}, false},
+	{0x1D6B8, "<font>", []rune{0x03A1}, false},
+	{0x1D6B9, "<font>", []rune{0x03F4}, false},
+	{0x1D6BA, "<font>", []rune{0x03A3}, false},
+	{0x1D6BB, "<font>", []rune{0x03A4}, false},
+	{0x1D6BC, "<font>", []rune{0x03A5}, false},
+	{0x1D6BD, "<font>", []rune{0x03A6}, false},
+	{0x1D6BE, "<font>", []rune{0x03A7}, false},
+	{0x1D6BF, "<font>", []rune{0x03A8}, false},
+	{0x1D6C0, "<font>", []rune{0x03A9}, false},
+	{0x1D6C1, "<font>", []rune{0x2207}, false},
+	{0x1D6C2, "<font>", []rune{0x03B1}, false},
+	{0x1D6C3, "<font>", []rune{0x03B2}, false},
+	{0x1D6C4, "<font>", []rune{0x03B3}, false},
+	{0x1D6C5, "<font>", []rune{0x03B4}, false},
+	{0x1D6C6, "<font>", []rune{0x03B5}, false},
+	{0x1D6C7, "<font>", []rune{0x03B6}, false},
+	{0x1D6C8, "<font>", []rune{0x03B7}, false},
+	{0x1D6C9, "<font>", []rune{0x03B8}, false},
+	{0x1D6CA, "<font>", []rune{0x03B9}, false},
+	{0x1D6CB, "<font>", []rune{0x03BA}, false},
+	{0x1D6CC, "<font>", []rune{0x03BB}, false},
+	{0x1D6CD, "<font>", []rune{0x03BC}, false},
+	{0x1D6CE, "<font>", []rune{0x03BD}, false},
+	{0x1D6CF, "<font>", []rune{0x03BE}, false},
+	{0x1D6D0, "<font>", []rune{0x03BF}, false},
+	{0x1D6D1, "<font>", []rune{0x03C0}, false},
+	{0x1D6D2, "<font>", []rune{0x03C1}, false},
+	{0x1D6D3, "<font>", []rune{0x03C2}, false},
+	{0x1D6D4, "<font>", []rune{0x03C3}, false},
+	{0x1D6D5, "<font>", []rune{0x03C4}, false},
+	{0x1D6D6, "<font>", []rune{0x03C5}, false},
+	{0x1D6D7, "<font>", []rune{0x03C6}, false},
+	{0x1D6D8, "<font>", []rune{0x03C7}, false},
+	{0x1D6D9, "<font>", []rune{0x03C8}, false},
+	{0x1D6DA, "<font>", []rune{0x03C9}, false},
+	{0x1D6DB, "<font>", []rune{0x2202}, false},
+	{0x1D6DC, "<font>", []rune{0x03F5}, false},
+	{0x1D6DD, "<font>", []rune{0x03D1}, false},
+	{0x1D6DE, "<font>", []rune{0x03F0}, false},
+	{0x1D6DF, "<font>", []rune{0x03D5}, false},
+	{0x1D6E0, "<font>", []rune{0x03F1}, false},
+	{0x1D6E1, "<font>", []rune{0x03D6}, false},
+	{0x1D6E2, "<font>", []rune{0x0391}, false},
+	{0x1D6E3, "<font>", []rune{0x0392}, false},
+	{0x1D6E4, "<font>", []rune{0x0393}, false},
+	{0x1D6E5, "<font>", []rune{0x0394}, false},
+	{0x1D6E6, "<font>", []rune{0x0395}, false},
+	{0x1D6E7, "<font>", []rune{0x0396}, false},
+	{0x1D6E8, "<font>", []rune{0x0397}, false},
+	{0x1D6E9, "<font>", []rune{0x0398}, false},
+	{0x1D6EA, "<font>", []rune{0x0399}, false},
+	{0x1D6EB, "<font>", []rune{0x039A}, false},
+	{0x1D6EC, "<font>", []rune{0x039B}, false},
+	{0x1D6ED, "<font>", []rune{0x039C}, false},
+	{0x1D6EE, "<font>", []rune{0x039D}, false},
+	{0x1D6EF, "<font>", []rune{0x039E}, false},
+	{0x1D6F0, "<font>", []rune{0x039F}, false},
+	{0x1D6F1, "<font>", []rune{0x03A0}, false},
+	{0x1D6F2, "<font>", []rune{0x03A1}, false},
+	{0x1D6F3, "<font>", []rune{0x03F4}, false},
+	{0x1D6F4, "<font>", []rune{0x03A3}, false},
+	{0x1D6F5, "<font>", []rune{0x03A4}, false},
+	{0x1D6F6, "<font>", []rune{0x03A5}, false},
+	{0x1D6F7, "<font>", []rune{0x03A6}, false},
+	{0x1D6F8, "<font>", []rune{0x03A7}, false},
+	{0x1D6F9, "<font>", []rune{0x03A8}, false},
+	{0x1D6FA, "<font>", []rune{0x03A9}, false},
+	{0x1D6FB, "<font>", []rune{0x2207}, false},
+	{0x1D6FC, "<font>", []rune{0x03B1}, false},
+	{0x1D6FD, "<font>", []rune{0x03B2}, false},
+	{0x1D6FE, "<font>", []rune{0x03B3}, false},
+	{0x1D6FF, "<font>", []rune{0x03B4}, false},
+	{0x1D700, "<font>", []rune{0x03B5}, false},
+	{0x1D701, "<font>", []rune{0x03B6}, false},
+	{0x1D702, "<font>", []rune{0x03B7}, false},
+	{0x1D703, "<font>", []rune{0x03B8}, false},
+	{0x1D704, "<font>", []rune{0x03B9}, false},
+	{0x1D705, "<font>", []rune{0x03BA}, false},
+	{0x1D706, "<font>", []rune{0x03BB}, false},
+	{0x1D707, "<font>", []rune{0x03BC}, false},
+	{0x1D708, "<font>", []rune{0x03BD}, false},
+	{0x1D709, "<font>", []rune{0x03BE}, false},
+	{0x1D70A, "<font>", []rune{0x03BF}, false},
+	{0x1D70B, "<font>", []rune{0x03C0}, false},
+	{0x1D70C, "<font>", []rune{0x03C1}, false},
+	{0x1D70D, "<font>", []rune{0x03C2}, false},
+	{0x1D70E, "<font>", []rune{0x03C3}, false},
+	{0x1D70F, "<font>", []rune{0x03C4}, false},
+	{0x1D710, "<font>", []rune{0x03C5}, false},
+	{0x1D711, "<font>", []rune{0x03C6}, false},
+	{0x1D712, "<font>", []rune{0x03C7}, false},
+	{0x1D713, "<font>", []rune{0x03C8}, false},
+	{0x1D714, "<font>", []rune{0x03C9}, false},
+	{0x1D715, "<font>", []rune{0x2202}, false},
+	{0x1D716, "<font>", []rune{0x03F5}, false},
+	{0x1D717, "<font>", []rune{0x03D1}, false},
+	{0x1D718, "<font>", []rune{0x03F0}, false},
+	{0x1D719, "<font>", []rune{0x03D5}, false},
+	{0x1D71A, "<font>", []rune{0x03F1}, false},
+	{0x1D71B, "<font>", []rune{0x03D6}, false},
+	{0x1D71C, "<font>", []rune{0x0391}, false},
+	{0x1D71D, "<font>", []rune{0x0392}, false},
+	{0x1D71E, "<font>", []rune{0x0393}, false},
+	{0x1D71F, "<font>", []rune{0x0394}, false},
+	{0x1D720, "<font>", []rune{0x0395}, false},
+	{0x1D721, "<font>", []rune{0x0396}, false},
+	{0x1D722, "<font>", []rune{0x0397}, false},
+	{0x1D723, "<font>", []rune{0x0398}, false},
+	{0x1D724, "<font>", []rune{0x0399}, false},
+	{0x1D725, "<font>", []rune{0x039A}, false},
+	{0x1D726, "<font>", []rune{0x039B}, false},
+	{0x1D727, "<font>", []rune{0x039C}, false},
+	{0x1D728, "<font>", []rune{0x039D}, false},
+	{0x1D729, "<font>", []rune{0x039E}, false},
+	{0x1D72A, "<font>", []rune{0x039F}, false},
+	{0x1D72B, "<font>", []rune{0x03A0}, false},
+	{0x1D72C, "<font>", []rune{0x03A1}, false},
+	{0x1D72D, "<font>", []rune{0x03F4}, false},
+	{0x1D72E, "<font>", []rune{0x03A3}, false},
+	{0x1D72F, "<font>", []rune{0x03A4}, false},
+	{0x1D730, "<font>", []rune{0x03A5}, false},
+	{0x1D731, "<font>", []rune{0x03A6}, false},
+	{0x1D732, "<font>", []rune{0x03A7}, false},
+	{0x1D733, "<font>", []rune{0x03A8}, false},
+	{0x1D734, "<font>", []rune{0x03A9}, false},
+	{0x1D735, "<font>", []rune{0x2207}, false},
+	{0x1D736, "<font>", []rune{0x03B1}, false},
+	{0x1D737, "<font>", []rune{0x03B2}, false},
+	{0x1D738, "<font>", []rune{0x03B3}, false},
+	{0x1D739, "<font>", []rune{0x03B4}, false},
+	{0x1D73A, "<font>", []rune{0x03B5}, false},
+	{0x1D73B, "<font>", []rune{0x03B6}, false},
+	{0x1D73C, "<font>", []rune{0x03B7}, false},
+	{0x1D73D, "<font>", []rune{0x03B8}, false},
+	{0x1D73E, "<font>", []rune{0x03B9}, false},
+	{0x1D73F, "<font>", []rune{0x03BA}, false},
+	{0x1D740, "<font>", []rune{0x03BB}, false},
+	{0x1D741, "<font>", []rune{0x03BC}, false},
+	{0x1D742, "<font>", []rune{0x03BD}, false},
+	{0x1D743, "<font>", []rune{0x03BE}, false},
+	{0x1D744, "<font>", []rune{0x03BF}, false},
+	{0x1D745, "<font>", []rune{0x03C0}, false},
+	{0x1D746, "<font>", []rune{0x03C1}, false},
+	{0x1D747, "<font>", []rune{0x03C2}, false},
+	{0x1D748, "<font>", []rune{0x03C3}, false},
+	{0x1D749, "<font>", []rune{0x03C4}, false},
+	{0x1D74A, "<font>", []rune{0x03C5}, false},
+	{0x1D74B, "<font>", []rune{0x03C6}, false},
+	{0x1D74C, "<font>", []rune{0x03C7}, false},
+	{0x1D74D, "<font>", []rune{0x03C8}, false},
+	{0x1D74E, "<font>", []rune{0x03C9}, false},
+	{0x1D74F, "<font>", []rune{0x2202}, false},
+	{0x1D750, "<font>", []rune{0x03F5}, false},
+	{0x1D751, "<font>", []rune{0x03D1}, false},
+	{0x1D752, "<font>", []rune{0x03F0}, false},
+	{0x1D753, "<font>", []rune{0x03D5}, false},
+	{0x1D754, "<font>", []rune{0x03F1}, false},
+	{0x1D755, "<font>", []rune{0x03D6}, false},
+	{0x1D756, "<font>", []rune{0x0391}, false},
+	{0x1D757, "<font>", []rune{0x0392}, false},
+	{0x1D758, "<font>", []rune{0x0393}, false},
+	{0x1D759, "<font>", []rune{0x0394}, false},
+	{0x1D75A, "<font>", []rune{0x0395}, false},
+	{0x1D75B, "<font>", []rune{0x0396}, false},
+	{0x1D75C, "<font>", []rune{0x0397}, false},
+	{0x1D75D, "<font>", []rune{0x0398}, false},
+	{0x1D75E, "<font>", []rune{0x0399}, false},
+	{0x1D75F, "<font>", []rune{0x039A}, false},
+	{0x1D760, "<font>", []rune{0x039B}, false},
+	{0x1D761, "<font>", []rune{0x039C}, false},
+	{0x1D762, "<font>", []rune{0x039D}, false},
+	{0x1D763, "<font>", []rune{0x039E}, false},
+	{0x1D764, "<font>", []rune{0x039F}, false},
+	{0x1D765, "<font>", []rune{0x03A0}, false},
+	{0x1D766, "<font>", []rune{0x03A1}, false},
+	{0x1D767, "<font>", []rune{0x03F4}, false},
+	{0x1D768, "<font>", []rune{0x03A3}, false},
+	{0x1D769, "<font>", []rune{0x03A4}, false},
+	{0x1D76A, "<font>", []rune{0x03A5}, false},
+	{0x1D76B, "<font>", []rune{0x03A6}, false},
+	{0x1D76C, "<font>", []rune{0x03A7}, false},
+	{0x1D76D, "<font>", []rune{0x03A8}, false},
+	{0x1D76E, "<font>", []rune{0x03A9}, false},
+	{0x1D76F, "<font>", []rune{0x2207}, false},
+	{0x1D770, "<font>", []rune{0x03B1}, false},
+	{0x1D771, "<font>", []rune{0x03B2}, false},
+	{0x1D772, "<font>", []rune{0x03B3}, false},
+	{0x1D773, "<font>", []rune{0x03B4}, false},
+	{0x1D774, "<font>", []rune{0x03B5}, false},
+	{0x1D775, "<font>", []rune{0x03B6}, false},
+	{0x1D776, "<font>", []rune{0x03B7}, false},
+	{0x1D777, "<font>", []rune{0x03B8}, false},
+	{0x1D778, "<font>", []rune{0x03B9}, false},
+	{0x1D779, "<font>", []rune{0x03BA}, false},
+	{0x1D77A, "<font>", []rune{0x03BB}, false},
+	{0x1D77B, "<font>", []rune{0x03BC}, false},
+	{0x1D77C, "<font>", []rune{0x03BD}, false},
+	{0x1D77D, "<font>", []rune{0x03BE}, false},
+	{0x1D77E, "<font>", []rune{0x03BF}, false},
+	{0x1D77F, "<font>", []rune{0x03C0}, false},
+	{0x1D780, "<font>", []rune{0x03C1}, false},
+	{0x1D781, "<font>", []rune{0x03C2}, false},
+	{0x1D782, "<font>", []rune{0x03C3}, false},
+	{0x1D783, "<font>", []rune{0x03C4}, false},
+	{0x1D784, "<font>", []rune{0x03C5}, false},
+	{0x1D785, "<font>", []rune{0x03C6}, false},
+	{0x1D786, "<font>", []rune{0x03C7}, false},
+	{0x1D787, "<font>", []rune{0x03C8}, false},
+	{0x1D788, "<font>", []rune{0x03C9}, false},
+	{0x1D789, "<font>", []rune{0x2202}, false},
+	{0x1D78A, "<font>", []rune{0x03F5}, false},
+	{0x1D78B, "<font>", []rune{0x03D1}, false},
+	{0x1D78C, "<font>", []rune{0x03F0}, false},
+	{0x1D78D, "<font>", []rune{0x03D5}, false},
+	{0x1D78E, "<font>", []rune{0x03F1}, false},
+	{0x1D78F, "<font>", []rune{0x03D6}, false},
+	{0x1D790, "<font>", []rune{0x0391}, false},
+	{0x1D791, "<font>", []rune{0x0392}, false},
+	{0x1D792, "<font>", []rune{0x0393}, false},
+	{0x1D793, "<font>", []rune{0x0394}, false},
+	{0x1D794, "<font>", []rune{0x0395}, false},
+	{0x1D795, "<font>", []rune{0x0396}, false},
+	{0x1D796, "<font>", []rune{0x0397}, false},
+	{0x1D797, "<font>", []rune{0x0398}, false},
+	{0x1D798, "<font>", []rune{0x0399}, false},
+	{0x1D799, "<font>", []rune{0x039A}, false},
+	{0x1D79A, "<font>", []rune{0x039B}, false},
+	{0x1D79B, "<font>", []rune{0x039C}, false},
+	{0x1D79C, "<font>", []rune{0x039D}, false},
+	{0x1D79D, "<font>", []rune{0x039E}, false},
+	{0x1D79E, "<font>", []rune{0x039F}, false},
+	{0x1D79F, "<font>", []rune{0x03A0}, false},
+	{0x1D7A0, "<font>", []rune{0x03A1}, false},
+	{0x1D7A1, "<font>", []rune{0x03F4}, false},
+	{0x1D7A2, "<font>", []rune{0x03A3}, false},
+	{0x1D7A3, "<font>", []rune{0x03A4}, false},
+	{0x1D7A4, "<font>", []rune{0x03A5}, false},
+	{0x1D7A5, "<font>", []rune{0x03A6}, false},
+	{0x1D7A6, "<font>", []rune{0x03A7}, false},
+	{0x1D7A7, "<font>", []rune{0x03A8}, false},
+	{0x1D7A8, "<font>", []rune{0x03A9}, false},
+	{0x1D7A9, "<font>", []rune{0x2207}, false},
+	{0x1D7AA, "<font>", []rune{0x03B1}, false},
+	{0x1D7AB, "<font>", []rune{0x03B2}, false},
+	{0x1D7AC, "<font>", []rune{0x03B3}, false},
+	{0x1D7AD, "<font>", []rune{0x03B4}, false},
+	{0x1D7AE, "<font>", []rune{0x03B5}, false},
+	{0x1D7AF, "<font>", []rune{0x03B6}, false},
+	{0x1D7B0, "<font>", []rune{0x03B7}, false},
+	{0x1D7B1, "<font>", []rune{0x03B8}, false},
+	{0x1D7B2, "<font>", []rune{0x03B9}, false},
+	{0x1D7B3, "<font>", []rune{0x03BA}, false},
+	{0x1D7B4, "<font>", []rune{0x03BB}, false},
+	{0x1D7B5, "<font>", []rune{0x03BC}, false},
+	{0x1D7B6, "<font>", []rune{0x03BD}, false},
+	{0x1D7B7, "<font>", []rune{0x03BE}, false},
+	{0x1D7B8, "<font>", []rune{0x03BF}, false},
+	{0x1D7B9, "<font>", []rune{0x03C0}, false},
+	{0x1D7BA, "<font>", []rune{0x03C1}, false},
+	{0x1D7BB, "<font>", []rune{0x03C2}, false},
+	{0x1D7BC, "<font>", []rune{0x03C3}, false},
+	{0x1D7BD, "<font>", []rune{0x03C4}, false},
+	{0x1D7BE, "<font>", []rune{0x03C5}, false},
+	{0x1D7BF, "<font>", []rune{0x03C6}, false},
+	{0x1D7C0, "<font>", []rune{0x03C7}, false},
+	{0x1D7C1, "<font>", []rune{0x03C8}, false},
+	{0x1D7C2, "<font>", []rune{0x03C9}, false},
+	{0x1D7C3, "<font>", []rune{0x2202}, false},
+	{0x1D7C4, "<font>", []rune{0x03F5}, false},
+	{0x1D7C5, "<font>", []rune{0x03D1}, false},
+	{0x1D7C6, "<font>", []rune{0x03F0}, false},
+	{0x1D7C7, "<font>", []rune{0x03D5}, false},
+	{0x1D7C8, "<font>", []rune{0x03F1}, false},
+	{0x1D7C9, "<font>", []rune{0x03D6}, false},
+	{0x1D7CA, "<font>", []rune{0x03DC}, false},
+	{0x1D7CB, "<font>", []rune{0x03DD}, false},
+	{0x1D7CE, "<font>", []rune{0x0030}, false},
+	{0x1D7CF, "<font>", []rune{0x0031}, false},
+	{0x1D7D0, "<font>", []rune{0x0032}, false},
+	{0x1D7D1, "<font>", []rune{0x0033}, false},
+	{0x1D7D2, "<font>", []rune{0x0034}, false},
+	{0x1D7D3, "<font>", []rune{0x0035}, false},
+	{0x1D7D4, "<font>", []rune{0x0036}, false},
+	{0x1D7D5, "<font>", []rune{0x0037}, false},
+	{0x1D7D6, "<font>", []rune{0x0038}, false},
+	{0x1D7D7, "<font>", []rune{0x0039}, false},
+	{0x1D7D8, "<font>", []rune{0x0030}, false},
+	{0x1D7D9, "<font>", []rune{0x0031}, false},
+	{0x1D7DA, "<font>", []rune{0x0032}, false},
+	{0x1D7DB, "<font>", []rune{0x0033}, false},
+	{0x1D7DC, "<font>", []rune{0x0034}, false},
+	{0x1D7DD, "<font>", []rune{0x0035}, false},
+	{0x1D7DE, "<font>", []rune{0x0036}, false},
+	{0x1D7DF, "<font>", []rune{0x0037}, false},
+	{0x1D7E0, "<font>", []rune{0x0038}, false},
+	{0x1D7E1, "<font>", []rune{0x0039}, false},
+	{0x1D7E2, "<font>", []rune{0x0030}, false},
+	{0x1D7E3, "<font>", []rune{0x0031}, false},
+	{0x1D7E4, "<font>", []rune{0x0032}, false},
+	{0x1D7E5, "<font>", []rune{0x0033}, false},
+	{0x1D7E6, "<font>", []rune{0x0034}, false},
+	{0x1D7E7, "<font>", []rune{0x0035}, false},
+	{0x1D7E8, "<font>", []rune{0x0036}, false},
+	{0x1D7E9, "<font>", []rune{0x0037}, false},
+	{0x1D7EA, "<font>", []rune{0x0038}, false},
+	{0x1D7EB, "<font>", []rune{0x0039}, false},
+	{0x1D7EC, "<font>", []rune{0x0030}, false},
+	{0x1D7ED, "<font>", []rune{0x0031}, false},
+	{0x1D7EE, "<font>", []rune{0x0032}, false},
+	{0x1D7EF, "<font>", []rune{0x0033}, false},
+	{0x1D7F0, "<font>", []rune{0x0034}, false},
+	{0x1D7F1, "<font>", []rune{0x0035}, false},
+	{0x1D7F2, "<font>", []rune{0x0036}, false},
+	{0x1D7F3, "<font>", []rune{0x0037}, false},
+	{0x1D7F4, "<font>", []rune{0x0038}, false},
+	{0x1D7F5, "<font>", []rune{0x0039}, false},
+	{0x1D7F6, "<font>", []rune{0x0030}, false},
+	{0x1D7F7, "<font>", []rune{0x0031}, false},
+	{0x1D7F8, "<font>", []rune{0x0032}, false},
+	{0x1D7F9, "<font>", []rune{0x0033}, false},
+	{0x1D7FA, "<font>", []rune{0x0034}, false},
+	{0x1D7FB, "<font>", []rune{0x0035}, false},
+	{0x1D7FC, "<font>", []rune{0x0036}, false},
+	{0x1D7FD, "<font>", []rune{0x0037}, false},
+	{0x1D7FE, "<font>", []rune{0x0038}, false},
+	{0x1D7FF, "<font>", []rune{0x0039}, false},
+	{0x1EE00, "<font>", []rune{0x0627}, false},
+	{0x1EE01, "<font>", []rune{0x0628}, false},
+	{0x1EE02, "<font>", []rune{0x062C}, false},
+	{0x1EE03, "<font>", []rune{0x062F}, false},
+	{0x1EE05, "<font>", []rune{0x0648}, false},
+	{0x1EE06, "<font>", []rune{0x0632}, false},
+	{0x1EE07, "<font>", []rune{0x062D}, false},
+	{0x1EE08, "<font>", []rune{0x0637}, false},
+	{0x1EE09, "<font>", []rune{0x064A}, false},
+	{0x1EE0A, "<font>", []rune{0x0643}, false},
+	{0x1EE0B, "<font>", []rune{0x0644}, false},
+	{0x1EE0C, "<font>", []rune{0x0645}, false},
+	{0x1EE0D, "<font>", []rune{0x0646}, false},
+	{0x1EE0E, "<font>", []rune{0x0633}, false},
+	{0x1EE0F, "<font>", []rune{0x0639}, false},
+	{0x1EE10, "<font>", []rune{0x0641}, false},
+	{0x1EE11, "<font>", []rune{0x0635}, false},
+	{0x1EE12, "<font>", []rune{0x0642}, false},
+	{0x1EE13, "<font>", []rune{0x0631}, false},
+	{0x1EE14, "<font>", []rune{0x0634}, false},
+	{0x1EE15, "<font>", []rune{0x062A}, false},
+	{0x1EE16, "<font>", []rune{0x062B}, false},
+	{0x1EE17, "<font>", []rune{0x062E}, false},
+	{0x1EE18, "<font>", []rune{0x0630}, false},
+	{0x1EE19, "<font>", []rune{0x0636}, false},
+	{0x1EE1A, "<font>", []rune{0x0638}, false},
+	{0x1EE1B, "<font>", []rune{0x063A}, false},
+	{0x1EE1C, "<font>", []rune{0x066E}, false},
+	{0x1EE1D, "<font>", []rune{0x06BA}, false},
+	{0x1EE1E, "<font>", []rune{0x06A1}, false},
+	{0x1EE1F, "<font>", []rune{0x066F}, false},
+	{0x1EE21, "<font>", []rune{0x0628}, false},
+	{0x1EE22, "<font>", []rune{0x062C}, false},
+	{0x1EE24, "<font>", []rune{0x0647}, false},
+	{0x1EE27, "<font>", []rune{0x062D}, false},
+	{0x1EE29, "<font>", []rune{0x064A}, false},
+	{0x1EE2A, "<font>", []rune{0x0643}, false},
+	{0x1EE2B, "<font>", []rune{0x0644}, false},
+	{0x1EE2C, "<font>", []rune{0x0645}, false},
+	{0x1EE2D, "<font>", []rune{0x0646}, false},
+	{0x1EE2E, "<font>", []rune{0x0633}, false},
+	{0x1EE2F, "<font>", []rune{0x0639}, false},
+	{0x1EE30, "<font>", []rune{0x0641}, false},
+	{0x1EE31, "<font>", []rune{0x0635}, false},
+	{0x1EE32, "<font>", []rune{0x0642}, false},
+	{0x1EE34, "<font>", []rune{0x0634}, false},
+	{0x1EE35, "<font>", []rune{0x062A}, false},
+	{0x1EE36, "<font>", []rune{0x062B}, false},
+	{0x1EE37, "<font>", []rune{0x062E}, false},
+	{0x1EE39, "<font>", []rune{0x0636}, false},
+	{0x1EE3B, "<font>", []rune{0x063A}, false},
+	{0x1EE42, "<font>", []rune{0x062C}, false},
+	{0x1EE47, "<font>", []rune{0x062D}, false},
+	{0x1EE49, "<font>", []rune{0x064A}, false},
+	{0x1EE4B, "<font>", []rune{0x0644}, false},
+	{0x1EE4D, "<font>", []rune{0x0646}, false},
+	{0x1EE4E, "<font>", []rune{0x0633}, false},
+	{0x1EE4F, "<font>", []rune{0x0639}, false},
+	{0x1EE51, "<font>", []rune{0x0635}, false},
+	{0x1EE52, "<font>", []rune{0x0642}, false},
+	{0x1EE54, "<font>", []rune{0x0634}, false},
+	{0x1EE57, "<font>", []rune{0x062E}, false},
+	{0x1EE59, "<font>", []rune{0x0636}, false},
+	{0x1EE5B, "<font>", []rune{0x063A}, false},
+	{0x1EE5D, "<font>", []rune{0x06BA}, false},
+	{0x1EE5F, "<font>", []rune{0x066F}, false},
+	{0x1EE61, "<font>", []rune{0x0628}, false},
+	{0x1EE62, "<font>", []rune{0x062C}, false},
+	{0x1EE64, "<font>", []rune{0x0647}, false},
+	{0x1EE67, "<font>", []rune{0x062D}, false},
+	{0x1EE68, "<font>", []rune{0x0637}, false},
+	{0x1EE69, "<font>", []rune{0x064A}, false},
+	{0x1EE6A, "<font>", []rune{0x0643}, false},
+	{0x1EE6C, "<font>", []rune{0x0645}, false},
+	{0x1EE6D, "<font>", []rune{0x0646}, false},
+	{0x1EE6E, "<font>", []rune{0x0633}, false},
+	{0x1EE6F, "<font>", []rune{0x0639}, false},
+	{0x1EE70, "<font>", []rune{0x0641}, false},
+	{0x1EE71, "<font>", []rune{0x0635}, false},
+	{0x1EE72, "<font>", []rune{0x0642}, false},
+	{0x1EE74, "<font>", []rune{0x0634}, false},
+	{0x1EE75, "<font>", []rune{0x062A}, false},
+	{0x1EE76, "<font>", []rune{0x062B}, false},
+	{0x1EE77, "<font>", []rune{0x062E}, false},
+	{0x1EE79, "<font>", []rune{0x0636}, false},
+	{0x1EE7A, "<font>", []rune{0x0638}, false},
+	{0x1EE7B, "<font>", []rune{0x063A}, false},
+	{0x1EE7C, "<font>", []rune{0x066E}, false},
+	{0x1EE7E, "<font>", []rune{0x06A1}, false},
+	{0x1EE80, "<font>", []rune{0x0627}, false},
+	{0x1EE81, "<font>", []rune{0x0628}, false},
+	{0x1EE82, "<font>", []rune{0x062C}, false},
+	{0x1EE83, "<font>", []rune{0x062F}, false},
+	{0x1EE84, "<font>", []rune{0x0647}, false},
+	{0x1EE85, "<font>", []rune{0x0648}, false},
+	{0x1EE86, "<font>", []rune{0x0632}, false},
+	{0x1EE87, "<font>", []rune{0x062D}, false},
+	{0x1EE88, "<font>", []rune{0x0637}, false},
+	{0x1EE89, "<font>", []rune{0x064A}, false},
+	{0x1EE8B, "<font>", []rune{0x0644}, false},
+	{0x1EE8C, "<font>", []rune{0x0645}, false},
+	{0x1EE8D, "<font>", []rune{0x0646}, false},
+	{0x1EE8E, "<font>", []rune{0x0633}, false},
+	{0x1EE8F, "<font>", []rune{0x0639}, false},
+	{0x1EE90, "<font>", []rune{0x0641}, false},
+	{0x1EE91, "<font>", []rune{0x0635}, false},
+	{0x1EE92, "<font>", []rune{0x0642}, false},
+	{0x1EE93, "<font>", []rune{0x0631}, false},
+	{0x1EE94, "<font>", []rune{0x0634}, false},
+	{0x1EE95, "<font>", []rune{0x062A}, false},
+	{0x1EE96, "<font>", []rune{0x062B}, false},
+	{0x1EE97, "<font>", []rune{0x062E}, false},
+	{0x1EE98, "<font>", []rune{0x0630}, false},
+	{0x1EE99, "<font>", []rune{0x0636}, false},
+	{0x1EE9A, "<font>", []rune{0x0638}, false},
+	{0x1EE9B, "<font>", []rune{0x063A}, false},
+	{0x1EEA1, "<font>", []rune{0x0628}, false},
+	{0x1EEA2, "<font>", []rune{0x062C}, false},
+	{0x1EEA3, "<font>", []rune{0x062F}, false},
+	{0x1EEA5, "<font>", []rune{0x0648}, false},
+	{0x1EEA6, "<font>", []rune{0x0632}, false},
+	{0x1EEA7, "<font>", []rune{0x062D}, false},
+	{0x1EEA8, "<font>", []rune{0x0637}, false},
+	{0x1EEA9, "<font>", []rune{0x064A}, false},
+	{0x1EEAB, "<font>", []rune{0x0644}, false},
+	{0x1EEAC, "<font>", []rune{0x0645}, false},
+	{0x1EEAD, "<font>", []rune{0x0646}, false},
+	{0x1EEAE, "<font>", []rune{0x0633}, false},
+	{0x1EEAF, "<font>", []rune{0x0639}, false},
+	{0x1EEB0, "<font>", []rune{0x0641}, false},
+	{0x1EEB1, "<font>", []rune{0x0635}, false},
+	{0x1EEB2, "<font>", []rune{0x0642}, false},
+	{0x1EEB3, "<font>", []rune{0x0631}, false},
+	{0x1EEB4, "<font>", []rune{0x0634}, false},
+	{0x1EEB5, "<font>", []rune{0x062A}, false},
+	{0x1EEB6, "<font>", []rune{0x062B}, false},
+	{0x1EEB7, "<font>", []rune{0x062E}, false},
+	{0x1EEB8, "<font>", []rune{0x0630}, false},
+	{0x1EEB9, "<font>", []rune{0x0636}, false},
+	{0x1EEBA, "<font>", []rune{0x0638}, false},
+	{0x1EEBB, "<font>", []rune{0x063A}, false},
+	{0x1F100, "<compat>", []rune{0x0030, 0x002E}, false},
+	{0x1F101, "<compat>", []rune{0x0030, 0x002C}, false},
+	{0x1F102, "<compat>", []rune{0x0031, 0x002C}, false},
+	{0x1F103, "<compat>", []rune{0x0032, 0x002C}, false},
+	{0x1F104, "<compat>", []rune{0x0033, 0x002C}, false},
+	{0x1F105, "<compat>", []rune{0x0034, 0x002C}, false},
+	{0x1F106, "<compat>", []rune{0x0035, 0x002C}, false},
+	{0x1F107, "<compat>", []rune{0x0036, 0x002C}, false},
+	{0x1F108, "<compat>", []rune{0x0037, 0x002C}, false},
+	{0x1F109, "<compat>", []rune{0x0038, 0x002C}, false},
+	{0x1F10A, "<compat>", []rune{0x0039, 0x002C}, false},
+	{0x1F110, "<compat>", []rune{0x0028, 0x0041, 0x0029}, false},
+	{0x1F111, "<compat>", []rune{0x0028, 0x0042, 0x0029}, false},
+	{0x1F112, "<compat>", []rune{0x0028, 0x0043, 0x0029}, false},
+	{0x1F113, "<compat>", []rune{0x0028, 0x0044, 0x0029}, false},
+	{0x1F114, "<compat>", []rune{0x0028, 0x0045, 0x0029}, false},
+	{0x1F115, "<compat>", []rune{0x0028, 0x0046, 0x0029}, false},
+	{0x1F116, "<compat>", []rune{0x0028, 0x0047, 0x0029}, false},
+	{0x1F117, "<compat>", []rune{0x0028, 0x0048, 0x0029}, false},
+	{0x1F118, "<compat>", []rune{0x0028, 0x0049, 0x0029}, false},
+	{0x1F119, "<compat>", []rune{0x0028, 0x004A, 0x0029}, false},
+	{0x1F11A, "<compat>", []rune{0x0028, 0x004B, 0x0029}, false},
+	{0x1F11B, "<compat>", []rune{0x0028, 0x004C, 0x0029}, false},
+	{0x1F11C, "<compat>", []rune{0x0028, 0x004D, 0x0029}, false},
+	{0x1F11D, "<compat>", []rune{0x0028, 0x004E, 0x0029}, false},
+	{0x1F11E, "<compat>", []rune{0x0028, 0x004F, 0x0029}, false},
+	{0x1F11F, "<compat>", []rune{0x0028, 0x0050, 0x0029}, false},
+	{0x1F120, "<compat>", []rune{0x0028, 0x0051, 0x0029}, false},
+	{0x1F121, "<compat>", []rune{0x0028, 0x0052, 0x0029}, false},
+	{0x1F122, "<compat>", []rune{0x0028, 0x0053, 0x0029}, false},
+	{0x1F123, "<compat>", []rune{0x0028, 0x0054, 0x0029}, false},
+	{0x1F124, "<compat>", []rune{0x0028, 0x0055, 0x0029}, false},
+	{0x1F125, "<compat>", []rune{0x0028, 0x0056, 0x0029}, false},
+	{0x1F126, "<compat>", []rune{0x0028, 0x0057, 0x0029}, false},
+	{0x1F127, "<compat>", []rune{0x0028, 0x0058, 0x0029}, false},
+	{0x1F128, "<compat>", []rune{0x0028, 0x0059, 0x0029}, false},
+	{0x1F129, "<compat>", []rune{0x0028, 0x005A, 0x0029}, false},
+	{0x1F12A, "<compat>", []rune{0x3014, 0x0053, 0x3015}, false},
+	{0x1F12B, "<circle>", []rune{0x0043}, false},
+	{0x1F12C, "<circle>", []rune{0x0052}, false},
+	{0x1F12D, "<circle>", []rune{0x0043, 0x0044}, false},
+	{0x1F12E, "<circle>", []rune{0x0057, 0x005A}, false},
+	{0x1F130, "<square>", []rune{0x0041}, false},
+	{0x1F131, "<square>", []rune{0x0042}, false},
+	{0x1F132, "<square>", []rune{0x0043}, false},
+	{0x1F133, "<square>", []rune{0x0044}, false},
+	{0x1F134, "<square>", []rune{0x0045}, false},
+	{0x1F135, "<square>", []rune{0x0046}, false},
+	{0x1F136, "<square>", []rune{0x0047}, false},
+	{0x1F137, "<square>", []rune{0x0048}, false},
+	{0x1F138, "<square>", []rune{0x0049}, false},
+	{0x1F139, "<square>", []rune{0x004A}, false},
+	{0x1F13A, "<square>", []rune{0x004B}, false},
+	{0x1F13B, "<square>", []rune{0x004C}, false},
+	{0x1F13C, "<square>", []rune{0x004D}, false},
+	{0x1F13D, "<square>", []rune{0x004E}, false},
+	{0x1F13E, "<square>", []rune{0x004F}, false},
+	{0x1F13F, "<square>", []rune{0x0050}, false},
+	{0x1F140, "<square>", []rune{0x0051}, false},
+	{0x1F141, "<square>", []rune{0x0052}, false},
+	{0x1F142, "<square>", []rune{0x0053}, false},
+	{0x1F143, "<square>", []rune{0x0054}, false},
+	{0x1F144, "<square>", []rune{0x0055}, false},
+	{0x1F145, "<square>", []rune{0x0056}, false},
+	{0x1F146, "<square>", []rune{0x0057}, false},
+	{0x1F147, "<square>", []rune{0x0058}, false},
+	{0x1F148, "<square>", []rune{0x0059}, false},
+	{0x1F149, "<square>", []rune{0x005A}, false},
+	{0x1F14A, "<square>", []rune{0x0048, 0x0056}, false},
+	{0x1F14B, "<square>", []rune{0x004D, 0x0056}, false},
+	{0x1F14C, "<square>", []rune{0x0053, 0x0044}, false},
+	{0x1F14D, "<square>", []rune{0x0053, 0x0053}, false},
+	{0x1F14E, "<square>", []rune{0x0050, 0x0050, 0x0056}, false},
+	{0x1F14F, "<square>", []rune{0x0057, 0x0043}, false},
+	{0x1F16A, "<super>", []rune{0x004D, 0x0043}, false},
+	{0x1F16B, "<super>", []rune{0x004D, 0x0044}, false},
+	{0x1F16C, "<super>", []rune{0x004D, 0x0052}, false},
+	{0x1F190, "<square>", []rune{0x0044, 0x004A}, false},
+	{0x1F200, "<square>", []rune{0x307B, 0x304B}, false},
+	{0x1F201, "<square>", []rune{0x30B3, 0x30B3}, false},
+	{0x1F202, "<square>", []rune{0x30B5}, false},
+	{0x1F210, "<square>", []rune{0x624B}, false},
+	{0x1F211, "<square>", []rune{0x5B57}, false},
+	{0x1F212, "<square>", []rune{0x53CC}, false},
+	{0x1F213, "<square>", []rune{0x30C7}, false},
+	{0x1F214, "<square>", []rune{0x4E8C}, false},
+	{0x1F215, "<square>", []rune{0x591A}, false},
+	{0x1F216, "<square>", []rune{0x89E3}, false},
+	{0x1F217, "<square>", []rune{0x5929}, false},
+	{0x1F218, "<square>", []rune{0x4EA4}, false},
+	{0x1F219, "<square>", []rune{0x6620}, false},
+	{0x1F21A, "<square>", []rune{0x7121}, false},
+	{0x1F21B, "<square>", []rune{0x6599}, false},
+	{0x1F21C, "<square>", []rune{0x524D}, false},
+	{0x1F21D, "<square>", []rune{0x5F8C}, false},
+	{0x1F21E, "<square>", []rune{0x518D}, false},
+	{0x1F21F, "<square>", []rune{0x65B0}, false},
+	{0x1F220, "<square>", []rune{0x521D}, false},
+	{0x1F221, "<square>", []rune{0x7D42}, false},
+	{0x1F222, "<square>", []rune{0x751F}, false},
+	{0x1F223, "<square>", []rune{0x8CA9}, false},
+	{0x1F224, "<square>", []rune{0x58F0}, false},
+	{0x1F225, "<square>", []rune{0x5439}, false},
+	{0x1F226, "<square>", []rune{0x6F14}, false},
+	{0x1F227, "<square>", []rune{0x6295}, false},
+	{0x1F228, "<square>", []rune{0x6355}, false},
+	{0x1F229, "<square>", []rune{0x4E00}, false},
+	{0x1F22A, "<square>", []rune{0x4E09}, false},
+	{0x1F22B, "<square>", []rune{0x904A}, false},
+	{0x1F22C, "<square>", []rune{0x5DE6}, false},
+	{0x1F22D, "<square>", []rune{0x4E2D}, false},
+	{0x1F22E, "<square>", []rune{0x53F3}, false},
+	{0x1F22F, "<square>", []rune{0x6307}, false},
+	{0x1F230, "<square>", []rune{0x8D70}, false},
+	{0x1F231, "<square>", []rune{0x6253}, false},
+	{0x1F232, "<square>", []rune{0x7981}, false},
+	{0x1F233, "<square>", []rune{0x7A7A}, false},
+	{0x1F234, "<square>", []rune{0x5408}, false},
+	{0x1F235, "<square>", []rune{0x6E80}, false},
+	{0x1F236, "<square>", []rune{0x6709}, false},
+	{0x1F237, "<square>", []rune{0x6708}, false},
+	{0x1F238, "<square>", []rune{0x7533}, false},
+	{0x1F239, "<square>", []rune{0x5272}, false},
+	{0x1F23A, "<square>", []rune{0x55B6}, false},
+	{0x1F23B, "<square>", []rune{0x914D}, false},
+	{0x1F240, "<compat>", []rune{0x3014, 0x672C, 0x3015}, false},
+	{0x1F241, "<compat>", []rune{0x3014, 0x4E09, 0x3015}, false},
+	{0x1F242, "<compat>", []rune{0x3014, 0x4E8C, 0x3015}, false},
+	{0x1F243, "<compat>", []rune{0x3014, 0x5B89, 0x3015}, false},
+	{0x1F244, "<compat>", []rune{0x3014, 0x70B9, 0x3015}, false},
+	{0x1F245, "<compat>", []rune{0x3014, 0x6253, 0x3015}, false},
+	{0x1F246, "<compat>", []rune{0x3014, 0x76D7, 0x3015}, false},
+	{0x1F247, "<compat>", []rune{0x3014, 0x52DD, 0x3015}, false},
+	{0x1F248, "<compat>", []rune{0x3014, 0x6557, 0x3015}, false},
+	{0x1F250, "<circle>", []rune{0x5F97}, false},
+	{0x1F251, "<circle>", []rune{0x53EF}, false},
+	{0x1FBF0, "<font>", []rune{0x0030}, false},
+	{0x1FBF1, "<font>", []rune{0x0031}, false},
+	{0x1FBF2, "<font>", []rune{0x0032}, false},
+	{0x1FBF3, "<font>", []rune{0x0033}, false},
+	{0x1FBF4, "<font>", []rune{0x0034}, false},
+	{0x1FBF5, "<font>", []rune{0x0035}, false},
+	{0x1FBF6, "<font>", []rune{0x0036}, false},
+	{0x1FBF7, "<font>", []rune{0x0037}, false},
+	{0x1FBF8, "<font>", []rune{0x0038}, false},
+	{0x1FBF9, "<font>", []rune{0x0039}, false},
+	{0x2F800, "", []rune{0x4E3D}, true},
+	{0x2F801, "", []rune{0x4E38}, true},
+	{0x2F802, "", []rune{0x4E41}, true},
+	{0x2F803, "", []rune{0x20122}, true},
+	{0x2F804, "", []rune{0x4F60}, true},
+	{0x2F805, "", []rune{0x4FAE}, true},
+	{0x2F806, "", []rune{0x4FBB}, true},
+	{0x2F807, "", []rune{0x5002}, true},
+	{0x2F808, "", []rune{0x507A}, true},
+	{0x2F809, "", []rune{0x5099}, true},
+	{0x2F80A, "", []rune{0x50E7}, true},
+	{0x2F80B, "", []rune{0x50CF}, true},
+	{0x2F80C, "", []rune{0x349E}, true},
+	{0x2F80D, "", []rune{0x2063A}, true},
+	{0x2F80E, "", []rune{0x514D}, true},
+	{0x2F80F, "", []rune{0x5154}, true},
+	{0x2F810, "", []rune{0x5164}, true},
+	{0x2F811, "", []rune{0x5177}, true},
+	{0x2F812, "", []rune{0x2051C}, true},
+	{0x2F813, "", []rune{0x34B9}, true},
+	{0x2F814, "", []rune{0x5167}, true},
+	{0x2F815, "", []rune{0x518D}, true},
+	{0x2F816, "", []rune{0x2054B}, true},
+	{0x2F817, "", []rune{0x5197}, true},
+	{0x2F818, "", []rune{0x51A4}, true},
+	{0x2F819, "", []rune{0x4ECC}, true},
+	{0x2F81A, "", []rune{0x51AC}, true},
+	{0x2F81B, "", []rune{0x51B5}, true},
+	{0x2F81C, "", []rune{0x291DF}, true},
+	{0x2F81D, "", []rune{0x51F5}, true},
+	{0x2F81E, "", []rune{0x5203}, true},
+	{0x2F81F, "", []rune{0x34DF}, true},
+	{0x2F820, "", []rune{0x523B}, true},
+	{0x2F821, "", []rune{0x5246}, true},
+	{0x2F822, "", []rune{0x5272}, true},
+	{0x2F823, "", []rune{0x5277}, true},
+	{0x2F824, "", []rune{0x3515}, true},
+	{0x2F825, "", []rune{0x52C7}, true},
+	{0x2F826, "", []rune{0x52C9}, true},
+	{0x2F827, "", []rune{0x52E4}, true},
+	{0x2F828, "", []rune{0x52FA}, true},
+	{0x2F829, "", []rune{0x5305}, true},
+	{0x2F82A, "", []rune{0x5306}, true},
+	{0x2F82B, "", []rune{0x5317}, true},
+	{0x2F82C, "", []rune{0x5349}, true},
+	{0x2F82D, "", []rune{0x5351}, true},
+	{0x2F82E, "", []rune{0x535A}, true},
+	{0x2F82F, "", []rune{0x5373}, true},
+	{0x2F830, "", []rune{0x537D}, true},
+	{0x2F831, "", []rune{0x537F}, true},
+	{0x2F832, "", []rune{0x537F}, true},
+	{0x2F833, "", []rune{0x537F}, true},
+	{0x2F834, "", []rune{0x20A2C}, true},
+	{0x2F835, "", []rune{0x7070}, true},
+	{0x2F836, "", []rune{0x53CA}, true},
+	{0x2F837, "", []rune{0x53DF}, true},
+	{0x2F838, "", []rune{0x20B63}, true},
+	{0x2F839, "", []rune{0x53EB}, true},
+	{0x2F83A, "", []rune{0x53F1}, true},
+	{0x2F83B, "", []rune{0x5406}, true},
+	{0x2F83C, "", []rune{0x549E}, true},
+	{0x2F83D, "", []rune{0x5438}, true},
+	{0x2F83E, "", []rune{0x5448}, true},
+	{0x2F83F, "", []rune{0x5468}, true},
+	{0x2F840, "", []rune{0x54A2}, true},
+	{0x2F841, "", []rune{0x54F6}, true},
+	{0x2F842, "", []rune{0x5510}, true},
+	{0x2F843, "", []rune{0x5553}, true},
+	{0x2F844, "", []rune{0x5563}, true},
+	{0x2F845, "", []rune{0x5584}, true},
+	{0x2F846, "", []rune{0x5584}, true},
+	{0x2F847, "", []rune{0x5599}, true},
+	{0x2F848, "", []rune{0x55AB}, true},
+	{0x2F849, "", []rune{0x55B3}, true},
+	{0x2F84A, "", []rune{0x55C2}, true},
+	{0x2F84B, "", []rune{0x5716}, true},
+	{0x2F84C, "", []rune{0x5606}, true},
+	{0x2F84D, "", []rune{0x5717}, true},
+	{0x2F84E, "", []rune{0x5651}, true},
+	{0x2F84F, "", []rune{0x5674}, true},
+	{0x2F850, "", []rune{0x5207}, true},
+	{0x2F851, "", []rune{0x58EE}, true},
+	{0x2F852, "", []rune{0x57CE}, true},
+	{0x2F853, "", []rune{0x57F4}, true},
+	{0x2F854, "", []rune{0x580D}, true},
+	{0x2F855, "", []rune{0x578B}, true},
+	{0x2F856, "", []rune{0x5832}, true},
+	{0x2F857, "", []rune{0x5831}, true},
+	{0x2F858, "", []rune{0x58AC}, true},
+	{0x2F859, "", []rune{0x214E4}, true},
+	{0x2F85A, "", []rune{0x58F2}, true},
+	{0x2F85B, "", []rune{0x58F7}, true},
+	{0x2F85C, "", []rune{0x5906}, true},
+	{0x2F85D, "", []rune{0x591A}, true},
+	{0x2F85E, "", []rune{0x5922}, true},
+	{0x2F85F, "", []rune{0x5962}, true},
+	{0x2F860, "", []rune{0x216A8}, true},
+	{0x2F861, "", []rune{0x216EA}, true},
+	{0x2F862, "", []rune{0x59EC}, true},
+	{0x2F863, "", []rune{0x5A1B}, true},
+	{0x2F864, "", []rune{0x5A27}, true},
+	{0x2F865, "", []rune{0x59D8}, true},
+	{0x2F866, "", []rune{0x5A66}, true},
+	{0x2F867, "", []rune{0x36EE}, true},
+	{0x2F868, "", []rune{0x36FC}, true},
+	{0x2F869, "", []rune{0x5B08}, true},
+	{0x2F86A, "", []rune{0x5B3E}, true},
+	{0x2F86B, "", []rune{0x5B3E}, true},
+	{0x2F86C, "", []rune{0x219C8}, true},
+	{0x2F86D, "", []rune{0x5BC3}, true},
+	{0x2F86E, "", []rune{0x5BD8}, true},
+	{0x2F86F, "", []rune{0x5BE7}, true},
+	{0x2F870, "", []rune{0x5BF3}, true},
+	{0x2F871, "", []rune{0x21B18}, true},
+	{0x2F872, "", []rune{0x5BFF}, true},
+	{0x2F873, "", []rune{0x5C06}, true},
+	{0x2F874, "", []rune{0x5F53}, true},
+	{0x2F875, "", []rune{0x5C22}, true},
+	{0x2F876, "", []rune{0x3781}, true},
+	{0x2F877, "", []rune{0x5C60}, true},
+	{0x2F878, "", []rune{0x5C6E}, true},
+	{0x2F879, "", []rune{0x5CC0}, true},
+	{0x2F87A, "", []rune{0x5C8D}, true},
+	{0x2F87B, "", []rune{0x21DE4}, true},
+	{0x2F87C, "", []rune{0x5D43}, true},
+	{0x2F87D, "", []rune{0x21DE6}, true},
+	{0x2F87E, "", []rune{0x5D6E}, true},
+	{0x2F87F, "", []rune{0x5D6B}, true},
+	{0x2F880, "", []rune{0x5D7C}, true},
+	{0x2F881, "", []rune{0x5DE1}, true},
+	{0x2F882, "", []rune{0x5DE2}, true},
+	{0x2F883, "", []rune{0x382F}, true},
+	{0x2F884, "", []rune{0x5DFD}, true},
+	{0x2F885, "", []rune{0x5E28}, true},
+	{0x2F886, "", []rune{0x5E3D}, true},
+	{0x2F887, "", []rune{0x5E69}, true},
+	{0x2F888, "", []rune{0x3862}, true},
+	{0x2F889, "", []rune{0x22183}, true},
+	{0x2F88A, "", []rune{0x387C}, true},
+	{0x2F88B, "", []rune{0x5EB0}, true},
+	{0x2F88C, "", []rune{0x5EB3}, true},
+	{0x2F88D, "", []rune{0x5EB6}, true},
+	{0x2F88E, "", []rune{0x5ECA}, true},
+	{0x2F88F, "", []rune{0x2A392}, true},
+	{0x2F890, "", []rune{0x5EFE}, true},
+	{0x2F891, "", []rune{0x22331}, true},
+	{0x2F892, "", []rune{0x22331}, true},
+	{0x2F893, "", []rune{0x8201}, true},
+	{0x2F894, "", []rune{0x5F22}, true},
+	{0x2F895, "", []rune{0x5F22}, true},
+	{0x2F896, "", []rune{0x38C7}, true},
+	{0x2F897, "", []rune{0x232B8}, true},
+	{0x2F898, "", []rune{0x261DA}, true},
+	{0x2F899, "", []rune{0x5F62}, true},
+	{0x2F89A, "", []rune{0x5F6B}, true},
+	{0x2F89B, "", []rune{0x38E3}, true},
+	{0x2F89C, "", []rune{0x5F9A}, true},
+	{0x2F89D, "", []rune{0x5FCD}, true},
+	{0x2F89E, "", []rune{0x5FD7}, true},
+	{0x2F89F, "", []rune{0x5FF9}, true},
+	{0x2F8A0, "", []rune{0x6081}, true},
+	{0x2F8A1, "", []rune{0x393A}, true},
+	{0x2F8A2, "", []rune{0x391C}, true},
+	{0x2F8A3, "", []rune{0x6094}, true},
+	{0x2F8A4, "", []rune{0x226D4}, true},
+	{0x2F8A5, "", []rune{0x60C7}, true},
+	{0x2F8A6, "", []rune{0x6148}, true},
+	{0x2F8A7, "", []rune{0x614C}, true},
+	{0x2F8A8, "", []rune{0x614E}, true},
+	{0x2F8A9, "", []rune{0x614C}, true},
+	{0x2F8AA, "", []rune{0x617A}, true},
+	{0x2F8AB, "", []rune{0x618E}, true},
+	{0x2F8AC, "", []rune{0x61B2}, true},
+	{0x2F8AD, "", []rune{0x61A4}, true},
+	{0x2F8AE, "", []rune{0x61AF}, true},
+	{0x2F8AF, "", []rune{0x61DE}, true},
+	{0x2F8B0, "", []rune{0x61F2}, true},
+	{0x2F8B1, "", []rune{0x61F6}, true},
+	{0x2F8B2, "", []rune{0x6210}, true},
+	{0x2F8B3, "", []rune{0x621B}, true},
+	{0x2F8B4, "", []rune{0x625D}, true},
+	{0x2F8B5, "", []rune{0x62B1}, true},
+	{0x2F8B6, "", []rune{0x62D4}, true},
+	{0x2F8B7, "", []rune{0x6350}, true},
+	{0x2F8B8, "", []rune{0x22B0C}, true},
+	{0x2F8B9, "", []rune{0x633D}, true},
+	{0x2F8BA, "", []rune{0x62FC}, true},
+	{0x2F8BB, "", []rune{0x6368}, true},
+	{0x2F8BC, "", []rune{0x6383}, true},
+	{0x2F8BD, "", []rune{0x63E4}, true},
+	{0x2F8BE, "", []rune{0x22BF1}, true},
+	{0x2F8BF, "", []rune{0x6422}, true},
+	{0x2F8C0, "", []rune{0x63C5}, true},
+	{0x2F8C1, "", []rune{0x63A9}, true},
+	{0x2F8C2, "", []rune{0x3A2E}, true},
+	{0x2F8C3, "", []rune{0x6469}, true},
+	{0x2F8C4, "", []rune{0x647E}, true},
+	{0x2F8C5, "", []rune{0x649D}, true},
+	{0x2F8C6, "", []rune{0x6477}, true},
+	{0x2F8C7, "", []rune{0x3A6C}, true},
+	{0x2F8C8, "", []rune{0x654F}, true},
+	{0x2F8C9, "", []rune{0x656C}, true},
+	{0x2F8CA, "", []rune{0x2300A}, true},
+	{0x2F8CB, "", []rune{0x65E3}, true},
+	{0x2F8CC, "", []rune{0x66F8}, true},
+	{0x2F8CD, "", []rune{0x6649}, true},
+	{0x2F8CE, "", []rune{0x3B19}, true},
+	{0x2F8CF, "", []rune{0x6691}, true},
+	{0x2F8D0, "", []rune{0x3B08}, true},
+	{0x2F8D1, "", []rune{0x3AE4}, true},
+	{0x2F8D2, "", []rune{0x5192}, true},
+	{0x2F8D3, "", []rune{0x5195}, true},
+	{0x2F8D4, "", []rune{0x6700}, true},
+	{0x2F8D5, "", []rune{0x669C}, true},
+	{0x2F8D6, "", []rune{0x80AD}, true},
+	{0x2F8D7, "", []rune{0x43D9}, true},
+	{0x2F8D8, "", []rune{0x6717}, true},
+	{0x2F8D9, "", []rune{0x671B}, true},
+	{0x2F8DA, "", []rune{0x6721}, true},
+	{0x2F8DB, "", []rune{0x675E}, true},
+	{0x2F8DC, "", []rune{0x6753}, true},
+	{0x2F8DD, "", []rune{0x233C3}, true},
+	{0x2F8DE, "", []rune{0x3B49}, true},
+	{0x2F8DF, "", []rune{0x67FA}, true},
+	{0x2F8E0, "", []rune{0x6785}, true},
+	{0x2F8E1, "", []rune{0x6852}, true},
+	{0x2F8E2, "", []rune{0x6885}, true},
+	{0x2F8E3, "", []rune{0x2346D}, true},
+	{0x2F8E4, "", []rune{0x688E}, true},
+	{0x2F8E5, "", []rune{0x681F}, true},
+	{0x2F8E6, "", []rune{0x6914}, true},
+	{0x2F8E7, "", []rune{0x3B9D}, true},
+	{0x2F8E8, "", []rune{0x6942}, true},
+	{0x2F8E9, "", []rune{0x69A3}, true},
+	{0x2F8EA, "", []rune{0x69EA}, true},
+	{0x2F8EB, "", []rune{0x6AA8}, true},
+	{0x2F8EC, "", []rune{0x236A3}, true},
+	{0x2F8ED, "", []rune{0x6ADB}, true},
+	{0x2F8EE, "", []rune{0x3C18}, true},
+	{0x2F8EF, "", []rune{0x6B21}, true},
+	{0x2F8F0, "", []rune{0x238A7}, true},
+	{0x2F8F1, "", []rune{0x6B54}, true},
+	{0x2F8F2, "", []rune{0x3C4E}, true},
+	{0x2F8F3, "", []rune{0x6B72}, true},
+	{0x2F8F4, "", []rune{0x6B9F}, true},
+	{0x2F8F5, "", []rune{0x6BBA}, true},
+	{0x2F8F6, "", []rune{0x6BBB}, true},
+	{0x2F8F7, "", []rune{0x23A8D}, true},
+	{0x2F8F8, "", []rune{0x21D0B}, true},
+	{0x2F8F9, "", []rune{0x23AFA}, true},
+	{0x2F8FA, "", []rune{0x6C4E}, true},
+	{0x2F8FB, "", []rune{0x23CBC}, true},
+	{0x2F8FC, "", []rune{0x6CBF}, true},
+	{0x2F8FD, "", []rune{0x6CCD}, true},
+	{0x2F8FE, "", []rune{0x6C67}, true},
+	{0x2F8FF, "", []rune{0x6D16}, true},
+	{0x2F900, "", []rune{0x6D3E}, true},
+	{0x2F901, "", []rune{0x6D77}, true},
+	{0x2F902, "", []rune{0x6D41}, true},
+	{0x2F903, "", []rune{0x6D69}, true},
+	{0x2F904, "", []rune{0x6D78}, true},
+	{0x2F905, "", []rune{0x6D85}, true},
+	{0x2F906, "", []rune{0x23D1E}, true},
+	{0x2F907, "", []rune{0x6D34}, true},
+	{0x2F908, "", []rune{0x6E2F}, true},
+	{0x2F909, "", []rune{0x6E6E}, true},
+	{0x2F90A, "", []rune{0x3D33}, true},
+	{0x2F90B, "", []rune{0x6ECB}, true},
+	{0x2F90C, "", []rune{0x6EC7}, true},
+	{0x2F90D, "", []rune{0x23ED1}, true},
+	{0x2F90E, "", []rune{0x6DF9}, true},
+	{0x2F90F, "", []rune{0x6F6E}, true},
+	{0x2F910, "", []rune{0x23F5E}, true},
+	{0x2F911, "", []rune{0x23F8E}, true},
+	{0x2F912, "", []rune{0x6FC6}, true},
+	{0x2F913, "", []rune{0x7039}, true},
+	{0x2F914, "", []rune{0x701E}, true},
+	{0x2F915, "", []rune{0x701B}, true},
+	{0x2F916, "", []rune{0x3D96}, true},
+	{0x2F917, "", []rune{0x704A}, true},
+	{0x2F918, "", []rune{0x707D}, true},
+	{0x2F919, "", []rune{0x7077}, true},
+	{0x2F91A, "", []rune{0x70AD}, true},
+	{0x2F91B, "", []rune{0x20525}, true},
+	{0x2F91C, "", []rune{0x7145}, true},
+	{0x2F91D, "", []rune{0x24263}, true},
+	{0x2F91E, "", []rune{0x719C}, true},
+	{0x2F91F, "", []rune{0x243AB}, true},
+	{0x2F920, "", []rune{0x7228}, true},
+	{0x2F921, "", []rune{0x7235}, true},
+	{0x2F922, "", []rune{0x7250}, true},
+	{0x2F923, "", []rune{0x24608}, true},
+	{0x2F924, "", []rune{0x7280}, true},
+	{0x2F925, "", []rune{0x7295}, true},
+	{0x2F926, "", []rune{0x24735}, true},
+	{0x2F927, "", []rune{0x24814}, true},
+	{0x2F928, "", []rune{0x737A}, true},
+	{0x2F929, "", []rune{0x738B}, true},
+	{0x2F92A, "", []rune{0x3EAC}, true},
+	{0x2F92B, "", []rune{0x73A5}, true},
+	{0x2F92C, "", []rune{0x3EB8}, true},
+	{0x2F92D, "", []rune{0x3EB8}, true},
+	{0x2F92E, "", []rune{0x7447}, true},
+	{0x2F92F, "", []rune{0x745C}, true},
+	{0x2F930, "", []rune{0x7471}, true},
+	{0x2F931, "", []rune{0x7485}, true},
+	{0x2F932, "", []rune{0x74CA}, true},
+	{0x2F933, "", []rune{0x3F1B}, true},
+	{0x2F934, "", []rune{0x7524}, true},
+	{0x2F935, "", []rune{0x24C36}, true},
+	{0x2F936, "", []rune{0x753E}, true},
+	{0x2F937, "", []rune{0x24C92}, true},
+	{0x2F938, "", []rune{0x7570}, true},
+	{0x2F939, "", []rune{0x2219F}, true},
+	{0x2F93A, "", []rune{0x7610}, true},
+	{0x2F93B, "", []rune{0x24FA1}, true},
+	{0x2F93C, "", []rune{0x24FB8}, true},
+	{0x2F93D, "", []rune{0x25044}, true},
+	{0x2F93E, "", []rune{0x3FFC}, true},
+	{0x2F93F, "", []rune{0x4008}, true},
+	{0x2F940, "", []rune{0x76F4}, true},
+	{0x2F941, "", []rune{0x250F3}, true},
+	{0x2F942, "", []rune{0x250F2}, true},
+	{0x2F943, "", []rune{0x25119}, true},
+	{0x2F944, "", []rune{0x25133}, true},
+	{0x2F945, "", []rune{0x771E}, true},
+	{0x2F946, "", []rune{0x771F}, true},
+	{0x2F947, "", []rune{0x771F}, true},
+	{0x2F948, "", []rune{0x774A}, true},
+	{0x2F949, "", []rune{0x4039}, true},
+	{0x2F94A, "", []rune{0x778B}, true},
+	{0x2F94B, "", []rune{0x4046}, true},
+	{0x2F94C, "", []rune{0x4096}, true},
+	{0x2F94D, "", []rune{0x2541D}, true},
+	{0x2F94E, "", []rune{0x784E}, true},
+	{0x2F94F, "", []rune{0x788C}, true},
+	{0x2F950, "", []rune{0x78CC}, true},
+	{0x2F951, "", []rune{0x40E3}, true},
+	{0x2F952, "", []rune{0x25626}, true},
+	{0x2F953, "", []rune{0x7956}, true},
+	{0x2F954, "", []rune{0x2569A}, true},
+	{0x2F955, "", []rune{0x256C5}, true},
+	{0x2F956, "", []rune{0x798F}, true},
+	{0x2F957, "", []rune{0x79EB}, true},
+	{0x2F958, "", []rune{0x412F}, true},
+	{0x2F959, "", []rune{0x7A40}, true},
+	{0x2F95A, "", []rune{0x7A4A}, true},
+	{0x2F95B, "", []rune{0x7A4F}, true},
+	{0x2F95C, "", []rune{0x2597C}, true},
+	{0x2F95D, "", []rune{0x25AA7}, true},
+	{0x2F95E, "", []rune{0x25AA7}, true},
+	{0x2F95F, "", []rune{0x7AEE}, true},
+	{0x2F960, "", []rune{0x4202}, true},
+	{0x2F961, "", []rune{0x25BAB}, true},
+	{0x2F962, "", []rune{0x7BC6}, true},
+	{0x2F963, "", []rune{0x7BC9}, true},
+	{0x2F964, "", []rune{0x4227}, true},
+	{0x2F965, "", []rune{0x25C80}, true},
+	{0x2F966, "", []rune{0x7CD2}, true},
+	{0x2F967, "", []rune{0x42A0}, true},
+	{0x2F968, "", []rune{0x7CE8}, true},
+	{0x2F969, "", []rune{0x7CE3}, true},
+	{0x2F96A, "", []rune{0x7D00}, true},
+	{0x2F96B, "", []rune{0x25F86}, true},
+	{0x2F96C, "", []rune{0x7D63}, true},
+	{0x2F96D, "", []rune{0x4301}, true},
+	{0x2F96E, "", []rune{0x7DC7}, true},
+	{0x2F96F, "", []rune{0x7E02}, true},
+	{0x2F970, "", []rune{0x7E45}, true},
+	{0x2F971, "", []rune{0x4334}, true},
+	{0x2F972, "", []rune{0x26228}, true},
+	{0x2F973, "", []rune{0x26247}, true},
+	{0x2F974, "", []rune{0x4359}, true},
+	{0x2F975, "", []rune{0x262D9}, true},
+	{0x2F976, "", []rune{0x7F7A}, true},
+	{0x2F977, "", []rune{0x2633E}, true},
+	{0x2F978, "", []rune{0x7F95}, true},
+	{0x2F979, "", []rune{0x7FFA}, true},
+	{0x2F97A, "", []rune{0x8005}, true},
+	{0x2F97B, "", []rune{0x264DA}, true},
+	{0x2F97C, "", []rune{0x26523}, true},
+	{0x2F97D, "", []rune{0x8060}, true},
+	{0x2F97E, "", []rune{0x265A8}, true},
+	{0x2F97F, "", []rune{0x8070}, true},
+	{0x2F980, "", []rune{0x2335F}, true},
+	{0x2F981, "", []rune{0x43D5}, true},
+	{0x2F982, "", []rune{0x80B2}, true},
+	{0x2F983, "", []rune{0x8103}, true},
+	{0x2F984, "", []rune{0x440B}, true},
+	{0x2F985, "", []rune{0x813E}, true},
+	{0x2F986, "", []rune{0x5AB5}, true},
+	{0x2F987, "", []rune{0x267A7}, true},
+	{0x2F988, "", []rune{0x267B5}, true},
+	{0x2F989, "", []rune{0x23393}, true},
+	{0x2F98A, "", []rune{0x2339C}, true},
+	{0x2F98B, "", []rune{0x8201}, true},
+	{0x2F98C, "", []rune{0x8204}, true},
+	{0x2F98D, "", []rune{0x8F9E}, true},
+	{0x2F98E, "", []rune{0x446B}, true},
+	{0x2F98F, "", []rune{0x8291}, true},
+	{0x2F990, "", []rune{0x828B}, true},
+	{0x2F991, "", []rune{0x829D}, true},
+	{0x2F992, "", []rune{0x52B3}, true},
+	{0x2F993, "", []rune{0x82B1}, true},
+	{0x2F994, "", []rune{0x82B3}, true},
+	{0x2F995, "", []rune{0x82BD}, true},
+	{0x2F996, "", []rune{0x82E6}, true},
+	{0x2F997, "", []rune{0x26B3C}, true},
+	{0x2F998, "", []rune{0x82E5}, true},
+	{0x2F999, "", []rune{0x831D}, true},
+	{0x2F99A, "", []rune{0x8363}, true},
+	{0x2F99B, "", []rune{0x83AD}, true},
+	{0x2F99C, "", []rune{0x8323}, true},
+	{0x2F99D, "", []rune{0x83BD}, true},
+	{0x2F99E, "", []rune{0x83E7}, true},
+	{0x2F99F, "", []rune{0x8457}, true},
+	{0x2F9A0, "", []rune{0x8353}, true},
+	{0x2F9A1, "", []rune{0x83CA}, true},
+	{0x2F9A2, "", []rune{0x83CC}, true},
+	{0x2F9A3, "", []rune{0x83DC}, true},
+	{0x2F9A4, "", []rune{0x26C36}, true},
+	{0x2F9A5, "", []rune{0x26D6B}, true},
+	{0x2F9A6, "", []rune{0x26CD5}, true},
+	{0x2F9A7, "", []rune{0x452B}, true},
+	{0x2F9A8, "", []rune{0x84F1}, true},
+	{0x2F9A9, "", []rune{0x84F3}, true},
+	{0x2F9AA, "", []rune{0x8516}, true},
+	{0x2F9AB, "", []rune{0x273CA}, true},
+	{0x2F9AC, "", []rune{0x8564}, true},
+	{0x2F9AD, "", []rune{0x26F2C}, true},
+	{0x2F9AE, "", []rune{0x455D}, true},
+	{0x2F9AF, "", []rune{0x4561}, true},
+	{0x2F9B0, "", []rune{0x26FB1}, true},
+	{0x2F9B1, "", []rune{0x270D2}, true},
+	{0x2F9B2, "", []rune{0x456B}, true},
+	{0x2F9B3, "", []rune{0x8650}, true},
+	{0x2F9B4, "", []rune{0x865C}, true},
+	{0x2F9B5, "", []rune{0x8667}, true},
+	{0x2F9B6, "", []rune{0x8669}, true},
+	{0x2F9B7, "", []rune{0x86A9}, true},
+	{0x2F9B8, "", []rune{0x8688}, true},
+	{0x2F9B9, "", []rune{0x870E}, true},
+	{0x2F9BA, "", []rune{0x86E2}, true},
+	{0x2F9BB, "", []rune{0x8779}, true},
+	{0x2F9BC, "", []rune{0x8728}, true},
+	{0x2F9BD, "", []rune{0x876B}, true},
+	{0x2F9BE, "", []rune{0x8786}, true},
+	{0x2F9BF, "", []rune{0x45D7}, true},
+	{0x2F9C0, "", []rune{0x87E1}, true},
+	{0x2F9C1, "", []rune{0x8801}, true},
+	{0x2F9C2, "", []rune{0x45F9}, true},
+	{0x2F9C3, "", []rune{0x8860}, true},
+	{0x2F9C4, "", []rune{0x8863}, true},
+	{0x2F9C5, "", []rune{0x27667}, true},
+	{0x2F9C6, "", []rune{0x88D7}, true},
+	{0x2F9C7, "", []rune{0x88DE}, true},
+	{0x2F9C8, "", []rune{0x4635}, true},
+	{0x2F9C9, "", []rune{0x88FA}, true},
+	{0x2F9CA, "", []rune{0x34BB}, true},
+	{0x2F9CB, "", []rune{0x278AE}, true},
+	{0x2F9CC, "", []rune{0x27966}, true},
+	{0x2F9CD, "", []rune{0x46BE}, true},
+	{0x2F9CE, "", []rune{0x46C7}, true},
+	{0x2F9CF, "", []rune{0x8AA0}, true},
+	{0x2F9D0, "", []rune{0x8AED}, true},
+	{0x2F9D1, "", []rune{0x8B8A}, true},
+	{0x2F9D2, "", []rune{0x8C55}, true},
+	{0x2F9D3, "", []rune{0x27CA8}, true},
+	{0x2F9D4, "", []rune{0x8CAB}, true},
+	{0x2F9D5, "", []rune{0x8CC1}, true},
+	{0x2F9D6, "", []rune{0x8D1B}, true},
+	{0x2F9D7, "", []rune{0x8D77}, true},
+	{0x2F9D8, "", []rune{0x27F2F}, true},
+	{0x2F9D9, "", []rune{0x20804}, true},
+	{0x2F9DA, "", []rune{0x8DCB}, true},
+	{0x2F9DB, "", []rune{0x8DBC}, true},
+	{0x2F9DC, "", []rune{0x8DF0}, true},
+	{0x2F9DD, "", []rune{0x208DE}, true},
+	{0x2F9DE, "", []rune{0x8ED4}, true},
+	{0x2F9DF, "", []rune{0x8F38}, true},
+	{0x2F9E0, "", []rune{0x285D2}, true},
+	{0x2F9E1, "", []rune{0x285ED}, true},
+	{0x2F9E2, "", []rune{0x9094}, true},
+	{0x2F9E3, "", []rune{0x90F1}, true},
+	{0x2F9E4, "", []rune{0x9111}, true},
+	{0x2F9E5, "", []rune{0x2872E}, true},
+	{0x2F9E6, "", []rune{0x911B}, true},
+	{0x2F9E7, "", []rune{0x9238}, true},
+	{0x2F9E8, "", []rune{0x92D7}, true},
+	{0x2F9E9, "", []rune{0x92D8}, true},
+	{0x2F9EA, "", []rune{0x927C}, true},
+	{0x2F9EB, "", []rune{0x93F9}, true},
+	{0x2F9EC, "", []rune{0x9415}, true},
+	{0x2F9ED, "", []rune{0x28BFA}, true},
+	{0x2F9EE, "", []rune{0x958B}, true},
+	{0x2F9EF, "", []rune{0x4995}, true},
+	{0x2F9F0, "", []rune{0x95B7}, true},
+	{0x2F9F1, "", []rune{0x28D77}, true},
+	{0x2F9F2, "", []rune{0x49E6}, true},
+	{0x2F9F3, "", []rune{0x96C3}, true},
+	{0x2F9F4, "", []rune{0x5DB2}, true},
+	{0x2F9F5, "", []rune{0x9723}, true},
+	{0x2F9F6, "", []rune{0x29145}, true},
+	{0x2F9F7, "", []rune{0x2921A}, true},
+	{0x2F9F8, "", []rune{0x4A6E}, true},
+	{0x2F9F9, "", []rune{0x4A76}, true},
+	{0x2F9FA, "", []rune{0x97E0}, true},
+	{0x2F9FB, "", []rune{0x2940A}, true},
+	{0x2F9FC, "", []rune{0x4AB2}, true},
+	{0x2F9FD, "", []rune{0x29496}, true},
+	{0x2F9FE, "", []rune{0x980B}, true},
+	{0x2F9FF, "", []rune{0x980B}, true},
+	{0x2FA00, "", []rune{0x9829}, true},
+	{0x2FA01, "", []rune{0x295B6}, true},
+	{0x2FA02, "", []rune{0x98E2}, true},
+	{0x2FA03, "", []rune{0x4B33}, true},
+	{0x2FA04, "", []rune{0x9929}, true},
+	{0x2FA05, "", []rune{0x99A7}, true},
+	{0x2FA06, "", []rune{0x99C2}, true},
+	{0x2FA07, "", []rune{0x99FE}, true},
+	{0x2FA08, "", []rune{0x4BCE}, true},
+	{0x2FA09, "", []rune{0x29B30}, true},
+	{0x2FA0A, "", []rune{0x9B12}, true},
+	{0x2FA0B, "", []rune{0x9C40}, true},
+	{0x2FA0C, "", []rune{0x9CFD}, true},
+	{0x2FA0D, "", []rune{0x4CCE}, true},
+	{0x2FA0E, "", []rune{0x4CED}, true},
+	{0x2FA0F, "", []rune{0x9D67}, true},
+	{0x2FA10, "", []rune{0x2A0CE}, true},
+	{0x2FA11, "", []rune{0x4CF8}, true},
+	{0x2FA12, "", []rune{0x2A105}, true},
+	{0x2FA13, "", []rune{0x2A20E}, true},
+	{0x2FA14, "", []rune{0x2A291}, true},
+	{0x2FA15, "", []rune{0x9EBB}, true},
+	{0x2FA16, "", []rune{0x4D56}, true},
+	{0x2FA17, "", []rune{0x9EF9}, true},
+	{0x2FA18, "", []rune{0x9EFE}, true},
+	{0x2FA19, "", []rune{0x9F05}, true},
+	{0x2FA1A, "", []rune{0x9F0F}, true},
+	{0x2FA1B, "", []rune{0x9F16}, true},
+	{0x2FA1C, "", []rune{0x9F3B}, true},
+	{0x2FA1D, "", []rune{0x2A600}, true},
+}
+
+// Numeric values of characters sorted by code point, with -1 for
+// no decimal or digit value
+var numerics = []numeric{
+	{0x0030, 0, 0, 0.0},
+	{0x0031, 1, 1, 1.0},
+	{0x0032, 2, 2, 2.0},
+	{0x0033, 3, 3, 3.0},
+	{0x0034, 4, 4, 4.0},
+	{0x0035, 5, 5, 5.0},
+	{0x0036, 6, 6, 6.0},
+	{0x0037, 7, 7, 7.0},
+	{0x0038, 8, 8, 8.0},
+	{0x0039, 9, 9, 9.0},
+	{0x00B2, -1, 2, 2.0},
+	{0x00B3, -1, 3, 3.0},
+	{0x00B9, -1, 1, 1.0},
+	{0x00BC, -1, -1, 0.25},
+	{0x00BD, -1, -1, 0.5},
+	{0x00BE, -1, -1, 0.75},
+	{0x0660, 0, 0, 0.0},
+	{0x0661, 1, 1, 1.0},
+	{0x0662, 2, 2, 2.0},
+	{0x0663, 3, 3, 3.0},
+	{0x0664, 4, 4, 4.0},
+	{0x0665, 5, 5, 5.0},
+	{0x0666, 6, 6, 6.0},
+	{0x0667, 7, 7, 7.0},
+	{0x0668, 8, 8, 8.0},
+	{0x0669, 9, 9, 9.0},
+	{0x06F0, 0, 0, 0.0},
+	{0x06F1, 1, 1, 1.0},
+	{0x06F2, 2, 2, 2.0},
+	{0x06F3, 3, 3, 3.0},
+	{0x06F4, 4, 4, 4.0},
+	{0x06F5, 5, 5, 5.0},
+	{0x06F6, 6, 6, 6.0},
+	{0x06F7, 7, 7, 7.0},
+	{0x06F8, 8, 8, 8.0},
+	{0x06F9, 9, 9, 9.0},
+	{0x07C0, 0, 0, 0.0},
+	{0x07C1, 1, 1, 1.0},
+	{0x07C2, 2, 2, 2.0},
+	{0x07C3, 3, 3, 3.0},
+	{0x07C4, 4, 4, 4.0},
+	{0x07C5, 5, 5, 5.0},
+	{0x07C6, 6, 6, 6.0},
+	{0x07C7, 7, 7, 7.0},
+	{0x07C8, 8, 8, 8.0},
+	{0x07C9, 9, 9, 9.0},
+	{0x0966, 0, 0, 0.0},
+	{0x0967, 1, 1, 1.0},
+	{0x0968, 2, 2, 2.0},
+	{0x0969, 3, 3, 3.0},
+	{0x096A, 4, 4, 4.0},
+	{0x096B, 5, 5, 5.0},
+	{0x096C, 6, 6, 6.0},
+	{0x096D, 7, 7, 7.0},
+	{0x096E, 8, 8, 8.0},
+	{0x096F, 9, 9, 9.0},
+	{0x09E6, 0, 0, 0.0},
+	{0x09E7, 1, 1, 1.0},
+	{0x09E8, 2, 2, 2.0},
+	{0x09E9, 3, 3, 3.0},
+	{0x09EA, 4, 4, 4.0},
+	{0x09EB, 5, 5, 5.0},
+	{0x09EC, 6, 6, 6.0},
+	{0x09ED, 7, 7, 7.0},
+	{0x09EE, 8, 8, 8.0},
+	{0x09EF, 9, 9, 9.0},
+	{0x09F4, -1, -1, 0.0625},
+	{0x09F5, -1, -1, 0.125},
+	{0x09F6, -1, -1, 0.1875},
+	{0x09F7, -1, -1, 0.25},
+	{0x09F8, -1, -1, 0.75},
+	{0x09F9, -1, -1, 16.0},
+	{0x0A66, 0, 0, 0.0},
+	{0x0A67, 1, 1, 1.0},
+	{0x0A68, 2, 2, 2.0},
+	{0x0A69, 3, 3, 3.0},
+	{0x0A6A, 4, 4, 4.0},
+	{0x0A6B, 5, 5, 5.0},
+	{0x0A6C, 6, 6, 6.0},
+	{0x0A6D, 7, 7, 7.0},
+	{0x0A6E, 8, 8, 8.0},
+	{0x0A6F, 9, 9, 9.0},
+	{0x0AE6, 0, 0, 0.0},
+	{0x0AE7, 1, 1, 1.0},
+	{0x0AE8, 2, 2, 2.0},
+	{0x0AE9, 3, 3, 3.0},
+	{0x0AEA, 4, 4, 4.0},
+	{0x0AEB, 5, 5, 5.0},
+	{0x0AEC, 6, 6, 6.0},
+	{0x0AED, 7, 7, 7.0},
+	{0x0AEE, 8, 8, 8.0},
+	{0x0AEF, 9, 9, 9.0},
+	{0x0B66, 0, 0, 0.0},
+	{0x0B67, 1, 1, 1.0},
+	{0x0B68, 2, 2, 2.0},
+	{0x0B69, 3, 3, 3.0},
+	{0x0B6A, 4, 4, 4.0},
+	{0x0B6B, 5, 5, 5.0},
+	{0x0B6C, 6, 6, 6.0},
+	{0x0B6D, 7, 7, 7.0},
+	{0x0B6E, 8, 8, 8.0},
+	{0x0B6F, 9, 9, 9.0},
+	{0x0B72, -1, -1, 0.25},
+	{0x0B73, -1, -1, 0.5},
+	{0x0B74, -1, -1, 0.75},
+	{0x0B75, -1, -1, 0.0625},
+	{0x0B76, -1, -1, 0.125},
+	{0x0B77, -1, -1, 0.1875},
+	{0x0BE6, 0, 0, 0.0},
+	{0x0BE7, 1, 1, 1.0},
+	{0x0BE8, 2, 2, 2.0},
+	{0x0BE9, 3, 3, 3.0},
+	{0x0BEA, 4, 4, 4.0},
+	{0x0BEB, 5, 5, 5.0},
+	{0x0BEC, 6, 6, 6.0},
+	{0x0BED, 7, 7, 7.0},
+	{0x0BEE, 8, 8, 8.0},
+	{0x0BEF, 9, 9, 9.0},
+	{0x0BF0, -1, -1, 10.0},
+	{0x0BF1, -1, -1, 100.0},
+	{0x0BF2, -1, -1, 1000.0},
+	{0x0C66, 0, 0, 0.0},
+	{0x0C67, 1, 1, 1.0},
+	{0x0C68, 2, 2, 2.0},
+	{0x0C69, 3, 3, 3.0},
+	{0x0C6A, 4, 4, 4.0},
+	{0x0C6B, 5, 5, 5.0},
+	{0x0C6C, 6, 6, 6.0},
+	{0x0C6D, 7, 7, 7.0},
+	{0x0C6E, 8, 8, 8.0},
+	{0x0C6F, 9, 9, 9.0},
+	{0x0C78, -1, -1, 0.0},
+	{0x0C79, -1, -1, 1.0},
+	{0x0C7A, -1, -1, 2.0},
+	{0x0C7B, -1, -1, 3.0},
+	{0x0C7C, -1, -1, 1.0},
+	{0x0C7D, -1, -1, 2.0},
+	{0x0C7E, -1, -1, 3.0},
+	{0x0CE6, 0, 0, 0.0},
+	{0x0CE7, 1, 1, 1.0},
+	{0x0CE8, 2, 2, 2.0},
+	{0x0CE9, 3, 3, 3.0},
+	{0x0CEA, 4, 4, 4.0},
+	{0x0CEB, 5, 5, 5.0},
+	{0x0CEC, 6, 6, 6.0},
+	{0x0CED, 7, 7, 7.0},
+	{0x0CEE, 8, 8, 8.0},
+	{0x0CEF, 9, 9, 9.0},
+	{0x0D58, -1, -1, 0.00625},
+	{0x0D59, -1, -1, 0.025},
+	{0x0D5A, -1, -1, 0.0375},
+	{0x0D5B, -1, -1, 0.05},
+	{0x0D5C, -1, -1, 0.1},
+	{0x0D5D, -1, -1, 0.15},
+	{0x0D5E, -1, -1, 0.2},
+	{0x0D66, 0, 0, 0.0},
+	{0x0D67, 1, 1, 1.0},
+	{0x0D68, 2, 2, 2.0},
+	{0x0D69, 3, 3, 3.0},
+	{0x0D6A, 4, 4, 4.0},
+	{0x0D6B, 5, 5, 5.0},
+	{0x0D6C, 6, 6, 6.0},
+	{0x0D6D, 7, 7, 7.0},
+	{0x0D6E, 8, 8, 8.0},
+	{0x0D6F, 9, 9, 9.0},
+	{0x0D70, -1, -1, 10.0},
+	{0x0D71, -1, -1, 100.0},
+	{0x0D72, -1, -1, 1000.0},
+	{0x0D73, -1, -1, 0.25},
+	{0x0D74, -1, -1, 0.5},
+	{0x0D75, -1, -1, 0.75},
+	{0x0D76, -1, -1, 0.0625},
+	{0x0D77, -1, -1, 0.125},
+	{0x0D78, -1, -1, 0.1875},
+	{0x0DE6, 0, 0, 0.0},
+	{0x0DE7, 1, 1, 1.0},
+	{0x0DE8, 2, 2, 2.0},
+	{0x0DE9, 3, 3, 3.0},
+	{0x0DEA, 4, 4, 4.0},
+	{0x0DEB, 5, 5, 5.0},
+	{0x0DEC, 6, 6, 6.0},
+	{0x0DED, 7, 7, 7.0},
+	{0x0DEE, 8, 8, 8.0},
+	{0x0DEF, 9, 9, 9.0},
+	{0x0E50, 0, 0, 0.0},
+	{0x0E51, 1, 1, 1.0},
+	{0x0E52, 2, 2, 2.0},
+	{0x0E53, 3, 3, 3.0},
+	{0x0E54, 4, 4, 4.0},
+	{0x0E55, 5, 5, 5.0},
+	{0x0E56, 6, 6, 6.0},
+	{0x0E57, 7, 7, 7.0},
+	{0x0E58, 8, 8, 8.0},
+	{0x0E59, 9, 9, 9.0},
+	{0x0ED0, 0, 0, 0.0},
+	{0x0ED1, 1, 1, 1.0},
+	{0x0ED2, 2, 2, 2.0},
+	{0x0ED3, 3, 3, 3.0},
+	{0x0ED4, 4, 4, 4.0},
+	{0x0ED5, 5, 5, 5.0},
+	{0x0ED6, 6, 6, 6.0},
+	{0x0ED7, 7, 7, 7.0},
+	{0x0ED8, 8, 8, 8.0},
+	{0x0ED9, 9, 9, 9.0},
+	{0x0F20, 0, 0, 0.0},
+	{0x0F21, 1, 1, 1.0},
+	{0x0F22, 2, 2, 2.0},
+	{0x0F23, 3, 3, 3.0},
+	{0x0F24, 4, 4, 4.0},
+	{0x0F25, 5, 5, 5.0},
+	{0x0F26, 6, 6, 6.0},
+	{0x0F27, 7, 7, 7.0},
+	{0x0F28, 8, 8, 8.0},
+	{0x0F29, 9, 9, 9.0},
+	{0x0F2A, -1, -1, 0.5},
+	{0x0F2B, -1, -1, 1.5},
+	{0x0F2C, -1, -1, 2.5},
+	{0x0F2D, -1, -1, 3.5},
+	{0x0F2E, -1, -1, 4.5},
+	{0x0F2F, -1, -1, 5.5},
+	{0x0F30, -1, -1, 6.5},
+	{0x0F31, -1, -1, 7.5},
+	{0x0F32, -1, -1, 8.5},
+	{0x0F33, -1, -1, -0.5},
+	{0x1040, 0, 0, 0.0},
+	{0x1041, 1, 1, 1.0},
+	{0x1042, 2, 2, 2.0},
+	{0x1043, 3, 3, 3.0},
+	{0x1044, 4, 4, 4.0},
+	{0x1045, 5, 5, 5.0},
+	{0x1046, 6, 6, 6.0},
+	{0x1047, 7, 7, 7.0},
+	{0x1048, 8, 8, 8.0},
+	{0x1049, 9, 9, 9.0},
+	{0x1090, 0, 0, 0.0},
+	{0x1091, 1, 1, 1.0},
+	{0x1092, 2, 2, 2.0},
+	{0x1093, 3, 3, 3.0},
+	{0x1094, 4, 4, 4.0},
+	{0x1095, 5, 5, 5.0},
+	{0x1096, 6, 6, 6.0},
+	{0x1097, 7, 7, 7.0},
+	{0x1098, 8, 8, 8.0},
+	{0x1099, 9, 9, 9.0},
+	{0x1369, -1, 1, 1.0},
+	{0x136A, -1, 2, 2.0},
+	{0x136B, -1, 3, 3.0},
+	{0x136C, -1, 4, 4.0},
+	{0x136D, -1, 5, 5.0},
+	{0x136E, -1, 6, 6.0},
+	{0x136F, -1, 7, 7.0},
+	{0x1370, -1, 8, 8.0},
+	{0x1371, -1, 9, 9.0},
+	{0x1372, -1, -1, 10.0},
+	{0x1373, -1, -1, 20.0},
+	{0x1374, -1, -1, 30.0},
+	{0x1375, -1, -1, 40.0},
+	{0x1376, -1, -1, 50.0},
+	{0x1377, -1, -1, 60.0},
+	{0x1378, -1, -1, 70.0},
+	{0x1379, -1, -1, 80.0},
+	{0x137A, -1, -1, 90.0},
+	{0x137B, -1, -1, 100.0},
+	{0x137C, -1, -1, 10000.0},
+	{0x16EE, -1, -1, 17.0},
+	{0x16EF, -1, -1, 18.0},
+	{0x16F0, -1, -1, 19.0},
+	{0x17E0, 0, 0, 0.0},
+	{0x17E1, 1, 1, 1.0},
+	{0x17E2, 2, 2, 2.0},
+	{0x17E3, 3, 3, 3.0},
+	{0x17E4, 4, 4, 4.0},
+	{0x17E5, 5, 5, 5.0},
+	{0x17E6, 6, 6, 6.0},
+	{0x17E7, 7, 7, 7.0},
+	{0x17E8, 8, 8, 8.0},
+	{0x17E9, 9, 9, 9.0},
+	{0x17F0, -1, -1, 0.0},
+	{0x17F1, -1, -1, 1.0},
+	{0x17F2, -1, -1, 2.0},
+	{0x17F3, -1, -1, 3.0},
+	{0x17F4, -1, -1, 4.0},
+	{0x17F5, -1, -1, 5.0},
+	{0x17F6, -1, -1, 6.0},
+	{0x17F7, -1, -1, 7.0},
+	{0x17F8, -1, -1, 8.0},
+	{0x17F9, -1, -1, 9.0},
+	{0x1810, 0, 0, 0.0},
+	{0x1811, 1, 1, 1.0},
+	{0x1812, 2, 2, 2.0},
+	{0x1813, 3, 3, 3.0},
+	{0x1814, 4, 4, 4.0},
+	{0x1815, 5, 5, 5.0},
+	{0x1816, 6, 6, 6.0},
+	{0x1817, 7, 7, 7.0},
+	{0x1818, 8, 8, 8.0},
+	{0x1819, 9, 9, 9.0},
+	{0x1946, 0, 0, 0.0},
+	{0x1947, 1, 1, 1.0},
+	{0x1948, 2, 2, 2.0},
+	{0x1949, 3, 3, 3.0},
+	{0x194A, 4, 4, 4.0},
+	{0x194B, 5, 5, 5.0},
+	{0x194C, 6, 6, 6.0},
+	{0x194D, 7, 7, 7.0},
+	{0x194E, 8, 8, 8.0},
+	{0x194F, 9, 9, 9.0},
+	{0x19D0, 0, 0, 0.0},
+	{0x19D1, 1, 1, 1.0},
+	{0x19D2, 2, 2, 2.0},
+	{0x19D3, 3, 3, 3.0},
+	{0x19D4, 4, 4, 4.0},
+	{0x19D5, 5, 5, 5.0},
+	{0x19D6, 6, 6, 6.0},
+	{0x19D7, 7, 7, 7.0},
+	{0x19D8, 8, 8, 8.0},
+	{0x19D9, 9, 9, 9.0},
+	{0x19DA, -1, 1, 1.0},
+	{0x1A80, 0, 0, 0.0},
+	{0x1A81, 1, 1, 1.0},
+	{0x1A82, 2, 2, 2.0},
+	{0x1A83, 3, 3, 3.0},
+	{0x1A84, 4, 4, 4.0},
+	{0x1A85, 5, 5, 5.0},
+	{0x1A86, 6, 6, 6.0},
+	{0x1A87, 7, 7, 7.0},
+	{0x1A88, 8, 8, 8.0},
+	{0x1A89, 9, 9, 9.0},
+	{0x1A90, 0, 0, 0.0},
+	{0x1A91, 1, 1, 1.0},
+	{0x1A92, 2, 2, 2.0},
+	{0x1A93, 3, 3, 3.0},
+	{0x1A94, 4, 4, 4.0},
+	{0x1A95, 5, 5, 5.0},
+	{0x1A96, 6, 6, 6.0},
+	{0x1A97, 7, 7, 7.0},
+	{0x1A98, 8, 8, 8.0},
+	{0x1A99, 9, 9, 9.0},
+	{0x1B50, 0, 0, 0.0},
+	{0x1B51, 1, 1, 1.0},
+	{0x1B52, 2, 2, 2.0},
+	{0x1B53, 3, 3, 3.0},
+	{0x1B54, 4, 4, 4.0},
+	{0x1B55, 5, 5, 5.0},
+	{0x1B56, 6, 6, 6.0},
+	{0x1B57, 7, 7, 7.0},
+	{0x1B58, 8, 8, 8.0},
+	{0x1B59, 9, 9, 9.0},
+	{0x1BB0, 0, 0, 0.0},
+	{0x1BB1, 1, 1, 1.0},
+	{0x1BB2, 2, 2, 2.0},
+	{0x1BB3, 3, 3, 3.0},
+	{0x1BB4, 4, 4, 4.0},
+	{0x1BB5, 5, 5, 5.0},
+	{0x1BB6, 6, 6, 6.0},
+	{0x1BB7, 7, 7, 7.0},
+	{0x1BB8, 8, 8, 8.0},
+	{0x1BB9, 9, 9, 9.0},
+	{0x1C40, 0, 0, 0.0},
+	{0x1C41, 1, 1, 1.0},
+	{0x1C42, 2, 2, 2.0},
+	{0x1C43, 3, 3, 3.0},
+	{0x1C44, 4, 4, 4.0},
+	{0x1C45, 5, 5, 5.0},
+	{0x1C46, 6, 6, 6.0},
+	{0x1C47, 7, 7, 7.0},
+	{0x1C48, 8, 8, 8.0},
+	{0x1C49, 9, 9, 9.0},
+	{0x1C50, 0, 0, 0.0},
+	{0x1C51, 1, 1, 1.0},
+	{0x1C52, 2, 2, 2.0},
+	{0x1C53, 3, 3, 3.0},
+	{0x1C54, 4, 4, 4.0},
+	{0x1C55, 5, 5, 5.0},
+	{0x1C56, 6, 6, 6.0},
+	{0x1C57, 7, 7, 7.0},
+	{0x1C58, 8, 8, 8.0},
+	{0x1C59, 9, 9, 9.0},
+	{0x2070, -1, 0, 0.0},
+	{0x2074, -1, 4, 4.0},
+	{0x2075, -1, 5, 5.0},
+	{0x2076, -1, 6, 6.0},
+	{0x2077, -1, 7, 7.0},
+	{0x2078, -1, 8, 8.0},
+	{0x2079, -1, 9, 9.0},
+	{0x2080, -1, 0, 0.0},
+	{0x2081, -1, 1, 1.0},
+	{0x2082, -1, 2, 2.0},
+	{0x2083, -1, 3, 3.0},
+	{0x2084, -1, 4, 4.0},
+	{0x2085, -1, 5, 5.0},
+	{0x2086, -1, 6, 6.0},
+	{0x2087, -1, 7, 7.0},
+	{0x2088, -1, 8, 8.0},
+	{0x2089, -1, 9, 9.0},
+	{0x2150, -1, -1, 0.14285714285714285},
+	{0x2151, -1, -1, 0.1111111111111111},
+	{0x2152, -1, -1, 0.1},
+	{0x2153, -1, -1, 0.3333333333333333},
+	{0x2154, -1, -1, 0.6666666666666666},
+	{0x2155, -1, -1, 0.2},
+	{0x2156, -1, -1, 0.4},
+	{0x2157, -1, -1, 0.6},
+	{0x2158, -1, -1, 0.8},
+	{0x2159, -1, -1, 0.16666666666666666},
+	{0x215A, -1, -1, 0.8333333333333334},
+	{0x215B, -1, -1, 0.125},
+	{0x215C, -1, -1, 0.375},
+	{0x215D, -1, -1, 0.625},
+	{0x215E, -1, -1, 0.875},
+	{0x215F, -1, -1, 1.0},
+	{0x2160, -1, -1, 1.0},
+	{0x2161, -1, -1, 2.0},
+	{0x2162, -1, -1, 3.0},
+	{0x2163, -1, -1, 4.0},
+	{0x2164, -1, -1, 5.0},
+	{0x2165, -1, -1, 6.0},
+	{0x2166, -1, -1, 7.0},
+	{0x2167, -1, -1, 8.0},
+	{0x2168, -1, -1, 9.0},
+	{0x2169, -1, -1, 10.0},
+	{0x216A, -1, -1, 11.0},
+	{0x216B, -1, -1, 12.0},
+	{0x216C, -1, -1, 50.0},
+	{0x216D, -1, -1, 100.0},
+	{0x216E, -1, -1, 500.0},
+	{0x216F, -1, -1, 1000.0},
+	{0x2170, -1, -1, 1.0},
+	{0x2171, -1, -1, 2.0},
+	{0x2172, -1, -1, 3.0},
+	{0x2173, -1, -1, 4.0},
+	{0x2174, -1, -1, 5.0},
+	{0x2175, -1, -1, 6.0},
+	{0x2176, -1, -1, 7.0},
+	{0x2177, -1, -1, 8.0},
+	{0x2178, -1, -1, 9.0},
+	{0x2179, -1, -1, 10.0},
+	{0x217A, -1, -1, 11.0},
+	{0x217B, -1, -1, 12.0},
+	{0x217C, -1, -1, 50.0},
+	{0x217D, -1, -1, 100.0},
+	{0x217E, -1, -1, 500.0},
+	{0x217F, -1, -1, 1000.0},
+	{0x2180, -1, -1, 1000.0},
+	{0x2181, -1, -1, 5000.0},
+	{0x2182, -1, -1, 10000.0},
+	{0x2185, -1, -1, 6.0},
+	{0x2186, -1, -1, 50.0},
+	{0x2187, -1, -1, 50000.0},
+	{0x2188, -1, -1, 100000.0},
+	{0x2189, -1, -1, 0.0},
+	{0x2460, -1, 1, 1.0},
+	{0x2461, -1, 2, 2.0},
+	{0x2462, -1, 3, 3.0},
+	{0x2463, -1, 4, 4.0},
+	{0x2464, -1, 5, 5.0},
+	{0x2465, -1, 6, 6.0},
+	{0x2466, -1, 7, 7.0},
+	{0x2467, -1, 8, 8.0},
+	{0x2468, -1, 9, 9.0},
+	{0x2469, -1, -1, 10.0},
+	{0x246A, -1, -1, 11.0},
+	{0x246B, -1, -1, 12.0},
+	{0x246C, -1, -1, 13.0},
+	{0x246D, -1, -1, 14.0},
+	{0x246E, -1, -1, 15.0},
+	{0x246F, -1, -1, 16.0},
+	{0x2470, -1, -1, 17.0},
+	{0x2471, -1, -1, 18.0},
+	{0x2472, -1, -1, 19.0},
+	{0x2473, -1, -1, 20.0},
+	{0x2474, -1, 1, 1.0},
+	{0x2475, -1, 2, 2.0},
+	{0x2476, -1, 3, 3.0},
+	{0x2477, -1, 4, 4.0},
+	{0x2478, -1, 5, 5.0},
+	{0x2479, -1, 6, 6.0},
+	{0x247A, -1, 7, 7.0},
+	{0x247B, -1, 8, 8.0},
+	{0x247C, -1, 9, 9.0},
+	{0x247D, -1, -1, 10.0},
+	{0x247E, -1, -1, 11.0},
+	{0x247F, -1, -1, 12.0},
+	{0x2480, -1, -1, 13.0},
+	{0x2481, -1, -1, 14.0},
+	{0x2482, -1, -1, 15.0},
+	{0x2483, -1, -1, 16.0},
+	{0x2484, -1, -1, 17.0},
+	{0x2485, -1, -1, 18.0},
+	{0x2486, -1, -1, 19.0},
+	{0x2487, -1, -1, 20.0},
+	{0x2488, -1, 1, 1.0},
+	{0x2489, -1, 2, 2.0},
+	{0x248A, -1, 3, 3.0},
+	{0x248B, -1, 4, 4.0},
+	{0x248C, -1, 5, 5.0},
+	{0x248D, -1, 6, 6.0},
+	{0x248E, -1, 7, 7.0},
+	{0x248F, -1, 8, 8.0},
+	{0x2490, -1, 9, 9.0},
+	{0x2491, -1, -1, 10.0},
+	{0x2492, -1, -1, 11.0},
+	{0x2493, -1, -1, 12.0},
+	{0x2494, -1, -1, 13.0},
+	{0x2495, -1, -1, 14.0},
+	{0x2496, -1, -1, 15.0},
+	{0x2497, -1, -1, 16.0},
+	{0x2498, -1, -1, 17.0},
+	{0x2499, -1, -1, 18.0},
+	{0x249A, -1, -1, 19.0},
+	{0x249B, -1, -1, 20.0},
+	{0x24EA, -1, 0, 0.0},
+	{0x24EB, -1, -1, 11.0},
+	{0x24EC, -1, -1, 12.0},
+	{0x24ED, -1, -1, 13.0},
+	{0x24EE, -1, -1, 14.0},
+	{0x24EF, -1, -1, 15.0},
+	{0x24F0, -1, -1, 16.0},
+	{0x24F1, -1, -1, 17.0},
+	{0x24F2, -1, -1, 18.0},
+	{0x24F3, -1, -1, 19.0},
+	{0x24F4, -1, -1, 20.0},
+	{0x24F5, -1, 1, 1.0},
+	{0x24F6, -1, 2, 2.0},
+	{0x24F7, -1, 3, 3.0},
+	{0x24F8, -1, 4, 4.0},
+	{0x24F9, -1, 5, 5.0},
+	{0x24FA, -1, 6, 6.0},
+	{0x24FB, -1, 7, 7.0},
+	{0x24FC, -1, 8, 8.0},
+	{0x24FD, -1, 9, 9.0},
+	{0x24FE, -1, -1, 10.0},
+	{0x24FF, -1, 0, 0.0},
+	{0x2776, -1, 1, 1.0},
+	{0x2777, -1, 2, 2.0},
+	{0x2778, -1, 3, 3.0},
+	{0x2779, -1, 4, 4.0},
+	{0x277A, -1, 5, 5.0},
+	{0x277B, -1, 6, 6.0},
+	{0x277C, -1, 7, 7.0},
+	{0x277D, -1, 8, 8.0},
+	{0x277E, -1, 9, 9.0},
+	{0x277F, -1, -1, 10.0},
+	{0x2780, -1, 1, 1.0},
+	{0x2781, -1, 2, 2.0},
+	{0x2782, -1, 3, 3.0},
+	{0x2783, -1, 4, 4.0},
+	{0x2784, -1, 5, 5.0},
+	{0x2785, -1, 6, 6.0},
+	{0x2786, -1, 7, 7.0},
+	{0x2787, -1, 8, 8.0},
+	{0x2788, -1, 9, 9.0},
+	{0x2789, -1, -1, 10.0},
+	{0x278A, -1, 1, 1.0},
+	{0x278B, -1, 2, 2.0},
+	{0x278C, -1, 3, 3.0},
+	{0x278D, -1, 4, 4.0},
+	{0x278E, -1, 5, 5.0},
+	{0x278F, -1, 6, 6.0},
+	{0x2790, -1, 7, 7.0},
+	{0x2791, -1, 8, 8.0},
+	{0x2792, -1, 9, 9.0},
+	{0x2793, -1, -1, 10.0},
+	{0x2CFD, -1, -1, 0.5},
+	{0x3007, -1, -1, 0.0},
+	{0x3021, -1, -1, 1.0},
+	{0x3022, -1, -1, 2.0},
+	{0x3023, -1, -1, 3.0},
+	{0x3024, -1, -1, 4.0},
+	{0x3025, -1, -1, 5.0},
+	{0x3026, -1, -1, 6.0},
+	{0x3027, -1, -1, 7.0},
+	{0x3028, -1, -1, 8.0},
+	{0x3029, -1, -1, 9.0},
+	{0x3038, -1, -1, 10.0},
+	{0x3039, -1, -1, 20.0},
+	{0x303A, -1, -1, 30.0},
+	{0x3192, -1, -1, 1.0},
+	{0x3193, -1, -1, 2.0},
+	{0x3194, -1, -1, 3.0},
+	{0x3195, -1, -1, 4.0},
+	{0x3220, -1, -1, 1.0},
+	{0x3221, -1, -1, 2.0},
+	{0x3222, -1, -1, 3.0},
+	{0x3223, -1, -1, 4.0},
+	{0x3224, -1, -1, 5.0},
+	{0x3225, -1, -1, 6.0},
+	{0x3226, -1, -1, 7.0},
+	{0x3227, -1, -1, 8.0},
+	{0x3228, -1, -1, 9.0},
+	{0x3229, -1, -1, 10.0},
+	{0x3248, -1, -1, 10.0},
+	{0x3249, -1, -1, 20.0},
+	{0x324A, -1, -1, 30.0},
+	{0x324B, -1, -1, 40.0},
+	{0x324C, -1, -1, 50.0},
+	{0x324D, -1, -1, 60.0},
+	{0x324E, -1, -1, 70.0},
+	{0x324F, -1, -1, 80.0},
+	{0x3251, -1, -1, 21.0},
+	{0x3252, -1, -1, 22.0},
+	{0x3253, -1, -1, 23.0},
+	{0x3254, -1, -1, 24.0},
+	{0x3255, -1, -1, 25.0},
+	{0x3256, -1, -1, 26.0},
+	{0x3257, -1, -1, 27.0},
+	{0x3258, -1, -1, 28.0},
+	{0x3259, -1, -1, 29.0},
+	{0x325A, -1, -1, 30.0},
+	{0x325B, -1, -1, 31.0},
+	{0x325C, -1, -1, 32.0},
+	{0x325D, -1, -1, 33.0},
+	{0x325E, -1, -1, 34.0},
+	{0x325F, -1, -1, 35.0},
+	{0x3280, -1, -1, 1.0},
+	{0x3281, -1, -1, 2.0},
+	{0x3282, -1, -1, 3.0},
+	{0x3283, -1, -1, 4.0},
+	{0x3284, -1, -1, 5.0},
+	{0x3285, -1, -1, 6.0},
+	{0x3286, -1, -1, 7.0},
+	{0x3287, -1, -1, 8.0},
+	{0x3288, -1, -1, 9.0},
+	{0x3289, -1, -1, 10.0},
+	{0x32B1, -1, -1, 36.0},
+	{0x32B2, -1, -1, 37.0},
+	{0x32B3, -1, -1, 38.0},
+	{0x32B4, -1, -1, 39.0},
+	{0x32B5, -1, -1, 40.0},
+	{0x32B6, -1, -1, 41.0},
+	{0x32B7, -1, -1, 42.0},
+	{0x32B8, -1, -1, 43.0},
+	{0x32B9, -1, -1, 44.0},
+	{0x32BA, -1, -1, 45.0},
+	{0x32BB, -1, -1, 46.0},
+	{0x32BC, -1, -1, 47.0},
+	{0x32BD, -1, -1, 48.0},
+	{0x32BE, -1, -1, 49.0},
+	{0x32BF, -1, -1, 50.0},
+	{0x3405, -1, -1, 5.0},
+	{0x3483, -1, -1, 2.0},
+	{0x382A, -1, -1, 5.0},
+	{0x3B4D, -1, -1, 7.0},
+	{0x4E00, -1, -1, 1.0},
+	{0x4E03, -1, -1, 7.0},
+	{0x4E07, -1, -1, 10000.0},
+	{0x4E09, -1, -1, 3.0},
+	{0x4E5D, -1, -1, 9.0},
+	{0x4E8C, -1, -1, 2.0},
+	{0x4E94, -1, -1, 5.0},
+	{0x4E96, -1, -1, 4.0},
+	{0x4EBF, -1, -1, 100000000.0},
+	{0x4EC0, -1, -1, 10.0},
+	{0x4EDF, -1, -1, 1000.0},
+	{0x4EE8, -1, -1, 3.0},
+	{0x4F0D, -1, -1, 5.0},
+	{0x4F70, -1, -1, 100.0},
+	{0x5104, -1, -1, 100000000.0},
+	{0x5146, -1, -1, 1000000000000.0},
+	{0x5169, -1, -1, 2.0},
+	{0x516B, -1, -1, 8.0},
+	{0x516D, -1, -1, 6.0},
+	{0x5341, -1, -1, 10.0},
+	{0x5343, -1, -1, 1000.0},
+	{0x5344, -1, -1, 20.0},
+	{0x5345, -1, -1, 30.0},
+	{0x534C, -1, -1, 40.0},
+	{0x53C1, -1, -1, 3.0},
+	{0x53C2, -1, -1, 3.0},
+	{0x53C3, -1, -1, 3.0},
+	{0x53C4, -1, -1, 3.0},
+	{0x56DB, -1, -1, 4.0},
+	{0x58F1, -1, -1, 1.0},
+	{0x58F9, -1, -1, 1.0},
+	{0x5E7A, -1, -1, 1.0},
+	{0x5EFE, -1, -1, 9.0},
+	{0x5EFF, -1, -1, 20.0},
+	{0x5F0C, -1, -1, 1.0},
+	{0x5F0D, -1, -1, 2.0},
+	{0x5F0E, -1, -1, 3.0},
+	{0x5F10, -1, -1, 2.0},
+	{0x62FE, -1, -1, 10.0},
+	{0x634C, -1, -1, 8.0},
+	{0x67D2, -1, -1, 7.0},
+	{0x6F06, -1, -1, 7.0},
+	{0x7396, -1, -1, 9.0},
+	{0x767E, -1, -1, 100.0},
+	{0x8086, -1, -1, 4.0},
+	{0x842C, -1, -1, 10000.0},
+	{0x8CAE, -1, -1, 2.0},
+	{0x8CB3, -1, -1, 2.0},
+	{0x8D30, -1, -1, 2.0},
+	{0x9621, -1, -1, 1000.0},
+	{0x9646, -1, -1, 6.0},
+	{0x964C, -1, -1, 100.0},
+	{0x9678, -1, -1, 6.0},
+	{0x96F6, -1, -1, 0.0},
+	{0xA620, 0, 0, 0.0},
+	{0xA621, 1, 1, 1.0},
+	{0xA622, 2, 2, 2.0},
+	{0xA623, 3, 3, 3.0},
+	{0xA624, 4, 4, 4.0},
+	{0xA625, 5, 5, 5.0},
+	{0xA626, 6, 6, 6.0},
+	{0xA627, 7, 7, 7.0},
+	{0xA628, 8, 8, 8.0},
+	{0xA629, 9, 9, 9.0},
+	{0xA6E6, -1, -1, 1.0},
+	{0xA6E7, -1, -1, 2.0},
+	{0xA6E8, -1, -1, 3.0},
+	{0xA6E9, -1, -1, 4.0},
+	{0xA6EA, -1, -1, 5.0},
+	{0xA6EB, -1, -1, 6.0},
+	{0xA6EC, -1, -1, 7.0},
+	{0xA6ED, -1, -1, 8.0},
+	{0xA6EE, -1, -1, 9.0},
+	{0xA6EF, -1, -1, 0.0},
+	{0xA830, -1, -1, 0.25},
+	{0xA831, -1, -1, 0.5},
+	{0xA832, -1, -1, 0.75},
+	{0xA833, -1, -1, 0.0625},
+	{0xA834, -1, -1, 0.125},
+	{0xA835, -1, -1, 0.1875},
+	{0xA8D0, 0, 0, 0.0},
+	{0xA8D1, 1, 1, 1.0},
+	{0xA8D2, 2, 2, 2.0},
+	{0xA8D3, 3, 3, 3.0},
+	{0xA8D4, 4, 4, 4.0},
+	{0xA8D5, 5, 5, 5.0},
+	{0xA8D6, 6, 6, 6.0},
+	{0xA8D7, 7, 7, 7.0},
+	{0xA8D8, 8, 8, 8.0},
+	{0xA8D9, 9, 9, 9.0},
+	{0xA900, 0, 0, 0.0},
+	{0xA901, 1, 1, 1.0},
+	{0xA902, 2, 2, 2.0},
+	{0xA903, 3, 3, 3.0},
+	{0xA904, 4, 4, 4.0},
+	{0xA905, 5, 5, 5.0},
+	{0xA906, 6, 6, 6.0},
+	{0xA907, 7, 7, 7.0},
+	{0xA908, 8, 8, 8.0},
+	{0xA909, 9, 9, 9.0},
+	{0xA9D0, 0, 0, 0.0},
+	{0xA9D1, 1, 1, 1.0},
+	{0xA9D2, 2, 2, 2.0},
+	{0xA9D3, 3, 3, 3.0},
+	{0xA9D4, 4, 4, 4.0},
+	{0xA9D5, 5, 5, 5.0},
+	{0xA9D6, 6, 6, 6.0},
+	{0xA9D7, 7, 7, 7.0},
+	{0xA9D8, 8, 8, 8.0},
+	{0xA9D9, 9, 9, 9.0},
+	{0xA9F0, 0, 0, 0.0},
+	{0xA9F1, 1, 1, 1.0},
+	{0xA9F2, 2, 2, 2.0},
+	{0xA9F3, 3, 3, 3.0},
+	{0xA9F4, 4, 4, 4.0},
+	{0xA9F5, 5, 5, 5.0},
+	{0xA9F6, 6, 6, 6.0},
+	{0xA9F7, 7, 7, 7.0},
+	{0xA9F8, 8, 8, 8.0},
+	{0xA9F9, 9, 9, 9.0},
+	{0xAA50, 0, 0, 0.0},
+	{0xAA51, 1, 1, 1.0},
+	{0xAA52, 2, 2, 2.0},
+	{0xAA53, 3, 3, 3.0},
+	{0xAA54, 4, 4, 4.0},
+	{0xAA55, 5, 5, 5.0},
+	{0xAA56, 6, 6, 6.0},
+	{0xAA57, 7, 7, 7.0},
+	{0xAA58, 8, 8, 8.0},
+	{0xAA59, 9, 9, 9.0},
+	{0xABF0, 0, 0, 0.0},
+	{0xABF1, 1, 1, 1.0},
+	{0xABF2, 2, 2, 2.0},
+	{0xABF3, 3, 3, 3.0},
+	{0xABF4, 4, 4, 4.0},
+	{0xABF5, 5, 5, 5.0},
+	{0xABF6, 6, 6, 6.0},
+	{0xABF7, 7, 7, 7.0},
+	{0xABF8, 8, 8, 8.0},
+	{0xABF9, 9, 9, 9.0},
+	{0xF96B, -1, -1, 3.0},
+	{0xF973, -1, -1, 10.0},
+	{0xF978, -1, -1, 2.0},
+	{0xF9B2, -1, -1, 0.0},
+	{0xF9D1, -1, -1, 6.0},
+	{0xF9D3, -1, -1, 6.0},
+	{0xF9FD, -1, -1, 10.0},
+	{0xFF10, 0, 0, 0.0},
+	{0xFF11, 1, 1, 1.0},
+	{0xFF12, 2, 2, 2.0},
+	{0xFF13, 3, 3, 3.0},
+	{0xFF14, 4, 4, 4.0},
+	{0xFF15, 5, 5, 5.0},
+	{0xFF16, 6, 6, 6.0},
+	{0xFF17, 7, 7, 7.0},
+	{0xFF18, 8, 8, 8.0},
+	{0xFF19, 9, 9, 9.0},
+	{0x10107, -1, -1, 1.0},
+	{0x10108, -1, -1, 2.0},
+	{0x10109, -1, -1, 3.0},
+	{0x1010A, -1, -1, 4.0},
+	{0x1010B, -1, -1, 5.0},
+	{0x1010C, -1, -1, 6.0},
+	{0x1010D, -1, -1, 7.0},
+	{0x1010E, -1, -1, 8.0},
+	{0x1010F, -1, -1, 9.0},
+	{0x10110, -1, -1, 10.0},
+	{0x10111, -1, -1, 20.0},
+	{0x10112, -1, -1, 30.0},
+	{0x10113, -1, -1, 40.0},
+	{0x10114, -1, -1, 50.0},
+	{0x10115, -1, -1, 60.0},
+	{0x10116, -1, -1, 70.0},
+	{0x10117, -1, -1, 80.0},
+	{0x10118, -1, -1, 90.0},
+	{0x10119, -1, -1, 100.0},
+	{0x1011A, -1, -1, 200.0},
+	{0x1011B, -1, -1, 300.0},
+	{0x1011C, -1, -1, 400.0},
+	{0x1011D, -1, -1, 500.0},
+	{0x1011E, -1, -1, 600.0},
+	{0x1011F, -1, -1, 700.0},
+	{0x10120, -1, -1, 800.0},
+	{0x10121, -1, -1, 900.0},
+	{0x10122, -1, -1, 1000.0},
+	{0x10123, -1, -1, 2000.0},
+	{0x10124, -1, -1, 3000.0},
+	{0x10125, -1, -1, 4000.0},
+	{0x10126, -1, -1, 5000.0},
+	{0x10127, -1, -1, 6000.0},
+	{0x10128, -1, -1, 7000.0},
+	{0x10129, -1, -1, 8000.0},
+	{0x1012A, -1, -1, 9000.0},
+	{0x1012B, -1, -1, 10000.0},
+	{0x1012C, -1, -1, 20000.0},
+	{0x1012D, -1, -1, 30000.0},
+	{0x1012E, -1, -1, 40000.0},
+	{0x1012F, -1, -1, 50000.0},
+	{0x10130, -1, -1, 60000.0},
+	{0x10131, -1, -1, 70000.0},
+	{0x10132, -1, -1, 80000.0},
+	{0x10133, -1, -1, 90000.0},
+	{0x10140, -1, -1, 0.25},
+	{0x10141, -1, -1, 0.5},
+	{0x10142, -1, -1, 1.0},
+	{0x10143, -1, -1, 5.0},
+	{0x10144, -1, -1, 50.0},
+	{0x10145, -1, -1, 500.0},
+	{0x10146, -1, -1, 5000.0},
+	{0x10147, -1, -1, 50000.0},
+	{0x10148, -1, -1, 5.0},
+	{0x10149, -1, -1, 10.0},
+	{0x1014A, -1, -1, 50.0},
+	{0x1014B, -1, -1, 100.0},
+	{0x1014C, -1, -1, 500.0},
+	{0x1014D, -1, -1, 1000.0},
+	{0x1014E, -1, -1, 5000.0},
+	{0x1014F, -1, -1, 5.0},
+	{0x10150, -1, -1, 10.0},
+	{0x10151, -1, -1, 50.0},
+	{0x10152, -1, -1, 100.0},
+	{0x10153, -1, -1, 500.0},
+	{0x10154, -1, -1, 1000.0},
+	{0x10155, -1, -1, 10000.0},
+	{0x10156, -1, -1, 50000.0},
+	{0x10157, -1, -1, 10.0},
+	{0x10158, -1, -1, 1.0},
+	{0x10159, -1, -1, 1.0},
+	{0x1015A, -1, -1, 1.0},
+	{0x1015B, -1, -1, 2.0},
+	{0x1015C, -1, -1, 2.0},
+	{0x1015D, -1, -1, 2.0},
+	{0x1015E, -1, -1, 2.0},
+	{0x1015F, -1, -1, 5.0},
+	{0x10160, -1, -1, 10.0},
+	{0x10161, -1, -1, 10.0},
+	{0x10162, -1, -1, 10.0},
+	{0x10163, -1, -1, 10.0},
+	{0x10164, -1, -1, 10.0},
+	{0x10165, -1, -1, 30.0},
+	{0x10166, -1, -1, 50.0},
+	{0x10167, -1, -1, 50.0},
+	{0x10168, -1, -1, 50.0},
+	{0x10169, -1, -1, 50.0},
+	{0x1016A, -1, -1, 100.0},
+	{0x1016B, -1, -1, 300.0},
+	{0x1016C, -1, -1, 500.0},
+	{0x1016D, -1, -1, 500.0},
+	{0x1016E, -1, -1, 500.0},
+	{0x1016F, -1, -1, 500.0},
+	{0x10170, -1, -1, 500.0},
+	{0x10171, -1, -1, 1000.0},
+	{0x10172, -1, -1, 5000.0},
+	{0x10173, -1, -1, 5.0},
+	{0x10174, -1, -1, 50.0},
+	{0x10175, -1, -1, 0.5},
+	{0x10176, -1, -1, 0.5},
+	{0x10177, -1, -1, 0.6666666666666666},
+	{0x10178, -1, -1, 0.75},
+	{0x1018A, -1, -1, 0.0},
+	{0x1018B, -1, -1, 0.25},
+	{0x102E1, -1, -1, 1.0},
+	{0x102E2, -1, -1, 2.0},
+	{0x102E3, -1, -1, 3.0},
+	{0x102E4, -1, -1, 4.0},
+	{0x102E5, -1, -1, 5.0},
+	{0x102E6, -1, -1, 6.0},
+	{0x102E7, -1, -1, 7.0},
+	{0x102E8, -1, -1, 8.0},
+	{0x102E9, -1, -1, 9.0},
+	{0x102EA, -1, -1, 10.0},
+	{0x102EB, -1, -1, 20.0},
+	{0x102EC, -1, -1, 30.0},
+	{0x102ED, -1, -1, 40.0},
+	{0x102EE, -1, -1, 50.0},
+	{0x102EF, -1, -1, 60.0},
+	{0x102F0, -1, -1, 70.0},
+	{0x102F1, -1, -1, 80.0},
+	{0x102F2, -1, -1, 90.0},
+	{0x102F3, -1, -1, 100.0},
+	{0x102F4, -1, -1, 200.0},
+	{0x102F5, -1, -1, 300.0},
+	{0x102F6, -1, -1, 400.0},
+	{0x102F7, -1, -1, 500.0},
+	{0x102F8, -1, -1, 600.0},
+	{0x102F9, -1, -1, 700.0},
+	{0x102FA, -1, -1, 800.0},
+	{0x102FB, -1, -1, 900.0},
+	{0x10320, -1, -1, 1.0},
+	{0x10321, -1, -1, 5.0},
+	{0x10322, -1, -1, 10.0},
+	{0x10323, -1, -1, 50.0},
+	{0x10341, -1, -1, 90.0},
+	{0x1034A, -1, -1, 900.0},
+	{0x103D1, -1, -1, 1.0},
+	{0x103D2, -1, -1, 2.0},
+	{0x103D3, -1, -1, 10.0},
+	{0x103D4, -1, -1, 20.0},
+	{0x103D5, -1, -1, 100.0},
+	{0x104A0, 0, 0, 0.0},
+	{0x104A1, 1, 1, 1.0},
+	{0x104A2, 2, 2, 2.0},
+	{0x104A3, 3, 3, 3.0},
+	{0x104A4, 4, 4, 4.0},
+	{0x104A5, 5, 5, 5.0},
+	{0x104A6, 6, 6, 6.0},
+	{0x104A7, 7, 7, 7.0},
+	{0x104A8, 8, 8, 8.0},
+	{0x104A9, 9, 9, 9.0},
+	{0x10858, -1, -1, 1.0},
+	{0x10859, -1, -1, 2.0},
+	{0x1085A, -1, -1, 3.0},
+	{0x1085B, -1, -1, 10.0},
+	{0x1085C, -1, -1, 20.0},
+	{0x1085D, -1, -1, 100.0},
+	{0x1085E, -1, -1, 1000.0},
+	{0x1085F, -1, -1, 10000.0},
+	{0x10879, -1, -1, 1.0},
+	{0x1087A, -1, -1, 2.0},
+	{0x1087B, -1, -1, 3.0},
+	{0x1087C, -1, -1, 4.0},
+	{0x1087D, -1, -1, 5.0},
+	{0x1087E, -1, -1, 10.0},
+	{0x1087F, -1, -1, 20.0},
+	{0x108A7, -1, -1, 1.0},
+	{0x108A8, -1, -1, 2.0},
+	{0x108A9, -1, -1, 3.0},
+	{0x108AA, -1, -1, 4.0},
+	{0x108AB, -1, -1, 4.0},
+	{0x108AC, -1, -1, 5.0},
+	{0x108AD, -1, -1, 10.0},
+	{0x108AE, -1, -1, 20.0},
+	{0x108AF, -1, -1, 100.0},
+	{0x108FB, -1, -1, 1.0},
+	{0x108FC, -1, -1, 5.0},
+	{0x108FD, -1, -1, 10.0},
+	{0x108FE, -1, -1, 20.0},
+	{0x108FF, -1, -1, 100.0},
+	{0x10916, -1, -1, 1.0},
+	{0x10917, -1, -1, 10.0},
+	{0x10918, -1, -1, 20.0},
+	{0x10919, -1, -1, 100.0},
+	{0x1091A, -1, -1, 2.0},
+	{0x1091B, -1, -1, 3.0},
+	{0x109BC, -1, -1, 0.9166666666666666},
+	{0x109BD, -1, -1, 0.5},
+	{0x109C0, -1, -1, 1.0},
+	{0x109C1, -1, -1, 2.0},
+	{0x109C2, -1, -1, 3.0},
+	{0x109C3, -1, -1, 4.0},
+	{0x109C4, -1, -1, 5.0},
+	{0x109C5, -1, -1, 6.0},
+	{0x109C6, -1, -1, 7.0},
+	{0x109C7, -1, -1, 8.0},
+	{0x109C8, -1, -1, 9.0},
+	{0x109C9, -1, -1, 10.0},
+	{0x109CA, -1, -1, 20.0},
+	{0x109CB, -1, -1, 30.0},
+	{0x109CC, -1, -1, 40.0},
+	{0x109CD, -1, -1, 50.0},
+	{0x109CE, -1, -1, 60.0},
+	{0x109CF, -1, -1, 70.0},
+	{0x109D2, -1, -1, 100.0},
+	{0x109D3, -1, -1, 200.0},
+	{0x109D4, -1, -1, 300.0},
+	{0x109D5, -1, -1, 400.0},
+	{0x109D6, -1, -1, 500.0},
+	{0x109D7, -1, -1, 600.0},
+	{0x109D8, -1, -1, 700.0},
+	{0x109D9, -1, -1, 800.0},
+	{0x109DA, -1, -1, 900.0},
+	{0x109DB, -1, -1, 1000.0},
+	{0x109DC, -1, -1, 2000.0},
+	{0x109DD, -1, -1, 3000.0},
+	{0x109DE, -1, -1, 4000.0},
+	{0x109DF, -1, -1, 5000.0},
+	{0x109E0, -1, -1, 6000.0},
+	{0x109E1, -1, -1, 7000.0},
+	{0x109E2, -1, -1, 8000.0},
+	{0x109E3, -1, -1, 9000.0},
+	{0x109E4, -1, -1, 10000.0},
+	{0x109E5, -1, -1, 20000.0},
+	{0x109E6, -1, -1, 30000.0},
+	{0x109E7, -1, -1, 40000.0},
+	{0x109E8, -1, -1, 50000.0},
+	{0x109E9, -1, -1, 60000.0},
+	{0x109EA, -1, -1, 70000.0},
+	{0x109EB, -1, -1, 80000.0},
+	{0x109EC, -1, -1, 90000.0},
+	{0x109ED, -1, -1, 100000.0},
+	{0x109EE, -1, -1, 200000.0},
+	{0x109EF, -1, -1, 300000.0},
+	{0x109F0, -1, -1, 400000.0},
+	{0x109F1, -1, -1, 500000.0},
+	{0x109F2, -1, -1, 600000.0},
+	{0x109F3, -1, -1, 700000.0},
+	{0x109F4, -1, -1, 800000.0},
+	{0x109F5, -1, -1, 900000.0},
+	{0x109F6, -1, -1, 0.08333333333333333},
+	{0x109F7, -1, -1, 0.16666666666666666},
+	{0x109F8, -1, -1, 0.25},
+	{0x109F9, -1, -1, 0.3333333333333333},
+	{0x109FA, -1, -1, 0.4166666666666667},
+	{0x109FB, -1, -1, 0.5},
+	{0x109FC, -1, -1, 0.5833333333333334},
+	{0x109FD, -1, -1, 0.6666666666666666},
+	{0x109FE, -1, -1, 0.75},
+	{0x109FF, -1, -1, 0.8333333333333334},
+	{0x10A40, -1, 1, 1.0},
+	{0x10A41, -1, 2, 2.0},
+	{0x10A42, -1, 3, 3.0},
+	{0x10A43, -1, 4, 4.0},
+	{0x10A44, -1, -1, 10.0},
+	{0x10A45, -1, -1, 20.0},
+	{0x10A46, -1, -1, 100.0},
+	{0x10A47, -1, -1, 1000.0},
+	{0x10A48, -1, -1, 0.5},
+	{0x10A7D, -1, -1, 1.0},
+	{0x10A7E, -1, -1, 50.0},
+	{0x10A9D, -1, -1, 1.0},
+	{0x10A9E, -1, -1, 10.0},
+	{0x10A9F, -1, -1, 20.0},
+	{0x10AEB, -1, -1, 1.0},
+	{0x10AEC, -1, -1, 5.0},
+	{0x10AED, -1, -1, 10.0},
+	{0x10AEE, -1, -1, 20.0},
+	{0x10AEF, -1, -1, 100.0},
+	{0x10B58, -1, -1, 1.0},
+	{0x10B59, -1, -1, 2.0},
+	{0x10B5A, -1, -1, 3.0},
+	{0x10B5B, -1, -1, 4.0},
+	{0x10B5C, -1, -1, 10.0},
+	{0x10B5D, -1, -1, 20.0},
+	{0x10B5E, -1, -1, 100.0},
+	{0x10B5F, -1, -1, 1000.0},
+	{0x10B78, -1, -1, 1.0},
+	{0x10B79, -1, -1, 2.0},
+	{0x10B7A, -1, -1, 3.0},
+	{0x10B7B, -1, -1, 4.0},
+	{0x10B7C, -1, -1, 10.0},
+	{0x10B7D, -1, -1, 20.0},
+	{0x10B7E, -1, -1, 100.0},
+	{0x10B7F, -1, -1, 1000.0},
+	{0x10BA9, -1, -1, 1.0},
+	{0x10BAA, -1, -1, 2.0},
+	{0x10BAB, -1, -1, 3.0},
+	{0x10BAC, -1, -1, 4.0},
+	{0x10BAD, -1, -1, 10.0},
+	{0x10BAE, -1, -1, 20.0},
+	{0x10BAF, -1, -1, 100.0},
+	{0x10CFA, -1, -1, 1.0},
+	{0x10CFB, -1, -1, 5.0},
+	{0x10CFC, -1, -1, 10.0},
+	{0x10CFD, -1, -1, 50.0},
+	{0x10CFE, -1, -1, 100.0},
+	{0x10CFF, -1, -1, 1000.0},
+	{0x10D30, 0, 0, 0.0},
+	{0x10D31, 1, 1, 1.0},
+	{0x10D32, 2, 2, 2.0},
+	{0x10D33, 3, 3, 3.0},
+	{0x10D34, 4, 4, 4.0},
+	{0x10D35, 5, 5, 5.0},
+	{0x10D36, 6, 6, 6.0},
+	{0x10D37, 7, 7, 7.0},
+	{0x10D38, 8, 8, 8.0},
+	{0x10D39, 9, 9, 9.0},
+	{0x10E60, -1, 1, 1.0},
+	{0x10E61, -1, 2, 2.0},
+	{0x10E62, -1, 3, 3.0},
+	{0x10E63, -1, 4, 4.0},
+	{0x10E64, -1, 5, 5.0},
+	{0x10E65, -1, 6, 6.0},
+	{0x10E66, -1, 7, 7.0},
+	{0x10E67, -1, 8, 8.0},
+	{0x10E68, -1, 9, 9.0},
+	{0x10E69, -1, -1, 10.0},
+	{0x10E6A, -1, -1, 20.0},
+	{0x10E6B, -1, -1, 30.0},
+	{0x10E6C, -1, -1, 40.0},
+	{0x10E6D, -1, -1, 50.0},
+	{0x10E6E, -1, -1, 60.0},
+	{0x10E6F, -1, -1, 70.0},
+	{0x10E70, -1, -1, 80.0},
+	{0x10E71, -1, -1, 90.0},
+	{0x10E72, -1, -1, 100.0},
+	{0x10E73, -1, -1, 200.0},
+	{0x10E74, -1, -1, 300.0},
+	{0x10E75, -1, -1, 400.0},
+	{0x10E76, -1, -1, 500.0},
+	{0x10E77, -1, -1, 600.0},
+	{0x10E78, -1, -1, 700.0},
+	{0x10E79, -1, -1, 800.0},
+	{0x10E7A, -1, -1, 900.0},
+	{0x10E7B, -1, -1, 0.5},
+	{0x10E7C, -1, -1, 0.25},
+	{0x10E7D, -1, -1, 0.3333333333333333},
+	{0x10E7E, -1, -1, 0.6666666666666666},
+	{0x10F1D, -1, -1, 1.0},
+	{0x10F1E, -1, -1, 2.0},
+	{0x10F1F, -1, -1, 3.0},
+	{0x10F20, -1, -1, 4.0},
+	{0x10F21, -1, -1, 5.0},
+	{0x10F22, -1, -1, 10.0},
+	{0x10F23, -1, -1, 20.0},
+	{0x10F24, -1, -1, 30.0},
+	{0x10F25, -1, -1, 100.0},
+	{0x10F26, -1, -1, 0.5},
+	{0x10F51, -1, -1, 1.0},
+	{0x10F52, -1, -1, 10.0},
+	{0x10F53, -1, -1, 20.0},
+	{0x10F54, -1, -1, 100.0},
+	{0x10FC5, -1, -1, 1.0},
+	{0x10FC6, -1, -1, 2.0},
+	{0x10FC7, -1, -1, 3.0},
+	{0x10FC8, -1, -1, 4.0},
+	{0x10FC9, -1, -1, 10.0},
+	{0x10FCA, -1, -1, 20.0},
+	{0x10FCB, -1, -1, 100.0},
+	{0x11052, -1, 1, 1.0},
+	{0x11053, -1, 2, 2.0},
+	{0x11054, -1, 3, 3.0},
+	{0x11055, -1, 4, 4.0},
+	{0x11056, -1, 5, 5.0},
+	{0x11057, -1, 6, 6.0},
+	{0x11058, -1, 7, 7.0},
+	{0x11059, -1, 8, 8.0},
+	{0x1105A, -1, 9, 9.0},
+	{0x1105B, -1, -1, 10.0},
+	{0x1105C, -1, -1, 20.0},
+	{0x1105D, -1, -1, 30.0},
+	{0x1105E, -1, -1, 40.0},
+	{0x1105F, -1, -1, 50.0},
+	{0x11060, -1, -1, 60.0},
+	{0x11061, -1, -1, 70.0},
+	{0x11062, -1, -1, 80.0},
+	{0x11063, -1, -1, 90.0},
+	{0x11064, -1, -1, 100.0},
+	{0x11065, -1, -1, 1000.0},
+	{0x11066, 0, 0, 0.0},
+	{0x11067, 1, 1, 1.0},
+	{0x11068, 2, 2, 2.0},
+	{0x11069, 3, 3, 3.0},
+	{0x1106A, 4, 4, 4.0},
+	{0x1106B, 5, 5, 5.0},
+	{0x1106C, 6, 6, 6.0},
+	{0x1106D, 7, 7, 7.0},
+	{0x1106E, 8, 8, 8.0},
+	{0x1106F, 9, 9, 9.0},
+	{0x110F0, 0, 0, 0.0},
+	{0x110F1, 1, 1, 1.0},
+	{0x110F2, 2, 2, 2.0},
+	{0x110F3, 3, 3, 3.0},
+	{0x110F4, 4, 4, 4.0},
+	{0x110F5, 5, 5, 5.0},
+	{0x110F6, 6, 6, 6.0},
+	{0x110F7, 7, 7, 7.0},
+	{0x110F8, 8, 8, 8.0},
+	{0x110F9, 9, 9, 9.0},
+	{0x11136, 0, 0, 0.0},
+	{0x11137, 1, 1, 1.0},
+	{0x11138, 2, 2, 2.0},
+	{0x11139, 3, 3, 3.0},
+	{0x1113A, 4, 4, 4.0},
+	{0x1113B, 5, 5, 5.0},
+	{0x1113C, 6, 6, 6.0},
+	{0x1113D, 7, 7, 7.0},
+	{0x1113E, 8, 8, 8.0},
+	{0x1113F, 9, 9, 9.0},
+	{0x111D0, 0, 0, 0.0},
+	{0x111D1, 1, 1, 1.0},
+	{0x111D2, 2, 2, 2.0},
+	{0x111D3, 3, 3, 3.0},
+	{0x111D4, 4, 4, 4.0},
+	{0x111D5, 5, 5, 5.0},
+	{0x111D6, 6, 6, 6.0},
+	{0x111D7, 7, 7, 7.0},
+	{0x111D8, 8, 8, 8.0},
+	{0x111D9, 9, 9, 9.0},
+	{0x111E1, -1, -1, 1.0},
+	{0x111E2, -1, -1, 2.0},
+	{0x111E3, -1, -1, 3.0},
+	{0x111E4, -1, -1, 4.0},
+	{0x111E5, -1, -1, 5.0},
+	{0x111E6, -1, -1, 6.0},
+	{0x111E7, -1, -1, 7.0},
+	{0x111E8, -1, -1, 8.0},
+	{0x111E9, -1, -1, 9.0},
+	{0x111EA, -1, -1, 10.0},
+	{0x111EB, -1, -1, 20.0},
+	{0x111EC, -1, -1, 30.0},
+	{0x111ED, -1, -1, 40.0},
+	{0x111EE, -1, -1, 50.0},
+	{0x111EF, -1, -1, 60.0},
+	{0x111F0, -1, -1, 70.0},
+	{0x111F1, -1, -1, 80.0},
+	{0x111F2, -1, -1, 90.0},
+	{0x111F3, -1, -1, 100.0},
+	{0x111F4, -1, -1, 1000.0},
+	{0x112F0, 0, 0, 0.0},
+	{0x112F1, 1, 1, 1.0},
+	{0x112F2, 2, 2, 2.0},
+	{0x112F3, 3, 3, 3.0},
+	{0x112F4, 4, 4, 4.0},
+	{0x112F5, 5, 5, 5.0},
+	{0x112F6, 6, 6, 6.0},
+	{0x112F7, 7, 7, 7.0},
+	{0x112F8, 8, 8, 8.0},
+	{0x112F9, 9, 9, 9.0},
+	{0x11450, 0, 0, 0.0},
+	{0x11451, 1, 1, 1.0},
+	{0x11452, 2, 2, 2.0},
+	{0x11453, 3, 3, 3.0},
+	{0x11454, 4, 4, 4.0},
+	{0x11455, 5, 5, 5.0},
+	{0x11456, 6, 6, 6.0},
+	{0x11457, 7, 7, 7.0},
+	{0x11458, 8, 8, 8.0},
+	{0x11459, 9, 9, 9.0},
+	{0x114D0, 0, 0, 0.0},
+	{0x114D1, 1, 1, 1.0},
+	{0x114D2, 2, 2, 2.0},
+	{0x114D3, 3, 3, 3.0},
+	{0x114D4, 4, 4, 4.0},
+	{0x114D5, 5, 5, 5.0},
+	{0x114D6, 6, 6, 6.0},
+	{0x114D7, 7, 7, 7.0},
+	{0x114D8, 8, 8, 8.0},
+	{0x114D9, 9, 9, 9.0},
+	{0x11650, 0, 0, 0.0},
+	{0x11651, 1, 1, 1.0},
+	{0x11652, 2, 2, 2.0},
+	{0x11653, 3, 3, 3.0},
+	{0x11654, 4, 4, 4.0},
+	{0x11655, 5, 5, 5.0},
+	{0x11656, 6, 6, 6.0},
+	{0x11657, 7, 7, 7.0},
+	{0x11658, 8, 8, 8.0},
+	{0x11659, 9, 9, 9.0},
+	{0x116C0, 0, 0, 0.0},
+	{0x116C1, 1, 1, 1.0},
+	{0x116C2, 2, 2, 2.0},
+	{0x116C3, 3, 3, 3.0},
+	{0x116C4, 4, 4, 4.0},
+	{0x116C5, 5, 5, 5.0},
+	{0x116C6, 6, 6, 6.0},
+	{0x116C7, 7, 7, 7.0},
+	{0x116C8, 8, 8, 8.0},
+	{0x116C9, 9, 9, 9.0},
+	{0x11730, 0, 0, 0.0},
+	{0x11731, 1, 1, 1.0},
+	{0x11732, 2, 2, 2.0},
+	{0x11733, 3, 3, 3.0},
+	{0x11734, 4, 4, 4.0},
+	{0x11735, 5, 5, 5.0},
+	{0x11736, 6, 6, 6.0},
+	{0x11737, 7, 7, 7.0},
+	{0x11738, 8, 8, 8.0},
+	{0x11739, 9, 9, 9.0},
+	{0x1173A, -1, -1, 10.0},
+	{0x1173B, -1, -1, 20.0},
+	{0x118E0, 0, 0, 0.0},
+	{0x118E1, 1, 1, 1.0},
+	{0x118E2, 2, 2, 2.0},
+	{0x118E3, 3, 3, 3.0},
+	{0x118E4, 4, 4, 4.0},
+	{0x118E5, 5, 5, 5.0},
+	{0x118E6, 6, 6, 6.0},
+	{0x118E7, 7, 7, 7.0},
+	{0x118E8, 8, 8, 8.0},
+	{0x118E9, 9, 9, 9.0},
+	{0x118EA, -1, -1, 10.0},
+	{0x118EB, -1, -1, 20.0},
+	{0x118EC, -1, -1, 30.0},
+	{0x118ED, -1, -1, 40.0},
+	{0x118EE, -1, -1, 50.0},
+	{0x118EF, -1, -1, 60.0},
+	{0x118F0, -1, -1, 70.0},
+	{0x118F1, -1, -1, 80.0},
+	{0x118F2, -1, -1, 90.0},
+	{0x11950, 0, 0, 0.0},
+	{0x11951, 1, 1, 1.0},
+	{0x11952, 2, 2, 2.0},
+	{0x11953, 3, 3, 3.0},
+	{0x11954, 4, 4, 4.0},
+	{0x11955, 5, 5, 5.0},
+	{0x11956, 6, 6, 6.0},
+	{0x11957, 7, 7, 7.0},
+	{0x11958, 8, 8, 8.0},
+	{0x11959, 9, 9, 9.0},
+	{0x11C50, 0, 0, 0.0},
+	{0x11C51, 1, 1, 1.0},
+	{0x11C52, 2, 2, 2.0},
+	{0x11C53, 3, 3, 3.0},
+	{0x11C54, 4, 4, 4.0},
+	{0x11C55, 5, 5, 5.0},
+	{0x11C56, 6, 6, 6.0},
+	{0x11C57, 7, 7, 7.0},
+	{0x11C58, 8, 8, 8.0},
+	{0x11C59, 9, 9, 9.0},
+	{0x11C5A, -1, -1, 1.0},
+	{0x11C5B, -1, -1, 2.0},
+	{0x11C5C, -1, -1, 3.0},
+	{0x11C5D, -1, -1, 4.0},
+	{0x11C5E, -1, -1, 5.0},
+	{0x11C5F, -1, -1, 6.0},
+	{0x11C60, -1, -1, 7.0},
+	{0x11C61, -1, -1, 8.0},
+	{0x11C62, -1, -1, 9.0},
+	{0x11C63, -1, -1, 10.0},
+	{0x11C64, -1, -1, 20.0},
+	{0x11C65, -1, -1, 30.0},
+	{0x11C66, -1, -1, 40.0},
+	{0x11C67, -1, -1, 50.0},
+	{0x11C68, -1, -1, 60.0},
+	{0x11C69, -1, -1, 70.0},
+	{0x11C6A, -1, -1, 80.0},
+	{0x11C6B, -1, -1, 90.0},
+	{0x11C6C, -1, -1, 100.0},
+	{0x11D50, 0, 0, 0.0},
+	{0x11D51, 1, 1, 1.0},
+	{0x11D52, 2, 2, 2.0},
+	{0x11D53, 3, 3, 3.0},
+	{0x11D54, 4, 4, 4.0},
+	{0x11D55, 5, 5, 5.0},
+	{0x11D56, 6, 6, 6.0},
+	{0x11D57, 7, 7, 7.0},
+	{0x11D58, 8, 8, 8.0},
+	{0x11D59, 9, 9, 9.0},
+	{0x11DA0, 0, 0, 0.0},
+	{0x11DA1, 1, 1, 1.0},
+	{0x11DA2, 2, 2, 2.0},
+	{0x11DA3, 3, 3, 3.0},
+	{0x11DA4, 4, 4, 4.0},
+	{0x11DA5, 5, 5, 5.0},
+	{0x11DA6, 6, 6, 6.0},
+	{0x11DA7, 7, 7, 7.0},
+	{0x11DA8, 8, 8, 8.0},
+	{0x11DA9, 9, 9, 9.0},
+	{0x11FC0, -1, -1, 0.003125},
+	{0x11FC1, -1, -1, 0.00625},
+	{0x11FC2, -1, -1, 0.0125},
+	{0x11FC3, -1, -1, 0.015625},
+	{0x11FC4, -1, -1, 0.025},
+	{0x11FC5, -1, -1, 0.03125},
+	{0x11FC6, -1, -1, 0.0375},
+	{0x11FC7, -1, -1, 0.046875},
+	{0x11FC8, -1, -1, 0.05},
+	{0x11FC9, -1, -1, 0.0625},
+	{0x11FCA, -1, -1, 0.0625},
+	{0x11FCB, -1, -1, 0.1},
+	{0x11FCC, -1, -1, 0.125},
+	{0x11FCD, -1, -1, 0.15},
+	{0x11FCE, -1, -1, 0.1875},
+	{0x11FCF, -1, -1, 0.2},
+	{0x11FD0, -1, -1, 0.25},
+	{0x11FD1, -1, -1, 0.5},
+	{0x11FD2, -1, -1, 0.5},
+	{0x11FD3, -1, -1, 0.75},
+	{0x11FD4, -1, -1, 0.003125},
+	{0x12400, -1, -1, 2.0},
+	{0x12401, -1, -1, 3.0},
+	{0x12402, -1, -1, 4.0},
+	{0x12403, -1, -1, 5.0},
+	{0x12404, -1, -1, 6.0},
+	{0x12405, -1, -1, 7.0},
+	{0x12406, -1, -1, 8.0},
+	{0x12407, -1, -1, 9.0},
+	{0x12408, -1, -1, 3.0},
+	{0x12409, -1, -1, 4.0},
+	{0x1240A, -1, -1, 5.0},
+	{0x1240B, -1, -1, 6.0},
+	{0x1240C, -1, -1, 7.0},
+	{0x1240D, -1, -1, 8.0},
+	{0x1240E, -1, -1, 9.0},
+	{0x1240F, -1, -1, 4.0},
+	{0x12410, -1, -1, 5.0},
+	{0x12411, -1, -1, 6.0},
+	{0x12412, -1, -1, 7.0},
+	{0x12413, -1, -1, 8.0},
+	{0x12414, -1, -1, 9.0},
+	{0x12415, -1, -1, 1.0},
+	{0x12416, -1, -1, 2.0},
+	{0x12417, -1, -1, 3.0},
+	{0x12418, -1, -1, 4.0},
+	{0x12419, -1, -1, 5.0},
+	{0x1241A, -1, -1, 6.0},
+	{0x1241B, -1, -1, 7.0},
+	{0x1241C, -1, -1, 8.0},
+	{0x1241D, -1, -1, 9.0},
+	{0x1241E, -1, -1, 1.0},
+	{0x1241F, -1, -1, 2.0},
+	{0x12420, -1, -1, 3.0},
+	{0x12421, -1, -1, 4.0},
+	{0x12422, -1, -1, 5.0},
+	{0x12423, -1, -1, 2.0},
+	{0x12424, -1, -1, 3.0},
+	{0x12425, -1, -1, 3.0},
+	{0x12426, -1, -1, 4.0},
+	{0x12427, -1, -1, 5.0},
+	{0x12428, -1, -1, 6.0},
+	{0x12429, -1, -1, 7.0},
+	{0x1242A, -1, -1, 8.0},
+	{0x1242B, -1, -1, 9.0},
+	{0x1242C, -1, -1, 1.0},
+	{0x1242D, -1, -1, 2.0},
+	{0x1242E, -1, -1, 3.0},
+	{0x1242F, -1, -1, 3.0},
+	{0x12430, -1, -1, 4.0},
+	{0x12431, -1, -1, 5.0},
+	{0x12432, -1, -1, 216000.0},
+	{0x12433, -1, -1, 432000.0},
+	{0x12434, -1, -1, 1.0},
+	{0x12435, -1, -1, 2.0},
+	{0x12436, -1, -1, 3.0},
+	{0x12437, -1, -1, 3.0},
+	{0x12438, -1, -1, 4.0},
+	{0x12439, -1, -1, 5.0},
+	{0x1243A, -1, -1, 3.0},
+	{0x1243B, -1, -1, 3.0},
+	{0x1243C, -1, -1, 4.0},
+	{0x1243D, -1, -1, 4.0},
+	{0x1243E, -1, -1, 4.0},
+	{0x1243F, -1, -1, 4.0},
+	{0x12440, -1, -1, 6.0},
+	{0x12441, -1, -1, 7.0},
+	{0x12442, -1, -1, 7.0},
+	{0x12443, -1, -1, 7.0},
+	{0x12444, -1, -1, 8.0},
+	{0x12445, -1, -1, 8.0},
+	{0x12446, -1, -1, 9.0},
+	{0x12447, -1, -1, 9.0},
+	{0x12448, -1, -1, 9.0},
+	{0x12449, -1, -1, 9.0},
+	{0x1244A, -1, -1, 2.0},
+	{0x1244B, -1, -1, 3.0},
+	{0x1244C, -1, -1, 4.0},
+	{0x1244D, -1, -1, 5.0},
+	{0x1244E, -1, -1, 6.0},
+	{0x1244F, -1, -1, 1.0},
+	{0x12450, -1, -1, 2.0},
+	{0x12451, -1, -1, 3.0},
+	{0x12452, -1, -1, 4.0},
+	{0x12453, -1, -1, 4.0},
+	{0x12454, -1, -1, 5.0},
+	{0x12455, -1, -1, 5.0},
+	{0x12456, -1, -1, 2.0},
+	{0x12457, -1, -1, 3.0},
+	{0x12458, -1, -1, 1.0},
+	{0x12459, -1, -1, 2.0},
+	{0x1245A, -1, -1, 0.3333333333333333},
+	{0x1245B, -1, -1, 0.6666666666666666},
+	{0x1245C, -1, -1, 0.8333333333333334},
+	{0x1245D, -1, -1, 0.3333333333333333},
+	{0x1245E, -1, -1, 0.6666666666666666},
+	{0x1245F, -1, -1, 0.125},
+	{0x12460, -1, -1, 0.25},
+	{0x12461, -1, -1, 0.16666666666666666},
+	{0x12462, -1, -1, 0.25},
+	{0x12463, -1, -1, 0.25},
+	{0x12464, -1, -1, 0.5},
+	{0x12465, -1, -1, 0.3333333333333333},
+	{0x12466, -1, -1, 0.6666666666666666},
+	{0x12467, -1, -1, 40.0},
+	{0x12468, -1, -1, 50.0},
+	{0x12469, -1, -1, 4.0},
+	{0x1246A, -1, -1, 5.0},
+	{0x1246B, -1, -1, 6.0},
+	{0x1246C, -1, -1, 7.0},
+	{0x1246D, -1, -1, 8.0},
+	{0x1246E, -1, -1, 9.0},
+	{0x16A60, 0, 0, 0.0},
+	{0x16A61, 1, 1, 1.0},
+	{0x16A62, 2, 2, 2.0},
+	{0x16A63, 3, 3, 3.0},
+	{0x16A64, 4, 4, 4.0},
+	{0x16A65, 5, 5, 5.0},
+	{0x16A66, 6, 6, 6.0},
+	{0x16A67, 7, 7, 7.0},
+	{0x16A68, 8, 8, 8.0},
+	{0x16A69, 9, 9, 9.0},
+	{0x16AC0, 0, 0, 0.0},
+	{0x16AC1, 1, 1, 1.0},
+	{0x16AC2, 2, 2, 2.0},
+	{0x16AC3, 3, 3, 3.0},
+	{0x16AC4, 4, 4, 4.0},
+	{0x16AC5, 5, 5, 5.0},
+	{0x16AC6, 6, 6, 6.0},
+	{0x16AC7, 7, 7, 7.0},
+	{0x16AC8, 8, 8, 8.0},
+	{0x16AC9, 9, 9, 9.0},
+	{0x16B50, 0, 0, 0.0},
+	{0x16B51, 1, 1, 1.0},
+	{0x16B52, 2, 2, 2.0},
+	{0x16B53, 3, 3, 3.0},
+	{0x16B54, 4, 4, 4.0},
+	{0x16B55, 5, 5, 5.0},
+	{0x16B56, 6, 6, 6.0},
+	{0x16B57, 7, 7, 7.0},
+	{0x16B58, 8, 8, 8.0},
+	{0x16B59, 9, 9, 9.0},
+	{0x16B5B, -1, -1, 10.0},
+	{0x16B5C, -1, -1, 100.0},
+	{0x16B5D, -1, -1, 10000.0},
+	{0x16B5E, -1, -1, 1000000.0},
+	{0x16B5F, -1, -1, 100000000.0},
+	{0x16B60, -1, -1, 10000000000.0},
+	{0x16B61, -1, -1, 1000000000000.0},
+	{0x16E80, -1, -1, 0.0},
+	{0x16E81, -1, -1, 1.0},
+	{0x16E82, -1, -1, 2.0},
+	{0x16E83, -1, -1, 3.0},
+	{0x16E84, -1, -1, 4.0},
+	{0x16E85, -1, -1, 5.0},
+	{0x16E86, -1, -1, 6.0},
+	{0x16E87, -1, -1, 7.0},
+	{0x16E88, -1, -1, 8.0},
+	{0x16E89, -1, -1, 9.0},
+	{0x16E8A, -1, -1, 10.0},
+	{0x16E8B, -1, -1, 11.0},
+	{0x16E8C, -1, -1, 12.0},
+	{0x16E8D, -1, -1, 13.0},
+	{0x16E8E, -1, -1, 14.0},
+	{0x16E8F, -1, -1, 15.0},
+	{0x16E90, -1, -1, 16.0},
+	{0x16E91, -1, -1, 17.0},
+	{0x16E92, -1, -1, 18.0},
+	{0x16E93, -1, -1, 19.0},
+	{0x16E94, -1, -1, 1.0},
+	{0x16E95, -1, -1, 2.0},
+	{0x16E96, -1, -1, 3.0},
+	{0x1D2E0, -1, -1, 0.0},
+	{0x1D2E1, -1, -1, 1.0},
+	{0x1D2E2, -1, -1, 2.0},
+	{0x1D2E3, -1, -1, 3.0},
+	{0x1D2E4, -1, -1, 4.0},
+	{0x1D2E5, -1, -1, 5.0},
+	{0x1D2E6, -1, -1, 6.0},
+	{0x1D2E7, -1, -1, 7.0},
+	{0x1D2E8, -1, -1, 8.0},
+	{0x1D2E9, -1, -1, 9.0},
+	{0x1D2EA, -1, -1, 10.0},
+	{0x1D2EB, -1, -1, 11.0},
+	{0x1D2EC, -1, -1, 12.0},
+	{0x1D2ED, -1, -1, 13.0},
+	{0x1D2EE, -1, -1, 14.0},
+	{0x1D2EF, -1, -1, 15.0},
+	{0x1D2F0, -1, -1, 16.0},
+	{0x1D2F1, -1, -1, 17.0},
+	{0x1D2F2, -1, -1, 18.0},
+	{0x1D2F3, -1, -1, 19.0},
+	{0x1D360, -1, -1, 1.0},
+	{0x1D361, -1, -1, 2.0},
+	{0x1D362, -1, -1, 3.0},
+	{0x1D363, -1, -1, 4.0},
+	{0x1D364, -1, -1, 5.0},
+	{0x1D365, -1, -1, 6.0},
+	{0x1D366, -1, -1, 7.0},
+	{0x1D367, -1, -1, 8.0},
+	{0x1D368, -1, -1, 9.0},
+	{0x1D369, -1, -1, 10.0},
+	{0x1D36A, -1, -1, 20.0},
+	{0x1D36B, -1, -1, 30.0},
+	{0x1D36C, -1, -1, 40.0},
+	{0x1D36D, -1, -1, 50.0},
+	{0x1D36E, -1, -1, 60.0},
+	{0x1D36F, -1, -1, 70.0},
+	{0x1D370, -1, -1, 80.0},
+	{0x1D371, -1, -1, 90.0},
+	{0x1D372, -1, -1, 1.0},
+	{0x1D373, -1, -1, 2.0},
+	{0x1D374, -1, -1, 3.0},
+	{0x1D375, -1, -1, 4.0},
+	{0x1D376, -1, -1, 5.0},
+	{0x1D377, -1, -1, 1.0},
+	{0x1D378, -1, -1, 5.0},
+	{0x1D7CE, 0, 0, 0.0},
+	{0x1D7CF, 1, 1, 1.0},
+	{0x1D7D0, 2, 2, 2.0},
+	{0x1D7D1, 3, 3, 3.0},
+	{0x1D7D2, 4, 4, 4.0},
+	{0x1D7D3, 5, 5, 5.0},
+	{0x1D7D4, 6, 6, 6.0},
+	{0x1D7D5, 7, 7, 7.0},
+	{0x1D7D6, 8, 8, 8.0},
+	{0x1D7D7, 9, 9, 9.0},
+	{0x1D7D8, 0, 0, 0.0},
+	{0x1D7D9, 1, 1, 1.0},
+	{0x1D7DA, 2, 2, 2.0},
+	{0x1D7DB, 3, 3, 3.0},
+	{0x1D7DC, 4, 4, 4.0},
+	{0x1D7DD, 5, 5, 5.0},
+	{0x1D7DE, 6, 6, 6.0},
+	{0x1D7DF, 7, 7, 7.0},
+	{0x1D7E0, 8, 8, 8.0},
+	{0x1D7E1, 9, 9, 9.0},
+	{0x1D7E2, 0, 0, 0.0},
+	{0x1D7E3, 1, 1, 1.0},
+	{0x1D7E4, 2, 2, 2.0},
+	{0x1D7E5, 3, 3, 3.0},
+	{0x1D7E6, 4, 4, 4.0},
+	{0x1D7E7, 5, 5, 5.0},
+	{0x1D7E8, 6, 6, 6.0},
+	{0x1D7E9, 7, 7, 7.0},
+	{0x1D7EA, 8, 8, 8.0},
+	{0x1D7EB, 9, 9, 9.0},
+	{0x1D7EC, 0, 0, 0.0},
+	{0x1D7ED, 1, 1, 1.0},
+	{0x1D7EE, 2, 2, 2.0},
+	{0x1D7EF, 3, 3, 3.0},
+	{0x1D7F0, 4, 4, 4.0},
+	{0x1D7F1, 5, 5, 5.0},
+	{0x1D7F2, 6, 6, 6.0},
+	{0x1D7F3, 7, 7, 7.0},
+	{0x1D7F4, 8, 8, 8.0},
+	{0x1D7F5, 9, 9, 9.0},
+	{0x1D7F6, 0, 0, 0.0},
+	{0x1D7F7, 1, 1, 1.0},
+	{0x1D7F8, 2, 2, 2.0},
+	{0x1D7F9, 3, 3, 3.0},
+	{0x1D7FA, 4, 4, 4.0},
+	{0x1D7FB, 5, 5, 5.0},
+	{0x1D7FC, 6, 6, 6.0},
+	{0x1D7FD, 7, 7, 7.0},
+	{0x1D7FE, 8, 8, 8.0},
+	{0x1D7FF, 9, 9, 9.0},
+	{0x1E140, 0, 0, 0.0},
+	{0x1E141, 1, 1, 1.0},
+	{0x1E142, 2, 2, 2.0},
+	{0x1E143, 3, 3, 3.0},
+	{0x1E144, 4, 4, 4.0},
+	{0x1E145, 5, 5, 5.0},
+	{0x1E146, 6, 6, 6.0},
+	{0x1E147, 7, 7, 7.0},
+	{0x1E148, 8, 8, 8.0},
+	{0x1E149, 9, 9, 9.0},
+	{0x1E2F0, 0, 0, 0.0},
+	{0x1E2F1, 1, 1, 1.0},
+	{0x1E2F2, 2, 2, 2.0},
+	{0x1E2F3, 3, 3, 3.0},
+	{0x1E2F4, 4, 4, 4.0},
+	{0x1E2F5, 5, 5, 5.0},
+	{0x1E2F6, 6, 6, 6.0},
+	{0x1E2F7, 7, 7, 7.0},
+	{0x1E2F8, 8, 8, 8.0},
+	{0x1E2F9, 9, 9, 9.0},
+	{0x1E8C7, -1, -1, 1.0},
+	{0x1E8C8, -1, -1, 2.0},
+	{0x1E8C9, -1, -1, 3.0},
+	{0x1E8CA, -1, -1, 4.0},
+	{0x1E8CB, -1, -1, 5.0},
+	{0x1E8CC, -1, -1, 6.0},
+	{0x1E8CD, -1, -1, 7.0},
+	{0x1E8CE, -1, -1, 8.0},
+	{0x1E8CF, -1, -1, 9.0},
+	{0x1E950, 0, 0, 0.0},
+	{0x1E951, 1, 1, 1.0},
+	{0x1E952, 2, 2, 2.0},
+	{0x1E953, 3, 3, 3.0},
+	{0x1E954, 4, 4, 4.0},
+	{0x1E955, 5, 5, 5.0},
+	{0x1E956, 6, 6, 6.0},
+	{0x1E957, 7, 7, 7.0},
+	{0x1E958, 8, 8, 8.0},
+	{0x1E959, 9, 9, 9.0},
+	{0x1EC71, -1, -1, 1.0},
+	{0x1EC72, -1, -1, 2.0},
+	{0x1EC73, -1, -1, 3.0},
+	{0x1EC74, -1, -1, 4.0},
+	{0x1EC75, -1, -1, 5.0},
+	{0x1EC76, -1, -1, 6.0},
+	{0x1EC77, -1, -1, 7.0},
+	{0x1EC78, -1, -1, 8.0},
+	{0x1EC79, -1, -1, 9.0},
+	{0x1EC7A, -1, -1, 10.0},
+	{0x1EC7B, -1, -1, 20.0},
+	{0x1EC7C, -1, -1, 30.0},
+	{0x1EC7D, -1, -1, 40.0},
+	{0x1EC7E, -1, -1, 50.0},
+	{0x1EC7F, -1, -1, 60.0},
+	{0x1EC80, -1, -1, 70.0},
+	{0x1EC81, -1, -1, 80.0},
+	{0x1EC82, -1, -1, 90.0},
+	{0x1EC83, -1, -1, 100.0},
+	{0x1EC84, -1, -1, 200.0},
+	{0x1EC85, -1, -1, 300.0},
+	{0x1EC86, -1, -1, 400.0},
+	{0x1EC87, -1, -1, 500.0},
+	{0x1EC88, -1, -1, 600.0},
+	{0x1EC89, -1, -1, 700.0},
+	{0x1EC8A, -1, -1, 800.0},
+	{0x1EC8B, -1, -1, 900.0},
+	{0x1EC8C, -1, -1, 1000.0},
+	{0x1EC8D, -1, -1, 2000.0},
+	{0x1EC8E, -1, -1, 3000.0},
+	{0x1EC8F, -1, -1, 4000.0},
+	{0x1EC90, -1, -1, 5000.0},
+	{0x1EC91, -1, -1, 6000.0},
+	{0x1EC92, -1, -1, 7000.0},
+	{0x1EC93, -1, -1, 8000.0},
+	{0x1EC94, -1, -1, 9000.0},
+	{0x1EC95, -1, -1, 10000.0},
+	{0x1EC96, -1, -1, 20000.0},
+	{0x1EC97, -1, -1, 30000.0},
+	{0x1EC98, -1, -1, 40000.0},
+	{0x1EC99, -1, -1, 50000.0},
+	{0x1EC9A, -1, -1, 60000.0},
+	{0x1EC9B, -1, -1, 70000.0},
+	{0x1EC9C, -1, -1, 80000.0},
+	{0x1EC9D, -1, -1, 90000.0},
+	{0x1EC9E, -1, -1, 100000.0},
+	{0x1EC9F, -1, -1, 200000.0},
+	{0x1ECA0, -1, -1, 100000.0},
+	{0x1ECA1, -1, -1, 10000000.0},
+	{0x1ECA2, -1, -1, 20000000.0},
+	{0x1ECA3, -1, -1, 1.0},
+	{0x1ECA4, -1, -1, 2.0},
+	{0x1ECA5, -1, -1, 3.0},
+	{0x1ECA6, -1, -1, 4.0},
+	{0x1ECA7, -1, -1, 5.0},
+	{0x1ECA8, -1, -1, 6.0},
+	{0x1ECA9, -1, -1, 7.0},
+	{0x1ECAA, -1, -1, 8.0},
+	{0x1ECAB, -1, -1, 9.0},
+	{0x1ECAD, -1, -1, 0.25},
+	{0x1ECAE, -1, -1, 0.5},
+	{0x1ECAF, -1, -1, 0.75},
+	{0x1ECB1, -1, -1, 1.0},
+	{0x1ECB2, -1, -1, 2.0},
+	{0x1ECB3, -1, -1, 10000.0},
+	{0x1ECB4, -1, -1, 100000.0},
+	{0x1ED01, -1, -1, 1.0},
+	{0x1ED02, -1, -1, 2.0},
+	{0x1ED03, -1, -1, 3.0},
+	{0x1ED04, -1, -1, 4.0},
+	{0x1ED05, -1, -1, 5.0},
+	{0x1ED06, -1, -1, 6.0},
+	{0x1ED07, -1, -1, 7.0},
+	{0x1ED08, -1, -1, 8.0},
+	{0x1ED09, -1, -1, 9.0},
+	{0x1ED0A, -1, -1, 10.0},
+	{0x1ED0B, -1, -1, 20.0},
+	{0x1ED0C, -1, -1, 30.0},
+	{0x1ED0D, -1, -1, 40.0},
+	{0x1ED0E, -1, -1, 50.0},
+	{0x1ED0F, -1, -1, 60.0},
+	{0x1ED10, -1, -1, 70.0},
+	{0x1ED11, -1, -1, 80.0},
+	{0x1ED12, -1, -1, 90.0},
+	{0x1ED13, -1, -1, 100.0},
+	{0x1ED14, -1, -1, 200.0},
+	{0x1ED15, -1, -1, 300.0},
+	{0x1ED16, -1, -1, 400.0},
+	{0x1ED17, -1, -1, 500.0},
+	{0x1ED18, -1, -1, 600.0},
+	{0x1ED19, -1, -1, 700.0},
+	{0x1ED1A, -1, -1, 800.0},
+	{0x1ED1B, -1, -1, 900.0},
+	{0x1ED1C, -1, -1, 1000.0},
+	{0x1ED1D, -1, -1, 2000.0},
+	{0x1ED1E, -1, -1, 3000.0},
+	{0x1ED1F, -1, -1, 4000.0},
+	{0x1ED20, -1, -1, 5000.0},
+	{0x1ED21, -1, -1, 6000.0},
+	{0x1ED22, -1, -1, 7000.0},
+	{0x1ED23, -1, -1, 8000.0},
+	{0x1ED24, -1, -1, 9000.0},
+	{0x1ED25, -1, -1, 10000.0},
+	{0x1ED26, -1, -1, 20000.0},
+	{0x1ED27, -1, -1, 30000.0},
+	{0x1ED28, -1, -1, 40000.0},
+	{0x1ED29, -1, -1, 50000.0},
+	{0x1ED2A, -1, -1, 60000.0},
+	{0x1ED2B, -1, -1, 70000.0},
+	{0x1ED2C, -1, -1, 80000.0},
+	{0x1ED2D, -1, -1, 90000.0},
+	{0x1ED2F, -1, -1, 2.0},
+	{0x1ED30, -1, -1, 3.0},
+	{0x1ED31, -1, -1, 4.0},
+	{0x1ED32, -1, -1, 5.0},
+	{0x1ED33, -1, -1, 6.0},
+	{0x1ED34, -1, -1, 7.0},
+	{0x1ED35, -1, -1, 8.0},
+	{0x1ED36, -1, -1, 9.0},
+	{0x1ED37, -1, -1, 10.0},
+	{0x1ED38, -1, -1, 400.0},
+	{0x1ED39, -1, -1, 600.0},
+	{0x1ED3A, -1, -1, 2000.0},
+	{0x1ED3B, -1, -1, 10000.0},
+	{0x1ED3C, -1, -1, 0.5},
+	{0x1ED3D, -1, -1, 0.16666666666666666},
+	{0x1F100, -1, 0, 0.0},
+	{0x1F101, -1, 0, 0.0},
+	{0x1F102, -1, 1, 1.0},
+	{0x1F103, -1, 2, 2.0},
+	{0x1F104, -1, 3, 3.0},
+	{0x1F105, -1, 4, 4.0},
+	{0x1F106, -1, 5, 5.0},
+	{0x1F107, -1, 6, 6.0},
+	{0x1F108, -1, 7, 7.0},
+	{0x1F109, -1, 8, 8.0},
+	{0x1F10A, -1, 9, 9.0},
+	{0x1F10B, -1, -1, 0.0},
+	{0x1F10C, -1, -1, 0.0},
+	{0x1FBF0, 0, 0, 0.0},
+	{0x1FBF1, 1, 1, 1.0},
+	{0x1FBF2, 2, 2, 2.0},
+	{0x1FBF3, 3, 3, 3.0},
+	{0x1FBF4, 4, 4, 4.0},
+	{0x1FBF5, 5, 5, 5.0},
+	{0x1FBF6, 6, 6, 6.0},
+	{0x1FBF7, 7, 7, 7.0},
+	{0x1FBF8, 8, 8, 8.0},
+	{0x1FBF9, 9, 9, 9.0},
+	{0x20001, -1, -1, 7.0},
+	{0x20064, -1, -1, 4.0},
+	{0x200E2, -1, -1, 4.0},
+	{0x20121, -1, -1, 5.0},
+	{0x2092A, -1, -1, 1.0},
+	{0x20983, -1, -1, 30.0},
+	{0x2098C, -1, -1, 40.0},
+	{0x2099C, -1, -1, 40.0},
+	{0x20AEA, -1, -1, 6.0},
+	{0x20AFD, -1, -1, 3.0},
+	{0x20B19, -1, -1, 3.0},
+	{0x22390, -1, -1, 2.0},
+	{0x22998, -1, -1, 3.0},
+	{0x23B1B, -1, -1, 3.0},
+	{0x2626D, -1, -1, 4.0},
+	{0x2F890, -1, -1, 9.0},
+}
+
+// Ranges of ideographs named by their prefix and hex code point
+var ideographRanges = []ideographRange{
+	{0x3400, 0x4DBF, "CJK UNIFIED IDEOGRAPH-"},
+	{0x4E00, 0x9FFF, "CJK UNIFIED IDEOGRAPH-"},
+	{0xF900, 0xFA6D, "CJK COMPATIBILITY IDEOGRAPH-"},
+	{0xFA70, 0xFAD9, "CJK COMPATIBILITY IDEOGRAPH-"},
+	{0x18B00, 0x18CD5, "KHITAN SMALL SCRIPT CHARACTER-"},
+	{0x1B170, 0x1B2FB, "NUSHU CHARACTER-"},
+	{0x20000, 0x2A6DF, "CJK UNIFIED IDEOGRAPH-"},
+	{0x2A700, 0x2B738, "CJK UNIFIED IDEOGRAPH-"},
+	{0x2B740, 0x2B81D, "CJK UNIFIED IDEOGRAPH-"},
+	{0x2B820, 0x2CEA1, "CJK UNIFIED IDEOGRAPH-"},
+	{0x2CEB0, 0x2EBE0, "CJK UNIFIED IDEOGRAPH-"},
+	{0x2F800, 0x2FA1D, "CJK COMPATIBILITY IDEOGRAPH-"},
+	{0x30000, 0x3134A, "CJK UNIFIED IDEOGRAPH-"},
+}
+
+// Names of the other characters, one per line. Each line has
+// the difference from the previous code point in hex, the number
+// of leading bytes shared with the previous name and the rest of
+// the name.
+const namesData = `20 0 SPACE
+1 0 EXCLAMATION MARK
+1 0 QUOTATION MARK
+1 0 NUMBER SIGN
+1 0 DOLLAR SIGN
+1 0 PERCENT SIGN
+1 0 AMPERSAND
+1 1 POSTROPHE
+1 0 LEFT PARENTHESIS
+1 0 RIGHT PARENTHESIS
+1 0 ASTERISK
+1 0 PLUS SIGN
+1 0 COMMA
+1 0 HYPHEN-MINUS
+1 0 FULL STOP
+1 0 SOLIDUS
+1 0 DIGIT ZERO
+1 6 ONE
+1 6 TWO
+1 7 HREE
+1 6 FOUR
+1 7 IVE
+1 6 SIX
+1 7 EVEN
+1 6 EIGHT
+1 6 NINE
+1 0 COLON
+1 0 SEMICOLON
+1 0 LESS-THAN SIGN
+1 0 EQUALS SIGN
+1 0 GREATER-THAN SIGN
+1 0 QUESTION MARK
+1 0 COMMERCIAL AT
+1 0 LATIN CAPITAL LETTER A
+1 21 B
+1 21 C
+1 21 D
+1 21 E
+1 21 F
+1 21 G
+1 21 H
+1 21 I
+1 21 J
+1 21 K
+1 21 L
+1 21 M
+1 21 N
+1 21 O
+1 21 P
+1 21 Q
+1 21 R
+1 21 S
+1 21 T
+1 21 U
+1 21 V
+1 21 W
+1 21 X
+1 21 Y
+1 21 Z
+1 1 EFT SQUARE BRACKET
+1 0 REVERSE SOLIDUS
+1 1 IGHT SQUARE BRACKET
+1 0 CIRCUMFLEX ACCENT
+1 0 LOW LINE
+1 0 GRAVE ACCENT
+1 0 LATIN SMALL LETTER A
+1 19 B
+1 19 C
+1 19 D
+1 19 E
+1 19 F
+1 19 G
+1 19 H
+1 19 I
+1 19 J
+1 19 K
+1 19 L
+1 19 M
+1 19 N
+1 19 O
+1 19 P
+1 19 Q
+1 19 R
+1 19 S
+1 19 T
+1 19 U
+1 19 V
+1 19 W
+1 19 X
+1 19 Y
+1 19 Z
+1 1 EFT CURLY BRACKET
+1 0 VERTICAL LINE
+1 0 RIGHT CURLY BRACKET
+1 0 TILDE
+22 0 NO-BREAK SPACE
+1 0 INVERTED EXCLAMATION MARK
+1 0 CENT SIGN
+1 0 POUND SIGN
+1 0 CURRENCY SIGN
+1 0 YEN SIGN
+1 0 BROKEN BAR
+1 0 SECTION SIGN
+1 0 DIAERESIS
+1 0 COPYRIGHT SIGN
+1 0 FEMININE ORDINAL INDICATOR
+1 0 LEFT-POINTING DOUBLE ANGLE QUOTATION MARK
+1 0 NOT SIGN
+1 0 SOFT HYPHEN
+1 0 REGISTERED SIGN
+1 0 MACRON
+1 0 DEGREE SIGN
+1 0 PLUS-MINUS SIGN
+1 0 SUPERSCRIPT TWO
+1 13 HREE
+1 0 ACUTE ACCENT
+1 0 MICRO SIGN
+1 0 PILCROW SIGN
+1 0 MIDDLE DOT
+1 0 CEDILLA
+1 0 SUPERSCRIPT ONE
+1 0 MASCULINE ORDINAL INDICATOR
+1 0 RIGHT-POINTING DOUBLE ANGLE QUOTATION MARK
+1 0 VULGAR FRACTION ONE QUARTER
+1 20 HALF
+1 16 THREE QUARTERS
+1 0 INVERTED QUESTION MARK
+1 0 LATIN CAPITAL LETTER A WITH GRAVE
+1 28 ACUTE
+1 28 CIRCUMFLEX
+1 28 TILDE
+1 28 DIAERESIS
+1 28 RING ABOVE
+1 22 E
+1 21 C WITH CEDILLA
+1 21 E WITH GRAVE
+1 28 ACUTE
+1 28 CIRCUMFLEX
+1 28 DIAERESIS
+1 21 I WITH GRAVE
+1 28 ACUTE
+1 28 CIRCUMFLEX
+1 28 DIAERESIS
+1 21 ETH
+1 21 N WITH TILDE
+1 21 O WITH GRAVE
+1 28 ACUTE
+1 28 CIRCUMFLEX
+1 28 TILDE
+1 28 DIAERESIS
+1 0 MULTIPLICATION SIGN
+1 0 LATIN CAPITAL LETTER O WITH STROKE
+1 21 U WITH GRAVE
+1 28 ACUTE
+1 28 CIRCUMFLEX
+1 28 DIAERESIS
+1 21 Y WITH ACUTE
+1 21 THORN
+1 6 SMALL LETTER SHARP S
+1 19 A WITH GRAVE
+1 26 ACUTE
+1 26 CIRCUMFLEX
+1 26 TILDE
+1 26 DIAERESIS
+1 26 RING ABOVE
+1 20 E
+1 19 C WITH CEDILLA
+1 19 E WITH GRAVE
+1 26 ACUTE
+1 26 CIRCUMFLEX
+1 26 DIAERESIS
+1 19 I WITH GRAVE
+1 26 ACUTE
+1 26 CIRCUMFLEX
+1 26 DIAERESIS
+1 19 ETH
+1 19 N WITH TILDE
+1 19 O WITH GRAVE
+1 26 ACUTE
+1 26 CIRCUMFLEX
+1 26 TILDE
+1 26 DIAERESIS
+1 0 DIVISION SIGN
+1 0 LATIN SMALL LETTER O WITH STROKE
+1 19 U WITH GRAVE
+1 26 ACUTE
+1 26 CIRCUMFLEX
+1 26 DIAERESIS
+1 19 Y WITH ACUTE
+1 19 THORN
+1 19 Y WITH DIAERESIS
+1 6 CAPITAL LETTER A WITH MACRON
+1 6 SMALL LETTER A WITH MACRON
+1 6 CAPITAL LETTER A WITH BREVE
+1 6 SMALL LETTER A WITH BREVE
+1 6 CAPITAL LETTER A WITH OGONEK
+1 6 SMALL LETTER A WITH OGONEK
+1 6 CAPITAL LETTER C WITH ACUTE
+1 6 SMALL LETTER C WITH ACUTE
+1 6 CAPITAL LETTER C WITH CIRCUMFLEX
+1 6 SMALL LETTER C WITH CIRCUMFLEX
+1 6 CAPITAL LETTER C WITH DOT ABOVE
+1 6 SMALL LETTER C WITH DOT ABOVE
+1 6 CAPITAL LETTER C WITH CARON
+1 6 SMALL LETTER C WITH CARON
+1 6 CAPITAL LETTER D WITH CARON
+1 6 SMALL LETTER D WITH CARON
+1 6 CAPITAL LETTER D WITH STROKE
+1 6 SMALL LETTER D WITH STROKE
+1 6 CAPITAL LETTER E WITH MACRON
+1 6 SMALL LETTER E WITH MACRON
+1 6 CAPITAL LETTER E WITH BREVE
+1 6 SMALL LETTER E WITH BREVE
+1 6 CAPITAL LETTER E WITH DOT ABOVE
+1 6 SMALL LETTER E WITH DOT ABOVE
+1 6 CAPITAL LETTER E WITH OGONEK
+1 6 SMALL LETTER E WITH OGONEK
+1 6 CAPITAL LETTER E WITH CARON
+1 6 SMALL LETTER E WITH CARON
+1 6 CAPITAL LETTER G WITH CIRCUMFLEX
+1 6 SMALL LETTER G WITH CIRCUMFLEX
+1 6 CAPITAL LETTER G WITH BREVE
+1 6 SMALL LETTER G WITH BREVE
+1 6 CAPITAL LETTER G WITH DOT ABOVE
+1 6 SMALL LETTER G WITH DOT ABOVE
+1 6 CAPITAL LETTER G WITH CEDILLA
+1 6 SMALL LETTER G WITH CEDILLA
+1 6 CAPITAL LETTER H WITH CIRCUMFLEX
+1 6 SMALL LETTER H WITH CIRCUMFLEX
+1 6 CAPITAL LETTER H WITH STROKE
+1 6 SMALL LETTER H WITH STROKE
+1 6 CAPITAL LETTER I WITH TILDE
+1 6 SMALL LETTER I WITH TILDE
+1 6 CAPITAL LETTER I WITH MACRON
+1 6 SMALL LETTER I WITH MACRON
+1 6 CAPITAL LETTER I WITH BREVE
+1 6 SMALL LETTER I WITH BREVE
+1 6 CAPITAL LETTER I WITH OGONEK
+1 6 SMALL LETTER I WITH OGONEK
+1 6 CAPITAL LETTER I WITH DOT ABOVE
+1 6 SMALL LETTER DOTLESS I
+1 6 CAPITAL LIGATURE IJ
+1 6 SMALL LIGATURE IJ
+1 6 CAPITAL LETTER J WITH CIRCUMFLEX
+1 6 SMALL LETTER J WITH CIRCUMFLEX
+1 6 CAPITAL LETTER K WITH CEDILLA
+1 6 SMALL LETTER K WITH CEDILLA
+1 20 RA
+1 6 CAPITAL LETTER L WITH ACUTE
+1 6 SMALL LETTER L WITH ACUTE
+1 6 CAPITAL LETTER L WITH CEDILLA
+1 6 SMALL LETTER L WITH CEDILLA
+1 6 CAPITAL LETTER L WITH CARON
+1 6 SMALL LETTER L WITH CARON
+1 6 CAPITAL LETTER L WITH MIDDLE DOT
+1 6 SMALL LETTER L WITH MIDDLE DOT
+1 6 CAPITAL LETTER L WITH STROKE
+1 6 SMALL LETTER L WITH STROKE
+1 6 CAPITAL LETTER N WITH ACUTE
+1 6 SMALL LETTER N WITH ACUTE
+1 6 CAPITAL LETTER N WITH CEDILLA
+1 6 SMALL LETTER N WITH CEDILLA
+1 6 CAPITAL LETTER N WITH CARON
+1 6 SMALL LETTER N WITH CARON
+1 21 PRECEDED BY APOSTROPHE
+1 6 CAPITAL LETTER ENG
+1 6 SMALL LETTER ENG
+1 6 CAPITAL LETTER O WITH MACRON
+1 6 SMALL LETTER O WITH MACRON
+1 6 CAPITAL LETTER O WITH BREVE
+1 6 SMALL LETTER O WITH BREVE
+1 6 CAPITAL LETTER O WITH DOUBLE ACUTE
+1 6 SMALL LETTER O WITH DOUBLE ACUTE
+1 6 CAPITAL LIGATURE OE
+1 6 SMALL LIGATURE OE
+1 6 CAPITAL LETTER R WITH ACUTE
+1 6 SMALL LETTER R WITH ACUTE
+1 6 CAPITAL LETTER R WITH CEDILLA
+1 6 SMALL LETTER R WITH CEDILLA
+1 6 CAPITAL LETTER R WITH CARON
+1 6 SMALL LETTER R WITH CARON
+1 6 CAPITAL LETTER S WITH ACUTE
+1 6 SMALL LETTER S WITH ACUTE
+1 6 CAPITAL LETTER S WITH CIRCUMFLEX
+1 6 SMALL LETTER S WITH CIRCUMFLEX
+1 6 CAPITAL LETTER S WITH CEDILLA
+1 6 SMALL LETTER S WITH CEDILLA
+1 6 CAPITAL LETTER S WITH CARON
+1 6 SMALL LETTER S WITH CARON
+1 6 CAPITAL LETTER T WITH CEDILLA
+1 6 SMALL LETTER T WITH CEDILLA
+1 6 CAPITAL LETTER T WITH CARON
+1 6 SMALL LETTER T WITH CARON
+1 6 CAPITAL LETTER T WITH STROKE
+1 6 SMALL LETTER T WITH STROKE
+1 6 CAPITAL LETTER U WITH TILDE
+1 6 SMALL LETTER U WITH TILDE
+1 6 CAPITAL LETTER U WITH MACRON
+1 6 SMALL LETTER U WITH MACRON
+1 6 CAPITAL LETTER U WITH BREVE
+1 6 SMALL LETTER U WITH BREVE
+1 6 CAPITAL LETTER U WITH RING ABOVE
+1 6 SMALL LETTER U WITH RING ABOVE
+1 6 CAPITAL LETTER U WITH DOUBLE ACUTE
+1 6 SMALL LETTER U WITH DOUBLE ACUTE
+1 6 CAPITAL LETTER U WITH OGONEK
+1 6 SMALL LETTER U WITH OGONEK
+1 6 CAPITAL LETTER W WITH CIRCUMFLEX
+1 6 SMALL LETTER W WITH CIRCUMFLEX
+1 6 CAPITAL LETTER Y WITH CIRCUMFLEX
+1 6 SMALL LETTER Y WITH CIRCUMFLEX
+1 6 CAPITAL LETTER Y WITH DIAERESIS
+1 21 Z WITH ACUTE
+1 6 SMALL LETTER Z WITH ACUTE
+1 6 CAPITAL LETTER Z WITH DOT ABOVE
+1 6 SMALL LETTER Z WITH DOT ABOVE
+1 6 CAPITAL LETTER Z WITH CARON
+1 6 SMALL LETTER Z WITH CARON
+1 19 LONG S
+1 19 B WITH STROKE
+1 6 CAPITAL LETTER B WITH HOOK
+1 28 TOPBAR
+1 6 SMALL LETTER B WITH TOPBAR
+1 6 CAPITAL LETTER TONE SIX
+1 6 SMALL LETTER TONE SIX
+1 6 CAPITAL LETTER OPEN O
+1 21 C WITH HOOK
+1 6 SMALL LETTER C WITH HOOK
+1 6 CAPITAL LETTER AFRICAN D
+1 21 D WITH HOOK
+1 28 TOPBAR
+1 6 SMALL LETTER D WITH TOPBAR
+1 19 TURNED DELTA
+1 6 CAPITAL LETTER REVERSED E
+1 21 SCHWA
+1 21 OPEN E
+1 21 F WITH HOOK
+1 6 SMALL LETTER F WITH HOOK
+1 6 CAPITAL LETTER G WITH HOOK
+1 22 AMMA
+1 6 SMALL LETTER HV
+1 6 CAPITAL LETTER IOTA
+1 22  WITH STROKE
+1 21 K WITH HOOK
+1 6 SMALL LETTER K WITH HOOK
+1 19 L WITH BAR
+1 20 AMBDA WITH STROKE
+1 6 CAPITAL LETTER TURNED M
+1 21 N WITH LEFT HOOK
+1 6 SMALL LETTER N WITH LONG RIGHT LEG
+1 6 CAPITAL LETTER O WITH MIDDLE TILDE
+1 28 HORN
+1 6 SMALL LETTER O WITH HORN
+1 6 CAPITAL LETTER OI
+1 6 SMALL LETTER OI
+1 6 CAPITAL LETTER P WITH HOOK
+1 6 SMALL LETTER P WITH HOOK
+1 6 LETTER YR
+1 6 CAPITAL LETTER TONE TWO
+1 6 SMALL LETTER TONE TWO
+1 6 CAPITAL LETTER ESH
+1 6 LETTER REVERSED ESH LOOP
+1 6 SMALL LETTER T WITH PALATAL HOOK
+1 6 CAPITAL LETTER T WITH HOOK
+1 6 SMALL LETTER T WITH HOOK
+1 6 CAPITAL LETTER T WITH RETROFLEX HOOK
+1 21 U WITH HORN
+1 6 SMALL LETTER U WITH HORN
+1 6 CAPITAL LETTER UPSILON
+1 21 V WITH HOOK
+1 21 Y WITH HOOK
+1 6 SMALL LETTER Y WITH HOOK
+1 6 CAPITAL LETTER Z WITH STROKE
+1 6 SMALL LETTER Z WITH STROKE
+1 6 CAPITAL LETTER EZH
+1 24  REVERSED
+1 6 SMALL LETTER EZH REVERSED
+1 23 WITH TAIL
+1 6 LETTER TWO WITH STROKE
+1 6 CAPITAL LETTER TONE FIVE
+1 6 SMALL LETTER TONE FIVE
+1 6 LETTER INVERTED GLOTTAL STOP WITH STROKE
+1 13 WYNN
+1 13 DENTAL CLICK
+1 13 LATERAL CLICK
+1 13 ALVEOLAR CLICK
+1 13 RETROFLEX CLICK
+1 6 CAPITAL LETTER DZ WITH CARON
+1 22  WITH SMALL LETTER Z WITH CARON
+1 6 SMALL LETTER DZ WITH CARON
+1 6 CAPITAL LETTER LJ
+1 22  WITH SMALL LETTER J
+1 6 SMALL LETTER LJ
+1 6 CAPITAL LETTER NJ
+1 22  WITH SMALL LETTER J
+1 6 SMALL LETTER NJ
+1 6 CAPITAL LETTER A WITH CARON
+1 6 SMALL LETTER A WITH CARON
+1 6 CAPITAL LETTER I WITH CARON
+1 6 SMALL LETTER I WITH CARON
+1 6 CAPITAL LETTER O WITH CARON
+1 6 SMALL LETTER O WITH CARON
+1 6 CAPITAL LETTER U WITH CARON
+1 6 SMALL LETTER U WITH CARON
+1 6 CAPITAL LETTER U WITH DIAERESIS AND MACRON
+1 6 SMALL LETTER U WITH DIAERESIS AND MACRON
+1 6 CAPITAL LETTER U WITH DIAERESIS AND ACUTE
+1 6 SMALL LETTER U WITH DIAERESIS AND ACUTE
+1 6 CAPITAL LETTER U WITH DIAERESIS AND CARON
+1 6 SMALL LETTER U WITH DIAERESIS AND CARON
+1 6 CAPITAL LETTER U WITH DIAERESIS AND GRAVE
+1 6 SMALL LETTER U WITH DIAERESIS AND GRAVE
+1 19 TURNED E
+1 6 CAPITAL LETTER A WITH DIAERESIS AND MACRON
+1 6 SMALL LETTER A WITH DIAERESIS AND MACRON
+1 6 CAPITAL LETTER A WITH DOT ABOVE AND MACRON
+1 6 SMALL LETTER A WITH DOT ABOVE AND MACRON
+1 6 CAPITAL LETTER AE WITH MACRON
+1 6 SMALL LETTER AE WITH MACRON
+1 6 CAPITAL LETTER G WITH STROKE
+1 6 SMALL LETTER G WITH STROKE
+1 6 CAPITAL LETTER G WITH CARON
+1 6 SMALL LETTER G WITH CARON
+1 6 CAPITAL LETTER K WITH CARON
+1 6 SMALL LETTER K WITH CARON
+1 6 CAPITAL LETTER O WITH OGONEK
+1 6 SMALL LETTER O WITH OGONEK
+1 6 CAPITAL LETTER O WITH OGONEK AND MACRON
+1 6 SMALL LETTER O WITH OGONEK AND MACRON
+1 6 CAPITAL LETTER EZH WITH CARON
+1 6 SMALL LETTER EZH WITH CARON
+1 19 J WITH CARON
+1 6 CAPITAL LETTER DZ
+1 22  WITH SMALL LETTER Z
+1 6 SMALL LETTER DZ
+1 6 CAPITAL LETTER G WITH ACUTE
+1 6 SMALL LETTER G WITH ACUTE
+1 6 CAPITAL LETTER HWAIR
+1 21 WYNN
+1 21 N WITH GRAVE
+1 6 SMALL LETTER N WITH GRAVE
+1 6 CAPITAL LETTER A WITH RING ABOVE AND ACUTE
+1 6 SMALL LETTER A WITH RING ABOVE AND ACUTE
+1 6 CAPITAL LETTER AE WITH ACUTE
+1 6 SMALL LETTER AE WITH ACUTE
+1 6 CAPITAL LETTER O WITH STROKE AND ACUTE
+1 6 SMALL LETTER O WITH STROKE AND ACUTE
+1 6 CAPITAL LETTER A WITH DOUBLE GRAVE
+1 6 SMALL LETTER A WITH DOUBLE GRAVE
+1 6 CAPITAL LETTER A WITH INVERTED BREVE
+1 6 SMALL LETTER A WITH INVERTED BREVE
+1 6 CAPITAL LETTER E WITH DOUBLE GRAVE
+1 6 SMALL LETTER E WITH DOUBLE GRAVE
+1 6 CAPITAL LETTER E WITH INVERTED BREVE
+1 6 SMALL LETTER E WITH INVERTED BREVE
+1 6 CAPITAL LETTER I WITH DOUBLE GRAVE
+1 6 SMALL LETTER I WITH DOUBLE GRAVE
+1 6 CAPITAL LETTER I WITH INVERTED BREVE
+1 6 SMALL LETTER I WITH INVERTED BREVE
+1 6 CAPITAL LETTER O WITH DOUBLE GRAVE
+1 6 SMALL LETTER O WITH DOUBLE GRAVE
+1 6 CAPITAL LETTER O WITH INVERTED BREVE
+1 6 SMALL LETTER O WITH INVERTED BREVE
+1 6 CAPITAL LETTER R WITH DOUBLE GRAVE
+1 6 SMALL LETTER R WITH DOUBLE GRAVE
+1 6 CAPITAL LETTER R WITH INVERTED BREVE
+1 6 SMALL LETTER R WITH INVERTED BREVE
+1 6 CAPITAL LETTER U WITH DOUBLE GRAVE
+1 6 SMALL LETTER U WITH DOUBLE GRAVE
+1 6 CAPITAL LETTER U WITH INVERTED BREVE
+1 6 SMALL LETTER U WITH INVERTED BREVE
+1 6 CAPITAL LETTER S WITH COMMA BELOW
+1 6 SMALL LETTER S WITH COMMA BELOW
+1 6 CAPITAL LETTER T WITH COMMA BELOW
+1 6 SMALL LETTER T WITH COMMA BELOW
+1 6 CAPITAL LETTER YOGH
+1 6 SMALL LETTER YOGH
+1 6 CAPITAL LETTER H WITH CARON
+1 6 SMALL LETTER H WITH CARON
+1 6 CAPITAL LETTER N WITH LONG RIGHT LEG
+1 6 SMALL LETTER D WITH CURL
+1 6 CAPITAL LETTER OU
+1 6 SMALL LETTER OU
+1 6 CAPITAL LETTER Z WITH HOOK
+1 6 SMALL LETTER Z WITH HOOK
+1 6 CAPITAL LETTER A WITH DOT ABOVE
+1 6 SMALL LETTER A WITH DOT ABOVE
+1 6 CAPITAL LETTER E WITH CEDILLA
+1 6 SMALL LETTER E WITH CEDILLA
+1 6 CAPITAL LETTER O WITH DIAERESIS AND MACRON
+1 6 SMALL LETTER O WITH DIAERESIS AND MACRON
+1 6 CAPITAL LETTER O WITH TILDE AND MACRON
+1 6 SMALL LETTER O WITH TILDE AND MACRON
+1 6 CAPITAL LETTER O WITH DOT ABOVE
+1 6 SMALL LETTER O WITH DOT ABOVE
+1 6 CAPITAL LETTER O WITH DOT ABOVE AND MACRON
+1 6 SMALL LETTER O WITH DOT ABOVE AND MACRON
+1 6 CAPITAL LETTER Y WITH MACRON
+1 6 SMALL LETTER Y WITH MACRON
+1 19 L WITH CURL
+1 19 N WITH CURL
+1 19 T WITH CURL
+1 19 DOTLESS J
+1 20 B DIGRAPH
+1 19 QP DIGRAPH
+1 6 CAPITAL LETTER A WITH STROKE
+1 21 C WITH STROKE
+1 6 SMALL LETTER C WITH STROKE
+1 6 CAPITAL LETTER L WITH BAR
+1 21 T WITH DIAGONAL STROKE
+1 6 SMALL LETTER S WITH SWASH TAIL
+1 19 Z WITH SWASH TAIL
+1 6 CAPITAL LETTER GLOTTAL STOP
+1 6 SMALL LETTER GLOTTAL STOP
+1 6 CAPITAL LETTER B WITH STROKE
+1 21 U BAR
+1 21 TURNED V
+1 21 E WITH STROKE
+1 6 SMALL LETTER E WITH STROKE
+1 6 CAPITAL LETTER J WITH STROKE
+1 6 SMALL LETTER J WITH STROKE
+1 6 CAPITAL LETTER SMALL Q WITH HOOK TAIL
+1 6 SMALL LETTER Q WITH HOOK TAIL
+1 6 CAPITAL LETTER R WITH STROKE
+1 6 SMALL LETTER R WITH STROKE
+1 6 CAPITAL LETTER Y WITH STROKE
+1 6 SMALL LETTER Y WITH STROKE
+1 19 TURNED A
+1 19 ALPHA
+1 19 TURNED ALPHA
+1 19 B WITH HOOK
+1 19 OPEN O
+1 19 C WITH CURL
+1 19 D WITH TAIL
+1 26 HOOK
+1 19 REVERSED E
+1 19 SCHWA
+1 24  WITH HOOK
+1 19 OPEN E
+1 19 REVERSED OPEN E
+1 34  WITH HOOK
+1 19 CLOSED REVERSED OPEN E
+1 19 DOTLESS J WITH STROKE
+1 19 G WITH HOOK
+1 19 SCRIPT G
+1 6 LETTER SMALL CAPITAL G
+1 6 SMALL LETTER GAMMA
+1 19 RAMS HORN
+1 19 TURNED H
+1 19 H WITH HOOK
+1 20 ENG WITH HOOK
+1 19 I WITH STROKE
+1 20 OTA
+1 6 LETTER SMALL CAPITAL I
+1 6 SMALL LETTER L WITH MIDDLE TILDE
+1 26 BELT
+1 26 RETROFLEX HOOK
+1 20 EZH
+1 19 TURNED M
+1 27  WITH LONG LEG
+1 19 M WITH HOOK
+1 19 N WITH LEFT HOOK
+1 26 RETROFLEX HOOK
+1 6 LETTER SMALL CAPITAL N
+1 6 SMALL LETTER BARRED O
+1 6 LETTER SMALL CAPITAL OE
+1 6 SMALL LETTER CLOSED OMEGA
+1 19 PHI
+1 19 TURNED R
+1 27  WITH LONG LEG
+1 33 HOOK
+1 19 R WITH LONG LEG
+1 26 TAIL
+1 26 FISHHOOK
+1 20 EVERSED R WITH FISHHOOK
+1 6 LETTER SMALL CAPITAL R
+1 27 INVERTED R
+1 6 SMALL LETTER S WITH HOOK
+1 19 ESH
+1 19 DOTLESS J WITH STROKE AND HOOK
+1 19 SQUAT REVERSED ESH
+1 19 ESH WITH CURL
+1 19 TURNED T
+1 20  WITH RETROFLEX HOOK
+1 19 U BAR
+1 20 PSILON
+1 19 V WITH HOOK
+1 19 TURNED V
+1 26 W
+1 26 Y
+1 6 LETTER SMALL CAPITAL Y
+1 6 SMALL LETTER Z WITH RETROFLEX HOOK
+1 26 CURL
+1 19 EZH
+1 22  WITH CURL
+1 6 LETTER GLOTTAL STOP
+1 13 PHARYNGEAL VOICED FRICATIVE
+1 13 INVERTED GLOTTAL STOP
+1 13 STRETCHED C
+1 13 BILABIAL CLICK
+1 13 SMALL CAPITAL B
+1 6 SMALL LETTER CLOSED OPEN E
+1 6 LETTER SMALL CAPITAL G WITH HOOK
+1 27 H
+1 6 SMALL LETTER J WITH CROSSED-TAIL
+1 19 TURNED K
+1 6 LETTER SMALL CAPITAL L
+1 6 SMALL LETTER Q WITH HOOK
+1 6 LETTER GLOTTAL STOP WITH STROKE
+1 13 REVERSED GLOTTAL STOP WITH STROKE
+1 6 SMALL LETTER DZ DIGRAPH
+1 20 EZH DIGRAPH
+1 20 Z DIGRAPH WITH CURL
+1 19 TS DIGRAPH
+1 20 ESH DIGRAPH
+1 20 C DIGRAPH WITH CURL
+1 19 FENG DIGRAPH
+1 19 LS DIGRAPH
+1 20 Z DIGRAPH
+1 6 LETTER BILABIAL PERCUSSIVE
+1 15 DENTAL PERCUSSIVE
+1 6 SMALL LETTER TURNED H WITH FISHHOOK
+1 41  AND TAIL
+1 0 MODIFIER LETTER SMALL H
+1 23  WITH HOOK
+1 22 J
+1 22 R
+1 22 TURNED R
+1 30  WITH HOOK
+1 22 CAPITAL INVERTED R
+1 22 W
+1 22 Y
+1 16 PRIME
+1 16 DOUBLE PRIME
+1 16 TURNED COMMA
+1 16 APOSTROPHE
+1 16 REVERSED COMMA
+1 17 IGHT HALF RING
+1 16 LEFT HALF RING
+1 16 GLOTTAL STOP
+1 16 REVERSED GLOTTAL STOP
+1 16 LEFT ARROWHEAD
+1 16 RIGHT ARROWHEAD
+1 16 UP ARROWHEAD
+1 16 DOWN ARROWHEAD
+1 16 CIRCUMFLEX ACCENT
+1 0 CARON
+1 0 MODIFIER LETTER VERTICAL LINE
+1 16 MACRON
+1 16 ACUTE ACCENT
+1 16 GRAVE ACCENT
+1 16 LOW VERTICAL LINE
+1 20 MACRON
+1 20 GRAVE ACCENT
+1 20 ACUTE ACCENT
+1 16 TRIANGULAR COLON
+1 16 HALF TRIANGULAR COLON
+1 16 CENTRED RIGHT HALF RING
+1 24 LEFT HALF RING
+1 16 UP TACK
+1 16 DOWN TACK
+1 16 PLUS SIGN
+1 16 MINUS SIGN
+1 0 BREVE
+1 0 DOT ABOVE
+1 0 RING ABOVE
+1 0 OGONEK
+1 0 SMALL TILDE
+1 0 DOUBLE ACUTE ACCENT
+1 0 MODIFIER LETTER RHOTIC HOOK
+1 16 CROSS ACCENT
+1 16 SMALL GAMMA
+1 22 L
+1 22 S
+1 22 X
+1 22 REVERSED GLOTTAL STOP
+1 16 EXTRA-HIGH TONE BAR
+1 16 HIGH TONE BAR
+1 16 MID TONE BAR
+1 16 LOW TONE BAR
+1 16 EXTRA-LOW TONE BAR
+1 16 YIN DEPARTING TONE MARK
+1 17 ANG DEPARTING TONE MARK
+1 16 VOICING
+1 16 UNASPIRATED
+1 16 DOUBLE APOSTROPHE
+1 16 LOW DOWN ARROWHEAD
+1 20 UP ARROWHEAD
+1 20 LEFT ARROWHEAD
+1 20 RIGHT ARROWHEAD
+1 22 NG
+1 16 MIDDLE GRAVE ACCENT
+1 23 DOUBLE GRAVE ACCENT
+1 30 ACUTE ACCENT
+1 16 LOW TILDE
+1 16 RAISED COLON
+1 16 BEGIN HIGH TONE
+1 16 END HIGH TONE
+1 16 BEGIN LOW TONE
+1 16 END LOW TONE
+1 16 SHELF
+1 16 OPEN SHELF
+1 16 LOW LEFT ARROW
+1 0 COMBINING GRAVE ACCENT
+1 10 ACUTE ACCENT
+1 10 CIRCUMFLEX ACCENT
+1 10 TILDE
+1 10 MACRON
+1 10 OVERLINE
+1 10 BREVE
+1 10 DOT ABOVE
+1 11 IAERESIS
+1 10 HOOK ABOVE
+1 10 RING ABOVE
+1 10 DOUBLE ACUTE ACCENT
+1 10 CARON
+1 10 VERTICAL LINE ABOVE
+1 10 DOUBLE VERTICAL LINE ABOVE
+1 17 GRAVE ACCENT
+1 10 CANDRABINDU
+1 10 INVERTED BREVE
+1 10 TURNED COMMA ABOVE
+1 10 COMMA ABOVE
+1 10 REVERSED COMMA ABOVE
+1 10 COMMA ABOVE RIGHT
+1 10 GRAVE ACCENT BELOW
+1 10 ACUTE ACCENT BELOW
+1 10 LEFT TACK BELOW
+1 10 RIGHT TACK BELOW
+1 10 LEFT ANGLE ABOVE
+1 10 HORN
+1 10 LEFT HALF RING BELOW
+1 10 UP TACK BELOW
+1 10 DOWN TACK BELOW
+1 10 PLUS SIGN BELOW
+1 10 MINUS SIGN BELOW
+1 10 PALATALIZED HOOK BELOW
+1 10 RETROFLEX HOOK BELOW
+1 10 DOT BELOW
+1 11 IAERESIS BELOW
+1 10 RING BELOW
+1 10 COMMA BELOW
+1 11 EDILLA
+1 10 OGONEK
+1 10 VERTICAL LINE BELOW
+1 10 BRIDGE BELOW
+1 10 INVERTED DOUBLE ARCH BELOW
+1 10 CARON BELOW
+1 11 IRCUMFLEX ACCENT BELOW
+1 10 BREVE BELOW
+1 10 INVERTED BREVE BELOW
+1 10 TILDE BELOW
+1 10 MACRON BELOW
+1 10 LOW LINE
+1 10 DOUBLE LOW LINE
+1 10 TILDE OVERLAY
+1 10 SHORT STROKE OVERLAY
+1 10 LONG STROKE OVERLAY
+1 10 SHORT SOLIDUS OVERLAY
+1 10 LONG SOLIDUS OVERLAY
+1 10 RIGHT HALF RING BELOW
+1 10 INVERTED BRIDGE BELOW
+1 10 SQUARE BELOW
+1 11 EAGULL BELOW
+1 10 X ABOVE
+1 10 VERTICAL TILDE
+1 10 DOUBLE OVERLINE
+1 10 GRAVE TONE MARK
+1 10 ACUTE TONE MARK
+1 10 GREEK PERISPOMENI
+1 16 KORONIS
+1 16 DIALYTIKA TONOS
+1 16 YPOGEGRAMMENI
+1 10 BRIDGE ABOVE
+1 10 EQUALS SIGN BELOW
+1 10 DOUBLE VERTICAL LINE BELOW
+1 10 LEFT ANGLE BELOW
+1 10 NOT TILDE ABOVE
+1 10 HOMOTHETIC ABOVE
+1 10 ALMOST EQUAL TO ABOVE
+1 10 LEFT RIGHT ARROW BELOW
+1 10 UPWARDS ARROW BELOW
+1 10 GRAPHEME JOINER
+1 10 RIGHT ARROWHEAD ABOVE
+1 10 LEFT HALF RING ABOVE
+1 10 FERMATA
+1 10 X BELOW
+1 10 LEFT ARROWHEAD BELOW
+1 10 RIGHT ARROWHEAD BELOW
+1 26 AND UP ARROWHEAD BELOW
+1 16 HALF RING ABOVE
+1 10 DOT ABOVE RIGHT
+1 10 ASTERISK BELOW
+1 10 DOUBLE RING BELOW
+1 10 ZIGZAG ABOVE
+1 10 DOUBLE BREVE BELOW
+1 22 
+1 17 MACRON
+1 23  BELOW
+1 17 TILDE
+1 17 INVERTED BREVE
+1 17 RIGHTWARDS ARROW BELOW
+1 10 LATIN SMALL LETTER A
+1 29 E
+1 29 I
+1 29 O
+1 29 U
+1 29 C
+1 29 D
+1 29 H
+1 29 M
+1 29 R
+1 29 T
+1 29 V
+1 29 X
+1 0 GREEK CAPITAL LETTER HETA
+1 6 SMALL LETTER HETA
+1 6 CAPITAL LETTER ARCHAIC SAMPI
+1 6 SMALL LETTER ARCHAIC SAMPI
+1 6 NUMERAL SIGN
+1 6 LOWER NUMERAL SIGN
+1 6 CAPITAL LETTER PAMPHYLIAN DIGAMMA
+1 6 SMALL LETTER PAMPHYLIAN DIGAMMA
+3 6 YPOGEGRAMMENI
+1 6 SMALL REVERSED LUNATE SIGMA SYMBOL
+1 12 DOTTED LUNATE SIGMA SYMBOL
+1 12 REVERSED DOTTED LUNATE SIGMA SYMBOL
+1 6 QUESTION MARK
+1 6 CAPITAL LETTER YOT
+5 6 TONOS
+1 6 DIALYTIKA TONOS
+1 6 CAPITAL LETTER ALPHA WITH TONOS
+1 6 ANO TELEIA
+1 6 CAPITAL LETTER EPSILON WITH TONOS
+1 22 TA WITH TONOS
+1 21 IOTA WITH TONOS
+2 21 OMICRON WITH TONOS
+2 21 UPSILON WITH TONOS
+1 21 OMEGA WITH TONOS
+1 6 SMALL LETTER IOTA WITH DIALYTIKA AND TONOS
+1 6 CAPITAL LETTER ALPHA
+1 21 BETA
+1 21 GAMMA
+1 21 DELTA
+1 21 EPSILON
+1 21 ZETA
+1 21 ETA
+1 21 THETA
+1 21 IOTA
+1 21 KAPPA
+1 21 LAMDA
+1 21 MU
+1 21 NU
+1 21 XI
+1 21 OMICRON
+1 21 PI
+1 21 RHO
+2 21 SIGMA
+1 21 TAU
+1 21 UPSILON
+1 21 PHI
+1 21 CHI
+1 21 PSI
+1 21 OMEGA
+1 21 IOTA WITH DIALYTIKA
+1 21 UPSILON WITH DIALYTIKA
+1 6 SMALL LETTER ALPHA WITH TONOS
+1 19 EPSILON WITH TONOS
+1 20 TA WITH TONOS
+1 19 IOTA WITH TONOS
+1 19 UPSILON WITH DIALYTIKA AND TONOS
+1 19 ALPHA
+1 19 BETA
+1 19 GAMMA
+1 19 DELTA
+1 19 EPSILON
+1 19 ZETA
+1 19 ETA
+1 19 THETA
+1 19 IOTA
+1 19 KAPPA
+1 19 LAMDA
+1 19 MU
+1 19 NU
+1 19 XI
+1 19 OMICRON
+1 19 PI
+1 19 RHO
+1 19 FINAL SIGMA
+1 19 SIGMA
+1 19 TAU
+1 19 UPSILON
+1 19 PHI
+1 19 CHI
+1 19 PSI
+1 19 OMEGA
+1 19 IOTA WITH DIALYTIKA
+1 19 UPSILON WITH DIALYTIKA
+1 19 OMICRON WITH TONOS
+1 19 UPSILON WITH TONOS
+1 19 OMEGA WITH TONOS
+1 6 CAPITAL KAI SYMBOL
+1 6 BETA SYMBOL
+1 6 THETA SYMBOL
+1 6 UPSILON WITH HOOK SYMBOL
+1 19 ACUTE AND HOOK SYMBOL
+1 19 DIAERESIS AND HOOK SYMBOL
+1 6 PHI SYMBOL
+1 7 I SYMBOL
+1 6 KAI SYMBOL
+1 6 LETTER ARCHAIC KOPPA
+1 6 SMALL LETTER ARCHAIC KOPPA
+1 6 LETTER STIGMA
+1 6 SMALL LETTER STIGMA
+1 6 LETTER DIGAMMA
+1 6 SMALL LETTER DIGAMMA
+1 6 LETTER KOPPA
+1 6 SMALL LETTER KOPPA
+1 6 LETTER SAMPI
+1 6 SMALL LETTER SAMPI
+1 0 COPTIC CAPITAL LETTER SHEI
+1 7 SMALL LETTER SHEI
+1 7 CAPITAL LETTER FEI
+1 7 SMALL LETTER FEI
+1 7 CAPITAL LETTER KHEI
+1 7 SMALL LETTER KHEI
+1 7 CAPITAL LETTER HORI
+1 7 SMALL LETTER HORI
+1 7 CAPITAL LETTER GANGIA
+1 7 SMALL LETTER GANGIA
+1 7 CAPITAL LETTER SHIMA
+1 7 SMALL LETTER SHIMA
+1 7 CAPITAL LETTER DEI
+1 7 SMALL LETTER DEI
+1 0 GREEK KAPPA SYMBOL
+1 6 RHO SYMBOL
+1 6 LUNATE SIGMA SYMBOL
+1 7 ETTER YOT
+1 6 CAPITAL THETA SYMBOL
+1 6 LUNATE EPSILON SYMBOL
+1 6 REVERSED LUNATE EPSILON SYMBOL
+1 6 CAPITAL LETTER SHO
+1 6 SMALL LETTER SHO
+1 6 CAPITAL LUNATE SIGMA SYMBOL
+1 15 ETTER SAN
+1 6 SMALL LETTER SAN
+1 6 RHO WITH STROKE SYMBOL
+1 6 CAPITAL REVERSED LUNATE SIGMA SYMBOL
+1 14 DOTTED LUNATE SIGMA SYMBOL
+1 14 REVERSED DOTTED LUNATE SIGMA SYMBOL
+1 0 CYRILLIC CAPITAL LETTER IE WITH GRAVE
+1 25 O
+1 24 DJE
+1 24 GJE
+1 24 UKRAINIAN IE
+1 24 DZE
+1 24 BYELORUSSIAN-UKRAINIAN I
+1 24 YI
+1 24 JE
+1 24 LJE
+1 24 NJE
+1 24 TSHE
+1 24 KJE
+1 24 I WITH GRAVE
+1 24 SHORT U
+1 24 DZHE
+1 24 A
+1 24 BE
+1 24 VE
+1 24 GHE
+1 24 DE
+1 24 IE
+1 24 ZHE
+1 25 E
+1 24 I
+1 24 SHORT I
+1 24 KA
+1 24 EL
+1 25 M
+1 25 N
+1 24 O
+1 24 PE
+1 24 ER
+1 25 S
+1 24 TE
+1 24 U
+1 24 EF
+1 24 HA
+1 24 TSE
+1 24 CHE
+1 24 SHA
+1 26 CHA
+1 24 HARD SIGN
+1 24 YERU
+1 24 SOFT SIGN
+1 24 E
+1 24 YU
+1 25 A
+1 9 SMALL LETTER A
+1 22 BE
+1 22 VE
+1 22 GHE
+1 22 DE
+1 22 IE
+1 22 ZHE
+1 23 E
+1 22 I
+1 22 SHORT I
+1 22 KA
+1 22 EL
+1 23 M
+1 23 N
+1 22 O
+1 22 PE
+1 22 ER
+1 23 S
+1 22 TE
+1 22 U
+1 22 EF
+1 22 HA
+1 22 TSE
+1 22 CHE
+1 22 SHA
+1 24 CHA
+1 22 HARD SIGN
+1 22 YERU
+1 22 SOFT SIGN
+1 22 E
+1 22 YU
+1 23 A
+1 22 IE WITH GRAVE
+1 23 O
+1 22 DJE
+1 22 GJE
+1 22 UKRAINIAN IE
+1 22 DZE
+1 22 BYELORUSSIAN-UKRAINIAN I
+1 22 YI
+1 22 JE
+1 22 LJE
+1 22 NJE
+1 22 TSHE
+1 22 KJE
+1 22 I WITH GRAVE
+1 22 SHORT U
+1 22 DZHE
+1 9 CAPITAL LETTER OMEGA
+1 9 SMALL LETTER OMEGA
+1 9 CAPITAL LETTER YAT
+1 9 SMALL LETTER YAT
+1 9 CAPITAL LETTER IOTIFIED E
+1 9 SMALL LETTER IOTIFIED E
+1 9 CAPITAL LETTER LITTLE YUS
+1 9 SMALL LETTER LITTLE YUS
+1 9 CAPITAL LETTER IOTIFIED LITTLE YUS
+1 9 SMALL LETTER IOTIFIED LITTLE YUS
+1 9 CAPITAL LETTER BIG YUS
+1 9 SMALL LETTER BIG YUS
+1 9 CAPITAL LETTER IOTIFIED BIG YUS
+1 9 SMALL LETTER IOTIFIED BIG YUS
+1 9 CAPITAL LETTER KSI
+1 9 SMALL LETTER KSI
+1 9 CAPITAL LETTER PSI
+1 9 SMALL LETTER PSI
+1 9 CAPITAL LETTER FITA
+1 9 SMALL LETTER FITA
+1 9 CAPITAL LETTER IZHITSA
+1 9 SMALL LETTER IZHITSA
+1 9 CAPITAL LETTER IZHITSA WITH DOUBLE GRAVE ACCENT
+1 9 SMALL LETTER IZHITSA WITH DOUBLE GRAVE ACCENT
+1 9 CAPITAL LETTER UK
+1 9 SMALL LETTER UK
+1 9 CAPITAL LETTER ROUND OMEGA
+1 9 SMALL LETTER ROUND OMEGA
+1 9 CAPITAL LETTER OMEGA WITH TITLO
+1 9 SMALL LETTER OMEGA WITH TITLO
+1 9 CAPITAL LETTER OT
+1 9 SMALL LETTER OT
+1 9 CAPITAL LETTER KOPPA
+1 9 SMALL LETTER KOPPA
+1 9 THOUSANDS SIGN
+1 1 OMBINING CYRILLIC TITLO
+1 19 PALATALIZATION
+1 19 DASIA PNEUMATA
+1 19 PSILI PNEUMATA
+1 20 OKRYTIE
+1 19 HUNDRED THOUSANDS SIGN
+1 19 MILLIONS SIGN
+1 1 YRILLIC CAPITAL LETTER SHORT I WITH TAIL
+1 9 SMALL LETTER SHORT I WITH TAIL
+1 9 CAPITAL LETTER SEMISOFT SIGN
+1 9 SMALL LETTER SEMISOFT SIGN
+1 9 CAPITAL LETTER ER WITH TICK
+1 9 SMALL LETTER ER WITH TICK
+1 9 CAPITAL LETTER GHE WITH UPTURN
+1 9 SMALL LETTER GHE WITH UPTURN
+1 9 CAPITAL LETTER GHE WITH STROKE
+1 9 SMALL LETTER GHE WITH STROKE
+1 9 CAPITAL LETTER GHE WITH MIDDLE HOOK
+1 9 SMALL LETTER GHE WITH MIDDLE HOOK
+1 9 CAPITAL LETTER ZHE WITH DESCENDER
+1 9 SMALL LETTER ZHE WITH DESCENDER
+1 9 CAPITAL LETTER ZE WITH DESCENDER
+1 9 SMALL LETTER ZE WITH DESCENDER
+1 9 CAPITAL LETTER KA WITH DESCENDER
+1 9 SMALL LETTER KA WITH DESCENDER
+1 9 CAPITAL LETTER KA WITH VERTICAL STROKE
+1 9 SMALL LETTER KA WITH VERTICAL STROKE
+1 9 CAPITAL LETTER KA WITH STROKE
+1 9 SMALL LETTER KA WITH STROKE
+1 9 CAPITAL LETTER BASHKIR KA
+1 9 SMALL LETTER BASHKIR KA
+1 9 CAPITAL LETTER EN WITH DESCENDER
+1 9 SMALL LETTER EN WITH DESCENDER
+1 9 CAPITAL LIGATURE EN GHE
+1 9 SMALL LIGATURE EN GHE
+1 9 CAPITAL LETTER PE WITH MIDDLE HOOK
+1 9 SMALL LETTER PE WITH MIDDLE HOOK
+1 9 CAPITAL LETTER ABKHASIAN HA
+1 9 SMALL LETTER ABKHASIAN HA
+1 9 CAPITAL LETTER ES WITH DESCENDER
+1 9 SMALL LETTER ES WITH DESCENDER
+1 9 CAPITAL LETTER TE WITH DESCENDER
+1 9 SMALL LETTER TE WITH DESCENDER
+1 9 CAPITAL LETTER STRAIGHT U
+1 9 SMALL LETTER STRAIGHT U
+1 9 CAPITAL LETTER STRAIGHT U WITH STROKE
+1 9 SMALL LETTER STRAIGHT U WITH STROKE
+1 9 CAPITAL LETTER HA WITH DESCENDER
+1 9 SMALL LETTER HA WITH DESCENDER
+1 9 CAPITAL LIGATURE TE TSE
+1 9 SMALL LIGATURE TE TSE
+1 9 CAPITAL LETTER CHE WITH DESCENDER
+1 9 SMALL LETTER CHE WITH DESCENDER
+1 9 CAPITAL LETTER CHE WITH VERTICAL STROKE
+1 9 SMALL LETTER CHE WITH VERTICAL STROKE
+1 9 CAPITAL LETTER SHHA
+1 9 SMALL LETTER SHHA
+1 9 CAPITAL LETTER ABKHASIAN CHE
+1 9 SMALL LETTER ABKHASIAN CHE
+1 9 CAPITAL LETTER ABKHASIAN CHE WITH DESCENDER
+1 9 SMALL LETTER ABKHASIAN CHE WITH DESCENDER
+1 9 LETTER PALOCHKA
+1 9 CAPITAL LETTER ZHE WITH BREVE
+1 9 SMALL LETTER ZHE WITH BREVE
+1 9 CAPITAL LETTER KA WITH HOOK
+1 9 SMALL LETTER KA WITH HOOK
+1 9 CAPITAL LETTER EL WITH TAIL
+1 9 SMALL LETTER EL WITH TAIL
+1 9 CAPITAL LETTER EN WITH HOOK
+1 9 SMALL LETTER EN WITH HOOK
+1 9 CAPITAL LETTER EN WITH TAIL
+1 9 SMALL LETTER EN WITH TAIL
+1 9 CAPITAL LETTER KHAKASSIAN CHE
+1 9 SMALL LETTER KHAKASSIAN CHE
+1 9 CAPITAL LETTER EM WITH TAIL
+1 9 SMALL LETTER EM WITH TAIL
+1 22 PALOCHKA
+1 9 CAPITAL LETTER A WITH BREVE
+1 9 SMALL LETTER A WITH BREVE
+1 9 CAPITAL LETTER A WITH DIAERESIS
+1 9 SMALL LETTER A WITH DIAERESIS
+1 9 CAPITAL LIGATURE A IE
+1 9 SMALL LIGATURE A IE
+1 9 CAPITAL LETTER IE WITH BREVE
+1 9 SMALL LETTER IE WITH BREVE
+1 9 CAPITAL LETTER SCHWA
+1 9 SMALL LETTER SCHWA
+1 9 CAPITAL LETTER SCHWA WITH DIAERESIS
+1 9 SMALL LETTER SCHWA WITH DIAERESIS
+1 9 CAPITAL LETTER ZHE WITH DIAERESIS
+1 9 SMALL LETTER ZHE WITH DIAERESIS
+1 9 CAPITAL LETTER ZE WITH DIAERESIS
+1 9 SMALL LETTER ZE WITH DIAERESIS
+1 9 CAPITAL LETTER ABKHASIAN DZE
+1 9 SMALL LETTER ABKHASIAN DZE
+1 9 CAPITAL LETTER I WITH MACRON
+1 9 SMALL LETTER I WITH MACRON
+1 9 CAPITAL LETTER I WITH DIAERESIS
+1 9 SMALL LETTER I WITH DIAERESIS
+1 9 CAPITAL LETTER O WITH DIAERESIS
+1 9 SMALL LETTER O WITH DIAERESIS
+1 9 CAPITAL LETTER BARRED O
+1 9 SMALL LETTER BARRED O
+1 9 CAPITAL LETTER BARRED O WITH DIAERESIS
+1 9 SMALL LETTER BARRED O WITH DIAERESIS
+1 9 CAPITAL LETTER E WITH DIAERESIS
+1 9 SMALL LETTER E WITH DIAERESIS
+1 9 CAPITAL LETTER U WITH MACRON
+1 9 SMALL LETTER U WITH MACRON
+1 9 CAPITAL LETTER U WITH DIAERESIS
+1 9 SMALL LETTER U WITH DIAERESIS
+1 9 CAPITAL LETTER U WITH DOUBLE ACUTE
+1 9 SMALL LETTER U WITH DOUBLE ACUTE
+1 9 CAPITAL LETTER CHE WITH DIAERESIS
+1 9 SMALL LETTER CHE WITH DIAERESIS
+1 9 CAPITAL LETTER GHE WITH DESCENDER
+1 9 SMALL LETTER GHE WITH DESCENDER
+1 9 CAPITAL LETTER YERU WITH DIAERESIS
+1 9 SMALL LETTER YERU WITH DIAERESIS
+1 9 CAPITAL LETTER GHE WITH STROKE AND HOOK
+1 9 SMALL LETTER GHE WITH STROKE AND HOOK
+1 9 CAPITAL LETTER HA WITH HOOK
+1 9 SMALL LETTER HA WITH HOOK
+1 9 CAPITAL LETTER HA WITH STROKE
+1 9 SMALL LETTER HA WITH STROKE
+1 9 CAPITAL LETTER KOMI DE
+1 9 SMALL LETTER KOMI DE
+1 9 CAPITAL LETTER KOMI DJE
+1 9 SMALL LETTER KOMI DJE
+1 9 CAPITAL LETTER KOMI ZJE
+1 9 SMALL LETTER KOMI ZJE
+1 9 CAPITAL LETTER KOMI DZJE
+1 9 SMALL LETTER KOMI DZJE
+1 9 CAPITAL LETTER KOMI LJE
+1 9 SMALL LETTER KOMI LJE
+1 9 CAPITAL LETTER KOMI NJE
+1 9 SMALL LETTER KOMI NJE
+1 9 CAPITAL LETTER KOMI SJE
+1 9 SMALL LETTER KOMI SJE
+1 9 CAPITAL LETTER KOMI TJE
+1 9 SMALL LETTER KOMI TJE
+1 9 CAPITAL LETTER REVERSED ZE
+1 9 SMALL LETTER REVERSED ZE
+1 9 CAPITAL LETTER EL WITH HOOK
+1 9 SMALL LETTER EL WITH HOOK
+1 9 CAPITAL LETTER LHA
+1 9 SMALL LETTER LHA
+1 9 CAPITAL LETTER RHA
+1 9 SMALL LETTER RHA
+1 9 CAPITAL LETTER YAE
+1 9 SMALL LETTER YAE
+1 9 CAPITAL LETTER QA
+1 9 SMALL LETTER QA
+1 9 CAPITAL LETTER WE
+1 9 SMALL LETTER WE
+1 9 CAPITAL LETTER ALEUT KA
+1 9 SMALL LETTER ALEUT KA
+1 9 CAPITAL LETTER EL WITH MIDDLE HOOK
+1 9 SMALL LETTER EL WITH MIDDLE HOOK
+1 9 CAPITAL LETTER EN WITH MIDDLE HOOK
+1 9 SMALL LETTER EN WITH MIDDLE HOOK
+1 9 CAPITAL LETTER PE WITH DESCENDER
+1 9 SMALL LETTER PE WITH DESCENDER
+1 9 CAPITAL LETTER SHHA WITH DESCENDER
+1 9 SMALL LETTER SHHA WITH DESCENDER
+1 9 CAPITAL LETTER EN WITH LEFT HOOK
+1 9 SMALL LETTER EN WITH LEFT HOOK
+1 9 CAPITAL LETTER DZZHE
+1 9 SMALL LETTER DZZHE
+1 9 CAPITAL LETTER DCHE
+1 9 SMALL LETTER DCHE
+1 9 CAPITAL LETTER EL WITH DESCENDER
+1 9 SMALL LETTER EL WITH DESCENDER
+2 0 ARMENIAN CAPITAL LETTER AYB
+1 24 BEN
+1 24 GIM
+1 24 DA
+1 24 ECH
+1 24 ZA
+1 24 EH
+1 25 T
+1 24 TO
+1 24 ZHE
+1 24 INI
+1 24 LIWN
+1 24 XEH
+1 24 CA
+1 24 KEN
+1 24 HO
+1 24 JA
+1 24 GHAD
+1 24 CHEH
+1 24 MEN
+1 24 YI
+1 24 NOW
+1 24 SHA
+1 24 VO
+1 24 CHA
+1 24 PEH
+1 24 JHEH
+1 24 RA
+1 24 SEH
+1 24 VEW
+1 24 TIWN
+1 24 REH
+1 24 CO
+1 24 YIWN
+1 24 PIWR
+1 24 KEH
+1 24 OH
+1 24 FEH
+3 9 MODIFIER LETTER LEFT HALF RING
+1 9 APOSTROPHE
+1 9 EMPHASIS MARK
+1 10 XCLAMATION MARK
+1 9 COMMA
+1 9 QUESTION MARK
+1 9 ABBREVIATION MARK
+1 9 SMALL LETTER TURNED AYB
+1 22 AYB
+1 22 BEN
+1 22 GIM
+1 22 DA
+1 22 ECH
+1 22 ZA
+1 22 EH
+1 23 T
+1 22 TO
+1 22 ZHE
+1 22 INI
+1 22 LIWN
+1 22 XEH
+1 22 CA
+1 22 KEN
+1 22 HO
+1 22 JA
+1 22 GHAD
+1 22 CHEH
+1 22 MEN
+1 22 YI
+1 22 NOW
+1 22 SHA
+1 22 VO
+1 22 CHA
+1 22 PEH
+1 22 JHEH
+1 22 RA
+1 22 SEH
+1 22 VEW
+1 22 TIWN
+1 22 REH
+1 22 CO
+1 22 YIWN
+1 22 PIWR
+1 22 KEH
+1 22 OH
+1 22 FEH
+1 16 IGATURE ECH YIWN
+1 16 ETTER YI WITH STROKE
+1 9 FULL STOP
+1 9 HYPHEN
+3 0 RIGHT-FACING ARMENIAN ETERNITY SIGN
+1 0 LEFT-FACING ARMENIAN ETERNITY SIGN
+1 0 ARMENIAN DRAM SIGN
+2 0 HEBREW ACCENT ETNAHTA
+1 14 SEGOL
+1 15 HALSHELET
+1 14 ZAQEF QATAN
+1 20 GADOL
+1 14 TIPEHA
+1 14 REVIA
+1 14 ZARQA
+1 14 PASHTA
+1 14 YETIV
+1 14 TEVIR
+1 14 GERESH
+1 20  MUQDAM
+1 17 SHAYIM
+1 14 QARNEY PARA
+1 14 TELISHA GEDOLA
+1 14 PAZER
+1 14 ATNAH HAFUKH
+1 14 MUNAH
+1 15 AHAPAKH
+1 15 ERKHA
+1 20  KEFULA
+1 14 DARGA
+1 14 QADMA
+1 14 TELISHA QETANA
+1 14 YERAH BEN YOMO
+1 14 OLE
+1 14 ILUY
+1 14 DEHI
+1 14 ZINOR
+1 7 MARK MASORA CIRCLE
+1 7 POINT SHEVA
+1 13 HATAF SEGOL
+1 19 PATAH
+1 19 QAMATS
+1 14 IRIQ
+1 13 TSERE
+1 13 SEGOL
+1 13 PATAH
+1 13 QAMATS
+1 13 HOLAM
+1 18  HASER FOR VAV
+1 13 QUBUTS
+1 13 DAGESH OR MAPIQ
+1 13 METEG
+1 8 UNCTUATION MAQAF
+1 8 OINT RAFE
+1 8 UNCTUATION PASEQ
+1 8 OINT SHIN DOT
+1 14 IN DOT
+1 8 UNCTUATION SOF PASUQ
+1 7 MARK UPPER DOT
+1 12 LOWER DOT
+1 7 PUNCTUATION NUN HAFUKHA
+1 8 OINT QAMATS QATAN
+9 7 LETTER ALEF
+1 14 BET
+1 14 GIMEL
+1 14 DALET
+1 14 HE
+1 14 VAV
+1 14 ZAYIN
+1 14 HET
+1 14 TET
+1 14 YOD
+1 14 FINAL KAF
+1 14 KAF
+1 14 LAMED
+1 14 FINAL MEM
+1 14 MEM
+1 14 FINAL NUN
+1 14 NUN
+1 14 SAMEKH
+1 14 AYIN
+1 14 FINAL PE
+1 14 PE
+1 14 FINAL TSADI
+1 14 TSADI
+1 14 QOF
+1 14 RESH
+1 14 SHIN
+1 14 TAV
+5 7 YOD TRIANGLE
+1 7 LIGATURE YIDDISH DOUBLE VAV
+1 24 VAV YOD
+1 24 DOUBLE YOD
+1 7 PUNCTUATION GERESH
+1 22 SHAYIM
+c 0 ARABIC NUMBER SIGN
+1 7 SIGN SANAH
+1 7 FOOTNOTE MARKER
+1 7 SIGN SAFHA
+1 14 MVAT
+1 7 NUMBER MARK ABOVE
+1 6 -INDIC CUBE ROOT
+1 13 FOURTH ROOT
+1 6  RAY
+1 6 -INDIC PER MILLE SIGN
+1 17 TEN THOUSAND SIGN
+1 1 FGHANI SIGN
+1 1 RABIC COMMA
+1 7 DATE SEPARATOR
+1 7 POETIC VERSE SIGN
+1 7 SIGN MISRA
+1 12 SALLALLAHOU ALAYHE WASSALLAM
+1 12 ALAYHE ASSALLAM
+1 12 RAHMATULLAH ALAYHE
+1 14 DI ALLAHOU ANHU
+1 12 TAKHALLUS
+1 8 MALL HIGH TAH
+1 18 LIGATURE ALEF WITH LAM WITH YEH
+1 18 ZAIN
+1 13 FATHA
+1 13 DAMMA
+1 13 KASRA
+1 8 EMICOLON
+1 7 LETTER MARK
+1 7 END OF TEXT MARK
+1 7 TRIPLE DOT PUNCTUATION MARK
+1 7 QUESTION MARK
+1 7 LETTER KASHMIRI YEH
+1 14 HAMZA
+1 14 ALEF WITH MADDA ABOVE
+1 24 HAMZA ABOVE
+1 14 WAW WITH HAMZA ABOVE
+1 14 ALEF WITH HAMZA BELOW
+1 14 YEH WITH HAMZA ABOVE
+1 14 ALEF
+1 14 BEH
+1 14 TEH MARBUTA
+1 17 
+1 15 HEH
+1 14 JEEM
+1 14 HAH
+1 14 KHAH
+1 14 DAL
+1 14 THAL
+1 14 REH
+1 14 ZAIN
+1 14 SEEN
+1 15 HEEN
+1 15 AD
+1 14 DAD
+1 14 TAH
+1 14 ZAH
+1 14 AIN
+1 14 GHAIN
+1 14 KEHEH WITH TWO DOTS ABOVE
+1 26 HREE DOTS BELOW
+1 14 FARSI YEH WITH INVERTED V
+1 29 TWO DOTS ABOVE
+1 30 HREE DOTS ABOVE
+1 7 TATWEEL
+1 7 LETTER FEH
+1 14 QAF
+1 14 KAF
+1 14 LAM
+1 14 MEEM
+1 14 NOON
+1 14 HEH
+1 14 WAW
+1 14 ALEF MAKSURA
+1 14 YEH
+1 7 FATHATAN
+1 7 DAMMATAN
+1 7 KASRATAN
+1 7 FATHA
+1 7 DAMMA
+1 7 KASRA
+1 7 SHADDA
+1 8 UKUN
+1 7 MADDAH ABOVE
+1 7 HAMZA ABOVE
+1 13 BELOW
+1 7 SUBSCRIPT ALEF
+1 7 INVERTED DAMMA
+1 7 MARK NOON GHUNNA
+1 7 ZWARAKAY
+1 7 VOWEL SIGN SMALL V ABOVE
+1 18 INVERTED SMALL V ABOVE
+1 18 DOT BELOW
+1 7 REVERSED DAMMA
+1 7 FATHA WITH TWO DOTS
+1 7 WAVY HAMZA BELOW
+1 6 -INDIC DIGIT ZERO
+1 19 ONE
+1 19 TWO
+1 20 HREE
+1 19 FOUR
+1 20 IVE
+1 19 SIX
+1 20 EVEN
+1 19 EIGHT
+1 19 NINE
+1 6  PERCENT SIGN
+1 7 DECIMAL SEPARATOR
+1 7 THOUSANDS SEPARATOR
+1 7 FIVE POINTED STAR
+1 7 LETTER DOTLESS BEH
+1 22 QAF
+1 14 SUPERSCRIPT ALEF
+1 14 ALEF WASLA
+1 20 ITH WAVY HAMZA ABOVE
+1 35 BELOW
+1 14 HIGH HAMZA
+1 24  ALEF
+1 25 WAW
+1 14 U WITH HAMZA ABOVE
+1 14 HIGH HAMZA YEH
+1 14 TTEH
+1 18 EH
+1 14 BEEH
+1 14 TEH WITH RING
+1 23 THREE DOTS ABOVE DOWNWARDS
+1 14 PEH
+1 14 TEHEH
+1 14 BEHEH
+1 14 HAH WITH HAMZA ABOVE
+1 23 TWO DOTS VERTICAL ABOVE
+1 14 NYEH
+1 14 DYEH
+1 14 HAH WITH THREE DOTS ABOVE
+1 14 TCHEH
+1 19 EH
+1 14 DDAL
+1 15 AL WITH RING
+1 23 DOT BELOW
+1 32  AND SMALL TAH
+1 16 HAL
+1 15 DAHAL
+1 15 UL
+1 15 AL WITH THREE DOTS ABOVE DOWNWARDS
+1 23 FOUR DOTS ABOVE
+1 14 RREH
+1 15 EH WITH SMALL V
+1 23 RING
+1 23 DOT BELOW
+1 23 SMALL V BELOW
+1 23 DOT BELOW AND DOT ABOVE
+1 23 TWO DOTS ABOVE
+1 14 JEH
+1 14 REH WITH FOUR DOTS ABOVE
+1 14 SEEN WITH DOT BELOW AND DOT ABOVE
+1 24 THREE DOTS BELOW
+1 40  AND THREE DOTS ABOVE
+1 15 AD WITH TWO DOTS BELOW
+1 24 HREE DOTS ABOVE
+1 14 TAH WITH THREE DOTS ABOVE
+1 14 AIN WITH THREE DOTS ABOVE
+1 14 DOTLESS FEH
+1 14 FEH WITH DOT MOVED BELOW
+1 27 BELOW
+1 14 VEH
+1 14 FEH WITH THREE DOTS BELOW
+1 14 PEHEH
+1 14 QAF WITH DOT ABOVE
+1 23 THREE DOTS ABOVE
+1 14 KEHEH
+1 14 SWASH KAF
+1 14 KAF WITH RING
+1 23 DOT ABOVE
+1 14 NG
+1 14 KAF WITH THREE DOTS BELOW
+1 14 GAF
+1 17  WITH RING
+1 14 NGOEH
+1 14 GAF WITH TWO DOTS BELOW
+1 15 UEH
+1 15 AF WITH THREE DOTS ABOVE
+1 14 LAM WITH SMALL V
+1 23 DOT ABOVE
+1 23 THREE DOTS ABOVE
+1 34 BELOW
+1 14 NOON WITH DOT BELOW
+1 19 GHUNNA
+1 14 RNOON
+1 14 NOON WITH RING
+1 24 THREE DOTS ABOVE
+1 14 HEH DOACHASHMEE
+1 14 TCHEH WITH DOT ABOVE
+1 14 HEH WITH YEH ABOVE
+1 18 GOAL
+1 22  WITH HAMZA ABOVE
+1 14 TEH MARBUTA GOAL
+1 14 WAW WITH RING
+1 14 KIRGHIZ OE
+1 14 OE
+1 14 U
+1 14 YU
+1 14 KIRGHIZ YU
+1 14 WAW WITH TWO DOTS ABOVE
+1 14 VE
+1 14 FARSI YEH
+1 14 YEH WITH TAIL
+1 23 SMALL V
+1 14 WAW WITH DOT ABOVE
+1 14 E
+1 14 YEH WITH THREE DOTS BELOW
+1 18 BARREE
+1 24  WITH HAMZA ABOVE
+1 7 FULL STOP
+1 7 LETTER AE
+1 7 SMALL HIGH LIGATURE SAD WITH LAM WITH ALEF MAKSURA
+1 27 QAF WITH LAM WITH ALEF MAKSURA
+1 18 MEEM INITIAL FORM
+1 18 LAM ALEF
+1 18 JEEM
+1 18 THREE DOTS
+1 18 SEEN
+1 7 END OF AYAH
+1 7 START OF RUB EL HIZB
+1 8 MALL HIGH ROUNDED ZERO
+1 18 UPRIGHT RECTANGULAR ZERO
+1 18 DOTLESS HEAD OF KHAH
+1 18 MEEM ISOLATED FORM
+1 13 LOW SEEN
+1 13 HIGH MADDA
+1 13 WAW
+1 13 YEH
+1 13 HIGH YEH
+1 18 NOON
+1 7 PLACE OF SAJDAH
+1 7 EMPTY CENTRE LOW STOP
+1 20 HIGH STOP
+1 7 ROUNDED HIGH STOP WITH FILLED CENTRE
+1 7 SMALL LOW MEEM
+1 7 LETTER DAL WITH INVERTED V
+1 14 REH WITH INVERTED V
+1 0 EXTENDED ARABIC-INDIC DIGIT ZERO
+1 28 ONE
+1 28 TWO
+1 29 HREE
+1 28 FOUR
+1 29 IVE
+1 28 SIX
+1 29 EVEN
+1 28 EIGHT
+1 28 NINE
+1 0 ARABIC LETTER SHEEN WITH DOT BELOW
+1 14 DAD WITH DOT BELOW
+1 14 GHAIN WITH DOT BELOW
+1 7 SIGN SINDHI AMPERSAND
+1 19 POSTPOSITION MEN
+1 7 LETTER HEH WITH INVERTED V
+1 0 SYRIAC END OF PARAGRAPH
+1 7 SUPRALINEAR FULL STOP
+1 9 BLINEAR FULL STOP
+1 9 PRALINEAR COLON
+1 9 BLINEAR COLON
+1 7 HORIZONTAL COLON
+1 7 COLON SKEWED LEFT
+1 20 RIGHT
+1 7 SUPRALINEAR COLON SKEWED LEFT
+1 9 BLINEAR COLON SKEWED RIGHT
+1 7 CONTRACTION
+1 7 HARKLEAN OBELUS
+1 16 METOBELUS
+1 16 ASTERISCUS
+2 7 ABBREVIATION MARK
+1 7 LETTER ALAPH
+1 14 SUPERSCRIPT ALAPH
+1 14 BETH
+1 14 GAMAL
+1 19  GARSHUNI
+1 14 DALATH
+1 15 OTLESS DALATH RISH
+1 14 HE
+1 14 WAW
+1 14 ZAIN
+1 14 HETH
+1 14 TETH
+1 18  GARSHUNI
+1 14 YUDH
+1 18  HE
+1 14 KAPH
+1 14 LAMADH
+1 14 MIM
+1 14 NUN
+1 14 SEMKATH
+1 14 FINAL SEMKATH
+1 14 E
+1 14 PE
+1 14 REVERSED PE
+1 14 SADHE
+1 14 QAPH
+1 14 RISH
+1 14 SHIN
+1 14 TAW
+1 14 PERSIAN BHETH
+1 22 GHAMAL
+1 22 DHALATH
+1 7 PTHAHA ABOVE
+1 14 BELOW
+1 14 DOTTED
+1 7 ZQAPHA ABOVE
+1 14 BELOW
+1 14 DOTTED
+1 7 RBASA ABOVE
+1 13 BELOW
+1 7 DOTTED ZLAMA HORIZONTAL
+1 20 ANGULAR
+1 7 HBASA ABOVE
+1 13 BELOW
+1 12 -ESASA DOTTED
+1 7 ESASA ABOVE
+1 13 BELOW
+1 7 RWAHA
+1 7 FEMININE DOT
+1 7 QUSHSHAYA
+1 7 RUKKAKHA
+1 7 TWO VERTICAL DOTS ABOVE
+1 25 BELOW
+1 8 HREE DOTS ABOVE
+1 18 BELOW
+1 7 OBLIQUE LINE ABOVE
+1 20 BELOW
+1 7 MUSIC
+1 7 BARREKH
+3 7 LETTER SOGDIAN ZHAIN
+1 22 KHAPH
+1 22 FE
+1 0 ARABIC LETTER BEH WITH THREE DOTS HORIZONTALLY BELOW
+1 23 DOT BELOW AND THREE DOTS ABOVE
+1 23 THREE DOTS POINTING UPWARDS BELOW
+1 56  AND TWO DOTS ABOVE
+1 24 WO DOTS BELOW AND DOT ABOVE
+1 23 INVERTED SMALL V BELOW
+1 23 SMALL V
+1 14 HAH WITH TWO DOTS ABOVE
+1 24 HREE DOTS POINTING UPWARDS BELOW
+1 14 DAL WITH TWO DOTS VERTICALLY BELOW AND SMALL TAH
+1 23 INVERTED SMALL V BELOW
+1 14 REH WITH STROKE
+1 14 SEEN WITH FOUR DOTS ABOVE
+1 14 AIN WITH TWO DOTS ABOVE
+1 24 HREE DOTS POINTING DOWNWARDS ABOVE
+1 24 WO DOTS VERTICALLY ABOVE
+1 14 FEH WITH TWO DOTS BELOW
+1 24 HREE DOTS POINTING UPWARDS BELOW
+1 14 KEHEH WITH DOT ABOVE
+1 25 THREE DOTS ABOVE
+1 36 POINTING UPWARDS BELOW
+1 14 MEEM WITH DOT ABOVE
+1 28 BELOW
+1 14 NOON WITH TWO DOTS BELOW
+1 24 SMALL TAH
+1 30 V
+1 14 LAM WITH BAR
+1 14 REH WITH TWO DOTS VERTICALLY ABOVE
+1 23 HAMZA ABOVE
+1 14 SEEN WITH TWO DOTS VERTICALLY ABOVE
+1 14 HAH WITH SMALL ARABIC LETTER TAH BELOW
+1 47 AND TWO DOTS
+1 14 SEEN WITH SMALL ARABIC LETTER TAH AND TWO DOTS
+1 14 REH WITH SMALL ARABIC LETTER TAH AND TWO DOTS
+1 14 HAH WITH SMALL ARABIC LETTER TAH ABOVE
+1 14 ALEF WITH EXTENDED ARABIC-INDIC DIGIT TWO ABOVE
+1 53 HREE ABOVE
+1 14 FARSI YEH WITH EXTENDED ARABIC-INDIC DIGIT TWO ABOVE
+1 58 HREE ABOVE
+1 57 FOUR BELOW
+1 14 WAW WITH EXTENDED ARABIC-INDIC DIGIT TWO ABOVE
+1 52 HREE ABOVE
+1 14 YEH BARREE WITH EXTENDED ARABIC-INDIC DIGIT TWO ABOVE
+1 59 HREE ABOVE
+1 14 HAH WITH EXTENDED ARABIC-INDIC DIGIT FOUR BELOW
+1 14 SEEN WITH EXTENDED ARABIC-INDIC DIGIT FOUR ABOVE
+1 24 INVERTED V
+1 14 KAF WITH TWO DOTS ABOVE
+1 0 THAANA LETTER HAA
+1 14 SHAVIYANI
+1 14 NOONU
+1 14 RAA
+1 14 BAA
+1 14 LHAVIYANI
+1 14 KAAFU
+1 14 ALIFU
+1 14 VAAVU
+1 14 MEEMU
+1 14 FAAFU
+1 14 DHAALU
+1 14 THAA
+1 14 LAAMU
+1 14 GAAFU
+1 15 NAVIYANI
+1 14 SEENU
+1 14 DAVIYANI
+1 14 ZAVIYANI
+1 14 TAVIYANI
+1 14 YAA
+1 14 PAVIYANI
+1 14 JAVIYANI
+1 14 CHAVIYANI
+1 14 TTAA
+1 14 HHAA
+1 14 KHAA
+1 14 THAALU
+1 14 ZAA
+1 14 SHEENU
+1 15 AADHU
+1 14 DAADHU
+1 14 TO
+1 14 ZO
+1 14 AINU
+1 14 GHAINU
+1 14 QAAFU
+1 14 WAAVU
+1 7 ABAFILI
+1 8 ABAAFILI
+1 7 IBIFILI
+1 7 EEBEEFILI
+1 7 UBUFILI
+1 7 OOBOOFILI
+1 7 EBEFILI
+1 8 YBEYFILI
+1 7 OBOFILI
+1 8 ABOAFILI
+1 7 SUKUN
+1 7 LETTER NAA
+f 0 NKO DIGIT ZERO
+1 10 ONE
+1 10 TWO
+1 11 HREE
+1 10 FOUR
+1 11 IVE
+1 10 SIX
+1 11 EVEN
+1 10 EIGHT
+1 10 NINE
+1 4 LETTER A
+1 11 EE
+1 11 I
+1 11 E
+1 11 U
+1 11 OO
+1 12 
+1 11 DAGBASINNA
+1 11 N
+1 11 BA
+1 11 PA
+1 11 TA
+1 11 JA
+1 11 CHA
+1 11 DA
+1 11 RA
+1 12 RA
+1 11 SA
+1 11 GBA
+1 11 FA
+1 11 KA
+1 11 LA
+1 11 NA WOLOSO
+1 11 MA
+1 11 NYA
+1 12 A
+1 11 HA
+1 11 WA
+1 11 YA
+1 11 NYA WOLOSO
+1 11 JONA JA
+1 16 CHA
+1 16 RA
+1 4 COMBINING SHORT HIGH TONE
+1 20 LOW TONE
+1 20 RISING TONE
+1 14 LONG DESCENDING TONE
+1 19 HIGH TONE
+1 19 LOW TONE
+1 19 RISING TONE
+1 14 NASALIZATION MARK
+1 14 DOUBLE DOT ABOVE
+1 4 HIGH TONE APOSTROPHE
+1 4 LOW TONE APOSTROPHE
+1 4 SYMBOL OO DENNEN
+1 11 GBAKURUNEN
+1 4 COMMA
+1 4 EXCLAMATION MARK
+1 4 LAJANYALAN
+3 4 DANTAYALAN
+1 5 OROME SIGN
+1 4 TAMAN SIGN
+1 0 SAMARITAN LETTER ALAF
+1 17 BIT
+1 17 GAMAN
+1 17 DALAT
+1 17 IY
+1 17 BAA
+1 17 ZEN
+1 17 IT
+1 17 TIT
+1 17 YUT
+1 17 KAAF
+1 17 LABAT
+1 17 MIM
+1 17 NUN
+1 17 SINGAAT
+1 17 IN
+1 17 FI
+1 17 TSAADIY
+1 17 QUF
+1 17 RISH
+1 17 SHAN
+1 17 TAAF
+1 10 MARK IN
+1 17 -ALAF
+1 15 OCCLUSION
+1 15 DAGESH
+1 11 ODIFIER LETTER EPENTHETIC YUT
+1 11 ARK EPENTHETIC YUT
+1 10 VOWEL SIGN LONG E
+1 21 E
+1 21 OVERLONG AA
+1 21 LONG AA
+1 21 AA
+1 21 OVERLONG A
+1 21 LONG A
+1 21 A
+1 10 MODIFIER LETTER SHORT A
+1 10 VOWEL SIGN SHORT A
+1 21 LONG U
+1 21 U
+1 10 MODIFIER LETTER I
+1 10 VOWEL SIGN LONG I
+1 21 I
+1 21 O
+1 21 SUKUN
+1 10 MARK NEQUDAA
+3 10 PUNCTUATION NEQUDAA
+1 22 AFSAAQ
+1 23 NGED
+1 22 BAU
+1 22 ATMAAU
+1 22 SHIYYAALAA
+1 10 ABBREVIATION MARK
+1 10 PUNCTUATION MELODIC QITSA
+1 22 ZIQAA
+1 22 QITSA
+1 22 ZAEF
+1 22 TURU
+1 22 ARKAANU
+1 22 SOF MASHFAAT
+1 22 ANNAAU
+2 0 MANDAIC LETTER HALQA
+1 15 AB
+1 16 G
+1 16 D
+1 16 H
+1 15 USHENNA
+1 15 AZ
+1 15 IT
+1 15 ATT
+1 16 KSA
+1 17 
+1 16 L
+1 16 M
+1 16 N
+1 16 S
+1 15 IN
+1 15 AP
+1 16 SZ
+1 16 Q
+1 16 R
+1 16 SH
+1 16 T
+1 15 DUSHENNA
+1 15 KAD
+1 15 AIN
+1 8 AFFRICATION MARK
+1 8 VOCALIZATION MARK
+1 8 GEMINATION MARK
+3 8 PUNCTUATION
+2 0 SYRIAC LETTER MALAYALAM NGA
+1 24 JA
+1 24 NYA
+1 24 TTA
+1 24 NNA
+1 26 NA
+1 24 BHA
+1 24 RA
+1 24 LLA
+1 26 LA
+1 24 SSA
+6 0 ARABIC LETTER ALEF WITH ATTACHED FATHA
+1 33 TOP RIGHT FATHA
+1 24 RIGHT MIDDLE STROKE
+1 24 LEFT MIDDLE STROKE
+1 24 ATTACHED KASRA
+1 33 BOTTOM RIGHT KASRA
+1 33 ROUND DOT ABOVE
+1 34 IGHT ROUND DOT
+1 33 LEFT ROUND DOT
+1 33 ROUND DOT BELOW
+1 24 DOT ABOVE
+1 24 ATTACHED TOP RIGHT FATHA AND DOT ABOVE
+1 24 RIGHT MIDDLE STROKE AND DOT ABOVE
+1 24 ATTACHED BOTTOM RIGHT KASRA AND DOT ABOVE
+1 33 TOP RIGHT FATHA AND LEFT RING
+1 24 RIGHT MIDDLE STROKE AND LEFT RING
+1 24 ATTACHED BOTTOM RIGHT KASRA AND LEFT RING
+1 33 RIGHT HAMZA
+1 33 LEFT HAMZA
+1 7 TATWEEL WITH OVERSTRUCK HAMZA
+1 31 WAW
+1 20 TWO DOTS BELOW
+1 7 LETTER THIN YEH
+1 7 BASELINE ROUND DOT
+1 7 RAISED ROUND DOT
+1 7 LETTER NOON WITH INVERTED SMALL V
+1 14 HAH WITH INVERTED SMALL V BELOW
+1 14 TAH WITH DOT BELOW
+1 23 THREE DOTS BELOW
+1 14 KEHEH WITH TWO DOTS VERTICALLY BELOW
+1 7 VERTICAL TAIL
+2 7 POUND MARK ABOVE
+1 8 IASTRE MARK ABOVE
+7 7 SMALL HIGH WORD AL-JUZ
+1 13 LOW WORD ISHMAAM
+1 23 MAALA
+1 22 TASHEEL
+1 7 MADDA WAAJIB
+1 7 SUPERSCRIPT ALEF MOKHASSAS
+1 7 DOUBLED MADDA
+1 7 HALF MADDA OVER MADDA
+1 7 LETTER BEH WITH SMALL V BELOW
+1 23 HAMZA ABOVE
+1 14 JEEM WITH TWO DOTS ABOVE
+1 14 TAH WITH TWO DOTS ABOVE
+1 14 FEH WITH DOT BELOW AND THREE DOTS ABOVE
+1 14 QAF WITH DOT BELOW
+1 14 LAM WITH DOUBLE BAR
+1 14 MEEM WITH THREE DOTS ABOVE
+1 14 YEH WITH TWO DOTS BELOW AND HAMZA ABOVE
+1 42 DOT ABOVE
+1 14 REH WITH LOOP
+1 14 WAW WITH DOT WITHIN
+1 14 ROHINGYA YEH
+1 14 LOW ALEF
+1 14 DAL WITH THREE DOTS BELOW
+1 14 SAD WITH THREE DOTS BELOW
+1 14 GAF WITH INVERTED STROKE
+1 14 STRAIGHT WAW
+1 14 ZAIN WITH INVERTED V ABOVE
+1 14 AIN WITH THREE DOTS BELOW
+1 14 KAF WITH DOT BELOW
+1 14 QAF WITH DOT BELOW AND NO DOTS ABOVE
+1 14 BEH WITH SMALL MEEM ABOVE
+1 14 PEH WITH SMALL MEEM ABOVE
+1 14 TEH WITH SMALL TEH ABOVE
+1 14 REH WITH SMALL NOON ABOVE
+1 14 YEH WITH TWO DOTS BELOW AND SMALL NOON ABOVE
+1 14 AFRICAN FEH
+1 22 QAF
+1 22 NOON
+1 14 PEH WITH SMALL V
+1 14 TEH WITH SMALL V
+1 15 TEH WITH SMALL V
+1 15 CHEH WITH SMALL V
+1 14 KEHEH WITH SMALL V
+1 14 GHAIN WITH THREE DOTS ABOVE
+1 14 AFRICAN QAF WITH THREE DOTS ABOVE
+1 14 JEEM WITH THREE DOTS ABOVE
+1 35 BELOW
+1 14 LAM WITH SMALL ARABIC LETTER TAH ABOVE
+1 14 GRAF
+1 7 SMALL FARSI YEH
+1 13 HIGH FARSI YEH
+1 18 YEH BARREE WITH TWO DOTS BELOW
+1 18 WORD SAH
+1 18 ZAH
+1 7 LARGE ROUND DOT ABOVE
+1 23 BELOW
+1 7 SUKUN BELOW
+1 7 LARGE CIRCLE BELOW
+1 13 ROUND DOT INSIDE CIRCLE BELOW
+1 7 SMALL LOW WAW
+1 13 HIGH WORD AR-RUB
+1 18 SAD
+1 18 AIN
+1 18 QAF
+1 18 NOON WITH KASRA
+1 13 LOW NOON WITH KASRA
+1 13 HIGH WORD ATH-THALATHA
+1 24 S-SAJDA
+1 24 N-NISF
+1 23 SAKTA
+1 23 QIF
+1 23 WAQFA
+1 18 FOOTNOTE MARKER
+1 18 SIGN SAFHA
+1 7 DISPUTED END OF AYAH
+1 7 TURNED DAMMA BELOW
+1 7 CURLY FATHA
+1 13 DAMMA
+1 13 KASRA
+1 13 FATHATAN
+1 13 DAMMATAN
+1 13 KASRATAN
+1 7 TONE ONE DOT ABOVE
+1 12 TWO DOTS ABOVE
+1 12 LOOP ABOVE
+1 12 ONE DOT BELOW
+1 12 TWO DOTS BELOW
+1 12 LOOP BELOW
+1 7 OPEN FATHATAN
+1 12 DAMMATAN
+1 12 KASRATAN
+1 7 SMALL HIGH WAW
+1 7 FATHA WITH RING
+1 18 DOT ABOVE
+1 7 KASRA WITH DOT BELOW
+1 7 LEFT ARROWHEAD ABOVE
+1 7 RIGHT ARROWHEAD ABOVE
+1 7 LEFT ARROWHEAD BELOW
+1 7 RIGHT ARROWHEAD BELOW
+1 7 DOUBLE RIGHT ARROWHEAD ABOVE
+1 35  WITH DOT
+1 7 RIGHT ARROWHEAD ABOVE WITH DOT
+1 7 DAMMA WITH DOT
+1 7 MARK SIDEWAYS NOON GHUNNA
+1 0 DEVANAGARI SIGN INVERTED CANDRABINDU
+1 16 CANDRABINDU
+1 16 ANUSVARA
+1 16 VISARGA
+1 11 LETTER SHORT A
+1 18 A
+1 19 A
+1 18 I
+1 19 I
+1 18 U
+1 19 U
+1 18 VOCALIC R
+1 26 L
+1 18 CANDRA E
+1 18 SHORT E
+1 18 E
+1 18 AI
+1 18 CANDRA O
+1 18 SHORT O
+1 18 O
+1 18 AU
+1 18 KA
+1 19 HA
+1 18 GA
+1 19 HA
+1 18 NGA
+1 18 CA
+1 19 HA
+1 18 JA
+1 19 HA
+1 18 NYA
+1 18 TTA
+1 20 HA
+1 18 DDA
+1 20 HA
+1 18 NNA
+1 18 TA
+1 19 HA
+1 18 DA
+1 19 HA
+1 18 NA
+1 19 NNA
+1 18 PA
+1 19 HA
+1 18 BA
+1 19 HA
+1 18 MA
+1 18 YA
+1 18 RA
+1 19 RA
+1 18 LA
+1 19 LA
+1 20 LA
+1 18 VA
+1 18 SHA
+1 19 SA
+1 19 A
+1 18 HA
+1 11 VOWEL SIGN OE
+1 23 OE
+1 11 SIGN NUKTA
+1 16 AVAGRAHA
+1 11 VOWEL SIGN AA
+1 22 I
+1 23 I
+1 22 U
+1 23 U
+1 22 VOCALIC R
+1 31 R
+1 22 CANDRA E
+1 22 SHORT E
+1 22 E
+1 22 AI
+1 22 CANDRA O
+1 22 SHORT O
+1 22 O
+1 22 AU
+1 11 SIGN VIRAMA
+1 11 VOWEL SIGN PRISHTHAMATRA E
+1 22 AW
+1 11 OM
+1 11 STRESS SIGN UDATTA
+1 23 ANUDATTA
+1 11 GRAVE ACCENT
+1 11 ACUTE ACCENT
+1 11 VOWEL SIGN CANDRA LONG E
+1 22 UE
+1 23 UE
+1 11 LETTER QA
+1 18 KHHA
+1 18 GHHA
+1 18 ZA
+1 18 DDDHA
+1 18 RHA
+1 18 FA
+1 18 YYA
+1 18 VOCALIC RR
+1 26 LL
+1 11 VOWEL SIGN VOCALIC L
+1 31 L
+1 11 DANDA
+1 12 OUBLE DANDA
+1 12 IGIT ZERO
+1 17 ONE
+1 17 TWO
+1 18 HREE
+1 17 FOUR
+1 18 IVE
+1 17 SIX
+1 18 EVEN
+1 17 EIGHT
+1 17 NINE
+1 11 ABBREVIATION SIGN
+1 11 SIGN HIGH SPACING DOT
+1 11 LETTER CANDRA A
+1 18 OE
+1 19 OE
+1 18 AW
+1 18 UE
+1 19 UE
+1 18 MARWARI DDA
+1 18 ZHA
+1 18 HEAVY YA
+1 18 GGA
+1 18 JJA
+1 18 GLOTTAL STOP
+1 18 DDDA
+1 18 BBA
+1 0 BENGALI ANJI
+1 8 SIGN CANDRABINDU
+1 13 ANUSVARA
+1 13 VISARGA
+2 8 LETTER A
+1 16 A
+1 15 I
+1 16 I
+1 15 U
+1 16 U
+1 15 VOCALIC R
+1 23 L
+3 15 E
+1 15 AI
+3 15 O
+1 15 AU
+1 15 KA
+1 16 HA
+1 15 GA
+1 16 HA
+1 15 NGA
+1 15 CA
+1 16 HA
+1 15 JA
+1 16 HA
+1 15 NYA
+1 15 TTA
+1 17 HA
+1 15 DDA
+1 17 HA
+1 15 NNA
+1 15 TA
+1 16 HA
+1 15 DA
+1 16 HA
+1 15 NA
+2 15 PA
+1 16 HA
+1 15 BA
+1 16 HA
+1 15 MA
+1 15 YA
+1 15 RA
+2 15 LA
+4 15 SHA
+1 16 SA
+1 16 A
+1 15 HA
+3 8 SIGN NUKTA
+1 13 AVAGRAHA
+1 8 VOWEL SIGN AA
+1 19 I
+1 20 I
+1 19 U
+1 20 U
+1 19 VOCALIC R
+1 28 R
+3 19 E
+1 19 AI
+3 19 O
+1 19 AU
+1 8 SIGN VIRAMA
+1 8 LETTER KHANDA TA
+9 8 AU LENGTH MARK
+5 8 LETTER RRA
+1 16 HA
+2 15 YYA
+1 15 VOCALIC RR
+1 23 LL
+1 8 VOWEL SIGN VOCALIC L
+1 28 L
+3 8 DIGIT ZERO
+1 14 ONE
+1 14 TWO
+1 15 HREE
+1 14 FOUR
+1 15 IVE
+1 14 SIX
+1 15 EVEN
+1 14 EIGHT
+1 14 NINE
+1 8 LETTER RA WITH MIDDLE DIAGONAL
+1 23 LOWER DIAGONAL
+1 8 RUPEE MARK
+1 14 SIGN
+1 8 CURRENCY NUMERATOR ONE
+1 27 TWO
+1 28 HREE
+1 27 FOUR
+1 27 ONE LESS THAN THE DENOMINATOR
+1 17 DENOMINATOR SIXTEEN
+1 8 ISSHAR
+1 8 GANDA MARK
+1 8 LETTER VEDIC ANUSVARA
+1 8 ABBREVIATION SIGN
+1 8 SANDHI MARK
+3 0 GURMUKHI SIGN ADAK BINDI
+1 14 BINDI
+1 14 VISARGA
+2 9 LETTER A
+1 17 A
+1 16 I
+1 17 I
+1 16 U
+1 17 U
+5 16 EE
+1 16 AI
+3 16 OO
+1 16 AU
+1 16 KA
+1 17 HA
+1 16 GA
+1 17 HA
+1 16 NGA
+1 16 CA
+1 17 HA
+1 16 JA
+1 17 HA
+1 16 NYA
+1 16 TTA
+1 18 HA
+1 16 DDA
+1 18 HA
+1 16 NNA
+1 16 TA
+1 17 HA
+1 16 DA
+1 17 HA
+1 16 NA
+2 16 PA
+1 17 HA
+1 16 BA
+1 17 HA
+1 16 MA
+1 16 YA
+1 16 RA
+2 16 LA
+1 17 LA
+2 16 VA
+1 16 SHA
+2 17 A
+1 16 HA
+3 9 SIGN NUKTA
+2 9 VOWEL SIGN AA
+1 20 I
+1 21 I
+1 20 U
+1 21 U
+5 20 EE
+1 20 AI
+3 20 OO
+1 20 AU
+1 9 SIGN VIRAMA
+4 14 UDAAT
+8 9 LETTER KHHA
+1 16 GHHA
+1 16 ZA
+1 16 RRA
+2 16 FA
+8 9 DIGIT ZERO
+1 15 ONE
+1 15 TWO
+1 16 HREE
+1 15 FOUR
+1 16 IVE
+1 15 SIX
+1 16 EVEN
+1 15 EIGHT
+1 15 NINE
+1 9 TIPPI
+1 9 ADDAK
+1 9 IRI
+1 9 URA
+1 9 EK ONKAR
+1 9 SIGN YAKASH
+1 9 ABBREVIATION SIGN
+b 2 JARATI SIGN CANDRABINDU
+1 14 ANUSVARA
+1 14 VISARGA
+2 9 LETTER A
+1 17 A
+1 16 I
+1 17 I
+1 16 U
+1 17 U
+1 16 VOCALIC R
+1 24 L
+1 9 VOWEL CANDRA E
+2 9 LETTER E
+1 16 AI
+1 9 VOWEL CANDRA O
+2 9 LETTER O
+1 16 AU
+1 16 KA
+1 17 HA
+1 16 GA
+1 17 HA
+1 16 NGA
+1 16 CA
+1 17 HA
+1 16 JA
+1 17 HA
+1 16 NYA
+1 16 TTA
+1 18 HA
+1 16 DDA
+1 18 HA
+1 16 NNA
+1 16 TA
+1 17 HA
+1 16 DA
+1 17 HA
+1 16 NA
+2 16 PA
+1 17 HA
+1 16 BA
+1 17 HA
+1 16 MA
+1 16 YA
+1 16 RA
+2 16 LA
+1 17 LA
+2 16 VA
+1 16 SHA
+1 17 SA
+1 17 A
+1 16 HA
+3 9 SIGN NUKTA
+1 14 AVAGRAHA
+1 9 VOWEL SIGN AA
+1 20 I
+1 21 I
+1 20 U
+1 21 U
+1 20 VOCALIC R
+1 29 R
+1 20 CANDRA E
+2 20 E
+1 20 AI
+1 20 CANDRA O
+2 20 O
+1 20 AU
+1 9 SIGN VIRAMA
+3 9 OM
+10 9 LETTER VOCALIC RR
+1 24 LL
+1 9 VOWEL SIGN VOCALIC L
+1 29 L
+3 9 DIGIT ZERO
+1 15 ONE
+1 15 TWO
+1 16 HREE
+1 15 FOUR
+1 16 IVE
+1 15 SIX
+1 16 EVEN
+1 15 EIGHT
+1 15 NINE
+1 9 ABBREVIATION SIGN
+1 9 RUPEE SIGN
+8 9 LETTER ZHA
+1 9 SIGN SUKUN
+1 15 HADDA
+1 14 MADDAH
+1 14 THREE-DOT NUKTA ABOVE
+1 14 CIRCLE NUKTA ABOVE
+1 14 TWO-CIRCLE NUKTA ABOVE
+2 0 ORIYA SIGN CANDRABINDU
+1 11 ANUSVARA
+1 11 VISARGA
+2 6 LETTER A
+1 14 A
+1 13 I
+1 14 I
+1 13 U
+1 14 U
+1 13 VOCALIC R
+1 21 L
+3 13 E
+1 13 AI
+3 13 O
+1 13 AU
+1 13 KA
+1 14 HA
+1 13 GA
+1 14 HA
+1 13 NGA
+1 13 CA
+1 14 HA
+1 13 JA
+1 14 HA
+1 13 NYA
+1 13 TTA
+1 15 HA
+1 13 DDA
+1 15 HA
+1 13 NNA
+1 13 TA
+1 14 HA
+1 13 DA
+1 14 HA
+1 13 NA
+2 13 PA
+1 14 HA
+1 13 BA
+1 14 HA
+1 13 MA
+1 13 YA
+1 13 RA
+2 13 LA
+1 14 LA
+2 13 VA
+1 13 SHA
+1 14 SA
+1 14 A
+1 13 HA
+3 6 SIGN NUKTA
+1 11 AVAGRAHA
+1 6 VOWEL SIGN AA
+1 17 I
+1 18 I
+1 17 U
+1 18 U
+1 17 VOCALIC R
+1 26 R
+3 17 E
+1 17 AI
+3 17 O
+1 17 AU
+1 6 SIGN VIRAMA
+8 11 OVERLINE
+1 6 AI LENGTH MARK
+1 7 U LENGTH MARK
+5 6 LETTER RRA
+1 14 HA
+2 13 YYA
+1 13 VOCALIC RR
+1 21 LL
+1 6 VOWEL SIGN VOCALIC L
+1 26 L
+3 6 DIGIT ZERO
+1 12 ONE
+1 12 TWO
+1 13 HREE
+1 12 FOUR
+1 13 IVE
+1 12 SIX
+1 13 EVEN
+1 12 EIGHT
+1 12 NINE
+1 6 ISSHAR
+1 6 LETTER WA
+1 6 FRACTION ONE QUARTER
+1 19 HALF
+1 15 THREE QUARTERS
+1 15 ONE SIXTEENTH
+1 19 EIGHTH
+1 15 THREE SIXTEENTHS
+b 0 TAMIL SIGN ANUSVARA
+1 11 VISARGA
+2 6 LETTER A
+1 14 A
+1 13 I
+1 14 I
+1 13 U
+1 14 U
+4 13 E
+1 14 E
+1 13 AI
+2 13 O
+1 14 O
+1 13 AU
+1 13 KA
+4 13 NGA
+1 13 CA
+2 13 JA
+2 13 NYA
+1 13 TTA
+4 13 NNA
+1 13 TA
+4 13 NA
+1 14 NNA
+1 13 PA
+4 13 MA
+1 13 YA
+1 13 RA
+1 14 RA
+1 13 LA
+1 14 LA
+1 15 LA
+1 13 VA
+1 13 SHA
+1 14 SA
+1 14 A
+1 13 HA
+5 6 VOWEL SIGN AA
+1 17 I
+1 18 I
+1 17 U
+1 18 U
+4 17 E
+1 18 E
+1 17 AI
+2 17 O
+1 18 O
+1 17 AU
+1 6 SIGN VIRAMA
+3 6 OM
+7 6 AU LENGTH MARK
+f 6 DIGIT ZERO
+1 12 ONE
+1 12 TWO
+1 13 HREE
+1 12 FOUR
+1 13 IVE
+1 12 SIX
+1 13 EVEN
+1 12 EIGHT
+1 12 NINE
+1 6 NUMBER TEN
+1 13 ONE HUNDRED
+1 17 THOUSAND
+1 6 DAY SIGN
+1 6 MONTH SIGN
+1 6 YEAR SIGN
+1 6 DEBIT SIGN
+1 6 CREDIT SIGN
+1 6 AS ABOVE SIGN
+1 6 RUPEE SIGN
+1 6 NUMBER SIGN
+6 1 ELUGU SIGN COMBINING CANDRABINDU ABOVE
+1 13 ANDRABINDU
+1 12 ANUSVARA
+1 12 VISARGA
+1 12 COMBINING ANUSVARA ABOVE
+1 7 LETTER A
+1 15 A
+1 14 I
+1 15 I
+1 14 U
+1 15 U
+1 14 VOCALIC R
+1 22 L
+2 14 E
+1 15 E
+1 14 AI
+2 14 O
+1 15 O
+1 14 AU
+1 14 KA
+1 15 HA
+1 14 GA
+1 15 HA
+1 14 NGA
+1 14 CA
+1 15 HA
+1 14 JA
+1 15 HA
+1 14 NYA
+1 14 TTA
+1 16 HA
+1 14 DDA
+1 16 HA
+1 14 NNA
+1 14 TA
+1 15 HA
+1 14 DA
+1 15 HA
+1 14 NA
+2 14 PA
+1 15 HA
+1 14 BA
+1 15 HA
+1 14 MA
+1 14 YA
+1 14 RA
+1 15 RA
+1 14 LA
+1 15 LA
+1 16 LA
+1 14 VA
+1 14 SHA
+1 15 SA
+1 15 A
+1 14 HA
+3 7 SIGN NUKTA
+1 12 AVAGRAHA
+1 7 VOWEL SIGN AA
+1 18 I
+1 19 I
+1 18 U
+1 19 U
+1 18 VOCALIC R
+1 27 R
+2 18 E
+1 19 E
+1 18 AI
+2 18 O
+1 19 O
+1 18 AU
+1 7 SIGN VIRAMA
+8 7 LENGTH MARK
+1 7 AI LENGTH MARK
+2 7 LETTER TSA
+1 14 DZA
+1 14 RRRA
+3 14 NAKAARA POLLU
+3 14 VOCALIC RR
+1 22 LL
+1 7 VOWEL SIGN VOCALIC L
+1 27 L
+3 7 DIGIT ZERO
+1 13 ONE
+1 13 TWO
+1 14 HREE
+1 13 FOUR
+1 14 IVE
+1 13 SIX
+1 14 EVEN
+1 13 EIGHT
+1 13 NINE
+8 7 SIGN SIDDHAM
+1 7 FRACTION DIGIT ZERO FOR ODD POWERS OF FOUR
+1 22 ONE FOR ODD POWERS OF FOUR
+1 22 TWO FOR ODD POWERS OF FOUR
+1 23 HREE FOR ODD POWERS OF FOUR
+1 22 ONE FOR EVEN POWERS OF FOUR
+1 22 TWO FOR EVEN POWERS OF FOUR
+1 23 HREE FOR EVEN POWERS OF FOUR
+1 7 SIGN TUUMU
+1 0 KANNADA SIGN SPACING CANDRABINDU
+1 13 CANDRABINDU
+1 13 ANUSVARA
+1 13 VISARGA
+1 13 SIDDHAM
+1 8 LETTER A
+1 16 A
+1 15 I
+1 16 I
+1 15 U
+1 16 U
+1 15 VOCALIC R
+1 23 L
+2 15 E
+1 16 E
+1 15 AI
+2 15 O
+1 16 O
+1 15 AU
+1 15 KA
+1 16 HA
+1 15 GA
+1 16 HA
+1 15 NGA
+1 15 CA
+1 16 HA
+1 15 JA
+1 16 HA
+1 15 NYA
+1 15 TTA
+1 17 HA
+1 15 DDA
+1 17 HA
+1 15 NNA
+1 15 TA
+1 16 HA
+1 15 DA
+1 16 HA
+1 15 NA
+2 15 PA
+1 16 HA
+1 15 BA
+1 16 HA
+1 15 MA
+1 15 YA
+1 15 RA
+1 16 RA
+1 15 LA
+1 16 LA
+2 15 VA
+1 15 SHA
+1 16 SA
+1 16 A
+1 15 HA
+3 8 SIGN NUKTA
+1 13 AVAGRAHA
+1 8 VOWEL SIGN AA
+1 19 I
+1 20 I
+1 19 U
+1 20 U
+1 19 VOCALIC R
+1 28 R
+2 19 E
+1 20 E
+1 19 AI
+2 19 O
+1 20 O
+1 19 AU
+1 8 SIGN VIRAMA
+8 8 LENGTH MARK
+1 8 AI LENGTH MARK
+7 8 LETTER NAKAARA POLLU
+1 15 FA
+2 15 VOCALIC RR
+1 23 LL
+1 8 VOWEL SIGN VOCALIC L
+1 28 L
+3 8 DIGIT ZERO
+1 14 ONE
+1 14 TWO
+1 15 HREE
+1 14 FOUR
+1 15 IVE
+1 14 SIX
+1 15 EVEN
+1 14 EIGHT
+1 14 NINE
+2 8 SIGN JIHVAMULIYA
+1 13 UPADHMANIYA
+e 0 MALAYALAM SIGN COMBINING ANUSVARA ABOVE
+1 16 ANDRABINDU
+1 15 ANUSVARA
+1 15 VISARGA
+1 10 LETTER VEDIC ANUSVARA
+1 17 A
+1 18 A
+1 17 I
+1 18 I
+1 17 U
+1 18 U
+1 17 VOCALIC R
+1 25 L
+2 17 E
+1 18 E
+1 17 AI
+2 17 O
+1 18 O
+1 17 AU
+1 17 KA
+1 18 HA
+1 17 GA
+1 18 HA
+1 17 NGA
+1 17 CA
+1 18 HA
+1 17 JA
+1 18 HA
+1 17 NYA
+1 17 TTA
+1 19 HA
+1 17 DDA
+1 19 HA
+1 17 NNA
+1 17 TA
+1 18 HA
+1 17 DA
+1 18 HA
+1 17 NA
+1 18 NNA
+1 17 PA
+1 18 HA
+1 17 BA
+1 18 HA
+1 17 MA
+1 17 YA
+1 17 RA
+1 18 RA
+1 17 LA
+1 18 LA
+1 19 LA
+1 17 VA
+1 17 SHA
+1 18 SA
+1 18 A
+1 17 HA
+1 17 TTTA
+1 10 SIGN VERTICAL BAR VIRAMA
+1 15 CIRCULAR VIRAMA
+1 15 AVAGRAHA
+1 10 VOWEL SIGN AA
+1 21 I
+1 22 I
+1 21 U
+1 22 U
+1 21 VOCALIC R
+1 30 R
+2 21 E
+1 22 E
+1 21 AI
+2 21 O
+1 22 O
+1 21 AU
+1 10 SIGN VIRAMA
+1 10 LETTER DOT REPH
+1 10 SIGN PARA
+5 10 LETTER CHILLU M
+1 24 Y
+1 24 LLL
+1 10 AU LENGTH MARK
+1 10 FRACTION ONE ONE-HUNDRED-AND-SIXTIETH
+1 23 FORTIETH
+1 19 THREE EIGHTIETHS
+1 19 ONE TWENTIETH
+1 24 ENTH
+1 19 THREE TWENTIETHS
+1 19 ONE FIFTH
+1 10 LETTER ARCHAIC II
+1 17 VOCALIC RR
+1 25 LL
+1 10 VOWEL SIGN VOCALIC L
+1 30 L
+3 10 DIGIT ZERO
+1 16 ONE
+1 16 TWO
+1 17 HREE
+1 16 FOUR
+1 17 IVE
+1 16 SIX
+1 17 EVEN
+1 16 EIGHT
+1 16 NINE
+1 10 NUMBER TEN
+1 17 ONE HUNDRED
+1 21 THOUSAND
+1 10 FRACTION ONE QUARTER
+1 23 HALF
+1 19 THREE QUARTERS
+1 19 ONE SIXTEENTH
+1 23 EIGHTH
+1 19 THREE SIXTEENTHS
+1 10 DATE MARK
+1 10 LETTER CHILLU NN
+1 25 
+1 24 RR
+1 24 L
+1 25 L
+1 24 K
+2 0 SINHALA SIGN CANDRABINDU
+1 13 ANUSVARAYA
+1 13 VISARGAYA
+2 8 LETTER AYANNA
+1 16 AYANNA
+1 16 EYANNA
+1 17 EYANNA
+1 15 IYANNA
+1 16 IYANNA
+1 15 UYANNA
+1 16 UYANNA
+1 15 IRUYANNA
+1 18 UYANNA
+1 16 LUYANNA
+1 18 UYANNA
+1 15 EYANNA
+1 16 EYANNA
+1 15 AIYANNA
+1 15 OYANNA
+1 16 OYANNA
+1 15 AUYANNA
+4 16 LPAPRAANA KAYANNA
+1 15 MAHAAPRAANA KAYANNA
+1 15 ALPAPRAANA GAYANNA
+1 15 MAHAAPRAANA GAYANNA
+1 15 KANTAJA NAASIKYAYA
+1 15 SANYAKA GAYANNA
+1 15 ALPAPRAANA CAYANNA
+1 15 MAHAAPRAANA CAYANNA
+1 15 ALPAPRAANA JAYANNA
+1 15 MAHAAPRAANA JAYANNA
+1 15 TAALUJA NAASIKYAYA
+1 23 SANYOOGA NAAKSIKYAYA
+1 15 SANYAKA JAYANNA
+1 15 ALPAPRAANA TTAYANNA
+1 15 MAHAAPRAANA TTAYANNA
+1 15 ALPAPRAANA DDAYANNA
+1 15 MAHAAPRAANA DDAYANNA
+1 16 UURDHAJA NAYANNA
+1 15 SANYAKA DDAYANNA
+1 15 ALPAPRAANA TAYANNA
+1 15 MAHAAPRAANA TAYANNA
+1 15 ALPAPRAANA DAYANNA
+1 15 MAHAAPRAANA DAYANNA
+1 15 DANTAJA NAYANNA
+2 15 SANYAKA DAYANNA
+1 15 ALPAPRAANA PAYANNA
+1 15 MAHAAPRAANA PAYANNA
+1 15 ALPAPRAANA BAYANNA
+1 15 MAHAAPRAANA BAYANNA
+1 17 YANNA
+1 15 AMBA BAYANNA
+1 15 YAYANNA
+1 15 RAYANNA
+2 15 DANTAJA LAYANNA
+3 15 VAYANNA
+1 15 TAALUJA SAYANNA
+1 15 MUURDHAJA SAYANNA
+1 15 DANTAJA SAYANNA
+1 15 HAYANNA
+1 15 MUURDHAJA LAYANNA
+1 15 FAYANNA
+4 8 SIGN AL-LAKUNA
+5 8 VOWEL SIGN AELA-PILLA
+1 19 KETTI AEDA-PILLA
+1 19 DIGA AEDA-PILLA
+1 19 KETTI IS-PILLA
+1 19 DIGA IS-PILLA
+1 19 KETTI PAA-PILLA
+2 19 DIGA PAA-PILLA
+2 19 GAETTA-PILLA
+1 19 KOMBUVA
+1 19 DIGA KOMBUVA
+1 19 KOMBU DEKA
+1 24 VA HAA AELA-PILLA
+1 31 DIGA AELA-PILLA
+1 31 GAYANUKITTA
+1 19 GAYANUKITTA
+7 8 LITH DIGIT ZERO
+1 19 ONE
+1 19 TWO
+1 20 HREE
+1 19 FOUR
+1 20 IVE
+1 19 SIX
+1 20 EVEN
+1 19 EIGHT
+1 19 NINE
+3 8 VOWEL SIGN DIGA GAETTA-PILLA
+1 26 YANUKITTA
+1 8 PUNCTUATION KUNDDALIYA
+d 0 THAI CHARACTER KO KAI
+1 16 HO KHAI
+1 21 UAT
+1 21 WAI
+1 21 ON
+1 19 RAKHANG
+1 15 NGO NGU
+1 15 CHO CHAN
+1 21 ING
+1 21 ANG
+1 15 SO SO
+1 15 CHO CHOE
+1 15 YO YING
+1 15 DO CHADA
+1 15 TO PATAK
+1 16 HO THAN
+1 19 NANGMONTHO
+1 19 PHUTHAO
+1 15 NO NEN
+1 15 DO DEK
+1 15 TO TAO
+1 16 HO THUNG
+1 21 AHAN
+1 21 ONG
+1 15 NO NU
+1 15 BO BAIMAI
+1 15 PO PLA
+1 16 HO PHUNG
+1 15 FO FA
+1 15 PHO PHAN
+1 15 FO FAN
+1 15 PHO SAMPHAO
+1 15 MO MA
+1 15 YO YAK
+1 15 RO RUA
+1 16 U
+1 15 LO LING
+1 16 U
+1 15 WO WAEN
+1 15 SO SALA
+1 18 RUSI
+1 18 SUA
+1 15 HO HIP
+1 15 LO CHULA
+1 15 O ANG
+1 15 HO NOKHUK
+1 15 PAIYANNOI
+1 15 SARA A
+1 15 MAI HAN-AKAT
+1 15 SARA AA
+1 21 M
+1 20 I
+1 21 I
+1 20 UE
+1 22 E
+1 21 
+1 21 U
+1 15 PHINTHU
+5 6 URRENCY SYMBOL BAHT
+1 6 HARACTER SARA E
+1 20 AE
+1 20 O
+1 20 AI MAIMUAN
+1 27 ALAI
+1 15 LAKKHANGYAO
+1 15 MAIYAMOK
+1 18 TAIKHU
+1 18  EK
+1 19 THO
+1 20 RI
+1 19 CHATTAWA
+1 15 THANTHAKHAT
+1 15 NIKHAHIT
+1 15 YAMAKKAN
+1 15 FONGMAN
+1 5 DIGIT ZERO
+1 11 ONE
+1 11 TWO
+1 12 HREE
+1 11 FOUR
+1 12 IVE
+1 11 SIX
+1 12 EVEN
+1 11 EIGHT
+1 11 NINE
+1 5 CHARACTER ANGKHANKHU
+1 15 KHOMUT
+26 0 LAO LETTER KO
+1 12 HO SUNG
+2 15 TAM
+2 11 PALI GHA
+1 11 NGO
+1 11 CO
+1 11 PALI CHA
+1 11 SO TAM
+2 11 PALI JHA
+1 11 NYO
+1 11 PALI NYA
+1 16 TTA
+1 18 HA
+1 16 DDA
+1 18 HA
+1 16 NNA
+1 11 DO
+1 11 TO
+1 12 HO SUNG
+1 15 TAM
+1 11 PALI DHA
+1 11 NO
+1 11 BO
+1 11 PO
+1 12 HO SUNG
+1 11 FO TAM
+1 11 PHO TAM
+1 11 FO SUNG
+1 11 PALI BHA
+1 11 MO
+1 11 YO
+1 11 LO LING
+2 15 OOT
+2 11 WO
+1 11 SANSKRIT SHA
+1 21 SA
+1 12 O SUNG
+1 11 HO SUNG
+1 11 PALI LLA
+1 11 O
+1 11 HO TAM
+1 4 ELLIPSIS
+1 4 VOWEL SIGN A
+1 15 MAI KAN
+1 15 AA
+1 16 M
+1 15 I
+1 16 I
+1 15 Y
+1 16 Y
+1 15 U
+1 16 U
+1 4 SIGN PALI VIRAMA
+1 4 VOWEL SIGN MAI KON
+1 4 SEMIVOWEL SIGN LO
+1 19 NYO
+3 4 VOWEL SIGN E
+1 16 I
+1 15 O
+1 15 AY
+1 16 I
+2 4 KO LA
+2 4 TONE MAI EK
+1 13 THO
+1 14 I
+1 13 CATAWA
+1 4 CANCELLATION MARK
+1 4 NIGGAHITA
+3 4 DIGIT ZERO
+1 10 ONE
+1 10 TWO
+1 11 HREE
+1 10 FOUR
+1 11 IVE
+1 10 SIX
+1 11 EVEN
+1 10 EIGHT
+1 10 NINE
+3 4 HO NO
+1 7 MO
+1 4 LETTER KHMU GO
+1 16 NYO
+21 0 TIBETAN SYLLABLE OM
+1 8 MARK GTER YIG MGO TRUNCATED A
+1 26 -UM RNAM BCAD MA
+1 30 GTER TSHEG MA
+1 13 INITIAL YIG MGO MDUN MA
+1 13 CLOSING YIG MGO SGAB MA
+1 14 ARET YIG MGO PHUR SHAD MA
+1 13 YIG MGO TSHEG SHAD MA
+1 13 SBRUL SHAD
+1 13 BSKUR YIG MGO
+1 14 KA- SHOG YIG MGO
+1 13 INTERSYLLABIC TSHEG
+1 13 DELIMITER TSHEG BSTAR
+1 13 SHAD
+1 13 NYIS SHAD
+1 13 TSHEG SHAD
+1 13 NYIS TSHEG SHAD
+1 13 RIN CHEN SPUNGS SHAD
+1 14 GYA GRAM SHAD
+1 13 CARET -DZUD RTAGS ME LONG CAN
+1 13 GTER TSHEG
+1 8 LOGOTYPE SIGN CHAD RTAGS
+1 22 LHAG RTAGS
+1 8 ASTROLOGICAL SIGN SGRA GCAN -CHAR RTAGS
+1 26 -KHYUD PA
+1 26 SDONG TSHUGS
+1 8 SIGN RDEL DKAR GCIG
+1 24 NYIS
+1 24 SUM
+1 18 NAG GCIG
+1 23 NYIS
+1 18 DKAR RDEL NAG
+1 8 DIGIT ZERO
+1 14 ONE
+1 14 TWO
+1 15 HREE
+1 14 FOUR
+1 15 IVE
+1 14 SIX
+1 15 EVEN
+1 14 EIGHT
+1 14 NINE
+1 14 HALF ONE
+1 19 TWO
+1 20 HREE
+1 19 FOUR
+1 20 IVE
+1 19 SIX
+1 20 EVEN
+1 19 EIGHT
+1 19 NINE
+1 19 ZERO
+1 8 MARK BSDUS RTAGS
+1 13 NGAS BZUNG NYI ZLA
+1 13 CARET -DZUD RTAGS BZHI MIG CAN
+1 13 NGAS BZUNG SGOR RTAGS
+1 13 CHE MGO
+1 13 TSA -PHRU
+1 13 GUG RTAGS GYON
+1 25 AS
+1 13 ANG KHANG GYON
+1 25 AS
+1 8 SIGN YAR TSHES
+1 13 MAR TSHES
+1 8 LETTER KA
+1 16 HA
+1 15 GA
+1 16 HA
+1 15 NGA
+1 15 CA
+1 16 HA
+1 15 JA
+2 15 NYA
+1 15 TTA
+1 17 HA
+1 15 DDA
+1 17 HA
+1 15 NNA
+1 15 TA
+1 16 HA
+1 15 DA
+1 16 HA
+1 15 NA
+1 15 PA
+1 16 HA
+1 15 BA
+1 16 HA
+1 15 MA
+1 15 TSA
+1 17 HA
+1 15 DZA
+1 17 HA
+1 15 WA
+1 15 ZHA
+1 16 A
+1 15 -A
+1 15 YA
+1 15 RA
+1 15 LA
+1 15 SHA
+1 16 SA
+1 16 A
+1 15 HA
+1 15 A
+1 15 KSSA
+1 15 FIXED-FORM RA
+1 15 KKA
+1 15 RRA
+5 8 VOWEL SIGN AA
+1 19 I
+1 20 I
+1 19 U
+1 20 U
+1 19 VOCALIC R
+1 28 R
+1 27 L
+1 28 L
+1 19 E
+1 20 E
+1 19 O
+1 20 O
+1 8 SIGN RJES SU NGA RO
+1 14 NAM BCAD
+1 8 VOWEL SIGN REVERSED I
+1 29 I
+1 8 SIGN NYI ZLA NAA DA
+1 13 SNA LDAN
+1 8 MARK HALANTA
+1 13 PALUTA
+1 8 SIGN LCI RTAGS
+1 13 YANG RTAGS
+1 13 LCE TSA CAN
+1 13 MCHU CAN
+1 13 GRU CAN RGYINGS
+1 17 MED RGYINGS
+1 13 INVERTED MCHU CAN
+1 9 UBJOINED SIGN LCE TSA CAN
+1 23 MCHU CAN
+1 23 INVERTED MCHU CAN
+1 18 LETTER KA
+1 26 HA
+1 25 GA
+1 26 HA
+1 25 NGA
+1 25 CA
+1 26 HA
+1 25 JA
+2 25 NYA
+1 25 TTA
+1 27 HA
+1 25 DDA
+1 27 HA
+1 25 NNA
+1 25 TA
+1 26 HA
+1 25 DA
+1 26 HA
+1 25 NA
+1 25 PA
+1 26 HA
+1 25 BA
+1 26 HA
+1 25 MA
+1 25 TSA
+1 27 HA
+1 25 DZA
+1 27 HA
+1 25 WA
+1 25 ZHA
+1 26 A
+1 25 -A
+1 25 YA
+1 25 RA
+1 25 LA
+1 25 SHA
+1 26 SA
+1 26 A
+1 25 HA
+1 25 A
+1 25 KSSA
+1 25 FIXED-FORM WA
+1 36 YA
+1 36 RA
+2 8 KU RU KHA
+1 17  BZHI MIG CAN
+1 8 CANTILLATION SIGN HEAVY BEAT
+1 26 LIGHT BEAT
+1 26 CANG TE-U
+1 26 SBUB -CHAL
+1 8 SYMBOL DRIL BU
+1 15 RDO RJE
+1 15 PADMA GDAN
+1 15 RDO RJE RGYA GRAM
+1 15 PHUR PA
+1 15 NOR BU
+1 21  NYIS -KHYIL
+1 22 GSUM -KHYIL
+1 22 BZHI -KHYIL
+2 9 IGN RDEL NAG RDEL DKAR
+1 22 GSUM
+1 8 MARK BSKA- SHOG GI MGO RGYAN
+1 13 MNYAM YIG GI MGO RGYAN
+1 13 NYIS TSHEG
+1 13 INITIAL BRDA RNYING YIG MGO MDUN MA
+1 13 CLOSING BRDA RNYING YIG MGO SGAB MA
+1 0 RIGHT-FACING SVASTI SIGN
+1 0 LEFT-FACING SVASTI SIGN
+1 0 RIGHT-FACING SVASTI SIGN WITH DOTS
+1 0 LEFT-FACING SVASTI SIGN WITH DOTS
+1 0 TIBETAN MARK LEADING MCHAN RTAGS
+1 13 TRAILING MCHAN RTAGS
+26 0 MYANMAR LETTER KA
+1 16 HA
+1 15 GA
+1 16 HA
+1 15 NGA
+1 15 CA
+1 16 HA
+1 15 JA
+1 16 HA
+1 15 NYA
+1 16 NYA
+1 15 TTA
+1 17 HA
+1 15 DDA
+1 17 HA
+1 15 NNA
+1 15 TA
+1 16 HA
+1 15 DA
+1 16 HA
+1 15 NA
+1 15 PA
+1 16 HA
+1 15 BA
+1 16 HA
+1 15 MA
+1 15 YA
+1 15 RA
+1 15 LA
+1 15 WA
+1 15 SA
+1 15 HA
+1 15 LLA
+1 15 A
+1 15 SHAN A
+1 15 I
+1 16 I
+1 15 U
+1 16 U
+1 15 E
+1 15 MON E
+1 15 O
+1 15 AU
+1 8 VOWEL SIGN TALL AA
+1 19 AA
+1 19 I
+1 20 I
+1 19 U
+1 20 U
+1 19 E
+1 19 AI
+1 19 MON II
+1 23 O
+1 19 E ABOVE
+1 8 SIGN ANUSVARA
+1 13 DOT BELOW
+1 13 VISARGA
+1 15 RAMA
+1 13 ASAT
+1 8 CONSONANT SIGN MEDIAL YA
+1 30 RA
+1 30 WA
+1 30 HA
+1 8 LETTER GREAT SA
+1 8 DIGIT ZERO
+1 14 ONE
+1 14 TWO
+1 15 HREE
+1 14 FOUR
+1 15 IVE
+1 14 SIX
+1 15 EVEN
+1 14 EIGHT
+1 14 NINE
+1 8 SIGN LITTLE SECTION
+1 13 SECTION
+1 9 YMBOL LOCATIVE
+1 15 COMPLETED
+1 15 AFOREMENTIONED
+1 15 GENITIVE
+1 8 LETTER SHA
+1 16 SA
+1 15 VOCALIC R
+1 24 R
+1 23 L
+1 24 L
+1 8 VOWEL SIGN VOCALIC R
+1 28 R
+1 27 L
+1 28 L
+1 8 LETTER MON NGA
+1 19 JHA
+1 19 BBA
+1 21 E
+1 8 CONSONANT SIGN MON MEDIAL NA
+1 34 MA
+1 34 LA
+1 8 LETTER SGAW KAREN SHA
+1 8 VOWEL SIGN SGAW KAREN EU
+1 8 TONE MARK SGAW KAREN HATHI
+1 29 KE PHO
+1 8 LETTER WESTERN PWO KAREN THA
+1 33 PWA
+1 8 VOWEL SIGN WESTERN PWO KAREN EU
+1 37 UE
+1 8 SIGN WESTERN PWO KAREN TONE-1
+1 36 2
+1 36 3
+1 36 4
+1 36 5
+1 8 LETTER EASTERN PWO KAREN NNA
+1 33 YWA
+1 33 GHWA
+1 8 VOWEL SIGN GEBA KAREN I
+1 19 KAYAH OE
+1 25 U
+1 25 EE
+1 8 LETTER SHAN KA
+1 21 HA
+1 20 GA
+1 20 CA
+1 20 ZA
+1 20 NYA
+1 20 DA
+1 20 NA
+1 20 PHA
+1 20 FA
+1 20 BA
+1 20 THA
+1 20 HA
+1 8 CONSONANT SIGN SHAN MEDIAL WA
+1 8 VOWEL SIGN SHAN AA
+1 24 E
+1 25  ABOVE
+1 24 FINAL Y
+1 8 SIGN SHAN TONE-2
+1 23 3
+1 23 5
+1 23 6
+1 18 COUNCIL TONE-2
+1 31 3
+1 26 EMPHATIC TONE
+1 8 LETTER RUMAI PALAUNG FA
+1 8 SIGN RUMAI PALAUNG TONE-5
+1 9 HAN DIGIT ZERO
+1 19 ONE
+1 19 TWO
+1 20 HREE
+1 19 FOUR
+1 20 IVE
+1 19 SIX
+1 20 EVEN
+1 19 EIGHT
+1 19 NINE
+1 9 IGN KHAMTI TONE-1
+1 25 3
+1 8 VOWEL SIGN AITON A
+1 26 I
+1 8 SYMBOL SHAN ONE
+1 20 EXCLAMATION
+1 0 GEORGIAN CAPITAL LETTER AN
+1 24 BAN
+1 24 GAN
+1 24 DON
+1 24 EN
+1 24 VIN
+1 24 ZEN
+1 24 TAN
+1 24 IN
+1 24 KAN
+1 24 LAS
+1 24 MAN
+1 24 NAR
+1 24 ON
+1 24 PAR
+1 24 ZHAR
+1 24 RAE
+1 24 SAN
+1 24 TAR
+1 24 UN
+1 24 PHAR
+1 24 KHAR
+1 24 GHAN
+1 24 QAR
+1 24 SHIN
+1 24 CHIN
+1 25 AN
+1 24 JIL
+1 24 CIL
+1 25 HAR
+1 24 XAN
+1 24 JHAN
+1 24 HAE
+1 25 E
+1 25 IE
+1 24 WE
+1 24 HAR
+1 25 OE
+2 24 YN
+6 24 AEN
+3 9 LETTER AN
+1 16 BAN
+1 16 GAN
+1 16 DON
+1 16 EN
+1 16 VIN
+1 16 ZEN
+1 16 TAN
+1 16 IN
+1 16 KAN
+1 16 LAS
+1 16 MAN
+1 16 NAR
+1 16 ON
+1 16 PAR
+1 16 ZHAR
+1 16 RAE
+1 16 SAN
+1 16 TAR
+1 16 UN
+1 16 PHAR
+1 16 KHAR
+1 16 GHAN
+1 16 QAR
+1 16 SHIN
+1 16 CHIN
+1 17 AN
+1 16 JIL
+1 16 CIL
+1 17 HAR
+1 16 XAN
+1 16 JHAN
+1 16 HAE
+1 17 E
+1 17 IE
+1 16 WE
+1 16 HAR
+1 17 OE
+1 16 FI
+1 16 YN
+1 16 ELIFI
+1 16 TURNED GAN
+1 16 AIN
+1 9 PARAGRAPH SEPARATOR
+1 0 MODIFIER LETTER GEORGIAN NAR
+1 0 GEORGIAN LETTER AEN
+1 16 HARD SIGN
+1 16 LABIAL SIGN
+1 0 HANGUL CHOSEONG KIYEOK
+1 16 SSANGKIYEOK
+1 16 NIEUN
+1 16 TIKEUT
+1 16 SSANGTIKEUT
+1 16 RIEUL
+1 16 MIEUM
+1 16 PIEUP
+1 16 SSANGPIEUP
+1 17 IOS
+1 17 SANGSIOS
+1 16 IEUNG
+1 16 CIEUC
+1 16 SSANGCIEUC
+1 16 CHIEUCH
+1 16 KHIEUKH
+1 16 THIEUTH
+1 16 PHIEUPH
+1 16 HIEUH
+1 16 NIEUN-KIYEOK
+1 16 SSANGNIEUN
+1 16 NIEUN-TIKEUT
+1 22 PIEUP
+1 16 TIKEUT-KIYEOK
+1 16 RIEUL-NIEUN
+1 16 SSANGRIEUL
+1 16 RIEUL-HIEUH
+1 16 KAPYEOUNRIEUL
+1 16 MIEUM-PIEUP
+1 16 KAPYEOUNMIEUM
+1 16 PIEUP-KIYEOK
+1 22 NIEUN
+1 22 TIKEUT
+1 22 SIOS
+1 26 -KIYEOK
+1 27 TIKEUT
+1 27 PIEUP
+1 23 SANGSIOS
+1 23 IOS-CIEUC
+1 22 CIEUC
+1 23 HIEUCH
+1 22 THIEUTH
+1 22 PHIEUPH
+1 16 KAPYEOUNPIEUP
+1 24 SSANGPIEUP
+1 16 SIOS-KIYEOK
+1 21 NIEUN
+1 21 TIKEUT
+1 21 RIEUL
+1 21 MIEUM
+1 21 PIEUP
+1 26 -KIYEOK
+1 21 SSANGSIOS
+1 21 IEUNG
+1 21 CIEUC
+1 22 HIEUCH
+1 21 KHIEUKH
+1 21 THIEUTH
+1 21 PHIEUPH
+1 21 HIEUH
+1 16 CHITUEUMSIOS
+1 25 SANGSIOS
+1 17 EONGCHIEUMSIOS
+1 28 SANGSIOS
+1 16 PANSIOS
+1 16 IEUNG-KIYEOK
+1 22 TIKEUT
+1 22 MIEUM
+1 22 PIEUP
+1 22 SIOS
+1 22 PANSIOS
+1 16 SSANGIEUNG
+1 16 IEUNG-CIEUC
+1 23 HIEUCH
+1 22 THIEUTH
+1 22 PHIEUPH
+1 16 YESIEUNG
+1 16 CIEUC-IEUNG
+1 17 HITUEUMCIEUC
+1 24 SSANGCIEUC
+1 17 EONGCHIEUMCIEUC
+1 27 SSANGCIEUC
+1 17 HIEUCH-KHIEUKH
+1 24 HIEUH
+1 19 TUEUMCHIEUCH
+1 17 EONGCHIEUMCHIEUCH
+1 16 PHIEUPH-PIEUP
+1 16 KAPYEOUNPHIEUPH
+1 16 SSANGHIEUH
+1 16 YEORINHIEUH
+1 16 KIYEOK-TIKEUT
+1 16 NIEUN-SIOS
+1 22 CIEUC
+1 22 HIEUH
+1 16 TIKEUT-RIEUL
+1 16 FILLER
+1 7 JUNGSEONG FILLER
+1 17 A
+1 18 E
+1 17 YA
+1 19 E
+1 17 EO
+1 18 
+1 17 YEO
+1 19 
+1 17 O
+1 17 WA
+1 19 E
+1 17 OE
+1 17 YO
+1 17 U
+1 17 WEO
+1 19 
+1 18 I
+1 17 YU
+1 17 EU
+1 17 YI
+1 17 I
+1 17 A-O
+1 19 U
+1 17 YA-O
+1 20 YO
+1 17 EO-O
+1 20 U
+1 20 EU
+1 17 YEO-O
+1 21 U
+1 17 O-EO
+1 20 
+1 19 YE
+1 19 O
+1 19 U
+1 17 YO-YA
+1 22 E
+1 21 EO
+1 20 O
+1 20 I
+1 17 U-A
+1 20 E
+1 19 EO-EU
+1 19 YE
+1 19 U
+1 17 YU-A
+1 20 EO
+1 21 
+1 20 YEO
+1 22 
+1 20 U
+1 20 I
+1 17 EU-U
+1 20 EU
+1 17 YI-U
+1 17 I-A
+1 19 YA
+1 19 O
+1 19 U
+1 19 EU
+1 19 ARAEA
+1 17 ARAEA
+1 22 -EO
+1 23 U
+1 23 I
+1 17 SSANGARAEA
+1 17 A-EU
+1 17 YA-U
+1 18 EO-YA
+1 17 O-YA
+1 21 E
+1 8 ONGSEONG KIYEOK
+1 17 SSANGKIYEOK
+1 17 KIYEOK-SIOS
+1 17 NIEUN
+1 22 -CIEUC
+1 23 HIEUH
+1 17 TIKEUT
+1 17 RIEUL
+1 22 -KIYEOK
+1 23 MIEUM
+1 23 PIEUP
+1 23 SIOS
+1 23 THIEUTH
+1 23 PHIEUPH
+1 23 HIEUH
+1 17 MIEUM
+1 17 PIEUP
+1 22 -SIOS
+1 17 SIOS
+1 18 SANGSIOS
+1 17 IEUNG
+1 17 CIEUC
+1 18 HIEUCH
+1 17 KHIEUKH
+1 17 THIEUTH
+1 17 PHIEUPH
+1 17 HIEUH
+1 17 KIYEOK-RIEUL
+1 24 SIOS-KIYEOK
+1 17 NIEUN-KIYEOK
+1 23 TIKEUT
+1 23 SIOS
+1 23 PANSIOS
+1 23 THIEUTH
+1 17 TIKEUT-KIYEOK
+1 24 RIEUL
+1 17 RIEUL-KIYEOK-SIOS
+1 23 NIEUN
+1 23 TIKEUT
+1 29 -HIEUH
+1 17 SSANGRIEUL
+1 17 RIEUL-MIEUM-KIYEOK
+1 29 SIOS
+1 23 PIEUP-SIOS
+1 29 HIEUH
+1 23 KAPYEOUNPIEUP
+1 23 SSANGSIOS
+1 23 PANSIOS
+1 23 KHIEUKH
+1 23 YEORINHIEUH
+1 17 MIEUM-KIYEOK
+1 23 RIEUL
+1 23 PIEUP
+1 23 SIOS
+1 24 SANGSIOS
+1 23 PANSIOS
+1 23 CHIEUCH
+1 23 HIEUH
+1 17 KAPYEOUNMIEUM
+1 17 PIEUP-RIEUL
+1 23 PHIEUPH
+1 23 HIEUH
+1 17 KAPYEOUNPIEUP
+1 17 SIOS-KIYEOK
+1 22 TIKEUT
+1 22 RIEUL
+1 22 PIEUP
+1 17 PANSIOS
+1 17 IEUNG-KIYEOK
+1 23 SSANGKIYEOK
+1 17 SSANGIEUNG
+1 17 IEUNG-KHIEUKH
+1 17 YESIEUNG
+1 25 -SIOS
+1 26 PANSIOS
+1 17 PHIEUPH-PIEUP
+1 17 KAPYEOUNPHIEUPH
+1 17 HIEUH-NIEUN
+1 23 RIEUL
+1 23 MIEUM
+1 23 PIEUP
+1 17 YEORINHIEUH
+1 17 KIYEOK-NIEUN
+1 24 PIEUP
+1 24 CHIEUCH
+1 24 KHIEUKH
+1 24 HIEUH
+1 17 SSANGNIEUN
+1 0 ETHIOPIC SYLLABLE HA
+1 19 U
+1 19 I
+1 19 AA
+1 19 EE
+1 20 
+1 19 O
+1 20 A
+1 18 LA
+1 19 U
+1 19 I
+1 19 AA
+1 19 EE
+1 20 
+1 19 O
+1 19 WA
+1 18 HHA
+1 20 U
+1 20 I
+1 20 AA
+1 20 EE
+1 21 
+1 20 O
+1 20 WA
+1 18 MA
+1 19 U
+1 19 I
+1 19 AA
+1 19 EE
+1 20 
+1 19 O
+1 19 WA
+1 18 SZA
+1 20 U
+1 20 I
+1 20 AA
+1 20 EE
+1 21 
+1 20 O
+1 20 WA
+1 18 RA
+1 19 U
+1 19 I
+1 19 AA
+1 19 EE
+1 20 
+1 19 O
+1 19 WA
+1 18 SA
+1 19 U
+1 19 I
+1 19 AA
+1 19 EE
+1 20 
+1 19 O
+1 19 WA
+1 19 HA
+1 20 U
+1 20 I
+1 20 AA
+1 20 EE
+1 21 
+1 20 O
+1 20 WA
+1 18 QA
+1 19 U
+1 19 I
+1 19 AA
+1 19 EE
+1 20 
+1 19 O
+1 20 A
+1 19 WA
+2 20 I
+1 20 AA
+1 20 EE
+1 21 
+3 19 HA
+1 20 U
+1 20 I
+1 20 AA
+1 20 EE
+1 21 
+1 20 O
+2 20 WA
+2 21 I
+1 21 AA
+1 21 EE
+1 22 
+3 18 BA
+1 19 U
+1 19 I
+1 19 AA
+1 19 EE
+1 20 
+1 19 O
+1 19 WA
+1 18 VA
+1 19 U
+1 19 I
+1 19 AA
+1 19 EE
+1 20 
+1 19 O
+1 19 WA
+1 18 TA
+1 19 U
+1 19 I
+1 19 AA
+1 19 EE
+1 20 
+1 19 O
+1 19 WA
+1 18 CA
+1 19 U
+1 19 I
+1 19 AA
+1 19 EE
+1 20 
+1 19 O
+1 19 WA
+1 18 XA
+1 19 U
+1 19 I
+1 19 AA
+1 19 EE
+1 20 
+1 19 O
+1 20 A
+1 19 WA
+2 20 I
+1 20 AA
+1 20 EE
+1 21 
+3 18 NA
+1 19 U
+1 19 I
+1 19 AA
+1 19 EE
+1 20 
+1 19 O
+1 19 WA
+1 19 YA
+1 20 U
+1 20 I
+1 20 AA
+1 20 EE
+1 21 
+1 20 O
+1 20 WA
+1 18 GLOTTAL A
+1 26 U
+1 26 I
+1 26 AA
+1 26 EE
+1 27 
+1 26 O
+1 26 WA
+1 18 KA
+1 19 U
+1 19 I
+1 19 AA
+1 19 EE
+1 20 
+1 19 O
+1 20 A
+1 19 WA
+2 20 I
+1 20 AA
+1 20 EE
+1 21 
+3 19 XA
+1 20 U
+1 20 I
+1 20 AA
+1 20 EE
+1 21 
+1 20 O
+2 20 WA
+2 21 I
+1 21 AA
+1 21 EE
+1 22 
+3 18 WA
+1 19 U
+1 19 I
+1 19 AA
+1 19 EE
+1 20 
+1 19 O
+1 20 A
+1 18 PHARYNGEAL A
+1 29 U
+1 29 I
+1 29 AA
+1 29 EE
+1 30 
+1 29 O
+2 18 ZA
+1 19 U
+1 19 I
+1 19 AA
+1 19 EE
+1 20 
+1 19 O
+1 19 WA
+1 19 HA
+1 20 U
+1 20 I
+1 20 AA
+1 20 EE
+1 21 
+1 20 O
+1 20 WA
+1 18 YA
+1 19 U
+1 19 I
+1 19 AA
+1 19 EE
+1 20 
+1 19 O
+1 20 A
+1 18 DA
+1 19 U
+1 19 I
+1 19 AA
+1 19 EE
+1 20 
+1 19 O
+1 19 WA
+1 19 DA
+1 20 U
+1 20 I
+1 20 AA
+1 20 EE
+1 21 
+1 20 O
+1 20 WA
+1 18 JA
+1 19 U
+1 19 I
+1 19 AA
+1 19 EE
+1 20 
+1 19 O
+1 19 WA
+1 18 GA
+1 19 U
+1 19 I
+1 19 AA
+1 19 EE
+1 20 
+1 19 O
+1 20 A
+1 19 WA
+2 20 I
+1 20 AA
+1 20 EE
+1 21 
+3 19 GA
+1 20 U
+1 20 I
+1 20 AA
+1 20 EE
+1 21 
+1 20 O
+1 20 WAA
+1 18 THA
+1 20 U
+1 20 I
+1 20 AA
+1 20 EE
+1 21 
+1 20 O
+1 20 WA
+1 18 CHA
+1 20 U
+1 20 I
+1 20 AA
+1 20 EE
+1 21 
+1 20 O
+1 20 WA
+1 18 PHA
+1 20 U
+1 20 I
+1 20 AA
+1 20 EE
+1 21 
+1 20 O
+1 20 WA
+1 18 TSA
+1 20 U
+1 20 I
+1 20 AA
+1 20 EE
+1 21 
+1 20 O
+1 20 WA
+1 19 ZA
+1 20 U
+1 20 I
+1 20 AA
+1 20 EE
+1 21 
+1 20 O
+1 21 A
+1 18 FA
+1 19 U
+1 19 I
+1 19 AA
+1 19 EE
+1 20 
+1 19 O
+1 19 WA
+1 18 PA
+1 19 U
+1 19 I
+1 19 AA
+1 19 EE
+1 20 
+1 19 O
+1 19 WA
+1 18 RYA
+1 18 MYA
+1 18 FYA
+3 9 COMBINING GEMINATION AND VOWEL LENGTH MARK
+1 19 VOWEL LENGTH MARK
+1 19 GEMINATION MARK
+1 9 SECTION MARK
+1 9 WORDSPACE
+1 9 FULL STOP
+1 9 COMMA
+1 9 SEMICOLON
+1 9 COLON
+1 9 PREFACE COLON
+1 9 QUESTION MARK
+1 9 PARAGRAPH SEPARATOR
+1 9 DIGIT ONE
+1 15 TWO
+1 16 HREE
+1 15 FOUR
+1 16 IVE
+1 15 SIX
+1 16 EVEN
+1 15 EIGHT
+1 15 NINE
+1 9 NUMBER TEN
+1 17 WENTY
+1 17 HIRTY
+1 16 FORTY
+1 17 IFTY
+1 16 SIXTY
+1 17 EVENTY
+1 16 EIGHTY
+1 16 NINETY
+1 16 HUNDRED
+1 16 TEN THOUSAND
+4 9 SYLLABLE SEBATBEIT MWA
+1 18 MWI
+1 20 EE
+1 21 
+1 18 SEBATBEIT BWA
+1 18 BWI
+1 20 EE
+1 21 
+1 18 SEBATBEIT FWA
+1 18 FWI
+1 20 EE
+1 21 
+1 18 SEBATBEIT PWA
+1 18 PWI
+1 20 EE
+1 21 
+1 9 TONAL MARK YIZET
+1 20 DERET
+1 20 RIKRIK
+1 20 SHORT RIKRIK
+1 20 DIFAT
+1 20 KENAT
+1 20 CHIRET
+1 20 HIDET
+1 20 DERET-HIDET
+1 20 KURT
+7 0 CHEROKEE LETTER A
+1 16 E
+1 16 I
+1 16 O
+1 16 U
+1 16 V
+1 16 GA
+1 16 KA
+1 16 GE
+1 17 I
+1 17 O
+1 17 U
+1 17 V
+1 16 HA
+1 17 E
+1 17 I
+1 17 O
+1 17 U
+1 17 V
+1 16 LA
+1 17 E
+1 17 I
+1 17 O
+1 17 U
+1 17 V
+1 16 MA
+1 17 E
+1 17 I
+1 17 O
+1 17 U
+1 16 NA
+1 16 HNA
+1 16 NAH
+1 17 E
+1 17 I
+1 17 O
+1 17 U
+1 17 V
+1 16 QUA
+1 18 E
+1 18 I
+1 18 O
+1 18 U
+1 18 V
+1 16 SA
+1 17 
+1 17 E
+1 17 I
+1 17 O
+1 17 U
+1 17 V
+1 16 DA
+1 16 TA
+1 16 DE
+1 16 TE
+1 16 DI
+1 16 TI
+1 16 DO
+1 17 U
+1 17 V
+1 17 LA
+1 16 TLA
+1 18 E
+1 18 I
+1 18 O
+1 18 U
+1 18 V
+1 17 SA
+1 18 E
+1 18 I
+1 18 O
+1 18 U
+1 18 V
+1 16 WA
+1 17 E
+1 17 I
+1 17 O
+1 17 U
+1 17 V
+1 16 YA
+1 17 E
+1 17 I
+1 17 O
+1 17 U
+1 17 V
+1 16 MV
+3 9 SMALL LETTER YE
+1 23 I
+1 23 O
+1 23 U
+1 23 V
+1 22 MV
+3 1 ANADIAN SYLLABICS HYPHEN
+1 19 E
+1 19 AAI
+1 19 I
+1 20 I
+1 19 O
+1 20 O
+1 19 Y-CREE OO
+1 19 CARRIER EE
+1 27 I
+1 19 A
+1 20 A
+1 19 WE
+1 21 ST-CREE WE
+1 20 I
+1 20 EST-CREE WI
+1 20 II
+1 20 EST-CREE WII
+1 20 O
+1 20 EST-CREE WO
+1 20 OO
+1 20 EST-CREE WOO
+1 19 NASKAPI WOO
+1 19 WA
+1 20 EST-CREE WA
+1 20 AA
+1 20 EST-CREE WAA
+1 19 NASKAPI WAA
+1 19 AI
+1 19 Y-CREE W
+1 19 GLOTTAL STOP
+1 19 FINAL ACUTE
+1 25 GRAVE
+1 25 BOTTOM HALF RING
+1 25 TOP HALF RING
+1 25 RIGHT HALF RING
+1 27 NG
+1 25 DOUBLE ACUTE
+1 32 SHORT VERTICAL STROKES
+1 25 MIDDLE DOT
+1 25 SHORT HORIZONTAL STROKE
+1 25 PLUS
+1 25 DOWN TACK
+1 19 EN
+1 19 IN
+1 19 ON
+1 19 AN
+1 19 PE
+1 20 AAI
+1 20 I
+1 21 I
+1 20 O
+1 21 O
+1 19 Y-CREE POO
+1 19 CARRIER HEE
+1 28 I
+1 19 PA
+1 21 A
+1 20 WE
+1 19 WEST-CREE PWE
+1 19 PWI
+1 19 WEST-CREE PWI
+1 19 PWII
+1 19 WEST-CREE PWII
+1 19 PWO
+1 19 WEST-CREE PWO
+1 19 PWOO
+1 19 WEST-CREE PWOO
+1 19 PWA
+1 19 WEST-CREE PWA
+1 19 PWAA
+1 19 WEST-CREE PWAA
+1 19 Y-CREE PWAA
+1 19 P
+1 19 WEST-CREE P
+1 19 CARRIER H
+1 19 TE
+1 20 AAI
+1 20 I
+1 21 I
+1 20 O
+1 21 O
+1 19 Y-CREE TOO
+1 19 CARRIER DEE
+1 28 I
+1 19 TA
+1 21 A
+1 20 WE
+1 19 WEST-CREE TWE
+1 19 TWI
+1 19 WEST-CREE TWI
+1 19 TWII
+1 19 WEST-CREE TWII
+1 19 TWO
+1 19 WEST-CREE TWO
+1 19 TWOO
+1 19 WEST-CREE TWOO
+1 19 TWA
+1 19 WEST-CREE TWA
+1 19 TWAA
+1 19 WEST-CREE TWAA
+1 19 NASKAPI TWAA
+1 19 T
+1 20 TE
+1 21 I
+1 21 O
+1 21 A
+1 19 KE
+1 20 AAI
+1 20 I
+1 21 I
+1 20 O
+1 21 O
+1 19 Y-CREE KOO
+1 19 KA
+1 21 A
+1 20 WE
+1 19 WEST-CREE KWE
+1 19 KWI
+1 19 WEST-CREE KWI
+1 19 KWII
+1 19 WEST-CREE KWII
+1 19 KWO
+1 19 WEST-CREE KWO
+1 19 KWOO
+1 19 WEST-CREE KWOO
+1 19 KWA
+1 19 WEST-CREE KWA
+1 19 KWAA
+1 19 WEST-CREE KWAA
+1 19 NASKAPI KWAA
+1 19 K
+1 20 W
+1 19 SOUTH-SLAVEY KEH
+1 33 IH
+1 33 OH
+1 33 AH
+1 19 CE
+1 20 AAI
+1 20 I
+1 21 I
+1 20 O
+1 21 O
+1 19 Y-CREE COO
+1 19 CA
+1 21 A
+1 20 WE
+1 19 WEST-CREE CWE
+1 19 CWI
+1 19 WEST-CREE CWI
+1 19 CWII
+1 19 WEST-CREE CWII
+1 19 CWO
+1 19 WEST-CREE CWO
+1 19 CWOO
+1 19 WEST-CREE CWOO
+1 19 CWA
+1 19 WEST-CREE CWA
+1 19 CWAA
+1 19 WEST-CREE CWAA
+1 19 NASKAPI CWAA
+1 19 C
+1 19 SAYISI TH
+1 19 ME
+1 20 AAI
+1 20 I
+1 21 I
+1 20 O
+1 21 O
+1 19 Y-CREE MOO
+1 19 MA
+1 21 A
+1 20 WE
+1 19 WEST-CREE MWE
+1 19 MWI
+1 19 WEST-CREE MWI
+1 19 MWII
+1 19 WEST-CREE MWII
+1 19 MWO
+1 19 WEST-CREE MWO
+1 19 MWOO
+1 19 WEST-CREE MWOO
+1 19 MWA
+1 19 WEST-CREE MWA
+1 19 MWAA
+1 19 WEST-CREE MWAA
+1 19 NASKAPI MWAA
+1 19 M
+1 19 WEST-CREE M
+1 19 MH
+1 19 ATHAPASCAN M
+1 19 SAYISI M
+1 19 NE
+1 20 AAI
+1 20 I
+1 21 I
+1 20 O
+1 21 O
+1 19 Y-CREE NOO
+1 19 NA
+1 21 A
+1 20 WE
+1 19 WEST-CREE NWE
+1 19 NWA
+1 19 WEST-CREE NWA
+1 19 NWAA
+1 19 WEST-CREE NWAA
+1 19 NASKAPI NWAA
+1 20 
+1 19 CARRIER NG
+1 19 NH
+1 19 LE
+1 20 AAI
+1 20 I
+1 21 I
+1 20 O
+1 21 O
+1 19 Y-CREE LOO
+1 19 LA
+1 21 A
+1 20 WE
+1 19 WEST-CREE LWE
+1 19 LWI
+1 19 WEST-CREE LWI
+1 19 LWII
+1 19 WEST-CREE LWII
+1 19 LWO
+1 19 WEST-CREE LWO
+1 19 LWOO
+1 19 WEST-CREE LWOO
+1 19 LWA
+1 19 WEST-CREE LWA
+1 19 LWAA
+1 19 WEST-CREE LWAA
+1 19 L
+1 19 WEST-CREE L
+1 19 MEDIAL L
+1 19 SE
+1 20 AAI
+1 20 I
+1 21 I
+1 20 O
+1 21 O
+1 19 Y-CREE SOO
+1 19 SA
+1 21 A
+1 20 WE
+1 19 WEST-CREE SWE
+1 19 SWI
+1 19 WEST-CREE SWI
+1 19 SWII
+1 19 WEST-CREE SWII
+1 19 SWO
+1 19 WEST-CREE SWO
+1 19 SWOO
+1 19 WEST-CREE SWOO
+1 19 SWA
+1 19 WEST-CREE SWA
+1 19 SWAA
+1 19 WEST-CREE SWAA
+1 19 NASKAPI SWAA
+1 19 S
+1 19 ATHAPASCAN S
+1 19 SW
+1 19 BLACKFOOT S
+1 19 MOOSE-CREE SK
+1 19 NASKAPI SKW
+1 28 -W
+1 28 PWA
+1 28 TWA
+1 28 KWA
+1 28 CWA
+1 19 SHE
+1 21 I
+1 22 I
+1 21 O
+1 22 O
+1 21 A
+1 22 A
+1 21 WE
+1 19 WEST-CREE SHWE
+1 19 SHWI
+1 19 WEST-CREE SHWI
+1 19 SHWII
+1 19 WEST-CREE SHWII
+1 19 SHWO
+1 19 WEST-CREE SHWO
+1 19 SHWOO
+1 19 WEST-CREE SHWOO
+1 19 SHWA
+1 19 WEST-CREE SHWA
+1 19 SHWAA
+1 19 WEST-CREE SHWAA
+1 19 SH
+1 19 YE
+1 20 AAI
+1 20 I
+1 21 I
+1 20 O
+1 21 O
+1 20 -CREE YOO
+1 20 A
+1 21 A
+1 20 WE
+1 19 WEST-CREE YWE
+1 19 YWI
+1 19 WEST-CREE YWI
+1 19 YWII
+1 19 WEST-CREE YWII
+1 19 YWO
+1 19 WEST-CREE YWO
+1 19 YWOO
+1 19 WEST-CREE YWOO
+1 19 YWA
+1 19 WEST-CREE YWA
+1 19 YWAA
+1 19 WEST-CREE YWAA
+1 19 NASKAPI YWAA
+1 19 Y
+1 19 BIBLE-CREE Y
+1 19 WEST-CREE Y
+1 19 SAYISI YI
+1 19 RE
+1 20 -CREE RE
+1 19 WEST-CREE LE
+1 19 RAAI
+1 20 I
+1 21 I
+1 20 O
+1 21 O
+1 19 WEST-CREE LO
+1 19 RA
+1 21 A
+1 19 WEST-CREE LA
+1 19 RWAA
+1 19 WEST-CREE RWAA
+1 19 R
+1 19 WEST-CREE R
+1 19 MEDIAL R
+1 19 FE
+1 20 AAI
+1 20 I
+1 21 I
+1 20 O
+1 21 O
+1 20 A
+1 21 A
+1 20 WAA
+1 19 WEST-CREE FWAA
+1 19 F
+1 19 THE
+1 19 N-CREE THE
+1 19 THI
+1 19 N-CREE THI
+1 19 THII
+1 19 N-CREE THII
+1 19 THO
+1 22 O
+1 21 A
+1 22 A
+1 21 WAA
+1 19 WEST-CREE THWAA
+1 19 TH
+1 20 THE
+1 22 I
+1 22 O
+1 22 A
+1 22 
+1 20 YE
+1 21 I
+1 21 O
+1 21 A
+1 19 NUNAVIK HE
+1 28 I
+1 29 I
+1 28 O
+1 29 O
+1 28 A
+1 29 A
+1 28 
+1 24 UT H
+1 19 HK
+1 19 QAAI
+1 20 I
+1 21 I
+1 20 O
+1 21 O
+1 20 A
+1 21 A
+1 20 
+1 19 TLHE
+1 22 I
+1 22 O
+1 22 A
+1 19 WEST-CREE RE
+1 30 I
+1 30 O
+1 30 A
+1 19 NGAAI
+1 21 I
+1 22 I
+1 21 O
+1 22 O
+1 21 A
+1 22 A
+1 21 
+1 20 NG
+1 19 SAYISI SHE
+1 28 I
+1 28 O
+1 28 A
+1 19 WOODS-CREE THE
+1 32 I
+1 32 O
+1 32 A
+1 32 
+1 19 LHI
+1 22 I
+1 21 O
+1 22 O
+1 21 A
+1 22 A
+1 21 
+1 19 TH-CREE THE
+1 29 I
+1 30 I
+1 29 O
+1 30 O
+1 29 A
+1 30 A
+1 29 
+1 19 AIVILIK B
+1 19 BLACKFOOT E
+1 29 I
+1 29 O
+1 29 A
+1 29 WE
+1 30 I
+1 30 O
+1 30 A
+1 29 NE
+1 30 I
+1 30 O
+1 30 A
+1 29 KE
+1 30 I
+1 30 O
+1 30 A
+1 19 SAYISI HE
+1 27 I
+1 27 O
+1 27 A
+1 19 CARRIER GHU
+1 29 O
+1 29 E
+1 30 E
+1 29 I
+1 29 A
+1 27 RU
+1 28 O
+1 28 E
+1 29 E
+1 28 I
+1 28 A
+1 27 WU
+1 28 O
+1 28 E
+1 29 E
+1 28 I
+1 28 A
+1 27 HWU
+1 29 O
+1 29 E
+1 30 E
+1 29 I
+1 29 A
+1 27 THU
+1 29 O
+1 29 E
+1 30 E
+1 29 I
+1 29 A
+1 28 TU
+1 29 O
+1 29 E
+1 30 E
+1 29 I
+1 29 A
+1 27 PU
+1 28 O
+1 28 E
+1 29 E
+1 28 I
+1 28 A
+1 28 
+1 27 GU
+1 28 O
+1 28 E
+1 29 E
+1 28 I
+1 28 A
+1 27 KHU
+1 29 O
+1 29 E
+1 30 E
+1 29 I
+1 29 A
+1 28 KU
+1 29 O
+1 29 E
+1 30 E
+1 29 I
+1 29 A
+1 29 
+1 27 NU
+1 28 O
+1 28 E
+1 29 E
+1 28 I
+1 28 A
+1 27 MU
+1 28 O
+1 28 E
+1 29 E
+1 28 I
+1 28 A
+1 27 YU
+1 28 O
+1 28 E
+1 29 E
+1 28 I
+1 28 A
+1 27 JU
+1 19 SAYISI JU
+1 19 CARRIER JO
+1 28 E
+1 29 E
+1 28 I
+1 19 SAYISI JI
+1 19 CARRIER JA
+1 28 JU
+1 29 O
+1 29 E
+1 30 E
+1 29 I
+1 29 A
+1 27 LU
+1 28 O
+1 28 E
+1 29 E
+1 28 I
+1 28 A
+1 27 DLU
+1 29 O
+1 29 E
+1 30 E
+1 29 I
+1 29 A
+1 27 LHU
+1 29 O
+1 29 E
+1 30 E
+1 29 I
+1 29 A
+1 27 TLHU
+1 30 O
+1 30 E
+1 31 E
+1 30 I
+1 30 A
+1 29 U
+1 29 O
+1 29 E
+1 30 E
+1 29 I
+1 29 A
+1 27 ZU
+1 28 O
+1 28 E
+1 29 E
+1 28 I
+1 28 A
+1 28 
+1 27 INITIAL Z
+1 27 DZU
+1 29 O
+1 29 E
+1 30 E
+1 29 I
+1 29 A
+1 27 SU
+1 28 O
+1 28 E
+1 29 E
+1 28 I
+1 28 A
+1 28 HU
+1 29 O
+1 29 E
+1 30 E
+1 29 I
+1 29 A
+1 29 
+1 27 TSU
+1 29 O
+1 29 E
+1 30 E
+1 29 I
+1 29 A
+1 27 CHU
+1 29 O
+1 29 E
+1 30 E
+1 29 I
+1 29 A
+1 27 TTSU
+1 30 O
+1 30 E
+1 31 E
+1 30 I
+1 30 A
+1 20 HI SIGN
+1 19 FULL STOP
+1 19 QAI
+1 19 NGAI
+1 20 NGI
+1 23 I
+1 22 O
+1 23 O
+1 22 A
+1 23 A
+1 19 WOODS-CREE THWEE
+1 33 I
+1 34 I
+1 33 O
+1 34 O
+1 33 A
+1 34 A
+1 30 FINAL TH
+1 19 BLACKFOOT W
+1 0 OGHAM SPACE MARK
+1 6 LETTER BEITH
+1 13 LUIS
+1 13 FEARN
+1 13 SAIL
+1 13 NION
+1 13 UATH
+1 13 DAIR
+1 13 TINNE
+1 13 COLL
+1 14 EIRT
+1 13 MUIN
+1 13 GORT
+1 13 NGEADAL
+1 13 STRAIF
+1 13 RUIS
+1 13 AILM
+1 13 ONN
+1 13 UR
+1 13 EADHADH
+1 13 IODHADH
+1 13 EABHADH
+1 13 OR
+1 13 UILLEANN
+1 13 IFIN
+1 13 EAMHANCHOLL
+1 13 PEITH
+1 6 FEATHER MARK
+1 6 REVERSED FEATHER MARK
+4 0 RUNIC LETTER FEHU FEOH FE F
+1 13 V
+1 13 URUZ UR U
+1 13 YR
+1 14 
+1 13 W
+1 13 THURISAZ THURS THORN
+1 13 ETH
+1 13 ANSUZ A
+1 13 OS O
+1 13 AC A
+1 14 ESC
+1 13 LONG-BRANCH-OSS O
+1 13 SHORT-TWIG-OSS O
+1 13 O
+1 14 E
+1 14 N
+1 13 RAIDO RAD REID R
+1 13 KAUNA
+1 13 CEN
+1 13 KAUN K
+1 13 G
+1 13 ENG
+1 13 GEBO GYFU G
+1 14 AR
+1 13 WUNJO WYNN W
+1 13 HAGLAZ H
+1 15 EGL H
+1 13 LONG-BRANCH-HAGALL H
+1 13 SHORT-TWIG-HAGALL H
+1 13 NAUDIZ NYD NAUD N
+1 13 SHORT-TWIG-NAUD N
+1 13 DOTTED-N
+1 13 ISAZ IS ISS I
+1 13 E
+1 13 JERAN J
+1 13 GER
+1 13 LONG-BRANCH-AR AE
+1 13 SHORT-TWIG-AR A
+1 13 IWAZ EOH
+1 13 PERTHO PEORTH P
+1 13 ALGIZ EOLHX
+1 13 SOWILO S
+1 14 IGEL LONG-BRANCH-SOL S
+1 14 HORT-TWIG-SOL S
+1 13 C
+1 13 Z
+1 13 TIWAZ TIR TYR T
+1 13 SHORT-TWIG-TYR T
+1 13 D
+1 13 BERKANAN BEORC BJARKAN B
+1 13 SHORT-TWIG-BJARKAN B
+1 13 DOTTED-P
+1 13 OPEN-P
+1 13 EHWAZ EH E
+1 13 MANNAZ MAN M
+1 13 LONG-BRANCH-MADR M
+1 13 SHORT-TWIG-MADR M
+1 13 LAUKAZ LAGU LOGR L
+1 13 DOTTED-L
+1 13 INGWAZ
+1 16 
+1 13 DAGAZ DAEG D
+1 13 OTHALAN ETHEL O
+1 13 EAR
+1 13 IOR
+1 13 CWEORTH
+1 14 ALC
+1 14 EALC
+1 13 STAN
+1 13 LONG-BRANCH-YR
+1 13 SHORT-TWIG-YR
+1 13 ICELANDIC-YR
+1 13 Q
+1 13 X
+1 6 SINGLE PUNCTUATION
+1 6 MULTIPLE PUNCTUATION
+1 6 CROSS PUNCTUATION
+1 6 ARLAUG SYMBOL
+1 6 TVIMADUR SYMBOL
+1 6 BELGTHOR SYMBOL
+1 6 LETTER K
+1 13 SH
+1 13 OO
+1 13 FRANKS CASKET OS
+1 27 IS
+1 27 EH
+1 27 AC
+1 28 ESC
+8 0 TAGALOG LETTER A
+1 15 I
+1 15 U
+1 15 KA
+1 15 GA
+1 15 NGA
+1 15 TA
+1 15 DA
+1 15 NA
+1 15 PA
+1 15 BA
+1 15 MA
+1 15 YA
+1 15 RA
+1 15 LA
+1 15 WA
+1 15 SA
+1 15 HA
+1 8 VOWEL SIGN I
+1 19 U
+1 8 SIGN VIRAMA
+1 13 PAMUDPOD
+a 8 LETTER ARCHAIC RA
+1 0 HANUNOO LETTER A
+1 15 I
+1 15 U
+1 15 KA
+1 15 GA
+1 15 NGA
+1 15 TA
+1 15 DA
+1 15 NA
+1 15 PA
+1 15 BA
+1 15 MA
+1 15 YA
+1 15 RA
+1 15 LA
+1 15 WA
+1 15 SA
+1 15 HA
+1 8 VOWEL SIGN I
+1 19 U
+1 8 SIGN PAMUDPOD
+1 0 PHILIPPINE SINGLE PUNCTUATION
+1 11 DOUBLE PUNCTUATION
+a 0 BUHID LETTER A
+1 13 I
+1 13 U
+1 13 KA
+1 13 GA
+1 13 NGA
+1 13 TA
+1 13 DA
+1 13 NA
+1 13 PA
+1 13 BA
+1 13 MA
+1 13 YA
+1 13 RA
+1 13 LA
+1 13 WA
+1 13 SA
+1 13 HA
+1 6 VOWEL SIGN I
+1 17 U
+d 0 TAGBANWA LETTER A
+1 16 I
+1 16 U
+1 16 KA
+1 16 GA
+1 16 NGA
+1 16 TA
+1 16 DA
+1 16 NA
+1 16 PA
+1 16 BA
+1 16 MA
+1 16 YA
+2 16 LA
+1 16 WA
+1 16 SA
+2 9 VOWEL SIGN I
+1 20 U
+d 0 KHMER LETTER KA
+1 14 HA
+1 14 O
+1 14 HO
+1 13 NGO
+1 13 CA
+1 14 HA
+1 14 O
+1 14 HO
+1 13 NYO
+1 13 DA
+1 13 TTHA
+1 13 DO
+1 13 TTHO
+1 13 NNO
+1 13 TA
+1 14 HA
+1 14 O
+1 14 HO
+1 13 NO
+1 13 BA
+1 13 PHA
+1 14 O
+1 14 HO
+1 13 MO
+1 13 YO
+1 13 RO
+1 13 LO
+1 13 VO
+1 13 SHA
+1 14 SO
+1 14 A
+1 13 HA
+1 13 LA
+1 13 QA
+1 6 INDEPENDENT VOWEL QAQ
+1 26 A
+1 25 I
+1 26 I
+1 25 U
+1 26 K
+1 26 U
+1 27 V
+1 24 RY
+1 26 Y
+1 24 LY
+1 26 Y
+1 24 QE
+1 25 AI
+1 25 OO TYPE ONE
+1 33 TWO
+1 25 AU
+1 6 VOWEL INHERENT AQ
+1 22 A
+1 12 SIGN AA
+1 17 I
+1 18 I
+1 17 Y
+1 18 Y
+1 17 U
+1 18 U
+1 18 A
+1 17 OE
+1 17 YA
+1 17 IE
+1 17 E
+1 17 AE
+1 18 I
+1 17 OO
+1 17 AU
+1 6 SIGN NIKAHIT
+1 11 REAHMUK
+1 11 YUUKALEAPINTU
+1 11 MUUSIKATOAN
+1 11 TRIISAP
+1 11 BANTOC
+1 11 ROBAT
+1 11 TOANDAKHIAT
+1 11 KAKABAT
+1 11 AHSDA
+1 11 SAMYOK SANNYA
+1 11 VIRIAM
+1 11 COENG
+1 11 BATHAMASAT
+1 11 KHAN
+1 11 BARIYOOSAN
+1 11 CAMNUC PII KUUH
+1 11 LEK TOO
+1 11 BEYYAL
+1 11 PHNAEK MUAN
+1 11 KOOMUUT
+1 6 CURRENCY SYMBOL RIEL
+1 6 SIGN AVAKRAHASANYA
+1 12 TTHACAN
+3 6 DIGIT ZERO
+1 12 ONE
+1 12 TWO
+1 13 HREE
+1 12 FOUR
+1 13 IVE
+1 12 SIX
+1 13 EVEN
+1 12 EIGHT
+1 12 NINE
+7 6 SYMBOL LEK ATTAK SON
+1 23 MUOY
+1 23 PII
+1 23 BEI
+1 24 UON
+1 23 PRAM
+1 27 -MUOY
+1 28 PII
+1 28 BEI
+1 29 UON
+7 0 MONGOLIAN BIRGA
+1 10 ELLIPSIS
+1 10 COMMA
+1 10 FULL STOP
+1 10 COLON
+1 10 FOUR DOTS
+1 10 TODO SOFT HYPHEN
+1 10 SIBE SYLLABLE BOUNDARY MARKER
+1 10 MANCHU COMMA
+1 17 FULL STOP
+1 10 NIRUGU
+1 10 FREE VARIATION SELECTOR ONE
+1 34 TWO
+1 35 HREE
+1 10 VOWEL SEPARATOR
+1 10 FREE VARIATION SELECTOR FOUR
+1 10 DIGIT ZERO
+1 16 ONE
+1 16 TWO
+1 17 HREE
+1 16 FOUR
+1 17 IVE
+1 16 SIX
+1 17 EVEN
+1 16 EIGHT
+1 16 NINE
+7 10 LETTER A
+1 17 E
+1 17 I
+1 17 O
+1 17 U
+1 17 OE
+1 17 UE
+1 17 EE
+1 17 NA
+1 17 ANG
+1 17 BA
+1 17 PA
+1 17 QA
+1 17 GA
+1 17 MA
+1 17 LA
+1 17 SA
+1 18 HA
+1 17 TA
+1 17 DA
+1 17 CHA
+1 17 JA
+1 17 YA
+1 17 RA
+1 17 WA
+1 17 FA
+1 17 KA
+1 18 HA
+1 17 TSA
+1 17 ZA
+1 17 HAA
+1 17 ZRA
+1 17 LHA
+1 17 ZHI
+1 17 CHI
+1 17 TODO LONG VOWEL SIGN
+1 22 E
+1 22 I
+1 22 O
+1 22 U
+1 22 OE
+1 22 UE
+1 22 ANG
+1 22 BA
+1 22 PA
+1 22 QA
+1 22 GA
+1 22 MA
+1 22 TA
+1 22 DA
+1 22 CHA
+1 22 JA
+1 22 TSA
+1 22 YA
+1 22 WA
+1 22 KA
+1 22 GAA
+1 22 HAA
+1 22 JIA
+1 22 NIA
+1 22 DZA
+1 17 SIBE E
+1 22 I
+1 23 Y
+1 22 UE
+1 23 
+1 22 ANG
+1 22 KA
+1 22 GA
+1 22 HA
+1 22 PA
+1 22 SHA
+1 22 TA
+1 22 DA
+1 22 JA
+1 22 FA
+1 22 GAA
+1 22 HAA
+1 22 TSA
+1 22 ZA
+1 22 RAA
+1 22 CHA
+1 22 ZHA
+1 17 MANCHU I
+1 24 KA
+1 24 RA
+1 24 FA
+1 24 ZHA
+1 17 CHA WITH TWO DOTS
+8 17 ALI GALI ANUSVARA ONE
+1 26 VISARGA ONE
+1 26 DAMARU
+1 26 UBADAMA
+1 26 INVERTED UBADAMA
+1 26 BALUDA
+1 26 THREE BALUDA
+1 26 A
+1 26 I
+1 26 KA
+1 26 NGA
+1 26 CA
+1 26 TTA
+1 28 HA
+1 26 DDA
+1 26 NNA
+1 26 TA
+1 26 DA
+1 26 PA
+1 27 HA
+1 26 SSA
+1 26 ZHA
+1 27 A
+1 26 AH
+1 17 TODO ALI GALI TA
+1 31 ZHA
+1 17 MANCHU ALI GALI GHA
+1 33 NGA
+1 33 CA
+1 33 JHA
+1 33 TTA
+1 33 DDHA
+1 33 TA
+1 33 DHA
+1 33 SSA
+1 33 CYA
+1 33 ZHA
+1 34 A
+1 17 ALI GALI HALF U
+1 31 YA
+1 17 MANCHU ALI GALI BHA
+1 17 ALI GALI DAGALGA
+1 17 MANCHU ALI GALI LHA
+6 0 CANADIAN SYLLABICS OY
+1 19 AY
+1 20 AY
+1 19 WAY
+1 19 POY
+1 20 AY
+1 20 WOY
+1 19 TAY
+1 19 KAY
+1 20 WAY
+1 19 MAY
+1 19 NOY
+1 20 AY
+1 19 LAY
+1 19 SOY
+1 20 AY
+1 20 HOY
+1 21 AY
+1 21 WOY
+1 19 YOY
+1 20 AY
+1 19 RAY
+1 19 NWI
+1 19 OJIBWAY NWI
+1 19 NWII
+1 19 OJIBWAY NWII
+1 19 NWO
+1 19 OJIBWAY NWO
+1 19 NWOO
+1 19 OJIBWAY NWOO
+1 19 RWEE
+1 21 I
+1 22 I
+1 21 O
+1 22 O
+1 21 A
+1 19 OJIBWAY P
+1 27 T
+1 27 K
+1 27 C
+1 27 M
+1 27 N
+1 27 S
+1 28 H
+1 19 EASTERN W
+1 19 WESTERN W
+1 19 FINAL SMALL RING
+1 25 RAISED DOT
+1 19 R-CREE RWE
+1 19 WEST-CREE LOO
+1 30 AA
+1 19 THWE
+1 22 A
+1 20 THWE
+1 22 OO
+1 22 AA
+1 20 LHWE
+1 22 OO
+1 19 SAYISI SHWE
+1 28 OO
+1 26 HOO
+1 19 CARRIER GWU
+1 27 DENE GEE
+1 27 GAA
+1 28 WA
+1 19 SAYISI JUU
+1 19 CARRIER JWA
+1 19 BEAVER DENE L
+1 31 R
+1 19 CARRIER DENTAL S
+b 0 LIMBU VOWEL-CARRIER LETTER
+1 6 LETTER KA
+1 14 HA
+1 13 GA
+1 14 HA
+1 13 NGA
+1 13 CA
+1 14 HA
+1 13 JA
+1 14 HA
+1 13 YAN
+1 13 TA
+1 14 HA
+1 13 DA
+1 14 HA
+1 13 NA
+1 13 PA
+1 14 HA
+1 13 BA
+1 14 HA
+1 13 MA
+1 13 YA
+1 13 RA
+1 13 LA
+1 13 WA
+1 13 SHA
+1 14 SA
+1 14 A
+1 13 HA
+1 13 GYAN
+1 13 TRA
+2 6 VOWEL SIGN A
+1 17 I
+1 17 U
+1 17 EE
+1 17 AI
+1 17 OO
+1 17 AU
+1 17 E
+1 17 O
+1 6 SUBJOINED LETTER YA
+1 23 RA
+1 23 WA
+5 7 MALL LETTER KA
+1 19 NGA
+1 19 ANUSVARA
+1 19 TA
+1 19 NA
+1 19 PA
+1 19 MA
+1 19 RA
+1 19 LA
+1 7 IGN MUKPHRENG
+1 11 KEMPHRENG
+1 11 SA-I
+5 11 LOO
+4 6 EXCLAMATION MARK
+1 6 QUESTION MARK
+1 6 DIGIT ZERO
+1 12 ONE
+1 12 TWO
+1 13 HREE
+1 12 FOUR
+1 13 IVE
+1 12 SIX
+1 13 EVEN
+1 12 EIGHT
+1 12 NINE
+1 0 TAI LE LETTER KA
+1 14 XA
+1 14 NGA
+1 14 TSA
+1 14 SA
+1 14 YA
+1 14 TA
+1 15 HA
+1 14 LA
+1 14 PA
+1 15 HA
+1 14 MA
+1 14 FA
+1 14 VA
+1 14 HA
+1 14 QA
+1 14 KHA
+1 14 TSHA
+1 14 NA
+1 14 A
+1 14 I
+1 14 EE
+1 15 H
+1 14 U
+1 14 OO
+1 15 
+1 14 UE
+1 14 E
+1 14 AUE
+1 15 I
+3 14 TONE-2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+c 0 NEW TAI LUE LETTER HIGH QA
+1 19 LOW QA
+1 19 HIGH KA
+1 24 XA
+1 24 NGA
+1 19 LOW KA
+1 23 XA
+1 23 NGA
+1 19 HIGH TSA
+1 24 SA
+1 24 YA
+1 19 LOW TSA
+1 23 SA
+1 23 YA
+1 19 HIGH TA
+1 25 HA
+1 24 NA
+1 19 LOW TA
+1 24 HA
+1 23 NA
+1 19 HIGH PA
+1 25 HA
+1 24 MA
+1 19 LOW PA
+1 24 HA
+1 23 MA
+1 19 HIGH FA
+1 24 VA
+1 24 LA
+1 19 LOW FA
+1 23 VA
+1 23 LA
+1 19 HIGH HA
+1 24 DA
+1 24 BA
+1 19 LOW HA
+1 23 DA
+1 23 BA
+1 19 HIGH KVA
+1 24 XVA
+1 19 LOW KVA
+1 23 XVA
+1 19 HIGH SUA
+1 19 LOW SUA
+5 12 VOWEL SIGN VOWEL SHORTENER
+1 23 AA
+1 23 II
+1 23 U
+1 24 U
+1 23 E
+1 23 AE
+1 23 O
+1 24 A
+1 23 UE
+1 23 AY
+1 24 AY
+1 23 UY
+1 23 OY
+1 24 AY
+1 23 UEY
+1 23 IY
+1 12 LETTER FINAL V
+1 25 NG
+1 26 
+1 25 M
+1 25 K
+1 25 D
+1 25 B
+1 12 TONE MARK-1
+1 22 2
+7 12 DIGIT ZERO
+1 18 ONE
+1 18 TWO
+1 19 HREE
+1 18 FOUR
+1 19 IVE
+1 18 SIX
+1 19 EVEN
+1 18 EIGHT
+1 18 NINE
+1 12 THAM DIGIT ONE
+4 12 SIGN LAE
+1 20 V
+1 0 KHMER SYMBOL PATHAMASAT
+1 13 MUOY KOET
+1 13 PII KOET
+1 13 BEI KOET
+1 14 UON KOET
+1 13 PRAM KOET
+1 17 -MUOY KOET
+1 18 PII KOET
+1 18 BEI KOET
+1 19 UON KOET
+1 13 DAP KOET
+1 16 -MUOY KOET
+1 17 PII KOET
+1 17 BEI KOET
+1 18 UON KOET
+1 17 PRAM KOET
+1 13 TUTEYASAT
+1 13 MUOY ROC
+1 13 PII ROC
+1 13 BEI ROC
+1 14 UON ROC
+1 13 PRAM ROC
+1 17 -MUOY ROC
+1 18 PII ROC
+1 18 BEI ROC
+1 19 UON ROC
+1 13 DAP ROC
+1 16 -MUOY ROC
+1 17 PII ROC
+1 17 BEI ROC
+1 18 UON ROC
+1 17 PRAM ROC
+1 0 BUGINESE LETTER KA
+1 16 GA
+1 16 NGA
+1 18 KA
+1 16 PA
+1 16 BA
+1 16 MA
+1 17 PA
+1 16 TA
+1 16 DA
+1 16 NA
+1 17 RA
+1 16 CA
+1 16 JA
+1 16 NYA
+1 18 CA
+1 16 YA
+1 16 RA
+1 16 LA
+1 16 VA
+1 16 SA
+1 16 A
+1 16 HA
+1 9 VOWEL SIGN I
+1 20 U
+1 20 E
+1 20 O
+1 20 AE
+3 9 PALLAWA
+1 9 END OF SECTION
+1 0 TAI THAM LETTER HIGH KA
+1 22 HA
+1 22 XA
+1 16 LOW KA
+1 21 XA
+1 21 HA
+1 16 NGA
+1 16 HIGH CA
+1 22 HA
+1 16 LOW CA
+1 20 SA
+1 20 CHA
+1 16 NYA
+1 16 RATA
+1 16 HIGH RATHA
+1 16 DA
+1 16 LOW RATHA
+1 16 RANA
+1 16 HIGH TA
+1 22 HA
+1 16 LOW TA
+1 21 HA
+1 16 NA
+1 16 BA
+1 16 HIGH PA
+1 22 HA
+1 21 FA
+1 16 LOW PA
+1 20 FA
+1 20 PHA
+1 16 MA
+1 16 LOW YA
+1 16 HIGH YA
+1 16 RA
+1 17 UE
+1 16 LA
+1 17 UE
+1 16 WA
+1 16 HIGH SHA
+1 22 SA
+1 22 A
+1 21 HA
+1 16 LLA
+1 16 A
+1 16 LOW HA
+1 16 I
+1 17 I
+1 16 U
+1 17 U
+1 16 EE
+1 16 OO
+1 16 LAE
+1 16 GREAT SA
+1 9 CONSONANT SIGN MEDIAL RA
+1 31 LA
+1 24 LA TANG LAI
+1 9 SIGN MAI KANG LAI
+1 9 CONSONANT SIGN FINAL NGA
+1 24 LOW PA
+1 24 HIGH RATHA OR LOW PA
+1 24 MA
+1 24 BA
+1 24 SA
+2 9 SIGN SAKOT
+1 9 VOWEL SIGN A
+1 20 MAI SAT
+1 20 AA
+1 20 TALL AA
+1 20 I
+1 21 I
+1 20 UE
+1 21 UE
+1 21 
+1 21 U
+1 20 O
+1 21 A BELOW
+1 21 Y
+1 20 E
+1 20 AE
+1 20 OO
+1 20 AI
+1 20 THAM AI
+1 20 OA ABOVE
+1 9 SIGN MAI KANG
+1 14 TONE-1
+1 19 2
+1 14 KHUEN TONE-3
+1 25 4
+1 25 5
+1 14 RA HAAM
+1 14 MAI SAM
+1 14 KHUEN-LUE KARAN
+3 9 COMBINING CRYPTOGRAMMIC DOT
+1 9 HORA DIGIT ZERO
+1 20 ONE
+1 20 TWO
+1 21 HREE
+1 20 FOUR
+1 21 IVE
+1 20 SIX
+1 21 EVEN
+1 20 EIGHT
+1 20 NINE
+7 9 THAM DIGIT ZERO
+1 20 ONE
+1 20 TWO
+1 21 HREE
+1 20 FOUR
+1 21 IVE
+1 20 SIX
+1 21 EVEN
+1 20 EIGHT
+1 20 NINE
+7 9 SIGN WIANG
+1 19 WAAK
+1 14 SAWAN
+1 14 KEOW
+1 14 HOY
+1 14 DOKMAI
+1 14 REVERSED ROTATED RANA
+1 14 MAI YAMOK
+1 14 KAAN
+1 18 KUU
+1 14 SATKAAN
+1 21 KUU
+1 14 HANG
+1 14 CAANG
+3 0 COMBINING DOUBLED CIRCUMFLEX ACCENT
+1 11 IAERESIS-RING
+1 10 INFINITY
+1 10 DOWNWARDS ARROW
+1 10 TRIPLE DOT
+1 10 X-X BELOW
+1 10 WIGGLY LINE BELOW
+1 10 OPEN MARK BELOW
+1 10 DOUBLE OPEN MARK BELOW
+1 10 LIGHT CENTRALIZATION STROKE BELOW
+1 10 STRONG CENTRALIZATION STROKE BELOW
+1 10 PARENTHESES ABOVE
+1 10 DOUBLE PARENTHESES ABOVE
+1 10 PARENTHESES BELOW
+1 22 OVERLAY
+1 10 LATIN SMALL LETTER W BELOW
+1 29 TURNED W BELOW
+1 11 EFT PARENTHESIS ABOVE LEFT
+1 10 RIGHT PARENTHESIS ABOVE RIGHT
+1 10 LEFT PARENTHESIS BELOW LEFT
+1 10 RIGHT PARENTHESIS BELOW RIGHT
+1 10 SQUARE BRACKETS ABOVE
+1 10 NUMBER SIGN ABOVE
+1 10 INVERTED DOUBLE ARCH ABOVE
+1 10 PLUS SIGN ABOVE
+1 10 DOUBLE PLUS SIGN ABOVE
+1 27 BELOW
+1 10 TRIPLE ACUTE ACCENT
+1 10 LATIN SMALL LETTER INSULAR G
+1 37 R
+1 37 T
+32 0 BALINESE SIGN ULU RICEM
+1 18 CANDRA
+1 14 CECEK
+1 14 SURANG
+1 14 BISAH
+1 9 LETTER AKARA
+1 21  TEDUNG
+1 16 IKARA
+1 21  TEDUNG
+1 16 UKARA
+1 21  TEDUNG
+1 16 RA REPA
+1 23  TEDUNG
+1 16 LA LENGA
+1 24  TEDUNG
+1 16 EKARA
+1 16 AIKARA
+1 16 OKARA
+1 21  TEDUNG
+1 16 KA
+1 18  MAHAPRANA
+1 16 GA
+1 18  GORA
+1 16 NGA
+1 16 CA
+1 18  LACA
+1 16 JA
+1 18  JERA
+1 16 NYA
+1 16 TA LATIK
+1 19 MURDA MAHAPRANA
+1 16 DA MURDA ALPAPRANA
+1 25 MAHAPRANA
+1 16 NA RAMBAT
+1 16 TA
+1 18  TAWA
+1 16 DA
+1 18  MADU
+1 16 NA
+1 16 PA
+1 18  KAPAL
+1 16 BA
+1 18  KEMBANG
+1 16 MA
+1 16 YA
+1 16 RA
+1 16 LA
+1 16 WA
+1 16 SA SAGA
+1 21 PA
+1 18 
+1 16 HA
+1 9 SIGN REREKAN
+1 9 VOWEL SIGN TEDUNG
+1 20 ULU
+1 23  SARI
+1 20 SUKU
+1 24  ILUT
+1 20 RA REPA
+1 27  TEDUNG
+1 20 LA LENGA
+1 28  TEDUNG
+1 20 TALING
+1 26  REPA
+1 27 TEDUNG
+1 27 REPA TEDUNG
+1 20 PEPET
+1 25  TEDUNG
+1 9 ADEG ADEG
+1 9 LETTER KAF SASAK
+1 17 HOT SASAK
+1 16 TZIR SASAK
+1 16 EF SASAK
+1 16 VE SASAK
+1 16 ZAL SASAK
+1 16 ASYURA SASAK
+1 17 RCHAIC JNYA
+4 9 DIGIT ZERO
+1 15 ONE
+1 15 TWO
+1 16 HREE
+1 15 FOUR
+1 16 IVE
+1 15 SIX
+1 16 EVEN
+1 15 EIGHT
+1 15 NINE
+1 9 PANTI
+1 11 MADA
+1 9 WINDU
+1 9 CARIK PAMUNGKAH
+1 15 SIKI
+1 15 PAREREN
+1 9 PAMENENG
+1 9 MUSICAL SYMBOL DONG
+1 25 ENG
+1 25 UNG
+1 25 ANG
+1 28  SURANG
+1 25 ING
+1 25 AENG
+1 25 EUNG
+1 25 AING
+1 26 NG GEDE
+1 24 COMBINING TEGEH
+1 34 ENDEP
+1 34 KEMPUL
+1 38 LI
+1 34 JEGOGAN
+1 34 KEMPUL WITH JEGOGAN
+1 38 LI WITH JEGOGAN
+1 34 BENDE
+1 34 GONG
+1 24 RIGHT-HAND OPEN DUG
+1 41 AG
+1 35 CLOSED TUK
+1 43 AK
+1 24 LEFT-HAND OPEN PANG
+1 40 UNG
+1 34 CLOSED PLAK
+1 43 UK
+1 34 OPEN PING
+1 9 PANTI LANTANG
+1 11 MADA LANTANG
+2 0 SUNDANESE SIGN PANYECEK
+1 18 GLAYAR
+1 19 WISAD
+1 10 LETTER A
+1 17 I
+1 17 U
+1 17 AE
+1 17 O
+1 17 E
+1 18 U
+1 17 KA
+1 17 QA
+1 17 GA
+1 17 NGA
+1 17 CA
+1 17 JA
+1 17 ZA
+1 17 NYA
+1 17 TA
+1 17 DA
+1 17 NA
+1 17 PA
+1 17 FA
+1 17 VA
+1 17 BA
+1 17 MA
+1 17 YA
+1 17 RA
+1 17 LA
+1 17 WA
+1 17 SA
+1 17 XA
+1 17 HA
+1 10 CONSONANT SIGN PAMINGKAL
+1 27 NYAKRA
+1 29 IKU
+1 10 VOWEL SIGN PANGHULU
+1 24 YUKU
+1 24 AELAENG
+1 24 OLONG
+1 23 MEPET
+1 23 NEULEUNG
+1 10 SIGN PAMAAEH
+1 15 VIRAMA
+1 10 CONSONANT SIGN PASANGAN MA
+1 34 WA
+1 10 LETTER KHA
+1 17 SYA
+1 10 DIGIT ZERO
+1 16 ONE
+1 16 TWO
+1 17 HREE
+1 16 FOUR
+1 17 IVE
+1 16 SIX
+1 17 EVEN
+1 16 EIGHT
+1 16 NINE
+1 10 AVAGRAHA
+1 10 LETTER REU
+1 17 LEU
+1 17 BHA
+1 17 FINAL K
+1 23 M
+1 0 BATAK LETTER A
+1 13 SIMALUNGUN A
+1 13 HA
+1 13 SIMALUNGUN HA
+1 13 MANDAILING HA
+1 13 BA
+1 13 KARO BA
+1 13 PA
+1 13 SIMALUNGUN PA
+1 13 NA
+1 13 MANDAILING NA
+1 13 WA
+1 13 SIMALUNGUN WA
+1 13 PAKPAK WA
+1 13 GA
+1 13 SIMALUNGUN GA
+1 13 JA
+1 13 DA
+1 13 RA
+1 13 SIMALUNGUN RA
+1 13 MA
+1 13 SIMALUNGUN MA
+1 14 OUTHERN TA
+1 13 NORTHERN TA
+1 13 SA
+1 14 IMALUNGUN SA
+1 13 MANDAILING SA
+1 13 YA
+1 13 SIMALUNGUN YA
+1 13 NGA
+1 13 LA
+1 13 SIMALUNGUN LA
+1 13 NYA
+1 13 CA
+1 13 NDA
+1 13 MBA
+1 13 I
+1 13 U
+1 6 SIGN TOMPI
+1 6 VOWEL SIGN E
+1 17 PAKPAK E
+1 17 EE
+1 17 I
+1 17 KARO I
+1 17 O
+1 17 KARO O
+1 17 U
+1 18  FOR SIMALUNGUN SA
+1 6 CONSONANT SIGN NG
+1 21 H
+1 6 PANGOLAT
+1 9 ONGONAN
+9 6 SYMBOL BINDU NA METEK
+1 19 PINARBORAS
+1 19 JUDUL
+1 19 PANGOLAT
+1 0 LEPCHA LETTER KA
+1 15 LA
+1 15 HA
+1 14 GA
+1 15 LA
+1 14 NGA
+1 14 CA
+1 15 HA
+1 14 JA
+1 14 NYA
+1 14 TA
+1 15 HA
+1 14 DA
+1 14 NA
+1 14 PA
+1 15 LA
+1 15 HA
+1 14 FA
+1 15 LA
+1 14 BA
+1 15 LA
+1 14 MA
+1 15 LA
+1 14 TSA
+1 16 HA
+1 14 DZA
+1 14 YA
+1 14 RA
+1 14 LA
+1 14 HA
+1 15 LA
+1 14 VA
+1 14 SA
+1 15 HA
+1 14 WA
+1 14 A
+1 7 SUBJOINED LETTER YA
+1 24 RA
+1 7 VOWEL SIGN AA
+1 18 I
+1 18 O
+1 19 O
+1 18 U
+1 19 U
+1 18 E
+1 7 CONSONANT SIGN K
+1 22 M
+1 22 L
+1 22 N
+1 22 P
+1 22 R
+1 22 T
+1 22 NYIN-DO
+1 22 KANG
+1 7 SIGN RAN
+1 12 NUKTA
+4 7 PUNCTUATION TA-ROL
+1 19 NYET THYOOM TA-ROL
+1 19 CER-WA
+1 19 TSHOOK CER-WA
+1 25 
+1 7 DIGIT ZERO
+1 13 ONE
+1 13 TWO
+1 14 HREE
+1 13 FOUR
+1 14 IVE
+1 13 SIX
+1 14 EVEN
+1 13 EIGHT
+1 13 NINE
+4 7 LETTER TTA
+1 16 HA
+1 14 DDA
+1 0 OL CHIKI DIGIT ZERO
+1 15 ONE
+1 15 TWO
+1 16 HREE
+1 15 FOUR
+1 16 IVE
+1 15 SIX
+1 16 EVEN
+1 15 EIGHT
+1 15 NINE
+1 9 LETTER LA
+1 16 AT
+1 17 G
+1 17 NG
+1 17 L
+1 16 LAA
+1 16 AAK
+1 18 J
+1 18 M
+1 18 W
+1 16 LI
+1 16 IS
+1 17 H
+1 17 NY
+1 17 R
+1 16 LU
+1 16 UC
+1 17 D
+1 17 NN
+1 17 Y
+1 16 LE
+1 16 EP
+1 17 DD
+1 17 N
+1 17 RR
+1 16 LO
+1 16 OTT
+1 17 B
+1 17 V
+1 17 H
+1 9 MU TTUDDAG
+1 9 GAAHLAA TTUDDAAG
+1 9 MU-GAAHLAA TTUDDAAG
+1 9 RELAA
+1 9 PHAARKAA
+1 9 AHAD
+1 9 PUNCTUATION MUCAAD
+1 21 DOUBLE MUCAAD
+1 0 CYRILLIC SMALL LETTER ROUNDED VE
+1 22 LONG-LEGGED DE
+1 22 NARROW O
+1 22 WIDE ES
+1 22 TALL TE
+1 23 HREE-LEGGED TE
+1 23 ALL HARD SIGN
+1 27 YAT
+1 22 UNBLENDED UK
+8 0 GEORGIAN MTAVRULI CAPITAL LETTER AN
+1 33 BAN
+1 33 GAN
+1 33 DON
+1 33 EN
+1 33 VIN
+1 33 ZEN
+1 33 TAN
+1 33 IN
+1 33 KAN
+1 33 LAS
+1 33 MAN
+1 33 NAR
+1 33 ON
+1 33 PAR
+1 33 ZHAR
+1 33 RAE
+1 33 SAN
+1 33 TAR
+1 33 UN
+1 33 PHAR
+1 33 KHAR
+1 33 GHAN
+1 33 QAR
+1 33 SHIN
+1 33 CHIN
+1 34 AN
+1 33 JIL
+1 33 CIL
+1 34 HAR
+1 33 XAN
+1 33 JHAN
+1 33 HAE
+1 34 E
+1 34 IE
+1 33 WE
+1 33 HAR
+1 34 OE
+1 33 FI
+1 33 YN
+1 33 ELIFI
+1 33 TURNED GAN
+1 33 AIN
+3 34 EN
+1 33 HARD SIGN
+1 33 LABIAL SIGN
+1 0 SUNDANESE PUNCTUATION BINDU SURYA
+1 28 PANGLONG
+1 29 URNAMA
+1 28 CAKRA
+1 28 LEU SATANGA
+1 28 KA SATANGA
+1 28 DA SATANGA
+1 28 BA SATANGA
+9 0 VEDIC TONE KARSHANA
+1 11 SHARA
+1 11 PRENKHA
+1 6 SIGN NIHSHVASA
+1 11 YAJURVEDIC MIDLINE SVARITA
+1 6 TONE YAJURVEDIC AGGRAVATED INDEPENDENT SVARITA
+1 22 INDEPENDENT SVARITA
+1 22 KATHAKA INDEPENDENT SVARITA
+1 11 CANDRA BELOW
+1 11 YAJURVEDIC KATHAKA INDEPENDENT SVARITA SCHROEDER
+1 11 DOUBLE SVARITA
+1 11 TRIPLE SVARITA
+1 11 KATHAKA ANUDATTA
+1 11 DOT BELOW
+1 11 TWO DOTS BELOW
+1 12 HREE DOTS BELOW
+1 11 RIGVEDIC KASHMIRI INDEPENDENT SVARITA
+1 11 ATHARVAVEDIC INDEPENDENT SVARITA
+1 6 SIGN VISARGA SVARITA
+1 19 UDATTA
+1 11 REVERSED VISARGA UDATTA
+1 11 VISARGA ANUDATTA
+1 11 REVERSED VISARGA ANUDATTA
+1 11 VISARGA UDATTA WITH TAIL
+1 19 ANUDATTA WITH TAIL
+1 11 ANUSVARA ANTARGOMUKHA
+1 20 BAHIRGOMUKHA
+1 20 VAMAGOMUKHA
+1 31  WITH TAIL
+1 11 TIRYAK
+1 11 HEXIFORM LONG ANUSVARA
+1 11 LONG ANUSVARA
+1 11 RTHANG LONG ANUSVARA
+1 11 ANUSVARA UBHAYATO MUKHA
+1 12 RDHAVISARGA
+1 11 ROTATED ARDHAVISARGA
+1 6 TONE CANDRA ABOVE
+1 6 SIGN JIHVAMULIYA
+1 11 UPADHMANIYA
+1 11 ATIKRAMA
+1 6 TONE RING ABOVE
+1 11 DOUBLE RING ABOVE
+1 6 SIGN DOUBLE ANUSVARA ANTARGOMUKHA
+6 0 LATIN LETTER SMALL CAPITAL A
+1 28 E
+1 6 SMALL LETTER TURNED AE
+1 6 LETTER SMALL CAPITAL BARRED B
+1 27 C
+1 27 D
+1 27 ETH
+1 28 
+1 6 SMALL LETTER TURNED OPEN E
+1 26 I
+1 6 LETTER SMALL CAPITAL J
+1 27 K
+1 27 L WITH STROKE
+1 27 M
+1 27 REVERSED N
+1 27 O
+1 28 PEN O
+1 6 SMALL LETTER SIDEWAYS O
+1 29 PEN O
+1 29  WITH STROKE
+1 19 TURNED OE
+1 6 LETTER SMALL CAPITAL OU
+1 6 SMALL LETTER TOP HALF O
+1 19 BOTTOM HALF O
+1 6 LETTER SMALL CAPITAL P
+1 27 REVERSED R
+1 27 TURNED R
+1 28 
+1 27 U
+1 6 SMALL LETTER SIDEWAYS U
+1 28 DIAERESIZED U
+1 28 TURNED M
+1 6 LETTER SMALL CAPITAL V
+1 27 W
+1 27 Z
+1 27 EZH
+1 13 VOICED LARYNGEAL SPIRANT
+1 13 AIN
+1 0 GREEK LETTER SMALL CAPITAL GAMMA
+1 27 LAMDA
+1 27 PI
+1 27 RHO
+1 27 PSI
+1 0 CYRILLIC LETTER SMALL CAPITAL EL
+1 0 MODIFIER LETTER CAPITAL A
+1 25 E
+1 24 B
+1 25 ARRED B
+1 24 D
+1 24 E
+1 24 REVERSED E
+1 24 G
+1 24 H
+1 24 I
+1 24 J
+1 24 K
+1 24 L
+1 24 M
+1 24 N
+1 24 REVERSED N
+1 24 O
+1 25 U
+1 24 P
+1 24 R
+1 24 T
+1 24 U
+1 24 W
+1 16 SMALL A
+1 22 TURNED A
+1 22 ALPHA
+1 22 TURNED AE
+1 22 B
+1 22 D
+1 22 E
+1 22 SCHWA
+1 22 OPEN E
+1 22 TURNED OPEN E
+1 22 G
+1 22 TURNED I
+1 22 K
+1 22 M
+1 22 ENG
+1 22 O
+1 23 PEN O
+1 22 TOP HALF O
+1 22 BOTTOM HALF O
+1 22 P
+1 22 T
+1 22 U
+1 22 SIDEWAYS U
+1 22 TURNED M
+1 22 V
+1 22 AIN
+1 22 BETA
+1 22 GREEK GAMMA
+1 22 DELTA
+1 22 GREEK PHI
+1 22 CHI
+1 0 LATIN SUBSCRIPT SMALL LETTER I
+1 29 R
+1 29 U
+1 29 V
+1 0 GREEK SUBSCRIPT SMALL LETTER BETA
+1 29 GAMMA
+1 29 RHO
+1 29 PHI
+1 29 CHI
+1 0 LATIN SMALL LETTER UE
+1 19 B WITH MIDDLE TILDE
+1 19 D WITH MIDDLE TILDE
+1 19 F WITH MIDDLE TILDE
+1 19 M WITH MIDDLE TILDE
+1 19 N WITH MIDDLE TILDE
+1 19 P WITH MIDDLE TILDE
+1 19 R WITH MIDDLE TILDE
+1 26 FISHHOOK AND MIDDLE TILDE
+1 19 S WITH MIDDLE TILDE
+1 19 T WITH MIDDLE TILDE
+1 19 Z WITH MIDDLE TILDE
+1 19 TURNED G
+1 0 MODIFIER LETTER CYRILLIC EN
+1 0 LATIN SMALL LETTER INSULAR G
+1 19 TH WITH STRIKETHROUGH
+1 12 CAPITAL LETTER I WITH STROKE
+1 12 LETTER IOTA WITH STROKE
+1 19 P WITH STROKE
+1 12 CAPITAL LETTER U WITH STROKE
+1 12 LETTER UPSILON WITH STROKE
+1 19 B WITH PALATAL HOOK
+1 19 D WITH PALATAL HOOK
+1 19 F WITH PALATAL HOOK
+1 19 G WITH PALATAL HOOK
+1 19 K WITH PALATAL HOOK
+1 19 L WITH PALATAL HOOK
+1 19 M WITH PALATAL HOOK
+1 19 N WITH PALATAL HOOK
+1 19 P WITH PALATAL HOOK
+1 19 R WITH PALATAL HOOK
+1 19 S WITH PALATAL HOOK
+1 19 ESH WITH PALATAL HOOK
+1 19 V WITH PALATAL HOOK
+1 19 X WITH PALATAL HOOK
+1 19 Z WITH PALATAL HOOK
+1 19 A WITH RETROFLEX HOOK
+1 20 LPHA WITH RETROFLEX HOOK
+1 19 D WITH HOOK AND TAIL
+1 19 E WITH RETROFLEX HOOK
+1 19 OPEN E WITH RETROFLEX HOOK
+1 19 REVERSED OPEN E WITH RETROFLEX HOOK
+1 19 SCHWA WITH RETROFLEX HOOK
+1 19 I WITH RETROFLEX HOOK
+1 19 OPEN O WITH RETROFLEX HOOK
+1 19 ESH WITH RETROFLEX HOOK
+1 19 U WITH RETROFLEX HOOK
+1 19 EZH WITH RETROFLEX HOOK
+1 0 MODIFIER LETTER SMALL TURNED ALPHA
+1 22 C
+1 23  WITH CURL
+1 22 ETH
+1 22 REVERSED OPEN E
+1 22 F
+1 22 DOTLESS J WITH STROKE
+1 22 SCRIPT G
+1 22 TURNED H
+1 22 I WITH STROKE
+1 23 OTA
+1 22 CAPITAL I
+1 31  WITH STROKE
+1 22 J WITH CROSSED-TAIL
+1 22 L WITH RETROFLEX HOOK
+1 29 PALATAL HOOK
+1 22 CAPITAL L
+1 22 M WITH HOOK
+1 22 TURNED M WITH LONG LEG
+1 22 N WITH LEFT HOOK
+1 29 RETROFLEX HOOK
+1 22 CAPITAL N
+1 22 BARRED O
+1 22 PHI
+1 22 S WITH HOOK
+1 22 ESH
+1 22 T WITH PALATAL HOOK
+1 22 U BAR
+1 23 PSILON
+1 22 CAPITAL U
+1 22 V WITH HOOK
+1 22 TURNED V
+1 22 Z
+1 23  WITH RETROFLEX HOOK
+1 29 CURL
+1 22 EZH
+1 22 THETA
+1 0 COMBINING DOTTED GRAVE ACCENT
+1 17 ACUTE ACCENT
+1 10 SNAKE BELOW
+1 11 USPENSION MARK
+1 10 MACRON-ACUTE
+1 10 GRAVE-MACRON
+1 10 MACRON-GRAVE
+1 10 ACUTE-MACRON
+1 10 GRAVE-ACUTE-GRAVE
+1 10 ACUTE-GRAVE-ACUTE
+1 10 LATIN SMALL LETTER R BELOW
+1 10 BREVE-MACRON
+1 10 MACRON-BREVE
+1 10 DOUBLE CIRCUMFLEX ABOVE
+1 10 OGONEK ABOVE
+1 10 ZIGZAG BELOW
+1 10 IS BELOW
+1 10 UR ABOVE
+1 11 S ABOVE
+1 10 LATIN SMALL LETTER FLATTENED OPEN A ABOVE
+1 29 AE
+1 30 O
+1 30 V
+1 29 C CEDILLA
+1 29 INSULAR D
+1 29 ETH
+1 29 G
+1 16 LETTER SMALL CAPITAL G
+1 16 SMALL LETTER K
+1 29 L
+1 16 LETTER SMALL CAPITAL L
+1 37 M
+1 16 SMALL LETTER N
+1 16 LETTER SMALL CAPITAL N
+1 37 R
+1 16 SMALL LETTER R ROTUNDA
+1 29 S
+1 29 LONG S
+1 29 Z
+1 29 ALPHA
+1 29 B
+1 30 ETA
+1 29 SCHWA
+1 29 F
+1 29 L WITH DOUBLE MIDDLE TILDE
+1 29 O WITH LIGHT CENTRALIZATION STROKE
+1 29 P
+1 29 ESH
+1 29 U WITH LIGHT CENTRALIZATION STROKE
+1 29 W
+1 29 A WITH DIAERESIS
+1 29 O WITH DIAERESIS
+1 29 U WITH DIAERESIS
+1 10 UP TACK ABOVE
+1 10 KAVYKA ABOVE RIGHT
+1 23 LEFT
+1 10 DOT ABOVE LEFT
+1 10 WIDE INVERTED BRIDGE BELOW
+1 10 DOT BELOW LEFT
+1 11 ELETION MARK
+1 11 OUBLE INVERTED BREVE BELOW
+1 10 ALMOST EQUAL TO BELOW
+1 10 LEFT ARROWHEAD ABOVE
+1 10 RIGHT ARROWHEAD AND DOWN ARROWHEAD BELOW
+1 0 LATIN CAPITAL LETTER A WITH RING BELOW
+1 6 SMALL LETTER A WITH RING BELOW
+1 6 CAPITAL LETTER B WITH DOT ABOVE
+1 6 SMALL LETTER B WITH DOT ABOVE
+1 6 CAPITAL LETTER B WITH DOT BELOW
+1 6 SMALL LETTER B WITH DOT BELOW
+1 6 CAPITAL LETTER B WITH LINE BELOW
+1 6 SMALL LETTER B WITH LINE BELOW
+1 6 CAPITAL LETTER C WITH CEDILLA AND ACUTE
+1 6 SMALL LETTER C WITH CEDILLA AND ACUTE
+1 6 CAPITAL LETTER D WITH DOT ABOVE
+1 6 SMALL LETTER D WITH DOT ABOVE
+1 6 CAPITAL LETTER D WITH DOT BELOW
+1 6 SMALL LETTER D WITH DOT BELOW
+1 6 CAPITAL LETTER D WITH LINE BELOW
+1 6 SMALL LETTER D WITH LINE BELOW
+1 6 CAPITAL LETTER D WITH CEDILLA
+1 6 SMALL LETTER D WITH CEDILLA
+1 6 CAPITAL LETTER D WITH CIRCUMFLEX BELOW
+1 6 SMALL LETTER D WITH CIRCUMFLEX BELOW
+1 6 CAPITAL LETTER E WITH MACRON AND GRAVE
+1 6 SMALL LETTER E WITH MACRON AND GRAVE
+1 6 CAPITAL LETTER E WITH MACRON AND ACUTE
+1 6 SMALL LETTER E WITH MACRON AND ACUTE
+1 6 CAPITAL LETTER E WITH CIRCUMFLEX BELOW
+1 6 SMALL LETTER E WITH CIRCUMFLEX BELOW
+1 6 CAPITAL LETTER E WITH TILDE BELOW
+1 6 SMALL LETTER E WITH TILDE BELOW
+1 6 CAPITAL LETTER E WITH CEDILLA AND BREVE
+1 6 SMALL LETTER E WITH CEDILLA AND BREVE
+1 6 CAPITAL LETTER F WITH DOT ABOVE
+1 6 SMALL LETTER F WITH DOT ABOVE
+1 6 CAPITAL LETTER G WITH MACRON
+1 6 SMALL LETTER G WITH MACRON
+1 6 CAPITAL LETTER H WITH DOT ABOVE
+1 6 SMALL LETTER H WITH DOT ABOVE
+1 6 CAPITAL LETTER H WITH DOT BELOW
+1 6 SMALL LETTER H WITH DOT BELOW
+1 6 CAPITAL LETTER H WITH DIAERESIS
+1 6 SMALL LETTER H WITH DIAERESIS
+1 6 CAPITAL LETTER H WITH CEDILLA
+1 6 SMALL LETTER H WITH CEDILLA
+1 6 CAPITAL LETTER H WITH BREVE BELOW
+1 6 SMALL LETTER H WITH BREVE BELOW
+1 6 CAPITAL LETTER I WITH TILDE BELOW
+1 6 SMALL LETTER I WITH TILDE BELOW
+1 6 CAPITAL LETTER I WITH DIAERESIS AND ACUTE
+1 6 SMALL LETTER I WITH DIAERESIS AND ACUTE
+1 6 CAPITAL LETTER K WITH ACUTE
+1 6 SMALL LETTER K WITH ACUTE
+1 6 CAPITAL LETTER K WITH DOT BELOW
+1 6 SMALL LETTER K WITH DOT BELOW
+1 6 CAPITAL LETTER K WITH LINE BELOW
+1 6 SMALL LETTER K WITH LINE BELOW
+1 6 CAPITAL LETTER L WITH DOT BELOW
+1 6 SMALL LETTER L WITH DOT BELOW
+1 6 CAPITAL LETTER L WITH DOT BELOW AND MACRON
+1 6 SMALL LETTER L WITH DOT BELOW AND MACRON
+1 6 CAPITAL LETTER L WITH LINE BELOW
+1 6 SMALL LETTER L WITH LINE BELOW
+1 6 CAPITAL LETTER L WITH CIRCUMFLEX BELOW
+1 6 SMALL LETTER L WITH CIRCUMFLEX BELOW
+1 6 CAPITAL LETTER M WITH ACUTE
+1 6 SMALL LETTER M WITH ACUTE
+1 6 CAPITAL LETTER M WITH DOT ABOVE
+1 6 SMALL LETTER M WITH DOT ABOVE
+1 6 CAPITAL LETTER M WITH DOT BELOW
+1 6 SMALL LETTER M WITH DOT BELOW
+1 6 CAPITAL LETTER N WITH DOT ABOVE
+1 6 SMALL LETTER N WITH DOT ABOVE
+1 6 CAPITAL LETTER N WITH DOT BELOW
+1 6 SMALL LETTER N WITH DOT BELOW
+1 6 CAPITAL LETTER N WITH LINE BELOW
+1 6 SMALL LETTER N WITH LINE BELOW
+1 6 CAPITAL LETTER N WITH CIRCUMFLEX BELOW
+1 6 SMALL LETTER N WITH CIRCUMFLEX BELOW
+1 6 CAPITAL LETTER O WITH TILDE AND ACUTE
+1 6 SMALL LETTER O WITH TILDE AND ACUTE
+1 6 CAPITAL LETTER O WITH TILDE AND DIAERESIS
+1 6 SMALL LETTER O WITH TILDE AND DIAERESIS
+1 6 CAPITAL LETTER O WITH MACRON AND GRAVE
+1 6 SMALL LETTER O WITH MACRON AND GRAVE
+1 6 CAPITAL LETTER O WITH MACRON AND ACUTE
+1 6 SMALL LETTER O WITH MACRON AND ACUTE
+1 6 CAPITAL LETTER P WITH ACUTE
+1 6 SMALL LETTER P WITH ACUTE
+1 6 CAPITAL LETTER P WITH DOT ABOVE
+1 6 SMALL LETTER P WITH DOT ABOVE
+1 6 CAPITAL LETTER R WITH DOT ABOVE
+1 6 SMALL LETTER R WITH DOT ABOVE
+1 6 CAPITAL LETTER R WITH DOT BELOW
+1 6 SMALL LETTER R WITH DOT BELOW
+1 6 CAPITAL LETTER R WITH DOT BELOW AND MACRON
+1 6 SMALL LETTER R WITH DOT BELOW AND MACRON
+1 6 CAPITAL LETTER R WITH LINE BELOW
+1 6 SMALL LETTER R WITH LINE BELOW
+1 6 CAPITAL LETTER S WITH DOT ABOVE
+1 6 SMALL LETTER S WITH DOT ABOVE
+1 6 CAPITAL LETTER S WITH DOT BELOW
+1 6 SMALL LETTER S WITH DOT BELOW
+1 6 CAPITAL LETTER S WITH ACUTE AND DOT ABOVE
+1 6 SMALL LETTER S WITH ACUTE AND DOT ABOVE
+1 6 CAPITAL LETTER S WITH CARON AND DOT ABOVE
+1 6 SMALL LETTER S WITH CARON AND DOT ABOVE
+1 6 CAPITAL LETTER S WITH DOT BELOW AND DOT ABOVE
+1 6 SMALL LETTER S WITH DOT BELOW AND DOT ABOVE
+1 6 CAPITAL LETTER T WITH DOT ABOVE
+1 6 SMALL LETTER T WITH DOT ABOVE
+1 6 CAPITAL LETTER T WITH DOT BELOW
+1 6 SMALL LETTER T WITH DOT BELOW
+1 6 CAPITAL LETTER T WITH LINE BELOW
+1 6 SMALL LETTER T WITH LINE BELOW
+1 6 CAPITAL LETTER T WITH CIRCUMFLEX BELOW
+1 6 SMALL LETTER T WITH CIRCUMFLEX BELOW
+1 6 CAPITAL LETTER U WITH DIAERESIS BELOW
+1 6 SMALL LETTER U WITH DIAERESIS BELOW
+1 6 CAPITAL LETTER U WITH TILDE BELOW
+1 6 SMALL LETTER U WITH TILDE BELOW
+1 6 CAPITAL LETTER U WITH CIRCUMFLEX BELOW
+1 6 SMALL LETTER U WITH CIRCUMFLEX BELOW
+1 6 CAPITAL LETTER U WITH TILDE AND ACUTE
+1 6 SMALL LETTER U WITH TILDE AND ACUTE
+1 6 CAPITAL LETTER U WITH MACRON AND DIAERESIS
+1 6 SMALL LETTER U WITH MACRON AND DIAERESIS
+1 6 CAPITAL LETTER V WITH TILDE
+1 6 SMALL LETTER V WITH TILDE
+1 6 CAPITAL LETTER V WITH DOT BELOW
+1 6 SMALL LETTER V WITH DOT BELOW
+1 6 CAPITAL LETTER W WITH GRAVE
+1 6 SMALL LETTER W WITH GRAVE
+1 6 CAPITAL LETTER W WITH ACUTE
+1 6 SMALL LETTER W WITH ACUTE
+1 6 CAPITAL LETTER W WITH DIAERESIS
+1 6 SMALL LETTER W WITH DIAERESIS
+1 6 CAPITAL LETTER W WITH DOT ABOVE
+1 6 SMALL LETTER W WITH DOT ABOVE
+1 6 CAPITAL LETTER W WITH DOT BELOW
+1 6 SMALL LETTER W WITH DOT BELOW
+1 6 CAPITAL LETTER X WITH DOT ABOVE
+1 6 SMALL LETTER X WITH DOT ABOVE
+1 6 CAPITAL LETTER X WITH DIAERESIS
+1 6 SMALL LETTER X WITH DIAERESIS
+1 6 CAPITAL LETTER Y WITH DOT ABOVE
+1 6 SMALL LETTER Y WITH DOT ABOVE
+1 6 CAPITAL LETTER Z WITH CIRCUMFLEX
+1 6 SMALL LETTER Z WITH CIRCUMFLEX
+1 6 CAPITAL LETTER Z WITH DOT BELOW
+1 6 SMALL LETTER Z WITH DOT BELOW
+1 6 CAPITAL LETTER Z WITH LINE BELOW
+1 6 SMALL LETTER Z WITH LINE BELOW
+1 19 H WITH LINE BELOW
+1 19 T WITH DIAERESIS
+1 19 W WITH RING ABOVE
+1 19 Y WITH RING ABOVE
+1 19 A WITH RIGHT HALF RING
+1 19 LONG S WITH DOT ABOVE
+1 32 IAGONAL STROKE
+1 31 HIGH STROKE
+1 6 CAPITAL LETTER SHARP S
+1 6 SMALL LETTER DELTA
+1 6 CAPITAL LETTER A WITH DOT BELOW
+1 6 SMALL LETTER A WITH DOT BELOW
+1 6 CAPITAL LETTER A WITH HOOK ABOVE
+1 6 SMALL LETTER A WITH HOOK ABOVE
+1 6 CAPITAL LETTER A WITH CIRCUMFLEX AND ACUTE
+1 6 SMALL LETTER A WITH CIRCUMFLEX AND ACUTE
+1 6 CAPITAL LETTER A WITH CIRCUMFLEX AND GRAVE
+1 6 SMALL LETTER A WITH CIRCUMFLEX AND GRAVE
+1 6 CAPITAL LETTER A WITH CIRCUMFLEX AND HOOK ABOVE
+1 6 SMALL LETTER A WITH CIRCUMFLEX AND HOOK ABOVE
+1 6 CAPITAL LETTER A WITH CIRCUMFLEX AND TILDE
+1 6 SMALL LETTER A WITH CIRCUMFLEX AND TILDE
+1 6 CAPITAL LETTER A WITH CIRCUMFLEX AND DOT BELOW
+1 6 SMALL LETTER A WITH CIRCUMFLEX AND DOT BELOW
+1 6 CAPITAL LETTER A WITH BREVE AND ACUTE
+1 6 SMALL LETTER A WITH BREVE AND ACUTE
+1 6 CAPITAL LETTER A WITH BREVE AND GRAVE
+1 6 SMALL LETTER A WITH BREVE AND GRAVE
+1 6 CAPITAL LETTER A WITH BREVE AND HOOK ABOVE
+1 6 SMALL LETTER A WITH BREVE AND HOOK ABOVE
+1 6 CAPITAL LETTER A WITH BREVE AND TILDE
+1 6 SMALL LETTER A WITH BREVE AND TILDE
+1 6 CAPITAL LETTER A WITH BREVE AND DOT BELOW
+1 6 SMALL LETTER A WITH BREVE AND DOT BELOW
+1 6 CAPITAL LETTER E WITH DOT BELOW
+1 6 SMALL LETTER E WITH DOT BELOW
+1 6 CAPITAL LETTER E WITH HOOK ABOVE
+1 6 SMALL LETTER E WITH HOOK ABOVE
+1 6 CAPITAL LETTER E WITH TILDE
+1 6 SMALL LETTER E WITH TILDE
+1 6 CAPITAL LETTER E WITH CIRCUMFLEX AND ACUTE
+1 6 SMALL LETTER E WITH CIRCUMFLEX AND ACUTE
+1 6 CAPITAL LETTER E WITH CIRCUMFLEX AND GRAVE
+1 6 SMALL LETTER E WITH CIRCUMFLEX AND GRAVE
+1 6 CAPITAL LETTER E WITH CIRCUMFLEX AND HOOK ABOVE
+1 6 SMALL LETTER E WITH CIRCUMFLEX AND HOOK ABOVE
+1 6 CAPITAL LETTER E WITH CIRCUMFLEX AND TILDE
+1 6 SMALL LETTER E WITH CIRCUMFLEX AND TILDE
+1 6 CAPITAL LETTER E WITH CIRCUMFLEX AND DOT BELOW
+1 6 SMALL LETTER E WITH CIRCUMFLEX AND DOT BELOW
+1 6 CAPITAL LETTER I WITH HOOK ABOVE
+1 6 SMALL LETTER I WITH HOOK ABOVE
+1 6 CAPITAL LETTER I WITH DOT BELOW
+1 6 SMALL LETTER I WITH DOT BELOW
+1 6 CAPITAL LETTER O WITH DOT BELOW
+1 6 SMALL LETTER O WITH DOT BELOW
+1 6 CAPITAL LETTER O WITH HOOK ABOVE
+1 6 SMALL LETTER O WITH HOOK ABOVE
+1 6 CAPITAL LETTER O WITH CIRCUMFLEX AND ACUTE
+1 6 SMALL LETTER O WITH CIRCUMFLEX AND ACUTE
+1 6 CAPITAL LETTER O WITH CIRCUMFLEX AND GRAVE
+1 6 SMALL LETTER O WITH CIRCUMFLEX AND GRAVE
+1 6 CAPITAL LETTER O WITH CIRCUMFLEX AND HOOK ABOVE
+1 6 SMALL LETTER O WITH CIRCUMFLEX AND HOOK ABOVE
+1 6 CAPITAL LETTER O WITH CIRCUMFLEX AND TILDE
+1 6 SMALL LETTER O WITH CIRCUMFLEX AND TILDE
+1 6 CAPITAL LETTER O WITH CIRCUMFLEX AND DOT BELOW
+1 6 SMALL LETTER O WITH CIRCUMFLEX AND DOT BELOW
+1 6 CAPITAL LETTER O WITH HORN AND ACUTE
+1 6 SMALL LETTER O WITH HORN AND ACUTE
+1 6 CAPITAL LETTER O WITH HORN AND GRAVE
+1 6 SMALL LETTER O WITH HORN AND GRAVE
+1 6 CAPITAL LETTER O WITH HORN AND HOOK ABOVE
+1 6 SMALL LETTER O WITH HORN AND HOOK ABOVE
+1 6 CAPITAL LETTER O WITH HORN AND TILDE
+1 6 SMALL LETTER O WITH HORN AND TILDE
+1 6 CAPITAL LETTER O WITH HORN AND DOT BELOW
+1 6 SMALL LETTER O WITH HORN AND DOT BELOW
+1 6 CAPITAL LETTER U WITH DOT BELOW
+1 6 SMALL LETTER U WITH DOT BELOW
+1 6 CAPITAL LETTER U WITH HOOK ABOVE
+1 6 SMALL LETTER U WITH HOOK ABOVE
+1 6 CAPITAL LETTER U WITH HORN AND ACUTE
+1 6 SMALL LETTER U WITH HORN AND ACUTE
+1 6 CAPITAL LETTER U WITH HORN AND GRAVE
+1 6 SMALL LETTER U WITH HORN AND GRAVE
+1 6 CAPITAL LETTER U WITH HORN AND HOOK ABOVE
+1 6 SMALL LETTER U WITH HORN AND HOOK ABOVE
+1 6 CAPITAL LETTER U WITH HORN AND TILDE
+1 6 SMALL LETTER U WITH HORN AND TILDE
+1 6 CAPITAL LETTER U WITH HORN AND DOT BELOW
+1 6 SMALL LETTER U WITH HORN AND DOT BELOW
+1 6 CAPITAL LETTER Y WITH GRAVE
+1 6 SMALL LETTER Y WITH GRAVE
+1 6 CAPITAL LETTER Y WITH DOT BELOW
+1 6 SMALL LETTER Y WITH DOT BELOW
+1 6 CAPITAL LETTER Y WITH HOOK ABOVE
+1 6 SMALL LETTER Y WITH HOOK ABOVE
+1 6 CAPITAL LETTER Y WITH TILDE
+1 6 SMALL LETTER Y WITH TILDE
+1 6 CAPITAL LETTER MIDDLE-WELSH LL
+1 6 SMALL LETTER MIDDLE-WELSH LL
+1 6 CAPITAL LETTER MIDDLE-WELSH V
+1 6 SMALL LETTER MIDDLE-WELSH V
+1 6 CAPITAL LETTER Y WITH LOOP
+1 6 SMALL LETTER Y WITH LOOP
+1 0 GREEK SMALL LETTER ALPHA WITH PSILI
+1 30 DASIA
+1 30 PSILI AND VARIA
+1 30 DASIA AND VARIA
+1 30 PSILI AND OXIA
+1 30 DASIA AND OXIA
+1 30 PSILI AND PERISPOMENI
+1 30 DASIA AND PERISPOMENI
+1 6 CAPITAL LETTER ALPHA WITH PSILI
+1 32 DASIA
+1 32 PSILI AND VARIA
+1 32 DASIA AND VARIA
+1 32 PSILI AND OXIA
+1 32 DASIA AND OXIA
+1 32 PSILI AND PERISPOMENI
+1 32 DASIA AND PERISPOMENI
+1 6 SMALL LETTER EPSILON WITH PSILI
+1 32 DASIA
+1 32 PSILI AND VARIA
+1 32 DASIA AND VARIA
+1 32 PSILI AND OXIA
+1 32 DASIA AND OXIA
+3 6 CAPITAL LETTER EPSILON WITH PSILI
+1 34 DASIA
+1 34 PSILI AND VARIA
+1 34 DASIA AND VARIA
+1 34 PSILI AND OXIA
+1 34 DASIA AND OXIA
+3 6 SMALL LETTER ETA WITH PSILI
+1 28 DASIA
+1 28 PSILI AND VARIA
+1 28 DASIA AND VARIA
+1 28 PSILI AND OXIA
+1 28 DASIA AND OXIA
+1 28 PSILI AND PERISPOMENI
+1 28 DASIA AND PERISPOMENI
+1 6 CAPITAL LETTER ETA WITH PSILI
+1 30 DASIA
+1 30 PSILI AND VARIA
+1 30 DASIA AND VARIA
+1 30 PSILI AND OXIA
+1 30 DASIA AND OXIA
+1 30 PSILI AND PERISPOMENI
+1 30 DASIA AND PERISPOMENI
+1 6 SMALL LETTER IOTA WITH PSILI
+1 29 DASIA
+1 29 PSILI AND VARIA
+1 29 DASIA AND VARIA
+1 29 PSILI AND OXIA
+1 29 DASIA AND OXIA
+1 29 PSILI AND PERISPOMENI
+1 29 DASIA AND PERISPOMENI
+1 6 CAPITAL LETTER IOTA WITH PSILI
+1 31 DASIA
+1 31 PSILI AND VARIA
+1 31 DASIA AND VARIA
+1 31 PSILI AND OXIA
+1 31 DASIA AND OXIA
+1 31 PSILI AND PERISPOMENI
+1 31 DASIA AND PERISPOMENI
+1 6 SMALL LETTER OMICRON WITH PSILI
+1 32 DASIA
+1 32 PSILI AND VARIA
+1 32 DASIA AND VARIA
+1 32 PSILI AND OXIA
+1 32 DASIA AND OXIA
+3 6 CAPITAL LETTER OMICRON WITH PSILI
+1 34 DASIA
+1 34 PSILI AND VARIA
+1 34 DASIA AND VARIA
+1 34 PSILI AND OXIA
+1 34 DASIA AND OXIA
+3 6 SMALL LETTER UPSILON WITH PSILI
+1 32 DASIA
+1 32 PSILI AND VARIA
+1 32 DASIA AND VARIA
+1 32 PSILI AND OXIA
+1 32 DASIA AND OXIA
+1 32 PSILI AND PERISPOMENI
+1 32 DASIA AND PERISPOMENI
+2 6 CAPITAL LETTER UPSILON WITH DASIA
+2 39  AND VARIA
+2 44 OXIA
+2 44 PERISPOMENI
+1 6 SMALL LETTER OMEGA WITH PSILI
+1 30 DASIA
+1 30 PSILI AND VARIA
+1 30 DASIA AND VARIA
+1 30 PSILI AND OXIA
+1 30 DASIA AND OXIA
+1 30 PSILI AND PERISPOMENI
+1 30 DASIA AND PERISPOMENI
+1 6 CAPITAL LETTER OMEGA WITH PSILI
+1 32 DASIA
+1 32 PSILI AND VARIA
+1 32 DASIA AND VARIA
+1 32 PSILI AND OXIA
+1 32 DASIA AND OXIA
+1 32 PSILI AND PERISPOMENI
+1 32 DASIA AND PERISPOMENI
+1 6 SMALL LETTER ALPHA WITH VARIA
+1 30 OXIA
+1 19 EPSILON WITH VARIA
+1 32 OXIA
+1 20 TA WITH VARIA
+1 28 OXIA
+1 19 IOTA WITH VARIA
+1 29 OXIA
+1 19 OMICRON WITH VARIA
+1 32 OXIA
+1 19 UPSILON WITH VARIA
+1 32 OXIA
+1 19 OMEGA WITH VARIA
+1 30 OXIA
+3 19 ALPHA WITH PSILI AND YPOGEGRAMMENI
+1 30 DASIA AND YPOGEGRAMMENI
+1 30 PSILI AND VARIA AND YPOGEGRAMMENI
+1 30 DASIA AND VARIA AND YPOGEGRAMMENI
+1 30 PSILI AND OXIA AND YPOGEGRAMMENI
+1 30 DASIA AND OXIA AND YPOGEGRAMMENI
+1 30 PSILI AND PERISPOMENI AND YPOGEGRAMMENI
+1 30 DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+1 6 CAPITAL LETTER ALPHA WITH PSILI AND PROSGEGRAMMENI
+1 32 DASIA AND PROSGEGRAMMENI
+1 32 PSILI AND VARIA AND PROSGEGRAMMENI
+1 32 DASIA AND VARIA AND PROSGEGRAMMENI
+1 32 PSILI AND OXIA AND PROSGEGRAMMENI
+1 32 DASIA AND OXIA AND PROSGEGRAMMENI
+1 32 PSILI AND PERISPOMENI AND PROSGEGRAMMENI
+1 32 DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+1 6 SMALL LETTER ETA WITH PSILI AND YPOGEGRAMMENI
+1 28 DASIA AND YPOGEGRAMMENI
+1 28 PSILI AND VARIA AND YPOGEGRAMMENI
+1 28 DASIA AND VARIA AND YPOGEGRAMMENI
+1 28 PSILI AND OXIA AND YPOGEGRAMMENI
+1 28 DASIA AND OXIA AND YPOGEGRAMMENI
+1 28 PSILI AND PERISPOMENI AND YPOGEGRAMMENI
+1 28 DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+1 6 CAPITAL LETTER ETA WITH PSILI AND PROSGEGRAMMENI
+1 30 DASIA AND PROSGEGRAMMENI
+1 30 PSILI AND VARIA AND PROSGEGRAMMENI
+1 30 DASIA AND VARIA AND PROSGEGRAMMENI
+1 30 PSILI AND OXIA AND PROSGEGRAMMENI
+1 30 DASIA AND OXIA AND PROSGEGRAMMENI
+1 30 PSILI AND PERISPOMENI AND PROSGEGRAMMENI
+1 30 DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+1 6 SMALL LETTER OMEGA WITH PSILI AND YPOGEGRAMMENI
+1 30 DASIA AND YPOGEGRAMMENI
+1 30 PSILI AND VARIA AND YPOGEGRAMMENI
+1 30 DASIA AND VARIA AND YPOGEGRAMMENI
+1 30 PSILI AND OXIA AND YPOGEGRAMMENI
+1 30 DASIA AND OXIA AND YPOGEGRAMMENI
+1 30 PSILI AND PERISPOMENI AND YPOGEGRAMMENI
+1 30 DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+1 6 CAPITAL LETTER OMEGA WITH PSILI AND PROSGEGRAMMENI
+1 32 DASIA AND PROSGEGRAMMENI
+1 32 PSILI AND VARIA AND PROSGEGRAMMENI
+1 32 DASIA AND VARIA AND PROSGEGRAMMENI
+1 32 PSILI AND OXIA AND PROSGEGRAMMENI
+1 32 DASIA AND OXIA AND PROSGEGRAMMENI
+1 32 PSILI AND PERISPOMENI AND PROSGEGRAMMENI
+1 32 DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+1 6 SMALL LETTER ALPHA WITH VRACHY
+1 30 MACRON
+1 30 VARIA AND YPOGEGRAMMENI
+1 30 YPOGEGRAMMENI
+1 30 OXIA AND YPOGEGRAMMENI
+2 30 PERISPOMENI
+1 41  AND YPOGEGRAMMENI
+1 6 CAPITAL LETTER ALPHA WITH VRACHY
+1 32 MACRON
+1 32 VARIA
+1 32 OXIA
+1 32 PROSGEGRAMMENI
+1 6 KORONIS
+1 6 PROSGEGRAMMENI
+1 7 SILI
+1 7 ERISPOMENI
+1 6 DIALYTIKA AND PERISPOMENI
+1 6 SMALL LETTER ETA WITH VARIA AND YPOGEGRAMMENI
+1 28 YPOGEGRAMMENI
+1 28 OXIA AND YPOGEGRAMMENI
+2 28 PERISPOMENI
+1 39  AND YPOGEGRAMMENI
+1 6 CAPITAL LETTER EPSILON WITH VARIA
+1 34 OXIA
+1 22 TA WITH VARIA
+1 30 OXIA
+1 30 PROSGEGRAMMENI
+1 6 PSILI AND VARIA
+1 16 OXIA
+1 16 PERISPOMENI
+1 6 SMALL LETTER IOTA WITH VRACHY
+1 29 MACRON
+1 29 DIALYTIKA AND VARIA
+1 43 OXIA
+3 29 PERISPOMENI
+1 29 DIALYTIKA AND PERISPOMENI
+1 6 CAPITAL LETTER IOTA WITH VRACHY
+1 31 MACRON
+1 31 VARIA
+1 31 OXIA
+2 6 DASIA AND VARIA
+1 16 OXIA
+1 16 PERISPOMENI
+1 6 SMALL LETTER UPSILON WITH VRACHY
+1 32 MACRON
+1 32 DIALYTIKA AND VARIA
+1 46 OXIA
+1 19 RHO WITH PSILI
+1 28 DASIA
+1 19 UPSILON WITH PERISPOMENI
+1 32 DIALYTIKA AND PERISPOMENI
+1 6 CAPITAL LETTER UPSILON WITH VRACHY
+1 34 MACRON
+1 34 VARIA
+1 34 OXIA
+1 21 RHO WITH DASIA
+1 6 DIALYTIKA AND VARIA
+1 20 OXIA
+1 6 VARIA
+3 6 SMALL LETTER OMEGA WITH VARIA AND YPOGEGRAMMENI
+1 30 YPOGEGRAMMENI
+1 30 OXIA AND YPOGEGRAMMENI
+2 30 PERISPOMENI
+1 41  AND YPOGEGRAMMENI
+1 6 CAPITAL LETTER OMICRON WITH VARIA
+1 34 OXIA
+1 23 EGA WITH VARIA
+1 32 OXIA
+1 32 PROSGEGRAMMENI
+1 6 OXIA
+1 6 DASIA
+2 0 EN QUAD
+1 1 M QUAD
+1 1 N SPACE
+1 1 M SPACE
+1 0 THREE-PER-EM SPACE
+1 0 FOUR-PER-EM SPACE
+1 0 SIX-PER-EM SPACE
+1 0 FIGURE SPACE
+1 0 PUNCTUATION SPACE
+1 0 THIN SPACE
+1 0 HAIR SPACE
+1 0 ZERO WIDTH SPACE
+1 11 NON-JOINER
+1 11 JOINER
+1 0 LEFT-TO-RIGHT MARK
+1 0 RIGHT-TO-LEFT MARK
+1 0 HYPHEN
+1 0 NON-BREAKING HYPHEN
+1 0 FIGURE DASH
+1 0 EN DASH
+1 1 M DASH
+1 0 HORIZONTAL BAR
+1 0 DOUBLE VERTICAL LINE
+1 7 LOW LINE
+1 0 LEFT SINGLE QUOTATION MARK
+1 0 RIGHT SINGLE QUOTATION MARK
+1 0 SINGLE LOW-9 QUOTATION MARK
+1 7 HIGH-REVERSED-9 QUOTATION MARK
+1 0 LEFT DOUBLE QUOTATION MARK
+1 0 RIGHT DOUBLE QUOTATION MARK
+1 0 DOUBLE LOW-9 QUOTATION MARK
+1 7 HIGH-REVERSED-9 QUOTATION MARK
+1 1 AGGER
+1 1 OUBLE DAGGER
+1 0 BULLET
+1 0 TRIANGULAR BULLET
+1 0 ONE DOT LEADER
+1 0 TWO DOT LEADER
+1 0 HORIZONTAL ELLIPSIS
+1 1 YPHENATION POINT
+1 0 LINE SEPARATOR
+1 0 PARAGRAPH SEPARATOR
+1 0 LEFT-TO-RIGHT EMBEDDING
+1 0 RIGHT-TO-LEFT EMBEDDING
+1 0 POP DIRECTIONAL FORMATTING
+1 0 LEFT-TO-RIGHT OVERRIDE
+1 0 RIGHT-TO-LEFT OVERRIDE
+1 0 NARROW NO-BREAK SPACE
+1 0 PER MILLE SIGN
+1 4 TEN THOUSAND SIGN
+1 1 RIME
+1 0 DOUBLE PRIME
+1 0 TRIPLE PRIME
+1 0 REVERSED PRIME
+1 9 DOUBLE PRIME
+1 9 TRIPLE PRIME
+1 0 CARET
+1 0 SINGLE LEFT-POINTING ANGLE QUOTATION MARK
+1 7 RIGHT-POINTING ANGLE QUOTATION MARK
+1 0 REFERENCE MARK
+1 0 DOUBLE EXCLAMATION MARK
+1 0 INTERROBANG
+1 0 OVERLINE
+1 0 UNDERTIE
+1 0 CHARACTER TIE
+1 1 ARET INSERTION POINT
+1 0 ASTERISM
+1 0 HYPHEN BULLET
+1 0 FRACTION SLASH
+1 0 LEFT SQUARE BRACKET WITH QUILL
+1 0 RIGHT SQUARE BRACKET WITH QUILL
+1 0 DOUBLE QUESTION MARK
+1 0 QUESTION EXCLAMATION MARK
+1 0 EXCLAMATION QUESTION MARK
+1 0 TIRONIAN SIGN ET
+1 0 REVERSED PILCROW SIGN
+1 0 BLACK LEFTWARDS BULLET
+1 6 RIGHTWARDS BULLET
+1 0 LOW ASTERISK
+1 0 REVERSED SEMICOLON
+1 0 CLOSE UP
+1 0 TWO ASTERISKS ALIGNED VERTICALLY
+1 0 COMMERCIAL MINUS SIGN
+1 0 SWUNG DASH
+1 0 INVERTED UNDERTIE
+1 0 FLOWER PUNCTUATION MARK
+1 0 THREE DOT PUNCTUATION
+1 0 QUADRUPLE PRIME
+1 0 FOUR DOT PUNCTUATION
+1 1 IVE DOT PUNCTUATION
+1 0 TWO DOT PUNCTUATION
+1 0 FOUR DOT MARK
+1 0 DOTTED CROSS
+1 0 TRICOLON
+1 0 VERTICAL FOUR DOTS
+1 0 MEDIUM MATHEMATICAL SPACE
+1 0 WORD JOINER
+1 0 FUNCTION APPLICATION
+1 0 INVISIBLE TIMES
+1 10 SEPARATOR
+1 10 PLUS
+2 0 LEFT-TO-RIGHT ISOLATE
+1 0 RIGHT-TO-LEFT ISOLATE
+1 0 FIRST STRONG ISOLATE
+1 0 POP DIRECTIONAL ISOLATE
+1 0 INHIBIT SYMMETRIC SWAPPING
+1 0 ACTIVATE SYMMETRIC SWAPPING
+1 0 INHIBIT ARABIC FORM SHAPING
+1 0 ACTIVATE ARABIC FORM SHAPING
+1 0 NATIONAL DIGIT SHAPES
+1 1 OMINAL DIGIT SHAPES
+1 0 SUPERSCRIPT ZERO
+1 12 LATIN SMALL LETTER I
+3 12 FOUR
+1 13 IVE
+1 12 SIX
+1 13 EVEN
+1 12 EIGHT
+1 12 NINE
+1 12 PLUS SIGN
+1 12 MINUS
+1 12 EQUALS SIGN
+1 12 LEFT PARENTHESIS
+1 12 RIGHT PARENTHESIS
+1 12 LATIN SMALL LETTER N
+1 2 BSCRIPT ZERO
+1 10 ONE
+1 10 TWO
+1 11 HREE
+1 10 FOUR
+1 11 IVE
+1 10 SIX
+1 11 EVEN
+1 10 EIGHT
+1 10 NINE
+1 10 PLUS SIGN
+1 10 MINUS
+1 10 EQUALS SIGN
+1 10 LEFT PARENTHESIS
+1 10 RIGHT PARENTHESIS
+2 0 LATIN SUBSCRIPT SMALL LETTER A
+1 29 E
+1 29 O
+1 29 X
+1 29 SCHWA
+1 29 H
+1 29 K
+1 29 L
+1 29 M
+1 29 N
+1 29 P
+1 29 S
+1 29 T
+4 0 EURO-CURRENCY SIGN
+1 0 COLON SIGN
+1 1 RUZEIRO SIGN
+1 0 FRENCH FRANC SIGN
+1 0 LIRA SIGN
+1 0 MILL SIGN
+1 0 NAIRA SIGN
+1 0 PESETA SIGN
+1 0 RUPEE SIGN
+1 0 WON SIGN
+1 0 NEW SHEQEL SIGN
+1 0 DONG SIGN
+1 0 EURO SIGN
+1 0 KIP SIGN
+1 0 TUGRIK SIGN
+1 0 DRACHMA SIGN
+1 0 GERMAN PENNY SIGN
+1 0 PESO SIGN
+1 0 GUARANI SIGN
+1 0 AUSTRAL SIGN
+1 0 HRYVNIA SIGN
+1 0 CEDI SIGN
+1 0 LIVRE TOURNOIS SIGN
+1 0 SPESMILO SIGN
+1 0 TENGE SIGN
+1 0 INDIAN RUPEE SIGN
+1 0 TURKISH LIRA SIGN
+1 0 NORDIC MARK SIGN
+1 0 MANAT SIGN
+1 0 RUBLE SIGN
+1 0 LARI SIGN
+1 0 BITCOIN SIGN
+1 0 SOM SIGN
+10 0 COMBINING LEFT HARPOON ABOVE
+1 10 RIGHT HARPOON ABOVE
+1 10 LONG VERTICAL LINE OVERLAY
+1 10 SHORT VERTICAL LINE OVERLAY
+1 10 ANTICLOCKWISE ARROW ABOVE
+1 10 CLOCKWISE ARROW ABOVE
+1 10 LEFT ARROW ABOVE
+1 10 RIGHT ARROW ABOVE
+1 12 NG OVERLAY
+1 10 CLOCKWISE RING OVERLAY
+1 10 ANTICLOCKWISE RING OVERLAY
+1 10 THREE DOTS ABOVE
+1 10 FOUR DOTS ABOVE
+1 10 ENCLOSING CIRCLE
+1 20 SQUARE
+1 20 DIAMOND
+1 20 CIRCLE BACKSLASH
+1 10 LEFT RIGHT ARROW ABOVE
+1 10 ENCLOSING SCREEN
+1 20 KEYCAP
+1 20 UPWARD POINTING TRIANGLE
+1 10 REVERSE SOLIDUS OVERLAY
+1 10 DOUBLE VERTICAL STROKE OVERLAY
+1 10 ANNUITY SYMBOL
+1 10 TRIPLE UNDERDOT
+1 10 WIDE BRIDGE ABOVE
+1 10 LEFTWARDS ARROW OVERLAY
+1 11 ONG DOUBLE SOLIDUS OVERLAY
+1 10 RIGHTWARDS HARPOON WITH BARB DOWNWARDS
+1 10 LEFTWARDS HARPOON WITH BARB DOWNWARDS
+1 14  ARROW BELOW
+1 10 RIGHT ARROW BELOW
+1 10 ASTERISK ABOVE
+10 0 ACCOUNT OF
+1 1 DDRESSED TO THE SUBJECT
+1 0 DOUBLE-STRUCK CAPITAL C
+1 1 EGREE CELSIUS
+1 0 CENTRE LINE SYMBOL
+1 1 ARE OF
+1 2 DA UNA
+1 0 EULER CONSTANT
+1 0 SCRUPLE
+1 0 DEGREE FAHRENHEIT
+1 0 SCRIPT SMALL G
+1 7 CAPITAL H
+1 0 BLACK-LETTER CAPITAL H
+1 0 DOUBLE-STRUCK CAPITAL H
+1 0 PLANCK CONSTANT
+1 15  OVER TWO PI
+1 0 SCRIPT CAPITAL I
+1 0 BLACK-LETTER CAPITAL I
+1 0 SCRIPT CAPITAL L
+1 7 SMALL L
+1 0 L B BAR SYMBOL
+1 0 DOUBLE-STRUCK CAPITAL N
+1 0 NUMERO SIGN
+1 0 SOUND RECORDING COPYRIGHT
+1 1 CRIPT CAPITAL P
+1 0 DOUBLE-STRUCK CAPITAL P
+1 22 Q
+1 0 SCRIPT CAPITAL R
+1 0 BLACK-LETTER CAPITAL R
+1 0 DOUBLE-STRUCK CAPITAL R
+1 0 PRESCRIPTION TAKE
+1 0 RESPONSE
+1 0 SERVICE MARK
+1 0 TELEPHONE SIGN
+1 1 RADE MARK SIGN
+1 0 VERSICLE
+1 0 DOUBLE-STRUCK CAPITAL Z
+1 0 OUNCE SIGN
+1 1 HM SIGN
+1 0 INVERTED OHM SIGN
+1 0 BLACK-LETTER CAPITAL Z
+1 0 TURNED GREEK SMALL LETTER IOTA
+1 0 KELVIN SIGN
+1 0 ANGSTROM SIGN
+1 0 SCRIPT CAPITAL B
+1 0 BLACK-LETTER CAPITAL C
+1 0 ESTIMATED SYMBOL
+1 0 SCRIPT SMALL E
+1 7 CAPITAL E
+1 15 F
+1 0 TURNED CAPITAL F
+1 0 SCRIPT CAPITAL M
+1 7 SMALL O
+1 0 ALEF SYMBOL
+1 0 BET SYMBOL
+1 0 GIMEL SYMBOL
+1 0 DALET SYMBOL
+1 0 INFORMATION SOURCE
+1 0 ROTATED CAPITAL Q
+1 0 FACSIMILE SIGN
+1 0 DOUBLE-STRUCK SMALL PI
+1 20 GAMMA
+1 14 CAPITAL GAMMA
+1 22 PI
+1 14 N-ARY SUMMATION
+1 0 TURNED SANS-SERIF CAPITAL G
+1 26 L
+1 0 REVERSED SANS-SERIF CAPITAL L
+1 0 TURNED SANS-SERIF CAPITAL Y
+1 0 DOUBLE-STRUCK ITALIC CAPITAL D
+1 21 SMALL D
+1 27 E
+1 27 I
+1 27 J
+1 0 PROPERTY LINE
+1 0 TURNED AMPERSAND
+1 0 PER SIGN
+1 0 AKTIESELSKAB
+1 0 TURNED SMALL F
+1 0 SYMBOL FOR SAMARITAN SOURCE
+1 0 VULGAR FRACTION ONE SEVENTH
+1 20 NINTH
+1 20 TENTH
+1 21 HIRD
+1 16 TWO THIRDS
+1 16 ONE FIFTH
+1 16 TWO FIFTHS
+1 17 HREE FIFTHS
+1 16 FOUR FIFTHS
+1 16 ONE SIXTH
+1 16 FIVE SIXTHS
+1 16 ONE EIGHTH
+1 16 THREE EIGHTHS
+1 16 FIVE EIGHTHS
+1 16 SEVEN EIGHTHS
+1 0 FRACTION NUMERATOR ONE
+1 0 ROMAN NUMERAL ONE
+1 14 TWO
+1 15 HREE
+1 14 FOUR
+1 15 IVE
+1 14 SIX
+1 15 EVEN
+1 14 EIGHT
+1 14 NINE
+1 14 TEN
+1 14 ELEVEN
+1 14 TWELVE
+1 14 FIFTY
+1 14 ONE HUNDRED
+1 14 FIVE HUNDRED
+1 14 ONE THOUSAND
+1 0 SMALL ROMAN NUMERAL ONE
+1 20 TWO
+1 21 HREE
+1 20 FOUR
+1 21 IVE
+1 20 SIX
+1 21 EVEN
+1 20 EIGHT
+1 20 NINE
+1 20 TEN
+1 20 ELEVEN
+1 20 TWELVE
+1 20 FIFTY
+1 20 ONE HUNDRED
+1 20 FIVE HUNDRED
+1 20 ONE THOUSAND
+1 0 ROMAN NUMERAL ONE THOUSAND C D
+1 14 FIVE THOUSAND
+1 14 TEN THOUSAND
+1 14 REVERSED ONE HUNDRED
+1 0 LATIN SMALL LETTER REVERSED C
+1 0 ROMAN NUMERAL SIX LATE FORM
+1 14 FIFTY EARLY FORM
+1 20 THOUSAND
+1 14 ONE HUNDRED THOUSAND
+1 0 VULGAR FRACTION ZERO THIRDS
+1 0 TURNED DIGIT TWO
+1 14 HREE
+5 0 LEFTWARDS ARROW
+1 0 UPWARDS ARROW
+1 0 RIGHTWARDS ARROW
+1 0 DOWNWARDS ARROW
+1 0 LEFT RIGHT ARROW
+1 0 UP DOWN ARROW
+1 0 NORTH WEST ARROW
+1 6 EAST ARROW
+1 0 SOUTH EAST ARROW
+1 6 WEST ARROW
+1 0 LEFTWARDS ARROW WITH STROKE
+1 0 RIGHTWARDS ARROW WITH STROKE
+1 0 LEFTWARDS WAVE ARROW
+1 0 RIGHTWARDS WAVE ARROW
+1 0 LEFTWARDS TWO HEADED ARROW
+1 0 UPWARDS TWO HEADED ARROW
+1 0 RIGHTWARDS TWO HEADED ARROW
+1 0 DOWNWARDS TWO HEADED ARROW
+1 0 LEFTWARDS ARROW WITH TAIL
+1 0 RIGHTWARDS ARROW WITH TAIL
+1 0 LEFTWARDS ARROW FROM BAR
+1 0 UPWARDS ARROW FROM BAR
+1 0 RIGHTWARDS ARROW FROM BAR
+1 0 DOWNWARDS ARROW FROM BAR
+1 0 UP DOWN ARROW WITH BASE
+1 0 LEFTWARDS ARROW WITH HOOK
+1 0 RIGHTWARDS ARROW WITH HOOK
+1 0 LEFTWARDS ARROW WITH LOOP
+1 0 RIGHTWARDS ARROW WITH LOOP
+1 0 LEFT RIGHT WAVE ARROW
+1 11 ARROW WITH STROKE
+1 0 DOWNWARDS ZIGZAG ARROW
+1 0 UPWARDS ARROW WITH TIP LEFTWARDS
+1 23 RIGHTWARDS
+1 0 DOWNWARDS ARROW WITH TIP LEFTWARDS
+1 25 RIGHTWARDS
+1 0 RIGHTWARDS ARROW WITH CORNER DOWNWARDS
+1 0 DOWNWARDS ARROW WITH CORNER LEFTWARDS
+1 0 ANTICLOCKWISE TOP SEMICIRCLE ARROW
+1 0 CLOCKWISE TOP SEMICIRCLE ARROW
+1 0 NORTH WEST ARROW TO LONG BAR
+1 0 LEFTWARDS ARROW TO BAR OVER RIGHTWARDS ARROW TO BAR
+1 0 ANTICLOCKWISE OPEN CIRCLE ARROW
+1 0 CLOCKWISE OPEN CIRCLE ARROW
+1 0 LEFTWARDS HARPOON WITH BARB UPWARDS
+1 28 DOWNWARDS
+1 0 UPWARDS HARPOON WITH BARB RIGHTWARDS
+1 26 LEFTWARDS
+1 0 RIGHTWARDS HARPOON WITH BARB UPWARDS
+1 29 DOWNWARDS
+1 0 DOWNWARDS HARPOON WITH BARB RIGHTWARDS
+1 28 LEFTWARDS
+1 0 RIGHTWARDS ARROW OVER LEFTWARDS ARROW
+1 0 UPWARDS ARROW LEFTWARDS OF DOWNWARDS ARROW
+1 0 LEFTWARDS ARROW OVER RIGHTWARDS ARROW
+1 10 PAIRED ARROWS
+1 0 UPWARDS PAIRED ARROWS
+1 0 RIGHTWARDS PAIRED ARROWS
+1 0 DOWNWARDS PAIRED ARROWS
+1 0 LEFTWARDS HARPOON OVER RIGHTWARDS HARPOON
+1 0 RIGHTWARDS HARPOON OVER LEFTWARDS HARPOON
+1 0 LEFTWARDS DOUBLE ARROW WITH STROKE
+1 4  RIGHT DOUBLE ARROW WITH STROKE
+1 0 RIGHTWARDS DOUBLE ARROW WITH STROKE
+1 0 LEFTWARDS DOUBLE ARROW
+1 0 UPWARDS DOUBLE ARROW
+1 0 RIGHTWARDS DOUBLE ARROW
+1 0 DOWNWARDS DOUBLE ARROW
+1 0 LEFT RIGHT DOUBLE ARROW
+1 0 UP DOWN DOUBLE ARROW
+1 0 NORTH WEST DOUBLE ARROW
+1 6 EAST DOUBLE ARROW
+1 0 SOUTH EAST DOUBLE ARROW
+1 6 WEST DOUBLE ARROW
+1 0 LEFTWARDS TRIPLE ARROW
+1 0 RIGHTWARDS TRIPLE ARROW
+1 0 LEFTWARDS SQUIGGLE ARROW
+1 0 RIGHTWARDS SQUIGGLE ARROW
+1 0 UPWARDS ARROW WITH DOUBLE STROKE
+1 0 DOWNWARDS ARROW WITH DOUBLE STROKE
+1 0 LEFTWARDS DASHED ARROW
+1 0 UPWARDS DASHED ARROW
+1 0 RIGHTWARDS DASHED ARROW
+1 0 DOWNWARDS DASHED ARROW
+1 0 LEFTWARDS ARROW TO BAR
+1 0 RIGHTWARDS ARROW TO BAR
+1 0 LEFTWARDS WHITE ARROW
+1 0 UPWARDS WHITE ARROW
+1 0 RIGHTWARDS WHITE ARROW
+1 0 DOWNWARDS WHITE ARROW
+1 0 UPWARDS WHITE ARROW FROM BAR
+1 20 ON PEDESTAL
+1 31  WITH HORIZONTAL BAR
+1 37 VERTICAL BAR
+1 14 DOUBLE ARROW
+1 26  ON PEDESTAL
+1 0 RIGHTWARDS WHITE ARROW FROM WALL
+1 0 NORTH WEST ARROW TO CORNER
+1 0 SOUTH EAST ARROW TO CORNER
+1 0 UP DOWN WHITE ARROW
+1 0 RIGHT ARROW WITH SMALL CIRCLE
+1 0 DOWNWARDS ARROW LEFTWARDS OF UPWARDS ARROW
+1 0 THREE RIGHTWARDS ARROWS
+1 0 LEFTWARDS ARROW WITH VERTICAL STROKE
+1 0 RIGHTWARDS ARROW WITH VERTICAL STROKE
+1 0 LEFT RIGHT ARROW WITH VERTICAL STROKE
+1 4 WARDS ARROW WITH DOUBLE VERTICAL STROKE
+1 0 RIGHTWARDS ARROW WITH DOUBLE VERTICAL STROKE
+1 0 LEFT RIGHT ARROW WITH DOUBLE VERTICAL STROKE
+1 4 WARDS OPEN-HEADED ARROW
+1 0 RIGHTWARDS OPEN-HEADED ARROW
+1 0 LEFT RIGHT OPEN-HEADED ARROW
+1 0 FOR ALL
+1 0 COMPLEMENT
+1 0 PARTIAL DIFFERENTIAL
+1 0 THERE EXISTS
+1 6 DOES NOT EXIST
+1 0 EMPTY SET
+1 0 INCREMENT
+1 0 NABLA
+1 0 ELEMENT OF
+1 0 NOT AN ELEMENT OF
+1 0 SMALL ELEMENT OF
+1 0 CONTAINS AS MEMBER
+1 0 DOES NOT CONTAIN AS MEMBER
+1 0 SMALL CONTAINS AS MEMBER
+1 0 END OF PROOF
+1 0 N-ARY PRODUCT
+1 6 COPRODUCT
+1 6 SUMMATION
+1 0 MINUS SIGN
+1 5 -OR-PLUS SIGN
+1 0 DOT PLUS
+1 1 IVISION SLASH
+1 0 SET MINUS
+1 0 ASTERISK OPERATOR
+1 0 RING OPERATOR
+1 0 BULLET OPERATOR
+1 0 SQUARE ROOT
+1 0 CUBE ROOT
+1 0 FOURTH ROOT
+1 0 PROPORTIONAL TO
+1 0 INFINITY
+1 0 RIGHT ANGLE
+1 0 ANGLE
+1 0 MEASURED ANGLE
+1 0 SPHERICAL ANGLE
+1 0 DIVIDES
+1 1 OES NOT DIVIDE
+1 0 PARALLEL TO
+1 0 NOT PARALLEL TO
+1 0 LOGICAL AND
+1 8 OR
+1 0 INTERSECTION
+1 0 UNION
+1 0 INTEGRAL
+1 0 DOUBLE INTEGRAL
+1 0 TRIPLE INTEGRAL
+1 0 CONTOUR INTEGRAL
+1 0 SURFACE INTEGRAL
+1 0 VOLUME INTEGRAL
+1 0 CLOCKWISE INTEGRAL
+1 10 CONTOUR INTEGRAL
+1 0 ANTICLOCKWISE CONTOUR INTEGRAL
+1 0 THEREFORE
+1 0 BECAUSE
+1 0 RATIO
+1 0 PROPORTION
+1 0 DOT MINUS
+1 0 EXCESS
+1 0 GEOMETRIC PROPORTION
+1 0 HOMOTHETIC
+1 0 TILDE OPERATOR
+1 0 REVERSED TILDE
+1 0 INVERTED LAZY S
+1 0 SINE WAVE
+1 0 WREATH PRODUCT
+1 0 NOT TILDE
+1 0 MINUS TILDE
+1 0 ASYMPTOTICALLY EQUAL TO
+1 0 NOT ASYMPTOTICALLY EQUAL TO
+1 0 APPROXIMATELY EQUAL TO
+1 14 BUT NOT ACTUALLY EQUAL TO
+1 0 NEITHER APPROXIMATELY NOR ACTUALLY EQUAL TO
+1 0 ALMOST EQUAL TO
+1 0 NOT ALMOST EQUAL TO
+1 0 ALMOST EQUAL OR EQUAL TO
+1 0 TRIPLE TILDE
+1 0 ALL EQUAL TO
+1 0 EQUIVALENT TO
+1 0 GEOMETRICALLY EQUIVALENT TO
+1 0 DIFFERENCE BETWEEN
+1 0 APPROACHES THE LIMIT
+1 0 GEOMETRICALLY EQUAL TO
+1 0 APPROXIMATELY EQUAL TO OR THE IMAGE OF
+1 0 IMAGE OF OR APPROXIMATELY EQUAL TO
+1 0 COLON EQUALS
+1 0 EQUALS COLON
+1 0 RING IN EQUAL TO
+1 5 EQUAL TO
+1 0 CORRESPONDS TO
+1 0 ESTIMATES
+1 1 QUIANGULAR TO
+1 0 STAR EQUALS
+1 0 DELTA EQUAL TO
+1 0 EQUAL TO BY DEFINITION
+1 0 MEASURED BY
+1 0 QUESTIONED EQUAL TO
+1 0 NOT EQUAL TO
+1 0 IDENTICAL TO
+1 0 NOT IDENTICAL TO
+1 0 STRICTLY EQUIVALENT TO
+1 0 LESS-THAN OR EQUAL TO
+1 0 GREATER-THAN OR EQUAL TO
+1 0 LESS-THAN OVER EQUAL TO
+1 0 GREATER-THAN OVER EQUAL TO
+1 0 LESS-THAN BUT NOT EQUAL TO
+1 0 GREATER-THAN BUT NOT EQUAL TO
+1 0 MUCH LESS-THAN
+1 5 GREATER-THAN
+1 0 BETWEEN
+1 0 NOT EQUIVALENT TO
+1 4 LESS-THAN
+1 4 GREATER-THAN
+1 1 EITHER LESS-THAN NOR EQUAL TO
+1 8 GREATER-THAN NOR EQUAL TO
+1 0 LESS-THAN OR EQUIVALENT TO
+1 0 GREATER-THAN OR EQUIVALENT TO
+1 0 NEITHER LESS-THAN NOR EQUIVALENT TO
+1 8 GREATER-THAN NOR EQUIVALENT TO
+1 0 LESS-THAN OR GREATER-THAN
+1 0 GREATER-THAN OR LESS-THAN
+1 0 NEITHER LESS-THAN NOR GREATER-THAN
+1 8 GREATER-THAN NOR LESS-THAN
+1 0 PRECEDES
+1 0 SUCCEEDS
+1 0 PRECEDES OR EQUAL TO
+1 0 SUCCEEDS OR EQUAL TO
+1 0 PRECEDES OR EQUIVALENT TO
+1 0 SUCCEEDS OR EQUIVALENT TO
+1 0 DOES NOT PRECEDE
+1 9 SUCCEED
+1 0 SUBSET OF
+1 2 PERSET OF
+1 0 NOT A SUBSET OF
+1 8 PERSET OF
+1 0 SUBSET OF OR EQUAL TO
+1 2 PERSET OF OR EQUAL TO
+1 0 NEITHER A SUBSET OF NOR EQUAL TO
+1 12 PERSET OF NOR EQUAL TO
+1 0 SUBSET OF WITH NOT EQUAL TO
+1 2 PERSET OF WITH NOT EQUAL TO
+1 0 MULTISET
+1 8  MULTIPLICATION
+1 9 UNION
+1 0 SQUARE IMAGE OF
+1 7 ORIGINAL OF
+1 7 IMAGE OF OR EQUAL TO
+1 7 ORIGINAL OF OR EQUAL TO
+1 7 CAP
+1 8 UP
+1 0 CIRCLED PLUS
+1 8 MINUS
+1 8 TIMES
+1 8 DIVISION SLASH
+1 9 OT OPERATOR
+1 8 RING OPERATOR
+1 8 ASTERISK OPERATOR
+1 8 EQUALS
+1 8 DASH
+1 0 SQUARED PLUS
+1 8 MINUS
+1 8 TIMES
+1 8 DOT OPERATOR
+1 0 RIGHT TACK
+1 0 LEFT TACK
+1 0 DOWN TACK
+1 0 UP TACK
+1 0 ASSERTION
+1 0 MODELS
+1 0 TRUE
+1 0 FORCES
+1 0 TRIPLE VERTICAL BAR RIGHT TURNSTILE
+1 0 DOUBLE VERTICAL BAR DOUBLE RIGHT TURNSTILE
+1 2 ES NOT PROVE
+1 0 NOT TRUE
+1 0 DOES NOT FORCE
+1 0 NEGATED DOUBLE VERTICAL BAR DOUBLE RIGHT TURNSTILE
+1 0 PRECEDES UNDER RELATION
+1 0 SUCCEEDS UNDER RELATION
+1 0 NORMAL SUBGROUP OF
+1 0 CONTAINS AS NORMAL SUBGROUP
+1 0 NORMAL SUBGROUP OF OR EQUAL TO
+1 0 CONTAINS AS NORMAL SUBGROUP OR EQUAL TO
+1 0 ORIGINAL OF
+1 0 IMAGE OF
+1 0 MULTIMAP
+1 0 HERMITIAN CONJUGATE MATRIX
+1 0 INTERCALATE
+1 0 XOR
+1 0 NAND
+1 1 OR
+1 0 RIGHT ANGLE WITH ARC
+1 6 TRIANGLE
+1 0 N-ARY LOGICAL AND
+1 14 OR
+1 6 INTERSECTION
+1 6 UNION
+1 0 DIAMOND OPERATOR
+1 1 OT OPERATOR
+1 0 STAR OPERATOR
+1 0 DIVISION TIMES
+1 0 BOWTIE
+1 0 LEFT NORMAL FACTOR SEMIDIRECT PRODUCT
+1 0 RIGHT NORMAL FACTOR SEMIDIRECT PRODUCT
+1 0 LEFT SEMIDIRECT PRODUCT
+1 0 RIGHT SEMIDIRECT PRODUCT
+1 1 EVERSED TILDE EQUALS
+1 0 CURLY LOGICAL OR
+1 14 AND
+1 0 DOUBLE SUBSET
+1 9 PERSET
+1 7 INTERSECTION
+1 7 UNION
+1 0 PITCHFORK
+1 0 EQUAL AND PARALLEL TO
+1 0 LESS-THAN WITH DOT
+1 0 GREATER-THAN WITH DOT
+1 0 VERY MUCH LESS-THAN
+1 10 GREATER-THAN
+1 0 LESS-THAN EQUAL TO OR GREATER-THAN
+1 0 GREATER-THAN EQUAL TO OR LESS-THAN
+1 0 EQUAL TO OR LESS-THAN
+1 12 GREATER-THAN
+1 12 PRECEDES
+1 12 SUCCEEDS
+1 0 DOES NOT PRECEDE OR EQUAL
+1 9 SUCCEED OR EQUAL
+1 0 NOT SQUARE IMAGE OF OR EQUAL TO
+1 11 ORIGINAL OF OR EQUAL TO
+1 0 SQUARE IMAGE OF OR NOT EQUAL TO
+1 7 ORIGINAL OF OR NOT EQUAL TO
+1 0 LESS-THAN BUT NOT EQUIVALENT TO
+1 0 GREATER-THAN BUT NOT EQUIVALENT TO
+1 0 PRECEDES BUT NOT EQUIVALENT TO
+1 0 SUCCEEDS BUT NOT EQUIVALENT TO
+1 0 NOT NORMAL SUBGROUP OF
+1 0 DOES NOT CONTAIN AS NORMAL SUBGROUP
+1 0 NOT NORMAL SUBGROUP OF OR EQUAL TO
+1 0 DOES NOT CONTAIN AS NORMAL SUBGROUP OR EQUAL
+1 0 VERTICAL ELLIPSIS
+1 0 MIDLINE HORIZONTAL ELLIPSIS
+1 0 UP RIGHT DIAGONAL ELLIPSIS
+1 0 DOWN RIGHT DIAGONAL ELLIPSIS
+1 0 ELEMENT OF WITH LONG HORIZONTAL STROKE
+1 16 VERTICAL BAR AT END OF HORIZONTAL STROKE
+1 0 SMALL ELEMENT OF WITH VERTICAL BAR AT END OF HORIZONTAL STROKE
+1 0 ELEMENT OF WITH DOT ABOVE
+1 16 OVERBAR
+1 0 SMALL ELEMENT OF WITH OVERBAR
+1 0 ELEMENT OF WITH UNDERBAR
+1 16 TWO HORIZONTAL STROKES
+1 0 CONTAINS WITH LONG HORIZONTAL STROKE
+1 14 VERTICAL BAR AT END OF HORIZONTAL STROKE
+1 0 SMALL CONTAINS WITH VERTICAL BAR AT END OF HORIZONTAL STROKE
+1 0 CONTAINS WITH OVERBAR
+1 0 SMALL CONTAINS WITH OVERBAR
+1 0 Z NOTATION BAG MEMBERSHIP
+1 0 DIAMETER SIGN
+1 0 ELECTRIC ARROW
+1 0 HOUSE
+1 0 UP ARROWHEAD
+1 0 DOWN ARROWHEAD
+1 0 PROJECTIVE
+1 1 ERSPECTIVE
+1 0 WAVY LINE
+1 0 LEFT CEILING
+1 0 RIGHT CEILING
+1 0 LEFT FLOOR
+1 0 RIGHT FLOOR
+1 0 BOTTOM RIGHT CROP
+1 7 LEFT CROP
+1 0 TOP RIGHT CROP
+1 4 LEFT CROP
+1 0 REVERSED NOT SIGN
+1 0 SQUARE LOZENGE
+1 0 ARC
+1 0 SEGMENT
+1 2 CTOR
+1 0 TELEPHONE RECORDER
+1 0 POSITION INDICATOR
+1 0 VIEWDATA SQUARE
+1 0 PLACE OF INTEREST SIGN
+1 0 TURNED NOT SIGN
+1 0 WATCH
+1 0 HOURGLASS
+1 0 TOP LEFT CORNER
+1 4 RIGHT CORNER
+1 0 BOTTOM LEFT CORNER
+1 7 RIGHT CORNER
+1 0 TOP HALF INTEGRAL
+1 0 BOTTOM HALF INTEGRAL
+1 0 FROWN
+1 0 SMILE
+1 0 UP ARROWHEAD BETWEEN TWO HORIZONTAL BARS
+1 0 OPTION KEY
+1 0 ERASE TO THE RIGHT
+1 0 X IN A RECTANGLE BOX
+1 0 KEYBOARD
+1 0 LEFT-POINTING ANGLE BRACKET
+1 0 RIGHT-POINTING ANGLE BRACKET
+1 0 ERASE TO THE LEFT
+1 0 BENZENE RING
+1 0 CYLINDRICITY
+1 0 ALL AROUND-PROFILE
+1 0 SYMMETRY
+1 0 TOTAL RUNOUT
+1 0 DIMENSION ORIGIN
+1 0 CONICAL TAPER
+1 0 SLOPE
+1 0 COUNTERBORE
+1 7 SINK
+1 0 APL FUNCTIONAL SYMBOL I-BEAM
+1 22 SQUISH QUAD
+1 22 QUAD EQUAL
+1 27 DIVIDE
+1 29 AMOND
+1 27 JOT
+1 27 CIRCLE
+1 22 CIRCLE STILE
+1 29 JOT
+1 22 SLASH BAR
+1 22 BACKSLASH BAR
+1 22 QUAD SLASH
+1 27 BACKSLASH
+1 27 LESS-THAN
+1 27 GREATER-THAN
+1 22 LEFTWARDS VANE
+1 22 RIGHTWARDS VANE
+1 22 QUAD LEFTWARDS ARROW
+1 27 RIGHTWARDS ARROW
+1 22 CIRCLE BACKSLASH
+1 22 DOWN TACK UNDERBAR
+1 23 ELTA STILE
+1 22 QUAD DOWN CARET
+1 28 ELTA
+1 22 DOWN TACK JOT
+1 22 UPWARDS VANE
+1 22 QUAD UPWARDS ARROW
+1 22 UP TACK OVERBAR
+1 22 DEL STILE
+1 22 QUAD UP CARET
+1 27 DEL
+1 22 UP TACK JOT
+1 22 DOWNWARDS VANE
+1 22 QUAD DOWNWARDS ARROW
+1 24 OTE UNDERBAR
+1 22 DELTA UNDERBAR
+1 23 IAMOND UNDERBAR
+1 22 JOT UNDERBAR
+1 22 CIRCLE UNDERBAR
+1 22 UP SHOE JOT
+1 22 QUOTE QUAD
+1 22 CIRCLE STAR
+1 22 QUAD COLON
+1 22 UP TACK DIAERESIS
+1 22 DEL DIAERESIS
+1 22 STAR DIAERESIS
+1 22 JOT DIAERESIS
+1 22 CIRCLE DIAERESIS
+1 22 DOWN SHOE STILE
+1 22 LEFT SHOE STILE
+1 22 TILDE DIAERESIS
+1 22 GREATER-THAN DIAERESIS
+1 22 COMMA BAR
+1 22 DEL TILDE
+1 22 ZILDE
+1 22 STILE TILDE
+1 23 EMICOLON UNDERBAR
+1 22 QUAD NOT EQUAL
+1 27 QUESTION
+1 22 DOWN CARET TILDE
+1 22 UP CARET TILDE
+1 22 IOTA
+1 22 RHO
+1 22 OMEGA
+1 22 ALPHA UNDERBAR
+1 22 EPSILON UNDERBAR
+1 22 IOTA UNDERBAR
+1 22 OMEGA UNDERBAR
+1 22 ALPHA
+1 0 NOT CHECK MARK
+1 0 RIGHT ANGLE WITH DOWNWARDS ZIGZAG ARROW
+1 0 SHOULDERED OPEN BOX
+1 0 BELL SYMBOL
+1 0 VERTICAL LINE WITH MIDDLE DOT
+1 0 INSERTION SYMBOL
+1 0 CONTINUOUS UNDERLINE SYMBOL
+1 0 DISCONTINUOUS UNDERLINE SYMBOL
+1 0 EMPHASIS SYMBOL
+1 0 COMPOSITION SYMBOL
+1 0 WHITE SQUARE WITH CENTRE VERTICAL LINE
+1 0 ENTER SYMBOL
+1 0 ALTERNATIVE KEY SYMBOL
+1 0 HELM SYMBOL
+1 0 CIRCLED HORIZONTAL BAR WITH NOTCH
+1 8 TRIANGLE DOWN
+1 0 BROKEN CIRCLE WITH NORTHWEST ARROW
+1 0 UNDO SYMBOL
+1 0 MONOSTABLE SYMBOL
+1 0 HYSTERESIS SYMBOL
+1 0 OPEN-CIRCUIT-OUTPUT H-TYPE SYMBOL
+1 20 L-TYPE SYMBOL
+1 0 PASSIVE-PULL-DOWN-OUTPUT SYMBOL
+1 13 UP-OUTPUT SYMBOL
+1 0 DIRECT CURRENT SYMBOL FORM TWO
+1 0 SOFTWARE-FUNCTION SYMBOL
+1 0 APL FUNCTIONAL SYMBOL QUAD
+1 0 DECIMAL SEPARATOR KEY SYMBOL
+1 0 PREVIOUS PAGE
+1 0 NEXT PAGE
+1 0 PRINT SCREEN SYMBOL
+1 0 CLEAR SCREEN SYMBOL
+1 0 LEFT PARENTHESIS UPPER HOOK
+1 17 EXTENSION
+1 17 LOWER HOOK
+1 0 RIGHT PARENTHESIS UPPER HOOK
+1 18 EXTENSION
+1 18 LOWER HOOK
+1 0 LEFT SQUARE BRACKET UPPER CORNER
+1 20 EXTENSION
+1 20 LOWER CORNER
+1 0 RIGHT SQUARE BRACKET UPPER CORNER
+1 21 EXTENSION
+1 21 LOWER CORNER
+1 0 LEFT CURLY BRACKET UPPER HOOK
+1 19 MIDDLE PIECE
+1 19 LOWER HOOK
+1 0 CURLY BRACKET EXTENSION
+1 0 RIGHT CURLY BRACKET UPPER HOOK
+1 20 MIDDLE PIECE
+1 20 LOWER HOOK
+1 0 INTEGRAL EXTENSION
+1 0 HORIZONTAL LINE EXTENSION
+1 0 UPPER LEFT OR LOWER RIGHT CURLY BRACKET SECTION
+1 6 RIGHT OR LOWER LEFT CURLY BRACKET SECTION
+1 0 SUMMATION TOP
+1 10 BOTTOM
+1 0 TOP SQUARE BRACKET
+1 0 BOTTOM SQUARE BRACKET
+1 21  OVER TOP SQUARE BRACKET
+1 0 RADICAL SYMBOL BOTTOM
+1 0 LEFT VERTICAL BOX LINE
+1 0 RIGHT VERTICAL BOX LINE
+1 0 HORIZONTAL SCAN LINE-1
+1 21 3
+1 21 7
+1 21 9
+1 0 DENTISTRY SYMBOL LIGHT VERTICAL AND TOP RIGHT
+1 36 BOTTOM RIGHT
+1 32 WITH CIRCLE
+1 23 DOWN AND HORIZONTAL WITH CIRCLE
+1 23 UP AND HORIZONTAL WITH CIRCLE
+1 23 VERTICAL WITH TRIANGLE
+1 23 DOWN AND HORIZONTAL WITH TRIANGLE
+1 23 UP AND HORIZONTAL WITH TRIANGLE
+1 23 VERTICAL AND WAVE
+1 23 DOWN AND HORIZONTAL WITH WAVE
+1 23 UP AND HORIZONTAL WITH WAVE
+1 23 DOWN AND HORIZONTAL
+1 23 UP AND HORIZONTAL
+1 23 VERTICAL AND TOP LEFT
+1 36 BOTTOM LEFT
+1 0 SQUARE FOOT
+1 0 RETURN SYMBOL
+1 0 EJECT SYMBOL
+1 0 VERTICAL LINE EXTENSION
+1 0 METRICAL BREVE
+1 9 LONG OVER SHORT
+1 9 SHORT OVER LONG
+1 9 LONG OVER TWO SHORTS
+1 9 TWO SHORTS OVER LONG
+1 20 JOINED
+1 10 RISEME
+1 10 ETRASEME
+1 9 PENTASEME
+1 0 EARTH GROUND
+1 0 FUSE
+1 0 TOP PARENTHESIS
+1 0 BOTTOM PARENTHESIS
+1 0 TOP CURLY BRACKET
+1 0 BOTTOM CURLY BRACKET
+1 0 TOP TORTOISE SHELL BRACKET
+1 0 BOTTOM TORTOISE SHELL BRACKET
+1 0 WHITE TRAPEZIUM
+1 0 BENZENE RING WITH CIRCLE
+1 0 STRAIGHTNESS
+1 0 FLATNESS
+1 0 AC CURRENT
+1 0 ELECTRICAL INTERSECTION
+1 0 DECIMAL EXPONENT SYMBOL
+1 0 BLACK RIGHT-POINTING DOUBLE TRIANGLE
+1 6 LEFT-POINTING DOUBLE TRIANGLE
+1 6 UP-POINTING DOUBLE TRIANGLE
+1 6 DOWN-POINTING DOUBLE TRIANGLE
+1 6 RIGHT-POINTING DOUBLE TRIANGLE WITH VERTICAL BAR
+1 6 LEFT-POINTING DOUBLE TRIANGLE WITH VERTICAL BAR
+1 6 RIGHT-POINTING TRIANGLE WITH DOUBLE VERTICAL BAR
+1 0 ALARM CLOCK
+1 0 STOPWATCH
+1 0 TIMER CLOCK
+1 0 HOURGLASS WITH FLOWING SAND
+1 0 BLACK MEDIUM LEFT-POINTING TRIANGLE
+1 13 RIGHT-POINTING TRIANGLE
+1 13 UP-POINTING TRIANGLE
+1 13 DOWN-POINTING TRIANGLE
+1 0 DOUBLE VERTICAL BAR
+1 0 BLACK SQUARE FOR STOP
+1 6 CIRCLE FOR RECORD
+1 0 POWER SYMBOL
+1 6 ON-OFF SYMBOL
+1 8  SYMBOL
+1 6 SLEEP SYMBOL
+1 0 OBSERVER EYE SYMBOL
+1 0 SYMBOL FOR NULL
+1 11 START OF HEADING
+1 20 TEXT
+1 11 END OF TEXT
+1 19 RANSMISSION
+1 13 QUIRY
+1 11 ACKNOWLEDGE
+1 11 BELL
+1 12 ACKSPACE
+1 11 HORIZONTAL TABULATION
+1 11 LINE FEED
+1 11 VERTICAL TABULATION
+1 11 FORM FEED
+1 11 CARRIAGE RETURN
+1 11 SHIFT OUT
+1 17 IN
+1 11 DATA LINK ESCAPE
+1 12 EVICE CONTROL ONE
+1 26 TWO
+1 27 HREE
+1 26 FOUR
+1 11 NEGATIVE ACKNOWLEDGE
+1 11 SYNCHRONOUS IDLE
+1 11 END OF TRANSMISSION BLOCK
+1 11 CANCEL
+1 11 END OF MEDIUM
+1 11 SUBSTITUTE
+1 11 ESCAPE
+1 11 FILE SEPARATOR
+1 11 GROUP SEPARATOR
+1 11 RECORD SEPARATOR
+1 11 UNIT SEPARATOR
+1 11 SPACE
+1 11 DELETE
+1 0 BLANK SYMBOL
+1 0 OPEN BOX
+1 0 SYMBOL FOR NEWLINE
+1 11 DELETE FORM TWO
+1 11 SUBSTITUTE FORM TWO
+1a 0 OCR HOOK
+1 4 CHAIR
+1 4 FORK
+1 4 INVERTED FORK
+1 4 BELT BUCKLE
+1 5 OW TIE
+1 5 RANCH BANK IDENTIFICATION
+1 4 AMOUNT OF CHECK
+1 4 DASH
+1 4 CUSTOMER ACCOUNT NUMBER
+1 4 DOUBLE BACKSLASH
+16 0 CIRCLED DIGIT ONE
+1 14 TWO
+1 15 HREE
+1 14 FOUR
+1 15 IVE
+1 14 SIX
+1 15 EVEN
+1 14 EIGHT
+1 14 NINE
+1 8 NUMBER TEN
+1 15 ELEVEN
+1 15 TWELVE
+1 16 HIRTEEN
+1 15 FOURTEEN
+1 16 IFTEEN
+1 15 SIXTEEN
+1 16 EVENTEEN
+1 15 EIGHTEEN
+1 15 NINETEEN
+1 15 TWENTY
+1 0 PARENTHESIZED DIGIT ONE
+1 20 TWO
+1 21 HREE
+1 20 FOUR
+1 21 IVE
+1 20 SIX
+1 21 EVEN
+1 20 EIGHT
+1 20 NINE
+1 14 NUMBER TEN
+1 21 ELEVEN
+1 21 TWELVE
+1 22 HIRTEEN
+1 21 FOURTEEN
+1 22 IFTEEN
+1 21 SIXTEEN
+1 22 EVENTEEN
+1 21 EIGHTEEN
+1 21 NINETEEN
+1 21 TWENTY
+1 0 DIGIT ONE FULL STOP
+1 6 TWO FULL STOP
+1 7 HREE FULL STOP
+1 6 FOUR FULL STOP
+1 7 IVE FULL STOP
+1 6 SIX FULL STOP
+1 7 EVEN FULL STOP
+1 6 EIGHT FULL STOP
+1 6 NINE FULL STOP
+1 0 NUMBER TEN FULL STOP
+1 7 ELEVEN FULL STOP
+1 7 TWELVE FULL STOP
+1 8 HIRTEEN FULL STOP
+1 7 FOURTEEN FULL STOP
+1 8 IFTEEN FULL STOP
+1 7 SIXTEEN FULL STOP
+1 8 EVENTEEN FULL STOP
+1 7 EIGHTEEN FULL STOP
+1 7 NINETEEN FULL STOP
+1 7 TWENTY FULL STOP
+1 0 PARENTHESIZED LATIN SMALL LETTER A
+1 33 B
+1 33 C
+1 33 D
+1 33 E
+1 33 F
+1 33 G
+1 33 H
+1 33 I
+1 33 J
+1 33 K
+1 33 L
+1 33 M
+1 33 N
+1 33 O
+1 33 P
+1 33 Q
+1 33 R
+1 33 S
+1 33 T
+1 33 U
+1 33 V
+1 33 W
+1 33 X
+1 33 Y
+1 33 Z
+1 0 CIRCLED LATIN CAPITAL LETTER A
+1 29 B
+1 29 C
+1 29 D
+1 29 E
+1 29 F
+1 29 G
+1 29 H
+1 29 I
+1 29 J
+1 29 K
+1 29 L
+1 29 M
+1 29 N
+1 29 O
+1 29 P
+1 29 Q
+1 29 R
+1 29 S
+1 29 T
+1 29 U
+1 29 V
+1 29 W
+1 29 X
+1 29 Y
+1 29 Z
+1 14 SMALL LETTER A
+1 27 B
+1 27 C
+1 27 D
+1 27 E
+1 27 F
+1 27 G
+1 27 H
+1 27 I
+1 27 J
+1 27 K
+1 27 L
+1 27 M
+1 27 N
+1 27 O
+1 27 P
+1 27 Q
+1 27 R
+1 27 S
+1 27 T
+1 27 U
+1 27 V
+1 27 W
+1 27 X
+1 27 Y
+1 27 Z
+1 8 DIGIT ZERO
+1 0 NEGATIVE CIRCLED NUMBER ELEVEN
+1 24 TWELVE
+1 25 HIRTEEN
+1 24 FOURTEEN
+1 25 IFTEEN
+1 24 SIXTEEN
+1 25 EVENTEEN
+1 24 EIGHTEEN
+1 24 NINETEEN
+1 24 TWENTY
+1 0 DOUBLE CIRCLED DIGIT ONE
+1 21 TWO
+1 22 HREE
+1 21 FOUR
+1 22 IVE
+1 21 SIX
+1 22 EVEN
+1 21 EIGHT
+1 21 NINE
+1 15 NUMBER TEN
+1 0 NEGATIVE CIRCLED DIGIT ZERO
+1 0 BOX DRAWINGS LIGHT HORIZONTAL
+1 13 HEAVY HORIZONTAL
+1 13 LIGHT VERTICAL
+1 13 HEAVY VERTICAL
+1 13 LIGHT TRIPLE DASH HORIZONTAL
+1 13 HEAVY TRIPLE DASH HORIZONTAL
+1 13 LIGHT TRIPLE DASH VERTICAL
+1 13 HEAVY TRIPLE DASH VERTICAL
+1 13 LIGHT QUADRUPLE DASH HORIZONTAL
+1 13 HEAVY QUADRUPLE DASH HORIZONTAL
+1 13 LIGHT QUADRUPLE DASH VERTICAL
+1 13 HEAVY QUADRUPLE DASH VERTICAL
+1 13 LIGHT DOWN AND RIGHT
+1 13 DOWN LIGHT AND RIGHT HEAVY
+1 18 HEAVY AND RIGHT LIGHT
+1 13 HEAVY DOWN AND RIGHT
+1 13 LIGHT DOWN AND LEFT
+1 13 DOWN LIGHT AND LEFT HEAVY
+1 18 HEAVY AND LEFT LIGHT
+1 13 HEAVY DOWN AND LEFT
+1 13 LIGHT UP AND RIGHT
+1 13 UP LIGHT AND RIGHT HEAVY
+1 16 HEAVY AND RIGHT LIGHT
+1 13 HEAVY UP AND RIGHT
+1 13 LIGHT UP AND LEFT
+1 13 UP LIGHT AND LEFT HEAVY
+1 16 HEAVY AND LEFT LIGHT
+1 13 HEAVY UP AND LEFT
+1 13 LIGHT VERTICAL AND RIGHT
+1 13 VERTICAL LIGHT AND RIGHT HEAVY
+1 13 UP HEAVY AND RIGHT DOWN LIGHT
+1 13 DOWN HEAVY AND RIGHT UP LIGHT
+1 13 VERTICAL HEAVY AND RIGHT LIGHT
+1 13 DOWN LIGHT AND RIGHT UP HEAVY
+1 13 UP LIGHT AND RIGHT DOWN HEAVY
+1 13 HEAVY VERTICAL AND RIGHT
+1 13 LIGHT VERTICAL AND LEFT
+1 13 VERTICAL LIGHT AND LEFT HEAVY
+1 13 UP HEAVY AND LEFT DOWN LIGHT
+1 13 DOWN HEAVY AND LEFT UP LIGHT
+1 13 VERTICAL HEAVY AND LEFT LIGHT
+1 13 DOWN LIGHT AND LEFT UP HEAVY
+1 13 UP LIGHT AND LEFT DOWN HEAVY
+1 13 HEAVY VERTICAL AND LEFT
+1 13 LIGHT DOWN AND HORIZONTAL
+1 14 EFT HEAVY AND RIGHT DOWN LIGHT
+1 13 RIGHT HEAVY AND LEFT DOWN LIGHT
+1 13 DOWN LIGHT AND HORIZONTAL HEAVY
+1 18 HEAVY AND HORIZONTAL LIGHT
+1 13 RIGHT LIGHT AND LEFT DOWN HEAVY
+1 13 LEFT LIGHT AND RIGHT DOWN HEAVY
+1 13 HEAVY DOWN AND HORIZONTAL
+1 13 LIGHT UP AND HORIZONTAL
+1 14 EFT HEAVY AND RIGHT UP LIGHT
+1 13 RIGHT HEAVY AND LEFT UP LIGHT
+1 13 UP LIGHT AND HORIZONTAL HEAVY
+1 16 HEAVY AND HORIZONTAL LIGHT
+1 13 RIGHT LIGHT AND LEFT UP HEAVY
+1 13 LEFT LIGHT AND RIGHT UP HEAVY
+1 13 HEAVY UP AND HORIZONTAL
+1 13 LIGHT VERTICAL AND HORIZONTAL
+1 14 EFT HEAVY AND RIGHT VERTICAL LIGHT
+1 13 RIGHT HEAVY AND LEFT VERTICAL LIGHT
+1 13 VERTICAL LIGHT AND HORIZONTAL HEAVY
+1 13 UP HEAVY AND DOWN HORIZONTAL LIGHT
+1 13 DOWN HEAVY AND UP HORIZONTAL LIGHT
+1 13 VERTICAL HEAVY AND HORIZONTAL LIGHT
+1 13 LEFT UP HEAVY AND RIGHT DOWN LIGHT
+1 13 RIGHT UP HEAVY AND LEFT DOWN LIGHT
+1 13 LEFT DOWN HEAVY AND RIGHT UP LIGHT
+1 13 RIGHT DOWN HEAVY AND LEFT UP LIGHT
+1 13 DOWN LIGHT AND UP HORIZONTAL HEAVY
+1 13 UP LIGHT AND DOWN HORIZONTAL HEAVY
+1 13 RIGHT LIGHT AND LEFT VERTICAL HEAVY
+1 13 LEFT LIGHT AND RIGHT VERTICAL HEAVY
+1 13 HEAVY VERTICAL AND HORIZONTAL
+1 13 LIGHT DOUBLE DASH HORIZONTAL
+1 13 HEAVY DOUBLE DASH HORIZONTAL
+1 13 LIGHT DOUBLE DASH VERTICAL
+1 13 HEAVY DOUBLE DASH VERTICAL
+1 13 DOUBLE HORIZONTAL
+1 20 VERTICAL
+1 15 WN SINGLE AND RIGHT DOUBLE
+1 18 DOUBLE AND RIGHT SINGLE
+1 15 UBLE DOWN AND RIGHT
+1 15 WN SINGLE AND LEFT DOUBLE
+1 18 DOUBLE AND LEFT SINGLE
+1 15 UBLE DOWN AND LEFT
+1 13 UP SINGLE AND RIGHT DOUBLE
+1 16 DOUBLE AND RIGHT SINGLE
+1 13 DOUBLE UP AND RIGHT
+1 13 UP SINGLE AND LEFT DOUBLE
+1 16 DOUBLE AND LEFT SINGLE
+1 13 DOUBLE UP AND LEFT
+1 13 VERTICAL SINGLE AND RIGHT DOUBLE
+1 22 DOUBLE AND RIGHT SINGLE
+1 13 DOUBLE VERTICAL AND RIGHT
+1 13 VERTICAL SINGLE AND LEFT DOUBLE
+1 22 DOUBLE AND LEFT SINGLE
+1 13 DOUBLE VERTICAL AND LEFT
+1 15 WN SINGLE AND HORIZONTAL DOUBLE
+1 18 DOUBLE AND HORIZONTAL SINGLE
+1 15 UBLE DOWN AND HORIZONTAL
+1 13 UP SINGLE AND HORIZONTAL DOUBLE
+1 16 DOUBLE AND HORIZONTAL SINGLE
+1 13 DOUBLE UP AND HORIZONTAL
+1 13 VERTICAL SINGLE AND HORIZONTAL DOUBLE
+1 22 DOUBLE AND HORIZONTAL SINGLE
+1 13 DOUBLE VERTICAL AND HORIZONTAL
+1 13 LIGHT ARC DOWN AND RIGHT
+1 32 LEFT
+1 23 UP AND LEFT
+1 30 RIGHT
+1 19 DIAGONAL UPPER RIGHT TO LOWER LEFT
+1 34 LEFT TO LOWER RIGHT
+1 28 CROSS
+1 19 LEFT
+1 19 UP
+1 19 RIGHT
+1 19 DOWN
+1 13 HEAVY LEFT
+1 19 UP
+1 19 RIGHT
+1 19 DOWN
+1 13 LIGHT LEFT AND HEAVY RIGHT
+1 19 UP AND HEAVY DOWN
+1 13 HEAVY LEFT AND LIGHT RIGHT
+1 19 UP AND LIGHT DOWN
+1 0 UPPER HALF BLOCK
+1 0 LOWER ONE EIGHTH BLOCK
+1 10 QUARTER BLOCK
+1 6 THREE EIGHTHS BLOCK
+1 6 HALF BLOCK
+1 6 FIVE EIGHTHS BLOCK
+1 6 THREE QUARTERS BLOCK
+1 6 SEVEN EIGHTHS BLOCK
+1 0 FULL BLOCK
+1 0 LEFT SEVEN EIGHTHS BLOCK
+1 5 THREE QUARTERS BLOCK
+1 5 FIVE EIGHTHS BLOCK
+1 5 HALF BLOCK
+1 5 THREE EIGHTHS BLOCK
+1 5 ONE QUARTER BLOCK
+1 9 EIGHTH BLOCK
+1 0 RIGHT HALF BLOCK
+1 0 LIGHT SHADE
+1 0 MEDIUM SHADE
+1 0 DARK SHADE
+1 0 UPPER ONE EIGHTH BLOCK
+1 0 RIGHT ONE EIGHTH BLOCK
+1 0 QUADRANT LOWER LEFT
+1 15 RIGHT
+1 9 UPPER LEFT
+1 19  AND LOWER LEFT AND LOWER RIGHT
+1 30 RIGHT
+1 24 UPPER RIGHT AND LOWER LEFT
+1 46 RIGHT
+1 15 RIGHT
+1 20  AND LOWER LEFT
+1 35  AND LOWER RIGHT
+1 0 BLACK SQUARE
+1 0 WHITE SQUARE
+1 12  WITH ROUNDED CORNERS
+1 13 CONTAINING BLACK SMALL SQUARE
+1 0 SQUARE WITH HORIZONTAL FILL
+1 12 VERTICAL FILL
+1 12 ORTHOGONAL CROSSHATCH FILL
+1 12 UPPER LEFT TO LOWER RIGHT FILL
+1 18 RIGHT TO LOWER LEFT FILL
+1 12 DIAGONAL CROSSHATCH FILL
+1 0 BLACK SMALL SQUARE
+1 0 WHITE SMALL SQUARE
+1 0 BLACK RECTANGLE
+1 0 WHITE RECTANGLE
+1 0 BLACK VERTICAL RECTANGLE
+1 0 WHITE VERTICAL RECTANGLE
+1 0 BLACK PARALLELOGRAM
+1 0 WHITE PARALLELOGRAM
+1 0 BLACK UP-POINTING TRIANGLE
+1 0 WHITE UP-POINTING TRIANGLE
+1 0 BLACK UP-POINTING SMALL TRIANGLE
+1 0 WHITE UP-POINTING SMALL TRIANGLE
+1 0 BLACK RIGHT-POINTING TRIANGLE
+1 0 WHITE RIGHT-POINTING TRIANGLE
+1 0 BLACK RIGHT-POINTING SMALL TRIANGLE
+1 0 WHITE RIGHT-POINTING SMALL TRIANGLE
+1 0 BLACK RIGHT-POINTING POINTER
+1 0 WHITE RIGHT-POINTING POINTER
+1 0 BLACK DOWN-POINTING TRIANGLE
+1 0 WHITE DOWN-POINTING TRIANGLE
+1 0 BLACK DOWN-POINTING SMALL TRIANGLE
+1 0 WHITE DOWN-POINTING SMALL TRIANGLE
+1 0 BLACK LEFT-POINTING TRIANGLE
+1 0 WHITE LEFT-POINTING TRIANGLE
+1 0 BLACK LEFT-POINTING SMALL TRIANGLE
+1 0 WHITE LEFT-POINTING SMALL TRIANGLE
+1 0 BLACK LEFT-POINTING POINTER
+1 0 WHITE LEFT-POINTING POINTER
+1 0 BLACK DIAMOND
+1 0 WHITE DIAMOND
+1 13  CONTAINING BLACK SMALL DIAMOND
+1 0 FISHEYE
+1 0 LOZENGE
+1 0 WHITE CIRCLE
+1 0 DOTTED CIRCLE
+1 0 CIRCLE WITH VERTICAL FILL
+1 0 BULLSEYE
+1 1 LACK CIRCLE
+1 0 CIRCLE WITH LEFT HALF BLACK
+1 12 RIGHT HALF BLACK
+1 12 LOWER HALF BLACK
+1 12 UPPER HALF BLACK
+1 18 RIGHT QUADRANT BLACK
+1 12 ALL BUT UPPER LEFT QUADRANT BLACK
+1 0 LEFT HALF BLACK CIRCLE
+1 0 RIGHT HALF BLACK CIRCLE
+1 0 INVERSE BULLET
+1 8 WHITE CIRCLE
+1 0 UPPER HALF INVERSE WHITE CIRCLE
+1 0 LOWER HALF INVERSE WHITE CIRCLE
+1 0 UPPER LEFT QUADRANT CIRCULAR ARC
+1 6 RIGHT QUADRANT CIRCULAR ARC
+1 0 LOWER RIGHT QUADRANT CIRCULAR ARC
+1 6 LEFT QUADRANT CIRCULAR ARC
+1 0 UPPER HALF CIRCLE
+1 0 LOWER HALF CIRCLE
+1 0 BLACK LOWER RIGHT TRIANGLE
+1 12 LEFT TRIANGLE
+1 6 UPPER LEFT TRIANGLE
+1 12 RIGHT TRIANGLE
+1 0 WHITE BULLET
+1 0 SQUARE WITH LEFT HALF BLACK
+1 12 RIGHT HALF BLACK
+1 12 UPPER LEFT DIAGONAL HALF BLACK
+1 12 LOWER RIGHT DIAGONAL HALF BLACK
+1 0 WHITE SQUARE WITH VERTICAL BISECTING LINE
+1 6 UP-POINTING TRIANGLE WITH DOT
+1 0 UP-POINTING TRIANGLE WITH LEFT HALF BLACK
+1 26 RIGHT HALF BLACK
+1 0 LARGE CIRCLE
+1 0 WHITE SQUARE WITH UPPER LEFT QUADRANT
+1 18 LOWER LEFT QUADRANT
+1 24 RIGHT QUADRANT
+1 18 UPPER RIGHT QUADRANT
+1 6 CIRCLE WITH UPPER LEFT QUADRANT
+1 18 LOWER LEFT QUADRANT
+1 24 RIGHT QUADRANT
+1 18 UPPER RIGHT QUADRANT
+1 0 UPPER LEFT TRIANGLE
+1 6 RIGHT TRIANGLE
+1 0 LOWER LEFT TRIANGLE
+1 0 WHITE MEDIUM SQUARE
+1 0 BLACK MEDIUM SQUARE
+1 0 WHITE MEDIUM SMALL SQUARE
+1 0 BLACK MEDIUM SMALL SQUARE
+1 0 LOWER RIGHT TRIANGLE
+1 0 BLACK SUN WITH RAYS
+1 0 CLOUD
+1 0 UMBRELLA
+1 0 SNOWMAN
+1 0 COMET
+1 0 BLACK STAR
+1 0 WHITE STAR
+1 0 LIGHTNING
+1 0 THUNDERSTORM
+1 0 SUN
+1 0 ASCENDING NODE
+1 0 DESCENDING NODE
+1 0 CONJUNCTION
+1 0 OPPOSITION
+1 0 BLACK TELEPHONE
+1 0 WHITE TELEPHONE
+1 0 BALLOT BOX
+1 10  WITH CHECK
+1 16 X
+1 0 SALTIRE
+1 0 UMBRELLA WITH RAIN DROPS
+1 0 HOT BEVERAGE
+1 0 WHITE SHOGI PIECE
+1 0 BLACK SHOGI PIECE
+1 0 SHAMROCK
+1 0 REVERSED ROTATED FLORAL HEART BULLET
+1 0 BLACK LEFT POINTING INDEX
+1 6 RIGHT POINTING INDEX
+1 0 WHITE LEFT POINTING INDEX
+1 6 UP POINTING INDEX
+1 6 RIGHT POINTING INDEX
+1 6 DOWN POINTING INDEX
+1 0 SKULL AND CROSSBONES
+1 0 CAUTION SIGN
+1 0 RADIOACTIVE SIGN
+1 0 BIOHAZARD SIGN
+1 0 CADUCEUS
+1 0 ANKH
+1 0 ORTHODOX CROSS
+1 0 CHI RHO
+1 1 ROSS OF LORRAINE
+1 9 JERUSALEM
+1 0 STAR AND CRESCENT
+1 0 FARSI SYMBOL
+1 0 ADI SHAKTI
+1 0 HAMMER AND SICKLE
+1 0 PEACE SYMBOL
+1 0 YIN YANG
+1 0 TRIGRAM FOR HEAVEN
+1 12 LAKE
+1 12 FIRE
+1 12 THUNDER
+1 12 WIND
+1 13 ATER
+1 12 MOUNTAIN
+1 12 EARTH
+1 0 WHEEL OF DHARMA
+1 2 ITE FROWNING FACE
+1 6 SMILING FACE
+1 0 BLACK SMILING FACE
+1 0 WHITE SUN WITH RAYS
+1 0 FIRST QUARTER MOON
+1 0 LAST QUARTER MOON
+1 0 MERCURY
+1 0 FEMALE SIGN
+1 0 EARTH
+1 0 MALE SIGN
+1 0 JUPITER
+1 0 SATURN
+1 0 URANUS
+1 0 NEPTUNE
+1 0 PLUTO
+1 0 ARIES
+1 0 TAURUS
+1 0 GEMINI
+1 0 CANCER
+1 0 LEO
+1 0 VIRGO
+1 0 LIBRA
+1 0 SCORPIUS
+1 1 AGITTARIUS
+1 0 CAPRICORN
+1 0 AQUARIUS
+1 0 PISCES
+1 0 WHITE CHESS KING
+1 12 QUEEN
+1 12 ROOK
+1 12 BISHOP
+1 12 KNIGHT
+1 12 PAWN
+1 0 BLACK CHESS KING
+1 12 QUEEN
+1 12 ROOK
+1 12 BISHOP
+1 12 KNIGHT
+1 12 PAWN
+1 6 SPADE SUIT
+1 0 WHITE HEART SUIT
+1 6 DIAMOND SUIT
+1 0 BLACK CLUB SUIT
+1 0 WHITE SPADE SUIT
+1 0 BLACK HEART SUIT
+1 6 DIAMOND SUIT
+1 0 WHITE CLUB SUIT
+1 0 HOT SPRINGS
+1 0 QUARTER NOTE
+1 0 EIGHTH NOTE
+1 0 BEAMED EIGHTH NOTES
+1 7 SIXTEENTH NOTES
+1 0 MUSIC FLAT SIGN
+1 6 NATURAL SIGN
+1 6 SHARP SIGN
+1 0 WEST SYRIAC CROSS
+1 0 EAST SYRIAC CROSS
+1 0 UNIVERSAL RECYCLING SYMBOL
+1 0 RECYCLING SYMBOL FOR TYPE-1 PLASTICS
+1 26 2 PLASTICS
+1 26 3 PLASTICS
+1 26 4 PLASTICS
+1 26 5 PLASTICS
+1 26 6 PLASTICS
+1 26 7 PLASTICS
+1 21 GENERIC MATERIALS
+1 0 BLACK UNIVERSAL RECYCLING SYMBOL
+1 0 RECYCLED PAPER SYMBOL
+1 0 PARTIALLY-RECYCLED PAPER SYMBOL
+1 1 ERMANENT PAPER SIGN
+1 0 WHEELCHAIR SYMBOL
+1 0 DIE FACE-1
+1 9 2
+1 9 3
+1 9 4
+1 9 5
+1 9 6
+1 0 WHITE CIRCLE WITH DOT RIGHT
+1 18 TWO DOTS
+1 0 BLACK CIRCLE WITH WHITE DOT RIGHT
+1 18 TWO WHITE DOTS
+1 0 MONOGRAM FOR YANG
+1 14 IN
+1 0 DIGRAM FOR GREATER YANG
+1 11 LESSER YIN
+1 19 ANG
+1 11 GREATER YIN
+1 0 WHITE FLAG
+1 0 BLACK FLAG
+1 0 HAMMER AND PICK
+1 0 ANCHOR
+1 0 CROSSED SWORDS
+1 0 STAFF OF AESCULAPIUS
+1 1 CALES
+1 0 ALEMBIC
+1 0 FLOWER
+1 0 GEAR
+1 0 STAFF OF HERMES
+1 0 ATOM SYMBOL
+1 0 FLEUR-DE-LIS
+1 0 OUTLINED WHITE STAR
+1 0 THREE LINES CONVERGING RIGHT
+1 23 LEFT
+1 0 WARNING SIGN
+1 0 HIGH VOLTAGE SIGN
+1 0 DOUBLED FEMALE SIGN
+1 8 MALE SIGN
+1 0 INTERLOCKED FEMALE AND MALE SIGN
+1 0 MALE AND FEMALE SIGN
+1 5 WITH STROKE SIGN
+1 17 AND MALE AND FEMALE SIGN
+1 0 VERTICAL MALE WITH STROKE SIGN
+1 0 HORIZONTAL MALE WITH STROKE SIGN
+1 0 MEDIUM WHITE CIRCLE
+1 7 BLACK CIRCLE
+1 7 SMALL WHITE CIRCLE
+1 1 ARRIAGE SYMBOL
+1 0 DIVORCE SYMBOL
+1 0 UNMARRIED PARTNERSHIP SYMBOL
+1 0 COFFIN
+1 0 FUNERAL URN
+1 0 NEUTER
+1 0 CERES
+1 0 PALLAS
+1 0 JUNO
+1 0 VESTA
+1 0 CHIRON
+1 0 BLACK MOON LILITH
+1 0 SEXTILE
+1 2 MISEXTILE
+1 0 QUINCUNX
+1 0 SESQUIQUADRATE
+1 1 OCCER BALL
+1 0 BASEBALL
+1 0 SQUARED KEY
+1 0 WHITE DRAUGHTS MAN
+1 15 KING
+1 0 BLACK DRAUGHTS MAN
+1 15 KING
+1 0 SNOWMAN WITHOUT SNOW
+1 1 UN BEHIND CLOUD
+1 0 RAIN
+1 0 BLACK SNOWMAN
+1 0 THUNDER CLOUD AND RAIN
+1 1 URNED WHITE SHOGI PIECE
+1 7 BLACK SHOGI PIECE
+1 0 WHITE DIAMOND IN SQUARE
+1 0 CROSSING LANES
+1 0 DISABLED CAR
+1 0 OPHIUCHUS
+1 0 PICK
+1 0 CAR SLIDING
+1 0 HELMET WITH WHITE CROSS
+1 0 CIRCLED CROSSING LANES
+1 1 HAINS
+1 0 NO ENTRY
+1 0 ALTERNATE ONE-WAY LEFT WAY TRAFFIC
+1 0 BLACK TWO-WAY LEFT WAY TRAFFIC
+1 0 WHITE TWO-WAY LEFT WAY TRAFFIC
+1 0 BLACK LEFT LANE MERGE
+1 0 WHITE LEFT LANE MERGE
+1 0 DRIVE SLOW SIGN
+1 0 HEAVY WHITE DOWN-POINTING TRIANGLE
+1 0 LEFT CLOSED ENTRY
+1 0 SQUARED SALTIRE
+1 0 FALLING DIAGONAL IN WHITE CIRCLE IN BLACK SQUARE
+1 0 BLACK TRUCK
+1 0 RESTRICTED LEFT ENTRY-1
+1 22 2
+1 0 ASTRONOMICAL SYMBOL FOR URANUS
+1 0 HEAVY CIRCLE WITH STROKE AND TWO DOTS ABOVE
+1 0 PENTAGRAM
+1 0 RIGHT-HANDED INTERLACED PENTAGRAM
+1 0 LEFT-HANDED INTERLACED PENTAGRAM
+1 0 INVERTED PENTAGRAM
+1 0 BLACK CROSS ON SHIELD
+1 0 SHINTO SHRINE
+1 0 CHURCH
+1 1 ASTLE
+1 0 HISTORIC SITE
+1 0 GEAR WITHOUT HUB
+1 9  HANDLES
+1 0 MAP SYMBOL FOR LIGHTHOUSE
+1 1 OUNTAIN
+1 0 UMBRELLA ON GROUND
+1 0 FOUNTAIN
+1 1 LAG IN HOLE
+1 1 ERRY
+1 0 SAILBOAT
+1 1 QUARE FOUR CORNERS
+1 1 KIER
+1 0 ICE SKATE
+1 0 PERSON WITH BALL
+1 0 TENT
+1 0 JAPANESE BANK SYMBOL
+1 0 HEADSTONE GRAVEYARD SYMBOL
+1 0 FUEL PUMP
+1 0 CUP ON BLACK SQUARE
+1 0 WHITE FLAG WITH HORIZONTAL MIDDLE BLACK STRIPE
+1 0 BLACK SAFETY SCISSORS
+1 0 UPPER BLADE SCISSORS
+1 0 BLACK SCISSORS
+1 0 LOWER BLADE SCISSORS
+1 0 WHITE SCISSORS
+1 6 HEAVY CHECK MARK
+1 0 TELEPHONE LOCATION SIGN
+1 1 APE DRIVE
+1 0 AIRPLANE
+1 0 ENVELOPE
+1 0 RAISED FIST
+1 7 HAND
+1 0 VICTORY HAND
+1 0 WRITING HAND
+1 0 LOWER RIGHT PENCIL
+1 0 PENCIL
+1 0 UPPER RIGHT PENCIL
+1 0 WHITE NIB
+1 0 BLACK NIB
+1 0 CHECK MARK
+1 0 HEAVY CHECK MARK
+1 0 MULTIPLICATION X
+1 0 HEAVY MULTIPLICATION X
+1 0 BALLOT X
+1 0 HEAVY BALLOT X
+1 0 OUTLINED GREEK CROSS
+1 0 HEAVY GREEK CROSS
+1 0 OPEN CENTRE CROSS
+1 0 HEAVY OPEN CENTRE CROSS
+1 0 LATIN CROSS
+1 0 SHADOWED WHITE LATIN CROSS
+1 0 OUTLINED LATIN CROSS
+1 0 MALTESE CROSS
+1 0 STAR OF DAVID
+1 0 FOUR TEARDROP-SPOKED ASTERISK
+1 5 BALLOON-SPOKED ASTERISK
+1 0 HEAVY FOUR BALLOON-SPOKED ASTERISK
+1 0 FOUR CLUB-SPOKED ASTERISK
+1 0 BLACK FOUR POINTED STAR
+1 0 WHITE FOUR POINTED STAR
+1 0 SPARKLES
+1 1 TRESS OUTLINED WHITE STAR
+1 0 CIRCLED WHITE STAR
+1 0 OPEN CENTRE BLACK STAR
+1 0 BLACK CENTRE WHITE STAR
+1 0 OUTLINED BLACK STAR
+1 0 HEAVY OUTLINED BLACK STAR
+1 0 PINWHEEL STAR
+1 0 SHADOWED WHITE STAR
+1 0 HEAVY ASTERISK
+1 0 OPEN CENTRE ASTERISK
+1 0 EIGHT SPOKED ASTERISK
+1 6 POINTED BLACK STAR
+1 14 PINWHEEL STAR
+1 0 SIX POINTED BLACK STAR
+1 0 EIGHT POINTED RECTILINEAR BLACK STAR
+1 0 HEAVY EIGHT POINTED RECTILINEAR BLACK STAR
+1 0 TWELVE POINTED BLACK STAR
+1 0 SIXTEEN POINTED ASTERISK
+1 0 TEARDROP-SPOKED ASTERISK
+1 0 OPEN CENTRE TEARDROP-SPOKED ASTERISK
+1 0 HEAVY TEARDROP-SPOKED ASTERISK
+1 0 SIX PETALLED BLACK AND WHITE FLORETTE
+1 0 BLACK FLORETTE
+1 0 WHITE FLORETTE
+1 0 EIGHT PETALLED OUTLINED BLACK FLORETTE
+1 0 CIRCLED OPEN CENTRE EIGHT POINTED STAR
+1 0 HEAVY TEARDROP-SPOKED PINWHEEL ASTERISK
+1 0 SNOWFLAKE
+1 0 TIGHT TRIFOLIATE SNOWFLAKE
+1 0 HEAVY CHEVRON SNOWFLAKE
+1 0 SPARKLE
+1 0 HEAVY SPARKLE
+1 0 BALLOON-SPOKED ASTERISK
+1 0 EIGHT TEARDROP-SPOKED PROPELLER ASTERISK
+1 0 HEAVY EIGHT TEARDROP-SPOKED PROPELLER ASTERISK
+1 0 CROSS MARK
+1 0 SHADOWED WHITE CIRCLE
+1 0 NEGATIVE SQUARED CROSS MARK
+1 0 LOWER RIGHT DROP-SHADOWED WHITE SQUARE
+1 0 UPPER RIGHT DROP-SHADOWED WHITE SQUARE
+1 0 LOWER RIGHT SHADOWED WHITE SQUARE
+1 0 UPPER RIGHT SHADOWED WHITE SQUARE
+1 0 BLACK QUESTION MARK ORNAMENT
+1 0 WHITE QUESTION MARK ORNAMENT
+1 6 EXCLAMATION MARK ORNAMENT
+1 0 BLACK DIAMOND MINUS WHITE X
+1 0 HEAVY EXCLAMATION MARK SYMBOL
+1 0 LIGHT VERTICAL BAR
+1 0 MEDIUM VERTICAL BAR
+1 0 HEAVY VERTICAL BAR
+1 6 SINGLE TURNED COMMA QUOTATION MARK ORNAMENT
+1 13 COMMA QUOTATION MARK ORNAMENT
+1 6 DOUBLE TURNED COMMA QUOTATION MARK ORNAMENT
+1 13 COMMA QUOTATION MARK ORNAMENT
+1 6 LOW SINGLE COMMA QUOTATION MARK ORNAMENT
+1 10 DOUBLE COMMA QUOTATION MARK ORNAMENT
+1 0 CURVED STEM PARAGRAPH SIGN ORNAMENT
+1 0 HEAVY EXCLAMATION MARK ORNAMENT
+1 6 HEART EXCLAMATION MARK ORNAMENT
+1 6 BLACK HEART
+1 0 ROTATED HEAVY BLACK HEART BULLET
+1 0 FLORAL HEART
+1 0 ROTATED FLORAL HEART BULLET
+1 0 MEDIUM LEFT PARENTHESIS ORNAMENT
+1 7 RIGHT PARENTHESIS ORNAMENT
+1 7 FLATTENED LEFT PARENTHESIS ORNAMENT
+1 17 RIGHT PARENTHESIS ORNAMENT
+1 7 LEFT-POINTING ANGLE BRACKET ORNAMENT
+1 7 RIGHT-POINTING ANGLE BRACKET ORNAMENT
+1 0 HEAVY LEFT-POINTING ANGLE QUOTATION MARK ORNAMENT
+1 6 RIGHT-POINTING ANGLE QUOTATION MARK ORNAMENT
+1 6 LEFT-POINTING ANGLE BRACKET ORNAMENT
+1 6 RIGHT-POINTING ANGLE BRACKET ORNAMENT
+1 0 LIGHT LEFT TORTOISE SHELL BRACKET ORNAMENT
+1 6 RIGHT TORTOISE SHELL BRACKET ORNAMENT
+1 0 MEDIUM LEFT CURLY BRACKET ORNAMENT
+1 7 RIGHT CURLY BRACKET ORNAMENT
+1 0 DINGBAT NEGATIVE CIRCLED DIGIT ONE
+1 31 TWO
+1 32 HREE
+1 31 FOUR
+1 32 IVE
+1 31 SIX
+1 32 EVEN
+1 31 EIGHT
+1 31 NINE
+1 25 NUMBER TEN
+1 8 CIRCLED SANS-SERIF DIGIT ONE
+1 33 TWO
+1 34 HREE
+1 33 FOUR
+1 34 IVE
+1 33 SIX
+1 34 EVEN
+1 33 EIGHT
+1 33 NINE
+1 27 NUMBER TEN
+1 8 NEGATIVE CIRCLED SANS-SERIF DIGIT ONE
+1 42 TWO
+1 43 HREE
+1 42 FOUR
+1 43 IVE
+1 42 SIX
+1 43 EVEN
+1 42 EIGHT
+1 42 NINE
+1 36 NUMBER TEN
+1 0 HEAVY WIDE-HEADED RIGHTWARDS ARROW
+1 6 PLUS SIGN
+1 6 MINUS SIGN
+1 6 DIVISION SIGN
+1 6 SOUTH EAST ARROW
+1 6 RIGHTWARDS ARROW
+1 6 NORTH EAST ARROW
+1 0 DRAFTING POINT RIGHTWARDS ARROW
+1 0 HEAVY ROUND-TIPPED RIGHTWARDS ARROW
+1 0 TRIANGLE-HEADED RIGHTWARDS ARROW
+1 0 HEAVY TRIANGLE-HEADED RIGHTWARDS ARROW
+1 0 DASHED TRIANGLE-HEADED RIGHTWARDS ARROW
+1 0 HEAVY DASHED TRIANGLE-HEADED RIGHTWARDS ARROW
+1 0 BLACK RIGHTWARDS ARROW
+1 0 THREE-D TOP-LIGHTED RIGHTWARDS ARROWHEAD
+1 8 BOTTOM-LIGHTED RIGHTWARDS ARROWHEAD
+1 0 BLACK RIGHTWARDS ARROWHEAD
+1 0 HEAVY BLACK CURVED DOWNWARDS AND RIGHTWARDS ARROW
+1 19 UPWARDS AND RIGHTWARDS ARROW
+1 0 SQUAT BLACK RIGHTWARDS ARROW
+1 0 HEAVY CONCAVE-POINTED BLACK RIGHTWARDS ARROW
+1 0 RIGHT-SHADED WHITE RIGHTWARDS ARROW
+1 0 LEFT-SHADED WHITE RIGHTWARDS ARROW
+1 0 BACK-TILTED SHADOWED WHITE RIGHTWARDS ARROW
+1 0 FRONT-TILTED SHADOWED WHITE RIGHTWARDS ARROW
+1 0 HEAVY LOWER RIGHT-SHADOWED WHITE RIGHTWARDS ARROW
+1 6 UPPER RIGHT-SHADOWED WHITE RIGHTWARDS ARROW
+1 0 NOTCHED LOWER RIGHT-SHADOWED WHITE RIGHTWARDS ARROW
+1 0 CURLY LOOP
+1 0 NOTCHED UPPER RIGHT-SHADOWED WHITE RIGHTWARDS ARROW
+1 0 CIRCLED HEAVY WHITE RIGHTWARDS ARROW
+1 0 WHITE-FEATHERED RIGHTWARDS ARROW
+1 0 BLACK-FEATHERED SOUTH EAST ARROW
+1 16 RIGHTWARDS ARROW
+1 16 NORTH EAST ARROW
+1 0 HEAVY BLACK-FEATHERED SOUTH EAST ARROW
+1 22 RIGHTWARDS ARROW
+1 22 NORTH EAST ARROW
+1 0 TEARDROP-BARBED RIGHTWARDS ARROW
+1 0 HEAVY TEARDROP-SHANKED RIGHTWARDS ARROW
+1 0 WEDGE-TAILED RIGHTWARDS ARROW
+1 0 HEAVY WEDGE-TAILED RIGHTWARDS ARROW
+1 0 OPEN-OUTLINED RIGHTWARDS ARROW
+1 0 DOUBLE CURLY LOOP
+1 0 THREE DIMENSIONAL ANGLE
+1 0 WHITE TRIANGLE CONTAINING SMALL WHITE TRIANGLE
+1 0 PERPENDICULAR
+1 0 OPEN SUBSET
+1 7 PERSET
+1 0 LEFT S-SHAPED BAG DELIMITER
+1 0 RIGHT S-SHAPED BAG DELIMITER
+1 0 OR WITH DOT INSIDE
+1 0 REVERSE SOLIDUS PRECEDING SUBSET
+1 0 SUPERSET PRECEDING SOLIDUS
+1 0 VERTICAL BAR WITH HORIZONTAL STROKE
+1 0 MATHEMATICAL RISING DIAGONAL
+1 0 LONG DIVISION
+1 0 MATHEMATICAL FALLING DIAGONAL
+1 0 SQUARED LOGICAL AND
+1 16 OR
+1 0 WHITE DIAMOND WITH CENTRED DOT
+1 0 AND WITH DOT
+1 0 ELEMENT OF OPENING UPWARDS
+1 0 LOWER RIGHT CORNER WITH DOT
+1 0 UPPER LEFT CORNER WITH DOT
+1 0 LEFT OUTER JOIN
+1 0 RIGHT OUTER JOIN
+1 0 FULL OUTER JOIN
+1 0 LARGE UP TACK
+1 6 DOWN TACK
+1 1 EFT AND RIGHT DOUBLE TURNSTILE
+1 15 TACK
+1 5 MULTIMAP
+1 1 ONG RIGHT TACK
+1 5 LEFT TACK
+1 0 UP TACK WITH CIRCLE ABOVE
+1 0 LOZENGE DIVIDED BY HORIZONTAL RULE
+1 0 WHITE CONCAVE-SIDED DIAMOND
+1 27  WITH LEFTWARDS TICK
+1 33 RIGHTWARDS TICK
+1 6 SQUARE WITH LEFTWARDS TICK
+1 18 RIGHTWARDS TICK
+1 0 MATHEMATICAL LEFT WHITE SQUARE BRACKET
+1 13 RIGHT WHITE SQUARE BRACKET
+1 13 LEFT ANGLE BRACKET
+1 13 RIGHT ANGLE BRACKET
+1 13 LEFT DOUBLE ANGLE BRACKET
+1 13 RIGHT DOUBLE ANGLE BRACKET
+1 13 LEFT WHITE TORTOISE SHELL BRACKET
+1 13 RIGHT WHITE TORTOISE SHELL BRACKET
+1 13 LEFT FLATTENED PARENTHESIS
+1 13 RIGHT FLATTENED PARENTHESIS
+1 0 UPWARDS QUADRUPLE ARROW
+1 0 DOWNWARDS QUADRUPLE ARROW
+1 0 ANTICLOCKWISE GAPPED CIRCLE ARROW
+1 0 CLOCKWISE GAPPED CIRCLE ARROW
+1 0 RIGHT ARROW WITH CIRCLED PLUS
+1 0 LONG LEFTWARDS ARROW
+1 5 RIGHTWARDS ARROW
+1 5 LEFT RIGHT ARROW
+1 9 WARDS DOUBLE ARROW
+1 5 RIGHTWARDS DOUBLE ARROW
+1 5 LEFT RIGHT DOUBLE ARROW
+1 9 WARDS ARROW FROM BAR
+1 5 RIGHTWARDS ARROW FROM BAR
+1 5 LEFTWARDS DOUBLE ARROW FROM BAR
+1 5 RIGHTWARDS DOUBLE ARROW FROM BAR
+1 16 SQUIGGLE ARROW
+1 0 BRAILLE PATTERN BLANK
+1 16 DOTS-1
+1 21 2
+1 21 12
+1 21 3
+1 21 13
+1 21 23
+1 21 123
+1 21 4
+1 21 14
+1 21 24
+1 21 124
+1 21 34
+1 21 134
+1 21 234
+1 21 1234
+1 21 5
+1 21 15
+1 21 25
+1 21 125
+1 21 35
+1 21 135
+1 21 235
+1 21 1235
+1 21 45
+1 21 145
+1 21 245
+1 21 1245
+1 21 345
+1 21 1345
+1 21 2345
+1 21 12345
+1 21 6
+1 21 16
+1 21 26
+1 21 126
+1 21 36
+1 21 136
+1 21 236
+1 21 1236
+1 21 46
+1 21 146
+1 21 246
+1 21 1246
+1 21 346
+1 21 1346
+1 21 2346
+1 21 12346
+1 21 56
+1 21 156
+1 21 256
+1 21 1256
+1 21 356
+1 21 1356
+1 21 2356
+1 21 12356
+1 21 456
+1 21 1456
+1 21 2456
+1 21 12456
+1 21 3456
+1 21 13456
+1 21 23456
+1 21 123456
+1 21 7
+1 21 17
+1 21 27
+1 21 127
+1 21 37
+1 21 137
+1 21 237
+1 21 1237
+1 21 47
+1 21 147
+1 21 247
+1 21 1247
+1 21 347
+1 21 1347
+1 21 2347
+1 21 12347
+1 21 57
+1 21 157
+1 21 257
+1 21 1257
+1 21 357
+1 21 1357
+1 21 2357
+1 21 12357
+1 21 457
+1 21 1457
+1 21 2457
+1 21 12457
+1 21 3457
+1 21 13457
+1 21 23457
+1 21 123457
+1 21 67
+1 21 167
+1 21 267
+1 21 1267
+1 21 367
+1 21 1367
+1 21 2367
+1 21 12367
+1 21 467
+1 21 1467
+1 21 2467
+1 21 12467
+1 21 3467
+1 21 13467
+1 21 23467
+1 21 123467
+1 21 567
+1 21 1567
+1 21 2567
+1 21 12567
+1 21 3567
+1 21 13567
+1 21 23567
+1 21 123567
+1 21 4567
+1 21 14567
+1 21 24567
+1 21 124567
+1 21 34567
+1 21 134567
+1 21 234567
+1 21 1234567
+1 21 8
+1 21 18
+1 21 28
+1 21 128
+1 21 38
+1 21 138
+1 21 238
+1 21 1238
+1 21 48
+1 21 148
+1 21 248
+1 21 1248
+1 21 348
+1 21 1348
+1 21 2348
+1 21 12348
+1 21 58
+1 21 158
+1 21 258
+1 21 1258
+1 21 358
+1 21 1358
+1 21 2358
+1 21 12358
+1 21 458
+1 21 1458
+1 21 2458
+1 21 12458
+1 21 3458
+1 21 13458
+1 21 23458
+1 21 123458
+1 21 68
+1 21 168
+1 21 268
+1 21 1268
+1 21 368
+1 21 1368
+1 21 2368
+1 21 12368
+1 21 468
+1 21 1468
+1 21 2468
+1 21 12468
+1 21 3468
+1 21 13468
+1 21 23468
+1 21 123468
+1 21 568
+1 21 1568
+1 21 2568
+1 21 12568
+1 21 3568
+1 21 13568
+1 21 23568
+1 21 123568
+1 21 4568
+1 21 14568
+1 21 24568
+1 21 124568
+1 21 34568
+1 21 134568
+1 21 234568
+1 21 1234568
+1 21 78
+1 21 178
+1 21 278
+1 21 1278
+1 21 378
+1 21 1378
+1 21 2378
+1 21 12378
+1 21 478
+1 21 1478
+1 21 2478
+1 21 12478
+1 21 3478
+1 21 13478
+1 21 23478
+1 21 123478
+1 21 578
+1 21 1578
+1 21 2578
+1 21 12578
+1 21 3578
+1 21 13578
+1 21 23578
+1 21 123578
+1 21 4578
+1 21 14578
+1 21 24578
+1 21 124578
+1 21 34578
+1 21 134578
+1 21 234578
+1 21 1234578
+1 21 678
+1 21 1678
+1 21 2678
+1 21 12678
+1 21 3678
+1 21 13678
+1 21 23678
+1 21 123678
+1 21 4678
+1 21 14678
+1 21 24678
+1 21 124678
+1 21 34678
+1 21 134678
+1 21 234678
+1 21 1234678
+1 21 5678
+1 21 15678
+1 21 25678
+1 21 125678
+1 21 35678
+1 21 135678
+1 21 235678
+1 21 1235678
+1 21 45678
+1 21 145678
+1 21 245678
+1 21 1245678
+1 21 345678
+1 21 1345678
+1 21 2345678
+1 21 12345678
+1 0 RIGHTWARDS TWO-HEADED ARROW WITH VERTICAL STROKE
+1 33 DOUBLE VERTICAL STROKE
+1 0 LEFTWARDS DOUBLE ARROW WITH VERTICAL STROKE
+1 0 RIGHTWARDS DOUBLE ARROW WITH VERTICAL STROKE
+1 0 LEFT RIGHT DOUBLE ARROW WITH VERTICAL STROKE
+1 0 RIGHTWARDS TWO-HEADED ARROW FROM BAR
+1 0 LEFTWARDS DOUBLE ARROW FROM BAR
+1 0 RIGHTWARDS DOUBLE ARROW FROM BAR
+1 0 DOWNWARDS ARROW WITH HORIZONTAL STROKE
+1 0 UPWARDS ARROW WITH HORIZONTAL STROKE
+1 8 TRIPLE ARROW
+1 0 DOWNWARDS TRIPLE ARROW
+1 0 LEFTWARDS DOUBLE DASH ARROW
+1 0 RIGHTWARDS DOUBLE DASH ARROW
+1 0 LEFTWARDS TRIPLE DASH ARROW
+1 0 RIGHTWARDS TRIPLE DASH ARROW
+1 12 WO-HEADED TRIPLE DASH ARROW
+1 11 ARROW WITH DOTTED STEM
+1 0 UPWARDS ARROW TO BAR
+1 0 DOWNWARDS ARROW TO BAR
+1 0 RIGHTWARDS ARROW WITH TAIL WITH VERTICAL STROKE
+1 32 DOUBLE VERTICAL STROKE
+1 11 TWO-HEADED ARROW WITH TAIL
+1 37  WITH VERTICAL STROKE
+1 43 DOUBLE VERTICAL STROKE
+1 0 LEFTWARDS ARROW-TAIL
+1 0 RIGHTWARDS ARROW-TAIL
+1 0 LEFTWARDS DOUBLE ARROW-TAIL
+1 0 RIGHTWARDS DOUBLE ARROW-TAIL
+1 0 LEFTWARDS ARROW TO BLACK DIAMOND
+1 0 RIGHTWARDS ARROW TO BLACK DIAMOND
+1 0 LEFTWARDS ARROW FROM BAR TO BLACK DIAMOND
+1 0 RIGHTWARDS ARROW FROM BAR TO BLACK DIAMOND
+1 0 NORTH WEST AND SOUTH EAST ARROW
+1 6 EAST AND SOUTH WEST ARROW
+1 6 WEST ARROW WITH HOOK
+1 6 EAST ARROW WITH HOOK
+1 0 SOUTH EAST ARROW WITH HOOK
+1 6 WEST ARROW WITH HOOK
+1 0 NORTH WEST ARROW AND NORTH EAST ARROW
+1 6 EAST ARROW AND SOUTH EAST ARROW
+1 0 SOUTH EAST ARROW AND SOUTH WEST ARROW
+1 6 WEST ARROW AND NORTH WEST ARROW
+1 0 RISING DIAGONAL CROSSING FALLING DIAGONAL
+1 0 FALLING DIAGONAL CROSSING RISING DIAGONAL
+1 0 SOUTH EAST ARROW CROSSING NORTH EAST ARROW
+1 0 NORTH EAST ARROW CROSSING SOUTH EAST ARROW
+1 0 FALLING DIAGONAL CROSSING NORTH EAST ARROW
+1 0 RISING DIAGONAL CROSSING SOUTH EAST ARROW
+1 0 NORTH EAST ARROW CROSSING NORTH WEST ARROW
+1 6 WEST ARROW CROSSING NORTH EAST ARROW
+1 0 WAVE ARROW POINTING DIRECTLY RIGHT
+1 0 ARROW POINTING RIGHTWARDS THEN CURVING UPWARDS
+1 39 DOWNWARDS
+1 15 DOWNWARDS THEN CURVING LEFTWARDS
+1 38 RIGHTWARDS
+1 0 RIGHT-SIDE ARC CLOCKWISE ARROW
+1 0 LEFT-SIDE ARC ANTICLOCKWISE ARROW
+1 0 TOP ARC ANTICLOCKWISE ARROW
+1 0 BOTTOM ARC ANTICLOCKWISE ARROW
+1 0 TOP ARC CLOCKWISE ARROW WITH MINUS
+1 8 ANTICLOCKWISE ARROW WITH PLUS
+1 0 LOWER RIGHT SEMICIRCULAR CLOCKWISE ARROW
+1 6 LEFT SEMICIRCULAR ANTICLOCKWISE ARROW
+1 0 ANTICLOCKWISE CLOSED CIRCLE ARROW
+1 0 CLOCKWISE CLOSED CIRCLE ARROW
+1 0 RIGHTWARDS ARROW ABOVE SHORT LEFTWARDS ARROW
+1 0 LEFTWARDS ARROW ABOVE SHORT RIGHTWARDS ARROW
+1 0 SHORT RIGHTWARDS ARROW ABOVE LEFTWARDS ARROW
+1 0 RIGHTWARDS ARROW WITH PLUS BELOW
+1 0 LEFTWARDS ARROW WITH PLUS BELOW
+1 0 RIGHTWARDS ARROW THROUGH X
+1 0 LEFT RIGHT ARROW THROUGH SMALL CIRCLE
+1 0 UPWARDS TWO-HEADED ARROW FROM SMALL CIRCLE
+1 0 LEFT BARB UP RIGHT BARB DOWN HARPOON
+1 10 DOWN RIGHT BARB UP HARPOON
+1 0 UP BARB RIGHT DOWN BARB LEFT HARPOON
+1 8 LEFT DOWN BARB RIGHT HARPOON
+1 0 LEFT BARB UP RIGHT BARB UP HARPOON
+1 0 UP BARB RIGHT DOWN BARB RIGHT HARPOON
+1 0 LEFT BARB DOWN RIGHT BARB DOWN HARPOON
+1 0 UP BARB LEFT DOWN BARB LEFT HARPOON
+1 0 LEFTWARDS HARPOON WITH BARB UP TO BAR
+1 0 RIGHTWARDS HARPOON WITH BARB UP TO BAR
+1 0 UPWARDS HARPOON WITH BARB RIGHT TO BAR
+1 0 DOWNWARDS HARPOON WITH BARB RIGHT TO BAR
+1 0 LEFTWARDS HARPOON WITH BARB DOWN TO BAR
+1 0 RIGHTWARDS HARPOON WITH BARB DOWN TO BAR
+1 0 UPWARDS HARPOON WITH BARB LEFT TO BAR
+1 0 DOWNWARDS HARPOON WITH BARB LEFT TO BAR
+1 0 LEFTWARDS HARPOON WITH BARB UP FROM BAR
+1 0 RIGHTWARDS HARPOON WITH BARB UP FROM BAR
+1 0 UPWARDS HARPOON WITH BARB RIGHT FROM BAR
+1 0 DOWNWARDS HARPOON WITH BARB RIGHT FROM BAR
+1 0 LEFTWARDS HARPOON WITH BARB DOWN FROM BAR
+1 0 RIGHTWARDS HARPOON WITH BARB DOWN FROM BAR
+1 0 UPWARDS HARPOON WITH BARB LEFT FROM BAR
+1 0 DOWNWARDS HARPOON WITH BARB LEFT FROM BAR
+1 0 LEFTWARDS HARPOON WITH BARB UP ABOVE LEFTWARDS HARPOON WITH BARB DOWN
+1 0 UPWARDS HARPOON WITH BARB LEFT BESIDE UPWARDS HARPOON WITH BARB RIGHT
+1 0 RIGHTWARDS HARPOON WITH BARB UP ABOVE RIGHTWARDS HARPOON WITH BARB DOWN
+1 0 DOWNWARDS HARPOON WITH BARB LEFT BESIDE DOWNWARDS HARPOON WITH BARB RIGHT
+1 0 LEFTWARDS HARPOON WITH BARB UP ABOVE RIGHTWARDS HARPOON WITH BARB UP
+1 28 DOWN ABOVE RIGHTWARDS HARPOON WITH BARB DOWN
+1 0 RIGHTWARDS HARPOON WITH BARB UP ABOVE LEFTWARDS HARPOON WITH BARB UP
+1 29 DOWN ABOVE LEFTWARDS HARPOON WITH BARB DOWN
+1 0 LEFTWARDS HARPOON WITH BARB UP ABOVE LONG DASH
+1 28 DOWN BELOW LONG DASH
+1 0 RIGHTWARDS HARPOON WITH BARB UP ABOVE LONG DASH
+1 29 DOWN BELOW LONG DASH
+1 0 UPWARDS HARPOON WITH BARB LEFT BESIDE DOWNWARDS HARPOON WITH BARB RIGHT
+1 0 DOWNWARDS HARPOON WITH BARB LEFT BESIDE UPWARDS HARPOON WITH BARB RIGHT
+1 0 RIGHT DOUBLE ARROW WITH ROUNDED HEAD
+1 0 EQUALS SIGN ABOVE RIGHTWARDS ARROW
+1 0 TILDE OPERATOR ABOVE RIGHTWARDS ARROW
+1 0 LEFTWARDS ARROW ABOVE TILDE OPERATOR
+1 0 RIGHTWARDS ARROW ABOVE TILDE OPERATOR
+1 23 ALMOST EQUAL TO
+1 0 LESS-THAN ABOVE LEFTWARDS ARROW
+1 2 FTWARDS ARROW THROUGH LESS-THAN
+1 0 GREATER-THAN ABOVE RIGHTWARDS ARROW
+1 0 SUBSET ABOVE RIGHTWARDS ARROW
+1 0 LEFTWARDS ARROW THROUGH SUBSET
+1 0 SUPERSET ABOVE LEFTWARDS ARROW
+1 0 LEFT FISH TAIL
+1 0 RIGHT FISH TAIL
+1 0 UP FISH TAIL
+1 0 DOWN FISH TAIL
+1 0 TRIPLE VERTICAL BAR DELIMITER
+1 0 Z NOTATION SPOT
+1 11 TYPE COLON
+1 0 LEFT WHITE CURLY BRACKET
+1 0 RIGHT WHITE CURLY BRACKET
+1 0 LEFT WHITE PARENTHESIS
+1 0 RIGHT WHITE PARENTHESIS
+1 0 Z NOTATION LEFT IMAGE BRACKET
+1 11 RIGHT IMAGE BRACKET
+1 11 LEFT BINDING BRACKET
+1 11 RIGHT BINDING BRACKET
+1 0 LEFT SQUARE BRACKET WITH UNDERBAR
+1 0 RIGHT SQUARE BRACKET WITH UNDERBAR
+1 0 LEFT SQUARE BRACKET WITH TICK IN TOP CORNER
+1 0 RIGHT SQUARE BRACKET WITH TICK IN BOTTOM CORNER
+1 0 LEFT SQUARE BRACKET WITH TICK IN BOTTOM CORNER
+1 0 RIGHT SQUARE BRACKET WITH TICK IN TOP CORNER
+1 0 LEFT ANGLE BRACKET WITH DOT
+1 0 RIGHT ANGLE BRACKET WITH DOT
+1 0 LEFT ARC LESS-THAN BRACKET
+1 0 RIGHT ARC GREATER-THAN BRACKET
+1 0 DOUBLE LEFT ARC GREATER-THAN BRACKET
+1 7 RIGHT ARC LESS-THAN BRACKET
+1 0 LEFT BLACK TORTOISE SHELL BRACKET
+1 0 RIGHT BLACK TORTOISE SHELL BRACKET
+1 0 DOTTED FENCE
+1 0 VERTICAL ZIGZAG LINE
+1 0 MEASURED ANGLE OPENING LEFT
+1 0 RIGHT ANGLE VARIANT WITH SQUARE
+1 0 MEASURED RIGHT ANGLE WITH DOT
+1 0 ANGLE WITH S INSIDE
+1 1 CUTE ANGLE
+1 0 SPHERICAL ANGLE OPENING LEFT
+1 24 UP
+1 0 TURNED ANGLE
+1 0 REVERSED ANGLE
+1 0 ANGLE WITH UNDERBAR
+1 0 REVERSED ANGLE WITH UNDERBAR
+1 0 OBLIQUE ANGLE OPENING UP
+1 22 DOWN
+1 0 MEASURED ANGLE WITH OPEN ARM ENDING IN ARROW POINTING UP AND RIGHT
+1 61 LEFT
+1 54 DOWN AND RIGHT
+1 63 LEFT
+1 54 RIGHT AND UP
+1 54 LEFT AND UP
+1 54 RIGHT AND DOWN
+1 54 LEFT AND DOWN
+1 0 REVERSED EMPTY SET
+1 0 EMPTY SET WITH OVERBAR
+1 15 SMALL CIRCLE ABOVE
+1 15 RIGHT ARROW ABOVE
+1 15 LEFT ARROW ABOVE
+1 0 CIRCLE WITH HORIZONTAL BAR
+1 6 D VERTICAL BAR
+1 8 PARALLEL
+1 8 REVERSE SOLIDUS
+1 8 PERPENDICULAR
+1 6  DIVIDED BY HORIZONTAL BAR AND TOP HALF DIVIDED BY VERTICAL BAR
+1 7 WITH SUPERIMPOSED X
+1 6 D ANTICLOCKWISE-ROTATED DIVISION SIGN
+1 0 UP ARROW THROUGH CIRCLE
+1 0 CIRCLED WHITE BULLET
+1 8 BULLET
+1 8 LESS-THAN
+1 8 GREATER-THAN
+1 6  WITH SMALL CIRCLE TO THE RIGHT
+1 12 TWO HORIZONTAL STROKES TO THE RIGHT
+1 0 SQUARED RISING DIAGONAL SLASH
+1 8 FALLING DIAGONAL SLASH
+1 8 ASTERISK
+1 8 SMALL CIRCLE
+1 9 QUARE
+1 0 TWO JOINED SQUARES
+1 1 RIANGLE WITH DOT ABOVE
+1 14 UNDERBAR
+1 0 S IN TRIANGLE
+1 0 TRIANGLE WITH SERIFS AT BOTTOM
+1 0 RIGHT TRIANGLE ABOVE LEFT TRIANGLE
+1 0 LEFT TRIANGLE BESIDE VERTICAL BAR
+1 0 VERTICAL BAR BESIDE RIGHT TRIANGLE
+1 0 BOWTIE WITH LEFT HALF BLACK
+1 12 RIGHT HALF BLACK
+1 1 LACK BOWTIE
+1 0 TIMES WITH LEFT HALF BLACK
+1 11 RIGHT HALF BLACK
+1 0 WHITE HOURGLASS
+1 0 BLACK HOURGLASS
+1 0 LEFT WIGGLY FENCE
+1 0 RIGHT WIGGLY FENCE
+1 0 LEFT DOUBLE WIGGLY FENCE
+1 0 RIGHT DOUBLE WIGGLY FENCE
+1 0 INCOMPLETE INFINITY
+1 0 TIE OVER INFINITY
+1 0 INFINITY NEGATED WITH VERTICAL BAR
+1 0 DOUBLE-ENDED MULTIMAP
+1 0 SQUARE WITH CONTOURED OUTLINE
+1 0 INCREASES AS
+1 0 SHUFFLE PRODUCT
+1 0 EQUALS SIGN AND SLANTED PARALLEL
+1 32  WITH TILDE ABOVE
+1 0 IDENTICAL TO AND SLANTED PARALLEL
+1 0 GLEICH STARK
+1 0 THERMODYNAMIC
+1 0 DOWN-POINTING TRIANGLE WITH LEFT HALF BLACK
+1 28 RIGHT HALF BLACK
+1 0 BLACK DIAMOND WITH DOWN ARROW
+1 6 LOZENGE
+1 0 WHITE CIRCLE WITH DOWN ARROW
+1 0 BLACK CIRCLE WITH DOWN ARROW
+1 0 ERROR-BARRED WHITE SQUARE
+1 13 BLACK SQUARE
+1 13 WHITE DIAMOND
+1 13 BLACK DIAMOND
+1 13 WHITE CIRCLE
+1 13 BLACK CIRCLE
+1 0 RULE-DELAYED
+1 1 EVERSE SOLIDUS OPERATOR
+1 0 SOLIDUS WITH OVERBAR
+1 0 REVERSE SOLIDUS WITH HORIZONTAL STROKE
+1 0 BIG SOLIDUS
+1 4 REVERSE SOLIDUS
+1 0 DOUBLE PLUS
+1 0 TRIPLE PLUS
+1 0 LEFT-POINTING CURVED ANGLE BRACKET
+1 0 RIGHT-POINTING CURVED ANGLE BRACKET
+1 0 TINY
+1 0 MINY
+1 0 N-ARY CIRCLED DOT OPERATOR
+1 14 PLUS OPERATOR
+1 14 TIMES OPERATOR
+1 6 UNION OPERATOR WITH DOT
+1 26 PLUS
+1 6 SQUARE INTERSECTION OPERATOR
+1 13 UNION OPERATOR
+1 0 TWO LOGICAL AND OPERATOR
+1 12 OR OPERATOR
+1 0 N-ARY TIMES OPERATOR
+1 0 MODULO TWO SUM
+1 0 SUMMATION WITH INTEGRAL
+1 0 QUADRUPLE INTEGRAL OPERATOR
+1 0 FINITE PART INTEGRAL
+1 0 INTEGRAL WITH DOUBLE STROKE
+1 9 AVERAGE WITH SLASH
+1 0 CIRCULATION FUNCTION
+1 0 ANTICLOCKWISE INTEGRATION
+1 0 LINE INTEGRATION WITH RECTANGULAR PATH AROUND POLE
+1 22 SEMICIRCULAR PATH AROUND POLE
+1 17 NOT INCLUDING THE POLE
+1 0 INTEGRAL AROUND A POINT OPERATOR
+1 0 QUATERNION INTEGRAL OPERATOR
+1 0 INTEGRAL WITH LEFTWARDS ARROW WITH HOOK
+1 14 TIMES SIGN
+1 14 INTERSECTION
+1 14 UNION
+1 14 OVERBAR
+1 14 UNDERBAR
+1 0 JOIN
+1 0 LARGE LEFT TRIANGLE OPERATOR
+1 0 Z NOTATION SCHEMA COMPOSITION
+1 18 PIPING
+1 19 ROJECTION
+1 0 PLUS SIGN WITH SMALL CIRCLE ABOVE
+1 15 CIRCUMFLEX ACCENT ABOVE
+1 15 TILDE ABOVE
+1 15 DOT BELOW
+1 15 TILDE BELOW
+1 15 SUBSCRIPT TWO
+1 15 BLACK TRIANGLE
+1 0 MINUS SIGN WITH COMMA ABOVE
+1 16 DOT BELOW
+1 16 FALLING DOTS
+1 16 RISING DOTS
+1 0 PLUS SIGN IN LEFT HALF CIRCLE
+1 13 RIGHT HALF CIRCLE
+1 0 VECTOR OR CROSS PRODUCT
+1 0 MULTIPLICATION SIGN WITH DOT ABOVE
+1 25 UNDERBAR
+1 0 SEMIDIRECT PRODUCT WITH BOTTOM CLOSED
+1 1 MASH PRODUCT
+1 0 MULTIPLICATION SIGN IN LEFT HALF CIRCLE
+1 23 RIGHT HALF CIRCLE
+1 0 CIRCLED MULTIPLICATION SIGN WITH CIRCUMFLEX ACCENT
+1 0 MULTIPLICATION SIGN IN DOUBLE CIRCLE
+1 0 CIRCLED DIVISION SIGN
+1 0 PLUS SIGN IN TRIANGLE
+1 0 MINUS SIGN IN TRIANGLE
+1 1 ULTIPLICATION SIGN IN TRIANGLE
+1 0 INTERIOR PRODUCT
+1 0 RIGHTHAND INTERIOR PRODUCT
+1 0 Z NOTATION RELATIONAL COMPOSITION
+1 0 AMALGAMATION OR COPRODUCT
+1 0 INTERSECTION WITH DOT
+1 0 UNION WITH MINUS SIGN
+1 11 OVERBAR
+1 0 INTERSECTION WITH OVERBAR
+1 18 LOGICAL AND
+1 0 UNION WITH LOGICAL OR
+1 6 ABOVE INTERSECTION
+1 0 INTERSECTION ABOVE UNION
+1 0 UNION ABOVE BAR ABOVE INTERSECTION
+1 0 INTERSECTION ABOVE BAR ABOVE UNION
+1 0 UNION BESIDE AND JOINED WITH UNION
+1 0 INTERSECTION BESIDE AND JOINED WITH INTERSECTION
+1 0 CLOSED UNION WITH SERIFS
+1 7 INTERSECTION WITH SERIFS
+1 0 DOUBLE SQUARE INTERSECTION
+1 14 UNION
+1 0 CLOSED UNION WITH SERIFS AND SMASH PRODUCT
+1 0 LOGICAL AND WITH DOT ABOVE
+1 8 OR WITH DOT ABOVE
+1 0 DOUBLE LOGICAL AND
+1 15 OR
+1 0 TWO INTERSECTING LOGICAL AND
+1 25 OR
+1 0 SLOPING LARGE OR
+1 14 AND
+1 0 LOGICAL OR OVERLAPPING LOGICAL AND
+1 8 AND WITH MIDDLE STEM
+1 8 OR WITH MIDDLE STEM
+1 8 AND WITH HORIZONTAL DASH
+1 8 OR WITH HORIZONTAL DASH
+1 8 AND WITH DOUBLE OVERBAR
+1 17 UNDERBAR
+1 17 DOUBLE UNDERBAR
+1 0 SMALL VEE WITH UNDERBAR
+1 0 LOGICAL OR WITH DOUBLE OVERBAR
+1 23 UNDERBAR
+1 0 Z NOTATION DOMAIN ANTIRESTRICTION
+1 11 RANGE ANTIRESTRICTION
+1 0 EQUALS SIGN WITH DOT BELOW
+1 0 IDENTICAL WITH DOT ABOVE
+1 0 TRIPLE HORIZONTAL BAR WITH DOUBLE VERTICAL STROKE
+1 27 TRIPLE VERTICAL STROKE
+1 1 ILDE OPERATOR WITH DOT ABOVE
+1 20 RISING DOTS
+1 0 SIMILAR MINUS SIMILAR
+1 0 CONGRUENT WITH DOT ABOVE
+1 0 EQUALS WITH ASTERISK
+1 0 ALMOST EQUAL TO WITH CIRCUMFLEX ACCENT
+1 1 PPROXIMATELY EQUAL OR EQUAL TO
+1 0 EQUALS SIGN ABOVE PLUS SIGN
+1 0 PLUS SIGN ABOVE EQUALS SIGN
+1 0 EQUALS SIGN ABOVE TILDE OPERATOR
+1 0 DOUBLE COLON EQUAL
+1 0 TWO CONSECUTIVE EQUALS SIGNS
+1 1 HREE CONSECUTIVE EQUALS SIGNS
+1 0 EQUALS SIGN WITH TWO DOTS ABOVE AND TWO DOTS BELOW
+1 3 IVALENT WITH FOUR DOTS ABOVE
+1 0 LESS-THAN WITH CIRCLE INSIDE
+1 0 GREATER-THAN WITH CIRCLE INSIDE
+1 0 LESS-THAN WITH QUESTION MARK ABOVE
+1 0 GREATER-THAN WITH QUESTION MARK ABOVE
+1 0 LESS-THAN OR SLANTED EQUAL TO
+1 0 GREATER-THAN OR SLANTED EQUAL TO
+1 0 LESS-THAN OR SLANTED EQUAL TO WITH DOT INSIDE
+1 0 GREATER-THAN OR SLANTED EQUAL TO WITH DOT INSIDE
+1 0 LESS-THAN OR SLANTED EQUAL TO WITH DOT ABOVE
+1 0 GREATER-THAN OR SLANTED EQUAL TO WITH DOT ABOVE
+1 0 LESS-THAN OR SLANTED EQUAL TO WITH DOT ABOVE RIGHT
+1 0 GREATER-THAN OR SLANTED EQUAL TO WITH DOT ABOVE LEFT
+1 0 LESS-THAN OR APPROXIMATE
+1 0 GREATER-THAN OR APPROXIMATE
+1 0 LESS-THAN AND SINGLE-LINE NOT EQUAL TO
+1 0 GREATER-THAN AND SINGLE-LINE NOT EQUAL TO
+1 0 LESS-THAN AND NOT APPROXIMATE
+1 0 GREATER-THAN AND NOT APPROXIMATE
+1 0 LESS-THAN ABOVE DOUBLE-LINE EQUAL ABOVE GREATER-THAN
+1 0 GREATER-THAN ABOVE DOUBLE-LINE EQUAL ABOVE LESS-THAN
+1 0 LESS-THAN ABOVE SIMILAR OR EQUAL
+1 0 GREATER-THAN ABOVE SIMILAR OR EQUAL
+1 0 LESS-THAN ABOVE SIMILAR ABOVE GREATER-THAN
+1 0 GREATER-THAN ABOVE SIMILAR ABOVE LESS-THAN
+1 0 LESS-THAN ABOVE GREATER-THAN ABOVE DOUBLE-LINE EQUAL
+1 0 GREATER-THAN ABOVE LESS-THAN ABOVE DOUBLE-LINE EQUAL
+1 0 LESS-THAN ABOVE SLANTED EQUAL ABOVE GREATER-THAN ABOVE SLANTED EQUAL
+1 0 GREATER-THAN ABOVE SLANTED EQUAL ABOVE LESS-THAN ABOVE SLANTED EQUAL
+1 0 SLANTED EQUAL TO OR LESS-THAN
+1 20 GREATER-THAN
+1 20 LESS-THAN WITH DOT INSIDE
+1 20 GREATER-THAN WITH DOT INSIDE
+1 0 DOUBLE-LINE EQUAL TO OR LESS-THAN
+1 24 GREATER-THAN
+1 12 SLANTED EQUAL TO OR LESS-THAN
+1 32 GREATER-THAN
+1 0 SIMILAR OR LESS-THAN
+1 11 GREATER-THAN
+1 8 ABOVE LESS-THAN ABOVE EQUALS SIGN
+1 14 GREATER-THAN ABOVE EQUALS SIGN
+1 0 DOUBLE NESTED LESS-THAN
+1 14 GREATER-THAN
+1 14 LESS-THAN WITH UNDERBAR
+1 0 GREATER-THAN OVERLAPPING LESS-THAN
+1 13 BESIDE LESS-THAN
+1 0 LESS-THAN CLOSED BY CURVE
+1 0 GREATER-THAN CLOSED BY CURVE
+1 0 LESS-THAN CLOSED BY CURVE ABOVE SLANTED EQUAL
+1 0 GREATER-THAN CLOSED BY CURVE ABOVE SLANTED EQUAL
+1 0 SMALLER THAN
+1 0 LARGER THAN
+1 0 SMALLER THAN OR EQUAL TO
+1 0 LARGER THAN OR EQUAL TO
+1 0 EQUALS SIGN WITH BUMPY ABOVE
+1 0 PRECEDES ABOVE SINGLE-LINE EQUALS SIGN
+1 0 SUCCEEDS ABOVE SINGLE-LINE EQUALS SIGN
+1 0 PRECEDES ABOVE SINGLE-LINE NOT EQUAL TO
+1 0 SUCCEEDS ABOVE SINGLE-LINE NOT EQUAL TO
+1 0 PRECEDES ABOVE EQUALS SIGN
+1 0 SUCCEEDS ABOVE EQUALS SIGN
+1 0 PRECEDES ABOVE NOT EQUAL TO
+1 0 SUCCEEDS ABOVE NOT EQUAL TO
+1 0 PRECEDES ABOVE ALMOST EQUAL TO
+1 0 SUCCEEDS ABOVE ALMOST EQUAL TO
+1 0 PRECEDES ABOVE NOT ALMOST EQUAL TO
+1 0 SUCCEEDS ABOVE NOT ALMOST EQUAL TO
+1 0 DOUBLE PRECEDES
+1 7 SUCCEEDS
+1 0 SUBSET WITH DOT
+1 2 PERSET WITH DOT
+1 2 BSET WITH PLUS SIGN BELOW
+1 2 PERSET WITH PLUS SIGN BELOW
+1 2 BSET WITH MULTIPLICATION SIGN BELOW
+1 2 PERSET WITH MULTIPLICATION SIGN BELOW
+1 2 BSET OF OR EQUAL TO WITH DOT ABOVE
+1 2 PERSET OF OR EQUAL TO WITH DOT ABOVE
+1 2 BSET OF ABOVE EQUALS SIGN
+1 2 PERSET OF ABOVE EQUALS SIGN
+1 2 BSET OF ABOVE TILDE OPERATOR
+1 2 PERSET OF ABOVE TILDE OPERATOR
+1 2 BSET OF ABOVE ALMOST EQUAL TO
+1 2 PERSET OF ABOVE ALMOST EQUAL TO
+1 2 BSET OF ABOVE NOT EQUAL TO
+1 2 PERSET OF ABOVE NOT EQUAL TO
+1 1 QUARE LEFT OPEN BOX OPERATOR
+1 7 RIGHT OPEN BOX OPERATOR
+1 0 CLOSED SUBSET
+1 9 PERSET
+1 9 BSET OR EQUAL TO
+1 9 PERSET OR EQUAL TO
+1 0 SUBSET ABOVE SUPERSET
+1 2 PERSET ABOVE SUBSET
+1 2 BSET ABOVE SUBSET
+1 2 PERSET ABOVE SUPERSET
+1 9 BESIDE SUBSET
+1 16 AND JOINED BY DASH WITH SUBSET
+1 0 ELEMENT OF OPENING DOWNWARDS
+1 0 PITCHFORK WITH TEE TOP
+1 0 TRANSVERSAL INTERSECTION
+1 0 FORKING
+1 0 NONFORKING
+1 0 SHORT LEFT TACK
+1 6 DOWN TACK
+1 6 UP TACK
+1 0 PERPENDICULAR WITH S
+1 0 VERTICAL BAR TRIPLE RIGHT TURNSTILE
+1 0 DOUBLE VERTICAL BAR LEFT TURNSTILE
+1 0 VERTICAL BAR DOUBLE LEFT TURNSTILE
+1 0 DOUBLE VERTICAL BAR DOUBLE LEFT TURNSTILE
+1 0 LONG DASH FROM LEFT MEMBER OF DOUBLE VERTICAL
+1 0 SHORT DOWN TACK WITH OVERBAR
+1 6 UP TACK WITH UNDERBAR
+1 14 ABOVE SHORT DOWN TACK
+1 0 DOUBLE DOWN TACK
+1 7 UP TACK
+1 7 STROKE NOT SIGN
+1 0 REVERSED DOUBLE STROKE NOT SIGN
+1 0 DOES NOT DIVIDE WITH REVERSED NEGATION SLASH
+1 0 VERTICAL LINE WITH CIRCLE ABOVE
+1 26 BELOW
+1 0 DOWN TACK WITH CIRCLE BELOW
+1 0 PARALLEL WITH HORIZONTAL STROKE
+1 14 TILDE OPERATOR
+1 0 TRIPLE VERTICAL BAR BINARY RELATION
+1 20 WITH HORIZONTAL STROKE
+1 7 COLON OPERATOR
+1 7 NESTED LESS-THAN
+1 14 GREATER-THAN
+1 0 DOUBLE-LINE SLANTED LESS-THAN OR EQUAL TO
+1 20 GREATER-THAN OR EQUAL TO
+1 0 TRIPLE SOLIDUS BINARY RELATION
+1 0 LARGE TRIPLE VERTICAL BAR OPERATOR
+1 0 DOUBLE SOLIDUS OPERATOR
+1 0 WHITE VERTICAL BAR
+1 0 N-ARY WHITE VERTICAL BAR
+1 1 ORTH EAST WHITE ARROW
+1 6 WEST WHITE ARROW
+1 0 SOUTH EAST WHITE ARROW
+1 6 WEST WHITE ARROW
+1 0 LEFT RIGHT WHITE ARROW
+1 4 WARDS BLACK ARROW
+1 0 UPWARDS BLACK ARROW
+1 0 DOWNWARDS BLACK ARROW
+1 0 NORTH EAST BLACK ARROW
+1 6 WEST BLACK ARROW
+1 0 SOUTH EAST BLACK ARROW
+1 6 WEST BLACK ARROW
+1 0 LEFT RIGHT BLACK ARROW
+1 0 UP DOWN BLACK ARROW
+1 0 RIGHTWARDS ARROW WITH TIP DOWNWARDS
+1 26 UPWARDS
+1 0 LEFTWARDS ARROW WITH TIP DOWNWARDS
+1 25 UPWARDS
+1 0 SQUARE WITH TOP HALF BLACK
+1 12 BOTTOM HALF BLACK
+1 12 UPPER RIGHT DIAGONAL HALF BLACK
+1 12 LOWER LEFT DIAGONAL HALF BLACK
+1 0 DIAMOND WITH LEFT HALF BLACK
+1 13 RIGHT HALF BLACK
+1 13 TOP HALF BLACK
+1 13 BOTTOM HALF BLACK
+1 1 OTTED SQUARE
+1 0 BLACK LARGE SQUARE
+1 0 WHITE LARGE SQUARE
+1 0 BLACK VERY SMALL SQUARE
+1 0 WHITE VERY SMALL SQUARE
+1 0 BLACK PENTAGON
+1 0 WHITE PENTAGON
+1 6 HEXAGON
+1 0 BLACK HEXAGON
+1 0 HORIZONTAL BLACK HEXAGON
+1 0 BLACK LARGE CIRCLE
+1 6 MEDIUM DIAMOND
+1 0 WHITE MEDIUM DIAMOND
+1 0 BLACK MEDIUM LOZENGE
+1 0 WHITE MEDIUM LOZENGE
+1 0 BLACK SMALL DIAMOND
+1 12 LOZENGE
+1 0 WHITE SMALL LOZENGE
+1 0 BLACK HORIZONTAL ELLIPSE
+1 0 WHITE HORIZONTAL ELLIPSE
+1 0 BLACK VERTICAL ELLIPSE
+1 0 WHITE VERTICAL ELLIPSE
+1 0 LEFT ARROW WITH SMALL CIRCLE
+1 0 THREE LEFTWARDS ARROWS
+1 0 LEFT ARROW WITH CIRCLED PLUS
+1 1 ONG LEFTWARDS SQUIGGLE ARROW
+1 1 EFTWARDS TWO-HEADED ARROW WITH VERTICAL STROKE
+1 32 DOUBLE VERTICAL STROKE
+1 27 FROM BAR
+1 21 TRIPLE DASH ARROW
+1 10 ARROW WITH DOTTED STEM
+1 21 TAIL WITH VERTICAL STROKE
+1 31 DOUBLE VERTICAL STROKE
+1 10 TWO-HEADED ARROW WITH TAIL
+1 36  WITH VERTICAL STROKE
+1 42 DOUBLE VERTICAL STROKE
+1 10 ARROW THROUGH X
+1 0 WAVE ARROW POINTING DIRECTLY LEFT
+1 0 EQUALS SIGN ABOVE LEFTWARDS ARROW
+1 0 REVERSE TILDE OPERATOR ABOVE LEFTWARDS ARROW
+1 0 LEFTWARDS ARROW ABOVE REVERSE ALMOST EQUAL TO
+1 0 RIGHTWARDS ARROW THROUGH GREATER-THAN
+1 25 SUPERSET
+1 0 LEFTWARDS QUADRUPLE ARROW
+1 0 RIGHTWARDS QUADRUPLE ARROW
+1 1 EVERSE TILDE OPERATOR ABOVE RIGHTWARDS ARROW
+1 1 IGHTWARDS ARROW ABOVE REVERSE ALMOST EQUAL TO
+1 0 TILDE OPERATOR ABOVE LEFTWARDS ARROW
+1 0 LEFTWARDS ARROW ABOVE ALMOST EQUAL TO
+1 22 REVERSE TILDE OPERATOR
+1 0 RIGHTWARDS ARROW ABOVE REVERSE TILDE OPERATOR
+1 0 DOWNWARDS TRIANGLE-HEADED ZIGZAG ARROW
+1 0 SHORT SLANTED NORTH ARROW
+1 6 BACKSLANTED SOUTH ARROW
+1 0 WHITE MEDIUM STAR
+1 0 BLACK SMALL STAR
+1 0 WHITE SMALL STAR
+1 0 BLACK RIGHT-POINTING PENTAGON
+1 0 WHITE RIGHT-POINTING PENTAGON
+1 0 HEAVY LARGE CIRCLE
+1 6 OVAL WITH OVAL INSIDE
+1 6 CIRCLE WITH CIRCLE INSIDE
+1 12 
+1 12 D SALTIRE
+1 0 SLANTED NORTH ARROW WITH HOOKED HEAD
+1 0 BACKSLANTED SOUTH ARROW WITH HOOKED TAIL
+1 0 SLANTED NORTH ARROW WITH HORIZONTAL TAIL
+1 0 BACKSLANTED SOUTH ARROW WITH HORIZONTAL TAIL
+1 1 ENT ARROW POINTING DOWNWARDS THEN NORTH EAST
+1 0 SHORT BENT ARROW POINTING DOWNWARDS THEN NORTH EAST
+1 0 LEFTWARDS TRIANGLE-HEADED ARROW
+1 0 UPWARDS TRIANGLE-HEADED ARROW
+1 0 RIGHTWARDS TRIANGLE-HEADED ARROW
+1 0 DOWNWARDS TRIANGLE-HEADED ARROW
+1 0 LEFT RIGHT TRIANGLE-HEADED ARROW
+1 0 UP DOWN TRIANGLE-HEADED ARROW
+1 0 NORTH WEST TRIANGLE-HEADED ARROW
+1 6 EAST TRIANGLE-HEADED ARROW
+1 0 SOUTH EAST TRIANGLE-HEADED ARROW
+1 6 WEST TRIANGLE-HEADED ARROW
+1 0 LEFTWARDS TRIANGLE-HEADED DASHED ARROW
+1 0 UPWARDS TRIANGLE-HEADED DASHED ARROW
+1 0 RIGHTWARDS TRIANGLE-HEADED DASHED ARROW
+1 0 DOWNWARDS TRIANGLE-HEADED DASHED ARROW
+1 0 CLOCKWISE TRIANGLE-HEADED OPEN CIRCLE ARROW
+1 0 ANTICLOCKWISE TRIANGLE-HEADED OPEN CIRCLE ARROW
+1 0 LEFTWARDS TRIANGLE-HEADED ARROW TO BAR
+1 0 UPWARDS TRIANGLE-HEADED ARROW TO BAR
+1 0 RIGHTWARDS TRIANGLE-HEADED ARROW TO BAR
+1 0 DOWNWARDS TRIANGLE-HEADED ARROW TO BAR
+3 0 NORTH WEST TRIANGLE-HEADED ARROW TO BAR
+1 6 EAST TRIANGLE-HEADED ARROW TO BAR
+1 0 SOUTH EAST TRIANGLE-HEADED ARROW TO BAR
+1 6 WEST TRIANGLE-HEADED ARROW TO BAR
+1 0 LEFTWARDS TRIANGLE-HEADED ARROW WITH DOUBLE HORIZONTAL STROKE
+1 0 UPWARDS TRIANGLE-HEADED ARROW WITH DOUBLE HORIZONTAL STROKE
+1 0 RIGHTWARDS TRIANGLE-HEADED ARROW WITH DOUBLE HORIZONTAL STROKE
+1 0 DOWNWARDS TRIANGLE-HEADED ARROW WITH DOUBLE HORIZONTAL STROKE
+1 0 HORIZONTAL TAB KEY
+1 0 VERTICAL TAB KEY
+1 0 LEFTWARDS TRIANGLE-HEADED ARROW OVER RIGHTWARDS TRIANGLE-HEADED ARROW
+1 0 UPWARDS TRIANGLE-HEADED ARROW LEFTWARDS OF DOWNWARDS TRIANGLE-HEADED ARROW
+1 0 RIGHTWARDS TRIANGLE-HEADED ARROW OVER LEFTWARDS TRIANGLE-HEADED ARROW
+1 0 DOWNWARDS TRIANGLE-HEADED ARROW LEFTWARDS OF UPWARDS TRIANGLE-HEADED ARROW
+1 0 LEFTWARDS TRIANGLE-HEADED PAIRED ARROWS
+1 0 UPWARDS TRIANGLE-HEADED PAIRED ARROWS
+1 0 RIGHTWARDS TRIANGLE-HEADED PAIRED ARROWS
+1 0 DOWNWARDS TRIANGLE-HEADED PAIRED ARROWS
+1 0 LEFTWARDS BLACK CIRCLED WHITE ARROW
+1 0 UPWARDS BLACK CIRCLED WHITE ARROW
+1 0 RIGHTWARDS BLACK CIRCLED WHITE ARROW
+1 0 DOWNWARDS BLACK CIRCLED WHITE ARROW
+1 0 ANTICLOCKWISE TRIANGLE-HEADED RIGHT U-SHAPED ARROW
+1 30 BOTTOM U-SHAPED ARROW
+1 30 LEFT U-SHAPED ARROW
+1 30 TOP U-SHAPED ARROW
+1 0 RETURN LEFT
+1 7 RIGHT
+1 0 NEWLINE LEFT
+1 8 RIGHT
+1 0 FOUR CORNER ARROWS CIRCLING ANTICLOCKWISE
+1 0 RIGHTWARDS BLACK ARROW
+2 0 SYMBOL FOR TYPE A ELECTRONICS
+1 0 THREE-D TOP-LIGHTED LEFTWARDS EQUILATERAL ARROWHEAD
+1 8 RIGHT-LIGHTED UPWARDS EQUILATERAL ARROWHEAD
+1 8 TOP-LIGHTED RIGHTWARDS EQUILATERAL ARROWHEAD
+1 8 LEFT-LIGHTED DOWNWARDS EQUILATERAL ARROWHEAD
+1 0 BLACK LEFTWARDS EQUILATERAL ARROWHEAD
+1 6 UPWARDS EQUILATERAL ARROWHEAD
+1 6 RIGHTWARDS EQUILATERAL ARROWHEAD
+1 6 DOWNWARDS EQUILATERAL ARROWHEAD
+1 0 DOWNWARDS TRIANGLE-HEADED ARROW WITH LONG TIP LEFTWARDS
+1 46 RIGHTWARDS
+1 0 UPWARDS TRIANGLE-HEADED ARROW WITH LONG TIP LEFTWARDS
+1 44 RIGHTWARDS
+1 0 LEFTWARDS TRIANGLE-HEADED ARROW WITH LONG TIP UPWARDS
+1 0 RIGHTWARDS TRIANGLE-HEADED ARROW WITH LONG TIP UPWARDS
+1 0 LEFTWARDS TRIANGLE-HEADED ARROW WITH LONG TIP DOWNWARDS
+1 0 RIGHTWARDS TRIANGLE-HEADED ARROW WITH LONG TIP DOWNWARDS
+1 0 BLACK CURVED DOWNWARDS AND LEFTWARDS ARROW
+1 27 RIGHTWARDS ARROW
+1 13 UPWARDS AND LEFTWARDS ARROW
+1 25 RIGHTWARDS ARROW
+1 13 LEFTWARDS AND UPWARDS ARROW
+1 13 RIGHTWARDS AND UPWARDS ARROW
+1 13 LEFTWARDS AND DOWNWARDS ARROW
+1 13 RIGHTWARDS AND DOWNWARDS ARROW
+1 0 RIBBON ARROW DOWN LEFT
+1 18 RIGHT
+1 13 UP LEFT
+1 16 RIGHT
+1 13 LEFT UP
+1 13 RIGHT UP
+1 13 LEFT DOWN
+1 13 RIGHT DOWN
+1 0 UPWARDS WHITE ARROW FROM BAR WITH HORIZONTAL BAR
+1 2  ARROWHEAD IN A RECTANGLE BOX
+1 0 OVERLAPPING WHITE SQUARES
+1 18 AND BLACK SQUARES
+1 12 BLACK SQUARES
+1 0 BALLOT BOX WITH LIGHT X
+1 0 CIRCLED X
+1 8 BOLD X
+1 0 BLACK SQUARE CENTRED
+1 6 DIAMOND CENTRED
+1 0 TURNED BLACK PENTAGON
+1 0 HORIZONTAL BLACK OCTAGON
+1 0 BLACK OCTAGON
+1 6 MEDIUM UP-POINTING TRIANGLE CENTRED
+1 13 DOWN-POINTING TRIANGLE CENTRED
+1 13 LEFT-POINTING TRIANGLE CENTRED
+1 13 RIGHT-POINTING TRIANGLE CENTRED
+1 0 NEPTUNE FORM TWO
+1 0 TOP HALF BLACK CIRCLE
+1 0 BOTTOM HALF BLACK CIRCLE
+1 0 LIGHT FOUR POINTED BLACK CUSP
+1 0 ROTATED LIGHT FOUR POINTED BLACK CUSP
+1 0 WHITE FOUR POINTED CUSP
+1 0 ROTATED WHITE FOUR POINTED CUSP
+1 0 SQUARE POSITION INDICATOR
+1 0 UNCERTAINTY SIGN
+1 0 GROUP MARK
+1 0 PLUTO FORM TWO
+1 12 HREE
+1 11 FOUR
+1 12 IVE
+1 0 TRANSPLUTO
+1 0 PROSERPINA
+1 0 ASTRAEA
+1 0 HYGIEA
+1 0 PHOLUS
+1 0 NESSUS
+1 0 WHITE MOON SELENA
+1 0 BLACK DIAMOND ON CROSS
+1 0 TRUE LIGHT MOON ARTA
+1 0 CUPIDO
+1 0 HADES
+1 0 ZEUS
+1 0 KRONOS
+1 0 APOLLON
+1 1 DMETOS
+1 0 VULCANUS
+1 0 POSEIDON
+1 0 LEFT HALF BLACK STAR
+1 0 RIGHT HALF BLACK STAR
+1 0 STAR WITH LEFT HALF BLACK
+1 10 RIGHT HALF BLACK
+1 0 LEFTWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS
+1 0 UPWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS
+1 0 RIGHTWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS
+1 0 DOWNWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS
+1 0 ERIS FORM ONE
+1 10 TWO
+1 0 SEDNA
+1 0 RUSSIAN ASTROLOGICAL SYMBOL VIGINTILE
+1 28 NOVILE
+1 28 QUINTILE
+1 28 BINOVILE
+1 28 SENTAGON
+1 28 TREDECILE
+1 0 EQUALS SIGN WITH INFINITY BELOW
+1 0 UNITED SYMBOL
+1 0 SEPARATED SYMBOL
+1 0 DOUBLED SYMBOL
+1 0 PASSED SYMBOL
+1 0 REVERSED RIGHT ANGLE
+1 0 HELLSCHREIBER PAUSE SYMBOL
+1 0 GLAGOLITIC CAPITAL LETTER AZU
+1 26 BUKY
+1 26 VEDE
+1 26 GLAGOLI
+1 26 DOBRO
+1 26 YESTU
+1 26 ZHIVETE
+1 26 DZELO
+1 26 ZEMLJA
+1 26 IZHE
+1 27 NITIAL IZHE
+1 27 
+1 26 DJERVI
+1 26 KAKO
+1 26 LJUDIJE
+1 26 MYSLITE
+1 26 NASHI
+1 26 ONU
+1 26 POKOJI
+1 26 RITSI
+1 26 SLOVO
+1 26 TVRIDO
+1 26 UKU
+1 26 FRITU
+1 26 HERU
+1 26 OTU
+1 26 PE
+1 26 SHTA
+1 26 TSI
+1 26 CHRIVI
+1 26 SHA
+1 26 YERU
+1 29 I
+1 27 ATI
+1 26 SPIDERY HA
+1 26 YU
+1 26 SMALL YUS
+1 35  WITH TAIL
+1 26 YO
+1 26 IOTATED SMALL YUS
+1 26 BIG YUS
+1 26 IOTATED BIG YUS
+1 26 FITA
+1 26 IZHITSA
+1 26 SHTAPIC
+1 26 TROKUTASTI A
+1 26 LATINATE MYSLITE
+1 26 CAUDATE CHRIVI
+1 11 SMALL LETTER AZU
+1 24 BUKY
+1 24 VEDE
+1 24 GLAGOLI
+1 24 DOBRO
+1 24 YESTU
+1 24 ZHIVETE
+1 24 DZELO
+1 24 ZEMLJA
+1 24 IZHE
+1 25 NITIAL IZHE
+1 25 
+1 24 DJERVI
+1 24 KAKO
+1 24 LJUDIJE
+1 24 MYSLITE
+1 24 NASHI
+1 24 ONU
+1 24 POKOJI
+1 24 RITSI
+1 24 SLOVO
+1 24 TVRIDO
+1 24 UKU
+1 24 FRITU
+1 24 HERU
+1 24 OTU
+1 24 PE
+1 24 SHTA
+1 24 TSI
+1 24 CHRIVI
+1 24 SHA
+1 24 YERU
+1 27 I
+1 25 ATI
+1 24 SPIDERY HA
+1 24 YU
+1 24 SMALL YUS
+1 33  WITH TAIL
+1 24 YO
+1 24 IOTATED SMALL YUS
+1 24 BIG YUS
+1 24 IOTATED BIG YUS
+1 24 FITA
+1 24 IZHITSA
+1 24 SHTAPIC
+1 24 TROKUTASTI A
+1 24 LATINATE MYSLITE
+1 24 CAUDATE CHRIVI
+1 0 LATIN CAPITAL LETTER L WITH DOUBLE BAR
+1 6 SMALL LETTER L WITH DOUBLE BAR
+1 6 CAPITAL LETTER L WITH MIDDLE TILDE
+1 21 P WITH STROKE
+1 21 R WITH TAIL
+1 6 SMALL LETTER A WITH STROKE
+1 19 T WITH DIAGONAL STROKE
+1 6 CAPITAL LETTER H WITH DESCENDER
+1 6 SMALL LETTER H WITH DESCENDER
+1 6 CAPITAL LETTER K WITH DESCENDER
+1 6 SMALL LETTER K WITH DESCENDER
+1 6 CAPITAL LETTER Z WITH DESCENDER
+1 6 SMALL LETTER Z WITH DESCENDER
+1 6 CAPITAL LETTER ALPHA
+1 21 M WITH HOOK
+1 21 TURNED A
+1 29 LPHA
+1 6 SMALL LETTER V WITH RIGHT HOOK
+1 6 CAPITAL LETTER W WITH HOOK
+1 6 SMALL LETTER W WITH HOOK
+1 19 V WITH CURL
+1 6 CAPITAL LETTER HALF H
+1 6 SMALL LETTER HALF H
+1 19 TAILLESS PHI
+1 19 E WITH NOTCH
+1 19 TURNED R WITH TAIL
+1 19 O WITH LOW RING INSIDE
+1 6 LETTER SMALL CAPITAL TURNED E
+1 6 SUBSCRIPT SMALL LETTER J
+1 0 MODIFIER LETTER CAPITAL V
+1 0 LATIN CAPITAL LETTER S WITH SWASH TAIL
+1 21 Z WITH SWASH TAIL
+1 0 COPTIC CAPITAL LETTER ALFA
+1 7 SMALL LETTER ALFA
+1 7 CAPITAL LETTER VIDA
+1 7 SMALL LETTER VIDA
+1 7 CAPITAL LETTER GAMMA
+1 7 SMALL LETTER GAMMA
+1 7 CAPITAL LETTER DALDA
+1 7 SMALL LETTER DALDA
+1 7 CAPITAL LETTER EIE
+1 7 SMALL LETTER EIE
+1 7 CAPITAL LETTER SOU
+1 7 SMALL LETTER SOU
+1 7 CAPITAL LETTER ZATA
+1 7 SMALL LETTER ZATA
+1 7 CAPITAL LETTER HATE
+1 7 SMALL LETTER HATE
+1 7 CAPITAL LETTER THETHE
+1 7 SMALL LETTER THETHE
+1 7 CAPITAL LETTER IAUDA
+1 7 SMALL LETTER IAUDA
+1 7 CAPITAL LETTER KAPA
+1 7 SMALL LETTER KAPA
+1 7 CAPITAL LETTER LAULA
+1 7 SMALL LETTER LAULA
+1 7 CAPITAL LETTER MI
+1 7 SMALL LETTER MI
+1 7 CAPITAL LETTER NI
+1 7 SMALL LETTER NI
+1 7 CAPITAL LETTER KSI
+1 7 SMALL LETTER KSI
+1 7 CAPITAL LETTER O
+1 7 SMALL LETTER O
+1 7 CAPITAL LETTER PI
+1 7 SMALL LETTER PI
+1 7 CAPITAL LETTER RO
+1 7 SMALL LETTER RO
+1 7 CAPITAL LETTER SIMA
+1 7 SMALL LETTER SIMA
+1 7 CAPITAL LETTER TAU
+1 7 SMALL LETTER TAU
+1 7 CAPITAL LETTER UA
+1 7 SMALL LETTER UA
+1 7 CAPITAL LETTER FI
+1 7 SMALL LETTER FI
+1 7 CAPITAL LETTER KHI
+1 7 SMALL LETTER KHI
+1 7 CAPITAL LETTER PSI
+1 7 SMALL LETTER PSI
+1 7 CAPITAL LETTER OOU
+1 7 SMALL LETTER OOU
+1 7 CAPITAL LETTER DIALECT-P ALEF
+1 7 SMALL LETTER DIALECT-P ALEF
+1 7 CAPITAL LETTER OLD COPTIC AIN
+1 7 SMALL LETTER OLD COPTIC AIN
+1 7 CAPITAL LETTER CRYPTOGRAMMIC EIE
+1 7 SMALL LETTER CRYPTOGRAMMIC EIE
+1 7 CAPITAL LETTER DIALECT-P KAPA
+1 7 SMALL LETTER DIALECT-P KAPA
+1 7 CAPITAL LETTER DIALECT-P NI
+1 7 SMALL LETTER DIALECT-P NI
+1 7 CAPITAL LETTER CRYPTOGRAMMIC NI
+1 7 SMALL LETTER CRYPTOGRAMMIC NI
+1 7 CAPITAL LETTER OLD COPTIC OOU
+1 7 SMALL LETTER OLD COPTIC OOU
+1 7 CAPITAL LETTER SAMPI
+1 7 SMALL LETTER SAMPI
+1 7 CAPITAL LETTER CROSSED SHEI
+1 7 SMALL LETTER CROSSED SHEI
+1 7 CAPITAL LETTER OLD COPTIC SHEI
+1 7 SMALL LETTER OLD COPTIC SHEI
+1 7 CAPITAL LETTER OLD COPTIC ESH
+1 7 SMALL LETTER OLD COPTIC ESH
+1 7 CAPITAL LETTER AKHMIMIC KHEI
+1 7 SMALL LETTER AKHMIMIC KHEI
+1 7 CAPITAL LETTER DIALECT-P HORI
+1 7 SMALL LETTER DIALECT-P HORI
+1 7 CAPITAL LETTER OLD COPTIC HORI
+1 7 SMALL LETTER OLD COPTIC HORI
+1 7 CAPITAL LETTER OLD COPTIC HA
+1 7 SMALL LETTER OLD COPTIC HA
+1 7 CAPITAL LETTER L-SHAPED HA
+1 7 SMALL LETTER L-SHAPED HA
+1 7 CAPITAL LETTER OLD COPTIC HEI
+1 7 SMALL LETTER OLD COPTIC HEI
+1 7 CAPITAL LETTER OLD COPTIC HAT
+1 7 SMALL LETTER OLD COPTIC HAT
+1 7 CAPITAL LETTER OLD COPTIC GANGIA
+1 7 SMALL LETTER OLD COPTIC GANGIA
+1 7 CAPITAL LETTER OLD COPTIC DJA
+1 7 SMALL LETTER OLD COPTIC DJA
+1 7 CAPITAL LETTER OLD COPTIC SHIMA
+1 7 SMALL LETTER OLD COPTIC SHIMA
+1 7 CAPITAL LETTER OLD NUBIAN SHIMA
+1 7 SMALL LETTER OLD NUBIAN SHIMA
+1 7 CAPITAL LETTER OLD NUBIAN NGI
+1 7 SMALL LETTER OLD NUBIAN NGI
+1 7 CAPITAL LETTER OLD NUBIAN NYI
+1 7 SMALL LETTER OLD NUBIAN NYI
+1 7 CAPITAL LETTER OLD NUBIAN WAU
+1 7 SMALL LETTER OLD NUBIAN WAU
+1 8 YMBOL KAI
+1 14 MI RO
+1 14 PI RO
+1 14 STAUROS
+1 14 TAU RO
+1 14 KHI RO
+1 14 SHIMA SIMA
+1 7 CAPITAL LETTER CRYPTOGRAMMIC SHEI
+1 7 SMALL LETTER CRYPTOGRAMMIC SHEI
+1 7 CAPITAL LETTER CRYPTOGRAMMIC GANGIA
+1 7 SMALL LETTER CRYPTOGRAMMIC GANGIA
+1 7 COMBINING NI ABOVE
+1 17 SPIRITUS ASPER
+1 26 LENIS
+1 8 APITAL LETTER BOHAIRIC KHEI
+1 7 SMALL LETTER BOHAIRIC KHEI
+6 7 OLD NUBIAN FULL STOP
+1 18 DIRECT QUESTION MARK
+1 18 INDIRECT QUESTION MARK
+1 18 VERSE DIVIDER
+1 7 FRACTION ONE HALF
+1 8 ULL STOP
+1 7 MORPHOLOGICAL DIVIDER
+1 0 GEORGIAN SMALL LETTER AN
+1 22 BAN
+1 22 GAN
+1 22 DON
+1 22 EN
+1 22 VIN
+1 22 ZEN
+1 22 TAN
+1 22 IN
+1 22 KAN
+1 22 LAS
+1 22 MAN
+1 22 NAR
+1 22 ON
+1 22 PAR
+1 22 ZHAR
+1 22 RAE
+1 22 SAN
+1 22 TAR
+1 22 UN
+1 22 PHAR
+1 22 KHAR
+1 22 GHAN
+1 22 QAR
+1 22 SHIN
+1 22 CHIN
+1 23 AN
+1 22 JIL
+1 22 CIL
+1 23 HAR
+1 22 XAN
+1 22 JHAN
+1 22 HAE
+1 23 E
+1 23 IE
+1 22 WE
+1 22 HAR
+1 23 OE
+2 22 YN
+6 22 AEN
+3 0 TIFINAGH LETTER YA
+1 18 B
+1 19 H
+1 18 G
+1 19 HH
+1 16 BERBER ACADEMY YAJ
+1 16 YAJ
+1 18 D
+1 19 H
+1 19 D
+1 20 H
+1 17 EY
+1 17 AF
+1 18 K
+1 16 TUAREG YAK
+1 16 YAKHH
+1 18 H
+1 16 BERBER ACADEMY YAH
+1 16 TUAREG YAH
+1 16 YAHH
+1 18 A
+1 18 KH
+1 16 TUAREG YAKH
+1 16 YAQ
+1 16 TUAREG YAQ
+1 16 YI
+1 17 AZH
+1 16 AHAGGAR YAZH
+1 16 TUAREG YAZH
+1 16 YAL
+1 18 M
+1 18 N
+1 16 TUAREG YAGN
+1 25 NG
+1 16 YAP
+1 17 U
+1 17 AR
+1 19 R
+1 18 GH
+1 16 TUAREG YAGH
+1 16 AYER YAGH
+1 16 YAS
+1 19 S
+1 19 H
+1 18 T
+1 19 H
+1 18 CH
+1 18 TT
+1 18 V
+1 18 W
+1 18 Y
+1 18 Z
+1 16 TAWELLEMET YAZ
+1 16 YAZZ
+1 17 E
+1 17 O
+8 9 MODIFIER LETTER LABIALIZATION MARK
+1 9 SEPARATOR MARK
+f 9 CONSONANT JOINER
+1 0 ETHIOPIC SYLLABLE LOA
+1 18 MOA
+1 18 ROA
+1 18 SOA
+1 19 HOA
+1 18 BOA
+1 18 TOA
+1 18 COA
+1 18 NOA
+1 19 YOA
+1 18 GLOTTAL OA
+1 18 ZOA
+1 18 DOA
+1 19 DOA
+1 18 JOA
+1 18 THOA
+1 18 CHOA
+1 18 PHOA
+1 19 OA
+1 18 GGWA
+1 21 I
+1 21 EE
+1 22 
+a 18 SSA
+1 20 U
+1 20 I
+1 20 AA
+1 20 EE
+1 21 
+1 20 O
+2 18 CCA
+1 20 U
+1 20 I
+1 20 AA
+1 20 EE
+1 21 
+1 20 O
+2 18 ZZA
+1 20 U
+1 20 I
+1 20 AA
+1 20 EE
+1 21 
+1 20 O
+2 18 CCHA
+1 21 U
+1 21 I
+1 21 AA
+1 21 EE
+1 22 
+1 21 O
+2 18 QYA
+1 20 U
+1 20 I
+1 20 AA
+1 20 EE
+1 21 
+1 20 O
+2 18 KYA
+1 20 U
+1 20 I
+1 20 AA
+1 20 EE
+1 21 
+1 20 O
+2 18 XYA
+1 20 U
+1 20 I
+1 20 AA
+1 20 EE
+1 21 
+1 20 O
+2 18 GYA
+1 20 U
+1 20 I
+1 20 AA
+1 20 EE
+1 21 
+1 20 O
+2 0 COMBINING CYRILLIC LETTER BE
+1 26 VE
+1 26 GHE
+1 26 DE
+1 26 ZHE
+1 27 E
+1 26 KA
+1 26 EL
+1 27 M
+1 27 N
+1 26 O
+1 26 PE
+1 26 ER
+1 27 S
+1 26 TE
+1 26 HA
+1 26 TSE
+1 26 CHE
+1 26 SHA
+1 28 CHA
+1 26 FITA
+1 26 ES-TE
+1 26 A
+1 26 IE
+1 26 DJERV
+1 26 MONOGRAPH UK
+1 26 YAT
+1 27 U
+1 26 IOTIFIED A
+1 26 LITTLE YUS
+1 26 BIG YUS
+1 26 IOTIFIED BIG YUS
+1 0 RIGHT ANGLE SUBSTITUTION MARKER
+1 12 DOTTED SUBSTITUTION MARKER
+1 0 LEFT SUBSTITUTION BRACKET
+1 0 RIGHT SUBSTITUTION BRACKET
+1 0 LEFT DOTTED SUBSTITUTION BRACKET
+1 0 RIGHT DOTTED SUBSTITUTION BRACKET
+1 1 AISED INTERPOLATION MARKER
+1 7 DOTTED INTERPOLATION MARKER
+1 0 DOTTED TRANSPOSITION MARKER
+1 0 LEFT TRANSPOSITION BRACKET
+1 0 RIGHT TRANSPOSITION BRACKET
+1 1 AISED SQUARE
+1 0 LEFT RAISED OMISSION BRACKET
+1 0 RIGHT RAISED OMISSION BRACKET
+1 0 EDITORIAL CORONIS
+1 0 PARAGRAPHOS
+1 0 FORKED PARAGRAPHOS
+1 0 REVERSED FORKED PARAGRAPHOS
+1 0 HYPODIASTOLE
+1 0 DOTTED OBELOS
+1 2 WNWARDS ANCORA
+1 0 UPWARDS ANCORA
+1 0 DOTTED RIGHT-POINTING ANGLE
+1 2 UBLE OBLIQUE HYPHEN
+1 0 INVERTED INTERROBANG
+1 0 PALM BRANCH
+1 0 HYPHEN WITH DIAERESIS
+1 0 TILDE WITH RING ABOVE
+1 0 LEFT LOW PARAPHRASE BRACKET
+1 0 RIGHT LOW PARAPHRASE BRACKET
+1 0 TILDE WITH DOT ABOVE
+1 15 BELOW
+1 0 LEFT VERTICAL BAR WITH QUILL
+1 0 RIGHT VERTICAL BAR WITH QUILL
+1 0 TOP LEFT HALF BRACKET
+1 4 RIGHT HALF BRACKET
+1 0 BOTTOM LEFT HALF BRACKET
+1 7 RIGHT HALF BRACKET
+1 0 LEFT SIDEWAYS U BRACKET
+1 0 RIGHT SIDEWAYS U BRACKET
+1 0 LEFT DOUBLE PARENTHESIS
+1 0 RIGHT DOUBLE PARENTHESIS
+1 0 TWO DOTS OVER ONE DOT PUNCTUATION
+1 0 ONE DOT OVER TWO DOTS PUNCTUATION
+1 0 SQUARED FOUR DOT PUNCTUATION
+1 0 FIVE DOT MARK
+1 0 REVERSED QUESTION MARK
+1 0 VERTICAL TILDE
+1 0 RING POINT
+1 0 WORD SEPARATOR MIDDLE DOT
+1 0 TURNED COMMA
+1 0 RAISED DOT
+1 7 COMMA
+1 0 TURNED SEMICOLON
+1 0 DAGGER WITH LEFT GUARD
+1 12 RIGHT GUARD
+1 0 TURNED DAGGER
+1 1 OP HALF SECTION SIGN
+1 1 WO-EM DASH
+1 1 HREE-EM DASH
+1 0 STENOGRAPHIC FULL STOP
+1 0 VERTICAL SIX DOTS
+1 0 WIGGLY VERTICAL LINE
+1 0 CAPITULUM
+1 0 DOUBLE HYPHEN
+1 0 REVERSED COMMA
+1 0 DOUBLE LOW-REVERSED-9 QUOTATION MARK
+1 1 ASH WITH LEFT UPTURN
+1 1 OUBLE SUSPENSION MARK
+1 0 INVERTED LOW KAVYKA
+1 19  WITH KAVYKA ABOVE
+1 0 LOW KAVYKA
+1 10  WITH DOT
+1 0 DOUBLE STACKED COMMA
+1 2 TTED SOLIDUS
+1 0 TRIPLE DAGGER
+1 0 MEDIEVAL COMMA
+1 0 PARAGRAPHUS MARK
+1 1 UNCTUS ELEVATUS MARK
+1 0 CORNISH VERSE DIVIDER
+1 1 ROSS PATTY WITH RIGHT CROSSBAR
+1 17 LEFT CROSSBAR
+1 0 TIRONIAN SIGN CAPITAL ET
+1 0 MEDIEVAL EXCLAMATION MARK
+1 9 QUESTION MARK
+1 0 LEFT SQUARE BRACKET WITH STROKE
+1 0 RIGHT SQUARE BRACKET WITH STROKE
+1 0 LEFT SQUARE BRACKET WITH DOUBLE STROKE
+1 0 RIGHT SQUARE BRACKET WITH DOUBLE STROKE
+1 0 TOP HALF LEFT PARENTHESIS
+1 9 RIGHT PARENTHESIS
+1 0 BOTTOM HALF LEFT PARENTHESIS
+1 12 RIGHT PARENTHESIS
+1 0 OBLIQUE HYPHEN
+23 0 CJK RADICAL REPEAT
+1 12 CLIFF
+1 12 SECOND ONE
+1 19 TWO
+1 20 HREE
+1 12 PERSON
+1 12 BOX
+1 12 TABLE
+1 12 KNIFE ONE
+1 18 TWO
+1 12 DIVINATION
+1 12 SEAL
+1 13 MALL ONE
+1 18 TWO
+1 12 LAME ONE
+1 17 TWO
+1 18 HREE
+1 17 FOUR
+1 12 SNAKE
+1 12 THREAD
+1 12 SNOUT ONE
+1 18 TWO
+1 12 HEART ONE
+1 18 TWO
+1 13 AND
+1 12 RAP
+2 12 CHOKE
+1 12 SUN
+1 12 MOON
+1 12 DEATH
+1 12 MOTHER
+1 12 CIVILIAN
+1 12 WATER ONE
+1 18 TWO
+1 12 FIRE
+1 12 PAW ONE
+1 16 TWO
+1 12 SIMPLIFIED HALF TREE TRUNK
+1 12 COW
+1 12 DOG
+1 12 JADE
+1 12 BOLT OF CLOTH
+1 12 EYE
+1 12 SPIRIT ONE
+1 19 TWO
+1 12 BAMBOO
+1 12 SILK
+1 12 C-SIMPLIFIED SILK
+1 12 NET ONE
+1 16 TWO
+1 17 HREE
+1 16 FOUR
+1 12 MESH
+1 12 SHEEP
+1 12 RAM
+1 12 EWE
+1 12 OLD
+1 12 BRUSH ONE
+1 18 TWO
+1 12 MEAT
+1 13 ORTAR
+1 12 GRASS ONE
+1 18 TWO
+1 19 HREE
+1 12 TIGER
+1 12 CLOTHES
+1 12 WEST ONE
+1 17 TWO
+1 12 C-SIMPLIFIED SEE
+1 12 SIMPLIFIED HORN
+1 12 HORN
+1 12 C-SIMPLIFIED SPEECH
+1 26 HELL
+1 12 FOOT
+1 12 C-SIMPLIFIED CART
+1 12 SIMPLIFIED WALK
+1 12 WALK ONE
+1 17 TWO
+1 12 CITY
+1 13 -SIMPLIFIED GOLD
+1 12 LONG ONE
+1 17 TWO
+1 12 C-SIMPLIFIED LONG
+1 25 GATE
+1 12 MOUND ONE
+1 18 TWO
+1 12 RAIN
+1 12 BLUE
+1 12 C-SIMPLIFIED TANNED LEATHER
+1 25 LEAF
+1 25 WIND
+1 25 FLY
+1 12 EAT ONE
+1 16 TWO
+1 17 HREE
+1 12 C-SIMPLIFIED EAT
+1 12 HEAD
+1 12 C-SIMPLIFIED HORSE
+1 12 BONE
+1 12 GHOST
+1 12 C-SIMPLIFIED FISH
+1 25 BIRD
+1 25 SALT
+1 12 SIMPLIFIED WHEAT
+1 23 YELLOW
+1 12 C-SIMPLIFIED FROG
+1 12 J-SIMPLIFIED EVEN
+1 12 C-SIMPLIFIED EVEN
+1 12 J-SIMPLIFIED TOOTH
+1 12 C-SIMPLIFIED TOOTH
+1 12 J-SIMPLIFIED DRAGON
+1 12 C-SIMPLIFIED DRAGON
+1 12 TURTLE
+1 12 J-SIMPLIFIED TURTLE
+1 12 C-SIMPLIFIED TURTLE
+d 0 KANGXI RADICAL ONE
+1 15 LINE
+1 15 DOT
+1 15 SLASH
+1 16 ECOND
+1 15 HOOK
+1 15 TWO
+1 15 LID
+1 15 MAN
+1 15 LEGS
+1 15 ENTER
+1 16 IGHT
+1 15 DOWN BOX
+1 15 COVER
+1 15 ICE
+1 15 TABLE
+1 15 OPEN BOX
+1 15 KNIFE
+1 15 POWER
+1 15 WRAP
+1 15 SPOON
+1 15 RIGHT OPEN BOX
+1 15 HIDING ENCLOSURE
+1 15 TEN
+1 15 DIVINATION
+1 15 SEAL
+1 15 CLIFF
+1 15 PRIVATE
+1 15 AGAIN
+1 15 MOUTH
+1 15 ENCLOSURE
+1 16 ARTH
+1 15 SCHOLAR
+1 15 GO
+1 17  SLOWLY
+1 15 EVENING
+1 15 BIG
+1 15 WOMAN
+1 15 CHILD
+1 15 ROOF
+1 15 INCH
+1 15 SMALL
+1 15 LAME
+1 15 CORPSE
+1 15 SPROUT
+1 15 MOUNTAIN
+1 15 RIVER
+1 15 WORK
+1 15 ONESELF
+1 15 TURBAN
+1 15 DRY
+1 15 SHORT THREAD
+1 15 DOTTED CLIFF
+1 15 LONG STRIDE
+1 15 TWO HANDS
+1 15 SHOOT
+1 15 BOW
+1 15 SNOUT
+1 15 BRISTLE
+1 15 STEP
+1 15 HEART
+1 16 ALBERD
+1 15 DOOR
+1 15 HAND
+1 15 BRANCH
+1 15 RAP
+1 15 SCRIPT
+1 15 DIPPER
+1 15 AXE
+1 15 SQUARE
+1 15 NOT
+1 15 SUN
+1 16 AY
+1 15 MOON
+1 15 TREE
+1 15 LACK
+1 15 STOP
+1 15 DEATH
+1 15 WEAPON
+1 15 DO NOT
+1 15 COMPARE
+1 15 FUR
+1 15 CLAN
+1 15 STEAM
+1 15 WATER
+1 15 FIRE
+1 15 CLAW
+1 15 FATHER
+1 15 DOUBLE X
+1 15 HALF TREE TRUNK
+1 15 SLICE
+1 15 FANG
+1 15 COW
+1 15 DOG
+1 15 PROFOUND
+1 15 JADE
+1 15 MELON
+1 15 TILE
+1 15 SWEET
+1 15 LIFE
+1 15 USE
+1 15 FIELD
+1 15 BOLT OF CLOTH
+1 15 SICKNESS
+1 15 DOTTED TENT
+1 15 WHITE
+1 15 SKIN
+1 15 DISH
+1 15 EYE
+1 15 SPEAR
+1 15 ARROW
+1 15 STONE
+1 16 PIRIT
+1 15 TRACK
+1 15 GRAIN
+1 15 CAVE
+1 15 STAND
+1 15 BAMBOO
+1 15 RICE
+1 15 SILK
+1 15 JAR
+1 15 NET
+1 15 SHEEP
+1 15 FEATHER
+1 15 OLD
+1 15 AND
+1 15 PLOW
+1 15 EAR
+1 15 BRUSH
+1 15 MEAT
+1 16 INISTER
+1 15 SELF
+1 15 ARRIVE
+1 15 MORTAR
+1 15 TONGUE
+1 15 OPPOSE
+1 15 BOAT
+1 15 STOPPING
+1 15 COLOR
+1 15 GRASS
+1 15 TIGER
+1 15 INSECT
+1 15 BLOOD
+1 15 WALK ENCLOSURE
+1 15 CLOTHES
+1 15 WEST
+1 15 SEE
+1 15 HORN
+1 15 SPEECH
+1 15 VALLEY
+1 15 BEAN
+1 15 PIG
+1 15 BADGER
+1 15 SHELL
+1 15 RED
+1 16 UN
+1 15 FOOT
+1 15 BODY
+1 15 CART
+1 15 BITTER
+1 15 MORNING
+1 15 WALK
+1 15 CITY
+1 15 WINE
+1 15 DISTINGUISH
+1 15 VILLAGE
+1 15 GOLD
+1 15 LONG
+1 15 GATE
+1 15 MOUND
+1 15 SLAVE
+1 16 HORT TAILED BIRD
+1 15 RAIN
+1 15 BLUE
+1 15 WRONG
+1 15 FACE
+1 15 LEATHER
+1 15 TANNED LEATHER
+1 15 LEEK
+1 15 SOUND
+1 15 LEAF
+1 15 WIND
+1 15 FLY
+1 15 EAT
+1 15 HEAD
+1 15 FRAGRANT
+1 15 HORSE
+1 15 BONE
+1 15 TALL
+1 15 HAIR
+1 15 FIGHT
+1 15 SACRIFICIAL WINE
+1 15 CAULDRON
+1 15 GHOST
+1 15 FISH
+1 15 BIRD
+1 15 SALT
+1 15 DEER
+1 15 WHEAT
+1 15 HEMP
+1 15 YELLOW
+1 15 MILLET
+1 15 BLACK
+1 15 EMBROIDERY
+1 15 FROG
+1 15 TRIPOD
+1 15 DRUM
+1 15 RAT
+1 15 NOSE
+1 15 EVEN
+1 15 TOOTH
+1 15 DRAGON
+1 15 TURTLE
+1 15 FLUTE
+1b 0 IDEOGRAPHIC DESCRIPTION CHARACTER LEFT TO RIGHT
+1 34 ABOVE TO BELOW
+1 34 LEFT TO MIDDLE AND RIGHT
+1 34 ABOVE TO MIDDLE AND BELOW
+1 34 FULL SURROUND
+1 34 SURROUND FROM ABOVE
+1 48 BELOW
+1 48 LEFT
+1 48 UPPER LEFT
+1 54 RIGHT
+1 48 LOWER LEFT
+1 34 OVERLAID
+5 12 SPACE
+1 12 COMMA
+1 12 FULL STOP
+1 0 DITTO MARK
+1 0 JAPANESE INDUSTRIAL STANDARD SYMBOL
+1 0 IDEOGRAPHIC ITERATION MARK
+1 12 CLOSING MARK
+1 12 NUMBER ZERO
+1 0 LEFT ANGLE BRACKET
+1 0 RIGHT ANGLE BRACKET
+1 0 LEFT DOUBLE ANGLE BRACKET
+1 0 RIGHT DOUBLE ANGLE BRACKET
+1 0 LEFT CORNER BRACKET
+1 0 RIGHT CORNER BRACKET
+1 0 LEFT WHITE CORNER BRACKET
+1 0 RIGHT WHITE CORNER BRACKET
+1 0 LEFT BLACK LENTICULAR BRACKET
+1 0 RIGHT BLACK LENTICULAR BRACKET
+1 0 POSTAL MARK
+1 0 GETA MARK
+1 0 LEFT TORTOISE SHELL BRACKET
+1 0 RIGHT TORTOISE SHELL BRACKET
+1 0 LEFT WHITE LENTICULAR BRACKET
+1 0 RIGHT WHITE LENTICULAR BRACKET
+1 0 LEFT WHITE TORTOISE SHELL BRACKET
+1 0 RIGHT WHITE TORTOISE SHELL BRACKET
+1 0 LEFT WHITE SQUARE BRACKET
+1 0 RIGHT WHITE SQUARE BRACKET
+1 0 WAVE DASH
+1 0 REVERSED DOUBLE PRIME QUOTATION MARK
+1 0 DOUBLE PRIME QUOTATION MARK
+1 0 LOW DOUBLE PRIME QUOTATION MARK
+1 0 POSTAL MARK FACE
+1 0 HANGZHOU NUMERAL ONE
+1 17 TWO
+1 18 HREE
+1 17 FOUR
+1 18 IVE
+1 17 SIX
+1 18 EVEN
+1 17 EIGHT
+1 17 NINE
+1 0 IDEOGRAPHIC LEVEL TONE MARK
+1 12 RISING TONE MARK
+1 12 DEPARTING TONE MARK
+1 12 ENTERING TONE MARK
+1 0 HANGUL SINGLE DOT TONE MARK
+1 7 DOUBLE DOT TONE MARK
+1 0 WAVY DASH
+1 0 VERTICAL KANA REPEAT MARK
+1 21 WITH VOICED SOUND MARK
+1 21 MARK UPPER HALF
+1 21 WITH VOICED SOUND MARK UPPER HALF
+1 21 MARK LOWER HALF
+1 0 CIRCLED POSTAL MARK
+1 0 IDEOGRAPHIC TELEGRAPH LINE FEED SEPARATOR SYMBOL
+1 0 HANGZHOU NUMERAL TEN
+1 18 WENTY
+1 18 HIRTY
+1 0 VERTICAL IDEOGRAPHIC ITERATION MARK
+1 0 MASU MARK
+1 0 PART ALTERNATION MARK
+1 0 IDEOGRAPHIC VARIATION INDICATOR
+1 12 HALF FILL SPACE
+2 0 HIRAGANA LETTER SMALL A
+1 16 A
+1 16 SMALL I
+1 16 I
+1 16 SMALL U
+1 16 U
+1 16 SMALL E
+1 16 E
+1 16 SMALL O
+1 16 O
+1 16 KA
+1 16 GA
+1 16 KI
+1 16 GI
+1 16 KU
+1 16 GU
+1 16 KE
+1 16 GE
+1 16 KO
+1 16 GO
+1 16 SA
+1 16 ZA
+1 16 SI
+1 16 ZI
+1 16 SU
+1 16 ZU
+1 16 SE
+1 16 ZE
+1 16 SO
+1 16 ZO
+1 16 TA
+1 16 DA
+1 16 TI
+1 16 DI
+1 16 SMALL TU
+1 16 TU
+1 16 DU
+1 16 TE
+1 16 DE
+1 16 TO
+1 16 DO
+1 16 NA
+1 17 I
+1 17 U
+1 17 E
+1 17 O
+1 16 HA
+1 16 BA
+1 16 PA
+1 16 HI
+1 16 BI
+1 16 PI
+1 16 HU
+1 16 BU
+1 16 PU
+1 16 HE
+1 16 BE
+1 16 PE
+1 16 HO
+1 16 BO
+1 16 PO
+1 16 MA
+1 17 I
+1 17 U
+1 17 E
+1 17 O
+1 16 SMALL YA
+1 16 YA
+1 16 SMALL YU
+1 16 YU
+1 16 SMALL YO
+1 16 YO
+1 16 RA
+1 17 I
+1 17 U
+1 17 E
+1 17 O
+1 16 SMALL WA
+1 16 WA
+1 17 I
+1 17 E
+1 17 O
+1 16 N
+1 16 VU
+1 16 SMALL KA
+1 23 E
+3 0 COMBINING KATAKANA-HIRAGANA VOICED SOUND MARK
+1 28 SEMI-VOICED SOUND MARK
+1 0 KATAKANA-HIRAGANA VOICED SOUND MARK
+1 18 SEMI-VOICED SOUND MARK
+1 0 HIRAGANA ITERATION MARK
+1 9 VOICED ITERATION MARK
+1 9 DIGRAPH YORI
+1 0 KATAKANA-HIRAGANA DOUBLE HYPHEN
+1 8  LETTER SMALL A
+1 16 A
+1 16 SMALL I
+1 16 I
+1 16 SMALL U
+1 16 U
+1 16 SMALL E
+1 16 E
+1 16 SMALL O
+1 16 O
+1 16 KA
+1 16 GA
+1 16 KI
+1 16 GI
+1 16 KU
+1 16 GU
+1 16 KE
+1 16 GE
+1 16 KO
+1 16 GO
+1 16 SA
+1 16 ZA
+1 16 SI
+1 16 ZI
+1 16 SU
+1 16 ZU
+1 16 SE
+1 16 ZE
+1 16 SO
+1 16 ZO
+1 16 TA
+1 16 DA
+1 16 TI
+1 16 DI
+1 16 SMALL TU
+1 16 TU
+1 16 DU
+1 16 TE
+1 16 DE
+1 16 TO
+1 16 DO
+1 16 NA
+1 17 I
+1 17 U
+1 17 E
+1 17 O
+1 16 HA
+1 16 BA
+1 16 PA
+1 16 HI
+1 16 BI
+1 16 PI
+1 16 HU
+1 16 BU
+1 16 PU
+1 16 HE
+1 16 BE
+1 16 PE
+1 16 HO
+1 16 BO
+1 16 PO
+1 16 MA
+1 17 I
+1 17 U
+1 17 E
+1 17 O
+1 16 SMALL YA
+1 16 YA
+1 16 SMALL YU
+1 16 YU
+1 16 SMALL YO
+1 16 YO
+1 16 RA
+1 17 I
+1 17 U
+1 17 E
+1 17 O
+1 16 SMALL WA
+1 16 WA
+1 17 I
+1 17 E
+1 17 O
+1 16 N
+1 16 VU
+1 16 SMALL KA
+1 23 E
+1 16 VA
+1 17 I
+1 17 E
+1 17 O
+1 9 MIDDLE DOT
+1 8 -HIRAGANA PROLONGED SOUND MARK
+1 8  ITERATION MARK
+1 9 VOICED ITERATION MARK
+1 9 DIGRAPH KOTO
+6 0 BOPOMOFO LETTER B
+1 16 P
+1 16 M
+1 16 F
+1 16 D
+1 16 T
+1 16 N
+1 16 L
+1 16 G
+1 16 K
+1 16 H
+1 16 J
+1 16 Q
+1 16 X
+1 16 ZH
+1 16 CH
+1 16 SH
+1 16 R
+1 16 Z
+1 16 C
+1 16 S
+1 16 A
+1 16 O
+1 16 E
+1 17 H
+1 16 AI
+1 16 EI
+1 16 AU
+1 16 OU
+1 16 AN
+1 16 EN
+1 16 ANG
+1 16 ENG
+1 17 R
+1 16 I
+1 16 U
+1 16 IU
+1 16 V
+1 16 NG
+1 16 GN
+1 16 IH
+1 16 O WITH DOT ABOVE
+1 16 NN
+2 0 HANGUL LETTER KIYEOK
+1 14 SSANGKIYEOK
+1 14 KIYEOK-SIOS
+1 14 NIEUN
+1 19 -CIEUC
+1 20 HIEUH
+1 14 TIKEUT
+1 14 SSANGTIKEUT
+1 14 RIEUL
+1 19 -KIYEOK
+1 20 MIEUM
+1 20 PIEUP
+1 20 SIOS
+1 20 THIEUTH
+1 20 PHIEUPH
+1 20 HIEUH
+1 14 MIEUM
+1 14 PIEUP
+1 14 SSANGPIEUP
+1 14 PIEUP-SIOS
+1 14 SIOS
+1 15 SANGSIOS
+1 14 IEUNG
+1 14 CIEUC
+1 14 SSANGCIEUC
+1 14 CHIEUCH
+1 14 KHIEUKH
+1 14 THIEUTH
+1 14 PHIEUPH
+1 14 HIEUH
+1 14 A
+1 15 E
+1 14 YA
+1 16 E
+1 14 EO
+1 15 
+1 14 YEO
+1 16 
+1 14 O
+1 14 WA
+1 16 E
+1 14 OE
+1 14 YO
+1 14 U
+1 14 WEO
+1 16 
+1 15 I
+1 14 YU
+1 14 EU
+1 14 YI
+1 14 I
+1 7 FILLER
+1 7 LETTER SSANGNIEUN
+1 14 NIEUN-TIKEUT
+1 20 SIOS
+1 20 PANSIOS
+1 14 RIEUL-KIYEOK-SIOS
+1 20 TIKEUT
+1 20 PIEUP-SIOS
+1 21 ANSIOS
+1 20 YEORINHIEUH
+1 14 MIEUM-PIEUP
+1 20 SIOS
+1 20 PANSIOS
+1 14 KAPYEOUNMIEUM
+1 14 PIEUP-KIYEOK
+1 20 TIKEUT
+1 20 SIOS-KIYEOK
+1 25 TIKEUT
+1 20 CIEUC
+1 20 THIEUTH
+1 14 KAPYEOUNPIEUP
+1 22 SSANGPIEUP
+1 14 SIOS-KIYEOK
+1 19 NIEUN
+1 19 TIKEUT
+1 19 PIEUP
+1 19 CIEUC
+1 14 PANSIOS
+1 14 SSANGIEUNG
+1 14 YESIEUNG
+1 22 -SIOS
+1 23 PANSIOS
+1 14 KAPYEOUNPHIEUPH
+1 14 SSANGHIEUH
+1 14 YEORINHIEUH
+1 15 O-YA
+1 19 E
+1 17 I
+1 15 U-YEO
+1 19 
+1 17 I
+1 14 ARAEA
+1 19 E
+2 0 IDEOGRAPHIC ANNOTATION LINKING MARK
+1 23 REVERSE MARK
+1 23 ONE MARK
+1 23 TWO MARK
+1 24 HREE MARK
+1 23 FOUR MARK
+1 23 TOP MARK
+1 23 MIDDLE MARK
+1 23 BOTTOM MARK
+1 23 FIRST MARK
+1 23 SECOND MARK
+1 23 THIRD MARK
+1 23 FOURTH MARK
+1 23 HEAVEN MARK
+1 23 EARTH MARK
+1 23 MAN MARK
+1 0 BOPOMOFO LETTER BU
+1 16 ZI
+1 16 JI
+1 16 GU
+1 16 EE
+1 17 NN
+1 16 OO
+1 17 NN
+1 16 IR
+1 16 ANN
+1 16 INN
+1 16 UNN
+1 16 IM
+1 16 NGG
+1 16 AINN
+1 17 UNN
+1 17 M
+1 16 OM
+1 17 NG
+1 16 INNN
+1 9 FINAL LETTER P
+1 22 T
+1 22 K
+1 22 H
+1 9 LETTER GH
+1 16 LH
+1 16 ZY
+1 9 FINAL LETTER G
+1 9 LETTER GW
+1 16 KW
+1 16 OE
+1 16 AH
+1 0 CJK STROKE T
+1 11 WG
+1 11 XG
+1 11 BXG
+1 11 SW
+1 11 HZZ
+1 13 G
+1 12 P
+1 12 ZWG
+1 11 SZWG
+1 11 HZT
+1 13 ZP
+1 12 PWG
+1 12 ZW
+1 13 ZZ
+1 11 N
+1 11 H
+1 11 S
+1 11 P
+1 11 SP
+1 11 D
+1 11 HZ
+1 12 G
+1 11 SZ
+1 12 WZ
+1 12 T
+1 12 G
+1 11 PD
+1 12 Z
+1 11 TN
+1 11 SZZ
+1 12 WG
+1 11 HXWG
+1 12 ZZZG
+1 11 PG
+1 11 Q
+d 0 KATAKANA LETTER SMALL KU
+1 22 SI
+1 23 U
+1 22 TO
+1 22 NU
+1 22 HA
+1 23 I
+1 23 U
+1 23 E
+1 23 O
+1 22 MU
+1 22 RA
+1 23 I
+1 23 U
+1 23 E
+1 23 O
+1 0 PARENTHESIZED HANGUL KIYEOK
+1 21 NIEUN
+1 21 TIKEUT
+1 21 RIEUL
+1 21 MIEUM
+1 21 PIEUP
+1 21 SIOS
+1 21 IEUNG
+1 21 CIEUC
+1 22 HIEUCH
+1 21 KHIEUKH
+1 21 THIEUTH
+1 21 PHIEUPH
+1 21 HIEUH
+1 21 KIYEOK A
+1 21 NIEUN A
+1 21 TIKEUT A
+1 21 RIEUL A
+1 21 MIEUM A
+1 21 PIEUP A
+1 21 SIOS A
+1 21 IEUNG A
+1 21 CIEUC A
+1 22 HIEUCH A
+1 21 KHIEUKH A
+1 21 THIEUTH A
+1 21 PHIEUPH A
+1 21 HIEUH A
+1 21 CIEUC U
+1 14 KOREAN CHARACTER OJEON
+1 32  HU
+2 14 IDEOGRAPH ONE
+1 24 TWO
+1 25 HREE
+1 24 FOUR
+1 25 IVE
+1 24 SIX
+1 25 EVEN
+1 24 EIGHT
+1 24 NINE
+1 24 TEN
+1 24 MOON
+1 24 FIRE
+1 24 WATER
+1 25 OOD
+1 24 METAL
+1 24 EARTH
+1 24 SUN
+1 25 TOCK
+1 24 HAVE
+1 24 SOCIETY
+1 24 NAME
+1 24 SPECIAL
+1 24 FINANCIAL
+1 24 CONGRATULATION
+1 24 LABOR
+1 24 REPRESENT
+1 24 CALL
+1 24 STUDY
+1 25 UPERVISE
+1 24 ENTERPRISE
+1 24 RESOURCE
+1 24 ALLIANCE
+1 24 FESTIVAL
+1 24 REST
+1 24 SELF
+1 24 REACH
+1 0 CIRCLED IDEOGRAPH QUESTION
+1 18 KINDERGARTEN
+1 18 SCHOOL
+1 18 KOTO
+1 8 NUMBER TEN ON BLACK SQUARE
+1 16 WENTY ON BLACK SQUARE
+1 16 HIRTY ON BLACK SQUARE
+1 15 FORTY ON BLACK SQUARE
+1 16 IFTY ON BLACK SQUARE
+1 15 SIXTY ON BLACK SQUARE
+1 16 EVENTY ON BLACK SQUARE
+1 15 EIGHTY ON BLACK SQUARE
+1 0 PARTNERSHIP SIGN
+1 0 CIRCLED NUMBER TWENTY ONE
+1 22 TWO
+1 23 HREE
+1 22 FOUR
+1 23 IVE
+1 22 SIX
+1 23 EVEN
+1 22 EIGHT
+1 22 NINE
+1 16 HIRTY
+1 21  ONE
+1 22 TWO
+1 23 HREE
+1 22 FOUR
+1 23 IVE
+1 8 HANGUL KIYEOK
+1 15 NIEUN
+1 15 TIKEUT
+1 15 RIEUL
+1 15 MIEUM
+1 15 PIEUP
+1 15 SIOS
+1 15 IEUNG
+1 15 CIEUC
+1 16 HIEUCH
+1 15 KHIEUKH
+1 15 THIEUTH
+1 15 PHIEUPH
+1 15 HIEUH
+1 15 KIYEOK A
+1 15 NIEUN A
+1 15 TIKEUT A
+1 15 RIEUL A
+1 15 MIEUM A
+1 15 PIEUP A
+1 15 SIOS A
+1 15 IEUNG A
+1 15 CIEUC A
+1 16 HIEUCH A
+1 15 KHIEUKH A
+1 15 THIEUTH A
+1 15 PHIEUPH A
+1 15 HIEUH A
+1 8 KOREAN CHARACTER CHAMKO
+1 25 JUEUI
+1 8 HANGUL IEUNG U
+1 0 KOREAN STANDARD SYMBOL
+1 0 CIRCLED IDEOGRAPH ONE
+1 18 TWO
+1 19 HREE
+1 18 FOUR
+1 19 IVE
+1 18 SIX
+1 19 EVEN
+1 18 EIGHT
+1 18 NINE
+1 18 TEN
+1 18 MOON
+1 18 FIRE
+1 18 WATER
+1 19 OOD
+1 18 METAL
+1 18 EARTH
+1 18 SUN
+1 19 TOCK
+1 18 HAVE
+1 18 SOCIETY
+1 18 NAME
+1 18 SPECIAL
+1 18 FINANCIAL
+1 18 CONGRATULATION
+1 18 LABOR
+1 18 SECRET
+1 18 MALE
+1 18 FEMALE
+1 18 SUITABLE
+1 18 EXCELLENT
+1 18 PRINT
+1 18 ATTENTION
+1 18 ITEM
+1 18 REST
+1 18 COPY
+1 20 RRECT
+1 18 HIGH
+1 18 CENTRE
+1 18 LOW
+1 19 EFT
+1 18 RIGHT
+1 18 MEDICINE
+1 18 RELIGION
+1 18 STUDY
+1 19 UPERVISE
+1 18 ENTERPRISE
+1 18 RESOURCE
+1 18 ALLIANCE
+1 18 NIGHT
+1 8 NUMBER THIRTY SIX
+1 23 EVEN
+1 22 EIGHT
+1 22 NINE
+1 15 FORTY
+1 20  ONE
+1 21 TWO
+1 22 HREE
+1 21 FOUR
+1 22 IVE
+1 21 SIX
+1 22 EVEN
+1 21 EIGHT
+1 21 NINE
+1 16 IFTY
+1 0 IDEOGRAPHIC TELEGRAPH SYMBOL FOR JANUARY
+1 33 FEBRUARY
+1 33 MARCH
+1 33 APRIL
+1 33 MAY
+1 33 JUNE
+1 35 LY
+1 33 AUGUST
+1 33 SEPTEMBER
+1 33 OCTOBER
+1 33 NOVEMBER
+1 33 DECEMBER
+1 0 SQUARE HG
+1 7 ERG
+1 8 V
+1 0 LIMITED LIABILITY SIGN
+1 0 CIRCLED KATAKANA A
+1 17 I
+1 17 U
+1 17 E
+1 17 O
+1 17 KA
+1 18 I
+1 18 U
+1 18 E
+1 18 O
+1 17 SA
+1 18 I
+1 18 U
+1 18 E
+1 18 O
+1 17 TA
+1 18 I
+1 18 U
+1 18 E
+1 18 O
+1 17 NA
+1 18 I
+1 18 U
+1 18 E
+1 18 O
+1 17 HA
+1 18 I
+1 18 U
+1 18 E
+1 18 O
+1 17 MA
+1 18 I
+1 18 U
+1 18 E
+1 18 O
+1 17 YA
+1 18 U
+1 18 O
+1 17 RA
+1 18 I
+1 18 U
+1 18 E
+1 18 O
+1 17 WA
+1 18 I
+1 18 E
+1 18 O
+1 0 SQUARE ERA NAME REIWA
+1 7 APAATO
+1 8 RUHUA
+1 8 NPEA
+1 8 ARU
+1 7 ININGU
+1 9 TI
+1 7 UON
+1 7 ESUKUUDO
+1 8 EKAA
+1 7 ONSU
+1 8 OMU
+1 7 KAIRI
+1 9 RATTO
+1 10 ORII
+1 7 GARON
+1 9 NMA
+1 8 IGA
+1 9 NII
+1 7 KYURII
+1 7 GIRUDAA
+1 7 KIRO
+1 11 GURAMU
+1 11 MEETORU
+1 11 WATTO
+1 7 GURAMU
+1 13 TON
+1 7 KURUZEIRO
+1 10 OONE
+1 8 EESU
+1 8 ORUNA
+1 9 OPO
+1 7 SAIKURU
+1 9 NTIIMU
+1 8 IRINGU
+1 8 ENTI
+1 11 O
+1 7 DAASU
+1 8 ESI
+1 8 ORU
+1 7 TON
+1 7 NANO
+1 8 OTTO
+1 7 HAITU
+1 7 PAASENTO
+1 10 TU
+1 7 BAARERU
+1 7 PIASUTORU
+1 9 KURU
+1 10 O
+1 7 BIRU
+1 7 HUARADDO
+1 9 IITO
+1 7 BUSSYERU
+1 7 HURAN
+1 8 EKUTAARU
+1 7 PESO
+1 9 NIHI
+1 7 HERUTU
+1 7 PENSU
+1 9 EZI
+1 7 BEETA
+1 7 POINTO
+1 7 BORUTO
+1 7 HON
+1 7 PONDO
+1 7 HOORU
+1 10 N
+1 7 MAIKURO
+1 10 RU
+1 9 HHA
+1 9 RUKU
+1 9 NSYON
+1 8 IKURON
+1 9 RI
+1 11 BAARU
+1 8 EGA
+1 11 TON
+1 9 ETORU
+1 7 YAADO
+1 10 RU
+1 8 UAN
+1 7 RITTORU
+1 9 RA
+1 8 UPII
+1 9 UBURU
+1 8 EMU
+1 9 NTOGEN
+1 7 WATTO
+1 0 IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR ZERO
+1 38 ONE
+1 38 TWO
+1 39 HREE
+1 38 FOUR
+1 39 IVE
+1 38 SIX
+1 39 EVEN
+1 38 EIGHT
+1 38 NINE
+1 38 TEN
+1 38 ELEVEN
+1 38 TWELVE
+1 39 HIRTEEN
+1 38 FOURTEEN
+1 39 IFTEEN
+1 38 SIXTEEN
+1 39 EVENTEEN
+1 38 EIGHTEEN
+1 38 NINETEEN
+1 38 TWENTY
+1 44 -ONE
+1 45 TWO
+1 46 HREE
+1 45 FOUR
+1 0 SQUARE HPA
+1 7 DA
+1 7 AU
+1 7 BAR
+1 7 OV
+1 7 PC
+1 7 DM
+1 9  SQUARED
+1 10 CUBED
+1 7 IU
+1 7 ERA NAME HEISEI
+1 16 SYOUWA
+1 16 TAISYOU
+1 16 MEIZI
+1 7 CORPORATION
+1 7 PA AMPS
+1 7 NA
+1 7 MU A
+1 8 A
+1 7 KA
+1 8 B
+1 7 MB
+1 7 GB
+1 7 CAL
+1 7 KCAL
+1 7 PF
+1 7 NF
+1 7 MU F
+1 10 G
+1 8 G
+1 7 KG
+1 7 HZ
+1 7 KHZ
+1 7 MHZ
+1 7 GHZ
+1 7 THZ
+1 7 MU L
+1 8 L
+1 7 DL
+1 7 KL
+1 7 FM
+1 7 NM
+1 7 MU M
+1 8 M
+1 7 CM
+1 7 KM
+1 7 MM SQUARED
+1 7 CM SQUARED
+1 7 M SQUARED
+1 7 KM SQUARED
+1 7 MM CUBED
+1 7 CM CUBED
+1 7 M CUBED
+1 7 KM CUBED
+1 7 M OVER S
+1 15  SQUARED
+1 7 PA
+1 7 KPA
+1 7 MPA
+1 7 GPA
+1 7 RAD
+1 10  OVER S
+1 17  SQUARED
+1 7 PS
+1 7 NS
+1 7 MU S
+1 8 S
+1 7 PV
+1 7 NV
+1 7 MU V
+1 8 V
+1 7 KV
+1 7 MV MEGA
+1 7 PW
+1 7 NW
+1 7 MU W
+1 8 W
+1 7 KW
+1 7 MW MEGA
+1 7 K OHM
+1 7 M OHM
+1 7 AM
+1 7 BQ
+1 7 CC
+1 8 D
+1 8  OVER KG
+1 8 O
+1 7 DB
+1 7 GY
+1 7 HA
+1 8 P
+1 7 IN
+1 7 KK
+1 8 M CAPITAL
+1 8 T
+1 7 LM
+1 8 N
+1 8 OG
+1 8 X
+1 7 MB SMALL
+1 8 IL
+1 8 OL
+1 7 PH
+1 8 M
+1 8 PM
+1 8 R
+1 7 SR
+1 8 V
+1 7 WB
+1 7 V OVER M
+1 7 A OVER M
+1 0 IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY ONE
+1 37 TWO
+1 38 HREE
+1 37 FOUR
+1 38 IVE
+1 37 SIX
+1 38 EVEN
+1 37 EIGHT
+1 37 NINE
+1 37 TEN
+1 37 ELEVEN
+1 37 TWELVE
+1 38 HIRTEEN
+1 37 FOURTEEN
+1 38 IFTEEN
+1 37 SIXTEEN
+1 38 EVENTEEN
+1 37 EIGHTEEN
+1 37 NINETEEN
+1 37 TWENTY
+1 43 -ONE
+1 44 TWO
+1 45 HREE
+1 44 FOUR
+1 45 IVE
+1 44 SIX
+1 45 EVEN
+1 44 EIGHT
+1 44 NINE
+1 38 HIRTY
+1 43 -ONE
+1 0 SQUARE GAL
+19c1 0 HEXAGRAM FOR THE CREATIVE HEAVEN
+1 17 RECEPTIVE EARTH
+1 13 DIFFICULTY AT THE BEGINNING
+1 13 YOUTHFUL FOLLY
+1 13 WAITING
+1 13 CONFLICT
+1 13 THE ARMY
+1 13 HOLDING TOGETHER
+1 13 SMALL TAMING
+1 13 TREADING
+1 13 PEACE
+1 13 STANDSTILL
+1 13 FELLOWSHIP
+1 13 GREAT POSSESSION
+1 13 MODESTY
+1 13 ENTHUSIASM
+1 13 FOLLOWING
+1 13 WORK ON THE DECAYED
+1 13 APPROACH
+1 13 CONTEMPLATION
+1 13 BITING THROUGH
+1 13 GRACE
+1 13 SPLITTING APART
+1 13 RETURN
+1 13 INNOCENCE
+1 13 GREAT TAMING
+1 13 MOUTH CORNERS
+1 13 GREAT PREPONDERANCE
+1 13 THE ABYSMAL WATER
+1 17 CLINGING FIRE
+1 13 INFLUENCE
+1 13 DURATION
+1 13 RETREAT
+1 13 GREAT POWER
+1 13 PROGRESS
+1 13 DARKENING OF THE LIGHT
+1 13 THE FAMILY
+1 13 OPPOSITION
+1 14 BSTRUCTION
+1 13 DELIVERANCE
+1 15 CREASE
+1 13 INCREASE
+1 13 BREAKTHROUGH
+1 13 COMING TO MEET
+1 13 GATHERING TOGETHER
+1 13 PUSHING UPWARD
+1 13 OPPRESSION
+1 13 THE WELL
+1 13 REVOLUTION
+1 13 THE CAULDRON
+1 17 AROUSING THUNDER
+1 17 KEEPING STILL MOUNTAIN
+1 13 DEVELOPMENT
+1 13 THE MARRYING MAIDEN
+1 13 ABUNDANCE
+1 13 THE WANDERER
+1 17 GENTLE WIND
+1 17 JOYOUS LAKE
+1 13 DISPERSION
+1 13 LIMITATION
+1 13 INNER TRUTH
+1 13 SMALL PREPONDERANCE
+1 13 AFTER COMPLETION
+1 13 BEFORE COMPLETION
+5201 0 YI SYLLABLE IT
+1 13 X
+1 13 
+1 13 P
+1 13 ET
+1 14 X
+1 14 
+1 14 P
+1 12 AT
+1 13 X
+1 13 
+1 13 P
+1 12 UOX
+1 14 
+1 14 P
+1 12 OT
+1 13 X
+1 13 
+1 13 P
+1 12 EX
+1 13 
+1 12 WU
+1 12 BIT
+1 14 X
+1 14 
+1 14 P
+1 14 ET
+1 15 X
+1 15 
+1 15 P
+1 13 AT
+1 14 X
+1 14 
+1 14 P
+1 13 UOX
+1 15 
+1 15 P
+1 13 OT
+1 14 X
+1 14 
+1 14 P
+1 13 EX
+1 14 
+1 14 P
+1 13 UT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 13 YT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 12 PIT
+1 14 X
+1 14 
+1 14 P
+1 14 EX
+1 15 
+1 15 P
+1 13 AT
+1 14 X
+1 14 
+1 14 P
+1 13 UOX
+1 15 
+1 15 P
+1 13 OT
+1 14 X
+1 14 
+1 14 P
+1 13 UT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 13 YT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 12 BBIT
+1 15 X
+1 15 
+1 15 P
+1 15 ET
+1 16 X
+1 16 
+1 16 P
+1 14 AT
+1 15 X
+1 15 
+1 15 P
+1 14 UOX
+1 16 
+1 16 P
+1 14 OT
+1 15 X
+1 15 
+1 15 P
+1 14 EX
+1 15 
+1 15 P
+1 14 UT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 14 YT
+1 15 X
+1 15 
+1 15 P
+1 12 NBIT
+1 15 X
+1 15 
+1 15 P
+1 15 EX
+1 16 
+1 16 P
+1 14 AT
+1 15 X
+1 15 
+1 15 P
+1 14 OT
+1 15 X
+1 15 
+1 15 P
+1 14 UT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 14 YT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 12 HMIT
+1 15 X
+1 15 
+1 15 P
+1 15 EX
+1 16 
+1 16 P
+1 14 AT
+1 15 X
+1 15 
+1 15 P
+1 14 UOX
+1 16 
+1 16 P
+1 14 OT
+1 15 X
+1 15 
+1 15 P
+1 14 UT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 14 YX
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 12 MIT
+1 14 X
+1 14 
+1 14 P
+1 14 EX
+1 15 
+1 15 P
+1 13 AT
+1 14 X
+1 14 
+1 14 P
+1 13 UOT
+1 15 X
+1 15 
+1 15 P
+1 13 OT
+1 14 X
+1 14 
+1 14 P
+1 13 EX
+1 14 
+1 13 UT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 13 YT
+1 14 X
+1 14 
+1 14 P
+1 12 FIT
+1 14 X
+1 14 
+1 14 P
+1 13 AT
+1 14 X
+1 14 
+1 14 P
+1 13 OX
+1 14 
+1 14 P
+1 13 UT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 13 YT
+1 14 X
+1 14 
+1 14 P
+1 12 VIT
+1 14 X
+1 14 
+1 14 P
+1 14 ET
+1 15 X
+1 15 
+1 15 P
+1 13 AT
+1 14 X
+1 14 
+1 14 P
+1 13 OT
+1 14 X
+1 14 
+1 14 P
+1 13 EX
+1 14 P
+1 13 UT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 13 YT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 12 DIT
+1 14 X
+1 14 
+1 14 P
+1 14 EX
+1 15 
+1 15 P
+1 13 AT
+1 14 X
+1 14 
+1 14 P
+1 13 UOX
+1 15 
+1 13 OT
+1 14 X
+1 14 
+1 14 P
+1 13 EX
+1 14 
+1 14 P
+1 13 UT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 12 TIT
+1 14 X
+1 14 
+1 14 P
+1 14 EX
+1 15 
+1 15 P
+1 13 AT
+1 14 X
+1 14 
+1 14 P
+1 13 UOT
+1 15 X
+1 15 
+1 15 P
+1 13 OT
+1 14 X
+1 14 
+1 14 P
+1 13 EX
+1 14 
+1 14 P
+1 13 UT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 12 DDIT
+1 15 X
+1 15 
+1 15 P
+1 15 EX
+1 16 
+1 16 P
+1 14 AT
+1 15 X
+1 15 
+1 15 P
+1 14 UOX
+1 16 
+1 16 P
+1 14 OT
+1 15 X
+1 15 
+1 15 P
+1 14 EX
+1 15 
+1 15 P
+1 14 UT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 12 NDIT
+1 15 X
+1 15 
+1 15 P
+1 15 EX
+1 16 
+1 14 AT
+1 15 X
+1 15 
+1 15 P
+1 14 OT
+1 15 X
+1 15 
+1 15 P
+1 14 EX
+1 15 
+1 15 P
+1 14 UT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 12 HNIT
+1 15 X
+1 15 
+1 15 P
+1 15 ET
+1 16 X
+1 16 
+1 16 P
+1 14 AT
+1 15 X
+1 15 
+1 15 P
+1 14 UOX
+1 16 
+1 14 OT
+1 15 X
+1 15 P
+1 14 EX
+1 15 
+1 15 P
+1 14 UT
+1 12 NIT
+1 14 X
+1 14 
+1 14 P
+1 14 EX
+1 15 
+1 15 P
+1 13 AX
+1 14 
+1 14 P
+1 13 UOX
+1 15 
+1 15 P
+1 13 OT
+1 14 X
+1 14 
+1 14 P
+1 13 EX
+1 14 
+1 14 P
+1 13 UT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 12 HLIT
+1 15 X
+1 15 
+1 15 P
+1 15 EX
+1 16 
+1 16 P
+1 14 AT
+1 15 X
+1 15 
+1 15 P
+1 14 UOX
+1 16 
+1 16 P
+1 14 OX
+1 15 
+1 15 P
+1 14 EX
+1 15 
+1 15 P
+1 14 UT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 14 YT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 12 LIT
+1 14 X
+1 14 
+1 14 P
+1 14 ET
+1 15 X
+1 15 
+1 15 P
+1 13 AT
+1 14 X
+1 14 
+1 14 P
+1 13 UOT
+1 15 X
+1 15 
+1 15 P
+1 13 OT
+1 14 X
+1 14 
+1 14 P
+1 13 EX
+1 14 
+1 14 P
+1 13 UT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 13 YT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 12 GIT
+1 14 X
+1 14 
+1 14 P
+1 14 ET
+1 15 X
+1 15 
+1 15 P
+1 13 AT
+1 14 X
+1 14 
+1 14 P
+1 13 UOT
+1 15 X
+1 15 
+1 15 P
+1 13 OT
+1 14 X
+1 14 
+1 14 P
+1 13 ET
+1 14 X
+1 14 
+1 14 P
+1 13 UT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 12 KIT
+1 14 X
+1 14 
+1 14 P
+1 14 EX
+1 15 
+1 15 P
+1 13 AT
+1 14 X
+1 14 
+1 14 P
+1 13 UOX
+1 15 
+1 15 P
+1 13 OT
+1 14 X
+1 14 
+1 14 P
+1 13 ET
+1 14 X
+1 14 
+1 14 P
+1 13 UT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 12 GGIT
+1 15 X
+1 15 
+1 15 EX
+1 16 
+1 16 P
+1 14 AT
+1 15 X
+1 15 
+1 15 P
+1 14 UOT
+1 16 X
+1 16 
+1 16 P
+1 14 OT
+1 15 X
+1 15 
+1 15 P
+1 14 ET
+1 15 X
+1 15 
+1 15 P
+1 14 UT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 12 MGIEX
+1 16 
+1 14 AT
+1 15 X
+1 15 
+1 15 P
+1 14 UOX
+1 16 
+1 16 P
+1 14 OT
+1 15 X
+1 15 
+1 15 P
+1 14 EX
+1 15 
+1 15 P
+1 14 UT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 12 HXIT
+1 15 X
+1 15 
+1 15 P
+1 15 ET
+1 16 X
+1 16 
+1 16 P
+1 14 AT
+1 15 X
+1 15 
+1 15 P
+1 14 UOT
+1 16 X
+1 16 
+1 16 P
+1 14 OT
+1 15 X
+1 15 
+1 15 P
+1 14 EX
+1 15 
+1 15 P
+1 12 NGIEX
+1 16 
+1 16 P
+1 14 AT
+1 15 X
+1 15 
+1 15 P
+1 14 UOT
+1 16 X
+1 16 
+1 14 OT
+1 15 X
+1 15 
+1 15 P
+1 14 EX
+1 15 
+1 15 P
+1 12 HIT
+1 14 EX
+1 15 
+1 13 AT
+1 14 X
+1 14 
+1 14 P
+1 13 UOT
+1 15 X
+1 15 
+1 15 P
+1 13 OT
+1 14 X
+1 14 
+1 14 P
+1 13 EX
+1 14 
+1 14 P
+1 12 WAT
+1 14 X
+1 14 
+1 14 P
+1 13 UOX
+1 15 
+1 15 P
+1 13 OX
+1 14 
+1 14 P
+1 13 EX
+1 14 
+1 14 P
+1 12 ZIT
+1 14 X
+1 14 
+1 14 P
+1 14 EX
+1 15 
+1 15 P
+1 13 AT
+1 14 X
+1 14 
+1 14 P
+1 13 UOX
+1 15 
+1 15 P
+1 13 OT
+1 14 X
+1 14 
+1 14 P
+1 13 EX
+1 14 
+1 14 P
+1 13 UT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 13 YT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 12 CIT
+1 14 X
+1 14 
+1 14 P
+1 14 ET
+1 15 X
+1 15 
+1 15 P
+1 13 AT
+1 14 X
+1 14 
+1 14 P
+1 13 UOX
+1 15 
+1 15 P
+1 13 OT
+1 14 X
+1 14 
+1 14 P
+1 13 EX
+1 14 
+1 14 P
+1 13 UT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 13 YT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 12 ZZIT
+1 15 X
+1 15 
+1 15 P
+1 15 ET
+1 16 X
+1 16 
+1 16 P
+1 14 AT
+1 15 X
+1 15 
+1 15 P
+1 14 OX
+1 15 
+1 15 P
+1 14 EX
+1 15 
+1 15 P
+1 14 UX
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 14 YT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 12 NZIT
+1 15 X
+1 15 
+1 15 P
+1 15 EX
+1 16 
+1 16 P
+1 14 AT
+1 15 X
+1 15 
+1 15 P
+1 14 UOX
+1 16 
+1 14 OX
+1 15 P
+1 14 EX
+1 15 
+1 14 UX
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 14 YT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 12 SIT
+1 14 X
+1 14 
+1 14 P
+1 14 EX
+1 15 
+1 15 P
+1 13 AT
+1 14 X
+1 14 
+1 14 P
+1 13 UOX
+1 15 
+1 15 P
+1 13 OT
+1 14 X
+1 14 
+1 14 P
+1 13 EX
+1 14 
+1 14 P
+1 13 UT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 13 YT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 13 SIT
+1 15 X
+1 15 
+1 15 P
+1 15 EX
+1 16 
+1 16 P
+1 14 AT
+1 15 X
+1 15 
+1 15 P
+1 14 OT
+1 15 X
+1 15 
+1 15 P
+1 14 EX
+1 15 
+1 15 P
+1 14 UT
+1 15 X
+1 15 
+1 15 P
+1 14 YT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 12 ZHAT
+1 15 X
+1 15 
+1 15 P
+1 14 UOX
+1 16 
+1 16 P
+1 14 OT
+1 15 X
+1 15 
+1 15 P
+1 14 ET
+1 15 X
+1 15 
+1 15 P
+1 14 UT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 14 YT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 12 CHAT
+1 15 X
+1 15 
+1 15 P
+1 14 UOT
+1 16 X
+1 16 
+1 16 P
+1 14 OT
+1 15 X
+1 15 
+1 15 P
+1 14 ET
+1 15 X
+1 15 
+1 15 P
+1 14 UX
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 14 YT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 12 RRAX
+1 15 
+1 14 UOX
+1 16 
+1 14 OT
+1 15 X
+1 15 
+1 15 P
+1 14 ET
+1 15 X
+1 15 
+1 15 P
+1 14 UT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 14 YT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 12 NRAT
+1 15 X
+1 15 
+1 15 P
+1 14 OX
+1 15 
+1 15 P
+1 14 ET
+1 15 X
+1 15 
+1 15 P
+1 14 UT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 14 YT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 12 SHAT
+1 15 X
+1 15 
+1 15 P
+1 14 UOX
+1 16 
+1 16 P
+1 14 OT
+1 15 X
+1 15 
+1 15 P
+1 14 ET
+1 15 X
+1 15 
+1 15 P
+1 14 UT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 14 YT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 12 RAT
+1 14 X
+1 14 
+1 14 P
+1 13 UOX
+1 15 
+1 15 P
+1 13 OT
+1 14 X
+1 14 
+1 14 P
+1 13 EX
+1 14 
+1 14 P
+1 13 UT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 13 YT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 12 JIT
+1 14 X
+1 14 
+1 14 P
+1 14 ET
+1 15 X
+1 15 
+1 15 P
+1 13 UOT
+1 15 X
+1 15 
+1 15 P
+1 13 OT
+1 14 X
+1 14 
+1 14 P
+1 13 UT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 13 YT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 12 QIT
+1 14 X
+1 14 
+1 14 P
+1 14 ET
+1 15 X
+1 15 
+1 15 P
+1 13 UOT
+1 15 X
+1 15 
+1 15 P
+1 13 OT
+1 14 X
+1 14 
+1 14 P
+1 13 UT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 13 YT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 12 JJIT
+1 15 X
+1 15 
+1 15 P
+1 15 ET
+1 16 X
+1 16 
+1 16 P
+1 14 UOX
+1 16 
+1 16 P
+1 14 OT
+1 15 X
+1 15 
+1 15 P
+1 14 UT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 14 YT
+1 15 X
+1 15 
+1 15 P
+1 12 NJIT
+1 15 X
+1 15 
+1 15 P
+1 15 ET
+1 16 X
+1 16 
+1 16 P
+1 14 UOX
+1 16 
+1 14 OT
+1 15 X
+1 15 
+1 15 P
+1 14 UX
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 14 YT
+1 15 X
+1 15 
+1 15 P
+1 15 RX
+1 16 
+1 13 YIT
+1 15 X
+1 15 
+1 15 P
+1 15 ET
+1 16 X
+1 16 
+1 16 P
+1 14 UOX
+1 16 
+1 16 P
+1 14 OT
+1 15 X
+1 15 
+1 15 P
+1 14 UT
+1 15 X
+1 15 
+1 15 P
+1 12 XIT
+1 14 X
+1 14 
+1 14 P
+1 14 ET
+1 15 X
+1 15 
+1 15 P
+1 13 UOX
+1 15 
+1 13 OT
+1 14 X
+1 14 
+1 14 P
+1 13 YT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 12 YIT
+1 14 X
+1 14 
+1 14 P
+1 14 ET
+1 15 X
+1 15 
+1 15 P
+1 13 UOT
+1 15 X
+1 15 
+1 15 P
+1 13 OT
+1 14 X
+1 14 
+1 14 P
+1 13 UT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+1 13 YT
+1 14 X
+1 14 
+1 14 P
+1 14 RX
+1 15 
+4 3 RADICAL QOT
+1 11 LI
+1 11 KIT
+1 11 NYIP
+1 11 CYP
+1 11 SSI
+1 11 GGOP
+1 12 EP
+1 11 MI
+1 11 HXIT
+1 11 LYR
+1 11 BBUT
+1 11 MOP
+1 11 YO
+1 11 PUT
+1 11 HXUO
+1 11 TAT
+1 11 GA
+1 11 ZUP
+1 11 CYT
+1 11 DDUR
+1 11 BUR
+1 11 GGUO
+1 11 NYOP
+1 11 TU
+1 11 OP
+1 11 JJUT
+1 11 ZOT
+1 11 PYT
+1 11 HMO
+1 11 YIT
+1 11 VUR
+1 11 SHY
+1 11 VEP
+1 11 ZA
+1 11 JO
+1 11 NZUP
+1 11 JJY
+1 11 GOT
+1 11 JJIE
+1 11 WO
+1 11 DU
+1 11 SHUR
+1 11 LIE
+1 11 CY
+1 12 UOP
+1 12 IP
+1 11 HXOP
+1 11 SHAT
+1 11 ZUR
+1 11 SHOP
+1 11 CHE
+1 11 ZZIET
+1 11 NBIE
+1 11 KE
+a 0 LISU LETTER BA
+1 12 PA
+1 13 HA
+1 12 DA
+1 12 TA
+1 13 HA
+1 12 GA
+1 12 KA
+1 13 HA
+1 12 JA
+1 12 CA
+1 13 HA
+1 12 DZA
+1 12 TSA
+1 14 HA
+1 12 MA
+1 12 NA
+1 12 LA
+1 12 SA
+1 12 ZHA
+1 13 A
+1 12 NGA
+1 12 HA
+1 12 XA
+1 12 HHA
+1 12 FA
+1 12 WA
+1 12 SHA
+1 12 YA
+1 12 GHA
+1 12 A
+1 13 E
+1 12 E
+1 13 U
+1 12 I
+1 12 O
+1 12 U
+1 13 E
+1 13 H
+1 12 OE
+1 12 TONE MYA TI
+1 17 NA PO
+1 17 MYA CYA
+1 21 BO
+1 21 NA
+1 21 JEU
+1 5 PUNCTUATION COMMA
+1 17 FULL STOP
+1 0 VAI SYLLABLE EE
+1 15 N
+1 13 HEE
+1 13 WEE
+1 16 N
+1 13 PEE
+1 13 BHEE
+1 14 EE
+1 13 MBEE
+1 13 KPEE
+1 13 MGBEE
+1 13 GBEE
+1 13 FEE
+1 13 VEE
+1 13 TEE
+1 14 HEE
+1 13 DHEE
+1 15 HEE
+1 13 LEE
+1 13 REE
+1 13 DEE
+1 13 NDEE
+1 13 SEE
+1 14 HEE
+1 13 ZEE
+1 14 HEE
+1 13 CEE
+1 13 JEE
+1 13 NJEE
+1 13 YEE
+1 13 KEE
+1 13 NGGEE
+1 13 GEE
+1 13 MEE
+1 13 NEE
+1 14 YEE
+1 13 I
+1 14 N
+1 13 HI
+1 15 N
+1 13 WI
+1 15 N
+1 13 PI
+1 13 BHI
+1 14 I
+1 13 MBI
+1 13 KPI
+1 13 MGBI
+1 13 GBI
+1 13 FI
+1 13 VI
+1 13 TI
+1 14 HI
+1 13 DHI
+1 15 HI
+1 13 LI
+1 13 RI
+1 13 DI
+1 13 NDI
+1 13 SI
+1 14 HI
+1 13 ZI
+1 14 HI
+1 13 CI
+1 13 JI
+1 13 NJI
+1 13 YI
+1 13 KI
+1 13 NGGI
+1 13 GI
+1 13 MI
+1 13 NI
+1 14 YI
+1 13 A
+1 14 N
+1 13 NGAN
+1 13 HA
+1 15 N
+1 13 WA
+1 15 N
+1 13 PA
+1 13 BHA
+1 14 A
+1 13 MBA
+1 13 KPA
+1 16 N
+1 13 MGBA
+1 13 GBA
+1 13 FA
+1 13 VA
+1 13 TA
+1 14 HA
+1 13 DHA
+1 15 HA
+1 13 LA
+1 13 RA
+1 13 DA
+1 13 NDA
+1 13 SA
+1 14 HA
+1 13 ZA
+1 14 HA
+1 13 CA
+1 13 JA
+1 13 NJA
+1 13 YA
+1 13 KA
+1 15 N
+1 13 NGGA
+1 13 GA
+1 13 MA
+1 13 NA
+1 14 YA
+1 13 OO
+1 15 N
+1 13 HOO
+1 13 WOO
+1 16 N
+1 13 POO
+1 13 BHOO
+1 14 OO
+1 13 MBOO
+1 13 KPOO
+1 13 MGBOO
+1 13 GBOO
+1 13 FOO
+1 13 VOO
+1 13 TOO
+1 14 HOO
+1 13 DHOO
+1 15 HOO
+1 13 LOO
+1 13 ROO
+1 13 DOO
+1 13 NDOO
+1 13 SOO
+1 14 HOO
+1 13 ZOO
+1 14 HOO
+1 13 COO
+1 13 JOO
+1 13 NJOO
+1 13 YOO
+1 13 KOO
+1 13 NGGOO
+1 13 GOO
+1 13 MOO
+1 13 NOO
+1 14 YOO
+1 13 U
+1 14 N
+1 13 HU
+1 15 N
+1 13 WU
+1 15 N
+1 13 PU
+1 13 BHU
+1 14 U
+1 13 MBU
+1 13 KPU
+1 13 MGBU
+1 13 GBU
+1 13 FU
+1 13 VU
+1 13 TU
+1 14 HU
+1 13 DHU
+1 15 HU
+1 13 LU
+1 13 RU
+1 13 DU
+1 13 NDU
+1 13 SU
+1 14 HU
+1 13 ZU
+1 14 HU
+1 13 CU
+1 13 JU
+1 13 NJU
+1 13 YU
+1 13 KU
+1 13 NGGU
+1 13 GU
+1 13 MU
+1 13 NU
+1 14 YU
+1 13 O
+1 14 N
+1 13 NGON
+1 13 HO
+1 15 N
+1 13 WO
+1 15 N
+1 13 PO
+1 13 BHO
+1 14 O
+1 13 MBO
+1 13 KPO
+1 13 MGBO
+1 13 GBO
+1 16 N
+1 13 FO
+1 13 VO
+1 13 TO
+1 14 HO
+1 13 DHO
+1 15 HO
+1 13 LO
+1 13 RO
+1 13 DO
+1 13 NDO
+1 13 SO
+1 14 HO
+1 13 ZO
+1 14 HO
+1 13 CO
+1 13 JO
+1 13 NJO
+1 13 YO
+1 13 KO
+1 13 NGGO
+1 13 GO
+1 13 MO
+1 13 NO
+1 14 YO
+1 13 E
+1 14 N
+1 13 NGEN
+1 13 HE
+1 15 N
+1 13 WE
+1 15 N
+1 13 PE
+1 13 BHE
+1 14 E
+1 13 MBE
+1 13 KPE
+1 16 N
+1 13 MGBE
+1 13 GBE
+1 16 N
+1 13 FE
+1 13 VE
+1 13 TE
+1 14 HE
+1 13 DHE
+1 15 HE
+1 13 LE
+1 13 RE
+1 13 DE
+1 13 NDE
+1 13 SE
+1 14 HE
+1 13 ZE
+1 14 HE
+1 13 CE
+1 13 JE
+1 13 NJE
+1 13 YE
+1 13 KE
+1 13 NGGE
+1 17 N
+1 13 GE
+1 15 N
+1 13 ME
+1 13 NE
+1 14 YE
+1 14 G
+1 13 LENGTHENER
+1 4 COMMA
+1 4 FULL STOP
+1 4 QUESTION MARK
+1 4 SYLLABLE NDOLE FA
+1 19 KA
+1 19 SOO
+1 6 MBOL FEENG
+1 11 KEENG
+1 11 TING
+1 11 NII
+1 11 BANG
+1 11 FAA
+1 11 TAA
+1 11 DANG
+1 12 OONG
+1 11 KUNG
+1 11 TONG
+1 11 DO-O
+1 11 JONG
+1 4 DIGIT ZERO
+1 10 ONE
+1 10 TWO
+1 11 HREE
+1 10 FOUR
+1 11 IVE
+1 10 SIX
+1 11 EVEN
+1 10 EIGHT
+1 10 NINE
+1 4 SYLLABLE NDOLE MA
+1 19 DO
+15 0 CYRILLIC CAPITAL LETTER ZEMLYA
+1 9 SMALL LETTER ZEMLYA
+1 9 CAPITAL LETTER DZELO
+1 9 SMALL LETTER DZELO
+1 9 CAPITAL LETTER REVERSED DZE
+1 9 SMALL LETTER REVERSED DZE
+1 9 CAPITAL LETTER IOTA
+1 9 SMALL LETTER IOTA
+1 9 CAPITAL LETTER DJERV
+1 9 SMALL LETTER DJERV
+1 9 CAPITAL LETTER MONOGRAPH UK
+1 9 SMALL LETTER MONOGRAPH UK
+1 9 CAPITAL LETTER BROAD OMEGA
+1 9 SMALL LETTER BROAD OMEGA
+1 9 CAPITAL LETTER NEUTRAL YER
+1 9 SMALL LETTER NEUTRAL YER
+1 9 CAPITAL LETTER YERU WITH BACK YER
+1 9 SMALL LETTER YERU WITH BACK YER
+1 9 CAPITAL LETTER IOTIFIED YAT
+1 9 SMALL LETTER IOTIFIED YAT
+1 9 CAPITAL LETTER REVERSED YU
+1 9 SMALL LETTER REVERSED YU
+1 9 CAPITAL LETTER IOTIFIED A
+1 9 SMALL LETTER IOTIFIED A
+1 9 CAPITAL LETTER CLOSED LITTLE YUS
+1 9 SMALL LETTER CLOSED LITTLE YUS
+1 9 CAPITAL LETTER BLENDED YUS
+1 9 SMALL LETTER BLENDED YUS
+1 9 CAPITAL LETTER IOTIFIED CLOSED LITTLE YUS
+1 9 SMALL LETTER IOTIFIED CLOSED LITTLE YUS
+1 9 CAPITAL LETTER YN
+1 9 SMALL LETTER YN
+1 9 CAPITAL LETTER REVERSED TSE
+1 9 SMALL LETTER REVERSED TSE
+1 9 CAPITAL LETTER SOFT DE
+1 9 SMALL LETTER SOFT DE
+1 9 CAPITAL LETTER SOFT EL
+1 9 SMALL LETTER SOFT EL
+1 9 CAPITAL LETTER SOFT EM
+1 9 SMALL LETTER SOFT EM
+1 9 CAPITAL LETTER MONOCULAR O
+1 9 SMALL LETTER MONOCULAR O
+1 9 CAPITAL LETTER BINOCULAR O
+1 9 SMALL LETTER BINOCULAR O
+1 9 CAPITAL LETTER DOUBLE MONOCULAR O
+1 9 SMALL LETTER DOUBLE MONOCULAR O
+1 9 LETTER MULTIOCULAR O
+1 1 OMBINING CYRILLIC VZMET
+1 19 TEN MILLIONS SIGN
+1 19 HUNDRED MILLIONS SIGN
+1 19 THOUSAND MILLIONS SIGN
+1 0 SLAVONIC ASTERISK
+1 0 COMBINING CYRILLIC LETTER UKRAINIAN IE
+1 26 I
+1 26 YI
+1 26 U
+1 26 HARD SIGN
+1 26 YERU
+1 26 SOFT SIGN
+1 26 OMEGA
+1 19 KAVYKA
+1 19 PAYEROK
+1 1 YRILLIC KAVYKA
+1 9 PAYEROK
+1 9 CAPITAL LETTER DWE
+1 9 SMALL LETTER DWE
+1 9 CAPITAL LETTER DZWE
+1 9 SMALL LETTER DZWE
+1 9 CAPITAL LETTER ZHWE
+1 9 SMALL LETTER ZHWE
+1 9 CAPITAL LETTER CCHE
+1 9 SMALL LETTER CCHE
+1 9 CAPITAL LETTER DZZE
+1 9 SMALL LETTER DZZE
+1 9 CAPITAL LETTER TE WITH MIDDLE HOOK
+1 9 SMALL LETTER TE WITH MIDDLE HOOK
+1 9 CAPITAL LETTER TWE
+1 9 SMALL LETTER TWE
+1 9 CAPITAL LETTER TSWE
+1 9 SMALL LETTER TSWE
+1 9 CAPITAL LETTER TSSE
+1 9 SMALL LETTER TSSE
+1 9 CAPITAL LETTER TCHE
+1 9 SMALL LETTER TCHE
+1 9 CAPITAL LETTER HWE
+1 9 SMALL LETTER HWE
+1 9 CAPITAL LETTER SHWE
+1 9 SMALL LETTER SHWE
+1 9 CAPITAL LETTER DOUBLE O
+1 9 SMALL LETTER DOUBLE O
+1 9 CAPITAL LETTER CROSSED O
+1 9 SMALL LETTER CROSSED O
+1 0 MODIFIER LETTER CYRILLIC HARD SIGN
+1 25 SOFT SIGN
+1 0 COMBINING CYRILLIC LETTER EF
+1 26 IOTIFIED E
+1 0 BAMUM LETTER A
+1 13 KA
+1 13 U
+1 13 KU
+1 13 EE
+1 13 REE
+1 13 TAE
+1 13 O
+1 13 NYI
+1 13 I
+1 13 LA
+1 13 PA
+1 13 RII
+1 15 EE
+1 13 LEEEE
+1 13 MEEEE
+1 13 TAA
+1 13 NDAA
+1 14 JAEM
+1 13 M
+1 13 SUU
+1 13 MU
+1 13 SHII
+1 14 I
+1 14 HEUX
+1 14 EUX
+1 13 KYEE
+1 14 ET
+1 13 NUAE
+1 15 
+1 14 JUAE
+1 13 YOQ
+1 13 SHU
+1 13 YUQ
+1 14 A
+1 13 NSHA
+1 13 KEUX
+1 13 PEUX
+1 13 NJEE
+1 14 TEE
+1 13 PUE
+1 13 WUE
+1 13 PEE
+1 13 FEE
+1 13 RU
+1 13 LU
+1 13 MI
+1 13 NI
+1 13 REUX
+1 14 AE
+1 13 KEN
+1 13 NGKWAEN
+1 15 GA
+1 15 A
+1 13 SHO
+1 13 PUAE
+1 13 FU
+1 14 OM
+1 13 WA
+1 13 NA
+1 13 LI
+1 13 PI
+1 13 LOQ
+1 13 KO
+1 13 MBEN
+1 13 REN
+1 13 MEN
+1 14 A
+1 13 TI
+1 13 KI
+1 13 MO
+1 14 BAA
+1 13 TET
+1 13 KPA
+1 13 TEN
+1 13 NTUU
+1 13 SAMBA
+1 13 FAAMAE
+1 13 KOVUU
+1 15 GHOM
+1 6 COMBINING MARK KOQNDON
+1 21 TUKWENTIS
+1 6 NJAEMLI
+1 6 FULL STOP
+1 6 COLON
+1 8 MMA
+1 6 SEMICOLON
+1 6 QUESTION MARK
+9 0 MODIFIER LETTER CHINESE TONE YIN PING
+1 30 ANG PING
+1 30 IN SHANG
+1 30 ANG SHANG
+1 30 IN QU
+1 30 ANG QU
+1 30 IN RU
+1 30 ANG RU
+1 16 EXTRA-HIGH DOTTED TONE BAR
+1 16 HIGH DOTTED TONE BAR
+1 16 MID DOTTED TONE BAR
+1 16 LOW DOTTED TONE BAR
+1 16 EXTRA-LOW DOTTED TONE BAR
+1 22 HIGH DOTTED LEFT-STEM TONE BAR
+1 16 HIGH DOTTED LEFT-STEM TONE BAR
+1 16 MID DOTTED LEFT-STEM TONE BAR
+1 16 LOW DOTTED LEFT-STEM TONE BAR
+1 16 EXTRA-LOW DOTTED LEFT-STEM TONE BAR
+1 22 HIGH LEFT-STEM TONE BAR
+1 16 HIGH LEFT-STEM TONE BAR
+1 16 MID LEFT-STEM TONE BAR
+1 16 LOW LEFT-STEM TONE BAR
+1 16 EXTRA-LOW LEFT-STEM TONE BAR
+1 16 DOT VERTICAL BAR
+1 20 SLASH
+1 20 HORIZONTAL BAR
+1 16 LOWER RIGHT CORNER ANGLE
+1 16 RAISED UP ARROW
+1 23 DOWN ARROW
+1 23 EXCLAMATION MARK
+1 23 INVERTED EXCLAMATION MARK
+1 16 LOW INVERTED EXCLAMATION MARK
+1 16 STRESS AND HIGH TONE
+1 27 LOW TONE
+1 0 LATIN CAPITAL LETTER EGYPTOLOGICAL ALEF
+1 6 SMALL LETTER EGYPTOLOGICAL ALEF
+1 6 CAPITAL LETTER EGYPTOLOGICAL AIN
+1 6 SMALL LETTER EGYPTOLOGICAL AIN
+1 6 CAPITAL LETTER HENG
+1 6 SMALL LETTER HENG
+1 6 CAPITAL LETTER TZ
+1 6 SMALL LETTER TZ
+1 6 CAPITAL LETTER TRESILLO
+1 6 SMALL LETTER TRESILLO
+1 6 CAPITAL LETTER CUATRILLO
+1 6 SMALL LETTER CUATRILLO
+1 6 CAPITAL LETTER CUATRILLO WITH COMMA
+1 6 SMALL LETTER CUATRILLO WITH COMMA
+1 6 LETTER SMALL CAPITAL F
+1 27 S
+1 6 CAPITAL LETTER AA
+1 6 SMALL LETTER AA
+1 6 CAPITAL LETTER AO
+1 6 SMALL LETTER AO
+1 6 CAPITAL LETTER AU
+1 6 SMALL LETTER AU
+1 6 CAPITAL LETTER AV
+1 6 SMALL LETTER AV
+1 6 CAPITAL LETTER AV WITH HORIZONTAL BAR
+1 6 SMALL LETTER AV WITH HORIZONTAL BAR
+1 6 CAPITAL LETTER AY
+1 6 SMALL LETTER AY
+1 6 CAPITAL LETTER REVERSED C WITH DOT
+1 6 SMALL LETTER REVERSED C WITH DOT
+1 6 CAPITAL LETTER K WITH STROKE
+1 6 SMALL LETTER K WITH STROKE
+1 6 CAPITAL LETTER K WITH DIAGONAL STROKE
+1 6 SMALL LETTER K WITH DIAGONAL STROKE
+1 6 CAPITAL LETTER K WITH STROKE AND DIAGONAL STROKE
+1 6 SMALL LETTER K WITH STROKE AND DIAGONAL STROKE
+1 6 CAPITAL LETTER BROKEN L
+1 6 SMALL LETTER BROKEN L
+1 6 CAPITAL LETTER L WITH HIGH STROKE
+1 6 SMALL LETTER L WITH HIGH STROKE
+1 6 CAPITAL LETTER O WITH LONG STROKE OVERLAY
+1 6 SMALL LETTER O WITH LONG STROKE OVERLAY
+1 6 CAPITAL LETTER O WITH LOOP
+1 6 SMALL LETTER O WITH LOOP
+1 6 CAPITAL LETTER OO
+1 6 SMALL LETTER OO
+1 6 CAPITAL LETTER P WITH STROKE THROUGH DESCENDER
+1 6 SMALL LETTER P WITH STROKE THROUGH DESCENDER
+1 6 CAPITAL LETTER P WITH FLOURISH
+1 6 SMALL LETTER P WITH FLOURISH
+1 6 CAPITAL LETTER P WITH SQUIRREL TAIL
+1 6 SMALL LETTER P WITH SQUIRREL TAIL
+1 6 CAPITAL LETTER Q WITH STROKE THROUGH DESCENDER
+1 6 SMALL LETTER Q WITH STROKE THROUGH DESCENDER
+1 6 CAPITAL LETTER Q WITH DIAGONAL STROKE
+1 6 SMALL LETTER Q WITH DIAGONAL STROKE
+1 6 CAPITAL LETTER R ROTUNDA
+1 6 SMALL LETTER R ROTUNDA
+1 6 CAPITAL LETTER RUM ROTUNDA
+1 6 SMALL LETTER RUM ROTUNDA
+1 6 CAPITAL LETTER V WITH DIAGONAL STROKE
+1 6 SMALL LETTER V WITH DIAGONAL STROKE
+1 6 CAPITAL LETTER VY
+1 6 SMALL LETTER VY
+1 6 CAPITAL LETTER VISIGOTHIC Z
+1 6 SMALL LETTER VISIGOTHIC Z
+1 6 CAPITAL LETTER THORN WITH STROKE
+1 6 SMALL LETTER THORN WITH STROKE
+1 6 CAPITAL LETTER THORN WITH STROKE THROUGH DESCENDER
+1 6 SMALL LETTER THORN WITH STROKE THROUGH DESCENDER
+1 6 CAPITAL LETTER VEND
+1 6 SMALL LETTER VEND
+1 6 CAPITAL LETTER ET
+1 6 SMALL LETTER ET
+1 6 CAPITAL LETTER IS
+1 6 SMALL LETTER IS
+1 6 CAPITAL LETTER CON
+1 6 SMALL LETTER CON
+1 0 MODIFIER LETTER US
+1 0 LATIN SMALL LETTER DUM
+1 19 LUM
+1 19 MUM
+1 19 NUM
+1 19 RUM
+1 6 LETTER SMALL CAPITAL RUM
+1 6 SMALL LETTER TUM
+1 19 UM
+1 6 CAPITAL LETTER INSULAR D
+1 6 SMALL LETTER INSULAR D
+1 6 CAPITAL LETTER INSULAR F
+1 6 SMALL LETTER INSULAR F
+1 6 CAPITAL LETTER INSULAR G
+1 21 TURNED INSULAR G
+1 6 SMALL LETTER TURNED INSULAR G
+1 6 CAPITAL LETTER TURNED L
+1 6 SMALL LETTER TURNED L
+1 6 CAPITAL LETTER INSULAR R
+1 6 SMALL LETTER INSULAR R
+1 6 CAPITAL LETTER INSULAR S
+1 6 SMALL LETTER INSULAR S
+1 6 CAPITAL LETTER INSULAR T
+1 6 SMALL LETTER INSULAR T
+1 0 MODIFIER LETTER LOW CIRCUMFLEX ACCENT
+1 16 COLON
+1 16 SHORT EQUALS SIGN
+1 0 LATIN CAPITAL LETTER SALTILLO
+1 6 SMALL LETTER SALTILLO
+1 6 CAPITAL LETTER TURNED H
+1 6 SMALL LETTER L WITH RETROFLEX HOOK AND BELT
+1 6 LETTER SINOLOGICAL DOT
+1 6 CAPITAL LETTER N WITH DESCENDER
+1 6 SMALL LETTER N WITH DESCENDER
+1 6 CAPITAL LETTER C WITH BAR
+1 6 SMALL LETTER C WITH BAR
+1 26 PALATAL HOOK
+1 19 H WITH PALATAL HOOK
+1 6 CAPITAL LETTER B WITH FLOURISH
+1 6 SMALL LETTER B WITH FLOURISH
+1 6 CAPITAL LETTER F WITH STROKE
+1 6 SMALL LETTER F WITH STROKE
+1 6 CAPITAL LETTER VOLAPUK AE
+1 6 SMALL LETTER VOLAPUK AE
+1 6 CAPITAL LETTER VOLAPUK OE
+1 6 SMALL LETTER VOLAPUK OE
+1 6 CAPITAL LETTER VOLAPUK UE
+1 6 SMALL LETTER VOLAPUK UE
+1 6 CAPITAL LETTER G WITH OBLIQUE STROKE
+1 6 SMALL LETTER G WITH OBLIQUE STROKE
+1 6 CAPITAL LETTER K WITH OBLIQUE STROKE
+1 6 SMALL LETTER K WITH OBLIQUE STROKE
+1 6 CAPITAL LETTER N WITH OBLIQUE STROKE
+1 6 SMALL LETTER N WITH OBLIQUE STROKE
+1 6 CAPITAL LETTER R WITH OBLIQUE STROKE
+1 6 SMALL LETTER R WITH OBLIQUE STROKE
+1 6 CAPITAL LETTER S WITH OBLIQUE STROKE
+1 6 SMALL LETTER S WITH OBLIQUE STROKE
+1 6 CAPITAL LETTER H WITH HOOK
+1 21 REVERSED OPEN E
+1 21 SCRIPT G
+1 21 L WITH BELT
+1 21 SMALL CAPITAL I
+1 6 LETTER SMALL CAPITAL Q
+1 6 CAPITAL LETTER TURNED K
+1 28 T
+1 21 J WITH CROSSED-TAIL
+1 21 CHI
+1 21 BETA
+1 6 SMALL LETTER BETA
+1 6 CAPITAL LETTER OMEGA
+1 6 SMALL LETTER OMEGA
+1 6 CAPITAL LETTER U WITH STROKE
+1 6 SMALL LETTER U WITH STROKE
+1 6 CAPITAL LETTER GLOTTAL A
+1 6 SMALL LETTER GLOTTAL A
+1 6 CAPITAL LETTER GLOTTAL I
+1 6 SMALL LETTER GLOTTAL I
+1 6 CAPITAL LETTER GLOTTAL U
+1 6 SMALL LETTER GLOTTAL U
+1 6 CAPITAL LETTER OLD POLISH O
+1 6 SMALL LETTER OLD POLISH O
+1 6 CAPITAL LETTER ANGLICANA W
+1 6 SMALL LETTER ANGLICANA W
+1 6 CAPITAL LETTER C WITH PALATAL HOOK
+1 21 S WITH HOOK
+1 21 Z WITH PALATAL HOOK
+1 21 D WITH SHORT STROKE OVERLAY
+1 6 SMALL LETTER D WITH SHORT STROKE OVERLAY
+1 6 CAPITAL LETTER S WITH SHORT STROKE OVERLAY
+1 6 SMALL LETTER S WITH SHORT STROKE OVERLAY
+6 6 CAPITAL LETTER CLOSED INSULAR G
+1 6 SMALL LETTER CLOSED INSULAR G
+2 19 DOUBLE THORN
+2 26 WYNN
+1 6 CAPITAL LETTER MIDDLE SCOTS S
+1 6 SMALL LETTER MIDDLE SCOTS S
+1 6 CAPITAL LETTER SIGMOID S
+1 6 SMALL LETTER SIGMOID S
+19 0 MODIFIER LETTER CAPITAL C
+1 24 F
+1 24 Q
+1 0 LATIN CAPITAL LETTER REVERSED HALF H
+1 6 SMALL LETTER REVERSED HALF H
+1 6 EPIGRAPHIC LETTER SIDEWAYS I
+1 0 MODIFIER LETTER CAPITAL H WITH STROKE
+1 16 SMALL LIGATURE OE
+1 0 LATIN LETTER SMALL CAPITAL TURNED M
+1 6 EPIGRAPHIC LETTER REVERSED F
+1 33 P
+1 24 INVERTED M
+1 25  LONGA
+1 24 ARCHAIC M
+1 0 SYLOTI NAGRI LETTER A
+1 20 I
+1 13 SIGN DVISVARA
+1 13 LETTER U
+1 20 E
+1 20 O
+1 13 SIGN HASANTA
+1 13 LETTER KO
+1 21 HO
+1 20 GO
+1 21 HO
+1 13 SIGN ANUSVARA
+1 13 LETTER CO
+1 21 HO
+1 20 JO
+1 21 HO
+1 20 TTO
+1 22 HO
+1 20 DDO
+1 22 HO
+1 20 TO
+1 21 HO
+1 20 DO
+1 21 HO
+1 20 NO
+1 20 PO
+1 21 HO
+1 20 BO
+1 21 HO
+1 20 MO
+1 20 RO
+1 20 LO
+1 20 RRO
+1 20 SO
+1 20 HO
+1 13 VOWEL SIGN A
+1 24 I
+1 24 U
+1 24 E
+1 24 OO
+1 13 POETRY MARK-1
+1 25 2
+1 25 3
+1 25 4
+1 13 SIGN ALTERNATE HASANTA
+4 0 NORTH INDIC FRACTION ONE QUARTER
+1 25 HALF
+1 21 THREE QUARTERS
+1 21 ONE SIXTEENTH
+1 25 EIGHTH
+1 21 THREE SIXTEENTHS
+1 12 QUARTER MARK
+1 12 PLACEHOLDER MARK
+1 12 RUPEE MARK
+1 12 QUANTITY MARK
+7 0 PHAGS-PA LETTER KA
+1 17 HA
+1 16 GA
+1 16 NGA
+1 16 CA
+1 17 HA
+1 16 JA
+1 16 NYA
+1 16 TA
+1 17 HA
+1 16 DA
+1 16 NA
+1 16 PA
+1 17 HA
+1 16 BA
+1 16 MA
+1 16 TSA
+1 18 HA
+1 16 DZA
+1 16 WA
+1 16 ZHA
+1 17 A
+1 16 SMALL A
+1 16 YA
+1 16 RA
+1 16 LA
+1 16 SHA
+1 17 A
+1 16 HA
+1 16 A
+1 16 I
+1 16 U
+1 16 E
+1 16 O
+1 16 QA
+1 16 XA
+1 16 FA
+1 16 GGA
+1 16 EE
+1 9 SUBJOINED LETTER WA
+1 26 YA
+1 9 LETTER TTA
+1 18 HA
+1 16 DDA
+1 16 NNA
+1 16 ALTERNATE YA
+1 16 VOICELESS SHA
+1 21 D HA
+1 16 ASPIRATED FA
+1 9 SUBJOINED LETTER RA
+1 11 PERFIXED LETTER RA
+1 9 LETTER CANDRABINDU
+1 9 SINGLE HEAD MARK
+1 9 DOUBLE HEAD MARK
+1 9 MARK SHAD
+1 14 DOUBLE SHAD
+9 0 SAURASHTRA SIGN ANUSVARA
+1 16 VISARGA
+1 11 LETTER A
+1 19 A
+1 18 I
+1 19 I
+1 18 U
+1 19 U
+1 18 VOCALIC R
+1 27 R
+1 26 L
+1 27 L
+1 18 E
+1 19 E
+1 18 AI
+1 18 O
+1 19 O
+1 18 AU
+1 18 KA
+1 19 HA
+1 18 GA
+1 19 HA
+1 18 NGA
+1 18 CA
+1 19 HA
+1 18 JA
+1 19 HA
+1 18 NYA
+1 18 TTA
+1 20 HA
+1 18 DDA
+1 20 HA
+1 18 NNA
+1 18 TA
+1 19 HA
+1 18 DA
+1 19 HA
+1 18 NA
+1 18 PA
+1 19 HA
+1 18 BA
+1 19 HA
+1 18 MA
+1 18 YA
+1 18 RA
+1 18 LA
+1 18 VA
+1 18 SHA
+1 19 SA
+1 19 A
+1 18 HA
+1 18 LLA
+1 11 CONSONANT SIGN HAARU
+1 11 VOWEL SIGN AA
+1 22 I
+1 23 I
+1 22 U
+1 23 U
+1 22 VOCALIC R
+1 31 R
+1 30 L
+1 31 L
+1 22 E
+1 23 E
+1 22 AI
+1 22 O
+1 23 O
+1 22 AU
+1 11 SIGN VIRAMA
+1 16 CANDRABINDU
+9 11 DANDA
+1 12 OUBLE DANDA
+1 12 IGIT ZERO
+1 17 ONE
+1 17 TWO
+1 18 HREE
+1 17 FOUR
+1 18 IVE
+1 17 SIX
+1 18 EVEN
+1 17 EIGHT
+1 17 NINE
+7 0 COMBINING DEVANAGARI DIGIT ZERO
+1 27 ONE
+1 27 TWO
+1 28 HREE
+1 27 FOUR
+1 28 IVE
+1 27 SIX
+1 28 EVEN
+1 27 EIGHT
+1 27 NINE
+1 21 LETTER A
+1 28 U
+1 28 KA
+1 28 NA
+1 28 PA
+1 28 RA
+1 28 VI
+1 21 SIGN AVAGRAHA
+1 0 DEVANAGARI SIGN SPACING CANDRABINDU
+1 16 CANDRABINDU VIRAMA
+1 16 DOUBLE CANDRABINDU VIRAMA
+1 16 CANDRABINDU TWO
+1 29 HREE
+1 28 AVAGRAHA
+1 16 PUSHPIKA
+1 11 GAP FILLER
+1 11 CARET
+1 11 HEADSTROKE
+1 11 SIGN SIDDHAM
+1 11 JAIN OM
+1 11 LETTER AY
+1 11 VOWEL SIGN AY
+1 0 KAYAH LI DIGIT ZERO
+1 15 ONE
+1 15 TWO
+1 16 HREE
+1 15 FOUR
+1 16 IVE
+1 15 SIX
+1 16 EVEN
+1 15 EIGHT
+1 15 NINE
+1 9 LETTER KA
+1 17 HA
+1 16 GA
+1 16 NGA
+1 16 SA
+1 17 HA
+1 16 ZA
+1 16 NYA
+1 16 TA
+1 16 HTA
+1 16 NA
+1 16 PA
+1 17 HA
+1 16 MA
+1 16 DA
+1 16 BA
+1 16 RA
+1 16 YA
+1 16 LA
+1 16 WA
+1 16 THA
+1 16 HA
+1 16 VA
+1 16 CA
+1 16 A
+1 16 OE
+1 16 I
+1 16 OO
+1 9 VOWEL UE
+1 15 E
+1 15 U
+1 15 EE
+1 15 O
+1 9 TONE PLOPHU
+1 14 CALYA
+1 19  PLOPHU
+1 9 SIGN CWI
+1 14 SHYA
+1 0 REJANG LETTER KA
+1 14 GA
+1 14 NGA
+1 14 TA
+1 14 DA
+1 14 NA
+1 14 PA
+1 14 BA
+1 14 MA
+1 14 CA
+1 14 JA
+1 14 NYA
+1 14 SA
+1 14 RA
+1 14 LA
+1 14 YA
+1 14 WA
+1 14 HA
+1 14 MBA
+1 14 NGGA
+1 15 DA
+1 15 YJA
+1 14 A
+1 7 VOWEL SIGN I
+1 18 U
+1 18 E
+1 18 AI
+1 18 O
+1 18 AU
+1 18 EU
+1 19 A
+1 7 CONSONANT SIGN NG
+1 23 
+1 22 R
+1 22 H
+1 7 VIRAMA
+c 7 SECTION MARK
+1 0 HANGUL CHOSEONG TIKEUT-MIEUM
+1 23 PIEUP
+1 23 SIOS
+1 23 CIEUC
+1 16 RIEUL-KIYEOK
+1 22 SSANGKIYEOK
+1 22 TIKEUT
+1 22 SSANGTIKEUT
+1 22 MIEUM
+1 22 PIEUP
+1 22 SSANGPIEUP
+1 22 KAPYEOUNPIEUP
+1 22 SIOS
+1 22 CIEUC
+1 22 KHIEUKH
+1 16 MIEUM-KIYEOK
+1 22 TIKEUT
+1 22 SIOS
+1 16 PIEUP-SIOS-THIEUTH
+1 22 KHIEUKH
+1 22 HIEUH
+1 16 SSANGSIOS-PIEUP
+1 16 IEUNG-RIEUL
+1 22 HIEUH
+1 16 SSANGCIEUC-HIEUH
+1 21 THIEUTH
+1 16 PHIEUPH-HIEUH
+1 16 HIEUH-SIOS
+1 16 SSANGYEORINHIEUH
+4 0 JAVANESE SIGN PANYANGGA
+1 14 CECAK
+1 14 LAYAR
+1 14 WIGNYAN
+1 9 LETTER A
+1 16 I KAWI
+1 17 
+1 17 I
+1 16 U
+1 16 PA CEREK
+1 16 NGA LELET
+1 25  RASWADI
+1 16 E
+1 16 AI
+1 16 O
+1 16 KA
+1 18  SASAK
+1 19 MURDA
+1 16 GA
+1 18  MURDA
+1 16 NGA
+1 16 CA
+1 18  MURDA
+1 16 JA
+1 16 NYA MURDA
+1 16 JA MAHAPRANA
+1 16 NYA
+1 16 TTA
+1 19  MAHAPRANA
+1 16 DDA
+1 19  MAHAPRANA
+1 16 NA MURDA
+1 16 TA
+1 18  MURDA
+1 16 DA
+1 18  MAHAPRANA
+1 16 NA
+1 16 PA
+1 18  MURDA
+1 16 BA
+1 18  MURDA
+1 16 MA
+1 16 YA
+1 16 RA
+1 18  AGUNG
+1 16 LA
+1 16 WA
+1 16 SA MURDA
+1 20 AHAPRANA
+1 18 
+1 16 HA
+1 9 SIGN CECAK TELU
+1 9 VOWEL SIGN TARUNG
+1 21 OLONG
+1 20 WULU
+1 24  MELIK
+1 20 SUKU
+1 24  MENDUT
+1 20 TALING
+1 20 DIRGA MURE
+1 20 PEPET
+1 9 CONSONANT SIGN KERET
+1 24 PENGKAL
+1 24 CAKRA
+1 9 PANGKON
+1 9 LEFT RERENGGAN
+1 9 RIGHT RERENGGAN
+1 9 PADA ANDAP
+1 14 MADYA
+1 14 LUHUR
+1 14 WINDU
+1 14 PANGKAT
+1 14 LINGSA
+1 15 UNGSI
+1 14 ADEG
+1 18  ADEG
+1 14 PISELEH
+1 9 TURNED PADA PISELEH
+2 9 PANGRANGKEP
+1 9 DIGIT ZERO
+1 15 ONE
+1 15 TWO
+1 16 HREE
+1 15 FOUR
+1 16 IVE
+1 15 SIX
+1 16 EVEN
+1 15 EIGHT
+1 15 NINE
+5 9 PADA TIRTA TUMETES
+1 14 ISEN-ISEN
+1 0 MYANMAR LETTER SHAN GHA
+1 20 CHA
+1 20 JHA
+1 20 NNA
+1 20 BHA
+1 8 SIGN SHAN SAW
+1 8 MODIFIER LETTER SHAN REDUPLICATION
+1 8 LETTER TAI LAING NYA
+1 25 FA
+1 25 GA
+1 26 HA
+1 25 JA
+1 26 HA
+1 25 DDA
+1 27 HA
+1 25 NNA
+1 8 TAI LAING DIGIT ZERO
+1 24 ONE
+1 24 TWO
+1 25 HREE
+1 24 FOUR
+1 25 IVE
+1 24 SIX
+1 25 EVEN
+1 24 EIGHT
+1 24 NINE
+1 8 LETTER TAI LAING LLA
+1 25 DA
+1 26 HA
+1 25 BA
+1 26 HA
+2 0 CHAM LETTER A
+1 12 I
+1 12 U
+1 12 E
+1 12 AI
+1 12 O
+1 12 KA
+1 13 HA
+1 12 GA
+1 13 HA
+1 12 NGUE
+1 14 A
+1 12 CHA
+1 14 HA
+1 12 JA
+1 13 HA
+1 12 NHUE
+1 14 A
+1 14 JA
+1 12 TA
+1 13 HA
+1 12 DA
+1 13 HA
+1 12 NUE
+1 13 A
+1 12 DDA
+1 12 PA
+1 13 PA
+1 13 HA
+1 12 BA
+1 13 HA
+1 12 MUE
+1 13 A
+1 12 BBA
+1 12 YA
+1 12 RA
+1 12 LA
+1 12 VA
+1 12 SSA
+1 13 A
+1 12 HA
+1 5 VOWEL SIGN AA
+1 16 I
+1 17 I
+1 16 EI
+1 16 U
+1 16 OE
+1 17 
+1 16 AI
+1 17 U
+1 16 UE
+1 5 CONSONANT SIGN YA
+1 20 RA
+1 20 LA
+1 20 WA
+a 5 LETTER FINAL K
+1 18 G
+1 18 NG
+1 5 CONSONANT SIGN FINAL NG
+1 5 LETTER FINAL CH
+1 18 T
+1 18 N
+1 18 P
+1 18 Y
+1 18 R
+1 18 L
+1 18 SS
+1 5 CONSONANT SIGN FINAL M
+1 26 H
+3 5 DIGIT ZERO
+1 11 ONE
+1 11 TWO
+1 12 HREE
+1 11 FOUR
+1 12 IVE
+1 11 SIX
+1 12 EVEN
+1 11 EIGHT
+1 11 NINE
+3 5 PUNCTUATION SPIRAL
+1 17 DANDA
+1 18 OUBLE DANDA
+1 17 TRIPLE DANDA
+1 0 MYANMAR LETTER KHAMTI GA
+1 22 CA
+1 23 HA
+1 22 JA
+1 23 HA
+1 22 NYA
+1 22 TTA
+1 24 HA
+1 22 DDA
+1 24 HA
+1 23 HA
+1 22 NA
+1 22 SA
+1 22 HA
+1 23 HA
+1 22 FA
+1 8 MODIFIER LETTER KHAMTI REDUPLICATION
+1 8 LETTER KHAMTI XA
+1 22 ZA
+1 22 RA
+1 9 OGOGRAM KHAMTI OAY
+1 24 QN
+1 24 HM
+1 8 SYMBOL AITON EXCLAMATION
+1 21 ONE
+1 21 TWO
+1 8 LETTER AITON RA
+1 8 SIGN PAO KAREN TONE
+1 13 TAI LAING TONE-2
+1 28 5
+1 8 LETTER SHWE PALAUNG CHA
+1 28 SHA
+1 0 TAI VIET LETTER LOW KO
+1 16 HIGH KO
+1 16 LOW KHO
+1 16 HIGH KHO
+1 16 LOW KHHO
+1 16 HIGH KHHO
+1 16 LOW GO
+1 16 HIGH GO
+1 16 LOW NGO
+1 16 HIGH NGO
+1 16 LOW CO
+1 16 HIGH CO
+1 16 LOW CHO
+1 16 HIGH CHO
+1 16 LOW SO
+1 16 HIGH SO
+1 16 LOW NYO
+1 16 HIGH NYO
+1 16 LOW DO
+1 16 HIGH DO
+1 16 LOW TO
+1 16 HIGH TO
+1 16 LOW THO
+1 16 HIGH THO
+1 16 LOW NO
+1 16 HIGH NO
+1 16 LOW BO
+1 16 HIGH BO
+1 16 LOW PO
+1 16 HIGH PO
+1 16 LOW PHO
+1 16 HIGH PHO
+1 16 LOW FO
+1 16 HIGH FO
+1 16 LOW MO
+1 16 HIGH MO
+1 16 LOW YO
+1 16 HIGH YO
+1 16 LOW RO
+1 16 HIGH RO
+1 16 LOW LO
+1 16 HIGH LO
+1 16 LOW VO
+1 16 HIGH VO
+1 16 LOW HO
+1 16 HIGH HO
+1 16 LOW O
+1 16 HIGH O
+1 9 MAI KANG
+1 9 VOWEL AA
+1 15 I
+1 15 UE
+1 16 
+1 15 E
+1 15 O
+1 9 MAI KHIT
+1 9 VOWEL IA
+1 15 UEA
+1 16 A
+1 15 AUE
+1 16 Y
+1 16 N
+1 16 M
+1 9 TONE MAI EK
+1 18 NUENG
+1 18 THO
+1 18 SONG
+19 9 SYMBOL KON
+1 16 NUENG
+1 16 SAM
+1 16 HO HOI
+1 16 KOI KOI
+1 0 MEETEI MAYEK LETTER E
+1 20 O
+1 20 CHA
+1 20 NYA
+1 20 TTA
+1 22 HA
+1 20 DDA
+1 22 HA
+1 20 NNA
+1 20 SHA
+1 21 SA
+1 13 VOWEL SIGN II
+1 24 UU
+1 24 AAI
+1 25 U
+1 25 AU
+1 13 CHEIKHAN
+1 13 AHANG KHUDAM
+1 14 NJI
+1 13 SYLLABLE REPETITION MARK
+1 13 WORD REPETITION MARK
+1 13 VOWEL SIGN VISARGA
+1 14 IRAMA
+b 0 ETHIOPIC SYLLABLE TTHU
+1 21 I
+1 21 AA
+1 21 EE
+1 22 
+1 21 O
+3 18 DDHU
+1 21 I
+1 21 AA
+1 21 EE
+1 22 
+1 21 O
+3 19 ZU
+1 20 I
+1 20 AA
+1 20 EE
+1 21 
+1 20 O
+a 18 CCHHA
+1 22 U
+1 22 I
+1 22 AA
+1 22 EE
+1 23 
+1 22 O
+2 18 BBA
+1 20 U
+1 20 I
+1 20 AA
+1 20 EE
+1 21 
+1 20 O
+2 0 LATIN SMALL LETTER BARRED ALPHA
+1 19 A REVERSED-SCHWA
+1 19 BLACKLETTER E
+1 20 ARRED E
+1 19 E WITH FLOURISH
+1 19 LENIS F
+1 19 SCRIPT G WITH CROSSED-TAIL
+1 19 L WITH INVERTED LAZY S
+1 26 DOUBLE MIDDLE TILDE
+1 26 MIDDLE RING
+1 19 M WITH CROSSED-TAIL
+1 19 N WITH CROSSED-TAIL
+1 19 ENG WITH CROSSED-TAIL
+1 19 BLACKLETTER O
+1 32  WITH STROKE
+1 19 OPEN O WITH STROKE
+1 19 INVERTED OE
+1 19 TURNED OE WITH STROKE
+1 34 HORIZONTAL STROKE
+1 27  OPEN-O
+1 34  WITH STROKE
+1 19 STIRRUP R
+1 6 LETTER SMALL CAPITAL R WITH RIGHT LEG
+1 6 SMALL LETTER R WITHOUT HANDLE
+1 19 DOUBLE R
+1 19 R WITH CROSSED-TAIL
+1 19 DOUBLE R WITH CROSSED-TAIL
+1 19 SCRIPT R
+1 27  WITH RING
+1 19 BASELINE ESH
+1 19 U WITH SHORT RIGHT LEG
+1 21 BAR WITH SHORT RIGHT LEG
+1 20 I
+1 19 TURNED UI
+1 19 U WITH LEFT HOOK
+1 19 CHI
+1 22  WITH LOW RIGHT RING
+1 32 LEFT SERIF
+1 19 X WITH LOW RIGHT RING
+1 28 NG LEFT LEG
+1 39  AND LOW RIGHT RING
+1 40 WITH SERIF
+1 19 Y WITH SHORT RIGHT LEG
+1 0 MODIFIER BREVE WITH INVERTED BREVE
+1 9 LETTER SMALL HENG
+1 22 L WITH INVERTED LAZY S
+1 29 MIDDLE TILDE
+1 22 U WITH LEFT HOOK
+1 0 LATIN SMALL LETTER SAKHA YAT
+1 19 IOTIFIED E
+1 19 OPEN OE
+1 19 UO
+1 19 INVERTED ALPHA
+1 0 GREEK LETTER SMALL CAPITAL OMEGA
+1 0 LATIN SMALL LETTER DZ DIGRAPH WITH RETROFLEX HOOK
+1 19 TS DIGRAPH WITH RETROFLEX HOOK
+1 20 URNED R WITH MIDDLE TILDE
+1 0 MODIFIER LETTER SMALL TURNED W
+1 16 LEFT TACK
+1 16 RIGHT TACK
+5 0 CHEROKEE SMALL LETTER A
+1 22 E
+1 22 I
+1 22 O
+1 22 U
+1 22 V
+1 22 GA
+1 22 KA
+1 22 GE
+1 23 I
+1 23 O
+1 23 U
+1 23 V
+1 22 HA
+1 23 E
+1 23 I
+1 23 O
+1 23 U
+1 23 V
+1 22 LA
+1 23 E
+1 23 I
+1 23 O
+1 23 U
+1 23 V
+1 22 MA
+1 23 E
+1 23 I
+1 23 O
+1 23 U
+1 22 NA
+1 22 HNA
+1 22 NAH
+1 23 E
+1 23 I
+1 23 O
+1 23 U
+1 23 V
+1 22 QUA
+1 24 E
+1 24 I
+1 24 O
+1 24 U
+1 24 V
+1 22 SA
+1 23 
+1 23 E
+1 23 I
+1 23 O
+1 23 U
+1 23 V
+1 22 DA
+1 22 TA
+1 22 DE
+1 22 TE
+1 22 DI
+1 22 TI
+1 22 DO
+1 23 U
+1 23 V
+1 23 LA
+1 22 TLA
+1 24 E
+1 24 I
+1 24 O
+1 24 U
+1 24 V
+1 23 SA
+1 24 E
+1 24 I
+1 24 O
+1 24 U
+1 24 V
+1 22 WA
+1 23 E
+1 23 I
+1 23 O
+1 23 U
+1 23 V
+1 22 YA
+1 0 MEETEI MAYEK LETTER KOK
+1 20 SAM
+1 20 LAI
+1 20 MIT
+1 20 PA
+1 20 NA
+1 20 CHIL
+1 20 TIL
+1 20 KHOU
+1 20 NGOU
+1 20 THOU
+1 20 WAI
+1 20 YANG
+1 20 HUK
+1 20 UN
+1 20 I
+1 20 PHAM
+1 20 ATIYA
+1 20 GOK
+1 20 JHAM
+1 20 RAI
+1 20 BA
+1 20 JIL
+1 20 DIL
+1 20 GHOU
+1 20 DHOU
+1 20 BHAM
+1 20 KOK LONSUM
+1 20 LAI LONSUM
+1 20 MIT LONSUM
+1 20 PA LONSUM
+1 20 NA LONSUM
+1 20 TIL LONSUM
+1 20 NGOU LONSUM
+1 20 I LONSUM
+1 13 VOWEL SIGN ONAP
+1 24 INAP
+1 24 ANAP
+1 24 YENAP
+1 24 SOUNAP
+1 24 UNAP
+1 24 CHEINAP
+1 24 NUNG
+1 13 CHEIKHEI
+1 13 LUM IYEK
+1 13 APUN IYEK
+3 13 DIGIT ZERO
+1 19 ONE
+1 19 TWO
+1 20 HREE
+1 19 FOUR
+1 20 IVE
+1 19 SIX
+1 20 EVEN
+1 19 EIGHT
+1 19 NINE
+2bb7 0 HANGUL JUNGSEONG O-YEO
+1 19 O-I
+1 17 YO-A
+1 21 E
+1 20 EO
+1 17 U-YEO
+1 19 I-I
+1 17 YU-AE
+1 20 O
+1 17 EU-A
+1 20 EO
+1 21 
+1 20 O
+1 17 I-YA-O
+1 21 E
+1 20 EO
+1 21 
+1 19 O-I
+1 19 YO
+1 20 U
+1 19 I
+1 17 ARAEA-A
+1 23 E
+5 8 ONGSEONG NIEUN-RIEUL
+1 23 CHIEUCH
+1 17 SSANGTIKEUT
+1 28 -PIEUP
+1 17 TIKEUT-PIEUP
+1 24 SIOS
+1 28 -KIYEOK
+1 24 CIEUC
+1 25 HIEUCH
+1 24 THIEUTH
+1 17 RIEUL-SSANGKIYEOK
+1 23 KIYEOK-HIEUH
+1 17 SSANGRIEUL-KHIEUKH
+1 17 RIEUL-MIEUM-HIEUH
+1 23 PIEUP-TIKEUT
+1 29 PHIEUPH
+1 23 YESIEUNG
+1 25 ORINHIEUH-HIEUH
+1 17 KAPYEOUNRIEUL
+1 17 MIEUM-NIEUN
+1 23 SSANGNIEUN
+1 17 SSANGMIEUM
+1 17 MIEUM-PIEUP-SIOS
+1 23 CIEUC
+1 17 PIEUP-TIKEUT
+1 23 RIEUL-PHIEUPH
+1 23 MIEUM
+1 17 SSANGPIEUP
+1 17 PIEUP-SIOS-TIKEUT
+1 23 CIEUC
+1 24 HIEUCH
+1 17 SIOS-MIEUM
+1 22 KAPYEOUNPIEUP
+1 18 SANGSIOS-KIYEOK
+1 27 TIKEUT
+1 18 IOS-PANSIOS
+1 22 CIEUC
+1 23 HIEUCH
+1 22 THIEUTH
+1 22 HIEUH
+1 17 PANSIOS-PIEUP
+1 25 KAPYEOUNPIEUP
+1 17 YESIEUNG-MIEUM
+1 26 HIEUH
+1 17 CIEUC-PIEUP
+1 23 SSANGPIEUP
+1 17 SSANGCIEUC
+1 17 PHIEUPH-SIOS
+1 25 THIEUTH
+2305 0 LATIN SMALL LIGATURE FF
+1 22 I
+1 22 L
+1 22 FI
+1 23 L
+1 21 LONG S T
+1 21 ST
+d 0 ARMENIAN SMALL LIGATURE MEN NOW
+1 28 ECH
+1 28 INI
+1 24 VEW NOW
+1 24 MEN XEH
+6 0 HEBREW LETTER YOD WITH HIRIQ
+1 7 POINT JUDEO-SPANISH VARIKA
+1 7 LIGATURE YIDDISH YOD YOD PATAH
+1 8 ETTER ALTERNATIVE AYIN
+1 14 WIDE ALEF
+1 19 DALET
+1 19 HE
+1 19 KAF
+1 19 LAMED
+1 19 FINAL MEM
+1 19 RESH
+1 19 TAV
+1 14 ALTERNATIVE PLUS SIGN
+1 14 SHIN WITH SHIN DOT
+1 25 IN DOT
+1 24 DAGESH AND SHIN DOT
+1 36 IN DOT
+1 14 ALEF WITH PATAH
+1 24 QAMATS
+1 24 MAPIQ
+1 14 BET WITH DAGESH
+1 14 GIMEL WITH DAGESH
+1 14 DALET WITH DAGESH
+1 14 HE WITH MAPIQ
+1 14 VAV WITH DAGESH
+1 14 ZAYIN WITH DAGESH
+2 14 TET WITH DAGESH
+1 14 YOD WITH DAGESH
+1 14 FINAL KAF WITH DAGESH
+1 14 KAF WITH DAGESH
+1 14 LAMED WITH DAGESH
+2 14 MEM WITH DAGESH
+2 14 NUN WITH DAGESH
+1 14 SAMEKH WITH DAGESH
+2 14 FINAL PE WITH DAGESH
+1 14 PE WITH DAGESH
+2 14 TSADI WITH DAGESH
+1 14 QOF WITH DAGESH
+1 14 RESH WITH DAGESH
+1 14 SHIN WITH DAGESH
+1 14 TAV WITH DAGESH
+1 14 VAV WITH HOLAM
+1 14 BET WITH RAFE
+1 14 KAF WITH RAFE
+1 14 PE WITH RAFE
+1 8 IGATURE ALEF LAMED
+1 0 ARABIC LETTER ALEF WASLA ISOLATED FORM
+1 25 FINAL FORM
+1 14 BEEH ISOLATED FORM
+1 19 FINAL FORM
+1 19 INITIAL FORM
+1 19 MEDIAL FORM
+1 14 PEH ISOLATED FORM
+1 18 FINAL FORM
+1 18 INITIAL FORM
+1 18 MEDIAL FORM
+1 14 BEHEH ISOLATED FORM
+1 20 FINAL FORM
+1 20 INITIAL FORM
+1 20 MEDIAL FORM
+1 14 TTEHEH ISOLATED FORM
+1 21 FINAL FORM
+1 21 INITIAL FORM
+1 21 MEDIAL FORM
+1 15 EHEH ISOLATED FORM
+1 20 FINAL FORM
+1 20 INITIAL FORM
+1 20 MEDIAL FORM
+1 15 TEH ISOLATED FORM
+1 19 FINAL FORM
+1 19 INITIAL FORM
+1 19 MEDIAL FORM
+1 14 VEH ISOLATED FORM
+1 18 FINAL FORM
+1 18 INITIAL FORM
+1 18 MEDIAL FORM
+1 14 PEHEH ISOLATED FORM
+1 20 FINAL FORM
+1 20 INITIAL FORM
+1 20 MEDIAL FORM
+1 14 DYEH ISOLATED FORM
+1 19 FINAL FORM
+1 19 INITIAL FORM
+1 19 MEDIAL FORM
+1 14 NYEH ISOLATED FORM
+1 19 FINAL FORM
+1 19 INITIAL FORM
+1 19 MEDIAL FORM
+1 14 TCHEH ISOLATED FORM
+1 20 FINAL FORM
+1 20 INITIAL FORM
+1 20 MEDIAL FORM
+1 19 EH ISOLATED FORM
+1 22 FINAL FORM
+1 22 INITIAL FORM
+1 22 MEDIAL FORM
+1 14 DDAHAL ISOLATED FORM
+1 21 FINAL FORM
+1 15 AHAL ISOLATED FORM
+1 20 FINAL FORM
+1 15 UL ISOLATED FORM
+1 18 FINAL FORM
+1 15 DAL ISOLATED FORM
+1 19 FINAL FORM
+1 14 JEH ISOLATED FORM
+1 18 FINAL FORM
+1 14 RREH ISOLATED FORM
+1 19 FINAL FORM
+1 14 KEHEH ISOLATED FORM
+1 20 FINAL FORM
+1 20 INITIAL FORM
+1 20 MEDIAL FORM
+1 14 GAF ISOLATED FORM
+1 18 FINAL FORM
+1 18 INITIAL FORM
+1 18 MEDIAL FORM
+1 15 UEH ISOLATED FORM
+1 19 FINAL FORM
+1 19 INITIAL FORM
+1 19 MEDIAL FORM
+1 14 NGOEH ISOLATED FORM
+1 20 FINAL FORM
+1 20 INITIAL FORM
+1 20 MEDIAL FORM
+1 15 OON GHUNNA ISOLATED FORM
+1 26 FINAL FORM
+1 14 RNOON ISOLATED FORM
+1 20 FINAL FORM
+1 20 INITIAL FORM
+1 20 MEDIAL FORM
+1 14 HEH WITH YEH ABOVE ISOLATED FORM
+1 33 FINAL FORM
+1 18 GOAL ISOLATED FORM
+1 23 FINAL FORM
+1 23 INITIAL FORM
+1 23 MEDIAL FORM
+1 18 DOACHASHMEE ISOLATED FORM
+1 30 FINAL FORM
+1 30 INITIAL FORM
+1 30 MEDIAL FORM
+1 14 YEH BARREE ISOLATED FORM
+1 25 FINAL FORM
+1 25 WITH HAMZA ABOVE ISOLATED FORM
+1 42 FINAL FORM
+1 7 SYMBOL DOT ABOVE
+1 18 BELOW
+1 14 TWO DOTS ABOVE
+1 23 BELOW
+1 15 HREE DOTS ABOVE
+1 25 BELOW
+1 25 POINTING DOWNWARDS ABOVE
+1 44 BELOW
+1 14 FOUR DOTS ABOVE
+1 24 BELOW
+1 14 DOUBLE VERTICAL BAR BELOW
+1 14 TWO DOTS VERTICALLY ABOVE
+1 34 BELOW
+1 14 RING
+1 14 SMALL TAH ABOVE
+1 24 BELOW
+1 14 WASLA ABOVE
+11 7 LETTER NG ISOLATED FORM
+1 17 FINAL FORM
+1 17 INITIAL FORM
+1 17 MEDIAL FORM
+1 14 U ISOLATED FORM
+1 16 FINAL FORM
+1 14 OE ISOLATED FORM
+1 17 FINAL FORM
+1 14 YU ISOLATED FORM
+1 17 FINAL FORM
+1 14 U WITH HAMZA ABOVE ISOLATED FORM
+1 14 VE ISOLATED FORM
+1 17 FINAL FORM
+1 14 KIRGHIZ OE ISOLATED FORM
+1 25 FINAL FORM
+1 22 YU ISOLATED FORM
+1 25 FINAL FORM
+1 14 E ISOLATED FORM
+1 16 FINAL FORM
+1 16 INITIAL FORM
+1 16 MEDIAL FORM
+1 14 UIGHUR KAZAKH KIRGHIZ ALEF MAKSURA INITIAL FORM
+1 49 MEDIAL FORM
+1 8 IGATURE YEH WITH HAMZA ABOVE WITH ALEF ISOLATED FORM
+1 47 FINAL FORM
+1 43 E ISOLATED FORM
+1 45 FINAL FORM
+1 42 WAW ISOLATED FORM
+1 46 FINAL FORM
+1 42 U ISOLATED FORM
+1 44 FINAL FORM
+1 42 OE ISOLATED FORM
+1 45 FINAL FORM
+1 42 YU ISOLATED FORM
+1 45 FINAL FORM
+1 42 E ISOLATED FORM
+1 44 FINAL FORM
+1 44 INITIAL FORM
+1 16 UIGHUR KIRGHIZ YEH WITH HAMZA ABOVE WITH ALEF MAKSURA ISOLATED FORM
+1 70 FINAL FORM
+1 70 INITIAL FORM
+1 8 ETTER FARSI YEH ISOLATED FORM
+1 24 FINAL FORM
+1 24 INITIAL FORM
+1 24 MEDIAL FORM
+1 8 IGATURE YEH WITH HAMZA ABOVE WITH JEEM ISOLATED FORM
+1 42 HAH ISOLATED FORM
+1 42 MEEM ISOLATED FORM
+1 42 ALEF MAKSURA ISOLATED FORM
+1 42 YEH ISOLATED FORM
+1 16 BEH WITH JEEM ISOLATED FORM
+1 25 HAH ISOLATED FORM
+1 25 KHAH ISOLATED FORM
+1 25 MEEM ISOLATED FORM
+1 25 ALEF MAKSURA ISOLATED FORM
+1 25 YEH ISOLATED FORM
+1 16 TEH WITH JEEM ISOLATED FORM
+1 25 HAH ISOLATED FORM
+1 25 KHAH ISOLATED FORM
+1 25 MEEM ISOLATED FORM
+1 25 ALEF MAKSURA ISOLATED FORM
+1 25 YEH ISOLATED FORM
+1 17 HEH WITH JEEM ISOLATED FORM
+1 26 MEEM ISOLATED FORM
+1 26 ALEF MAKSURA ISOLATED FORM
+1 26 YEH ISOLATED FORM
+1 16 JEEM WITH HAH ISOLATED FORM
+1 26 MEEM ISOLATED FORM
+1 16 HAH WITH JEEM ISOLATED FORM
+1 25 MEEM ISOLATED FORM
+1 16 KHAH WITH JEEM ISOLATED FORM
+1 26 HAH ISOLATED FORM
+1 26 MEEM ISOLATED FORM
+1 16 SEEN WITH JEEM ISOLATED FORM
+1 26 HAH ISOLATED FORM
+1 26 KHAH ISOLATED FORM
+1 26 MEEM ISOLATED FORM
+1 17 AD WITH HAH ISOLATED FORM
+1 25 MEEM ISOLATED FORM
+1 16 DAD WITH JEEM ISOLATED FORM
+1 25 HAH ISOLATED FORM
+1 25 KHAH ISOLATED FORM
+1 25 MEEM ISOLATED FORM
+1 16 TAH WITH HAH ISOLATED FORM
+1 25 MEEM ISOLATED FORM
+1 16 ZAH WITH MEEM ISOLATED FORM
+1 16 AIN WITH JEEM ISOLATED FORM
+1 25 MEEM ISOLATED FORM
+1 16 GHAIN WITH JEEM ISOLATED FORM
+1 27 MEEM ISOLATED FORM
+1 16 FEH WITH JEEM ISOLATED FORM
+1 25 HAH ISOLATED FORM
+1 25 KHAH ISOLATED FORM
+1 25 MEEM ISOLATED FORM
+1 25 ALEF MAKSURA ISOLATED FORM
+1 25 YEH ISOLATED FORM
+1 16 QAF WITH HAH ISOLATED FORM
+1 25 MEEM ISOLATED FORM
+1 25 ALEF MAKSURA ISOLATED FORM
+1 25 YEH ISOLATED FORM
+1 16 KAF WITH ALEF ISOLATED FORM
+1 25 JEEM ISOLATED FORM
+1 25 HAH ISOLATED FORM
+1 25 KHAH ISOLATED FORM
+1 25 LAM ISOLATED FORM
+1 25 MEEM ISOLATED FORM
+1 25 ALEF MAKSURA ISOLATED FORM
+1 25 YEH ISOLATED FORM
+1 16 LAM WITH JEEM ISOLATED FORM
+1 25 HAH ISOLATED FORM
+1 25 KHAH ISOLATED FORM
+1 25 MEEM ISOLATED FORM
+1 25 ALEF MAKSURA ISOLATED FORM
+1 25 YEH ISOLATED FORM
+1 16 MEEM WITH JEEM ISOLATED FORM
+1 26 HAH ISOLATED FORM
+1 26 KHAH ISOLATED FORM
+1 26 MEEM ISOLATED FORM
+1 26 ALEF MAKSURA ISOLATED FORM
+1 26 YEH ISOLATED FORM
+1 16 NOON WITH JEEM ISOLATED FORM
+1 26 HAH ISOLATED FORM
+1 26 KHAH ISOLATED FORM
+1 26 MEEM ISOLATED FORM
+1 26 ALEF MAKSURA ISOLATED FORM
+1 26 YEH ISOLATED FORM
+1 16 HEH WITH JEEM ISOLATED FORM
+1 25 MEEM ISOLATED FORM
+1 25 ALEF MAKSURA ISOLATED FORM
+1 25 YEH ISOLATED FORM
+1 16 YEH WITH JEEM ISOLATED FORM
+1 25 HAH ISOLATED FORM
+1 25 KHAH ISOLATED FORM
+1 25 MEEM ISOLATED FORM
+1 25 ALEF MAKSURA ISOLATED FORM
+1 25 YEH ISOLATED FORM
+1 16 THAL WITH SUPERSCRIPT ALEF ISOLATED FORM
+1 16 REH WITH SUPERSCRIPT ALEF ISOLATED FORM
+1 16 ALEF MAKSURA WITH SUPERSCRIPT ALEF ISOLATED FORM
+1 16 SHADDA WITH DAMMATAN ISOLATED FORM
+1 28 KASRATAN ISOLATED FORM
+1 28 FATHA ISOLATED FORM
+1 28 DAMMA ISOLATED FORM
+1 28 KASRA ISOLATED FORM
+1 28 SUPERSCRIPT ALEF ISOLATED FORM
+1 16 YEH WITH HAMZA ABOVE WITH REH FINAL FORM
+1 42 ZAIN FINAL FORM
+1 42 MEEM FINAL FORM
+1 42 NOON FINAL FORM
+1 42 ALEF MAKSURA FINAL FORM
+1 42 YEH FINAL FORM
+1 16 BEH WITH REH FINAL FORM
+1 25 ZAIN FINAL FORM
+1 25 MEEM FINAL FORM
+1 25 NOON FINAL FORM
+1 25 ALEF MAKSURA FINAL FORM
+1 25 YEH FINAL FORM
+1 16 TEH WITH REH FINAL FORM
+1 25 ZAIN FINAL FORM
+1 25 MEEM FINAL FORM
+1 25 NOON FINAL FORM
+1 25 ALEF MAKSURA FINAL FORM
+1 25 YEH FINAL FORM
+1 17 HEH WITH REH FINAL FORM
+1 26 ZAIN FINAL FORM
+1 26 MEEM FINAL FORM
+1 26 NOON FINAL FORM
+1 26 ALEF MAKSURA FINAL FORM
+1 26 YEH FINAL FORM
+1 16 FEH WITH ALEF MAKSURA FINAL FORM
+1 25 YEH FINAL FORM
+1 16 QAF WITH ALEF MAKSURA FINAL FORM
+1 25 YEH FINAL FORM
+1 16 KAF WITH ALEF FINAL FORM
+1 25 LAM FINAL FORM
+1 25 MEEM FINAL FORM
+1 25 ALEF MAKSURA FINAL FORM
+1 25 YEH FINAL FORM
+1 16 LAM WITH MEEM FINAL FORM
+1 25 ALEF MAKSURA FINAL FORM
+1 25 YEH FINAL FORM
+1 16 MEEM WITH ALEF FINAL FORM
+1 26 MEEM FINAL FORM
+1 16 NOON WITH REH FINAL FORM
+1 26 ZAIN FINAL FORM
+1 26 MEEM FINAL FORM
+1 26 NOON FINAL FORM
+1 26 ALEF MAKSURA FINAL FORM
+1 26 YEH FINAL FORM
+1 16 ALEF MAKSURA WITH SUPERSCRIPT ALEF FINAL FORM
+1 16 YEH WITH REH FINAL FORM
+1 25 ZAIN FINAL FORM
+1 25 MEEM FINAL FORM
+1 25 NOON FINAL FORM
+1 25 ALEF MAKSURA FINAL FORM
+1 25 YEH FINAL FORM
+1 25 HAMZA ABOVE WITH JEEM INITIAL FORM
+1 42 HAH INITIAL FORM
+1 42 KHAH INITIAL FORM
+1 42 MEEM INITIAL FORM
+1 42 HEH INITIAL FORM
+1 16 BEH WITH JEEM INITIAL FORM
+1 25 HAH INITIAL FORM
+1 25 KHAH INITIAL FORM
+1 25 MEEM INITIAL FORM
+1 25 HEH INITIAL FORM
+1 16 TEH WITH JEEM INITIAL FORM
+1 25 HAH INITIAL FORM
+1 25 KHAH INITIAL FORM
+1 25 MEEM INITIAL FORM
+1 25 HEH INITIAL FORM
+1 17 HEH WITH MEEM INITIAL FORM
+1 16 JEEM WITH HAH INITIAL FORM
+1 26 MEEM INITIAL FORM
+1 16 HAH WITH JEEM INITIAL FORM
+1 25 MEEM INITIAL FORM
+1 16 KHAH WITH JEEM INITIAL FORM
+1 26 MEEM INITIAL FORM
+1 16 SEEN WITH JEEM INITIAL FORM
+1 26 HAH INITIAL FORM
+1 26 KHAH INITIAL FORM
+1 26 MEEM INITIAL FORM
+1 17 AD WITH HAH INITIAL FORM
+1 25 KHAH INITIAL FORM
+1 25 MEEM INITIAL FORM
+1 16 DAD WITH JEEM INITIAL FORM
+1 25 HAH INITIAL FORM
+1 25 KHAH INITIAL FORM
+1 25 MEEM INITIAL FORM
+1 16 TAH WITH HAH INITIAL FORM
+1 16 ZAH WITH MEEM INITIAL FORM
+1 16 AIN WITH JEEM INITIAL FORM
+1 25 MEEM INITIAL FORM
+1 16 GHAIN WITH JEEM INITIAL FORM
+1 27 MEEM INITIAL FORM
+1 16 FEH WITH JEEM INITIAL FORM
+1 25 HAH INITIAL FORM
+1 25 KHAH INITIAL FORM
+1 25 MEEM INITIAL FORM
+1 16 QAF WITH HAH INITIAL FORM
+1 25 MEEM INITIAL FORM
+1 16 KAF WITH JEEM INITIAL FORM
+1 25 HAH INITIAL FORM
+1 25 KHAH INITIAL FORM
+1 25 LAM INITIAL FORM
+1 25 MEEM INITIAL FORM
+1 16 LAM WITH JEEM INITIAL FORM
+1 25 HAH INITIAL FORM
+1 25 KHAH INITIAL FORM
+1 25 MEEM INITIAL FORM
+1 25 HEH INITIAL FORM
+1 16 MEEM WITH JEEM INITIAL FORM
+1 26 HAH INITIAL FORM
+1 26 KHAH INITIAL FORM
+1 26 MEEM INITIAL FORM
+1 16 NOON WITH JEEM INITIAL FORM
+1 26 HAH INITIAL FORM
+1 26 KHAH INITIAL FORM
+1 26 MEEM INITIAL FORM
+1 26 HEH INITIAL FORM
+1 16 HEH WITH JEEM INITIAL FORM
+1 25 MEEM INITIAL FORM
+1 25 SUPERSCRIPT ALEF INITIAL FORM
+1 16 YEH WITH JEEM INITIAL FORM
+1 25 HAH INITIAL FORM
+1 25 KHAH INITIAL FORM
+1 25 MEEM INITIAL FORM
+1 25 HEH INITIAL FORM
+1 26 AMZA ABOVE WITH MEEM MEDIAL FORM
+1 42 HEH MEDIAL FORM
+1 16 BEH WITH MEEM MEDIAL FORM
+1 25 HEH MEDIAL FORM
+1 16 TEH WITH MEEM MEDIAL FORM
+1 25 HEH MEDIAL FORM
+1 17 HEH WITH MEEM MEDIAL FORM
+1 26 HEH MEDIAL FORM
+1 16 SEEN WITH MEEM MEDIAL FORM
+1 26 HEH MEDIAL FORM
+1 17 HEEN WITH MEEM MEDIAL FORM
+1 27 HEH MEDIAL FORM
+1 16 KAF WITH LAM MEDIAL FORM
+1 25 MEEM MEDIAL FORM
+1 16 LAM WITH MEEM MEDIAL FORM
+1 16 NOON WITH MEEM MEDIAL FORM
+1 26 HEH MEDIAL FORM
+1 16 YEH WITH MEEM MEDIAL FORM
+1 25 HEH MEDIAL FORM
+1 16 SHADDA WITH FATHA MEDIAL FORM
+1 28 DAMMA MEDIAL FORM
+1 28 KASRA MEDIAL FORM
+1 16 TAH WITH ALEF MAKSURA ISOLATED FORM
+1 25 YEH ISOLATED FORM
+1 16 AIN WITH ALEF MAKSURA ISOLATED FORM
+1 25 YEH ISOLATED FORM
+1 16 GHAIN WITH ALEF MAKSURA ISOLATED FORM
+1 27 YEH ISOLATED FORM
+1 16 SEEN WITH ALEF MAKSURA ISOLATED FORM
+1 26 YEH ISOLATED FORM
+1 17 HEEN WITH ALEF MAKSURA ISOLATED FORM
+1 27 YEH ISOLATED FORM
+1 16 HAH WITH ALEF MAKSURA ISOLATED FORM
+1 25 YEH ISOLATED FORM
+1 16 JEEM WITH ALEF MAKSURA ISOLATED FORM
+1 26 YEH ISOLATED FORM
+1 16 KHAH WITH ALEF MAKSURA ISOLATED FORM
+1 26 YEH ISOLATED FORM
+1 16 SAD WITH ALEF MAKSURA ISOLATED FORM
+1 25 YEH ISOLATED FORM
+1 16 DAD WITH ALEF MAKSURA ISOLATED FORM
+1 25 YEH ISOLATED FORM
+1 16 SHEEN WITH JEEM ISOLATED FORM
+1 27 HAH ISOLATED FORM
+1 27 KHAH ISOLATED FORM
+1 27 MEEM ISOLATED FORM
+1 27 REH ISOLATED FORM
+1 17 EEN WITH REH ISOLATED FORM
+1 17 AD WITH REH ISOLATED FORM
+1 16 DAD WITH REH ISOLATED FORM
+1 16 TAH WITH ALEF MAKSURA FINAL FORM
+1 25 YEH FINAL FORM
+1 16 AIN WITH ALEF MAKSURA FINAL FORM
+1 25 YEH FINAL FORM
+1 16 GHAIN WITH ALEF MAKSURA FINAL FORM
+1 27 YEH FINAL FORM
+1 16 SEEN WITH ALEF MAKSURA FINAL FORM
+1 26 YEH FINAL FORM
+1 17 HEEN WITH ALEF MAKSURA FINAL FORM
+1 27 YEH FINAL FORM
+1 16 HAH WITH ALEF MAKSURA FINAL FORM
+1 25 YEH FINAL FORM
+1 16 JEEM WITH ALEF MAKSURA FINAL FORM
+1 26 YEH FINAL FORM
+1 16 KHAH WITH ALEF MAKSURA FINAL FORM
+1 26 YEH FINAL FORM
+1 16 SAD WITH ALEF MAKSURA FINAL FORM
+1 25 YEH FINAL FORM
+1 16 DAD WITH ALEF MAKSURA FINAL FORM
+1 25 YEH FINAL FORM
+1 16 SHEEN WITH JEEM FINAL FORM
+1 27 HAH FINAL FORM
+1 27 KHAH FINAL FORM
+1 27 MEEM FINAL FORM
+1 27 REH FINAL FORM
+1 17 EEN WITH REH FINAL FORM
+1 17 AD WITH REH FINAL FORM
+1 16 DAD WITH REH FINAL FORM
+1 16 SHEEN WITH JEEM INITIAL FORM
+1 27 HAH INITIAL FORM
+1 27 KHAH INITIAL FORM
+1 27 MEEM INITIAL FORM
+1 17 EEN WITH HEH INITIAL FORM
+1 17 HEEN WITH HEH INITIAL FORM
+1 16 TAH WITH MEEM INITIAL FORM
+1 16 SEEN WITH JEEM MEDIAL FORM
+1 26 HAH MEDIAL FORM
+1 26 KHAH MEDIAL FORM
+1 17 HEEN WITH JEEM MEDIAL FORM
+1 27 HAH MEDIAL FORM
+1 27 KHAH MEDIAL FORM
+1 16 TAH WITH MEEM MEDIAL FORM
+1 16 ZAH WITH MEEM MEDIAL FORM
+1 16 ALEF WITH FATHATAN FINAL FORM
+1 35 ISOLATED FORM
+1 0 ORNATE LEFT PARENTHESIS
+1 7 RIGHT PARENTHESIS
+1 0 ARABIC LIGATURE RAHIMAHU ALLAAH
+1 18 DI ALLAAHU ANH
+1 32 AA
+1 32 UM
+1 34 AA
+1 33 NNA
+1 16 SALLALLAAHU ALAYHI WA-AALIH
+1 16 ALAYHI AS-SALAAM
+1 22 M AS-SALAAM
+1 23 AA AS-SALAAM
+1 22  AS-SALAATU WAS-SALAAM
+1 16 QUDDISA SIRRAH
+1 16 SALLALLAHU ALAYHI WAAALIHEE WA-SALLAM
+1 16 ALAYHAA AS-SALAAM
+1 16 TABAARAKA WA-TAAALAA
+1 16 RAHIMAHUM ALLAAH
+1 16 TEH WITH JEEM WITH MEEM INITIAL FORM
+1 25 HAH WITH JEEM FINAL FORM
+1 39 INITIAL FORM
+1 34 MEEM INITIAL FORM
+1 25 KHAH WITH MEEM INITIAL FORM
+1 25 MEEM WITH JEEM INITIAL FORM
+1 35 HAH INITIAL FORM
+1 35 KHAH INITIAL FORM
+1 16 JEEM WITH MEEM WITH HAH FINAL FORM
+1 40 INITIAL FORM
+1 16 HAH WITH MEEM WITH YEH FINAL FORM
+1 35 ALEF MAKSURA FINAL FORM
+1 16 SEEN WITH HAH WITH JEEM INITIAL FORM
+1 26 JEEM WITH HAH INITIAL FORM
+1 36 ALEF MAKSURA FINAL FORM
+1 26 MEEM WITH HAH FINAL FORM
+1 40 INITIAL FORM
+1 36 JEEM INITIAL FORM
+1 36 MEEM FINAL FORM
+1 41 INITIAL FORM
+1 17 AD WITH HAH WITH HAH FINAL FORM
+1 38 INITIAL FORM
+1 25 MEEM WITH MEEM FINAL FORM
+1 17 HEEN WITH HAH WITH MEEM FINAL FORM
+1 41 INITIAL FORM
+1 27 JEEM WITH YEH FINAL FORM
+1 27 MEEM WITH KHAH FINAL FORM
+1 42 INITIAL FORM
+1 37 MEEM FINAL FORM
+1 42 INITIAL FORM
+1 16 DAD WITH HAH WITH ALEF MAKSURA FINAL FORM
+1 25 KHAH WITH MEEM FINAL FORM
+1 40 INITIAL FORM
+1 16 TAH WITH MEEM WITH HAH FINAL FORM
+1 39 INITIAL FORM
+1 35 MEEM INITIAL FORM
+1 35 YEH FINAL FORM
+1 16 AIN WITH JEEM WITH MEEM FINAL FORM
+1 25 MEEM WITH MEEM FINAL FORM
+1 40 INITIAL FORM
+1 35 ALEF MAKSURA FINAL FORM
+1 16 GHAIN WITH MEEM WITH MEEM FINAL FORM
+1 37 YEH FINAL FORM
+1 37 ALEF MAKSURA FINAL FORM
+1 16 FEH WITH KHAH WITH MEEM FINAL FORM
+1 40 INITIAL FORM
+1 16 QAF WITH MEEM WITH HAH FINAL FORM
+1 35 MEEM FINAL FORM
+1 16 LAM WITH HAH WITH MEEM FINAL FORM
+1 34 YEH FINAL FORM
+1 34 ALEF MAKSURA FINAL FORM
+1 25 JEEM WITH JEEM INITIAL FORM
+1 40 FINAL FORM
+1 25 KHAH WITH MEEM FINAL FORM
+1 40 INITIAL FORM
+1 25 MEEM WITH HAH FINAL FORM
+1 39 INITIAL FORM
+1 16 MEEM WITH HAH WITH JEEM INITIAL FORM
+1 35 MEEM INITIAL FORM
+1 35 YEH FINAL FORM
+1 26 JEEM WITH HAH INITIAL FORM
+1 36 MEEM INITIAL FORM
+1 26 KHAH WITH JEEM INITIAL FORM
+1 36 MEEM INITIAL FORM
+3 26 JEEM WITH KHAH INITIAL FORM
+1 16 HEH WITH MEEM WITH JEEM INITIAL FORM
+1 35 MEEM INITIAL FORM
+1 16 NOON WITH HAH WITH MEEM INITIAL FORM
+1 35 ALEF MAKSURA FINAL FORM
+1 26 JEEM WITH MEEM FINAL FORM
+1 41 INITIAL FORM
+1 36 ALEF MAKSURA FINAL FORM
+1 26 MEEM WITH YEH FINAL FORM
+1 36 ALEF MAKSURA FINAL FORM
+1 16 YEH WITH MEEM WITH MEEM FINAL FORM
+1 40 INITIAL FORM
+1 16 BEH WITH KHAH WITH YEH FINAL FORM
+1 16 TEH WITH JEEM WITH YEH FINAL FORM
+1 35 ALEF MAKSURA FINAL FORM
+1 25 KHAH WITH YEH FINAL FORM
+1 35 ALEF MAKSURA FINAL FORM
+1 25 MEEM WITH YEH FINAL FORM
+1 35 ALEF MAKSURA FINAL FORM
+1 16 JEEM WITH MEEM WITH YEH FINAL FORM
+1 26 HAH WITH ALEF MAKSURA FINAL FORM
+1 26 MEEM WITH ALEF MAKSURA FINAL FORM
+1 16 SEEN WITH KHAH WITH ALEF MAKSURA FINAL FORM
+1 17 AD WITH HAH WITH YEH FINAL FORM
+1 17 HEEN WITH HAH WITH YEH FINAL FORM
+1 16 DAD WITH HAH WITH YEH FINAL FORM
+1 16 LAM WITH JEEM WITH YEH FINAL FORM
+1 25 MEEM WITH YEH FINAL FORM
+1 16 YEH WITH HAH WITH YEH FINAL FORM
+1 25 JEEM WITH YEH FINAL FORM
+1 25 MEEM WITH YEH FINAL FORM
+1 16 MEEM WITH MEEM WITH YEH FINAL FORM
+1 16 QAF WITH MEEM WITH YEH FINAL FORM
+1 16 NOON WITH HAH WITH YEH FINAL FORM
+1 16 QAF WITH MEEM WITH HAH INITIAL FORM
+1 16 LAM WITH HAH WITH MEEM INITIAL FORM
+1 16 AIN WITH MEEM WITH YEH FINAL FORM
+1 16 KAF WITH MEEM WITH YEH FINAL FORM
+1 16 NOON WITH JEEM WITH HAH INITIAL FORM
+1 16 MEEM WITH KHAH WITH YEH FINAL FORM
+1 16 LAM WITH JEEM WITH MEEM INITIAL FORM
+1 16 KAF WITH MEEM WITH MEEM FINAL FORM
+1 16 LAM WITH JEEM WITH MEEM FINAL FORM
+1 16 NOON WITH JEEM WITH HAH FINAL FORM
+1 16 JEEM WITH HAH WITH YEH FINAL FORM
+1 16 HAH WITH JEEM WITH YEH FINAL FORM
+1 16 MEEM WITH JEEM WITH YEH FINAL FORM
+1 16 FEH WITH MEEM WITH YEH FINAL FORM
+1 16 BEH WITH HAH WITH YEH FINAL FORM
+1 16 KAF WITH MEEM WITH MEEM INITIAL FORM
+1 16 AIN WITH JEEM WITH MEEM INITIAL FORM
+1 16 SAD WITH MEEM WITH MEEM INITIAL FORM
+1 17 EEN WITH KHAH WITH YEH FINAL FORM
+1 16 NOON WITH JEEM WITH YEH FINAL FORM
+8 16 SALAAMUHU ALAYNAA
+21 19 LA USED AS KORANIC STOP SIGN ISOLATED FORM
+1 16 QALA USED AS KORANIC STOP SIGN ISOLATED FORM
+1 16 ALLAH ISOLATED FORM
+1 17 KBAR ISOLATED FORM
+1 16 MOHAMMAD ISOLATED FORM
+1 16 SALAM ISOLATED FORM
+1 16 RASOUL ISOLATED FORM
+1 16 ALAYHE ISOLATED FORM
+1 16 WASALLAM ISOLATED FORM
+1 16 SALLA ISOLATED FORM
+1 21 LLAHOU ALAYHE WASALLAM
+1 16 JALLAJALALOUHOU
+1 0 RIAL SIGN
+1 0 ARABIC LIGATURE BISMILLAH AR-RAHMAN AR-RAHEEM
+1 16 SUBHAANAHU WA TAAALAA
+1 16 AZZA WA JALL
+1 0 VARIATION SELECTOR-1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 19 10
+1 20 1
+1 20 2
+1 20 3
+1 20 4
+1 20 5
+1 20 6
+1 0 PRESENTATION FORM FOR VERTICAL COMMA
+1 31 IDEOGRAPHIC COMMA
+1 43 FULL STOP
+1 31 COLON
+1 31 SEMICOLON
+1 31 EXCLAMATION MARK
+1 31 QUESTION MARK
+1 31 LEFT WHITE LENTICULAR BRACKET
+1 31 RIGHT WHITE LENTICULAR BRAKCET
+1 31 HORIZONTAL ELLIPSIS
+7 0 COMBINING LIGATURE LEFT HALF
+1 19 RIGHT HALF
+1 10 DOUBLE TILDE LEFT HALF
+1 23 RIGHT HALF
+1 10 MACRON LEFT HALF
+1 17 RIGHT HALF
+1 10 CONJOINING MACRON
+1 10 LIGATURE LEFT HALF BELOW
+1 19 RIGHT HALF BELOW
+1 10 TILDE LEFT HALF BELOW
+1 16 RIGHT HALF BELOW
+1 10 MACRON LEFT HALF BELOW
+1 17 RIGHT HALF BELOW
+1 10 CONJOINING MACRON BELOW
+1 11 YRILLIC TITLO LEFT HALF
+1 25 RIGHT HALF
+1 0 PRESENTATION FORM FOR VERTICAL TWO DOT LEADER
+1 31 EM DASH
+1 32 N DASH
+1 31 LOW LINE
+1 31 WAVY LOW LINE
+1 31 LEFT PARENTHESIS
+1 31 RIGHT PARENTHESIS
+1 31 LEFT CURLY BRACKET
+1 31 RIGHT CURLY BRACKET
+1 31 LEFT TORTOISE SHELL BRACKET
+1 31 RIGHT TORTOISE SHELL BRACKET
+1 31 LEFT BLACK LENTICULAR BRACKET
+1 31 RIGHT BLACK LENTICULAR BRACKET
+1 31 LEFT DOUBLE ANGLE BRACKET
+1 31 RIGHT DOUBLE ANGLE BRACKET
+1 31 LEFT ANGLE BRACKET
+1 31 RIGHT ANGLE BRACKET
+1 31 LEFT CORNER BRACKET
+1 31 RIGHT CORNER BRACKET
+1 31 LEFT WHITE CORNER BRACKET
+1 31 RIGHT WHITE CORNER BRACKET
+1 0 SESAME DOT
+1 0 WHITE SESAME DOT
+1 0 PRESENTATION FORM FOR VERTICAL LEFT SQUARE BRACKET
+1 31 RIGHT SQUARE BRACKET
+1 0 DASHED OVERLINE
+1 0 CENTRELINE OVERLINE
+1 0 WAVY OVERLINE
+1 0 DOUBLE WAVY OVERLINE
+1 1 ASHED LOW LINE
+1 0 CENTRELINE LOW LINE
+1 0 WAVY LOW LINE
+1 0 SMALL COMMA
+1 6 IDEOGRAPHIC COMMA
+1 6 FULL STOP
+2 6 SEMICOLON
+1 6 COLON
+1 6 QUESTION MARK
+1 6 EXCLAMATION MARK
+1 7 M DASH
+1 6 LEFT PARENTHESIS
+1 6 RIGHT PARENTHESIS
+1 6 LEFT CURLY BRACKET
+1 6 RIGHT CURLY BRACKET
+1 6 LEFT TORTOISE SHELL BRACKET
+1 6 RIGHT TORTOISE SHELL BRACKET
+1 6 NUMBER SIGN
+1 6 AMPERSAND
+1 7 STERISK
+1 6 PLUS SIGN
+1 6 HYPHEN-MINUS
+1 6 LESS-THAN SIGN
+1 6 GREATER-THAN SIGN
+1 6 EQUALS SIGN
+2 6 REVERSE SOLIDUS
+1 6 DOLLAR SIGN
+1 6 PERCENT SIGN
+1 6 COMMERCIAL AT
+5 0 ARABIC FATHATAN ISOLATED FORM
+1 7 TATWEEL WITH FATHATAN ABOVE
+1 7 DAMMATAN ISOLATED FORM
+1 7 TAIL FRAGMENT
+1 7 KASRATAN ISOLATED FORM
+2 7 FATHA ISOLATED FORM
+1 13 MEDIAL FORM
+1 7 DAMMA ISOLATED FORM
+1 13 MEDIAL FORM
+1 7 KASRA ISOLATED FORM
+1 13 MEDIAL FORM
+1 7 SHADDA ISOLATED FORM
+1 14 MEDIAL FORM
+1 8 UKUN ISOLATED FORM
+1 13 MEDIAL FORM
+1 7 LETTER HAMZA ISOLATED FORM
+1 14 ALEF WITH MADDA ABOVE ISOLATED FORM
+1 36 FINAL FORM
+1 24 HAMZA ABOVE ISOLATED FORM
+1 36 FINAL FORM
+1 14 WAW WITH HAMZA ABOVE ISOLATED FORM
+1 35 FINAL FORM
+1 14 ALEF WITH HAMZA BELOW ISOLATED FORM
+1 36 FINAL FORM
+1 14 YEH WITH HAMZA ABOVE ISOLATED FORM
+1 35 FINAL FORM
+1 35 INITIAL FORM
+1 35 MEDIAL FORM
+1 14 ALEF ISOLATED FORM
+1 19 FINAL FORM
+1 14 BEH ISOLATED FORM
+1 18 FINAL FORM
+1 18 INITIAL FORM
+1 18 MEDIAL FORM
+1 14 TEH MARBUTA ISOLATED FORM
+1 26 FINAL FORM
+1 18 ISOLATED FORM
+1 18 FINAL FORM
+1 18 INITIAL FORM
+1 18 MEDIAL FORM
+1 15 HEH ISOLATED FORM
+1 19 FINAL FORM
+1 19 INITIAL FORM
+1 19 MEDIAL FORM
+1 14 JEEM ISOLATED FORM
+1 19 FINAL FORM
+1 19 INITIAL FORM
+1 19 MEDIAL FORM
+1 14 HAH ISOLATED FORM
+1 18 FINAL FORM
+1 18 INITIAL FORM
+1 18 MEDIAL FORM
+1 14 KHAH ISOLATED FORM
+1 19 FINAL FORM
+1 19 INITIAL FORM
+1 19 MEDIAL FORM
+1 14 DAL ISOLATED FORM
+1 18 FINAL FORM
+1 14 THAL ISOLATED FORM
+1 19 FINAL FORM
+1 14 REH ISOLATED FORM
+1 18 FINAL FORM
+1 14 ZAIN ISOLATED FORM
+1 19 FINAL FORM
+1 14 SEEN ISOLATED FORM
+1 19 FINAL FORM
+1 19 INITIAL FORM
+1 19 MEDIAL FORM
+1 15 HEEN ISOLATED FORM
+1 20 FINAL FORM
+1 20 INITIAL FORM
+1 20 MEDIAL FORM
+1 15 AD ISOLATED FORM
+1 18 FINAL FORM
+1 18 INITIAL FORM
+1 18 MEDIAL FORM
+1 14 DAD ISOLATED FORM
+1 18 FINAL FORM
+1 18 INITIAL FORM
+1 18 MEDIAL FORM
+1 14 TAH ISOLATED FORM
+1 18 FINAL FORM
+1 18 INITIAL FORM
+1 18 MEDIAL FORM
+1 14 ZAH ISOLATED FORM
+1 18 FINAL FORM
+1 18 INITIAL FORM
+1 18 MEDIAL FORM
+1 14 AIN ISOLATED FORM
+1 18 FINAL FORM
+1 18 INITIAL FORM
+1 18 MEDIAL FORM
+1 14 GHAIN ISOLATED FORM
+1 20 FINAL FORM
+1 20 INITIAL FORM
+1 20 MEDIAL FORM
+1 14 FEH ISOLATED FORM
+1 18 FINAL FORM
+1 18 INITIAL FORM
+1 18 MEDIAL FORM
+1 14 QAF ISOLATED FORM
+1 18 FINAL FORM
+1 18 INITIAL FORM
+1 18 MEDIAL FORM
+1 14 KAF ISOLATED FORM
+1 18 FINAL FORM
+1 18 INITIAL FORM
+1 18 MEDIAL FORM
+1 14 LAM ISOLATED FORM
+1 18 FINAL FORM
+1 18 INITIAL FORM
+1 18 MEDIAL FORM
+1 14 MEEM ISOLATED FORM
+1 19 FINAL FORM
+1 19 INITIAL FORM
+1 19 MEDIAL FORM
+1 14 NOON ISOLATED FORM
+1 19 FINAL FORM
+1 19 INITIAL FORM
+1 19 MEDIAL FORM
+1 14 HEH ISOLATED FORM
+1 18 FINAL FORM
+1 18 INITIAL FORM
+1 18 MEDIAL FORM
+1 14 WAW ISOLATED FORM
+1 18 FINAL FORM
+1 14 ALEF MAKSURA ISOLATED FORM
+1 27 FINAL FORM
+1 14 YEH ISOLATED FORM
+1 18 FINAL FORM
+1 18 INITIAL FORM
+1 18 MEDIAL FORM
+1 8 IGATURE LAM WITH ALEF WITH MADDA ABOVE ISOLATED FORM
+1 47 FINAL FORM
+1 35 HAMZA ABOVE ISOLATED FORM
+1 47 FINAL FORM
+1 41 BELOW ISOLATED FORM
+1 47 FINAL FORM
+1 30 ISOLATED FORM
+1 30 FINAL FORM
+3 0 ZERO WIDTH NO-BREAK SPACE
+2 0 FULLWIDTH EXCLAMATION MARK
+1 10 QUOTATION MARK
+1 10 NUMBER SIGN
+1 10 DOLLAR SIGN
+1 10 PERCENT SIGN
+1 10 AMPERSAND
+1 11 POSTROPHE
+1 10 LEFT PARENTHESIS
+1 10 RIGHT PARENTHESIS
+1 10 ASTERISK
+1 10 PLUS SIGN
+1 10 COMMA
+1 10 HYPHEN-MINUS
+1 10 FULL STOP
+1 10 SOLIDUS
+1 10 DIGIT ZERO
+1 16 ONE
+1 16 TWO
+1 17 HREE
+1 16 FOUR
+1 17 IVE
+1 16 SIX
+1 17 EVEN
+1 16 EIGHT
+1 16 NINE
+1 10 COLON
+1 10 SEMICOLON
+1 10 LESS-THAN SIGN
+1 10 EQUALS SIGN
+1 10 GREATER-THAN SIGN
+1 10 QUESTION MARK
+1 10 COMMERCIAL AT
+1 10 LATIN CAPITAL LETTER A
+1 31 B
+1 31 C
+1 31 D
+1 31 E
+1 31 F
+1 31 G
+1 31 H
+1 31 I
+1 31 J
+1 31 K
+1 31 L
+1 31 M
+1 31 N
+1 31 O
+1 31 P
+1 31 Q
+1 31 R
+1 31 S
+1 31 T
+1 31 U
+1 31 V
+1 31 W
+1 31 X
+1 31 Y
+1 31 Z
+1 11 EFT SQUARE BRACKET
+1 10 REVERSE SOLIDUS
+1 11 IGHT SQUARE BRACKET
+1 10 CIRCUMFLEX ACCENT
+1 10 LOW LINE
+1 10 GRAVE ACCENT
+1 10 LATIN SMALL LETTER A
+1 29 B
+1 29 C
+1 29 D
+1 29 E
+1 29 F
+1 29 G
+1 29 H
+1 29 I
+1 29 J
+1 29 K
+1 29 L
+1 29 M
+1 29 N
+1 29 O
+1 29 P
+1 29 Q
+1 29 R
+1 29 S
+1 29 T
+1 29 U
+1 29 V
+1 29 W
+1 29 X
+1 29 Y
+1 29 Z
+1 11 EFT CURLY BRACKET
+1 10 VERTICAL LINE
+1 10 RIGHT CURLY BRACKET
+1 10 TILDE
+1 10 LEFT WHITE PARENTHESIS
+1 10 RIGHT WHITE PARENTHESIS
+1 0 HALFWIDTH IDEOGRAPHIC FULL STOP
+1 10 LEFT CORNER BRACKET
+1 10 RIGHT CORNER BRACKET
+1 10 IDEOGRAPHIC COMMA
+1 10 KATAKANA MIDDLE DOT
+1 19 LETTER WO
+1 26 SMALL A
+1 32 I
+1 32 U
+1 32 E
+1 32 O
+1 32 YA
+1 33 U
+1 33 O
+1 32 TU
+1 18 -HIRAGANA PROLONGED SOUND MARK
+1 18  LETTER A
+1 26 I
+1 26 U
+1 26 E
+1 26 O
+1 26 KA
+1 27 I
+1 27 U
+1 27 E
+1 27 O
+1 26 SA
+1 27 I
+1 27 U
+1 27 E
+1 27 O
+1 26 TA
+1 27 I
+1 27 U
+1 27 E
+1 27 O
+1 26 NA
+1 27 I
+1 27 U
+1 27 E
+1 27 O
+1 26 HA
+1 27 I
+1 27 U
+1 27 E
+1 27 O
+1 26 MA
+1 27 I
+1 27 U
+1 27 E
+1 27 O
+1 26 YA
+1 27 U
+1 27 O
+1 26 RA
+1 27 I
+1 27 U
+1 27 E
+1 27 O
+1 26 WA
+1 26 N
+1 19 VOICED SOUND MARK
+1 19 SEMI-VOICED SOUND MARK
+1 10 HANGUL FILLER
+1 17 LETTER KIYEOK
+1 24 SSANGKIYEOK
+1 24 KIYEOK-SIOS
+1 24 NIEUN
+1 29 -CIEUC
+1 30 HIEUH
+1 24 TIKEUT
+1 24 SSANGTIKEUT
+1 24 RIEUL
+1 29 -KIYEOK
+1 30 MIEUM
+1 30 PIEUP
+1 30 SIOS
+1 30 THIEUTH
+1 30 PHIEUPH
+1 30 HIEUH
+1 24 MIEUM
+1 24 PIEUP
+1 24 SSANGPIEUP
+1 24 PIEUP-SIOS
+1 24 SIOS
+1 25 SANGSIOS
+1 24 IEUNG
+1 24 CIEUC
+1 24 SSANGCIEUC
+1 24 CHIEUCH
+1 24 KHIEUKH
+1 24 THIEUTH
+1 24 PHIEUPH
+1 24 HIEUH
+4 24 A
+1 25 E
+1 24 YA
+1 26 E
+1 24 EO
+1 25 
+3 24 YEO
+1 26 
+1 24 O
+1 24 WA
+1 26 E
+1 24 OE
+3 24 YO
+1 24 U
+1 24 WEO
+1 26 
+1 25 I
+1 24 YU
+3 24 EU
+1 24 YI
+1 24 I
+4 0 FULLWIDTH CENT SIGN
+1 10 POUND SIGN
+1 10 NOT SIGN
+1 10 MACRON
+1 10 BROKEN BAR
+1 10 YEN SIGN
+1 10 WON SIGN
+2 0 HALFWIDTH FORMS LIGHT VERTICAL
+1 10 LEFTWARDS ARROW
+1 10 UPWARDS ARROW
+1 10 RIGHTWARDS ARROW
+1 10 DOWNWARDS ARROW
+1 10 BLACK SQUARE
+1 10 WHITE CIRCLE
+b 0 INTERLINEAR ANNOTATION ANCHOR
+1 23 SEPARATOR
+1 23 TERMINATOR
+1 0 OBJECT REPLACEMENT CHARACTER
+1 0 REPLACEMENT CHARACTER
+3 0 LINEAR B SYLLABLE B008 A
+1 20 38 E
+1 20 28 I
+1 20 61 O
+1 20 10 U
+1 20 01 DA
+1 20 45 DE
+1 20 07 DI
+1 20 14 DO
+1 20 51 DU
+1 21 7 JA
+1 20 46 JE
+2 20 36 JO
+1 20 65 JU
+1 20 77 KA
+1 20 44 KE
+1 20 67 KI
+1 20 70 KO
+1 20 81 KU
+1 21 0 MA
+1 20 13 ME
+1 20 73 MI
+1 20 15 MO
+1 20 23 MU
+1 20 06 NA
+1 20 24 NE
+1 20 30 NI
+1 20 52 NO
+1 21 5 NU
+1 20 03 PA
+1 20 72 PE
+1 20 39 PI
+1 20 11 PO
+1 20 50 PU
+1 20 16 QA
+1 20 78 QE
+1 20 21 QI
+1 20 32 QO
+2 20 60 RA
+1 20 27 RE
+1 20 53 RI
+1 20 02 RO
+1 20 26 RU
+1 20 31 SA
+1 20 09 SE
+1 20 41 SI
+1 20 12 SO
+1 20 58 SU
+1 21 9 TA
+1 20 04 TE
+1 20 37 TI
+1 20 05 TO
+1 20 69 TU
+1 20 54 WA
+1 20 75 WE
+1 20 40 WI
+1 21 2 WO
+2 20 17 ZA
+1 20 74 ZE
+2 20 20 ZO
+1 21 5 A2
+1 20 43 A3
+1 20 85 AU
+1 20 71 DWE
+1 20 90 DWO
+1 20 48 NWA
+1 20 29 PU2
+1 20 62 PTE
+1 20 76 RA2
+1 20 33 RA3
+1 20 68 RO2
+1 21 6 TA2
+1 20 87 TWE
+1 20 91 TWO
+3 11 MBOL B018
+1 19 9
+1 18 22
+1 18 34
+1 18 47
+1 19 9
+1 18 56
+1 18 63
+1 19 4
+1 18 79
+1 18 82
+1 19 3
+1 19 6
+1 19 9
+23 9 IDEOGRAM B100 MAN
+1 21 2 WOMAN
+1 21 4 DEER
+1 21 5 EQUID
+1 22 F MARE
+1 22 M STALLION
+1 21 6F EWE
+1 22 M RAM
+1 21 7F SHE-GOAT
+1 22 M HE-GOAT
+1 21 8F SOW
+1 22 M BOAR
+1 21 9F COW
+1 22 M BULL
+1 20 20 WHEAT
+1 21 1 BARLEY
+1 21 2 OLIVE
+1 21 3 SPICE
+1 21 5 CYPERUS
+1 9 MONOGRAM B127 KAPO
+1 21 8 KANAKO
+1 9 IDEOGRAM B130 OIL
+1 21 1 WINE
+1 21 2
+1 9 MONOGRAM B133 AREPA
+1 21 5 MERI
+1 9 IDEOGRAM B140 BRONZE
+1 21 1 GOLD
+1 21 2
+1 21 5 WOOL
+1 21 6
+1 20 50
+1 21 1 HORN
+1 21 2
+1 21 3
+1 21 4
+1 9 MONOGRAM B156 TURO2
+1 9 IDEOGRAM B157
+1 21 8
+1 21 9 CLOTH
+1 20 60
+1 21 1
+1 21 2 GARMENT
+1 21 3 ARMOUR
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 21 9
+1 20 70
+1 21 1
+1 21 2
+1 21 3 MONTH
+1 21 4
+1 21 6 TREE
+1 21 7
+1 21 8
+1 21 9
+1 20 80
+1 21 1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 9
+1 20 90
+1 21 1 HELMET
+1 19 220 FOOTSTOOL
+1 21 5 BATHTUB
+1 20 30 SPEAR
+1 21 1 ARROW
+1 21 2
+1 21 3 SWORD
+1 21 4
+1 21 6
+1 20 40 WHEELED CHARIOT
+1 21 1 CHARIOT
+1 21 2 CHARIOT FRAME
+1 21 3 WHEEL
+1 21 5
+1 21 6
+1 9 MONOGRAM B247 DIPTE
+1 9 IDEOGRAM B248
+1 21 9
+1 20 51
+1 21 2
+1 21 3
+1 21 4 DART
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 21 9
+1 18 VESSEL B155
+1 26 200
+1 28 1
+1 28 2
+1 28 3
+1 28 4
+1 28 5
+1 28 6
+1 28 7
+1 28 8
+1 28 9
+1 27 10
+1 28 1
+1 28 2
+1 28 3
+1 28 4
+1 28 5
+1 28 6
+1 28 7
+1 28 8
+1 28 9
+1 27 21
+1 28 2
+1 28 6
+1 28 7
+1 28 8
+1 28 9
+1 27 50
+1 26 305
+6 0 AEGEAN WORD SEPARATOR LINE
+1 22 DOT
+1 7 CHECK MARK
+5 7 NUMBER ONE
+1 14 TWO
+1 15 HREE
+1 14 FOUR
+1 15 IVE
+1 14 SIX
+1 15 EVEN
+1 14 EIGHT
+1 14 NINE
+1 14 TEN
+1 15 WENTY
+1 15 HIRTY
+1 14 FORTY
+1 15 IFTY
+1 14 SIXTY
+1 15 EVENTY
+1 14 EIGHTY
+1 14 NINETY
+1 14 ONE HUNDRED
+1 14 TWO HUNDRED
+1 15 HREE HUNDRED
+1 14 FOUR HUNDRED
+1 15 IVE HUNDRED
+1 14 SIX HUNDRED
+1 15 EVEN HUNDRED
+1 14 EIGHT HUNDRED
+1 14 NINE HUNDRED
+1 14 ONE THOUSAND
+1 14 TWO THOUSAND
+1 15 HREE THOUSAND
+1 14 FOUR THOUSAND
+1 15 IVE THOUSAND
+1 14 SIX THOUSAND
+1 15 EVEN THOUSAND
+1 14 EIGHT THOUSAND
+1 14 NINE THOUSAND
+1 14 TEN THOUSAND
+1 15 WENTY THOUSAND
+1 15 HIRTY THOUSAND
+1 14 FORTY THOUSAND
+1 15 IFTY THOUSAND
+1 14 SIXTY THOUSAND
+1 15 EVENTY THOUSAND
+1 14 EIGHTY THOUSAND
+1 14 NINETY THOUSAND
+4 7 WEIGHT BASE UNIT
+1 14 FIRST SUBUNIT
+1 14 SECOND SUBUNIT
+1 14 THIRD SUBUNIT
+1 14 FOURTH SUBUNIT
+1 7 DRY MEASURE FIRST SUBUNIT
+1 7 LIQUID MEASURE FIRST SUBUNIT
+1 7 MEASURE SECOND SUBUNIT
+1 15 THIRD SUBUNIT
+1 0 GREEK ACROPHONIC ATTIC ONE QUARTER
+1 27 HALF
+1 27 DRACHMA
+1 23 FIVE
+1 25 FTY
+1 25 VE HUNDRED
+1 28 THOUSAND
+1 25 FTY THOUSAND
+1 25 VE TALENTS
+1 23 TEN TALENTS
+1 23 FIFTY TALENTS
+1 23 ONE HUNDRED TALENTS
+1 23 FIVE HUNDRED TALENTS
+1 23 ONE THOUSAND TALENTS
+1 23 FIVE THOUSAND TALENTS
+1 28 STATERS
+1 23 TEN STATERS
+1 23 FIFTY STATERS
+1 23 ONE HUNDRED STATERS
+1 23 FIVE HUNDRED STATERS
+1 23 ONE THOUSAND STATERS
+1 23 TEN THOUSAND STATERS
+1 23 FIFTY THOUSAND STATERS
+1 23 TEN MNAS
+1 17 HERAEUM ONE PLETHRON
+1 17 THESPIAN ONE
+1 17 HERMIONIAN ONE
+1 17 EPIDAUREAN TWO
+1 17 THESPIAN TWO
+1 17 CYRENAIC TWO DRACHMAS
+1 17 EPIDAUREAN TWO DRACHMAS
+1 17 TROEZENIAN FIVE
+1 28 TEN
+1 31  ALTERNATE FORM
+1 17 HERMIONIAN TEN
+1 17 MESSENIAN TEN
+1 17 THESPIAN TEN
+1 27 HIRTY
+1 18 ROEZENIAN FIFTY
+1 33  ALTERNATE FORM
+1 17 HERMIONIAN FIFTY
+1 17 THESPIAN FIFTY
+1 26 ONE HUNDRED
+1 26 THREE HUNDRED
+1 17 EPIDAUREAN FIVE HUNDRED
+1 17 TROEZENIAN FIVE HUNDRED
+1 18 HESPIAN FIVE HUNDRED
+1 17 CARYSTIAN FIVE HUNDRED
+1 17 NAXIAN FIVE HUNDRED
+1 17 THESPIAN ONE THOUSAND
+1 26 FIVE THOUSAND
+1 17 DELPHIC FIVE MNAS
+1 17 STRATIAN FIFTY MNAS
+1 6 ONE HALF SIGN
+1 19  ALTERNATE FORM
+1 6 TWO THIRDS SIGN
+1 7 HREE QUARTERS SIGN
+1 6 YEAR SIGN
+1 6 TALENT SIGN
+1 6 DRACHMA SIGN
+1 6 OBOL SIGN
+1 6 TWO OBOLS SIGN
+1 7 HREE OBOLS SIGN
+1 6 FOUR OBOLS SIGN
+1 7 IVE OBOLS SIGN
+1 6 METRETES SIGN
+1 6 KYATHOS BASE SIGN
+1 6 LITRA SIGN
+1 6 OUNKIA SIGN
+1 6 XESTES SIGN
+1 6 ARTABE SIGN
+1 8 OURA SIGN
+1 6 GRAMMA SIGN
+1 6 TRYBLION BASE SIGN
+1 6 ZERO SIGN
+1 6 ONE QUARTER SIGN
+1 6 SINUSOID SIGN
+1 6 INDICTION SIGN
+1 0 NOMISMA SIGN
+2 0 ROMAN SEXTANS SIGN
+1 6 UNCIA SIGN
+1 6 SEMUNCIA SIGN
+1 8 XTULA SIGN
+1 6 DIMIDIA SEXTULA SIGN
+1 6 SILIQUA SIGN
+1 6 DENARIUS SIGN
+1 6 QUINARIUS SIGN
+1 6 SESTERTIUS SIGN
+1 6 DUPONDIUS SIGN
+1 6 AS SIGN
+1 6 CENTURIAL SIGN
+1 0 ASCIA SYMBOL
+4 0 GREEK SYMBOL TAU RHO
+30 0 PHAISTOS DISC SIGN PEDESTRIAN
+1 20 LUMED HEAD
+1 19 TATTOOED HEAD
+1 19 CAPTIVE
+1 20 HILD
+1 19 WOMAN
+1 19 HELMET
+1 19 GAUNTLET
+1 19 TIARA
+1 19 ARROW
+1 19 BOW
+1 19 SHIELD
+1 19 CLUB
+1 19 MANACLES
+1 21 TTOCK
+1 19 SAW
+1 19 LID
+1 19 BOOMERANG
+1 19 CARPENTRY PLANE
+1 19 DOLIUM
+1 19 COMB
+1 19 SLING
+1 19 COLUMN
+1 19 BEEHIVE
+1 19 SHIP
+1 19 HORN
+1 20 IDE
+1 19 BULLS LEG
+1 19 CAT
+1 19 RAM
+1 19 EAGLE
+1 19 DOVE
+1 19 TUNNY
+1 19 BEE
+1 19 PLANE TREE
+1 19 VINE
+1 19 PAPYRUS
+1 19 ROSETTE
+1 19 LILY
+1 19 OX BACK
+1 19 FLUTE
+1 19 GRATER
+1 19 STRAINER
+1 20 MALL AXE
+1 19 WAVY BAND
+1 19 COMBINING OBLIQUE STROKE
+83 0 LYCIAN LETTER A
+1 14 E
+1 14 B
+1 15 H
+1 14 G
+1 14 D
+1 14 I
+1 14 W
+1 14 Z
+1 14 TH
+1 14 J
+1 14 K
+1 14 Q
+1 14 L
+1 14 M
+1 14 N
+1 14 MM
+1 14 NN
+1 14 U
+1 14 P
+1 14 KK
+1 14 R
+1 14 S
+1 14 T
+1 15 T
+1 14 AN
+1 14 EN
+1 14 H
+1 14 X
+4 0 CARIAN LETTER A
+1 14 P2
+1 14 D
+1 14 L
+1 14 UUU
+1 14 R
+1 14 LD
+1 14 A2
+1 14 Q
+1 14 B
+1 14 M
+1 14 O
+1 14 D2
+1 14 T
+1 14 SH
+1 16 2
+1 15 
+1 14 C-18
+1 14 U
+1 14 NN
+1 14 X
+1 14 N
+1 14 TT2
+1 14 P
+1 14 SS
+1 14 I
+1 14 E
+1 14 UUUU
+1 14 K
+1 15 2
+1 14 ND
+1 14 UU
+1 14 G
+1 15 2
+1 14 ST
+1 16 2
+1 14 NG
+1 14 II
+1 14 C-39
+1 14 TT
+1 14 UUU2
+1 14 RR
+1 14 MB
+1 16 2
+1 16 3
+1 16 4
+1 14 LD2
+1 14 E2
+1 14 UUU3
+10 1 OPTIC EPACT THOUSANDS MARK
+1 13 DIGIT ONE
+1 19 TWO
+1 20 HREE
+1 19 FOUR
+1 20 IVE
+1 19 SIX
+1 20 EVEN
+1 19 EIGHT
+1 19 NINE
+1 13 NUMBER TEN
+1 21 WENTY
+1 21 HIRTY
+1 20 FORTY
+1 21 IFTY
+1 20 SIXTY
+1 21 EVENTY
+1 20 EIGHTY
+1 20 NINETY
+1 20 ONE HUNDRED
+1 20 TWO HUNDRED
+1 21 HREE HUNDRED
+1 20 FOUR HUNDRED
+1 21 IVE HUNDRED
+1 20 SIX HUNDRED
+1 21 EVEN HUNDRED
+1 20 EIGHT HUNDRED
+1 20 NINE HUNDRED
+5 0 OLD ITALIC LETTER A
+1 18 BE
+1 18 KE
+1 18 DE
+1 18 E
+1 18 VE
+1 18 ZE
+1 18 HE
+1 18 THE
+1 18 I
+1 18 KA
+1 18 EL
+1 19 M
+1 19 N
+1 19 SH
+1 18 O
+1 18 PE
+1 18 SHE
+1 18 KU
+1 18 ER
+1 19 S
+1 18 TE
+1 18 U
+1 18 EKS
+1 18 PHE
+1 18 KHE
+1 18 EF
+1 19 RS
+1 18 CHE
+1 18 II
+1 18 UU
+1 18 ESS
+1 11 NUMERAL ONE
+1 19 FIVE
+1 19 TEN
+1 19 FIFTY
+a 11 LETTER YE
+1 18 NORTHERN TSE
+1 18 SOUTHERN TSE
+1 0 GOTHIC LETTER AHSA
+1 14 BAIRKAN
+1 14 GIBA
+1 14 DAGS
+1 14 AIHVUS
+1 14 QAIRTHRA
+1 14 IUJA
+1 14 HAGL
+1 14 THIUTH
+1 14 EIS
+1 14 KUSMA
+1 14 LAGUS
+1 14 MANNA
+1 14 NAUTHS
+1 14 JER
+1 14 URUS
+1 14 PAIRTHRA
+1 14 NINETY
+1 14 RAIDA
+1 14 SAUIL
+1 14 TEIWS
+1 14 WINJA
+1 14 FAIHU
+1 14 IGGWS
+1 14 HWAIR
+1 14 OTHAL
+1 14 NINE HUNDRED
+6 0 OLD PERMIC LETTER AN
+1 18 BUR
+1 18 GAI
+1 18 DOI
+1 18 E
+1 18 ZHOI
+1 18 DZHOI
+1 18 ZATA
+1 18 DZITA
+1 18 I
+1 18 KOKE
+1 18 LEI
+1 18 MENOE
+1 18 NENOE
+1 18 VOOI
+1 18 PEEI
+1 18 REI
+1 18 SII
+1 18 TAI
+1 18 U
+1 18 CHERY
+1 18 SHOOI
+1 20 CHOOI
+1 18 YRY
+1 19 ERU
+1 18 O
+1 19 O
+1 18 EF
+1 18 HA
+1 18 TSIU
+1 18 VER
+1 18 YER
+1 21 I
+1 19 AT
+1 18 IE
+1 18 YU
+1 19 A
+1 18 IA
+1 0 COMBINING OLD PERMIC LETTER AN
+1 28 DOI
+1 28 ZATA
+1 28 NENOE
+1 28 SII
+6 0 UGARITIC LETTER ALPA
+1 16 BETA
+1 16 GAMLA
+1 16 KHA
+1 16 DELTA
+1 16 HO
+1 16 WO
+1 16 ZETA
+1 16 HOTA
+1 16 TET
+1 16 YOD
+1 16 KAF
+1 16 SHIN
+1 16 LAMDA
+1 16 MEM
+1 16 DHAL
+1 16 NUN
+1 16 ZU
+1 16 SAMKA
+1 16 AIN
+1 16 PU
+1 16 SADE
+1 16 QOPA
+1 16 RASHA
+1 16 THANNA
+1 16 GHAIN
+1 16 TO
+1 16 I
+1 16 U
+1 16 SSU
+2 9 WORD DIVIDER
+1 0 OLD PERSIAN SIGN A
+1 17 I
+1 17 U
+1 17 KA
+1 18 U
+1 17 GA
+1 18 U
+1 17 XA
+1 17 CA
+1 17 JA
+1 18 I
+1 17 TA
+1 18 U
+1 17 DA
+1 18 I
+1 18 U
+1 17 THA
+1 17 PA
+1 17 BA
+1 17 FA
+1 17 NA
+1 18 U
+1 17 MA
+1 18 I
+1 18 U
+1 17 YA
+1 17 VA
+1 18 I
+1 17 RA
+1 18 U
+1 17 LA
+1 17 SA
+1 17 ZA
+1 17 SHA
+1 18 SA
+1 17 HA
+5 17 AURAMAZDAA
+1 27 -2
+1 27 HA
+1 17 XSHAAYATHIYA
+1 17 DAHYAAUSH
+1 26 -2
+1 17 BAGA
+1 18 UUMISH
+1 12 WORD DIVIDER
+1 12 NUMBER ONE
+1 19 TWO
+1 20 EN
+1 20 WENTY
+1 19 HUNDRED
+2b 0 DESERET CAPITAL LETTER LONG I
+1 28 E
+1 28 A
+1 29 H
+1 28 O
+1 29 O
+1 23 SHORT I
+1 29 E
+1 29 A
+1 30 H
+1 29 O
+1 30 O
+1 23 AY
+1 23 OW
+1 23 WU
+1 23 YEE
+1 23 H
+1 23 PEE
+1 23 BEE
+1 23 TEE
+1 23 DEE
+1 23 CHEE
+1 23 JEE
+1 23 KAY
+1 23 GAY
+1 23 EF
+1 23 VEE
+1 23 ETH
+1 23 THEE
+1 23 ES
+1 23 ZEE
+1 23 ESH
+1 23 ZHEE
+1 23 ER
+1 24 L
+1 24 M
+1 24 N
+1 25 G
+1 23 OI
+1 23 EW
+1 8 SMALL LETTER LONG I
+1 26 E
+1 26 A
+1 27 H
+1 26 O
+1 27 O
+1 21 SHORT I
+1 27 E
+1 27 A
+1 28 H
+1 27 O
+1 28 O
+1 21 AY
+1 21 OW
+1 21 WU
+1 21 YEE
+1 21 H
+1 21 PEE
+1 21 BEE
+1 21 TEE
+1 21 DEE
+1 21 CHEE
+1 21 JEE
+1 21 KAY
+1 21 GAY
+1 21 EF
+1 21 VEE
+1 21 ETH
+1 21 THEE
+1 21 ES
+1 21 ZEE
+1 21 ESH
+1 21 ZHEE
+1 21 ER
+1 22 L
+1 22 M
+1 22 N
+1 23 G
+1 21 OI
+1 21 EW
+1 0 SHAVIAN LETTER PEEP
+1 15 TOT
+1 15 KICK
+1 15 FEE
+1 15 THIGH
+1 15 SO
+1 16 URE
+1 15 CHURCH
+1 15 YEA
+1 15 HUNG
+1 15 BIB
+1 15 DEAD
+1 15 GAG
+1 15 VOW
+1 15 THEY
+1 15 ZOO
+1 15 MEASURE
+1 15 JUDGE
+1 15 WOE
+1 15 HA-HA
+1 15 LOLL
+1 15 MIME
+1 15 IF
+1 15 EGG
+1 15 ASH
+1 16 DO
+1 15 ON
+1 15 WOOL
+1 15 OUT
+1 15 AH
+1 15 ROAR
+1 15 NUN
+1 15 EAT
+1 15 AGE
+1 15 ICE
+1 15 UP
+1 15 OAK
+1 16 OZE
+1 16 IL
+1 15 AWE
+1 16 RE
+1 15 OR
+1 15 AIR
+1 15 ERR
+1 15 ARRAY
+1 15 EAR
+1 15 IAN
+1 15 YEW
+1 0 OSMANYA LETTER ALEF
+1 15 BA
+1 15 TA
+1 15 JA
+1 15 XA
+1 15 KHA
+1 15 DEEL
+1 15 RA
+1 15 SA
+1 16 HIIN
+1 15 DHA
+1 15 CAYN
+1 15 GA
+1 15 FA
+1 15 QAAF
+1 15 KAAF
+1 15 LAAN
+1 15 MIIN
+1 15 NUUN
+1 15 WAW
+1 15 HA
+1 15 YA
+1 15 A
+1 15 E
+1 15 I
+1 15 O
+1 15 U
+1 15 AA
+1 15 EE
+1 15 OO
+3 8 DIGIT ZERO
+1 14 ONE
+1 14 TWO
+1 15 HREE
+1 14 FOUR
+1 15 IVE
+1 14 SIX
+1 15 EVEN
+1 14 EIGHT
+1 14 NINE
+7 2 AGE CAPITAL LETTER A
+1 22 I
+1 23 N
+1 22 H
+1 21 BRA
+1 21 CHA
+1 21 EHCHA
+1 22 
+1 22 IN
+1 21 HA
+1 22 YA
+1 21 I
+1 21 KA
+1 21 EHKA
+1 21 KYA
+1 21 LA
+1 21 MA
+1 21 NA
+1 21 O
+1 22 IN
+1 21 PA
+1 21 EHPA
+1 21 SA
+1 22 HA
+1 21 TA
+1 21 EHTA
+1 21 TSA
+1 21 EHTSA
+1 21 TSHA
+1 21 DHA
+1 21 U
+1 21 WA
+1 21 KHA
+1 21 GHA
+1 21 ZA
+1 22 HA
+5 6 SMALL LETTER A
+1 20 I
+1 21 N
+1 20 H
+1 19 BRA
+1 19 CHA
+1 19 EHCHA
+1 20 
+1 20 IN
+1 19 HA
+1 20 YA
+1 19 I
+1 19 KA
+1 19 EHKA
+1 19 KYA
+1 19 LA
+1 19 MA
+1 19 NA
+1 19 O
+1 20 IN
+1 19 PA
+1 19 EHPA
+1 19 SA
+1 20 HA
+1 19 TA
+1 19 EHTA
+1 19 TSA
+1 19 EHTSA
+1 19 TSHA
+1 19 DHA
+1 19 U
+1 19 WA
+1 19 KHA
+1 19 GHA
+1 19 ZA
+1 20 HA
+5 0 ELBASAN LETTER A
+1 15 BE
+1 15 CE
+1 16 HE
+1 15 DE
+1 15 NDE
+1 15 DHE
+1 15 EI
+1 16 
+1 15 FE
+1 15 GE
+1 16 JE
+1 15 HE
+1 15 I
+1 15 JE
+1 15 KE
+1 15 LE
+1 16 LE
+1 15 ME
+1 15 NE
+1 16 A
+1 16 JE
+1 15 O
+1 15 PE
+1 15 QE
+1 15 RE
+1 16 RE
+1 15 SE
+1 16 HE
+1 15 TE
+1 16 HE
+1 15 U
+1 15 VE
+1 15 XE
+1 15 Y
+1 15 ZE
+1 16 HE
+1 15 GHE
+1 17 AMMA
+1 15 KHE
+9 0 CAUCASIAN ALBANIAN LETTER ALT
+1 26 BET
+1 26 GIM
+1 26 DAT
+1 26 EB
+1 26 ZARL
+1 26 EYN
+1 26 ZHIL
+1 26 TAS
+1 26 CHA
+1 26 YOWD
+1 26 ZHA
+1 26 IRB
+1 26 SHA
+1 26 LAN
+1 26 INYA
+1 26 XEYN
+1 26 DYAN
+1 26 CAR
+1 26 JHOX
+1 26 KAR
+1 26 LYIT
+1 26 HEYT
+1 26 QAY
+1 26 AOR
+1 26 CHOY
+1 28 I
+1 27 YAY
+1 26 MAQ
+1 26 QAR
+1 26 NOWC
+1 26 DZYAY
+1 26 SHAK
+1 26 JAYN
+1 26 ON
+1 26 TYAY
+1 26 FAM
+1 26 DZAY
+1 26 CHAT
+1 26 PEN
+1 26 GHEYS
+1 26 RAT
+1 26 SEYK
+1 26 VEYZ
+1 26 TIWR
+1 26 SHOY
+1 26 IWN
+1 26 CYAW
+1 27 AYN
+1 26 YAYD
+1 26 PIWR
+1 26 KIW
+c 19 CITATION MARK
+1 0 VITHKUQI CAPITAL LETTER A
+1 24 BBE
+1 25 E
+1 24 CE
+1 25 HE
+1 24 DE
+1 25 HE
+1 24 EI
+1 25 
+1 24 FE
+1 24 GA
+2 24 HA
+1 25 HA
+1 24 I
+1 25 JE
+1 24 JE
+1 24 KA
+1 24 LA
+1 25 LA
+1 24 ME
+1 24 NE
+1 25 JE
+1 24 O
+1 24 PE
+1 24 QA
+1 24 RE
+2 24 SE
+1 25 HE
+1 24 TE
+1 25 HE
+1 24 U
+1 24 VE
+1 24 XE
+2 24 Y
+1 24 ZE
+2 9 SMALL LETTER A
+1 22 BBE
+1 23 E
+1 22 CE
+1 23 HE
+1 22 DE
+1 23 HE
+1 22 EI
+1 23 
+1 22 FE
+1 22 GA
+2 22 HA
+1 23 HA
+1 22 I
+1 23 JE
+1 22 JE
+1 22 KA
+1 22 LA
+1 23 LA
+1 22 ME
+1 22 NE
+1 23 JE
+1 22 O
+1 22 PE
+1 22 QA
+1 22 RE
+2 22 SE
+1 23 HE
+1 22 TE
+1 23 HE
+1 22 U
+1 22 VE
+1 22 XE
+2 22 Y
+1 22 ZE
+44 0 LINEAR A SIGN AB001
+1 18 2
+1 18 3
+1 18 4
+1 18 5
+1 18 6
+1 18 7
+1 18 8
+1 18 9
+1 17 10
+1 18 1
+1 18 3
+1 18 6
+1 18 7
+1 17 20
+1 18 1
+1 19 F
+1 19 M
+1 18 2
+1 19 F
+1 19 M
+1 18 3
+1 19 M
+1 18 4
+1 18 6
+1 18 7
+1 18 8
+1 15 028B
+1 15 B029
+1 17 30
+1 18 1
+1 18 4
+1 18 7
+1 18 8
+1 18 9
+1 17 40
+1 18 1
+1 18 4
+1 18 5
+1 18 6
+1 18 7
+1 18 8
+1 18 9
+1 17 50
+1 18 1
+1 18 3
+1 18 4
+1 18 5
+1 18 6
+1 18 7
+1 18 8
+1 18 9
+1 17 60
+1 18 1
+1 18 5
+1 18 6
+1 18 7
+1 18 9
+1 17 70
+1 18 3
+1 18 4
+1 18 6
+1 18 7
+1 18 8
+1 18 9
+1 17 80
+1 18 1
+1 18 2
+1 18 5
+1 18 6
+1 18 7
+1 15 100-102
+1 15 B118
+1 17 20
+1 15 120B
+1 15 B122
+1 18 3
+1 17 31A
+1 19 B
+1 15 131C
+1 15 B164
+1 17 71
+1 17 80
+1 18 8
+1 17 91
+1 15 301
+1 17 2
+1 17 3
+1 17 4
+1 17 5
+1 17 6
+1 17 7
+1 17 8
+1 17 9A
+1 18 B
+1 18 C
+1 16 10
+1 17 1
+1 17 2
+1 17 3A
+1 18 B
+1 18 C
+1 17 4
+1 17 5
+1 17 6
+1 17 7
+1 17 8
+1 17 9
+1 16 20
+1 17 1
+1 17 2
+1 17 3
+1 17 4
+1 17 5
+1 17 6
+1 17 7
+1 17 8
+1 17 9
+1 16 30
+1 17 1
+1 17 2
+1 17 3
+1 17 4
+1 17 5
+1 17 6
+1 17 7
+1 17 8
+1 17 9
+1 16 40
+1 17 1
+1 17 2
+1 17 3
+1 17 4
+1 17 5
+1 17 6
+1 17 7
+1 17 8
+1 17 9
+1 16 50
+1 17 1
+1 17 2
+1 17 3
+1 17 4
+1 17 5
+1 17 6
+1 17 7
+1 17 8
+1 17 9
+1 16 60
+1 17 1
+1 17 2
+1 17 3
+1 17 4
+1 17 5
+1 17 6
+1 17 7
+1 17 8
+1 17 9
+1 16 70
+1 17 1
+1 15 400-VAS
+1 17 1-VAS
+1 17 2-VAS
+1 17 3-VAS
+1 17 4-VAS
+1 17 5-VAS
+1 17 6-VAS
+1 17 7-VAS
+1 17 8-VAS
+1 17 9-VAS
+1 16 10-VAS
+1 17 1-VAS
+1 17 2-VAS
+1 17 3-VAS
+1 17 4-VAS
+1 17 5-VAS
+1 17 6-VAS
+1 17 7-VAS
+1 17 8-VAS
+1 15 501
+1 17 2
+1 17 3
+1 17 4
+1 17 5
+1 17 6
+1 17 8
+1 17 9
+1 16 10
+1 17 1
+1 17 2
+1 17 3
+1 17 5
+1 17 6
+1 16 20
+1 17 1
+1 17 3
+1 17 4
+1 17 5
+1 17 6
+1 17 7
+1 17 8
+1 17 9
+1 16 30
+1 17 1
+1 17 2
+1 17 4
+1 17 5
+1 17 6
+1 17 7
+1 17 8
+1 17 9
+1 16 40
+1 17 1
+1 17 2
+1 17 5
+1 17 7
+1 17 8
+1 17 9
+1 16 50
+1 17 1
+1 17 2
+1 17 3
+1 17 4
+1 17 5
+1 17 6
+1 17 7
+1 17 9
+1 16 63
+1 17 4
+1 17 5
+1 17 6
+1 17 8
+1 17 9
+1 16 70
+1 17 1
+1 17 2
+1 17 3
+1 17 4
+1 17 5
+1 17 6
+1 17 7
+1 17 8
+1 17 9
+1 16 80
+1 17 1
+1 17 2
+1 17 3
+1 17 4
+1 17 5
+1 17 6
+1 17 7
+1 17 8
+1 17 9
+1 16 91
+1 17 2
+1 17 4
+1 17 5
+1 17 6
+1 17 8
+1 15 600
+1 17 1
+1 17 2
+1 17 3
+1 17 4
+1 17 6
+1 17 8
+1 17 9
+1 16 10
+1 17 1
+1 17 2
+1 17 3
+1 17 4
+1 17 5
+1 17 6
+1 17 7
+1 17 8
+1 17 9
+1 16 20
+1 17 1
+1 17 2
+1 17 3
+1 17 4
+1 17 6
+1 17 7
+1 17 8
+1 17 9
+1 16 34
+1 17 7
+1 17 8
+1 16 40
+1 17 2
+1 17 3
+1 17 4
+1 17 5
+1 17 6
+1 17 8
+1 17 9
+1 16 51
+1 17 2
+1 17 3
+1 17 4
+1 17 5
+1 17 6
+1 17 7
+1 17 8
+1 17 9
+1 16 60
+1 17 1
+1 17 2
+1 17 3
+1 17 4
+a 15 701 A
+1 17 2 B
+1 17 3 D
+1 17 4 E
+1 17 5 F
+1 17 6 H
+1 17 7 J
+1 17 8 K
+1 17 9 L
+1 18 -2 L2
+1 19 3 L3
+1 19 4 L4
+1 19 6 L6
+1 16 10 W
+1 17 1 X
+1 17 2 Y
+1 17 3 OMEGA
+1 17 4 ABB
+1 17 5 BB
+1 17 7 DD
+1 16 26 EYYY
+1 16 32 JE
+b 15 800
+1 17 1
+1 17 2
+1 17 3
+1 17 4
+1 17 5
+1 17 6
+1 17 7
+19 0 MODIFIER LETTER SMALL CAPITAL AA
+1 17 UPERSCRIPT TRIANGULAR COLON
+1 28 HALF TRIANGULAR COLON
+1 17 MALL AE
+1 22 CAPITAL B
+1 22 B WITH HOOK
+2 22 DZ DIGRAPH
+1 32  WITH RETROFLEX HOOK
+1 38 CURL
+1 23 EZH DIGRAPH
+1 23  WITH TAIL
+1 29 HOOK
+1 33  AND TAIL
+1 22 REVERSED E
+1 22 CLOSED REVERSED OPEN E
+1 22 FENG DIGRAPH
+1 22 RAMS HORN
+1 22 CAPITAL G
+1 22 G WITH HOOK
+1 22 CAPITAL G WITH HOOK
+1 22 H WITH STROKE
+1 22 CAPITAL H
+1 22 HENG WITH HOOK
+1 22 DOTLESS J WITH STROKE AND HOOK
+1 22 LS DIGRAPH
+1 23 Z DIGRAPH
+1 23  WITH BELT
+1 22 CAPITAL L WITH BELT
+1 22 L WITH RETROFLEX HOOK AND BELT
+1 23 EZH
+1 26  WITH RETROFLEX HOOK
+1 22 TURNED Y
+1 30  WITH BELT
+1 22 O WITH STROKE
+1 22 CAPITAL OE
+1 23 LOSED OMEGA
+1 22 Q
+1 22 TURNED R WITH LONG LEG
+1 44  AND RETROFLEX HOOK
+1 22 R WITH TAIL
+1 29 FISHHOOK
+1 22 CAPITAL R
+1 22 TC DIGRAPH WITH CURL
+1 23 S DIGRAPH
+1 32  WITH RETROFLEX HOOK
+1 23 ESH DIGRAPH
+1 23  WITH RETROFLEX HOOK
+1 22 V WITH RIGHT HOOK
+2 22 CAPITAL Y
+1 16 GLOTTAL STOP WITH STROKE
+1 16 REVERSED GLOTTAL STOP WITH STROKE
+1 16 BILABIAL CLICK
+1 16 DENTAL CLICK
+1 16 LATERAL CLICK
+1 16 ALVEOLAR CLICK
+1 16 RETROFLEX CLICK WITH RETROFLEX HOOK
+1 16 SMALL S WITH CURL
+46 0 CYPRIOT SYLLABLE A
+1 17 E
+1 17 I
+1 17 O
+1 17 U
+1 17 JA
+3 18 O
+2 17 KA
+1 18 E
+1 18 I
+1 18 O
+1 18 U
+1 17 LA
+1 18 E
+1 18 I
+1 18 O
+1 18 U
+1 17 MA
+1 18 E
+1 18 I
+1 18 O
+1 18 U
+1 17 NA
+1 18 E
+1 18 I
+1 18 O
+1 18 U
+1 17 PA
+1 18 E
+1 18 I
+1 18 O
+1 18 U
+1 17 RA
+1 18 E
+1 18 I
+1 18 O
+1 18 U
+1 17 SA
+1 18 E
+1 18 I
+1 18 O
+1 18 U
+1 17 TA
+1 18 E
+1 18 I
+1 18 O
+1 18 U
+1 17 WA
+1 18 E
+1 18 I
+1 18 O
+2 17 XA
+1 18 E
+4 17 ZA
+3 18 O
+1 0 IMPERIAL ARAMAIC LETTER ALEPH
+1 24 BETH
+1 24 GIMEL
+1 24 DALETH
+1 24 HE
+1 24 WAW
+1 24 ZAYIN
+1 24 HETH
+1 24 TETH
+1 24 YODH
+1 24 KAPH
+1 24 LAMEDH
+1 24 MEM
+1 24 NUN
+1 24 SAMEKH
+1 24 AYIN
+1 24 PE
+1 24 SADHE
+1 24 QOPH
+1 24 RESH
+1 24 SHIN
+1 24 TAW
+2 17 SECTION SIGN
+1 17 NUMBER ONE
+1 24 TWO
+1 25 HREE
+1 25 EN
+1 25 WENTY
+1 24 ONE HUNDRED
+1 28 THOUSAND
+1 24 TEN THOUSAND
+1 0 PALMYRENE LETTER ALEPH
+1 17 BETH
+1 17 GIMEL
+1 17 DALETH
+1 17 HE
+1 17 WAW
+1 17 ZAYIN
+1 17 HETH
+1 17 TETH
+1 17 YODH
+1 17 KAPH
+1 17 LAMEDH
+1 17 MEM
+1 17 FINAL NUN
+1 17 NUN
+1 17 SAMEKH
+1 17 AYIN
+1 17 PE
+1 17 SADHE
+1 17 QOPH
+1 17 RESH
+1 17 SHIN
+1 17 TAW
+1 12 FT-POINTING FLEURON
+1 10 RIGHT-POINTING FLEURON
+1 10 NUMBER ONE
+1 17 TWO
+1 18 HREE
+1 17 FOUR
+1 18 IVE
+1 17 TEN
+1 18 WENTY
+1 0 NABATAEAN LETTER FINAL ALEPH
+1 17 ALEPH
+1 17 FINAL BETH
+1 17 BETH
+1 17 GIMEL
+1 17 DALETH
+1 17 FINAL HE
+1 17 HE
+1 17 WAW
+1 17 ZAYIN
+1 17 HETH
+1 17 TETH
+1 17 FINAL YODH
+1 17 YODH
+1 17 FINAL KAPH
+1 17 KAPH
+1 17 FINAL LAMEDH
+1 17 LAMEDH
+1 17 FINAL MEM
+1 17 MEM
+1 17 FINAL NUN
+1 17 NUN
+1 17 SAMEKH
+1 17 AYIN
+1 17 PE
+1 17 SADHE
+1 17 QOPH
+1 17 RESH
+1 17 FINAL SHIN
+1 17 SHIN
+1 17 TAW
+9 10 NUMBER ONE
+1 17 TWO
+1 18 HREE
+1 17 FOUR
+1 10 CRUCIFORM NUMBER FOUR
+1 10 NUMBER FIVE
+1 17 TEN
+1 18 WENTY
+1 17 ONE HUNDRED
+31 0 HATRAN LETTER ALEPH
+1 14 BETH
+1 14 GIMEL
+1 14 DALETH-RESH
+1 14 HE
+1 14 WAW
+1 14 ZAYN
+1 14 HETH
+1 14 TETH
+1 14 YODH
+1 14 KAPH
+1 14 LAMEDH
+1 14 MEM
+1 14 NUN
+1 14 SAMEKH
+1 14 AYN
+1 14 PE
+1 14 SADHE
+1 14 QOPH
+2 14 SHIN
+1 14 TAW
+6 7 NUMBER ONE
+1 14 FIVE
+1 14 TEN
+1 15 WENTY
+1 14 ONE HUNDRED
+1 0 PHOENICIAN LETTER ALF
+1 18 BET
+1 18 GAML
+1 18 DELT
+1 18 HE
+1 18 WAU
+1 18 ZAI
+1 18 HET
+1 18 TET
+1 18 YOD
+1 18 KAF
+1 18 LAMD
+1 18 MEM
+1 18 NUN
+1 18 SEMK
+1 18 AIN
+1 18 PE
+1 18 SADE
+1 18 QOF
+1 18 ROSH
+1 18 SHIN
+1 18 TAU
+1 11 NUMBER ONE
+1 18 TEN
+1 19 WENTY
+1 18 ONE HUNDRED
+1 18 TWO
+1 19 HREE
+4 11 WORD SEPARATOR
+1 0 LYDIAN LETTER A
+1 14 B
+1 14 G
+1 14 D
+1 14 E
+1 14 V
+1 14 I
+1 14 Y
+1 14 K
+1 14 L
+1 14 M
+1 14 N
+1 14 O
+1 14 R
+1 14 SS
+1 14 T
+1 14 U
+1 14 F
+1 14 Q
+1 14 S
+1 14 TT
+1 14 AN
+1 14 EN
+1 14 LY
+1 14 NN
+1 14 C
+6 7 TRIANGULAR MARK
+41 0 MEROITIC HIEROGLYPHIC LETTER A
+1 29 E
+1 29 I
+1 29 O
+1 29 YA
+1 29 WA
+1 29 BA
+1 31 -2
+1 29 PA
+1 29 MA
+1 29 NA
+1 31 -2
+1 30 E
+1 31 -2
+1 29 RA
+1 31 -2
+1 29 LA
+1 29 KHA
+1 29 HHA
+1 29 SA
+1 31 -2
+1 30 E
+1 29 KA
+1 29 QA
+1 29 TA
+1 31 -2
+1 30 E
+1 31 -2
+1 30 O
+1 29 DA
+1 22 SYMBOL VIDJ
+1 33 -2
+1 9 CURSIVE LETTER A
+1 24 E
+1 24 I
+1 24 O
+1 24 YA
+1 24 WA
+1 24 BA
+1 24 PA
+1 24 MA
+1 24 NA
+1 25 E
+1 24 RA
+1 24 LA
+1 24 KHA
+1 24 HHA
+1 24 SA
+1 24 ARCHAIC SA
+1 24 SE
+1 24 KA
+1 24 QA
+1 24 TA
+1 25 E
+1 25 O
+1 24 DA
+5 17 FRACTION ELEVEN TWELFTHS
+1 26 ONE HALF
+1 17 LOGOGRAM RMT
+1 26 IMN
+1 17 NUMBER ONE
+1 24 TWO
+1 25 HREE
+1 24 FOUR
+1 25 IVE
+1 24 SIX
+1 25 EVEN
+1 24 EIGHT
+1 24 NINE
+1 24 TEN
+1 25 WENTY
+1 25 HIRTY
+1 24 FORTY
+1 25 IFTY
+1 24 SIXTY
+1 25 EVENTY
+3 24 ONE HUNDRED
+1 24 TWO HUNDRED
+1 25 HREE HUNDRED
+1 24 FOUR HUNDRED
+1 25 IVE HUNDRED
+1 24 SIX HUNDRED
+1 25 EVEN HUNDRED
+1 24 EIGHT HUNDRED
+1 24 NINE HUNDRED
+1 24 ONE THOUSAND
+1 24 TWO THOUSAND
+1 25 HREE THOUSAND
+1 24 FOUR THOUSAND
+1 25 IVE THOUSAND
+1 24 SIX THOUSAND
+1 25 EVEN THOUSAND
+1 24 EIGHT THOUSAND
+1 24 NINE THOUSAND
+1 24 TEN THOUSAND
+1 25 WENTY THOUSAND
+1 25 HIRTY THOUSAND
+1 24 FORTY THOUSAND
+1 25 IFTY THOUSAND
+1 24 SIXTY THOUSAND
+1 25 EVENTY THOUSAND
+1 24 EIGHTY THOUSAND
+1 24 NINETY THOUSAND
+1 24 ONE HUNDRED THOUSAND
+1 24 TWO HUNDRED THOUSAND
+1 25 HREE HUNDRED THOUSAND
+1 24 FOUR HUNDRED THOUSAND
+1 25 IVE HUNDRED THOUSAND
+1 24 SIX HUNDRED THOUSAND
+1 25 EVEN HUNDRED THOUSAND
+1 24 EIGHT HUNDRED THOUSAND
+1 24 NINE HUNDRED THOUSAND
+1 17 FRACTION ONE TWELFTH
+1 26 TWO TWELFTHS
+1 27 HREE TWELFTHS
+1 26 FOUR TWELFTHS
+1 27 IVE TWELFTHS
+1 26 SIX TWELFTHS
+1 27 EVEN TWELFTHS
+1 26 EIGHT TWELFTHS
+1 26 NINE TWELFTHS
+1 26 TEN TWELFTHS
+1 0 KHAROSHTHI LETTER A
+1 11 VOWEL SIGN I
+1 22 U
+1 22 VOCALIC R
+2 22 E
+1 22 O
+6 17 LENGTH MARK
+1 11 SIGN DOUBLE RING BELOW
+1 16 ANUSVARA
+1 16 VISARGA
+1 11 LETTER KA
+1 19 HA
+1 18 GA
+1 19 HA
+2 18 CA
+1 19 HA
+1 18 JA
+2 18 NYA
+1 18 TTA
+1 20 HA
+1 18 DDA
+1 20 HA
+1 18 NNA
+1 18 TA
+1 19 HA
+1 18 DA
+1 19 HA
+1 18 NA
+1 18 PA
+1 19 HA
+1 18 BA
+1 19 HA
+1 18 MA
+1 18 YA
+1 18 RA
+1 18 LA
+1 18 VA
+1 18 SHA
+1 19 SA
+1 19 A
+1 18 ZA
+1 18 HA
+1 18 KKA
+1 18 TTTHA
+1 21 A
+1 18 VHA
+3 11 SIGN BAR ABOVE
+1 16 CAUDA
+1 16 DOT BELOW
+5 11 VIRAMA
+1 11 DIGIT ONE
+1 17 TWO
+1 18 HREE
+1 17 FOUR
+1 11 NUMBER TEN
+1 19 WENTY
+1 18 ONE HUNDRED
+1 22 THOUSAND
+1 11 FRACTION ONE HALF
+8 11 PUNCTUATION DOT
+1 23 SMALL CIRCLE
+1 23 CIRCLE
+1 24 RESCENT BAR
+1 23 MANGALAM
+1 23 LOTUS
+1 23 DANDA
+1 24 OUBLE DANDA
+1 23 LINES
+8 0 OLD SOUTH ARABIAN LETTER HE
+1 25 LAMEDH
+1 25 HETH
+1 25 MEM
+1 25 QOPH
+1 25 WAW
+1 25 SHIN
+1 25 RESH
+1 25 BETH
+1 25 TAW
+1 25 SAT
+1 25 KAPH
+1 25 NUN
+1 25 KHETH
+1 25 SADHE
+1 27 MEKH
+1 25 FE
+1 25 ALEF
+1 26 YN
+1 25 DHADHE
+1 25 GIMEL
+1 25 DALETH
+1 25 GHAYN
+1 25 TETH
+1 25 ZAYN
+1 25 DHALETH
+1 25 YODH
+1 25 THAW
+1 27 ETH
+1 18 NUMBER ONE
+1 25 FIFTY
+1 21 ERIC INDICATOR
+1 4 NORTH ARABIAN LETTER HEH
+1 25 LAM
+1 25 HAH
+1 25 MEEM
+1 25 QAF
+1 25 WAW
+1 25 ES-2
+1 25 REH
+1 25 BEH
+1 25 TEH
+1 25 ES-1
+1 25 KAF
+1 25 NOON
+1 25 KHAH
+1 25 SAD
+1 25 ES-3
+1 25 FEH
+1 25 ALEF
+1 26 IN
+1 25 DAD
+1 25 GEEM
+1 25 DAL
+1 25 GHAIN
+1 25 TAH
+1 25 ZAIN
+1 25 THAL
+1 25 YEH
+1 25 THEH
+1 25 ZAH
+1 18 NUMBER ONE
+1 25 TEN
+1 26 WENTY
+21 0 MANICHAEAN LETTER ALEPH
+1 18 BETH
+1 19 HETH
+1 18 GIMEL
+1 19 HIMEL
+1 18 DALETH
+1 18 HE
+1 18 WAW
+1 11 SIGN UD
+1 11 LETTER ZAYIN
+1 19 HAYIN
+1 18 JAYIN
+1 19 HAYIN
+1 18 HETH
+1 18 TETH
+1 18 YODH
+1 18 KAPH
+1 18 XAPH
+1 18 KHAPH
+1 18 LAMEDH
+1 18 DHAMEDH
+1 18 THAMEDH
+1 18 MEM
+1 18 NUN
+1 18 SAMEKH
+1 18 AYIN
+1 19 AYIN
+1 18 PE
+1 18 FE
+1 18 SADHE
+1 18 QOPH
+1 18 XOPH
+1 18 QHOPH
+1 18 RESH
+1 18 SHIN
+1 19 SHIN
+1 18 TAW
+1 11 ABBREVIATION MARK ABOVE
+1 29 BELOW
+5 11 NUMBER ONE
+1 18 FIVE
+1 18 TEN
+1 19 WENTY
+1 18 ONE HUNDRED
+1 11 PUNCTUATION STAR
+1 23 FLEURON
+1 23 DOUBLE DOT WITHIN DOT
+1 25 T WITHIN DOT
+1 26 
+1 23 TWO DOTS
+1 23 LINE FILLER
+a 0 AVESTAN LETTER A
+1 16 A
+1 16 O
+1 16 AO
+1 16 N
+1 16 AN
+1 16 E
+1 17 E
+1 15 E
+1 16 E
+1 15 O
+1 16 O
+1 15 I
+1 16 I
+1 15 U
+1 16 U
+1 15 KE
+1 15 XE
+1 16 YE
+1 16 VE
+1 15 GE
+1 16 GE
+1 16 HE
+1 15 CE
+1 15 JE
+1 15 TE
+1 16 HE
+1 15 DE
+1 16 HE
+1 15 TTE
+1 15 PE
+1 15 FE
+1 15 BE
+1 16 HE
+1 15 NGE
+1 17 YE
+1 17 VE
+1 16 E
+1 16 YE
+1 16 NE
+1 15 ME
+1 15 HME
+1 15 YYE
+1 16 E
+1 15 VE
+1 15 RE
+1 15 LE
+1 15 SE
+1 15 ZE
+1 15 SHE
+1 15 ZHE
+1 15 SHYE
+1 16 SHE
+1 15 HE
+4 8 ABBREVIATION MARK
+1 0 TINY TWO DOTS OVER ONE DOT PUNCTUATION
+1 0 SMALL TWO DOTS OVER ONE DOT PUNCTUATION
+1 0 LARGE TWO DOTS OVER ONE DOT PUNCTUATION
+1 6 ONE DOT OVER TWO DOTS PUNCTUATION
+1 6 TWO RINGS OVER ONE RING PUNCTUATION
+1 6 ONE RING OVER TWO RINGS PUNCTUATION
+1 0 INSCRIPTIONAL PARTHIAN LETTER ALEPH
+1 30 BETH
+1 30 GIMEL
+1 30 DALETH
+1 30 HE
+1 30 WAW
+1 30 ZAYIN
+1 30 HETH
+1 30 TETH
+1 30 YODH
+1 30 KAPH
+1 30 LAMEDH
+1 30 MEM
+1 30 NUN
+1 30 SAMEKH
+1 30 AYIN
+1 30 PE
+1 30 SADHE
+1 30 QOPH
+1 30 RESH
+1 30 SHIN
+1 30 TAW
+3 23 NUMBER ONE
+1 30 TWO
+1 31 HREE
+1 30 FOUR
+1 30 TEN
+1 31 WENTY
+1 30 ONE HUNDRED
+1 34 THOUSAND
+1 16 HLAVI LETTER ALEPH
+1 29 BETH
+1 29 GIMEL
+1 29 DALETH
+1 29 HE
+1 29 WAW-AYIN-RESH
+1 29 ZAYIN
+1 29 HETH
+1 29 TETH
+1 29 YODH
+1 29 KAPH
+1 29 LAMEDH
+1 29 MEM-QOPH
+1 29 NUN
+1 29 SAMEKH
+1 29 PE
+1 29 SADHE
+1 30 HIN
+1 29 TAW
+6 22 NUMBER ONE
+1 29 TWO
+1 30 HREE
+1 29 FOUR
+1 29 TEN
+1 30 WENTY
+1 29 ONE HUNDRED
+1 33 THOUSAND
+1 0 PSALTER PAHLAVI LETTER ALEPH
+1 23 BETH
+1 23 GIMEL
+1 23 DALETH
+1 23 HE
+1 23 WAW-AYIN-RESH
+1 23 ZAYIN
+1 23 HETH
+1 23 YODH
+1 23 KAPH
+1 23 LAMEDH
+1 23 MEM-QOPH
+1 23 NUN
+1 23 SAMEKH
+1 23 PE
+1 23 SADHE
+1 24 HIN
+1 23 TAW
+8 16 SECTION MARK
+1 16 TURNED SECTION MARK
+1 16 FOUR DOTS WITH CROSS
+1 31 DOT
+d 16 NUMBER ONE
+1 23 TWO
+1 24 HREE
+1 23 FOUR
+1 23 TEN
+1 24 WENTY
+1 23 ONE HUNDRED
+51 0 OLD TURKIC LETTER ORKHON A
+1 18 YENISEI A
+1 27 E
+1 18 ORKHON I
+1 18 YENISEI I
+1 26 E
+1 18 ORKHON O
+1 26 E
+1 18 YENISEI OE
+1 18 ORKHON AB
+1 18 YENISEI AB
+1 18 ORKHON AEB
+1 18 YENISEI AEB
+1 18 ORKHON AG
+1 18 YENISEI AG
+1 18 ORKHON AEG
+1 18 YENISEI AEG
+1 18 ORKHON AD
+1 18 YENISEI AD
+1 18 ORKHON AED
+1 25 EZ
+1 18 YENISEI EZ
+1 18 ORKHON AY
+1 18 YENISEI AY
+1 18 ORKHON AEY
+1 18 YENISEI AEY
+1 18 ORKHON AEK
+1 18 YENISEI AEK
+1 18 ORKHON OEK
+1 18 YENISEI OEK
+1 18 ORKHON AL
+1 18 YENISEI AL
+1 18 ORKHON AEL
+1 25 ELT
+1 26 M
+1 25 AN
+1 26 EN
+1 18 YENISEI AEN
+1 18 ORKHON ENT
+1 18 YENISEI ENT
+1 18 ORKHON ENC
+1 18 YENISEI ENC
+1 18 ORKHON ENY
+1 18 YENISEI ENY
+1 26 ANG
+1 18 ORKHON ENG
+1 18 YENISEI AENG
+1 18 ORKHON EP
+1 25 OP
+1 25 IC
+1 25 EC
+1 18 YENISEI EC
+1 18 ORKHON AQ
+1 18 YENISEI AQ
+1 18 ORKHON IQ
+1 18 YENISEI IQ
+1 18 ORKHON OQ
+1 18 YENISEI OQ
+1 18 ORKHON AR
+1 18 YENISEI AR
+1 18 ORKHON AER
+1 26 S
+1 26 ES
+1 26 SH
+1 18 YENISEI ASH
+1 18 ORKHON ESH
+1 18 YENISEI ESH
+1 18 ORKHON AT
+1 18 YENISEI AT
+1 18 ORKHON AET
+1 18 YENISEI AET
+1 18 ORKHON OT
+1 25 BASH
+38 4 HUNGARIAN CAPITAL LETTER A
+1 30 A
+1 29 EB
+1 29 AMB
+1 29 EC
+1 30 NC
+1 30 CS
+1 30 D
+1 29 AND
+1 29 E
+1 29 CLOSE E
+1 29 EE
+1 30 F
+1 30 G
+1 31 Y
+1 30 H
+1 29 I
+1 30 I
+1 29 EJ
+1 30 K
+1 29 AK
+1 29 UNK
+1 29 EL
+1 31 Y
+1 30 M
+1 30 N
+1 31 Y
+1 29 O
+1 30 O
+1 29 NIKOLSBURG OE
+1 29 RUDIMENTA OE
+1 29 OEE
+1 29 EP
+1 30 MP
+1 30 R
+1 29 SHORT ER
+1 29 ES
+1 31 Z
+1 30 T
+1 30 NT
+1 30 TY
+1 30 CH
+1 29 U
+1 30 U
+1 29 NIKOLSBURG UE
+1 29 RUDIMENTA UE
+1 29 EV
+1 30 Z
+1 31 S
+1 30 NT-SHAPED SIGN
+1 29 US
+e 14 SMALL LETTER A
+1 28 A
+1 27 EB
+1 27 AMB
+1 27 EC
+1 28 NC
+1 28 CS
+1 28 D
+1 27 AND
+1 27 E
+1 27 CLOSE E
+1 27 EE
+1 28 F
+1 28 G
+1 29 Y
+1 28 H
+1 27 I
+1 28 I
+1 27 EJ
+1 28 K
+1 27 AK
+1 27 UNK
+1 27 EL
+1 29 Y
+1 28 M
+1 28 N
+1 29 Y
+1 27 O
+1 28 O
+1 27 NIKOLSBURG OE
+1 27 RUDIMENTA OE
+1 27 OEE
+1 27 EP
+1 28 MP
+1 28 R
+1 27 SHORT ER
+1 27 ES
+1 29 Z
+1 28 T
+1 28 NT
+1 28 TY
+1 28 CH
+1 27 U
+1 28 U
+1 27 NIKOLSBURG UE
+1 27 RUDIMENTA UE
+1 27 EV
+1 28 Z
+1 29 S
+1 28 NT-SHAPED SIGN
+1 27 US
+8 14 NUMBER ONE
+1 21 FIVE
+1 21 TEN
+1 21 FIFTY
+1 21 ONE HUNDRED
+1 25 THOUSAND
+1 0 HANIFI ROHINGYA LETTER A
+1 23 BA
+1 23 PA
+1 23 TA
+1 24 TA
+1 23 JA
+1 23 CA
+1 23 HA
+1 23 KHA
+1 23 FA
+1 23 DA
+1 24 DA
+1 23 RA
+1 24 RA
+1 23 ZA
+1 23 SA
+1 24 HA
+1 23 KA
+1 23 GA
+1 23 LA
+1 23 MA
+1 23 NA
+1 23 WA
+1 23 KINNA WA
+1 23 YA
+1 23 KINNA YA
+1 23 NGA
+1 24 YA
+1 23 VA
+1 16 VOWEL A
+1 22 I
+1 22 U
+1 22 E
+1 22 O
+1 16 MARK SAKIN
+1 21 NA KHONNA
+1 16 SIGN HARBAHAY
+1 21 TAHALA
+1 23 NA
+1 23 SSI
+9 16 DIGIT ZERO
+1 22 ONE
+1 22 TWO
+1 23 HREE
+1 22 FOUR
+1 23 IVE
+1 22 SIX
+1 23 EVEN
+1 22 EIGHT
+1 22 NINE
+127 0 RUMI DIGIT ONE
+1 11 TWO
+1 12 HREE
+1 11 FOUR
+1 12 IVE
+1 11 SIX
+1 12 EVEN
+1 11 EIGHT
+1 11 NINE
+1 5 NUMBER TEN
+1 13 WENTY
+1 13 HIRTY
+1 12 FORTY
+1 13 IFTY
+1 12 SIXTY
+1 13 EVENTY
+1 12 EIGHTY
+1 12 NINETY
+1 12 ONE HUNDRED
+1 12 TWO HUNDRED
+1 13 HREE HUNDRED
+1 12 FOUR HUNDRED
+1 13 IVE HUNDRED
+1 12 SIX HUNDRED
+1 13 EVEN HUNDRED
+1 12 EIGHT HUNDRED
+1 12 NINE HUNDRED
+1 5 FRACTION ONE HALF
+1 18 QUARTER
+1 18 THIRD
+1 14 TWO THIRDS
+2 0 YEZIDI LETTER ELIF
+1 14 BE
+1 14 PE
+1 15 HE
+1 14 THE
+1 14 SE
+1 14 CIM
+1 15 HIM
+1 16 HIM
+1 14 HHA
+1 14 XA
+1 14 DAL
+1 14 ZAL
+1 14 RA
+1 15 HA
+1 14 ZA
+1 14 JA
+1 14 SIN
+1 15 HIN
+1 15 AD
+1 14 DAD
+1 14 TA
+1 14 ZE
+1 14 EYN
+1 14 XHEYN
+1 14 FA
+1 14 VA
+1 16  ALTERNATE FORM
+1 14 QAF
+1 14 KAF
+1 15 HAF
+1 14 GAF
+1 14 LAM
+1 14 MIM
+1 14 NUN
+1 14 UM
+1 14 WAW
+1 14 OW
+1 14 EW
+1 14 HAY
+1 14 YOT
+1 14 ET
+2 7 COMBINING HAMZA MARK
+1 17 MADDA MARK
+1 7 HYPHENATION MARK
+3 7 LETTER LAM WITH DOT ABOVE
+1 14 YOT WITH CIRCUMFLEX ABOVE
+4f 0 OLD SOGDIAN LETTER ALEPH
+1 19 FINAL ALEPH
+1 19 BETH
+1 19 FINAL BETH
+1 19 GIMEL
+1 19 HE
+1 19 FINAL HE
+1 19 WAW
+1 19 ZAYIN
+1 19 HETH
+1 19 YODH
+1 19 KAPH
+1 19 LAMEDH
+1 19 MEM
+1 19 NUN
+1 19 FINAL NUN
+1 28  WITH VERTICAL TAIL
+1 19 SAMEKH
+1 19 AYIN
+1 20 LTERNATE AYIN
+1 19 PE
+1 19 SADHE
+1 19 FINAL SADHE
+1 30  WITH VERTICAL TAIL
+1 19 RESH-AYIN-DALETH
+1 19 SHIN
+1 19 TAW
+1 19 FINAL TAW
+1 28  WITH VERTICAL TAIL
+1 12 NUMBER ONE
+1 19 TWO
+1 20 HREE
+1 19 FOUR
+1 20 IVE
+1 19 TEN
+1 20 WENTY
+1 20 HIRTY
+1 19 ONE HUNDRED
+1 12 FRACTION ONE HALF
+1 12 LIGATURE AYIN-DALETH
+9 0 SOGDIAN LETTER ALEPH
+1 15 BETH
+1 15 GIMEL
+1 15 HE
+1 15 WAW
+1 15 ZAYIN
+1 15 HETH
+1 15 YODH
+1 15 KAPH
+1 15 LAMEDH
+1 15 MEM
+1 15 NUN
+1 15 SAMEKH
+1 15 AYIN
+1 15 PE
+1 15 SADHE
+1 15 RESH-AYIN
+1 15 SHIN
+1 15 TAW
+1 15 FETH
+1 15 LESH
+1 8 INDEPENDENT SHIN
+1 8 COMBINING DOT BELOW
+1 18 TWO DOTS BELOW
+1 18 DOT ABOVE
+1 18 TWO DOTS ABOVE
+1 18 CURVE ABOVE
+1 24 BELOW
+1 18 HOOK ABOVE
+1 23 BELOW
+1 18 LONG HOOK BELOW
+1 18 RESH BELOW
+1 18 STROKE BELOW
+1 8 NUMBER ONE
+1 15 TEN
+1 16 WENTY
+1 15 ONE HUNDRED
+1 8 PUNCTUATION TWO VERTICAL BARS
+1 37  WITH DOTS
+1 20 CIRCLE WITH DOT
+1 20 TWO CIRCLES WITH DOTS
+1 20 HALF CIRCLE WITH DOT
+17 0 OLD UYGHUR LETTER ALEPH
+1 18 BETH
+1 18 GIMEL-HETH
+1 18 WAW
+1 18 ZAYIN
+1 18 FINAL HETH
+1 18 YODH
+1 18 KAPH
+1 18 LAMEDH
+1 18 MEM
+1 18 NUN
+1 18 SAMEKH
+1 18 PE
+1 18 SADHE
+1 18 RESH
+1 18 SHIN
+1 18 TAW
+1 18 LESH
+1 11 COMBINING DOT ABOVE
+1 25 BELOW
+1 21 TWO DOTS ABOVE
+1 30 BELOW
+1 11 PUNCTUATION BAR
+1 23 TWO BARS
+1 27 DOTS
+1 23 FOUR DOTS
+27 0 CHORASMIAN LETTER ALEPH
+1 18 SMALL ALEPH
+1 18 BETH
+1 18 GIMEL
+1 18 DALETH
+1 18 HE
+1 18 WAW
+1 18 CURLED WAW
+1 18 ZAYIN
+1 18 HETH
+1 18 YODH
+1 18 KAPH
+1 18 LAMEDH
+1 18 MEM
+1 18 NUN
+1 18 SAMEKH
+1 18 AYIN
+1 18 PE
+1 18 RESH
+1 18 SHIN
+1 18 TAW
+1 11 NUMBER ONE
+1 18 TWO
+1 19 HREE
+1 18 FOUR
+1 18 TEN
+1 19 WENTY
+1 18 ONE HUNDRED
+15 0 ELYMAIC LETTER ALEPH
+1 15 BETH
+1 15 GIMEL
+1 15 DALETH
+1 15 HE
+1 15 WAW
+1 15 ZAYIN
+1 15 HETH
+1 15 TETH
+1 15 YODH
+1 15 KAPH
+1 15 LAMEDH
+1 15 MEM
+1 15 NUN
+1 15 SAMEKH
+1 15 AYIN
+1 15 PE
+1 15 SADHE
+1 15 QOPH
+1 15 RESH
+1 15 SHIN
+1 15 TAW
+1 9 IGATURE ZAYIN-YODH
+a 0 BRAHMI SIGN CANDRABINDU
+1 12 ANUSVARA
+1 12 VISARGA
+1 12 JIHVAMULIYA
+1 12 UPADHMANIYA
+1 7 LETTER A
+1 15 A
+1 14 I
+1 15 I
+1 14 U
+1 15 U
+1 14 VOCALIC R
+1 23 R
+1 22 L
+1 23 L
+1 14 E
+1 14 AI
+1 14 O
+1 14 AU
+1 14 KA
+1 15 HA
+1 14 GA
+1 15 HA
+1 14 NGA
+1 14 CA
+1 15 HA
+1 14 JA
+1 15 HA
+1 14 NYA
+1 14 TTA
+1 16 HA
+1 14 DDA
+1 16 HA
+1 14 NNA
+1 14 TA
+1 15 HA
+1 14 DA
+1 15 HA
+1 14 NA
+1 14 PA
+1 15 HA
+1 14 BA
+1 15 HA
+1 14 MA
+1 14 YA
+1 14 RA
+1 14 LA
+1 14 VA
+1 14 SHA
+1 15 SA
+1 15 A
+1 14 HA
+1 14 LLA
+1 14 OLD TAMIL LLLA
+1 24 RRA
+1 24 NNNA
+1 7 VOWEL SIGN AA
+1 18 BHATTIPROLU AA
+1 18 I
+1 19 I
+1 18 U
+1 19 U
+1 18 VOCALIC R
+1 27 R
+1 26 L
+1 27 L
+1 18 E
+1 18 AI
+1 18 O
+1 18 AU
+1 8 IRAMA
+1 7 DANDA
+1 8 OUBLE DANDA
+1 7 PUNCTUATION DOT
+1 21 UBLE DOT
+1 19 LINE
+1 19 CRESCENT BAR
+1 19 LOTUS
+5 7 NUMBER ONE
+1 14 TWO
+1 15 HREE
+1 14 FOUR
+1 15 IVE
+1 14 SIX
+1 15 EVEN
+1 14 EIGHT
+1 14 NINE
+1 14 TEN
+1 15 WENTY
+1 15 HIRTY
+1 14 FORTY
+1 15 IFTY
+1 14 SIXTY
+1 15 EVENTY
+1 14 EIGHTY
+1 14 NINETY
+1 14 ONE HUNDRED
+1 18 THOUSAND
+1 7 DIGIT ZERO
+1 13 ONE
+1 13 TWO
+1 14 HREE
+1 13 FOUR
+1 14 IVE
+1 13 SIX
+1 14 EVEN
+1 13 EIGHT
+1 13 NINE
+1 7 SIGN OLD TAMIL VIRAMA
+1 7 LETTER OLD TAMIL SHORT E
+1 30 O
+1 7 VOWEL SIGN OLD TAMIL SHORT E
+1 34 O
+1 7 LETTER OLD TAMIL LLA
+a 7 NUMBER JOINER
+1 0 KAITHI SIGN CANDRABINDU
+1 12 ANUSVARA
+1 12 VISARGA
+1 7 LETTER A
+1 15 A
+1 14 I
+1 15 I
+1 14 U
+1 15 U
+1 14 E
+1 14 AI
+1 14 O
+1 14 AU
+1 14 KA
+1 15 HA
+1 14 GA
+1 15 HA
+1 14 NGA
+1 14 CA
+1 15 HA
+1 14 JA
+1 15 HA
+1 14 NYA
+1 14 TTA
+1 16 HA
+1 14 DDA
+1 16 DHA
+1 16 HA
+1 14 RHA
+1 14 NNA
+1 14 TA
+1 15 HA
+1 14 DA
+1 15 HA
+1 14 NA
+1 14 PA
+1 15 HA
+1 14 BA
+1 15 HA
+1 14 MA
+1 14 YA
+1 14 RA
+1 14 LA
+1 14 VA
+1 14 SHA
+1 15 SA
+1 15 A
+1 14 HA
+1 7 VOWEL SIGN AA
+1 18 I
+1 19 I
+1 18 U
+1 19 U
+1 18 E
+1 18 AI
+1 18 O
+1 18 AU
+1 7 SIGN VIRAMA
+1 12 NUKTA
+1 7 ABBREVIATION SIGN
+1 7 ENUMERATION SIGN
+1 7 NUMBER SIGN
+1 7 SECTION MARK
+1 7 DOUBLE SECTION MARK
+1 8 ANDA
+1 8 OUBLE DANDA
+1 7 VOWEL SIGN VOCALIC R
+b 7 NUMBER SIGN ABOVE
+3 0 SORA SOMPENG LETTER SAH
+1 20 TAH
+1 20 BAH
+1 20 CAH
+1 20 DAH
+1 20 GAH
+1 20 MAH
+1 20 NGAH
+1 20 LAH
+1 20 NAH
+1 20 VAH
+1 20 PAH
+1 20 YAH
+1 20 RAH
+1 20 HAH
+1 20 KAH
+1 20 JAH
+1 20 NYAH
+1 20 AH
+1 20 EEH
+1 20 IH
+1 20 UH
+1 20 OH
+1 20 EH
+1 20 MAE
+8 13 DIGIT ZERO
+1 19 ONE
+1 19 TWO
+1 20 HREE
+1 19 FOUR
+1 20 IVE
+1 19 SIX
+1 20 EVEN
+1 19 EIGHT
+1 19 NINE
+7 0 CHAKMA SIGN CANDRABINDU
+1 12 ANUSVARA
+1 12 VISARGA
+1 7 LETTER AA
+1 14 I
+1 14 U
+1 14 E
+1 14 KAA
+1 15 HAA
+1 14 GAA
+1 15 HAA
+1 14 NGAA
+1 14 CAA
+1 15 HAA
+1 14 JAA
+1 15 HAA
+1 14 NYAA
+1 14 TTAA
+1 16 HAA
+1 14 DDAA
+1 16 HAA
+1 14 NNAA
+1 14 TAA
+1 15 HAA
+1 14 DAA
+1 15 HAA
+1 14 NAA
+1 14 PAA
+1 15 HAA
+1 14 BAA
+1 15 HAA
+1 14 MAA
+1 14 YYAA
+1 15 AA
+1 14 RAA
+1 14 LAA
+1 14 WAA
+1 14 SAA
+1 14 HAA
+1 7 VOWEL SIGN A
+1 18 I
+1 19 I
+1 18 U
+1 19 U
+1 18 E
+1 18 AI
+1 18 O
+1 18 AU
+1 18 OI
+1 7 O MARK
+1 7 AU MARK
+1 7 VIRAMA
+1 7 MAAYYAA
+2 7 DIGIT ZERO
+1 13 ONE
+1 13 TWO
+1 14 HREE
+1 13 FOUR
+1 14 IVE
+1 13 SIX
+1 14 EVEN
+1 13 EIGHT
+1 13 NINE
+1 7 SECTION MARK
+1 7 DANDA
+1 8 OUBLE DANDA
+1 7 QUESTION MARK
+1 7 LETTER LHAA
+1 7 VOWEL SIGN AA
+1 18 EI
+1 7 LETTER VAA
+9 0 MAHAJANI LETTER A
+1 16 I
+1 16 U
+1 16 E
+1 16 O
+1 16 KA
+1 17 HA
+1 16 GA
+1 17 HA
+1 16 CA
+1 17 HA
+1 16 JA
+1 17 HA
+1 16 NYA
+1 16 TTA
+1 18 HA
+1 16 DDA
+1 18 HA
+1 16 NNA
+1 16 TA
+1 17 HA
+1 16 DA
+1 17 HA
+1 16 NA
+1 16 PA
+1 17 HA
+1 16 BA
+1 17 HA
+1 16 MA
+1 16 RA
+1 16 LA
+1 16 VA
+1 16 SA
+1 16 HA
+1 16 RRA
+1 9 SIGN NUKTA
+1 9 ABBREVIATION SIGN
+1 9 SECTION MARK
+1 9 LIGATURE SHRI
+a 0 SHARADA SIGN CANDRABINDU
+1 13 ANUSVARA
+1 13 VISARGA
+1 8 LETTER A
+1 16 A
+1 15 I
+1 16 I
+1 15 U
+1 16 U
+1 15 VOCALIC R
+1 24 R
+1 23 L
+1 24 L
+1 15 E
+1 15 AI
+1 15 O
+1 15 AU
+1 15 KA
+1 16 HA
+1 15 GA
+1 16 HA
+1 15 NGA
+1 15 CA
+1 16 HA
+1 15 JA
+1 16 HA
+1 15 NYA
+1 15 TTA
+1 17 HA
+1 15 DDA
+1 17 HA
+1 15 NNA
+1 15 TA
+1 16 HA
+1 15 DA
+1 16 HA
+1 15 NA
+1 15 PA
+1 16 HA
+1 15 BA
+1 16 HA
+1 15 MA
+1 15 YA
+1 15 RA
+1 15 LA
+1 16 LA
+1 15 VA
+1 15 SHA
+1 16 SA
+1 16 A
+1 15 HA
+1 8 VOWEL SIGN AA
+1 19 I
+1 20 I
+1 19 U
+1 20 U
+1 19 VOCALIC R
+1 28 R
+1 27 L
+1 28 L
+1 19 E
+1 19 AI
+1 19 O
+1 19 AU
+1 8 SIGN VIRAMA
+1 13 AVAGRAHA
+1 13 JIHVAMULIYA
+1 13 UPADHMANIYA
+1 8 OM
+1 8 DANDA
+1 9 OUBLE DANDA
+1 8 ABBREVIATION SIGN
+1 8 SEPARATOR
+1 9 ANDHI MARK
+1 9 IGN NUKTA
+1 8 VOWEL MODIFIER MARK
+1 8 EXTRA SHORT VOWEL MARK
+1 8 SUTRA MARK
+1 8 VOWEL SIGN PRISHTHAMATRA E
+1 8 SIGN INVERTED CANDRABINDU
+1 8 DIGIT ZERO
+1 14 ONE
+1 14 TWO
+1 15 HREE
+1 14 FOUR
+1 15 IVE
+1 14 SIX
+1 15 EVEN
+1 14 EIGHT
+1 14 NINE
+1 8 EKAM
+1 8 SIGN SIDDHAM
+1 8 HEADSTROKE
+1 8 CONTINUATION SIGN
+1 8 SECTION MARK-1
+1 21 2
+2 1 INHALA ARCHAIC DIGIT ONE
+1 22 TWO
+1 23 HREE
+1 22 FOUR
+1 23 IVE
+1 22 SIX
+1 23 EVEN
+1 22 EIGHT
+1 22 NINE
+1 16 NUMBER TEN
+1 24 WENTY
+1 24 HIRTY
+1 23 FORTY
+1 24 IFTY
+1 23 SIXTY
+1 24 EVENTY
+1 23 EIGHTY
+1 23 NINETY
+1 23 ONE HUNDRED
+1 27 THOUSAND
+c 0 KHOJKI LETTER A
+1 15 A
+1 14 I
+1 14 U
+1 14 E
+1 14 AI
+1 14 O
+1 14 AU
+1 14 KA
+1 15 HA
+1 14 GA
+1 15 GA
+1 15 HA
+1 14 NGA
+1 14 CA
+1 15 HA
+1 14 JA
+1 15 JA
+2 14 NYA
+1 14 TTA
+1 16 HA
+1 14 DDA
+1 16 HA
+1 14 NNA
+1 14 TA
+1 15 HA
+1 14 DA
+1 15 DDA
+1 15 HA
+1 14 NA
+1 14 PA
+1 15 HA
+1 14 BA
+1 15 BA
+1 15 HA
+1 14 MA
+1 14 YA
+1 14 RA
+1 14 LA
+1 14 VA
+1 14 SA
+1 14 HA
+1 14 LLA
+1 7 VOWEL SIGN AA
+1 18 I
+1 19 I
+1 18 U
+1 18 E
+1 18 AI
+1 18 O
+1 18 AU
+1 7 SIGN ANUSVARA
+1 12 VIRAMA
+1 12 NUKTA
+1 12 SHADDA
+1 7 DANDA
+1 8 OUBLE DANDA
+1 7 WORD SEPARATOR
+1 7 SECTION MARK
+1 7 DOUBLE SECTION MARK
+1 7 ABBREVIATION SIGN
+1 7 SIGN SUKUN
+42 0 MULTANI LETTER A
+1 15 I
+1 15 U
+1 15 E
+1 15 KA
+1 16 HA
+1 15 GA
+2 16 HA
+2 15 CA
+1 16 HA
+1 15 JA
+1 16 JA
+2 15 NYA
+1 15 TTA
+1 17 HA
+1 15 DDA
+1 17 DA
+1 17 HA
+1 15 NNA
+1 15 TA
+1 16 HA
+1 15 DA
+1 16 HA
+1 15 NA
+1 15 PA
+1 16 HA
+1 15 BA
+2 16 HA
+1 15 MA
+1 15 YA
+1 15 RA
+1 15 LA
+1 15 VA
+1 15 SA
+1 15 HA
+1 15 RRA
+1 16 HA
+1 8 SECTION MARK
+7 0 KHUDAWADI LETTER A
+1 18 A
+1 17 I
+1 18 I
+1 17 U
+1 18 U
+1 17 E
+1 17 AI
+1 17 O
+1 17 AU
+1 17 KA
+1 18 HA
+1 17 GA
+1 18 GA
+1 18 HA
+1 17 NGA
+1 17 CA
+1 18 HA
+1 17 JA
+1 18 JA
+1 18 HA
+1 17 NYA
+1 17 TTA
+1 19 HA
+1 17 DDA
+1 19 DA
+1 17 RRA
+1 17 DDHA
+1 17 NNA
+1 17 TA
+1 18 HA
+1 17 DA
+1 18 HA
+1 17 NA
+1 17 PA
+1 18 HA
+1 17 BA
+1 18 BA
+1 18 HA
+1 17 MA
+1 17 YA
+1 17 RA
+1 17 LA
+1 17 VA
+1 17 SHA
+1 18 A
+1 17 HA
+1 10 SIGN ANUSVARA
+1 10 VOWEL SIGN AA
+1 21 I
+1 22 I
+1 21 U
+1 22 U
+1 21 E
+1 21 AI
+1 21 O
+1 21 AU
+1 10 SIGN NUKTA
+1 15 VIRAMA
+6 10 DIGIT ZERO
+1 16 ONE
+1 16 TWO
+1 17 HREE
+1 16 FOUR
+1 17 IVE
+1 16 SIX
+1 17 EVEN
+1 16 EIGHT
+1 16 NINE
+7 0 GRANTHA SIGN COMBINING ANUSVARA ABOVE
+1 14 ANDRABINDU
+1 13 ANUSVARA
+1 13 VISARGA
+2 8 LETTER A
+1 16 A
+1 15 I
+1 16 I
+1 15 U
+1 16 U
+1 15 VOCALIC R
+1 23 L
+3 15 EE
+1 15 AI
+3 15 OO
+1 15 AU
+1 15 KA
+1 16 HA
+1 15 GA
+1 16 HA
+1 15 NGA
+1 15 CA
+1 16 HA
+1 15 JA
+1 16 HA
+1 15 NYA
+1 15 TTA
+1 17 HA
+1 15 DDA
+1 17 HA
+1 15 NNA
+1 15 TA
+1 16 HA
+1 15 DA
+1 16 HA
+1 15 NA
+2 15 PA
+1 16 HA
+1 15 BA
+1 16 HA
+1 15 MA
+1 15 YA
+1 15 RA
+2 15 LA
+1 16 LA
+2 15 VA
+1 15 SHA
+1 16 SA
+1 16 A
+1 15 HA
+2 0 COMBINING BINDU BELOW
+1 0 GRANTHA SIGN NUKTA
+1 13 AVAGRAHA
+1 8 VOWEL SIGN AA
+1 19 I
+1 20 I
+1 19 U
+1 20 U
+1 19 VOCALIC R
+1 28 R
+3 19 EE
+1 19 AI
+3 19 OO
+1 19 AU
+1 8 SIGN VIRAMA
+3 8 OM
+7 8 AU LENGTH MARK
+6 8 SIGN PLUTA
+1 8 LETTER VEDIC ANUSVARA
+1 21 DOUBLE ANUSVARA
+1 16 OCALIC RR
+1 23 LL
+1 8 VOWEL SIGN VOCALIC L
+1 28 L
+3 0 COMBINING GRANTHA DIGIT ZERO
+1 24 ONE
+1 24 TWO
+1 25 HREE
+1 24 FOUR
+1 25 IVE
+1 24 SIX
+4 18 LETTER A
+1 25 KA
+1 25 NA
+1 25 VI
+1 25 PA
+8c 0 NEWA LETTER A
+1 13 A
+1 12 I
+1 13 I
+1 12 U
+1 13 U
+1 12 VOCALIC R
+1 21 R
+1 20 L
+1 21 L
+1 12 E
+1 12 AI
+1 12 O
+1 12 AU
+1 12 KA
+1 13 HA
+1 12 GA
+1 13 HA
+1 12 NGA
+1 14 HA
+1 12 CA
+1 13 HA
+1 12 JA
+1 13 HA
+1 12 NYA
+1 14 HA
+1 12 TTA
+1 14 HA
+1 12 DDA
+1 14 HA
+1 12 NNA
+1 12 TA
+1 13 HA
+1 12 DA
+1 13 HA
+1 12 NA
+1 13 HA
+1 12 PA
+1 13 HA
+1 12 BA
+1 13 HA
+1 12 MA
+1 13 HA
+1 12 YA
+1 12 RA
+1 13 HA
+1 12 LA
+1 13 HA
+1 12 WA
+1 12 SHA
+1 13 SA
+1 13 A
+1 12 HA
+1 5 VOWEL SIGN AA
+1 16 I
+1 17 I
+1 16 U
+1 17 U
+1 16 VOCALIC R
+1 25 R
+1 24 L
+1 25 L
+1 16 E
+1 16 AI
+1 16 O
+1 16 AU
+1 5 SIGN VIRAMA
+1 10 CANDRABINDU
+1 10 ANUSVARA
+1 10 VISARGA
+1 10 NUKTA
+1 10 AVAGRAHA
+1 10 FINAL ANUSVARA
+1 5 OM
+1 5 SIDDHI
+1 5 DANDA
+1 6 OUBLE DANDA
+1 5 COMMA
+1 5 GAP FILLER
+1 5 ABBREVIATION SIGN
+1 5 DIGIT ZERO
+1 11 ONE
+1 11 TWO
+1 12 HREE
+1 11 FOUR
+1 12 IVE
+1 11 SIX
+1 12 EVEN
+1 11 EIGHT
+1 11 NINE
+1 6 OUBLE COMMA
+1 5 PLACEHOLDER MARK
+2 5 INSERTION SIGN
+1 5 SANDHI MARK
+1 5 LETTER VEDIC ANUSVARA
+1 5 SIGN JIHVAMULIYA
+1 10 UPADHMANIYA
+1f 0 TIRHUTA ANJI
+1 8 LETTER A
+1 16 A
+1 15 I
+1 16 I
+1 15 U
+1 16 U
+1 15 VOCALIC R
+1 24 R
+1 23 L
+1 24 L
+1 15 E
+1 15 AI
+1 15 O
+1 15 AU
+1 15 KA
+1 16 HA
+1 15 GA
+1 16 HA
+1 15 NGA
+1 15 CA
+1 16 HA
+1 15 JA
+1 16 HA
+1 15 NYA
+1 15 TTA
+1 17 HA
+1 15 DDA
+1 17 HA
+1 15 NNA
+1 15 TA
+1 16 HA
+1 15 DA
+1 16 HA
+1 15 NA
+1 15 PA
+1 16 HA
+1 15 BA
+1 16 HA
+1 15 MA
+1 15 YA
+1 15 RA
+1 15 LA
+1 15 VA
+1 15 SHA
+1 16 SA
+1 16 A
+1 15 HA
+1 8 VOWEL SIGN AA
+1 19 I
+1 20 I
+1 19 U
+1 20 U
+1 19 VOCALIC R
+1 28 R
+1 27 L
+1 28 L
+1 19 E
+1 19 SHORT E
+1 19 AI
+1 19 O
+1 19 SHORT O
+1 19 AU
+1 8 SIGN CANDRABINDU
+1 13 ANUSVARA
+1 13 VISARGA
+1 15 RAMA
+1 13 NUKTA
+1 13 AVAGRAHA
+1 8 GVANG
+1 8 ABBREVIATION SIGN
+1 8 OM
+9 8 DIGIT ZERO
+1 14 ONE
+1 14 TWO
+1 15 HREE
+1 14 FOUR
+1 15 IVE
+1 14 SIX
+1 15 EVEN
+1 14 EIGHT
+1 14 NINE
+a7 0 SIDDHAM LETTER A
+1 16 A
+1 15 I
+1 16 I
+1 15 U
+1 16 U
+1 15 VOCALIC R
+1 24 R
+1 23 L
+1 24 L
+1 15 E
+1 15 AI
+1 15 O
+1 15 AU
+1 15 KA
+1 16 HA
+1 15 GA
+1 16 HA
+1 15 NGA
+1 15 CA
+1 16 HA
+1 15 JA
+1 16 HA
+1 15 NYA
+1 15 TTA
+1 17 HA
+1 15 DDA
+1 17 HA
+1 15 NNA
+1 15 TA
+1 16 HA
+1 15 DA
+1 16 HA
+1 15 NA
+1 15 PA
+1 16 HA
+1 15 BA
+1 16 HA
+1 15 MA
+1 15 YA
+1 15 RA
+1 15 LA
+1 15 VA
+1 15 SHA
+1 16 SA
+1 16 A
+1 15 HA
+1 8 VOWEL SIGN AA
+1 19 I
+1 20 I
+1 19 U
+1 20 U
+1 19 VOCALIC R
+1 28 R
+3 19 E
+1 19 AI
+1 19 O
+1 19 AU
+1 8 SIGN CANDRABINDU
+1 13 ANUSVARA
+1 13 VISARGA
+1 15 RAMA
+1 13 NUKTA
+1 13 SIDDHAM
+1 8 DANDA
+1 9 OUBLE DANDA
+1 8 SEPARATOR DOT
+1 18 BAR
+1 8 REPETITION MARK-1
+1 24 2
+1 24 3
+1 8 END OF TEXT MARK
+1 8 SECTION MARK WITH TRIDENT AND U-SHAPED ORNAMENTS
+1 38 DOTTED CRESCENTS
+1 26 RAYS AND DOTTED CRESCENTS
+1 42 DOUBLE CRESCENTS
+1 42 TRIPLE CRESCENTS
+1 21 DOUBLE RING
+1 32  WITH RAYS
+1 21 WITH DOUBLE CRESCENTS
+1 26 TRIPLE CRESCENTS
+1 26 QUADRUPLE CRESCENTS
+1 26 SEPTUPLE CRESCENTS
+1 26 CIRCLES AND RAYS
+1 38 TWO ENCLOSURES
+1 38 FOUR ENCLOSURES
+1 8 LETTER THREE-CIRCLE ALTERNATE I
+1 16 WO-CIRCLE ALTERNATE I
+1 37 I
+1 15 ALTERNATE U
+1 8 VOWEL SIGN ALTERNATE U
+1 30 U
+23 0 MODI LETTER A
+1 13 A
+1 12 I
+1 13 I
+1 12 U
+1 13 U
+1 12 VOCALIC R
+1 21 R
+1 20 L
+1 21 L
+1 12 E
+1 12 AI
+1 12 O
+1 12 AU
+1 12 KA
+1 13 HA
+1 12 GA
+1 13 HA
+1 12 NGA
+1 12 CA
+1 13 HA
+1 12 JA
+1 13 HA
+1 12 NYA
+1 12 TTA
+1 14 HA
+1 12 DDA
+1 14 HA
+1 12 NNA
+1 12 TA
+1 13 HA
+1 12 DA
+1 13 HA
+1 12 NA
+1 12 PA
+1 13 HA
+1 12 BA
+1 13 HA
+1 12 MA
+1 12 YA
+1 12 RA
+1 12 LA
+1 12 VA
+1 12 SHA
+1 13 SA
+1 13 A
+1 12 HA
+1 12 LLA
+1 5 VOWEL SIGN AA
+1 16 I
+1 17 I
+1 16 U
+1 17 U
+1 16 VOCALIC R
+1 25 R
+1 24 L
+1 25 L
+1 16 E
+1 16 AI
+1 16 O
+1 16 AU
+1 5 SIGN ANUSVARA
+1 10 VISARGA
+1 12 RAMA
+1 10 ARDHACANDRA
+1 5 DANDA
+1 6 OUBLE DANDA
+1 5 ABBREVIATION SIGN
+1 5 SIGN HUVA
+c 5 DIGIT ZERO
+1 11 ONE
+1 11 TWO
+1 12 HREE
+1 11 FOUR
+1 12 IVE
+1 11 SIX
+1 12 EVEN
+1 11 EIGHT
+1 11 NINE
+7 2 NGOLIAN BIRGA WITH ORNAMENT
+1 10 ROTATED BIRGA
+1 10 DOUBLE BIRGA WITH ORNAMENT
+1 10 TRIPLE BIRGA WITH ORNAMENT
+1 10 BIRGA WITH DOUBLE ORNAMENT
+1 10 ROTATED BIRGA WITH ORNAMENT
+1 29 DOUBLE ORNAMENT
+1 10 INVERTED BIRGA
+1 24  WITH DOUBLE ORNAMENT
+1 10 SWIRL BIRGA
+1 21  WITH ORNAMENT
+1 27 DOUBLE ORNAMENT
+1 10 TURNED SWIRL BIRGA WITH DOUBLE ORNAMENT
+14 0 TAKRI LETTER A
+1 14 A
+1 13 I
+1 14 I
+1 13 U
+1 14 U
+1 13 E
+1 13 AI
+1 13 O
+1 13 AU
+1 13 KA
+1 14 HA
+1 13 GA
+1 14 HA
+1 13 NGA
+1 13 CA
+1 14 HA
+1 13 JA
+1 14 HA
+1 13 NYA
+1 13 TTA
+1 15 HA
+1 13 DDA
+1 15 HA
+1 13 NNA
+1 13 TA
+1 14 HA
+1 13 DA
+1 14 HA
+1 13 NA
+1 13 PA
+1 14 HA
+1 13 BA
+1 14 HA
+1 13 MA
+1 13 YA
+1 13 RA
+1 13 LA
+1 13 VA
+1 13 SHA
+1 14 A
+1 13 HA
+1 13 RRA
+1 6 SIGN ANUSVARA
+1 11 VISARGA
+1 6 VOWEL SIGN AA
+1 17 I
+1 18 I
+1 17 U
+1 18 U
+1 17 E
+1 17 AI
+1 17 O
+1 17 AU
+1 6 SIGN VIRAMA
+1 11 NUKTA
+1 6 LETTER ARCHAIC KHA
+1 6 ABBREVIATION SIGN
+7 6 DIGIT ZERO
+1 12 ONE
+1 12 TWO
+1 13 HREE
+1 12 FOUR
+1 13 IVE
+1 12 SIX
+1 13 EVEN
+1 12 EIGHT
+1 12 NINE
+37 0 AHOM LETTER KA
+1 13 HA
+1 12 NGA
+1 13 A
+1 12 TA
+1 12 ALTERNATE TA
+1 12 PA
+1 13 HA
+1 12 BA
+1 12 MA
+1 12 JA
+1 12 CHA
+1 12 THA
+1 12 RA
+1 12 LA
+1 12 SA
+1 12 NYA
+1 12 HA
+1 12 A
+1 12 DA
+1 13 HA
+1 12 GA
+1 12 ALTERNATE GA
+1 12 GHA
+1 12 BHA
+1 12 JHA
+1 12 ALTERNATE BA
+3 5 CONSONANT SIGN MEDIAL LA
+1 27 RA
+1 27 LIGATING RA
+1 5 VOWEL SIGN A
+1 17 A
+1 16 I
+1 17 I
+1 16 U
+1 17 U
+1 16 E
+1 16 AW
+1 16 O
+1 16 AI
+1 17 M
+1 5 SIGN KILLER
+5 5 DIGIT ZERO
+1 11 ONE
+1 11 TWO
+1 12 HREE
+1 11 FOUR
+1 12 IVE
+1 11 SIX
+1 12 EVEN
+1 11 EIGHT
+1 11 NINE
+1 5 NUMBER TEN
+1 13 WENTY
+1 5 SIGN SMALL SECTION
+1 11 ECTION
+1 10 RULAI
+1 6 YMBOL VI
+1 5 LETTER CA
+1 12 TTA
+1 14 HA
+1 12 DDA
+1 14 HA
+1 12 NNA
+1 12 LLA
+ba 0 DOGRA LETTER A
+1 14 A
+1 13 I
+1 14 I
+1 13 U
+1 14 U
+1 13 E
+1 13 AI
+1 13 O
+1 13 AU
+1 13 KA
+1 14 HA
+1 13 GA
+1 14 HA
+1 13 NGA
+1 13 CA
+1 14 HA
+1 13 JA
+1 14 HA
+1 13 NYA
+1 13 TTA
+1 15 HA
+1 13 DDA
+1 15 HA
+1 13 NNA
+1 13 TA
+1 14 HA
+1 13 DA
+1 14 HA
+1 13 NA
+1 13 PA
+1 14 HA
+1 13 BA
+1 14 HA
+1 13 MA
+1 13 YA
+1 13 RA
+1 13 LA
+1 13 VA
+1 13 SHA
+1 14 SA
+1 14 A
+1 13 HA
+1 13 RRA
+1 6 VOWEL SIGN AA
+1 17 I
+1 18 I
+1 17 U
+1 18 U
+1 17 VOCALIC R
+1 26 R
+1 17 E
+1 17 AI
+1 17 O
+1 17 AU
+1 6 SIGN ANUSVARA
+1 11 VISARGA
+1 13 RAMA
+1 11 NUKTA
+1 6 ABBREVIATION SIGN
+65 0 WARANG CITI CAPITAL LETTER NGAA
+1 27 A
+1 27 WI
+1 27 YU
+1 28 A
+1 28 O
+1 27 II
+1 27 UU
+1 27 E
+1 27 O
+1 27 ANG
+1 27 GA
+1 27 KO
+1 27 ENY
+1 27 YUJ
+1 27 UC
+1 27 ENN
+1 27 ODD
+1 27 TTE
+1 27 NUNG
+1 27 DA
+1 27 AT
+1 28 M
+1 27 BU
+1 27 PU
+1 27 HIYO
+1 28 OLO
+1 29 RR
+1 28 AR
+1 27 SSUU
+1 28 II
+1 27 VIYO
+1 12 SMALL LETTER NGAA
+1 25 A
+1 25 WI
+1 25 YU
+1 26 A
+1 26 O
+1 25 II
+1 25 UU
+1 25 E
+1 25 O
+1 25 ANG
+1 25 GA
+1 25 KO
+1 25 ENY
+1 25 YUJ
+1 25 UC
+1 25 ENN
+1 25 ODD
+1 25 TTE
+1 25 NUNG
+1 25 DA
+1 25 AT
+1 26 M
+1 25 BU
+1 25 PU
+1 25 HIYO
+1 26 OLO
+1 27 RR
+1 26 AR
+1 25 SSUU
+1 26 II
+1 25 VIYO
+1 12 DIGIT ZERO
+1 18 ONE
+1 18 TWO
+1 19 HREE
+1 18 FOUR
+1 19 IVE
+1 18 SIX
+1 19 EVEN
+1 18 EIGHT
+1 18 NINE
+1 12 NUMBER TEN
+1 20 WENTY
+1 20 HIRTY
+1 19 FORTY
+1 20 IFTY
+1 19 SIXTY
+1 20 EVENTY
+1 19 EIGHTY
+1 19 NINETY
+d 12 OM
+1 0 DIVES AKURU LETTER A
+1 20 A
+1 19 I
+1 20 I
+1 19 U
+1 20 U
+1 19 E
+3 19 O
+3 19 KA
+1 20 HA
+1 19 GA
+1 20 HA
+1 19 NGA
+1 19 CA
+1 20 HA
+1 19 JA
+2 19 NYA
+1 19 TTA
+2 19 DDA
+1 21 HA
+1 19 NNA
+1 19 TA
+1 20 HA
+1 19 DA
+1 20 HA
+1 19 NA
+1 19 PA
+1 20 HA
+1 19 BA
+1 20 HA
+1 19 MA
+1 19 YA
+1 20 YA
+1 19 RA
+1 19 LA
+1 19 VA
+1 19 SHA
+1 20 SA
+1 20 A
+1 19 HA
+1 19 LLA
+1 19 ZA
+1 12 VOWEL SIGN AA
+1 23 I
+1 24 I
+1 23 U
+1 24 U
+1 23 E
+2 23 AI
+1 23 O
+3 12 SIGN ANUSVARA
+1 17 CANDRABINDU
+1 17 HALANTA
+1 12 VIRAMA
+1 12 PREFIXED NASAL SIGN
+1 12 MEDIAL YA
+1 12 INITIAL RA
+1 12 MEDIAL RA
+1 12 SIGN NUKTA
+1 12 DOUBLE DANDA
+1 12 GAP FILLER
+1 12 END OF TEXT MARK
+a 12 DIGIT ZERO
+1 18 ONE
+1 18 TWO
+1 19 HREE
+1 18 FOUR
+1 19 IVE
+1 18 SIX
+1 19 EVEN
+1 18 EIGHT
+1 18 NINE
+47 0 NANDINAGARI LETTER A
+1 20 A
+1 19 I
+1 20 I
+1 19 U
+1 20 U
+1 19 VOCALIC R
+1 28 R
+3 19 E
+1 19 AI
+1 19 O
+1 19 AU
+1 19 KA
+1 20 HA
+1 19 GA
+1 20 HA
+1 19 NGA
+1 19 CA
+1 20 HA
+1 19 JA
+1 20 HA
+1 19 NYA
+1 19 TTA
+1 21 HA
+1 19 DDA
+1 21 HA
+1 19 NNA
+1 19 TA
+1 20 HA
+1 19 DA
+1 20 HA
+1 19 NA
+1 19 PA
+1 20 HA
+1 19 BA
+1 20 HA
+1 19 MA
+1 19 YA
+1 19 RA
+1 19 LA
+1 19 VA
+1 19 SHA
+1 20 SA
+1 20 A
+1 19 HA
+1 19 LLA
+1 19 RRA
+1 12 VOWEL SIGN AA
+1 23 I
+1 24 I
+1 23 U
+1 24 U
+1 23 VOCALIC R
+1 32 R
+3 23 E
+1 23 AI
+1 23 O
+1 23 AU
+1 12 SIGN ANUSVARA
+1 17 VISARGA
+1 19 RAMA
+1 17 AVAGRAHA
+1 17 SIDDHAM
+1 12 HEADSTROKE
+1 12 VOWEL SIGN PRISHTHAMATRA E
+1c 0 ZANABAZAR SQUARE LETTER A
+1 17 VOWEL SIGN I
+1 28 UE
+1 29 
+1 28 E
+1 28 OE
+1 29 
+1 28 AI
+1 29 U
+1 28 REVERSED I
+1 23 LENGTH MARK
+1 17 LETTER KA
+1 25 HA
+1 24 GA
+1 25 HA
+1 24 NGA
+1 24 CA
+1 25 HA
+1 24 JA
+1 24 NYA
+1 24 TTA
+1 26 HA
+1 24 DDA
+1 26 HA
+1 24 NNA
+1 24 TA
+1 25 HA
+1 24 DA
+1 25 HA
+1 24 NA
+1 24 PA
+1 25 HA
+1 24 BA
+1 25 HA
+1 24 MA
+1 24 TSA
+1 26 HA
+1 24 DZA
+1 26 HA
+1 24 ZHA
+1 25 A
+1 24 -A
+1 24 YA
+1 24 RA
+1 24 LA
+1 24 VA
+1 24 SHA
+1 25 SA
+1 25 A
+1 24 HA
+1 24 KSSA
+1 17 FINAL CONSONANT MARK
+1 17 SIGN VIRAMA
+1 22 CANDRABINDU
+1 33  WITH ORNAMENT
+1 28  WITH ORNAMENT
+1 22 ANUSVARA
+1 22 VISARGA
+1 17 CLUSTER-INITIAL LETTER RA
+1 25 FINAL LETTER YA
+1 38 RA
+1 38 LA
+1 38 VA
+1 17 INITIAL HEAD MARK
+1 17 CLOSING HEAD MARK
+1 17 MARK TSHEG
+1 22 SHAD
+1 22 DOUBLE SHAD
+1 22 LONG TSHEG
+1 17 INITIAL DOUBLE-LINED HEAD MARK
+1 17 CLOSING DOUBLE-LINED HEAD MARK
+1 17 SUBJOINER
+9 0 SOYOMBO LETTER A
+1 8 VOWEL SIGN I
+1 19 UE
+1 20 
+1 19 E
+1 19 O
+1 20 E
+1 19 AI
+1 20 U
+1 19 VOCALIC R
+1 27 L
+1 14 LENGTH MARK
+1 8 LETTER KA
+1 16 HA
+1 15 GA
+1 16 HA
+1 15 NGA
+1 15 CA
+1 16 HA
+1 15 JA
+1 16 HA
+1 15 NYA
+1 15 TTA
+1 17 HA
+1 15 DDA
+1 17 HA
+1 15 NNA
+1 15 TA
+1 16 HA
+1 15 DA
+1 16 HA
+1 15 NA
+1 15 PA
+1 16 HA
+1 15 BA
+1 16 HA
+1 15 MA
+1 15 TSA
+1 17 HA
+1 15 DZA
+1 15 ZHA
+1 16 A
+1 15 -A
+1 15 YA
+1 15 RA
+1 15 LA
+1 15 VA
+1 15 SHA
+1 16 SA
+1 16 A
+1 15 HA
+1 15 KSSA
+1 8 SIGN JIHVAMULIYA
+1 13 UPADHMANIYA
+1 8 CLUSTER-INITIAL LETTER RA
+1 31 LA
+1 31 SHA
+1 32 A
+1 8 FINAL CONSONANT SIGN G
+1 29 K
+1 29 NG
+1 29 D
+1 29 N
+1 29 B
+1 29 M
+1 29 R
+1 29 L
+1 29 SH
+1 30 
+1 29 -A
+1 8 SIGN ANUSVARA
+1 13 VISARGA
+1 8 GEMINATION MARK
+1 8 SUBJOINER
+1 8 MARK TSHEG
+1 13 SHAD
+1 13 DOUBLE SHAD
+1 13 PLUTA
+1 8 HEAD MARK WITH MOON AND SUN AND TRIPLE FLAME
+1 40 FLAME
+1 35 
+1 8 TERMINAL MARK-1
+1 22 2
+e 0 CANADIAN SYLLABICS NATTILIK HI
+1 30 I
+1 29 O
+1 30 O
+1 29 A
+1 30 A
+1 28 SHRI
+1 32 I
+1 31 O
+1 32 O
+1 31 A
+1 32 A
+1 19 SPE
+1 21 I
+1 21 O
+1 21 A
+1 0 PAU CIN HAU LETTER PA
+1 19 KA
+1 19 LA
+1 19 MA
+1 19 DA
+1 19 ZA
+1 19 VA
+1 19 NGA
+1 19 HA
+1 19 GA
+1 19 KHA
+1 19 SA
+1 19 BA
+1 19 CA
+1 19 TA
+1 20 HA
+1 19 NA
+1 19 PHA
+1 19 RA
+1 19 FA
+1 19 CHA
+1 19 A
+1 19 E
+1 19 I
+1 19 O
+1 19 U
+1 20 A
+1 19 IA
+1 19 FINAL P
+1 25 K
+1 25 T
+1 25 M
+1 25 N
+1 25 L
+1 25 W
+1 25 NG
+1 25 Y
+1 12 RISING TONE LONG
+1 23 
+1 12 SANDHI GLOTTAL STOP
+1 12 RISING TONE LONG FINAL
+1 24 FINAL
+1 12 SANDHI GLOTTAL STOP FINAL
+1 19 TONE LONG
+1 23 
+1 23  LONG FINAL
+1 24 FINAL
+1 12 MID-LEVEL TONE
+1 12 GLOTTAL STOP VARIANT
+1 12 MID-LEVEL TONE LONG FINAL
+1 27 FINAL
+1 12 LOW-FALLING TONE LONG
+1 28 
+1 12 GLOTTAL STOP
+1 12 LOW-FALLING TONE LONG FINAL
+1 29 FINAL
+1 12 GLOTTAL STOP FINAL
+108 0 BHAIKSUKI LETTER A
+1 18 A
+1 17 I
+1 18 I
+1 17 U
+1 18 U
+1 17 VOCALIC R
+1 26 R
+1 25 L
+2 17 E
+1 17 AI
+1 17 O
+1 17 AU
+1 17 KA
+1 18 HA
+1 17 GA
+1 18 HA
+1 17 NGA
+1 17 CA
+1 18 HA
+1 17 JA
+1 18 HA
+1 17 NYA
+1 17 TTA
+1 19 HA
+1 17 DDA
+1 19 HA
+1 17 NNA
+1 17 TA
+1 18 HA
+1 17 DA
+1 18 HA
+1 17 NA
+1 17 PA
+1 18 HA
+1 17 BA
+1 18 HA
+1 17 MA
+1 17 YA
+1 17 RA
+1 17 LA
+1 17 VA
+1 17 SHA
+1 18 SA
+1 18 A
+1 17 HA
+1 10 VOWEL SIGN AA
+1 21 I
+1 22 I
+1 21 U
+1 22 U
+1 21 VOCALIC R
+1 30 R
+1 29 L
+2 21 E
+1 21 AI
+1 21 O
+1 21 AU
+1 10 SIGN CANDRABINDU
+1 15 ANUSVARA
+1 15 VISARGA
+1 17 RAMA
+1 15 AVAGRAHA
+1 10 DANDA
+1 11 OUBLE DANDA
+1 10 WORD SEPARATOR
+1 10 GAP FILLER-1
+1 21 2
+b 10 DIGIT ZERO
+1 16 ONE
+1 16 TWO
+1 17 HREE
+1 16 FOUR
+1 17 IVE
+1 16 SIX
+1 17 EVEN
+1 16 EIGHT
+1 16 NINE
+1 10 NUMBER ONE
+1 17 TWO
+1 18 HREE
+1 17 FOUR
+1 18 IVE
+1 17 SIX
+1 18 EVEN
+1 17 EIGHT
+1 17 NINE
+1 17 TEN
+1 18 WENTY
+1 18 HIRTY
+1 17 FORTY
+1 18 IFTY
+1 17 SIXTY
+1 18 EVENTY
+1 17 EIGHTY
+1 17 NINETY
+1 10 HUNDREDS UNIT MARK
+4 0 MARCHEN HEAD MARK
+1 8 MARK SHAD
+1 8 LETTER KA
+1 16 HA
+1 15 GA
+1 15 NGA
+1 15 CA
+1 16 HA
+1 15 JA
+1 15 NYA
+1 15 TA
+1 16 HA
+1 15 DA
+1 15 NA
+1 15 PA
+1 16 HA
+1 15 BA
+1 15 MA
+1 15 TSA
+1 17 HA
+1 15 DZA
+1 15 WA
+1 15 ZHA
+1 16 A
+1 15 -A
+1 15 YA
+1 15 RA
+1 15 LA
+1 15 SHA
+1 16 A
+1 15 HA
+1 15 A
+3 8 SUBJOINED LETTER KA
+1 26 HA
+1 25 GA
+1 25 NGA
+1 25 CA
+1 26 HA
+1 25 JA
+1 25 NYA
+1 25 TA
+1 26 HA
+1 25 DA
+1 25 NA
+1 25 PA
+1 26 HA
+1 25 BA
+1 25 MA
+1 25 TSA
+1 27 HA
+1 25 DZA
+1 25 WA
+1 25 ZHA
+1 26 A
+2 25 YA
+1 25 RA
+1 25 LA
+1 25 SHA
+1 26 A
+1 25 HA
+1 25 A
+1 8 VOWEL SIGN AA
+1 19 I
+1 19 U
+1 19 E
+1 19 O
+1 8 SIGN ANUSVARA
+1 13 CANDRABINDU
+4a 2 SARAM GONDI LETTER A
+1 22 A
+1 21 I
+1 22 I
+1 21 U
+1 22 U
+1 21 E
+2 21 AI
+1 21 O
+2 21 AU
+1 21 KA
+1 22 HA
+1 21 GA
+1 22 HA
+1 21 NGA
+1 21 CA
+1 22 HA
+1 21 JA
+1 22 HA
+1 21 NYA
+1 21 TTA
+1 23 HA
+1 21 DDA
+1 23 HA
+1 21 NNA
+1 21 TA
+1 22 HA
+1 21 DA
+1 22 HA
+1 21 NA
+1 21 PA
+1 22 HA
+1 21 BA
+1 22 HA
+1 21 MA
+1 21 YA
+1 21 RA
+1 21 LA
+1 21 VA
+1 21 SHA
+1 22 SA
+1 22 A
+1 21 HA
+1 21 LLA
+1 21 KSSA
+1 21 JNYA
+1 21 TRA
+1 14 VOWEL SIGN AA
+1 25 I
+1 26 I
+1 25 U
+1 26 U
+1 25 VOCALIC R
+4 25 E
+2 25 AI
+1 25 O
+2 25 AU
+1 14 SIGN ANUSVARA
+1 19 VISARGA
+1 19 NUKTA
+1 19 CANDRA
+1 19 HALANTA
+1 14 VIRAMA
+1 14 REPHA
+1 15 A-KARA
+9 14 DIGIT ZERO
+1 20 ONE
+1 20 TWO
+1 21 HREE
+1 20 FOUR
+1 21 IVE
+1 20 SIX
+1 21 EVEN
+1 20 EIGHT
+1 20 NINE
+7 0 GUNJALA GONDI LETTER A
+1 22 A
+1 21 I
+1 22 I
+1 21 U
+1 22 U
+2 21 EE
+1 21 AI
+2 21 OO
+1 21 AU
+1 21 YA
+1 21 VA
+1 21 BA
+1 22 HA
+1 21 MA
+1 21 KA
+1 22 HA
+1 21 TA
+1 22 HA
+1 21 LA
+1 21 GA
+1 22 HA
+1 21 DA
+1 22 HA
+1 21 NA
+1 21 CA
+1 22 HA
+1 21 TTA
+1 23 HA
+1 21 LLA
+1 21 JA
+1 22 HA
+1 21 DDA
+1 23 HA
+1 21 NGA
+1 21 PA
+1 22 HA
+1 21 HA
+1 21 RA
+1 21 SA
+1 14 VOWEL SIGN AA
+1 25 I
+1 26 I
+1 25 U
+1 26 U
+2 25 EE
+1 25 AI
+2 25 OO
+1 25 AU
+1 14 SIGN ANUSVARA
+1 19 VISARGA
+1 14 VIRAMA
+1 14 OM
+8 14 DIGIT ZERO
+1 20 ONE
+1 20 TWO
+1 21 HREE
+1 20 FOUR
+1 21 IVE
+1 20 SIX
+1 21 EVEN
+1 20 EIGHT
+1 20 NINE
+137 0 MAKASAR LETTER KA
+1 15 GA
+1 15 NGA
+1 15 PA
+1 15 BA
+1 15 MA
+1 15 TA
+1 15 DA
+1 15 NA
+1 15 CA
+1 15 JA
+1 15 NYA
+1 15 YA
+1 15 RA
+1 15 LA
+1 15 VA
+1 15 SA
+1 15 A
+1 8 ANGKA
+1 8 VOWEL SIGN I
+1 19 U
+1 19 E
+1 19 O
+1 8 PASSIMBANG
+1 8 END OF SECTION
+b8 0 LISU LETTER YHA
+10 0 TAMIL FRACTION ONE THREE-HUNDRED-AND-TWENTIETH
+1 19 ONE-HUNDRED-AND-SIXTIETH
+1 19 EIGHTIETH
+1 19 SIXTY-FOURTH
+1 19 FORTIETH
+1 19 THIRTY-SECOND
+1 15 THREE EIGHTIETHS
+1 21 SIXTY-FOURTHS
+1 15 ONE TWENTIETH
+1 19 SIXTEENTH-1
+1 29 2
+1 19 TENTH
+1 19 EIGHTH
+1 15 THREE TWENTIETHS
+1 21 SIXTEENTHS
+1 15 ONE FIFTH
+1 19 QUARTER
+1 19 HALF-1
+1 24 2
+1 15 THREE QUARTERS
+1 15 DOWNSCALING FACTOR KIIZH
+1 6 SIGN NEL
+1 11 CEVITU
+1 11 AAZHAAKKU
+1 11 UZHAKKU
+1 11 MUUVUZHAKKU
+1 11 KURUNI
+1 11 PATHAKKU
+1 11 MUKKURUNI
+1 11 KAACU
+1 11 PANAM
+1 12 ON
+1 11 VARAAKAN
+1 11 PAARAM
+1 11 KUZHI
+1 11 VELI
+1 6 WET CULTIVATION SIGN
+1 6 DRY CULTIVATION SIGN
+1 6 LAND SIGN
+1 6 SALT PAN SIGN
+1 6 TRADITIONAL CREDIT SIGN
+1 18 NUMBER SIGN
+1 6 CURRENT SIGN
+1 6 AND ODD SIGN
+1 6 SPENT SIGN
+1 6 TOTAL SIGN
+1 6 IN POSSESSION SIGN
+1 6 STARTING FROM SIGN
+1 7 IGN MUTHALIYA
+1 11 VAKAIYARAA
+e 6 PUNCTUATION END OF TEXT
+1 0 CUNEIFORM SIGN A
+1 16  TIMES A
+1 23 BAD
+1 23 GAN2 TENU
+1 23 HA
+1 23 IGI
+1 23 LAGAR GUNU
+1 23 MUSH
+1 23 SAG
+1 16 2
+1 16 B
+1 17  TIMES ASH2
+1 24 DUN3 GUNU
+1 24 GAL
+1 26 N2 TENU
+1 24 HA
+1 24 IGI GUNU
+1 25 MIN
+1 24 LAGAB
+1 24 SHESH
+1 24 U PLUS U PLUS U
+1 18 GUNU
+1 17 2
+1 18  TIMES BALAG
+1 25 GAN2 TENU
+1 25 ME PLUS EN
+1 25 SHA3
+1 25 TAK4
+1 16 D
+1 16 K
+1 17  TIMES ERIN2
+1 24 SHITA PLUS GISH
+1 16 L
+1 17  TIMES AL
+1 24 DIM2
+1 24 GISH
+1 24 HA
+1 24 KAD3
+1 25 I
+1 24 SHE
+1 24 USH
+1 17 AN
+1 17 EPH
+1 16 MAR
+1 19  TIMES SHE
+1 16 N
+1 17  OVER AN
+1 18 THREE TIMES
+1 18 PLUS NAGA OPPOSING AN PLUS NAGA
+1 28 SQUARED
+1 17 SHE
+1 16 PIN
+1 16 RAD
+1 19  TIMES KUR
+1 17 KAB
+1 16 SAL2
+1 17 H
+1 18  ZIDA TENU
+1 19 KABA TENU
+1 19 OVER ASH TUG2 OVER TUG2 TUG2 OVER TUG2 PAP
+1 28 OVER ASH
+1 36  CROSSING ASH OVER ASH OVER ASH
+1 18 2
+1 18 GAB
+1 15 BA
+1 17 D
+1 17 G3
+1 17 HAR2
+1 17 L
+1 18  OVER BAL
+1 18 AG
+1 17 R
+1 18 A2
+1 16 I
+1 17  TIMES A
+1 24 GAR
+1 24 IGI GUNU
+1 16 U
+1 17  OVER BU AB
+1 26 UN
+1 18 CROSSING BU
+1 17 LUG
+1 20  OVER BULUG
+1 17 R
+1 18 2
+1 15 DA
+1 17 G
+1 18  KISIM5 TIMES A PLUS MASH
+1 33 MAR
+1 32 BALAG
+1 33 I
+1 32 GA
+1 34  PLUS MASH
+1 33 I
+1 34 R2
+1 33 UD
+1 32 HA
+1 32 IR
+1 34  PLUS LU
+1 32 KAK
+1 32 LA
+1 33 U
+1 34  PLUS MASH2
+1 34 M
+1 32 NE
+1 32 PAP PLUS PAP
+1 32 SI
+1 32 TAK4
+1 32 U2 PLUS GIR2
+1 33 SH
+1 17 M
+1 17 R
+1 18 A3
+1 19 4
+1 16 I
+1 17 B
+1 17 M
+1 18  TIMES SHE
+1 18 2
+1 17 N
+1 18  KASKAL U GUNU DISH
+1 17 SH
+1 16 U
+1 17  OVER DU
+1 18 GUNU
+1 18 SHESHIG
+1 17 B
+1 18  TIMES ESH2
+1 18 2
+1 17 G
+1 18 UD
+1 17 H
+1 17 N
+1 18 3
+1 19  GUNU
+1 24  GUNU
+1 18 4
+1 17 R2
+1 15 E
+1 16  TIMES PAP
+1 17 OVER E NUN OVER NUN
+1 16 2
+1 17  TIMES A PLUS HA PLUS DA
+1 24 GAR
+1 24 MI
+1 24 SAL
+1 25 HE
+1 24 U
+1 16 DIN
+1 16 GIR
+1 16 L
+1 16 N
+1 17  TIMES GAN2
+1 28  TENU
+1 24 ME
+1 18 CROSSING EN
+1 18 OPPOSING EN
+1 18 SQUARED
+1 16 REN
+1 17 IN2
+1 16 SH2
+1 16 ZEN
+1 19  TIMES A
+1 27  PLUS LAL
+1 36  TIMES LAL
+1 27 N
+1 26 BAD
+1 26 DUN3 GUNU
+1 35  GUNU
+1 26 HA
+1 28  GUNU
+1 26 IGI GUNU
+1 26 KASKAL
+1 32  SQUARED
+1 27 U3
+1 26 LA
+1 28 L TIMES LAL
+1 27 I
+1 27 U
+1 26 U2
+1 27 D
+1 15 GA
+1 17  GUNU
+1 17 2
+1 18  TIMES A PLUS DA PLUS HA
+1 32 HA
+1 32 IGI
+1 26 B2 TENU PLUS TAB
+1 26 N
+1 26 SH
+1 28 2 PLUS GAL
+1 25 BAD
+1 27 R PLUS RA
+1 26 UR
+1 28  PLUS RA
+1 25 DA
+1 26 I
+1 27 M TIMES SHE
+1 26 UB
+1 25 EL
+1 27  PLUS LA
+1 26 N
+1 27  TIMES GAN2 TENU
+1 25 GAN2 TENU
+1 27 R
+1 26 I
+1 27 4
+1 28  PLUS A
+1 27 R2 PLUS SU
+1 25 HA PLUS LU PLUS ESH2
+1 27 L
+1 28  PLUS LA
+1 26 I PLUS LI
+1 26 UB2
+1 25 IGI GUNU
+1 26 SH PLUS HU PLUS ASH
+1 25 KAK
+1 27 SKAL
+1 26 ID
+1 28  PLUS LAL
+1 26 U3 PLUS AN
+1 25 LA
+1 25 ME PLUS EN
+1 26 I
+1 25 NUN
+1 28  OVER NUN
+1 25 PA
+1 25 SAL
+1 27 R
+1 26 HE
+1 28  PLUS TUR
+1 27 ID
+1 26 UM
+1 25 TAK4
+1 25 U
+1 26 D
+1 27  PLUS DU
+1 19 OVER GA2
+1 17 BA
+1 19  CROSSING GABA
+1 17 D
+1 18  OVER GAD GAR OVER GAR
+1 17 L
+1 18  GAD OVER GAD GAR OVER GAR
+1 18 AM
+1 17 M
+1 17 N
+1 18 2
+1 19  TENU
+1 20 OVER GAN2
+1 20 CROSSING GAN2
+1 17 R
+1 18 3
+1 17 SHAN
+1 16 ESHTIN
+1 22  TIMES KUR
+1 16 I
+1 17  TIMES E
+1 24 U
+1 18 CROSSING GI
+1 17 4
+1 18  OVER GI4
+1 19 CROSSING GI4
+1 17 DIM
+1 17 R2
+1 19  GUNU
+1 18 3
+1 19  TIMES A PLUS IGI
+1 26 GAN2 TENU
+1 26 IGI
+1 26 LU PLUS IGI
+1 26 PA
+1 17 SAL
+1 18 H
+1 19  CROSSING GISH
+1 20 TIMES BAD
+1 26 TAK4
+1 21 ENU
+1 16 U
+1 17  CROSSING GU
+1 17 2
+1 18  TIMES KAK
+1 28  TIMES IGI GUNU
+1 25 NUN
+1 25 SAL PLUS TUG2
+1 19 GUNU
+1 17 D
+1 18  TIMES A PLUS KUR
+1 25 KUR
+1 19 OVER GUD LUGAL
+1 17 L
+1 17 M
+1 18  TIMES SHE
+1 17 R
+1 18 7
+1 18 UN
+1 19 SH
+1 15 HA
+1 17  TENU
+1 18 GUNU
+1 17 L
+1 16 I
+1 17  TIMES ASH
+1 27 2
+1 24 BAD
+1 24 DISH
+1 24 GAD
+1 24 KIN
+1 24 NUN
+1 24 SHE
+1 24 U
+1 16 U
+1 17 B2
+1 19  TIMES AN
+1 26 HAL
+1 26 KASKAL
+1 26 LISH
+1 26 UD
+1 17 L2
+1 15 I
+1 16  A
+1 16 B
+1 16 DIM
+1 19  OVER IDIM BUR
+1 30 SQUARED
+1 16 G
+1 17 I
+1 18  DIB
+1 19 RI
+1 19 OVER IGI SHIR OVER SHIR UD OVER UD
+1 19 GUNU
+1 16 L
+1 17  TIMES GAN2 TENU
+1 17 2
+1 16 M
+1 17  TIMES TAK4
+1 18 CROSSING IM
+1 18 OPPOSING IM
+1 18 SQUARED
+1 17 IN
+1 16 N
+1 16 R
+1 16 SH
+1 15 KA
+1 17  TIMES A
+1 25 D
+1 26  PLUS KU3
+1 25 SH2
+1 24 BAD
+1 26 LAG
+1 26 R
+1 25 I
+1 24 ERIN2
+1 25 SH2
+1 24 GA
+1 26 L
+1 26 N2 TENU
+1 26 R
+1 27  PLUS SHA3 PLUS A
+1 25 I
+1 26 R2
+1 26 SH PLUS SAR
+1 29 CROSSING GISH
+1 25 U
+1 26 R7
+1 24 IGI
+1 25 M
+1 24 KAK
+1 25 I
+1 26 D
+1 24 LI
+1 25 U
+1 24 ME
+1 26  PLUS DU
+1 32 GI
+1 32 TE
+1 25 I
+1 26  PLUS NUNUZ
+1 24 NE
+1 25 UN
+1 24 PI
+1 24 RU
+1 24 SA
+1 26 R
+1 25 HA
+1 26 E
+1 26 ID
+1 26 U
+1 25 IG
+1 25 UHUR
+1 24 TAR
+1 24 U
+1 25 2
+1 25 D
+1 25 MUM TIMES PA
+1 25 SH
+1 24 ZI
+1 17 2
+1 18  CROSSING KA2
+1 17 B
+1 17 D2
+1 18 3
+1 18 4
+1 18 5
+1 19  OVER KAD5
+1 17 K
+1 18  TIMES IGI GUNU
+1 17 L
+1 18  TIMES BAD
+1 19 CROSSING KAL
+1 17 M2
+1 18 4
+1 17 SKAL
+1 21  LAGAB TIMES U OVER LAGAB TIMES U
+1 22 OVER KASKAL LAGAB TIMES U OVER LAGAB TIMES U
+1 16 ESH2
+1 16 I
+1 17  TIMES BAD
+1 24 U
+1 25 D
+1 17 D
+1 17 N
+1 17 SAL
+1 18 H
+1 18 IM5
+1 21  OVER KISIM5
+1 16 U
+1 17  OVER HI TIMES ASH2 KU OVER HI TIMES ASH2
+1 17 3
+1 17 4
+1 18  VARIANT FORM
+1 17 7
+1 17 L
+1 18  GUNU
+1 17 N
+1 17 R
+1 18  OPPOSING KUR
+1 17 SHU2
+1 16 WU318
+1 15 LA
+1 17 GAB
+1 20  TIMES A
+1 28  PLUS DA PLUS HA
+1 34 GAR
+1 34 LAL
+1 28 L
+1 28 N
+1 28 SH ZIDA TENU
+1 27 BAD
+1 28 I
+1 27 DAR
+1 27 EN
+1 27 GA
+1 29 R
+1 28 UD
+1 30  PLUS GUD
+1 27 HA
+1 29 L
+1 28 I TIMES NUN
+1 27 IGI GUNU
+1 28 M
+1 29  PLUS HA
+1 35 LU
+1 27 KI
+1 29 N
+1 28 U3
+1 29 L
+1 30  PLUS HI PLUS A
+1 27 LAGAB
+1 28 ISH
+1 28 U
+1 29 L
+1 27 ME
+1 29  PLUS EN
+1 28 USH
+1 27 NE
+1 27 SHE PLUS SUM
+1 29 ITA PLUS GISH PLUS ERIN2
+1 43 TENU
+1 29 U2
+1 31  PLUS SHU2
+1 28 UM
+1 27 TAG
+1 29 K4
+1 28 E PLUS A PLUS SU PLUS NA
+1 27 U
+1 28  PLUS A
+1 34 U PLUS U
+1 28 2 PLUS ASH
+1 28 D
+1 28 SH
+1 21 SQUARED
+1 19 R
+1 20  TIMES SHE
+1 30  PLUS SUM
+1 21 GUNU
+1 25  OVER LAGAR GUNU SHE
+1 17 HSHU
+1 17 L
+1 18  TIMES LAL
+1 17 M
+1 18  TIMES KUR
+1 28  PLUS RU
+1 16 I
+1 17 L
+1 17 MMU2
+1 17 SH
+1 16 U
+1 17  TIMES BAD
+1 17 2
+1 18  TIMES AL
+1 25 BAD
+1 25 ESH2
+1 29  TENU
+1 25 GAN2 TENU
+1 25 HI TIMES BAD
+1 25 IM
+1 25 KAD2
+1 28 3
+1 29  PLUS ASH
+1 26 I
+1 25 LA PLUS ASH
+1 27 GAB
+1 25 ME PLUS EN
+1 25 NE
+1 26 U
+1 25 SI PLUS ASH
+1 27 K2 PLUS BU
+1 25 TUG2
+1 20 ENU
+1 19 CROSSING LU2
+1 19 OPPOSING LU2
+1 19 SQUARED
+1 20 HESHIG
+1 17 3
+1 17 GAL
+1 20  OVER LUGAL
+1 22 PPOSING LUGAL
+1 21 SHESHIG
+1 17 H
+1 17 L
+1 17 M
+1 18  OVER LUM
+1 27  GAR OVER GAR
+1 15 MA
+1 17  TIMES TAK4
+1 18 GUNU
+1 17 2
+1 17 H
+1 17 R
+1 17 SH
+1 19 2
+1 16 E
+1 17 S
+1 16 I
+1 17 N
+1 16 U
+1 17  OVER MU
+1 17 G
+1 18  GUNU
+1 17 NSUB
+1 17 RGU2
+1 17 SH
+1 19  TIMES A
+1 26 KUR
+1 26 ZA
+1 20 OVER MUSH
+1 29  TIMES A PLUS NA
+1 20 CROSSING MUSH
+1 19 3
+1 20  TIMES A
+1 28  PLUS DI
+1 27 DI
+1 21 GUNU
+1 15 NA
+1 17 2
+1 17 GA
+1 19  INVERTED
+1 20 TIMES SHU TENU
+1 20 OPPOSING NAGA
+1 19 R
+1 17 M NUTILLU
+1 18 
+1 18 2
+1 16 E
+1 17  TIMES A
+1 24 UD
+1 18 SHESHIG
+1 16 I
+1 17  TIMES E
+1 17 2
+1 17 M
+1 18  TIMES GAN2 TENU
+1 27 R PLUS GAN2 TENU
+1 17 NDA2
+1 21  TIMES AN
+1 29 SH
+1 31  PLUS ASH
+1 28 GUD
+1 28 ME PLUS GAN2 TENU
+1 28 NE
+1 29 UN
+1 28 SHE
+1 31  PLUS A AN
+1 38 SH
+1 40  PLUS ASH
+1 28 U2 PLUS ASH
+1 29 SH
+1 17 SAG
+1 16 U
+1 17 11
+1 17 N
+1 18  LAGAR TIMES GAR
+1 31 MASH
+1 31 SAL
+1 34  OVER NUN LAGAR TIMES SAL
+1 31 USH
+1 19 TENU
+1 19 OVER NUN
+1 19 CROSSING NUN
+1 31  LAGAR OVER LAGAR
+1 18 UZ
+1 20  AB2 TIMES ASHGAB
+1 31 BI
+1 31 DUG
+1 31 GUD
+1 31 IGI GUNU
+1 31 KAD3
+1 31 LA
+1 31 NE
+1 31 SILA3
+1 31 U2
+1 21 KISIM5 TIMES BI
+1 36  U
+1 15 PA
+1 17 D
+1 17 N
+1 17 P
+1 16 ESH2
+1 16 I
+1 17  TIMES A
+1 25 B
+1 24 BI
+1 25 U
+1 24 E
+1 24 I
+1 25 B
+1 24 U
+1 25 2
+1 18 CROSSING PI
+1 17 RIG
+1 20  TIMES KAL
+1 27 UD
+1 27 ZA
+1 21 OPPOSING PIRIG
+1 15 RA
+1 17 B
+1 16 I
+1 16 U
+1 15 SA
+1 17 G NUTILLU
+1 18 
+1 18  TIMES A
+1 25 DU
+1 27 B
+1 25 HA
+1 25 KAK
+1 26 UR
+1 25 LUM
+1 25 MI
+1 25 NUN
+1 25 SAL
+1 26 HID
+1 25 TAB
+1 25 U2
+1 26 B
+1 26 M
+1 26 R
+1 26 SH
+1 19 OVER SAG
+1 19 GUNU
+1 17 L
+1 18  LAGAB TIMES ASH2
+1 17 NGA2
+1 17 R
+1 16 HA
+1 18 3
+1 19  TIMES A
+1 26 BAD
+1 26 GISH
+1 26 NE
+1 26 SHU2
+1 26 TUR
+1 26 U
+1 27  PLUS A
+1 18 6
+1 18 B6
+1 18 R2
+1 17 E
+1 18  HU
+1 19 OVER SHE GAD OVER GAD GAR OVER GAR
+1 28 TAB OVER TAB GAR OVER GAR
+1 18 G9
+1 18 N
+1 18 SH
+1 20 2
+1 20 LAM
+1 17 ID
+1 19  TIMES A
+1 26 IM
+1 18 M
+1 19  TIMES A
+1 26 BAL
+1 27 ULUG
+1 26 DIN
+1 26 GAR
+1 26 IGI
+1 29  GUNU
+1 26 KUSHU2
+1 26 LUL
+1 26 MUG
+1 26 SAL
+1 18 NIG
+1 18 R
+1 19  TENU
+1 20 OVER SHIR BUR OVER BUR
+1 18 TA
+1 17 U
+1 18  OVER INVERTED SHU
+1 18 2
+1 18 BUR
+1 16 I
+1 17  GUNU
+1 17 G
+1 18 4
+1 19  OVER SIG4 SHU2
+1 17 K2
+1 17 LA3
+1 16 U
+1 17  OVER SU
+1 17 D
+1 18 2
+1 17 HUR
+1 17 M
+1 18 ASH
+1 17 R
+1 18 9
+1 15 TA
+1 17  ASTERISK
+1 18 TIMES HI
+1 24 MI
+1 18 GUNU
+1 17 B
+1 18  OVER TAB NI OVER NI DISH OVER DISH
+1 19 SQUARED
+1 17 G
+1 18  TIMES BI
+1 25 GUD
+1 25 SHE
+1 27 U
+1 25 TUG2
+1 25 UD
+1 17 K4
+1 17 R
+1 16 E
+1 17  GUNU
+1 16 I
+1 17  TENU
+1 17 L
+1 17 R
+1 18  TIMES TAK4
+1 19 OVER TIR
+1 27  GAD OVER GAD GAR OVER GAR
+1 16 U
+1 17 G2
+1 17 K
+1 17 M
+1 17 R
+1 18  OVER TUR ZA OVER ZA
+1 15 U
+1 16  GUD
+1 17 U U
+1 17 OVER U PA OVER PA GAR OVER GAR
+1 24 SUR OVER SUR
+1 24 U REVERSED OVER U REVERSED
+1 16 2
+1 16 B
+1 16 D
+1 17  KUSHU2
+1 18 TIMES BAD
+1 24 MI
+1 24 U PLUS U PLUS U
+1 39  GUNU
+1 18 GUNU
+1 18 SHESHIG
+1 25  TIMES BAD
+1 17 UG
+1 16 M
+1 17  TIMES LAGAB
+1 24 ME PLUS DA
+1 24 SHA3
+1 24 U
+1 17 BIN
+1 17 UM
+1 19  TIMES KASKAL
+1 26 PA
+1 16 N
+1 17  GUNU
+1 16 R
+1 17  CROSSING UR
+1 18 SHESHIG
+1 17 2
+1 18  TIMES A PLUS HA
+1 32 NA
+1 26 L
+1 25 HA
+1 25 NUN
+1 25 U2
+1 27  PLUS ASH
+1 33 BI
+1 17 4
+1 17 I
+1 18 3
+1 17 U
+1 18  TIMES A
+1 26 SHGAB
+1 25 BAR
+1 25 DUN
+1 25 GA
+1 27 L
+1 27 N2 TENU
+1 27 R
+1 26 U
+1 25 HA
+1 25 IGI
+1 26 M
+1 26 SH
+1 25 KI
+1 25 LUM
+1 25 MIN
+1 25 PA
+1 25 SHE
+1 26 IG4
+1 25 TU
+1 25 U PLUS GUD
+1 26 D
+1 26 RUDA
+1 18 DA
+1 20  TIMES U
+1 16 SH
+1 18  TIMES A
+1 25 KU
+1 27 R
+1 25 TAK4
+1 18 X
+1 18 2
+1 18 UMX
+1 16 TUKI
+1 16 Z3
+1 18  TIMES KASKAL
+1 17 U
+1 15 ZA
+1 17  TENU
+1 18 SQUARED TIMES KUR
+1 17 G
+1 17 MX
+1 16 E2
+1 16 I
+1 17  OVER ZI
+1 17 3
+1 17 B
+1 18  KABA TENU
+1 17 G
+1 17 Z2
+1 16 U
+1 17 5
+1 18  TIMES A
+1 17 BUR
+1 17 M
+1 15 KAP ELAMITE
+1 15 AB TIMES NUN
+1 17 2 TIMES A
+1 16 MAR TIMES KUG
+1 15 DAG KISIM5 TIMES U2 PLUS MASH
+1 18 3
+1 16 ISH PLUS SHU
+1 16 UB TIMES SHE
+1 15 EZEN TIMES GUD
+1 26 SHE
+1 15 GA2 TIMES AN PLUS KAK PLUS A
+1 26 SH2
+1 16 E22
+1 16 IG
+1 15 HUSH
+1 15 KA TIMES ANSHE
+1 25 SH3
+1 24 GISH
+1 25 UD
+1 24 HI TIMES ASH2
+1 24 LUM
+1 24 PA
+1 24 SHUL
+1 24 TU
+1 24 UR2
+1 15 LAGAB TIMES GI
+1 16 U2 SHESHIG TIMES BAD
+1 19 TIMES ESH2 PLUS LAL
+1 25 SHU
+1 15 MESH
+1 16 USH3 TIMES ZA
+1 15 NA4
+1 16 IN
+1 18 9
+1 18 DA2 TIMES BAL
+1 28 GI
+1 16 U11 ROTATED NINETY DEGREES
+1 15 PESH2 ASTERISK
+1 16 IR2
+1 15 SAG TIMES IGI GUNU
+1 15 TI2
+1 15 UM TIMES ME
+1 16  U
+67 10 NUMERIC SIGN TWO ASH
+1 24 HREE ASH
+1 23 FOUR ASH
+1 24 IVE ASH
+1 23 SIX ASH
+1 24 EVEN ASH
+1 23 EIGHT ASH
+1 23 NINE ASH
+1 23 THREE DISH
+1 23 FOUR DISH
+1 24 IVE DISH
+1 23 SIX DISH
+1 24 EVEN DISH
+1 23 EIGHT DISH
+1 23 NINE DISH
+1 23 FOUR U
+1 24 IVE U
+1 23 SIX U
+1 24 EVEN U
+1 23 EIGHT U
+1 23 NINE U
+1 23 ONE GESH2
+1 23 TWO GESH2
+1 24 HREE GESH2
+1 23 FOUR GESH2
+1 24 IVE GESH2
+1 23 SIX GESH2
+1 24 EVEN GESH2
+1 23 EIGHT GESH2
+1 23 NINE GESH2
+1 23 ONE GESHU
+1 23 TWO GESHU
+1 24 HREE GESHU
+1 23 FOUR GESHU
+1 24 IVE GESHU
+1 23 TWO SHAR2
+1 24 HREE SHAR2
+1 34  VARIANT FORM
+1 23 FOUR SHAR2
+1 24 IVE SHAR2
+1 23 SIX SHAR2
+1 24 EVEN SHAR2
+1 23 EIGHT SHAR2
+1 23 NINE SHAR2
+1 23 ONE SHARU
+1 23 TWO SHARU
+1 24 HREE SHARU
+1 34  VARIANT FORM
+1 23 FOUR SHARU
+1 24 IVE SHARU
+1 23 SHAR2 TIMES GAL PLUS DISH
+1 44 MIN
+1 23 ONE BURU
+1 23 TWO BURU
+1 24 HREE BURU
+1 33  VARIANT FORM
+1 23 FOUR BURU
+1 24 IVE BURU
+1 23 THREE VARIANT FORM ESH16
+1 45 21
+1 23 FOUR VARIANT FORM LIMMU
+1 46 4
+1 46  A
+1 47 B
+1 23 SIX VARIANT FORM ASH9
+1 24 EVEN VARIANT FORM IMIN3
+1 46  A
+1 47 B
+1 23 EIGHT VARIANT FORM USSU
+1 46 3
+1 23 NINE VARIANT FORM ILIMMU
+1 47 3
+1 47 4
+1 47  A
+1 23 TWO ASH TENU
+1 24 HREE ASH TENU
+1 23 FOUR ASH TENU
+1 24 IVE ASH TENU
+1 23 SIX ASH TENU
+1 23 ONE BAN2
+1 23 TWO BAN2
+1 24 HREE BAN2
+1 23 FOUR BAN2
+1 32  VARIANT FORM
+1 24 IVE BAN2
+1 32  VARIANT FORM
+1 23 NIGIDAMIN
+1 29 ESH
+1 23 ONE ESHE3
+1 23 TWO ESHE3
+1 23 ONE THIRD DISH
+1 23 TWO THIRDS DISH
+1 23 FIVE SIXTHS DISH
+1 23 ONE THIRD VARIANT FORM A
+1 23 TWO THIRDS VARIANT FORM A
+1 23 ONE EIGHTH ASH
+1 27 QUARTER ASH
+1 24 LD ASSYRIAN ONE SIXTH
+1 40 QUARTER
+1 24 NE QUARTER GUR
+1 27 HALF GUR
+1 23 ELAMITE ONE THIRD
+1 31 TWO THIRDS
+1 31 FORTY
+1 32 IFTY
+1 23 FOUR U VARIANT FORM
+1 24 IVE U VARIANT FORM
+1 23 SIX U VARIANT FORM
+1 24 EVEN U VARIANT FORM
+1 23 EIGHT U VARIANT FORM
+1 23 NINE U VARIANT FORM
+2 10 PUNCTUATION SIGN OLD ASSYRIAN WORD DIVIDER
+1 27 VERTICAL COLON
+1 27 DIAGONAL COLON
+1 36 TRICOLON
+1 36 QUADCOLON
+c 10 SIGN AB TIMES NUN TENU
+1 24 SHU2
+1 16 D TIMES ESH2
+1 15 BAD TIMES DISH TENU
+1 17 HAR2 TIMES AB2
+1 28 NI
+1 28 ZA
+1 16 U OVER BU TIMES NA2
+1 15 DA TIMES TAK4
+1 17 G TIMES KUR
+1 16 IM TIMES IGI
+1 25 U U U
+1 18 2 TIMES UD
+1 16 UG TIMES ANSHE
+1 26 SH
+1 28  AT LEFT
+1 25 DIN
+1 26 UN
+1 25 ERIN2
+1 25 GA
+1 26 I
+1 27 R2 GUNU
+1 27 SH
+1 25 HA
+1 26 I
+1 25 IGI GUNU
+1 25 KASKAL
+1 26 UR
+1 27 SHU2
+1 31  PLUS KASKAL
+1 25 LAK-020
+1 27 M
+1 28  TIMES KUR
+1 26 UH PLUS GISH
+1 25 MASH
+1 26 ES
+1 26 I
+1 25 NI
+1 25 PI
+1 25 SHE
+1 26 I GUNU
+1 15 E2 TIMES KUR
+1 24 PAP
+1 16 RIN2 X
+1 16 SH2 CROSSING ESH2
+1 16 ZEN SHESHIG TIMES ASH
+1 34 HI
+1 34 IGI GUNU
+1 34 LA
+1 36 L
+1 34 ME
+1 36 S
+1 34 SU
+1 20 TIMES SU
+1 15 GA2 TIMES BAHAR2
+1 25 DIM GUNU
+1 26 UG TIMES IGI GUNU
+1 35 KASKAL
+1 25 EREN
+1 25 GA
+1 27 R PLUS DI
+1 34 NE
+1 25 HA PLUS A
+1 25 KUSHU2 PLUS KASKAL
+1 25 LAM
+1 28  TIMES KUR
+1 26 UH
+1 25 MUSH
+1 25 NE
+1 27  PLUS E2
+1 33 GI
+1 25 SHIM
+1 25 ZIZ2
+1 17 BA ROTATED NINETY DEGREES
+1 16 ESHTIN TIMES U
+1 16 ISH TIMES GISH CROSSING GISH
+1 16 U2 TIMES IGI GUNU
+1 17 D PLUS GISH TIMES TAK4
+1 15 HA TENU GUNU
+1 16 I TIMES ASH OVER HI TIMES ASH
+1 15 KA TIMES BU
+1 24 KA
+1 24 U U U
+1 25 R
+1 15 LAGAB TIMES ZU OVER ZU
+1 17 K-003
+1 20 21
+1 21 5
+1 20 30
+1 20 50
+1 21 1
+1 20 62
+1 20 79 OVER LAK-079 GUNU
+1 20 80
+1 21 1 OVER LAK-081
+1 20 92
+1 19 130
+1 20 42
+1 19 210
+1 21 9
+1 20 20
+1 21 5
+1 21 8
+1 20 38
+1 20 65
+1 21 6
+1 19 343
+1 21 7
+1 21 8
+1 20 83
+1 21 4
+1 20 90
+1 19 441
+1 21 9
+1 22  TIMES GU
+1 29 IGI
+1 29 PAP PLUS LU3
+1 38 PAP PLUS LU3
+1 29 U2 PLUS BA
+1 20 50
+1 21 7
+1 20 70
+1 20 83
+1 20 90
+1 21 2
+1 21 3
+1 21 5
+1 19 550
+1 19 608
+1 20 17
+1 22  TIMES ASH
+1 29 BAD
+1 29 DUN3 GUNU GUNU
+1 29 KU3
+1 29 LA
+1 29 TAR
+1 30 E
+1 29 U2
+1 30 D
+1 30 RUDA
+1 20 36
+1 20 48
+1 22  TIMES DUB
+1 29 GA
+1 29 IGI
+1 32  GUNU
+1 29 NI
+1 29 PAP PLUS PAP PLUS LU3
+1 29 SHESH PLUS KI
+1 29 UD
+1 30 RUDA
+1 19 724
+1 20 49
+1 16 U2 GUNU TIMES ASH
+1 19 TIMES DISH
+1 25 HAL
+1 25 PAP
+1 28  PLUS PAP PLUS LU3
+1 25 TAK4
+1 15 MI PLUS ZA7
+1 16 USH OVER MUSH TIMES GA
+1 36 KAK
+1 15 NINDA2 TIMES DIM GUNU
+1 28 GISH
+1 29 UL
+1 28 HI
+1 28 KESH2
+1 28 LAK-050
+1 28 MASH
+1 28 PAP PLUS PAP
+1 28 U
+1 29  PLUS U
+1 29 RUDA
+1 15 SAG GUNU TIMES HA
+1 19 TIMES EN
+1 25 SHE AT LEFT
+1 25 TAK4
+1 16 HA6 TENU
+1 17 E OVER SHE
+1 19 PLUS HUB2
+1 24 NAM2
+1 24 SAR
+1 17 U2 PLUS DUG TIMES NI
+1 25 E2 TIMES AN
+1 16 I TIMES TAK4
+1 15 TAK4 PLUS SAG
+1 16 UM TIMES GAN2 TENU
+1 25 THREE DISH
+1 15 UR2 INVERTED
+1 19 TIMES UD
+1 17 U TIMES DARA3
+1 25 LAK-668
+1 26 U3
+1 15 ZA7
+1 16 U OVER ZU PLUS SAR
+1 17 5 TIMES THREE DISH TENU
+a4d 1 YPRO-MINOAN SIGN CM001
+1 22 2
+1 22 4
+1 22 5
+1 22 6
+1 22 7
+1 22 8
+1 22 9
+1 21 10
+1 22 1
+1 22 2
+1 23 B
+1 22 3
+1 22 5
+1 22 7
+1 22 9
+1 21 21
+1 22 3
+1 22 4
+1 22 5
+1 22 6
+1 22 7
+1 22 8
+1 22 9
+1 21 30
+1 22 3
+1 22 4
+1 22 5
+1 22 6
+1 22 7
+1 22 8
+1 22 9
+1 21 40
+1 22 1
+1 22 4
+1 22 6
+1 22 7
+1 22 9
+1 21 50
+1 22 1
+1 22 2
+1 22 3
+1 22 4
+1 22 5
+1 22 6
+1 22 8
+1 22 9
+1 21 60
+1 22 1
+1 22 2
+1 22 3
+1 22 4
+1 22 6
+1 22 7
+1 22 8
+1 22 9
+1 21 70
+1 22 1
+1 22 2
+1 22 3
+1 22 4
+1 22 5
+1 23 B
+1 22 6
+1 22 8
+1 22 9
+1 21 80
+1 22 1
+1 22 2
+1 22 3
+1 22 4
+1 22 5
+1 22 6
+1 22 7
+1 22 8
+1 22 9
+1 21 90
+1 22 1
+1 22 2
+1 22 4
+1 22 5
+1 22 6
+1 22 7
+1 22 8
+1 22 9
+1 20 100
+1 22 1
+1 22 2
+1 22 3
+1 22 4
+1 22 5
+1 22 7
+1 22 8
+1 22 9
+1 21 10
+1 22 2
+1 22 4
+1 20 301
+1 22 2
+e 0 EGYPTIAN HIEROGLYPH A001
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 24 A
+1 23 6
+1 24 A
+1 24 B
+1 23 7
+1 23 8
+1 23 9
+1 22 10
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 24 A
+1 23 5
+1 23 6
+1 23 7
+1 24 A
+1 23 8
+1 23 9
+1 22 20
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 30
+1 23 1
+1 23 2
+1 24 A
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 40
+1 24 A
+1 23 1
+1 23 2
+1 24 A
+1 23 3
+1 24 A
+1 23 4
+1 23 5
+1 24 A
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 50
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 60
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 70
+1 20 B001
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 24 A
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 20 C001
+1 23 2
+1 24 A
+1 24 B
+1 24 C
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 10
+1 24 A
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 20
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 20 D001
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 24 A
+1 23 9
+1 22 10
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 20
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 24 A
+1 23 8
+1 23 9
+1 22 30
+1 23 1
+1 24 A
+1 23 2
+1 23 3
+1 23 4
+1 24 A
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 40
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 24 A
+1 23 7
+1 23 8
+1 24 A
+1 23 9
+1 22 50
+1 24 A
+1 24 B
+1 24 C
+1 24 D
+1 24 E
+1 24 F
+1 24 G
+1 24 H
+1 24 I
+1 23 1
+1 23 2
+1 24 A
+1 23 3
+1 23 4
+1 24 A
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 60
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 24 A
+1 24 B
+1 24 C
+1 24 D
+1 24 E
+1 24 F
+1 24 G
+1 24 H
+1 20 E001
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 24 A
+1 23 9
+1 24 A
+1 22 10
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 24 A
+1 23 7
+1 24 A
+1 23 8
+1 23 9
+1 22 20
+1 24 A
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 24 A
+1 23 9
+1 22 30
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 24 A
+1 23 6
+1 23 7
+1 23 8
+1 20 F001
+1 24 A
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 10
+1 23 1
+1 23 2
+1 23 3
+1 24 A
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 20
+1 23 1
+1 24 A
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 30
+1 23 1
+1 24 A
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 24 A
+1 23 8
+1 24 A
+1 23 9
+1 22 40
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 24 A
+1 23 6
+1 24 A
+1 23 7
+1 24 A
+1 23 8
+1 23 9
+1 22 50
+1 23 1
+1 24 A
+1 24 B
+1 24 C
+1 23 2
+1 23 3
+1 20 G001
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 24 A
+1 23 7
+1 24 A
+1 24 B
+1 23 8
+1 23 9
+1 22 10
+1 23 1
+1 24 A
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 20
+1 24 A
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 24 A
+1 23 7
+1 23 8
+1 23 9
+1 22 30
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 24 A
+1 23 7
+1 24 A
+1 23 8
+1 23 9
+1 22 40
+1 23 1
+1 23 2
+1 23 3
+1 24 A
+1 23 4
+1 23 5
+1 24 A
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 50
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 20 H001
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 24 A
+1 23 7
+1 23 8
+1 20 I001
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 24 A
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 24 A
+1 22 10
+1 24 A
+1 23 1
+1 24 A
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 20 K001
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 20 L001
+1 23 2
+1 24 A
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 24 A
+1 23 7
+1 23 8
+1 20 M001
+1 24 A
+1 24 B
+1 23 2
+1 23 3
+1 24 A
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 10
+1 24 A
+1 23 1
+1 23 2
+1 24 A
+1 24 B
+1 24 C
+1 24 D
+1 24 E
+1 24 F
+1 24 G
+1 24 H
+1 23 3
+1 23 4
+1 23 5
+1 24 A
+1 23 6
+1 24 A
+1 23 7
+1 24 A
+1 23 8
+1 23 9
+1 22 20
+1 23 1
+1 23 2
+1 24 A
+1 23 3
+1 23 4
+1 24 A
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 24 A
+1 23 9
+1 22 30
+1 23 1
+1 24 A
+1 23 2
+1 23 3
+1 24 A
+1 24 B
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 40
+1 24 A
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 20 N001
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 10
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 24 A
+1 24 B
+1 23 9
+1 22 20
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 24 A
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 30
+1 23 1
+1 23 2
+1 23 3
+1 24 A
+1 23 4
+1 24 A
+1 23 5
+1 24 A
+1 23 6
+1 23 7
+1 24 A
+1 23 8
+1 23 9
+1 22 40
+1 23 1
+1 23 2
+1 21 L001
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 25 A
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 10
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 25 A
+1 24 8
+1 24 9
+1 23 20
+1 21 U001
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 10
+1 25 A
+1 24 1
+1 25 A
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 25 A
+1 24 9
+1 23 20
+1 24 1
+1 24 2
+1 25 A
+1 20 O001
+1 24 A
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 24 A
+1 23 6
+1 24 A
+1 24 B
+1 24 C
+1 24 D
+1 24 E
+1 24 F
+1 23 7
+1 23 8
+1 23 9
+1 22 10
+1 24 A
+1 24 B
+1 24 C
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 24 A
+1 22 20
+1 24 A
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 24 A
+1 23 5
+1 24 A
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 24 A
+1 22 30
+1 24 A
+1 23 1
+1 23 2
+1 23 3
+1 24 A
+1 23 4
+1 23 5
+1 23 6
+1 24 A
+1 24 B
+1 24 C
+1 24 D
+1 23 7
+1 23 8
+1 23 9
+1 22 40
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 50
+1 24 A
+1 24 B
+1 23 1
+1 20 P001
+1 24 A
+1 23 2
+1 23 3
+1 24 A
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 10
+1 23 1
+1 20 Q001
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 20 R001
+1 23 2
+1 24 A
+1 23 3
+1 24 A
+1 24 B
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 10
+1 24 A
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 24 A
+1 23 7
+1 23 8
+1 23 9
+1 22 20
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 20 S001
+1 23 2
+1 24 A
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 24 A
+1 23 7
+1 23 8
+1 23 9
+1 22 10
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 24 A
+1 24 B
+1 23 5
+1 23 6
+1 23 7
+1 24 A
+1 23 8
+1 23 9
+1 22 20
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 24 A
+1 24 B
+1 23 7
+1 23 8
+1 23 9
+1 22 30
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 24 A
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 40
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 20 T001
+1 23 2
+1 23 3
+1 24 A
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 24 A
+1 23 8
+1 24 A
+1 23 9
+1 24 A
+1 22 10
+1 23 1
+1 24 A
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 24 A
+1 23 7
+1 23 8
+1 23 9
+1 22 20
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 30
+1 23 1
+1 23 2
+1 24 A
+1 23 3
+1 24 A
+1 23 4
+1 23 5
+1 23 6
+1 20 U001
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 24 A
+1 24 B
+1 23 7
+1 23 8
+1 23 9
+1 22 10
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 20
+1 23 1
+1 23 2
+1 23 3
+1 24 A
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 24 A
+1 22 30
+1 23 1
+1 23 2
+1 24 A
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 40
+1 23 1
+1 23 2
+1 20 V001
+1 24 A
+1 24 B
+1 24 C
+1 24 D
+1 24 E
+1 24 F
+1 24 G
+1 24 H
+1 24 I
+1 23 2
+1 24 A
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 24 A
+1 24 B
+1 23 8
+1 23 9
+1 22 10
+1 23 1
+1 24 A
+1 24 B
+1 24 C
+1 23 2
+1 24 A
+1 24 B
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 20
+1 24 A
+1 24 B
+1 24 C
+1 24 D
+1 24 E
+1 24 F
+1 24 G
+1 24 H
+1 24 I
+1 24 J
+1 24 K
+1 24 L
+1 23 1
+1 23 2
+1 23 3
+1 24 A
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 24 A
+1 23 9
+1 24 A
+1 22 30
+1 24 A
+1 23 1
+1 24 A
+1 23 2
+1 23 3
+1 24 A
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 24 A
+1 23 8
+1 23 9
+1 22 40
+1 24 A
+1 20 W001
+1 23 2
+1 23 3
+1 24 A
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 24 A
+1 22 10
+1 24 A
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 24 A
+1 23 5
+1 23 6
+1 23 7
+1 24 A
+1 23 8
+1 24 A
+1 23 9
+1 22 20
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 24 A
+1 23 5
+1 20 X001
+1 23 2
+1 23 3
+1 23 4
+1 24 A
+1 24 B
+1 23 5
+1 23 6
+1 24 A
+1 23 7
+1 23 8
+1 24 A
+1 20 Y001
+1 24 A
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 23 6
+1 23 7
+1 23 8
+1 20 Z001
+1 23 2
+1 24 A
+1 24 B
+1 24 C
+1 24 D
+1 23 3
+1 24 A
+1 24 B
+1 23 4
+1 24 A
+1 23 5
+1 24 A
+1 23 6
+1 23 7
+1 23 8
+1 23 9
+1 22 10
+1 23 1
+1 23 2
+1 23 3
+1 23 4
+1 23 5
+1 24 A
+1 24 B
+1 24 C
+1 24 D
+1 24 E
+1 24 F
+1 24 G
+1 24 H
+1 24 I
+1 23 6
+1 24 A
+1 24 B
+1 24 C
+1 24 D
+1 24 E
+1 24 F
+1 24 G
+1 24 H
+1 20 AA001
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 25 A
+1 25 B
+1 24 8
+1 24 9
+1 23 10
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 20
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 30
+1 24 1
+1 24 2
+2 20 VERTICAL JOINER
+1 20 HORIZONTAL JOINER
+1 20 INSERT AT TOP START
+1 30 BOTTOM START
+1 30 TOP END
+1 30 BOTTOM END
+1 20 OVERLAY MIDDLE
+1 20 BEGIN SEGMENT
+1 20 END SEGMENT
+fc8 0 ANATOLIAN HIEROGLYPH A001
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 10
+1 25 A
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 20
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 25 A
+1 24 7
+1 24 8
+1 24 9
+1 23 30
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 25 A
+1 23 40
+1 24 1
+1 25 A
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 25 A
+1 24 6
+1 25 A
+1 25 B
+1 24 7
+1 24 8
+1 24 9
+1 23 50
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 60
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 25 A
+1 25 B
+1 25 C
+1 24 7
+1 24 8
+1 24 9
+1 23 70
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 80
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 90
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 25 A
+1 24 8
+1 25 A
+1 24 9
+1 22 100
+1 25 A
+1 24 1
+1 25 A
+1 24 2
+1 25 A
+1 24 3
+1 24 4
+1 25 A
+1 25 B
+1 25 C
+1 24 5
+1 25 A
+1 25 B
+1 24 6
+1 24 7
+1 25 A
+1 25 B
+1 25 C
+1 24 8
+1 24 9
+1 23 10
+1 25 A
+1 25 B
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 25 A
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 20
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 25 A
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 30
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 25 A
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 40
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 50
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 60
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 70
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 80
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 90
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 22 200
+1 24 1
+1 24 2
+1 25 A
+1 25 B
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 25 A
+1 24 8
+1 24 9
+1 25 A
+1 23 10
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 25 A
+1 24 6
+1 25 A
+1 24 7
+1 24 8
+1 24 9
+1 23 20
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 25 A
+1 24 8
+1 24 9
+1 23 30
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 40
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 50
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 60
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 25 A
+1 24 8
+1 24 9
+1 23 70
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 80
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 25 A
+1 23 90
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 25 A
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 25 A
+1 22 300
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 25 A
+1 23 10
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 20
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 25 A
+1 23 30
+1 24 1
+1 24 2A
+1 25 B
+1 25 C
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 25 A
+1 25 B
+1 25 C
+1 24 7
+1 24 8
+1 24 9
+1 23 40
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 50
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 25 A
+1 23 60
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 25 A
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 25 A
+1 24 9
+1 23 70
+1 24 1
+1 25 A
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 80
+1 24 1
+1 25 A
+1 24 2
+1 24 3 RA OR RI
+1 25 A
+1 24 4
+1 24 5
+1 24 6
+1 25 A
+1 24 7
+1 24 8
+1 24 9
+1 23 90
+1 24 1
+1 24 2
+1 24 3 EIGHT
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 22 400
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 10 BEGIN LOGOGRAM MARK
+1 25 A END LOGOGRAM MARK
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 20
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 30
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 40
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 50
+1 25 A
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 25 A
+1 24 8
+1 24 9
+1 23 60
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 70
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 80
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 90
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 22 501
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 10
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 20
+1 24 1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 24 8
+1 24 9
+1 23 30
+21ba 0 BAMUM LETTER PHASE-A NGKUE MFON
+1 21 GBIEE FON
+1 21 PON MFON PIPAEMGBIEE
+1 36 BA
+1 21 NAA MFON
+1 21 SHUENSHUET
+1 21 TITA MFON
+1 21 NZA MFON
+1 21 SHINDA PA NJI
+1 21 PON PA NJI PIPAEMGBIEE
+1 38 BA
+1 21 MAEMBGBIEE
+1 21 TU MAEMBA
+1 21 NGANGU
+1 21 MAEMVEUX
+1 23 NSUAE
+1 22 VEUAENGAM
+1 21 SEUNYAM
+1 21 NTOQPEN
+1 21 KEUKEUTNDA
+1 21 NKINDI
+1 21 SUU
+1 21 NGKUENZEUM
+1 21 LAPAQ
+1 22 ET KUT
+1 21 NTAP MFAA
+1 21 MAEKEUP
+1 21 PASHAE
+1 21 GHEUAERAE
+1 21 PAMSHAE
+1 21 MON NGGEUAET
+1 21 NZUN MEUT
+1 21 U YUQ NAE
+1 21 GHEUAEGHEUAE
+1 21 NTAP NTAA
+1 21 SISA
+1 21 MGBASA
+1 22 EUNJOMNDEUQ
+1 22 OOMPUQ
+1 21 KAFA
+1 21 PA LEERAEWA
+1 21 NDA LEERAEWA
+1 21 PET
+1 21 MAEMKPEN
+1 21 NIKA
+1 21 PUP
+1 21 TUAEP
+1 21 LUAEP
+1 21 SONJAM
+1 21 TEUTEUWEN
+1 21 MAENYI
+1 21 KET
+1 21 NDAANGGEUAET
+1 21 KUOQ
+1 21 MOOMEUT
+1 21 SHUM
+1 21 LOMMAE
+1 21 FIRI
+1 21 ROM
+1 21 KPOQ
+1 21 SOQ
+1 21 MAP PIEET
+1 21 SHIRAE
+1 21 NTAP
+1 21 SHOQ NSHUT YUM
+1 21 NYIT MONGKEUAEQ
+1 21 PAARAE
+1 21 NKAARAE
+1 21 UNKNOWN
+1 21 NGGEN
+1 21 MAESI
+1 21 NJAM
+1 21 MBANYI
+1 21 NYET
+1 21 TEUAEN
+1 21 SOT
+1 21 PAAM
+1 21 NSHIEE
+1 21 MAEM
+1 21 NYI
+1 21 KAQ
+1 21 NSHA
+1 21 VEE
+1 21 LU
+1 21 NEN
+1 22 AQ
+1 21 MBAQ
+1 19 B NSHUET
+1 21 TU MAEMGBIEE
+1 21 SIEE
+1 22 ET TU
+1 21 LOM NTEUM
+1 21 MBA MAELEE
+1 21 KIEEM
+1 21 YEURAE
+1 21 MBAARAE
+1 21 KAM
+1 21 PEESHI
+1 21 YAFU LEERAEWA
+1 21 LAM NSHUT NYAM
+1 21 NTIEE SHEUOQ
+1 22 DU NJAA
+1 21 GHEUGHEUAEM
+1 21 PIT
+1 21 TU NSIEE
+1 21 SHET NJAQ
+1 24 UAEQTU
+1 21 MFON TEUAEQ
+1 22 BIT MBAAKET
+1 21 NYI NTEUM
+1 21 KEUPUQ
+1 21 GHEUGHEN
+1 21 KEUYEUX
+1 21 LAANAE
+1 21 PARUM
+1 21 VEUM
+1 21 NGKINDI MVOP
+1 23 GEU MBU
+1 21 WUAET
+1 21 SAKEUAE
+1 21 TAAM
+1 21 MEUQ
+1 21 NGGUOQ
+1 27  LARGE
+1 21 MFIYAQ
+1 21 SUE
+1 21 MBEURI
+1 22 ONTIEEN
+1 21 NYAEMAE
+1 21 PUNGAAM
+1 21 MEUT NGGEET
+1 21 FEUX
+1 21 MBUOQ
+1 21 FEE
+1 21 KEUAEM
+1 21 MA NJEUAENA
+1 26 UQA
+1 21 LET
+1 21 NGGAAM
+1 22 SEN
+1 21 MA
+1 21 KIQ
+1 21 NGOM
+1 19 C NGKUE MAEMBA
+1 22 ZA
+1 21 YUM
+1 21 WANGKUOQ
+1 21 NGGEN
+1 22 DEUAEREE
+1 22 GKAQ
+1 21 GHARAE
+1 21 MBEEKEET
+1 21 GBAYI
+1 21 NYIR MKPARAQ MEUN
+1 22 TU MBIT
+1 21 MBEUM
+1 21 PIRIEEN
+1 21 NDOMBU
+1 21 MBAA CABBAGE-TREE
+1 21 KEUSHEUAEP
+1 21 GHAP
+1 21 KEUKAQ
+1 21 YU MUOMAE
+1 21 NZEUM
+1 21 MBUE
+1 21 NSEUAEN
+1 21 MBIT
+1 21 YEUQ
+1 21 KPARAQ
+1 22 AA
+1 21 SEUX
+1 21 NDIDA
+1 21 TAASHAE
+1 21 NJUEQ
+1 21 TITA YUE
+1 21 SUAET
+1 21 NGGUAEN NYAM
+1 21 VEUX
+1 21 NANSANAQ
+1 21 MA KEUAERI
+1 21 NTAA
+1 22 GGUON
+1 21 LAP
+1 21 MBIRIEEN
+1 22 GBASAQ
+1 21 NTEUNGBA
+1 21 TEUTEUX
+1 21 NGGUM
+1 21 FUE
+1 21 NDEUT
+1 22 SA
+1 23 HAQ
+1 21 BUNG
+1 21 VEUAEPEN
+1 21 MBERAE
+1 21 RU
+1 21 NJAEM
+1 21 LAM
+1 21 TITUAEP
+1 21 NSUOT NGOM
+1 22 JEEEE
+1 21 KET
+1 21 NGGU
+1 21 MAESI
+1 22 BUAEM
+1 21 LU
+1 21 KUT
+1 21 NJAM
+1 22 GOM
+1 21 WUP
+1 21 NGGUEET
+1 22 SOM
+1 22 TEN
+1 21 KUOP NKAARAE
+1 21 NSUN
+1 22 DAM
+1 21 MA NSIEE
+1 21 YAA
+1 21 NDAP
+1 21 SHUEQ
+1 22 ETFON
+1 21 MBI
+1 22 AEMBA
+1 22 BANYI
+1 21 KEUSEUX
+1 21 MBEUX
+1 21 KEUM
+1 21 MBAA PICKET
+1 21 YUWOQ
+1 21 NJEUX
+1 21 MIEE
+1 22 UAE
+1 21 SHIQ
+1 21 KEN LAW
+1 25 FATIGUE
+1 21 NGAQ
+1 22 AQ
+1 21 LIQ
+1 21 PIN
+1 22 EN
+1 21 TET
+1 19 D MBUO
+1 21 WAP
+1 21 NJI
+1 21 MFON
+1 21 NJIEE
+1 21 LIEE
+1 21 NJEUT
+1 22 SHEE
+1 22 GGAAMAE
+1 22 YAM
+1 21 WUAEN
+1 21 NGKUN
+1 21 SHEE
+1 21 NGKAP
+1 21 KEUAETMEUN
+1 21 TEUT
+1 21 SHEUAE
+1 21 NJAP
+1 21 SUE
+1 21 KET
+1 21 YAEMMAE
+1 21 KUOM
+1 21 SAP
+1 21 MFEUT
+1 21 NDEUX
+1 21 MALEERI
+1 22 EUT
+1 21 SEUAEQ
+1 21 YEN
+1 21 NJEUAEM
+1 21 KEUOT MBUAE
+1 21 NGKEURI
+1 21 TU
+1 21 GHAA
+1 21 NGKYEE
+1 21 FEUFEUAET
+1 21 NDEE
+1 21 MGBOFUM
+1 21 LEUAEP
+1 21 NDON
+1 21 MONI
+1 22 GBEUN
+1 21 PUUT
+1 21 MGBIEE
+1 22 FO
+1 21 LUM
+1 21 NSIEEP
+1 21 MBAA
+1 21 KWAET
+1 21 NYET
+1 21 TEUAEN
+1 21 SOT
+1 21 YUWOQ
+1 21 KEUM
+1 21 RAEM
+1 21 TEEEE
+1 21 NGKEUAEQ
+1 21 MFEUAE
+1 21 NSIEET
+1 21 KEUP
+1 21 PIP
+1 22 EUTAE
+1 21 NYUE
+1 21 LET
+1 21 NGGAAM
+1 21 MFIEE
+1 21 NGGWAEN
+1 21 YUOM
+1 21 PAP
+1 21 YUOP
+1 21 NDAM
+1 22 TEUM
+1 21 SUAE
+1 21 KUN
+1 21 NGGEUX
+1 23 KIEE
+1 21 TUOT
+1 21 MEUN
+1 21 KUQ
+1 21 NSUM
+1 21 TEUN
+1 21 MAENJET
+1 21 NGGAP
+1 21 LEUM
+1 21 NGGUOM
+1 22 SHUT
+1 22 JUEQ
+1 21 GHEUAE
+1 21 KU
+1 21 REN OLD
+1 21 TAE
+1 22 OQ
+1 21 NYI
+1 21 RII
+1 21 LEEEE
+1 21 MEEEE
+1 22 
+1 21 SUU
+1 21 MU
+1 21 SHII
+1 23 EUX
+1 21 KYEE
+1 21 NU
+1 21 SHU
+1 21 NTEE
+1 21 PEE
+1 21 NI
+1 21 SHOQ
+1 21 PUQ
+1 21 MVOP
+1 21 LOQ
+1 21 REN MUCH
+1 21 TI
+1 21 NTUU
+1 21 MBAA SEVEN
+1 21 SAQ
+1 21 FAA
+1 19 E NDAP
+1 21 TOON
+1 21 MBEUM
+1 21 LAP
+1 21 VOM
+1 21 LOON
+1 21 PAA
+1 21 SOM
+1 21 RAQ
+1 21 NSHUOP
+1 22 DUN
+1 21 PUAE
+1 21 TAM
+1 21 NGKA
+1 21 KPEUX
+1 21 WUO
+1 21 SEE
+1 21 NGGEUAET
+1 21 PAAM
+1 21 TOO
+1 21 KUOP
+1 21 LOM
+1 21 NSHIEE
+1 22 GOP
+1 21 MAEM
+1 21 NGKEUX
+1 23 OQ
+1 22 SHUE
+1 21 RIMGBA
+1 21 NJEUX
+1 21 PEEM
+1 21 SAA
+1 21 NGGURAE
+1 21 MGBA
+1 21 GHEUX
+1 21 NGKEUAEM
+1 22 JAEMLI
+1 21 MAP
+1 21 LOOT
+1 21 NGGEEEE
+1 22 DIQ
+1 21 TAEN NTEUM
+1 21 SET
+1 21 PUM
+1 21 NDAA SOFTNESS
+1 22 GGUAESHAE NYAM
+1 21 YIEE
+1 21 GHEUN
+1 21 TUAE
+1 21 YEUAE
+1 21 PO
+1 21 TUMAE
+1 21 KEUAE
+1 21 SUAEN
+1 21 TEUAEQ
+1 21 VEUAE
+1 21 WEUX
+1 21 LAAM
+1 21 PU
+1 21 TAAQ
+1 21 GHAAMAE
+1 21 NGEUREUT
+1 21 SHEUAEQ
+1 21 MGBEN
+1 22 BEE
+1 21 NZAQ
+1 22 KOM
+1 21 GBET
+1 21 TUM
+1 21 KUET
+1 21 YAP
+1 21 NYI CLEAVER
+1 21 YIT
+1 21 MFEUQ
+1 21 NDIAQ
+1 21 PIEEQ
+1 21 YUEQ
+1 21 LEUAEM
+1 21 FUE
+1 21 GBEUX
+1 21 NGKUP
+1 21 KET
+1 21 MAE
+1 21 NGKAAMI
+1 21 GHET
+1 21 FA
+1 21 NTUM
+1 21 PEUT
+1 21 YEUM
+1 21 NGGEUAE
+1 22 YI BETWEEN
+1 22 ZUQ
+1 21 POON
+1 21 MIEE
+1 21 FUET
+1 21 NAE
+1 21 MUAE
+1 21 GHEUAE
+1 21 FU I
+1 21 MVI
+1 21 PUAQ
+1 21 NGKUM
+1 21 KUT
+1 21 PIET
+1 21 NTAP
+1 21 YEUAET
+1 21 NGGUP
+1 21 PA PEOPLE
+1 21 FU CALL
+1 22 OM
+1 21 NJEE
+1 21 A
+1 21 TOQ
+1 21 O
+1 21 I
+1 21 LAQ
+1 21 PA PLURAL
+1 21 TAA
+1 23 Q
+1 21 NDAA MY HOUSE
+1 21 SHIQ
+1 21 YEUX
+1 21 NGUAE
+1 21 YUAEN
+1 22 OQ SWIMMING
+1 25 COVER
+1 22 UQ
+1 23 N
+1 21 KEUX
+1 21 PEUX
+1 21 NJEE EPOCH
+1 21 PUE
+1 21 WUE
+1 21 FEE
+1 21 VEE
+1 21 LU
+1 21 MI
+1 21 REUX
+1 22 AE
+1 21 NGUAET
+1 23 A
+1 21 SHO
+1 24 Q
+1 21 FU REMEDY
+1 21 NA
+1 21 PI
+1 21 LOQ
+1 21 KO
+1 21 MEN
+1 22 A
+1 23 Q
+1 21 TEU
+1 21 KI
+1 21 MON
+1 21 TEN
+1 21 FAQ
+1 21 GHOM
+1 19 F KA
+1 21 U
+1 21 KU
+1 21 EE
+1 21 REE
+1 21 TAE
+1 21 NYI
+1 21 LA
+1 21 RII
+1 23 EE
+1 21 MEEEE
+1 21 TAA
+1 21 NDAA
+1 22 JAEM
+1 21 M
+1 21 SUU
+1 22 HII
+1 22 I
+1 22 EUX
+1 21 KYEE
+1 22 ET
+1 21 NUAE
+1 23 
+1 22 JUAE
+1 21 YOQ
+1 21 SHU
+1 21 YA
+1 21 NSHA
+1 21 PEUX
+1 21 NTEE
+1 21 WUE
+1 21 PEE
+1 21 RU
+1 21 NI
+1 21 REUX
+1 21 KEN
+1 21 NGKWAEN
+1 23 GA
+1 21 SHO
+1 21 PUAE
+1 21 FOM
+1 21 WA
+1 21 LI
+1 22 OQ
+1 21 KO
+1 21 MBEN
+1 21 REN
+1 21 MA
+1 22 O
+1 22 BAA
+1 21 TET
+1 21 KPA
+1 21 SAMBA
+1 21 VUEQ
+8 0 MRO LETTER TA
+1 11 NGI
+1 11 YO
+1 11 MIM
+1 11 BA
+1 11 DA
+1 11 A
+1 11 PHI
+1 11 KHAI
+1 11 HAO
+1 11 DAI
+1 11 CHU
+1 11 KEAAE
+1 11 OL
+1 11 MAEM
+1 11 NIN
+1 11 PA
+1 11 OO
+1 12 
+1 11 RO
+1 11 SHI
+1 11 THEA
+1 11 EA
+1 11 WA
+1 11 E
+1 11 KO
+1 11 LAN
+1 13 
+1 11 HAI
+1 11 RI
+1 11 TEK
+2 4 DIGIT ZERO
+1 10 ONE
+1 10 TWO
+1 11 HREE
+1 10 FOUR
+1 11 IVE
+1 10 SIX
+1 11 EVEN
+1 10 EIGHT
+1 10 NINE
+5 5 ANDA
+1 5 OUBLE DANDA
+1 0 TANGSA LETTER OZ
+1 15 C
+1 15 Q
+1 15 X
+1 14 AZ
+1 15 C
+1 15 Q
+1 15 X
+1 14 VZ
+1 15 C
+1 15 Q
+1 15 X
+1 14 EZ
+1 15 C
+1 15 Q
+1 15 X
+1 14 IZ
+1 15 C
+1 15 Q
+1 15 X
+1 14 UZ
+1 15 C
+1 15 Q
+1 15 X
+1 14 AWZ
+1 16 C
+1 16 Q
+1 16 X
+1 14 UIZ
+1 16 C
+1 16 Q
+1 16 X
+1 14 FINAL NG
+1 14 LONG UEX
+1 14 SHORT UEZ
+1 20 AWX
+1 14 UEC
+1 16 Z
+1 16 Q
+1 16 X
+1 15 IUZ
+1 17 C
+1 17 Q
+1 17 X
+1 14 MZ
+1 15 C
+1 15 Q
+1 15 X
+1 14 KA
+1 15 HA
+1 14 GA
+1 14 NGA
+1 14 SA
+1 14 YA
+1 14 WA
+1 14 PA
+1 14 NYA
+1 14 PHA
+1 14 BA
+1 14 MA
+1 14 NA
+1 14 HA
+1 14 LA
+1 14 HTA
+1 14 TA
+1 14 DA
+1 14 RA
+1 14 NHA
+1 14 SHA
+1 14 CA
+1 14 TSA
+1 14 GHA
+1 14 HTTA
+1 14 THA
+1 14 XA
+1 14 FA
+1 14 DHA
+1 14 CHA
+1 14 ZA
+2 7 DIGIT ZERO
+1 13 ONE
+1 13 TWO
+1 14 HREE
+1 13 FOUR
+1 14 IVE
+1 13 SIX
+1 14 EVEN
+1 13 EIGHT
+1 13 NINE
+7 0 BASSA VAH LETTER ENNI
+1 17 KA
+1 17 SE
+1 17 FA
+1 17 MBE
+1 17 YIE
+1 17 GAH
+1 17 DHII
+1 17 KPAH
+1 17 JO
+1 17 HWAH
+1 17 WA
+1 17 ZO
+1 17 GBU
+1 17 DO
+1 17 CE
+1 17 UWU
+1 17 TO
+1 17 BA
+1 17 VU
+1 17 YEIN
+1 17 PA
+1 17 WADDA
+1 17 A
+1 17 O
+1 18 O
+1 17 U
+1 17 EE
+1 18 
+1 17 I
+3 10 COMBINING HIGH TONE
+1 20 LOW TONE
+1 20 MID TONE
+1 20 LOW-MID TONE
+1 20 HIGH-LOW TONE
+1 10 FULL STOP
+b 0 PAHAWH HMONG VOWEL KEEB
+1 22 V
+1 20 IB
+1 21 V
+1 20 AUB
+1 22 V
+1 20 UB
+1 21 V
+1 20 EB
+1 21 V
+1 20 AIB
+1 22 V
+1 20 OOB
+1 22 V
+1 20 AWB
+1 22 V
+1 20 UAB
+1 22 V
+1 20 OB
+1 21 V
+1 20 IAB
+1 22 V
+1 20 AB
+1 21 V
+1 20 WB
+1 21 V
+1 20 AAB
+1 22 V
+1 13 CONSONANT VAU
+1 23 NTSAU
+1 23 LAU
+1 23 HAU
+1 23 NLAU
+1 23 RAU
+1 23 NKAU
+1 23 QHAU
+1 23 YAU
+1 23 HLAU
+1 23 MAU
+1 23 CHAU
+1 23 NCHAU
+1 23 HNAU
+1 23 PLHAU
+1 23 NTHAU
+1 24 AU
+1 23 AU
+1 23 XAU
+1 23 CAU
+1 13 MARK CIM TUB
+1 22 SO
+1 22 KES
+1 23 HAV
+1 22 SUAM
+1 22 HOM
+1 22 TAUM
+1 13 SIGN VOS THOM
+1 23 SHAB CEEB
+1 18 CIM CHEEM
+1 18 VOS THIAB
+1 22 FEEM
+1 18 XYEEM NTXIV
+1 24 RHO
+1 24 TOV
+1 24 FAIB
+1 18 VOS SEEV
+1 18 MEEJ SUAB
+1 18 VOS NRUA
+1 18 IB YAM
+1 18 XAUS
+1 18 CIM TSOV ROG
+b 13 DIGIT ZERO
+1 19 ONE
+1 19 TWO
+1 20 HREE
+1 19 FOUR
+1 20 IVE
+1 19 SIX
+1 20 EVEN
+1 19 EIGHT
+1 19 NINE
+2 13 NUMBER TENS
+1 20 HUNDREDS
+1 20 TEN THOUSANDS
+1 20 MILLIONS
+1 20 HUNDRED MILLIONS
+1 20 TEN BILLIONS
+1 21 RILLIONS
+2 13 SIGN VOS LUB
+1 18 XYOO
+1 18 HLI
+1 18 THIRD-STAGE HLI
+1 18 ZWJ THAJ
+1 18 HNUB
+1 18 NQIG
+1 18 XIAB
+1 18 NTUJ
+1 18 AV
+1 18 TXHEEJ CEEV
+1 18 MEEJ TSEEB
+1 18 TAU
+1 18 LOS
+1 18 MUS
+1 18 CIM HAIS LUS NTOG NTOG
+1 22 CUAM TSHOOJ
+1 22 TXWV
+1 26  CHWV
+1 22 PUB DAWB
+1 22 NRES TOS
+6 13 CLAN SIGN TSHEEJ
+1 23 YEEG
+1 23 LIS
+1 24 AUJ
+1 23 XYOOJ
+1 23 KOO
+1 23 HAWJ
+1 23 MUAS
+1 23 THOJ
+1 24 SAB
+1 23 PHAB
+1 23 KHAB
+1 23 HAM
+1 23 VAJ
+1 23 FAJ
+1 23 YAJ
+1 23 TSWB
+1 23 KWM
+1 23 VWJ
+2b1 0 MEDEFAIDRIN CAPITAL LETTER M
+1 27 S
+1 27 V
+1 27 W
+1 27 ATIU
+1 27 Z
+1 27 KP
+1 27 P
+1 27 T
+1 27 G
+1 27 F
+1 27 I
+1 27 K
+1 27 A
+1 27 J
+1 27 E
+1 27 B
+1 27 C
+1 27 U
+1 27 YU
+1 27 L
+1 27 Q
+1 27 HP
+1 27 NY
+1 27 X
+1 27 D
+1 27 OE
+1 27 N
+1 27 R
+1 27 O
+1 27 AI
+1 27 Y
+1 12 SMALL LETTER M
+1 25 S
+1 25 V
+1 25 W
+1 25 ATIU
+1 25 Z
+1 25 KP
+1 25 P
+1 25 T
+1 25 G
+1 25 F
+1 25 I
+1 25 K
+1 25 A
+1 25 J
+1 25 E
+1 25 B
+1 25 C
+1 25 U
+1 25 YU
+1 25 L
+1 25 Q
+1 25 HP
+1 25 NY
+1 25 X
+1 25 D
+1 25 OE
+1 25 N
+1 25 R
+1 25 O
+1 25 AI
+1 25 Y
+1 12 DIGIT ZERO
+1 18 ONE
+1 18 TWO
+1 19 HREE
+1 18 FOUR
+1 19 IVE
+1 18 SIX
+1 19 EVEN
+1 18 EIGHT
+1 18 NINE
+1 12 NUMBER TEN
+1 19 ELEVEN
+1 19 TWELVE
+1 20 HIRTEEN
+1 19 FOURTEEN
+1 20 IFTEEN
+1 19 SIXTEEN
+1 20 EVENTEEN
+1 19 EIGHTEEN
+1 19 NINETEEN
+1 12 DIGIT ONE ALTERNATE FORM
+1 18 TWO ALTERNATE FORM
+1 19 HREE ALTERNATE FORM
+1 12 COMMA
+1 12 FULL STOP
+1 12 SYMBOL AIVA
+1 12 EXCLAMATION OH
+66 1 IAO LETTER PA
+1 12 BA
+1 12 YI PA
+1 12 PLA
+1 12 MA
+1 13 HA
+1 12 ARCHAIC MA
+1 12 FA
+1 12 VA
+1 13 FA
+1 12 TA
+1 12 DA
+1 12 YI TTA
+1 16 A
+1 12 TTA
+1 12 DDA
+1 12 NA
+1 13 HA
+1 12 YI NNA
+1 12 ARCHAIC NA
+1 12 NNA
+1 14 HA
+1 12 LA
+1 13 YA
+1 13 HA
+1 14 YA
+1 12 TLHA
+1 12 DLHA
+1 12 TLHYA
+1 12 DLHYA
+1 12 KA
+1 12 GA
+1 12 YI KA
+1 12 QA
+1 13 GA
+1 12 NGA
+1 14 HA
+1 12 ARCHAIC NGA
+1 12 HA
+1 12 XA
+1 12 GHA
+1 14 HA
+1 12 TSSA
+1 12 DZZA
+1 12 NYA
+1 14 HA
+1 12 TSHA
+1 12 DZHA
+1 12 YI TSHA
+1 15 DZHA
+1 12 REFORMED TSHA
+1 12 SHA
+1 13 SA
+1 12 ZHA
+1 13 SHA
+1 12 TSA
+1 12 DZA
+1 12 YI TSA
+1 12 SA
+1 12 ZA
+1 13 SA
+1 13 ZA
+1 14 SA
+1 12 ARCHAIC ZZA
+1 12 ZZYA
+1 14 SYA
+1 12 WA
+1 12 AH
+1 12 HHA
+1 12 BRI
+1 12 SYI
+1 12 DZYI
+1 12 TE
+1 13 SE
+1 12 RTE
+5 5 SIGN CONSONANT MODIFIER BAR
+1 5 LETTER NASALIZATION
+1 5 SIGN ASPIRATION
+1 10 REFORMED VOICING
+1 19 ASPIRATION
+1 5 VOWEL SIGN A
+1 17 A
+1 17 HH
+1 17 N
+1 18 G
+1 16 O
+1 17 O
+1 16 WO
+1 17 
+1 16 E
+1 17 N
+1 18 G
+1 16 OEY
+1 16 I
+1 17 A
+1 18 N
+1 19 G
+1 17 O
+1 17 E
+1 17 I
+1 17 U
+1 17 NG
+1 16 U
+1 17 A
+1 18 N
+1 19 G
+1 17 U
+1 17 EI
+1 17 NG
+1 16 Y
+1 17 I
+1 16 AE
+1 18 E
+1 16 ERR
+1 16 ROUNDED ERR
+1 16 ER
+1 16 ROUNDED ER
+1 16 AI
+1 16 EI
+1 16 AU
+1 16 OU
+1 16 N
+1 17 G
+1 16 UOG
+1 16 YUI
+1 16 OG
+1 17 ER
+1 16 VW
+1 16 IG
+1 16 EA
+1 16 IONG
+1 16 UI
+8 5 TONE RIGHT
+1 10 TOP RIGHT
+1 10 ABOVE
+1 10 BELOW
+1 5 LETTER TONE-2
+1 17 3
+1 17 4
+1 17 5
+1 17 6
+1 17 7
+1 17 8
+1 12 REFORMED TONE-1
+1 26 2
+1 26 4
+1 26 5
+1 26 6
+1 26 8
+41 0 TANGUT ITERATION MARK
+1 0 NUSHU ITERATION MARK
+1 0 OLD CHINESE HOOK MARK
+1 12 ITERATION MARK
+1 0 KHITAN SMALL SCRIPT FILLER
+c 0 VIETNAMESE ALTERNATE READING MARK CA
+1 34 NHAY
+180f 0 TANGUT COMPONENT-001
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 10
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 20
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 30
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 40
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 50
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 60
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 70
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 80
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 90
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 17 100
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 10
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 20
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 30
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 40
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 50
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 60
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 70
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 80
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 90
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 17 200
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 10
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 20
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 30
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 40
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 50
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 60
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 70
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 80
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 90
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 17 300
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 10
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 20
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 30
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 40
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 50
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 60
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 70
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 80
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 90
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 17 400
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 10
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 20
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 30
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 40
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 50
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 60
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 70
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 80
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 90
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 17 500
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 10
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 20
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 30
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 40
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 50
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 60
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 70
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 80
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 90
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 17 600
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 10
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 20
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 30
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 40
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 50
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 60
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 70
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 80
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 90
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 17 700
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 10
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 20
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 30
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 40
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 50
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 18 60
+1 19 1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+24f1 0 KATAKANA LETTER MINNAN TONE-2
+1 28 3
+1 28 4
+1 28 5
+2 28 7
+1 28 8
+1 23 NASALIZED TONE-1
+1 38 2
+1 38 3
+1 38 4
+1 38 5
+2 38 7
+1 38 8
+2 16 ARCHAIC E
+1 0 HIRAGANA LETTER ARCHAIC YE
+1 1 ENTAIGANA LETTER A-1
+1 20 2
+1 20 3
+1 20 WO
+1 18 I-1
+1 20 2
+1 20 3
+1 20 4
+1 18 U-1
+1 20 2
+1 20 3
+1 20 4
+1 20 5
+1 18 E-2
+1 20 3
+1 20 4
+1 20 5
+1 20 6
+1 18 O-1
+1 20 2
+1 20 3
+1 18 KA-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 21 9
+1 21 10
+1 22 1
+1 21 KE
+1 19 I-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 19 U-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 19 E-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 19 O-1
+1 21 2
+1 21 3
+1 21 KI
+1 18 SA-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 19 I-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 19 U-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 19 E-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 19 O-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 18 TA-1
+1 21 2
+1 21 3
+1 21 4
+1 19 I-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 19 U-1
+1 21 2
+1 21 3
+1 21 4
+1 21 TO
+1 19 E-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 21 9
+1 19 O-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 RA
+1 18 NA-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 21 9
+1 19 I-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 TE
+1 19 U-1
+1 21 2
+1 21 3
+1 19 E-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 KO
+1 19 O-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 18 HA-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 21 9
+1 21 10
+1 22 1
+1 19 I-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 19 U-1
+1 21 2
+1 21 3
+1 19 E-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 19 O-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 18 MA-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 19 I-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 19 U-1
+1 21 2
+1 21 3
+1 21 4
+1 19 E-1
+1 21 2
+1 21 MA
+1 19 O-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 18 YA-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 YO
+1 19 U-1
+1 21 2
+1 21 3
+1 21 4
+1 19 O-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 18 RA-1
+1 21 2
+1 21 3
+1 21 4
+1 19 I-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 19 U-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 19 E-1
+1 21 2
+1 21 3
+1 21 4
+1 19 O-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 18 WA-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 19 I-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 19 E-1
+1 21 2
+1 21 3
+1 21 4
+1 19 O-1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 18 N-MU-MO-1
+1 26 2
+1 1 IRAGANA LETTER ARCHAIC WU
+1 0 KATAKANA LETTER ARCHAIC YI
+1 25 E
+1 24 WU
+2e 0 HIRAGANA LETTER SMALL WI
+1 23 E
+1 23 O
+12 0 KATAKANA LETTER SMALL WI
+1 23 E
+1 23 O
+1 22 N
+a99 0 DUPLOYAN LETTER H
+1 16 X
+1 16 P
+1 16 T
+1 16 F
+1 16 K
+1 16 L
+1 16 B
+1 16 D
+1 16 V
+1 16 G
+1 16 R
+1 16 P N
+1 16 D S
+1 16 F N
+1 16 K M
+1 16 R S
+1 16 TH
+1 16 SLOAN DH
+1 16 DH
+1 16 KK
+1 16 SLOAN J
+1 16 HL
+1 16 LH
+1 16 RH
+1 16 M
+1 16 N
+1 16 J
+1 16 S
+1 16 M N
+1 16 N M
+1 16 J M
+1 16 S J
+1 16 M WITH DOT
+1 16 N WITH DOT
+1 16 J WITH DOT
+1 26 S INSIDE AND ABOVE
+1 16 S WITH DOT
+1 26  BELOW
+1 16 M S
+1 16 N S
+1 16 J S
+1 16 S S
+1 16 M N S
+1 16 N M S
+1 16 J M S
+1 16 S J S
+1 16 J S WITH DOT
+1 18 N
+1 19  S
+1 16 S T
+1 19  R
+1 18 P
+1 19  R
+1 16 T S
+1 18 R S
+1 16 W
+1 17 H
+1 17  R
+1 16 S N
+1 18 M
+1 16 K R S
+1 16 G R S
+1 16 S K
+1 19  R
+1 16 A
+1 16 SLOAN OW
+1 16 OA
+1 17 
+1 16 AOU
+1 16 I
+1 16 E
+1 16 IE
+1 16 SHORT I
+1 16 UI
+1 16 EE
+1 16 SLOAN EH
+1 16 ROMANIAN I
+1 16 SLOAN EE
+1 16 LONG I
+1 16 YE
+1 16 U
+1 16 EU
+1 16 XW
+1 16 U N
+1 16 LONG U
+1 16 ROMANIAN U
+1 16 UH
+1 16 SLOAN U
+1 16 OOH
+1 17 W
+1 17 U
+1 16 WA
+1 17 O
+1 17 I
+1 17 EI
+1 17 OW
+1 16 NASAL U
+1 22 O
+1 22 I
+1 22 A
+1 16 PERNIN AN
+1 24 M
+1 16 SLOAN EN
+1 22 AN
+1 22 ON
+1 16 VOCALIC M
+6 9 AFFIX LEFT HORIZONTAL SECANT
+1 15 MID HORIZONTAL SECANT
+1 15 RIGHT HORIZONTAL SECANT
+1 15 LOW VERTICAL SECANT
+1 15 MID VERTICAL SECANT
+1 15 HIGH VERTICAL SECANT
+1 15 ATTACHED SECANT
+1 24 LEFT-TO-RIGHT SECANT
+1 24 TANGENT
+1 26 IL
+1 24 E HOOK
+1 24 I HOOK
+1 24 TANGENT HOOK
+4 15 HIGH ACUTE
+1 20 TIGHT ACUTE
+1 20 GRAVE
+1 20 LONG GRAVE
+1 20 DOT
+1 20 CIRCLE
+1 20 LINE
+1 20 WAVE
+1 20 VERTICAL
+8 15 LOW ACUTE
+1 19 TIGHT ACUTE
+1 19 GRAVE
+1 19 LONG GRAVE
+1 19 DOT
+1 19 CIRCLE
+1 19 LINE
+1 19 WAVE
+1 19 VERTICAL
+1 19 ARROW
+3 9 SIGN O WITH CROSS
+1 9 THICK LETTER SELECTOR
+1 9 DOUBLE MARK
+1 9 PUNCTUATION CHINOOK FULL STOP
+1 0 SHORTHAND FORMAT LETTER OVERLAP
+1 17 CONTINUING OVERLAP
+1 17 DOWN STEP
+1 17 UP STEP
+125d 0 ZNAMENNY COMBINING MARK GORAZDO NIZKO S KRYZHEM ON LEFT
+1 24 NIZKO S KRYZHEM ON LEFT
+1 24 TSATA ON LEFT
+1 24 GORAZDO NIZKO ON LEFT
+1 24 NIZKO ON LEFT
+1 24 SREDNE ON LEFT
+1 24 MALO POVYSHE ON LEFT
+1 24 POVYSHE ON LEFT
+1 24 VYSOKO ON LEFT
+1 24 MALO POVYSHE S KHOKHLOM ON LEFT
+1 24 POVYSHE S KHOKHLOM ON LEFT
+1 24 VYSOKO S KHOKHLOM ON LEFT
+1 24 GORAZDO NIZKO S KRYZHEM ON RIGHT
+1 24 NIZKO S KRYZHEM ON RIGHT
+1 24 TSATA ON RIGHT
+1 24 GORAZDO NIZKO ON RIGHT
+1 24 NIZKO ON RIGHT
+1 24 SREDNE ON RIGHT
+1 24 MALO POVYSHE ON RIGHT
+1 24 POVYSHE ON RIGHT
+1 24 VYSOKO ON RIGHT
+1 24 MALO POVYSHE S KHOKHLOM ON RIGHT
+1 24 POVYSHE S KHOKHLOM ON RIGHT
+1 24 VYSOKO S KHOKHLOM ON RIGHT
+1 24 TSATA S KRYZHEM
+1 24 MALO POVYSHE S KRYZHEM
+1 24 STRANNO MALO POVYSHE
+1 24 POVYSHE S KRYZHEM
+1 33 TRANNO
+1 24 VYSOKO S KRYZHEM
+1 24 MALO POVYSHE STRANNO
+1 24 GORAZDO VYSOKO
+1 24 ZELO
+1 24 ON
+1 24 RAVNO
+1 24 TIKHAYA
+1 24 BORZAYA
+1 24 UDARKA
+1 24 PODVERTKA
+1 24 LOMKA
+1 24 KUPNAYA
+1 25 ACHKA
+1 24 ZEVOK
+1 24 SKOBA
+1 24 RAZSEKA
+1 24 KRYZH ON LEFT
+3 19 TONAL RANGE MARK MRACHNO
+1 36 SVETLO
+1 36 TRESVETLO
+1 19 MARK ZADERZHKA
+1 24 DEMESTVENNY ZADERZHKA
+1 24 OTSECHKA
+1 24 PODCHASHIE
+1 34  WITH VERTICAL STROKE
+1 24 CHASHKA
+1 31  POLNAYA
+1 24 OBLACHKO
+1 24 SOROCHYA NOZHKA
+1 24 TOCHKA
+1 24 DVOETOCHIE
+1 19 ATTACHING VERTICAL OMET
+1 19 MARK CURVED OMET
+1 24 KRYZH
+1 19 LOWER TONAL RANGE INDICATOR
+1 9 PRIZNAK MODIFIER LEVEL-2
+1 32 3
+1 26 DIRECTION FLIP
+1 26 KRYZH
+1 26 ROG
+a 9 NEUME KRYUK
+1 20  TIKHY
+1 15 PARAKLIT
+1 15 DVA V CHELNU
+1 15 KLYUCH
+1 15 ZANOZHEK
+1 15 STOPITSA
+1 23  S OCHKOM
+1 15 PEREVODKA
+1 24  NEPOSTOYANNAYA
+1 15 STOPITSA WITH SOROCHYA NOZHKA
+1 15 CHELYUSTKA
+1 15 PALKA
+1 15 ZAPYATAYA
+1 15 GOLUBCHIK BORZY
+1 25 TIKHY
+1 25 MRACHNY
+1 25 SVETLY
+1 25 TRESVETLY
+1 15 VRAKHIYA PROSTAYA
+1 24 MRACHNAYA
+1 24 SVETLAYA
+1 24 TRESVETLAYA
+1 24 KLYUCHEVAYA PROSTAYA
+1 36 MRACHNAYA
+1 36 SVETLAYA
+1 36 TRESVETLAYA
+1 15 DOUBLE ZAPYATAYA
+1 15 REVERSED CHELYUSTKA
+1 15 DERBITSA
+1 15 KHAMILO
+1 15 CHASHKA
+1 15 PODCHASHIE
+1 15 SKAMEYTSA MRACHNAYA
+1 25 SVETLAYA
+1 25 TRESVETLAYA
+1 26 IKHAYA
+1 15 DEMESTVENNY KLYUCH
+1 15 SKAMEYTSA KLYUCHEVAYA SVETLAYA
+1 32 NEPOSTOYANNAYA
+1 32 VAYA TIKHAYA
+1 25 DVOECHELNAYA PROSTAYA
+1 38 SVETLAYA
+1 38 NEPOSTOYANNAYA
+1 38 KLYUCHEVAYA
+1 16 LOZHITIE
+1 24  S ZAPYATOY
+1 25 ZAKRYTOE
+1 25 S KRYZHEM
+1 15 KRYZH
+1 15 ROG
+1 15 FITA
+1 15 KOBYLA
+1 15 ZMEYTSA
+1 15 STATYA
+1 21  S ZAPYATOY
+1 24 KRYZHEM
+1 24 ZAPYATOY I KRYZHEM
+1 24 KRYZHEM I ZAPYATOY
+1 22 ZAKRYTAYA
+1 31  S ZAPYATOY
+1 22 S ROGOM
+1 24 DVUMYA ZAPYATYMI
+1 24 ZAPYATOY I PODCHASHIEM
+1 15 POLKULIZMY
+1 15 STATYA NEPOSTOYANNAYA
+1 17 RELA PROSTAYA
+1 22 MRACHNOTIKHAYA
+1 22 KRYZHEVAYA
+1 22 POLUPOVODNAYA
+1 24 VODNAYA
+1 22 NEPOSTOYANNAYA
+1 22 KLYUCHEPOVODNAYA
+1 29 NEPOSTOYANNAYA
+1 22 TIKHAYA PUTNAYA
+1 22 DVOECHELNAYA
+1 31 OKRYZHEVAYA
+1 32 POVODNAYA
+1 31 AYA KLYUCHEVAYA
+1 31 OPOVODNAYA KLYUCHEVAYA
+1 22 GROMNAYA WITH SINGLE ZAPYATAYA
+1 26 OPOVODNAYA WITH SINGLE ZAPYATAYA
+1 26 NAYA
+1 26 OPOVODNAYA
+1 36  WITH DOUBLE ZAPYATAYA
+1 27 KRYZHEVAYA
+1 37  POVODNAYA
+1 15 MECHIK
+1 21  POVODNY
+1 22 KLYUCHEVOY
+1 29 POVODNY
+1 29 NEPOSTOYANNY
+1 15 STRELA TRYASOGLASNAYA
+1 28 POVODNAYA
+1 28 STRELNAYA
+1 15 OSOKA
+1 20  SVETLAYA
+1 21 TRESVETLAYA
+1 21 KRYUKOVAYA SVETLAYA
+1 22 LYUCHEVAYA SVETLAYA
+1 33 NEPOSTOYANNAYA
+1 15 STRELA KRYUKOVAYA
+1 32  POVODNAYA
+1 33 GROMNAYA WITH SINGLE ZAPYATAYA
+1 37 OPOVODNAYA WITH SINGLE ZAPYATAYA
+1 37 NAYA
+1 37 OPOVODNAYA
+1 47  WITH DOUBLE ZAPYATAYA
+1 38 KRYZHEVAYA
+1 48  POVODNAYA
+1 33 TRYASKA
+1 15 KUFISMA
+1 15 OBLAKO
+1 15 DUDA
+1 15 NEMKA
+1 15 PAUK
+3d 0 BYZANTINE MUSICAL SYMBOL PSILI
+1 25 DASEIA
+1 25 PERISPOMENI
+1 25 OXEIA EKFONITIKON
+1 31 DIPLI
+1 25 VAREIA EKFONITIKON
+1 32 DIPLI
+1 25 KATHISTI
+1 25 SYRMATIKI
+1 25 PARAKLITIKI
+1 25 YPOKRISIS
+1 34  DIPLI
+1 25 KREMASTI
+1 25 APESO EKFONITIKON
+1 25 EXO EKFONITIKON
+1 25 TELEIA
+1 25 KENTIMATA
+1 25 APOSTROFOS
+1 35  DIPLI
+1 25 SYNEVMA
+1 25 THITA
+1 25 OLIGON ARCHAION
+1 25 GORGON ARCHAION
+1 25 PSILON
+1 25 CHAMILON
+1 25 VATHY
+1 25 ISON ARCHAION
+1 25 KENTIMA ARCHAION
+1 32 TA ARCHAION
+1 25 SAXIMATA
+1 25 PARICHON
+1 25 STAVROS APODEXIA
+1 25 OXEIAI ARCHAION
+1 25 VAREIAI ARCHAION
+1 25 APODERMA ARCHAION
+1 28 THEMA
+1 25 KLASMA
+1 25 REVMA
+1 25 PIASMA ARCHAION
+1 25 TINAGMA
+1 25 ANATRICHISMA
+1 25 SEISMA
+1 26 YNAGMA ARCHAION
+1 33 META STAVROU
+1 25 OYRANISMA ARCHAION
+1 25 THEMA
+1 25 LEMOI
+1 25 DYO
+1 25 TRIA
+1 26 ESSERA
+1 25 KRATIMATA
+1 25 APESO EXO NEO
+1 25 FTHORA ARCHAION
+1 25 IMIFTHORA
+1 25 TROMIKON ARCHAION
+1 25 KATAVA TROMIKON
+1 25 PELASTON
+1 26 SIFISTON
+1 25 KONTEVMA
+1 25 CHOREVMA ARCHAION
+1 25 RAPISMA
+1 25 PARAKALESMA ARCHAION
+1 30 LITIKI ARCHAION
+1 25 ICHADIN
+1 25 NANA
+1 25 PETASMA
+1 25 KONTEVMA ALLO
+1 25 TROMIKON ALLO
+1 25 STRAGGISMATA
+1 25 GRONTHISMATA
+1 25 ISON NEO
+1 25 OLIGON NEO
+1 26 XEIA NEO
+1 25 PETASTI
+1 25 KOUFISMA
+1 25 PETASTOKOUFISMA
+1 25 KRATIMOKOUFISMA
+1 25 PELASTON NEO
+1 25 KENTIMATA NEO ANO
+1 32  NEO ANO
+1 25 YPSILI
+1 25 APOSTROFOS NEO
+1 34 I SYNDESMOS NEO
+1 25 YPORROI
+1 25 KRATIMOYPORROON
+1 25 ELAFRON
+1 25 CHAMILI
+1 25 MIKRON ISON
+1 25 VAREIA NEO
+1 25 PIASMA NEO
+1 26 SIFISTON NEO
+1 25 OMALON
+1 25 ANTIKENOMA
+1 25 LYGISMA
+1 25 PARAKLITIKI NEO
+1 30 ALESMA NEO
+1 25 ETERON PARAKALESMA
+1 25 KYLISMA
+1 25 ANTIKENOKYLISMA
+1 25 TROMIKON NEO
+1 25 EKSTREPTON
+1 25 SYNAGMA NEO
+1 27 RMA
+1 25 CHOREVMA NEO
+1 25 EPEGERMA
+1 25 SEISMA NEO
+1 25 XIRON KLASMA
+1 25 TROMIKOPSIFISTON
+1 25 PSIFISTOLYGISMA
+1 25 TROMIKOLYGISMA
+1 32 PARAKALESMA
+1 25 PSIFISTOPARAKALESMA
+1 25 TROMIKOSYNAGMA
+1 25 PSIFISTOSYNAGMA
+1 25 GORGOSYNTHETON
+1 25 ARGOSYNTHETON
+1 25 ETERON ARGOSYNTHETON
+1 25 OYRANISMA NEO
+1 25 THEMATISMOS ESO
+1 38 XO
+1 30  APLOUN
+1 28 S KAI APOTHES
+1 25 KATAVASMA
+1 25 ENDOFONON
+1 25 YFEN KATO
+1 30 ANO
+1 25 STAVROS
+1 25 KLASMA ANO
+1 25 DIPLI ARCHAION
+1 25 KRATIMA ARCHAION
+1 34 LLO
+1 33 NEO
+1 25 APODERMA NEO
+1 27 LI
+1 25 DIPLI
+1 25 TRIPLI
+1 26 ETRAPLI
+1 25 KORONIS
+1 25 LEIMMA ENOS CHRONOU
+1 32 DYO CHRONON
+1 32 TRION CHRONON
+1 33 ESSARON CHRONON
+1 32 IMISEOS CHRONOU
+1 25 GORGON NEO ANO
+1 32 PARESTIGMENON ARISTERA
+1 46 DEXIA
+1 25 DIGORGON
+1 33  PARESTIGMENON ARISTERA KATO
+1 57 ANO
+1 48 DEXIA
+1 25 TRIGORGON
+1 25 ARGON
+1 25 IMIDIARGON
+1 25 DIARGON
+1 25 AGOGI POLI ARGI
+1 31 ARGOTERI
+1 34 I
+1 31 METRIA
+1 33 SI
+1 31 GORGI
+1 35 OTERI
+1 31 POLI GORGI
+1 25 MARTYRIA PROTOS ICHOS
+1 34 ALLI PROTOS ICHOS
+1 34 DEYTEROS ICHOS
+1 34 ALLI DEYTEROS ICHOS
+1 34 TRITOS ICHOS
+1 37 FONIAS
+1 35 ETARTOS ICHOS
+1 43 LEGETOS ICHOS
+1 34 LEGETOS ICHOS
+1 34 PLAGIOS ICHOS
+1 25 ISAKIA TELOUS ICHIMATOS
+1 25 APOSTROFOI TELOUS ICHIMATOS
+1 25 FANEROSIS TETRAFONIAS
+1 35 MONOFONIAS
+1 35 DIFONIAS
+1 25 MARTYRIA VARYS ICHOS
+1 34 PROTOVARYS ICHOS
+1 35 LAGIOS TETARTOS ICHOS
+1 25 GORTHMIKON N APLOUN
+1 38 DIPLOUN
+1 25 ENARXIS KAI FTHORA VOU
+1 25 IMIFONON
+1 29 THORON
+1 25 FTHORA ARCHAION DEYTEROU ICHOU
+1 32 DIATONIKI PA
+1 42 NANA
+1 32 NAOS ICHOS
+1 32 DIATONIKI DI
+1 32 SKLIRON DIATONON DI
+1 32 DIATONIKI KE
+1 42 ZO
+1 42 NI KATO
+1 45 ANO
+1 32 MALAKON CHROMA DIFONIAS
+1 47 MONOFONIAS
+1 26 HTORA SKLIRON CHROMA VASIS
+1 26 THORA SKLIRON CHROMA SYNAFI
+1 32 NENANO
+1 25 CHROA ZYGOS
+1 31 KLITON
+1 31 SPATHI
+1 25 FTHORA I YFESIS TETARTIMORION
+1 32 ENARMONIOS ANTIFONIA
+1 25 YFESIS TRITIMORION
+1 25 DIESIS TRITIMORION
+1 33 ETARTIMORION
+1 32 APLI DYO DODEKATA
+1 32 MONOGRAMMOS TESSERA DODEKATA
+1 32 DIGRAMMOS EX DODEKATA
+1 32 TRIGRAMMOS OKTO DODEKATA
+1 25 YFESIS APLI DYO DODEKATA
+1 32 MONOGRAMMOS TESSERA DODEKATA
+1 32 DIGRAMMOS EX DODEKATA
+1 32 TRIGRAMMOS OKTO DODEKATA
+1 25 GENIKI DIESIS
+1 32 YFESIS
+1 25 DIASTOLI APLI MIKRI
+1 40 EGALI
+1 34 DIPLI
+1 34 THESEOS
+1 25 SIMANSIS THESEOS
+1 41  DISIMOU
+1 42 TRISIMOU
+1 43 ETRASIMOU
+1 34 ARSEOS
+1 40  DISIMOU
+1 41 TRISIMOU
+1 42 ETRASIMOU
+1 25 DIGRAMMA GG
+1 27 FTOGGOS OU
+1 25 STIGMA
+1 25 ARKTIKO PA
+1 33 VOU
+1 33 GA
+1 33 DI
+1 33 KE
+1 33 ZO
+1 33 NI
+1 25 KENTIMATA NEO MESO
+1 32  NEO MESO
+1 32 TA NEO KATO
+1 32  NEO KATO
+1 26 LASMA KATO
+1 25 GORGON NEO KATO
+b 0 MUSICAL SYMBOL SINGLE BARLINE
+1 15 DOUBLE BARLINE
+1 15 FINAL BARLINE
+1 15 REVERSE FINAL BARLINE
+1 15 DASHED BARLINE
+1 15 SHORT BARLINE
+1 15 LEFT REPEAT SIGN
+1 15 RIGHT REPEAT SIGN
+1 16 EPEAT DOTS
+1 15 DAL SEGNO
+1 17  CAPO
+1 15 SEGNO
+1 15 CODA
+1 15 REPEATED FIGURE-1
+1 31 2
+1 31 3
+1 15 FERMATA
+1 22  BELOW
+1 15 BREATH MARK
+1 15 CAESURA
+1 15 BRACE
+1 19 KET
+1 15 ONE-LINE STAFF
+1 15 TWO-LINE STAFF
+1 16 HREE-LINE STAFF
+1 15 FOUR-LINE STAFF
+1 16 IVE-LINE STAFF
+1 15 SIX-LINE STAFF
+1 19 STRING FRETBOARD
+1 15 FOUR-STRING FRETBOARD
+1 15 G CLEF
+1 21  OTTAVA ALTA
+1 29 BASSA
+1 15 C CLEF
+1 15 F CLEF
+1 21  OTTAVA ALTA
+1 29 BASSA
+1 15 DRUM CLEF-1
+1 25 2
+3 15 MULTIPLE MEASURE REST
+1 15 DOUBLE SHARP
+1 22 FLAT
+1 15 FLAT UP
+1 20 DOWN
+1 15 NATURAL UP
+1 23 DOWN
+1 15 SHARP UP
+1 21 DOWN
+1 15 QUARTER TONE SHARP
+1 28 FLAT
+1 15 COMMON TIME
+1 16 UT TIME
+1 15 OTTAVA ALTA
+1 22 BASSA
+1 15 QUINDICESIMA ALTA
+1 28 BASSA
+1 15 MULTI REST
+1 15 WHOLE REST
+1 15 HALF REST
+1 15 QUARTER REST
+1 15 EIGHTH REST
+1 15 SIXTEENTH REST
+1 15 THIRTY-SECOND REST
+1 15 SIXTY-FOURTH REST
+1 15 ONE HUNDRED TWENTY-EIGHTH REST
+1 15 X NOTEHEAD
+1 15 PLUS NOTEHEAD
+1 15 CIRCLE X NOTEHEAD
+1 15 SQUARE NOTEHEAD WHITE
+1 31 BLACK
+1 15 TRIANGLE NOTEHEAD UP WHITE
+1 36 BLACK
+1 33 LEFT WHITE
+1 38 BLACK
+1 33 RIGHT WHITE
+1 39 BLACK
+1 33 DOWN WHITE
+1 38 BLACK
+1 33 UP RIGHT WHITE
+1 42 BLACK
+1 15 MOON NOTEHEAD WHITE
+1 29 BLACK
+1 15 TRIANGLE-ROUND NOTEHEAD DOWN WHITE
+1 44 BLACK
+1 15 PARENTHESIS NOTEHEAD
+1 15 VOID NOTEHEAD
+1 15 NOTEHEAD BLACK
+1 16 ULL NOTEHEAD
+1 15 CLUSTER NOTEHEAD WHITE
+1 32 BLACK
+1 15 BREVE
+1 15 WHOLE NOTE
+1 15 HALF NOTE
+1 15 QUARTER NOTE
+1 15 EIGHTH NOTE
+1 15 SIXTEENTH NOTE
+1 15 THIRTY-SECOND NOTE
+1 15 SIXTY-FOURTH NOTE
+1 15 ONE HUNDRED TWENTY-EIGHTH NOTE
+1 15 COMBINING STEM
+1 26 PRECHGESANG STEM
+1 25 TREMOLO-1
+1 33 2
+1 33 3
+1 15 FINGERED TREMOLO-1
+1 32 2
+1 32 3
+1 15 COMBINING AUGMENTATION DOT
+1 25 FLAG-1
+1 30 2
+1 30 3
+1 30 4
+1 30 5
+1 15 BEGIN BEAM
+1 15 END BEAM
+1 15 BEGIN TIE
+1 15 END TIE
+1 15 BEGIN SLUR
+1 15 END SLUR
+1 15 BEGIN PHRASE
+1 15 END PHRASE
+1 15 COMBINING ACCENT
+1 25 STACCATO
+1 25 TENUTO
+1 25 STACCATISSIMO
+1 25 MARCATO
+1 32 -STACCATO
+1 25 ACCENT-STACCATO
+1 25 LOURE
+1 15 ARPEGGIATO UP
+1 26 DOWN
+1 15 COMBINING DOIT
+1 25 RIP
+1 25 FLIP
+1 25 SMEAR
+1 25 BEND
+1 25 DOUBLE TONGUE
+1 25 TRIPLE TONGUE
+1 15 RINFORZANDO
+1 15 SUBITO
+1 15 Z
+1 15 PIANO
+1 15 MEZZO
+1 15 FORTE
+1 15 CRESCENDO
+1 15 DECRESCENDO
+1 15 GRACE NOTE SLASH
+1 26 NO SLASH
+1 15 TR
+1 16 URN
+1 15 INVERTED TURN
+1 15 TURN SLASH
+1 20 UP
+1 15 ORNAMENT STROKE-1
+1 31 2
+1 31 3
+1 31 4
+1 31 5
+1 31 6
+1 31 7
+1 31 8
+1 31 9
+1 31 10
+1 32 1
+1 15 HAUPTSTIMME
+1 15 NEBENSTIMME
+1 15 END OF STIMME
+1 15 DEGREE SLASH
+1 15 COMBINING DOWN BOW
+1 25 UP BOW
+1 25 HARMONIC
+1 25 SNAP PIZZICATO
+1 15 PEDAL MARK
+1 21 UP MARK
+1 15 HALF PEDAL MARK
+1 15 GLISSANDO UP
+1 25 DOWN
+1 15 WITH FINGERNAILS
+1 15 DAMP
+1 19  ALL
+1 15 MAXIMA
+1 15 LONGA
+1 15 BREVIS
+1 15 SEMIBREVIS WHITE
+1 26 BLACK
+1 15 MINIMA
+1 21  BLACK
+1 15 SEMIMINIMA WHITE
+1 26 BLACK
+1 15 FUSA WHITE
+1 20 BLACK
+1 15 LONGA PERFECTA REST
+1 21 IMPERFECTA REST
+1 15 BREVIS REST
+1 15 SEMIBREVIS REST
+1 15 MINIMA REST
+1 15 SEMIMINIMA REST
+1 15 TEMPUS PERFECTUM CUM PROLATIONE PERFECTA
+1 47 IMPERFECTA
+1 47 PERFECTA DIMINUTION-1
+1 22 IMPERFECTUM CUM PROLATIONE PERFECTA
+1 49 IMPERFECTA
+1 59  DIMINUTION-1
+1 71 2
+1 71 3
+1 15 CROIX
+1 15 GREGORIAN C CLEF
+1 25 F CLEF
+1 15 SQUARE B
+1 15 VIRGA
+1 15 PODATUS
+1 15 CLIVIS
+1 15 SCANDICUS
+1 15 CLIMACUS
+1 15 TORCULUS
+1 15 PORRECTUS
+1 24  FLEXUS
+1 15 SCANDICUS FLEXUS
+1 15 TORCULUS RESUPINUS
+1 15 PES SUBPUNCTIS
+1 15 KIEVAN C CLEF
+1 22 END OF PIECE
+1 22 FINAL NOTE
+1 22 RECITATIVE MARK
+1 22 WHOLE NOTE
+1 22 HALF NOTE
+1 22 QUARTER NOTE STEM DOWN
+1 40 UP
+1 22 EIGHTH NOTE STEM DOWN
+1 39 UP
+1 22 FLAT SIGN
+1 15 SORI
+1 15 KORON
+16 0 GREEK VOCAL NOTATION SYMBOL-1
+1 28 2
+1 28 3
+1 28 4
+1 28 5
+1 28 6
+1 28 7
+1 28 8
+1 28 9
+1 28 10
+1 29 1
+1 29 2
+1 29 3
+1 29 4
+1 29 5
+1 29 6
+1 29 7
+1 29 8
+1 29 9
+1 28 20
+1 29 1
+1 29 2
+1 29 3
+1 29 4
+1 28 50
+1 29 1
+1 29 2
+1 29 3
+1 29 4
+1 6 INSTRUMENTAL NOTATION SYMBOL-1
+1 35 2
+1 35 4
+1 35 5
+1 35 7
+1 35 8
+1 35 11
+1 36 2
+1 36 3
+1 36 4
+1 36 7
+1 36 8
+1 36 9
+1 35 23
+1 36 4
+1 36 5
+1 36 6
+1 36 7
+1 36 9
+1 35 30
+1 36 2
+1 36 6
+1 36 7
+1 36 8
+1 36 9
+1 35 40
+1 36 2
+1 36 3
+1 36 5
+1 36 7
+1 36 8
+1 36 9
+1 35 50
+1 36 1
+1 36 2
+1 36 3
+1 36 4
+1 0 COMBINING GREEK MUSICAL TRISEME
+1 25 ETRASEME
+1 24 PENTASEME
+1 0 GREEK MUSICAL LEIMMA
+9b 0 MAYAN NUMERAL ZERO
+1 14 ONE
+1 14 TWO
+1 15 HREE
+1 14 FOUR
+1 15 IVE
+1 14 SIX
+1 15 EVEN
+1 14 EIGHT
+1 14 NINE
+1 14 TEN
+1 14 ELEVEN
+1 14 TWELVE
+1 15 HIRTEEN
+1 14 FOURTEEN
+1 15 IFTEEN
+1 14 SIXTEEN
+1 15 EVENTEEN
+1 14 EIGHTEEN
+1 14 NINETEEN
+d 1 ONOGRAM FOR EARTH
+1 0 DIGRAM FOR HEAVENLY EARTH
+1 12 UMAN EARTH
+1 11 EARTHLY HEAVEN
+1 20 UMAN
+1 16 
+1 0 TETRAGRAM FOR CENTRE
+1 14 FULL CIRCLE
+1 14 MIRED
+1 14 BARRIER
+1 14 KEEPING SMALL
+1 14 CONTRARIETY
+1 14 ASCENT
+1 14 OPPOSITION
+1 14 BRANCHING OUT
+1 14 DEFECTIVENESS OR DISTORTION
+1 15 IVERGENCE
+1 14 YOUTHFULNESS
+1 14 INCREASE
+1 14 PENETRATION
+1 14 REACH
+1 14 CONTACT
+1 14 HOLDING BACK
+1 14 WAITING
+1 14 FOLLOWING
+1 14 ADVANCE
+1 14 RELEASE
+1 16 SISTANCE
+1 14 EASE
+1 14 JOY
+1 14 CONTENTION
+1 14 ENDEAVOUR
+1 14 DUTIES
+1 14 CHANGE
+1 14 DECISIVENESS
+1 14 BOLD RESOLUTION
+1 14 PACKING
+1 14 LEGION
+1 14 CLOSENESS
+1 14 KINSHIP
+1 14 GATHERING
+1 14 STRENGTH
+1 14 PURITY
+1 14 FULLNESS
+1 14 RESIDENCE
+1 14 LAW OR MODEL
+1 14 RESPONSE
+1 14 GOING TO MEET
+1 14 ENCOUNTERS
+1 14 STOVE
+1 14 GREATNESS
+1 14 ENLARGEMENT
+1 14 PATTERN
+1 14 RITUAL
+1 14 FLIGHT
+1 14 VASTNESS OR WASTING
+1 14 CONSTANCY
+1 14 MEASURE
+1 14 ETERNITY
+1 14 UNITY
+1 14 DIMINISHMENT
+1 14 CLOSED MOUTH
+1 14 GUARDEDNESS
+1 15 ATHERING IN
+1 14 MASSING
+1 14 ACCUMULATION
+1 14 EMBELLISHMENT
+1 14 DOUBT
+1 14 WATCH
+1 14 SINKING
+1 14 INNER
+1 14 DEPARTURE
+1 15 ARKENING
+1 15 IMMING
+1 14 EXHAUSTION
+1 14 SEVERANCE
+1 15 TOPPAGE
+1 14 HARDNESS
+1 14 COMPLETION
+1 15 LOSURE
+1 14 FAILURE
+1 14 AGGRAVATION
+1 14 COMPLIANCE
+1 14 ON THE VERGE
+1 14 DIFFICULTIES
+1 14 LABOURING
+1 14 FOSTERING
+a 0 COUNTING ROD UNIT DIGIT ONE
+1 24 TWO
+1 25 HREE
+1 24 FOUR
+1 25 IVE
+1 24 SIX
+1 25 EVEN
+1 24 EIGHT
+1 24 NINE
+1 13 TENS DIGIT ONE
+1 24 TWO
+1 25 HREE
+1 24 FOUR
+1 25 IVE
+1 24 SIX
+1 25 EVEN
+1 24 EIGHT
+1 24 NINE
+1 0 IDEOGRAPHIC TALLY MARK ONE
+1 23 TWO
+1 24 HREE
+1 23 FOUR
+1 24 IVE
+1 0 TALLY MARK ONE
+1 11 FIVE
+88 0 MATHEMATICAL BOLD CAPITAL A
+1 26 B
+1 26 C
+1 26 D
+1 26 E
+1 26 F
+1 26 G
+1 26 H
+1 26 I
+1 26 J
+1 26 K
+1 26 L
+1 26 M
+1 26 N
+1 26 O
+1 26 P
+1 26 Q
+1 26 R
+1 26 S
+1 26 T
+1 26 U
+1 26 V
+1 26 W
+1 26 X
+1 26 Y
+1 26 Z
+1 18 SMALL A
+1 24 B
+1 24 C
+1 24 D
+1 24 E
+1 24 F
+1 24 G
+1 24 H
+1 24 I
+1 24 J
+1 24 K
+1 24 L
+1 24 M
+1 24 N
+1 24 O
+1 24 P
+1 24 Q
+1 24 R
+1 24 S
+1 24 T
+1 24 U
+1 24 V
+1 24 W
+1 24 X
+1 24 Y
+1 24 Z
+1 13 ITALIC CAPITAL A
+1 28 B
+1 28 C
+1 28 D
+1 28 E
+1 28 F
+1 28 G
+1 28 H
+1 28 I
+1 28 J
+1 28 K
+1 28 L
+1 28 M
+1 28 N
+1 28 O
+1 28 P
+1 28 Q
+1 28 R
+1 28 S
+1 28 T
+1 28 U
+1 28 V
+1 28 W
+1 28 X
+1 28 Y
+1 28 Z
+1 20 SMALL A
+1 26 B
+1 26 C
+1 26 D
+1 26 E
+1 26 F
+1 26 G
+2 26 I
+1 26 J
+1 26 K
+1 26 L
+1 26 M
+1 26 N
+1 26 O
+1 26 P
+1 26 Q
+1 26 R
+1 26 S
+1 26 T
+1 26 U
+1 26 V
+1 26 W
+1 26 X
+1 26 Y
+1 26 Z
+1 13 BOLD ITALIC CAPITAL A
+1 33 B
+1 33 C
+1 33 D
+1 33 E
+1 33 F
+1 33 G
+1 33 H
+1 33 I
+1 33 J
+1 33 K
+1 33 L
+1 33 M
+1 33 N
+1 33 O
+1 33 P
+1 33 Q
+1 33 R
+1 33 S
+1 33 T
+1 33 U
+1 33 V
+1 33 W
+1 33 X
+1 33 Y
+1 33 Z
+1 25 SMALL A
+1 31 B
+1 31 C
+1 31 D
+1 31 E
+1 31 F
+1 31 G
+1 31 H
+1 31 I
+1 31 J
+1 31 K
+1 31 L
+1 31 M
+1 31 N
+1 31 O
+1 31 P
+1 31 Q
+1 31 R
+1 31 S
+1 31 T
+1 31 U
+1 31 V
+1 31 W
+1 31 X
+1 31 Y
+1 31 Z
+1 13 SCRIPT CAPITAL A
+2 28 C
+1 28 D
+3 28 G
+3 28 J
+1 28 K
+3 28 N
+1 28 O
+1 28 P
+1 28 Q
+2 28 S
+1 28 T
+1 28 U
+1 28 V
+1 28 W
+1 28 X
+1 28 Y
+1 28 Z
+1 20 SMALL A
+1 26 B
+1 26 C
+1 26 D
+2 26 F
+2 26 H
+1 26 I
+1 26 J
+1 26 K
+1 26 L
+1 26 M
+1 26 N
+2 26 P
+1 26 Q
+1 26 R
+1 26 S
+1 26 T
+1 26 U
+1 26 V
+1 26 W
+1 26 X
+1 26 Y
+1 26 Z
+1 13 BOLD SCRIPT CAPITAL A
+1 33 B
+1 33 C
+1 33 D
+1 33 E
+1 33 F
+1 33 G
+1 33 H
+1 33 I
+1 33 J
+1 33 K
+1 33 L
+1 33 M
+1 33 N
+1 33 O
+1 33 P
+1 33 Q
+1 33 R
+1 33 S
+1 33 T
+1 33 U
+1 33 V
+1 33 W
+1 33 X
+1 33 Y
+1 33 Z
+1 25 SMALL A
+1 31 B
+1 31 C
+1 31 D
+1 31 E
+1 31 F
+1 31 G
+1 31 H
+1 31 I
+1 31 J
+1 31 K
+1 31 L
+1 31 M
+1 31 N
+1 31 O
+1 31 P
+1 31 Q
+1 31 R
+1 31 S
+1 31 T
+1 31 U
+1 31 V
+1 31 W
+1 31 X
+1 31 Y
+1 31 Z
+1 13 FRAKTUR CAPITAL A
+1 29 B
+2 29 D
+1 29 E
+1 29 F
+1 29 G
+3 29 J
+1 29 K
+1 29 L
+1 29 M
+1 29 N
+1 29 O
+1 29 P
+1 29 Q
+2 29 S
+1 29 T
+1 29 U
+1 29 V
+1 29 W
+1 29 X
+1 29 Y
+2 21 SMALL A
+1 27 B
+1 27 C
+1 27 D
+1 27 E
+1 27 F
+1 27 G
+1 27 H
+1 27 I
+1 27 J
+1 27 K
+1 27 L
+1 27 M
+1 27 N
+1 27 O
+1 27 P
+1 27 Q
+1 27 R
+1 27 S
+1 27 T
+1 27 U
+1 27 V
+1 27 W
+1 27 X
+1 27 Y
+1 27 Z
+1 13 DOUBLE-STRUCK CAPITAL A
+1 35 B
+2 35 D
+1 35 E
+1 35 F
+1 35 G
+2 35 I
+1 35 J
+1 35 K
+1 35 L
+1 35 M
+2 35 O
+4 35 S
+1 35 T
+1 35 U
+1 35 V
+1 35 W
+1 35 X
+1 35 Y
+2 27 SMALL A
+1 33 B
+1 33 C
+1 33 D
+1 33 E
+1 33 F
+1 33 G
+1 33 H
+1 33 I
+1 33 J
+1 33 K
+1 33 L
+1 33 M
+1 33 N
+1 33 O
+1 33 P
+1 33 Q
+1 33 R
+1 33 S
+1 33 T
+1 33 U
+1 33 V
+1 33 W
+1 33 X
+1 33 Y
+1 33 Z
+1 13 BOLD FRAKTUR CAPITAL A
+1 34 B
+1 34 C
+1 34 D
+1 34 E
+1 34 F
+1 34 G
+1 34 H
+1 34 I
+1 34 J
+1 34 K
+1 34 L
+1 34 M
+1 34 N
+1 34 O
+1 34 P
+1 34 Q
+1 34 R
+1 34 S
+1 34 T
+1 34 U
+1 34 V
+1 34 W
+1 34 X
+1 34 Y
+1 34 Z
+1 26 SMALL A
+1 32 B
+1 32 C
+1 32 D
+1 32 E
+1 32 F
+1 32 G
+1 32 H
+1 32 I
+1 32 J
+1 32 K
+1 32 L
+1 32 M
+1 32 N
+1 32 O
+1 32 P
+1 32 Q
+1 32 R
+1 32 S
+1 32 T
+1 32 U
+1 32 V
+1 32 W
+1 32 X
+1 32 Y
+1 32 Z
+1 13 SANS-SERIF CAPITAL A
+1 32 B
+1 32 C
+1 32 D
+1 32 E
+1 32 F
+1 32 G
+1 32 H
+1 32 I
+1 32 J
+1 32 K
+1 32 L
+1 32 M
+1 32 N
+1 32 O
+1 32 P
+1 32 Q
+1 32 R
+1 32 S
+1 32 T
+1 32 U
+1 32 V
+1 32 W
+1 32 X
+1 32 Y
+1 32 Z
+1 24 SMALL A
+1 30 B
+1 30 C
+1 30 D
+1 30 E
+1 30 F
+1 30 G
+1 30 H
+1 30 I
+1 30 J
+1 30 K
+1 30 L
+1 30 M
+1 30 N
+1 30 O
+1 30 P
+1 30 Q
+1 30 R
+1 30 S
+1 30 T
+1 30 U
+1 30 V
+1 30 W
+1 30 X
+1 30 Y
+1 30 Z
+1 24 BOLD CAPITAL A
+1 37 B
+1 37 C
+1 37 D
+1 37 E
+1 37 F
+1 37 G
+1 37 H
+1 37 I
+1 37 J
+1 37 K
+1 37 L
+1 37 M
+1 37 N
+1 37 O
+1 37 P
+1 37 Q
+1 37 R
+1 37 S
+1 37 T
+1 37 U
+1 37 V
+1 37 W
+1 37 X
+1 37 Y
+1 37 Z
+1 29 SMALL A
+1 35 B
+1 35 C
+1 35 D
+1 35 E
+1 35 F
+1 35 G
+1 35 H
+1 35 I
+1 35 J
+1 35 K
+1 35 L
+1 35 M
+1 35 N
+1 35 O
+1 35 P
+1 35 Q
+1 35 R
+1 35 S
+1 35 T
+1 35 U
+1 35 V
+1 35 W
+1 35 X
+1 35 Y
+1 35 Z
+1 24 ITALIC CAPITAL A
+1 39 B
+1 39 C
+1 39 D
+1 39 E
+1 39 F
+1 39 G
+1 39 H
+1 39 I
+1 39 J
+1 39 K
+1 39 L
+1 39 M
+1 39 N
+1 39 O
+1 39 P
+1 39 Q
+1 39 R
+1 39 S
+1 39 T
+1 39 U
+1 39 V
+1 39 W
+1 39 X
+1 39 Y
+1 39 Z
+1 31 SMALL A
+1 37 B
+1 37 C
+1 37 D
+1 37 E
+1 37 F
+1 37 G
+1 37 H
+1 37 I
+1 37 J
+1 37 K
+1 37 L
+1 37 M
+1 37 N
+1 37 O
+1 37 P
+1 37 Q
+1 37 R
+1 37 S
+1 37 T
+1 37 U
+1 37 V
+1 37 W
+1 37 X
+1 37 Y
+1 37 Z
+1 24 BOLD ITALIC CAPITAL A
+1 44 B
+1 44 C
+1 44 D
+1 44 E
+1 44 F
+1 44 G
+1 44 H
+1 44 I
+1 44 J
+1 44 K
+1 44 L
+1 44 M
+1 44 N
+1 44 O
+1 44 P
+1 44 Q
+1 44 R
+1 44 S
+1 44 T
+1 44 U
+1 44 V
+1 44 W
+1 44 X
+1 44 Y
+1 44 Z
+1 36 SMALL A
+1 42 B
+1 42 C
+1 42 D
+1 42 E
+1 42 F
+1 42 G
+1 42 H
+1 42 I
+1 42 J
+1 42 K
+1 42 L
+1 42 M
+1 42 N
+1 42 O
+1 42 P
+1 42 Q
+1 42 R
+1 42 S
+1 42 T
+1 42 U
+1 42 V
+1 42 W
+1 42 X
+1 42 Y
+1 42 Z
+1 13 MONOSPACE CAPITAL A
+1 31 B
+1 31 C
+1 31 D
+1 31 E
+1 31 F
+1 31 G
+1 31 H
+1 31 I
+1 31 J
+1 31 K
+1 31 L
+1 31 M
+1 31 N
+1 31 O
+1 31 P
+1 31 Q
+1 31 R
+1 31 S
+1 31 T
+1 31 U
+1 31 V
+1 31 W
+1 31 X
+1 31 Y
+1 31 Z
+1 23 SMALL A
+1 29 B
+1 29 C
+1 29 D
+1 29 E
+1 29 F
+1 29 G
+1 29 H
+1 29 I
+1 29 J
+1 29 K
+1 29 L
+1 29 M
+1 29 N
+1 29 O
+1 29 P
+1 29 Q
+1 29 R
+1 29 S
+1 29 T
+1 29 U
+1 29 V
+1 29 W
+1 29 X
+1 29 Y
+1 29 Z
+1 13 ITALIC SMALL DOTLESS I
+1 34 J
+3 13 BOLD CAPITAL ALPHA
+1 26 BETA
+1 26 GAMMA
+1 26 DELTA
+1 26 EPSILON
+1 26 ZETA
+1 26 ETA
+1 26 THETA
+1 26 IOTA
+1 26 KAPPA
+1 26 LAMDA
+1 26 MU
+1 26 NU
+1 26 XI
+1 26 OMICRON
+1 26 PI
+1 26 RHO
+1 26 THETA SYMBOL
+1 26 SIGMA
+1 26 TAU
+1 26 UPSILON
+1 26 PHI
+1 26 CHI
+1 26 PSI
+1 26 OMEGA
+1 18 NABLA
+1 18 SMALL ALPHA
+1 24 BETA
+1 24 GAMMA
+1 24 DELTA
+1 24 EPSILON
+1 24 ZETA
+1 24 ETA
+1 24 THETA
+1 24 IOTA
+1 24 KAPPA
+1 24 LAMDA
+1 24 MU
+1 24 NU
+1 24 XI
+1 24 OMICRON
+1 24 PI
+1 24 RHO
+1 24 FINAL SIGMA
+1 24 SIGMA
+1 24 TAU
+1 24 UPSILON
+1 24 PHI
+1 24 CHI
+1 24 PSI
+1 24 OMEGA
+1 18 PARTIAL DIFFERENTIAL
+1 18 EPSILON SYMBOL
+1 18 THETA SYMBOL
+1 18 KAPPA SYMBOL
+1 18 PHI SYMBOL
+1 18 RHO SYMBOL
+1 18 PI SYMBOL
+1 13 ITALIC CAPITAL ALPHA
+1 28 BETA
+1 28 GAMMA
+1 28 DELTA
+1 28 EPSILON
+1 28 ZETA
+1 28 ETA
+1 28 THETA
+1 28 IOTA
+1 28 KAPPA
+1 28 LAMDA
+1 28 MU
+1 28 NU
+1 28 XI
+1 28 OMICRON
+1 28 PI
+1 28 RHO
+1 28 THETA SYMBOL
+1 28 SIGMA
+1 28 TAU
+1 28 UPSILON
+1 28 PHI
+1 28 CHI
+1 28 PSI
+1 28 OMEGA
+1 20 NABLA
+1 20 SMALL ALPHA
+1 26 BETA
+1 26 GAMMA
+1 26 DELTA
+1 26 EPSILON
+1 26 ZETA
+1 26 ETA
+1 26 THETA
+1 26 IOTA
+1 26 KAPPA
+1 26 LAMDA
+1 26 MU
+1 26 NU
+1 26 XI
+1 26 OMICRON
+1 26 PI
+1 26 RHO
+1 26 FINAL SIGMA
+1 26 SIGMA
+1 26 TAU
+1 26 UPSILON
+1 26 PHI
+1 26 CHI
+1 26 PSI
+1 26 OMEGA
+1 20 PARTIAL DIFFERENTIAL
+1 20 EPSILON SYMBOL
+1 20 THETA SYMBOL
+1 20 KAPPA SYMBOL
+1 20 PHI SYMBOL
+1 20 RHO SYMBOL
+1 20 PI SYMBOL
+1 13 BOLD ITALIC CAPITAL ALPHA
+1 33 BETA
+1 33 GAMMA
+1 33 DELTA
+1 33 EPSILON
+1 33 ZETA
+1 33 ETA
+1 33 THETA
+1 33 IOTA
+1 33 KAPPA
+1 33 LAMDA
+1 33 MU
+1 33 NU
+1 33 XI
+1 33 OMICRON
+1 33 PI
+1 33 RHO
+1 33 THETA SYMBOL
+1 33 SIGMA
+1 33 TAU
+1 33 UPSILON
+1 33 PHI
+1 33 CHI
+1 33 PSI
+1 33 OMEGA
+1 25 NABLA
+1 25 SMALL ALPHA
+1 31 BETA
+1 31 GAMMA
+1 31 DELTA
+1 31 EPSILON
+1 31 ZETA
+1 31 ETA
+1 31 THETA
+1 31 IOTA
+1 31 KAPPA
+1 31 LAMDA
+1 31 MU
+1 31 NU
+1 31 XI
+1 31 OMICRON
+1 31 PI
+1 31 RHO
+1 31 FINAL SIGMA
+1 31 SIGMA
+1 31 TAU
+1 31 UPSILON
+1 31 PHI
+1 31 CHI
+1 31 PSI
+1 31 OMEGA
+1 25 PARTIAL DIFFERENTIAL
+1 25 EPSILON SYMBOL
+1 25 THETA SYMBOL
+1 25 KAPPA SYMBOL
+1 25 PHI SYMBOL
+1 25 RHO SYMBOL
+1 25 PI SYMBOL
+1 13 SANS-SERIF BOLD CAPITAL ALPHA
+1 37 BETA
+1 37 GAMMA
+1 37 DELTA
+1 37 EPSILON
+1 37 ZETA
+1 37 ETA
+1 37 THETA
+1 37 IOTA
+1 37 KAPPA
+1 37 LAMDA
+1 37 MU
+1 37 NU
+1 37 XI
+1 37 OMICRON
+1 37 PI
+1 37 RHO
+1 37 THETA SYMBOL
+1 37 SIGMA
+1 37 TAU
+1 37 UPSILON
+1 37 PHI
+1 37 CHI
+1 37 PSI
+1 37 OMEGA
+1 29 NABLA
+1 29 SMALL ALPHA
+1 35 BETA
+1 35 GAMMA
+1 35 DELTA
+1 35 EPSILON
+1 35 ZETA
+1 35 ETA
+1 35 THETA
+1 35 IOTA
+1 35 KAPPA
+1 35 LAMDA
+1 35 MU
+1 35 NU
+1 35 XI
+1 35 OMICRON
+1 35 PI
+1 35 RHO
+1 35 FINAL SIGMA
+1 35 SIGMA
+1 35 TAU
+1 35 UPSILON
+1 35 PHI
+1 35 CHI
+1 35 PSI
+1 35 OMEGA
+1 29 PARTIAL DIFFERENTIAL
+1 29 EPSILON SYMBOL
+1 29 THETA SYMBOL
+1 29 KAPPA SYMBOL
+1 29 PHI SYMBOL
+1 29 RHO SYMBOL
+1 29 PI SYMBOL
+1 29 ITALIC CAPITAL ALPHA
+1 44 BETA
+1 44 GAMMA
+1 44 DELTA
+1 44 EPSILON
+1 44 ZETA
+1 44 ETA
+1 44 THETA
+1 44 IOTA
+1 44 KAPPA
+1 44 LAMDA
+1 44 MU
+1 44 NU
+1 44 XI
+1 44 OMICRON
+1 44 PI
+1 44 RHO
+1 44 THETA SYMBOL
+1 44 SIGMA
+1 44 TAU
+1 44 UPSILON
+1 44 PHI
+1 44 CHI
+1 44 PSI
+1 44 OMEGA
+1 36 NABLA
+1 36 SMALL ALPHA
+1 42 BETA
+1 42 GAMMA
+1 42 DELTA
+1 42 EPSILON
+1 42 ZETA
+1 42 ETA
+1 42 THETA
+1 42 IOTA
+1 42 KAPPA
+1 42 LAMDA
+1 42 MU
+1 42 NU
+1 42 XI
+1 42 OMICRON
+1 42 PI
+1 42 RHO
+1 42 FINAL SIGMA
+1 42 SIGMA
+1 42 TAU
+1 42 UPSILON
+1 42 PHI
+1 42 CHI
+1 42 PSI
+1 42 OMEGA
+1 36 PARTIAL DIFFERENTIAL
+1 36 EPSILON SYMBOL
+1 36 THETA SYMBOL
+1 36 KAPPA SYMBOL
+1 36 PHI SYMBOL
+1 36 RHO SYMBOL
+1 36 PI SYMBOL
+1 13 BOLD CAPITAL DIGAMMA
+1 18 SMALL DIGAMMA
+3 18 DIGIT ZERO
+1 24 ONE
+1 24 TWO
+1 25 HREE
+1 24 FOUR
+1 25 IVE
+1 24 SIX
+1 25 EVEN
+1 24 EIGHT
+1 24 NINE
+1 13 DOUBLE-STRUCK DIGIT ZERO
+1 33 ONE
+1 33 TWO
+1 34 HREE
+1 33 FOUR
+1 34 IVE
+1 33 SIX
+1 34 EVEN
+1 33 EIGHT
+1 33 NINE
+1 13 SANS-SERIF DIGIT ZERO
+1 30 ONE
+1 30 TWO
+1 31 HREE
+1 30 FOUR
+1 31 IVE
+1 30 SIX
+1 31 EVEN
+1 30 EIGHT
+1 30 NINE
+1 24 BOLD DIGIT ZERO
+1 35 ONE
+1 35 TWO
+1 36 HREE
+1 35 FOUR
+1 36 IVE
+1 35 SIX
+1 36 EVEN
+1 35 EIGHT
+1 35 NINE
+1 13 MONOSPACE DIGIT ZERO
+1 29 ONE
+1 29 TWO
+1 30 HREE
+1 29 FOUR
+1 30 IVE
+1 29 SIX
+1 30 EVEN
+1 29 EIGHT
+1 29 NINE
+1 0 SIGNWRITING HAND-FIST INDEX
+1 17 CIRCLE INDEX
+1 18 UP INDEX
+1 17 OVAL INDEX
+1 17 HINGE INDEX
+1 17 ANGLE INDEX
+1 17 FIST INDEX BENT
+1 17 CIRCLE INDEX BENT
+1 17 FIST THUMB UNDER INDEX BENT
+1 22 INDEX RAISED KNUCKLE
+1 28 CUPPED
+1 28 HINGED
+1 34  LOW
+1 17 CIRCLE INDEX HINGE
+1 17 FIST INDEX MIDDLE
+1 17 CIRCLE INDEX MIDDLE
+1 17 FIST INDEX MIDDLE BENT
+1 35 RAISED KNUCKLES
+1 35 HINGED
+1 28 UP MIDDLE HINGED
+1 28 HINGED MIDDLE UP
+1 28 MIDDLE CONJOINED
+1 44  INDEX BENT
+1 45 MIDDLE BENT
+1 45 CUPPED
+1 45 HINGED
+1 36 ROSSED
+1 17 CIRCLE INDEX MIDDLE CROSSED
+1 17 FIST MIDDLE BENT OVER INDEX
+1 22 INDEX BENT OVER MIDDLE
+1 28 MIDDLE THUMB
+1 17 CIRCLE INDEX MIDDLE THUMB
+1 17 FIST INDEX MIDDLE STRAIGHT THUMB BENT
+1 35 BENT THUMB STRAIGHT
+1 35 THUMB BENT
+1 35 HINGED SPREAD THUMB SIDE
+1 28 UP MIDDLE HINGED THUMB SIDE
+1 51 CONJOINED
+1 28 HINGED MIDDLE UP THUMB SIDE
+1 28 MIDDLE UP SPREAD THUMB FORWARD
+1 35 THUMB CUPPED
+1 42 IRCLED
+1 41 HOOKED
+1 42 INGED
+1 22 THUMB BETWEEN INDEX MIDDLE STRAIGHT
+1 22 INDEX MIDDLE CONJOINED THUMB SIDE
+1 55  CONJOINED
+1 56 BENT
+1 22 MIDDLE THUMB HOOKED INDEX UP
+1 22 INDEX THUMB HOOKED MIDDLE UP
+1 28 MIDDLE CONJOINED HINGED THUMB SIDE
+1 36 ROSSED THUMB SIDE
+1 36 ONJOINED THUMB FORWARD
+1 45 CUPPED THUMB FORWARD
+1 22 MIDDLE THUMB CUPPED INDEX UP
+1 22 INDEX THUMB CUPPED MIDDLE UP
+1 22 MIDDLE THUMB CIRCLED INDEX UP
+1 49 HINGED
+1 22 INDEX THUMB ANGLED OUT MIDDLE UP
+1 41 IN MIDDLE UP
+1 34 CIRCLED MIDDLE UP
+1 28 MIDDLE THUMB CONJOINED HINGED
+1 41 ANGLED OUT
+1 47 
+1 22 MIDDLE THUMB ANGLED OUT INDEX UP
+1 52 CROSSED
+1 42 INDEX UP
+1 22 INDEX THUMB HOOKED MIDDLE HINGED
+1 18 LAT FOUR FINGERS
+1 34  BENT
+1 35 HINGED
+1 35 CONJOINED
+1 44  SPLIT
+1 17 CLAW FOUR FINGERS CONJOINED
+1 17 FIST FOUR FINGERS CONJOINED BENT
+1 17 HINGE FOUR FINGERS CONJOINED
+1 17 FLAT FIVE FINGERS SPREAD
+1 22 HEEL FIVE FINGERS SPREAD
+1 22 FIVE FINGERS SPREAD FOUR BENT
+1 22 HEEL FIVE FINGERS SPREAD FOUR BENT
+1 22 FIVE FINGERS SPREAD BENT
+1 22 HEEL FIVE FINGERS SPREAD BENT
+1 22 FIVE FINGERS SPREAD THUMB FORWARD
+1 17 CUP FIVE FINGERS SPREAD
+1 40  OPEN
+1 17 HINGE FIVE FINGERS SPREAD OPEN
+1 17 OVAL FIVE FINGERS SPREAD
+1 17 FLAT FIVE FINGERS SPREAD HINGED
+1 48  THUMB SIDE
+1 49 NO THUMB
+1 21 
+1 21  BETWEEN PALM FACINGS
+1 22 HEEL
+1 22 THUMB SIDE
+1 22 HEEL THUMB SIDE
+1 22 THUMB BENT
+1 28 FORWARD
+1 22 SPLIT INDEX THUMB SIDE
+1 28 CENTRE
+1 34  THUMB SIDE
+1 45  BENT
+1 28 LITTLE
+1 17 CLAW
+1 21  THUMB SIDE
+1 22 NO THUMB
+1 22 THUMB FORWARD
+1 17 HOOK CURLICUE
+1 21 
+1 17 CUP OPEN
+1 20 
+1 20  OPEN THUMB SIDE
+1 21 THUMB SIDE
+1 21 OPEN NO THUMB
+1 21 NO THUMB
+1 21 OPEN THUMB FORWARD
+1 21 THUMB FORWARD
+1 19 RLICUE OPEN
+1 25 
+1 18 IRCLE
+1 17 OVAL
+1 21  THUMB SIDE
+1 22 NO THUMB
+1 22 THUMB FORWARD
+1 17 HINGE OPEN
+1 27  THUMB FORWARD
+1 22 
+1 22  SMALL
+1 23 OPEN THUMB SIDE
+1 23 THUMB SIDE
+1 23 OPEN NO THUMB
+1 23 NO THUMB
+1 23 THUMB SIDE TOUCHING INDEX
+1 29 BETWEEN MIDDLE RING
+1 17 ANGLE
+1 17 FIST INDEX MIDDLE RING
+1 17 CIRCLE INDEX MIDDLE RING
+1 17 HINGE INDEX MIDDLE RING
+1 17 ANGLE INDEX MIDDLE RING
+1 17 HINGE LITTLE
+1 17 FIST INDEX MIDDLE RING BENT
+1 40 CONJOINED
+1 17 HINGE INDEX MIDDLE RING CONJOINED
+1 17 FIST LITTLE DOWN
+1 33  RIPPLE STRAIGHT
+1 41 CURVED
+1 34 OTHERS CIRCLED
+1 29 UP
+1 22 THUMB UNDER LITTLE UP
+1 17 CIRCLE LITTLE UP
+1 17 OVAL LITTLE UP
+1 17 ANGLE LITTLE UP
+1 17 FIST LITTLE RAISED KNUCKLE
+1 29 BENT
+1 29 TOUCHES THUMB
+1 30 HUMB
+1 17 HINGE LITTLE THUMB
+1 17 FIST LITTLE INDEX THUMB
+1 17 HINGE LITTLE INDEX THUMB
+1 17 ANGLE LITTLE INDEX THUMB INDEX THUMB OUT
+1 53 
+1 17 FIST LITTLE INDEX
+1 17 CIRCLE LITTLE INDEX
+1 17 HINGE LITTLE INDEX
+1 17 ANGLE LITTLE INDEX
+1 17 FIST INDEX MIDDLE LITTLE
+1 17 CIRCLE INDEX MIDDLE LITTLE
+1 17 HINGE INDEX MIDDLE LITTLE
+1 23 RING
+1 17 ANGLE INDEX MIDDLE LITTLE
+1 17 FIST INDEX MIDDLE CROSS LITTLE
+1 17 CIRCLE INDEX MIDDLE CROSS LITTLE
+1 17 FIST RING DOWN
+1 17 HINGE RING DOWN INDEX THUMB HOOK MIDDLE
+1 17 ANGLE RING DOWN MIDDLE THUMB INDEX CROSS
+1 17 FIST RING UP
+1 27 RAISED KNUCKLE
+1 27 LITTLE
+1 17 CIRCLE RING LITTLE
+1 17 OVAL RING LITTLE
+1 17 ANGLE RING LITTLE
+1 17 FIST RING MIDDLE
+1 33  CONJOINED
+1 34 RAISED KNUCKLES
+1 27 INDEX
+1 27 THUMB
+1 17 HOOK RING THUMB
+1 17 FIST INDEX RING LITTLE
+1 17 CIRCLE INDEX RING LITTLE
+1 18 URLICUE INDEX RING LITTLE ON
+1 17 HOOK INDEX RING LITTLE OUT
+1 40 IN
+1 40 UNDER
+1 17 CUP INDEX RING LITTLE
+1 17 HINGE INDEX RING LITTLE
+1 17 ANGLE INDEX RING LITTLE OUT
+1 40 
+1 17 FIST MIDDLE DOWN
+1 17 HINGE MIDDLE
+1 17 FIST MIDDLE UP
+1 17 CIRCLE MIDDLE UP
+1 17 FIST MIDDLE RAISED KNUCKLE
+1 29 UP THUMB SIDE
+1 17 HOOK MIDDLE THUMB
+1 17 FIST MIDDLE THUMB LITTLE
+1 29 LITTLE
+1 29 RING LITTLE
+1 17 CIRCLE MIDDLE RING LITTLE
+1 18 URLICUE MIDDLE RING LITTLE ON
+1 19 P MIDDLE RING LITTLE
+1 17 HINGE MIDDLE RING LITTLE
+1 17 ANGLE MIDDLE RING LITTLE OUT
+1 42 IN
+1 41 
+1 17 CIRCLE MIDDLE RING LITTLE BENT
+1 18 LAW MIDDLE RING LITTLE CONJOINED
+1 50  SIDE
+1 17 HOOK MIDDLE RING LITTLE CONJOINED OUT
+1 51 IN
+1 50 
+1 18 INGE INDEX HINGED
+1 17 FIST INDEX THUMB SIDE
+1 17 HINGE INDEX THUMB SIDE
+1 17 FIST INDEX THUMB SIDE THUMB DIAGONAL
+1 45 CONJOINED
+1 45 BENT
+1 39 INDEX BENT
+1 39 BOTH BENT
+1 39 INDEX HINGE
+1 34 FORWARD INDEX STRAIGHT
+1 48 BENT
+1 34 HOOK
+1 34 CURLICUE
+1 37 VE THUMB INSIDE
+1 17 CLAW INDEX THUMB CURVE THUMB INSIDE
+1 17 FIST INDEX THUMB CURVE THUMB UNDER
+1 35 IRCLE
+1 17 CUP INDEX THUMB
+1 32  OPEN
+1 17 HINGE INDEX THUMB OPEN
+1 35 LARGE
+1 34 
+1 34  SMALL
+1 17 ANGLE INDEX THUMB OUT
+1 35 IN
+1 34 
+1 17 FIST THUMB
+1 27  HEEL
+1 28 SIDE DIAGONAL
+1 33 CONJOINED
+1 33 BENT
+1 28 FORWARD
+1 28 BETWEEN INDEX MIDDLE
+1 36 MIDDLE RING
+1 36 RING LITTLE
+1 28 UNDER TWO FINGERS
+1 28 OVER TWO FINGERS
+1 28 UNDER THREE FINGERS
+1 34 FOUR FINGERS
+1 28 OVER FOUR RAISED KNUCKLES
+1 21 
+1 21  HEEL
+1 12 TOUCH SINGLE
+1 18 MULTIPLE
+1 18 BETWEEN
+1 12 GRASP SINGLE
+1 18 MULTIPLE
+1 18 BETWEEN
+1 12 STRIKE SINGLE
+1 19 MULTIPLE
+1 19 BETWEEN
+1 12 BRUSH SINGLE
+1 18 MULTIPLE
+1 18 BETWEEN
+1 12 RUB SINGLE
+1 16 MULTIPLE
+1 16 BETWEEN
+1 12 SURFACE SYMBOLS
+1 20 BETWEEN
+1 13 QUEEZE LARGE SINGLE
+1 20 SMALL SINGLE
+1 20 LARGE MULTIPLE
+1 20 SMALL MULTIPLE
+1 21 EQUENTIAL
+1 12 FLICK LARGE SINGLE
+1 18 SMALL SINGLE
+1 18 LARGE MULTIPLE
+1 18 SMALL MULTIPLE
+1 19 EQUENTIAL
+1 12 SQUEEZE FLICK ALTERNATING
+1 12 MOVEMENT-HINGE UP DOWN LARGE
+1 35 SMALL
+1 30 SEQUENTIAL
+1 27 DOWN SEQUENTIAL
+1 27 UP DOWN ALTERNATING LARGE
+1 47 SMALL
+1 27 SIDE TO SIDE SCISSORS
+1 21 WALLPLANE FINGER CONTACT
+1 21 FLOORPLANE FINGER CONTACT
+1 21 WALLPLANE SINGLE STRAIGHT SMALL
+1 47 MEDIUM
+1 47 LARGE
+1 52 ST
+1 38 WRIST FLEX
+1 31 DOUBLE STRAIGHT
+1 38 WRIST FLEX
+1 38 ALTERNATING
+1 49  WRIST FLEX
+1 31 CROSS
+1 31 TRIPLE STRAIGHT MOVEMENT
+1 38 WRIST FLEX
+1 38 ALTERNATING
+1 49  WRIST FLEX
+1 31 BEND SMALL
+1 36 MEDIUM
+1 36 LARGE
+1 31 CORNER SMALL
+1 38 MEDIUM
+1 38 LARGE
+1 38 ROTATION
+1 32 HECK SMALL
+1 37 MEDIUM
+1 37 LARGE
+1 31 BOX SMALL
+1 35 MEDIUM
+1 35 LARGE
+1 31 ZIGZAG SMALL
+1 38 MEDIUM
+1 38 LARGE
+1 31 PEAKS SMALL
+1 37 MEDIUM
+1 37 LARGE
+1 12 TRAVEL-WALLPLANE ROTATION-WALLPLANE SINGLE
+1 48 DOUBLE
+1 48 ALTERNATING
+1 38 FLOORPLANE SINGLE
+1 49 DOUBLE
+1 49 ALTERNATING
+1 29 SHAKING
+1 29 ARM SPIRAL SINGLE
+1 40 DOUBLE
+1 40 TRIPLE
+1 12 MOVEMENT-DIAGONAL AWAY SMALL
+1 35 MEDIUM
+1 35 LARGE
+1 40 ST
+1 30 TOWARDS SMALL
+1 38 MEDIUM
+1 38 LARGE
+1 43 ST
+1 30 BETWEEN AWAY SMALL
+1 43 MEDIUM
+1 43 LARGE
+1 48 ST
+1 38 TOWARDS SMALL
+1 46 MEDIUM
+1 46 LARGE
+1 51 ST
+1 21 FLOORPLANE SINGLE STRAIGHT SMALL
+1 48 MEDIUM
+1 48 LARGE
+1 53 ST
+1 39 WRIST FLEX
+1 32 DOUBLE STRAIGHT
+1 39 WRIST FLEX
+1 39 ALTERNATING
+1 50  WRIST FLEX
+1 32 CROSS
+1 32 TRIPLE STRAIGHT MOVEMENT
+1 39 WRIST FLEX
+1 39 ALTERNATING MOVEMENT
+1 51 WRIST FLEX
+1 32 BEND
+1 32 CORNER SMALL
+1 39 MEDIUM
+1 39 LARGE
+1 33 HECK
+1 32 BOX SMALL
+1 36 MEDIUM
+1 36 LARGE
+1 32 ZIGZAG SMALL
+1 39 MEDIUM
+1 39 LARGE
+1 32 PEAKS SMALL
+1 38 MEDIUM
+1 38 LARGE
+1 12 TRAVEL-FLOORPLANE ROTATION-FLOORPLANE SINGLE
+1 50 DOUBLE
+1 50 ALTERNATING
+1 39 WALLPLANE SINGLE
+1 49 DOUBLE
+1 49 ALTERNATING
+1 30 SHAKING
+1 12 MOVEMENT-WALLPLANE CURVE QUARTER SMALL
+1 45 MEDIUM
+1 45 LARGE
+1 50 ST
+1 37 HALF-CIRCLE SMALL
+1 49 MEDIUM
+1 49 LARGE
+1 54 ST
+1 37 THREE-QUARTER CIRCLE SMALL
+1 58 MEDIUM
+1 31 HUMP SMALL
+1 36 MEDIUM
+1 36 LARGE
+1 31 LOOP SMALL
+1 36 MEDIUM
+1 36 LARGE
+1 36 SMALL DOUBLE
+1 31 WAVE CURVE DOUBLE SMALL
+1 49 MEDIUM
+1 49 LARGE
+1 42 TRIPLE SMALL
+1 49 MEDIUM
+1 49 LARGE
+1 31 CURVE THEN STRAIGHT
+1 36 D CROSS SMALL
+1 44 MEDIUM
+1 12 ROTATION-WALLPLANE SINGLE
+1 31 DOUBLE
+1 31 ALTERNATE
+1 12 MOVEMENT-WALLPLANE SHAKING
+1 31 CURVE HITTING FRONT WALL
+1 31 HUMP HITTING FRONT WALL
+1 31 LOOP HITTING FRONT WALL
+1 31 WAVE HITTING FRONT WALL
+1 12 ROTATION-WALLPLANE SINGLE HITTING FRONT WALL
+1 31 DOUBLE HITTING FRONT WALL
+1 31 ALTERNATING HITTING FRONT WALL
+1 12 MOVEMENT-WALLPLANE CURVE HITTING CHEST
+1 31 HUMP HITTING CHEST
+1 31 LOOP HITTING CHEST
+1 31 WAVE HITTING CHEST
+1 12 ROTATION-WALLPLANE SINGLE HITTING CHEST
+1 31 DOUBLE HITTING CHEST
+1 31 ALTERNATING HITTING CHEST
+1 12 MOVEMENT-WALLPLANE WAVE DIAGONAL PATH SMALL
+1 50 MEDIUM
+1 50 LARGE
+1 21 FLOORPLANE CURVE HITTING CEILING SMALL
+1 54 LARGE
+1 32 HUMP HITTING CEILING SMALL DOUBLE
+1 53 LARGE DOUBLE
+1 53 SMALL TRIPLE
+1 53 LARGE TRIPLE
+1 32 LOOP HITTING CEILING SMALL SINGLE
+1 53 LARGE SINGLE
+1 53 SMALL DOUBLE
+1 53 LARGE DOUBLE
+1 32 WAVE HITTING CEILING SMALL
+1 53 LARGE
+1 12 ROTATION-FLOORPLANE SINGLE HITTING CEILING
+1 32 DOUBLE HITTING CEILING
+1 32 ALTERNATING HITTING CEILING
+1 12 MOVEMENT-FLOORPLANE CURVE HITTING FLOOR SMALL
+1 52 LARGE
+1 32 HUMP HITTING FLOOR SMALL DOUBLE
+1 51 LARGE DOUBLE
+1 51 TRIPLE SMALL TRIPLE
+1 58 LARGE TRIPLE
+1 32 LOOP HITTING FLOOR SMALL SINGLE
+1 51 LARGE SINGLE
+1 51 SMALL DOUBLE
+1 51 LARGE DOUBLE
+1 32 WAVE HITTING FLOOR SMALL
+1 51 LARGE
+1 12 ROTATION-FLOORPLANE SINGLE HITTING FLOOR
+1 32 DOUBLE HITTING FLOOR
+1 32 ALTERNATING HITTING FLOOR
+1 12 MOVEMENT-FLOORPLANE CURVE SMALL
+1 38 MEDIUM
+1 38 LARGE
+1 43 ST
+1 38 COMBINED
+1 32 HUMP SMALL
+1 32 LOOP SMALL
+1 32 WAVE SNAKE
+1 38 MALL
+1 37 LARGE
+1 12 ROTATION-FLOORPLANE SINGLE
+1 32 DOUBLE
+1 32 ALTERNATING
+1 12 MOVEMENT-FLOORPLANE SHAKING PARALLEL
+1 21 WALLPLANE ARM CIRCLE SMALL SINGLE
+1 42 MEDIUM SINGLE
+1 42 SMALL DOUBLE
+1 42 MEDIUM DOUBLE
+1 21 FLOORPLANE ARM CIRCLE HITTING WALL SMALL SINGLE
+1 56 MEDIUM SINGLE
+1 56 LARGE SINGLE
+1 56 SMALL DOUBLE
+1 56 MEDIUM DOUBLE
+1 56 LARGE DOUBLE
+1 21 WALLPLANE WRIST CIRCLE FRONT SINGLE
+1 50 DOUBLE
+1 21 FLOORPLANE WRIST CIRCLE HITTING WALL SINGLE
+1 58 DOUBLE
+1 21 WALLPLANE FINGER CIRCLES SINGLE
+1 46 DOUBLE
+1 21 FLOORPLANE FINGER CIRCLES HITTING WALL SINGLE
+1 60 DOUBLE
+1 12 DYNAMIC ARROWHEAD SMALL
+1 30 LARGE
+1 20 FAST
+1 20 SLOW
+1 20 TENSE
+1 20 RELAXED
+1 20 SIMULTANEOUS
+1 32  ALTERNATING
+1 20 EVERY OTHER TIME
+1 20 GRADUAL
+1 12 HEAD
+1 16  RIM
+1 17 MOVEMENT-WALLPLANE STRAIGHT
+1 36 TILT
+1 26 FLOORPLANE STRAIGHT
+1 26 WALLPLANE CURVE
+1 26 FLOORPLANE CURVE
+1 25  CIRCLE
+1 12 FACE DIRECTION POSITION NOSE FORWARD TILTING
+1 41 UP OR DOWN
+1 51  TILTING
+1 12 EYEBROWS STRAIGHT UP
+1 30 NEUTRAL
+1 30 DOWN
+1 12 DREAMY EYEBROWS NEUTRAL DOWN
+1 28 DOWN NEUTRAL
+1 28 UP NEUTRAL
+1 28 NEUTRAL UP
+1 12 FOREHEAD NEUTRAL
+1 21 CONTACT
+1 21 WRINKLED
+1 12 EYES OPEN
+1 17 SQUEEZED
+1 17 CLOSED
+1 15  BLINK SINGLE
+1 22 MULTIPLE
+1 15 S HALF OPEN
+1 17 WIDE OPEN
+1 17 HALF CLOSED
+1 17 WIDENING MOVEMENT
+1 15  WINK
+1 15 LASHES UP
+1 22 DOWN
+1 22 FLUTTERING
+1 15 GAZE-WALLPLANE STRAIGHT
+1 38  DOUBLE
+1 39 ALTERNATING
+1 20 FLOORPLANE STRAIGHT
+1 39  DOUBLE
+1 40 ALTERNATING
+1 20 WALLPLANE CURVED
+1 20 FLOORPLANE CURVED
+1 20 WALLPLANE CIRCLING
+1 12 CHEEKS PUFFED
+1 19 NEUTRAL
+1 19 SUCKED
+1 12 TENSE CHEEKS HIGH
+1 25 MIDDLE
+1 25 LOW
+1 12 EARS
+1 12 NOSE NEUTRAL
+1 17 CONTACT
+1 17 WRINKLES
+1 18 IGGLES
+1 12 AIR BLOWING OUT
+1 16 SUCKING IN
+1 16 BLOW SMALL ROTATIONS
+1 16 SUCK SMALL ROTATIONS
+1 12 BREATH INHALE
+1 19 EXHALE
+1 12 MOUTH CLOSED NEUTRAL
+1 25 FORWARD
+1 25 CONTACT
+1 18 SMILE
+1 23  WRINKLED
+1 24 OPEN
+1 18 FROWN
+1 23  WRINKLED
+1 24 OPEN
+1 18 OPEN CIRCLE
+1 23 FORWARD
+1 23 WRINKLED
+1 23 OVAL
+1 27  WRINKLED
+1 28 YAWN
+1 23 RECTANGLE
+1 32  WRINKLED
+1 33 YAWN
+1 18 KISS
+1 22  FORWARD
+1 23 WRINKLED
+1 18 TENSE
+1 23  FORWARD
+1 24 SUCKED
+1 12 LIPS PRESSED TOGETHER
+1 15  LOWER OVER UPPER
+1 16 UPPER OVER LOWER
+1 12 MOUTH CORNERS
+1 18 WRINKLES SINGLE
+1 27 DOUBLE
+1 12 TONGUE STICKING OUT FAR
+1 19 LICKING LIPS
+1 19 TIP BETWEEN LIPS
+1 23 TOUCHING INSIDE MOUTH
+1 19 INSIDE MOUTH RELAXED
+1 19 MOVES AGAINST CHEEK
+1 19 CENTRE STICKING OUT
+1 26 INSIDE MOUTH
+1 13 EETH
+1 17  MOVEMENT
+1 18 ON TONGUE
+1 27  MOVEMENT
+1 21 LIPS
+1 25  MOVEMENT
+1 18 BITE LIPS
+1 12 MOVEMENT-WALLPLANE JAW
+1 21 FLOORPLANE JAW
+1 12 NECK
+1 12 HAIR
+1 12 EXCITEMENT
+1 12 SHOULDER HIP SPINE
+1 25 POSITIONS
+1 12 WALLPLANE SHOULDER HIP MOVE
+1 12 FLOORPLANE SHOULDER HIP MOVE
+1 12 SHOULDER TILTING FROM WAIST
+1 12 TORSO-WALLPLANE STRAIGHT STRETCH
+1 28 CURVED BEND
+1 18 FLOORPLANE TWISTING
+1 12 UPPER BODY TILTING FROM HIP JOINTS
+1 12 LIMB COMBINATION
+1 17 LENGTH-1
+1 24 2
+1 24 3
+1 24 4
+1 24 5
+1 24 6
+1 24 7
+1 12 FINGER
+1 12 LOCATION-WALLPLANE SPACE
+1 21 FLOORPLANE SPACE
+1 20  HEIGHT
+1 21 WIDTH
+1 21 DEPTH
+1 21 HEAD NECK
+1 21 TORSO
+1 21 LIMBS DIGITS
+1 12 COMMA
+1 12 FULL STOP
+1 12 SEMICOLON
+1 12 COLON
+1 12 PARENTHESIS
+10 12 FILL MODIFIER-2
+1 26 3
+1 26 4
+1 26 5
+1 26 6
+2 12 ROTATION MODIFIER-2
+1 30 3
+1 30 4
+1 30 5
+1 30 6
+1 30 7
+1 30 8
+1 30 9
+1 30 10
+1 31 1
+1 31 2
+1 31 3
+1 31 4
+1 31 5
+1 31 6
+451 0 LATIN SMALL LETTER FENG DIGRAPH WITH TRILL
+1 19 REVERSED SCRIPT G
+1 6 LETTER SMALL CAPITAL TURNED G
+1 6 SMALL LETTER REVERSED K
+1 6 LETTER SMALL CAPITAL L WITH BELT
+1 6 SMALL LETTER LEZH WITH RETROFLEX HOOK
+1 19 TURNED Y WITH BELT
+1 19 REVERSED ENG
+1 19 TURNED R WITH LONG LEG AND RETROFLEX HOOK
+1 20  WITH HOOK AND RETROFLEX HOOK
+1 6 LETTER RETROFLEX CLICK WITH RETROFLEX HOOK
+1 6 SMALL LETTER ESH WITH DOUBLE BAR
+1 38  AND CURL
+1 19 TURNED T WITH CURL
+1 6 LETTER INVERTED GLOTTAL STOP WITH CURL
+1 13 STRETCHED C WITH CURL
+1 14 MALL CAPITAL TURNED K
+1 6 SMALL LETTER L WITH FISHHOOK
+1 19 DEZH DIGRAPH WITH PALATAL HOOK
+1 19 L WITH BELT AND PALATAL HOOK
+1 19 ENG WITH PALATAL HOOK
+1 19 TURNED R WITH PALATAL HOOK
+1 19 R WITH FISHHOOK AND PALATAL HOOK
+1 19 TESH DIGRAPH WITH PALATAL HOOK
+1 19 EZH WITH PALATAL HOOK
+1 19 DEZH DIGRAPH WITH RETROFLEX HOOK
+1 19 I WITH STROKE AND RETROFLEX HOOK
+1 19 O WITH RETROFLEX HOOK
+1 19 TESH DIGRAPH WITH RETROFLEX HOOK
+1 19 C WITH RETROFLEX HOOK
+1 19 S WITH CURL
+e2 0 COMBINING GLAGOLITIC LETTER AZU
+1 28 BUKY
+1 28 VEDE
+1 28 GLAGOLI
+1 28 DOBRO
+1 28 YESTU
+1 28 ZHIVETE
+2 29 EMLJA
+1 28 IZHE
+1 29 NITIAL IZHE
+1 29 
+1 28 DJERVI
+1 28 KAKO
+1 28 LJUDIJE
+1 28 MYSLITE
+1 28 NASHI
+1 28 ONU
+1 28 POKOJI
+1 28 RITSI
+1 28 SLOVO
+1 28 TVRIDO
+1 28 UKU
+1 28 FRITU
+1 28 HERU
+3 28 SHTA
+1 28 TSI
+1 28 CHRIVI
+1 28 SHA
+1 28 YERU
+1 31 I
+1 29 ATI
+2 29 U
+1 28 SMALL YUS
+2 28 YO
+1 28 IOTATED SMALL YUS
+1 28 BIG YUS
+1 28 IOTATED BIG YUS
+1 28 FITA
+d6 0 NYIAKENG PUACHUE HMONG LETTER MA
+1 30 TSA
+1 30 NTA
+1 30 TA
+1 30 HA
+1 30 NA
+1 30 XA
+1 30 NKA
+1 30 CA
+1 30 LA
+1 30 SA
+1 30 ZA
+1 30 NCA
+1 31 TSA
+1 30 KA
+1 30 DA
+1 30 NYA
+1 31 RA
+1 30 VA
+1 30 NTXA
+1 30 TXA
+1 30 FA
+1 30 RA
+1 30 QA
+1 30 YA
+1 30 NQA
+1 30 PA
+1 30 XYA
+1 30 NPA
+1 30 DLA
+1 30 NPLA
+1 30 HAH
+1 30 MLA
+1 30 PLA
+1 30 GA
+1 30 RRA
+1 30 A
+1 31 A
+1 30 I
+1 30 U
+1 30 O
+1 31 O
+1 30 E
+1 31 E
+1 30 W
+4 23 TONE-B
+1 28 M
+1 28 J
+1 28 V
+1 28 S
+1 28 G
+1 28 D
+1 23 SIGN FOR PERSON
+1 32 THING
+1 32 LOCATION
+1 32 ANIMAL
+1 32 INVERTEBRATE
+1 28 XW XW
+1 24 YLLABLE LENGTHENER
+3 23 DIGIT ZERO
+1 29 ONE
+1 29 TWO
+1 30 HREE
+1 29 FOUR
+1 30 IVE
+1 29 SIX
+1 30 EVEN
+1 29 EIGHT
+1 29 NINE
+5 23 LOGOGRAM NYAJ
+1 23 CIRCLED CA
+141 0 TOTO LETTER PA
+1 12 BA
+1 12 TA
+1 12 DA
+1 12 KA
+1 12 GA
+1 12 MA
+1 12 NA
+1 13 GA
+1 12 SA
+1 12 CHA
+1 12 YA
+1 12 WA
+1 12 JA
+1 12 HA
+1 12 RA
+1 12 LA
+1 12 I
+1 12 BREATHY I
+1 12 IU
+1 12 BREATHY IU
+1 12 U
+1 12 E
+1 12 BREATHY E
+1 12 EO
+1 12 BREATHY EO
+1 12 O
+1 12 AE
+1 12 BREATHY AE
+1 12 A
+1 5 SIGN RISING TONE
+12 0 WANCHO LETTER AA
+1 15 
+1 14 BA
+1 14 CA
+1 14 DA
+1 14 GA
+1 14 YA
+1 14 PHA
+1 14 LA
+1 14 NA
+1 14 PA
+1 14 TA
+1 15 HA
+1 14 FA
+1 14 SA
+1 15 HA
+1 14 JA
+1 14 ZA
+1 14 WA
+1 14 VA
+1 14 KA
+1 14 O
+1 14 AU
+1 14 RA
+1 14 MA
+1 14 KHA
+1 14 HA
+1 14 E
+1 14 I
+1 14 NGA
+1 14 U
+1 14 LLHA
+1 14 TSA
+1 15 RA
+1 14 ONG
+1 14 AANG
+1 15 NG
+1 14 ING
+1 14 ON
+1 14 EN
+1 14 AAN
+1 14 NYA
+1 14 UEN
+1 14 YIH
+1 7 TONE TUP
+1 15 NI
+1 12 KOI
+1 15 NI
+1 7 DIGIT ZERO
+1 13 ONE
+1 13 TWO
+1 14 HREE
+1 13 FOUR
+1 14 IVE
+1 13 SIX
+1 14 EVEN
+1 13 EIGHT
+1 13 NINE
+6 7 NGUN SIGN
+4e1 0 ETHIOPIC SYLLABLE HHYA
+1 21 U
+1 21 I
+1 21 AA
+1 21 EE
+1 22 
+1 21 O
+2 18 GURAGE HHWA
+1 18 HHWI
+1 21 EE
+1 22 
+2 18 GURAGE MWI
+1 27 EE
+2 25 QWI
+1 27 EE
+1 28 
+1 25 BWI
+1 27 EE
+1 25 KWI
+1 27 EE
+1 28 
+1 25 GWI
+1 27 EE
+1 28 
+1 25 FWI
+1 27 EE
+1 25 PWI
+1 27 EE
+2 0 MENDE KIKAKUI SYLLABLE M001 KI
+1 26 2 KA
+1 26 3 KU
+1 25 65 KEE
+1 25 95 KE
+1 25 76 KOO
+1 25 48 KO
+1 24 179 KUA
+1 24 004 WI
+1 26 5 WA
+1 26 6 WU
+1 24 126 WEE
+1 25 18 WE
+1 26 4 WOO
+1 24 045 WO
+1 24 194 WUI
+1 25 43 WEI
+1 24 061 WVI
+1 25 49 WVA
+1 24 139 WVE
+1 24 007 MIN
+1 26 8 MAN
+1 26 9 MUN
+1 25 59 MEN
+1 25 94 MON
+1 24 154 MUAN
+1 25 89 MUEN
+1 24 010 BI
+1 26 1 BA
+1 26 2 BU
+1 24 150 BEE
+1 24 097 BE
+1 24 103 BOO
+1 25 38 BO
+1 24 013 I
+1 26 4 A
+1 26 5 U
+1 24 163 EE
+1 25 00 E
+1 25 65 OO
+1 25 47 O
+1 25 37 EI
+1 26 1 IN
+1 26 5 IN
+1 25 95 AN
+1 25 78 EN
+1 24 019 SI
+1 25 20 SA
+1 26 1 SU
+1 24 162 SEE
+1 25 16 SE
+1 25 36 SOO
+1 24 079 SO
+1 24 196 SIA
+1 24 025 LI
+1 26 6 LA
+1 26 7 LU
+1 25 84 LEE
+1 25 73 LE
+1 25 54 LOO
+1 24 153 LO
+1 25 10 LONG LE
+1 24 016 DI
+1 26 7 DA
+1 26 8 DU
+1 25 89 DEE
+1 24 180 DOO
+1 26 1 DO
+1 24 022 TI
+1 26 3 TA
+1 26 4 TU
+1 25 91 TEE
+1 25 55 TE
+1 24 104 TOO
+1 24 069 TO
+1 25 28 JI
+1 26 9 JA
+1 25 30 JU
+1 24 157 JEE
+1 25 13 JE
+1 25 60 JOO
+1 24 063 JO
+1 24 175 LONG JO
+1 24 031 YI
+1 26 2 YA
+1 26 3 YU
+1 24 109 YEE
+1 24 080 YE
+1 24 141 YOO
+1 25 21 YO
+1 24 034 FI
+1 26 5 FA
+1 26 6 FU
+1 25 78 FEE
+1 26 5 FE
+1 24 133 FOO
+1 24 088 FO
+1 24 197 FUA
+1 25 01 FAN
+1 24 037 NIN
+1 26 8 NAN
+1 26 9 NUN
+1 24 117 NEN
+1 25 69 NON
+1 25 76 HI
+1 24 041 HA
+1 24 186 HU
+1 24 040 HEE
+1 25 96 HE
+1 25 42 HOO
+1 24 140 HO
+1 24 083 HEEI
+1 24 128 HOOU
+1 24 053 HIN
+1 24 130 HAN
+1 24 087 HUN
+1 25 52 HEN
+1 24 193 HON
+1 24 046 HUAN
+1 25 90 NGGI
+1 25 43 NGGA
+1 25 82 NGGU
+1 24 115 NGGEE
+1 25 46 NGGE
+1 25 56 NGGOO
+1 25 20 NGGO
+1 25 59 NGGAA
+1 25 27 NGGUA
+1 24 086 LONG NGGE
+1 24 106 LONG NGGOO
+1 25 83 LONG NGGO
+1 25 55 GI
+1 25 11 GA
+1 25 68 GU
+1 25 90 GEE
+1 25 66 GUEI
+1 26 7 GUAN
+1 25 84 NGEN
+1 24 057 NGON
+1 24 177 NGUAN
+1 24 068 PI
+1 25 99 PA
+1 25 50 PU
+1 25 81 PEE
+1 25 51 PE
+1 24 102 POO
+1 24 066 PO
+1 24 145 MBI
+1 24 062 MBA
+1 24 122 MBU
+1 24 047 MBEE
+1 24 188 MBEE
+1 24 072 MBE
+1 24 172 MBOO
+1 26 4 MBO
+1 25 87 MBUU
+1 25 61 LONG MBE
+1 25 05 LONG MBOO
+1 25 42 LONG MBO
+1 25 32 KPI
+1 24 092 KPA
+1 25 74 KPU
+1 25 44 KPEE
+1 24 108 KPE
+1 25 12 KPOO
+1 25 58 KPO
+1 25 24 GBI
+1 24 056 GBA
+1 24 148 GBU
+1 24 093 GBEE
+1 24 107 GBE
+1 24 071 GBOO
+1 26 0 GBO
+1 24 171 RA
+1 25 23 NDI
+1 26 9 NDA
+1 26 5 NDU
+1 25 91 NDEE
+1 25 19 NDE
+1 24 067 NDOO
+1 26 4 NDO
+1 24 152 NJA
+1 25 92 NJU
+1 25 49 NJEE
+1 25 34 NJOO
+1 25 82 VI
+1 26 5 VA
+1 25 51 VU
+1 25 73 VEE
+1 24 085 VE
+1 24 144 VOO
+1 24 077 VO
+1 24 164 NYIN
+1 24 058 NYAN
+1 24 170 NYUN
+1 24 098 NYEN
+1 25 60 NYON
+3 14 DIGIT ONE
+1 20 TWO
+1 21 HREE
+1 20 FOUR
+1 21 IVE
+1 20 SIX
+1 21 EVEN
+1 20 EIGHT
+1 20 NINE
+1 14 COMBINING NUMBER TEENS
+1 33 NS
+1 31 HUNDREDS
+1 31 THOUSANDS
+1 32 EN THOUSANDS
+1 31 HUNDRED THOUSANDS
+1 31 MILLIONS
+2a 0 ADLAM CAPITAL LETTER ALIF
+1 21 DAALI
+1 21 LAAM
+1 21 MIIM
+1 21 BA
+1 21 SINNYIIYHE
+1 21 PE
+1 21 BHE
+1 21 RA
+1 21 E
+1 21 FA
+1 21 I
+1 21 O
+1 21 DHA
+1 21 YHE
+1 21 WAW
+1 21 NUN
+1 21 KAF
+1 21 YA
+1 21 U
+1 21 JIIM
+1 21 CHI
+1 21 HA
+1 21 QAAF
+1 21 GA
+1 21 NYA
+1 21 TU
+1 21 NHA
+1 21 VA
+1 21 KHA
+1 21 GBE
+1 21 ZAL
+1 21 KPO
+1 21 SHA
+1 6 SMALL LETTER ALIF
+1 19 DAALI
+1 19 LAAM
+1 19 MIIM
+1 19 BA
+1 19 SINNYIIYHE
+1 19 PE
+1 19 BHE
+1 19 RA
+1 19 E
+1 19 FA
+1 19 I
+1 19 O
+1 19 DHA
+1 19 YHE
+1 19 WAW
+1 19 NUN
+1 19 KAF
+1 19 YA
+1 19 U
+1 19 JIIM
+1 19 CHI
+1 19 HA
+1 19 QAAF
+1 19 GA
+1 19 NYA
+1 19 TU
+1 19 NHA
+1 19 VA
+1 19 KHA
+1 19 GBE
+1 19 ZAL
+1 19 KPO
+1 19 SHA
+1 6 ALIF LENGTHENER
+1 6 VOWEL LENGTHENER
+1 6 GEMINATION MARK
+1 6 HAMZA
+1 6 CONSONANT MODIFIER
+1 6 GEMINATE CONSONANT MODIFIER
+1 6 NUKTA
+1 7 ASALIZATION MARK
+5 6 DIGIT ZERO
+1 12 ONE
+1 12 TWO
+1 13 HREE
+1 12 FOUR
+1 13 IVE
+1 12 SIX
+1 13 EVEN
+1 12 EIGHT
+1 12 NINE
+5 6 INITIAL EXCLAMATION MARK
+1 14 QUESTION MARK
+312 0 INDIC SIYAQ NUMBER ONE
+1 19 TWO
+1 20 HREE
+1 19 FOUR
+1 20 IVE
+1 19 SIX
+1 20 EVEN
+1 19 EIGHT
+1 19 NINE
+1 19 TEN
+1 20 WENTY
+1 20 HIRTY
+1 19 FORTY
+1 20 IFTY
+1 19 SIXTY
+1 20 EVENTY
+1 19 EIGHTY
+1 19 NINETY
+1 19 ONE HUNDRED
+1 19 TWO HUNDRED
+1 20 HREE HUNDRED
+1 19 FOUR HUNDRED
+1 20 IVE HUNDRED
+1 19 SIX HUNDRED
+1 20 EVEN HUNDRED
+1 19 EIGHT HUNDRED
+1 19 NINE HUNDRED
+1 19 ONE THOUSAND
+1 19 TWO THOUSAND
+1 20 HREE THOUSAND
+1 19 FOUR THOUSAND
+1 20 IVE THOUSAND
+1 19 SIX THOUSAND
+1 20 EVEN THOUSAND
+1 19 EIGHT THOUSAND
+1 19 NINE THOUSAND
+1 19 TEN THOUSAND
+1 20 WENTY THOUSAND
+1 20 HIRTY THOUSAND
+1 19 FORTY THOUSAND
+1 20 IFTY THOUSAND
+1 19 SIXTY THOUSAND
+1 20 EVENTY THOUSAND
+1 19 EIGHTY THOUSAND
+1 19 NINETY THOUSAND
+1 19 LAKH
+1 23 AN
+1 12 LAKH MARK
+1 12 NUMBER KAROR
+1 24 AN
+1 19 PREFIXED ONE
+1 28 TWO
+1 29 HREE
+1 28 FOUR
+1 29 IVE
+1 28 SIX
+1 29 EVEN
+1 28 EIGHT
+1 28 NINE
+1 12 PLACEHOLDER
+1 12 FRACTION ONE QUARTER
+1 25 HALF
+1 21 THREE QUARTERS
+1 12 RUPEE MARK
+1 12 NUMBER ALTERNATE ONE
+1 29 TWO
+1 30 EN THOUSAND
+1 12 ALTERNATE LAKH MARK
+4d 0 OTTOMAN SIYAQ NUMBER ONE
+1 21 TWO
+1 22 HREE
+1 21 FOUR
+1 22 IVE
+1 21 SIX
+1 22 EVEN
+1 21 EIGHT
+1 21 NINE
+1 21 TEN
+1 22 WENTY
+1 22 HIRTY
+1 21 FORTY
+1 22 IFTY
+1 21 SIXTY
+1 22 EVENTY
+1 21 EIGHTY
+1 21 NINETY
+1 21 ONE HUNDRED
+1 21 TWO HUNDRED
+1 22 HREE HUNDRED
+1 21 FOUR HUNDRED
+1 22 IVE HUNDRED
+1 21 SIX HUNDRED
+1 22 EVEN HUNDRED
+1 21 EIGHT HUNDRED
+1 21 NINE HUNDRED
+1 21 ONE THOUSAND
+1 21 TWO THOUSAND
+1 22 HREE THOUSAND
+1 21 FOUR THOUSAND
+1 22 IVE THOUSAND
+1 21 SIX THOUSAND
+1 22 EVEN THOUSAND
+1 21 EIGHT THOUSAND
+1 21 NINE THOUSAND
+1 21 TEN THOUSAND
+1 22 WENTY THOUSAND
+1 22 HIRTY THOUSAND
+1 21 FORTY THOUSAND
+1 22 IFTY THOUSAND
+1 21 SIXTY THOUSAND
+1 22 EVENTY THOUSAND
+1 21 EIGHTY THOUSAND
+1 21 NINETY THOUSAND
+1 14 MARRATAN
+1 14 ALTERNATE NUMBER TWO
+1 32 HREE
+1 31 FOUR
+1 32 IVE
+1 31 SIX
+1 32 EVEN
+1 31 EIGHT
+1 31 NINE
+1 31 TEN
+1 31 FOUR HUNDRED
+1 31 SIX HUNDRED
+1 31 TWO THOUSAND
+1 32 EN THOUSAND
+1 14 FRACTION ONE HALF
+1 27 SIXTH
+c3 0 ARABIC MATHEMATICAL ALEF
+1 20 BEH
+1 20 JEEM
+1 20 DAL
+2 20 WAW
+1 20 ZAIN
+1 20 HAH
+1 20 TAH
+1 20 YEH
+1 20 KAF
+1 20 LAM
+1 20 MEEM
+1 20 NOON
+1 20 SEEN
+1 20 AIN
+1 20 FEH
+1 20 SAD
+1 20 QAF
+1 20 REH
+1 20 SHEEN
+1 20 TEH
+1 21 HEH
+1 20 KHAH
+1 20 THAL
+1 20 DAD
+1 20 ZAH
+1 20 GHAIN
+1 20 DOTLESS BEH
+1 28 NOON
+1 28 FEH
+1 28 QAF
+2 20 INITIAL BEH
+1 28 JEEM
+2 28 HEH
+3 29 AH
+2 28 YEH
+1 28 KAF
+1 28 LAM
+1 28 MEEM
+1 28 NOON
+1 28 SEEN
+1 28 AIN
+1 28 FEH
+1 28 SAD
+1 28 QAF
+2 28 SHEEN
+1 28 TEH
+1 29 HEH
+1 28 KHAH
+2 28 DAD
+2 28 GHAIN
+7 20 TAILED JEEM
+5 27 HAH
+2 27 YEH
+2 27 LAM
+2 27 NOON
+1 27 SEEN
+1 27 AIN
+2 27 SAD
+1 27 QAF
+2 27 SHEEN
+3 27 KHAH
+2 27 DAD
+2 27 GHAIN
+2 27 DOTLESS NOON
+2 35 QAF
+2 20 STRETCHED BEH
+1 30 JEEM
+2 30 HEH
+3 31 AH
+1 30 TAH
+1 30 YEH
+1 30 KAF
+2 30 MEEM
+1 30 NOON
+1 30 SEEN
+1 30 AIN
+1 30 FEH
+1 30 SAD
+1 30 QAF
+2 30 SHEEN
+1 30 TEH
+1 31 HEH
+1 30 KHAH
+2 30 DAD
+1 30 ZAH
+1 30 GHAIN
+1 30 DOTLESS BEH
+2 38 FEH
+2 20 LOOPED ALEF
+1 27 BEH
+1 27 JEEM
+1 27 DAL
+1 27 HEH
+1 27 WAW
+1 27 ZAIN
+1 27 HAH
+1 27 TAH
+1 27 YEH
+2 27 LAM
+1 27 MEEM
+1 27 NOON
+1 27 SEEN
+1 27 AIN
+1 27 FEH
+1 27 SAD
+1 27 QAF
+1 27 REH
+1 27 SHEEN
+1 27 TEH
+1 28 HEH
+1 27 KHAH
+1 27 THAL
+1 27 DAD
+1 27 ZAH
+1 27 GHAIN
+6 20 DOUBLE-STRUCK BEH
+1 34 JEEM
+1 34 DAL
+2 34 WAW
+1 34 ZAIN
+1 34 HAH
+1 34 TAH
+1 34 YEH
+2 34 LAM
+1 34 MEEM
+1 34 NOON
+1 34 SEEN
+1 34 AIN
+1 34 FEH
+1 34 SAD
+1 34 QAF
+1 34 REH
+1 34 SHEEN
+1 34 TEH
+1 35 HEH
+1 34 KHAH
+1 34 THAL
+1 34 DAD
+1 34 ZAH
+1 34 GHAIN
+35 20 OPERATOR MEEM WITH HAH WITH TATWEEL
+1 29 HAH WITH DAL
+10f 0 MAHJONG TILE EAST WIND
+1 13 SOUTH WIND
+1 13 WEST WIND
+1 13 NORTH WIND
+1 13 RED DRAGON
+1 13 GREEN DRAGON
+1 13 WHITE DRAGON
+1 13 ONE OF CHARACTERS
+1 13 TWO OF CHARACTERS
+1 14 HREE OF CHARACTERS
+1 13 FOUR OF CHARACTERS
+1 14 IVE OF CHARACTERS
+1 13 SIX OF CHARACTERS
+1 14 EVEN OF CHARACTERS
+1 13 EIGHT OF CHARACTERS
+1 13 NINE OF CHARACTERS
+1 13 ONE OF BAMBOOS
+1 13 TWO OF BAMBOOS
+1 14 HREE OF BAMBOOS
+1 13 FOUR OF BAMBOOS
+1 14 IVE OF BAMBOOS
+1 13 SIX OF BAMBOOS
+1 14 EVEN OF BAMBOOS
+1 13 EIGHT OF BAMBOOS
+1 13 NINE OF BAMBOOS
+1 13 ONE OF CIRCLES
+1 13 TWO OF CIRCLES
+1 14 HREE OF CIRCLES
+1 13 FOUR OF CIRCLES
+1 14 IVE OF CIRCLES
+1 13 SIX OF CIRCLES
+1 14 EVEN OF CIRCLES
+1 13 EIGHT OF CIRCLES
+1 13 NINE OF CIRCLES
+1 13 PLUM
+1 13 ORCHID
+1 13 BAMBOO
+1 13 CHRYSANTHEMUM
+1 13 SPRING
+1 14 UMMER
+1 13 AUTUMN
+1 13 WINTER
+1 13 JOKER
+1 13 BACK
+5 0 DOMINO TILE HORIZONTAL BACK
+1 22 -00-00
+1 27 1
+1 27 2
+1 27 3
+1 27 4
+1 27 5
+1 27 6
+1 24 1-00
+1 27 1
+1 27 2
+1 27 3
+1 27 4
+1 27 5
+1 27 6
+1 24 2-00
+1 27 1
+1 27 2
+1 27 3
+1 27 4
+1 27 5
+1 27 6
+1 24 3-00
+1 27 1
+1 27 2
+1 27 3
+1 27 4
+1 27 5
+1 27 6
+1 24 4-00
+1 27 1
+1 27 2
+1 27 3
+1 27 4
+1 27 5
+1 27 6
+1 24 5-00
+1 27 1
+1 27 2
+1 27 3
+1 27 4
+1 27 5
+1 27 6
+1 24 6-00
+1 27 1
+1 27 2
+1 27 3
+1 27 4
+1 27 5
+1 27 6
+1 12 VERTICAL BACK
+1 20 -00-00
+1 25 1
+1 25 2
+1 25 3
+1 25 4
+1 25 5
+1 25 6
+1 22 1-00
+1 25 1
+1 25 2
+1 25 3
+1 25 4
+1 25 5
+1 25 6
+1 22 2-00
+1 25 1
+1 25 2
+1 25 3
+1 25 4
+1 25 5
+1 25 6
+1 22 3-00
+1 25 1
+1 25 2
+1 25 3
+1 25 4
+1 25 5
+1 25 6
+1 22 4-00
+1 25 1
+1 25 2
+1 25 3
+1 25 4
+1 25 5
+1 25 6
+1 22 5-00
+1 25 1
+1 25 2
+1 25 3
+1 25 4
+1 25 5
+1 25 6
+1 22 6-00
+1 25 1
+1 25 2
+1 25 3
+1 25 4
+1 25 5
+1 25 6
+d 0 PLAYING CARD BACK
+1 13 ACE OF SPADES
+1 13 TWO OF SPADES
+1 14 HREE OF SPADES
+1 13 FOUR OF SPADES
+1 14 IVE OF SPADES
+1 13 SIX OF SPADES
+1 14 EVEN OF SPADES
+1 13 EIGHT OF SPADES
+1 13 NINE OF SPADES
+1 13 TEN OF SPADES
+1 13 JACK OF SPADES
+1 13 KNIGHT OF SPADES
+1 13 QUEEN OF SPADES
+1 13 KING OF SPADES
+3 13 ACE OF HEARTS
+1 13 TWO OF HEARTS
+1 14 HREE OF HEARTS
+1 13 FOUR OF HEARTS
+1 14 IVE OF HEARTS
+1 13 SIX OF HEARTS
+1 14 EVEN OF HEARTS
+1 13 EIGHT OF HEARTS
+1 13 NINE OF HEARTS
+1 13 TEN OF HEARTS
+1 13 JACK OF HEARTS
+1 13 KNIGHT OF HEARTS
+1 13 QUEEN OF HEARTS
+1 13 KING OF HEARTS
+1 13 RED JOKER
+2 13 ACE OF DIAMONDS
+1 13 TWO OF DIAMONDS
+1 14 HREE OF DIAMONDS
+1 13 FOUR OF DIAMONDS
+1 14 IVE OF DIAMONDS
+1 13 SIX OF DIAMONDS
+1 14 EVEN OF DIAMONDS
+1 13 EIGHT OF DIAMONDS
+1 13 NINE OF DIAMONDS
+1 13 TEN OF DIAMONDS
+1 13 JACK OF DIAMONDS
+1 13 KNIGHT OF DIAMONDS
+1 13 QUEEN OF DIAMONDS
+1 13 KING OF DIAMONDS
+1 13 BLACK JOKER
+2 13 ACE OF CLUBS
+1 13 TWO OF CLUBS
+1 14 HREE OF CLUBS
+1 13 FOUR OF CLUBS
+1 14 IVE OF CLUBS
+1 13 SIX OF CLUBS
+1 14 EVEN OF CLUBS
+1 13 EIGHT OF CLUBS
+1 13 NINE OF CLUBS
+1 13 TEN OF CLUBS
+1 13 JACK OF CLUBS
+1 13 KNIGHT OF CLUBS
+1 13 QUEEN OF CLUBS
+1 13 KING OF CLUBS
+1 13 WHITE JOKER
+1 13 FOOL
+1 13 TRUMP-1
+1 19 2
+1 19 3
+1 19 4
+1 19 5
+1 19 6
+1 19 7
+1 19 8
+1 19 9
+1 19 10
+1 20 1
+1 20 2
+1 20 3
+1 20 4
+1 20 5
+1 20 6
+1 20 7
+1 20 8
+1 20 9
+1 19 20
+1 20 1
+b 0 DIGIT ZERO FULL STOP
+1 11 COMMA
+1 6 ONE COMMA
+1 6 TWO COMMA
+1 7 HREE COMMA
+1 6 FOUR COMMA
+1 7 IVE COMMA
+1 6 SIX COMMA
+1 7 EVEN COMMA
+1 6 EIGHT COMMA
+1 6 NINE COMMA
+1 2 NGBAT CIRCLED SANS-SERIF DIGIT ZERO
+1 8 NEGATIVE CIRCLED SANS-SERIF DIGIT ZERO
+1 0 CIRCLED ZERO WITH SLASH
+1 8 ANTICLOCKWISE ARROW
+1 8 DOLLAR SIGN WITH OVERLAID BACKSLASH
+1 0 PARENTHESIZED LATIN CAPITAL LETTER A
+1 35 B
+1 35 C
+1 35 D
+1 35 E
+1 35 F
+1 35 G
+1 35 H
+1 35 I
+1 35 J
+1 35 K
+1 35 L
+1 35 M
+1 35 N
+1 35 O
+1 35 P
+1 35 Q
+1 35 R
+1 35 S
+1 35 T
+1 35 U
+1 35 V
+1 35 W
+1 35 X
+1 35 Y
+1 35 Z
+1 0 TORTOISE SHELL BRACKETED LATIN CAPITAL LETTER S
+1 0 CIRCLED ITALIC LATIN CAPITAL LETTER C
+1 36 R
+1 8 CD
+1 8 WZ
+1 1 OPYLEFT SYMBOL
+1 0 SQUARED LATIN CAPITAL LETTER A
+1 29 B
+1 29 C
+1 29 D
+1 29 E
+1 29 F
+1 29 G
+1 29 H
+1 29 I
+1 29 J
+1 29 K
+1 29 L
+1 29 M
+1 29 N
+1 29 O
+1 29 P
+1 29 Q
+1 29 R
+1 29 S
+1 29 T
+1 29 U
+1 29 V
+1 29 W
+1 29 X
+1 29 Y
+1 29 Z
+1 8 HV
+1 8 MV
+1 8 SD
+1 9 S
+1 8 PPV
+1 8 WC
+1 0 NEGATIVE CIRCLED LATIN CAPITAL LETTER A
+1 38 B
+1 38 C
+1 38 D
+1 38 E
+1 38 F
+1 38 G
+1 38 H
+1 38 I
+1 38 J
+1 38 K
+1 38 L
+1 38 M
+1 38 N
+1 38 O
+1 38 P
+1 38 Q
+1 38 R
+1 38 S
+1 38 T
+1 38 U
+1 38 V
+1 38 W
+1 38 X
+1 38 Y
+1 38 Z
+1 0 RAISED MC SIGN
+1 8 D SIGN
+1 8 R SIGN
+1 0 CIRCLED CC
+1 9  WITH OVERLAID BACKSLASH
+1 8 HUMAN FIGURE
+1 0 NEGATIVE SQUARED LATIN CAPITAL LETTER A
+1 38 B
+1 38 C
+1 38 D
+1 38 E
+1 38 F
+1 38 G
+1 38 H
+1 38 I
+1 38 J
+1 38 K
+1 38 L
+1 38 M
+1 38 N
+1 38 O
+1 38 P
+1 38 Q
+1 38 R
+1 38 S
+1 38 T
+1 38 U
+1 38 V
+1 38 W
+1 38 X
+1 38 Y
+1 38 Z
+1 0 CROSSED NEGATIVE SQUARED LATIN CAPITAL LETTER P
+1 0 NEGATIVE SQUARED IC
+1 17 PA
+1 17 SA
+1 17 AB
+1 17 WC
+1 0 SQUARE DJ
+1 6 D CL
+1 9 OOL
+1 8 FREE
+1 8 ID
+1 8 NEW
+1 9 G
+1 8 OK
+1 8 SOS
+1 8 UP WITH EXCLAMATION MARK
+1 8 VS
+1 8 THREE D
+1 8 SECOND SCREEN
+1 8 TWO K
+1 8 FOUR K
+1 8 EIGHT K
+1 8 FIVE POINT ONE
+1 8 SEVEN POINT ONE
+1 8 TWENTY-TWO POINT TWO
+1 8 SIXTY P
+1 8 ONE HUNDRED TWENTY P
+1 8 LATIN SMALL LETTER D
+1 8 HC
+1 9 DR
+1 9 I-RES
+1 8 LOSSLESS
+1 8 SHV
+1 8 UHD
+1 8 VOD
+1 0 MASK WORK SYMBOL
+39 0 REGIONAL INDICATOR SYMBOL LETTER A
+1 33 B
+1 33 C
+1 33 D
+1 33 E
+1 33 F
+1 33 G
+1 33 H
+1 33 I
+1 33 J
+1 33 K
+1 33 L
+1 33 M
+1 33 N
+1 33 O
+1 33 P
+1 33 Q
+1 33 R
+1 33 S
+1 33 T
+1 33 U
+1 33 V
+1 33 W
+1 33 X
+1 33 Y
+1 33 Z
+1 0 SQUARE HIRAGANA HOKA
+1 6 D KATAKANA KOKO
+1 17 SA
+e 8 CJK UNIFIED IDEOGRAPH-624B
+1 30 5B57
+1 31 3CC
+1 8 KATAKANA DE
+1 8 CJK UNIFIED IDEOGRAPH-4E8C
+1 30 591A
+1 30 89E3
+1 30 5929
+1 30 4EA4
+1 30 6620
+1 30 7121
+1 30 6599
+1 30 524D
+1 31 F8C
+1 31 18D
+1 30 65B0
+1 30 521D
+1 30 7D42
+1 31 51F
+1 30 8CA9
+1 30 58F0
+1 31 439
+1 30 6F14
+1 31 295
+1 31 355
+1 30 4E00
+1 33 9
+1 30 904A
+1 30 5DE6
+1 30 4E2D
+1 30 53F3
+1 30 6307
+1 30 8D70
+1 30 6253
+1 30 7981
+1 31 A7A
+1 30 5408
+1 30 6E80
+1 31 709
+1 33 8
+1 30 7533
+1 30 5272
+1 31 5B6
+1 30 914D
+5 0 TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-672C
+1 47 4E09
+1 49 8C
+1 47 5B89
+1 47 70B9
+1 47 6253
+1 47 76D7
+1 47 52DD
+1 47 6557
+8 0 CIRCLED IDEOGRAPH ADVANTAGE
+1 19 CCEPT
+f 0 ROUNDED SYMBOL FOR FU
+1 19 LU
+1 19 SHOU
+1 19 XI
+1 19 SHUANGXI
+1 19 CAI
+9b 0 CYCLONE
+1 0 FOGGY
+1 0 CLOSED UMBRELLA
+1 0 NIGHT WITH STARS
+1 0 SUNRISE OVER MOUNTAINS
+1 7 
+1 0 CITYSCAPE AT DUSK
+1 0 SUNSET OVER BUILDINGS
+1 0 RAINBOW
+1 0 BRIDGE AT NIGHT
+1 0 WATER WAVE
+1 0 VOLCANO
+1 0 MILKY WAY
+1 0 EARTH GLOBE EUROPE-AFRICA
+1 12 AMERICAS
+1 13 SIA-AUSTRALIA
+1 0 GLOBE WITH MERIDIANS
+1 0 NEW MOON SYMBOL
+1 0 WAXING CRESCENT MOON SYMBOL
+1 0 FIRST QUARTER MOON SYMBOL
+1 0 WAXING GIBBOUS MOON SYMBOL
+1 0 FULL MOON SYMBOL
+1 0 WANING GIBBOUS MOON SYMBOL
+1 0 LAST QUARTER MOON SYMBOL
+1 0 WANING CRESCENT MOON SYMBOL
+1 0 CRESCENT MOON
+1 0 NEW MOON WITH FACE
+1 0 FIRST QUARTER MOON WITH FACE
+1 0 LAST QUARTER MOON WITH FACE
+1 0 FULL MOON WITH FACE
+1 0 SUN WITH FACE
+1 0 GLOWING STAR
+1 0 SHOOTING STAR
+1 0 THERMOMETER
+1 0 BLACK DROPLET
+1 0 WHITE SUN
+1 9  WITH SMALL CLOUD
+1 10 BEHIND CLOUD
+1 22  WITH RAIN
+1 0 CLOUD WITH RAIN
+1 11 SNOW
+1 11 LIGHTNING
+1 11 TORNADO
+1 0 FOG
+1 0 WIND BLOWING FACE
+1 0 HOT DOG
+1 0 TACO
+1 0 BURRITO
+1 0 CHESTNUT
+1 0 SEEDLING
+1 0 EVERGREEN TREE
+1 0 DECIDUOUS TREE
+1 0 PALM TREE
+1 0 CACTUS
+1 0 HOT PEPPER
+1 0 TULIP
+1 0 CHERRY BLOSSOM
+1 0 ROSE
+1 0 HIBISCUS
+1 0 SUNFLOWER
+1 0 BLOSSOM
+1 0 EAR OF MAIZE
+1 7 RICE
+1 0 HERB
+1 0 FOUR LEAF CLOVER
+1 0 MAPLE LEAF
+1 0 FALLEN LEAF
+1 0 LEAF FLUTTERING IN WIND
+1 0 MUSHROOM
+1 0 TOMATO
+1 0 AUBERGINE
+1 0 GRAPES
+1 0 MELON
+1 0 WATERMELON
+1 0 TANGERINE
+1 0 LEMON
+1 0 BANANA
+1 0 PINEAPPLE
+1 0 RED APPLE
+1 0 GREEN APPLE
+1 0 PEAR
+1 3 CH
+1 0 CHERRIES
+1 0 STRAWBERRY
+1 0 HAMBURGER
+1 0 SLICE OF PIZZA
+1 0 MEAT ON BONE
+1 0 POULTRY LEG
+1 0 RICE CRACKER
+1 5 BALL
+1 0 COOKED RICE
+1 1 URRY AND RICE
+1 0 STEAMING BOWL
+1 1 PAGHETTI
+1 0 BREAD
+1 0 FRENCH FRIES
+1 0 ROASTED SWEET POTATO
+1 0 DANGO
+1 0 ODEN
+1 0 SUSHI
+1 0 FRIED SHRIMP
+1 1 ISH CAKE WITH SWIRL DESIGN
+1 0 SOFT ICE CREAM
+1 1 HAVED ICE
+1 0 ICE CREAM
+1 0 DOUGHNUT
+1 0 COOKIE
+1 1 HOCOLATE BAR
+1 1 ANDY
+1 0 LOLLIPOP
+1 0 CUSTARD
+1 0 HONEY POT
+1 0 SHORTCAKE
+1 0 BENTO BOX
+1 0 POT OF FOOD
+1 0 COOKING
+1 0 FORK AND KNIFE
+1 0 TEACUP WITHOUT HANDLE
+1 0 SAKE BOTTLE AND CUP
+1 0 WINE GLASS
+1 0 COCKTAIL GLASS
+1 0 TROPICAL DRINK
+1 0 BEER MUG
+1 0 CLINKING BEER MUGS
+1 0 BABY BOTTLE
+1 0 FORK AND KNIFE WITH PLATE
+1 0 BOTTLE WITH POPPING CORK
+1 0 POPCORN
+1 0 RIBBON
+1 0 WRAPPED PRESENT
+1 0 BIRTHDAY CAKE
+1 0 JACK-O-LANTERN
+1 0 CHRISTMAS TREE
+1 0 FATHER CHRISTMAS
+1 1 IREWORKS
+1 8  SPARKLER
+1 0 BALLOON
+1 0 PARTY POPPER
+1 0 CONFETTI BALL
+1 0 TANABATA TREE
+1 0 CROSSED FLAGS
+1 0 PINE DECORATION
+1 0 JAPANESE DOLLS
+1 0 CARP STREAMER
+1 0 WIND CHIME
+1 0 MOON VIEWING CEREMONY
+1 0 SCHOOL SATCHEL
+1 0 GRADUATION CAP
+1 0 HEART WITH TIP ON THE LEFT
+1 0 BOUQUET OF FLOWERS
+1 0 MILITARY MEDAL
+1 0 REMINDER RIBBON
+1 0 MUSICAL KEYBOARD WITH JACKS
+1 0 STUDIO MICROPHONE
+1 0 LEVEL SLIDER
+1 0 CONTROL KNOBS
+1 0 BEAMED ASCENDING MUSICAL NOTES
+1 7 DESCENDING MUSICAL NOTES
+1 0 FILM FRAMES
+1 0 ADMISSION TICKETS
+1 0 CAROUSEL HORSE
+1 0 FERRIS WHEEL
+1 0 ROLLER COASTER
+1 0 FISHING POLE AND FISH
+1 0 MICROPHONE
+1 1 OVIE CAMERA
+1 0 CINEMA
+1 0 HEADPHONE
+1 0 ARTIST PALETTE
+1 0 TOP HAT
+1 0 CIRCUS TENT
+1 0 TICKET
+1 0 CLAPPER BOARD
+1 0 PERFORMING ARTS
+1 0 VIDEO GAME
+1 0 DIRECT HIT
+1 0 SLOT MACHINE
+1 0 BILLIARDS
+1 0 GAME DIE
+1 0 BOWLING
+1 0 FLOWER PLAYING CARDS
+1 0 MUSICAL NOTE
+1 2 LTIPLE MUSICAL NOTES
+1 0 SAXOPHONE
+1 0 GUITAR
+1 0 MUSICAL KEYBOARD
+1 0 TRUMPET
+1 0 VIOLIN
+1 0 MUSICAL SCORE
+1 0 RUNNING SHIRT WITH SASH
+1 0 TENNIS RACQUET AND BALL
+1 0 SKI AND SKI BOOT
+1 0 BASKETBALL AND HOOP
+1 0 CHEQUERED FLAG
+1 0 SNOWBOARDER
+1 0 RUNNER
+1 0 SURFER
+1 1 PORTS MEDAL
+1 0 TROPHY
+1 0 HORSE RACING
+1 0 AMERICAN FOOTBALL
+1 0 RUGBY FOOTBALL
+1 0 SWIMMER
+1 0 WEIGHT LIFTER
+1 0 GOLFER
+1 0 RACING MOTORCYCLE
+1 7 CAR
+1 0 CRICKET BAT AND BALL
+1 0 VOLLEYBALL
+1 0 FIELD HOCKEY STICK AND BALL
+1 0 ICE HOCKEY STICK AND PUCK
+1 0 TABLE TENNIS PADDLE AND BALL
+1 0 SNOW CAPPED MOUNTAIN
+1 0 CAMPING
+1 0 BEACH WITH UMBRELLA
+1 1 UILDING CONSTRUCTION
+1 0 HOUSE BUILDINGS
+1 0 CITYSCAPE
+1 0 DERELICT HOUSE BUILDING
+1 0 CLASSICAL BUILDING
+1 0 DESERT
+1 6  ISLAND
+1 0 NATIONAL PARK
+1 0 STADIUM
+1 0 HOUSE BUILDING
+1 6 WITH GARDEN
+1 0 OFFICE BUILDING
+1 0 JAPANESE POST OFFICE
+1 0 EUROPEAN POST OFFICE
+1 0 HOSPITAL
+1 0 BANK
+1 0 AUTOMATED TELLER MACHINE
+1 0 HOTEL
+1 0 LOVE HOTEL
+1 0 CONVENIENCE STORE
+1 0 SCHOOL
+1 0 DEPARTMENT STORE
+1 0 FACTORY
+1 0 IZAKAYA LANTERN
+1 0 JAPANESE CASTLE
+1 0 EUROPEAN CASTLE
+1 0 WHITE PENNANT
+1 0 BLACK PENNANT
+1 0 WAVING WHITE FLAG
+1 7 BLACK FLAG
+1 0 ROSETTE
+1 0 BLACK ROSETTE
+1 0 LABEL
+1 0 BADMINTON RACQUET AND SHUTTLECOCK
+1 1 OW AND ARROW
+1 0 AMPHORA
+1 0 EMOJI MODIFIER FITZPATRICK TYPE-1-2
+1 32 3
+1 32 4
+1 32 5
+1 32 6
+1 0 RAT
+1 0 MOUSE
+1 0 OX
+1 0 WATER BUFFALO
+1 0 COW
+1 0 TIGER
+1 0 LEOPARD
+1 0 RABBIT
+1 0 CAT
+1 0 DRAGON
+1 0 CROCODILE
+1 0 WHALE
+1 0 SNAIL
+1 3 KE
+1 0 HORSE
+1 0 RAM
+1 0 GOAT
+1 0 SHEEP
+1 0 MONKEY
+1 0 ROOSTER
+1 0 CHICKEN
+1 0 DOG
+1 0 PIG
+1 0 BOAR
+1 0 ELEPHANT
+1 0 OCTOPUS
+1 0 SPIRAL SHELL
+1 0 BUG
+1 0 ANT
+1 0 HONEYBEE
+1 0 LADY BEETLE
+1 0 FISH
+1 0 TROPICAL FISH
+1 0 BLOWFISH
+1 0 TURTLE
+1 0 HATCHING CHICK
+1 0 BABY CHICK
+1 0 FRONT-FACING BABY CHICK
+1 0 BIRD
+1 0 PENGUIN
+1 0 KOALA
+1 0 POODLE
+1 0 DROMEDARY CAMEL
+1 0 BACTRIAN CAMEL
+1 0 DOLPHIN
+1 0 MOUSE FACE
+1 0 COW FACE
+1 0 TIGER FACE
+1 0 RABBIT FACE
+1 0 CAT FACE
+1 0 DRAGON FACE
+1 0 SPOUTING WHALE
+1 0 HORSE FACE
+1 0 MONKEY FACE
+1 0 DOG FACE
+1 0 PIG FACE
+1 0 FROG FACE
+1 0 HAMSTER FACE
+1 0 WOLF FACE
+1 0 BEAR FACE
+1 0 PANDA FACE
+1 1 IG NOSE
+1 1 AW PRINTS
+1 0 CHIPMUNK
+1 0 EYES
+1 3 
+1 1 AR
+1 0 NOSE
+1 0 MOUTH
+1 0 TONGUE
+1 0 WHITE UP POINTING BACKHAND INDEX
+1 6 DOWN POINTING BACKHAND INDEX
+1 6 LEFT POINTING BACKHAND INDEX
+1 6 RIGHT POINTING BACKHAND INDEX
+1 0 FISTED HAND SIGN
+1 0 WAVING HAND SIGN
+1 0 OK HAND SIGN
+1 0 THUMBS UP SIGN
+1 7 DOWN SIGN
+1 0 CLAPPING HANDS SIGN
+1 0 OPEN HANDS SIGN
+1 0 CROWN
+1 0 WOMANS HAT
+1 0 EYEGLASSES
+1 0 NECKTIE
+1 0 T-SHIRT
+1 0 JEANS
+1 0 DRESS
+1 0 KIMONO
+1 0 BIKINI
+1 0 WOMANS CLOTHES
+1 0 PURSE
+1 0 HANDBAG
+1 0 POUCH
+1 0 MANS SHOE
+1 0 ATHLETIC SHOE
+1 0 HIGH-HEELED SHOE
+1 0 WOMANS SANDAL
+1 7 BOOTS
+1 0 FOOTPRINTS
+1 0 BUST IN SILHOUETTE
+1 4 S IN SILHOUETTE
+1 1 OY
+1 0 GIRL
+1 0 MAN
+1 0 WOMAN
+1 0 FAMILY
+1 0 MAN AND WOMAN HOLDING HANDS
+1 0 TWO MEN HOLDING HANDS
+1 4 WOMEN HOLDING HANDS
+1 0 POLICE OFFICER
+1 0 WOMAN WITH BUNNY EARS
+1 0 BRIDE WITH VEIL
+1 0 PERSON WITH BLOND HAIR
+1 0 MAN WITH GUA PI MAO
+1 9 TURBAN
+1 0 OLDER MAN
+1 6 WOMAN
+1 0 BABY
+1 0 CONSTRUCTION WORKER
+1 0 PRINCESS
+1 0 JAPANESE OGRE
+1 9 GOBLIN
+1 0 GHOST
+1 0 BABY ANGEL
+1 0 EXTRATERRESTRIAL ALIEN
+1 0 ALIEN MONSTER
+1 0 IMP
+1 0 SKULL
+1 0 INFORMATION DESK PERSON
+1 0 GUARDSMAN
+1 0 DANCER
+1 0 LIPSTICK
+1 0 NAIL POLISH
+1 0 FACE MASSAGE
+1 0 HAIRCUT
+1 0 BARBER POLE
+1 0 SYRINGE
+1 0 PILL
+1 0 KISS MARK
+1 0 LOVE LETTER
+1 0 RING
+1 0 GEM STONE
+1 0 KISS
+1 0 BOUQUET
+1 0 COUPLE WITH HEART
+1 0 WEDDING
+1 0 BEATING HEART
+1 1 ROKEN HEART
+1 0 TWO HEARTS
+1 0 SPARKLING HEART
+1 0 GROWING HEART
+1 0 HEART WITH ARROW
+1 0 BLUE HEART
+1 0 GREEN HEART
+1 0 YELLOW HEART
+1 0 PURPLE HEART
+1 0 HEART WITH RIBBON
+1 0 REVOLVING HEARTS
+1 0 HEART DECORATION
+1 0 DIAMOND SHAPE WITH A DOT INSIDE
+1 0 ELECTRIC LIGHT BULB
+1 0 ANGER SYMBOL
+1 0 BOMB
+1 0 SLEEPING SYMBOL
+1 0 COLLISION SYMBOL
+1 0 SPLASHING SWEAT SYMBOL
+1 0 DROPLET
+1 1 ASH SYMBOL
+1 0 PILE OF POO
+1 0 FLEXED BICEPS
+1 0 DIZZY SYMBOL
+1 0 SPEECH BALLOON
+1 0 THOUGHT BALLOON
+1 0 WHITE FLOWER
+1 0 HUNDRED POINTS SYMBOL
+1 0 MONEY BAG
+1 0 CURRENCY EXCHANGE
+1 0 HEAVY DOLLAR SIGN
+1 0 CREDIT CARD
+1 0 BANKNOTE WITH YEN SIGN
+1 14 DOLLAR SIGN
+1 14 EURO SIGN
+1 14 POUND SIGN
+1 0 MONEY WITH WINGS
+1 0 CHART WITH UPWARDS TREND AND YEN SIGN
+1 0 SEAT
+1 0 PERSONAL COMPUTER
+1 0 BRIEFCASE
+1 0 MINIDISC
+1 0 FLOPPY DISK
+1 0 OPTICAL DISC
+1 0 DVD
+1 0 FILE FOLDER
+1 0 OPEN FILE FOLDER
+1 0 PAGE WITH CURL
+1 5 FACING UP
+1 0 CALENDAR
+1 0 TEAR-OFF CALENDAR
+1 0 CARD INDEX
+1 1 HART WITH UPWARDS TREND
+1 11 DOWNWARDS TREND
+1 0 BAR CHART
+1 0 CLIPBOARD
+1 0 PUSHPIN
+1 0 ROUND PUSHPIN
+1 0 PAPERCLIP
+1 0 STRAIGHT RULER
+1 0 TRIANGULAR RULER
+1 0 BOOKMARK TABS
+1 0 LEDGER
+1 0 NOTEBOOK
+1 8  WITH DECORATIVE COVER
+1 0 CLOSED BOOK
+1 0 OPEN BOOK
+1 0 GREEN BOOK
+1 0 BLUE BOOK
+1 0 ORANGE BOOK
+1 0 BOOKS
+1 0 NAME BADGE
+1 0 SCROLL
+1 0 MEMO
+1 0 TELEPHONE RECEIVER
+1 0 PAGER
+1 0 FAX MACHINE
+1 0 SATELLITE ANTENNA
+1 0 PUBLIC ADDRESS LOUDSPEAKER
+1 0 CHEERING MEGAPHONE
+1 0 OUTBOX TRAY
+1 0 INBOX TRAY
+1 0 PACKAGE
+1 0 E-MAIL SYMBOL
+1 0 INCOMING ENVELOPE
+1 0 ENVELOPE WITH DOWNWARDS ARROW ABOVE
+1 0 CLOSED MAILBOX WITH LOWERED FLAG
+1 20 RAISED FLAG
+1 0 OPEN MAILBOX WITH RAISED FLAG
+1 18 LOWERED FLAG
+1 0 POSTBOX
+1 4 AL HORN
+1 0 NEWSPAPER
+1 0 MOBILE PHONE
+1 12  WITH RIGHTWARDS ARROW AT LEFT
+1 0 VIBRATION MODE
+1 0 MOBILE PHONE OFF
+1 0 NO MOBILE PHONES
+1 0 ANTENNA WITH BARS
+1 0 CAMERA
+1 6  WITH FLASH
+1 0 VIDEO CAMERA
+1 0 TELEVISION
+1 0 RADIO
+1 0 VIDEOCASSETTE
+1 0 FILM PROJECTOR
+1 0 PORTABLE STEREO
+1 1 RAYER BEADS
+1 0 TWISTED RIGHTWARDS ARROWS
+1 0 CLOCKWISE RIGHTWARDS AND LEFTWARDS OPEN CIRCLE ARROWS
+1 53  WITH CIRCLED ONE OVERLAY
+1 10 DOWNWARDS AND UPWARDS OPEN CIRCLE ARROWS
+1 0 ANTICLOCKWISE DOWNWARDS AND UPWARDS OPEN CIRCLE ARROWS
+1 0 LOW BRIGHTNESS SYMBOL
+1 0 HIGH BRIGHTNESS SYMBOL
+1 0 SPEAKER WITH CANCELLATION STROKE
+1 7 
+1 7  WITH ONE SOUND WAVE
+1 13 THREE SOUND WAVES
+1 0 BATTERY
+1 0 ELECTRIC PLUG
+1 0 LEFT-POINTING MAGNIFYING GLASS
+1 0 RIGHT-POINTING MAGNIFYING GLASS
+1 0 LOCK WITH INK PEN
+1 0 CLOSED LOCK WITH KEY
+1 0 KEY
+1 0 LOCK
+1 0 OPEN LOCK
+1 0 BELL
+1 4  WITH CANCELLATION STROKE
+1 1 OOKMARK
+1 0 LINK SYMBOL
+1 0 RADIO BUTTON
+1 0 BACK WITH LEFTWARDS ARROW ABOVE
+1 0 END WITH LEFTWARDS ARROW ABOVE
+1 0 ON WITH EXCLAMATION MARK WITH LEFT RIGHT ARROW ABOVE
+1 0 SOON WITH RIGHTWARDS ARROW ABOVE
+1 0 TOP WITH UPWARDS ARROW ABOVE
+1 0 NO ONE UNDER EIGHTEEN SYMBOL
+1 0 KEYCAP TEN
+1 0 INPUT SYMBOL FOR LATIN CAPITAL LETTERS
+1 23 SMALL LETTERS
+1 17 NUMBERS
+1 17 SYMBOLS
+1 17 LATIN LETTERS
+1 0 FIRE
+1 0 ELECTRIC TORCH
+1 0 WRENCH
+1 0 HAMMER
+1 0 NUT AND BOLT
+1 0 HOCHO
+1 0 PISTOL
+1 0 MICROSCOPE
+1 0 TELESCOPE
+1 0 CRYSTAL BALL
+1 0 SIX POINTED STAR WITH MIDDLE DOT
+1 0 JAPANESE SYMBOL FOR BEGINNER
+1 0 TRIDENT EMBLEM
+1 0 BLACK SQUARE BUTTON
+1 0 WHITE SQUARE BUTTON
+1 0 LARGE RED CIRCLE
+1 6 BLUE CIRCLE
+1 6 ORANGE DIAMOND
+1 6 BLUE DIAMOND
+1 0 SMALL ORANGE DIAMOND
+1 6 BLUE DIAMOND
+1 0 UP-POINTING RED TRIANGLE
+1 0 DOWN-POINTING RED TRIANGLE
+1 0 UP-POINTING SMALL RED TRIANGLE
+1 0 DOWN-POINTING SMALL RED TRIANGLE
+1 0 LOWER RIGHT SHADOWED WHITE CIRCLE
+1 0 UPPER RIGHT SHADOWED WHITE CIRCLE
+1 0 CIRCLED CROSS POMMEE
+1 1 ROSS POMMEE WITH HALF-CIRCLE BELOW
+1 12 
+1 0 NOTCHED LEFT SEMICIRCLE WITH THREE DOTS
+1 8 RIGHT SEMICIRCLE WITH THREE DOTS
+1 0 SYMBOL FOR MARKS CHAPTER
+1 0 WHITE LATIN CROSS
+1 0 HEAVY LATIN CROSS
+1 0 CELTIC CROSS
+1 0 OM SYMBOL
+1 0 DOVE OF PEACE
+1 0 KAABA
+1 0 MOSQUE
+1 0 SYNAGOGUE
+1 0 MENORAH WITH NINE BRANCHES
+1 0 BOWL OF HYGIEIA
+1 0 CLOCK FACE ONE OCLOCK
+1 11 TWO OCLOCK
+1 12 HREE OCLOCK
+1 11 FOUR OCLOCK
+1 12 IVE OCLOCK
+1 11 SIX OCLOCK
+1 12 EVEN OCLOCK
+1 11 EIGHT OCLOCK
+1 11 NINE OCLOCK
+1 11 TEN OCLOCK
+1 11 ELEVEN OCLOCK
+1 11 TWELVE OCLOCK
+1 11 ONE-THIRTY
+1 11 TWO-THIRTY
+1 12 HREE-THIRTY
+1 11 FOUR-THIRTY
+1 12 IVE-THIRTY
+1 11 SIX-THIRTY
+1 12 EVEN-THIRTY
+1 11 EIGHT-THIRTY
+1 11 NINE-THIRTY
+1 11 TEN-THIRTY
+1 11 ELEVEN-THIRTY
+1 11 TWELVE-THIRTY
+1 0 RIGHT SPEAKER
+1 13  WITH ONE SOUND WAVE
+1 19 THREE SOUND WAVES
+1 0 BULLHORN
+1 8  WITH SOUND WAVES
+1 0 RINGING BELL
+1 0 BOOK
+1 0 CANDLE
+1 0 MANTELPIECE CLOCK
+1 0 BLACK SKULL AND CROSSBONES
+1 0 NO PIRACY
+1 0 HOLE
+1 0 MAN IN BUSINESS SUIT LEVITATING
+1 0 SLEUTH OR SPY
+1 0 DARK SUNGLASSES
+1 0 SPIDER
+1 6  WEB
+1 0 JOYSTICK
+1 0 MAN DANCING
+1 0 LEFT HAND TELEPHONE RECEIVER
+1 0 TELEPHONE RECEIVER WITH PAGE
+1 0 RIGHT HAND TELEPHONE RECEIVER
+1 0 WHITE TOUCHTONE TELEPHONE
+1 0 BLACK TOUCHTONE TELEPHONE
+1 0 TELEPHONE ON TOP OF MODEM
+1 0 CLAMSHELL MOBILE PHONE
+1 0 BACK OF ENVELOPE
+1 0 STAMPED ENVELOPE
+1 0 ENVELOPE WITH LIGHTNING
+1 0 FLYING ENVELOPE
+1 0 PEN OVER STAMPED ENVELOPE
+1 0 LINKED PAPERCLIPS
+1 0 BLACK PUSHPIN
+1 0 LOWER LEFT PENCIL
+1 11 BALLPOINT PEN
+1 11 FOUNTAIN PEN
+1 11 PAINTBRUSH
+1 11 CRAYON
+1 1 EFT WRITING HAND
+1 0 TURNED OK HAND SIGN
+1 0 RAISED HAND WITH FINGERS SPLAYED
+1 1 EVERSED RAISED HAND WITH FINGERS SPLAYED
+1 9 THUMBS UP SIGN
+1 16 DOWN SIGN
+1 9 VICTORY HAND
+1 9 HAND WITH MIDDLE FINGER EXTENDED
+1 1 AISED HAND WITH PART BETWEEN MIDDLE AND RING FINGERS
+1 0 WHITE DOWN POINTING LEFT HAND INDEX
+1 0 SIDEWAYS WHITE LEFT POINTING INDEX
+1 15 RIGHT POINTING INDEX
+1 9 BLACK LEFT POINTING INDEX
+1 15 RIGHT POINTING INDEX
+1 0 BLACK LEFT POINTING BACKHAND INDEX
+1 6 RIGHT POINTING BACKHAND INDEX
+1 0 SIDEWAYS WHITE UP POINTING INDEX
+1 15 DOWN POINTING INDEX
+1 9 BLACK UP POINTING INDEX
+1 15 DOWN POINTING INDEX
+1 0 BLACK UP POINTING BACKHAND INDEX
+1 6 DOWN POINTING BACKHAND INDEX
+1 6 HEART
+1 0 DESKTOP COMPUTER
+1 0 KEYBOARD AND MOUSE
+1 0 THREE NETWORKED COMPUTERS
+1 0 PRINTER
+1 1 OCKET CALCULATOR
+1 0 BLACK HARD SHELL FLOPPY DISK
+1 0 WHITE HARD SHELL FLOPPY DISK
+1 0 SOFT SHELL FLOPPY DISK
+1 0 TAPE CARTRIDGE
+1 0 WIRED KEYBOARD
+1 0 ONE BUTTON MOUSE
+1 0 TWO BUTTON MOUSE
+1 1 HREE BUTTON MOUSE
+1 1 RACKBALL
+1 0 OLD PERSONAL COMPUTER
+1 0 HARD DISK
+1 0 SCREEN
+1 0 PRINTER ICON
+1 0 FAX ICON
+1 0 OPTICAL DISC ICON
+1 0 DOCUMENT WITH TEXT
+1 18  AND PICTURE
+1 14 PICTURE
+1 0 FRAME WITH PICTURE
+1 11 TILES
+1 11 AN X
+1 0 BLACK FOLDER
+1 0 FOLDER
+1 0 OPEN FOLDER
+1 0 CARD INDEX DIVIDERS
+1 5 FILE BOX
+1 0 FILE CABINET
+1 0 EMPTY NOTE
+1 10  PAGE
+1 13 D
+1 0 NOTE
+1 4  PAGE
+1 7 D
+1 0 EMPTY DOCUMENT
+1 6 PAGE
+1 10 S
+1 0 DOCUMENT
+1 0 PAGE
+1 4 S
+1 0 WASTEBASKET
+1 0 SPIRAL NOTE PAD
+1 7 CALENDAR PAD
+1 0 DESKTOP WINDOW
+1 0 MINIMIZE
+1 1 AXIMIZE
+1 0 OVERLAP
+1 0 CLOCKWISE RIGHT AND LEFT SEMICIRCLE ARROWS
+1 1 ANCELLATION X
+1 0 INCREASE FONT SIZE SYMBOL
+1 0 DECREASE FONT SIZE SYMBOL
+1 0 COMPRESSION
+1 0 OLD KEY
+1 0 ROLLED-UP NEWSPAPER
+1 0 PAGE WITH CIRCLED TEXT
+1 0 STOCK CHART
+1 0 DAGGER KNIFE
+1 0 LIPS
+1 0 SPEAKING HEAD IN SILHOUETTE
+1 0 THREE RAYS ABOVE
+1 11 BELOW
+1 11 LEFT
+1 11 RIGHT
+1 0 LEFT SPEECH BUBBLE
+1 0 RIGHT SPEECH BUBBLE
+1 0 TWO SPEECH BUBBLES
+1 1 HREE SPEECH BUBBLES
+1 0 LEFT THOUGHT BUBBLE
+1 0 RIGHT THOUGHT BUBBLE
+1 0 LEFT ANGER BUBBLE
+1 0 RIGHT ANGER BUBBLE
+1 0 MOOD BUBBLE
+1 0 LIGHTNING MOOD BUBBLE
+1 14 
+1 0 BALLOT BOX WITH BALLOT
+1 7 SCRIPT X
+1 7 BOX WITH SCRIPT X
+1 9 LD SCRIPT X
+1 9 X WITH BOLD SCRIPT X
+1 0 LIGHT CHECK MARK
+1 0 BALLOT BOX WITH BOLD CHECK
+1 0 WORLD MAP
+1 0 MOUNT FUJI
+1 0 TOKYO TOWER
+1 0 STATUE OF LIBERTY
+1 1 ILHOUETTE OF JAPAN
+1 0 MOYAI
+1 0 GRINNING FACE
+1 13  WITH SMILING EYES
+1 0 FACE WITH TEARS OF JOY
+1 0 SMILING FACE WITH OPEN MOUTH
+1 28  AND SMILING EYES
+1 33 COLD SWEAT
+1 33 TIGHTLY-CLOSED EYES
+1 18 HALO
+1 19 ORNS
+1 0 WINKING FACE
+1 0 SMILING FACE WITH SMILING EYES
+1 0 FACE SAVOURING DELICIOUS FOOD
+1 0 RELIEVED FACE
+1 0 SMILING FACE WITH HEART-SHAPED EYES
+1 18 SUNGLASSES
+1 3 RKING FACE
+1 0 NEUTRAL FACE
+1 0 EXPRESSIONLESS FACE
+1 0 UNAMUSED FACE
+1 0 FACE WITH COLD SWEAT
+1 0 PENSIVE FACE
+1 0 CONFUSED FACE
+1 4 OUNDED FACE
+1 0 KISSING FACE
+1 0 FACE THROWING A KISS
+1 0 KISSING FACE WITH SMILING EYES
+1 18 CLOSED EYES
+1 0 FACE WITH STUCK-OUT TONGUE
+1 26  AND WINKING EYE
+1 31 TIGHTLY-CLOSED EYES
+1 0 DISAPPOINTED FACE
+1 0 WORRIED FACE
+1 0 ANGRY FACE
+1 0 POUTING FACE
+1 0 CRYING FACE
+1 0 PERSEVERING FACE
+1 0 FACE WITH LOOK OF TRIUMPH
+1 0 DISAPPOINTED BUT RELIEVED FACE
+1 0 FROWNING FACE WITH OPEN MOUTH
+1 0 ANGUISHED FACE
+1 0 FEARFUL FACE
+1 0 WEARY FACE
+1 0 SLEEPY FACE
+1 0 TIRED FACE
+1 0 GRIMACING FACE
+1 0 LOUDLY CRYING FACE
+1 0 FACE WITH OPEN MOUTH
+1 0 HUSHED FACE
+1 0 FACE WITH OPEN MOUTH AND COLD SWEAT
+1 5 SCREAMING IN FEAR
+1 0 ASTONISHED FACE
+1 0 FLUSHED FACE
+1 0 SLEEPING FACE
+1 0 DIZZY FACE
+1 0 FACE WITHOUT MOUTH
+1 9  MEDICAL MASK
+1 0 GRINNING CAT FACE WITH SMILING EYES
+1 0 CAT FACE WITH TEARS OF JOY
+1 0 SMILING CAT FACE WITH OPEN MOUTH
+1 22 HEART-SHAPED EYES
+1 0 CAT FACE WITH WRY SMILE
+1 0 KISSING CAT FACE WITH CLOSED EYES
+1 0 POUTING CAT FACE
+1 0 CRYING CAT FACE
+1 0 WEARY CAT FACE
+1 0 SLIGHTLY FROWNING FACE
+1 9 SMILING FACE
+1 0 UPSIDE-DOWN FACE
+1 0 FACE WITH ROLLING EYES
+1 10 NO GOOD GESTURE
+1 10 OK GESTURE
+1 0 PERSON BOWING DEEPLY
+1 0 SEE-NO-EVIL MONKEY
+1 0 HEAR-NO-EVIL MONKEY
+1 0 SPEAK-NO-EVIL MONKEY
+1 0 HAPPY PERSON RAISING ONE HAND
+1 0 PERSON RAISING BOTH HANDS IN CELEBRATION
+1 7 FROWNING
+1 7 WITH POUTING FACE
+1 12 FOLDED HANDS
+1 0 NORTH WEST POINTING LEAF
+1 0 SOUTH WEST POINTING LEAF
+1 0 NORTH EAST POINTING LEAF
+1 0 SOUTH EAST POINTING LEAF
+1 0 TURNED NORTH WEST POINTING LEAF
+1 7 SOUTH WEST POINTING LEAF
+1 7 NORTH EAST POINTING LEAF
+1 7 SOUTH EAST POINTING LEAF
+1 0 NORTH WEST POINTING VINE LEAF
+1 0 SOUTH WEST POINTING VINE LEAF
+1 0 NORTH EAST POINTING VINE LEAF
+1 0 SOUTH EAST POINTING VINE LEAF
+1 0 HEAVY NORTH WEST POINTING VINE LEAF
+1 6 SOUTH WEST POINTING VINE LEAF
+1 6 NORTH EAST POINTING VINE LEAF
+1 6 SOUTH EAST POINTING VINE LEAF
+1 0 NORTH WEST POINTING BUD
+1 0 SOUTH WEST POINTING BUD
+1 0 NORTH EAST POINTING BUD
+1 0 SOUTH EAST POINTING BUD
+1 0 HEAVY NORTH WEST POINTING BUD
+1 6 SOUTH WEST POINTING BUD
+1 6 NORTH EAST POINTING BUD
+1 6 SOUTH EAST POINTING BUD
+1 1 OLLOW QUILT SQUARE ORNAMENT
+1 28  IN BLACK SQUARE
+1 0 SOLID QUILT SQUARE ORNAMENT
+1 27  IN BLACK SQUARE
+1 0 LEFTWARDS ROCKET
+1 0 UPWARDS ROCKET
+1 0 RIGHTWARDS ROCKET
+1 0 DOWNWARDS ROCKET
+1 0 SCRIPT LIGATURE ET ORNAMENT
+1 0 HEAVY SCRIPT LIGATURE ET ORNAMENT
+1 0 LIGATURE OPEN ET ORNAMENT
+1 0 HEAVY LIGATURE OPEN ET ORNAMENT
+1 6 AMPERSAND ORNAMENT
+1 0 SWASH AMPERSAND ORNAMENT
+1 1 ANS-SERIF HEAVY DOUBLE TURNED COMMA QUOTATION MARK ORNAMENT
+1 24 COMMA QUOTATION MARK ORNAMENT
+1 17 LOW DOUBLE COMMA QUOTATION MARK ORNAMENT
+1 0 HEAVY INTERROBANG ORNAMENT
+1 0 SANS-SERIF INTERROBANG ORNAMENT
+1 0 HEAVY SANS-SERIF INTERROBANG ORNAMENT
+1 0 VERY HEAVY SOLIDUS
+1 11 REVERSE SOLIDUS
+1 0 CHECKER BOARD
+1 0 REVERSE CHECKER BOARD
+1 1 OCKET
+1 0 HELICOPTER
+1 0 STEAM LOCOMOTIVE
+1 0 RAILWAY CAR
+1 0 HIGH-SPEED TRAIN
+1 16  WITH BULLET NOSE
+1 0 TRAIN
+1 0 METRO
+1 0 LIGHT RAIL
+1 0 STATION
+1 0 TRAM
+1 4  CAR
+1 0 BUS
+1 0 ONCOMING BUS
+1 0 TROLLEYBUS
+1 0 BUS STOP
+1 0 MINIBUS
+1 0 AMBULANCE
+1 0 FIRE ENGINE
+1 0 POLICE CAR
+1 0 ONCOMING POLICE CAR
+1 0 TAXI
+1 0 ONCOMING TAXI
+1 0 AUTOMOBILE
+1 0 ONCOMING AUTOMOBILE
+1 0 RECREATIONAL VEHICLE
+1 0 DELIVERY TRUCK
+1 0 ARTICULATED LORRY
+1 0 TRACTOR
+1 0 MONORAIL
+1 2 UNTAIN RAILWAY
+1 0 SUSPENSION RAILWAY
+1 0 MOUNTAIN CABLEWAY
+1 0 AERIAL TRAMWAY
+1 0 SHIP
+1 0 ROWBOAT
+1 0 SPEEDBOAT
+1 0 HORIZONTAL TRAFFIC LIGHT
+1 0 VERTICAL TRAFFIC LIGHT
+1 0 CONSTRUCTION SIGN
+1 0 POLICE CARS REVOLVING LIGHT
+1 0 TRIANGULAR FLAG ON POST
+1 0 DOOR
+1 0 NO ENTRY SIGN
+1 0 SMOKING SYMBOL
+1 0 NO SMOKING SYMBOL
+1 0 PUT LITTER IN ITS PLACE SYMBOL
+1 0 DO NOT LITTER SYMBOL
+1 0 POTABLE WATER SYMBOL
+1 0 NON-POTABLE WATER SYMBOL
+1 0 BICYCLE
+1 0 NO BICYCLES
+1 0 BICYCLIST
+1 0 MOUNTAIN BICYCLIST
+1 0 PEDESTRIAN
+1 0 NO PEDESTRIANS
+1 0 CHILDREN CROSSING
+1 0 MENS SYMBOL
+1 0 WOMENS SYMBOL
+1 0 RESTROOM
+1 0 BABY SYMBOL
+1 0 TOILET
+1 0 WATER CLOSET
+1 0 SHOWER
+1 0 BATH
+1 4 TUB
+1 0 PASSPORT CONTROL
+1 0 CUSTOMS
+1 0 BAGGAGE CLAIM
+1 0 LEFT LUGGAGE
+1 0 TRIANGLE WITH ROUNDED CORNERS
+1 0 PROHIBITED SIGN
+1 0 CIRCLED INFORMATION SOURCE
+1 0 BOYS SYMBOL
+1 0 GIRLS SYMBOL
+1 0 COUCH AND LAMP
+1 0 SLEEPING ACCOMMODATION
+1 1 HOPPING BAGS
+1 0 BELLHOP BELL
+1 2 D
+1 0 PLACE OF WORSHIP
+1 0 OCTAGONAL SIGN
+1 0 SHOPPING TROLLEY
+1 1 TUPA
+1 0 PAGODA
+1 0 HINDU TEMPLE
+1 1 UT
+1 0 ELEVATOR
+6 0 PLAYGROUND SLIDE
+1 0 WHEEL
+1 0 RING BUOY
+1 0 HAMMER AND WRENCH
+1 0 SHIELD
+1 0 OIL DRUM
+1 0 MOTORWAY
+1 0 RAILWAY TRACK
+1 0 MOTOR BOAT
+1 0 UP-POINTING MILITARY AIRPLANE
+1 12 AIRPLANE
+1 12 SMALL AIRPLANE
+1 0 SMALL AIRPLANE
+1 0 NORTHEAST-POINTING AIRPLANE
+1 0 AIRPLANE DEPARTURE
+1 9 ARRIVING
+4 0 SATELLITE
+1 0 ONCOMING FIRE ENGINE
+1 0 DIESEL LOCOMOTIVE
+1 0 PASSENGER SHIP
+1 0 SCOOTER
+1 0 MOTOR SCOOTER
+1 0 CANOE
+1 0 SLED
+1 0 FLYING SAUCER
+1 0 SKATEBOARD
+1 0 AUTO RICKSHAW
+1 0 PICKUP TRUCK
+1 0 ROLLER SKATE
+4 0 ALCHEMICAL SYMBOL FOR QUINTESSENCE
+1 22 AIR
+1 22 FIRE
+1 22 EARTH
+1 22 WATER
+1 22 AQUAFORTIS
+1 26  REGIA
+1 32 -2
+1 27 VITAE
+1 32 -2
+1 22 VINEGAR
+1 29 -2
+1 30 3
+1 22 SULFUR
+1 22 PHILOSOPHERS SULFUR
+1 22 BLACK SULFUR
+1 22 MERCURY SUBLIMATE
+1 39 -2
+1 40 3
+1 22 CINNABAR
+1 22 SALT
+1 22 NITRE
+1 22 VITRIOL
+1 29 -2
+1 22 ROCK SALT
+1 31 -2
+1 22 GOLD
+1 22 SILVER
+1 22 IRON ORE
+1 30 -2
+1 22 CROCUS OF IRON
+1 22 REGULUS OF IRON
+1 22 COPPER ORE
+1 22 IRON-COPPER ORE
+1 22 SUBLIMATE OF COPPER
+1 22 CROCUS OF COPPER
+1 38 -2
+1 23 OPPER ANTIMONIATE
+1 22 SALT OF COPPER ANTIMONIATE
+1 23 UBLIMATE OF SALT OF COPPER
+1 22 VERDIGRIS
+1 22 TIN ORE
+1 22 LEAD ORE
+1 22 ANTIMONY ORE
+1 22 SUBLIMATE OF ANTIMONY
+1 23 ALT OF ANTIMONY
+1 23 UBLIMATE OF SALT OF ANTIMONY
+1 22 VINEGAR OF ANTIMONY
+1 22 REGULUS OF ANTIMONY
+1 41 -2
+1 29 
+1 29 -2
+1 30 3
+1 30 4
+1 22 ALKALI
+1 28 -2
+1 22 MARCASITE
+1 22 SAL-AMMONIAC
+1 22 ARSENIC
+1 22 REALGAR
+1 29 -2
+1 22 AURIPIGMENT
+1 22 BISMUTH ORE
+1 22 TARTAR
+1 28 -2
+1 22 QUICK LIME
+1 22 BORAX
+1 27 -2
+1 28 3
+1 22 ALUM
+1 22 OIL
+1 22 SPIRIT
+1 22 TINCTURE
+1 22 GUM
+1 22 WAX
+1 22 POWDER
+1 22 CALX
+1 22 TUTTY
+1 22 CAPUT MORTUUM
+1 22 SCEPTER OF JOVE
+1 22 CADUCEUS
+1 22 TRIDENT
+1 22 STARRED TRIDENT
+1 22 LODESTONE
+1 22 SOAP
+1 22 URINE
+1 22 HORSE DUNG
+1 22 ASHES
+1 22 POT ASHES
+1 22 BRICK
+1 22 POWDERED BRICK
+1 22 AMALGAM
+1 22 STRATUM SUPER STRATUM
+1 43 -2
+1 23 UBLIMATION
+1 22 PRECIPITATE
+1 22 DISTILL
+1 25 SOLVE
+1 30 -2
+1 22 PURIFY
+1 24 TREFACTION
+1 22 CRUCIBLE
+1 30 -2
+1 31 3
+1 31 4
+1 31 5
+1 22 ALEMBIC
+1 22 BATH OF MARY
+1 30 VAPOURS
+1 22 RETORT
+1 22 HOUR
+1 22 NIGHT
+1 22 DAY-NIGHT
+1 22 MONTH
+1 22 HALF DRAM
+1 27 OUNCE
+d 0 BLACK LEFT-POINTING ISOSCELES RIGHT TRIANGLE
+1 6 UP-POINTING ISOSCELES RIGHT TRIANGLE
+1 6 RIGHT-POINTING ISOSCELES RIGHT TRIANGLE
+1 6 DOWN-POINTING ISOSCELES RIGHT TRIANGLE
+1 6 SLIGHTLY SMALL CIRCLE
+1 0 MEDIUM BOLD WHITE CIRCLE
+1 0 BOLD WHITE CIRCLE
+1 0 HEAVY WHITE CIRCLE
+1 0 VERY HEAVY WHITE CIRCLE
+1 0 EXTREMELY HEAVY WHITE CIRCLE
+1 0 WHITE CIRCLE CONTAINING BLACK SMALL CIRCLE
+1 0 ROUND TARGET
+1 0 BLACK TINY SQUARE
+1 6 SLIGHTLY SMALL SQUARE
+1 0 LIGHT WHITE SQUARE
+1 0 MEDIUM WHITE SQUARE
+1 0 BOLD WHITE SQUARE
+1 0 HEAVY WHITE SQUARE
+1 0 VERY HEAVY WHITE SQUARE
+1 0 EXTREMELY HEAVY WHITE SQUARE
+1 0 WHITE SQUARE CONTAINING BLACK VERY SMALL SQUARE
+1 30 MEDIUM SQUARE
+1 0 SQUARE TARGET
+1 0 BLACK TINY DIAMOND
+1 6 VERY SMALL DIAMOND
+1 6 MEDIUM SMALL DIAMOND
+1 0 WHITE DIAMOND CONTAINING BLACK VERY SMALL DIAMOND
+1 31 MEDIUM DIAMOND
+1 0 DIAMOND TARGET
+1 0 BLACK TINY LOZENGE
+1 6 VERY SMALL LOZENGE
+1 6 MEDIUM SMALL LOZENGE
+1 0 WHITE LOZENGE CONTAINING BLACK SMALL LOZENGE
+1 0 THIN GREEK CROSS
+1 0 LIGHT GREEK CROSS
+1 0 MEDIUM GREEK CROSS
+1 0 BOLD GREEK CROSS
+1 0 VERY BOLD GREEK CROSS
+1 5 HEAVY GREEK CROSS
+1 0 EXTREMELY HEAVY GREEK CROSS
+1 0 THIN SALTIRE
+1 0 LIGHT SALTIRE
+1 0 MEDIUM SALTIRE
+1 0 BOLD SALTIRE
+1 0 HEAVY SALTIRE
+1 0 VERY HEAVY SALTIRE
+1 0 EXTREMELY HEAVY SALTIRE
+1 0 LIGHT FIVE SPOKED ASTERISK
+1 0 MEDIUM FIVE SPOKED ASTERISK
+1 0 BOLD FIVE SPOKED ASTERISK
+1 0 HEAVY FIVE SPOKED ASTERISK
+1 0 VERY HEAVY FIVE SPOKED ASTERISK
+1 0 EXTREMELY HEAVY FIVE SPOKED ASTERISK
+1 0 LIGHT SIX SPOKED ASTERISK
+1 0 MEDIUM SIX SPOKED ASTERISK
+1 0 BOLD SIX SPOKED ASTERISK
+1 0 HEAVY SIX SPOKED ASTERISK
+1 0 VERY HEAVY SIX SPOKED ASTERISK
+1 0 EXTREMELY HEAVY SIX SPOKED ASTERISK
+1 0 LIGHT EIGHT SPOKED ASTERISK
+1 0 MEDIUM EIGHT SPOKED ASTERISK
+1 0 BOLD EIGHT SPOKED ASTERISK
+1 0 HEAVY EIGHT SPOKED ASTERISK
+1 0 VERY HEAVY EIGHT SPOKED ASTERISK
+1 0 LIGHT THREE POINTED BLACK STAR
+1 0 MEDIUM THREE POINTED BLACK STAR
+1 0 THREE POINTED BLACK STAR
+1 0 MEDIUM THREE POINTED PINWHEEL STAR
+1 0 LIGHT FOUR POINTED BLACK STAR
+1 0 MEDIUM FOUR POINTED BLACK STAR
+1 0 FOUR POINTED BLACK STAR
+1 0 MEDIUM FOUR POINTED PINWHEEL STAR
+1 0 REVERSE LIGHT FOUR POINTED PINWHEEL STAR
+1 0 LIGHT FIVE POINTED BLACK STAR
+1 0 HEAVY FIVE POINTED BLACK STAR
+1 0 MEDIUM SIX POINTED BLACK STAR
+1 0 HEAVY SIX POINTED BLACK STAR
+1 0 SIX POINTED PINWHEEL STAR
+1 0 MEDIUM EIGHT POINTED BLACK STAR
+1 0 HEAVY EIGHT POINTED BLACK STAR
+1 0 VERY HEAVY EIGHT POINTED BLACK STAR
+1 0 HEAVY EIGHT POINTED PINWHEEL STAR
+1 0 LIGHT TWELVE POINTED BLACK STAR
+1 0 HEAVY TWELVE POINTED BLACK STAR
+1 21 PINWHEEL STAR
+1 0 CIRCLED TRIANGLE
+1 0 NEGATIVE CIRCLED TRIANGLE
+1 0 CIRCLED SQUARE
+1 0 NEGATIVE CIRCLED SQUARE
+8 0 LARGE ORANGE CIRCLE
+1 6 YELLOW CIRCLE
+1 6 GREEN CIRCLE
+1 6 PURPLE CIRCLE
+1 6 BROWN CIRCLE
+1 6 RED SQUARE
+1 6 BLUE SQUARE
+1 6 ORANGE SQUARE
+1 6 YELLOW SQUARE
+1 6 GREEN SQUARE
+1 6 PURPLE SQUARE
+1 6 BROWN SQUARE
+5 0 HEAVY EQUALS SIGN
+10 0 LEFTWARDS ARROW WITH SMALL TRIANGLE ARROWHEAD
+1 0 UPWARDS ARROW WITH SMALL TRIANGLE ARROWHEAD
+1 0 RIGHTWARDS ARROW WITH SMALL TRIANGLE ARROWHEAD
+1 0 DOWNWARDS ARROW WITH SMALL TRIANGLE ARROWHEAD
+1 0 LEFTWARDS ARROW WITH MEDIUM TRIANGLE ARROWHEAD
+1 0 UPWARDS ARROW WITH MEDIUM TRIANGLE ARROWHEAD
+1 0 RIGHTWARDS ARROW WITH MEDIUM TRIANGLE ARROWHEAD
+1 0 DOWNWARDS ARROW WITH MEDIUM TRIANGLE ARROWHEAD
+1 0 LEFTWARDS ARROW WITH LARGE TRIANGLE ARROWHEAD
+1 0 UPWARDS ARROW WITH LARGE TRIANGLE ARROWHEAD
+1 0 RIGHTWARDS ARROW WITH LARGE TRIANGLE ARROWHEAD
+1 0 DOWNWARDS ARROW WITH LARGE TRIANGLE ARROWHEAD
+5 0 LEFTWARDS ARROW WITH SMALL EQUILATERAL ARROWHEAD
+1 0 UPWARDS ARROW WITH SMALL EQUILATERAL ARROWHEAD
+1 0 RIGHTWARDS ARROW WITH SMALL EQUILATERAL ARROWHEAD
+1 0 DOWNWARDS ARROW WITH SMALL EQUILATERAL ARROWHEAD
+1 0 LEFTWARDS ARROW WITH EQUILATERAL ARROWHEAD
+1 0 UPWARDS ARROW WITH EQUILATERAL ARROWHEAD
+1 0 RIGHTWARDS ARROW WITH EQUILATERAL ARROWHEAD
+1 0 DOWNWARDS ARROW WITH EQUILATERAL ARROWHEAD
+1 0 HEAVY LEFTWARDS ARROW WITH EQUILATERAL ARROWHEAD
+1 6 UPWARDS ARROW WITH EQUILATERAL ARROWHEAD
+1 6 RIGHTWARDS ARROW WITH EQUILATERAL ARROWHEAD
+1 6 DOWNWARDS ARROW WITH EQUILATERAL ARROWHEAD
+1 6 LEFTWARDS ARROW WITH LARGE EQUILATERAL ARROWHEAD
+1 6 UPWARDS ARROW WITH LARGE EQUILATERAL ARROWHEAD
+1 6 RIGHTWARDS ARROW WITH LARGE EQUILATERAL ARROWHEAD
+1 6 DOWNWARDS ARROW WITH LARGE EQUILATERAL ARROWHEAD
+1 0 LEFTWARDS TRIANGLE-HEADED ARROW WITH NARROW SHAFT
+1 0 UPWARDS TRIANGLE-HEADED ARROW WITH NARROW SHAFT
+1 0 RIGHTWARDS TRIANGLE-HEADED ARROW WITH NARROW SHAFT
+1 0 DOWNWARDS TRIANGLE-HEADED ARROW WITH NARROW SHAFT
+1 0 LEFTWARDS TRIANGLE-HEADED ARROW WITH MEDIUM SHAFT
+1 0 UPWARDS TRIANGLE-HEADED ARROW WITH MEDIUM SHAFT
+1 0 RIGHTWARDS TRIANGLE-HEADED ARROW WITH MEDIUM SHAFT
+1 0 DOWNWARDS TRIANGLE-HEADED ARROW WITH MEDIUM SHAFT
+1 0 LEFTWARDS TRIANGLE-HEADED ARROW WITH BOLD SHAFT
+1 0 UPWARDS TRIANGLE-HEADED ARROW WITH BOLD SHAFT
+1 0 RIGHTWARDS TRIANGLE-HEADED ARROW WITH BOLD SHAFT
+1 0 DOWNWARDS TRIANGLE-HEADED ARROW WITH BOLD SHAFT
+1 0 LEFTWARDS TRIANGLE-HEADED ARROW WITH HEAVY SHAFT
+1 0 UPWARDS TRIANGLE-HEADED ARROW WITH HEAVY SHAFT
+1 0 RIGHTWARDS TRIANGLE-HEADED ARROW WITH HEAVY SHAFT
+1 0 DOWNWARDS TRIANGLE-HEADED ARROW WITH HEAVY SHAFT
+1 0 LEFTWARDS TRIANGLE-HEADED ARROW WITH VERY HEAVY SHAFT
+1 0 UPWARDS TRIANGLE-HEADED ARROW WITH VERY HEAVY SHAFT
+1 0 RIGHTWARDS TRIANGLE-HEADED ARROW WITH VERY HEAVY SHAFT
+1 0 DOWNWARDS TRIANGLE-HEADED ARROW WITH VERY HEAVY SHAFT
+1 0 LEFTWARDS FINGER-POST ARROW
+1 0 UPWARDS FINGER-POST ARROW
+1 0 RIGHTWARDS FINGER-POST ARROW
+1 0 DOWNWARDS FINGER-POST ARROW
+1 0 LEFTWARDS SQUARED ARROW
+1 0 UPWARDS SQUARED ARROW
+1 0 RIGHTWARDS SQUARED ARROW
+1 0 DOWNWARDS SQUARED ARROW
+1 0 LEFTWARDS COMPRESSED ARROW
+1 0 UPWARDS COMPRESSED ARROW
+1 0 RIGHTWARDS COMPRESSED ARROW
+1 0 DOWNWARDS COMPRESSED ARROW
+1 0 LEFTWARDS HEAVY COMPRESSED ARROW
+1 0 UPWARDS HEAVY COMPRESSED ARROW
+1 0 RIGHTWARDS HEAVY COMPRESSED ARROW
+1 0 DOWNWARDS HEAVY COMPRESSED ARROW
+1 0 LEFTWARDS HEAVY ARROW
+1 0 UPWARDS HEAVY ARROW
+1 0 RIGHTWARDS HEAVY ARROW
+1 0 DOWNWARDS HEAVY ARROW
+9 0 LEFTWARDS SANS-SERIF ARROW
+1 0 UPWARDS SANS-SERIF ARROW
+1 0 RIGHTWARDS SANS-SERIF ARROW
+1 0 DOWNWARDS SANS-SERIF ARROW
+1 0 NORTH WEST SANS-SERIF ARROW
+1 6 EAST SANS-SERIF ARROW
+1 0 SOUTH EAST SANS-SERIF ARROW
+1 6 WEST SANS-SERIF ARROW
+1 0 LEFT RIGHT SANS-SERIF ARROW
+1 0 UP DOWN SANS-SERIF ARROW
+7 0 WIDE-HEADED LEFTWARDS LIGHT BARB ARROW
+1 12 UPWARDS LIGHT BARB ARROW
+1 12 RIGHTWARDS LIGHT BARB ARROW
+1 12 DOWNWARDS LIGHT BARB ARROW
+1 12 NORTH WEST LIGHT BARB ARROW
+1 18 EAST LIGHT BARB ARROW
+1 12 SOUTH EAST LIGHT BARB ARROW
+1 18 WEST LIGHT BARB ARROW
+1 12 LEFTWARDS BARB ARROW
+1 12 UPWARDS BARB ARROW
+1 12 RIGHTWARDS BARB ARROW
+1 12 DOWNWARDS BARB ARROW
+1 12 NORTH WEST BARB ARROW
+1 18 EAST BARB ARROW
+1 12 SOUTH EAST BARB ARROW
+1 18 WEST BARB ARROW
+1 12 LEFTWARDS MEDIUM BARB ARROW
+1 12 UPWARDS MEDIUM BARB ARROW
+1 12 RIGHTWARDS MEDIUM BARB ARROW
+1 12 DOWNWARDS MEDIUM BARB ARROW
+1 12 NORTH WEST MEDIUM BARB ARROW
+1 18 EAST MEDIUM BARB ARROW
+1 12 SOUTH EAST MEDIUM BARB ARROW
+1 18 WEST MEDIUM BARB ARROW
+1 12 LEFTWARDS HEAVY BARB ARROW
+1 12 UPWARDS HEAVY BARB ARROW
+1 12 RIGHTWARDS HEAVY BARB ARROW
+1 12 DOWNWARDS HEAVY BARB ARROW
+1 12 NORTH WEST HEAVY BARB ARROW
+1 18 EAST HEAVY BARB ARROW
+1 12 SOUTH EAST HEAVY BARB ARROW
+1 18 WEST HEAVY BARB ARROW
+1 12 LEFTWARDS VERY HEAVY BARB ARROW
+1 12 UPWARDS VERY HEAVY BARB ARROW
+1 12 RIGHTWARDS VERY HEAVY BARB ARROW
+1 12 DOWNWARDS VERY HEAVY BARB ARROW
+1 12 NORTH WEST VERY HEAVY BARB ARROW
+1 18 EAST VERY HEAVY BARB ARROW
+1 12 SOUTH EAST VERY HEAVY BARB ARROW
+1 18 WEST VERY HEAVY BARB ARROW
+9 0 LEFTWARDS TRIANGLE ARROWHEAD
+1 0 UPWARDS TRIANGLE ARROWHEAD
+1 0 RIGHTWARDS TRIANGLE ARROWHEAD
+1 0 DOWNWARDS TRIANGLE ARROWHEAD
+1 0 LEFTWARDS WHITE ARROW WITHIN TRIANGLE ARROWHEAD
+1 0 UPWARDS WHITE ARROW WITHIN TRIANGLE ARROWHEAD
+1 0 RIGHTWARDS WHITE ARROW WITHIN TRIANGLE ARROWHEAD
+1 0 DOWNWARDS WHITE ARROW WITHIN TRIANGLE ARROWHEAD
+1 0 LEFTWARDS ARROW WITH NOTCHED TAIL
+1 0 UPWARDS ARROW WITH NOTCHED TAIL
+1 0 RIGHTWARDS ARROW WITH NOTCHED TAIL
+1 0 DOWNWARDS ARROW WITH NOTCHED TAIL
+1 0 HEAVY ARROW SHAFT WIDTH ONE
+1 24 TWO THIRDS
+1 24 ONE HALF
+1 28 THIRD
+1 0 LEFTWARDS BOTTOM-SHADED WHITE ARROW
+1 0 RIGHTWARDS BOTTOM SHADED WHITE ARROW
+1 0 LEFTWARDS TOP SHADED WHITE ARROW
+1 0 RIGHTWARDS TOP SHADED WHITE ARROW
+1 0 LEFTWARDS LEFT-SHADED WHITE ARROW
+1 0 RIGHTWARDS RIGHT-SHADED WHITE ARROW
+1 0 LEFTWARDS RIGHT-SHADED WHITE ARROW
+1 0 RIGHTWARDS LEFT-SHADED WHITE ARROW
+1 0 LEFTWARDS BACK-TILTED SHADOWED WHITE ARROW
+1 0 RIGHTWARDS BACK-TILTED SHADOWED WHITE ARROW
+1 0 LEFTWARDS FRONT-TILTED SHADOWED WHITE ARROW
+1 0 RIGHTWARDS FRONT-TILTED SHADOWED WHITE ARROW
+1 0 WHITE ARROW SHAFT WIDTH ONE
+1 24 TWO THIRDS
+3 0 ARROW POINTING UPWARDS THEN NORTH WEST
+1 15 RIGHTWARDS THEN CURVING SOUTH WEST
+4f 0 CIRCLED CROSS FORMEE WITH FOUR DOTS
+1 26 TWO DOTS
+1 20 
+1 0 LEFT HALF CIRCLE WITH FOUR DOTS
+1 22 THREE DOTS
+1 23 WO DOTS
+1 22 DOT
+1 16 
+1 0 DOWNWARD FACING HOOK
+1 16 NOTCHED HOOK
+1 16 HOOK WITH DOT
+1 16 NOTCHED HOOK WITH DOT
+1 0 PINCHED FINGERS
+1 0 WHITE HEART
+1 0 BROWN HEART
+1 0 PINCHING HAND
+1 0 ZIPPER-MOUTH FACE
+1 0 MONEY-MOUTH FACE
+1 0 FACE WITH THERMOMETER
+1 0 NERD FACE
+1 0 THINKING FACE
+1 0 FACE WITH HEAD-BANDAGE
+1 0 ROBOT FACE
+1 0 HUGGING FACE
+1 0 SIGN OF THE HORNS
+1 0 CALL ME HAND
+1 0 RAISED BACK OF HAND
+1 0 LEFT-FACING FIST
+1 0 RIGHT-FACING FIST
+1 0 HANDSHAKE
+1 4  WITH INDEX AND MIDDLE FINGERS CROSSED
+1 0 I LOVE YOU HAND SIGN
+1 0 FACE WITH COWBOY HAT
+1 0 CLOWN FACE
+1 0 NAUSEATED FACE
+1 0 ROLLING ON THE FLOOR LAUGHING
+1 0 DROOLING FACE
+1 0 LYING FACE
+1 0 FACE PALM
+1 0 SNEEZING FACE
+1 0 FACE WITH ONE EYEBROW RAISED
+1 0 GRINNING FACE WITH STAR EYES
+1 19 ONE LARGE AND ONE SMALL EYE
+1 0 FACE WITH FINGER COVERING CLOSED LIPS
+1 0 SERIOUS FACE WITH SYMBOLS COVERING MOUTH
+1 1 MILING FACE WITH SMILING EYES AND HAND COVERING MOUTH
+1 0 FACE WITH OPEN MOUTH VOMITING
+1 0 SHOCKED FACE WITH EXPLODING HEAD
+1 0 PREGNANT WOMAN
+1 0 BREAST-FEEDING
+1 0 PALMS UP TOGETHER
+1 0 SELFIE
+1 0 PRINCE
+1 0 MAN IN TUXEDO
+1 1 OTHER CHRISTMAS
+1 0 SHRUG
+1 0 PERSON DOING CARTWHEEL
+1 0 JUGGLING
+1 0 FENCER
+1 0 MODERN PENTATHLON
+1 0 WRESTLERS
+1 1 ATER POLO
+1 0 HANDBALL
+1 0 DIVING MASK
+1 0 WILTED FLOWER
+1 0 DRUM WITH DRUMSTICKS
+1 0 CLINKING GLASSES
+1 0 TUMBLER GLASS
+1 0 SPOON
+1 0 GOAL NET
+1 0 RIFLE
+1 0 FIRST PLACE MEDAL
+1 0 SECOND PLACE MEDAL
+1 0 THIRD PLACE MEDAL
+1 0 BOXING GLOVE
+1 0 MARTIAL ARTS UNIFORM
+1 0 CURLING STONE
+1 0 LACROSSE STICK AND BALL
+1 0 SOFTBALL
+1 0 FLYING DISC
+1 0 CROISSANT
+1 0 AVOCADO
+1 0 CUCUMBER
+1 0 BACON
+1 0 POTATO
+1 0 CARROT
+1 0 BAGUETTE BREAD
+1 0 GREEN SALAD
+1 0 SHALLOW PAN OF FOOD
+1 1 TUFFED FLATBREAD
+1 0 EGG
+1 0 GLASS OF MILK
+1 0 PEANUTS
+1 0 KIWIFRUIT
+1 0 PANCAKES
+1 0 DUMPLING
+1 0 FORTUNE COOKIE
+1 0 TAKEOUT BOX
+1 0 CHOPSTICKS
+1 0 BOWL WITH SPOON
+1 0 CUP WITH STRAW
+1 1 OCONUT
+1 0 BROCCOLI
+1 0 PIE
+1 1 RETZEL
+1 0 CUT OF MEAT
+1 0 SANDWICH
+1 0 CANNED FOOD
+1 0 LEAFY GREEN
+1 0 MANGO
+1 1 OON CAKE
+1 0 BAGEL
+1 0 SMILING FACE WITH SMILING EYES AND THREE HEARTS
+1 0 YAWNING FACE
+1 0 SMILING FACE WITH TEAR
+1 0 FACE WITH PARTY HORN AND PARTY HAT
+1 10 UNEVEN EYES AND WAVY MOUTH
+1 0 OVERHEATED FACE
+1 0 FREEZING FACE
+1 0 NINJA
+1 0 DISGUISED FACE
+1 0 FACE HOLDING BACK TEARS
+1 5 WITH PLEADING EYES
+1 0 SARI
+1 0 LAB COAT
+1 0 GOGGLES
+1 0 HIKING BOOT
+1 0 FLAT SHOE
+1 0 CRAB
+1 0 LION FACE
+1 0 SCORPION
+1 0 TURKEY
+1 0 UNICORN FACE
+1 0 EAGLE
+1 0 DUCK
+1 0 BAT
+1 0 SHARK
+1 0 OWL
+1 0 FOX FACE
+1 0 BUTTERFLY
+1 0 DEER
+1 0 GORILLA
+1 0 LIZARD
+1 0 RHINOCEROS
+1 0 SHRIMP
+1 1 QUID
+1 0 GIRAFFE FACE
+1 0 ZEBRA FACE
+1 0 HEDGEHOG
+1 0 SAUROPOD
+1 0 T-REX
+1 0 CRICKET
+1 0 KANGAROO
+1 0 LLAMA
+1 0 PEACOCK
+1 0 HIPPOPOTAMUS
+1 0 PARROT
+1 0 RACCOON
+1 0 LOBSTER
+1 0 MOSQUITO
+1 1 ICROBE
+1 0 BADGER
+1 0 SWAN
+1 0 MAMMOTH
+1 0 DODO
+1 0 SLOTH
+1 0 OTTER
+1 1 RANGUTAN
+1 0 SKUNK
+1 0 FLAMINGO
+1 0 OYSTER
+1 0 BEAVER
+1 1 ISON
+1 0 SEAL
+1 0 GUIDE DOG
+1 0 PROBING CANE
+1 0 EMOJI COMPONENT RED HAIR
+1 16 CURLY HAIR
+1 16 BALD
+1 16 WHITE HAIR
+1 0 BONE
+1 0 LEG
+1 0 FOOT
+1 0 TOOTH
+1 0 SUPERHERO
+1 5 VILLAIN
+1 1 AFETY VEST
+1 0 EAR WITH HEARING AID
+1 0 MOTORIZED WHEELCHAIR
+1 1 ANUAL WHEELCHAIR
+1 1 ECHANICAL ARM
+1 11 LEG
+1 0 CHEESE WEDGE
+1 1 UPCAKE
+1 0 SALT SHAKER
+1 0 BEVERAGE BOX
+1 0 GARLIC
+1 0 ONION
+1 0 FALAFEL
+1 0 WAFFLE
+1 0 BUTTER
+1 0 MATE DRINK
+1 0 ICE CUBE
+1 0 BUBBLE TEA
+1 0 TROLL
+1 0 STANDING PERSON
+1 0 KNEELING PERSON
+1 0 DEAF PERSON
+1 0 FACE WITH MONOCLE
+1 0 ADULT
+1 0 CHILD
+1 0 OLDER ADULT
+1 0 BEARDED PERSON
+1 0 PERSON WITH HEADSCARF
+1 7 IN STEAMY ROOM
+1 7 CLIMBING
+1 7 IN LOTUS POSITION
+1 0 MAGE
+1 0 FAIRY
+1 0 VAMPIRE
+1 0 MERPERSON
+1 0 ELF
+1 0 GENIE
+1 0 ZOMBIE
+1 0 BRAIN
+1 0 ORANGE HEART
+1 0 BILLED CAP
+1 0 SCARF
+1 0 GLOVES
+1 0 COAT
+1 0 SOCKS
+1 0 RED GIFT ENVELOPE
+1 0 FIRECRACKER
+1 0 JIGSAW PUZZLE PIECE
+1 0 TEST TUBE
+1 0 PETRI DISH
+1 0 DNA DOUBLE HELIX
+1 0 COMPASS
+1 0 ABACUS
+1 0 FIRE EXTINGUISHER
+1 0 TOOLBOX
+1 0 BRICK
+1 0 MAGNET
+1 0 LUGGAGE
+1 1 OTION BOTTLE
+1 0 SPOOL OF THREAD
+1 0 BALL OF YARN
+1 0 SAFETY PIN
+1 0 TEDDY BEAR
+1 0 BROOM
+1 1 ASKET
+1 0 ROLL OF PAPER
+1 0 BAR OF SOAP
+1 0 SPONGE
+1 0 RECEIPT
+1 0 NAZAR AMULET
+1 1 EUTRAL CHESS KING
+1 14 QUEEN
+1 14 ROOK
+1 14 BISHOP
+1 14 KNIGHT
+1 14 PAWN
+1 0 WHITE CHESS KNIGHT ROTATED FORTY-FIVE DEGREES
+1 0 BLACK CHESS KNIGHT ROTATED FORTY-FIVE DEGREES
+1 0 NEUTRAL CHESS KNIGHT ROTATED FORTY-FIVE DEGREES
+1 0 WHITE CHESS KING ROTATED NINETY DEGREES
+1 12 QUEEN ROTATED NINETY DEGREES
+1 12 ROOK ROTATED NINETY DEGREES
+1 12 BISHOP ROTATED NINETY DEGREES
+1 12 KNIGHT ROTATED NINETY DEGREES
+1 12 PAWN ROTATED NINETY DEGREES
+1 0 BLACK CHESS KING ROTATED NINETY DEGREES
+1 12 QUEEN ROTATED NINETY DEGREES
+1 12 ROOK ROTATED NINETY DEGREES
+1 12 BISHOP ROTATED NINETY DEGREES
+1 12 KNIGHT ROTATED NINETY DEGREES
+1 12 PAWN ROTATED NINETY DEGREES
+1 0 NEUTRAL CHESS KING ROTATED NINETY DEGREES
+1 14 QUEEN ROTATED NINETY DEGREES
+1 14 ROOK ROTATED NINETY DEGREES
+1 14 BISHOP ROTATED NINETY DEGREES
+1 14 KNIGHT ROTATED NINETY DEGREES
+1 14 PAWN ROTATED NINETY DEGREES
+1 0 WHITE CHESS KNIGHT ROTATED ONE HUNDRED THIRTY-FIVE DEGREES
+1 0 BLACK CHESS KNIGHT ROTATED ONE HUNDRED THIRTY-FIVE DEGREES
+1 0 NEUTRAL CHESS KNIGHT ROTATED ONE HUNDRED THIRTY-FIVE DEGREES
+1 0 WHITE CHESS TURNED KING
+1 19 QUEEN
+1 19 ROOK
+1 19 BISHOP
+1 19 KNIGHT
+1 19 PAWN
+1 0 BLACK CHESS TURNED KING
+1 19 QUEEN
+1 19 ROOK
+1 19 BISHOP
+1 19 KNIGHT
+1 19 PAWN
+1 0 NEUTRAL CHESS TURNED KING
+1 21 QUEEN
+1 21 ROOK
+1 21 BISHOP
+1 21 KNIGHT
+1 21 PAWN
+1 0 WHITE CHESS KNIGHT ROTATED TWO HUNDRED TWENTY-FIVE DEGREES
+1 0 BLACK CHESS KNIGHT ROTATED TWO HUNDRED TWENTY-FIVE DEGREES
+1 0 NEUTRAL CHESS KNIGHT ROTATED TWO HUNDRED TWENTY-FIVE DEGREES
+1 0 WHITE CHESS KING ROTATED TWO HUNDRED SEVENTY DEGREES
+1 12 QUEEN ROTATED TWO HUNDRED SEVENTY DEGREES
+1 12 ROOK ROTATED TWO HUNDRED SEVENTY DEGREES
+1 12 BISHOP ROTATED TWO HUNDRED SEVENTY DEGREES
+1 12 KNIGHT ROTATED TWO HUNDRED SEVENTY DEGREES
+1 12 PAWN ROTATED TWO HUNDRED SEVENTY DEGREES
+1 0 BLACK CHESS KING ROTATED TWO HUNDRED SEVENTY DEGREES
+1 12 QUEEN ROTATED TWO HUNDRED SEVENTY DEGREES
+1 12 ROOK ROTATED TWO HUNDRED SEVENTY DEGREES
+1 12 BISHOP ROTATED TWO HUNDRED SEVENTY DEGREES
+1 12 KNIGHT ROTATED TWO HUNDRED SEVENTY DEGREES
+1 12 PAWN ROTATED TWO HUNDRED SEVENTY DEGREES
+1 0 NEUTRAL CHESS KING ROTATED TWO HUNDRED SEVENTY DEGREES
+1 14 QUEEN ROTATED TWO HUNDRED SEVENTY DEGREES
+1 14 ROOK ROTATED TWO HUNDRED SEVENTY DEGREES
+1 14 BISHOP ROTATED TWO HUNDRED SEVENTY DEGREES
+1 14 KNIGHT ROTATED TWO HUNDRED SEVENTY DEGREES
+1 14 PAWN ROTATED TWO HUNDRED SEVENTY DEGREES
+1 0 WHITE CHESS KNIGHT ROTATED THREE HUNDRED FIFTEEN DEGREES
+1 0 BLACK CHESS KNIGHT ROTATED THREE HUNDRED FIFTEEN DEGREES
+1 0 NEUTRAL CHESS KNIGHT ROTATED THREE HUNDRED FIFTEEN DEGREES
+1 0 WHITE CHESS EQUIHOPPER
+1 0 BLACK CHESS EQUIHOPPER
+1 0 NEUTRAL CHESS EQUIHOPPER
+1 0 WHITE CHESS EQUIHOPPER ROTATED NINETY DEGREES
+1 0 BLACK CHESS EQUIHOPPER ROTATED NINETY DEGREES
+1 0 NEUTRAL CHESS EQUIHOPPER ROTATED NINETY DEGREES
+1 0 WHITE CHESS KNIGHT-QUEEN
+1 19 ROOK
+1 19 BISHOP
+1 0 BLACK CHESS KNIGHT-QUEEN
+1 19 ROOK
+1 19 BISHOP
+d 0 XIANGQI RED GENERAL
+1 12 MANDARIN
+1 12 ELEPHANT
+1 12 HORSE
+1 12 CHARIOT
+1 13 ANNON
+1 12 SOLDIER
+1 8 BLACK GENERAL
+1 14 MANDARIN
+1 14 ELEPHANT
+1 14 HORSE
+1 14 CHARIOT
+1 15 ANNON
+1 14 SOLDIER
+3 0 BALLET SHOES
+1 0 ONE-PIECE SWIMSUIT
+1 0 BRIEFS
+1 0 SHORTS
+1 0 THONG SANDAL
+4 0 DROP OF BLOOD
+1 0 ADHESIVE BANDAGE
+1 0 STETHOSCOPE
+1 0 X-RAY
+1 0 CRUTCH
+4 0 YO-YO
+1 0 KITE
+1 0 PARACHUTE
+1 0 BOOMERANG
+1 0 MAGIC WAND
+1 0 PINATA
+1 0 NESTING DOLLS
+a 0 RINGED PLANET
+1 0 CHAIR
+1 0 RAZOR
+1 0 AXE
+1 0 DIYA LAMP
+1 0 BANJO
+1 0 MILITARY HELMET
+1 0 ACCORDION
+1 0 LONG DRUM
+1 0 COIN
+1 1 ARPENTRY SAW
+1 0 SCREWDRIVER
+1 0 LADDER
+1 0 HOOK
+1 0 MIRROR
+1 0 WINDOW
+1 0 PLUNGER
+1 0 SEWING NEEDLE
+1 0 KNOT
+1 0 BUCKET
+1 0 MOUSE TRAP
+1 0 TOOTHBRUSH
+1 0 HEADSTONE
+1 0 PLACARD
+1 0 ROCK
+1 0 MIRROR BALL
+1 0 IDENTIFICATION CARD
+1 0 LOW BATTERY
+1 0 HAMSA
+4 0 FLY
+1 0 WORM
+1 0 BEETLE
+1 0 COCKROACH
+1 0 POTTED PLANT
+1 0 WOOD
+1 0 FEATHER
+1 0 LOTUS
+1 0 CORAL
+1 0 EMPTY NEST
+1 0 NEST WITH EGGS
+6 0 ANATOMICAL HEART
+1 0 LUNGS
+1 0 PEOPLE HUGGING
+1 1 REGNANT MAN
+1 9 PERSON
+1 1 ERSON WITH CROWN
+b 0 BLUEBERRIES
+1 1 ELL PEPPER
+1 0 OLIVE
+1 0 FLATBREAD
+1 0 TAMALE
+1 0 FONDUE
+1 0 TEAPOT
+1 0 POURING LIQUID
+1 0 BEANS
+1 0 JAR
+7 0 MELTING FACE
+1 0 SALUTING FACE
+1 0 FACE WITH OPEN EYES AND HAND OVER MOUTH
+1 10 PEEKING EYE
+1 10 DIAGONAL MOUTH
+1 0 DOTTED LINE FACE
+1 0 BITING LIP
+1 1 UBBLES
+9 0 HAND WITH INDEX FINGER AND THUMB CROSSED
+1 0 RIGHTWARDS HAND
+1 0 LEFTWARDS HAND
+1 0 PALM DOWN HAND
+1 5 UP HAND
+1 0 INDEX POINTING AT THE VIEWER
+1 0 HEART HANDS
+a 0 BLOCK SEXTANT-1
+1 14 2
+1 14 12
+1 14 3
+1 14 13
+1 14 23
+1 14 123
+1 14 4
+1 14 14
+1 14 24
+1 14 124
+1 14 34
+1 14 134
+1 14 234
+1 14 1234
+1 14 5
+1 14 15
+1 14 25
+1 14 125
+1 14 35
+1 14 235
+1 14 1235
+1 14 45
+1 14 145
+1 14 245
+1 14 1245
+1 14 345
+1 14 1345
+1 14 2345
+1 14 12345
+1 14 6
+1 14 16
+1 14 26
+1 14 126
+1 14 36
+1 14 136
+1 14 236
+1 14 1236
+1 14 46
+1 14 146
+1 15 246
+1 14 346
+1 14 1346
+1 14 2346
+1 14 12346
+1 14 56
+1 14 156
+1 14 256
+1 14 1256
+1 14 356
+1 14 1356
+1 14 2356
+1 14 12356
+1 14 456
+1 14 1456
+1 14 2456
+1 14 12456
+1 14 3456
+1 14 13456
+1 14 23456
+1 0 LOWER LEFT BLOCK DIAGONAL LOWER MIDDLE LEFT TO LOWER CENTRE
+1 53 RIGHT
+1 26 UPPER MIDDLE LEFT TO LOWER CENTRE
+1 53 RIGHT
+1 32 LEFT TO LOWER CENTRE
+1 6 RIGHT BLOCK DIAGONAL UPPER MIDDLE LEFT TO UPPER CENTRE
+1 54 RIGHT
+1 27 LOWER MIDDLE LEFT TO UPPER CENTRE
+1 54 RIGHT
+1 33 LEFT TO UPPER CENTRE
+1 33 MIDDLE LEFT TO UPPER MIDDLE RIGHT
+1 33 CENTRE TO LOWER MIDDLE RIGHT
+1 33 LEFT TO LOWER MIDDLE RIGHT
+1 33 CENTRE TO UPPER MIDDLE RIGHT
+1 33 LEFT TO UPPER MIDDLE RIGHT
+1 33 CENTRE TO UPPER RIGHT
+1 6 LEFT BLOCK DIAGONAL UPPER CENTRE TO UPPER MIDDLE RIGHT
+1 32 LEFT TO UPPER MIDDLE RIGHT
+1 32 CENTRE TO LOWER MIDDLE RIGHT
+1 32 LEFT TO LOWER MIDDLE RIGHT
+1 32 CENTRE TO LOWER RIGHT
+1 32 MIDDLE LEFT TO LOWER MIDDLE RIGHT
+1 0 UPPER RIGHT BLOCK DIAGONAL LOWER MIDDLE LEFT TO LOWER CENTRE
+1 54 RIGHT
+1 27 UPPER MIDDLE LEFT TO LOWER CENTRE
+1 54 RIGHT
+1 33 LEFT TO LOWER CENTRE
+1 6 LEFT BLOCK DIAGONAL UPPER MIDDLE LEFT TO UPPER CENTRE
+1 53 RIGHT
+1 26 LOWER MIDDLE LEFT TO UPPER CENTRE
+1 53 RIGHT
+1 32 LEFT TO UPPER CENTRE
+1 32 MIDDLE LEFT TO UPPER MIDDLE RIGHT
+1 32 CENTRE TO LOWER MIDDLE RIGHT
+1 32 LEFT TO LOWER MIDDLE RIGHT
+1 32 CENTRE TO UPPER MIDDLE RIGHT
+1 32 LEFT TO UPPER MIDDLE RIGHT
+1 32 CENTRE TO UPPER RIGHT
+1 6 RIGHT BLOCK DIAGONAL UPPER CENTRE TO UPPER MIDDLE RIGHT
+1 33 LEFT TO UPPER MIDDLE RIGHT
+1 33 CENTRE TO LOWER MIDDLE RIGHT
+1 33 LEFT TO LOWER MIDDLE RIGHT
+1 33 CENTRE TO LOWER RIGHT
+1 33 MIDDLE LEFT TO LOWER MIDDLE RIGHT
+1 6 AND RIGHT AND LOWER TRIANGULAR THREE QUARTERS BLOCK
+1 0 LEFT AND LOWER AND RIGHT TRIANGULAR THREE QUARTERS BLOCK
+1 0 UPPER AND LEFT AND LOWER TRIANGULAR THREE QUARTERS BLOCK
+1 0 LEFT AND UPPER AND RIGHT TRIANGULAR THREE QUARTERS BLOCK
+1 5 TRIANGULAR ONE QUARTER BLOCK
+1 0 UPPER TRIANGULAR ONE QUARTER BLOCK
+1 0 RIGHT TRIANGULAR ONE QUARTER BLOCK
+1 0 LOWER TRIANGULAR ONE QUARTER BLOCK
+1 0 VERTICAL ONE EIGHTH BLOCK-2
+1 26 3
+1 26 4
+1 26 5
+1 26 6
+1 26 7
+1 0 HORIZONTAL ONE EIGHTH BLOCK-2
+1 28 3
+1 28 4
+1 28 5
+1 28 6
+1 28 7
+1 0 LEFT AND LOWER ONE EIGHTH BLOCK
+1 9 UPPER ONE EIGHTH BLOCK
+1 0 RIGHT AND UPPER ONE EIGHTH BLOCK
+1 10 LOWER ONE EIGHTH BLOCK
+1 0 UPPER AND LOWER ONE EIGHTH BLOCK
+1 0 HORIZONTAL ONE EIGHTH BLOCK-1358
+1 0 UPPER ONE QUARTER BLOCK
+1 6 THREE EIGHTHS BLOCK
+1 6 FIVE EIGHTHS BLOCK
+1 6 THREE QUARTERS BLOCK
+1 6 SEVEN EIGHTHS BLOCK
+1 0 RIGHT ONE QUARTER BLOCK
+1 6 THREE EIGHTHS BLOCK
+1 6 FIVE EIGHTHS BLOCK
+1 6 THREE QUARTERS BLOCK
+1 6 SEVEN EIGHTHS BLOCK
+1 0 LEFT HALF MEDIUM SHADE
+1 0 RIGHT HALF MEDIUM SHADE
+1 0 UPPER HALF MEDIUM SHADE
+1 0 LOWER HALF MEDIUM SHADE
+1 0 INVERSE MEDIUM SHADE
+1 0 UPPER HALF BLOCK AND LOWER HALF INVERSE MEDIUM SHADE
+1 11 INVERSE MEDIUM SHADE AND LOWER HALF BLOCK
+2 0 LEFT HALF INVERSE MEDIUM SHADE AND RIGHT HALF BLOCK
+1 0 CHECKER BOARD FILL
+1 0 INVERSE CHECKER BOARD FILL
+1 0 HEAVY HORIZONTAL FILL
+1 0 UPPER LEFT TO LOWER RIGHT FILL
+1 6 RIGHT TO LOWER LEFT FILL
+1 6 AND LOWER TRIANGULAR HALF BLOCK
+1 0 LEFT AND RIGHT TRIANGULAR HALF BLOCK
+1 0 UPPER LEFT TRIANGULAR MEDIUM SHADE
+1 6 RIGHT TRIANGULAR MEDIUM SHADE
+1 0 LOWER RIGHT TRIANGULAR MEDIUM SHADE
+1 6 LEFT TRIANGULAR MEDIUM SHADE
+1 0 BOX DRAWINGS LIGHT DIAGONAL UPPER CENTRE TO MIDDLE LEFT
+1 51 RIGHT
+1 28 MIDDLE LEFT TO LOWER CENTRE
+1 35 RIGHT TO LOWER CENTRE
+1 28 UPPER CENTRE TO MIDDLE LEFT TO LOWER CENTRE
+1 51 RIGHT TO LOWER CENTRE
+1 28 MIDDLE LEFT TO LOWER CENTRE TO MIDDLE RIGHT
+1 43 UPPER CENTRE TO MIDDLE RIGHT
+1 28 UPPER CENTRE TO MIDDLE LEFT AND MIDDLE RIGHT TO LOWER CENTRE
+1 51 RIGHT AND MIDDLE LEFT TO LOWER CENTRE
+1 57 TO LOWER CENTRE TO MIDDLE LEFT
+1 51 LEFT TO LOWER CENTRE TO MIDDLE RIGHT
+1 28 MIDDLE LEFT TO UPPER CENTRE TO MIDDLE RIGHT TO LOWER CENTRE
+1 35 RIGHT TO UPPER CENTRE TO MIDDLE LEFT TO LOWER CENTRE
+1 28 DIAMOND
+1 19 HORIZONTAL WITH VERTICAL STROKE
+1 0 ARROWHEAD-SHAPED POINTER
+1 0 INVERSE CHECK MARK
+1 0 LEFT HALF RUNNING MAN
+1 0 RIGHT HALF RUNNING MAN
+1 0 INVERSE DOWNWARDS ARROW WITH TIP LEFTWARDS
+1 0 LEFTWARDS ARROW AND UPPER AND LOWER ONE EIGHTH BLOCK
+1 0 RIGHTWARDS ARROW AND UPPER AND LOWER ONE EIGHTH BLOCK
+1 0 DOWNWARDS ARROW AND RIGHT ONE EIGHTH BLOCK
+1 0 UPWARDS ARROW AND RIGHT ONE EIGHTH BLOCK
+1 0 LEFT HALF FOLDER
+1 0 RIGHT HALF FOLDER
+1 0 VOIDED GREEK CROSS
+1 0 RIGHT OPEN SQUARED DOT
+1 0 NEGATIVE DIAGONAL CROSS
+1 18 MIDDLE RIGHT TO LOWER CENTRE
+1 18 DIAMOND
+1 0 WHITE HEAVY SALTIRE WITH ROUNDED CORNERS
+1 0 LEFT THIRD WHITE RIGHT POINTING INDEX
+1 0 MIDDLE THIRD WHITE RIGHT POINTING INDEX
+1 0 RIGHT THIRD WHITE RIGHT POINTING INDEX
+1 0 NEGATIVE SQUARED QUESTION MARK
+1 0 STICK FIGURE
+1 12  WITH ARMS RAISED
+1 13 LEANING LEFT
+1 21 RIGHT
+1 13 WITH DRESS
+1 0 WHITE UP-POINTING CHEVRON
+26 0 SEGMENTED DIGIT ZERO
+1 16 ONE
+1 16 TWO
+1 17 HREE
+1 16 FOUR
+1 17 IVE
+1 16 SIX
+1 17 EVEN
+1 16 EIGHT
+1 16 NINE
+c0408 0 LANGUAGE TAG
+1f 0 TAG SPACE
+1 4 EXCLAMATION MARK
+1 4 QUOTATION MARK
+1 4 NUMBER SIGN
+1 4 DOLLAR SIGN
+1 4 PERCENT SIGN
+1 4 AMPERSAND
+1 5 POSTROPHE
+1 4 LEFT PARENTHESIS
+1 4 RIGHT PARENTHESIS
+1 4 ASTERISK
+1 4 PLUS SIGN
+1 4 COMMA
+1 4 HYPHEN-MINUS
+1 4 FULL STOP
+1 4 SOLIDUS
+1 4 DIGIT ZERO
+1 10 ONE
+1 10 TWO
+1 11 HREE
+1 10 FOUR
+1 11 IVE
+1 10 SIX
+1 11 EVEN
+1 10 EIGHT
+1 10 NINE
+1 4 COLON
+1 4 SEMICOLON
+1 4 LESS-THAN SIGN
+1 4 EQUALS SIGN
+1 4 GREATER-THAN SIGN
+1 4 QUESTION MARK
+1 4 COMMERCIAL AT
+1 4 LATIN CAPITAL LETTER A
+1 25 B
+1 25 C
+1 25 D
+1 25 E
+1 25 F
+1 25 G
+1 25 H
+1 25 I
+1 25 J
+1 25 K
+1 25 L
+1 25 M
+1 25 N
+1 25 O
+1 25 P
+1 25 Q
+1 25 R
+1 25 S
+1 25 T
+1 25 U
+1 25 V
+1 25 W
+1 25 X
+1 25 Y
+1 25 Z
+1 5 EFT SQUARE BRACKET
+1 4 REVERSE SOLIDUS
+1 5 IGHT SQUARE BRACKET
+1 4 CIRCUMFLEX ACCENT
+1 4 LOW LINE
+1 4 GRAVE ACCENT
+1 4 LATIN SMALL LETTER A
+1 23 B
+1 23 C
+1 23 D
+1 23 E
+1 23 F
+1 23 G
+1 23 H
+1 23 I
+1 23 J
+1 23 K
+1 23 L
+1 23 M
+1 23 N
+1 23 O
+1 23 P
+1 23 Q
+1 23 R
+1 23 S
+1 23 T
+1 23 U
+1 23 V
+1 23 W
+1 23 X
+1 23 Y
+1 23 Z
+1 5 EFT CURLY BRACKET
+1 4 VERTICAL LINE
+1 4 RIGHT CURLY BRACKET
+1 4 TILDE
+1 0 CANCEL TAG
+81 0 VARIATION SELECTOR-17
+1 20 8
+1 20 9
+1 19 20
+1 20 1
+1 20 2
+1 20 3
+1 20 4
+1 20 5
+1 20 6
+1 20 7
+1 20 8
+1 20 9
+1 19 30
+1 20 1
+1 20 2
+1 20 3
+1 20 4
+1 20 5
+1 20 6
+1 20 7
+1 20 8
+1 20 9
+1 19 40
+1 20 1
+1 20 2
+1 20 3
+1 20 4
+1 20 5
+1 20 6
+1 20 7
+1 20 8
+1 20 9
+1 19 50
+1 20 1
+1 20 2
+1 20 3
+1 20 4
+1 20 5
+1 20 6
+1 20 7
+1 20 8
+1 20 9
+1 19 60
+1 20 1
+1 20 2
+1 20 3
+1 20 4
+1 20 5
+1 20 6
+1 20 7
+1 20 8
+1 20 9
+1 19 70
+1 20 1
+1 20 2
+1 20 3
+1 20 4
+1 20 5
+1 20 6
+1 20 7
+1 20 8
+1 20 9
+1 19 80
+1 20 1
+1 20 2
+1 20 3
+1 20 4
+1 20 5
+1 20 6
+1 20 7
+1 20 8
+1 20 9
+1 19 90
+1 20 1
+1 20 2
+1 20 3
+1 20 4
+1 20 5
+1 20 6
+1 20 7
+1 20 8
+1 20 9
+1 19 100
+1 21 1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 21 9
+1 20 10
+1 21 1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 21 9
+1 20 20
+1 21 1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 21 9
+1 20 30
+1 21 1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 21 9
+1 20 40
+1 21 1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 21 9
+1 20 50
+1 21 1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 21 9
+1 20 60
+1 21 1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 21 9
+1 20 70
+1 21 1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 21 9
+1 20 80
+1 21 1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 21 9
+1 20 90
+1 21 1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 21 9
+1 19 200
+1 21 1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 21 9
+1 20 10
+1 21 1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 21 9
+1 20 20
+1 21 1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 21 9
+1 20 30
+1 21 1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 21 9
+1 20 40
+1 21 1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6
+1 21 7
+1 21 8
+1 21 9
+1 20 50
+1 21 1
+1 21 2
+1 21 3
+1 21 4
+1 21 5
+1 21 6`
@@ -0,0 +1,110 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+import unicodedata
+
+def ck(fn, exc, text, *args):
+    try:
+        fn(*args)
+    except exc as e:
+        if e.args[0] != text:
+            raise
+    else:
+        assert False, "%s not raised" % exc
+
+doc="unidata_version"
+assert unicodedata.unidata_version == "14.0.0"
+
+doc="name"
+assert unicodedata.name("a") == "LATIN SMALL LETTER A"
+assert unicodedata.name("À") == "LATIN CAPITAL LETTER A WITH GRAVE"
+assert unicodedata.name("각") == "HANGUL SYLLABLE GAG"
+assert unicodedata.name("一") == "CJK UNIFIED IDEOGRAPH-4E00"
+assert unicodedata.name("\U0001f954") == "POTATO"
+assert unicodedata.name("\x00", None) is None
+assert unicodedata.name("\x00", "default") == "default"
+ck(unicodedata.name, ValueError, "no such name", "\x00")
+ck(unicodedata.name, TypeError, "name() argument 1 must be a unicode character, not str", "ab")
+ck(unicodedata.name, TypeError, "name() argument 1 must be a unicode character, not int", 1)
+
+doc="lookup"
+assert unicodedata.lookup("LATIN SMALL LETTER A") == "a"
+assert unicodedata.lookup("latin small letter a") == "a"
+assert unicodedata.lookup("HANGUL SYLLABLE GAG") == "각"
+assert unicodedata.lookup("CJK UNIFIED IDEOGRAPH-4E00") == "一"
+assert unicodedata.lookup("EM DASH") == "—"
+ck(unicodedata.lookup, KeyError, "undefined character name 'SPUDNIK'", "SPUDNIK")
+ck(unicodedata.lookup, KeyError, "undefined character name 'HANGUL SYLLABLE X'", "HANGUL SYLLABLE X")
+ck(unicodedata.lookup, KeyError, "undefined character name 'CJK UNIFIED IDEOGRAPH-0041'", "CJK UNIFIED IDEOGRAPH-0041")
+for c in ("a", "Z", "9", " ", "é", "—", "가", "힣", "一", "\U0001f600"):
+    assert unicodedata.lookup(unicodedata.name(c)) == c
+
+doc="named escapes"
+assert "\N{LATIN SMALL LETTER A}" == "a"
+assert "\N{EM DASH}" == "—"
+assert "x\N{latin capital letter a with grave}y" == "xÀy"
+assert f"{1}\N{EM DASH}{2}" == "1—2"
+assert b"\N{EM DASH}" == b"\\N{EM DASH}"
+assert r"\N{EM DASH}" == "\\N{EM DASH}"
+
+doc="category"
+assert unicodedata.category("A") == "Lu"
+assert unicodedata.category("a") == "Ll"
+assert unicodedata.category("1") == "Nd"
+assert unicodedata.category(" ") == "Zs"
+assert unicodedata.category("̀") == "Mn"
+assert unicodedata.category("가") == "Lo"
+assert unicodedata.category("\U000e0000") == "Cn"
+assert unicodedata.category("\U0010ffff") == "Cn"
+
+doc="east_asian_width"
+assert unicodedata.east_asian_width("a") == "Na"
+assert unicodedata.east_asian_width("一") == "W"
+assert unicodedata.east_asian_width("Ａ") == "F"
+assert unicodedata.east_asian_width("｡") == "H"
+assert unicodedata.east_asian_width("¡") == "A"
+assert unicodedata.east_asian_width("̀") == "A"
+assert unicodedata.east_asian_width("À") == "N"
+
+doc="combining"
+assert unicodedata.combining("a") == 0
+assert unicodedata.combining("̀") == 230
+assert unicodedata.combining("̧") == 202
+
+doc="decomposition"
+assert unicodedata.decomposition("a") == ""
+assert unicodedata.decomposition("À") == "0041 0300"
+assert unicodedata.decomposition("½") == "<fraction> 0031 2044 0032"
+assert unicodedata.decomposition("ﬁ") == "<compat> 0066 0069"
+assert unicodedata.decomposition("가") == ""
+
+doc="numeric values"
+assert unicodedata.decimal("9") == 9
+assert unicodedata.decimal("٩") == 9
+assert unicodedata.decimal("a", None) is None
+ck(unicodedata.decimal, ValueError, "not a decimal", "a")
+ck(unicodedata.decimal, ValueError, "not a decimal", "²")
+assert unicodedata.digit("²") == 2
+ck(unicodedata.digit, ValueError, "not a digit", "½")
+assert unicodedata.numeric("½") == 0.5
+assert unicodedata.numeric("Ⅻ") == 12.0
+assert unicodedata.numeric("7") == 7.0
+assert unicodedata.numeric("x", -1) == -1
+ck(unicodedata.numeric, ValueError, "not a numeric character", "x")
+
+doc="normalize"
+assert unicodedata.normalize("NFC", "À") == "À"
+assert unicodedata.normalize("NFD", "À") == "À"
+assert unicodedata.normalize("NFKC", "ﬁ") == "fi"
+assert unicodedata.normalize("NFKD", "½") == "1⁄2"
+assert unicodedata.normalize("NFC", "ﬁ") == "ﬁ"
+assert unicodedata.normalize("NFD", "각") == "각"
+assert unicodedata.normalize("NFC", "각") == "각"
+assert unicodedata.normalize("NFD", "q̣̇") == "q̣̇"
+assert unicodedata.normalize("NFC", "ḍ̇") == "ḍ̇"
+assert unicodedata.normalize("NFC", "Å") == "Å"
+assert unicodedata.normalize("NFC", "") == ""
+ck(unicodedata.normalize, ValueError, "invalid normalization form", "NFX", "a")
+
+doc="finished"
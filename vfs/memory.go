@@ -0,0 +1,491 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// An in-memory filesystem
+
+package vfs
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// memoryFS is a filesystem held in memory
+//
+// It has files and directories but no symbolic links.  One lock
+// protects the whole tree and the open files.
+type memoryFS struct {
+	mu   sync.Mutex
+	root *memNode
+	wd   string // absolute path of the working directory
+}
+
+// A file or directory of a memoryFS
+type memNode struct {
+	name    string
+	mode    fs.FileMode
+	modTime time.Time
+	data    []byte              // contents of a file
+	entries map[string]*memNode // contents of a directory
+}
+
+// NewMemory returns an empty in-memory FS
+//
+// The working directory starts as the root which is / on unix.
+func NewMemory() FS {
+	root := string(filepath.Separator)
+	return &memoryFS{
+		root: newMemDir(root, 0777),
+		wd:   root,
+	}
+}
+
+// Makes a directory node
+func newMemDir(name string, perm fs.FileMode) *memNode {
+	return &memNode{
+		name:    name,
+		mode:    fs.ModeDir | perm&fs.ModePerm,
+		modTime: time.Now(),
+		entries: map[string]*memNode{},
+	}
+}
+
+// Returns the components of the absolute path of name
+//
+// Call with the lock held.
+func (m *memoryFS) split(name string) []string {
+	if !filepath.IsAbs(name) {
+		name = filepath.Join(m.wd, name)
+	}
+	name = filepath.Clean(name)
+	name = name[len(filepath.VolumeName(name)):]
+	var parts []string
+	for _, part := range strings.Split(name, string(filepath.Separator)) {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
+
+// Finds the node for name
+//
+// Call with the lock held.
+func (m *memoryFS) lookup(op, name string) (*memNode, error) {
+	node := m.root
+	for _, part := range m.split(name) {
+		if node.entries == nil {
+			return nil, pathError(op, name, syscall.ENOTDIR)
+		}
+		child, ok := node.entries[part]
+		if !ok {
+			return nil, pathError(op, name, syscall.ENOENT)
+		}
+		node = child
+	}
+	return node, nil
+}
+
+// Finds the directory node name is in and the last component of
+// name which is "" for the root
+//
+// Call with the lock held.
+func (m *memoryFS) lookupParent(op, name string) (*memNode, string, error) {
+	parts := m.split(name)
+	if len(parts) == 0 {
+		return m.root, "", nil
+	}
+	dir := m.root
+	for _, part := range parts[:len(parts)-1] {
+		child, ok := dir.entries[part]
+		if !ok {
+			return nil, "", pathError(op, name, syscall.ENOENT)
+		}
+		if child.entries == nil {
+			return nil, "", pathError(op, name, syscall.ENOTDIR)
+		}
+		dir = child
+	}
+	return dir, parts[len(parts)-1], nil
+}
+
+func (m *memoryFS) OpenFile(name string, flag int, perm fs.FileMode) (File, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	dir, base, err := m.lookupParent("open", name)
+	if err != nil {
+		return nil, err
+	}
+	node := dir
+	if base != "" {
+		node = dir.entries[base]
+	}
+	writing := flag&(os.O_WRONLY|os.O_RDWR) != 0
+	if node == nil {
+		if flag&os.O_CREATE == 0 {
+			return nil, pathError("open", name, syscall.ENOENT)
+		}
+		node = &memNode{
+			name:    base,
+			mode:    perm & fs.ModePerm,
+			modTime: time.Now(),
+		}
+		dir.entries[base] = node
+		dir.modTime = node.modTime
+	} else {
+		if flag&(os.O_CREATE|os.O_EXCL) == os.O_CREATE|os.O_EXCL {
+			return nil, pathError("open", name, syscall.EEXIST)
+		}
+		if node.entries != nil && writing {
+			return nil, pathError("open", name, syscall.EISDIR)
+		}
+		if flag&os.O_TRUNC != 0 && writing {
+			node.data = nil
+			node.modTime = time.Now()
+		}
+	}
+	return &memFile{
+		fs:       m,
+		node:     node,
+		name:     name,
+		readable: flag&os.O_WRONLY == 0,
+		writable: writing,
+		append:   flag&os.O_APPEND != 0,
+	}, nil
+}
+
+func (m *memoryFS) Stat(name string) (fs.FileInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	node, err := m.lookup("stat", name)
+	if err != nil {
+		return nil, err
+	}
+	return node.info(), nil
+}
+
+// Lstat is the same as Stat as there are no symbolic links
+func (m *memoryFS) Lstat(name string) (fs.FileInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	node, err := m.lookup("lstat", name)
+	if err != nil {
+		return nil, err
+	}
+	return node.info(), nil
+}
+
+func (m *memoryFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	node, err := m.lookup("readdirent", name)
+	if err != nil {
+		return nil, err
+	}
+	if node.entries == nil {
+		return nil, pathError("readdirent", name, syscall.ENOTDIR)
+	}
+	entries := make([]fs.DirEntry, 0, len(node.entries))
+	for _, child := range node.entries {
+		entries = append(entries, fs.FileInfoToDirEntry(child.info()))
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+	return entries, nil
+}
+
+func (m *memoryFS) Mkdir(name string, perm fs.FileMode) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	dir, base, err := m.lookupParent("mkdir", name)
+	if err != nil {
+		return err
+	}
+	if _, ok := dir.entries[base]; ok || base == "" {
+		return pathError("mkdir", name, syscall.EEXIST)
+	}
+	node := newMemDir(base, perm)
+	dir.entries[base] = node
+	dir.modTime = node.modTime
+	return nil
+}
+
+func (m *memoryFS) Remove(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	dir, base, err := m.lookupParent("remove", name)
+	if err != nil {
+		return err
+	}
+	if base == "" {
+		return pathError("remove", name, syscall.EBUSY)
+	}
+	node, ok := dir.entries[base]
+	if !ok {
+		return pathError("remove", name, syscall.ENOENT)
+	}
+	if len(node.entries) != 0 {
+		return pathError("remove", name, syscall.ENOTEMPTY)
+	}
+	delete(dir.entries, base)
+	dir.modTime = time.Now()
+	return nil
+}
+
+func (m *memoryFS) Rename(oldname, newname string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	linkError := func(errno syscall.Errno) error {
+		return &os.LinkError{Op: "rename", Old: oldname, New: newname, Err: errno}
+	}
+	oldDir, oldBase, err := m.lookupParent("rename", oldname)
+	if err != nil {
+		return linkError(err.(*fs.PathError).Err.(syscall.Errno))
+	}
+	newDir, newBase, err := m.lookupParent("rename", newname)
+	if err != nil {
+		return linkError(err.(*fs.PathError).Err.(syscall.Errno))
+	}
+	if oldBase == "" || newBase == "" {
+		return linkError(syscall.EBUSY)
+	}
+	node, ok := oldDir.entries[oldBase]
+	if !ok {
+		return linkError(syscall.ENOENT)
+	}
+	// Don't move a directory into itself
+	if node.entries != nil && contains(node, newDir) {
+		return linkError(syscall.EINVAL)
+	}
+	if target, ok := newDir.entries[newBase]; ok {
+		if target == node {
+			return nil
+		}
+		switch {
+		case target.entries != nil && node.entries == nil:
+			return linkError(syscall.EISDIR)
+		case target.entries == nil && node.entries != nil:
+			return linkError(syscall.ENOTDIR)
+		case len(target.entries) != 0:
+			return linkError(syscall.ENOTEMPTY)
+		}
+	}
+	delete(oldDir.entries, oldBase)
+	node.name = newBase
+	newDir.entries[newBase] = node
+	now := time.Now()
+	oldDir.modTime = now
+	newDir.modTime = now
+	return nil
+}
+
+// Returns true if dir is node or is somewhere below it
+//
+// Call with the lock held.
+func contains(node, dir *memNode) bool {
+	if node == dir {
+		return true
+	}
+	for _, child := range node.entries {
+		if contains(child, dir) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *memoryFS) Getwd() (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.wd, nil
+}
+
+func (m *memoryFS) Chdir(dir string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	node, err := m.lookup("chdir", dir)
+	if err != nil {
+		return err
+	}
+	if node.entries == nil {
+		return pathError("chdir", dir, syscall.ENOTDIR)
+	}
+	m.wd = string(filepath.Separator) + filepath.Join(m.split(dir)...)
+	return nil
+}
+
+// Returns the FileInfo for the node
+//
+// Call with the lock held.
+func (n *memNode) info() fs.FileInfo {
+	return memFileInfo{
+		name:    n.name,
+		size:    int64(len(n.data)),
+		mode:    n.mode,
+		modTime: n.modTime,
+	}
+}
+
+// memFileInfo is a snapshot of a memNode
+type memFileInfo struct {
+	name    string
+	size    int64
+	mode    fs.FileMode
+	modTime time.Time
+}
+
+func (fi memFileInfo) Name() string       { return fi.name }
+func (fi memFileInfo) Size() int64        { return fi.size }
+func (fi memFileInfo) Mode() fs.FileMode  { return fi.mode }
+func (fi memFileInfo) ModTime() time.Time { return fi.modTime }
+func (fi memFileInfo) IsDir() bool        { return fi.mode.IsDir() }
+func (fi memFileInfo) Sys() interface{}   { return nil }
+
+// memFile is an open file of a memoryFS
+type memFile struct {
+	fs       *memoryFS
+	node     *memNode
+	name     string
+	pos      int64
+	readable bool
+	writable bool
+	append   bool
+	closed   bool
+}
+
+// Returns an error if the file is closed or doesn't allow the access
+//
+// Call with the lock held.
+func (f *memFile) check(op string, ok bool) error {
+	if f.closed || !ok {
+		return pathError(op, f.name, syscall.EBADF)
+	}
+	return nil
+}
+
+func (f *memFile) Stat() (fs.FileInfo, error) {
+	f.fs.mu.Lock()
+	defer f.fs.mu.Unlock()
+	if err := f.check("stat", true); err != nil {
+		return nil, err
+	}
+	return f.node.info(), nil
+}
+
+func (f *memFile) Read(b []byte) (int, error) {
+	f.fs.mu.Lock()
+	defer f.fs.mu.Unlock()
+	n, err := f.readAt(b, f.pos)
+	f.pos += int64(n)
+	return n, err
+}
+
+func (f *memFile) ReadAt(b []byte, off int64) (int, error) {
+	f.fs.mu.Lock()
+	defer f.fs.mu.Unlock()
+	n, err := f.readAt(b, off)
+	if err == nil && n < len(b) {
+		err = io.EOF
+	}
+	return n, err
+}
+
+// Reads from off into b
+//
+// Call with the lock held.
+func (f *memFile) readAt(b []byte, off int64) (int, error) {
+	if err := f.check("read", f.readable); err != nil {
+		return 0, err
+	}
+	if f.node.entries != nil {
+		return 0, pathError("read", f.name, syscall.EISDIR)
+	}
+	if off < 0 {
+		return 0, pathError("read", f.name, syscall.EINVAL)
+	}
+	if off >= int64(len(f.node.data)) {
+		if len(b) == 0 {
+			return 0, nil
+		}
+		return 0, io.EOF
+	}
+	return copy(b, f.node.data[off:]), nil
+}
+
+func (f *memFile) Write(b []byte) (int, error) {
+	f.fs.mu.Lock()
+	defer f.fs.mu.Unlock()
+	if err := f.check("write", f.writable); err != nil {
+		return 0, err
+	}
+	if f.append {
+		f.pos = int64(len(f.node.data))
+	}
+	end := f.pos + int64(len(b))
+	if end > int64(len(f.node.data)) {
+		data := make([]byte, end)
+		copy(data, f.node.data)
+		f.node.data = data
+	}
+	copy(f.node.data[f.pos:], b)
+	f.pos = end
+	f.node.modTime = time.Now()
+	return len(b), nil
+}
+
+func (f *memFile) Seek(offset int64, whence int) (int64, error) {
+	f.fs.mu.Lock()
+	defer f.fs.mu.Unlock()
+	if err := f.check("seek", true); err != nil {
+		return 0, err
+	}
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += f.pos
+	case io.SeekEnd:
+		offset += int64(len(f.node.data))
+	default:
+		return 0, pathError("seek", f.name, syscall.EINVAL)
+	}
+	if offset < 0 {
+		return 0, pathError("seek", f.name, syscall.EINVAL)
+	}
+	f.pos = offset
+	return offset, nil
+}
+
+func (f *memFile) Truncate(size int64) error {
+	f.fs.mu.Lock()
+	defer f.fs.mu.Unlock()
+	if err := f.check("truncate", f.writable); err != nil {
+		return err
+	}
+	if size < 0 {
+		return pathError("truncate", f.name, syscall.EINVAL)
+	}
+	data := make([]byte, size)
+	copy(data, f.node.data)
+	f.node.data = data
+	f.node.modTime = time.Now()
+	return nil
+}
+
+func (f *memFile) Close() error {
+	f.fs.mu.Lock()
+	defer f.fs.mu.Unlock()
+	if err := f.check("close", true); err != nil {
+		return err
+	}
+	f.closed = true
+	return nil
+}
@@ -0,0 +1,166 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// A read-only subtree of the real disk
+
+package vfs
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+)
+
+// readOnlyFS is a directory of the real disk which can be read but
+// not changed
+//
+// Names are the real paths of the files so tracebacks and __file__
+// look the same as they do on the real disk, but names outside the
+// directory give PermissionError.  It is built on os.Root so
+// symbolic links can't be used to get out either.
+type readOnlyFS struct {
+	root *os.Root
+	dir  string // absolute path of the root
+
+	mu sync.Mutex
+	wd string // absolute path of the working directory
+}
+
+// NewReadOnly returns a read-only FS of the files in dir and below
+//
+// The working directory starts as dir.
+func NewReadOnly(dir string) (FS, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	root, err := os.OpenRoot(dir)
+	if err != nil {
+		return nil, err
+	}
+	return &readOnlyFS{
+		root: root,
+		dir:  dir,
+		wd:   dir,
+	}, nil
+}
+
+// Returns the path of name relative to the root or an EACCES error
+// for op if it is outside it
+func (r *readOnlyFS) resolve(op, name string) (string, error) {
+	if !filepath.IsAbs(name) {
+		r.mu.Lock()
+		name = filepath.Join(r.wd, name)
+		r.mu.Unlock()
+	}
+	rel, err := filepath.Rel(r.dir, name)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", pathError(op, name, syscall.EACCES)
+	}
+	return rel, nil
+}
+
+// Puts name back into the errors from the os.Root which have the
+// relative path in
+func fixPath(err error, name string) error {
+	var pathErr *fs.PathError
+	if errors.As(err, &pathErr) {
+		return &fs.PathError{Op: pathErr.Op, Path: name, Err: pathErr.Err}
+	}
+	return err
+}
+
+func (r *readOnlyFS) OpenFile(name string, flag int, perm fs.FileMode) (File, error) {
+	if flag&(os.O_WRONLY|os.O_RDWR|os.O_CREATE|os.O_TRUNC|os.O_APPEND) != 0 {
+		return nil, pathError("open", name, syscall.EROFS)
+	}
+	rel, err := r.resolve("open", name)
+	if err != nil {
+		return nil, err
+	}
+	f, err := r.root.OpenFile(rel, flag, 0)
+	if err != nil {
+		return nil, fixPath(err, name)
+	}
+	return f, nil
+}
+
+func (r *readOnlyFS) Stat(name string) (fs.FileInfo, error) {
+	rel, err := r.resolve("stat", name)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := r.root.Stat(rel)
+	return fi, fixPath(err, name)
+}
+
+func (r *readOnlyFS) Lstat(name string) (fs.FileInfo, error) {
+	rel, err := r.resolve("lstat", name)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := r.root.Lstat(rel)
+	return fi, fixPath(err, name)
+}
+
+func (r *readOnlyFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	rel, err := r.resolve("readdirent", name)
+	if err != nil {
+		return nil, err
+	}
+	f, err := r.root.Open(rel)
+	if err != nil {
+		return nil, fixPath(err, name)
+	}
+	defer f.Close()
+	entries, err := f.ReadDir(-1)
+	if err != nil {
+		return nil, fixPath(err, name)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+	return entries, nil
+}
+
+func (r *readOnlyFS) Mkdir(name string, perm fs.FileMode) error {
+	return pathError("mkdir", name, syscall.EROFS)
+}
+
+func (r *readOnlyFS) Remove(name string) error {
+	return pathError("remove", name, syscall.EROFS)
+}
+
+func (r *readOnlyFS) Rename(oldname, newname string) error {
+	return &os.LinkError{Op: "rename", Old: oldname, New: newname, Err: syscall.EROFS}
+}
+
+func (r *readOnlyFS) Getwd() (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.wd, nil
+}
+
+func (r *readOnlyFS) Chdir(dir string) error {
+	fi, err := r.Stat(dir)
+	if err != nil {
+		return err
+	}
+	if !fi.IsDir() {
+		return pathError("chdir", dir, syscall.ENOTDIR)
+	}
+	rel, err := r.resolve("chdir", dir)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	r.wd = filepath.Join(r.dir, rel)
+	r.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,157 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package vfs provides the filesystems python code runs on.
+//
+// Everything python does with files, opening them, importing modules
+// and the os module functions, goes through an FS so an embedder can
+// run untrusted scripts on a read-only view of part of the disk or on
+// an in-memory filesystem instead of the real disk.  The FS is chosen
+// with py.ContextOpts.FS.
+//
+// It is like io/fs but with writes, and the names are OS paths, eg
+// "/usr/lib/python3.4/os.py" or "lib/a.py", rather than the slash
+// separated unrooted paths io/fs uses.  Relative names are relative
+// to the FS's working directory which is changed with Chdir.
+//
+// Errors are *fs.PathError or *os.LinkError wrapping a syscall.Errno
+// where there is one, as the os package returns, so python can raise
+// the matching subclass of OSError.
+package vfs
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"syscall"
+)
+
+// FS is a filesystem
+//
+// Implementations must be safe to use from multiple goroutines and
+// should be comparable, eg pointers, as they are used in map keys by
+// importers which cache what they read.
+type FS interface {
+	// OpenFile opens the named file with the os.O_* flags, creating
+	// it with perm (before umask) if os.O_CREATE is set
+	OpenFile(name string, flag int, perm fs.FileMode) (File, error)
+
+	// Stat returns the FileInfo of the named file following
+	// symbolic links
+	Stat(name string) (fs.FileInfo, error)
+
+	// Lstat returns the FileInfo of the named file without
+	// following a symbolic link at the end of name
+	Lstat(name string) (fs.FileInfo, error)
+
+	// ReadDir returns the entries of the named directory sorted by
+	// name
+	ReadDir(name string) ([]fs.DirEntry, error)
+
+	// Mkdir makes the named directory with perm (before umask)
+	Mkdir(name string, perm fs.FileMode) error
+
+	// Remove removes the named file or empty directory
+	Remove(name string) error
+
+	// Rename renames oldname to newname replacing newname if it
+	// exists and isn't a directory
+	Rename(oldname, newname string) error
+
+	// Getwd returns the absolute path of the working directory
+	Getwd() (string, error)
+
+	// Chdir changes the working directory to dir
+	Chdir(dir string) error
+}
+
+// File is an open file
+//
+// *os.File satisfies it.  Files of the real disk also have an
+// Fd() uintptr method which python's fileno() uses.
+type File interface {
+	fs.File
+	io.Writer
+	io.Seeker
+	io.ReaderAt
+
+	// Truncate changes the size of the file
+	Truncate(size int64) error
+}
+
+// Open opens the named file for reading
+func Open(fsys FS, name string) (File, error) {
+	return fsys.OpenFile(name, os.O_RDONLY, 0)
+}
+
+// ReadFile reads the whole of the named file
+func ReadFile(fsys FS, name string) ([]byte, error) {
+	f, err := Open(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(f)
+}
+
+// WriteFile writes data to the named file, creating it with perm
+// (before umask) if necessary and truncating it if not
+func WriteFile(fsys FS, name string, data []byte, perm fs.FileMode) error {
+	f, err := fsys.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if err1 := f.Close(); err == nil {
+		err = err1
+	}
+	return err
+}
+
+// MkdirAll makes the directory name and any parents of it which
+// don't exist with perm (before umask)
+//
+// It does nothing if name is already a directory.
+func MkdirAll(fsys FS, name string, perm fs.FileMode) error {
+	fi, err := fsys.Stat(name)
+	if err == nil {
+		if fi.IsDir() {
+			return nil
+		}
+		return &fs.PathError{Op: "mkdir", Path: name, Err: syscall.ENOTDIR}
+	}
+	clean := filepath.Clean(name)
+	if parent := filepath.Dir(clean); parent != clean {
+		if err := MkdirAll(fsys, parent, perm); err != nil {
+			return err
+		}
+	}
+	err = fsys.Mkdir(name, perm)
+	if err != nil {
+		// Cope with "name/." and races with another mkdir
+		if fi, err1 := fsys.Lstat(name); err1 == nil && fi.IsDir() {
+			return nil
+		}
+	}
+	return err
+}
+
+// Abs returns the absolute path of name relative to the working
+// directory of fsys
+func Abs(fsys FS, name string) (string, error) {
+	if filepath.IsAbs(name) {
+		return filepath.Clean(name), nil
+	}
+	wd, err := fsys.Getwd()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(wd, name), nil
+}
+
+// Returns the error op on name failed with errno
+func pathError(op, name string, errno syscall.Errno) error {
+	return &fs.PathError{Op: op, Path: name, Err: errno}
+}
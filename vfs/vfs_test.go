@@ -0,0 +1,243 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vfs
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"syscall"
+	"testing"
+)
+
+// Checks err is errno
+func assertErrno(t *testing.T, what string, err error, errno syscall.Errno) {
+	t.Helper()
+	if !errors.Is(err, errno) {
+		t.Errorf("%s: want %v got %v", what, errno, err)
+	}
+}
+
+// Returns the names of the entries of dir
+func readDirNames(t *testing.T, fsys FS, dir string) []string {
+	t.Helper()
+	entries, err := fsys.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := []string{}
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	return names
+}
+
+func TestMemory(t *testing.T) {
+	m := NewMemory()
+
+	if err := MkdirAll(m, "/a/b", 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteFile(m, "/a/f.txt", []byte("hello"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ReadFile(m, "/a/f.txt")
+	if err != nil || string(data) != "hello" {
+		t.Fatalf("ReadFile: %q %v", data, err)
+	}
+	fi, err := m.Stat("/a/f.txt")
+	if err != nil || fi.Size() != 5 || fi.IsDir() || fi.Name() != "f.txt" || fi.Mode() != 0666 {
+		t.Errorf("Stat: %v %v", fi, err)
+	}
+	fi, err = m.Stat("/a")
+	if err != nil || !fi.IsDir() {
+		t.Errorf("Stat dir: %v %v", fi, err)
+	}
+	if got := readDirNames(t, m, "/a"); !reflect.DeepEqual(got, []string{"b", "f.txt"}) {
+		t.Errorf("ReadDir: %v", got)
+	}
+
+	// Reading, writing and seeking
+	f, err := m.OpenFile("/a/f.txt", os.O_RDWR, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 3)
+	if n, err := f.Read(buf); n != 3 || err != nil || string(buf) != "hel" {
+		t.Errorf("Read: %d %v %q", n, err, buf)
+	}
+	if _, err := f.Write([]byte("P!!")); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := f.Read(buf); n != 0 || err != io.EOF {
+		t.Errorf("Read at end: %d %v", n, err)
+	}
+	if pos, err := f.Seek(-2, io.SeekEnd); pos != 4 || err != nil {
+		t.Errorf("Seek: %d %v", pos, err)
+	}
+	if n, err := f.ReadAt(buf, 1); n != 3 || err != nil || string(buf) != "elP" {
+		t.Errorf("ReadAt: %d %v %q", n, err, buf)
+	}
+	if err := f.Truncate(2); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	_, err = f.Read(buf)
+	assertErrno(t, "read closed", err, syscall.EBADF)
+	data, _ = ReadFile(m, "/a/f.txt")
+	if string(data) != "he" {
+		t.Errorf("after truncate: %q", data)
+	}
+
+	// Append and read only files
+	f, err = m.OpenFile("/a/f.txt", os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.Seek(0, io.SeekStart)
+	_, _ = f.Write([]byte("y"))
+	_, err = f.Read(buf)
+	assertErrno(t, "read write only", err, syscall.EBADF)
+	_ = f.Close()
+	data, _ = ReadFile(m, "/a/f.txt")
+	if string(data) != "hey" {
+		t.Errorf("after append: %q", data)
+	}
+	f, _ = Open(m, "/a/f.txt")
+	_, err = f.Write([]byte("x"))
+	assertErrno(t, "write read only", err, syscall.EBADF)
+	_ = f.Close()
+
+	// Errors
+	_, err = m.OpenFile("/a/f.txt", os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0666)
+	assertErrno(t, "create exclusive", err, syscall.EEXIST)
+	_, err = Open(m, "/a/missing")
+	assertErrno(t, "open missing", err, syscall.ENOENT)
+	_, err = m.OpenFile("/a", os.O_WRONLY, 0)
+	assertErrno(t, "open dir for writing", err, syscall.EISDIR)
+	_, err = m.Stat("/a/f.txt/x")
+	assertErrno(t, "stat through file", err, syscall.ENOTDIR)
+	assertErrno(t, "mkdir existing", m.Mkdir("/a/b", 0777), syscall.EEXIST)
+	assertErrno(t, "mkdir missing parent", m.Mkdir("/x/y", 0777), syscall.ENOENT)
+	assertErrno(t, "MkdirAll file", MkdirAll(m, "/a/f.txt", 0777), syscall.ENOTDIR)
+	assertErrno(t, "remove non empty", m.Remove("/a"), syscall.ENOTEMPTY)
+	assertErrno(t, "remove missing", m.Remove("/a/missing"), syscall.ENOENT)
+	assertErrno(t, "rename into itself", m.Rename("/a", "/a/b/a"), syscall.EINVAL)
+	assertErrno(t, "rename file over dir", m.Rename("/a/f.txt", "/a/b"), syscall.EISDIR)
+	assertErrno(t, "rename dir over file", m.Rename("/a/b", "/a/f.txt"), syscall.ENOTDIR)
+	var linkErr *os.LinkError
+	if err := m.Rename("/a/missing", "/a/x"); !errors.As(err, &linkErr) || !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("rename missing: %v", err)
+	}
+
+	// Working directory and relative names
+	if err := m.Chdir("/a"); err != nil {
+		t.Fatal(err)
+	}
+	assertErrno(t, "chdir file", m.Chdir("f.txt"), syscall.ENOTDIR)
+	if wd, _ := m.Getwd(); wd != filepath.FromSlash("/a") {
+		t.Errorf("Getwd: %q", wd)
+	}
+	if err := m.Rename("f.txt", "b/g.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if got := readDirNames(t, m, "b"); !reflect.DeepEqual(got, []string{"g.txt"}) {
+		t.Errorf("ReadDir after rename: %v", got)
+	}
+	if err := m.Chdir(".."); err != nil {
+		t.Fatal(err)
+	}
+	if abs, _ := Abs(m, "a/b"); abs != filepath.FromSlash("/a/b") {
+		t.Errorf("Abs: %q", abs)
+	}
+	if err := m.Remove("a/b/g.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Remove("a/b"); err != nil {
+		t.Fatal(err)
+	}
+	if got := readDirNames(t, m, "a"); len(got) != 0 {
+		t.Errorf("ReadDir after remove: %v", got)
+	}
+}
+
+func TestReadOnly(t *testing.T) {
+	base, err := ioutil.TempDir("", "vfs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(base)
+	base, err = filepath.EvalSymlinks(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := filepath.Join(base, "root")
+	if err := os.MkdirAll(filepath.Join(dir, "sub"), 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "sub", "a.txt"), []byte("inside"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	outside := filepath.Join(base, "outside.txt")
+	if err := ioutil.WriteFile(outside, []byte("outside"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	symlinks := os.Symlink(outside, filepath.Join(dir, "link")) == nil
+
+	r, err := NewReadOnly(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wd, _ := r.Getwd(); wd != dir {
+		t.Errorf("Getwd: %q", wd)
+	}
+	data, err := ReadFile(r, filepath.Join(dir, "sub", "a.txt"))
+	if err != nil || string(data) != "inside" {
+		t.Errorf("ReadFile: %q %v", data, err)
+	}
+	if err := r.Chdir("sub"); err != nil {
+		t.Fatal(err)
+	}
+	data, err = ReadFile(r, "a.txt")
+	if err != nil || string(data) != "inside" {
+		t.Errorf("ReadFile relative: %q %v", data, err)
+	}
+	if got := readDirNames(t, r, "."); !reflect.DeepEqual(got, []string{"a.txt"}) {
+		t.Errorf("ReadDir: %v", got)
+	}
+	assertErrno(t, "chdir file", r.Chdir("a.txt"), syscall.ENOTDIR)
+	assertErrno(t, "chdir outside", r.Chdir("../.."), syscall.EACCES)
+
+	// Nothing outside can be read
+	_, err = ReadFile(r, outside)
+	assertErrno(t, "read outside", err, syscall.EACCES)
+	_, err = ReadFile(r, "../../outside.txt")
+	assertErrno(t, "read outside relative", err, syscall.EACCES)
+	_, err = r.Stat(base)
+	assertErrno(t, "stat outside", err, syscall.EACCES)
+	if symlinks {
+		if _, err = ReadFile(r, filepath.Join(dir, "link")); err == nil {
+			t.Errorf("read through symlink: no error")
+		}
+	}
+
+	// Nothing can be changed
+	_, err = r.OpenFile("a.txt", os.O_RDWR, 0)
+	assertErrno(t, "open for writing", err, syscall.EROFS)
+	_, err = r.OpenFile("new.txt", os.O_WRONLY|os.O_CREATE, 0666)
+	assertErrno(t, "create", err, syscall.EROFS)
+	assertErrno(t, "mkdir", r.Mkdir("d", 0777), syscall.EROFS)
+	assertErrno(t, "remove", r.Remove("a.txt"), syscall.EROFS)
+	assertErrno(t, "rename", r.Rename("a.txt", "b.txt"), syscall.EROFS)
+	if _, err := os.Stat(filepath.Join(dir, "sub", "a.txt")); err != nil {
+		t.Errorf("file went: %v", err)
+	}
+}
@@ -12,7 +12,7 @@ import (
 	"github.com/go-python/gpython/py"
 )
 
-func builtinEvalOrExec(self py.Object, args py.Tuple, kwargs, currentLocals, currentGlobals, builtins py.StringDict, mode string) (py.Object, error) {
+func builtinEvalOrExec(self py.Object, args py.Tuple, kwargs, currentLocals, currentGlobals py.StringDict, builtins *py.Module, mode string) (py.Object, error) {
 	var (
 		cmd     py.Object
 		globals py.Object = py.None
@@ -40,7 +40,8 @@ func builtinEvalOrExec(self py.Object, args py.Tuple, kwargs, currentLocals, cur
 		return nil, py.ExceptionNewf(py.TypeError, "locals must be a dict")
 	}
 
-	// Set __builtins__ if not set
+	// Set __builtins__ if not set so the code runs in the
+	// same context
 	if _, ok := globalsDict["__builtins__"]; !ok {
 		globalsDict["__builtins__"] = builtins
 	}
@@ -72,11 +73,11 @@ func builtinEvalOrExec(self py.Object, args py.Tuple, kwargs, currentLocals, cur
 	return EvalCode(code, globalsDict, localsDict)
 }
 
-func builtinEval(self py.Object, args py.Tuple, kwargs, currentLocals, currentGlobals, builtins py.StringDict) (py.Object, error) {
+func builtinEval(self py.Object, args py.Tuple, kwargs, currentLocals, currentGlobals py.StringDict, builtins *py.Module) (py.Object, error) {
 	return builtinEvalOrExec(self, args, kwargs, currentLocals, currentGlobals, builtins, "eval")
 }
 
-func builtinExec(self py.Object, args py.Tuple, kwargs, currentLocals, currentGlobals, builtins py.StringDict) (py.Object, error) {
+func builtinExec(self py.Object, args py.Tuple, kwargs, currentLocals, currentGlobals py.StringDict, builtins *py.Module) (py.Object, error) {
 	_, err := builtinEvalOrExec(self, args, kwargs, currentLocals, currentGlobals, builtins, "exec")
 	if err != nil {
 		return nil, err
@@ -22,7 +22,6 @@ objects so they can be GCed
 
 import (
 	"fmt"
-	"os"
 	"runtime/debug"
 	"strings"
 
@@ -248,6 +247,13 @@ func do_BINARY_MULTIPLY(vm *Vm, arg int32) error {
 	return vm.setTopAndCheckErr(py.Mul(a, b))
 }
 
+// Implements TOS = TOS1 @ TOS.
+func do_BINARY_MATRIX_MULTIPLY(vm *Vm, arg int32) error {
+	b := vm.POP()
+	a := vm.TOP()
+	return vm.setTopAndCheckErr(py.MatMul(a, b))
+}
+
 // Implements TOS = TOS1 // TOS.
 func do_BINARY_FLOOR_DIVIDE(vm *Vm, arg int32) error {
 	b := vm.POP()
@@ -345,6 +351,13 @@ func do_INPLACE_MULTIPLY(vm *Vm, arg int32) error {
 	return vm.setTopAndCheckErr(py.IMul(a, b))
 }
 
+// Implements in-place TOS = TOS1 @ TOS.
+func do_INPLACE_MATRIX_MULTIPLY(vm *Vm, arg int32) error {
+	b := vm.POP()
+	a := vm.TOP()
+	return vm.setTopAndCheckErr(py.IMatMul(a, b))
+}
+
 // Implements in-place TOS = TOS1 // TOS.
 func do_INPLACE_FLOOR_DIVIDE(vm *Vm, arg int32) error {
 	b := vm.POP()
@@ -445,32 +458,20 @@ func do_DELETE_SUBSCR(vm *Vm, arg int32) error {
 
 // Miscellaneous opcodes.
 
-// PrintExpr controls where the output of PRINT_EXPR goes which is
-// used in the REPL
-var PrintExpr = func(out string) {
-	_, _ = os.Stdout.WriteString(out + "\n")
-}
-
 // Implements the expression statement for the interactive mode. TOS
 // is removed from the stack and printed. In non-interactive mode, an
 // expression statement is terminated with POP_STACK.
 func do_PRINT_EXPR(vm *Vm, arg int32) error {
-	// FIXME this should be calling sys.displayhook
-
-	// Print value except if None
-	// After printing, also assign to '_'
-	// Before, set '_' to None to avoid recursion
 	value := vm.POP()
-	vm.frame.Globals["_"] = py.None
-	if value != py.None {
-		repr, err := py.Repr(value)
-		if err != nil {
-			return err
-		}
-		PrintExpr(fmt.Sprint(repr))
+	var hook py.Object
+	if sys, err := vm.frame.Context.GetModule("sys"); err == nil {
+		hook = sys.Globals["displayhook"]
 	}
-	vm.frame.Globals["_"] = value
-	return nil
+	if hook == nil {
+		return py.ExceptionNewf(py.RuntimeError, "lost sys.displayhook")
+	}
+	_, err := py.Call(hook, py.Tuple{value}, nil)
+	return err
 }
 
 // Terminates a loop due to a break statement.
@@ -610,16 +611,22 @@ func do_YIELD_FROM(vm *Vm, arg int32) error {
 	u := vm.POP()
 	x := vm.TOP()
 	// send u to x
-	if u == py.None {
-		retval, err = py.Next(x)
-	} else {
+	switch x.(type) {
+	case *py.Generator, *py.Coroutine:
 		retval, err = py.Send(x, u)
-
+	default:
+		if u == py.None {
+			retval, err = py.Next(x)
+		} else {
+			retval, err = py.Send(x, u)
+		}
 	}
 	if err != nil {
 		if !py.IsException(py.StopIteration, err) {
 			return err
 		}
+		// The value of the StopIteration is the result
+		vm.SET_TOP(py.StopIterationValue(err))
 		return nil
 	}
 	// x remains on stack, retval is value to be yielded
@@ -681,6 +688,16 @@ func do_IMPORT_STAR(vm *Vm, arg int32) error {
 	return nil
 }
 
+// Checks whether __annotations__ is defined in locals(), if not it is
+// set up to an empty dict. This opcode is only emitted if a class or
+// module body contains variable annotations statically.
+func do_SETUP_ANNOTATIONS(vm *Vm, arg int32) error {
+	if _, ok := vm.frame.Locals["__annotations__"]; !ok {
+		vm.frame.Locals["__annotations__"] = py.NewStringDict()
+	}
+	return nil
+}
+
 // Removes one block from the block stack. Per frame, there is a stack
 // of blocks, denoting nested loops, try statements, and such.
 func do_POP_BLOCK(vm *Vm, arg int32) error {
@@ -767,13 +784,13 @@ func do_END_FINALLY(vm *Vm, arg int32) error {
 // Loads the __build_class__ helper function to the stack which
 // creates a new class object.
 func do_LOAD_BUILD_CLASS(vm *Vm, arg int32) error {
-	vm.PUSH(py.Builtins.Globals["__build_class__"])
+	vm.PUSH(vm.frame.Builtins["__build_class__"])
 	return nil
 }
 
 // This opcode performs several operations before a with block
 // starts. First, it loads __exit__( ) from the context manager and
-// pushes it onto the stack for later use by WITH_CLEANUP. Then,
+// pushes it onto the stack for later use by WITH_CLEANUP_START. Then,
 // __enter__( ) is called, and a finally block pointing to delta is
 // pushed. Finally, the result of calling the enter method is pushed
 // onto the stack. The next opcode will either ignore it (POP_TOP), or
@@ -802,9 +819,19 @@ func do_SETUP_WITH(vm *Vm, delta int32) error {
 	return nil
 }
 
-// Cleans up the stack when a with statement block exits. On top of
-// the stack are 1–3 values indicating how/why the finally clause was
-// entered:
+// Creates a new frame object for an async with statement. TOS is the
+// awaited result of __aenter__ which is kept on the stack above the
+// finally block pointing to delta.
+func do_SETUP_ASYNC_WITH(vm *Vm, delta int32) error {
+	res := vm.POP()
+	vm.frame.PushBlock(py.TryBlockSetupFinally, vm.frame.Lasti+delta, vm.STACK_LEVEL())
+	vm.PUSH(res)
+	return nil
+}
+
+// Starts cleaning up the stack when a with statement block exits. On
+// top of the stack are 1–3 values indicating how/why the finally
+// clause was entered:
 //
 // TOP = None
 // (TOP, SECOND) = (WHY_{RETURN,CONTINUE}), retval
@@ -816,11 +843,10 @@ func do_SETUP_WITH(vm *Vm, delta int32) error {
 // EXIT(None, None, None).
 //
 // EXIT is removed from the stack, leaving the values above it in the
-// same order. In addition, if the stack represents an exception, and
-// the function call returns a ‘true’ value, this information is
-// “zapped”, to prevent END_FINALLY from re-raising the
-// exception. (But non-local gotos should still be resumed.)
-func do_WITH_CLEANUP(vm *Vm, arg int32) error {
+// same order. Then the exception (or None) is pushed followed by the
+// result of the call for WITH_CLEANUP_FINISH. For async with the
+// result is awaited in between.
+func do_WITH_CLEANUP_START(vm *Vm, arg int32) error {
 	var exit_func py.Object
 
 	exc := vm.TOP()
@@ -860,7 +886,7 @@ func do_WITH_CLEANUP(vm *Vm, arg int32) error {
 		   values are lower than it expects. */
 		block := vm.frame.Block
 		if block.Type != py.TryBlockExceptHandler {
-			panic("vm: WITH_CLEANUP expecting TryBlockExceptHandler")
+			panic("vm: WITH_CLEANUP_START expecting TryBlockExceptHandler")
 		}
 		block.Level--
 	}
@@ -869,7 +895,20 @@ func do_WITH_CLEANUP(vm *Vm, arg int32) error {
 	if err != nil {
 		return err
 	}
+	vm.PUSH(exc)
+	vm.PUSH(res)
+	return nil
+}
 
+// Finishes cleaning up the stack when a with statement block
+// exits. Pops the result of calling EXIT and the exception pushed by
+// WITH_CLEANUP_START. If the stack represents an exception, and the
+// function call returned a ‘true’ value, this information is
+// “zapped”, to prevent END_FINALLY from re-raising the
+// exception. (But non-local gotos should still be resumed.)
+func do_WITH_CLEANUP_FINISH(vm *Vm, arg int32) error {
+	res := vm.POP()
+	exc := vm.POP()
 	wasErr := false
 	if exc != py.None {
 		wasErr = res == py.True
@@ -881,6 +920,88 @@ func do_WITH_CLEANUP(vm *Vm, arg int32) error {
 	return nil
 }
 
+// Resolves an async context manager. Looks up __aexit__ and
+// __aenter__ on TOS, replaces TOS with __aexit__ and pushes the
+// result of calling __aenter__, which is awaited next.
+func do_BEFORE_ASYNC_WITH(vm *Vm, arg int32) error {
+	mgr := vm.TOP()
+	enter, err := py.GetAttrString(mgr, "__aenter__")
+	if err != nil {
+		return err
+	}
+	exit, err := py.GetAttrString(mgr, "__aexit__")
+	if err != nil {
+		return err
+	}
+	vm.SET_TOP(exit)
+	res, err := py.Call(enter, nil, nil)
+	if err != nil {
+		return err
+	}
+	vm.PUSH(res)
+	return nil
+}
+
+// Implements TOS = get_awaitable(TOS), where get_awaitable(o)
+// returns o if o is a coroutine object or a generator object with
+// the CO_ITERABLE_COROUTINE flag, or resolves o.__await__.
+func do_GET_AWAITABLE(vm *Vm, arg int32) error {
+	iter, err := py.GetAwaitableIter(vm.TOP())
+	if err != nil {
+		return err
+	}
+	vm.SET_TOP(iter)
+	return nil
+}
+
+// Implements TOS = TOS.__aiter__().
+func do_GET_AITER(vm *Vm, arg int32) error {
+	obj := vm.TOP()
+	var iter py.Object
+	var err error
+	if I, ok := obj.(py.I__aiter__); ok {
+		iter, err = I.M__aiter__()
+	} else if res, ok, e := py.TypeCall0(obj, "__aiter__"); ok {
+		iter, err = res, e
+	} else {
+		return py.ExceptionNewf(py.TypeError, "'async for' requires an object with __aiter__ method, got %s", obj.Type().Name)
+	}
+	if err != nil {
+		return err
+	}
+	if _, ok := iter.(py.I__anext__); !ok {
+		if _, err := py.GetAttrString(iter, "__anext__"); err != nil {
+			return py.ExceptionNewf(py.TypeError, "'async for' received an object from __aiter__ that does not implement __anext__: %s", iter.Type().Name)
+		}
+	}
+	vm.SET_TOP(iter)
+	return nil
+}
+
+// Implements PUSH(get_awaitable(TOS.__anext__())). See GET_AWAITABLE
+// for details about get_awaitable
+func do_GET_ANEXT(vm *Vm, arg int32) error {
+	aiter := vm.TOP()
+	var next py.Object
+	var err error
+	if I, ok := aiter.(py.I__anext__); ok {
+		next, err = I.M__anext__()
+	} else if res, ok, e := py.TypeCall0(aiter, "__anext__"); ok {
+		next, err = res, e
+	} else {
+		return py.ExceptionNewf(py.TypeError, "'async for' requires an iterator with __anext__ method, got %s", aiter.Type().Name)
+	}
+	if err != nil {
+		return err
+	}
+	awaitable, err := py.GetAwaitableIter(next)
+	if err != nil {
+		return py.ExceptionNewf(py.TypeError, "'async for' received an invalid object from __anext__: %s", next.Type().Name)
+	}
+	vm.PUSH(awaitable)
+	return nil
+}
+
 // All of the following opcodes expect arguments. An argument is two bytes, with the more significant byte last.
 
 // Implements name = TOS. namei is the index of name in the attribute
@@ -1048,9 +1169,9 @@ func do_COMPARE_OP(vm *Vm, opname int32) error {
 		in, err = py.SequenceContains(b, a)
 		r = py.NewBool(!in)
 	case PyCmp_IS:
-		r = py.NewBool(a == b)
+		r = py.NewBool(py.Is(a, b))
 	case PyCmp_IS_NOT:
-		r = py.NewBool(a != b)
+		r = py.NewBool(!py.Is(a, b))
 	case PyCmp_EXC_MATCH:
 		if bTuple, ok := b.(py.Tuple); ok {
 			for _, exc := range bTuple {
@@ -1196,6 +1317,9 @@ func do_JUMP_ABSOLUTE(vm *Vm, target int32) error {
 func do_FOR_ITER(vm *Vm, delta int32) error {
 	r, finished := py.Next(vm.TOP())
 	if finished != nil {
+		if !py.IsException(py.StopIteration, finished) {
+			return finished
+		}
 		vm.DROP()
 		vm.frame.Lasti += delta
 	} else {
@@ -1369,6 +1493,195 @@ func do_DELETE_DEREF(vm *Vm, i int32) error {
 	return nil
 }
 
+// Used for implementing formatted literal strings (f-strings). Pops
+// an optional format spec then a value, converts the value as
+// specified by the low bits of flags and pushes the result of
+// formatting it with the format spec.
+func do_FORMAT_VALUE(vm *Vm, flags int32) error {
+	var spec py.Object = py.String("")
+	if flags&FVS_MASK == FVS_HAVE_SPEC {
+		spec = vm.POP()
+	}
+	value := vm.TOP()
+	var err error
+	switch flags & FVC_MASK {
+	case FVC_STR:
+		value, err = py.Str(value)
+	case FVC_REPR:
+		value, err = py.Repr(value)
+	case FVC_ASCII:
+		value, err = py.Repr(value)
+		if err == nil {
+			value = py.String(py.StringEscape(value.(py.String), true))
+		}
+	}
+	if err != nil {
+		return err
+	}
+	// Skip the call to format if the value is already a str and
+	// there is no format spec
+	if _, ok := value.(py.String); !ok || spec.(py.String) != "" {
+		value, err = py.Format(value, spec)
+		if err != nil {
+			return err
+		}
+	}
+	vm.SET_TOP(value)
+	return nil
+}
+
+// Concatenates count strings from the stack and pushes the resulting
+// string onto the stack.
+func do_BUILD_STRING(vm *Vm, count int32) error {
+	var out strings.Builder
+	for _, item := range vm.frame.Stack[len(vm.frame.Stack)-int(count):] {
+		out.WriteString(string(item.(py.String)))
+	}
+	vm.DROPN(int(count))
+	vm.PUSH(py.String(out.String()))
+	return nil
+}
+
+// Concatenates the count iterables on the stack calling fn for each
+// item then drops them
+func (vm *Vm) unpackIterables(count int32, fn func(py.Object)) error {
+	for _, iterable := range vm.frame.Stack[len(vm.frame.Stack)-int(count):] {
+		err := py.Iterate(iterable, func(item py.Object) bool {
+			fn(item)
+			return false
+		})
+		if err != nil {
+			return err
+		}
+	}
+	vm.DROPN(int(count))
+	return nil
+}
+
+// Pops count iterables from the stack, joins them in a single tuple,
+// and pushes the result. Implements iterable unpacking in tuple
+// displays (*x, *y, *z).
+func do_BUILD_TUPLE_UNPACK(vm *Vm, count int32) error {
+	var tuple py.Tuple
+	err := vm.unpackIterables(count, func(item py.Object) {
+		tuple = append(tuple, item)
+	})
+	if err != nil {
+		return err
+	}
+	if tuple == nil {
+		tuple = py.Tuple{}
+	}
+	vm.PUSH(tuple)
+	return nil
+}
+
+// This is similar to BUILD_TUPLE_UNPACK, but pushes a list instead of
+// tuple. Implements iterable unpacking in list displays [*x, *y, *z].
+func do_BUILD_LIST_UNPACK(vm *Vm, count int32) error {
+	list := py.NewList()
+	err := vm.unpackIterables(count, func(item py.Object) {
+		list.Append(item)
+	})
+	if err != nil {
+		return err
+	}
+	vm.PUSH(list)
+	return nil
+}
+
+// This is similar to BUILD_TUPLE_UNPACK, but pushes a set instead of
+// tuple. Implements iterable unpacking in set displays {*x, *y, *z}.
+func do_BUILD_SET_UNPACK(vm *Vm, count int32) error {
+	set := py.NewSet()
+	err := vm.unpackIterables(count, func(item py.Object) {
+		set.Add(item)
+	})
+	if err != nil {
+		return err
+	}
+	vm.PUSH(set)
+	return nil
+}
+
+// Calls fn with each key and value in the mapping
+func forEachMapping(mapping py.Object, fn func(key string, value py.Object) error) error {
+	if d, ok := mapping.(py.StringDict); ok {
+		for k, v := range d {
+			err := fn(k, v)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	keys, ok, err := py.TypeCall0(mapping, "keys")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return py.ExceptionNewf(py.TypeError, "'%s' object is not a mapping", mapping.Type().Name)
+	}
+	return py.Iterate(keys, func(key py.Object) bool {
+		k, ok := key.(py.String)
+		if !ok {
+			err = py.ExceptionNewf(py.TypeError, "keywords must be strings")
+			return true
+		}
+		var value py.Object
+		value, err = py.GetItem(mapping, key)
+		if err == nil {
+			err = fn(string(k), value)
+		}
+		return err != nil
+	})
+}
+
+// Pops count mappings from the stack, merges them into a single
+// dictionary, and pushes the result. Implements dictionary unpacking
+// in dictionary displays {**x, **y, **z}.
+func do_BUILD_MAP_UNPACK(vm *Vm, count int32) error {
+	dict := py.NewStringDict()
+	for _, mapping := range vm.frame.Stack[len(vm.frame.Stack)-int(count):] {
+		err := forEachMapping(mapping, func(key string, value py.Object) error {
+			dict[key] = value
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	vm.DROPN(int(count))
+	vm.PUSH(dict)
+	return nil
+}
+
+// This is similar to BUILD_MAP_UNPACK, but is used for f(**x, **y,
+// **z) call syntax. The lowest byte of oparg is the count of
+// mappings, the relative position of the corresponding callable f is
+// encoded in the second byte of oparg.
+func do_BUILD_MAP_UNPACK_WITH_CALL(vm *Vm, oparg int32) error {
+	numMaps := int(oparg & 0xFF)
+	functionLocation := int((oparg >> 8) & 0xFF)
+	fn := vm.frame.Stack[len(vm.frame.Stack)-(numMaps+functionLocation)]
+	dict := py.NewStringDict()
+	for _, mapping := range vm.frame.Stack[len(vm.frame.Stack)-numMaps:] {
+		err := forEachMapping(mapping, func(key string, value py.Object) error {
+			if _, found := dict[key]; found {
+				return py.ExceptionNewf(py.TypeError, "%s%s got multiple values for keyword argument '%s'", EvalGetFuncName(fn), EvalGetFuncDesc(fn), key)
+			}
+			dict[key] = value
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	vm.DROPN(numMaps)
+	vm.PUSH(dict)
+	return nil
+}
+
 // Logic for the raise statement
 func (vm *Vm) raise(exc, cause py.Object) error {
 	if exc == nil {
@@ -1528,11 +1841,22 @@ func do_EXTENDED_ARG(vm *Vm, ext int32) error {
 	return nil
 }
 
-// Calls a function. argc is interpreted as in CALL_FUNCTION. The top
-// element on the stack contains the variable argument list, followed
-// by keyword and positional arguments.
+// Removes the variable argument list from the stack. It is found
+// below the keyword arguments.
+func (vm *Vm) popStarArgs(argc int32) py.Object {
+	nkwargs := int((argc >> 8) & 0xFF)
+	i := len(vm.frame.Stack) - 2*nkwargs - 1
+	args := vm.frame.Stack[i]
+	copy(vm.frame.Stack[i:], vm.frame.Stack[i+1:])
+	vm.DROP()
+	return args
+}
+
+// Calls a function. argc is interpreted as in CALL_FUNCTION. The
+// keyword arguments are on top of the stack, followed by the variable
+// argument list, followed by the positional arguments.
 func do_CALL_FUNCTION_VAR(vm *Vm, argc int32) error {
-	args := vm.POP()
+	args := vm.popStarArgs(argc)
 	return vm.Call(argc, args, nil)
 }
 
@@ -1546,11 +1870,11 @@ func do_CALL_FUNCTION_KW(vm *Vm, argc int32) error {
 
 // Calls a function. argc is interpreted as in CALL_FUNCTION. The top
 // element on the stack contains the keyword arguments dictionary,
-// followed by the variable-arguments tuple, followed by explicit
-// keyword and positional arguments.
+// followed by explicit keyword arguments, followed by the
+// variable-arguments tuple, followed by the positional arguments.
 func do_CALL_FUNCTION_VAR_KW(vm *Vm, argc int32) error {
 	kwargs := vm.POP()
-	args := vm.POP()
+	args := vm.popStarArgs(argc)
 	return vm.Call(argc, args, kwargs)
 }
 
@@ -1595,10 +1919,10 @@ func callInternal(fn py.Object, args py.Tuple, kwargs py.StringDict, f *py.Frame
 			return py.BuiltinImport(nil, args, kwargs, f.Globals)
 		case py.InternalMethodEval:
 			f.FastToLocals()
-			return builtinEval(nil, args, kwargs, f.Locals, f.Globals, f.Builtins)
+			return builtinEval(nil, args, kwargs, f.Locals, f.Globals, f.Context.Builtins())
 		case py.InternalMethodExec:
 			f.FastToLocals()
-			return builtinExec(nil, args, kwargs, f.Locals, f.Globals, f.Builtins)
+			return builtinExec(nil, args, kwargs, f.Locals, f.Globals, f.Context.Builtins())
 		default:
 			return nil, py.ExceptionNewf(py.SystemError, "Internal method %v not found", x)
 		}
@@ -1658,16 +1982,15 @@ func (vm *Vm) Call(argc int32, starArgs py.Object, starKwargs py.Object) error {
 		if kwargs == nil {
 			kwargs = py.NewStringDict()
 		}
-		// FIXME should be some sort of dictionary iterator...
-		starKwargsDict, ok := starKwargs.(py.StringDict)
-		if !ok {
-			return py.ExceptionNewf(py.SystemError, "FIXME can't use %T as **kwargs", starKwargs)
-		}
-		for k, v := range starKwargsDict {
+		err := forEachMapping(starKwargs, func(k string, v py.Object) error {
 			if _, ok := kwargs[k]; ok {
 				return py.ExceptionNewf(py.TypeError, multipleValues, EvalGetFuncName(fn), EvalGetFuncDesc(fn), k)
 			}
 			kwargs[k] = v
+			return nil
+		})
+		if err != nil {
+			return err
 		}
 	}
 
@@ -1730,9 +2053,78 @@ func (vm *Vm) UnwindExceptHandler(frame *py.Frame, block *py.TryBlock) {
 //
 // This is the equivalent of PyEval_EvalFrame
 func RunFrame(frame *py.Frame) (res py.Object, err error) {
-	var vm = Vm{
-		frame: frame,
+	return runFrame(frame, nil)
+}
+
+// RunFrameThrow resumes a suspended frame by raising exc at the point
+// where it was paused
+//
+// If the frame is paused in a yield from then exc is thrown into the
+// subiterator first and only raised in the frame if the subiterator
+// doesn't handle it.
+//
+// This is the equivalent of gen_throw and the throwflag of
+// PyEval_EvalFrameEx
+func RunFrameThrow(frame *py.Frame, exc error) (res py.Object, err error) {
+	if frame.Lasti <= 0 || int(frame.Lasti) >= len(frame.Code.Code) || OpCode(frame.Code.Code[frame.Lasti]) != YIELD_FROM {
+		return runFrame(frame, exc)
+	}
+	x := frame.Stack[len(frame.Stack)-1]
+	if py.IsException(py.GeneratorExit, exc) {
+		// Close the subiterator then raise GeneratorExit here
+		if I, ok := x.(py.I_close); ok {
+			_, err = I.Close()
+		} else if closeMethod, e := py.GetAttrString(x, "close"); e == nil {
+			_, err = py.Call(closeMethod, nil, nil)
+		}
+		if err != nil {
+			return runFrame(frame, err)
+		}
+		return runFrame(frame, exc)
+	}
+	args := py.Tuple{py.MakeException(exc)}
+	if I, ok := x.(py.I_throw); ok {
+		res, err = I.Throw(args, nil)
+	} else if throwMethod, e := py.GetAttrString(x, "throw"); e == nil {
+		res, err = py.Call(throwMethod, args, nil)
+	} else {
+		return runFrame(frame, exc)
 	}
+	if err == nil {
+		// The subiterator yielded so stay paused in the yield from
+		frame.Yielded = true
+		return res, nil
+	}
+	// The subiterator has finished so pop it and carry on after
+	// the yield from
+	frame.Stack = frame.Stack[:len(frame.Stack)-1]
+	frame.Lasti++
+	if py.IsException(py.StopIteration, err) {
+		frame.Stack = append(frame.Stack, py.StopIterationValue(err))
+		return runFrame(frame, nil)
+	}
+	return runFrame(frame, err)
+}
+
+// runFrame runs the frame, raising throw first if set
+func runFrame(frame *py.Frame, throw error) (res py.Object, err error) {
+	state := getState(frame.Context)
+	vm := state.newVm(frame)
+	frame.Back = nil
+	if vm.back != nil {
+		frame.Back = vm.back.frame
+	}
+
+	// Inherit the exception being handled by the caller so
+	// sys.exc_info and a bare raise work in called functions
+	if vm.back != nil {
+		vm.exc = vm.back.exc
+	}
+	state.current = vm
+	defer func() {
+		state.current = vm.back
+		state.freeVm(vm)
+	}()
 
 	// FIXME need to do this to save the old exeption when we
 	// yield from a generator.  Should save it in the Frame though
@@ -1756,33 +2148,50 @@ func RunFrame(frame *py.Frame) (res py.Object, err error) {
 		return nil, py.ExceptionNewf(py.SystemError, "vm: instruction out of range - code most likely finished already")
 	}
 
+	if state.traceFunc != nil {
+		vm.traceLasti = frame.Lasti
+		if err := vm.trace(TraceCall, py.None); err != nil && throw == nil {
+			throw = err
+		}
+	}
+
 	var opcode OpCode
 	var arg int32
 	opcodes := frame.Code.Code
 	for vm.why == whyNot {
-		if debugging {
-			debugf("* %4d:", frame.Lasti)
+		if throw == nil && state.traceFunc != nil {
+			// Errors from the trace function are raised in the frame
+			throw = vm.traceLine()
 		}
-		opcode = OpCode(opcodes[frame.Lasti])
-		frame.Lasti++
-		if opcode.HAS_ARG() {
-			arg = int32(opcodes[frame.Lasti])
-			frame.Lasti++
-			arg += int32(opcodes[frame.Lasti]) << 8
-			frame.Lasti++
-			if vm.extended {
-				arg += vm.ext << 16
-			}
-			if debugging {
-				debugf(" %v(%d)\n", opcode, arg)
-			}
+		if throw != nil {
+			// Raise the exception thrown into the frame
+			// instead of running the next instruction
+			err, throw = throw, nil
 		} else {
 			if debugging {
-				debugf(" %v\n", opcode)
+				debugf("* %4d:", frame.Lasti)
+			}
+			opcode = OpCode(opcodes[frame.Lasti])
+			frame.Lasti++
+			if opcode.HAS_ARG() {
+				arg = int32(opcodes[frame.Lasti])
+				frame.Lasti++
+				arg += int32(opcodes[frame.Lasti]) << 8
+				frame.Lasti++
+				if vm.extended {
+					arg += vm.ext << 16
+				}
+				if debugging {
+					debugf(" %v(%d)\n", opcode, arg)
+				}
+			} else {
+				if debugging {
+					debugf(" %v\n", opcode)
+				}
 			}
+			vm.extended = false
+			err = jumpTable[opcode](vm, arg)
 		}
-		vm.extended = false
-		err = jumpTable[opcode](&vm, arg)
 		if err != nil {
 			// FIXME shouldn't be doing this - just use err?
 			if errExcInfo, ok := err.(py.ExceptionInfo); ok {
@@ -1927,6 +2336,16 @@ fast_yield:
 	//         swap_exc_state(tstate, f);
 	// }
 
+	if state.traceFunc != nil {
+		retval := vm.retval
+		if retval == nil {
+			retval = py.None
+		}
+		if err := vm.trace(TraceReturn, retval); err != nil {
+			return nil, err
+		}
+	}
+
 	if vm.curexc.IsSet() {
 		return vm.retval, vm.curexc
 	}
@@ -2033,6 +2452,23 @@ func tooManyPositional(co *py.Code, given, defcount int, fastlocals []py.Object)
 		chooseString(given == 1 && kwonly_given == 0, "was", "were"))
 }
 
+// Format an error for positional only arguments passed as keywords
+func positionalOnlyPassedAsKeyword(co *py.Code, kws py.StringDict) error {
+	var names []string
+	for _, name := range co.Varnames[:co.Posonlyargcount] {
+		if _, ok := kws[name]; ok {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	return py.ExceptionNewf(py.TypeError,
+		"%s() got some positional-only arguments passed as keyword arguments: '%s'",
+		co.Name,
+		strings.Join(names, ", "))
+}
+
 func EvalCodeEx(co *py.Code, globals, locals py.StringDict, args []py.Object, kws py.StringDict, defs []py.Object, kwdefs py.StringDict, closure py.Tuple) (retval py.Object, err error) {
 	total_args := int(co.Argcount + co.Kwonlyargcount)
 	n := len(args)
@@ -2073,13 +2509,19 @@ func EvalCodeEx(co *py.Code, globals, locals py.StringDict, args []py.Object, kw
 		}
 	}
 	for keyword, value := range kws {
-		j := 0
+		// Positional only arguments can't be passed by keyword
+		j := int(co.Posonlyargcount)
 		for ; j < total_args; j++ {
 			if co.Varnames[j] == keyword {
 				goto kw_found
 			}
 		}
 		if j >= total_args && kwdict == nil {
+			if co.Posonlyargcount > 0 {
+				if err := positionalOnlyPassedAsKeyword(co, kws); err != nil {
+					return nil, err
+				}
+			}
 			return nil, py.ExceptionNewf(py.TypeError, "%s() got an unexpected keyword argument '%s'", co.Name, keyword)
 		}
 		kwdict[keyword] = value
@@ -2153,6 +2595,12 @@ func EvalCodeEx(co *py.Code, globals, locals py.StringDict, args []py.Object, kw
 		freevars[len(co.Cellvars)+i] = closure[i]
 	}
 
+	if co.Flags&py.CO_COROUTINE != 0 {
+		/* Create a new coroutine that owns the ready to run frame
+		 * and return that as the value. */
+		return py.NewCoroutine(f), nil
+	}
+
 	if co.Flags&py.CO_GENERATOR != 0 {
 		/* Create a new generator that owns the ready to run frame
 		 * and return that as the value. */
@@ -2191,5 +2639,7 @@ func Run(globals, locals py.StringDict, code *py.Code, closure py.Tuple) (res py
 func init() {
 	py.VmRun = Run
 	py.VmRunFrame = RunFrame
+	py.VmRunFrameThrow = RunFrameThrow
 	py.VmEvalCodeEx = EvalCodeEx
+	py.VmExcInfo = ExcInfo
 }
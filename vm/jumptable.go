@@ -29,6 +29,9 @@ func init() {
 
 	jumpTable[UNARY_INVERT] = do_UNARY_INVERT
 
+	jumpTable[BINARY_MATRIX_MULTIPLY] = do_BINARY_MATRIX_MULTIPLY
+	jumpTable[INPLACE_MATRIX_MULTIPLY] = do_INPLACE_MATRIX_MULTIPLY
+
 	jumpTable[BINARY_POWER] = do_BINARY_POWER
 
 	jumpTable[BINARY_MULTIPLY] = do_BINARY_MULTIPLY
@@ -42,6 +45,10 @@ func init() {
 	jumpTable[INPLACE_FLOOR_DIVIDE] = do_INPLACE_FLOOR_DIVIDE
 	jumpTable[INPLACE_TRUE_DIVIDE] = do_INPLACE_TRUE_DIVIDE
 
+	jumpTable[GET_AITER] = do_GET_AITER
+	jumpTable[GET_ANEXT] = do_GET_ANEXT
+	jumpTable[BEFORE_ASYNC_WITH] = do_BEFORE_ASYNC_WITH
+
 	jumpTable[STORE_MAP] = do_STORE_MAP
 	jumpTable[INPLACE_ADD] = do_INPLACE_ADD
 	jumpTable[INPLACE_SUBTRACT] = do_INPLACE_SUBTRACT
@@ -61,6 +68,7 @@ func init() {
 	jumpTable[PRINT_EXPR] = do_PRINT_EXPR
 	jumpTable[LOAD_BUILD_CLASS] = do_LOAD_BUILD_CLASS
 	jumpTable[YIELD_FROM] = do_YIELD_FROM
+	jumpTable[GET_AWAITABLE] = do_GET_AWAITABLE
 
 	jumpTable[INPLACE_LSHIFT] = do_INPLACE_LSHIFT
 	jumpTable[INPLACE_RSHIFT] = do_INPLACE_RSHIFT
@@ -68,10 +76,12 @@ func init() {
 	jumpTable[INPLACE_XOR] = do_INPLACE_XOR
 	jumpTable[INPLACE_OR] = do_INPLACE_OR
 	jumpTable[BREAK_LOOP] = do_BREAK_LOOP
-	jumpTable[WITH_CLEANUP] = do_WITH_CLEANUP
+	jumpTable[WITH_CLEANUP_START] = do_WITH_CLEANUP_START
+	jumpTable[WITH_CLEANUP_FINISH] = do_WITH_CLEANUP_FINISH
 
 	jumpTable[RETURN_VALUE] = do_RETURN_VALUE
 	jumpTable[IMPORT_STAR] = do_IMPORT_STAR
+	jumpTable[SETUP_ANNOTATIONS] = do_SETUP_ANNOTATIONS
 
 	jumpTable[YIELD_VALUE] = do_YIELD_VALUE
 	jumpTable[POP_BLOCK] = do_POP_BLOCK
@@ -142,4 +152,14 @@ func init() {
 	jumpTable[MAP_ADD] = do_MAP_ADD
 
 	jumpTable[LOAD_CLASSDEREF] = do_LOAD_CLASSDEREF
+
+	jumpTable[BUILD_LIST_UNPACK] = do_BUILD_LIST_UNPACK
+	jumpTable[BUILD_MAP_UNPACK] = do_BUILD_MAP_UNPACK
+	jumpTable[BUILD_MAP_UNPACK_WITH_CALL] = do_BUILD_MAP_UNPACK_WITH_CALL
+	jumpTable[BUILD_TUPLE_UNPACK] = do_BUILD_TUPLE_UNPACK
+	jumpTable[BUILD_SET_UNPACK] = do_BUILD_SET_UNPACK
+
+	jumpTable[SETUP_ASYNC_WITH] = do_SETUP_ASYNC_WITH
+	jumpTable[FORMAT_VALUE] = do_FORMAT_VALUE
+	jumpTable[BUILD_STRING] = do_BUILD_STRING
 }
@@ -24,6 +24,9 @@ const (
 
 	UNARY_INVERT OpCode = 15
 
+	BINARY_MATRIX_MULTIPLY  OpCode = 16 // New in Python 3.5
+	INPLACE_MATRIX_MULTIPLY OpCode = 17 // New in Python 3.5
+
 	BINARY_POWER OpCode = 19
 
 	BINARY_MULTIPLY OpCode = 20
@@ -37,6 +40,10 @@ const (
 	INPLACE_FLOOR_DIVIDE OpCode = 28
 	INPLACE_TRUE_DIVIDE  OpCode = 29
 
+	GET_AITER         OpCode = 50 // New in Python 3.5
+	GET_ANEXT         OpCode = 51 // New in Python 3.5
+	BEFORE_ASYNC_WITH OpCode = 52 // New in Python 3.5
+
 	STORE_MAP        OpCode = 54
 	INPLACE_ADD      OpCode = 55
 	INPLACE_SUBTRACT OpCode = 56
@@ -56,18 +63,22 @@ const (
 	PRINT_EXPR       OpCode = 70
 	LOAD_BUILD_CLASS OpCode = 71
 	YIELD_FROM       OpCode = 72
+	GET_AWAITABLE    OpCode = 73 // New in Python 3.5
 
-	INPLACE_LSHIFT OpCode = 75
-	INPLACE_RSHIFT OpCode = 76
-	INPLACE_AND    OpCode = 77
-	INPLACE_XOR    OpCode = 78
-	INPLACE_OR     OpCode = 79
-	BREAK_LOOP     OpCode = 80
-	WITH_CLEANUP   OpCode = 81
+	INPLACE_LSHIFT      OpCode = 75
+	INPLACE_RSHIFT      OpCode = 76
+	INPLACE_AND         OpCode = 77
+	INPLACE_XOR         OpCode = 78
+	INPLACE_OR          OpCode = 79
+	BREAK_LOOP          OpCode = 80
+	WITH_CLEANUP_START  OpCode = 81 // New in Python 3.5
+	WITH_CLEANUP_FINISH OpCode = 82 // New in Python 3.5
 
 	RETURN_VALUE OpCode = 83
 	IMPORT_STAR  OpCode = 84
 
+	SETUP_ANNOTATIONS OpCode = 85 // New in Python 3.6
+
 	YIELD_VALUE OpCode = 86
 	POP_BLOCK   OpCode = 87
 	END_FINALLY OpCode = 88
@@ -143,6 +154,27 @@ const (
 	MAP_ADD     OpCode = 147
 
 	LOAD_CLASSDEREF OpCode = 148 // New in Python 3.4
+
+	BUILD_LIST_UNPACK          OpCode = 149 // Number of iterables - new in Python 3.5
+	BUILD_MAP_UNPACK           OpCode = 150 // Number of mappings - new in Python 3.5
+	BUILD_MAP_UNPACK_WITH_CALL OpCode = 151 // Number of mappings + (function position<<8) - new in Python 3.5
+	BUILD_TUPLE_UNPACK         OpCode = 152 // Number of iterables - new in Python 3.5
+	BUILD_SET_UNPACK           OpCode = 153 // Number of iterables - new in Python 3.5
+
+	SETUP_ASYNC_WITH OpCode = 154 // New in Python 3.5
+	FORMAT_VALUE     OpCode = 155 // New in Python 3.6
+	BUILD_STRING     OpCode = 157 // New in Python 3.6
+)
+
+// Flags for FORMAT_VALUE
+const (
+	FVC_MASK      = 0x3 // Mask for the conversion
+	FVC_NONE      = 0x0
+	FVC_STR       = 0x1
+	FVC_REPR      = 0x2
+	FVC_ASCII     = 0x3
+	FVS_MASK      = 0x4 // Mask for the format spec
+	FVS_HAVE_SPEC = 0x4 // Format spec is on the stack
 )
 
 // Rich comparison opcodes
@@ -0,0 +1,70 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+doc="module annotations"
+x: int = 1
+y: "str"
+assert x == 1
+assert __annotations__['x'] == int
+assert __annotations__['y'] == "str"
+ok = False
+try:
+    y
+except NameError:
+    ok = True
+assert ok, "NameError not raised"
+
+(z): int = 2
+assert z == 2
+assert 'z' not in __annotations__
+
+doc="class annotations"
+class A:
+    a: int
+    b: str = "hello"
+    if True:
+        c: float = 1.5
+assert A.__annotations__ == {'a': int, 'b': str, 'c': float}
+assert A.b == "hello"
+assert A.c == 1.5
+
+class B:
+    pass
+ok = False
+try:
+    B.__annotations__
+except AttributeError:
+    ok = True
+assert ok, "AttributeError not raised"
+
+doc="attribute and subscript annotations"
+class C:
+    pass
+obj = C()
+obj.attr: int = 3
+assert obj.attr == 3
+d = {}
+d['k']: int = 4
+assert d['k'] == 4
+d['j']: int
+assert 'j' not in d
+assert 'attr' not in __annotations__
+
+doc="function annotations"
+def fn():
+    v: undefined_name
+    w: int = 5
+    return w
+assert fn() == 5
+
+doc="evaluation order"
+order = []
+def note(v):
+    order.append(v)
+    return v
+class D:
+    e: note("ann") = note("value")
+assert order == ["value", "ann"]
+
+doc="finished"
@@ -0,0 +1,73 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+doc="Assignment expressions"
+assert (a := 1) == 1
+assert a == 1
+b = (c := 2) + 1
+assert b == 3 and c == 2
+
+if (n := len([1, 2, 3])) > 2:
+    pass
+assert n == 3
+
+values = iter([0, 1, 2, 3])
+out = []
+while (v := next(values)) != 3:
+    out.append(v)
+assert out == [0, 1, 2]
+
+def f(x):
+    return x * 2
+assert [y := f(1), y ** 2] == [2, 4]
+assert f(z := 5) == 10 and z == 5
+
+doc="Comprehensions at module level"
+total = 0
+[total := total + x for x in (1, 2, 3)]
+assert total == 6
+assert [last := x for x in range(4)] == [0, 1, 2, 3]
+assert last == 3
+assert [(w := x) for x in (7, 8) if x] == [7, 8]
+assert w == 8
+
+doc="Comprehensions in functions"
+def fn():
+    [m := x for x in ("a", "b", "c")]
+    return m
+assert fn() == "c"
+
+def fn2():
+    found = None
+    if any((found := x) > 2 for x in (1, 2, 3, 4)):
+        return found
+assert fn2() == 3
+
+def fn3():
+    return [[(q := x + y) for y in (1, 2)] for x in (10, 20)], q
+assert fn3() == ([[11, 12], [21, 22]], 22)
+
+def fn4():
+    # The target in a lambda is local to the lambda
+    g = [lambda: (r := x) for x in (1, 2)]
+    return [h() for h in g]
+assert fn4() == [2, 2]
+
+doc="Syntax errors"
+for src, msg in (
+    ("a := 1", "invalid syntax"),
+    ("(a.b := 1)", "cannot use named assignment with attribute"),
+    ("[x := 0 for x in y]", "assignment expression cannot rebind comprehension iteration variable 'x'"),
+    ("[x for x in (y := [1])]", "assignment expression cannot be used in a comprehension iterable expression"),
+    ("class A:\n    [(y := x) for x in z]\n", "assignment expression within a comprehension cannot be used in a class body"),
+):
+    ok = False
+    try:
+        compile(src, "<string>", "exec")
+    except SyntaxError as e:
+        assert e.args[0] == msg, e.args[0]
+        ok = True
+    assert ok, "SyntaxError not raised for %s" % src
+
+doc="finished"
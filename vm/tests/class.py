@@ -60,4 +60,67 @@ assert c.method1(1) == 2
 # c = x()
 # assert c.method1(1) == 2
 
+doc="class attributes inherited from a base class"
+class Base:
+    X = 1
+    def method(self):
+        return 2
+class Derived(Base):
+    pass
+assert Derived.X == 1
+assert Derived.method(Derived()) == 2
+
+doc="special methods inherited from a base class"
+class Sized:
+    def __len__(self):
+        return 3
+    def __iter__(self):
+        return iter((1, 2, 3))
+class SizedChild(Sized):
+    pass
+c = SizedChild()
+assert len(c) == 3
+assert list(c) == [1, 2, 3]
+total = 0
+for x in c:
+    total += x
+assert total == 6
+
+doc="operators defined in python"
+class Num:
+    def __init__(self, n):
+        self.n = n
+    def __add__(self, other):
+        return Num(self.n + other)
+    def __radd__(self, other):
+        return Num(other + self.n)
+    def __iadd__(self, other):
+        self.n += other
+        return self
+    def __neg__(self):
+        return Num(-self.n)
+    def __eq__(self, other):
+        return self.n == other.n
+    def __lt__(self, other):
+        return self.n < other.n
+assert (Num(1) + 2).n == 3
+assert (2 + Num(1)).n == 3
+assert (-Num(1)).n == -1
+x = Num(1)
+y = x
+x += 1
+assert y.n == 2
+assert Num(1) == Num(1)
+assert Num(1) != Num(2)
+assert Num(1) < Num(2)
+assert Num(2) > Num(1)
+
+doc="__doc__ set from the docstring or None"
+class Documented:
+    "the docstring"
+assert Documented.__doc__ == "the docstring"
+class Undocumented:
+    pass
+assert Undocumented.__doc__ is None
+
 doc="finished"
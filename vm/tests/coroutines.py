@@ -0,0 +1,225 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+class Suspend:
+    """Awaitable which suspends the coroutine yielding value"""
+    def __init__(self, value):
+        self.value = value
+    def __await__(self):
+        sent = yield self.value
+        return sent
+
+def run(coro, sends=None):
+    """Drive coro returning the values it yielded and its result"""
+    yielded = []
+    i = 0
+    try:
+        value = coro.send(None)
+        while True:
+            yielded.append(value)
+            sent = None
+            if sends:
+                sent = sends[i]
+                i += 1
+            value = coro.send(sent)
+    except StopIteration as e:
+        return yielded, e.value
+
+doc="async def"
+async def add(a, b):
+    return a + b
+c = add(1, 2)
+assert repr(type(c)) == "<class 'coroutine'>"
+assert run(c) == ([], 3)
+
+doc="await"
+async def double(x):
+    y = await Suspend(x)
+    return y * 2
+async def outer():
+    a = await double(1)
+    b = await double(2)
+    return a + b
+assert run(outer(), [10, 20]) == ([1, 2], 60)
+
+doc="not iterable"
+c = add(1, 2)
+ok = False
+try:
+    iter(c)
+except TypeError:
+    ok = True
+assert ok, "TypeError not raised"
+c.close()
+
+doc="reuse"
+c = add(1, 2)
+run(c)
+ok = False
+try:
+    c.send(None)
+except RuntimeError as e:
+    ok = e.args[0] == "cannot reuse already awaited coroutine"
+assert ok, "RuntimeError not raised"
+
+doc="__await__"
+c = add(3, 4)
+w = c.__await__()
+ok = False
+try:
+    next(w)
+except StopIteration as e:
+    ok = e.value == 7
+assert ok, "StopIteration not raised"
+
+doc="throw"
+async def catcher():
+    try:
+        await Suspend(1)
+    except ValueError as e:
+        return "caught " + e.args[0]
+c = catcher()
+assert c.send(None) == 1
+ok = False
+try:
+    c.throw(ValueError("potato"))
+except StopIteration as e:
+    ok = e.value == "caught potato"
+assert ok, "StopIteration not raised"
+
+doc="close"
+state = "not started"
+async def closer():
+    global state
+    try:
+        state = "started"
+        await Suspend(1)
+    finally:
+        state = "finally"
+c = closer()
+c.send(None)
+assert state == "started"
+c.close()
+assert state == "finally"
+
+doc="not awaitable"
+async def bad():
+    await 1
+ok = False
+try:
+    run(bad())
+except TypeError as e:
+    ok = e.args[0] == "object int can't be used in 'await' expression"
+assert ok, "TypeError not raised"
+
+doc="async with"
+events = []
+class Manager:
+    def __init__(self, suppress):
+        self.suppress = suppress
+    async def __aenter__(self):
+        events.append("enter")
+        await Suspend("enter")
+        return self
+    async def __aexit__(self, exc_type, exc, tb):
+        events.append(exc_type)
+        await Suspend("exit")
+        return self.suppress
+async def with_ok():
+    async with Manager(False) as m:
+        events.append("body")
+    return "done"
+assert run(with_ok()) == (["enter", "exit"], "done")
+assert events == ["enter", "body", None]
+
+events = []
+async def with_suppress():
+    async with Manager(True):
+        raise KeyError
+    return "suppressed"
+assert run(with_suppress()) == (["enter", "exit"], "suppressed")
+assert events == ["enter", KeyError]
+
+events = []
+async def with_raise():
+    async with Manager(False), Manager(False):
+        raise KeyError
+ok = False
+try:
+    run(with_raise())
+except KeyError:
+    ok = True
+assert ok, "KeyError not raised"
+assert events == ["enter", "enter", KeyError, KeyError]
+
+doc="async for"
+class Counter:
+    def __init__(self, n):
+        self.i = 0
+        self.n = n
+    def __aiter__(self):
+        return self
+    async def __anext__(self):
+        if self.i >= self.n:
+            raise StopAsyncIteration
+        self.i += 1
+        await Suspend(self.i)
+        return self.i
+async def for_loop():
+    total = 0
+    async for i in Counter(4):
+        if i == 2:
+            continue
+        total += i
+    else:
+        total += 100
+    return total
+assert run(for_loop()) == ([1, 2, 3, 4], 108)
+
+async def for_break():
+    async for i in Counter(10):
+        if i == 3:
+            break
+    else:
+        return "else"
+    return i
+assert run(for_break()) == ([1, 2, 3], 3)
+
+doc="async for errors"
+async def for_bad():
+    async for i in 1:
+        pass
+ok = False
+try:
+    run(for_bad())
+except TypeError:
+    ok = True
+assert ok, "TypeError not raised"
+
+doc="coroutine raised StopIteration"
+async def stopper():
+    raise StopIteration
+ok = False
+try:
+    run(stopper())
+except RuntimeError as e:
+    ok = e.args[0] == "coroutine raised StopIteration"
+assert ok, "RuntimeError not raised"
+
+doc="syntax errors"
+for src, msg in (
+    ("await x", "'await' outside function"),
+    ("def f():\n    await x\n", "'await' outside async function"),
+    ("async def f():\n    yield 1\n", "'yield' inside async function"),
+    ("def f():\n    async with x:\n        pass\n", "'async with' outside async function"),
+    ("def f():\n    async for x in y:\n        pass\n", "'async for' outside async function"),
+):
+    ok = False
+    try:
+        compile(src, "<string>", "exec")
+    except SyntaxError as e:
+        ok = e.args[0] == msg
+    assert ok, "SyntaxError not raised for " + src
+
+doc="finished"
@@ -0,0 +1,100 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+doc="simple"
+a = 42
+b = "potato"
+assert f"" == ""
+assert f"abc" == "abc"
+assert f"{a}" == "42"
+assert f"a={a} b={b}" == "a=42 b=potato"
+assert f"{a+1}{a*2}" == "4384"
+
+doc="braces"
+assert f"{{}}" == "{}"
+assert f"{{{a}}}" == "{42}"
+assert f"{ {'x': 1}['x'] }" == "1"
+
+doc="quotes"
+assert f"{'abc'}" == "abc"
+assert f'{"abc"}' == "abc"
+assert f"""{'a' + "b"}""" == "ab"
+assert f'''{"""c"""}''' == "c"
+
+doc="conversions"
+assert f"{b!r}" == "'potato'"
+assert f"{b!s}" == "potato"
+assert f"{'안'!a}" == "'\\uc548'"
+assert f"{a!r:>5}" == "   42"
+w = 6
+assert f"x{a!r:>{w}}y" == "x    42y"
+assert f"{a!s}{b!a:10}" == "42'potato'  "
+assert f"{a != 1}" == "True"
+
+doc="format specs"
+assert f"{a:5}" == "   42"
+assert f"{a:<5}|" == "42   |"
+assert f"{b:^10}" == "  potato  "
+assert f"{3.14159:.2f}" == "3.14"
+width, precision = 10, 3
+assert f"{3.14159:{width}.{precision}}" == "      3.14"
+assert f"{b:>{width}}" == "    potato"
+
+doc="concatenation"
+assert "a" f"{a}" "b" == "a42b"
+assert f"{a}" f"{b}" == "42potato"
+assert f"x" "y" == "xy"
+
+doc="escapes"
+assert f"\t{a}\n" == "\t42\n"
+assert rf"\t{a}" == "\\t42"
+assert len(f"\n") == 1
+
+doc="expressions"
+def double(x):
+    return x * 2
+assert f"{double(a)}" == "84"
+assert f"{[x for x in range(3)]}" == "[0, 1, 2]"
+assert f"{(lambda x: x + 1)(1)}" == "2"
+assert f"{a if a else 0}" == "42"
+L = [1, 2, 3]
+assert f"{L[1]}" == "2"
+
+doc="closures"
+def outer():
+    x = "inner"
+    def f():
+        return f"{x}!"
+    return f()
+assert outer() == "inner!"
+
+doc="__format__"
+class C:
+    def __format__(self, spec):
+        return "C<" + spec + ">"
+    def __repr__(self):
+        return "C()"
+c = C()
+assert f"{c}" == "C<>"
+assert f"{c:xyz}" == "C<xyz>"
+assert f"{c!r}" == "C()"
+assert f"{c!r:>4}" == " C()"
+
+doc="errors"
+ok = False
+try:
+    f"{a:abc}"
+except ValueError:
+    ok = True
+assert ok, "ValueError not raised"
+
+for src in ['f"{}"', 'f"}"', 'f"{a!x}"', 'f"{a"', 'f"{a#}"', 'f"{a:{b:{c}}}"']:
+    ok = False
+    try:
+        compile(src, "<string>", "eval")
+    except SyntaxError:
+        ok = True
+    assert ok, "SyntaxError not raised for " + src
+
+doc="finished"
@@ -57,6 +57,17 @@ fn5 = counter5(1)
 assert fn5() == 2
 assert fn5() == 3
 
+doc="closure7 with cell and free variables"
+def closure7():
+    x = 1
+    def middle():
+        y = 2
+        def inner():
+            return (x, y)
+        return inner
+    return middle()()
+assert closure7() == (1, 2)
+
 
 doc="del_deref6"
 def del_deref6(initial):
@@ -238,6 +249,57 @@ ck(fn16_4, "fn16_4() missing 1 required keyword-only argument: 'a'")
 ck(fn16_5, "fn16_5() missing 2 required keyword-only arguments: 'a' and 'b'")
 ck(fn16_6, "fn16_6() missing 3 required keyword-only arguments: 'a', 'b', and 'c'")
 
-#FIXME decorators
+doc="Positional only arguments fn17"
+def fn17_0(a, /):
+    return a
+def fn17_1(a, b=2, /, c=3, *, d=4):
+    return (a, b, c, d)
+def fn17_2(a, /, **kwargs):
+    return (a, kwargs)
+def fn17_3(a, b, /, c):
+    return (a, b, c)
+assert fn17_0(1) == 1
+assert fn17_1(1) == (1, 2, 3, 4)
+assert fn17_1(1, 5, 6, d=7) == (1, 5, 6, 7)
+assert fn17_1(1, c=8) == (1, 2, 8, 4)
+assert fn17_2(1, a=2) == (1, {'a': 2})
+assert fn17_3(1, 2, c=3) == (1, 2, 3)
+assert (lambda a, /, b: (a, b))(1, b=2) == (1, 2)
+assert fn17_1.__code__.co_argcount == 3
+assert fn17_1.__code__.co_posonlyargcount == 2
+assert fn17_1.__code__.co_kwonlyargcount == 1
+ck(fn17_0, "fn17_0() got some positional-only arguments passed as keyword arguments: 'a'", a=1)
+ck(fn17_1, "fn17_1() got some positional-only arguments passed as keyword arguments: 'a, b'", a=1, b=2)
+ck(fn17_3, "fn17_3() got some positional-only arguments passed as keyword arguments: 'b'", 1, b=2, c=3)
+ck(fn17_0, "fn17_0() missing 1 required positional argument: 'a'")
+ck(fn17_0, "fn17_0() takes 1 positional argument but 2 were given", 1, 2)
+
+doc="Decorators fn18"
+def twice(fn):
+    def wrapper(*args):
+        return fn(fn(*args))
+    return wrapper
+@twice
+def fn18_0(a, b=1):
+    return a + b
+assert fn18_0(1) == 3
+class Deco:
+    def add(self, n):
+        def deco(fn):
+            return lambda: fn() + n
+        return deco
+deco = Deco()
+@deco.add(2)
+def fn18_1(x=1):
+    return 1
+assert fn18_1() == 3
+
+doc="Argument used by a closure fn19"
+def fn19(a, *args, b=1, **kwargs):
+    c = a
+    def inner():
+        return a, args, b, kwargs
+    return c, inner()
+assert fn19(1, 2, b=3, d=4) == (1, (1, (2,), 3, {'d': 4}))
 
 doc="finished"
@@ -76,15 +76,88 @@ assert state == "started"
 assert next(generator) == None
 assert state == "started"
 
-# FIXME not implemented
-# err = ValueError("potato")
-# e = generator.throw(ValueError, "potato")
-# assert isinstance(e, ValueError)
-# assert state == "started"
-
-# FIXME not implemented
-# generator.close()
-# assert state == "finally"
+e = generator.throw(ValueError, "potato")
+assert type(e) == ValueError
+assert e.args[0] == "potato"
+assert state == "started"
+
+generator.close()
+assert state == "finally"
+
+doc="throw"
+def g4():
+    try:
+        yield 1
+    except KeyError:
+        yield "caught"
+g = g4()
+assert next(g) == 1
+assert g.throw(KeyError) == "caught"
+ok = False
+try:
+    g.throw(IndexError("x"))
+except IndexError as e:
+    ok = e.args[0] == "x"
+assert ok, "IndexError not raised"
+ok = False
+try:
+    g.throw(IndexError, 1)
+except IndexError:
+    ok = True
+assert ok, "IndexError not raised on finished generator"
+
+doc="throw into yield from"
+g = g3()
+assert next(g) == "potato"
+assert next(g) == 1
+ok = False
+try:
+    g.throw(KeyError)
+except KeyError:
+    ok = True
+assert ok, "KeyError not raised"
+def g5():
+    r = yield from g4()
+    yield r
+g = g5()
+assert next(g) == 1
+assert g.throw(KeyError) == "caught"
+assert next(g) == None
+
+doc="close"
+def g6():
+    try:
+        yield 1
+    except GeneratorExit:
+        yield 2
+g = g6()
+next(g)
+ok = False
+try:
+    g.close()
+except RuntimeError as e:
+    ok = e.args[0] == "generator ignored GeneratorExit"
+assert ok, "RuntimeError not raised"
+g = g6()
+assert g.close() == None
+assert g.close() == None
+
+doc="return value"
+def g7():
+    yield 1
+    return "potato"
+def g8():
+    r = yield from g7()
+    yield r
+assert list(g8()) == [1, "potato"]
+g = g7()
+next(g)
+ok = False
+try:
+    next(g)
+except StopIteration as e:
+    ok = e.value == "potato"
+assert ok, "StopIteration value not set"
 
 
 doc="finished"
@@ -88,4 +88,18 @@ for i in (1,2,3,4,5):
 assert a == 12
 assert ok
 
+doc="Errors from an iterator are raised from the for loop"
+class Broken:
+    def __iter__(self):
+        return self
+    def __next__(self):
+        raise ValueError("broken")
+ok = False
+try:
+    for i in Broken():
+        pass
+except ValueError:
+    ok = True
+assert ok
+
 doc="finished"
@@ -93,6 +93,14 @@ assert _2 in (1,2,3)
 assert _100 not in (1,2,3)
 assert True is True
 assert True is not False
+t = (1, 2)
+assert t is t
+assert t is not (1, 2, 3)
+d = {}
+assert d is d
+assert d is not {}
+b = b"abc"
+assert b is b and b is not t
 # FIXME EXC_MATCH
 
 doc="Multiple comparison"
@@ -132,4 +140,13 @@ assert (t or f or t) == True
 assert (t or t or f) == True
 assert (t or t or t) == True
 
+doc="underscores in numbers"
+assert 1_000_000 == 1000000
+assert 0x_ff_ff == 65535
+assert 0o_7_7 == 63
+assert 0b_1_0 == 2
+assert 1_0.2_5 == 10.25
+assert 1_0e1_0 == 1e11
+assert 1_0j == 10j
+
 doc="finished"
@@ -0,0 +1,107 @@
+# Copyright 2018 The go-python Authors.  All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+doc="BUILD_TUPLE_UNPACK"
+a = (1, 2)
+b = [3]
+assert (*a,) == (1, 2)
+assert (*a, *b) == (1, 2, 3)
+assert (0, *a, 9, *b, *"xy") == (0, 1, 2, 9, 3, "x", "y")
+assert (*[],) == ()
+
+doc="BUILD_LIST_UNPACK"
+assert [*a] == [1, 2]
+assert [*a, *b, 4] == [1, 2, 3, 4]
+assert [*range(3), *a] == [0, 1, 2, 1, 2]
+
+doc="BUILD_SET_UNPACK"
+assert {*a} == {1, 2}
+assert {*a, *b, 1, 4} == {1, 2, 3, 4}
+
+doc="BUILD_MAP_UNPACK"
+x = {'a': 1, 'b': 2}
+y = {'b': 3, 'c': 4}
+assert {**x} == {'a': 1, 'b': 2}
+assert {**x, **y} == {'a': 1, 'b': 3, 'c': 4}
+assert {'b': 0, **x, 'd': 5} == {'a': 1, 'b': 2, 'd': 5}
+assert {**x, 'a': 0} == {'a': 0, 'b': 2}
+z = {**x}
+z['a'] = 100
+assert x['a'] == 1
+
+ok = False
+try:
+    {**1}
+except TypeError as e:
+    assert e.args[0] == "'int' object is not a mapping"
+    ok = True
+assert ok, "TypeError not raised"
+
+doc="CALL_FUNCTION with multiple unpackings"
+def fn(*args, **kwargs):
+    return args, kwargs
+assert fn(*a, *b) == ((1, 2, 3), {})
+assert fn(0, *a, 4, *b) == ((0, 1, 2, 4, 3), {})
+assert fn(*a, c=5) == ((1, 2), {'c': 5})
+assert fn(c=5, *a) == ((1, 2), {'c': 5})
+assert fn(**x, c=4, **{'d': 5}) == ((), {'a': 1, 'b': 2, 'c': 4, 'd': 5})
+assert fn(1, *a, d=1, **x, e=2) == ((1, 1, 2), {'a': 1, 'b': 2, 'd': 1, 'e': 2})
+
+def fn2(a, b, c, d):
+    return a, b, c, d
+assert fn2(*[1], *[2, 3], **{'d': 4}) == (1, 2, 3, 4)
+
+ok = False
+try:
+    fn(**x, **y)
+except TypeError as e:
+    assert e.args[0] == "fn() got multiple values for keyword argument 'b'"
+    ok = True
+assert ok, "TypeError not raised"
+
+ok = False
+try:
+    fn(a=1, **x)
+except TypeError as e:
+    assert e.args[0] == "fn() got multiple values for keyword argument 'a'"
+    ok = True
+assert ok, "TypeError not raised"
+
+doc="class definition with unpacking"
+class A(*(), **{}):
+    pass
+
+doc="syntax errors"
+for src, msg in (
+    ("f(**a, *b)", "iterable argument unpacking follows keyword argument unpacking"),
+    ("f(**a, b)", "positional argument follows keyword argument unpacking"),
+    ("f(a=1, b)", "positional argument follows keyword argument"),
+):
+    ok = False
+    try:
+        compile(src, "<string>", "eval")
+    except SyntaxError as e:
+        assert e.args[0] == msg, e.args[0]
+        ok = True
+    assert ok, "SyntaxError not raised for %s" % src
+
+doc="BINARY_MATRIX_MULTIPLY"
+ok = False
+try:
+    1 @ 2
+except TypeError as e:
+    assert e.args[0] == "unsupported operand type(s) for @: 'int' and 'int'"
+    ok = True
+assert ok, "TypeError not raised"
+
+doc="INPLACE_MATRIX_MULTIPLY"
+ok = False
+try:
+    c = 1
+    c @= 2
+except TypeError:
+    ok = True
+assert ok, "TypeError not raised"
+
+doc="finished"
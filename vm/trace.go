@@ -0,0 +1,116 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Tracing support for debuggers
+
+package vm
+
+import (
+	"github.com/go-python/gpython/py"
+)
+
+// Events passed to a TraceFunc
+const (
+	TraceCall   = "call"   // a frame is about to start or resume running
+	TraceLine   = "line"   // a new line is about to run
+	TraceReturn = "return" // a frame is returning or yielding
+)
+
+// TraceFunc is called by the vm as python code runs when set with
+// SetTrace
+//
+// event is one of TraceCall, TraceLine or TraceReturn.  arg is None
+// except for TraceReturn where it is the value being returned or
+// yielded, or None if the frame is returning because of an exception.
+//
+// If the TraceFunc returns an error it is raised in the frame.  The
+// TraceFunc isn't called for code it runs itself.
+type TraceFunc func(frame *py.Frame, event string, arg py.Object) error
+
+// SetTrace sets the trace function for the code running in ctx, or
+// turns tracing off if fn is nil
+func SetTrace(ctx *py.Context, fn TraceFunc) {
+	state := getState(ctx)
+	if state.traceFunc == nil && fn != nil {
+		// Note where the running frames are so jumps can be spotted
+		for vm := state.current; vm != nil; vm = vm.back {
+			vm.traceLasti = vm.frame.Lasti
+		}
+	}
+	state.traceFunc = fn
+}
+
+// GetTrace returns the trace function set with SetTrace for ctx or
+// nil
+func GetTrace(ctx *py.Context) TraceFunc {
+	return getState(ctx).traceFunc
+}
+
+// CurrentFrame returns the frame of the innermost python code running
+// in ctx or nil if no python code is running
+func CurrentFrame(ctx *py.Context) *py.Frame {
+	current := getState(ctx).current
+	if current == nil {
+		return nil
+	}
+	return current.frame
+}
+
+// Calls the trace function if it is set and not already running
+func (vm *Vm) trace(event string, arg py.Object) error {
+	state := vm.state
+	if state.traceFunc == nil || state.tracing {
+		return nil
+	}
+	state.tracing = true
+	defer func() {
+		state.tracing = false
+	}()
+	return state.traceFunc(vm.frame, event, arg)
+}
+
+// Returns which instructions of co start a line according to its
+// line number table
+//
+// The table is worked out the first time co is traced in the context
+// and kept so tracing doesn't rescan the line numbers every
+// instruction.
+func (state *threadState) lineStarts(co *py.Code) []bool {
+	starts, ok := state.lines[co]
+	if ok {
+		return starts
+	}
+	starts = make([]bool, len(co.Code))
+	starts[0] = true
+	addr := 0
+	for i := 0; i < len(co.Lnotab); i += 2 {
+		addr += int(co.Lnotab[i])
+		if addr >= len(starts) {
+			break
+		}
+		if co.Lnotab[i+1] != 0 {
+			starts[addr] = true
+		}
+	}
+	if state.lines == nil {
+		state.lines = make(map[*py.Code][]bool)
+	}
+	state.lines[co] = starts
+	return starts
+}
+
+// Calls the trace function with a TraceLine event if the instruction
+// about to be run starts a line or is the target of a backwards jump
+func (vm *Vm) traceLine() error {
+	frame := vm.frame
+	if vm.lineStarts == nil {
+		vm.lineStarts = vm.state.lineStarts(frame.Code)
+	}
+	newLine := vm.lineStarts[frame.Lasti] || frame.Lasti < vm.traceLasti
+	vm.traceLasti = frame.Lasti
+	if !newLine {
+		return nil
+	}
+	return vm.trace(TraceLine, py.None)
+}
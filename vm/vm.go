@@ -41,4 +41,77 @@ type Vm struct {
 	curexc py.ExceptionInfo
 	// Previous exception type, value and traceback
 	exc py.ExceptionInfo
+	// Vm of the calling frame or nil
+	back *Vm
+	// State of the context the frame is running in
+	state *threadState
+	// Instruction last seen while tracing
+	traceLasti int32
+	// Which instructions start a line, set when first traced
+	lineStarts []bool
+}
+
+// The key the threadState of a context is stored under
+type threadStateKey struct{}
+
+// The state of the python code running in a context
+type threadState struct {
+	// The innermost running Vm, used to find the exception
+	// currently being handled for sys.exc_info
+	current *Vm
+	// The current trace function or nil
+	traceFunc TraceFunc
+	// Set while traceFunc is running so it isn't traced itself
+	tracing bool
+	// Which instructions start a line for each code traced
+	lines map[*py.Code][]bool
+	// Vms whose frames have finished, kept to save allocating a
+	// new one for every call
+	free []*Vm
+}
+
+// Returns the vm state of ctx, making it if necessary
+func getState(ctx *py.Context) *threadState {
+	if ctx == nil {
+		ctx = py.DefaultContext
+	}
+	state, ok := ctx.Value(threadStateKey{}).(*threadState)
+	if !ok {
+		state = &threadState{}
+		ctx.SetValue(threadStateKey{}, state)
+	}
+	return state
+}
+
+// Returns a Vm to run frame in, called from the current Vm
+func (state *threadState) newVm(frame *py.Frame) *Vm {
+	var vm *Vm
+	if n := len(state.free); n > 0 {
+		vm = state.free[n-1]
+		state.free = state.free[:n-1]
+	} else {
+		vm = new(Vm)
+	}
+	*vm = Vm{
+		frame: frame,
+		back:  state.current,
+		state: state,
+	}
+	return vm
+}
+
+// Keeps vm to be reused once its frame has finished running
+func (state *threadState) freeVm(vm *Vm) {
+	*vm = Vm{}
+	state.free = append(state.free, vm)
+}
+
+// Returns the exception currently being handled by the code running
+// in ctx or an empty ExceptionInfo if there isn't one
+func ExcInfo(ctx *py.Context) py.ExceptionInfo {
+	current := getState(ctx).current
+	if current == nil {
+		return py.ExceptionInfo{}
+	}
+	return current.exc
 }
@@ -7,7 +7,9 @@ package vm_test
 import (
 	"testing"
 
+	"github.com/go-python/gpython/py"
 	"github.com/go-python/gpython/pytest"
+	"github.com/go-python/gpython/vm"
 )
 
 func TestVm(t *testing.T) {
@@ -17,3 +19,11 @@ func TestVm(t *testing.T) {
 func BenchmarkVM(b *testing.B) {
 	pytest.RunBenchmarks(b, "benchmarks")
 }
+
+func BenchmarkVMTrace(b *testing.B) {
+	vm.SetTrace(py.DefaultContext, func(frame *py.Frame, event string, arg py.Object) error {
+		return nil
+	})
+	defer vm.SetTrace(py.DefaultContext, nil)
+	pytest.RunBenchmarks(b, "benchmarks")
+}
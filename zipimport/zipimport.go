@@ -0,0 +1,519 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Zipimport module
+//
+// Imports python modules and packages from zip archives on sys.path.
+
+package zipimport
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/go-python/gpython/marshal"
+	"github.com/go-python/gpython/py"
+	"github.com/go-python/gpython/vfs"
+)
+
+const module_doc = `zipimport provides support for importing Python modules from Zip archives.
+
+This module exports two objects:
+- zipimporter: a class; its constructor takes a path to a Zip archive.
+- ZipImportError: exception raised by zipimporter objects. It's a
+  subclass of ImportError, so it can be caught as ImportError, too.
+
+It is usually not needed to use the zipimport module explicitly; it is
+used by the builtin import mechanism for sys.path items that are paths
+to Zip archives.`
+
+var ZipImportError = py.ImportError.NewType("ZipImportError", "Error raised by the zipimporter.", nil, nil)
+
+// Magic number at the start of python 3.4 .pyc files which is the
+// bytecode gpython runs
+const pycMagic = 3310 | '\r'<<16 | '\n'<<24
+
+// Where an archive is
+type archiveKey struct {
+	fsys vfs.FS
+	path string
+}
+
+// The archives which have been read indexed by filesystem and path
+//
+// The archives are kept open so their files can be read on import.
+var (
+	directoryCacheMu sync.Mutex
+	directoryCache   = map[archiveKey]*zip.Reader{}
+)
+
+// Reads the directory of the zip file archive in fsys or fetches it
+// from the cache
+func readDirectory(fsys vfs.FS, archive string) (map[string]*zip.File, error) {
+	key := archiveKey{fsys: fsys, path: archive}
+	directoryCacheMu.Lock()
+	defer directoryCacheMu.Unlock()
+	r, ok := directoryCache[key]
+	if !ok {
+		var err error
+		r, err = openArchive(fsys, archive)
+		if err != nil {
+			return nil, py.ExceptionNewf(ZipImportError, "not a Zip file: '%s'", archive)
+		}
+		directoryCache[key] = r
+	}
+	files := make(map[string]*zip.File, len(r.File))
+	for _, f := range r.File {
+		files[f.Name] = f
+	}
+	return files, nil
+}
+
+// Opens the zip file archive in fsys leaving it open for reading
+// the files
+func openArchive(fsys vfs.FS, archive string) (*zip.Reader, error) {
+	f, err := vfs.Open(fsys, archive)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := f.Stat()
+	if err == nil {
+		var r *zip.Reader
+		r, err = zip.NewReader(f, fi.Size())
+		if err == nil {
+			return r, nil
+		}
+	}
+	_ = f.Close()
+	return nil, err
+}
+
+// ZipImporter imports modules from a zip archive
+//
+// Unlike python's zipimporter the module names it is given are full
+// dotted names which are looked up relative to the prefix, the same
+// way modules are looked up in directories on sys.path.
+type ZipImporter struct {
+	Archive string // path of the zip file
+	Prefix  string // directory within the archive, "" or ending in "/"
+	files   map[string]*zip.File
+	ctx     *py.Context // context modules are loaded into
+}
+
+var ZipImporterType = py.NewTypeX("zipimporter", `zipimporter(archivepath) -> zipimporter object
+
+Create a new zipimporter instance. 'archivepath' must be a path to
+a zipfile, or to a specific path inside a zipfile. For example, it can be
+'/tmp/myimport.zip', or '/tmp/myimport.zip/mydirectory', if mydirectory is a
+valid directory inside the archive.
+
+'ZipImportError is raised if 'archivepath' doesn't point to a valid Zip
+archive.`, zipImporterNoContext, nil)
+
+// Type of this object
+func (z *ZipImporter) Type() *py.Type {
+	if z.ctx == nil {
+		return ZipImporterType
+	}
+	return zipImporterType(z.ctx)
+}
+
+// Returns the zipimporter type of ctx which python code uses to make
+// zipimporters for archives on the context's filesystem
+func zipImporterType(ctx *py.Context) *py.Type {
+	return ctx.SubType(ZipImporterType, zipImporterNew)
+}
+
+// NewZipImporter makes a ZipImporter for path on the filesystem of
+// ctx which is either a zip file or a directory inside one such as
+// "app.zip/lib"
+//
+// It returns a ZipImportError if path isn't in a zip file.
+func NewZipImporter(ctx *py.Context, path string) (*ZipImporter, error) {
+	if path == "" {
+		return nil, py.ExceptionNewf(ZipImportError, "archive path is empty")
+	}
+	fsys := ctx.FS()
+	archive, prefix := path, ""
+	for {
+		fi, err := fsys.Stat(archive)
+		if err == nil {
+			if !fi.Mode().IsRegular() {
+				return nil, py.ExceptionNewf(ZipImportError, "not a Zip file")
+			}
+			break
+		}
+		parent := filepath.Dir(archive)
+		if parent == archive {
+			return nil, py.ExceptionNewf(ZipImportError, "not a Zip file")
+		}
+		prefix = filepath.Base(archive) + "/" + prefix
+		archive = parent
+	}
+	files, err := readDirectory(fsys, archive)
+	if err != nil {
+		return nil, err
+	}
+	return &ZipImporter{
+		Archive: archive,
+		Prefix:  prefix,
+		files:   files,
+		ctx:     ctx,
+	}, nil
+}
+
+// Refuses to make a zipimporter from python without a context to find
+// the archive in - the zipimporter type of each context is used
+// instead
+func zipImporterNoContext(metatype *py.Type, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	return nil, py.ExceptionNewf(py.TypeError, "cannot create '%s' instances", metatype.Name)
+}
+
+// Makes a zipimporter from python in ctx
+func zipImporterNew(ctx *py.Context, args py.Tuple, kwargs py.StringDict) (py.Object, error) {
+	var path py.Object
+	err := py.ParseTupleAndKeywords(args, kwargs, "O:zipimporter", []string{"archivepath"}, &path)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := path.(py.String)
+	if !ok {
+		return nil, py.ExceptionNewf(py.TypeError, "zipimporter() argument 1 must be str, not %s", path.Type().Name)
+	}
+	z, err := NewZipImporter(ctx, string(s))
+	if err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// Returns the path of name within the archive in the archive's file
+// system
+func (z *ZipImporter) osPath(name string) string {
+	return z.Archive + string(filepath.Separator) + filepath.FromSlash(name)
+}
+
+// Where a module was found in the archive
+type moduleInfo struct {
+	name      string // path of the file in the archive
+	isPackage bool
+	source    *zip.File // source file or nil
+	bytecode  *zip.File // up to date .pyc file or nil
+}
+
+// The places to look for a module in order
+var searchOrder = []struct {
+	suffix    string
+	isPackage bool
+}{
+	{"/__init__", true},
+	{"", false},
+}
+
+// Reads the header of the .pyc file f, returning false if it can't be
+// read or isn't python 3.4 bytecode
+func readPycHeader(f *zip.File) (header marshal.PycHeader, ok bool) {
+	rc, err := f.Open()
+	if err != nil {
+		return header, false
+	}
+	defer rc.Close()
+	err = binary.Read(rc, binary.LittleEndian, &header)
+	return header, err == nil && header.Magic == pycMagic
+}
+
+// Returns true if the .pyc file f can be used in place of source
+//
+// Like python, the timestamp in the .pyc header must be within a
+// second of the modification time of the source as zip files only
+// store times to two second accuracy.
+func bytecodeUsable(f *zip.File, source *zip.File) bool {
+	header, ok := readPycHeader(f)
+	if !ok {
+		return false
+	}
+	if source == nil {
+		return true
+	}
+	diff := int64(header.Timestamp) - source.Modified.Unix()
+	return diff >= -1 && diff <= 1
+}
+
+// Finds the module name, a full dotted name, in the archive
+func (z *ZipImporter) find(name string) (*moduleInfo, error) {
+	base := z.Prefix + strings.Replace(name, ".", "/", -1)
+	for _, search := range searchOrder {
+		path := base + search.suffix
+		source := z.files[path+".py"]
+		bytecode := z.files[path+".pyc"]
+		if bytecode != nil && !bytecodeUsable(bytecode, source) {
+			bytecode = nil
+		}
+		if source == nil && bytecode == nil {
+			continue
+		}
+		info := &moduleInfo{
+			name:      path + ".py",
+			isPackage: search.isPackage,
+			source:    source,
+			bytecode:  bytecode,
+		}
+		if bytecode != nil {
+			info.name = path + ".pyc"
+		}
+		return info, nil
+	}
+	return nil, py.ExceptionNewf(ZipImportError, "can't find module '%s'", name)
+}
+
+// Reads all of f
+func readFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, py.ExceptionNewf(ZipImportError, "can't read Zip file: %v", err)
+	}
+	defer rc.Close()
+	data, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return nil, py.ExceptionNewf(ZipImportError, "can't read Zip file: %v", err)
+	}
+	return data, nil
+}
+
+// FindModule returns the filename of the module name and whether it
+// is a package, or a ZipImportError if it isn't in the archive
+func (z *ZipImporter) FindModule(name string) (string, bool, error) {
+	info, err := z.find(name)
+	if err != nil {
+		return "", false, err
+	}
+	return z.osPath(info.name), info.isPackage, nil
+}
+
+// GetCode returns the code object for the module name reading it from
+// an up to date .pyc file if there is one or compiling the source
+func (z *ZipImporter) GetCode(name string) (*py.Code, error) {
+	info, err := z.find(name)
+	if err != nil {
+		return nil, err
+	}
+	if info.bytecode != nil {
+		data, err := readFile(info.bytecode)
+		if err != nil {
+			return nil, err
+		}
+		obj, err := marshal.ReadPyc(bytes.NewReader(data))
+		if err != nil {
+			return nil, py.ExceptionNewf(ZipImportError, "bad .pyc file %q: %v", z.osPath(info.name), err)
+		}
+		code, ok := obj.(*py.Code)
+		if !ok {
+			return nil, py.ExceptionNewf(py.TypeError, "compiled module %s is not a code object", z.osPath(info.name))
+		}
+		return code, nil
+	}
+	data, err := readFile(info.source)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := py.Compile(string(data), z.osPath(info.name), "exec", 0, true)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*py.Code), nil
+}
+
+// GetSource returns the source of the module name or None if it only
+// has a .pyc file
+func (z *ZipImporter) GetSource(name string) (py.Object, error) {
+	info, err := z.find(name)
+	if err != nil {
+		return nil, err
+	}
+	if info.source == nil {
+		return py.None, nil
+	}
+	data, err := readFile(info.source)
+	if err != nil {
+		return nil, err
+	}
+	return py.String(data), nil
+}
+
+// GetData returns the contents of the file at pathname in the archive
+//
+// pathname may start with the path of the archive.
+func (z *ZipImporter) GetData(pathname string) ([]byte, error) {
+	key := pathname
+	if strings.HasPrefix(key, z.Archive+string(filepath.Separator)) {
+		key = key[len(z.Archive)+1:]
+	}
+	f, ok := z.files[filepath.ToSlash(key)]
+	if !ok {
+		return nil, py.ExceptionNewf(py.FileNotFoundError, "[Errno 2] No such file or directory: '%s'", pathname)
+	}
+	return readFile(f)
+}
+
+func (z *ZipImporter) M__repr__() (py.Object, error) {
+	path := z.Archive
+	if z.Prefix != "" {
+		path = z.osPath(z.Prefix)
+	}
+	return py.String(fmt.Sprintf("<zipimporter object \"%s\">", path)), nil
+}
+
+// Check interfaces are satisfied
+var _ py.Importer = (*ZipImporter)(nil)
+var _ py.I__repr__ = (*ZipImporter)(nil)
+
+// Reads a fullname string argument
+func fullnameArg(name string, arg py.Object) (string, error) {
+	s, ok := arg.(py.String)
+	if !ok {
+		return "", py.ExceptionNewf(py.TypeError, "%s() argument must be str, not %s", name, arg.Type().Name)
+	}
+	return string(s), nil
+}
+
+func init() {
+	self := func(o py.Object) *ZipImporter {
+		return o.(*ZipImporter)
+	}
+	ZipImporterType.Dict["archive"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return py.String(self(o).Archive), nil
+		},
+	}
+	ZipImporterType.Dict["prefix"] = &py.Property{
+		Fget: func(o py.Object) (py.Object, error) {
+			return py.String(filepath.FromSlash(self(o).Prefix)), nil
+		},
+	}
+	ZipImporterType.Dict["find_module"] = py.MustNewMethod("find_module", func(o py.Object, args py.Tuple) (py.Object, error) {
+		var fullname, path py.Object = nil, py.None
+		err := py.UnpackTuple(args, nil, "find_module", 1, 2, &fullname, &path)
+		if err != nil {
+			return nil, err
+		}
+		name, err := fullnameArg("find_module", fullname)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := self(o).find(name); err != nil {
+			return py.None, nil
+		}
+		return o, nil
+	}, 0, `find_module(fullname, path=None) -> self or None.
+
+Search for a module specified by 'fullname'. 'fullname' must be the
+fully qualified (dotted) module name. It returns the zipimporter
+instance itself if the module was found, or None if it wasn't.
+The optional 'path' argument is ignored -- it's there for compatibility
+with the importer protocol.`)
+	ZipImporterType.Dict["load_module"] = py.MustNewMethod("load_module", func(o py.Object, arg py.Object) (py.Object, error) {
+		name, err := fullnameArg("load_module", arg)
+		if err != nil {
+			return nil, err
+		}
+		filename, isPackage, err := self(o).FindModule(name)
+		if err != nil {
+			return nil, err
+		}
+		return py.LoadModule(self(o).ctx, &py.ModuleSpec{
+			Name:      name,
+			Filename:  filename,
+			IsPackage: isPackage,
+			Importer:  self(o),
+		})
+	}, 0, `load_module(fullname) -> module.
+
+Load the module specified by 'fullname'. 'fullname' must be the
+fully qualified (dotted) module name. It returns the imported
+module, or raises ZipImportError if it wasn't found.`)
+	ZipImporterType.Dict["get_code"] = py.MustNewMethod("get_code", func(o py.Object, arg py.Object) (py.Object, error) {
+		name, err := fullnameArg("get_code", arg)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).GetCode(name)
+	}, 0, `get_code(fullname) -> code object.
+
+Return the code object for the specified module. Raise ZipImportError
+if the module couldn't be found.`)
+	ZipImporterType.Dict["get_source"] = py.MustNewMethod("get_source", func(o py.Object, arg py.Object) (py.Object, error) {
+		name, err := fullnameArg("get_source", arg)
+		if err != nil {
+			return nil, err
+		}
+		return self(o).GetSource(name)
+	}, 0, `get_source(fullname) -> source string.
+
+Return the source code for the specified module. Raise ZipImportError
+if the module couldn't be found, return None if the archive does
+contain the module, but has no source for it.`)
+	ZipImporterType.Dict["get_data"] = py.MustNewMethod("get_data", func(o py.Object, arg py.Object) (py.Object, error) {
+		pathname, err := fullnameArg("get_data", arg)
+		if err != nil {
+			return nil, err
+		}
+		data, err := self(o).GetData(pathname)
+		if err != nil {
+			return nil, err
+		}
+		return py.Bytes(data), nil
+	}, 0, `get_data(pathname) -> string with file data.
+
+Return the data associated with 'pathname'. Raise OSError if
+the file wasn't found.`)
+	ZipImporterType.Dict["get_filename"] = py.MustNewMethod("get_filename", func(o py.Object, arg py.Object) (py.Object, error) {
+		name, err := fullnameArg("get_filename", arg)
+		if err != nil {
+			return nil, err
+		}
+		filename, _, err := self(o).FindModule(name)
+		if err != nil {
+			return nil, err
+		}
+		return py.String(filename), nil
+	}, 0, `get_filename(fullname) -> filename string.
+
+Return the filename for the specified module.`)
+	ZipImporterType.Dict["is_package"] = py.MustNewMethod("is_package", func(o py.Object, arg py.Object) (py.Object, error) {
+		name, err := fullnameArg("is_package", arg)
+		if err != nil {
+			return nil, err
+		}
+		_, isPackage, err := self(o).FindModule(name)
+		if err != nil {
+			return nil, err
+		}
+		return py.NewBool(isPackage), nil
+	}, 0, `is_package(fullname) -> bool.
+
+Return True if the module specified by fullname is a package.
+Raise ZipImportError if the module couldn't be found.`)
+
+	py.AddPathHook(func(ctx *py.Context, entry string) (py.Importer, error) {
+		z, err := NewZipImporter(ctx, entry)
+		if err != nil {
+			return nil, err
+		}
+		return z, nil
+	})
+	globals := py.StringDict{
+		"zipimporter":    ZipImporterType,
+		"ZipImportError": ZipImportError,
+	}
+	module := py.NewModule("zipimport", module_doc, nil, globals)
+	module.ContextInit = func(m *py.Module) {
+		m.Globals["zipimporter"] = zipImporterType(m.Context)
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright 2018 The go-python Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zipimport_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	_ "github.com/go-python/gpython/builtin"
+	"github.com/go-python/gpython/compile"
+	"github.com/go-python/gpython/py"
+	_ "github.com/go-python/gpython/sys"
+	"github.com/go-python/gpython/vm"
+	_ "github.com/go-python/gpython/zipimport"
+)
+
+// Makes a python 3.4 .pyc file with the given timestamp for a module
+// which runs x = value
+func makePyc(timestamp time.Time, value string) []byte {
+	var b bytes.Buffer
+	w := func(x interface{}) {
+		_ = binary.Write(&b, binary.LittleEndian, x)
+	}
+	str := func(s string) {
+		b.WriteByte('z')
+		b.WriteByte(byte(len(s)))
+		b.WriteString(s)
+	}
+	w(uint32(3310 | '\r'<<16 | '\n'<<24))
+	w(int32(timestamp.Unix()))
+	w(int32(0))
+	b.WriteByte('c')
+	w([]int32{0, 0, 0, 1, 64}) // argcount, kwonlyargcount, nlocals, stacksize, flags
+	// LOAD_CONST 0; STORE_NAME 0; LOAD_CONST 1; RETURN_VALUE
+	code := []byte{100, 0, 0, 90, 0, 0, 100, 1, 0, 83}
+	b.WriteByte('s')
+	w(int32(len(code)))
+	b.Write(code)
+	b.WriteString(")\x02")
+	str(value)
+	b.WriteByte('N')
+	b.WriteString(")\x01")
+	str("x")
+	b.WriteString(")\x00)\x00)\x00") // varnames, freevars, cellvars
+	str("<zip>")
+	str("<module>")
+	w(int32(1))
+	b.WriteString("s\x00\x00\x00\x00")
+	return b.Bytes()
+}
+
+const testScript = `
+import sys
+import zipimport
+sys.path.append(archive)
+
+import fresh
+assert fresh.x == "bytecode", fresh.x
+assert fresh.__file__ == archive + "/fresh.pyc", fresh.__file__
+import stale
+assert stale.x == "source", stale.x
+assert stale.__file__ == archive + "/stale.py", stale.__file__
+import only
+assert only.x == "only"
+import pkg
+assert pkg.name == "pkg"
+assert pkg.__file__ == archive + "/pkg/__init__.py"
+from pkg.mod import x
+assert x == "mod"
+
+z = zipimport.zipimporter(archive)
+assert z.archive == archive
+assert z.prefix == ""
+assert z.find_module("pkg") is z
+assert z.find_module("nope") is None
+assert z.is_package("pkg")
+assert not z.is_package("pkg.mod")
+assert z.get_source("pkg.mod") == 'x = "mod"\n'
+assert z.get_source("only") is None
+assert z.get_filename("stale") == archive + "/stale.py"
+assert z.get_data(archive + "/pkg/mod.py") == b'x = "mod"\n'
+assert z.get_data("data.txt") == b"hello"
+ok = False
+try:
+    z.get_data("missing.txt")
+except OSError:
+    ok = True
+assert ok
+ok = False
+try:
+    z.get_code("nope")
+except zipimport.ZipImportError:
+    ok = True
+assert ok
+ok = False
+try:
+    zipimport.zipimporter(archive + ".missing")
+except ImportError:
+    ok = True
+assert ok
+
+z = zipimport.zipimporter(archive + "/lib")
+assert z.prefix == "lib/"
+inner = z.load_module("inner")
+assert inner.x == "inner"
+import inner as again
+assert again is inner
+`
+
+func TestZipImport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "zipimport")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	archive := filepath.Join(dir, "test.zip")
+
+	modified := time.Date(2018, 1, 2, 3, 4, 6, 0, time.UTC)
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, file := range []struct {
+		name string
+		data []byte
+	}{
+		{"fresh.py", []byte(`x = "source"` + "\n")},
+		{"fresh.pyc", makePyc(modified, "bytecode")},
+		{"stale.py", []byte(`x = "source"` + "\n")},
+		{"stale.pyc", makePyc(modified.Add(-time.Hour), "bytecode")},
+		{"only.pyc", makePyc(modified, "only")},
+		{"pkg/__init__.py", []byte(`name = "pkg"` + "\n")},
+		{"pkg/mod.py", []byte(`x = "mod"` + "\n")},
+		{"lib/inner.py", []byte(`x = "inner"` + "\n")},
+		{"data.txt", []byte("hello")},
+	} {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: file.name, Modified: modified, Method: zip.Deflate})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = w.Write(file.data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(archive, buf.Bytes(), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	obj, err := compile.Compile(testScript, "zipimport_test.py", "exec", 0, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	module := py.DefaultContext.NewModule("__main__", "", nil, nil)
+	module.Globals["archive"] = py.String(archive)
+	_, err = vm.Run(module.Globals, module.Globals, obj.(*py.Code), nil)
+	if err != nil {
+		py.TracebackDump(err)
+		t.Fatal(err)
+	}
+}